@@ -17,6 +17,8 @@ import (
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/commitment"
 	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/tlv"
 )
 
@@ -71,6 +73,23 @@ var (
 	// ErrUnknownVersion is returned when encountering an address with an
 	// unrecognised version number.
 	ErrUnknownVersion = errors.New("address: unknown version number")
+
+	// ErrUnknownRequiredRecord is returned when decoding an address with
+	// a record that has an unknown even type. Records with an even type
+	// are required to be understood.
+	ErrUnknownRequiredRecord = errors.New("address: unknown required " +
+		"record type")
+
+	// ErrInvalidNumConfs is returned when an address requests more
+	// confirmations than the chain backend can watch for.
+	ErrInvalidNumConfs = errors.New("address: invalid number of " +
+		"confirmations")
+)
+
+const (
+	// MaxNumConfs is the maximum number of confirmations an address can
+	// ask the sender to wait for.
+	MaxNumConfs = chainntnfs.MaxNumConfs
 )
 
 // Version denotes the version of a Taproot Asset address format.
@@ -83,8 +102,12 @@ const (
 	// V1 addresses use V2 Taproot Asset commitments.
 	V1 Version = 1
 
+	// V2 addresses use V2 Taproot Asset commitments and signal the features
+	// the sender needs to support in a feature vector.
+	V2 Version = 2
+
 	// LatestVersion is the latest supported Taproot Asset address version.
-	latestVersion = V1
+	latestVersion = V2
 )
 
 // Tap represents a Taproot Asset address. Taproot Asset addresses specify an
@@ -130,12 +153,28 @@ type Tap struct {
 	// ProofCourierAddr is the address of the proof courier that will be
 	// used to distribute related proofs for this address.
 	ProofCourierAddr url.URL
+
+	// NumConfs is the number of confirmations the receiver asks the sender
+	// to wait for before the transfer is considered complete. If zero,
+	// the sender waits for a single confirmation.
+	NumConfs uint32
+
+	// Features is the feature vector of a V2 address, which signals the
+	// features the sender needs to support. It is nil for addresses
+	// before V2.
+	Features *lnwire.RawFeatureVector
+
+	// UnknownOddTypes are the records with an odd type that were decoded
+	// but aren't known to this implementation. They are kept, so the
+	// address can be encoded again without losing them.
+	UnknownOddTypes tlv.TypeMap
 }
 
 // newAddrOptions are a set of options that can modified how a new address is
 // created.
 type newAddrOptions struct {
 	assetVersion asset.Version
+	numConfs     uint32
 }
 
 // defaultNewAddrOptions returns a newAddrOptions struct with default values.`
@@ -157,6 +196,15 @@ func WithAssetVersion(v asset.Version) NewAddrOpt {
 	}
 }
 
+// WithNumConfs is a new address option that allows callers to specify the
+// number of confirmations the sender should wait for before the transfer to
+// the address is considered complete.
+func WithNumConfs(numConfs uint32) NewAddrOpt {
+	return func(o *newAddrOptions) {
+		o.numConfs = numConfs
+	}
+}
+
 // New creates an address for receiving a Taproot asset.
 //
 // TODO(ffranr): This function takes many arguments. Add a struct to better
@@ -212,6 +260,10 @@ func New(version Version, genesis asset.Genesis, groupKey *btcec.PublicKey,
 		return nil, fmt.Errorf("address: missing group signature")
 	}
 
+	if options.numConfs > MaxNumConfs {
+		return nil, ErrInvalidNumConfs
+	}
+
 	payload := Tap{
 		Version:          version,
 		ChainParams:      net,
@@ -224,7 +276,15 @@ func New(version Version, genesis asset.Genesis, groupKey *btcec.PublicKey,
 		Amount:           amt,
 		assetGen:         genesis,
 		ProofCourierAddr: proofCourierAddr,
+		NumConfs:         options.numConfs,
+	}
+
+	// Starting with V2, addresses signal the features the sender needs to
+	// support.
+	if version >= V2 {
+		payload.Features = deriveFeatures(&payload)
 	}
+
 	return &payload, nil
 }
 
@@ -237,6 +297,19 @@ func (a *Tap) Copy() *Tap {
 		addressCopy.GroupKey = &groupPubKey
 	}
 
+	if a.Features != nil {
+		addressCopy.Features = a.Features.Clone()
+	}
+
+	if a.UnknownOddTypes != nil {
+		addressCopy.UnknownOddTypes = make(
+			tlv.TypeMap, len(a.UnknownOddTypes),
+		)
+		for typ, value := range a.UnknownOddTypes {
+			addressCopy.UnknownOddTypes[typ] = fn.CopySlice(value)
+		}
+	}
+
 	return &addressCopy
 }
 
@@ -250,7 +323,7 @@ func CommitmentVersion(vers Version) (*commitment.TapCommitmentVersion,
 	// can't know without accessing all leaves of the commitment itself.
 	case V0:
 		return nil, nil
-	case V1:
+	case V1, V2:
 		return fn.Ptr(commitment.TapCommitmentV2), nil
 	default:
 		return nil, ErrUnknownVersion
@@ -376,6 +449,22 @@ func (a *Tap) EncodeRecords() []tlv.Record {
 		records, newProofCourierAddrRecord(&a.ProofCourierAddr),
 	)
 
+	if a.NumConfs != 0 {
+		records = append(records, newAddressNumConfsRecord(&a.NumConfs))
+	}
+
+	if a.Features != nil {
+		records = append(records, newAddressFeaturesRecord(&a.Features))
+	}
+
+	// Any unknown odd records we decoded are encoded again, so the address
+	// doesn't change when it passes through this implementation.
+	for typ, value := range a.UnknownOddTypes {
+		value := value
+		records = append(records, tlv.MakePrimitiveRecord(typ, &value))
+	}
+	tlv.SortRecords(records)
+
 	return records
 }
 
@@ -392,6 +481,8 @@ func (a *Tap) DecodeRecords() []tlv.Record {
 		newAddressTapscriptSiblingRecord(&a.TapscriptSibling),
 		newAddressAmountRecord(&a.Amount),
 		newProofCourierAddrRecord(&a.ProofCourierAddr),
+		newAddressNumConfsRecord(&a.NumConfs),
+		newAddressFeaturesRecord(&a.Features),
 	}
 }
 
@@ -404,13 +495,49 @@ func (a *Tap) Encode(w io.Writer) error {
 	return stream.Encode(w)
 }
 
-// Decode decodes an address from a TLV stream.
+// Decode decodes an address from a TLV stream. Unknown records of addresses
+// before V2 are ignored. Starting with V2, unknown records with an odd type are
+// tolerated and kept in UnknownOddTypes, while unknown records with an even
+// type result in ErrUnknownRequiredRecord.
 func (a *Tap) Decode(r io.Reader) error {
 	stream, err := tlv.NewStream(a.DecodeRecords()...)
 	if err != nil {
 		return err
 	}
-	return stream.DecodeP2P(r)
+
+	parsedTypes, err := stream.DecodeWithParsedTypesP2P(r)
+	if err != nil {
+		return err
+	}
+
+	a.UnknownOddTypes = nil
+
+	// Addresses before V2 don't have a feature vector and are decoded as
+	// they always were, skipping over any record we don't know.
+	if a.Version < V2 {
+		a.Features = nil
+		return nil
+	}
+
+	for typ, value := range parsedTypes {
+		if value == nil {
+			continue
+		}
+
+		// Records with an even type must be understood, so we can't
+		// decode an address with an unknown one.
+		if typ%2 == 0 {
+			return fmt.Errorf("%w: %d", ErrUnknownRequiredRecord,
+				typ)
+		}
+
+		if a.UnknownOddTypes == nil {
+			a.UnknownOddTypes = make(tlv.TypeMap)
+		}
+		a.UnknownOddTypes[typ] = value
+	}
+
+	return nil
 }
 
 // EncodeAddress returns a bech32m string encoding of a Taproot Asset address.
@@ -449,7 +576,7 @@ func (a *Tap) String() string {
 // this implementation of tap.
 func IsUnknownVersion(v Version) bool {
 	switch v {
-	case V0, V1:
+	case V0, V1, V2:
 		return false
 	default:
 		return true
@@ -511,5 +638,9 @@ func DecodeAddress(addr string, net *ChainParams) (*Tap, error) {
 		return nil, ErrUnknownVersion
 	}
 
+	if a.NumConfs > MaxNumConfs {
+		return nil, ErrInvalidNumConfs
+	}
+
 	return &a, nil
 }
@@ -14,6 +14,8 @@ import (
 	"github.com/lightninglabs/taproot-assets/commitment"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
 	"github.com/stretchr/testify/require"
 )
 
@@ -126,6 +128,9 @@ func assertAddressEqual(t *testing.T, a, b *Tap) {
 	require.Equal(t, a.TapscriptSibling, b.TapscriptSibling)
 	require.Equal(t, a.Amount, b.Amount)
 	require.Equal(t, a.ProofCourierAddr, b.ProofCourierAddr)
+	require.Equal(t, a.NumConfs, b.NumConfs)
+	require.Equal(t, a.Features, b.Features)
+	require.Equal(t, a.UnknownOddTypes, b.UnknownOddTypes)
 }
 
 // TestNewAddress tests edge cases around creating a new address.
@@ -459,6 +464,151 @@ func TestAddressEncoding(t *testing.T) {
 	test.WriteTestVectors(t, generatedTestVectorName, testVectors)
 }
 
+// TestAddressNumConfs tests that the number of confirmations requested by an
+// address is encoded in a way that older decoders can ignore.
+func TestAddressNumConfs(t *testing.T) {
+	t.Parallel()
+
+	// An address can't ask for more confirmations than the chain backend
+	// can watch for.
+	_, err := randAddress(
+		t, &TestNet3Tap, nil, false, false, nil, asset.Normal,
+		WithNumConfs(MaxNumConfs+1),
+	)
+	require.ErrorIs(t, err, ErrInvalidNumConfs)
+
+	addr, err := randAddress(
+		t, &TestNet3Tap, nil, false, false, nil, asset.Normal,
+		WithNumConfs(6),
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 6, addr.NumConfs)
+
+	encodedAddr, err := addr.EncodeAddress()
+	require.NoError(t, err)
+	decodedAddr, err := DecodeAddress(encodedAddr, &TestNet3Tap)
+	require.NoError(t, err)
+	assertAddressEqual(t, addr, decodedAddr)
+
+	// Without a number of confirmations, the encoding doesn't change, so
+	// the record is just appended to the encoding.
+	var withConfs, withoutConfs bytes.Buffer
+	require.NoError(t, addr.Encode(&withConfs))
+
+	addrWithoutConfs := addr.Copy()
+	addrWithoutConfs.NumConfs = 0
+	require.NoError(t, addrWithoutConfs.Encode(&withoutConfs))
+	require.True(
+		t, bytes.HasPrefix(withConfs.Bytes(), withoutConfs.Bytes()),
+	)
+
+	// A decoder that doesn't know about the record can still decode the
+	// address, as the record type is odd.
+	var oldAddr Tap
+	records := fn.Filter(oldAddr.DecodeRecords(), func(r tlv.Record) bool {
+		return r.Type() != addrNumConfsType
+	})
+	stream, err := tlv.NewStream(records...)
+	require.NoError(t, err)
+	require.NoError(t, stream.DecodeP2P(bytes.NewReader(withConfs.Bytes())))
+	require.Equal(t, addr.AssetID, oldAddr.AssetID)
+	require.Zero(t, oldAddr.NumConfs)
+}
+
+// TestAddressV2Features tests that V2 addresses signal the features the sender
+// needs to support, and that unknown odd records survive an encoding round
+// trip.
+func TestAddressV2Features(t *testing.T) {
+	t.Parallel()
+
+	addr, err := randAddress(
+		t, &TestNet3Tap, fn.Ptr(V2), false, false, nil, asset.Normal,
+		WithNumConfs(6), WithAssetVersion(asset.V1),
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, lnwire.NewRawFeatureVector(
+		NumConfsRequired, AssetV1Required, HashmailCourierRequired,
+	), addr.Features)
+	require.NoError(t, addr.ValidateFeatures())
+
+	encodedAddr, err := addr.EncodeAddress()
+	require.NoError(t, err)
+	decodedAddr, err := DecodeAddress(encodedAddr, &TestNet3Tap)
+	require.NoError(t, err)
+	assertAddressEqual(t, addr, decodedAddr)
+
+	// Addresses before V2 don't carry a feature vector.
+	v1Addr, err := randAddress(
+		t, &TestNet3Tap, fn.Ptr(V1), false, false, nil, asset.Normal,
+		WithNumConfs(6),
+	)
+	require.NoError(t, err)
+	require.Nil(t, v1Addr.Features)
+	require.NoError(t, v1Addr.ValidateFeatures())
+
+	// A record with an unknown odd type is tolerated and kept, so the
+	// address encodes to the same string again.
+	oddAddr := addr.Copy()
+	oddAddr.UnknownOddTypes = tlv.TypeMap{
+		101: []byte("future feature"),
+	}
+	encodedOddAddr, err := oddAddr.EncodeAddress()
+	require.NoError(t, err)
+
+	decodedAddr, err = DecodeAddress(encodedOddAddr, &TestNet3Tap)
+	require.NoError(t, err)
+	assertAddressEqual(t, oddAddr, decodedAddr)
+
+	reEncodedAddr, err := decodedAddr.EncodeAddress()
+	require.NoError(t, err)
+	require.Equal(t, encodedOddAddr, reEncodedAddr)
+
+	// A record with an unknown even type must be understood, so the
+	// address can't be decoded.
+	evenAddr := addr.Copy()
+	evenAddr.UnknownOddTypes = tlv.TypeMap{
+		100: []byte("future feature"),
+	}
+	encodedEvenAddr, err := evenAddr.EncodeAddress()
+	require.NoError(t, err)
+
+	_, err = DecodeAddress(encodedEvenAddr, &TestNet3Tap)
+	require.ErrorIs(t, err, ErrUnknownRequiredRecord)
+
+	// Addresses before V2 are decoded as they always were, skipping over
+	// any unknown record, even or odd, without keeping it.
+	v1UnknownAddr := v1Addr.Copy()
+	v1UnknownAddr.UnknownOddTypes = tlv.TypeMap{
+		100: []byte("future feature"),
+		101: []byte("future feature"),
+	}
+	encodedV1Addr, err := v1UnknownAddr.EncodeAddress()
+	require.NoError(t, err)
+
+	decodedAddr, err = DecodeAddress(encodedV1Addr, &TestNet3Tap)
+	require.NoError(t, err)
+	assertAddressEqual(t, v1Addr, decodedAddr)
+
+	// Preparing the records for decoding doesn't modify the address.
+	v1Addr.DecodeRecords()
+	require.Nil(t, v1Addr.Features)
+
+	// Unknown optional features are ignored, while a sender must refuse to
+	// send to an address with an unknown required feature.
+	futureAddr := addr.Copy()
+	futureAddr.Features.Set(101)
+	require.NoError(t, futureAddr.ValidateFeatures())
+
+	futureAddr.Features.Set(100)
+	require.ErrorIs(
+		t, futureAddr.ValidateFeatures(), ErrUnknownRequiredFeature,
+	)
+
+	// The copy doesn't share the feature vector with the original.
+	require.False(t, addr.Features.IsSet(100))
+}
+
 // TestBIPTestVectors tests that the BIP test vectors are passing.
 func TestBIPTestVectors(t *testing.T) {
 	t.Parallel()
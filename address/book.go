@@ -10,6 +10,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taproot-assets/asset"
@@ -48,6 +49,75 @@ type AddrWithKeyInfo struct {
 	// ManagedAfter is the time at which the address was imported into the
 	// wallet.
 	ManagedAfter time.Time
+
+	// Note is an optional user-defined annotation of the address. It also
+	// serves as the label of the address in the inventory.
+	Note string
+
+	// ExpiryTime is the time after which the address no longer accepts
+	// new inbound transfers. A zero time means the address never expires.
+	ExpiryTime time.Time
+
+	// ArchivedAt is the time the address was archived at. A zero time
+	// means the address isn't archived.
+	ArchivedAt time.Time
+}
+
+// AddrState is the lifecycle state of an address in the inventory.
+type AddrState uint8
+
+const (
+	// AddrStateAll matches addresses in any state when querying.
+	AddrStateAll AddrState = 0
+
+	// AddrStateActive is the state of an address that accepts new inbound
+	// transfers.
+	AddrStateActive AddrState = 1
+
+	// AddrStateExpired is the state of an address whose expiry time has
+	// passed. The custodian doesn't watch for new inbound transfers to an
+	// expired address anymore.
+	AddrStateExpired AddrState = 2
+
+	// AddrStateArchived is the state of an address that was archived. The
+	// custodian doesn't watch for new inbound transfers to an archived
+	// address anymore.
+	AddrStateArchived AddrState = 3
+)
+
+// String returns a human-readable string for the address state.
+func (s AddrState) String() string {
+	switch s {
+	case AddrStateAll:
+		return "all"
+
+	case AddrStateActive:
+		return "active"
+
+	case AddrStateExpired:
+		return "expired"
+
+	case AddrStateArchived:
+		return "archived"
+
+	default:
+		return fmt.Sprintf("unknown(%d)", s)
+	}
+}
+
+// State returns the lifecycle state of the address at the given time. An
+// archived address is reported as archived, even if it also expired.
+func (a *AddrWithKeyInfo) State(now time.Time) AddrState {
+	switch {
+	case !a.ArchivedAt.IsZero():
+		return AddrStateArchived
+
+	case !a.ExpiryTime.IsZero() && !now.Before(a.ExpiryTime):
+		return AddrStateExpired
+
+	default:
+		return AddrStateActive
+	}
 }
 
 // QueryParams holds the set of query params for the address book.
@@ -70,6 +140,10 @@ type QueryParams struct {
 	// UnmanagedOnly is a boolean pointer indicating whether only addresses
 	// should be returned that are not yet managed by the wallet.
 	UnmanagedOnly bool
+
+	// State if set, only addresses in the given lifecycle state will be
+	// returned.
+	State AddrState
 }
 
 // AssetSyncer is an interface that allows the address.Book to look up asset
@@ -110,6 +184,21 @@ type Storage interface {
 	SetAddrManaged(ctx context.Context, addr *AddrWithKeyInfo,
 		managedFrom time.Time) error
 
+	// SetAddrNote sets the user-defined note of the address with the given
+	// Taproot output key. An empty note removes an existing note.
+	SetAddrNote(ctx context.Context, taprootOutputKey *btcec.PublicKey,
+		note string) error
+
+	// SetAddrExpiry sets the expiry time of the address with the given
+	// Taproot output key. A zero time removes an existing expiry.
+	SetAddrExpiry(ctx context.Context, taprootOutputKey *btcec.PublicKey,
+		expiry time.Time) error
+
+	// SetAddrArchived archives the address with the given Taproot output
+	// key at the given time. A zero time unarchives the address.
+	SetAddrArchived(ctx context.Context,
+		taprootOutputKey *btcec.PublicKey, archivedAt time.Time) error
+
 	// InsertInternalKey inserts an internal key into the database to make
 	// sure it is identified as a local key later on when importing proofs.
 	// The key can be an internal key for an asset script key or the
@@ -126,6 +215,31 @@ type Storage interface {
 	// this key in the asset list and balances).
 	InsertScriptKey(ctx context.Context, scriptKey asset.ScriptKey,
 		declareAsKnown bool) error
+
+	// InsertStaticAddr inserts a new static address into the database.
+	InsertStaticAddr(ctx context.Context,
+		addr *StaticAddrWithKeyInfo) error
+
+	// QueryStaticAddrs returns all static addresses.
+	QueryStaticAddrs(ctx context.Context) ([]StaticAddrWithKeyInfo, error)
+
+	// SetStaticAddrsScanHeight records that all static addresses were
+	// scanned for payments up to the given block height, which is the
+	// height of the block with the given hash.
+	SetStaticAddrsScanHeight(ctx context.Context, height uint32,
+		blockHash chainhash.Hash) error
+
+	// StaticAddrScanBlocks returns the hashes of the most recently scanned
+	// blocks, keyed by their height.
+	StaticAddrScanBlocks(
+		ctx context.Context) (map[uint32]chainhash.Hash, error)
+
+	// RollbackStaticAddrsScan resets the scan height of all static
+	// addresses to the given height and unclaims the payments that were
+	// found above it and aren't completed yet. The anchor outpoints of the
+	// unclaimed payments are returned.
+	RollbackStaticAddrsScan(ctx context.Context,
+		height uint32) ([]wire.OutPoint, error)
 }
 
 // KeyRing is used to create script and internal keys for Taproot Asset
@@ -145,6 +259,13 @@ type KeyRing interface {
 	// IsLocalKey returns true if the key is under the control of the wallet
 	// and can be derived by it.
 	IsLocalKey(ctx context.Context, desc keychain.KeyDescriptor) bool
+
+	// DeriveSharedKey returns a shared secret key by performing
+	// Diffie-Hellman key derivation between the ephemeral public key and
+	// the key specified by the key locator. The shared key is the SHA256
+	// of the compressed shared point.
+	DeriveSharedKey(ctx context.Context, ephemeralPubKey *btcec.PublicKey,
+		keyLocator *keychain.KeyLocator) ([32]byte, error)
 }
 
 // BookConfig is the main config for the address.Book.
@@ -419,6 +540,209 @@ func (b *Book) NextScriptKey(ctx context.Context,
 	return scriptKey, nil
 }
 
+// NewStaticAddr creates a new static address for the given asset. The chain is
+// scanned for payments to the address from the given birth height on, which
+// should be the current block height.
+func (b *Book) NewStaticAddr(ctx context.Context, assetID asset.ID,
+	assetVersion asset.Version, proofCourierAddr url.URL,
+	birthHeight uint32) (*StaticAddrWithKeyInfo, error) {
+
+	if _, err := b.queryAssetInfo(ctx, assetID); err != nil {
+		return nil, fmt.Errorf("unable to make static address for "+
+			"unknown asset %x: %w", assetID[:], err)
+	}
+
+	if !IsBech32MTapPrefix(b.cfg.Chain.TapHRP + "1") {
+		return nil, ErrUnsupportedHRP
+	}
+
+	// Each static address uses its own scan, spend and internal key.
+	var keyDescs [3]keychain.KeyDescriptor
+	for idx := range keyDescs {
+		keyDesc, err := b.cfg.KeyRing.DeriveNextTaprootAssetKey(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to gen key: %w", err)
+		}
+
+		keyDescs[idx] = keyDesc
+	}
+	scanKeyDesc, spendKeyDesc, internalKeyDesc := keyDescs[0], keyDescs[1],
+		keyDescs[2]
+
+	addr := &StaticAddrWithKeyInfo{
+		StaticAddr: &StaticAddr{
+			Version:          StaticV0,
+			ChainParams:      &b.cfg.Chain,
+			AssetVersion:     assetVersion,
+			AssetID:          assetID,
+			ScanKey:          *scanKeyDesc.PubKey,
+			SpendKey:         *spendKeyDesc.PubKey,
+			InternalKey:      *internalKeyDesc.PubKey,
+			ProofCourierAddr: proofCourierAddr,
+		},
+		ScanKeyDesc:     scanKeyDesc,
+		SpendKeyDesc:    spendKeyDesc,
+		InternalKeyDesc: internalKeyDesc,
+		CreationTime:    time.Now(),
+		ScanHeight:      birthHeight,
+	}
+	if err := b.cfg.Store.InsertStaticAddr(ctx, addr); err != nil {
+		return nil, fmt.Errorf("unable to insert static addr: %w", err)
+	}
+
+	return addr, nil
+}
+
+// ListStaticAddrs returns all static addresses.
+func (b *Book) ListStaticAddrs(
+	ctx context.Context) ([]StaticAddrWithKeyInfo, error) {
+
+	return b.cfg.Store.QueryStaticAddrs(ctx)
+}
+
+// SetStaticAddrsScanHeight records that all static addresses were scanned for
+// payments up to the given block height, which is the height of the block with
+// the given hash.
+func (b *Book) SetStaticAddrsScanHeight(ctx context.Context,
+	height uint32, blockHash chainhash.Hash) error {
+
+	return b.cfg.Store.SetStaticAddrsScanHeight(ctx, height, blockHash)
+}
+
+// StaticAddrScanBlocks returns the hashes of the most recently scanned blocks,
+// keyed by their height.
+func (b *Book) StaticAddrScanBlocks(
+	ctx context.Context) (map[uint32]chainhash.Hash, error) {
+
+	return b.cfg.Store.StaticAddrScanBlocks(ctx)
+}
+
+// RollbackStaticAddrsScan resets the scan height of all static addresses to
+// the given height and unclaims the payments that were found above it and
+// aren't completed yet. The anchor outpoints of the unclaimed payments are
+// returned.
+func (b *Book) RollbackStaticAddrsScan(ctx context.Context,
+	height uint32) ([]wire.OutPoint, error) {
+
+	return b.cfg.Store.RollbackStaticAddrsScan(ctx, height)
+}
+
+// NewStaticPayment derives the single-use address and announcement of a
+// payment of the given amount to the given static address, with a new
+// ephemeral key. The asset of the static address must be known or be
+// importable from the universe servers in our federation.
+func (b *Book) NewStaticPayment(ctx context.Context, addr *StaticAddr,
+	amount uint64) (*StaticPayment, error) {
+
+	assetGroup, err := b.queryAssetInfo(ctx, addr.AssetID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to pay static address for "+
+			"unknown asset %x: %w", addr.AssetID[:], err)
+	}
+
+	var (
+		groupKey     *btcec.PublicKey
+		groupWitness wire.TxWitness
+	)
+	if assetGroup.GroupKey != nil {
+		groupKey = &assetGroup.GroupPubKey
+		groupWitness = assetGroup.Witness
+	}
+
+	ephemeralKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, fmt.Errorf("unable to gen ephemeral key: %w", err)
+	}
+
+	return NewStaticPayment(
+		addr, *assetGroup.Genesis, groupKey, groupWitness, amount,
+		&keychain.PrivKeyECDH{PrivKey: ephemeralKey},
+	)
+}
+
+// ClaimStaticPayment checks whether the given payment announcement is meant
+// for the given static address. If it is, the single-use address of the
+// payment is added to the address book and returned, so the payment can be
+// received like a payment to a regular address. If the announcement is meant
+// for a different address, ErrStaticAnnouncementMismatch is returned.
+func (b *Book) ClaimStaticPayment(ctx context.Context,
+	addr *StaticAddrWithKeyInfo,
+	ann *StaticAnnouncement) (*AddrWithKeyInfo, error) {
+
+	sharedSecret, err := b.cfg.KeyRing.DeriveSharedKey(
+		ctx, &ann.EphemeralKey, &addr.ScanKeyDesc.KeyLocator,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive shared secret: %w",
+			err)
+	}
+
+	amount, err := ann.Open(sharedSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	scriptKey := StaticScriptKey(addr.SpendKeyDesc, sharedSecret)
+
+	return b.staticPaymentAddr(ctx, addr, scriptKey, amount)
+}
+
+// staticPaymentAddr returns the single-use address of a payment to the given
+// static address with the given script key and amount, adding it to the
+// address book if it isn't known yet.
+func (b *Book) staticPaymentAddr(ctx context.Context,
+	addr *StaticAddrWithKeyInfo, scriptKey asset.ScriptKey,
+	amount uint64) (*AddrWithKeyInfo, error) {
+
+	assetGroup, err := b.queryAssetInfo(ctx, addr.AssetID)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		groupKey     *btcec.PublicKey
+		groupWitness wire.TxWitness
+	)
+	if assetGroup.GroupKey != nil {
+		groupKey = &assetGroup.GroupPubKey
+		groupWitness = assetGroup.Witness
+	}
+
+	tapAddr, err := New(
+		staticPaymentVersion, *assetGroup.Genesis, groupKey,
+		groupWitness, *scriptKey.PubKey, addr.InternalKey, amount, nil,
+		&b.cfg.Chain, addr.ProofCourierAddr,
+		WithAssetVersion(addr.AssetVersion),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to make payment addr: %w", err)
+	}
+
+	taprootOutputKey, err := tapAddr.TaprootOutputKey()
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive Taproot output key:"+
+			" %w", err)
+	}
+
+	// The same payment might be found again, for example if a scan was
+	// interrupted by a shutdown. We then return the address we already
+	// know.
+	existing, err := b.cfg.Store.AddrByTaprootOutput(ctx, taprootOutputKey)
+	switch {
+	case err == nil:
+		return existing, nil
+
+	case !errors.Is(err, ErrNoAddr):
+		return nil, err
+	}
+
+	return b.NewAddressWithKeys(
+		ctx, staticPaymentVersion, addr.AssetID, amount, scriptKey,
+		addr.InternalKeyDesc, nil, addr.ProofCourierAddr,
+		WithAssetVersion(addr.AssetVersion),
+	)
+}
+
 // ListAddrs lists a set of addresses based on the expressed query params.
 func (b *Book) ListAddrs(ctx context.Context,
 	params QueryParams) ([]AddrWithKeyInfo, error) {
@@ -442,6 +766,43 @@ func (b *Book) SetAddrManaged(ctx context.Context, addr *AddrWithKeyInfo,
 	return b.cfg.Store.SetAddrManaged(ctx, addr, managedFrom)
 }
 
+// SetAddrNote sets the user-defined note of the address with the given Taproot
+// output key. An empty note removes an existing note.
+func (b *Book) SetAddrNote(ctx context.Context,
+	taprootOutputKey *btcec.PublicKey, note string) error {
+
+	return b.cfg.Store.SetAddrNote(ctx, taprootOutputKey, note)
+}
+
+// SetAddrExpiry sets the expiry time of the address with the given Taproot
+// output key. The custodian stops watching for new inbound transfers to the
+// address once the expiry time has passed. A zero time removes an existing
+// expiry.
+func (b *Book) SetAddrExpiry(ctx context.Context,
+	taprootOutputKey *btcec.PublicKey, expiry time.Time) error {
+
+	return b.cfg.Store.SetAddrExpiry(ctx, taprootOutputKey, expiry)
+}
+
+// ArchiveAddr archives the address with the given Taproot output key. The
+// custodian stops watching for new inbound transfers to an archived address,
+// while transfers that were already detected are still completed.
+func (b *Book) ArchiveAddr(ctx context.Context,
+	taprootOutputKey *btcec.PublicKey) error {
+
+	return b.cfg.Store.SetAddrArchived(ctx, taprootOutputKey, time.Now())
+}
+
+// UnarchiveAddr unarchives the address with the given Taproot output key, so
+// the custodian watches for new inbound transfers to it again. Transfers that
+// arrived while the address was archived are only picked up if the wallet
+// still reports them.
+func (b *Book) UnarchiveAddr(ctx context.Context,
+	taprootOutputKey *btcec.PublicKey) error {
+
+	return b.cfg.Store.SetAddrArchived(ctx, taprootOutputKey, time.Time{})
+}
+
 // GetOrCreateEvent creates a new address event for the given status, address
 // and transaction. If an event for that address and transaction already exists,
 // then the status and transaction information is updated instead.
@@ -0,0 +1,119 @@
+package address
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrContactNotFound is returned if no contact with the given label
+	// exists in the contact book.
+	ErrContactNotFound = errors.New("address: contact not found")
+
+	// ErrContactLabelEmpty is returned if a contact should be stored
+	// without a label.
+	ErrContactLabelEmpty = errors.New("address: contact label must be " +
+		"set")
+
+	// ErrContactTotalOverflow is returned if a send to a contact can't be
+	// recorded, because the total amount sent to it would overflow.
+	ErrContactTotalOverflow = errors.New("address: total amount sent to " +
+		"contact overflows")
+)
+
+// Contact is an entry of the contact book, which maps a user-defined label to
+// the Taproot Asset address of a recurring counterparty we send assets to.
+type Contact struct {
+	// Label is the unique, user-defined label of the contact.
+	Label string
+
+	// Addr is the bech32m encoded Taproot Asset address of the contact.
+	Addr string
+
+	// Group is the optional group the contact belongs to. An empty group
+	// means the contact isn't part of a group.
+	Group string
+
+	// Notes are free-form notes about the contact.
+	Notes string
+
+	// CreatedAt is the time the contact was created.
+	CreatedAt time.Time
+
+	// LastUsed is the time the address of the contact was last sent to.
+	// It is zero if nothing was sent to the contact yet.
+	LastUsed time.Time
+
+	// TotalSent is the cumulative amount of asset units sent to the
+	// contact.
+	TotalSent uint64
+
+	// NumSends is the number of sends to the contact.
+	NumSends uint64
+}
+
+// NewContact creates a new contact for the given address, making sure the
+// address is valid for the given network.
+func NewContact(label, addr, group, notes string,
+	params *ChainParams) (*Contact, error) {
+
+	if label == "" {
+		return nil, ErrContactLabelEmpty
+	}
+
+	tapAddr, err := DecodeAddress(addr, params)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address of contact %v: %w",
+			label, err)
+	}
+
+	// Contacts are only stored to send to them later, which isn't possible
+	// if the address requires a feature we don't know about.
+	if err := tapAddr.ValidateFeatures(); err != nil {
+		return nil, fmt.Errorf("invalid address of contact %v: %w",
+			label, err)
+	}
+
+	return &Contact{
+		Label: label,
+		Addr:  addr,
+		Group: group,
+		Notes: notes,
+	}, nil
+}
+
+// ContactQuery is used to filter the contacts of the contact book.
+type ContactQuery struct {
+	// Group, if set, limits the contacts to the ones of the given group.
+	Group *string
+}
+
+// ContactBook is a persisted address book of outbound destinations. It keeps
+// track of how often and how much was sent to each contact.
+type ContactBook interface {
+	// UpsertContact stores the given contact. If a contact with the same
+	// label already exists, its address, group and notes are replaced
+	// while its usage stats are kept.
+	UpsertContact(ctx context.Context, contact *Contact) error
+
+	// FetchContact returns the contact with the given label or
+	// ErrContactNotFound if it doesn't exist.
+	FetchContact(ctx context.Context, label string) (*Contact, error)
+
+	// QueryContacts returns all contacts that match the given query,
+	// ordered by their label.
+	QueryContacts(ctx context.Context,
+		query ContactQuery) ([]*Contact, error)
+
+	// DeleteContact removes the contact with the given label or returns
+	// ErrContactNotFound if it doesn't exist.
+	DeleteContact(ctx context.Context, label string) error
+
+	// RecordSends updates the usage stats of all contacts with the given
+	// addresses after they were sent to in a transfer. If the total amount
+	// sent to a contact would overflow, ErrContactTotalOverflow is returned
+	// and none of the sends are recorded.
+	RecordSends(ctx context.Context, addrs []*Tap) error
+}
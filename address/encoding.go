@@ -5,6 +5,7 @@ import (
 	"net/url"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/tlv"
 )
 
@@ -89,3 +90,26 @@ func VersionDecoder(r io.Reader, val any, buf *[8]byte, l uint64) error {
 	}
 	return tlv.NewTypeForDecodingErr(val, "Version", l, 1)
 }
+
+// FeaturesEncoder encodes a feature vector as its raw bytes, without a length
+// prefix, as the length is covered by the TLV record.
+func FeaturesEncoder(w io.Writer, val any, _ *[8]byte) error {
+	if t, ok := val.(**lnwire.RawFeatureVector); ok {
+		return (*t).EncodeBase256(w)
+	}
+	return tlv.NewTypeForEncodingErr(val, "*lnwire.RawFeatureVector")
+}
+
+// FeaturesDecoder decodes a feature vector from its raw bytes into a newly
+// allocated vector.
+func FeaturesDecoder(r io.Reader, val any, _ *[8]byte, l uint64) error {
+	if t, ok := val.(**lnwire.RawFeatureVector); ok {
+		features := lnwire.NewRawFeatureVector()
+		if err := features.DecodeBase256(r, int(l)); err != nil {
+			return err
+		}
+		*t = features
+		return nil
+	}
+	return tlv.NewTypeForDecodingErr(val, "*lnwire.RawFeatureVector", l, l)
+}
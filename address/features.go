@@ -0,0 +1,117 @@
+package address
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// The feature bits of V2 addresses. They follow the same rules as the feature
+// bits of the Lightning Network: features are assigned in pairs, where the
+// even bit signals that the sender is required to understand the feature and
+// the odd bit signals that the feature is optional. A sender must refuse to
+// send to an address with a required feature it doesn't know, while unknown
+// optional features are ignored.
+const (
+	// NumConfsRequired signals that the sender must wait for the number of
+	// confirmations the address asks for before the transfer is complete.
+	NumConfsRequired lnwire.FeatureBit = 0
+
+	// NumConfsOptional is the optional variant of NumConfsRequired.
+	NumConfsOptional lnwire.FeatureBit = 1
+
+	// AssetV1Required signals that the receiver expects the asset to be
+	// sent as a V1 asset.
+	AssetV1Required lnwire.FeatureBit = 2
+
+	// AssetV1Optional is the optional variant of AssetV1Required.
+	AssetV1Optional lnwire.FeatureBit = 3
+
+	// HashmailCourierRequired signals that the receiver expects the proof
+	// to be delivered through a hashmail proof courier.
+	HashmailCourierRequired lnwire.FeatureBit = 4
+
+	// HashmailCourierOptional is the optional variant of
+	// HashmailCourierRequired.
+	HashmailCourierOptional lnwire.FeatureBit = 5
+
+	// UniverseCourierRequired signals that the receiver expects the proof
+	// to be delivered through a universe RPC proof courier.
+	UniverseCourierRequired lnwire.FeatureBit = 6
+
+	// UniverseCourierOptional is the optional variant of
+	// UniverseCourierRequired.
+	UniverseCourierOptional lnwire.FeatureBit = 7
+)
+
+var (
+	// ErrUnknownRequiredFeature is returned when an address requires a
+	// feature that this implementation doesn't know.
+	ErrUnknownRequiredFeature = errors.New("address: unknown required " +
+		"feature")
+
+	// FeatureNames maps the known address feature bits to their names.
+	FeatureNames = map[lnwire.FeatureBit]string{
+		NumConfsRequired:        "num-confs",
+		NumConfsOptional:        "num-confs",
+		AssetV1Required:         "asset-v1",
+		AssetV1Optional:         "asset-v1",
+		HashmailCourierRequired: "hashmail-courier",
+		HashmailCourierOptional: "hashmail-courier",
+		UniverseCourierRequired: "universe-courier",
+		UniverseCourierOptional: "universe-courier",
+	}
+
+	// courierFeatures maps the proof courier URL schemes to the feature
+	// bit that requires them. The schemes match the courier types of the
+	// proof package.
+	courierFeatures = map[string]lnwire.FeatureBit{
+		"hashmail":    HashmailCourierRequired,
+		"universerpc": UniverseCourierRequired,
+	}
+)
+
+// deriveFeatures returns the features the sender of the given address needs
+// to support, derived from the fields of the address.
+func deriveFeatures(a *Tap) *lnwire.RawFeatureVector {
+	features := lnwire.NewRawFeatureVector()
+
+	if a.NumConfs != 0 {
+		features.Set(NumConfsRequired)
+	}
+
+	if a.AssetVersion == asset.V1 {
+		features.Set(AssetV1Required)
+	}
+
+	courierFeature, ok := courierFeatures[a.ProofCourierAddr.Scheme]
+	if ok {
+		features.Set(courierFeature)
+	}
+
+	return features
+}
+
+// FeatureVector returns the features of the address together with their
+// names. Addresses before V2 don't signal any features.
+func (a *Tap) FeatureVector() *lnwire.FeatureVector {
+	var raw *lnwire.RawFeatureVector
+	if a.Features != nil {
+		raw = a.Features.Clone()
+	}
+
+	return lnwire.NewFeatureVector(raw, FeatureNames)
+}
+
+// ValidateFeatures makes sure that the address doesn't require any feature
+// this implementation doesn't know, which means it is safe to send to it.
+func (a *Tap) ValidateFeatures() error {
+	unknown := a.FeatureVector().UnknownRequiredFeatures()
+	if len(unknown) != 0 {
+		return fmt.Errorf("%w: %v", ErrUnknownRequiredFeature, unknown)
+	}
+
+	return nil
+}
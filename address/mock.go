@@ -79,12 +79,13 @@ func RandAddr(t testing.TB, params *ChainParams,
 	}
 
 	addrVersion = test.RandFlip(V0, V1)
+	numConfs := test.RandFlip(0, uint32(test.RandIntn(MaxNumConfs)+1))
 
 	tapAddr, err := New(
 		addrVersion, genesis, groupPubKey, groupWitness,
 		*scriptKey.PubKey, *internalKey.PubKey(), amount,
 		tapscriptSibling, params, proofCourierAddr,
-		WithAssetVersion(assetVersion),
+		WithAssetVersion(assetVersion), WithNumConfs(numConfs),
 	)
 	require.NoError(t, err)
 
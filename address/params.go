@@ -1,6 +1,7 @@
 package address
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
@@ -21,6 +22,11 @@ const (
 	// SigNetHRP is the HRP for "the" signet.
 	SigNetHRP = "taptb"
 
+	// TestNet4HRP is the HRP for testnet4. Like signet, testnet4 shares
+	// the HRP with testnet3, as the on-chain addresses of all test networks
+	// share the same encoding.
+	TestNet4HRP = "taptb"
+
 	// SimNetHRP is the HRP for simnet.
 	SimNetHRP = "tapsb"
 )
@@ -58,6 +64,8 @@ func Register(params *ChainParams) error {
 	}
 
 	bech32TapPrefixes[params.TapHRP+"1"] = struct{}{}
+	registeredNets[params.Name] = *params
+
 	return nil
 }
 
@@ -66,6 +74,10 @@ var (
 	// bech32m encoded addresses.
 	bech32TapPrefixes = make(map[string]struct{})
 
+	// registeredNets holds the Taproot Asset chain params of all supported
+	// networks, keyed by the name of the network.
+	registeredNets = make(map[string]ChainParams)
+
 	// MainNetTap holds the chain params for mainnet.
 	MainNetTap = ChainParams{
 		Params: &chaincfg.MainNetParams,
@@ -84,6 +96,12 @@ var (
 		TapHRP: RegTestHRP,
 	}
 
+	// TestNet4Tap holds the chain params for testnet4.
+	TestNet4Tap = ChainParams{
+		Params: &TestNet4Params,
+		TapHRP: TestNet4HRP,
+	}
+
 	// SigNetTap holds the chain params for signet.
 	SigNetTap = ChainParams{
 		Params: &chaincfg.SigNetParams,
@@ -158,6 +176,9 @@ func ParamsForChain(name string) ChainParams {
 	case chaincfg.RegressionNetParams.Name:
 		return RegressionNetTap
 
+	case TestNet4Params.Name:
+		return TestNet4Tap
+
 	case chaincfg.SigNetParams.Name:
 		return SigNetTap
 
@@ -171,8 +192,45 @@ func ParamsForChain(name string) ChainParams {
 		return simNet
 
 	default:
-		panic(fmt.Sprintf("unknown chain: %v", name))
+		registerMtx.RLock()
+		params, ok := registeredNets[name]
+		registerMtx.RUnlock()
+
+		if !ok {
+			panic(fmt.Sprintf("unknown chain: %v", name))
+		}
+
+		return params
+	}
+}
+
+// ParamsFromChaincfg returns the Taproot Asset ChainParams for the given
+// chain parameters. In contrast to ParamsForChain, the given parameters are
+// used as they are (except for simnet, see ParamsForChain), which allows
+// networks with custom parameters, such as a custom signet, to be used.
+func ParamsFromChaincfg(params *chaincfg.Params) (ChainParams, error) {
+	registerMtx.RLock()
+	_, ok := registeredNets[params.Name]
+	registerMtx.RUnlock()
+
+	if !ok {
+		return ChainParams{}, fmt.Errorf("unsupported network: %v",
+			params.Name)
+	}
+
+	tapParams := ParamsForChain(params.Name)
+	if params.Name != chaincfg.SimNetParams.Name {
+		tapParams.Params = params
 	}
+
+	return tapParams, nil
+}
+
+// NetworkID returns a string that uniquely identifies the network. In addition
+// to the name, it contains the network magic, which differs between custom
+// signets that all share the same name.
+func (c *ChainParams) NetworkID() string {
+	return fmt.Sprintf("%s:%08x", c.Name, uint32(c.Net))
 }
 
 func init() {
@@ -180,9 +238,19 @@ func init() {
 	defer registerMtx.RUnlock()
 
 	// Register all default networks when the package is initialized.
-	bech32TapPrefixes[MainNetTap.TapHRP+"1"] = struct{}{}
-	bech32TapPrefixes[TestNet3Tap.TapHRP+"1"] = struct{}{}
-	bech32TapPrefixes[RegressionNetTap.TapHRP+"1"] = struct{}{}
-	bech32TapPrefixes[SigNetTap.TapHRP+"1"] = struct{}{}
-	bech32TapPrefixes[SimNetTap.TapHRP+"1"] = struct{}{}
+	defaultNets := []ChainParams{
+		MainNetTap, TestNet3Tap, TestNet4Tap, RegressionNetTap,
+		SigNetTap, SimNetTap,
+	}
+	for _, params := range defaultNets {
+		bech32TapPrefixes[params.TapHRP+"1"] = struct{}{}
+		registeredNets[params.Name] = params
+	}
+
+	// The version of btcd we depend on doesn't know about testnet4 yet, so
+	// we register its parameters ourselves.
+	err := chaincfg.Register(&TestNet4Params)
+	if err != nil && !errors.Is(err, chaincfg.ErrDuplicateNet) {
+		panic(fmt.Sprintf("unable to register testnet4: %v", err))
+	}
 }
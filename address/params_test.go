@@ -0,0 +1,57 @@
+package address
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTestNet4Params makes sure the testnet4 genesis block we construct
+// ourselves matches the one defined in BIP-94.
+func TestTestNet4Params(t *testing.T) {
+	t.Parallel()
+
+	expectedRoot, err := chainhash.NewHashFromStr(
+		"7aa0a7ae1e223414cb807e40cd57e667b718e42aaf9306db9102fe2891" +
+			"2b7b4e",
+	)
+	require.NoError(t, err)
+
+	expectedHash, err := chainhash.NewHashFromStr(
+		"00000000da84f2bafbbc53dee25a72ae507ff4914b867c565be350b0da" +
+			"8bf043",
+	)
+	require.NoError(t, err)
+
+	header := TestNet4Params.GenesisBlock.Header
+	require.Equal(t, *expectedRoot, header.MerkleRoot)
+	require.Equal(t, *expectedHash, *TestNet4Params.GenesisHash)
+	require.Equal(t, *expectedHash, header.BlockHash())
+
+	require.Equal(t, TestNet4Tap, ParamsForChain(TestNet4Name))
+	require.NotEqual(t, TestNet3Tap.NetworkID(), TestNet4Tap.NetworkID())
+}
+
+// TestParamsFromChaincfg tests that custom network parameters are kept when
+// deriving the Taproot Asset chain params from them.
+func TestParamsFromChaincfg(t *testing.T) {
+	t.Parallel()
+
+	customSignet := chaincfg.CustomSignetParams([]byte{0x51}, nil)
+	params, err := ParamsFromChaincfg(&customSignet)
+	require.NoError(t, err)
+	require.Equal(t, SigNetHRP, params.TapHRP)
+	require.Equal(t, &customSignet, params.Params)
+	require.NotEqual(t, SigNetTap.NetworkID(), params.NetworkID())
+
+	params, err = ParamsFromChaincfg(&chaincfg.MainNetParams)
+	require.NoError(t, err)
+	require.Equal(t, MainNetTap, params)
+
+	unknown := chaincfg.RegressionNetParams
+	unknown.Name = "unknown"
+	_, err = ParamsFromChaincfg(&unknown)
+	require.ErrorContains(t, err, "unsupported network")
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/tlv"
 )
 
@@ -41,6 +42,15 @@ const (
 
 	// addrProofCourierType is the TLV type of the proof courier address.
 	addrProofCourierAddrType addressTLVType = 12
+
+	// addrNumConfsType is the TLV type of the number of confirmations the
+	// sender should wait for. The type is odd, so older decoders that
+	// don't know about it can still decode the address.
+	addrNumConfsType addressTLVType = 13
+
+	// addrFeaturesType is the TLV type of the feature vector of V2
+	// addresses.
+	addrFeaturesType addressTLVType = 15
 )
 
 func newAddressVersionRecord(version *Version) tlv.Record {
@@ -125,3 +135,20 @@ func newProofCourierAddrRecord(addr *url.URL) tlv.Record {
 		UrlEncoder, UrlDecoder,
 	)
 }
+
+func newAddressNumConfsRecord(numConfs *uint32) tlv.Record {
+	return tlv.MakePrimitiveRecord(addrNumConfsType, numConfs)
+}
+
+func newAddressFeaturesRecord(features **lnwire.RawFeatureVector) tlv.Record {
+	recordSize := func() uint64 {
+		if *features == nil {
+			return 0
+		}
+		return uint64((*features).SerializeSize())
+	}
+	return tlv.MakeDynamicRecord(
+		addrFeaturesType, features, recordSize,
+		FeaturesEncoder, FeaturesDecoder,
+	)
+}
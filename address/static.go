@@ -0,0 +1,502 @@
+package address
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// Static addresses are reusable Taproot Asset addresses. Instead of a script
+// key, a static address contains a scan key and a spend key of the receiver.
+// For each payment, the sender creates a new ephemeral key and derives a
+// shared secret with the scan key, similar to BIP-352 silent payments. The
+// secret is used to tweak the spend key into a script key that is unique to
+// the payment and that can only be linked to the static address by the
+// receiver. The ephemeral key is announced in an OP_RETURN output of the
+// anchor transaction, so the receiver can find its payments by scanning the
+// chain.
+
+var (
+	// ErrStaticAnnouncementMismatch is returned when a static address
+	// payment announcement isn't meant for the static address it is
+	// checked against.
+	ErrStaticAnnouncementMismatch = errors.New("address: static address " +
+		"announcement doesn't match")
+)
+
+const (
+	// staticHRPSuffix is appended to the Taproot Asset HRP of a network to
+	// form the HRP of static addresses.
+	staticHRPSuffix = "s"
+
+	// staticTweakTag is the tag of the tagged hash that derives the script
+	// key tweak of a static address payment from the shared secret.
+	staticTweakTag = "taproot-assets/static-addr/tweak"
+
+	// staticCheckTag is the tag of the tagged hash that derives the check
+	// value of a static address payment announcement from the shared
+	// secret.
+	staticCheckTag = "taproot-assets/static-addr/check"
+
+	// staticAmountTag is the tag of the tagged hash that derives the key
+	// the amount of a static address payment is encrypted with from the
+	// shared secret.
+	staticAmountTag = "taproot-assets/static-addr/amount"
+
+	// staticAnnouncementMagic prefixes the data of a static address
+	// payment announcement, so the announcement can be told apart from
+	// other OP_RETURN outputs.
+	staticAnnouncementMagic = "TAPS"
+
+	// staticCheckLen is the length of the check value in a static address
+	// payment announcement.
+	staticCheckLen = 8
+
+	// staticAnnouncementLen is the length of the data pushed by the
+	// OP_RETURN output of a static address payment announcement.
+	staticAnnouncementLen = len(staticAnnouncementMagic) +
+		btcec.PubKeyBytesLenCompressed + staticCheckLen + 8
+
+	// staticPaymentVersion is the address version of the single-use
+	// addresses the payments to a static address are made to.
+	staticPaymentVersion = V1
+)
+
+// StaticVersion denotes the version of the static address format.
+type StaticVersion uint8
+
+const (
+	// StaticV0 is the initial static address format version.
+	StaticV0 StaticVersion = 0
+)
+
+// The TLV types of the static address records.
+const (
+	staticVersionType      tlv.Type = 0
+	staticAssetVersionType tlv.Type = 2
+	staticAssetIDType      tlv.Type = 4
+	staticScanKeyType      tlv.Type = 6
+	staticSpendKeyType     tlv.Type = 8
+	staticInternalKeyType  tlv.Type = 10
+	staticCourierAddrType  tlv.Type = 12
+)
+
+// StaticAddr is a reusable Taproot Asset address. Payments to a static address
+// are made to single-use addresses derived from it, which the receiver finds
+// by scanning the chain for payment announcements.
+type StaticAddr struct {
+	// Version is the version of the static address.
+	Version StaticVersion
+
+	// ChainParams is the reference to the chain parameters that were used
+	// to encode the static address.
+	ChainParams *ChainParams
+
+	// AssetVersion is the Taproot Asset version of the asset.
+	AssetVersion asset.Version
+
+	// AssetID is the asset ID of the asset.
+	AssetID asset.ID
+
+	// ScanKey is the public key the sender derives the shared secret of a
+	// payment with.
+	ScanKey btcec.PublicKey
+
+	// SpendKey is the public key that is tweaked with the shared secret of
+	// a payment to derive the script key of the payment.
+	SpendKey btcec.PublicKey
+
+	// InternalKey is the internal key of the anchor outputs of all
+	// payments to the static address.
+	InternalKey btcec.PublicKey
+
+	// ProofCourierAddr is the address of the proof courier that will be
+	// used to distribute the proofs of the payments.
+	ProofCourierAddr url.URL
+}
+
+// StaticAddrWithKeyInfo wraps a static address with the key descriptors of
+// its keys.
+type StaticAddrWithKeyInfo struct {
+	*StaticAddr
+
+	// ScanKeyDesc is the key descriptor of the scan key.
+	ScanKeyDesc keychain.KeyDescriptor
+
+	// SpendKeyDesc is the key descriptor of the spend key.
+	SpendKeyDesc keychain.KeyDescriptor
+
+	// InternalKeyDesc is the key descriptor of the internal key.
+	InternalKeyDesc keychain.KeyDescriptor
+
+	// CreationTime is the time the static address was created in the
+	// database.
+	CreationTime time.Time
+
+	// ScanHeight is the height of the last block that was scanned for
+	// payments to the static address.
+	ScanHeight uint32
+}
+
+// staticHRP returns the HRP of static addresses on the given network.
+func staticHRP(net *ChainParams) string {
+	return net.TapHRP + staticHRPSuffix
+}
+
+// records returns the TLV records of the static address.
+func (s *StaticAddr) records() []tlv.Record {
+	return []tlv.Record{
+		tlv.MakePrimitiveRecord(
+			staticVersionType, (*uint8)(&s.Version),
+		),
+		tlv.MakeStaticRecord(
+			staticAssetVersionType, &s.AssetVersion, 1,
+			asset.VersionEncoder, asset.VersionDecoder,
+		),
+		tlv.MakePrimitiveRecord(
+			staticAssetIDType, (*[32]byte)(&s.AssetID),
+		),
+		tlv.MakeStaticRecord(
+			staticScanKeyType, &s.ScanKey,
+			btcec.PubKeyBytesLenCompressed,
+			compressedPubKeyEncoder, compressedPubKeyDecoder,
+		),
+		tlv.MakeStaticRecord(
+			staticSpendKeyType, &s.SpendKey,
+			btcec.PubKeyBytesLenCompressed,
+			compressedPubKeyEncoder, compressedPubKeyDecoder,
+		),
+		tlv.MakeStaticRecord(
+			staticInternalKeyType, &s.InternalKey,
+			btcec.PubKeyBytesLenCompressed,
+			compressedPubKeyEncoder, compressedPubKeyDecoder,
+		),
+		newStaticCourierAddrRecord(&s.ProofCourierAddr),
+	}
+}
+
+// newStaticCourierAddrRecord returns the TLV record of the proof courier
+// address of a static address.
+func newStaticCourierAddrRecord(addr *url.URL) tlv.Record {
+	addrBytes := []byte(addr.String())
+	recordSize := tlv.SizeVarBytes(&addrBytes)
+
+	return tlv.MakeDynamicRecord(
+		staticCourierAddrType, addr, recordSize, UrlEncoder,
+		UrlDecoder,
+	)
+}
+
+// Encode encodes the static address into a TLV stream.
+func (s *StaticAddr) Encode(w io.Writer) error {
+	stream, err := tlv.NewStream(s.records()...)
+	if err != nil {
+		return err
+	}
+	return stream.Encode(w)
+}
+
+// Decode decodes a static address from a TLV stream.
+func (s *StaticAddr) Decode(r io.Reader) error {
+	stream, err := tlv.NewStream(s.records()...)
+	if err != nil {
+		return err
+	}
+
+	parsedTypes, err := stream.DecodeWithParsedTypesP2P(r)
+	if err != nil {
+		return err
+	}
+
+	// Records with an even type must be understood, so we can't decode a
+	// static address with an unknown one.
+	for typ, value := range parsedTypes {
+		if value != nil && typ%2 == 0 {
+			return fmt.Errorf("%w: %d", ErrUnknownRequiredRecord,
+				typ)
+		}
+	}
+
+	return nil
+}
+
+// EncodeAddress returns a bech32m string encoding of the static address.
+func (s *StaticAddr) EncodeAddress() (string, error) {
+	if !IsBech32MTapPrefix(s.ChainParams.TapHRP + "1") {
+		return "", ErrUnsupportedHRP
+	}
+
+	var buf bytes.Buffer
+	if err := s.Encode(&buf); err != nil {
+		return "", err
+	}
+
+	converted, err := bech32.ConvertBits(buf.Bytes(), 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+
+	return bech32.EncodeM(staticHRP(s.ChainParams), converted)
+}
+
+// String returns the string representation of the static address.
+func (s *StaticAddr) String() string {
+	return fmt.Sprintf("StaticAddr{id=%s, scan_key=%x}", s.AssetID,
+		s.ScanKey.SerializeCompressed())
+}
+
+// DecodeStaticAddress parses a bech32m encoded static address string for the
+// given network.
+func DecodeStaticAddress(addr string,
+	net *ChainParams) (*StaticAddr, error) {
+
+	oneIndex := strings.LastIndexByte(addr, '1')
+	if oneIndex <= 0 {
+		return nil, ErrInvalidBech32m
+	}
+
+	if strings.ToLower(addr[:oneIndex]) != staticHRP(net) {
+		return nil, ErrMismatchedHRP
+	}
+
+	_, data, err := bech32.DecodeNoLimit(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	converted, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var s StaticAddr
+	if err := s.Decode(bytes.NewReader(converted)); err != nil {
+		return nil, err
+	}
+
+	if s.Version != StaticV0 {
+		return nil, ErrUnknownVersion
+	}
+
+	s.ChainParams = net
+
+	return &s, nil
+}
+
+// StaticScriptKey derives the script key of a payment to a static address
+// from the spend key of the address and the shared secret of the payment. The
+// tweak is applied like a tapscript root, so the receiver can spend the asset
+// with a plain key spend of the tweaked key.
+func StaticScriptKey(spendKey keychain.KeyDescriptor,
+	sharedSecret [32]byte) asset.ScriptKey {
+
+	tweak := chainhash.TaggedHash([]byte(staticTweakTag), sharedSecret[:])
+
+	tweakedKey := txscript.ComputeTaprootOutputKey(
+		spendKey.PubKey, tweak[:],
+	)
+
+	// Like with BIP-0086 script keys, we only ever use the x-only
+	// representation of the key.
+	tweakedKey, _ = schnorr.ParsePubKey(schnorr.SerializePubKey(tweakedKey))
+
+	return asset.ScriptKey{
+		PubKey: tweakedKey,
+		TweakedScriptKey: &asset.TweakedScriptKey{
+			RawKey: spendKey,
+			Tweak:  tweak[:],
+		},
+	}
+}
+
+// staticAmountKey returns the key the amount of a static address payment is
+// encrypted with.
+func staticAmountKey(sharedSecret [32]byte) [8]byte {
+	var key [8]byte
+	hash := chainhash.TaggedHash([]byte(staticAmountTag), sharedSecret[:])
+	copy(key[:], hash[:])
+
+	return key
+}
+
+// StaticAnnouncement is the announcement of a payment to a static address
+// that is placed in the anchor transaction of the payment, so the receiver can
+// find the payment.
+type StaticAnnouncement struct {
+	// EphemeralKey is the ephemeral public key of the sender the shared
+	// secret of the payment is derived with.
+	EphemeralKey btcec.PublicKey
+
+	// Check is derived from the shared secret and allows the receiver to
+	// tell whether the payment is meant for one of its static addresses.
+	Check [staticCheckLen]byte
+
+	// EncryptedAmount is the amount of the payment, encrypted with a key
+	// derived from the shared secret.
+	EncryptedAmount [8]byte
+}
+
+// newStaticAnnouncement creates the announcement of a payment of the given
+// amount with the given ephemeral key and shared secret.
+func newStaticAnnouncement(ephemeralKey *btcec.PublicKey,
+	sharedSecret [32]byte, amount uint64) *StaticAnnouncement {
+
+	ann := &StaticAnnouncement{
+		EphemeralKey: *ephemeralKey,
+	}
+
+	check := chainhash.TaggedHash([]byte(staticCheckTag), sharedSecret[:])
+	copy(ann.Check[:], check[:])
+
+	amountKey := staticAmountKey(sharedSecret)
+	binary.BigEndian.PutUint64(ann.EncryptedAmount[:], amount)
+	for i := range ann.EncryptedAmount {
+		ann.EncryptedAmount[i] ^= amountKey[i]
+	}
+
+	return ann
+}
+
+// Open checks that the announcement was created with the given shared secret
+// and returns the decrypted amount of the payment. If the announcement was
+// created with a different secret, ErrStaticAnnouncementMismatch is returned.
+func (a *StaticAnnouncement) Open(sharedSecret [32]byte) (uint64, error) {
+	check := chainhash.TaggedHash([]byte(staticCheckTag), sharedSecret[:])
+	if subtle.ConstantTimeCompare(check[:staticCheckLen], a.Check[:]) != 1 {
+		return 0, ErrStaticAnnouncementMismatch
+	}
+
+	var amountBytes [8]byte
+	amountKey := staticAmountKey(sharedSecret)
+	for i := range amountBytes {
+		amountBytes[i] = a.EncryptedAmount[i] ^ amountKey[i]
+	}
+
+	return binary.BigEndian.Uint64(amountBytes[:]), nil
+}
+
+// PkScript returns the OP_RETURN script that carries the announcement.
+func (a *StaticAnnouncement) PkScript() ([]byte, error) {
+	data := make([]byte, 0, staticAnnouncementLen)
+	data = append(data, []byte(staticAnnouncementMagic)...)
+	data = append(data, a.EphemeralKey.SerializeCompressed()...)
+	data = append(data, a.Check[:]...)
+	data = append(data, a.EncryptedAmount[:]...)
+
+	return txscript.NullDataScript(data)
+}
+
+// TxOut returns the zero value OP_RETURN output that carries the announcement.
+func (a *StaticAnnouncement) TxOut() (*wire.TxOut, error) {
+	pkScript, err := a.PkScript()
+	if err != nil {
+		return nil, err
+	}
+
+	return wire.NewTxOut(0, pkScript), nil
+}
+
+// ParseStaticAnnouncement parses a static address payment announcement from
+// the given output script. False is returned if the script isn't an
+// announcement.
+func ParseStaticAnnouncement(pkScript []byte) (*StaticAnnouncement, bool) {
+	// The announcement is a single data push after OP_RETURN, which is
+	// short enough to use a direct push opcode.
+	if len(pkScript) != staticAnnouncementLen+2 ||
+		pkScript[0] != txscript.OP_RETURN ||
+		int(pkScript[1]) != staticAnnouncementLen {
+
+		return nil, false
+	}
+
+	data := pkScript[2:]
+	if !bytes.HasPrefix(data, []byte(staticAnnouncementMagic)) {
+		return nil, false
+	}
+	data = data[len(staticAnnouncementMagic):]
+
+	ephemeralKey, err := btcec.ParsePubKey(
+		data[:btcec.PubKeyBytesLenCompressed],
+	)
+	if err != nil {
+		return nil, false
+	}
+	data = data[btcec.PubKeyBytesLenCompressed:]
+
+	ann := &StaticAnnouncement{
+		EphemeralKey: *ephemeralKey,
+	}
+	copy(ann.Check[:], data[:staticCheckLen])
+	copy(ann.EncryptedAmount[:], data[staticCheckLen:])
+
+	return ann, true
+}
+
+// StaticPayment is a single payment to a static address. The payment is made
+// by sending to the single-use address and placing the announcement in the
+// anchor transaction.
+type StaticPayment struct {
+	// Addr is the single-use address derived for the payment.
+	Addr *Tap
+
+	// Announcement is the announcement of the payment.
+	Announcement *StaticAnnouncement
+}
+
+// NewStaticPayment derives the single-use address and announcement of a
+// payment of the given amount to the static address, using the given
+// ephemeral key of the sender. A new ephemeral key must be used for each
+// payment. The genesis and group key of the asset must be known by the
+// sender.
+func NewStaticPayment(addr *StaticAddr, genesis asset.Genesis,
+	groupKey *btcec.PublicKey, groupWitness wire.TxWitness, amount uint64,
+	ephemeralKey keychain.SingleKeyECDH) (*StaticPayment, error) {
+
+	if genesis.ID() != addr.AssetID {
+		return nil, fmt.Errorf("address: genesis doesn't match asset "+
+			"ID %v of static address", addr.AssetID)
+	}
+
+	sharedSecret, err := ephemeralKey.ECDH(&addr.ScanKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive shared secret: %w",
+			err)
+	}
+
+	scriptKey := StaticScriptKey(
+		keychain.KeyDescriptor{PubKey: &addr.SpendKey}, sharedSecret,
+	)
+
+	tapAddr, err := New(
+		staticPaymentVersion, genesis, groupKey, groupWitness,
+		*scriptKey.PubKey, addr.InternalKey, amount, nil,
+		addr.ChainParams, addr.ProofCourierAddr,
+		WithAssetVersion(addr.AssetVersion),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StaticPayment{
+		Addr: tapAddr,
+		Announcement: newStaticAnnouncement(
+			ephemeralKey.PubKey(), sharedSecret, amount,
+		),
+	}, nil
+}
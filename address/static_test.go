@@ -0,0 +1,151 @@
+package address
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStaticAddressEncoding tests that a static address survives an encoding
+// round trip and can't be decoded for a different network.
+func TestStaticAddressEncoding(t *testing.T) {
+	t.Parallel()
+
+	courierAddr, err := url.ParseRequestURI(
+		"hashmail://universe.lightning.finance:443",
+	)
+	require.NoError(t, err)
+
+	addr := &StaticAddr{
+		Version:          StaticV0,
+		ChainParams:      &TestNet3Tap,
+		AssetVersion:     asset.V1,
+		AssetID:          asset.RandID(t),
+		ScanKey:          *test.RandPubKey(t),
+		SpendKey:         *test.RandPubKey(t),
+		InternalKey:      *test.RandPubKey(t),
+		ProofCourierAddr: *courierAddr,
+	}
+
+	encodedAddr, err := addr.EncodeAddress()
+	require.NoError(t, err)
+
+	decodedAddr, err := DecodeStaticAddress(encodedAddr, &TestNet3Tap)
+	require.NoError(t, err)
+	require.Equal(t, addr, decodedAddr)
+
+	_, err = DecodeStaticAddress(encodedAddr, &MainNetTap)
+	require.ErrorIs(t, err, ErrMismatchedHRP)
+
+	// A static address isn't a regular address.
+	_, err = DecodeAddress(encodedAddr, &TestNet3Tap)
+	require.Error(t, err)
+}
+
+// TestStaticPayment tests that the receiver of a static address payment
+// derives the same script key and amount from the announcement as the sender,
+// while a different receiver can't open the announcement.
+func TestStaticPayment(t *testing.T) {
+	t.Parallel()
+
+	scanKey := test.RandPrivKey(t)
+	spendKey := test.RandPrivKey(t)
+	genesis := asset.RandGenesis(t, asset.Normal)
+
+	courierAddr, err := url.ParseRequestURI(
+		"hashmail://universe.lightning.finance:443",
+	)
+	require.NoError(t, err)
+
+	addr := &StaticAddr{
+		Version:          StaticV0,
+		ChainParams:      &TestNet3Tap,
+		AssetVersion:     asset.V0,
+		AssetID:          genesis.ID(),
+		ScanKey:          *scanKey.PubKey(),
+		SpendKey:         *spendKey.PubKey(),
+		InternalKey:      *test.RandPubKey(t),
+		ProofCourierAddr: *courierAddr,
+	}
+
+	const amount = 1234
+	payment, err := NewStaticPayment(
+		addr, genesis, nil, nil, amount,
+		&keychain.PrivKeyECDH{PrivKey: test.RandPrivKey(t)},
+	)
+	require.NoError(t, err)
+	require.Equal(t, uint64(amount), payment.Addr.Amount)
+
+	// A second payment with a different ephemeral key pays to a different
+	// script key.
+	payment2, err := NewStaticPayment(
+		addr, genesis, nil, nil, amount,
+		&keychain.PrivKeyECDH{PrivKey: test.RandPrivKey(t)},
+	)
+	require.NoError(t, err)
+	require.False(t, payment.Addr.ScriptKey.IsEqual(
+		&payment2.Addr.ScriptKey,
+	))
+
+	// The announcement is found in the output script of the anchor
+	// transaction.
+	txOut, err := payment.Announcement.TxOut()
+	require.NoError(t, err)
+	require.Zero(t, txOut.Value)
+
+	ann, ok := ParseStaticAnnouncement(txOut.PkScript)
+	require.True(t, ok)
+	require.Equal(t, payment.Announcement, ann)
+
+	// The receiver derives the same shared secret with its scan key.
+	scanECDH := &keychain.PrivKeyECDH{PrivKey: scanKey}
+	sharedSecret, err := scanECDH.ECDH(&ann.EphemeralKey)
+	require.NoError(t, err)
+
+	openedAmount, err := ann.Open(sharedSecret)
+	require.NoError(t, err)
+	require.Equal(t, uint64(amount), openedAmount)
+
+	scriptKey := StaticScriptKey(
+		keychain.KeyDescriptor{PubKey: spendKey.PubKey()}, sharedSecret,
+	)
+	require.True(t, scriptKey.PubKey.IsEqual(&payment.Addr.ScriptKey))
+
+	// The receiver can sign for the script key by tweaking the spend key
+	// with the tweak of the script key.
+	tweakedPrivKey := txscript.TweakTaprootPrivKey(
+		*spendKey, scriptKey.Tweak,
+	)
+	require.Equal(
+		t, schnorr.SerializePubKey(scriptKey.PubKey),
+		schnorr.SerializePubKey(tweakedPrivKey.PubKey()),
+	)
+
+	// Someone else can't open the announcement.
+	otherECDH := &keychain.PrivKeyECDH{PrivKey: test.RandPrivKey(t)}
+	otherSecret, err := otherECDH.ECDH(&ann.EphemeralKey)
+	require.NoError(t, err)
+
+	_, err = ann.Open(otherSecret)
+	require.ErrorIs(t, err, ErrStaticAnnouncementMismatch)
+
+	// Other scripts aren't mistaken for announcements.
+	_, ok = ParseStaticAnnouncement(txOut.PkScript[:len(txOut.PkScript)-1])
+	require.False(t, ok)
+
+	otherScript, err := txscript.NullDataScript([]byte("not a payment"))
+	require.NoError(t, err)
+	_, ok = ParseStaticAnnouncement(otherScript)
+	require.False(t, ok)
+
+	p2trScript, err := txscript.PayToTaprootScript(test.RandPubKey(t))
+	require.NoError(t, err)
+	_, ok = ParseStaticAnnouncement(p2trScript)
+	require.False(t, ok)
+}
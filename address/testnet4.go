@@ -0,0 +1,95 @@
+package address
+
+import (
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+)
+
+const (
+	// TestNet4Name is the name of the test network introduced in BIP-94.
+	TestNet4Name = "testnet4"
+
+	// testNet4Magic is the network magic of testnet4.
+	testNet4Magic wire.BitcoinNet = 0x283f161c
+)
+
+var (
+	// testNet4GenesisMessage is the message embedded in the coinbase of the
+	// testnet4 genesis block.
+	testNet4GenesisMessage = "03/May/2024 00000000000000000000" +
+		"1ebd58c244970b3aa9d783bb001011fbe8ea8e98e00e"
+
+	// testNet4GenesisCoinbase is the coinbase transaction of the testnet4
+	// genesis block.
+	testNet4GenesisCoinbase = wire.MsgTx{
+		Version: 1,
+		TxIn: []*wire.TxIn{{
+			PreviousOutPoint: wire.OutPoint{
+				Index: wire.MaxPrevOutIndex,
+			},
+			SignatureScript: append(
+				[]byte{
+					0x04, 0xff, 0xff, 0x00, 0x1d, 0x01,
+					0x04, 0x4c,
+					byte(len(testNet4GenesisMessage)),
+				},
+				testNet4GenesisMessage...,
+			),
+			Sequence: wire.MaxTxInSequenceNum,
+		}},
+		TxOut: []*wire.TxOut{{
+			Value: 50 * 1e8,
+			PkScript: append(
+				append([]byte{0x21}, make([]byte, 33)...),
+				0xac,
+			),
+		}},
+	}
+
+	// testNet4GenesisBlock is the genesis block of testnet4.
+	testNet4GenesisBlock = wire.MsgBlock{
+		Header: wire.BlockHeader{
+			Version:    1,
+			MerkleRoot: testNet4GenesisCoinbase.TxHash(),
+			Timestamp:  time.Unix(1714777860, 0),
+			Bits:       0x1d00ffff,
+			Nonce:      393743547,
+		},
+		Transactions: []*wire.MsgTx{&testNet4GenesisCoinbase},
+	}
+
+	// testNet4GenesisHash is the hash of the testnet4 genesis block.
+	testNet4GenesisHash = testNet4GenesisBlock.BlockHash()
+
+	// TestNet4Params are the chain parameters of testnet4 (BIP-94). The
+	// version of btcd we depend on doesn't define them yet, so they are
+	// derived from the testnet3 parameters, which share the address
+	// encoding and proof of work limit.
+	TestNet4Params = newTestNet4Params()
+)
+
+// newTestNet4Params creates the chain parameters of testnet4.
+func newTestNet4Params() chaincfg.Params {
+	params := chaincfg.TestNet3Params
+
+	params.Name = TestNet4Name
+	params.Net = testNet4Magic
+	params.DefaultPort = "48333"
+	params.DNSSeeds = []chaincfg.DNSSeed{{
+		Host:         "seed.testnet4.bitcoin.sprovoost.nl",
+		HasFiltering: true,
+	}, {
+		Host:         "seed.testnet4.wiz.biz",
+		HasFiltering: true,
+	}}
+	params.GenesisBlock = &testNet4GenesisBlock
+	params.GenesisHash = &testNet4GenesisHash
+	params.BIP0034Height = 1
+	params.BIP0065Height = 1
+	params.BIP0066Height = 1
+	params.Checkpoints = nil
+
+	return params
+}
@@ -629,6 +629,19 @@ func TapTreeNodesFromBranch(branch txscript.TapBranch) TapscriptTreeNodes {
 	}
 }
 
+// TapTreeNodesFromBranchHashes creates a TapscriptTreeNodes object from the
+// tapHashes of the two child nodes of a TapBranch.
+func TapTreeNodesFromBranchHashes(left,
+	right chainhash.Hash) TapscriptTreeNodes {
+
+	return TapscriptTreeNodes{
+		branch: &TapBranchNodes{
+			left:  left,
+			right: right,
+		},
+	}
+}
+
 // ToBranch returns an encoded TapBranchNodes object.
 func ToBranch(b TapBranchNodes) [][]byte {
 	return EncodeTapBranchNodes(b)
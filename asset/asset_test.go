@@ -9,6 +9,7 @@ import (
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taproot-assets/fn"
@@ -919,6 +920,96 @@ func TestDeriveGroupKey(t *testing.T) {
 	require.NotNil(t, groupKey)
 }
 
+// TestMuSig2GroupKey tests that a group witness created by multiple issuers
+// with MuSig2 is valid for the group key derived from their combined key.
+func TestMuSig2GroupKey(t *testing.T) {
+	t.Parallel()
+
+	privKeys := []*btcec.PrivateKey{
+		test.RandPrivKey(t), test.RandPrivKey(t), test.RandPrivKey(t),
+	}
+	pubKeys := fn.Map(privKeys, (*btcec.PrivateKey).PubKey)
+
+	_, err := MuSig2GroupRawKey(pubKeys[:1])
+	require.ErrorContains(t, err, "at least two signers")
+
+	rawKey, err := MuSig2GroupRawKey(pubKeys)
+	require.NoError(t, err)
+
+	for _, tapscriptRoot := range [][]byte{nil, test.RandBytes(32)} {
+		baseGen := RandGenesis(t, Normal)
+		protoAsset := RandAssetWithValues(
+			t, baseGen, nil, RandScriptKey(t),
+		)
+		groupReq := GroupKeyRequest{
+			RawKey:        test.PubToKeyDesc(rawKey),
+			AnchorGen:     baseGen,
+			TapscriptRoot: tapscriptRoot,
+			NewAsset:      protoAsset,
+		}
+		genTx, err := groupReq.BuildGroupVirtualTx(
+			&MockGroupTxBuilder{},
+		)
+		require.NoError(t, err)
+
+		tweaks, err := MuSig2GroupKeyTweaks(
+			rawKey, genTx.GenID, tapscriptRoot,
+		)
+		require.NoError(t, err)
+
+		sigHash, err := genTx.KeySpendSigHash()
+		require.NoError(t, err)
+
+		// Each issuer creates a session with the group key tweaks and
+		// contributes a nonce and a partial signature.
+		sessions := make([]*musig2.Session, len(privKeys))
+		for idx, privKey := range privKeys {
+			muSigCtx, err := musig2.NewContext(
+				privKey, true, musig2.WithKnownSigners(pubKeys),
+				musig2.WithTweakedContext(tweaks...),
+			)
+			require.NoError(t, err)
+
+			combinedKey, err := muSigCtx.CombinedKey()
+			require.NoError(t, err)
+			require.Equal(
+				t, schnorr.SerializePubKey(&genTx.TweakedKey),
+				schnorr.SerializePubKey(combinedKey),
+			)
+
+			sessions[idx], err = muSigCtx.NewSession()
+			require.NoError(t, err)
+		}
+
+		for idx, session := range sessions {
+			for otherIdx, other := range sessions {
+				if idx == otherIdx {
+					continue
+				}
+
+				_, err := session.RegisterPubNonce(
+					other.PublicNonce(),
+				)
+				require.NoError(t, err)
+			}
+		}
+
+		partialSigs := make([]*musig2.PartialSignature, len(sessions))
+		for idx, session := range sessions {
+			partialSigs[idx], err = session.Sign(sigHash)
+			require.NoError(t, err)
+		}
+
+		for _, partialSig := range partialSigs[1:] {
+			_, err := sessions[0].CombineSig(partialSig)
+			require.NoError(t, err)
+		}
+
+		finalSig := sessions[0].FinalSig()
+		require.True(t, finalSig.Verify(sigHash[:], &genTx.TweakedKey))
+	}
+}
+
 // TestAssetWitness tests that the asset group witness can be serialized and
 // parsed correctly, and that signature detection works correctly.
 func TestAssetWitnesses(t *testing.T) {
@@ -0,0 +1,87 @@
+package asset
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightningnetwork/lnd/input"
+)
+
+// MuSig2GroupRawKey returns the raw group key of an asset group whose group
+// witnesses require the signatures of all the given issuer keys. The raw key
+// is the MuSig2 combination of the sorted issuer keys, before any tweaks are
+// applied. It is used as the group internal key of the seedling that creates
+// the group.
+func MuSig2GroupRawKey(signers []*btcec.PublicKey) (*btcec.PublicKey, error) {
+	if len(signers) < 2 {
+		return nil, fmt.Errorf("a musig2 group key needs at least " +
+			"two signers")
+	}
+
+	// The keys are sorted in place, so we don't pass in the caller's
+	// slice.
+	sortedKeys := fn.CopySlice(signers)
+	aggKey, _, _, err := musig2.AggregateKeys(sortedKeys, true)
+	if err != nil {
+		return nil, fmt.Errorf("unable to combine signer keys: %w", err)
+	}
+
+	return aggKey.PreTweakedKey, nil
+}
+
+// MuSig2GroupKeyTweaks returns the generic MuSig2 tweaks that turn the raw
+// group key into the tweaked group key of the asset with the given genesis ID,
+// matching GroupPubKey. The first tweak is the plain single tweak with the
+// genesis ID, the second one is the x-only taproot tweak with the given
+// tapscript root. The taproot tweak can't be expressed with the taproot
+// options of a MuSig2 session, as those always tweak the untweaked combined
+// key.
+func MuSig2GroupKeyTweaks(rawKey *btcec.PublicKey, genID ID,
+	tapscriptRoot []byte) ([]musig2.KeyTweakDesc, error) {
+
+	if len(tapscriptRoot) != 0 && len(tapscriptRoot) != sha256.Size {
+		return nil, fmt.Errorf("tapscript tweaks must be %d bytes",
+			sha256.Size)
+	}
+
+	internalKey := input.TweakPubKeyWithTweak(rawKey, genID[:])
+	tapTweak := chainhash.TaggedHash(
+		chainhash.TagTapTweak, schnorr.SerializePubKey(internalKey),
+		tapscriptRoot,
+	)
+
+	return []musig2.KeyTweakDesc{{
+		Tweak:   genID,
+		IsXOnly: false,
+	}, {
+		Tweak:   *tapTweak,
+		IsXOnly: true,
+	}}, nil
+}
+
+// KeySpendSigHash returns the signature hash of the key spend path of the
+// virtual transaction, which is the message a group witness signs.
+func (g *GroupVirtualTx) KeySpendSigHash() ([32]byte, error) {
+	prevOutFetcher := txscript.NewCannedPrevOutputFetcher(
+		g.PrevOut.PkScript, g.PrevOut.Value,
+	)
+	sigHashes := txscript.NewTxSigHashes(&g.Tx, prevOutFetcher)
+
+	sigHash, err := txscript.CalcTaprootSignatureHash(
+		sigHashes, txscript.SigHashDefault, &g.Tx, 0, prevOutFetcher,
+	)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	var msg [32]byte
+	copy(msg[:], sigHash)
+
+	return msg, nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/rfq"
 	"github.com/lightninglabs/taproot-assets/tapchannel"
 	"github.com/lightninglabs/taproot-assets/tapdb"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
 	"github.com/lightninglabs/taproot-assets/tapgarden"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/funding"
@@ -91,6 +92,23 @@ func (l *LndRpcChainBridge) RegisterConfirmationsNtfn(ctx context.Context,
 	}, errChan, nil
 }
 
+// RegisterSpendNtfn registers an intent to be notified once the given outpoint
+// is spent on chain.
+func (l *LndRpcChainBridge) RegisterSpendNtfn(ctx context.Context,
+	outpoint *wire.OutPoint, pkScript []byte,
+	heightHint int32) (chan *chainntnfs.SpendDetail, chan error, error) {
+
+	spendChan, errChan, err := l.lnd.ChainNotifier.RegisterSpendNtfn(
+		ctx, outpoint, pkScript, heightHint,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to register for spend: %w",
+			err)
+	}
+
+	return spendChan, errChan, nil
+}
+
 // RegisterBlockEpochNtfn registers an intent to be notified of each new block
 // connected to the main chain.
 func (l *LndRpcChainBridge) RegisterBlockEpochNtfn(
@@ -296,6 +314,10 @@ func (l *LndRpcChainBridge) GenProofChainLookup(
 // tapgarden.ChainBridge interface.
 var _ tapgarden.ChainBridge = (*LndRpcChainBridge)(nil)
 
+// A compile time assertion to ensure LndRpcChainBridge meets the
+// tapfreighter.SpendNotifier interface.
+var _ tapfreighter.SpendNotifier = (*LndRpcChainBridge)(nil)
+
 // LndMsgTransportClient is an LND RPC message transport client.
 type LndMsgTransportClient struct {
 	lnd *lndclient.LndServices
@@ -22,6 +22,13 @@ var addrCommands = []cli.Command{
 			queryAddrsCommand,
 			decodeAddrCommand,
 			receivesAddrCommand,
+			setAddrNoteCommand,
+			setAddrExpiryCommand,
+			archiveAddrCommand,
+			unarchiveAddrCommand,
+			newStaticAddrCommand,
+			listStaticAddrsCommand,
+			importStaticPaymentCommand,
 		},
 	},
 }
@@ -32,6 +39,7 @@ const (
 	assetVersionName     = "asset_version"
 	addressVersionName   = "address_version"
 	proofCourierAddrName = "proof_courier_addr"
+	rawTxName            = "raw_tx"
 )
 
 var newAddrCommand = cli.Command{
@@ -49,13 +57,21 @@ var newAddrCommand = cli.Command{
 			Name:  amtName,
 			Usage: "the amt of the asset to receive",
 		},
+		cli.StringFlag{
+			Name: decimalAmtName,
+			Usage: "(optional) the amt of the asset to receive " +
+				"as a decimal number according to the " +
+				"decimal display of the asset, for example " +
+				"12.34; can be used instead of --amt",
+		},
 		cli.Uint64Flag{
 			Name:  assetVersionName,
 			Usage: "the asset version of the asset to receive",
 		},
 		cli.StringFlag{
-			Name:  addressVersionName,
-			Usage: "the version of address to generate",
+			Name: addressVersionName,
+			Usage: "the version of address to generate; one of " +
+				"v0, v1 or v2",
 		},
 		cli.StringFlag{
 			Name: proofCourierAddrName,
@@ -64,6 +80,12 @@ var newAddrCommand = cli.Command{
 				"default proof courier should be " +
 				"overwritten; format: protocol://host:port",
 		},
+		cli.Uint64Flag{
+			Name: numConfsName,
+			Usage: "(optional) the number of confirmations the " +
+				"sender should wait for before the transfer " +
+				"is considered complete",
+		},
 	},
 	Action: newAddr,
 }
@@ -96,6 +118,8 @@ func newAddr(ctx *cli.Context) error {
 		addrVersion = taprpc.AddrVersion_ADDR_VERSION_V0
 	case "v1", "V1":
 		addrVersion = taprpc.AddrVersion_ADDR_VERSION_V1
+	case "v2", "V2":
+		addrVersion = taprpc.AddrVersion_ADDR_VERSION_V2
 	default:
 		return fmt.Errorf("unknown address version: %s",
 			ctx.String(addressVersionName))
@@ -104,9 +128,11 @@ func newAddr(ctx *cli.Context) error {
 	addr, err := client.NewAddr(ctxc, &taprpc.NewAddrRequest{
 		AssetId:          assetID,
 		Amt:              ctx.Uint64(amtName),
+		DecimalAmt:       ctx.String(decimalAmtName),
 		AssetVersion:     assetVersion,
 		ProofCourierAddr: ctx.String(proofCourierAddrName),
 		AddressVersion:   addrVersion,
+		NumConfs:         uint32(ctx.Uint64(numConfsName)),
 	})
 	if err != nil {
 		return fmt.Errorf("unable to make addr: %w", err)
@@ -148,6 +174,11 @@ var queryAddrsCommand = cli.Command{
 			Name:  offsetName,
 			Usage: "the number of addrs to skip before returning the first addr",
 		},
+		cli.StringFlag{
+			Name: addrStateName,
+			Usage: "(optional) only return addrs in the given " +
+				"state; one of active, expired or archived",
+		},
 	},
 	Action: queryAddr,
 }
@@ -178,11 +209,26 @@ func queryAddr(ctx *cli.Context) error {
 		start = endTime.Unix()
 	}
 
+	var state taprpc.AddrState
+	switch ctx.String(addrStateName) {
+	case "":
+	case "active":
+		state = taprpc.AddrState_ADDR_STATE_ACTIVE
+	case "expired":
+		state = taprpc.AddrState_ADDR_STATE_EXPIRED
+	case "archived":
+		state = taprpc.AddrState_ADDR_STATE_ARCHIVED
+	default:
+		return fmt.Errorf("unknown address state: %s",
+			ctx.String(addrStateName))
+	}
+
 	addrs, err := client.QueryAddrs(ctxc, &taprpc.QueryAddrRequest{
 		CreatedAfter:  start,
 		CreatedBefore: end,
 		Limit:         int32(ctx.Int64(limitName)),
 		Offset:        int32(ctx.Int64(offsetName)),
+		State:         state,
 	})
 	if err != nil {
 		return fmt.Errorf("unable to make addrs: %w", err)
@@ -192,7 +238,11 @@ func queryAddr(ctx *cli.Context) error {
 	return nil
 }
 
-const addrName = "addr"
+const (
+	addrName       = "addr"
+	addrStateName  = "state"
+	expiryTimeName = "expiry_time"
+)
 
 var decodeAddrCommand = cli.Command{
 	Name:      "decode",
@@ -274,3 +324,274 @@ func addrReceives(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+var setAddrNoteCommand = cli.Command{
+	Name:  "setnote",
+	Usage: "set the note of a taproot asset addr",
+	Description: "set a user-defined note of an address, an empty note " +
+		"removes an existing note",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  addrName,
+			Usage: "the address to set the note of",
+		},
+		cli.StringFlag{
+			Name:  noteName,
+			Usage: "the note to set",
+		},
+	},
+	Action: setAddrNote,
+}
+
+func setAddrNote(ctx *cli.Context) error {
+	if !ctx.IsSet(addrName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SetAddrNote(ctxc, &taprpc.SetAddrNoteRequest{
+		Addr: ctx.String(addrName),
+		Note: ctx.String(noteName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to set addr note: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var setAddrExpiryCommand = cli.Command{
+	Name:  "setexpiry",
+	Usage: "set the expiry time of a taproot asset addr",
+	Description: "set the time after which an address no longer accepts " +
+		"new inbound transfers, an expiry time of zero removes an " +
+		"existing expiry",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  addrName,
+			Usage: "the address to set the expiry time of",
+		},
+		cli.Int64Flag{
+			Name:  expiryTimeName,
+			Usage: "the expiry time as a unix timestamp in seconds",
+		},
+	},
+	Action: setAddrExpiry,
+}
+
+func setAddrExpiry(ctx *cli.Context) error {
+	if !ctx.IsSet(addrName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SetAddrExpiry(ctxc, &taprpc.SetAddrExpiryRequest{
+		Addr:       ctx.String(addrName),
+		ExpiryTime: ctx.Int64(expiryTimeName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to set addr expiry: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var archiveAddrCommand = cli.Command{
+	Name:  "archive",
+	Usage: "archive a taproot asset addr",
+	Description: "archive an address, so the daemon stops watching for " +
+		"new inbound transfers to it",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  addrName,
+			Usage: "the address to archive",
+		},
+	},
+	Action: archiveAddr,
+}
+
+func archiveAddr(ctx *cli.Context) error {
+	if !ctx.IsSet(addrName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ArchiveAddr(ctxc, &taprpc.ArchiveAddrRequest{
+		Addr: ctx.String(addrName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to archive addr: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var unarchiveAddrCommand = cli.Command{
+	Name:  "unarchive",
+	Usage: "unarchive a taproot asset addr",
+	Description: "unarchive an address, so the daemon watches for new " +
+		"inbound transfers to it again",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  addrName,
+			Usage: "the address to unarchive",
+		},
+	},
+	Action: unarchiveAddr,
+}
+
+func unarchiveAddr(ctx *cli.Context) error {
+	if !ctx.IsSet(addrName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.UnarchiveAddr(ctxc, &taprpc.UnarchiveAddrRequest{
+		Addr: ctx.String(addrName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to unarchive addr: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var newStaticAddrCommand = cli.Command{
+	Name:  "newstatic",
+	Usage: "create a static Taproot Asset address",
+	Description: "Create a new reusable static address to receive an " +
+		"asset on-chain any number of times, with any amount",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset genesis ID of the asset to receive",
+		},
+		cli.Uint64Flag{
+			Name:  assetVersionName,
+			Usage: "the asset version of the asset to receive",
+		},
+		cli.StringFlag{
+			Name: proofCourierAddrName,
+			Usage: "(optional) the address of the proof courier " +
+				"to use for this specific address, if the " +
+				"default proof courier should be " +
+				"overwritten; format: protocol://host:port",
+		},
+	},
+	Action: newStaticAddr,
+}
+
+func newStaticAddr(ctx *cli.Context) error {
+	if ctx.String(assetIDName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	assetID, err := hex.DecodeString(ctx.String(assetIDName))
+	if err != nil {
+		return fmt.Errorf("unable to decode assetID: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	assetVersion, err := taprpc.MarshalAssetVersion(
+		asset.Version(ctx.Uint64(assetVersionName)),
+	)
+	if err != nil {
+		return err
+	}
+
+	addr, err := client.NewStaticAddr(ctxc, &taprpc.NewStaticAddrRequest{
+		AssetId:          assetID,
+		AssetVersion:     assetVersion,
+		ProofCourierAddr: ctx.String(proofCourierAddrName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to make static addr: %w", err)
+	}
+
+	printRespJSON(addr)
+	return nil
+}
+
+var listStaticAddrsCommand = cli.Command{
+	Name:        "liststatic",
+	Usage:       "list static Taproot Asset addresses",
+	Description: "List all static addresses of the daemon",
+	Action:      listStaticAddrs,
+}
+
+func listStaticAddrs(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListStaticAddrs(
+		ctxc, &taprpc.ListStaticAddrsRequest{},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to list static addrs: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var importStaticPaymentCommand = cli.Command{
+	Name:  "importstaticpayment",
+	Usage: "import an unconfirmed payment to a static address",
+	Description: "Inspect an unconfirmed transaction for payments to " +
+		"the static addresses of the daemon, so they are detected " +
+		"before they confirm",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  rawTxName,
+			Usage: "the hex encoded raw unconfirmed transaction",
+		},
+	},
+	Action: importStaticPayment,
+}
+
+func importStaticPayment(ctx *cli.Context) error {
+	if ctx.String(rawTxName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	rawTx, err := hex.DecodeString(ctx.String(rawTxName))
+	if err != nil {
+		return fmt.Errorf("unable to decode raw tx: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ImportStaticPaymentTx(
+		ctxc, &taprpc.ImportStaticPaymentTxRequest{
+			RawTx: rawTx,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to import static payment: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
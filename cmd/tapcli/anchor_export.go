@@ -0,0 +1,166 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	wrpc "github.com/lightninglabs/taproot-assets/taprpc/assetwalletrpc"
+	"github.com/urfave/cli"
+)
+
+const (
+	signedPsbtName = "signed_psbt"
+)
+
+var exportAnchorCommand = cli.Command{
+	Name:  "exportanchor",
+	Usage: "send an asset with an externally signed anchor transaction",
+	Description: `
+	Carry out a send to one or more addresses up to the point where the BTC
+	level anchor transaction needs to be signed. The funded anchor PSBT is
+	printed instead of being signed by the wallet, so it can be signed by
+	an external signer, for example a hardware wallet. The send is
+	continued with importanchor or aborted with cancelexport.`,
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name: addrName,
+			Usage: "addr to send to; can be specified multiple " +
+				"times to send to multiple addresses at once",
+		},
+		cli.Uint64Flag{
+			Name: feeRateName,
+			Usage: "if set, the fee rate in sat/vB to use for " +
+				"the anchor transaction",
+		},
+	},
+	Action: exportAnchor,
+}
+
+func exportAnchor(ctx *cli.Context) error {
+	addrs := ctx.StringSlice(addrName)
+	if ctx.NArg() != 0 || len(addrs) == 0 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	feeRate, err := parseFeeRate(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ExportAnchorPsbt(ctxc, &wrpc.ExportAnchorPsbtRequest{
+		TapAddrs: addrs,
+		FeeRate:  feeRate,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to export anchor PSBT: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var importAnchorCommand = cli.Command{
+	Name:  "importanchor",
+	Usage: "continue a send with an externally signed anchor transaction",
+	Description: `
+	Continue a send that was started with exportanchor with the externally
+	signed version of its anchor PSBT. The transfer is then logged and
+	broadcast like any other transfer.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: signedPsbtName,
+			Usage: "the signed anchor PSBT, either hex or base64 " +
+				"encoded",
+		},
+	},
+	Action: importAnchor,
+}
+
+func importAnchor(ctx *cli.Context) error {
+	if !ctx.IsSet(signedPsbtName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	signedPsbt, err := decodePsbt(ctx.String(signedPsbtName))
+	if err != nil {
+		return fmt.Errorf("unable to decode signed PSBT: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ImportSignedAnchorPsbt(
+		ctxc, &wrpc.ImportSignedAnchorPsbtRequest{
+			SignedPsbt: signedPsbt,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to import signed anchor PSBT: %w",
+			err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var cancelExportCommand = cli.Command{
+	Name:  "cancelexport",
+	Usage: "cancel a send whose anchor transaction was exported",
+	Description: `
+	Cancel a send that was started with exportanchor. The BTC inputs the
+	wallet added to pay for the fees are released immediately, the asset
+	inputs once their lease expires.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  anchorTxidName,
+			Usage: "the transaction ID of the exported anchor PSBT",
+		},
+	},
+	Action: cancelExport,
+}
+
+func cancelExport(ctx *cli.Context) error {
+	if !ctx.IsSet(anchorTxidName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.CancelAnchorExport(
+		ctxc, &wrpc.CancelAnchorExportRequest{
+			AnchorTxid: ctx.String(anchorTxidName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to cancel anchor export: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+// decodePsbt decodes a PSBT given on the command line. The RPC responses
+// print PSBTs hex encoded, while external signers usually hand out base64, so
+// we accept both.
+func decodePsbt(encoded string) ([]byte, error) {
+	rawPsbt, err := hex.DecodeString(encoded)
+	if err == nil {
+		return rawPsbt, nil
+	}
+
+	rawPsbt, err = base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("PSBT must be hex or base64 encoded: %w",
+			err)
+	}
+
+	return rawPsbt, nil
+}
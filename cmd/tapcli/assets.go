@@ -1,16 +1,22 @@
 package main
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"math"
 	"os"
 	"strconv"
+	"time"
 
 	taprootassets "github.com/lightninglabs/taproot-assets"
 	"github.com/lightninglabs/taproot-assets/tapcfg"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
 	"github.com/lightninglabs/taproot-assets/taprpc"
+	wrpc "github.com/lightninglabs/taproot-assets/taprpc/assetwalletrpc"
 	"github.com/lightninglabs/taproot-assets/taprpc/mintrpc"
+	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/urfave/cli"
 )
@@ -25,12 +31,29 @@ var assetsCommands = []cli.Command{
 			mintAssetCommand,
 			listAssetsCommand,
 			listUtxosCommand,
+			setUtxoNoteCommand,
+			fetchUtxoNoteCommand,
 			listGroupsCommand,
+			groupSummaryCommand,
 			listAssetBalancesCommand,
 			sendAssetsCommand,
+			sendToScriptKeyCommand,
+			sendStaticPaymentCommand,
 			burnAssetsCommand,
 			listTransfersCommand,
+			bumpTransferFeeCommand,
+			cancelTransferCommand,
+			exportAnchorCommand,
+			importAnchorCommand,
+			cancelExportCommand,
+			sendLimitsCommand,
+			listCoinSelectionsCommand,
+			statementCommand,
+			feeSpendCommand,
+			snapshotDiffCommand,
 			fetchMetaCommand,
+			inspectVPacketCommand,
+			validateVPacketCommand,
 		},
 	},
 }
@@ -56,6 +79,11 @@ var (
 	feeRateName                  = "sat_per_vbyte"
 	assetAmountName              = "amount"
 	burnOverrideConfirmationName = "override_confirmation_destroy_assets"
+	assetTickerName              = "ticker"
+	numConfsName                 = "num_confs"
+	idempotencyKeyName           = "idempotency_key"
+	decimalAmtName               = "decimal_amt"
+	externalPsbtName             = "external_psbt"
 )
 
 var mintAssetCommand = cli.Command{
@@ -133,6 +161,12 @@ var mintAssetCommand = cli.Command{
 				"in order to avoid printing a large amount " +
 				"of data in case of large batches",
 		},
+		cli.StringFlag{
+			Name: idempotencyKeyName,
+			Usage: "if set, a key that identifies the mint call; " +
+				"retrying with the same key returns the " +
+				"original result instead of minting again",
+		},
 	},
 	Action: mintAsset,
 	Subcommands: []cli.Command{
@@ -140,6 +174,10 @@ var mintAssetCommand = cli.Command{
 		fundBatchCommand,
 		sealBatchCommand,
 		finalizeBatchCommand,
+		submitGenesisSigsCommand,
+		genesisSkeletonCommand,
+		previewBatchCommand,
+		batchScheduleCommand,
 		cancelBatchCommand,
 	},
 }
@@ -336,7 +374,8 @@ func mintAsset(ctx *cli.Context) error {
 				ctx.Uint64(assetVersionName),
 			),
 		},
-		ShortResponse: ctx.Bool(shortResponseName),
+		ShortResponse:  ctx.Bool(shortResponseName),
+		IdempotencyKey: ctx.String(idempotencyKeyName),
 	})
 	if err != nil {
 		return fmt.Errorf("unable to mint asset: %w", err)
@@ -353,6 +392,12 @@ var fundBatchCommand = cli.Command{
 	Attempt to fund a pending batch, or create a new funded batch if no
 	batch exists yet. This is only needed if batch funding should happen
 	separately from batch finalization. Otherwise, finalize can be used.
+
+	The batch can be funded by an external wallet by passing the genesis
+	skeleton returned by the skeleton command, after the external wallet
+	added its inputs and an optional change output to it. The genesis
+	transaction of such a batch is signed by the external wallet after the
+	batch is finalized, and handed back with the submitsigs command.
 	`,
 	Flags: []cli.Flag{
 		cli.BoolFlag{
@@ -367,6 +412,12 @@ var fundBatchCommand = cli.Command{
 			Usage: "if set, the fee rate in sat/vB to use for " +
 				"the minting transaction",
 		},
+		cli.StringFlag{
+			Name: externalPsbtName,
+			Usage: "if set, the genesis skeleton funded by an " +
+				"external wallet, either hex or base64 " +
+				"encoded; can't be combined with a fee rate",
+		},
 	},
 	Action: fundBatch,
 }
@@ -381,9 +432,19 @@ func fundBatch(ctx *cli.Context) error {
 		return err
 	}
 
+	var externalPsbt []byte
+	if ctx.IsSet(externalPsbtName) {
+		externalPsbt, err = decodePsbt(ctx.String(externalPsbtName))
+		if err != nil {
+			return fmt.Errorf("unable to decode external PSBT: %w",
+				err)
+		}
+	}
+
 	resp, err := client.FundBatch(ctxc, &mintrpc.FundBatchRequest{
 		ShortResponse: ctx.Bool(shortResponseName),
 		FeeRate:       feeRate,
+		ExternalPsbt:  externalPsbt,
 	})
 	if err != nil {
 		return fmt.Errorf("unable to fund batch: %w", err)
@@ -474,6 +535,135 @@ func finalizeBatch(ctx *cli.Context) error {
 	return nil
 }
 
+var submitGenesisSigsCommand = cli.Command{
+	Name:  "submitsigs",
+	Usage: "submit the signed genesis transaction of a batch",
+	Description: `
+	Submit the genesis transaction of a finalized batch that was funded by
+	an external wallet, after the external wallet signed and finalized all
+	of its inputs. The transaction is then broadcast.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  batchKeyName,
+			Usage: "the hex encoded key of the finalized batch",
+		},
+		cli.StringFlag{
+			Name: signedPsbtName,
+			Usage: "the signed genesis PSBT of the batch, either " +
+				"hex or base64 encoded",
+		},
+		cli.BoolFlag{
+			Name: shortResponseName,
+			Usage: "if true, then the assets within the batch " +
+				"will not be returned in the response in " +
+				"order to avoid printing a large amount of " +
+				"data in case of large batches",
+		},
+	},
+	Action: submitGenesisSigs,
+}
+
+func submitGenesisSigs(ctx *cli.Context) error {
+	if !ctx.IsSet(batchKeyName) || !ctx.IsSet(signedPsbtName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	batchKey, err := hex.DecodeString(ctx.String(batchKeyName))
+	if err != nil {
+		return fmt.Errorf("unable to decode batch key: %w", err)
+	}
+
+	signedPsbt, err := decodePsbt(ctx.String(signedPsbtName))
+	if err != nil {
+		return fmt.Errorf("unable to decode signed PSBT: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SubmitGenesisSigs(
+		ctxc, &mintrpc.SubmitGenesisSigsRequest{
+			BatchKey:      batchKey,
+			SignedPsbt:    signedPsbt,
+			ShortResponse: ctx.Bool(shortResponseName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to submit genesis signatures: %w",
+			err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var genesisSkeletonCommand = cli.Command{
+	Name:  "skeleton",
+	Usage: "print the genesis skeleton for external funding",
+	Description: `
+	Print the unsigned skeleton of a genesis transaction as a base64
+	encoded PSBT. The skeleton has a single output that is replaced with
+	the Taproot Asset commitment when the batch is finalized. An external
+	wallet can fund it by adding inputs and an optional change output,
+	without touching that output, before it is passed to fund.
+	`,
+	Action: genesisSkeleton,
+}
+
+func genesisSkeleton(_ *cli.Context) error {
+	skeleton, err := tapgarden.NewGenesisSkeleton()
+	if err != nil {
+		return fmt.Errorf("unable to create genesis skeleton: %w", err)
+	}
+
+	encoded, err := skeleton.B64Encode()
+	if err != nil {
+		return fmt.Errorf("unable to encode genesis skeleton: %w", err)
+	}
+
+	fmt.Println(encoded)
+	return nil
+}
+
+var previewBatchCommand = cli.Command{
+	Name:  "preview",
+	Usage: "preview the finalization of a batch",
+	Description: "Estimate the size and chain fee of the genesis " +
+		"transaction of the pending batch and the resulting asset " +
+		"IDs, without broadcasting anything.",
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: feeRateName,
+			Usage: "if set, the fee rate in sat/vB to estimate " +
+				"the chain fee with",
+		},
+	},
+	Action: previewBatch,
+}
+
+func previewBatch(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	feeRate, err := parseFeeRate(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.PreviewBatch(ctxc, &mintrpc.PreviewBatchRequest{
+		FeeRate: feeRate,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to preview batch: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var cancelBatchCommand = cli.Command{
 	Name:        "cancel",
 	ShortName:   "c",
@@ -597,6 +787,123 @@ func listUtxos(ctx *cli.Context) error {
 	return nil
 }
 
+const noteName = "note"
+
+var setUtxoNoteCommand = cli.Command{
+	Name:  "setnote",
+	Usage: "set the note of an asset UTXO",
+	Description: "set a user-defined note of an asset anchor output, an " +
+		"empty note removes an existing note",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: outpointName,
+			Usage: "the outpoint of the UTXO, in the form " +
+				"txid:index",
+		},
+		cli.StringFlag{
+			Name:  noteName,
+			Usage: "the note to set",
+		},
+	},
+	Action: setUtxoNote,
+}
+
+func setUtxoNote(ctx *cli.Context) error {
+	if !ctx.IsSet(outpointName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SetUtxoNote(ctxc, &taprpc.SetUtxoNoteRequest{
+		Outpoint: ctx.String(outpointName),
+		Note:     ctx.String(noteName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to set UTXO note: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var fetchUtxoNoteCommand = cli.Command{
+	Name:  "note",
+	Usage: "show the note of an asset UTXO",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: outpointName,
+			Usage: "the outpoint of the UTXO, in the form " +
+				"txid:index",
+		},
+	},
+	Action: fetchUtxoNote,
+}
+
+func fetchUtxoNote(ctx *cli.Context) error {
+	if !ctx.IsSet(outpointName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.FetchUtxoNote(ctxc, &taprpc.FetchUtxoNoteRequest{
+		Outpoint: ctx.String(outpointName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to fetch UTXO note: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var groupSummaryCommand = cli.Command{
+	Name:      "groupsummary",
+	ShortName: "gs",
+	Usage:     "summarize an asset group",
+	Description: "show the balance, the UTXOs and the outbound transfers " +
+		"of all asset IDs within an asset group",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetGroupKeyName,
+			Usage: "the tweaked group key of the asset group",
+		},
+	},
+	Action: groupSummary,
+}
+
+func groupSummary(ctx *cli.Context) error {
+	if !ctx.IsSet(assetGroupKeyName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	groupKey, err := hex.DecodeString(ctx.String(assetGroupKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid group key: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.QueryGroupSummary(
+		ctxc, &taprpc.QueryGroupSummaryRequest{
+			GroupKey: groupKey,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to query group summary: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var listGroupsCommand = cli.Command{
 	Name:        "groups",
 	ShortName:   "g",
@@ -705,6 +1012,33 @@ var sendAssetsCommand = cli.Command{
 			Usage: "if set, the fee rate in sat/vB to use for " +
 				"the anchor transaction",
 		},
+		cli.StringFlag{
+			Name: assetTickerName,
+			Usage: "if set, the ticker of the asset to send; the " +
+				"send is rejected if any addr is for a " +
+				"different asset than the one the ticker " +
+				"resolves to",
+		},
+		cli.Uint64Flag{
+			Name: numConfsName,
+			Usage: "if set, the number of confirmations the " +
+				"anchor transaction needs to reach before " +
+				"the transfer is complete; can't be lower " +
+				"than what any addr asks for",
+		},
+		cli.StringFlag{
+			Name: idempotencyKeyName,
+			Usage: "if set, a key that identifies the send; " +
+				"retrying with the same key returns the " +
+				"original transfer instead of sending again",
+		},
+		cli.StringFlag{
+			Name: decimalAmtName,
+			Usage: "if set, the total amount expected to be sent " +
+				"as a decimal number according to the " +
+				"decimal display of the asset; the send is " +
+				"rejected if the addrs don't add up to it",
+		},
 		// TODO(roasbeef): add arg for file name to write sender proof
 		// blob
 	},
@@ -726,110 +1060,123 @@ func sendAssets(ctx *cli.Context) error {
 		return err
 	}
 
+	ticker := ctx.String(assetTickerName)
 	resp, err := client.SendAsset(ctxc, &taprpc.SendAssetRequest{
-		TapAddrs: addrs,
-		FeeRate:  feeRate,
+		TapAddrs:       addrs,
+		FeeRate:        feeRate,
+		AssetTicker:    ticker,
+		NumConfs:       uint32(ctx.Uint64(numConfsName)),
+		IdempotencyKey: ctx.String(idempotencyKeyName),
+		DecimalAmount:  ctx.String(decimalAmtName),
 	})
 	if err != nil {
 		return fmt.Errorf("unable to send assets: %w", err)
 	}
 
+	// The daemon confirms which asset the ticker resolved to. A daemon
+	// that doesn't know tickers doesn't return a confirmation, in which
+	// case the addrs weren't checked against the ticker.
+	if ticker != "" && resp.ResolvedTicker == nil {
+		fmt.Fprintf(os.Stderr, "WARNING: ticker %s was not checked "+
+			"by the daemon\n", ticker)
+	}
+
 	printRespJSON(resp)
 	return nil
 }
 
-var burnAssetsCommand = cli.Command{
-	Name:  "burn",
-	Usage: "burn a number of asset units",
-	Description: `
-	Burn (destroy, remove from circulation) a number of asset units in a
-	provable way. The returned burn proof is cryptographic evidence that the
-	assets can no longer be spent and that the supply has been reduced by
-	the specified amount.
+const (
+	anchorInternalKeyName = "anchor_internal_key"
+)
 
-	To avoid a dangling BTC output, not all assets within a commitment can
-	be burned completely, there always must be a change output (either with
-	a change amount or another asset that resides in the same commitment).
-	`,
+var sendToScriptKeyCommand = cli.Command{
+	Name:  "sendtokey",
+	Usage: "send an asset to a raw script key",
+	Description: "send an asset interactively to a script key and " +
+		"anchor internal key the receiver handed out out of band; " +
+		"the receiver's proof isn't delivered through a proof " +
+		"courier but can be exported once the transfer confirmed",
 	Flags: []cli.Flag{
 		cli.StringFlag{
 			Name:  assetIDName,
-			Usage: "the asset ID to burn units from",
+			Usage: "the ID of the asset to send",
 		},
 		cli.Uint64Flag{
 			Name:  assetAmountName,
-			Usage: "the amount of units to burn/destroy",
+			Usage: "the amount of asset units to send",
 		},
-		cli.BoolFlag{
-			Name: burnOverrideConfirmationName,
-			Usage: "if set, the confirmation prompt will be " +
-				"skipped and the assets are burned/destroyed " +
-				"immediately",
+		cli.StringFlag{
+			Name:  scriptKeyName,
+			Usage: "the hex encoded script key of the receiver",
+		},
+		cli.StringFlag{
+			Name: anchorInternalKeyName,
+			Usage: "the hex encoded internal key of the " +
+				"receiver's anchor output",
+		},
+		cli.Uint64Flag{
+			Name:  assetVersionName,
+			Usage: "the version of the asset the receiver gets",
+		},
+		cli.Uint64Flag{
+			Name: feeRateName,
+			Usage: "if set, the fee rate in sat/vB to use for " +
+				"the anchor transaction",
 		},
 	},
-	Action: burnAssets,
+	Action: sendToScriptKey,
 }
 
-func burnAssets(ctx *cli.Context) error {
-	if ctx.NArg() != 0 || ctx.NumFlags() == 0 {
-		return cli.ShowSubcommandHelp(ctx)
+func sendToScriptKey(ctx *cli.Context) error {
+	switch {
+	case ctx.String(assetIDName) == "":
+		return fmt.Errorf("asset ID must be set")
+
+	case ctx.Uint64(assetAmountName) == 0:
+		return fmt.Errorf("amount must be set")
+
+	case ctx.String(scriptKeyName) == "":
+		return fmt.Errorf("script key must be set")
+
+	case ctx.String(anchorInternalKeyName) == "":
+		return fmt.Errorf("anchor internal key must be set")
 	}
 
-	assetIDHex := ctx.String(assetIDName)
-	assetIDBytes, err := hex.DecodeString(assetIDHex)
+	assetID, err := hex.DecodeString(ctx.String(assetIDName))
+	if err != nil {
+		return fmt.Errorf("invalid asset ID: %w", err)
+	}
+	scriptKey, err := hex.DecodeString(ctx.String(scriptKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid script key: %w", err)
+	}
+	internalKey, err := hex.DecodeString(
+		ctx.String(anchorInternalKeyName),
+	)
 	if err != nil {
-		return fmt.Errorf("invalid asset ID")
+		return fmt.Errorf("invalid anchor internal key: %w", err)
 	}
 
-	burnAmount := ctx.Uint64(assetAmountName)
-	if burnAmount == 0 {
-		return fmt.Errorf("invalid burn amount")
+	feeRate, err := parseFeeRate(ctx)
+	if err != nil {
+		return err
 	}
 
 	ctxc := getContext()
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
-	if !ctx.Bool(burnOverrideConfirmationName) {
-		balance, err := client.ListBalances(
-			ctxc, &taprpc.ListBalancesRequest{
-				GroupBy: &taprpc.ListBalancesRequest_AssetId{
-					AssetId: true,
-				},
-				AssetFilter: assetIDBytes,
-			},
-		)
-		if err != nil {
-			return fmt.Errorf("unable to list current asset "+
-				"balances: %w", err)
-		}
-
-		assetBalance, ok := balance.AssetBalances[assetIDHex]
-		if !ok {
-			return fmt.Errorf("couldn't fetch balance for asset %x",
-				assetIDBytes)
-		}
-
-		msg := fmt.Sprintf("Please confirm destructive action.\n"+
-			"Asset ID: %x\nCurrent available balance: %d\n"+
-			"Amount to burn: %d\n Are you sure you want to "+
-			"irreversibly burn (destroy, remove from circulation) "+
-			"the specified amount of assets?\nPlease answer 'yes' "+
-			"or 'no' and press enter: ", assetIDBytes,
-			assetBalance.Balance, burnAmount)
-
-		if !promptForConfirmation(msg) {
-			return nil
-		}
-	}
-
-	resp, err := client.BurnAsset(ctxc, &taprpc.BurnAssetRequest{
-		Asset: &taprpc.BurnAssetRequest_AssetId{
-			AssetId: assetIDBytes,
+	assetVersion := taprpc.AssetVersion(ctx.Uint64(assetVersionName))
+	resp, err := client.SendToScriptKey(
+		ctxc, &taprpc.SendToScriptKeyRequest{
+			AssetId:           assetID,
+			Amt:               ctx.Uint64(assetAmountName),
+			ScriptKey:         scriptKey,
+			AnchorInternalKey: internalKey,
+			AssetVersion:      assetVersion,
+			FeeRate:           feeRate,
 		},
-		AmountToBurn:     burnAmount,
-		ConfirmationText: taprootassets.AssetBurnConfirmationText,
-	})
+	)
 	if err != nil {
 		return fmt.Errorf("unable to send assets: %w", err)
 	}
@@ -838,28 +1185,315 @@ func burnAssets(ctx *cli.Context) error {
 	return nil
 }
 
-var listTransfersCommand = cli.Command{
-	Name:      "transfers",
-	ShortName: "t",
-	Usage:     "list asset transfers",
-	Description: "list outgoing transfers of all assets or a selected " +
-		"asset",
-	Action: listTransfers,
+const (
+	staticAddrName = "static_addr"
+)
+
+var sendStaticPaymentCommand = cli.Command{
+	Name:  "sendstatic",
+	Usage: "send an asset to a reusable static address",
+	Description: "send an asset to a static address; a new single-use " +
+		"address is derived for the payment and announced in the " +
+		"anchor transaction, so the receiver can find it on chain",
 	Flags: []cli.Flag{
 		cli.StringFlag{
-			Name: assetIDName,
-			Usage: "A specific asset ID to list outgoing " +
-				"transfers for",
+			Name:  staticAddrName,
+			Usage: "the static address to send to",
 		},
-	},
-}
-
-func listTransfers(ctx *cli.Context) error {
-	ctxc := getContext()
-	client, cleanUp := getClient(ctx)
+		cli.Uint64Flag{
+			Name:  assetAmountName,
+			Usage: "the amount of asset units to send",
+		},
+		cli.Uint64Flag{
+			Name: feeRateName,
+			Usage: "if set, the fee rate in sat/vB to use for " +
+				"the anchor transaction",
+		},
+	},
+	Action: sendStaticPayment,
+}
+
+func sendStaticPayment(ctx *cli.Context) error {
+	switch {
+	case ctx.String(staticAddrName) == "":
+		return fmt.Errorf("static address must be set")
+
+	case ctx.Uint64(assetAmountName) == 0:
+		return fmt.Errorf("amount must be set")
+	}
+
+	feeRate, err := parseFeeRate(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SendStaticPayment(
+		ctxc, &taprpc.SendStaticPaymentRequest{
+			StaticAddr: ctx.String(staticAddrName),
+			Amt:        ctx.Uint64(assetAmountName),
+			FeeRate:    feeRate,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to send assets: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var burnAssetsCommand = cli.Command{
+	Name:  "burn",
+	Usage: "burn a number of asset units",
+	Description: `
+	Burn (destroy, remove from circulation) a number of asset units in a
+	provable way. The returned burn proof is cryptographic evidence that the
+	assets can no longer be spent and that the supply has been reduced by
+	the specified amount.
+
+	To avoid a dangling BTC output, not all assets within a commitment can
+	be burned completely, there always must be a change output (either with
+	a change amount or another asset that resides in the same commitment).
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID to burn units from",
+		},
+		cli.StringFlag{
+			Name: assetGroupKeyName,
+			Usage: "the group key of the asset group to burn " +
+				"units from; the burn can span multiple " +
+				"asset IDs of the group",
+		},
+		cli.Uint64Flag{
+			Name:  assetAmountName,
+			Usage: "the amount of units to burn/destroy",
+		},
+		cli.BoolFlag{
+			Name: burnOverrideConfirmationName,
+			Usage: "if set, the confirmation prompt will be " +
+				"skipped and the assets are burned/destroyed " +
+				"immediately",
+		},
+	},
+	Action: burnAssets,
+}
+
+func burnAssets(ctx *cli.Context) error {
+	if ctx.NArg() != 0 || ctx.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	var (
+		assetIDHex  = ctx.String(assetIDName)
+		groupKeyHex = ctx.String(assetGroupKeyName)
+	)
+	switch {
+	case assetIDHex != "" && groupKeyHex != "":
+		return fmt.Errorf("only one of asset ID or group key can be " +
+			"set")
+
+	case assetIDHex == "" && groupKeyHex == "":
+		return fmt.Errorf("asset ID or group key must be set")
+	}
+
+	burnAmount := ctx.Uint64(assetAmountName)
+	if burnAmount == 0 {
+		return fmt.Errorf("invalid burn amount")
+	}
+
+	req := &taprpc.BurnAssetRequest{
+		AmountToBurn:     burnAmount,
+		ConfirmationText: taprootassets.AssetBurnConfirmationText,
+	}
+	balanceReq := &taprpc.ListBalancesRequest{}
+	if assetIDHex != "" {
+		assetIDBytes, err := hex.DecodeString(assetIDHex)
+		if err != nil {
+			return fmt.Errorf("invalid asset ID")
+		}
+
+		req.Asset = &taprpc.BurnAssetRequest_AssetId{
+			AssetId: assetIDBytes,
+		}
+		balanceReq.GroupBy = &taprpc.ListBalancesRequest_AssetId{
+			AssetId: true,
+		}
+		balanceReq.AssetFilter = assetIDBytes
+	} else {
+		groupKeyBytes, err := hex.DecodeString(groupKeyHex)
+		if err != nil {
+			return fmt.Errorf("invalid group key")
+		}
+
+		req.Asset = &taprpc.BurnAssetRequest_GroupKey{
+			GroupKey: groupKeyBytes,
+		}
+		balanceReq.GroupBy = &taprpc.ListBalancesRequest_GroupKey{
+			GroupKey: true,
+		}
+		balanceReq.GroupKeyFilter = groupKeyBytes
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if !ctx.Bool(burnOverrideConfirmationName) {
+		balance, err := client.ListBalances(ctxc, balanceReq)
+		if err != nil {
+			return fmt.Errorf("unable to list current asset "+
+				"balances: %w", err)
+		}
+
+		var (
+			assetBalance uint64
+			assetDesc    string
+		)
+		if assetIDHex != "" {
+			b, ok := balance.AssetBalances[assetIDHex]
+			if !ok {
+				return fmt.Errorf("couldn't fetch balance for "+
+					"asset %v", assetIDHex)
+			}
+			assetBalance = b.Balance
+			assetDesc = "Asset ID: " + assetIDHex
+		} else {
+			b, ok := balance.AssetGroupBalances[groupKeyHex]
+			if !ok {
+				return fmt.Errorf("couldn't fetch balance for "+
+					"asset group %v", groupKeyHex)
+			}
+			assetBalance = b.Balance
+			assetDesc = "Group key: " + groupKeyHex
+		}
+
+		msg := fmt.Sprintf("Please confirm destructive action.\n"+
+			"%s\nCurrent available balance: %d\n"+
+			"Amount to burn: %d\n Are you sure you want to "+
+			"irreversibly burn (destroy, remove from circulation) "+
+			"the specified amount of assets?\nPlease answer 'yes' "+
+			"or 'no' and press enter: ", assetDesc, assetBalance,
+			burnAmount)
+
+		if !promptForConfirmation(msg) {
+			return nil
+		}
+	}
+
+	resp, err := client.BurnAsset(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to send assets: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+const (
+	transferStateName = "state"
+
+	transferCursorName = "cursor"
+
+	descendingName = "desc"
+)
+
+// transferStateFilters maps the values of the transfer state flag to the
+// transfer state filters of the RPC.
+var transferStateFilters = map[string]taprpc.TransferStateFilter{
+	"pending":   taprpc.TransferStateFilter_TRANSFER_STATE_FILTER_PENDING,
+	"confirmed": taprpc.TransferStateFilter_TRANSFER_STATE_FILTER_CONFIRMED,
+}
+
+var listTransfersCommand = cli.Command{
+	Name:      "transfers",
+	ShortName: "t",
+	Usage:     "list asset transfers",
+	Description: `
+	List outgoing transfers of all assets or a selected asset. If any of
+	the filter flags is set, only a single page of the matching transfers
+	is returned, together with the cursor of the next page.
+	`,
+	Action: listTransfers,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: assetIDName,
+			Usage: "A specific asset ID to list outgoing " +
+				"transfers for",
+		},
+		cli.StringFlag{
+			Name: groupKeyName,
+			Usage: "A specific asset group key to list " +
+				"outgoing transfers for",
+		},
+		cli.StringFlag{
+			Name: addrName,
+			Usage: "only list transfers with an output to this " +
+				"addr",
+		},
+		cli.StringFlag{
+			Name: transferStateName,
+			Usage: "only list transfers in the given state; one " +
+				"of pending or confirmed",
+		},
+		cli.Int64Flag{
+			Name: startTime,
+			Usage: "only list transfers created at or after " +
+				"this unix timestamp",
+		},
+		cli.Int64Flag{
+			Name: endTime,
+			Usage: "only list transfers created before this " +
+				"unix timestamp",
+		},
+		cli.BoolFlag{
+			Name:  descendingName,
+			Usage: "list the newest transfers first",
+		},
+		cli.Int64Flag{
+			Name: transferCursorName,
+			Usage: "the next_cursor of the previous page to " +
+				"continue listing from",
+		},
+		cli.Int64Flag{
+			Name: limitName,
+			Usage: "the maximum number of transfers in the page, " +
+				"defaults to 100",
+		},
+	},
+}
+
+func listTransfers(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
 	req := &taprpc.ListTransfersRequest{}
+
+	filterFlags := []string{
+		assetIDName, groupKeyName, addrName, transferStateName,
+		startTime, endTime, descendingName, transferCursorName,
+		limitName,
+	}
+	for _, flag := range filterFlags {
+		if !ctx.IsSet(flag) {
+			continue
+		}
+
+		filter, err := parseTransferFilter(ctx)
+		if err != nil {
+			return err
+		}
+
+		req.Filter = filter
+		break
+	}
+
 	resp, err := client.ListTransfers(ctxc, req)
 	if err != nil {
 		return fmt.Errorf("unable to list asset transfers: %w", err)
@@ -869,6 +1503,377 @@ func listTransfers(ctx *cli.Context) error {
 	return nil
 }
 
+// parseTransferFilter parses the transfer filter flags of the transfers
+// command.
+func parseTransferFilter(ctx *cli.Context) (*taprpc.TransferFilter, error) {
+	filter := &taprpc.TransferFilter{
+		Addr:                 ctx.String(addrName),
+		StartTimeUnixSeconds: ctx.Int64(startTime),
+		EndTimeUnixSeconds:   ctx.Int64(endTime),
+		Descending:           ctx.Bool(descendingName),
+		Cursor:               ctx.Int64(transferCursorName),
+		Limit:                int32(ctx.Int64(limitName)),
+	}
+
+	var err error
+	if ctx.IsSet(assetIDName) {
+		filter.AssetId, err = hex.DecodeString(ctx.String(assetIDName))
+		if err != nil {
+			return nil, fmt.Errorf("invalid asset ID: %w", err)
+		}
+	}
+
+	if ctx.IsSet(groupKeyName) {
+		filter.GroupKey, err = hex.DecodeString(
+			ctx.String(groupKeyName),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid group key: %w", err)
+		}
+	}
+
+	if ctx.IsSet(transferStateName) {
+		state, ok := transferStateFilters[ctx.String(transferStateName)]
+		if !ok {
+			return nil, fmt.Errorf("invalid transfer state: %v",
+				ctx.String(transferStateName))
+		}
+
+		filter.State = state
+	}
+
+	return filter, nil
+}
+
+const (
+	anchorTxidName = "anchor_txid"
+)
+
+var bumpTransferFeeCommand = cli.Command{
+	Name:      "bumpfee",
+	ShortName: "bf",
+	Usage:     "bump the fee of a pending asset transfer",
+	Description: `
+	Replace the anchor transaction of a pending asset transfer that is still
+	waiting to confirm with a version that pays a higher fee rate. The
+	additional fee is deducted from the BTC change output of the anchor
+	transaction.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: anchorTxidName,
+			Usage: "the anchor transaction ID of the pending " +
+				"transfer to fee bump",
+		},
+		cli.Uint64Flag{
+			Name: feeRateName,
+			Usage: "the new fee rate to use for the anchor " +
+				"transaction, in sat/vB",
+		},
+	},
+	Action: bumpTransferFee,
+}
+
+func bumpTransferFee(ctx *cli.Context) error {
+	if !ctx.IsSet(anchorTxidName) || !ctx.IsSet(feeRateName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	feeRate, err := parseFeeRate(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &taprpc.BumpTransferFeeRequest{
+		AnchorTxid: ctx.String(anchorTxidName),
+		FeeRate:    feeRate,
+	}
+	resp, err := client.BumpTransferFee(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to bump transfer fee: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var cancelTransferCommand = cli.Command{
+	Name:  "cancel",
+	Usage: "cancel a pending asset transfer that was never broadcast",
+	Description: `
+	Cancel a pending asset transfer whose anchor transaction never made it
+	to the network, for example because its broadcast failed. The transfer
+	is removed and its asset and BTC inputs are released again.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: anchorTxidName,
+			Usage: "the anchor transaction ID of the pending " +
+				"transfer to cancel",
+		},
+	},
+	Action: cancelTransfer,
+}
+
+func cancelTransfer(ctx *cli.Context) error {
+	if !ctx.IsSet(anchorTxidName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &taprpc.CancelTransferRequest{
+		AnchorTxid: ctx.String(anchorTxidName),
+	}
+	resp, err := client.CancelTransfer(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to cancel transfer: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+const (
+	coinSelectionStartName = "start_timestamp"
+	coinSelectionLimitName = "limit"
+)
+
+var listCoinSelectionsCommand = cli.Command{
+	Name:      "coinselections",
+	ShortName: "cs",
+	Usage:     "list past asset coin selections",
+	Description: "list the recorded outcomes of the asset coin " +
+		"selections of past transfers, most recent first",
+	Action: listCoinSelections,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "only list coin selections for this asset ID",
+		},
+		cli.StringFlag{
+			Name:  assetGroupKeyName,
+			Usage: "only list coin selections for this group key",
+		},
+		cli.Int64Flag{
+			Name: coinSelectionStartName,
+			Usage: "only list coin selections made at or after " +
+				"this unix timestamp",
+		},
+		cli.IntFlag{
+			Name:  coinSelectionLimitName,
+			Usage: "the maximum number of coin selections to list",
+		},
+	},
+}
+
+func listCoinSelections(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &taprpc.ListCoinSelectionsRequest{
+		StartTimestamp: ctx.Int64(coinSelectionStartName),
+		Limit:          int32(ctx.Int(coinSelectionLimitName)),
+	}
+
+	var err error
+	if ctx.IsSet(assetIDName) {
+		req.AssetId, err = hex.DecodeString(ctx.String(assetIDName))
+		if err != nil {
+			return fmt.Errorf("invalid asset ID: %w", err)
+		}
+	}
+	if ctx.IsSet(assetGroupKeyName) {
+		req.GroupKey, err = hex.DecodeString(
+			ctx.String(assetGroupKeyName),
+		)
+		if err != nil {
+			return fmt.Errorf("invalid group key: %w", err)
+		}
+	}
+
+	resp, err := client.ListCoinSelections(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to list coin selections: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+const (
+	statementEndName = "end_timestamp"
+)
+
+var statementCommand = cli.Command{
+	Name:  "statement",
+	Usage: "generate a statement of the transfers with a counterparty",
+	Description: "list all transfers between the local node and a " +
+		"counterparty over a period, together with the totals per " +
+		"asset; outbound transfers are matched by the script key of " +
+		"the counterparty, inbound transfers by the addresses " +
+		"handed out to it",
+	Action: generateStatement,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: scriptKeyName,
+			Usage: "the compressed script key of the " +
+				"counterparty",
+		},
+		cli.StringSliceFlag{
+			Name: addrName,
+			Usage: "a local address that was handed out to the " +
+				"counterparty, can be specified multiple times",
+		},
+		cli.Int64Flag{
+			Name: coinSelectionStartName,
+			Usage: "only include transfers made at or after this " +
+				"unix timestamp",
+		},
+		cli.Int64Flag{
+			Name: statementEndName,
+			Usage: "only include transfers made before this unix " +
+				"timestamp",
+		},
+	},
+}
+
+func generateStatement(ctx *cli.Context) error {
+	if ctx.String(scriptKeyName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	scriptKey, err := hex.DecodeString(ctx.String(scriptKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid script key: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.GenerateStatement(ctxc, &taprpc.StatementRequest{
+		ScriptKey:      scriptKey,
+		Addrs:          ctx.StringSlice(addrName),
+		StartTimestamp: ctx.Int64(coinSelectionStartName),
+		EndTimestamp:   ctx.Int64(statementEndName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to generate statement: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+const (
+	feeSpendIntervalName = "interval"
+)
+
+var feeSpendCommand = cli.Command{
+	Name:  "feespend",
+	Usage: "aggregate the chain fees spent per asset",
+	Description: "attribute the chain fees of the outbound transfers " +
+		"pro-rata to the assets of their outputs and sum them up " +
+		"per asset and period",
+	Action: queryFeeSpend,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: coinSelectionStartName,
+			Usage: "only include transfers made at or after this " +
+				"unix timestamp",
+		},
+		cli.Int64Flag{
+			Name: statementEndName,
+			Usage: "only include transfers made before this unix " +
+				"timestamp",
+		},
+		cli.DurationFlag{
+			Name: feeSpendIntervalName,
+			Usage: "the length of each period, e.g. 24h; if " +
+				"unset, the fees are summed up into a single " +
+				"period",
+		},
+	},
+}
+
+func queryFeeSpend(ctx *cli.Context) error {
+	interval := ctx.Duration(feeSpendIntervalName)
+	if interval < 0 || interval%time.Second != 0 {
+		return fmt.Errorf("interval must be a positive number of " +
+			"seconds")
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.QueryFeeSpend(ctxc, &taprpc.FeeSpendRequest{
+		StartTimestamp:  ctx.Int64(coinSelectionStartName),
+		EndTimestamp:    ctx.Int64(statementEndName),
+		IntervalSeconds: uint64(interval / time.Second),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to query fee spend: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+const (
+	snapshotStartHeightName = "start_height"
+
+	snapshotEndHeightName = "end_height"
+)
+
+var snapshotDiffCommand = cli.Command{
+	Name:  "snapshotdiff",
+	Usage: "list the asset UTXOs created and spent between two heights",
+	Description: "list the local asset UTXOs that were created and " +
+		"spent between two block heights, together with the " +
+		"balance changes per asset",
+	Action: diffAssetSnapshots,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: snapshotStartHeightName,
+			Usage: "the height of the earlier snapshot; changes " +
+				"confirmed at this height aren't included",
+		},
+		cli.Uint64Flag{
+			Name: snapshotEndHeightName,
+			Usage: "the height of the later snapshot; if unset, " +
+				"the current best block height is used",
+		},
+	},
+}
+
+func diffAssetSnapshots(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	startHeight := ctx.Uint64(snapshotStartHeightName)
+	endHeight := ctx.Uint64(snapshotEndHeightName)
+	resp, err := client.DiffAssetSnapshots(
+		ctxc, &taprpc.SnapshotDiffRequest{
+			StartHeight: uint32(startHeight),
+			EndHeight:   uint32(endHeight),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to diff snapshots: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 const (
 	metaName = "asset_meta"
 )
@@ -933,3 +1938,279 @@ func fetchMeta(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+const vPacketPathName = "vpsbt_file"
+
+var inspectVPacketCommand = cli.Command{
+	Name:  "inspectvpsbt",
+	Usage: "inspect a virtual PSBT or a pending transfer",
+	Description: `
+	Break down a virtual PSBT into its inputs and outputs, listing the
+	previous asset outpoints and amounts of the inputs, the script keys and
+	types of the outputs, the on-chain outputs the assets are anchored in
+	and the inputs that are still missing a witness. The virtual PSBT is
+	decoded locally, without connecting to tapd, and can be in binary or
+	base64 encoding.
+
+	With --anchor_txid, the pending transfer with that anchor transaction
+	is broken down by tapd instead.
+`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: vPacketPathName,
+			Usage: "the path to the virtual PSBT on disk; use the " +
+				"dash character (-) to read from stdin instead",
+		},
+		cli.StringFlag{
+			Name: anchorTxidName,
+			Usage: "the anchor transaction ID of a pending " +
+				"transfer to inspect",
+		},
+	},
+	Action: inspectVPacket,
+}
+
+// vInputSummary is the JSON representation of a virtual transaction input
+// summary.
+//
+// nolint: lll
+type vInputSummary struct {
+	Index          int    `json:"index"`
+	AnchorOutPoint string `json:"anchor_outpoint"`
+	AssetID        string `json:"asset_id"`
+	ScriptKey      string `json:"script_key"`
+	Amount         uint64 `json:"amount"`
+	HasAsset       bool   `json:"has_asset"`
+	HasProof       bool   `json:"has_proof"`
+	AnchorValue    int64  `json:"anchor_value"`
+	Signed         bool   `json:"signed"`
+}
+
+// vOutputSummary is the JSON representation of a virtual transaction output
+// summary.
+//
+// nolint: lll
+type vOutputSummary struct {
+	Index                int    `json:"index"`
+	Type                 string `json:"type"`
+	Amount               uint64 `json:"amount"`
+	Interactive          bool   `json:"interactive"`
+	ScriptKey            string `json:"script_key,omitempty"`
+	AnchorOutputIndex    uint32 `json:"anchor_output_index"`
+	AnchorInternalKey    string `json:"anchor_internal_key,omitempty"`
+	HasTapSibling        bool   `json:"has_tap_sibling"`
+	HasAsset             bool   `json:"has_asset"`
+	HasSplitCommitment   bool   `json:"has_split_commitment"`
+	HasProofSuffix       bool   `json:"has_proof_suffix"`
+	ProofDeliveryAddress string `json:"proof_delivery_address,omitempty"`
+}
+
+// anchorOutputSummary is the JSON representation of the virtual outputs that
+// are anchored in the same on-chain output.
+type anchorOutputSummary struct {
+	AnchorOutputIndex uint32 `json:"anchor_output_index"`
+	OutputIndexes     []int  `json:"output_indexes"`
+}
+
+// vPacketInspection is the JSON representation of a virtual packet
+// inspection.
+//
+// nolint: lll
+type vPacketInspection struct {
+	Version          uint8                 `json:"version"`
+	Network          string                `json:"network"`
+	AssetID          string                `json:"asset_id,omitempty"`
+	Inputs           []vInputSummary       `json:"inputs"`
+	Outputs          []vOutputSummary      `json:"outputs"`
+	AnchorOutputs    []anchorOutputSummary `json:"anchor_outputs"`
+	MissingWitnesses []int                 `json:"missing_witnesses"`
+}
+
+// readVPacket reads and decodes the virtual PSBT from the file given by the
+// vpsbt_file flag.
+func readVPacket(ctx *cli.Context) (*tappsbt.VPacket, error) {
+	filePath := lncfg.CleanAndExpandPath(ctx.String(vPacketPathName))
+	rawPacket, err := readFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read virtual PSBT: %w", err)
+	}
+
+	// A binary packet starts with the PSBT magic bytes, everything else is
+	// treated as base64.
+	rawPacket = bytes.TrimSpace(rawPacket)
+	isBase64 := !bytes.HasPrefix(rawPacket, []byte("psbt\xff"))
+	vPkt, err := tappsbt.NewFromRawBytes(
+		bytes.NewReader(rawPacket), isBase64,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode virtual PSBT: %w",
+			err)
+	}
+
+	return vPkt, nil
+}
+
+func inspectVPacket(ctx *cli.Context) error {
+	switch {
+	case ctx.IsSet(vPacketPathName) && ctx.IsSet(anchorTxidName):
+		return fmt.Errorf("only one of %s and %s can be set",
+			vPacketPathName, anchorTxidName)
+
+	case ctx.IsSet(anchorTxidName):
+		return inspectPendingTransfer(ctx)
+
+	case !ctx.IsSet(vPacketPathName):
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	vPkt, err := readVPacket(ctx)
+	if err != nil {
+		return err
+	}
+
+	inspection := tappsbt.Inspect(vPkt)
+
+	inputs := make([]vInputSummary, 0, len(inspection.Inputs))
+	for _, in := range inspection.Inputs {
+		scriptKey := in.PrevID.ScriptKey
+		inputs = append(inputs, vInputSummary{
+			Index:          in.Index,
+			AnchorOutPoint: in.PrevID.OutPoint.String(),
+			AssetID:        in.PrevID.ID.String(),
+			ScriptKey:      hex.EncodeToString(scriptKey[:]),
+			Amount:         in.Amount,
+			HasAsset:       in.HasAsset,
+			HasProof:       in.HasProof,
+			AnchorValue:    in.AnchorValue,
+			Signed:         in.Signed,
+		})
+	}
+
+	outputs := make([]vOutputSummary, 0, len(inspection.Outputs))
+	for _, out := range inspection.Outputs {
+		summary := vOutputSummary{
+			Index:                out.Index,
+			Type:                 out.Type.String(),
+			Amount:               out.Amount,
+			Interactive:          out.Interactive,
+			AnchorOutputIndex:    out.AnchorOutputIndex,
+			HasTapSibling:        out.HasTapSibling,
+			HasAsset:             out.HasAsset,
+			HasSplitCommitment:   out.HasSplitCommitment,
+			HasProofSuffix:       out.HasProofSuffix,
+			ProofDeliveryAddress: out.ProofDeliveryAddress,
+		}
+		if out.ScriptKey != nil {
+			summary.ScriptKey = hex.EncodeToString(
+				out.ScriptKey.SerializeCompressed(),
+			)
+		}
+		if out.AnchorInternalKey != nil {
+			summary.AnchorInternalKey = hex.EncodeToString(
+				out.AnchorInternalKey.SerializeCompressed(),
+			)
+		}
+
+		outputs = append(outputs, summary)
+	}
+
+	anchorOutputs := make(
+		[]anchorOutputSummary, 0, len(inspection.AnchorOutputs),
+	)
+	for _, anchorOutput := range inspection.AnchorOutputs {
+		anchorOutputs = append(anchorOutputs, anchorOutputSummary{
+			AnchorOutputIndex: anchorOutput.AnchorOutputIndex,
+			OutputIndexes:     anchorOutput.OutputIndexes,
+		})
+	}
+
+	var assetID string
+	if inspection.AssetID != nil {
+		assetID = inspection.AssetID.String()
+	}
+
+	printJSON(vPacketInspection{
+		Version:          uint8(inspection.Version),
+		Network:          inspection.Network,
+		AssetID:          assetID,
+		Inputs:           inputs,
+		Outputs:          outputs,
+		AnchorOutputs:    anchorOutputs,
+		MissingWitnesses: inspection.MissingWitnesses,
+	})
+
+	return nil
+}
+
+// inspectPendingTransfer asks tapd for a breakdown of the pending transfer
+// given by the anchor_txid flag.
+func inspectPendingTransfer(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	req := &wrpc.InspectVirtualPsbtRequest{
+		Packet: &wrpc.InspectVirtualPsbtRequest_PendingAnchorTxid{
+			PendingAnchorTxid: ctx.String(anchorTxidName),
+		},
+	}
+	resp, err := client.InspectVirtualPsbt(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to inspect transfer: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var validateVPacketCommand = cli.Command{
+	Name:  "validatevpsbt",
+	Usage: "validate a virtual PSBT without signing it",
+	Description: `
+	Check a virtual PSBT built by an external party before signing it. The
+	input commitments are derived from the proofs of the virtual inputs,
+	which must therefore be set. The amounts, split commitments, asset and
+	script versions and lock times are checked as well. All problems found
+	are listed. The virtual PSBT can be in binary or base64 encoding.
+`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: vPacketPathName,
+			Usage: "the path to the virtual PSBT on disk; use the " +
+				"dash character (-) to read from stdin instead",
+		},
+	},
+	Action: validateVPacket,
+}
+
+func validateVPacket(ctx *cli.Context) error {
+	if !ctx.IsSet(vPacketPathName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	vPkt, err := readVPacket(ctx)
+	if err != nil {
+		return err
+	}
+
+	rawPacket, err := tappsbt.Encode(vPkt)
+	if err != nil {
+		return fmt.Errorf("unable to encode virtual PSBT: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ValidateVirtualPsbt(
+		ctxc, &wrpc.ValidateVirtualPsbtRequest{
+			VirtualPsbt: rawPacket,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to validate virtual PSBT: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -131,3 +131,28 @@ func getInfo(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+var dbHealthCommand = cli.Command{
+	Name:  "dbhealth",
+	Usage: "Check the health of the daemon's database.",
+	Description: "Returns the schema version of the database, the " +
+		"migrations that weren't applied yet, the row counts of the " +
+		"key tables and the results of quick referential integrity " +
+		"checks.",
+	Action: dbHealth,
+}
+
+func dbHealth(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &taprpc.DatabaseHealthRequest{}
+	resp, err := client.GetDatabaseHealth(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
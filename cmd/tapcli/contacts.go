@@ -0,0 +1,140 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/urfave/cli"
+)
+
+var contactCommands = []cli.Command{
+	{
+		Name:     "contacts",
+		Usage:    "Manage the contact book of recurring send destinations.",
+		Category: "Addresses",
+		Subcommands: []cli.Command{
+			addContactCommand,
+			listContactsCommand,
+			deleteContactCommand,
+		},
+	},
+}
+
+const (
+	contactLabelName = "label"
+	contactGroupName = "group"
+	contactNotesName = "notes"
+)
+
+var addContactCommand = cli.Command{
+	Name:  "add",
+	Usage: "add or update a contact",
+	Description: "Store a Taproot Asset address under a label, so it can " +
+		"be passed to `assets send` by its label instead. If a " +
+		"contact with the same label exists, its address, group and " +
+		"notes are replaced",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  contactLabelName,
+			Usage: "the unique label of the contact",
+		},
+		cli.StringFlag{
+			Name:  addrName,
+			Usage: "the Taproot Asset address of the contact",
+		},
+		cli.StringFlag{
+			Name:  contactGroupName,
+			Usage: "(optional) the group the contact belongs to",
+		},
+		cli.StringFlag{
+			Name:  contactNotesName,
+			Usage: "(optional) free-form notes about the contact",
+		},
+	},
+	Action: addContact,
+}
+
+func addContact(ctx *cli.Context) error {
+	if ctx.String(contactLabelName) == "" || ctx.String(addrName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.AddContact(ctxc, &taprpc.AddContactRequest{
+		Label: ctx.String(contactLabelName),
+		Addr:  ctx.String(addrName),
+		Group: ctx.String(contactGroupName),
+		Notes: ctx.String(contactNotesName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to add contact: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listContactsCommand = cli.Command{
+	Name:        "list",
+	Usage:       "list the contacts of the contact book",
+	Description: "List all contacts, optionally limited to a group",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  contactGroupName,
+			Usage: "(optional) only list the contacts of this group",
+		},
+	},
+	Action: listContacts,
+}
+
+func listContacts(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListContacts(ctxc, &taprpc.ListContactsRequest{
+		Group: ctx.String(contactGroupName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list contacts: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var deleteContactCommand = cli.Command{
+	Name:        "delete",
+	Usage:       "remove a contact from the contact book",
+	Description: "Remove the contact with the given label",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  contactLabelName,
+			Usage: "the label of the contact to remove",
+		},
+	},
+	Action: deleteContact,
+}
+
+func deleteContact(ctx *cli.Context) error {
+	if ctx.String(contactLabelName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.DeleteContact(ctxc, &taprpc.DeleteContactRequest{
+		Label: ctx.String(contactLabelName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to delete contact: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
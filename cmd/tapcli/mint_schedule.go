@@ -0,0 +1,152 @@
+package main
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/lightninglabs/taproot-assets/taprpc/mintrpc"
+	"github.com/urfave/cli"
+)
+
+const (
+	finalizeHeightName = "finalize_height"
+	finalizeTimeName   = "finalize_time"
+	minSeedlingsName   = "min_seedlings"
+)
+
+var batchScheduleCommand = cli.Command{
+	Name:  "schedule",
+	Usage: "manage the schedule at which the pending batch is finalized",
+	Description: `
+	Manage the schedule at which the pending batch is finalized
+	automatically. The batch is finalized as soon as any of the conditions
+	of the schedule is met. The schedule is restored after a restart and is
+	removed once the batch is finalized.`,
+	Subcommands: []cli.Command{
+		setBatchScheduleCommand,
+		getBatchScheduleCommand,
+		removeBatchScheduleCommand,
+	},
+}
+
+var setBatchScheduleCommand = cli.Command{
+	Name:  "set",
+	Usage: "set the schedule at which the pending batch is finalized",
+	Description: `
+	Set the schedule at which the pending batch is finalized automatically,
+	replacing any existing schedule. At least one of the finalization
+	conditions must be set.`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: finalizeHeightName,
+			Usage: "if set, the block height at which the batch " +
+				"is finalized",
+		},
+		cli.Int64Flag{
+			Name: finalizeTimeName,
+			Usage: "if set, the Unix timestamp in seconds at " +
+				"which the batch is finalized",
+		},
+		cli.Uint64Flag{
+			Name: minSeedlingsName,
+			Usage: "if set, the number of seedlings the batch " +
+				"needs to contain for it to be finalized",
+		},
+		cli.Uint64Flag{
+			Name: feeRateName,
+			Usage: "if set, the fee rate in sat/vB to use for " +
+				"the genesis transaction",
+		},
+	},
+	Action: setBatchSchedule,
+}
+
+func setBatchSchedule(ctx *cli.Context) error {
+	if !ctx.IsSet(finalizeHeightName) && !ctx.IsSet(finalizeTimeName) &&
+		!ctx.IsSet(minSeedlingsName) {
+
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	finalizeHeight := ctx.Uint64(finalizeHeightName)
+	if finalizeHeight > math.MaxUint32 {
+		return fmt.Errorf("finalize height exceeds 2^32")
+	}
+
+	minSeedlings := ctx.Uint64(minSeedlingsName)
+	if minSeedlings > math.MaxUint32 {
+		return fmt.Errorf("min seedlings exceeds 2^32")
+	}
+
+	feeRate, err := parseFeeRate(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ScheduleBatch(ctxc, &mintrpc.ScheduleBatchRequest{
+		Schedule: &mintrpc.BatchSchedule{
+			FinalizeHeight: uint32(finalizeHeight),
+			FinalizeTime:   ctx.Int64(finalizeTimeName),
+			MinSeedlings:   uint32(minSeedlings),
+			FeeRate:        feeRate,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to schedule batch: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var getBatchScheduleCommand = cli.Command{
+	Name:  "get",
+	Usage: "show the schedule at which the pending batch is finalized",
+	Description: "Show the current schedule at which the pending batch " +
+		"is finalized automatically, if there is one",
+	Action: getBatchSchedule,
+}
+
+func getBatchSchedule(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.GetBatchSchedule(
+		ctxc, &mintrpc.GetBatchScheduleRequest{},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to get batch schedule: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var removeBatchScheduleCommand = cli.Command{
+	Name:  "remove",
+	Usage: "remove the schedule at which the pending batch is finalized",
+	Description: "Remove the current schedule, so the pending batch is " +
+		"no longer finalized automatically",
+	Action: removeBatchSchedule,
+}
+
+func removeBatchSchedule(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ScheduleBatch(
+		ctxc, &mintrpc.ScheduleBatchRequest{},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to remove batch schedule: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/taprpc"
 	wrpc "github.com/lightninglabs/taproot-assets/taprpc/assetwalletrpc"
 	"github.com/lightningnetwork/lnd/lncfg"
@@ -22,9 +23,12 @@ var proofCommands = []cli.Command{
 		Subcommands: []cli.Command{
 			verifyProofCommand,
 			decodeProofCommand,
+			inspectProofCommand,
 			exportProofCommand,
 			proveOwnershipCommand,
 			verifyOwnershipCommand,
+			bulkImportProofsCommand,
+			proofImportStatusCommand,
 		},
 	},
 }
@@ -35,6 +39,10 @@ const (
 	proofAtDepthName      = "proof_at_depth"
 	withPrevWitnessesName = "latest_proof"
 	withMetaRevealName    = "meta_reveal"
+	partialVerifyName     = "verify"
+	withTransitionsName   = "with_transitions"
+
+	importJobIDName = "job_id"
 )
 
 var verifyProofCommand = cli.Command{
@@ -94,6 +102,10 @@ var decodeProofCommand = cli.Command{
 	of an asset, but does not prove that the creator of the proof can 
 	actually also spend the asset. To verify ownership, use the 
 	"verifyownership" command with a separate ownership proof.
+
+	With --with_transitions, a summary of every state transition of the
+	proof is returned as well. Adding --verify partially verifies each of
+	them, the same way the offline "inspect" command does.
 `,
 	Flags: []cli.Flag{
 		cli.StringFlag{
@@ -116,6 +128,16 @@ var decodeProofCommand = cli.Command{
 			Usage: "if true, will attempt to reveal the meta data " +
 				"associated with the proof",
 		},
+		cli.BoolFlag{
+			Name: withTransitionsName,
+			Usage: "if true, a summary of every state transition " +
+				"is returned as well",
+		},
+		cli.BoolFlag{
+			Name: partialVerifyName,
+			Usage: "if true, each state transition is partially " +
+				"verified; requires --with_transitions",
+		},
 	},
 	Action: decodeProof,
 }
@@ -141,6 +163,8 @@ func decodeProof(ctx *cli.Context) error {
 		ProofAtDepth:      uint32(ctx.Uint(proofAtDepthName)),
 		WithPrevWitnesses: ctx.Bool(withPrevWitnessesName),
 		WithMetaReveal:    ctx.Bool(withMetaRevealName),
+		WithTransitions:   ctx.Bool(withTransitionsName),
+		VerifyTransitions: ctx.Bool(partialVerifyName),
 	}
 
 	resp, err := client.DecodeProof(ctxc, req)
@@ -152,6 +176,198 @@ func decodeProof(ctx *cli.Context) error {
 	return nil
 }
 
+var inspectProofCommand = cli.Command{
+	Name:      "inspect",
+	ShortName: "i",
+	Usage:     "inspect a Taproot Asset proof offline",
+	Description: `
+	Break down a taproot asset proof file or a single state transition
+	proof into its state transitions, listing the anchor transactions,
+	inclusion and exclusion proofs and the resulting asset states. The
+	proof is decoded locally, without connecting to tapd, and is not
+	imported anywhere.
+
+	With --verify, each state transition is also partially verified. This
+	checks the merkle proof of the anchor transaction, the inclusion,
+	exclusion and split root proofs, the chaining of the transitions and
+	the genesis and group key reveals. The block headers, group keys and
+	asset witnesses are not verified, as that requires access to the chain.
+`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: proofPathName,
+			Usage: "the path to the proof file on disk; use the " +
+				"dash character (-) to read from stdin instead",
+		},
+		cli.BoolFlag{
+			Name: partialVerifyName,
+			Usage: "if true, each state transition is partially " +
+				"verified",
+		},
+	},
+	Action: inspectProof,
+}
+
+// taprootProofSummary is the JSON representation of an inclusion or exclusion
+// proof summary.
+type taprootProofSummary struct {
+	OutputIndex   uint32 `json:"output_index"`
+	InternalKey   string `json:"internal_key"`
+	Kind          string `json:"kind"`
+	HasTapSibling bool   `json:"has_tap_sibling"`
+}
+
+// transitionSummary is the JSON representation of a state transition proof
+// summary.
+//
+// nolint: lll
+type transitionSummary struct {
+	Index               uint32                `json:"index"`
+	Version             uint32                `json:"version"`
+	AssetID             string                `json:"asset_id"`
+	AssetType           string                `json:"asset_type"`
+	Amount              uint64                `json:"amount"`
+	ScriptKey           string                `json:"script_key"`
+	GroupKey            string                `json:"group_key,omitempty"`
+	IsGenesis           bool                  `json:"is_genesis"`
+	IsBurn              bool                  `json:"is_burn"`
+	IsSplit             bool                  `json:"is_split"`
+	PrevOut             string                `json:"prev_out"`
+	AnchorTxid          string                `json:"anchor_txid"`
+	AnchorOutPoint      string                `json:"anchor_outpoint"`
+	BlockHash           string                `json:"block_hash"`
+	BlockHeight         uint32                `json:"block_height"`
+	InclusionProof      taprootProofSummary   `json:"inclusion_proof"`
+	ExclusionProofs     []taprootProofSummary `json:"exclusion_proofs"`
+	SplitRootProof      *taprootProofSummary  `json:"split_root_proof,omitempty"`
+	NumPrevWitnesses    int                   `json:"num_prev_witnesses"`
+	NumAdditionalInputs int                   `json:"num_additional_inputs"`
+	HasMetaReveal       bool                  `json:"has_meta_reveal"`
+	HasGenesisReveal    bool                  `json:"has_genesis_reveal"`
+	HasGroupKeyReveal   bool                  `json:"has_group_key_reveal"`
+	HasChallengeWitness bool                  `json:"has_challenge_witness"`
+	Verified            bool                  `json:"verified"`
+	VerifyError         string                `json:"verify_error,omitempty"`
+}
+
+// newTaprootProofSummary converts the given taproot proof summary into its
+// JSON representation.
+func newTaprootProofSummary(
+	summary proof.TaprootProofSummary) taprootProofSummary {
+
+	var internalKey string
+	if summary.InternalKey != nil {
+		internalKey = hex.EncodeToString(
+			summary.InternalKey.SerializeCompressed(),
+		)
+	}
+
+	return taprootProofSummary{
+		OutputIndex:   summary.OutputIndex,
+		InternalKey:   internalKey,
+		Kind:          summary.Kind.String(),
+		HasTapSibling: summary.HasTapSibling,
+	}
+}
+
+// newTransitionSummary converts the given state transition summary into its
+// JSON representation.
+func newTransitionSummary(
+	summary proof.TransitionSummary) transitionSummary {
+
+	jsonSummary := transitionSummary{
+		Index:          summary.Index,
+		Version:        uint32(summary.Version),
+		AssetID:        summary.AssetID.String(),
+		AssetType:      summary.AssetType.String(),
+		Amount:         summary.Amount,
+		IsGenesis:      summary.IsGenesis,
+		IsBurn:         summary.IsBurn,
+		IsSplit:        summary.IsSplit,
+		PrevOut:        summary.PrevOut.String(),
+		AnchorTxid:     summary.AnchorTxid.String(),
+		AnchorOutPoint: summary.AnchorOutPoint.String(),
+		BlockHash:      summary.BlockHash.String(),
+		BlockHeight:    summary.BlockHeight,
+		InclusionProof: newTaprootProofSummary(
+			summary.InclusionProof,
+		),
+		ExclusionProofs:     []taprootProofSummary{},
+		NumPrevWitnesses:    summary.NumPrevWitnesses,
+		NumAdditionalInputs: summary.NumAdditionalInputs,
+		HasMetaReveal:       summary.HasMetaReveal,
+		HasGenesisReveal:    summary.HasGenesisReveal,
+		HasGroupKeyReveal:   summary.HasGroupKeyReveal,
+		HasChallengeWitness: summary.HasChallengeWitness,
+		Verified:            summary.Verified,
+	}
+
+	if summary.ScriptKey != nil {
+		jsonSummary.ScriptKey = hex.EncodeToString(
+			summary.ScriptKey.SerializeCompressed(),
+		)
+	}
+	if summary.GroupKey != nil {
+		jsonSummary.GroupKey = hex.EncodeToString(
+			summary.GroupKey.SerializeCompressed(),
+		)
+	}
+
+	for _, exclusionProof := range summary.ExclusionProofs {
+		jsonSummary.ExclusionProofs = append(
+			jsonSummary.ExclusionProofs,
+			newTaprootProofSummary(exclusionProof),
+		)
+	}
+
+	if summary.SplitRootProof != nil {
+		splitRootProof := newTaprootProofSummary(
+			*summary.SplitRootProof,
+		)
+		jsonSummary.SplitRootProof = &splitRootProof
+	}
+
+	if summary.VerifyErr != nil {
+		jsonSummary.VerifyError = summary.VerifyErr.Error()
+	}
+
+	return jsonSummary
+}
+
+func inspectProof(ctx *cli.Context) error {
+	if !ctx.IsSet(proofPathName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	filePath := lncfg.CleanAndExpandPath(ctx.String(proofPathName))
+	rawFile, err := readFile(filePath)
+	if err != nil {
+		return fmt.Errorf("unable to read proof file: %w", err)
+	}
+
+	inspection, err := proof.Inspect(rawFile, ctx.Bool(partialVerifyName))
+	if err != nil {
+		return fmt.Errorf("unable to inspect proof: %w", err)
+	}
+
+	transitions := make(
+		[]transitionSummary, 0, len(inspection.Transitions),
+	)
+	for _, summary := range inspection.Transitions {
+		transitions = append(transitions, newTransitionSummary(summary))
+	}
+
+	printJSON(struct {
+		IsFile      bool                `json:"is_file"`
+		Transitions []transitionSummary `json:"transitions"`
+	}{
+		IsFile:      inspection.IsFile,
+		Transitions: transitions,
+	})
+
+	return nil
+}
+
 var verifyOwnershipCommand = cli.Command{
 	Name:      "verifyownership",
 	ShortName: "vo",
@@ -389,3 +605,93 @@ func writeToFile(fileName string, content []byte) error {
 
 	return nil
 }
+
+var bulkImportProofsCommand = cli.Command{
+	Name:      "bulkimport",
+	ShortName: "bi",
+	Usage:     "import many taproot asset proofs in the background",
+	Description: `
+	Submit a set of proof files to be imported in the background. Each
+	proof file is verified and imported independently, so an invalid proof
+	file doesn't prevent the others from being imported. The returned job
+	ID can be used with the "importstatus" command to query the progress.
+`,
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name: proofPathName,
+			Usage: "the path to a proof file on disk; can be " +
+				"specified multiple times",
+		},
+	},
+	Action: bulkImportProofs,
+}
+
+func bulkImportProofs(ctx *cli.Context) error {
+	proofPaths := ctx.StringSlice(proofPathName)
+	if len(proofPaths) == 0 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	rawFiles := make([][]byte, 0, len(proofPaths))
+	for _, proofPath := range proofPaths {
+		filePath := lncfg.CleanAndExpandPath(proofPath)
+		rawFile, err := readFile(filePath)
+		if err != nil {
+			return fmt.Errorf("unable to read proof file %v: %w",
+				proofPath, err)
+		}
+
+		rawFiles = append(rawFiles, rawFile)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.BulkImportProofs(
+		ctxc, &taprpc.BulkImportProofsRequest{
+			RawProofFiles: rawFiles,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to import proof files: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var proofImportStatusCommand = cli.Command{
+	Name:      "importstatus",
+	ShortName: "is",
+	Usage:     "show the progress of a bulk proof import",
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name:  importJobIDName,
+			Usage: "the ID of the import job",
+		},
+	},
+	Action: proofImportStatus,
+}
+
+func proofImportStatus(ctx *cli.Context) error {
+	if !ctx.IsSet(importJobIDName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ProofImportStatus(
+		ctxc, &taprpc.ProofImportStatusRequest{
+			JobId: ctx.Int64(importJobIDName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to query proof import: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/urfave/cli"
+)
+
+const (
+	sendLimitMaxAmtName = "max_amt"
+)
+
+var sendLimitsCommand = cli.Command{
+	Name:  "sendlimits",
+	Usage: "manage the limits of amounts sent to destinations",
+	Description: `
+	Manage the limits of the cumulative amount of an asset that can be sent
+	to a destination script key, and query the amounts sent so far. Sends
+	that would exceed a limit are rejected.`,
+	Subcommands: []cli.Command{
+		setSendLimitCommand,
+		removeSendLimitCommand,
+		listSendLimitsCommand,
+		listSendTotalsCommand,
+	},
+}
+
+// sendDestinationFlags are the flags that identify the destination and asset
+// of a send limit.
+var sendDestinationFlags = []cli.Flag{
+	cli.StringFlag{
+		Name: scriptKeyName,
+		Usage: "the hex encoded compressed script key of the " +
+			"destination",
+	},
+	cli.StringFlag{
+		Name:  assetIDName,
+		Usage: "the hex encoded ID of the asset",
+	},
+}
+
+// parseSendDestinationFlags decodes the script key and asset ID flags of a
+// send limit command. Flags that aren't set are returned as nil.
+func parseSendDestinationFlags(ctx *cli.Context) ([]byte, []byte, error) {
+	var scriptKey, assetID []byte
+	if ctx.IsSet(scriptKeyName) {
+		var err error
+		scriptKey, err = hex.DecodeString(ctx.String(scriptKeyName))
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to decode script "+
+				"key: %w", err)
+		}
+	}
+
+	if ctx.IsSet(assetIDName) {
+		var err error
+		assetID, err = hex.DecodeString(ctx.String(assetIDName))
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to decode asset "+
+				"ID: %w", err)
+		}
+	}
+
+	return scriptKey, assetID, nil
+}
+
+var setSendLimitCommand = cli.Command{
+	Name:  "set",
+	Usage: "set the limit of an asset sent to a destination",
+	Description: `
+	Set the maximum cumulative amount of an asset that can be sent to a
+	destination script key, replacing any existing limit.`,
+	Flags: append([]cli.Flag{
+		cli.Uint64Flag{
+			Name: sendLimitMaxAmtName,
+			Usage: "the maximum cumulative amount of the asset " +
+				"that can be sent to the destination",
+		},
+	}, sendDestinationFlags...),
+	Action: setSendLimit,
+}
+
+func setSendLimit(ctx *cli.Context) error {
+	if !ctx.IsSet(scriptKeyName) || !ctx.IsSet(assetIDName) ||
+		!ctx.IsSet(sendLimitMaxAmtName) {
+
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	scriptKey, assetID, err := parseSendDestinationFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SetSendLimit(ctxc, &taprpc.SetSendLimitRequest{
+		Limit: &taprpc.SendLimit{
+			ScriptKey: scriptKey,
+			AssetId:   assetID,
+			MaxAmount: ctx.Uint64(sendLimitMaxAmtName),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to set send limit: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var removeSendLimitCommand = cli.Command{
+	Name:  "remove",
+	Usage: "remove the limit of an asset sent to a destination",
+	Description: `
+	Remove the limit of the cumulative amount of an asset that can be sent
+	to a destination script key. The amounts sent so far are kept.`,
+	Flags:  sendDestinationFlags,
+	Action: removeSendLimit,
+}
+
+func removeSendLimit(ctx *cli.Context) error {
+	if !ctx.IsSet(scriptKeyName) || !ctx.IsSet(assetIDName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	scriptKey, assetID, err := parseSendDestinationFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.RemoveSendLimit(
+		ctxc, &taprpc.RemoveSendLimitRequest{
+			ScriptKey: scriptKey,
+			AssetId:   assetID,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to remove send limit: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listSendLimitsCommand = cli.Command{
+	Name:        "list",
+	Usage:       "list all send limits",
+	Description: "List all limits of amounts sent to destinations",
+	Action:      listSendLimits,
+}
+
+func listSendLimits(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListSendLimits(
+		ctxc, &taprpc.ListSendLimitsRequest{},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to list send limits: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listSendTotalsCommand = cli.Command{
+	Name:  "totals",
+	Usage: "list the amounts sent to destinations",
+	Description: `
+	List the cumulative amounts of assets sent to destination script keys,
+	together with their limits. The list can be limited to a destination,
+	an asset or both.`,
+	Flags:  sendDestinationFlags,
+	Action: listSendTotals,
+}
+
+func listSendTotals(ctx *cli.Context) error {
+	scriptKey, assetID, err := parseSendDestinationFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListSendTotals(
+		ctxc, &taprpc.ListSendTotalsRequest{
+			ScriptKey: scriptKey,
+			AssetId:   assetID,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to list send totals: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
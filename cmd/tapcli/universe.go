@@ -4,11 +4,16 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"os"
+	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/wire"
 	tap "github.com/lightninglabs/taproot-assets"
 	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
 	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/taprpc"
 	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
 	"github.com/lightninglabs/taproot-assets/universe"
 	"github.com/lightningnetwork/lnd/lncfg"
@@ -18,6 +23,8 @@ import (
 const (
 	proofTypeName       = "proof_type"
 	skipAmountsByIdName = "skip_amounts_by_id"
+	minHeightName       = "min_height"
+	maxHeightName       = "max_height"
 )
 
 func getUniverseClient(ctx *cli.Context) (unirpc.UniverseClient, func()) {
@@ -44,10 +51,15 @@ var universeCommands = []cli.Command{
 			universeLeavesCommand,
 			universeKeysCommand,
 			universeProofCommand,
+			universeMetaCommand,
 			universeSyncCommand,
 			universeFederationCommand,
 			universeInfoCommand,
 			universeStatsCommand,
+			universeAuditCommand,
+			universeSnapshotCommand,
+			universeSubscribeCommand,
+			universeSubscribeRootsCommand,
 		},
 	},
 }
@@ -299,6 +311,16 @@ var universeKeysCommand = cli.Command{
 				"either 'issuance' or 'transfer'",
 			Value: universe.ProofTypeIssuance.String(),
 		},
+		cli.Uint64Flag{
+			Name: minHeightName,
+			Usage: "if set, only the keys of proofs anchored at " +
+				"or above this block height are returned",
+		},
+		cli.Uint64Flag{
+			Name: maxHeightName,
+			Usage: "if set, only the keys of proofs anchored at " +
+				"or below this block height are returned",
+		},
 	},
 	Action: universeKeys,
 }
@@ -322,6 +344,12 @@ func universeKeys(ctx *cli.Context) error {
 				Id:     universeID,
 				Offset: int32(offset),
 				Limit:  universe.MaxPageSize,
+				MinBlockHeight: uint32(
+					ctx.Uint64(minHeightName),
+				),
+				MaxBlockHeight: uint32(
+					ctx.Uint64(maxHeightName),
+				),
 			},
 		)
 
@@ -390,6 +418,127 @@ func universeLeaves(ctx *cli.Context) error {
 	return nil
 }
 
+var universeSubscribeCommand = cli.Command{
+	Name:  "subscribe",
+	Usage: "subscribe to new leaves inserted into the universe",
+	Description: `
+	Get live updates on the proof leaves that are inserted or updated in the
+	universe. If an asset ID or group key is given, only the leaves of that
+	universe are streamed. Otherwise the leaves of all universes are
+	streamed.
+	This command will block until aborted manually by hitting Ctrl+C.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the universe to subscribe to",
+		},
+		cli.StringFlag{
+			Name:  groupKeyName,
+			Usage: "the group key of the universe to subscribe to",
+		},
+		cli.StringFlag{
+			Name: proofTypeName,
+			Usage: "the type of proof to subscribe to, either " +
+				"'issuance' or 'transfer'",
+			Value: universe.ProofTypeIssuance.String(),
+		},
+	},
+	Action: universeSubscribe,
+}
+
+func universeSubscribe(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	universeID, err := parseUniverseID(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	req := &unirpc.SubscribeLeavesRequest{}
+	if universeID != nil {
+		req.Ids = []*unirpc.ID{universeID}
+	}
+
+	stream, err := client.SubscribeLeaves(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to subscribe to leaves: %w", err)
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return fmt.Errorf("unable to receive leaf event: %w",
+				err)
+		}
+
+		printRespJSON(event)
+	}
+}
+
+var universeSubscribeRootsCommand = cli.Command{
+	Name:  "subscriberoots",
+	Usage: "subscribe to changes of universe roots",
+	Description: `
+	Get live updates on the roots of the local universes. If asset IDs or
+	group keys are given, only the root changes of those universes are
+	streamed. Otherwise the root changes of all universes are streamed.
+	This command will block until aborted manually by hitting Ctrl+C.`,
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name: assetIDName,
+			Usage: "the asset ID to receive root changes " +
+				"for; can be specified multiple times",
+		},
+		cli.StringSliceFlag{
+			Name: groupKeyName,
+			Usage: "the group key to receive root changes for; " +
+				"can be specified multiple times",
+		},
+	},
+	Action: universeSubscribeRoots,
+}
+
+func universeSubscribeRoots(ctx *cli.Context) error {
+	req := &unirpc.SubscribeUniverseRootsRequest{}
+	for _, assetIDStr := range ctx.StringSlice(assetIDName) {
+		assetID, err := hex.DecodeString(assetIDStr)
+		if err != nil {
+			return fmt.Errorf("invalid asset ID: %w", err)
+		}
+
+		req.AssetIds = append(req.AssetIds, assetID)
+	}
+	for _, groupKeyStr := range ctx.StringSlice(groupKeyName) {
+		groupKey, err := hex.DecodeString(groupKeyStr)
+		if err != nil {
+			return fmt.Errorf("invalid group key: %w", err)
+		}
+
+		req.GroupKeys = append(req.GroupKeys, groupKey)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	stream, err := client.SubscribeUniverseRoots(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to subscribe to roots: %w", err)
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return fmt.Errorf("unable to receive root event: %w",
+				err)
+		}
+
+		printRespJSON(event)
+	}
+}
+
 const (
 	outpointName = "outpoint"
 )
@@ -431,12 +580,13 @@ var universeProofCommand = cli.Command{
 	on chain. Proofs are namespaced based on a top level assetID/groupKey,
 	so that must be specified for each command.
 
-	Two sub-commands are available: proof querying (query) and proof
-	insertion (insert).
+	Three sub-commands are available: proof querying (query), proof
+	insertion (insert) and pushing a proof to the federation (push).
 	`,
 	Subcommands: []cli.Command{
 		universeProofQueryCommand,
 		universeProofInsertInsert,
+		universeProofPushCommand,
 	},
 }
 
@@ -581,8 +731,113 @@ func universeProofInsert(ctx *cli.Context) error {
 	return nil
 }
 
+var universeProofPushCommand = cli.Command{
+	Name:  "push",
+	Usage: "push a universe proof to the federation",
+	Description: `
+	Immediately push a proof of the local universe to the given federation
+	servers, or to all of them if no server is given. The proof is
+	identified by a top level asset id or group key, and the leaf key of:
+	outpoint || script key. The result of the push to each server is
+	returned. A failed push is retried with the next federation sync.
+	`,
+	Flags: append(universeProofArgs, cli.StringSliceFlag{
+		Name: universeHostName,
+		Usage: "the host:port of the federation server to push " +
+			"to; can be specified multiple times to push to " +
+			"multiple servers",
+	}),
+	Action: universeProofPush,
+}
+
+func universeProofPush(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	assetKey, err := parseAssetKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	universeID, err := parseUniverseID(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	var servers []*unirpc.UniverseFederationServer
+	for _, host := range ctx.StringSlice(universeHostName) {
+		servers = append(servers, &unirpc.UniverseFederationServer{
+			Host: host,
+		})
+	}
+
+	resp, err := client.PushProof(ctxc, &unirpc.PushProofRequest{
+		Key: &unirpc.UniverseKey{
+			Id:      universeID,
+			LeafKey: assetKey,
+		},
+		Servers: servers,
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var universeMetaCommand = cli.Command{
+	Name:  "meta",
+	Usage: "fetch the meta of an asset in the universe",
+	Description: `
+	Fetch the full metadata of an asset that has a leaf in the target
+	universe, based on its meta hash. This doesn't require downloading the
+	issuance proof of the asset.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: metaName,
+			Usage: "the hex encoded meta_hash to fetch the " +
+				"meta for",
+		},
+	},
+	Action: universeMeta,
+}
+
+func universeMeta(ctx *cli.Context) error {
+	if !ctx.IsSet(metaName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	metaHash, err := hex.DecodeString(ctx.String(metaName))
+	if err != nil {
+		return fmt.Errorf("invalid meta hash: %w", err)
+	}
+
+	resp, err := client.QueryAssetMeta(ctxc, &unirpc.AssetMetaRequest{
+		Meta: &unirpc.AssetMetaRequest_MetaHash{
+			MetaHash: metaHash,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to fetch asset meta: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var (
 	universeHostName = "universe_host"
+
+	universeIdentityKeyName = "identity_key"
+
+	dryRunName = "dry_run"
 )
 
 var universeSyncCommand = cli.Command{
@@ -610,6 +865,12 @@ var universeSyncCommand = cli.Command{
 				"or 'transfer'",
 			Value: universe.ProofTypeIssuance.String(),
 		},
+		cli.BoolFlag{
+			Name: dryRunName,
+			Usage: "if set, only compare the local universe with " +
+				"the remote universe and report the " +
+				"differences, without inserting anything",
+		},
 	},
 	Action: universeSync,
 }
@@ -648,6 +909,7 @@ func universeSync(ctx *cli.Context) error {
 		UniverseHost: ctx.String(universeHostName),
 		SyncTargets:  targets,
 		SyncMode:     syncMode,
+		DryRun:       ctx.Bool(dryRunName),
 	})
 	if err != nil {
 		return err
@@ -672,6 +934,7 @@ var universeFederationCommand = cli.Command{
 		universeFederationAddCommand,
 		universeFederationDelCommand,
 		universeFederationConfigCommand,
+		universeFederationProfileCommand,
 	},
 }
 
@@ -713,6 +976,13 @@ var universeFederationAddCommand = cli.Command{
 				"testnet.mydomain.com:10029. The default port " +
 				"(10029) will be used if none is provided",
 		},
+		cli.StringFlag{
+			Name: universeIdentityKeyName,
+			Usage: "the hex encoded identity key of the " +
+				"universe server; signed messages of the " +
+				"server, like root change notifications, are " +
+				"only accepted if they verify against this key",
+		},
 	},
 	Action: universeFederationAdd,
 }
@@ -722,17 +992,24 @@ func universeFederationAdd(ctx *cli.Context) error {
 		return cli.ShowSubcommandHelp(ctx)
 	}
 
+	identityKey, err := hex.DecodeString(
+		ctx.String(universeIdentityKeyName),
+	)
+	if err != nil {
+		return fmt.Errorf("invalid identity key: %w", err)
+	}
+
 	ctxc := getContext()
 	client, cleanUp := getUniverseClient(ctx)
 	defer cleanUp()
 
+	server := &unirpc.UniverseFederationServer{
+		Host:        ctx.String(universeHostName),
+		IdentityKey: identityKey,
+	}
 	resp, err := client.AddFederationServer(
 		ctxc, &unirpc.AddFederationServerRequest{
-			Servers: []*unirpc.UniverseFederationServer{
-				{
-					Host: ctx.String(universeHostName),
-				},
-			},
+			Servers: []*unirpc.UniverseFederationServer{server},
 		},
 	)
 	if err != nil {
@@ -795,6 +1072,157 @@ func universeFederationDel(ctx *cli.Context) error {
 	return nil
 }
 
+const federationProfileName = "name"
+
+var universeFederationProfileCommand = cli.Command{
+	Name:      "profile",
+	ShortName: "p",
+	Usage:     "Manage named Universe Federation profiles",
+	Description: `
+	Manage named sets of Universe Federation servers and sync configs.
+	Applying a profile replaces the current federation servers and sync
+	configs with the ones of the profile, which allows switching between
+	different federation setups without re-adding servers manually.
+	`,
+	Subcommands: []cli.Command{
+		universeFederationProfileListCommand,
+		universeFederationProfileSaveCommand,
+		universeFederationProfileApplyCommand,
+		universeFederationProfileDeleteCommand,
+	},
+}
+
+var universeFederationProfileListCommand = cli.Command{
+	Name:        "list",
+	ShortName:   "l",
+	Description: "List the stored federation profiles",
+	Action:      universeFederationProfileList,
+}
+
+func universeFederationProfileList(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListFederationProfiles(
+		ctxc, &unirpc.ListFederationProfilesRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var universeFederationProfileSaveCommand = cli.Command{
+	Name:      "save",
+	ShortName: "s",
+	Description: "Save the current federation servers and sync configs " +
+		"as a profile, replacing any existing profile with the same " +
+		"name",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  federationProfileName,
+			Usage: "the name of the profile",
+		},
+	},
+	Action: universeFederationProfileSave,
+}
+
+func universeFederationProfileSave(ctx *cli.Context) error {
+	if ctx.String(federationProfileName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SaveFederationProfile(
+		ctxc, &unirpc.SaveFederationProfileRequest{
+			Name: ctx.String(federationProfileName),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var universeFederationProfileApplyCommand = cli.Command{
+	Name:      "apply",
+	ShortName: "a",
+	Description: "Switch the federation to the servers and sync configs " +
+		"of a profile",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  federationProfileName,
+			Usage: "the name of the profile",
+		},
+	},
+	Action: universeFederationProfileApply,
+}
+
+func universeFederationProfileApply(ctx *cli.Context) error {
+	if ctx.String(federationProfileName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ApplyFederationProfile(
+		ctxc, &unirpc.ApplyFederationProfileRequest{
+			Name: ctx.String(federationProfileName),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var universeFederationProfileDeleteCommand = cli.Command{
+	Name:        "delete",
+	ShortName:   "d",
+	Description: "Delete a federation profile",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  federationProfileName,
+			Usage: "the name of the profile",
+		},
+	},
+	Action: universeFederationProfileDelete,
+}
+
+func universeFederationProfileDelete(ctx *cli.Context) error {
+	if ctx.String(federationProfileName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.DeleteFederationProfile(
+		ctxc, &unirpc.DeleteFederationProfileRequest{
+			Name: ctx.String(federationProfileName),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var universeFederationConfigCommand = cli.Command{
 	Name:      "config",
 	ShortName: "c",
@@ -809,6 +1237,7 @@ var universeFederationConfigCommand = cli.Command{
 		universeFederationGlobalConfig,
 		universeFederationLocalConfig,
 		universeFederationConfigInfo,
+		universeFederationConfigSchedule,
 	},
 }
 
@@ -1069,6 +1498,138 @@ func universeFederationUpdateLocalConfig(ctx *cli.Context) error {
 	return nil
 }
 
+const (
+	syncIntervalName = "interval"
+)
+
+var universeFederationConfigSchedule = cli.Command{
+	Name:      "schedule",
+	ShortName: "s",
+	Usage: "Change the sync interval of a specific asset or " +
+		"federation server",
+	Description: `
+	Manage the interval at which the local Universe syncs a specific asset
+	or the interval of the full sync with a specific federation server,
+	overriding the default sync interval. Scheduled assets are synced on
+	their own and are no longer part of the full sync. An interval of 0
+	removes the schedule. If neither an asset nor a server is specified,
+	all schedules are listed.
+        `,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  proofTypeName,
+			Usage: "the type of proof of the universe to schedule",
+		},
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the universe to schedule",
+		},
+		cli.StringFlag{
+			Name:  groupKeyName,
+			Usage: "the group key of the universe to schedule",
+		},
+		cli.StringFlag{
+			Name:  universeHostName,
+			Usage: "the host of the federation server to schedule",
+		},
+		cli.DurationFlag{
+			Name: syncIntervalName,
+			Usage: "the sync interval, for example 1m or 24h; 0 " +
+				"removes the schedule",
+		},
+	},
+	Action: universeFederationUpdateSchedule,
+}
+
+// syncSchedule is the JSON representation of a single sync schedule.
+type syncSchedule struct {
+	Universe     string `json:"universe,omitempty"`
+	UniverseHost string `json:"universe_host,omitempty"`
+	SyncInterval string `json:"sync_interval"`
+}
+
+func universeFederationUpdateSchedule(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	interval := uint64(ctx.Duration(syncIntervalName) / time.Second)
+
+	var schedule *unirpc.FederationSyncSchedule
+	switch {
+	case ctx.IsSet(universeHostName):
+		schedule = &unirpc.FederationSyncSchedule{
+			Target: &unirpc.FederationSyncSchedule_ServerHost{
+				ServerHost: ctx.String(universeHostName),
+			},
+			SyncIntervalSeconds: interval,
+		}
+
+	case ctx.IsSet(assetIDName) || ctx.IsSet(groupKeyName):
+		rpcID, err := parseUniverseID(ctx, true)
+		if err != nil {
+			return err
+		}
+
+		schedule = &unirpc.FederationSyncSchedule{
+			Target: &unirpc.FederationSyncSchedule_UniverseId{
+				UniverseId: rpcID,
+			},
+			SyncIntervalSeconds: interval,
+		}
+
+	// Without a target, we just list the current schedules.
+	default:
+		resp, err := client.QueryFederationSyncConfig(
+			ctxc, &unirpc.QueryFederationSyncConfigRequest{},
+		)
+		if err != nil {
+			return err
+		}
+
+		current, err := tap.UnmarshalSyncSchedules(resp.SyncSchedules)
+		if err != nil {
+			return err
+		}
+
+		list := make([]syncSchedule, 0)
+		for _, schedule := range current.Universes {
+			id := schedule.UniverseID
+			list = append(list, syncSchedule{
+				Universe:     id.StringForLog(),
+				SyncInterval: schedule.SyncInterval.String(),
+			})
+		}
+		for _, schedule := range current.Servers {
+			list = append(list, syncSchedule{
+				UniverseHost: schedule.ServerHost,
+				SyncInterval: schedule.SyncInterval.String(),
+			})
+		}
+
+		printJSON(list)
+		return nil
+	}
+
+	if !ctx.IsSet(syncIntervalName) {
+		return fmt.Errorf("must specify sync interval")
+	}
+
+	resp, err := client.SetFederationSyncConfig(
+		ctxc, &unirpc.SetFederationSyncConfigRequest{
+			SyncSchedules: []*unirpc.FederationSyncSchedule{
+				schedule,
+			},
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var universeFederationConfigInfo = cli.Command{
 	Name:      "info",
 	ShortName: "i",
@@ -1407,6 +1968,11 @@ var universeEventStatsCommand = cli.Command{
 				"querying from; if not specified, will query " +
 				"until now by default",
 		},
+		cli.StringFlag{
+			Name: assetIDName,
+			Usage: "(optional) the asset ID to also query the " +
+				"supply changes per day for",
+		},
 	},
 	Action: universeEventStatsQueryCommand,
 }
@@ -1416,9 +1982,15 @@ func universeEventStatsQueryCommand(ctx *cli.Context) error {
 	client, cleanUp := getUniverseClient(ctx)
 	defer cleanUp()
 
+	assetID, err := hex.DecodeString(ctx.String(assetIDName))
+	if err != nil {
+		return fmt.Errorf("unable to decode asset ID: %w", err)
+	}
+
 	resp, err := client.QueryEvents(ctxc, &unirpc.QueryEventsRequest{
 		StartTimestamp: ctx.Int64(startTime),
 		EndTimestamp:   ctx.Int64(endTime),
+		AssetIdFilter:  assetID,
 	})
 	if err != nil {
 		return err
@@ -1427,3 +1999,369 @@ func universeEventStatsQueryCommand(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+var universeAuditCommand = cli.Command{
+	Name:  "audit",
+	Usage: "check the consistency of the universe trees",
+	Description: `
+	Audit the universe trees of the connected universe server. Each stored
+	proof is decoded and checked against the leaf key and universe it is
+	stored under, and its inclusion proof is checked against the stored
+	universe root. The root is then recomputed from all leaves and compared
+	with the stored root.
+
+	If neither an asset ID nor a group key is specified, all known
+	universes are audited. The command fails if any universe didn't pass
+	the audit.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the universe to audit",
+		},
+		cli.StringFlag{
+			Name:  groupKeyName,
+			Usage: "the group key of the universe to audit",
+		},
+		cli.StringFlag{
+			Name: proofTypeName,
+			Usage: "the type of proof of the universe to audit, " +
+				"either 'issuance' or 'transfer'",
+			Value: universe.ProofTypeIssuance.String(),
+		},
+	},
+	Action: universeAudit,
+}
+
+// auditLeaf is the JSON representation of a leaf that didn't pass the audit.
+type auditLeaf struct {
+	OutPoint  string `json:"outpoint"`
+	ScriptKey string `json:"script_key"`
+	Reason    string `json:"reason"`
+}
+
+// auditReport is the JSON representation of the audit of a single universe.
+type auditReport struct {
+	UniverseID      string      `json:"universe_id"`
+	StoredRoot      string      `json:"stored_root"`
+	ComputedRoot    string      `json:"computed_root"`
+	RootMatches     bool        `json:"root_matches"`
+	NumLeaves       int         `json:"num_leaves"`
+	CorruptedLeaves []auditLeaf `json:"corrupted_leaves"`
+}
+
+// newAuditReport converts the given universe audit report into its JSON
+// representation.
+func newAuditReport(report *universe.AuditReport) auditReport {
+	nodeHash := func(node mssmt.Node) string {
+		if node == nil {
+			return ""
+		}
+
+		return node.NodeHash().String()
+	}
+
+	jsonReport := auditReport{
+		UniverseID:      report.ID.String(),
+		StoredRoot:      nodeHash(report.StoredRoot),
+		ComputedRoot:    nodeHash(report.ComputedRoot),
+		RootMatches:     report.RootMatches(),
+		NumLeaves:       report.NumLeaves,
+		CorruptedLeaves: []auditLeaf{},
+	}
+	for _, leaf := range report.CorruptedLeaves {
+		var scriptKey string
+		if leaf.Key.ScriptKey != nil {
+			scriptKey = hex.EncodeToString(
+				leaf.Key.ScriptKey.PubKey.SerializeCompressed(),
+			)
+		}
+
+		jsonReport.CorruptedLeaves = append(
+			jsonReport.CorruptedLeaves, auditLeaf{
+				OutPoint:  leaf.Key.OutPoint.String(),
+				ScriptKey: scriptKey,
+				Reason:    leaf.Reason.Error(),
+			},
+		)
+	}
+
+	return jsonReport
+}
+
+func universeAudit(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	rpcID, err := parseUniverseID(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	// The audit only reads the universe through the existing universe
+	// RPCs, so it works against any universe server we can connect to.
+	auditor := universe.NewAuditor(universe.AuditorConfig{
+		Universe: tap.NewRpcUniverseDiffFromClient(client),
+	})
+
+	var reports []*universe.AuditReport
+	switch {
+	case rpcID == nil:
+		reports, err = auditor.AuditAll(ctxc, false)
+		if err != nil {
+			return err
+		}
+
+	default:
+		uniID, err := tap.UnmarshalUniID(rpcID)
+		if err != nil {
+			return err
+		}
+
+		report, err := auditor.Audit(ctxc, uniID, false)
+		if err != nil {
+			return err
+		}
+		reports = append(reports, report)
+	}
+
+	jsonReports := make([]auditReport, 0, len(reports))
+	var numUnhealthy int
+	for _, report := range reports {
+		if !report.Healthy() {
+			numUnhealthy++
+		}
+
+		jsonReports = append(jsonReports, newAuditReport(report))
+	}
+
+	printJSON(struct {
+		Universes []auditReport `json:"universes"`
+	}{
+		Universes: jsonReports,
+	})
+
+	if numUnhealthy > 0 {
+		return fmt.Errorf("%d of %d universes failed the audit",
+			numUnhealthy, len(reports))
+	}
+
+	return nil
+}
+
+const (
+	snapshotFileName = "snapshot_file"
+	signerKeyName    = "signer_key"
+	signatureName    = "signature"
+)
+
+var universeSnapshotCommand = cli.Command{
+	Name:  "snapshot",
+	Usage: "create and sign universe snapshots",
+	Description: `
+	Create and sign snapshots of single universes. A snapshot contains the
+	root and all leaves of a universe at the current block height. Nodes
+	that trust the signers of a snapshot can import it on startup without
+	verifying every proof first, see the universe.snapshot-file option.
+	`,
+	Subcommands: []cli.Command{
+		universeSnapshotCreateCommand,
+		universeSnapshotAddSigCommand,
+	},
+}
+
+var universeSnapshotCreateCommand = cli.Command{
+	Name:  "create",
+	Usage: "create an unsigned snapshot of a universe",
+	Description: `
+	Create an unsigned snapshot of the universe with the given asset ID or
+	group key and write it to the given file. The printed signature digest
+	needs to be signed by the snapshot signers with a BIP-340 Schnorr
+	signature, which is then added with the addsig command.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the universe to snapshot",
+		},
+		cli.StringFlag{
+			Name:  groupKeyName,
+			Usage: "the group key of the universe to snapshot",
+		},
+		cli.StringFlag{
+			Name: proofTypeName,
+			Usage: "the type of proof of the universe to " +
+				"snapshot, either 'issuance' or 'transfer'",
+			Value: universe.ProofTypeIssuance.String(),
+		},
+		cli.StringFlag{
+			Name:     snapshotFileName,
+			Usage:    "the file to write the snapshot to",
+			Required: true,
+		},
+	},
+	Action: universeSnapshotCreate,
+}
+
+// snapshotInfo is the JSON representation of a universe snapshot.
+type snapshotInfo struct {
+	UniverseID string   `json:"universe_id"`
+	Height     uint32   `json:"height"`
+	RootHash   string   `json:"root_hash"`
+	RootSum    uint64   `json:"root_sum"`
+	NumLeaves  int      `json:"num_leaves"`
+	SigDigest  string   `json:"sig_digest"`
+	Signers    []string `json:"signers"`
+}
+
+// newSnapshotInfo converts the given universe snapshot into its JSON
+// representation.
+func newSnapshotInfo(snapshot *universe.Snapshot) snapshotInfo {
+	sigDigest := snapshot.SigDigest()
+	info := snapshotInfo{
+		UniverseID: snapshot.ID.String(),
+		Height:     snapshot.Height,
+		RootHash:   snapshot.Root.NodeHash().String(),
+		RootSum:    snapshot.Root.NodeSum(),
+		NumLeaves:  len(snapshot.Leaves),
+		SigDigest:  hex.EncodeToString(sigDigest[:]),
+		Signers:    []string{},
+	}
+	for _, sig := range snapshot.Sigs {
+		info.Signers = append(info.Signers, hex.EncodeToString(
+			schnorr.SerializePubKey(sig.SignerKey),
+		))
+	}
+
+	return info
+}
+
+// writeSnapshotFile encodes the given snapshot and writes it to the given
+// file.
+func writeSnapshotFile(snapshotFile string,
+	snapshot *universe.Snapshot) error {
+
+	var buf bytes.Buffer
+	if err := snapshot.Encode(&buf); err != nil {
+		return fmt.Errorf("unable to encode snapshot: %w", err)
+	}
+
+	return os.WriteFile(
+		lncfg.CleanAndExpandPath(snapshotFile), buf.Bytes(), 0644,
+	)
+}
+
+func universeSnapshotCreate(ctx *cli.Context) error {
+	ctxc := getContext()
+	uniClient, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	client, cleanUpClient := getClient(ctx)
+	defer cleanUpClient()
+
+	rpcID, err := parseUniverseID(ctx, true)
+	if err != nil {
+		return err
+	}
+	uniID, err := tap.UnmarshalUniID(rpcID)
+	if err != nil {
+		return err
+	}
+
+	info, err := client.GetInfo(ctxc, &taprpc.GetInfoRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to fetch block height: %w", err)
+	}
+
+	snapshot, err := universe.NewSnapshot(
+		ctxc, tap.NewRpcUniverseDiffFromClient(uniClient), uniID,
+		info.BlockHeight,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to create snapshot: %w", err)
+	}
+
+	err = writeSnapshotFile(ctx.String(snapshotFileName), snapshot)
+	if err != nil {
+		return err
+	}
+
+	printJSON(newSnapshotInfo(snapshot))
+
+	return nil
+}
+
+var universeSnapshotAddSigCommand = cli.Command{
+	Name:  "addsig",
+	Usage: "add a signature to a universe snapshot",
+	Description: `
+	Add the BIP-340 Schnorr signature of a snapshot signer over the
+	signature digest of the given snapshot. The signature is verified
+	before it's added, and any existing signature of the same signer is
+	replaced.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:     snapshotFileName,
+			Usage:    "the snapshot file to add the signature to",
+			Required: true,
+		},
+		cli.StringFlag{
+			Name: signerKeyName,
+			Usage: "the hex encoded x-only public key of the " +
+				"signer",
+			Required: true,
+		},
+		cli.StringFlag{
+			Name:     signatureName,
+			Usage:    "the hex encoded 64-byte Schnorr signature",
+			Required: true,
+		},
+	},
+	Action: universeSnapshotAddSig,
+}
+
+func universeSnapshotAddSig(ctx *cli.Context) error {
+	snapshotFile := lncfg.CleanAndExpandPath(ctx.String(snapshotFileName))
+	snapshotBytes, err := os.ReadFile(snapshotFile)
+	if err != nil {
+		return fmt.Errorf("unable to read snapshot: %w", err)
+	}
+
+	var snapshot universe.Snapshot
+	err = snapshot.Decode(bytes.NewReader(snapshotBytes))
+	if err != nil {
+		return fmt.Errorf("unable to decode snapshot: %w", err)
+	}
+
+	signerKeyBytes, err := hex.DecodeString(ctx.String(signerKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid signer key: %w", err)
+	}
+	signerKey, err := schnorr.ParsePubKey(signerKeyBytes)
+	if err != nil {
+		return fmt.Errorf("invalid signer key: %w", err)
+	}
+
+	sigBytes, err := hex.DecodeString(ctx.String(signatureName))
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+	sig, err := schnorr.ParseSignature(sigBytes)
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+
+	if err := snapshot.AddSig(signerKey, sig); err != nil {
+		return err
+	}
+
+	if err := writeSnapshotFile(snapshotFile, &snapshot); err != nil {
+		return err
+	}
+
+	printJSON(newSnapshotInfo(&snapshot))
+
+	return nil
+}
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	ossignal "os/signal"
 	"runtime/pprof"
+	"syscall"
 
 	"github.com/jessevdk/go-flags"
 	"github.com/lightninglabs/taproot-assets/fn"
@@ -75,6 +77,23 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Reload the part of the config that can be changed at runtime
+	// whenever we receive SIGHUP.
+	reloadSignals := make(chan os.Signal, 1)
+	ossignal.Notify(reloadSignals, syscall.SIGHUP)
+	defer ossignal.Stop(reloadSignals)
+	go func() {
+		for range reloadSignals {
+			cfgLogger.Infof("Received SIGHUP, reloading config")
+
+			err := tapcfg.ReloadConfig(cfg, server)
+			if err != nil {
+				cfgLogger.Errorf("Unable to reload config: %v",
+					err)
+			}
+		}
+	}()
+
 	err = server.RunUntilShutdown(errQueue.ChanOut())
 	if err != nil {
 		_, _ = fmt.Fprintln(os.Stderr, err)
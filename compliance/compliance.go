@@ -0,0 +1,261 @@
+package compliance
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/asset"
+)
+
+const (
+	// DefaultTimeout is the default time we wait for a verdict of the
+	// policy service.
+	DefaultTimeout = 10 * time.Second
+)
+
+var (
+	// ErrTransferRejected is returned if a transfer was rejected by the
+	// policy service, or if the policy service couldn't be reached and the
+	// screening is configured to fail closed.
+	ErrTransferRejected = errors.New("transfer rejected by compliance " +
+		"policy")
+)
+
+// Direction is the direction of a screened transfer.
+type Direction string
+
+const (
+	// DirectionSend is used for outbound transfers.
+	DirectionSend Direction = "send"
+
+	// DirectionReceive is used for inbound transfers.
+	DirectionReceive Direction = "receive"
+)
+
+// FailMode determines what happens with a transfer if the policy service
+// can't be reached or returns an invalid response.
+type FailMode uint8
+
+const (
+	// FailOpen allows transfers if no verdict can be obtained.
+	FailOpen FailMode = 0
+
+	// FailClosed rejects transfers if no verdict can be obtained.
+	FailClosed FailMode = 1
+)
+
+// String returns the string representation of the fail mode.
+func (f FailMode) String() string {
+	switch f {
+	case FailOpen:
+		return "open"
+
+	case FailClosed:
+		return "closed"
+
+	default:
+		return fmt.Sprintf("<unknown %d>", f)
+	}
+}
+
+// ParseFailMode parses a fail mode from its string representation.
+func ParseFailMode(s string) (FailMode, error) {
+	switch strings.ToLower(s) {
+	case "", "open":
+		return FailOpen, nil
+
+	case "closed":
+		return FailClosed, nil
+
+	default:
+		return 0, fmt.Errorf("unknown compliance fail mode: %v", s)
+	}
+}
+
+// TransferAsset describes a single asset that is part of a screened transfer.
+type TransferAsset struct {
+	// AssetID is the hex encoded ID of the asset.
+	AssetID string `json:"asset_id"`
+
+	// GroupKey is the hex encoded group key of the asset, if it is part of
+	// a group.
+	GroupKey string `json:"group_key,omitempty"`
+
+	// Amount is the amount of units of the asset.
+	Amount uint64 `json:"amount"`
+
+	// ScriptKey is the hex encoded x-only script key the asset is locked
+	// to.
+	ScriptKey string `json:"script_key"`
+
+	// Address is the Taproot Asset address the asset is sent to or was
+	// received on, if known.
+	Address string `json:"address,omitempty"`
+
+	// AnchorOutputIndex is the index of the output in the anchor
+	// transaction that carries the asset.
+	AnchorOutputIndex uint32 `json:"anchor_output_index"`
+
+	// GenesisOutpoint is the first outpoint spent by the genesis
+	// transaction of the asset, which uniquely identifies its origin.
+	GenesisOutpoint string `json:"genesis_outpoint"`
+
+	// PrevOutpoints are the anchor outpoints of the assets that were spent
+	// to create this asset.
+	PrevOutpoints []string `json:"prev_outpoints,omitempty"`
+
+	// ProofChainLength is the number of state transitions in the proof
+	// chain of the asset, if known.
+	ProofChainLength uint32 `json:"proof_chain_length,omitempty"`
+
+	// Local is true if the asset is sent to a key of our own wallet, for
+	// example as change.
+	Local bool `json:"local"`
+
+	// Burn is true if the asset is burned.
+	Burn bool `json:"burn,omitempty"`
+}
+
+// NewTransferAsset creates the screening representation of the given asset.
+func NewTransferAsset(a *asset.Asset) TransferAsset {
+	var groupKey string
+	if a.GroupKey != nil {
+		groupKey = hex.EncodeToString(
+			a.GroupKey.GroupPubKey.SerializeCompressed(),
+		)
+	}
+
+	var scriptKey string
+	if a.ScriptKey.PubKey != nil {
+		scriptKey = hex.EncodeToString(
+			schnorr.SerializePubKey(a.ScriptKey.PubKey),
+		)
+	}
+
+	var prevOutpoints []string
+	for _, witness := range a.PrevWitnesses {
+		if witness.PrevID == nil {
+			continue
+		}
+
+		prevOutpoints = append(
+			prevOutpoints, witness.PrevID.OutPoint.String(),
+		)
+	}
+
+	return TransferAsset{
+		AssetID:         a.ID().String(),
+		GroupKey:        groupKey,
+		Amount:          a.Amount,
+		ScriptKey:       scriptKey,
+		GenesisOutpoint: a.Genesis.FirstPrevOut.String(),
+		PrevOutpoints:   prevOutpoints,
+		Burn:            a.IsBurn(),
+	}
+}
+
+// Transfer is the context of a transfer that is sent to the policy service
+// for screening.
+type Transfer struct {
+	// Direction is the direction of the transfer.
+	Direction Direction `json:"direction"`
+
+	// AnchorTxid is the hex encoded ID of the anchor transaction.
+	AnchorTxid string `json:"anchor_txid"`
+
+	// Assets are the assets that are transferred.
+	Assets []TransferAsset `json:"assets"`
+}
+
+// Verdict is the decision of the policy service about a transfer.
+type Verdict struct {
+	// Allow is true if the transfer may proceed.
+	Allow bool `json:"allow"`
+
+	// Reason is an optional human readable explanation of the verdict.
+	Reason string `json:"reason,omitempty"`
+
+	// Annotation is an optional note about the transfer that is logged,
+	// for example a case ID of the policy service.
+	Annotation string `json:"annotation,omitempty"`
+}
+
+// Screener is an external policy service that decides whether a transfer is
+// allowed to proceed.
+type Screener interface {
+	// Screen returns the verdict of the policy service for the given
+	// transfer.
+	Screen(ctx context.Context, transfer *Transfer) (*Verdict, error)
+}
+
+// Config is the configuration of a compliance checker.
+type Config struct {
+	// Screener is the policy service transfers are screened with.
+	Screener Screener
+
+	// FailMode determines what happens if no verdict can be obtained.
+	FailMode FailMode
+
+	// Timeout is the maximum time we wait for a verdict.
+	Timeout time.Duration
+}
+
+// Checker screens transfers with an external policy service. A nil Checker
+// allows all transfers, so callers don't need to check whether screening is
+// enabled.
+type Checker struct {
+	cfg *Config
+}
+
+// NewChecker creates a new compliance checker.
+func NewChecker(cfg *Config) *Checker {
+	return &Checker{
+		cfg: cfg,
+	}
+}
+
+// Check screens the given transfer and returns an error wrapping
+// ErrTransferRejected if it must not proceed.
+func (c *Checker) Check(ctx context.Context, transfer *Transfer) error {
+	if c == nil || c.cfg.Screener == nil {
+		return nil
+	}
+
+	ctxt, cancel := context.WithTimeout(ctx, c.cfg.Timeout)
+	defer cancel()
+
+	verdict, err := c.cfg.Screener.Screen(ctxt, transfer)
+	if err != nil {
+		if c.cfg.FailMode == FailClosed {
+			return fmt.Errorf("%w: unable to obtain verdict: %v",
+				ErrTransferRejected, err)
+		}
+
+		log.Warnf("Unable to screen %s transfer %v, allowing it as "+
+			"screening fails open: %v", transfer.Direction,
+			transfer.AnchorTxid, err)
+
+		return nil
+	}
+
+	if verdict.Annotation != "" {
+		log.Infof("Compliance annotation for %s transfer %v: %s",
+			transfer.Direction, transfer.AnchorTxid,
+			verdict.Annotation)
+	}
+
+	if !verdict.Allow {
+		log.Warnf("Compliance policy rejected %s transfer %v: %s",
+			transfer.Direction, transfer.AnchorTxid, verdict.Reason)
+
+		return fmt.Errorf("%w: %s", ErrTransferRejected,
+			verdict.Reason)
+	}
+
+	return nil
+}
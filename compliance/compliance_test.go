@@ -0,0 +1,153 @@
+package compliance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockScreener is a Screener that returns a fixed verdict or error.
+type mockScreener struct {
+	verdict *Verdict
+	err     error
+
+	transfers []*Transfer
+}
+
+// Screen returns the verdict of the policy service for the given transfer.
+func (m *mockScreener) Screen(_ context.Context,
+	transfer *Transfer) (*Verdict, error) {
+
+	m.transfers = append(m.transfers, transfer)
+
+	return m.verdict, m.err
+}
+
+// TestChecker tests that the checker enforces the verdict of the policy
+// service and respects the configured fail mode.
+func TestChecker(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	transfer := &Transfer{
+		Direction:  DirectionSend,
+		AnchorTxid: "00",
+	}
+
+	// A nil checker allows everything.
+	var nilChecker *Checker
+	require.NoError(t, nilChecker.Check(ctx, transfer))
+
+	errUnreachable := errors.New("unreachable")
+	testCases := []struct {
+		name     string
+		screener *mockScreener
+		failMode FailMode
+		rejected bool
+	}{{
+		name: "allowed",
+		screener: &mockScreener{
+			verdict: &Verdict{Allow: true, Annotation: "case 1"},
+		},
+	}, {
+		name: "rejected",
+		screener: &mockScreener{
+			verdict: &Verdict{Allow: false, Reason: "sanctioned"},
+		},
+		rejected: true,
+	}, {
+		name:     "fail open",
+		screener: &mockScreener{err: errUnreachable},
+		failMode: FailOpen,
+	}, {
+		name:     "fail closed",
+		screener: &mockScreener{err: errUnreachable},
+		failMode: FailClosed,
+		rejected: true,
+	}}
+
+	for _, tc := range testCases {
+		checker := NewChecker(&Config{
+			Screener: tc.screener,
+			FailMode: tc.failMode,
+			Timeout:  time.Second,
+		})
+
+		err := checker.Check(ctx, transfer)
+		if tc.rejected {
+			require.ErrorIs(t, err, ErrTransferRejected, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+		require.Equal(
+			t, []*Transfer{transfer}, tc.screener.transfers,
+			tc.name,
+		)
+	}
+}
+
+// TestHTTPScreener tests that the HTTP screener sends the transfer context to
+// the policy service and parses its verdict.
+func TestHTTPScreener(t *testing.T) {
+	t.Parallel()
+
+	var received Transfer
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("X-Api-Key") != "s3cr3t" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			err := json.NewDecoder(r.Body).Decode(&received)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			_ = json.NewEncoder(w).Encode(&Verdict{
+				Allow:  false,
+				Reason: "blocked",
+			})
+		},
+	))
+	defer server.Close()
+
+	_, err := NewHTTPScreener("", "", server.Client())
+	require.Error(t, err)
+
+	_, err = NewHTTPScreener(server.URL, "X-Api-Key", server.Client())
+	require.Error(t, err)
+
+	transfer := &Transfer{
+		Direction:  DirectionReceive,
+		AnchorTxid: "01",
+		Assets: []TransferAsset{{
+			AssetID: "02",
+			Amount:  42,
+		}},
+	}
+
+	// Without the auth header, the request fails.
+	screener, err := NewHTTPScreener(server.URL, "", server.Client())
+	require.NoError(t, err)
+
+	_, err = screener.Screen(context.Background(), transfer)
+	require.ErrorContains(t, err, "unexpected status code: 401")
+
+	screener, err = NewHTTPScreener(
+		server.URL, "X-Api-Key: s3cr3t", server.Client(),
+	)
+	require.NoError(t, err)
+
+	verdict, err := screener.Screen(context.Background(), transfer)
+	require.NoError(t, err)
+	require.Equal(t, &Verdict{Allow: false, Reason: "blocked"}, verdict)
+	require.Equal(t, *transfer, received)
+}
@@ -0,0 +1,104 @@
+package compliance
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	// maxVerdictSize is the maximum size of a verdict response we accept.
+	maxVerdictSize = 64 * 1024
+)
+
+// HTTPScreener is a Screener that POSTs the transfer context as JSON to an
+// external policy service and expects a JSON encoded Verdict in the response.
+type HTTPScreener struct {
+	url string
+
+	authHeaderName  string
+	authHeaderValue string
+
+	client *http.Client
+}
+
+// NewHTTPScreener creates a new HTTP policy service client. The auth header is
+// optional and must have the format "<name>: <value>" if set.
+func NewHTTPScreener(url, authHeader string,
+	client *http.Client) (*HTTPScreener, error) {
+
+	if url == "" {
+		return nil, fmt.Errorf("compliance service url is required")
+	}
+
+	s := &HTTPScreener{
+		url:    url,
+		client: client,
+	}
+
+	if authHeader != "" {
+		name, value, ok := strings.Cut(authHeader, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid compliance auth " +
+				"header, expected name:value")
+		}
+
+		s.authHeaderName = strings.TrimSpace(name)
+		s.authHeaderValue = strings.TrimSpace(value)
+	}
+
+	return s, nil
+}
+
+// Screen returns the verdict of the policy service for the given transfer.
+//
+// NOTE: This is part of the Screener interface.
+func (s *HTTPScreener) Screen(ctx context.Context,
+	transfer *Transfer) (*Verdict, error) {
+
+	payload, err := json.Marshal(transfer)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode transfer: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, s.url, bytes.NewReader(payload),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if s.authHeaderName != "" {
+		req.Header.Set(s.authHeaderName, s.authHeaderValue)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d",
+			resp.StatusCode)
+	}
+
+	var verdict Verdict
+	err = json.NewDecoder(
+		io.LimitReader(resp.Body, maxVerdictSize),
+	).Decode(&verdict)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode verdict: %w", err)
+	}
+
+	return &verdict, nil
+}
+
+// A compile-time assertion to make sure HTTPScreener satisfies the Screener
+// interface.
+var _ Screener = (*HTTPScreener)(nil)
@@ -8,17 +8,22 @@ import (
 
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/invoice"
 	"github.com/lightninglabs/taproot-assets/monitoring"
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/rfq"
+	"github.com/lightninglabs/taproot-assets/rpccompress"
 	"github.com/lightninglabs/taproot-assets/tapchannel"
 	"github.com/lightninglabs/taproot-assets/tapdb"
 	"github.com/lightninglabs/taproot-assets/tapfreighter"
 	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/tapmusig"
 	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightninglabs/taproot-assets/webhook"
 	"github.com/lightningnetwork/lnd"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/signal"
+	"github.com/lightningnetwork/lnd/tor"
 	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
 )
@@ -52,6 +57,8 @@ type RPCConfig struct {
 
 	AllowPublicStats bool
 
+	MaxProofFileSize uint64
+
 	LetsEncryptDir string
 
 	LetsEncryptListen string
@@ -72,9 +79,21 @@ type DatabaseConfig struct {
 
 	TapAddrBook *tapdb.TapAddressBook
 
-	Multiverse *tapdb.MultiverseStore
+	Multiverse universe.MultiverseArchive
 
 	FederationDB *tapdb.UniverseFederationDB
+
+	// IdempotencyStore stores the responses of send and mint calls that
+	// were made with a caller-supplied idempotency key.
+	IdempotencyStore *tapdb.IdempotencyStore
+
+	// Health reports the schema version and the integrity of the
+	// database.
+	Health *tapdb.DatabaseHealth
+
+	// ReadSnapshots manages the read snapshots callers can use to query
+	// a consistent state of the database across multiple calls.
+	ReadSnapshots *tapdb.ReadSnapshots
 }
 
 // UniversePublicAccessStatus is a type that indicates the status of public
@@ -173,26 +192,93 @@ type Config struct {
 	// creating an address.
 	AddrBookDisableSyncer bool
 
+	// ContactBook is the persisted address book of outbound destinations,
+	// which allows sending to recurring counterparties by label.
+	ContactBook address.ContactBook
+
+	// TickerResolver resolves asset tickers, so a send can be checked
+	// against the ticker of the asset the caller expects to send.
+	TickerResolver *tapfreighter.TickerResolver
+
 	DefaultProofCourierAddr *url.URL
 
+	// ProofCourierHealth tracks the health of the configured proof courier
+	// endpoints.
+	ProofCourierHealth *proof.CourierHealth
+
 	ProofArchive proof.Archiver
 
+	// ProofImporter is used to import large sets of proof files
+	// asynchronously.
+	ProofImporter *proof.BulkImporter
+
 	AssetWallet tapfreighter.Wallet
 
 	CoinSelect *tapfreighter.CoinSelect
 
+	// CoinSelectionLog stores the metrics of each asset coin selection.
+	CoinSelectionLog tapfreighter.CoinSelectionLog
+
+	// SendQuotaLog tracks the cumulative amounts sent to each destination
+	// and enforces per-destination send limits.
+	SendQuotaLog tapfreighter.SendQuotaLog
+
 	ChainPorter tapfreighter.Porter
 
+	// Webhooks delivers asset transfer events to the configured webhook
+	// endpoints.
+	Webhooks *webhook.Dispatcher
+
+	// SpendWatcher is the optional watcher that raises alerts for anchor
+	// outputs that were spent by a third party.
+	SpendWatcher *tapfreighter.SpendWatcher
+
+	// InvoiceManager creates asset invoices and tracks their payment
+	// state.
+	InvoiceManager *invoice.Manager
+
+	// MuSig2Sessions drives the MuSig2 signing sessions for asset script
+	// keys that are shared with other signers.
+	MuSig2Sessions *tapmusig.Manager
+
 	UniverseArchive *universe.Archive
 
-	UniverseSyncer universe.Syncer
+	UniverseSyncer universe.StreamingSyncer
 
 	UniverseFederation *universe.FederationEnvoy
 
+	// UniverseAuditor periodically checks the consistency of the local
+	// universe trees.
+	UniverseAuditor *universe.Auditor
+
+	// UniverseRootEvents notifies subscribers about changes to the roots
+	// of the local universes.
+	UniverseRootEvents *universe.RootEventNotifier
+
+	// UniverseSnapshots imports signed universe snapshots and verifies
+	// them in the background.
+	UniverseSnapshots *universe.SnapshotImporter
+
+	// UniverseDialNet is used to dial remote universe servers. If this is
+	// nil, universe servers are dialed directly, so onion services can't
+	// be reached.
+	UniverseDialNet tor.Net
+
+	// UniverseResponseSigner is used to sign the responses of universe
+	// proof and leaf key queries with the server's identity key. If this is
+	// nil, responses aren't signed.
+	UniverseResponseSigner universe.ResponseSigner
+
 	// UniFedSyncAllAssets is a flag that indicates whether the
 	// universe federation syncer should default to syncing all assets.
 	UniFedSyncAllAssets bool
 
+	// UniverseCheckInvariants is a flag that indicates whether the
+	// multiverse trees should be checked for consistency with their
+	// universes on startup. If any inconsistency is found, the server
+	// refuses to start.
+	UniverseCheckInvariants bool
+
 	RfqManager *rfq.Manager
 
 	UniverseStats universe.Telemetry
@@ -227,12 +313,36 @@ type Config struct {
 	// limiting.
 	UniverseQueriesBurst int
 
+	// UniverseClientQPS is the rate in queries per second at which the
+	// query budget of each individual universe client is refilled. If this
+	// is zero, clients aren't rate limited individually.
+	UniverseClientQPS rate.Limit
+
+	// UniverseClientBurst is the query budget of each individual universe
+	// client.
+	UniverseClientBurst int
+
+	// UniverseMethodCosts is the number of budget units a call to each
+	// universe RPC method costs a client. If nil, the default costs are
+	// used.
+	UniverseMethodCosts map[string]int
+
+	// UniverseCompression is the ordered list of compressors the universe
+	// RPC server negotiates with its clients to compress its responses. If
+	// empty, responses aren't compressed.
+	UniverseCompression rpccompress.Preferences
+
 	Prometheus monitoring.PrometheusConfig
 
 	// LogWriter is the root logger that all of the daemon's subloggers are
 	// hooked up to.
 	LogWriter *build.RotatingLogWriter
 
+	// ShutdownFuncs release resources that were acquired while creating
+	// the config, like connections to other daemons. They are called in
+	// reverse order once the server is stopped.
+	ShutdownFuncs []func() error
+
 	*RPCConfig
 
 	*DatabaseConfig
@@ -0,0 +1,118 @@
+package taprootassets
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/proof"
+	"golang.org/x/time/rate"
+)
+
+// ReloadableConfig is the part of the configuration that can be changed while
+// the daemon is running, without restarting it.
+type ReloadableConfig struct {
+	// FederationServers are the static universe federation members. Newly
+	// added servers join the federation, servers that were removed from
+	// the list stay part of the federation until they're removed over RPC.
+	FederationServers []string
+
+	// UniverseSyncInterval is the default interval at which the local
+	// universe is synced with the federation.
+	UniverseSyncInterval time.Duration
+
+	// UniverseQueriesPerSecond is the maximum number of queries per
+	// second across the set of active universe queries that is permitted.
+	UniverseQueriesPerSecond rate.Limit
+
+	// UniverseQueriesBurst is the burst budget for the universe query rate
+	// limiting.
+	UniverseQueriesBurst int
+
+	// UniverseClientQPS is the rate in queries per second at which the
+	// query budget of each individual universe client is refilled. If this
+	// is zero, clients aren't rate limited individually.
+	UniverseClientQPS rate.Limit
+
+	// UniverseClientBurst is the query budget of each individual universe
+	// client.
+	UniverseClientBurst int
+
+	// UniverseMethodCosts is the number of budget units a call to each
+	// universe RPC method costs a client. If nil, the default costs are
+	// used.
+	UniverseMethodCosts map[string]int
+
+	// DefaultProofCourierAddr is the proof courier address used for new
+	// addresses that don't specify one.
+	DefaultProofCourierAddr *url.URL
+}
+
+// Validate makes sure the reloadable config can be applied.
+func (c *ReloadableConfig) Validate() error {
+	switch {
+	case c.UniverseSyncInterval <= 0:
+		return fmt.Errorf("universe sync interval must be positive")
+
+	case c.UniverseQueriesPerSecond < 0 || c.UniverseQueriesBurst < 0:
+		return fmt.Errorf("universe rate limit must not be negative")
+
+	case c.UniverseClientQPS < 0 || c.UniverseClientBurst < 0:
+		return fmt.Errorf("universe client rate limit must not be " +
+			"negative")
+
+	case c.DefaultProofCourierAddr == nil:
+		return fmt.Errorf("missing default proof courier address")
+	}
+
+	err := proof.ValidateCourierAddress(c.DefaultProofCourierAddr)
+	if err != nil {
+		return fmt.Errorf("invalid default proof courier address: %w",
+			err)
+	}
+
+	return nil
+}
+
+// ReloadConfig validates the given config and then applies it to the running
+// subsystems. Nothing is changed if the config is invalid. The server must be
+// ready before its config can be reloaded.
+func (s *Server) ReloadConfig(cfg *ReloadableConfig) error {
+	if err := cfg.Validate(); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+
+	select {
+	case <-s.ready:
+	default:
+		return fmt.Errorf("server not ready")
+	}
+
+	s.reloadMtx.Lock()
+	defer s.reloadMtx.Unlock()
+
+	srvrLog.Infof("Reloading config")
+
+	federation := s.cfg.UniverseFederation
+	err := federation.SetSyncInterval(cfg.UniverseSyncInterval)
+	if err != nil {
+		return fmt.Errorf("unable to set universe sync interval: %w",
+			err)
+	}
+
+	s.rpcServer.universeRateLimiter.updateLimits(
+		cfg.UniverseQueriesPerSecond, cfg.UniverseQueriesBurst,
+		cfg.UniverseClientQPS, cfg.UniverseClientBurst,
+		cfg.UniverseMethodCosts,
+	)
+
+	s.rpcServer.defaultCourierAddr.Store(cfg.DefaultProofCourierAddr)
+
+	// Checking and adding new federation servers requires connecting to
+	// them, so we do this last.
+	federation.AddStaticMembers(cfg.FederationServers)
+
+	srvrLog.Infof("Config reloaded")
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package invoice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/address"
+)
+
+const (
+	// DefaultExpiry is the default time an invoice can be paid for.
+	DefaultExpiry = 24 * time.Hour
+
+	// MaxMemoLength is the maximum length of an invoice memo in bytes.
+	MaxMemoLength = 1024
+)
+
+var (
+	// ErrInvoiceNotFound is returned if an invoice can't be found.
+	ErrInvoiceNotFound = errors.New("invoice not found")
+
+	// ErrMemoTooLong is returned if the memo of a new invoice exceeds
+	// MaxMemoLength.
+	ErrMemoTooLong = fmt.Errorf("invoice memo exceeds maximum length of "+
+		"%d bytes", MaxMemoLength)
+)
+
+// State is the payment state of an invoice.
+type State uint8
+
+const (
+	// StateOpen denotes an invoice that wasn't paid yet and didn't expire.
+	StateOpen State = 0
+
+	// StatePaid denotes an invoice that received exactly the invoice
+	// amount.
+	StatePaid State = 1
+
+	// StateOverpaid denotes an invoice that received more than the
+	// invoice amount, for example because its address was paid twice.
+	StateOverpaid State = 2
+
+	// StateUnderpaid denotes an invoice that received less than the
+	// invoice amount.
+	StateUnderpaid State = 3
+
+	// StateExpired denotes an invoice that expired before receiving any
+	// payment.
+	StateExpired State = 4
+)
+
+// String returns a human-readable representation of the state.
+func (s State) String() string {
+	switch s {
+	case StateOpen:
+		return "open"
+
+	case StatePaid:
+		return "paid"
+
+	case StateOverpaid:
+		return "overpaid"
+
+	case StateUnderpaid:
+		return "underpaid"
+
+	case StateExpired:
+		return "expired"
+
+	default:
+		return fmt.Sprintf("<unknown %d>", s)
+	}
+}
+
+// resolveState returns the state of an invoice over the given amount that
+// received the given amount at the given time. Payments that arrive after the
+// expiry are still taken into account, as the assets were received anyway.
+func resolveState(amount, received uint64, expiry, now time.Time) State {
+	switch {
+	case received == 0 && !now.Before(expiry):
+		return StateExpired
+
+	case received == 0:
+		return StateOpen
+
+	case received < amount:
+		return StateUnderpaid
+
+	case received == amount:
+		return StatePaid
+
+	default:
+		return StateOverpaid
+	}
+}
+
+// Invoice is a payment request for a specific amount of an asset. It binds the
+// amount, an expiry and a memo to a unique address that is only used for this
+// invoice.
+type Invoice struct {
+	// ID is the database primary key of the invoice.
+	ID int64
+
+	// Addr is the unique address the invoice is paid to. The amount of
+	// the address is the invoice amount.
+	Addr *address.AddrWithKeyInfo
+
+	// Memo is an optional description of the invoice.
+	Memo string
+
+	// CreationTime is the time the invoice was created.
+	CreationTime time.Time
+
+	// Expiry is the time after which the invoice is considered expired if
+	// it didn't receive any payment.
+	Expiry time.Time
+
+	// State is the current payment state of the invoice.
+	State State
+
+	// AmtReceived is the total amount of asset units received on the
+	// invoice address so far.
+	AmtReceived uint64
+
+	// StateTime is the time of the last state change of the invoice.
+	StateTime time.Time
+}
+
+// Amount returns the amount of asset units requested by the invoice.
+func (i *Invoice) Amount() uint64 {
+	return i.Addr.Amount
+}
+
+// Copy returns a copy of the invoice, so it can be handed to subscribers.
+func (i *Invoice) Copy() *Invoice {
+	invoiceCopy := *i
+	return &invoiceCopy
+}
+
+// Timestamp returns the time of the last state change of the invoice.
+//
+// NOTE: This is part of the fn.Event interface.
+func (i *Invoice) Timestamp() time.Time {
+	return i.StateTime
+}
+
+// QueryParams holds the set of query params for invoices.
+type QueryParams struct {
+	// State is the optional state to filter by. Must be set to nil to
+	// return invoices in all states.
+	State *State
+
+	// TaprootOutputKey is the optional taproot output key of the invoice
+	// address to filter by. Must be set to nil to return all invoices.
+	TaprootOutputKey *btcec.PublicKey
+}
+
+// Store is the interface that a component storing invoices should implement.
+type Store interface {
+	// InsertInvoice inserts a new invoice for an address that already
+	// exists in the address book and sets its ID.
+	InsertInvoice(ctx context.Context, invoice *Invoice) error
+
+	// QueryInvoices returns all invoices that match the given query.
+	QueryInvoices(ctx context.Context, params QueryParams) ([]*Invoice,
+		error)
+
+	// UpdateInvoiceState persists the state, the received amount and the
+	// state time of the given invoice.
+	UpdateInvoiceState(ctx context.Context, invoice *Invoice) error
+}
@@ -0,0 +1,457 @@
+package invoice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+const (
+	// DefaultExpiryCheckInterval is the default interval in which open
+	// invoices are checked for expiry.
+	DefaultExpiryCheckInterval = time.Minute
+
+	// defaultTimeout is the default timeout for database operations.
+	defaultTimeout = 30 * time.Second
+)
+
+// AddrBook is the part of the address book the invoice manager needs to create
+// invoice addresses and find the payments made to them.
+type AddrBook interface {
+	// NewAddress creates a new Taproot Asset address based on the input
+	// parameters.
+	NewAddress(ctx context.Context, addrVersion address.Version,
+		assetID asset.ID, amount uint64,
+		tapscriptSibling *commitment.TapscriptPreimage,
+		proofCourierAddr url.URL,
+		addrOpts ...address.NewAddrOpt) (*address.AddrWithKeyInfo,
+		error)
+
+	// QueryEvents returns all events that match the given query.
+	QueryEvents(ctx context.Context,
+		query address.EventQueryParams) ([]*address.Event, error)
+}
+
+// ManagerConfig is the configuration of the invoice manager.
+type ManagerConfig struct {
+	// Store is used to persist invoices.
+	Store Store
+
+	// AddrBook is used to create invoice addresses and to look up the
+	// payments made to them.
+	AddrBook AddrBook
+
+	// ReceiveEvents is the source of inbound transfer events.
+	ReceiveEvents fn.EventPublisher[fn.Event, time.Time]
+
+	// Clock is used to determine the expiry of invoices.
+	Clock clock.Clock
+
+	// ExpiryCheckInterval is the interval in which open invoices are
+	// checked for expiry.
+	ExpiryCheckInterval time.Duration
+
+	// ErrChan is the main error channel the manager will report back
+	// critical errors to the main server.
+	ErrChan chan<- error
+}
+
+// Request holds the parameters of a new invoice.
+type Request struct {
+	// AddrVersion is the version of the invoice address.
+	AddrVersion address.Version
+
+	// AssetID is the ID of the requested asset.
+	AssetID asset.ID
+
+	// Amount is the requested amount of asset units.
+	Amount uint64
+
+	// Memo is an optional description of the invoice.
+	Memo string
+
+	// Expiry is the time the invoice can be paid for. If zero,
+	// DefaultExpiry is used.
+	Expiry time.Duration
+
+	// ProofCourierAddr is the proof courier address used for the invoice
+	// address.
+	ProofCourierAddr url.URL
+}
+
+// Manager creates asset invoices and tracks their payment state. Every state
+// change is published to the subscribers of the manager.
+type Manager struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg *ManagerConfig
+
+	receiveSub *fn.EventReceiver[fn.Event]
+
+	// updateMtx serializes state updates of invoices, which can be
+	// triggered on startup and from the event loop.
+	updateMtx sync.Mutex
+
+	*fn.EventDistributor[*Invoice]
+
+	// ContextGuard provides a wait group and main quit channel that can be
+	// used to create guarded contexts.
+	*fn.ContextGuard
+}
+
+// NewManager creates a new invoice manager.
+func NewManager(cfg *ManagerConfig) *Manager {
+	return &Manager{
+		cfg: cfg,
+		receiveSub: fn.NewEventReceiver[fn.Event](
+			fn.DefaultQueueSize,
+		),
+		EventDistributor: fn.NewEventDistributor[*Invoice](),
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: defaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start subscribes to inbound transfer events, brings the state of all
+// unsettled invoices up to date and starts the main event loop.
+func (m *Manager) Start() error {
+	var startErr error
+	m.startOnce.Do(func() {
+		log.Info("Starting invoice manager")
+
+		err := m.cfg.ReceiveEvents.RegisterSubscriber(
+			m.receiveSub, false, time.Time{},
+		)
+		if err != nil {
+			startErr = fmt.Errorf("unable to subscribe to receive "+
+				"events: %w", err)
+			return
+		}
+
+		// Payments might have completed while we were offline, so we
+		// refresh all invoices that can still change their state.
+		ctx, cancel := m.WithCtxQuit()
+		defer cancel()
+
+		invoices, err := m.cfg.Store.QueryInvoices(ctx, QueryParams{})
+		if err != nil {
+			startErr = fmt.Errorf("unable to query invoices: %w",
+				err)
+			return
+		}
+
+		for _, invoice := range invoices {
+			if invoice.State == StateOverpaid {
+				continue
+			}
+
+			if err := m.refresh(ctx, invoice); err != nil {
+				startErr = err
+				return
+			}
+		}
+
+		m.Wg.Add(1)
+		go m.eventLoop()
+	})
+
+	return startErr
+}
+
+// Stop stops the invoice manager.
+func (m *Manager) Stop() error {
+	var stopErr error
+	m.stopOnce.Do(func() {
+		log.Info("Stopping invoice manager")
+
+		close(m.Quit)
+		m.Wg.Wait()
+
+		err := m.cfg.ReceiveEvents.RemoveSubscriber(m.receiveSub)
+		if err != nil {
+			stopErr = err
+		}
+	})
+
+	return stopErr
+}
+
+// NewInvoice creates a new invoice with a unique address for the requested
+// amount.
+func (m *Manager) NewInvoice(ctx context.Context,
+	req *Request) (*Invoice, error) {
+
+	if req.Amount == 0 {
+		return nil, fmt.Errorf("invoice amount must be positive")
+	}
+	if len(req.Memo) > MaxMemoLength {
+		return nil, ErrMemoTooLong
+	}
+
+	expiry := req.Expiry
+	if expiry == 0 {
+		expiry = DefaultExpiry
+	}
+	if expiry < 0 {
+		return nil, fmt.Errorf("invalid invoice expiry: %v", expiry)
+	}
+
+	addr, err := m.cfg.AddrBook.NewAddress(
+		ctx, req.AddrVersion, req.AssetID, req.Amount, nil,
+		req.ProofCourierAddr,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create invoice address: %w",
+			err)
+	}
+
+	now := m.cfg.Clock.Now().UTC()
+	invoice := &Invoice{
+		Addr:         addr,
+		Memo:         req.Memo,
+		CreationTime: now,
+		Expiry:       now.Add(expiry),
+		State:        StateOpen,
+		StateTime:    now,
+	}
+	if err := m.cfg.Store.InsertInvoice(ctx, invoice); err != nil {
+		return nil, fmt.Errorf("unable to store invoice: %w", err)
+	}
+
+	log.Infof("Created invoice %d over %d units of asset %v, expiring "+
+		"at %v", invoice.ID, req.Amount, req.AssetID,
+		invoice.Expiry)
+
+	m.NotifySubscribers(invoice.Copy())
+
+	return invoice, nil
+}
+
+// ListInvoices returns all invoices that match the given query.
+func (m *Manager) ListInvoices(ctx context.Context,
+	params QueryParams) ([]*Invoice, error) {
+
+	return m.cfg.Store.QueryInvoices(ctx, params)
+}
+
+// LookupInvoice returns the invoice that is paid to the address with the given
+// taproot output key.
+func (m *Manager) LookupInvoice(ctx context.Context,
+	taprootOutputKey *btcec.PublicKey) (*Invoice, error) {
+
+	invoices, err := m.cfg.Store.QueryInvoices(ctx, QueryParams{
+		TaprootOutputKey: taprootOutputKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(invoices) == 0 {
+		return nil, ErrInvoiceNotFound
+	}
+
+	return invoices[0], nil
+}
+
+// RegisterSubscriber adds a new subscriber for invoice updates. If
+// deliverExisting is true, all invoices that changed their state at or after
+// deliverFrom are delivered first.
+//
+// NOTE: This is part of the fn.EventPublisher interface.
+func (m *Manager) RegisterSubscriber(receiver *fn.EventReceiver[*Invoice],
+	deliverExisting bool, deliverFrom time.Time) error {
+
+	m.EventDistributor.RegisterSubscriber(receiver)
+
+	if !deliverExisting {
+		return nil
+	}
+
+	ctx, cancel := m.WithCtxQuit()
+	defer cancel()
+
+	invoices, err := m.cfg.Store.QueryInvoices(ctx, QueryParams{})
+	if err != nil {
+		return fmt.Errorf("unable to query invoices: %w", err)
+	}
+
+	for _, invoice := range invoices {
+		if invoice.StateTime.Before(deliverFrom) {
+			continue
+		}
+
+		receiver.NewItemCreated.ChanIn() <- invoice
+	}
+
+	return nil
+}
+
+// eventLoop updates invoices when payments to their addresses complete and
+// when they expire.
+func (m *Manager) eventLoop() {
+	defer m.Wg.Done()
+
+	interval := m.cfg.ExpiryCheckInterval
+	if interval == 0 {
+		interval = DefaultExpiryCheckInterval
+	}
+
+	for {
+		select {
+		case e := <-m.receiveSub.NewItemCreated.ChanOut():
+			event, ok := e.(*tapgarden.AssetReceiveEvent)
+			if !ok || event.Error != nil ||
+				event.Status != address.StatusCompleted {
+
+				continue
+			}
+
+			if err := m.handlePayment(&event.Address); err != nil {
+				m.reportErr(err)
+				return
+			}
+
+		case <-m.cfg.Clock.TickAfter(interval):
+			if err := m.expireInvoices(); err != nil {
+				m.reportErr(err)
+				return
+			}
+
+		case <-m.Quit:
+			return
+		}
+	}
+}
+
+// reportErr reports a critical error to the main server.
+func (m *Manager) reportErr(err error) {
+	log.Errorf("Invoice manager error: %v", err)
+
+	select {
+	case m.cfg.ErrChan <- err:
+	case <-m.Quit:
+	}
+}
+
+// handlePayment refreshes the invoice that belongs to the given address, if
+// there is one.
+func (m *Manager) handlePayment(addr *address.Tap) error {
+	ctx, cancel := m.WithCtxQuit()
+	defer cancel()
+
+	taprootOutputKey, err := addr.TaprootOutputKey()
+	if err != nil {
+		return fmt.Errorf("unable to derive taproot output key: %w",
+			err)
+	}
+
+	invoice, err := m.LookupInvoice(ctx, taprootOutputKey)
+	switch {
+	// Most addresses aren't invoice addresses.
+	case errors.Is(err, ErrInvoiceNotFound):
+		return nil
+
+	case err != nil:
+		return err
+	}
+
+	return m.refresh(ctx, invoice)
+}
+
+// expireInvoices updates the state of all open invoices that expired.
+func (m *Manager) expireInvoices() error {
+	ctx, cancel := m.WithCtxQuit()
+	defer cancel()
+
+	openState := StateOpen
+	invoices, err := m.cfg.Store.QueryInvoices(ctx, QueryParams{
+		State: &openState,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to query open invoices: %w", err)
+	}
+
+	now := m.cfg.Clock.Now()
+	for _, invoice := range invoices {
+		if now.Before(invoice.Expiry) {
+			continue
+		}
+
+		if err := m.refresh(ctx, invoice); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// refresh recomputes the state of the given invoice from the payments that
+// completed on its address. If the state changed, it is persisted and
+// published to all subscribers.
+func (m *Manager) refresh(ctx context.Context, invoice *Invoice) error {
+	m.updateMtx.Lock()
+	defer m.updateMtx.Unlock()
+
+	completed := address.StatusCompleted
+	events, err := m.cfg.AddrBook.QueryEvents(
+		ctx, address.EventQueryParams{
+			AddrTaprootOutputKey: schnorr.SerializePubKey(
+				&invoice.Addr.TaprootOutputKey,
+			),
+			StatusFrom: &completed,
+			StatusTo:   &completed,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to query payments of invoice %d: %w",
+			invoice.ID, err)
+	}
+
+	// Each payment to the invoice address carries exactly the amount the
+	// address commits to.
+	received := uint64(len(events)) * invoice.Amount()
+	now := m.cfg.Clock.Now().UTC()
+	state := resolveState(
+		invoice.Amount(), received, invoice.Expiry, now,
+	)
+
+	if state == invoice.State && received == invoice.AmtReceived {
+		return nil
+	}
+
+	log.Infof("Invoice %d changed state from %v to %v, received %d of "+
+		"%d units", invoice.ID, invoice.State, state, received,
+		invoice.Amount())
+
+	invoice.State = state
+	invoice.AmtReceived = received
+	invoice.StateTime = now
+
+	if err := m.cfg.Store.UpdateInvoiceState(ctx, invoice); err != nil {
+		return fmt.Errorf("unable to update invoice %d: %w",
+			invoice.ID, err)
+	}
+
+	m.NotifySubscribers(invoice.Copy())
+
+	return nil
+}
+
+// A compile-time assertion to make sure Manager satisfies the
+// fn.EventPublisher interface.
+var _ fn.EventPublisher[*Invoice, time.Time] = (*Manager)(nil)
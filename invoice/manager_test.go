@@ -0,0 +1,310 @@
+package invoice
+
+import (
+	"bytes"
+	"context"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+const testTimeout = 5 * time.Second
+
+// mockStore is an in-memory implementation of the Store interface.
+type mockStore struct {
+	sync.Mutex
+
+	invoices []*Invoice
+}
+
+func (m *mockStore) InsertInvoice(_ context.Context, invoice *Invoice) error {
+	m.Lock()
+	defer m.Unlock()
+
+	invoice.ID = int64(len(m.invoices) + 1)
+	m.invoices = append(m.invoices, invoice.Copy())
+
+	return nil
+}
+
+func (m *mockStore) QueryInvoices(_ context.Context,
+	params QueryParams) ([]*Invoice, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	var result []*Invoice
+	for _, invoice := range m.invoices {
+		if params.State != nil && *params.State != invoice.State {
+			continue
+		}
+
+		if params.TaprootOutputKey != nil &&
+			!params.TaprootOutputKey.IsEqual(
+				&invoice.Addr.TaprootOutputKey,
+			) {
+
+			continue
+		}
+
+		result = append(result, invoice.Copy())
+	}
+
+	return result, nil
+}
+
+func (m *mockStore) UpdateInvoiceState(_ context.Context,
+	invoice *Invoice) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.invoices[invoice.ID-1] = invoice.Copy()
+
+	return nil
+}
+
+// mockAddrBook creates random addresses and returns a configurable number of
+// completed events for each of them.
+type mockAddrBook struct {
+	sync.Mutex
+
+	t        *testing.T
+	payments map[string]int
+}
+
+func (m *mockAddrBook) NewAddress(_ context.Context, _ address.Version,
+	assetID asset.ID, amount uint64, _ *commitment.TapscriptPreimage,
+	proofCourierAddr url.URL,
+	_ ...address.NewAddrOpt) (*address.AddrWithKeyInfo, error) {
+
+	addr, assetGen, _ := address.RandAddr(
+		m.t, &address.RegressionNetTap, proofCourierAddr,
+	)
+	assetGen.Type = asset.Normal
+	addr.AttachGenesis(*assetGen)
+	addr.AssetID = assetID
+	addr.Amount = amount
+
+	// The output key commits to the asset ID and amount, so it needs to be
+	// derived again.
+	taprootOutputKey, err := addr.Tap.TaprootOutputKey()
+	if err != nil {
+		return nil, err
+	}
+	addr.TaprootOutputKey = *taprootOutputKey
+
+	return addr, nil
+}
+
+func (m *mockAddrBook) QueryEvents(_ context.Context,
+	query address.EventQueryParams) ([]*address.Event, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	numPayments := m.payments[string(query.AddrTaprootOutputKey)]
+
+	return make([]*address.Event, numPayments), nil
+}
+
+func (m *mockAddrBook) pay(addr *address.AddrWithKeyInfo) {
+	m.Lock()
+	defer m.Unlock()
+
+	key := schnorr.SerializePubKey(&addr.TaprootOutputKey)
+	m.payments[string(key)]++
+}
+
+// mockReceiveEvents is a publisher of receive events that can be triggered
+// manually.
+type mockReceiveEvents struct {
+	*fn.EventDistributor[fn.Event]
+}
+
+func (m *mockReceiveEvents) RegisterSubscriber(
+	receiver *fn.EventReceiver[fn.Event], _ bool, _ time.Time) error {
+
+	m.EventDistributor.RegisterSubscriber(receiver)
+
+	return nil
+}
+
+// TestResolveState tests the state an invoice is in for different received
+// amounts and times.
+func TestResolveState(t *testing.T) {
+	t.Parallel()
+
+	expiry := time.Unix(1_000_000, 0)
+	before := expiry.Add(-time.Second)
+
+	testCases := []struct {
+		name     string
+		received uint64
+		now      time.Time
+		expected State
+	}{{
+		name:     "open",
+		now:      before,
+		expected: StateOpen,
+	}, {
+		name:     "expired",
+		now:      expiry,
+		expected: StateExpired,
+	}, {
+		name:     "paid",
+		received: 100,
+		now:      before,
+		expected: StatePaid,
+	}, {
+		name:     "paid after expiry",
+		received: 100,
+		now:      expiry.Add(time.Hour),
+		expected: StatePaid,
+	}, {
+		name:     "underpaid",
+		received: 99,
+		now:      before,
+		expected: StateUnderpaid,
+	}, {
+		name:     "overpaid",
+		received: 200,
+		now:      before,
+		expected: StateOverpaid,
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			state := resolveState(100, tc.received, expiry, tc.now)
+			require.Equal(t, tc.expected, state)
+		})
+	}
+}
+
+// TestManager tests that the invoice manager tracks the payment state of the
+// invoices it creates.
+func TestManager(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx       = context.Background()
+		testClock = clock.NewTestClock(time.Now())
+		store     = &mockStore{}
+		addrBook  = &mockAddrBook{
+			t:        t,
+			payments: make(map[string]int),
+		}
+		receiveEvents = &mockReceiveEvents{
+			EventDistributor: fn.NewEventDistributor[fn.Event](),
+		}
+		errChan = make(chan error, 1)
+	)
+
+	manager := NewManager(&ManagerConfig{
+		Store:               store,
+		AddrBook:            addrBook,
+		ReceiveEvents:       receiveEvents,
+		Clock:               testClock,
+		ExpiryCheckInterval: time.Minute,
+		ErrChan:             errChan,
+	})
+	require.NoError(t, manager.Start())
+	t.Cleanup(func() {
+		require.NoError(t, manager.Stop())
+	})
+
+	sub := fn.NewEventReceiver[*Invoice](fn.DefaultQueueSize)
+	require.NoError(t, manager.RegisterSubscriber(sub, false, time.Time{}))
+
+	// Invalid requests are rejected.
+	_, err := manager.NewInvoice(ctx, &Request{})
+	require.ErrorContains(t, err, "amount must be positive")
+	_, err = manager.NewInvoice(ctx, &Request{
+		Amount: 100,
+		Memo:   string(bytes.Repeat([]byte("a"), MaxMemoLength+1)),
+	})
+	require.ErrorIs(t, err, ErrMemoTooLong)
+
+	assertUpdate := func(id int64, state State, received uint64) {
+		t.Helper()
+
+		select {
+		case invoice := <-sub.NewItemCreated.ChanOut():
+			require.Equal(t, id, invoice.ID)
+			require.Equal(t, state, invoice.State)
+			require.Equal(t, received, invoice.AmtReceived)
+
+		case err := <-errChan:
+			t.Fatalf("unexpected error: %v", err)
+
+		case <-time.After(testTimeout):
+			t.Fatalf("no invoice update received")
+		}
+	}
+
+	paidInvoice, err := manager.NewInvoice(ctx, &Request{
+		Amount: 100,
+		Memo:   "paid",
+		Expiry: time.Hour,
+	})
+	require.NoError(t, err)
+	assertUpdate(paidInvoice.ID, StateOpen, 0)
+
+	expiredInvoice, err := manager.NewInvoice(ctx, &Request{
+		Amount: 100,
+		Memo:   "expired",
+		Expiry: time.Hour,
+	})
+	require.NoError(t, err)
+	assertUpdate(expiredInvoice.ID, StateOpen, 0)
+
+	// A completed payment to the invoice address marks it as paid.
+	pay := func(invoice *Invoice) {
+		addrBook.pay(invoice.Addr)
+		receiveEvents.NotifySubscribers(&tapgarden.AssetReceiveEvent{
+			Address: *invoice.Addr.Tap,
+			Status:  address.StatusCompleted,
+		})
+	}
+	pay(paidInvoice)
+	assertUpdate(paidInvoice.ID, StatePaid, 100)
+
+	// Paying the same address again results in an overpaid invoice.
+	pay(paidInvoice)
+	assertUpdate(paidInvoice.ID, StateOverpaid, 200)
+
+	// Once the expiry passes, the unpaid invoice expires. We keep moving
+	// the clock forward, as we can't know when the event loop registers
+	// its next tick.
+	expiry := expiredInvoice.Expiry
+	require.Eventually(t, func() bool {
+		expiry = expiry.Add(time.Minute)
+		testClock.SetTime(expiry)
+
+		openState := StateOpen
+		open, err := manager.ListInvoices(ctx, QueryParams{
+			State: &openState,
+		})
+		require.NoError(t, err)
+
+		return len(open) == 0
+	}, testTimeout, 10*time.Millisecond)
+	assertUpdate(expiredInvoice.ID, StateExpired, 0)
+
+	invoice, err := manager.LookupInvoice(
+		ctx, &paidInvoice.Addr.TaprootOutputKey,
+	)
+	require.NoError(t, err)
+	require.Equal(t, StateOverpaid, invoice.State)
+	require.Equal(t, "paid", invoice.Memo)
+}
@@ -304,6 +304,113 @@ func testMultiAddress(t *harnessTest) {
 	require.ErrorContains(t.t, err, "mixed address versions")
 }
 
+// testAddressContacts tests that addresses can be stored in the contact book
+// and sent to by their label.
+func testAddressContacts(t *harnessTest) {
+	rpcAssets := MintAssetsConfirmBatch(
+		t.t, t.lndHarness.Miner.Client, t.tapd,
+		[]*mintrpc.MintAssetRequest{simpleAssets[0]},
+	)
+	mintedAsset := rpcAssets[0]
+	genInfo := mintedAsset.AssetGenesis
+
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultWaitTimeout)
+	defer cancel()
+
+	bob := setupTapdHarness(
+		t.t, t, t.lndHarness.Bob, t.universeServer,
+	)
+	defer func() {
+		require.NoError(t.t, bob.stop(!*noDelete))
+	}()
+
+	const sendAmt = 100
+	bobAddr, err := bob.NewAddr(ctxt, &taprpc.NewAddrRequest{
+		AssetId: genInfo.AssetId,
+		Amt:     sendAmt,
+	})
+	require.NoError(t.t, err)
+
+	AssertAddrCreated(t.t, bob, mintedAsset, bobAddr)
+
+	// A contact needs a label and a valid address.
+	_, err = t.tapd.AddContact(ctxt, &taprpc.AddContactRequest{
+		Addr: bobAddr.Encoded,
+	})
+	require.ErrorContains(t.t, err, "label must be set")
+
+	_, err = t.tapd.AddContact(ctxt, &taprpc.AddContactRequest{
+		Label: "bob",
+		Addr:  "not an address",
+	})
+	require.ErrorContains(t.t, err, "invalid address of contact")
+
+	const label = "bob"
+	addResp, err := t.tapd.AddContact(ctxt, &taprpc.AddContactRequest{
+		Label: label,
+		Addr:  bobAddr.Encoded,
+		Group: "friends",
+		Notes: "met at the conference",
+	})
+	require.NoError(t.t, err)
+	require.Equal(t.t, label, addResp.Contact.Label)
+	require.Equal(t.t, bobAddr.Encoded, addResp.Contact.Addr)
+	require.Zero(t.t, addResp.Contact.NumSends)
+
+	// The group filter only returns the contacts of the given group.
+	listResp, err := t.tapd.ListContacts(
+		ctxt, &taprpc.ListContactsRequest{Group: "friends"},
+	)
+	require.NoError(t.t, err)
+	require.Len(t.t, listResp.Contacts, 1)
+
+	listResp, err = t.tapd.ListContacts(
+		ctxt, &taprpc.ListContactsRequest{Group: "family"},
+	)
+	require.NoError(t.t, err)
+	require.Empty(t.t, listResp.Contacts)
+
+	// We now send to the contact by its label instead of the address.
+	sendResp, err := t.tapd.SendAsset(ctxt, &taprpc.SendAssetRequest{
+		TapAddrs: []string{label},
+	})
+	require.NoError(t.t, err)
+
+	ConfirmAndAssertOutboundTransfer(
+		t.t, t.lndHarness.Miner.Client, t.tapd, sendResp,
+		genInfo.AssetId,
+		[]uint64{mintedAsset.Amount - sendAmt, sendAmt}, 0, 1,
+	)
+	AssertNonInteractiveRecvComplete(t.t, bob, 1)
+
+	// The send is recorded in the usage stats of the contact.
+	listResp, err = t.tapd.ListContacts(ctxt, &taprpc.ListContactsRequest{})
+	require.NoError(t.t, err)
+	require.Len(t.t, listResp.Contacts, 1)
+
+	contact := listResp.Contacts[0]
+	require.EqualValues(t.t, 1, contact.NumSends)
+	require.EqualValues(t.t, sendAmt, contact.TotalSent)
+	require.NotZero(t.t, contact.LastUsed)
+
+	// Once the contact is deleted, its label can no longer be sent to.
+	_, err = t.tapd.DeleteContact(ctxt, &taprpc.DeleteContactRequest{
+		Label: label,
+	})
+	require.NoError(t.t, err)
+
+	_, err = t.tapd.DeleteContact(ctxt, &taprpc.DeleteContactRequest{
+		Label: label,
+	})
+	require.ErrorContains(t.t, err, "contact not found")
+
+	_, err = t.tapd.SendAsset(ctxt, &taprpc.SendAssetRequest{
+		TapAddrs: []string{label},
+	})
+	require.Error(t.t, err)
+}
+
 // testAddressAssetSyncer tests that we can create addresses for assets that
 // were not synced to the address creating node before creating the address.
 func testAddressAssetSyncer(t *harnessTest) {
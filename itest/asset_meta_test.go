@@ -158,6 +158,39 @@ func testMintAssetWithDecimalDisplayMetaField(t *harnessTest) {
 	genMetaHash := firstAssetMinted.AssetGenesis.MetaHash
 	require.Equal(t.t, genMetaHash, metaHash[:])
 
+	// The decimal display must also be returned as a decoded field of the
+	// metadata when listing the asset.
+	listResp, err := t.tapd.ListAssets(ctxt, &taprpc.ListAssetRequest{})
+	require.NoError(t.t, err)
+	require.Len(t.t, listResp.Assets, 1)
+
+	decodedMeta := listResp.Assets[0].DecodedMeta
+	require.NotNil(t.t, decodedMeta)
+	require.NotNil(t.t, decodedMeta.DecimalDisplay)
+	require.EqualValues(
+		t.t, firstAsset.DecimalDisplay,
+		decodedMeta.DecimalDisplay.DecimalDisplay,
+	)
+
+	// The amount is also formatted according to the decimal display.
+	require.Equal(t.t, "5.00", listResp.Assets[0].DecimalAmount)
+
+	// A decimal address amount is converted into asset units, unless it is
+	// more precise than the decimal display allows.
+	assetID := firstAssetMinted.AssetGenesis.AssetId
+	addr, err := t.tapd.NewAddr(ctxt, &taprpc.NewAddrRequest{
+		AssetId:    assetID,
+		DecimalAmt: "1.5",
+	})
+	require.NoError(t.t, err)
+	require.EqualValues(t.t, 150, addr.Amount)
+
+	_, err = t.tapd.NewAddr(ctxt, &taprpc.NewAddrRequest{
+		AssetId:    assetID,
+		DecimalAmt: "1.234",
+	})
+	require.ErrorContains(t.t, err, "too many fractional digits")
+
 	// Mint another asset into the same asset group as the first asset.
 	groupKey := firstAssetMinted.AssetGroup.TweakedGroupKey
 	secondAssetReq := CopyRequest(firstAssetReq)
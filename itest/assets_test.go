@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
@@ -16,11 +17,13 @@ import (
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/internal/test"
 	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
 	"github.com/lightninglabs/taproot-assets/taprpc"
 	"github.com/lightninglabs/taproot-assets/taprpc/mintrpc"
 	"github.com/lightninglabs/taproot-assets/taprpc/tapdevrpc"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/maps"
 	"golang.org/x/net/http2"
@@ -528,3 +531,234 @@ func testMintAssetsWithTapscriptSibling(t *harnessTest) {
 	t.lndHarness.MineBlocksAndAssertNumTxes(1, 1)
 	t.lndHarness.AssertNumUTXOsWithConf(t.lndHarness.Bob, 1, 1, 1)
 }
+
+// testMintAssetWithAssetSibling tests that a tapscript sibling given for a
+// single asset of a batch ends up in the genesis output of the batch.
+func testMintAssetWithAssetSibling(t *harnessTest) {
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultWaitTimeout)
+	defer cancel()
+
+	hashLockLeaf := test.ScriptHashLock(t.t, []byte("foobar"))
+	sigLeaf := test.ScriptSchnorrSig(t.t, test.RandPubKey(t.t))
+	siblingTree := txscript.AssembleTaprootScriptTree(hashLockLeaf, sigLeaf)
+
+	siblingBranch := txscript.NewTapBranch(
+		siblingTree.RootNode.Left(), siblingTree.RootNode.Right(),
+	)
+	siblingPreimage := commitment.NewPreimageFromBranch(siblingBranch)
+	typedBranch := asset.TapTreeNodesFromBranch(siblingBranch)
+	rawBranch := fn.MapOptionZ(asset.GetBranch(typedBranch), asset.ToBranch)
+	require.Len(t.t, rawBranch, 2)
+
+	assetReq := &mintrpc.MintAssetRequest{
+		Asset: &mintrpc.MintAsset{
+			AssetType: taprpc.AssetType_NORMAL,
+			Name:      "itestbuxx-sibling",
+			AssetMeta: &taprpc.AssetMeta{
+				Data: []byte("some metadata"),
+			},
+			Amount:       5000,
+			AssetVersion: taprpc.AssetVersion_ASSET_VERSION_V1,
+			AssetSibling: &mintrpc.MintAsset_SiblingBranch{
+				SiblingBranch: &taprpc.TapBranch{
+					LeftTaphash:  rawBranch[0],
+					RightTaphash: rawBranch[1],
+				},
+			},
+		},
+	}
+	// The second asset of the batch doesn't have a sibling.
+	rpcAssets := MintAssetsConfirmBatch(
+		t.t, t.lndHarness.Miner.Client, t.tapd,
+		[]*mintrpc.MintAssetRequest{assetReq, simpleAssets[1]},
+	)
+	require.Len(t.t, rpcAssets, 2)
+
+	// The genesis output of the batch must commit to the sibling of the
+	// first asset, as it is the only sibling in the batch.
+	utxos, err := t.tapd.ListUtxos(ctxt, &taprpc.ListUtxosRequest{})
+	require.NoError(t.t, err)
+
+	genesisOutpoint := rpcAssets[0].ChainAnchor.AnchorOutpoint
+	genesisUtxo, ok := utxos.ManagedUtxos[genesisOutpoint]
+	require.True(t.t, ok)
+
+	AssertGenesisOutput(t.t, genesisUtxo, siblingPreimage)
+}
+
+// testMintBatchSchedule tests that a batch is finalized automatically once its
+// schedule is due, and that the schedule survives a restart of the daemon.
+func testMintBatchSchedule(t *harnessTest) {
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultWaitTimeout)
+	defer cancel()
+
+	// Schedule the batch to be finalized once it contains two seedlings.
+	schedule := &mintrpc.BatchSchedule{
+		MinSeedlings: 2,
+	}
+	_, err := t.tapd.ScheduleBatch(ctxt, &mintrpc.ScheduleBatchRequest{
+		Schedule: schedule,
+	})
+	require.NoError(t.t, err)
+
+	// The schedule is persisted, so it is still in place after a restart.
+	require.NoError(t.t, t.tapd.stop(false))
+	require.NoError(t.t, t.tapd.start(false))
+
+	scheduleResp, err := t.tapd.GetBatchSchedule(
+		ctxt, &mintrpc.GetBatchScheduleRequest{},
+	)
+	require.NoError(t.t, err)
+	require.Equal(t.t, schedule.MinSeedlings,
+		scheduleResp.Schedule.MinSeedlings)
+
+	ctxc, streamCancel := context.WithCancel(ctxb)
+	stream, err := t.tapd.SubscribeMintEvents(
+		ctxc, &mintrpc.SubscribeMintEventsRequest{},
+	)
+	require.NoError(t.t, err)
+	sub := &EventSubscription[*mintrpc.MintEvent]{
+		ClientEventStream: stream,
+		Cancel:            streamCancel,
+	}
+
+	// The first seedling leaves the batch pending, the second one
+	// finalizes and broadcasts it without an explicit FinalizeBatch call.
+	assetReqs := simpleAssets[:2]
+	firstResp, err := t.tapd.MintAsset(ctxt, assetReqs[0])
+	require.NoError(t.t, err)
+	batchKey := firstResp.PendingBatch.BatchKey
+
+	_, err = t.tapd.MintAsset(ctxt, assetReqs[1])
+	require.NoError(t.t, err)
+
+	WaitForBatchState(
+		t.t, ctxt, t.tapd, defaultWaitTimeout, batchKey,
+		mintrpc.BatchState_BATCH_STATE_BROADCAST,
+	)
+	hashes, err := waitForNTxsInMempool(
+		t.lndHarness.Miner.Client, 1, defaultWaitTimeout,
+	)
+	require.NoError(t.t, err)
+
+	ConfirmBatch(
+		t.t, t.lndHarness.Miner.Client, t.tapd, assetReqs, sub,
+		*hashes[0], batchKey,
+	)
+
+	// The schedule was removed together with the finalized batch.
+	scheduleResp, err = t.tapd.GetBatchSchedule(
+		ctxt, &mintrpc.GetBatchScheduleRequest{},
+	)
+	require.NoError(t.t, err)
+	require.Nil(t.t, scheduleResp.Schedule)
+}
+
+// testMintExternallyFunded tests that a batch can be funded and signed by an
+// external wallet. Bob's lnd node acts as the external wallet for the batch
+// minted by Alice's tapd node.
+func testMintExternallyFunded(t *harnessTest) {
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultWaitTimeout)
+	defer cancel()
+
+	bobLnd := t.lndHarness.Bob
+	assetReq := simpleAssets[0]
+
+	ctxc, streamCancel := context.WithCancel(ctxb)
+	stream, err := t.tapd.SubscribeMintEvents(
+		ctxc, &mintrpc.SubscribeMintEventsRequest{},
+	)
+	require.NoError(t.t, err)
+	sub := &EventSubscription[*mintrpc.MintEvent]{
+		ClientEventStream: stream,
+		Cancel:            streamCancel,
+	}
+
+	BuildMintingBatch(
+		t.t, t.tapd, []*mintrpc.MintAssetRequest{assetReq},
+	)
+
+	// Let the external wallet add its inputs and change to the genesis
+	// skeleton.
+	skeleton, err := tapgarden.NewGenesisSkeleton()
+	require.NoError(t.t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t.t, skeleton.Serialize(&buf))
+
+	fundResp := bobLnd.RPC.FundPsbt(&walletrpc.FundPsbtRequest{
+		Template: &walletrpc.FundPsbtRequest_Psbt{
+			Psbt: buf.Bytes(),
+		},
+		Fees: &walletrpc.FundPsbtRequest_SatPerVbyte{
+			SatPerVbyte: 5,
+		},
+	})
+
+	// A fee rate can't be combined with an externally funded PSBT.
+	_, err = t.tapd.FundBatch(ctxt, &mintrpc.FundBatchRequest{
+		FeeRate:      uint32(chainfee.FeePerKwFloor),
+		ExternalPsbt: fundResp.FundedPsbt,
+	})
+	require.Error(t.t, err)
+
+	fundBatchResp, err := t.tapd.FundBatch(ctxt, &mintrpc.FundBatchRequest{
+		ExternalPsbt: fundResp.FundedPsbt,
+	})
+	require.NoError(t.t, err)
+	require.NotNil(t.t, fundBatchResp.Batch)
+
+	// Finalizing the batch doesn't broadcast anything yet, as the genesis
+	// transaction still needs to be signed by the external wallet.
+	finalizeResp, err := t.tapd.FinalizeBatch(
+		ctxt, &mintrpc.FinalizeBatchRequest{},
+	)
+	require.NoError(t.t, err)
+	require.NotEmpty(t.t, finalizeResp.Batch.BatchPsbt)
+
+	batchKey := finalizeResp.Batch.BatchKey
+	genesisPkt, err := psbt.NewFromRawBytes(
+		bytes.NewReader(finalizeResp.Batch.BatchPsbt), false,
+	)
+	require.NoError(t.t, err)
+
+	signedPkt := finalizePacket(t.t, bobLnd, genesisPkt)
+
+	// Signatures for a batch that doesn't exist are rejected.
+	buf.Reset()
+	require.NoError(t.t, signedPkt.Serialize(&buf))
+	_, err = t.tapd.SubmitGenesisSigs(
+		ctxt, &mintrpc.SubmitGenesisSigsRequest{
+			BatchKey:   test.RandPubKey(t.t).SerializeCompressed(),
+			SignedPsbt: buf.Bytes(),
+		},
+	)
+	require.Error(t.t, err)
+
+	sigsResp, err := t.tapd.SubmitGenesisSigs(
+		ctxt, &mintrpc.SubmitGenesisSigsRequest{
+			BatchKey:   batchKey,
+			SignedPsbt: buf.Bytes(),
+		},
+	)
+	require.NoError(t.t, err)
+	require.Equal(
+		t.t, mintrpc.BatchState_BATCH_STATE_BROADCAST,
+		sigsResp.Batch.State,
+	)
+
+	hashes, err := waitForNTxsInMempool(
+		t.lndHarness.Miner.Client, 1, defaultWaitTimeout,
+	)
+	require.NoError(t.t, err)
+	require.Equal(t.t, signedPkt.UnsignedTx.TxHash(), *hashes[0])
+
+	ConfirmBatch(
+		t.t, t.lndHarness.Miner.Client, t.tapd,
+		[]*mintrpc.MintAssetRequest{assetReq}, sub, *hashes[0],
+		batchKey,
+	)
+}
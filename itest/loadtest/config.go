@@ -6,6 +6,7 @@ import (
 
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/jessevdk/go-flags"
+	"github.com/lightninglabs/taproot-assets/address"
 	"github.com/lightninglabs/taproot-assets/taprpc"
 )
 
@@ -71,7 +72,7 @@ type Config struct {
 	Bob *User `group:"bob" namespace:"bob" description:"bob related configuration"`
 
 	// Network is the network that the nodes are connected to.
-	Network string `long:"network" description:"the network the nodes are connected to" choice:"regtest" choice:"testnet" choice:"mainnet"`
+	Network string `long:"network" description:"the network the nodes are connected to" choice:"regtest" choice:"testnet" choice:"testnet4" choice:"signet" choice:"mainnet"`
 
 	// Bitcoin is the configuration for the bitcoin backend.
 	Bitcoin *BitcoinConfig `group:"bitcoin" namespace:"bitcoin" long:"bitcoin" description:"bitcoin client configuration"`
@@ -168,6 +169,9 @@ func networkParams(network string) (*chaincfg.Params, error) {
 	case "testnet":
 		return &chaincfg.TestNet3Params, nil
 
+	case "testnet4":
+		return &address.TestNet4Params, nil
+
 	case "regtest":
 		return &chaincfg.RegressionNetParams, nil
 
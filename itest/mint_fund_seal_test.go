@@ -9,6 +9,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/txscript"
@@ -27,6 +28,8 @@ import (
 	"github.com/lightninglabs/taproot-assets/universe"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc/signrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
 	"github.com/lightningnetwork/lnd/lntest/rpc"
 	"github.com/stretchr/testify/require"
 )
@@ -481,6 +484,171 @@ func testMintFundSealAssets(t *harnessTest) {
 
 // Derive a random key on an LND node, with a key family not matching the
 // Taproot Assets key family.
+// testMintMuSig2GroupKey tests that the group witness of an asset whose group
+// key is shared between two issuers can be created with a group signing
+// session. Alice's tapd mints the asset, while Bob's lnd node acts as the
+// remote issuer.
+func testMintMuSig2GroupKey(t *harnessTest) {
+	var (
+		aliceTapd = t.tapd
+		bobLnd    = t.lndHarness.Bob
+	)
+
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultWaitTimeout)
+	defer cancel()
+
+	// The raw group key is the MuSig2 combination of the keys of both
+	// issuers.
+	_, aliceKey := DeriveKeys(t.t, aliceTapd)
+	bobKeyDesc := bobLnd.RPC.DeriveNextKey(&walletrpc.KeyReq{
+		KeyFamily: int32(asset.TaprootAssetsKeyFamily),
+	})
+	bobKey, err := btcec.ParsePubKey(bobKeyDesc.RawKeyBytes)
+	require.NoError(t.t, err)
+
+	rawGroupKey, err := asset.MuSig2GroupRawKey(
+		[]*btcec.PublicKey{aliceKey.PubKey, bobKey},
+	)
+	require.NoError(t.t, err)
+
+	assetReq := CopyRequest(issuableAssets[0])
+	assetReq.Asset.GroupInternalKey = taprpc.MarshalKeyDescriptor(
+		keychain.KeyDescriptor{
+			PubKey: rawGroupKey,
+		},
+	)
+	assetReqs := []*mintrpc.MintAssetRequest{assetReq}
+
+	// The virtual transaction of the group witness is only known once the
+	// batch is funded.
+	_, err = aliceTapd.FundBatch(ctxt, &mintrpc.FundBatchRequest{})
+	require.NoError(t.t, err)
+	BuildMintingBatch(t.t, aliceTapd, assetReqs)
+
+	sessionResp, err := aliceTapd.CreateGroupSession(
+		ctxt, &mintrpc.CreateGroupSessionRequest{
+			AssetName: assetReq.Asset.Name,
+			LocalKey:  taprpc.MarshalKeyDescriptor(aliceKey),
+			Signers:   [][]byte{bobKey.SerializeCompressed()},
+		},
+	)
+	require.NoError(t.t, err)
+
+	session := sessionResp.Session
+	require.Len(t.t, session.Participants, 2)
+	aliceNonce := session.Participants[0].PubNonce
+	require.True(t.t, session.Participants[0].Local)
+	require.Len(t.t, aliceNonce, musig2.PubNonceSize)
+
+	// Bob starts his side of the session with the same tweaks.
+	var genID asset.ID
+	copy(genID[:], session.AssetId)
+	tweaks, err := asset.MuSig2GroupKeyTweaks(rawGroupKey, genID, nil)
+	require.NoError(t.t, err)
+	rpcTweaks := fn.Map(
+		tweaks, func(tweak musig2.KeyTweakDesc) *signrpc.TweakDesc {
+			return &signrpc.TweakDesc{
+				Tweak:   fn.CopySlice(tweak.Tweak[:]),
+				IsXOnly: tweak.IsXOnly,
+			}
+		},
+	)
+
+	bobSession := bobLnd.RPC.MuSig2CreateSession(
+		&signrpc.MuSig2SessionRequest{
+			KeyLoc: bobKeyDesc.KeyLoc,
+			AllSignerPubkeys: [][]byte{
+				aliceKey.PubKey.SerializeCompressed(),
+				bobKey.SerializeCompressed(),
+			},
+			Tweaks:  rpcTweaks,
+			Version: signrpc.MuSig2Version_MUSIG2_VERSION_V100RC2,
+		},
+	)
+	require.Equal(t.t, session.GroupKey, bobSession.CombinedKey)
+
+	// The nonces are exchanged in both directions.
+	_, err = aliceTapd.RegisterGroupSessionNonce(
+		ctxt, &mintrpc.RegisterGroupSessionNonceRequest{
+			SessionId: session.SessionId,
+			Signer:    bobKey.SerializeCompressed(),
+			PubNonce:  bobSession.LocalPublicNonces,
+		},
+	)
+	require.NoError(t.t, err)
+	bobLnd.RPC.MuSig2RegisterNonces(&signrpc.MuSig2RegisterNoncesRequest{
+		SessionId:               bobSession.SessionId,
+		OtherSignerPublicNonces: [][]byte{aliceNonce},
+	})
+
+	// Both issuers sign, and Bob's partial signature is handed to Alice.
+	_, err = aliceTapd.SignGroupSession(
+		ctxt, &mintrpc.SignGroupSessionRequest{
+			SessionId: session.SessionId,
+		},
+	)
+	require.NoError(t.t, err)
+	bobSig := bobLnd.RPC.MuSig2Sign(&signrpc.MuSig2SignRequest{
+		SessionId:     bobSession.SessionId,
+		MessageDigest: session.SigHash,
+	})
+	_, err = aliceTapd.RegisterGroupSessionPartialSig(
+		ctxt, &mintrpc.RegisterGroupSessionPartialSigRequest{
+			SessionId:  session.SessionId,
+			Signer:     bobKey.SerializeCompressed(),
+			PartialSig: bobSig.LocalPartialSignature,
+		},
+	)
+	require.NoError(t.t, err)
+
+	finalizeResp, err := aliceTapd.FinalizeGroupSession(
+		ctxt, &mintrpc.FinalizeGroupSessionRequest{
+			SessionId: session.SessionId,
+		},
+	)
+	require.NoError(t.t, err)
+	require.Equal(t.t, genID[:], finalizeResp.GroupWitness.GenesisId)
+
+	// The witness is now used to seal the batch like any other external
+	// group witness.
+	_, err = aliceTapd.SealBatch(ctxt, &mintrpc.SealBatchRequest{
+		GroupWitnesses: []*taprpc.GroupWitness{
+			finalizeResp.GroupWitness,
+		},
+	})
+	require.NoError(t.t, err)
+
+	ctxc, streamCancel := context.WithCancel(ctxb)
+	stream, err := aliceTapd.SubscribeMintEvents(
+		ctxc, &mintrpc.SubscribeMintEventsRequest{},
+	)
+	require.NoError(t.t, err)
+	sub := &EventSubscription[*mintrpc.MintEvent]{
+		ClientEventStream: stream,
+		Cancel:            streamCancel,
+	}
+
+	batchTXID, batchKey := FinalizeBatchUnconfirmed(
+		t.t, t.lndHarness.Miner.Client, aliceTapd, assetReqs,
+	)
+	batchAssets := ConfirmBatch(
+		t.t, t.lndHarness.Miner.Client, aliceTapd, assetReqs, sub,
+		batchTXID, batchKey,
+	)
+	require.Len(t.t, batchAssets, 1)
+
+	// The group key of the minted asset is the combined key of the
+	// session.
+	tweakedGroupKey, err := btcec.ParsePubKey(
+		batchAssets[0].AssetGroup.TweakedGroupKey,
+	)
+	require.NoError(t.t, err)
+	require.Equal(
+		t.t, session.GroupKey, schnorr.SerializePubKey(tweakedGroupKey),
+	)
+}
+
 func deriveRandomKey(t *testing.T, ctxt context.Context,
 	keyRing *taprootassets.LndRpcKeyRing) keychain.KeyDescriptor {
 
@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/lightninglabs/taproot-assets/internal/test"
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/tapfreighter"
@@ -128,6 +129,217 @@ func testBasicSendUnidirectional(t *harnessTest) {
 	wg.Wait()
 }
 
+// testSendLimits tests that the cumulative amount sent to a destination can be
+// limited, and that the amounts sent so far can be queried.
+func testSendLimits(t *harnessTest) {
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultWaitTimeout)
+	defer cancel()
+
+	const (
+		numUnits = 10
+		limit    = 15
+	)
+
+	rpcAssets := MintAssetsConfirmBatch(
+		t.t, t.lndHarness.Miner.Client, t.tapd,
+		[]*mintrpc.MintAssetRequest{issuableAssets[0]},
+	)
+	genInfo := rpcAssets[0].AssetGenesis
+
+	bob := setupTapdHarness(
+		t.t, t, t.lndHarness.Bob, t.universeServer,
+	)
+	defer func() {
+		require.NoError(t.t, bob.stop(!*noDelete))
+	}()
+
+	// We reuse a single address, so all sends go to the same destination
+	// script key.
+	bobAddr, err := bob.NewAddr(ctxt, &taprpc.NewAddrRequest{
+		AssetId:      genInfo.AssetId,
+		Amt:          numUnits,
+		AssetVersion: rpcAssets[0].Version,
+	})
+	require.NoError(t.t, err)
+	AssertAddrCreated(t.t, bob, rpcAssets[0], bobAddr)
+
+	_, err = t.tapd.SetSendLimit(ctxt, &taprpc.SetSendLimitRequest{
+		Limit: &taprpc.SendLimit{
+			ScriptKey: bobAddr.ScriptKey,
+			AssetId:   genInfo.AssetId,
+			MaxAmount: limit,
+		},
+	})
+	require.NoError(t.t, err)
+
+	limits, err := t.tapd.ListSendLimits(
+		ctxt, &taprpc.ListSendLimitsRequest{},
+	)
+	require.NoError(t.t, err)
+	require.Len(t.t, limits.Limits, 1)
+	require.EqualValues(t.t, limit, limits.Limits[0].MaxAmount)
+
+	// The first send is within the limit.
+	currentUnits := issuableAssets[0].Asset.Amount - numUnits
+	sendResp, _ := sendAssetsToAddr(t, t.tapd, bobAddr)
+	ConfirmAndAssertOutboundTransfer(
+		t.t, t.lndHarness.Miner.Client, t.tapd, sendResp,
+		genInfo.AssetId, []uint64{currentUnits, numUnits}, 0, 1,
+	)
+	AssertNonInteractiveRecvComplete(t.t, bob, 1)
+
+	totals, err := t.tapd.ListSendTotals(
+		ctxt, &taprpc.ListSendTotalsRequest{
+			ScriptKey: bobAddr.ScriptKey,
+		},
+	)
+	require.NoError(t.t, err)
+	require.Len(t.t, totals.Totals, 1)
+	require.EqualValues(t.t, numUnits, totals.Totals[0].TotalAmount)
+	require.EqualValues(t.t, 1, totals.Totals[0].NumSends)
+	require.True(t.t, totals.Totals[0].HasLimit)
+	require.EqualValues(t.t, limit, totals.Totals[0].MaxAmount)
+
+	// A second send would exceed the limit and is rejected.
+	_, err = t.tapd.SendAsset(ctxt, &taprpc.SendAssetRequest{
+		TapAddrs: []string{bobAddr.Encoded},
+	})
+	require.ErrorContains(
+		t.t, err, tapfreighter.ErrSendLimitExceeded.Error(),
+	)
+
+	// Once the limit is removed, the send goes through.
+	_, err = t.tapd.RemoveSendLimit(ctxt, &taprpc.RemoveSendLimitRequest{
+		ScriptKey: bobAddr.ScriptKey,
+		AssetId:   genInfo.AssetId,
+	})
+	require.NoError(t.t, err)
+
+	currentUnits -= numUnits
+	sendResp, _ = sendAssetsToAddr(t, t.tapd, bobAddr)
+	ConfirmAndAssertOutboundTransfer(
+		t.t, t.lndHarness.Miner.Client, t.tapd, sendResp,
+		genInfo.AssetId, []uint64{currentUnits, numUnits}, 1, 2,
+	)
+	AssertNonInteractiveRecvComplete(t.t, bob, 2)
+
+	totals, err = t.tapd.ListSendTotals(
+		ctxt, &taprpc.ListSendTotalsRequest{
+			AssetId: genInfo.AssetId,
+		},
+	)
+	require.NoError(t.t, err)
+	require.Len(t.t, totals.Totals, 1)
+	require.EqualValues(t.t, 2*numUnits, totals.Totals[0].TotalAmount)
+	require.EqualValues(t.t, 2, totals.Totals[0].NumSends)
+	require.False(t.t, totals.Totals[0].HasLimit)
+}
+
+// testAnchorExportRoundTrip tests that the anchor transaction of a send can be
+// exported, signed by an external signer and imported again, and that an
+// exported send can be cancelled instead.
+func testAnchorExportRoundTrip(t *harnessTest) {
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultWaitTimeout)
+	defer cancel()
+
+	const numUnits = 10
+
+	rpcAssets := MintAssetsConfirmBatch(
+		t.t, t.lndHarness.Miner.Client, t.tapd,
+		[]*mintrpc.MintAssetRequest{issuableAssets[0]},
+	)
+	genInfo := rpcAssets[0].AssetGenesis
+
+	bob := setupTapdHarness(
+		t.t, t, t.lndHarness.Bob, t.universeServer,
+	)
+	defer func() {
+		require.NoError(t.t, bob.stop(!*noDelete))
+	}()
+
+	bobAddr, err := bob.NewAddr(ctxt, &taprpc.NewAddrRequest{
+		AssetId:      genInfo.AssetId,
+		Amt:          numUnits,
+		AssetVersion: rpcAssets[0].Version,
+	})
+	require.NoError(t.t, err)
+	AssertAddrCreated(t.t, bob, rpcAssets[0], bobAddr)
+
+	exportAnchor := func() (*wrpc.ExportAnchorPsbtResponse, []byte) {
+		exportResp, err := t.tapd.ExportAnchorPsbt(
+			ctxt, &wrpc.ExportAnchorPsbtRequest{
+				TapAddrs: []string{bobAddr.Encoded},
+			},
+		)
+		require.NoError(t.t, err)
+
+		anchorPkt, err := psbt.NewFromRawBytes(
+			bytes.NewReader(exportResp.AnchorPsbt), false,
+		)
+		require.NoError(t.t, err)
+		require.Equal(
+			t.t, exportResp.AnchorTxid,
+			anchorPkt.UnsignedTx.TxHash().String(),
+		)
+
+		// The lnd node of the sender acts as the external signer.
+		signedPkt := signPacket(t.t, t.lndHarness.Alice, anchorPkt)
+
+		var buf bytes.Buffer
+		require.NoError(t.t, signedPkt.Serialize(&buf))
+
+		return exportResp, buf.Bytes()
+	}
+
+	exportResp, signedPsbt := exportAnchor()
+	sendResp, err := t.tapd.ImportSignedAnchorPsbt(
+		ctxt, &wrpc.ImportSignedAnchorPsbtRequest{
+			SignedPsbt: signedPsbt,
+		},
+	)
+	require.NoError(t.t, err)
+
+	ConfirmAndAssertOutboundTransfer(
+		t.t, t.lndHarness.Miner.Client, t.tapd, sendResp,
+		genInfo.AssetId,
+		[]uint64{issuableAssets[0].Asset.Amount - numUnits, numUnits},
+		0, 1,
+	)
+	AssertNonInteractiveRecvComplete(t.t, bob, 1)
+
+	// The export is removed once the signed transaction is imported, so
+	// it can no longer be cancelled.
+	_, err = t.tapd.CancelAnchorExport(
+		ctxt, &wrpc.CancelAnchorExportRequest{
+			AnchorTxid: exportResp.AnchorTxid,
+		},
+	)
+	require.ErrorContains(
+		t.t, err, tapfreighter.ErrAnchorExportNotFound.Error(),
+	)
+
+	// A second export is cancelled, after which its signed version can't
+	// be imported anymore.
+	exportResp, signedPsbt = exportAnchor()
+	_, err = t.tapd.CancelAnchorExport(
+		ctxt, &wrpc.CancelAnchorExportRequest{
+			AnchorTxid: exportResp.AnchorTxid,
+		},
+	)
+	require.NoError(t.t, err)
+
+	_, err = t.tapd.ImportSignedAnchorPsbt(
+		ctxt, &wrpc.ImportSignedAnchorPsbtRequest{
+			SignedPsbt: signedPsbt,
+		},
+	)
+	require.ErrorContains(
+		t.t, err, tapfreighter.ErrAnchorExportNotFound.Error(),
+	)
+}
+
 // testRestartReceiver tests that the receiver node's asset balance after a
 // single asset transfer does not change if the receiver node restarts.
 // Before the addition of this test, after restarting the receiver node
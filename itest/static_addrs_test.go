@@ -0,0 +1,81 @@
+package itest
+
+import (
+	"context"
+
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/lightninglabs/taproot-assets/taprpc/mintrpc"
+	"github.com/stretchr/testify/require"
+)
+
+// testStaticAddresses tests that a static address can be paid multiple times
+// and that the receiver finds each payment by scanning the chain.
+func testStaticAddresses(t *harnessTest) {
+	rpcAssets := MintAssetsConfirmBatch(
+		t.t, t.lndHarness.Miner.Client, t.tapd,
+		[]*mintrpc.MintAssetRequest{simpleAssets[0]},
+	)
+	mintedAsset := rpcAssets[0]
+	assetID := mintedAsset.AssetGenesis.AssetId
+
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultWaitTimeout)
+	defer cancel()
+
+	// We'll make a second node now that'll be the receiver of the
+	// payments to the static address.
+	secondTapd := setupTapdHarness(
+		t.t, t, t.lndHarness.Bob, t.universeServer,
+	)
+	defer func() {
+		require.NoError(t.t, secondTapd.stop(!*noDelete))
+	}()
+
+	staticAddr, err := secondTapd.NewStaticAddr(
+		ctxt, &taprpc.NewStaticAddrRequest{
+			AssetId:      assetID,
+			AssetVersion: mintedAsset.Version,
+		},
+	)
+	require.NoError(t.t, err)
+
+	// We pay the same static address twice. Each payment must be made to
+	// a different single-use address.
+	const numUnits = 100
+	var paymentAddrs []string
+	for idx := 0; idx < 2; idx++ {
+		sendResp, err := t.tapd.SendStaticPayment(
+			ctxt, &taprpc.SendStaticPaymentRequest{
+				StaticAddr: staticAddr.Encoded,
+				Amt:        numUnits,
+			},
+		)
+		require.NoError(t.t, err)
+		require.NotContains(t.t, paymentAddrs, sendResp.PaymentAddr)
+		paymentAddrs = append(paymentAddrs, sendResp.PaymentAddr)
+
+		// The receiver only finds the payment once the anchor
+		// transaction confirms and the block is scanned.
+		sentUnits := uint64(idx+1) * numUnits
+		AssertAssetOutboundTransferWithOutputs(
+			t.t, t.lndHarness.Miner.Client, t.tapd,
+			sendResp.Transfer, assetID,
+			[]uint64{mintedAsset.Amount - sentUnits, numUnits},
+			idx, idx+1, 2, true,
+		)
+
+		AssertAddrEventByStatus(t.t, secondTapd, statusCompleted, idx+1)
+		AssertNonInteractiveRecvComplete(t.t, secondTapd, idx+1)
+		AssertBalanceByID(t.t, secondTapd, assetID, sentUnits)
+	}
+
+	// The receiver should have claimed both single-use addresses.
+	addrs, err := secondTapd.QueryAddrs(ctxt, &taprpc.QueryAddrRequest{})
+	require.NoError(t.t, err)
+
+	var claimedAddrs []string
+	for _, addr := range addrs.Addrs {
+		claimedAddrs = append(claimedAddrs, addr.Encoded)
+	}
+	require.ElementsMatch(t.t, paymentAddrs, claimedAddrs)
+}
@@ -122,6 +122,10 @@ type harnessOpts struct {
 	// sqliteDatabaseFilePath is the path to the SQLite database file to
 	// use.
 	sqliteDatabaseFilePath *string
+
+	// signResponses indicates whether the universe server should sign its
+	// proof and leaf key query responses.
+	signResponses bool
 }
 
 type harnessOption func(*harnessOpts)
@@ -287,6 +291,8 @@ func newTapdHarness(t *testing.T, ht *harnessTest, cfg tapdConfig,
 		finalCfg.Universe.SyncInterval = *opts.fedSyncTickerInterval
 	}
 
+	finalCfg.Universe.SignResponses = opts.signResponses
+
 	return &tapdHarness{
 		cfg:       &cfg,
 		clientCfg: finalCfg,
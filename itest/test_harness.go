@@ -378,6 +378,10 @@ type tapdHarnessParams struct {
 	// sqliteDatabaseFilePath is the path to the SQLite database file to
 	// use.
 	sqliteDatabaseFilePath *string
+
+	// signResponses indicates whether the universe server should sign its
+	// proof and leaf key query responses.
+	signResponses bool
 }
 
 type Option func(*tapdHarnessParams)
@@ -413,6 +417,7 @@ func setupTapdHarness(t *testing.T, ht *harnessTest,
 		ho.addrAssetSyncerDisable = params.addrAssetSyncerDisable
 		ho.fedSyncTickerInterval = params.fedSyncTickerInterval
 		ho.sqliteDatabaseFilePath = params.sqliteDatabaseFilePath
+		ho.signResponses = params.signResponses
 	}
 
 	tapdCfg := tapdConfig{
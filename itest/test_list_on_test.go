@@ -25,10 +25,26 @@ var testCases = []*testCase{
 		name: "mint assets with tap sibling",
 		test: testMintAssetsWithTapscriptSibling,
 	},
+	{
+		name: "mint asset with asset sibling",
+		test: testMintAssetWithAssetSibling,
+	},
+	{
+		name: "mint batch schedule",
+		test: testMintBatchSchedule,
+	},
+	{
+		name: "mint externally funded",
+		test: testMintExternallyFunded,
+	},
 	{
 		name: "mint fund seal assets",
 		test: testMintFundSealAssets,
 	},
+	{
+		name: "mint musig2 group key",
+		test: testMintMuSig2GroupKey,
+	},
 	{
 		name: "mint asset decimal display",
 		test: testMintAssetWithDecimalDisplayMetaField,
@@ -41,10 +57,18 @@ var testCases = []*testCase{
 		name: "multi address",
 		test: testMultiAddress,
 	},
+	{
+		name: "address contacts",
+		test: testAddressContacts,
+	},
 	{
 		name: "address syncer",
 		test: testAddressAssetSyncer,
 	},
+	{
+		name: "static addresses",
+		test: testStaticAddresses,
+	},
 	// For some (yet unknown) reason, the Postgres itest is much more flaky
 	// if the re-org tests run last. So we run them toward the beginning to
 	// reduce the flakiness of the Postgres itest.
@@ -69,6 +93,14 @@ var testCases = []*testCase{
 		name: "basic send unidirectional",
 		test: testBasicSendUnidirectional,
 	},
+	{
+		name: "send limits",
+		test: testSendLimits,
+	},
+	{
+		name: "anchor export round trip",
+		test: testAnchorExportRoundTrip,
+	},
 	{
 		name: "restart receiver check balance",
 		test: testRestartReceiverCheckBalance,
@@ -241,6 +273,14 @@ var testCases = []*testCase{
 		name: "universe sync manual insert",
 		test: testUniverseManualSync,
 	},
+	{
+		name: "universe root subscription",
+		test: testUniverseRootSubscription,
+	},
+	{
+		name: "universe response signatures",
+		test: testUniverseResponseSigs,
+	},
 	{
 		name: "universe federation",
 		test: testUniverseFederation,
@@ -63,6 +63,30 @@ func testUniverseSync(t *harnessTest) {
 	)
 	require.NoError(t.t, err)
 
+	// Before the actual sync, a dry run should report a single leaf that
+	// only exists remotely for each of the assets, without inserting
+	// anything into Bob's universe.
+	totalAssets := len(rpcSimpleAssets) + len(rpcIssuableAssets)
+	dryRunResp, err := bob.SyncUniverse(ctxt, &unirpc.SyncRequest{
+		UniverseHost: t.tapd.rpcHost(),
+		SyncMode:     unirpc.UniverseSyncMode_SYNC_ISSUANCE_ONLY,
+		DryRun:       true,
+	})
+	require.NoError(t.t, err)
+	require.Empty(t.t, dryRunResp.SyncedUniverses)
+	require.Len(t.t, dryRunResp.Divergences, totalAssets)
+	for _, divergence := range dryRunResp.Divergences {
+		require.False(t.t, divergence.InSync)
+		require.Nil(t.t, divergence.LocalRoot)
+		require.Len(t.t, divergence.RemoteOnly, 1)
+		require.Empty(t.t, divergence.LocalOnly)
+		require.Empty(t.t, divergence.Conflicting)
+	}
+
+	bobRoots, err := bob.AssetRoots(ctxt, &unirpc.AssetRootRequest{})
+	require.NoError(t.t, err)
+	require.Empty(t.t, bobRoots.UniverseRoots)
+
 	// Now we have an initial benchmark, so we'll kick off the universe
 	// sync with Bob syncing off the primary harness node that created the
 	// assets.
@@ -76,7 +100,6 @@ func testUniverseSync(t *harnessTest) {
 
 	// Bob's universe diff should contain an entry for each of the assets
 	// we created above.
-	totalAssets := len(rpcSimpleAssets) + len(rpcIssuableAssets)
 	require.Len(t.t, syncDiff.SyncedUniverses, totalAssets)
 
 	// Each item in the diff should match the set of universe roots we got
@@ -375,6 +398,142 @@ func testUniverseManualSync(t *harnessTest) {
 	require.NoError(t.t, err)
 }
 
+// testUniverseRootSubscription tests that changes of the roots of the local
+// universes are streamed to subscribers.
+func testUniverseRootSubscription(t *harnessTest) {
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultWaitTimeout)
+	defer cancel()
+
+	// An invalid filter is rejected once the stream is read from.
+	badStream, err := t.tapd.SubscribeUniverseRoots(
+		ctxt, &unirpc.SubscribeUniverseRootsRequest{
+			AssetIds: [][]byte{{1, 2, 3}},
+		},
+	)
+	require.NoError(t.t, err)
+	_, err = badStream.Recv()
+	require.ErrorContains(t.t, err, "invalid asset id length")
+
+	stream, err := t.tapd.SubscribeUniverseRoots(
+		ctxt, &unirpc.SubscribeUniverseRootsRequest{},
+	)
+	require.NoError(t.t, err)
+
+	rpcAssets := MintAssetsConfirmBatch(
+		t.t, t.lndHarness.Miner.Client, t.tapd,
+		[]*mintrpc.MintAssetRequest{simpleAssets[0]},
+	)
+	minted := rpcAssets[0]
+
+	// The issuance universe of the new asset now has a root that commits
+	// to the minted amount.
+	for {
+		event, err := stream.Recv()
+		require.NoError(t.t, err)
+
+		root := event.Root
+		assetID := root.Id.GetAssetId()
+		if !bytes.Equal(assetID, minted.AssetGenesis.AssetId) {
+			continue
+		}
+
+		require.Equal(
+			t.t, unirpc.ProofType_PROOF_TYPE_ISSUANCE,
+			root.Id.ProofType,
+		)
+		require.EqualValues(t.t, minted.Amount, root.MssmtRoot.RootSum)
+		require.NotZero(t.t, event.Timestamp)
+
+		break
+	}
+}
+
+// testUniverseResponseSigs tests that a universe server that signs its
+// responses sets the signature field of proof and leaf key query responses,
+// and that the signature verifies against the identity key of its lnd node.
+func testUniverseResponseSigs(t *harnessTest) {
+	miner := t.lndHarness.Miner.Client
+
+	// We'll use a new node as the signing universe server, so we can
+	// enable response signing.
+	bobLnd := t.lndHarness.Bob
+	bob := setupTapdHarness(
+		t.t, t, bobLnd, t.universeServer,
+		func(params *tapdHarnessParams) {
+			params.noDefaultUniverseSync = true
+			params.signResponses = true
+		},
+	)
+	defer func() {
+		require.NoError(t.t, bob.stop(!*noDelete))
+	}()
+
+	rpcAssets := MintAssetsConfirmBatch(
+		t.t, miner, bob, []*mintrpc.MintAssetRequest{simpleAssets[0]},
+	)
+	rpcAsset := rpcAssets[0]
+
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultWaitTimeout)
+	defer cancel()
+
+	identityKey, err := btcec.ParsePubKey(bobLnd.PubKey[:])
+	require.NoError(t.t, err)
+
+	uniID := &unirpc.ID{
+		Id: &unirpc.ID_AssetId{
+			AssetId: rpcAsset.AssetGenesis.AssetId,
+		},
+		ProofType: unirpc.ProofType_PROOF_TYPE_ISSUANCE,
+	}
+
+	// The leaf keys response should be signed by Bob's identity key.
+	const leafKeysMethod = "/universerpc.Universe/AssetLeafKeys"
+	leafKeys, err := bob.AssetLeafKeys(
+		ctxt, &unirpc.AssetLeafKeysRequest{
+			Id: uniID,
+		},
+	)
+	require.NoError(t.t, err)
+	require.Len(t.t, leafKeys.AssetKeys, 1)
+	require.NotNil(t.t, leafKeys.Signature)
+	require.NoError(t.t, tap.VerifyUniverseResponse(
+		identityKey, leafKeysMethod, leafKeys,
+		universe.DefaultResponseSigMaxAge,
+	))
+
+	// The same goes for the proof response.
+	const proofMethod = "/universerpc.Universe/QueryProof"
+	proofResp, err := bob.QueryProof(ctxt, &unirpc.UniverseKey{
+		Id:      uniID,
+		LeafKey: leafKeys.AssetKeys[0],
+	})
+	require.NoError(t.t, err)
+	require.NotNil(t.t, proofResp.Signature)
+	require.NoError(t.t, tap.VerifyUniverseResponse(
+		identityKey, proofMethod, proofResp,
+		universe.DefaultResponseSigMaxAge,
+	))
+
+	// A response that was tampered with must not verify anymore.
+	proofResp.UniverseInclusionProof = []byte{1, 2, 3}
+	err = tap.VerifyUniverseResponse(
+		identityKey, proofMethod, proofResp,
+		universe.DefaultResponseSigMaxAge,
+	)
+	require.ErrorIs(t.t, err, universe.ErrResponseSigInvalid)
+
+	// Neither must a valid response signed by another key.
+	aliceKey, err := btcec.ParsePubKey(t.lndHarness.Alice.PubKey[:])
+	require.NoError(t.t, err)
+	err = tap.VerifyUniverseResponse(
+		aliceKey, leafKeysMethod, leafKeys,
+		universe.DefaultResponseSigMaxAge,
+	)
+	require.ErrorIs(t.t, err, universe.ErrResponseSigInvalid)
+}
+
 // unmarshalMerkleSumNode un-marshals a protobuf MerkleSumNode.
 func unmarshalMerkleSumNode(root *unirpc.MerkleSumNode) mssmt.Node {
 	var nodeHash mssmt.NodeHash
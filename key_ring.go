@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taproot-assets/asset"
@@ -106,6 +107,24 @@ func (l *LndRpcKeyRing) IsLocalKey(ctx context.Context,
 	return derived.PubKey.IsEqual(desc.PubKey)
 }
 
+// DeriveSharedKey returns a shared secret key by performing Diffie-Hellman key
+// derivation between the ephemeral public key and the key specified by the key
+// locator. The shared key is the SHA256 of the compressed shared point.
+func (l *LndRpcKeyRing) DeriveSharedKey(ctx context.Context,
+	ephemeralPubKey *btcec.PublicKey,
+	keyLocator *keychain.KeyLocator) ([32]byte, error) {
+
+	sharedKey, err := l.lnd.Signer.DeriveSharedKey(
+		ctx, ephemeralPubKey, keyLocator,
+	)
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("unable to derive shared key: "+
+			"%w", err)
+	}
+
+	return sharedKey, nil
+}
+
 // A compile time assertion to ensure LndRpcKeyRing meets the
 // tapgarden.KeyRing interface.
 var _ tapgarden.KeyRing = (*LndRpcKeyRing)(nil)
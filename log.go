@@ -4,6 +4,8 @@ import (
 	"github.com/btcsuite/btclog"
 	"github.com/lightninglabs/taproot-assets/address"
 	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/compliance"
+	"github.com/lightninglabs/taproot-assets/invoice"
 	"github.com/lightninglabs/taproot-assets/monitoring"
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/rfq"
@@ -11,8 +13,10 @@ import (
 	"github.com/lightninglabs/taproot-assets/tapdb"
 	"github.com/lightninglabs/taproot-assets/tapfreighter"
 	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/tapmusig"
 	"github.com/lightninglabs/taproot-assets/tapsend"
 	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightninglabs/taproot-assets/webhook"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/signal"
 )
@@ -113,6 +117,12 @@ func SetupLoggers(root *build.RotatingLogWriter, interceptor signal.Interceptor)
 	AddSubLogger(
 		root, tapchannel.Subsystem, interceptor, tapchannel.UseLogger,
 	)
+	AddSubLogger(root, webhook.Subsystem, interceptor, webhook.UseLogger)
+	AddSubLogger(root, invoice.Subsystem, interceptor, invoice.UseLogger)
+	AddSubLogger(
+		root, compliance.Subsystem, interceptor, compliance.UseLogger,
+	)
+	AddSubLogger(root, tapmusig.Subsystem, interceptor, tapmusig.UseLogger)
 }
 
 // AddSubLogger is a helper method to conveniently create and register the
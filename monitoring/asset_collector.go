@@ -16,6 +16,8 @@ const (
 	numTotalSyncsMetric = "num_total_syncs"
 
 	numTotalProofsMetric = "num_total_proofs"
+
+	numSkippedSyncsMetric = "num_skipped_syncs"
 )
 
 // universeStatsCollector is a Prometheus collector that exports the stats of
@@ -27,6 +29,10 @@ type universeStatsCollector struct {
 	registry *prometheus.Registry
 
 	gauges map[string]prometheus.Gauge
+
+	// skippedSyncs is an optional counter of the universe root syncs that
+	// were skipped because the remote root didn't change.
+	skippedSyncs prometheus.CounterFunc
 }
 
 func newUniverseStatsCollector(cfg *PrometheusConfig,
@@ -69,10 +75,26 @@ func newUniverseStatsCollector(cfg *PrometheusConfig,
 		),
 	}
 
+	var skippedSyncs prometheus.CounterFunc
+	if cfg.UniverseSyncMetrics != nil {
+		syncMetrics := cfg.UniverseSyncMetrics
+		skippedSyncs = prometheus.NewCounterFunc(
+			prometheus.CounterOpts{
+				Name: numSkippedSyncsMetric,
+				Help: "Total number of universe syncs " +
+					"skipped because the remote root was " +
+					"unchanged",
+			}, func() float64 {
+				return float64(syncMetrics.NumSkippedSyncs())
+			},
+		)
+	}
+
 	return &universeStatsCollector{
-		cfg:      cfg,
-		registry: registry,
-		gauges:   gaugesMap,
+		cfg:          cfg,
+		registry:     registry,
+		gauges:       gaugesMap,
+		skippedSyncs: skippedSyncs,
 	}, nil
 }
 
@@ -88,6 +110,10 @@ func (a *universeStatsCollector) Describe(ch chan<- *prometheus.Desc) {
 	for _, gauge := range a.gauges {
 		gauge.Describe(ch)
 	}
+
+	if a.skippedSyncs != nil {
+		a.skippedSyncs.Describe(ch)
+	}
 }
 
 // Collect is called by the Prometheus registry when collecting metrics.
@@ -125,4 +151,8 @@ func (a *universeStatsCollector) Collect(ch chan<- prometheus.Metric) {
 	for _, gauge := range a.gauges {
 		gauge.Collect(ch)
 	}
+
+	if a.skippedSyncs != nil {
+		a.skippedSyncs.Collect(ch)
+	}
 }
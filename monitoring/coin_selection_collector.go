@@ -0,0 +1,142 @@
+package monitoring
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// coinSelectionAssetLabel is the label that identifies the asset ID or
+	// group key a coin selection was made for.
+	coinSelectionAssetLabel = "asset"
+)
+
+// coinSelectionCollector is a Prometheus collector that exports the metrics of
+// the most recent coin selection of each asset.
+type coinSelectionCollector struct {
+	collectMx sync.Mutex
+
+	cfg      *PrometheusConfig
+	registry *prometheus.Registry
+
+	candidatesVec    *prometheus.GaugeVec
+	selectedVec      *prometheus.GaugeVec
+	changeVec        *prometheus.GaugeVec
+	fragmentationVec *prometheus.GaugeVec
+}
+
+func newCoinSelectionCollector(cfg *PrometheusConfig,
+	registry *prometheus.Registry) (*coinSelectionCollector, error) {
+
+	if cfg == nil {
+		return nil, errors.New("coin selection collector prometheus " +
+			"cfg is nil")
+	}
+
+	if cfg.CoinSelectionLog == nil {
+		return nil, errors.New("coin selection collector log is nil")
+	}
+
+	labels := []string{coinSelectionAssetLabel}
+
+	return &coinSelectionCollector{
+		cfg:      cfg,
+		registry: registry,
+		candidatesVec: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "coin_selection_candidates",
+				Help: "Number of eligible coins in the last " +
+					"coin selection",
+			}, labels,
+		),
+		selectedVec: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "coin_selection_selected",
+				Help: "Number of selected coins in the last " +
+					"coin selection",
+			}, labels,
+		),
+		changeVec: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "coin_selection_change",
+				Help: "Change amount of the last coin " +
+					"selection",
+			}, labels,
+		),
+		fragmentationVec: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "coin_selection_fragmentation",
+				Help: "Fragmentation score between 0 and 1 " +
+					"of the eligible coins in the last " +
+					"coin selection",
+			}, labels,
+		),
+	}, nil
+}
+
+// Describe sends the super-set of all possible descriptors of metrics
+// collected by this Collector to the provided channel and returns once the
+// last descriptor has been sent.
+//
+// NOTE: Part of the prometheus.Collector interface.
+func (a *coinSelectionCollector) Describe(ch chan<- *prometheus.Desc) {
+	a.collectMx.Lock()
+	defer a.collectMx.Unlock()
+
+	a.candidatesVec.Describe(ch)
+	a.selectedVec.Describe(ch)
+	a.changeVec.Describe(ch)
+	a.fragmentationVec.Describe(ch)
+}
+
+// Collect is called by the Prometheus registry when collecting metrics.
+//
+// NOTE: Part of the prometheus.Collector interface.
+func (a *coinSelectionCollector) Collect(ch chan<- prometheus.Metric) {
+	a.collectMx.Lock()
+	defer a.collectMx.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	records, err := a.cfg.CoinSelectionLog.LatestCoinSelections(ctx)
+	if err != nil {
+		log.Errorf("unable to fetch latest coin selections: %v", err)
+		return
+	}
+
+	for _, record := range records {
+		var label string
+		switch {
+		case record.GroupKey != nil:
+			label = hex.EncodeToString(
+				record.GroupKey.SerializeCompressed(),
+			)
+
+		case record.AssetID != nil:
+			label = record.AssetID.String()
+		}
+
+		a.candidatesVec.WithLabelValues(label).Set(
+			float64(record.NumCandidates),
+		)
+		a.selectedVec.WithLabelValues(label).Set(
+			float64(record.NumSelected),
+		)
+		a.changeVec.WithLabelValues(label).Set(
+			float64(record.ChangeAmt),
+		)
+		a.fragmentationVec.WithLabelValues(label).Set(
+			record.FragmentationScore,
+		)
+	}
+
+	a.candidatesVec.Collect(ch)
+	a.selectedVec.Collect(ch)
+	a.changeVec.Collect(ch)
+	a.fragmentationVec.Collect(ch)
+}
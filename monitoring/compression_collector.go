@@ -0,0 +1,96 @@
+package monitoring
+
+import (
+	"sync"
+
+	"github.com/lightninglabs/taproot-assets/rpccompress"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// compressionBytesMetric is the name of the metric that counts the
+	// bytes of RPC messages before and after compression.
+	compressionBytesMetric = "rpc_compression_bytes_total"
+
+	// compressionRatioMetric is the name of the metric that tracks the
+	// compression ratio of RPC messages.
+	compressionRatioMetric = "rpc_compression_ratio"
+)
+
+// compressionCollector is a Prometheus collector that exports the number of
+// bytes compressed by each RPC compressor and the resulting compression
+// ratios.
+type compressionCollector struct {
+	collectMx sync.Mutex
+
+	bytes *prometheus.Desc
+	ratio *prometheus.Desc
+}
+
+func newCompressionCollector() *compressionCollector {
+	return &compressionCollector{
+		bytes: prometheus.NewDesc(
+			compressionBytesMetric,
+			"Total size of compressed RPC messages, before and "+
+				"after compression",
+			[]string{"algorithm", "direction", "stage"}, nil,
+		),
+		ratio: prometheus.NewDesc(
+			compressionRatioMetric,
+			"Ratio of the uncompressed to the compressed size of "+
+				"all compressed RPC messages",
+			[]string{"algorithm", "direction"}, nil,
+		),
+	}
+}
+
+// Describe sends the super-set of all possible descriptors of metrics
+// collected by this Collector to the provided channel and returns once the
+// last descriptor has been sent.
+//
+// NOTE: Part of the prometheus.Collector interface.
+func (c *compressionCollector) Describe(ch chan<- *prometheus.Desc) {
+	c.collectMx.Lock()
+	defer c.collectMx.Unlock()
+
+	ch <- c.bytes
+	ch <- c.ratio
+}
+
+// Collect is called by the Prometheus registry when collecting metrics.
+//
+// NOTE: Part of the prometheus.Collector interface.
+func (c *compressionCollector) Collect(ch chan<- prometheus.Metric) {
+	c.collectMx.Lock()
+	defer c.collectMx.Unlock()
+
+	for name, stats := range rpccompress.AllStats() {
+		sent, received := "sent", "received"
+		counters := []struct {
+			direction string
+			stage     string
+			value     uint64
+		}{
+			{sent, "uncompressed", stats.SentUncompressed},
+			{sent, "compressed", stats.SentCompressed},
+			{received, "uncompressed", stats.ReceivedUncompressed},
+			{received, "compressed", stats.ReceivedCompressed},
+		}
+		for _, counter := range counters {
+			ch <- prometheus.MustNewConstMetric(
+				c.bytes, prometheus.CounterValue,
+				float64(counter.value), name,
+				counter.direction, counter.stage,
+			)
+		}
+
+		ch <- prometheus.MustNewConstMetric(
+			c.ratio, prometheus.GaugeValue, stats.SentRatio(),
+			name, sent,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			c.ratio, prometheus.GaugeValue, stats.ReceivedRatio(),
+			name, received,
+		)
+	}
+}
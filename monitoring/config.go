@@ -1,7 +1,9 @@
 package monitoring
 
 import (
+	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/tapdb"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
 	"github.com/lightninglabs/taproot-assets/tapgarden"
 	"github.com/lightninglabs/taproot-assets/universe"
 	"google.golang.org/grpc"
@@ -26,6 +28,10 @@ type PrometheusConfig struct {
 	// universe.
 	UniverseStats universe.Telemetry
 
+	// UniverseSyncMetrics is used to collect any metrics about the syncs
+	// of the universe with remote servers.
+	UniverseSyncMetrics universe.SyncMetrics
+
 	// AssetStore is used to collect any stats that are relevant to the
 	// asset store.
 	AssetStore *tapdb.AssetStore
@@ -34,6 +40,14 @@ type PrometheusConfig struct {
 	// asset minter.
 	AssetMinter tapgarden.Planter
 
+	// CoinSelectionLog is used to collect the metrics of the most recent
+	// asset coin selections.
+	CoinSelectionLog tapfreighter.CoinSelectionLog
+
+	// ProofCourierHealth is used to collect the health metrics of the
+	// proof courier endpoints.
+	ProofCourierHealth *proof.CourierHealth
+
 	// PerfHistograms indicates if the additional histogram information for
 	// latency, and handling time of gRPC calls should be enabled. This
 	// generates additional data, and consume more memory for the
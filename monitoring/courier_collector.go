@@ -0,0 +1,115 @@
+package monitoring
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// courierEndpointLabel is the label that identifies a proof courier
+	// endpoint.
+	courierEndpointLabel = "endpoint"
+)
+
+// courierCollector is a Prometheus collector that exports the health metrics
+// of the proof courier endpoints.
+type courierCollector struct {
+	collectMx sync.Mutex
+
+	cfg *PrometheusConfig
+
+	successes           *prometheus.Desc
+	failures            *prometheus.Desc
+	consecutiveFailures *prometheus.Desc
+	healthy             *prometheus.Desc
+}
+
+func newCourierCollector(cfg *PrometheusConfig) (*courierCollector, error) {
+	if cfg == nil {
+		return nil, errors.New("courier collector prometheus cfg is " +
+			"nil")
+	}
+
+	if cfg.ProofCourierHealth == nil {
+		return nil, errors.New("courier collector health tracker is " +
+			"nil")
+	}
+
+	labels := []string{courierEndpointLabel}
+
+	return &courierCollector{
+		cfg: cfg,
+		successes: prometheus.NewDesc(
+			"proof_courier_successes_total",
+			"Number of successful proof deliveries and "+
+				"retrievals of a proof courier endpoint",
+			labels, nil,
+		),
+		failures: prometheus.NewDesc(
+			"proof_courier_failures_total",
+			"Number of failed proof deliveries of a proof "+
+				"courier endpoint",
+			labels, nil,
+		),
+		consecutiveFailures: prometheus.NewDesc(
+			"proof_courier_consecutive_failures",
+			"Number of failed proof deliveries of a proof "+
+				"courier endpoint since its last success",
+			labels, nil,
+		),
+		healthy: prometheus.NewDesc(
+			"proof_courier_healthy",
+			"Whether a proof courier endpoint is considered "+
+				"healthy (1) or not (0)",
+			labels, nil,
+		),
+	}, nil
+}
+
+// Describe sends the super-set of all possible descriptors of metrics
+// collected by this Collector to the provided channel and returns once the
+// last descriptor has been sent.
+//
+// NOTE: Part of the prometheus.Collector interface.
+func (c *courierCollector) Describe(ch chan<- *prometheus.Desc) {
+	c.collectMx.Lock()
+	defer c.collectMx.Unlock()
+
+	ch <- c.successes
+	ch <- c.failures
+	ch <- c.consecutiveFailures
+	ch <- c.healthy
+}
+
+// Collect is called by the Prometheus registry when collecting metrics.
+//
+// NOTE: Part of the prometheus.Collector interface.
+func (c *courierCollector) Collect(ch chan<- prometheus.Metric) {
+	c.collectMx.Lock()
+	defer c.collectMx.Unlock()
+
+	for _, stats := range c.cfg.ProofCourierHealth.Stats() {
+		var healthy float64
+		if stats.Healthy {
+			healthy = 1
+		}
+
+		ch <- prometheus.MustNewConstMetric(
+			c.successes, prometheus.CounterValue,
+			float64(stats.Successes), stats.Addr,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			c.failures, prometheus.CounterValue,
+			float64(stats.Failures), stats.Addr,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			c.consecutiveFailures, prometheus.GaugeValue,
+			float64(stats.ConsecutiveFailures), stats.Addr,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			c.healthy, prometheus.GaugeValue, healthy, stats.Addr,
+		)
+	}
+}
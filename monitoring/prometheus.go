@@ -74,6 +74,22 @@ func (p *PrometheusExporter) Start() error {
 	}
 	p.registry.MustRegister(gardenCollector)
 
+	coinSelectionCollector, err := newCoinSelectionCollector(
+		p.config, p.registry,
+	)
+	if err != nil {
+		return err
+	}
+	p.registry.MustRegister(coinSelectionCollector)
+
+	p.registry.MustRegister(newCompressionCollector())
+
+	courierCollector, err := newCourierCollector(p.config)
+	if err != nil {
+		return err
+	}
+	p.registry.MustRegister(courierCollector)
+
 	// Make ensure that all metrics exist when collecting and querying.
 	serverMetrics.InitializeMetrics(p.config.RPCServer)
 
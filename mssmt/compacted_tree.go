@@ -13,14 +13,29 @@ import (
 // for large trees.
 type CompactedTree struct {
 	store TreeStore
+
+	hasher Hasher
+
+	emptyTree []Node
 }
 
 var _ Tree = (*CompactedTree)(nil)
 
 // NewCompactedTree initializes an empty MS-SMT backed by `store`.
 func NewCompactedTree(store TreeStore) *CompactedTree {
+	return NewCompactedTreeWithHasher(store, DefaultHasher)
+}
+
+// NewCompactedTreeWithHasher initializes an empty MS-SMT backed by `store`
+// that computes all node hashes with the given hasher. The store must use the
+// same hasher for the empty nodes it returns.
+func NewCompactedTreeWithHasher(store TreeStore, h Hasher) *CompactedTree {
+	h = hasherOrDefault(h)
+
 	return &CompactedTree{
-		store: store,
+		store:     store,
+		hasher:    h,
+		emptyTree: EmptyTreeFor(h),
 	}
 }
 
@@ -70,12 +85,14 @@ func (t *CompactedTree) walkDown(tx TreeStoreViewTx, key *[hashSize]byte,
 		case *CompactedLeafNode:
 			// Our next node is a compacted leaf. We just need to
 			// expand it so we can continue our walk down the tree.
-			next = node.Extract(i)
+			next = node.ExtractWithHasher(i, t.hasher)
 
 			// Sibling might be a compacted leaf too, in which case
 			// we need to extract it as well.
 			if compSibling, ok := sibling.(*CompactedLeafNode); ok {
-				sibling = compSibling.Extract(i)
+				sibling = compSibling.ExtractWithHasher(
+					i, t.hasher,
+				)
 			}
 
 			// Now that all required branches are reconstructed we
@@ -136,8 +153,12 @@ func (t *CompactedTree) merge(tx TreeStoreUpdateTx, height int, key1 [32]byte,
 
 	// Now we create two compacted leaves and insert them as children of
 	// a newly created branch.
-	node1 := NewCompactedLeafNode(commonPrefixLen+1, &key1, leaf1)
-	node2 := NewCompactedLeafNode(commonPrefixLen+1, &key2, leaf2)
+	node1 := NewCompactedLeafNodeWithHasher(
+		commonPrefixLen+1, &key1, leaf1, t.hasher,
+	)
+	node2 := NewCompactedLeafNodeWithHasher(
+		commonPrefixLen+1, &key2, leaf2, t.hasher,
+	)
 	if err := tx.InsertCompactedLeaf(node1); err != nil {
 		return nil, err
 	}
@@ -147,7 +168,7 @@ func (t *CompactedTree) merge(tx TreeStoreUpdateTx, height int, key1 [32]byte,
 	}
 
 	left, right := stepOrder(commonPrefixLen, &key1, node1, node2)
-	parent := NewBranch(left, right)
+	parent := NewBranchWithHasher(left, right, t.hasher)
 	if err := tx.InsertBranch(parent); err != nil {
 		return nil, err
 	}
@@ -155,8 +176,8 @@ func (t *CompactedTree) merge(tx TreeStoreUpdateTx, height int, key1 [32]byte,
 	// From here we'll walk up to the current level and create branches
 	// along the way. Optionally we could compact these branches too.
 	for i := commonPrefixLen - 1; i >= height; i-- {
-		left, right := stepOrder(i, &key1, parent, EmptyTree[i+1])
-		parent = NewBranch(left, right)
+		left, right := stepOrder(i, &key1, parent, t.emptyTree[i+1])
+		parent = NewBranchWithHasher(left, right, t.hasher)
 		if err := tx.InsertBranch(parent); err != nil {
 			return nil, err
 		}
@@ -189,11 +210,13 @@ func (t *CompactedTree) insert(tx TreeStoreUpdateTx, key *[hashSize]byte,
 
 	switch node := next.(type) {
 	case *BranchNode:
-		if node == EmptyTree[nextHeight] {
+		if node == t.emptyTree[nextHeight] {
 			// This is an empty subtree, so we can just walk up
 			// from the leaf to recreate the node key for this
 			// subtree then replace it with a compacted leaf.
-			newLeaf := NewCompactedLeafNode(nextHeight, key, leaf)
+			newLeaf := NewCompactedLeafNodeWithHasher(
+				nextHeight, key, leaf, t.hasher,
+			)
 			err = tx.InsertCompactedLeaf(newLeaf)
 			if err != nil {
 				return nil, err
@@ -219,10 +242,10 @@ func (t *CompactedTree) insert(tx TreeStoreUpdateTx, key *[hashSize]byte,
 		if *key == node.key {
 			// Replace of an existing leaf.
 			if leaf.IsEmpty() {
-				newNode = EmptyTree[nextHeight]
+				newNode = t.emptyTree[nextHeight]
 			} else {
-				newLeaf := NewCompactedLeafNode(
-					nextHeight, key, leaf,
+				newLeaf := NewCompactedLeafNodeWithHasher(
+					nextHeight, key, leaf, t.hasher,
 				)
 
 				err := tx.InsertCompactedLeaf(newLeaf)
@@ -245,7 +268,7 @@ func (t *CompactedTree) insert(tx TreeStoreUpdateTx, key *[hashSize]byte,
 	}
 
 	// Delete the old root.
-	if root != EmptyTree[height] {
+	if root != t.emptyTree[height] {
 		err = tx.DeleteBranch(root.NodeHash())
 		if err != nil {
 			return nil, err
@@ -255,13 +278,13 @@ func (t *CompactedTree) insert(tx TreeStoreUpdateTx, key *[hashSize]byte,
 	// Create the new root.
 	var branch *BranchNode
 	if isLeft {
-		branch = NewBranch(newNode, sibling)
+		branch = NewBranchWithHasher(newNode, sibling, t.hasher)
 	} else {
-		branch = NewBranch(sibling, newNode)
+		branch = NewBranchWithHasher(sibling, newNode, t.hasher)
 	}
 
 	// Only insert this new branch if not a default one.
-	if !IsEqualNode(branch, EmptyTree[height]) {
+	if !IsEqualNode(branch, t.emptyTree[height]) {
 		err = tx.InsertBranch(branch)
 		if err != nil {
 			return nil, err
@@ -271,10 +294,17 @@ func (t *CompactedTree) insert(tx TreeStoreUpdateTx, key *[hashSize]byte,
 	return branch, nil
 }
 
+// emptyLeaf returns the empty leaf of the tree's hasher.
+func (t *CompactedTree) emptyLeaf() *LeafNode {
+	return t.emptyTree[MaxTreeLevels].(*LeafNode)
+}
+
 // Insert inserts a leaf node at the given key within the MS-SMT.
 func (t *CompactedTree) Insert(ctx context.Context, key [hashSize]byte,
 	leaf *LeafNode) (Tree, error) {
 
+	leaf = withHasher(leaf, t.hasher)
+
 	dbErr := t.store.Update(ctx, func(tx TreeStoreUpdateTx) error {
 		currentRoot, err := tx.RootNode()
 		if err != nil {
@@ -319,7 +349,7 @@ func (t *CompactedTree) Delete(ctx context.Context, key [hashSize]byte) (
 		}
 
 		root, err := t.insert(
-			tx, &key, 0, currentRoot.(*BranchNode), EmptyLeafNode,
+			tx, &key, 0, currentRoot.(*BranchNode), t.emptyLeaf(),
 		)
 		if err != nil {
 			return err
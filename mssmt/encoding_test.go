@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"io"
 	"strconv"
 	"testing"
+	"testing/iotest"
 
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/internal/test"
@@ -54,6 +56,37 @@ func TestBitPacking(t *testing.T) {
 	}
 }
 
+// TestCompressedProofStreamDecoding tests that a compressed proof can be
+// decoded from a reader that only returns a few bytes at a time, as is the
+// case when reading from the network, and that truncated proofs are rejected.
+func TestCompressedProofStreamDecoding(t *testing.T) {
+	t.Parallel()
+
+	leaves := randTree(100)
+	tree := mssmt.NewCompactedTree(mssmt.NewDefaultStore())
+	ctx := context.TODO()
+	for _, item := range leaves {
+		_, err := tree.Insert(ctx, item.key, item.leaf)
+		require.NoError(t, err)
+	}
+
+	proof, err := tree.MerkleProof(ctx, leaves[0].key)
+	require.NoError(t, err)
+	compressed := proof.Compress()
+
+	var buf bytes.Buffer
+	require.NoError(t, compressed.Encode(&buf))
+
+	var decoded mssmt.CompressedProof
+	err = decoded.Decode(iotest.OneByteReader(bytes.NewReader(buf.Bytes())))
+	require.NoError(t, err)
+	assertEqualCompressedProof(t, compressed, &decoded)
+
+	truncated := buf.Bytes()[:buf.Len()-1]
+	err = decoded.Decode(bytes.NewReader(truncated))
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
 func TestProofEncoding(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,235 @@
+package mssmt
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"sync"
+)
+
+const (
+	// leafDomain is the domain separation byte of leaf hashes of a
+	// TaggedHasher.
+	leafDomain byte = 0x00
+
+	// branchDomain is the domain separation byte of branch hashes of a
+	// TaggedHasher.
+	branchDomain byte = 0x01
+)
+
+var (
+	// DefaultHasher is the plain SHA-256 hasher without any domain
+	// separation that is used by all existing commitments. Changing it
+	// would change every node hash, so it must remain untouched.
+	DefaultHasher Hasher = &sha256Hasher{}
+
+	// emptyTrees caches the empty tree of every non-default hasher that
+	// was used so far, keyed by the hasher's tag.
+	emptyTrees   = make(map[string][]Node)
+	emptyTreesMu sync.Mutex
+)
+
+// Hasher is the hash function that is used to compute the node hashes of a
+// MS-SMT. Since the hashes are returned as a NodeHash, the hash function must
+// produce 32 byte digests.
+type Hasher interface {
+	// Tag returns the domain separation tag of the hasher. The tag must
+	// uniquely identify the hasher, as it is used to cache the nodes of an
+	// empty tree. Only the DefaultHasher has an empty tag.
+	Tag() string
+
+	// HashLeaf returns the node hash of a leaf with the given value and
+	// sum.
+	HashLeaf(value []byte, sum uint64) NodeHash
+
+	// HashBranch returns the node hash of a branch with the given child
+	// node hashes and sum.
+	HashBranch(left, right NodeHash, sum uint64) NodeHash
+}
+
+// sha256Hasher is the untagged SHA-256 hasher of the MS-SMT.
+type sha256Hasher struct{}
+
+// A compile-time assertion to make sure sha256Hasher satisfies the Hasher
+// interface.
+var _ Hasher = (*sha256Hasher)(nil)
+
+// Tag returns the domain separation tag of the hasher, which is empty as
+// the default hasher doesn't use domain separation.
+//
+// NOTE: This is part of the Hasher interface.
+func (s *sha256Hasher) Tag() string {
+	return ""
+}
+
+// HashLeaf returns the node hash of a leaf with the given value and sum.
+//
+// NOTE: This is part of the Hasher interface.
+func (s *sha256Hasher) HashLeaf(value []byte, sum uint64) NodeHash {
+	h := sha256.New()
+	h.Write(value)
+	_ = binary.Write(h, binary.BigEndian, sum)
+
+	return NodeHash(h.Sum(nil))
+}
+
+// HashBranch returns the node hash of a branch with the given child node
+// hashes and sum.
+//
+// NOTE: This is part of the Hasher interface.
+func (s *sha256Hasher) HashBranch(left, right NodeHash, sum uint64) NodeHash {
+	h := sha256.New()
+	h.Write(left[:])
+	h.Write(right[:])
+	_ = binary.Write(h, binary.BigEndian, sum)
+
+	return NodeHash(h.Sum(nil))
+}
+
+// TaggedHasher is a domain separated hasher, which can be used by trees that
+// must not share node hashes with the trees of the default commitments. Every
+// hash is prefixed with the twice repeated hash of the tag (similar to BIP-340
+// tagged hashes) and a byte that separates leaf from branch hashes.
+type TaggedHasher struct {
+	tag string
+
+	tagHash []byte
+
+	newHash func() hash.Hash
+}
+
+// A compile-time assertion to make sure TaggedHasher satisfies the Hasher
+// interface.
+var _ Hasher = (*TaggedHasher)(nil)
+
+// NewTaggedHasher creates a new domain separated hasher with the given tag on
+// top of the given hash function, which must produce 32 byte digests.
+func NewTaggedHasher(tag string, newHash func() hash.Hash) (*TaggedHasher,
+	error) {
+
+	if tag == "" {
+		return nil, fmt.Errorf("hasher tag must be set")
+	}
+
+	h := newHash()
+	if h.Size() != hashSize {
+		return nil, fmt.Errorf("invalid hash size %d, expected %d",
+			h.Size(), hashSize)
+	}
+
+	h.Write([]byte(tag))
+
+	return &TaggedHasher{
+		tag:     tag,
+		tagHash: h.Sum(nil),
+		newHash: newHash,
+	}, nil
+}
+
+// newTaggedHash returns a new hash instance that already committed to the tag
+// of the hasher and the given domain byte.
+func (t *TaggedHasher) newTaggedHash(domain byte) hash.Hash {
+	h := t.newHash()
+	h.Write(t.tagHash)
+	h.Write(t.tagHash)
+	h.Write([]byte{domain})
+
+	return h
+}
+
+// Tag returns the domain separation tag of the hasher.
+//
+// NOTE: This is part of the Hasher interface.
+func (t *TaggedHasher) Tag() string {
+	return t.tag
+}
+
+// HashLeaf returns the node hash of a leaf with the given value and sum.
+//
+// NOTE: This is part of the Hasher interface.
+func (t *TaggedHasher) HashLeaf(value []byte, sum uint64) NodeHash {
+	h := t.newTaggedHash(leafDomain)
+	h.Write(value)
+	_ = binary.Write(h, binary.BigEndian, sum)
+
+	return NodeHash(h.Sum(nil))
+}
+
+// HashBranch returns the node hash of a branch with the given child node
+// hashes and sum.
+//
+// NOTE: This is part of the Hasher interface.
+func (t *TaggedHasher) HashBranch(left, right NodeHash, sum uint64) NodeHash {
+	h := t.newTaggedHash(branchDomain)
+	h.Write(left[:])
+	h.Write(right[:])
+	_ = binary.Write(h, binary.BigEndian, sum)
+
+	return NodeHash(h.Sum(nil))
+}
+
+// hasherOrDefault returns the given hasher, or the DefaultHasher if it isn't
+// set.
+func hasherOrDefault(h Hasher) Hasher {
+	if h == nil {
+		return DefaultHasher
+	}
+
+	return h
+}
+
+// isDefaultHasher returns true if the given hasher is the DefaultHasher.
+func isDefaultHasher(h Hasher) bool {
+	return h == nil || h.Tag() == ""
+}
+
+// newEmptyTree computes all nodes up to the root of a MS-SMT in which all the
+// leaves are the given empty leaf, using the given hasher. The empty leaf must
+// be hashed with the same hasher.
+func newEmptyTree(emptyLeaf *LeafNode, h Hasher) []Node {
+	// Force the calculation of the node key for the empty node. This will
+	// ensure the value is fully cached for the loop below.
+	emptyLeaf.NodeHash()
+
+	// Initialize the empty MS-SMT by starting from an empty leaf and
+	// hashing all the way up to the root.
+	emptyTree := make([]Node, MaxTreeLevels+1)
+	emptyTree[MaxTreeLevels] = emptyLeaf
+	for i := lastBitIndex; i >= 0; i-- {
+		// Create the branch and force the calculation of the node key.
+		// At this point we already have computed the keys of each of
+		// the siblings, so those cached values can be used here. If we
+		// don't do this, then concurrent callers will attempt to
+		// read/populate this value causing a race condition.
+		branch := NewBranchWithHasher(
+			emptyTree[i+1], emptyTree[i+1], h,
+		)
+		branch.NodeHash()
+		branch.NodeSum()
+
+		emptyTree[i] = branch
+	}
+
+	return emptyTree
+}
+
+// EmptyTreeFor returns all nodes up to the root of a MS-SMT in which all the
+// leaves are empty, computed with the given hasher. The nodes of the default
+// hasher are the ones of EmptyTree.
+func EmptyTreeFor(h Hasher) []Node {
+	if isDefaultHasher(h) {
+		return EmptyTree
+	}
+
+	emptyTreesMu.Lock()
+	defer emptyTreesMu.Unlock()
+
+	emptyTree, ok := emptyTrees[h.Tag()]
+	if !ok {
+		emptyTree = newEmptyTree(NewLeafNodeWithHasher(nil, 0, h), h)
+		emptyTrees[h.Tag()] = emptyTree
+	}
+
+	return emptyTree
+}
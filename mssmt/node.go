@@ -2,7 +2,6 @@ package mssmt
 
 import (
 	"crypto/sha256"
-	"encoding/binary"
 	"encoding/hex"
 )
 
@@ -68,6 +67,19 @@ func NewLeafNode(value []byte, sum uint64) *LeafNode {
 	}
 }
 
+// NewLeafNodeWithHasher constructs a new leaf node whose node hash is computed
+// with the given hasher. The node hash is computed right away, so the leaf
+// doesn't need to know about the hasher.
+func NewLeafNodeWithHasher(value []byte, sum uint64, h Hasher) *LeafNode {
+	leaf := NewLeafNode(value, sum)
+	if !isDefaultHasher(h) {
+		nodeHash := h.HashLeaf(value, sum)
+		leaf.nodeHash = &nodeHash
+	}
+
+	return leaf
+}
+
 // NodeHash returns the unique identifier for a MS-SMT node. It represents the
 // hash of the leaf committing to its internal data.
 func (n *LeafNode) NodeHash() NodeHash {
@@ -75,10 +87,8 @@ func (n *LeafNode) NodeHash() NodeHash {
 		return *n.nodeHash
 	}
 
-	h := sha256.New()
-	h.Write(n.Value)
-	_ = binary.Write(h, binary.BigEndian, n.sum)
-	n.nodeHash = (*NodeHash)(h.Sum(nil))
+	nodeHash := DefaultHasher.HashLeaf(n.Value, n.sum)
+	n.nodeHash = &nodeHash
 	return *n.nodeHash
 }
 
@@ -128,12 +138,27 @@ type CompactedLeafNode struct {
 func NewCompactedLeafNode(height int, key *[32]byte,
 	leaf *LeafNode) *CompactedLeafNode {
 
+	return NewCompactedLeafNodeWithHasher(height, key, leaf, DefaultHasher)
+}
+
+// NewCompactedLeafNodeWithHasher creates a new compacted leaf at the passed
+// height with the passed leaf key, whose omitted branches are hashed with the
+// given hasher.
+func NewCompactedLeafNodeWithHasher(height int, key *[32]byte,
+	leaf *LeafNode, hasher Hasher) *CompactedLeafNode {
+
+	emptyTree := EmptyTreeFor(hasher)
+
 	var current Node = leaf
 	for i := lastBitIndex; i >= height; i-- {
 		if bitIndex(uint8(i), key) == 0 {
-			current = NewBranch(current, EmptyTree[i+1])
+			current = NewBranchWithHasher(
+				current, emptyTree[i+1], hasher,
+			)
 		} else {
-			current = NewBranch(EmptyTree[i+1], current)
+			current = NewBranchWithHasher(
+				emptyTree[i+1], current, hasher,
+			)
 		}
 	}
 	nodeHash := current.NodeHash()
@@ -160,18 +185,27 @@ func (c *CompactedLeafNode) Key() [32]byte {
 // Extract extracts the subtree represented by this compacted leaf and returns
 // the topmost node in the tree.
 func (c *CompactedLeafNode) Extract(height int) Node {
+	return c.ExtractWithHasher(height, DefaultHasher)
+}
+
+// ExtractWithHasher extracts the subtree represented by this compacted leaf
+// of a tree that uses the given hasher and returns the topmost node in the
+// tree.
+func (c *CompactedLeafNode) ExtractWithHasher(height int, hasher Hasher) Node {
+	emptyTree := EmptyTreeFor(hasher)
+
 	var current Node = c.LeafNode
 
 	// Walk up and recreate the missing branches.
 	for j := MaxTreeLevels; j > height+1; j-- {
 		var left, right Node
 		if bitIndex(uint8(j-1), &c.key) == 0 {
-			left, right = current, EmptyTree[j]
+			left, right = current, emptyTree[j]
 		} else {
-			left, right = EmptyTree[j], current
+			left, right = emptyTree[j], current
 		}
 
-		current = NewBranch(left, right)
+		current = NewBranchWithHasher(left, right, hasher)
 	}
 
 	return current
@@ -216,6 +250,22 @@ func NewBranch(left, right Node) *BranchNode {
 	}
 }
 
+// NewBranchWithHasher constructs a new branch backed by its left and right
+// children, whose node hash is computed with the given hasher. The node hash
+// is computed right away, so the branch doesn't need to know about the
+// hasher.
+func NewBranchWithHasher(left, right Node, h Hasher) *BranchNode {
+	branch := NewBranch(left, right)
+	if !isDefaultHasher(h) {
+		nodeHash := h.HashBranch(
+			left.NodeHash(), right.NodeHash(), branch.NodeSum(),
+		)
+		branch.nodeHash = &nodeHash
+	}
+
+	return branch
+}
+
 // NodeHash returns the unique identifier for a MS-SMT node. It represents the
 // hash of the branch committing to its internal data.
 func (n *BranchNode) NodeHash() NodeHash {
@@ -223,14 +273,10 @@ func (n *BranchNode) NodeHash() NodeHash {
 		return *n.nodeHash
 	}
 
-	left := n.Left.NodeHash()
-	right := n.Right.NodeHash()
-
-	h := sha256.New()
-	h.Write(left[:])
-	h.Write(right[:])
-	_ = binary.Write(h, binary.BigEndian, n.NodeSum())
-	n.nodeHash = (*NodeHash)(h.Sum(nil))
+	nodeHash := DefaultHasher.HashBranch(
+		n.Left.NodeHash(), n.Right.NodeHash(), n.NodeSum(),
+	)
+	n.nodeHash = &nodeHash
 	return *n.nodeHash
 }
 
@@ -260,10 +306,20 @@ func (n *BranchNode) Copy() Node {
 		*sumCopy = *n.sum
 	}
 
+	// A computed branch, as returned by a tree store, doesn't have its
+	// children available.
+	var left, right Node
+	if n.Left != nil {
+		left = NewComputedNode(n.Left.NodeHash(), n.Left.NodeSum())
+	}
+	if n.Right != nil {
+		right = NewComputedNode(n.Right.NodeHash(), n.Right.NodeSum())
+	}
+
 	return &BranchNode{
 		nodeHash: nodeHashCopy,
-		Left:     NewComputedNode(n.Left.NodeHash(), n.Left.NodeSum()),
-		Right:    NewComputedNode(n.Right.NodeHash(), n.Right.NodeSum()),
+		Left:     left,
+		Right:    right,
 		sum:      sumCopy,
 	}
 }
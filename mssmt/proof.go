@@ -43,9 +43,20 @@ func NewProof(nodes []Node) *Proof {
 
 // Root returns the root node obtained by walking up the tree.
 func (p Proof) Root(key [32]byte, leaf *LeafNode) *BranchNode {
+	return p.RootWithHasher(key, leaf, DefaultHasher)
+}
+
+// RootWithHasher returns the root node obtained by walking up a tree that uses
+// the given hasher. The leaf is hashed with the given hasher as well.
+func (p Proof) RootWithHasher(key [32]byte, leaf *LeafNode,
+	h Hasher) *BranchNode {
+
+	h = hasherOrDefault(h)
+	leaf = withHasher(leaf, h)
+
 	// Note that we don't need to check the error here since the only point
 	// where the error could come from is the passed iterator which is nil.
-	node, _ := walkUp(&key, leaf, p.Nodes, nil)
+	node, _ := walkUp(&key, leaf, p.Nodes, h, nil)
 	return node
 }
 
@@ -61,16 +72,23 @@ func (p Proof) Copy() *Proof {
 // Compress compresses a merkle proof by replacing its empty nodes with a bit
 // vector.
 func (p Proof) Compress() *CompressedProof {
+	return p.CompressWithHasher(DefaultHasher)
+}
+
+// CompressWithHasher compresses a merkle proof of a tree that uses the given
+// hasher by replacing its empty nodes with a bit vector.
+func (p Proof) CompressWithHasher(h Hasher) *CompressedProof {
 	var (
-		bits  = make([]bool, len(p.Nodes))
-		nodes []Node
+		bits      = make([]bool, len(p.Nodes))
+		nodes     []Node
+		emptyTree = EmptyTreeFor(h)
 	)
 	for idx := range p.Nodes {
 		node := p.Nodes[idx]
 
 		// The proof nodes start at the leaf, while the EmptyTree starts
 		// at the root.
-		if node.NodeHash() == EmptyTree[MaxTreeLevels-idx].NodeHash() {
+		if node.NodeHash() == emptyTree[MaxTreeLevels-idx].NodeHash() {
 			bits[idx] = true
 		} else {
 			nodes = append(nodes, node)
@@ -85,8 +103,16 @@ func (p Proof) Compress() *CompressedProof {
 // Decompress decompresses a compressed merkle proof by replacing its bit vector
 // with the empty nodes it represents.
 func (p *CompressedProof) Decompress() (*Proof, error) {
+	return p.DecompressWithHasher(DefaultHasher)
+}
+
+// DecompressWithHasher decompresses a compressed merkle proof of a tree that
+// uses the given hasher by replacing its bit vector with the empty nodes it
+// represents.
+func (p *CompressedProof) DecompressWithHasher(h Hasher) (*Proof, error) {
 	nextNodeIdx := 0
 	nodes := make([]Node, len(p.Bits))
+	emptyTree := EmptyTreeFor(h)
 
 	// The number of 0 bits should match the number of pre-populated nodes.
 	numExpectedNodes := fn.Reduce(p.Bits, func(count int, bit bool) int {
@@ -106,7 +132,7 @@ func (p *CompressedProof) Decompress() (*Proof, error) {
 		if bitSet {
 			// The proof nodes start at the leaf, while the
 			// EmptyTree starts at the root.
-			nodes[i] = EmptyTree[MaxTreeLevels-i]
+			nodes[i] = emptyTree[MaxTreeLevels-i]
 		} else {
 			nodes[i] = p.Nodes[nextNodeIdx]
 			nextNodeIdx++
@@ -130,6 +130,10 @@ type DefaultStore struct {
 
 	root *BranchNode
 
+	// emptyTree holds the empty nodes of the hasher used by the trees
+	// backed by this store.
+	emptyTree []Node
+
 	cntReads   int
 	cntWrites  int
 	cntDeletes int
@@ -139,10 +143,17 @@ var _ TreeStore = (*DefaultStore)(nil)
 
 // NewDefaultStore initializes a new DefaultStore.
 func NewDefaultStore() *DefaultStore {
+	return NewDefaultStoreWithHasher(DefaultHasher)
+}
+
+// NewDefaultStoreWithHasher initializes a new DefaultStore for trees that use
+// the given hasher.
+func NewDefaultStoreWithHasher(h Hasher) *DefaultStore {
 	return &DefaultStore{
 		branches:        make(map[NodeHash]*BranchNode),
 		leaves:          make(map[NodeHash]*LeafNode),
 		compactedLeaves: make(map[NodeHash]*CompactedLeafNode),
+		emptyTree:       EmptyTreeFor(h),
 	}
 }
 
@@ -197,7 +208,7 @@ func (d *DefaultStore) UpdateRoot(node *BranchNode) error {
 // RootNode returns the root node of the tree.
 func (d *DefaultStore) RootNode() (Node, error) {
 	if d.root == nil {
-		return EmptyTree[0], nil
+		return d.emptyTree[0], nil
 	}
 
 	return d.root, nil
@@ -284,8 +295,8 @@ func (d *DefaultStore) GetChildren(height int, key NodeHash) (
 	Node, Node, error) {
 
 	getNode := func(height uint, key NodeHash) Node {
-		if key == EmptyTree[height].NodeHash() {
-			return EmptyTree[height]
+		if key == d.emptyTree[height].NodeHash() {
+			return d.emptyTree[height]
 		}
 		if branch, ok := d.branches[key]; ok {
 			d.cntReads++
@@ -302,12 +313,13 @@ func (d *DefaultStore) GetChildren(height int, key NodeHash) (
 			return leaf
 		}
 
-		return EmptyTree[height]
+		return d.emptyTree[height]
 	}
 
 	node := getNode(uint(height), key)
 
-	if key != EmptyTree[height].NodeHash() && node == EmptyTree[height] {
+	emptyNode := d.emptyTree[height]
+	if key != emptyNode.NodeHash() && node == emptyNode {
 		return nil, nil, fmt.Errorf("node not found")
 	}
 
@@ -32,27 +32,7 @@ var (
 )
 
 func init() {
-	// Force the calculation of the node key for the empty node. This will
-	// ensure the value is fully cached for the loop below.
-	EmptyLeafNode.NodeHash()
-
-	// Initialize the empty MS-SMT by starting from an empty leaf and
-	// hashing all the way up to the root.
-	EmptyTree = make([]Node, MaxTreeLevels+1)
-	EmptyTree[MaxTreeLevels] = EmptyLeafNode
-	for i := lastBitIndex; i >= 0; i-- {
-		// Create the branch and force the calculation of the node key.
-		// At this point we already have computed the keys of each of
-		// the siblings, so those cached values can be used here. If we
-		// don't do this, then concurrent callers will attempt to
-		// read/populate this value causing a race condition.
-		branch := NewBranch(EmptyTree[i+1], EmptyTree[i+1])
-		branch.NodeHash()
-		branch.NodeSum()
-
-		EmptyTree[i] = branch
-	}
-
+	EmptyTree = newEmptyTree(EmptyLeafNode, DefaultHasher)
 	EmptyTreeRootHash = EmptyTree[0].NodeHash()
 }
 
@@ -63,6 +43,10 @@ func init() {
 // proofs of invalid merkle sum commitments.
 type FullTree struct {
 	store TreeStore
+
+	hasher Hasher
+
+	emptyTree []Node
 }
 
 var _ Tree = (*FullTree)(nil)
@@ -71,8 +55,19 @@ var _ Tree = (*FullTree)(nil)
 // `store` will only maintain non-empty relevant nodes, i.e., stale parents are
 // deleted and empty nodes are never stored.
 func NewFullTree(store TreeStore) *FullTree {
+	return NewFullTreeWithHasher(store, DefaultHasher)
+}
+
+// NewFullTreeWithHasher initializes an empty MS-SMT backed by `store` that
+// computes all node hashes with the given hasher. The store must use the same
+// hasher for the empty nodes it returns.
+func NewFullTreeWithHasher(store TreeStore, h Hasher) *FullTree {
+	h = hasherOrDefault(h)
+
 	return &FullTree{
-		store: store,
+		store:     store,
+		hasher:    h,
+		emptyTree: EmptyTreeFor(h),
 	}
 }
 
@@ -136,18 +131,19 @@ func (t *FullTree) walkDown(tx TreeStoreViewTx, key *[hashSize]byte,
 }
 
 // walkUp walks up from the `start` leaf node up to the root with the help of
-// `siblings`. The root branch node computed is returned.
+// `siblings`. The branches are hashed with the given hasher, which must be the
+// one the leaf was hashed with. The root branch node computed is returned.
 func walkUp(key *[hashSize]byte, start *LeafNode, siblings []Node,
-	iter iterFunc) (*BranchNode, error) {
+	hasher Hasher, iter iterFunc) (*BranchNode, error) {
 
 	var current Node = start
 	for i := lastBitIndex; i >= 0; i-- {
 		sibling := siblings[lastBitIndex-i]
 		var parent Node
 		if bitIndex(uint8(i), key) == 0 {
-			parent = NewBranch(current, sibling)
+			parent = NewBranchWithHasher(current, sibling, hasher)
 		} else {
-			parent = NewBranch(sibling, current)
+			parent = NewBranchWithHasher(sibling, current, hasher)
 		}
 		if iter != nil {
 			err := iter(i, current, sibling, parent)
@@ -183,18 +179,19 @@ func (t *FullTree) insert(tx TreeStoreUpdateTx, key *[hashSize]byte,
 	// back up to the root, updating any stale and new intermediate branch
 	// nodes.
 	root, err := walkUp(
-		key, leaf, siblings, func(i int, _, _, parent Node) error {
+		key, leaf, siblings, t.hasher,
+		func(i int, _, _, parent Node) error {
 			// Replace the old parent with the new one. Our store
 			// should never track empty branches.
 			prevParent := prevParents[MaxTreeLevels-1-i]
-			if prevParent != EmptyTree[i].NodeHash() {
+			if prevParent != t.emptyTree[i].NodeHash() {
 				err := tx.DeleteBranch(prevParent)
 				if err != nil {
 					return err
 				}
 			}
 
-			if parent.NodeHash() != EmptyTree[i].NodeHash() {
+			if parent.NodeHash() != t.emptyTree[i].NodeHash() {
 				err := tx.InsertBranch(parent.(*BranchNode))
 				if err != nil {
 					return err
@@ -224,10 +221,28 @@ func (t *FullTree) insert(tx TreeStoreUpdateTx, key *[hashSize]byte,
 	return root, nil
 }
 
+// emptyLeaf returns the empty leaf of the tree's hasher.
+func (t *FullTree) emptyLeaf() *LeafNode {
+	return t.emptyTree[MaxTreeLevels].(*LeafNode)
+}
+
+// withHasher returns the given leaf if the given hasher is the DefaultHasher,
+// which leaves are hashed with by default, or a copy of it that is hashed with
+// the given hasher.
+func withHasher(leaf *LeafNode, h Hasher) *LeafNode {
+	if isDefaultHasher(h) {
+		return leaf
+	}
+
+	return NewLeafNodeWithHasher(leaf.Value, leaf.sum, h)
+}
+
 // Insert inserts a leaf node at the given key within the MS-SMT.
 func (t *FullTree) Insert(ctx context.Context, key [hashSize]byte,
 	leaf *LeafNode) (Tree, error) {
 
+	leaf = withHasher(leaf, t.hasher)
+
 	err := t.store.Update(ctx, func(tx TreeStoreUpdateTx) error {
 		currentRoot, err := t.Root(ctx)
 		if err != nil {
@@ -264,7 +279,7 @@ func (t *FullTree) Delete(ctx context.Context, key [hashSize]byte) (
 	Tree, error) {
 
 	err := t.store.Update(ctx, func(tx TreeStoreUpdateTx) error {
-		root, err := t.insert(tx, &key, EmptyLeafNode)
+		root, err := t.insert(tx, &key, t.emptyLeaf())
 		if err != nil {
 			return err
 		}
@@ -338,7 +353,17 @@ func (t *FullTree) MerkleProof(ctx context.Context, key [hashSize]byte) (
 func VerifyMerkleProof(key [hashSize]byte, leaf *LeafNode, proof *Proof,
 	root Node) bool {
 
-	return IsEqualNode(proof.Root(key, leaf), root)
+	return VerifyMerkleProofWithHasher(
+		key, leaf, proof, root, DefaultHasher,
+	)
+}
+
+// VerifyMerkleProofWithHasher determines whether a merkle proof for the leaf
+// found at the given key of a tree that uses the given hasher is valid.
+func VerifyMerkleProofWithHasher(key [hashSize]byte, leaf *LeafNode,
+	proof *Proof, root Node, h Hasher) bool {
+
+	return IsEqualNode(proof.RootWithHasher(key, leaf, h), root)
 }
 
 // CheckSumOverflowUint64 checks if the sum of two uint64 values will overflow.
@@ -5,6 +5,8 @@ package mssmt_test
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"crypto/sha512"
 	"encoding/hex"
 	"fmt"
 	"math"
@@ -996,3 +998,91 @@ func runBIPTestVector(t *testing.T, testVectors *mssmt.TestVectors) {
 		})
 	}
 }
+
+// TestTaggedHasher tests that trees using a domain separated hasher produce
+// the same roots and valid proofs for both tree implementations, which are
+// different from the ones of the default hasher.
+func TestTaggedHasher(t *testing.T) {
+	t.Parallel()
+
+	_, err := mssmt.NewTaggedHasher("", sha256.New)
+	require.ErrorContains(t, err, "tag must be set")
+	_, err = mssmt.NewTaggedHasher("test", sha512.New)
+	require.ErrorContains(t, err, "invalid hash size")
+
+	hasher, err := mssmt.NewTaggedHasher("test", sha256.New)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	leaves := randTree(100)
+
+	emptyTree := mssmt.EmptyTreeFor(hasher)
+	require.NotEqual(
+		t, mssmt.EmptyTreeRootHash, emptyTree[0].NodeHash(),
+	)
+
+	defaultTree := mssmt.NewFullTree(mssmt.NewDefaultStore())
+	fullTree := mssmt.NewFullTreeWithHasher(
+		mssmt.NewDefaultStoreWithHasher(hasher), hasher,
+	)
+	smolTree := mssmt.NewCompactedTreeWithHasher(
+		mssmt.NewDefaultStoreWithHasher(hasher), hasher,
+	)
+	for _, item := range leaves {
+		for _, tree := range []mssmt.Tree{
+			defaultTree, fullTree, smolTree,
+		} {
+			_, err := tree.Insert(ctx, item.key, item.leaf)
+			require.NoError(t, err)
+		}
+	}
+
+	defaultRoot, err := defaultTree.Root(ctx)
+	require.NoError(t, err)
+	fullRoot, err := fullTree.Root(ctx)
+	require.NoError(t, err)
+	smolRoot, err := smolTree.Root(ctx)
+	require.NoError(t, err)
+
+	// Both tree implementations should arrive at the same root, which
+	// commits to the same sum as the default tree but with a different
+	// hash.
+	require.True(t, mssmt.IsEqualNode(fullRoot, smolRoot))
+	require.Equal(t, defaultRoot.NodeSum(), fullRoot.NodeSum())
+	require.NotEqual(t, defaultRoot.NodeHash(), fullRoot.NodeHash())
+
+	for _, item := range leaves {
+		for _, tree := range []mssmt.Tree{fullTree, smolTree} {
+			proof, err := tree.MerkleProof(ctx, item.key)
+			require.NoError(t, err)
+
+			// Proofs must be verified with the hasher of the tree.
+			require.True(t, mssmt.VerifyMerkleProofWithHasher(
+				item.key, item.leaf, proof, fullRoot, hasher,
+			))
+			require.False(t, mssmt.VerifyMerkleProof(
+				item.key, item.leaf, proof, fullRoot,
+			))
+
+			compressed := proof.CompressWithHasher(hasher)
+			decompressed, err := compressed.DecompressWithHasher(
+				hasher,
+			)
+			require.NoError(t, err)
+			require.Equal(t, proof, decompressed)
+		}
+	}
+
+	// Deleting all leaves should result in the empty tree of the hasher.
+	for _, item := range leaves {
+		for _, tree := range []mssmt.Tree{fullTree, smolTree} {
+			_, err := tree.Delete(ctx, item.key)
+			require.NoError(t, err)
+		}
+	}
+	for _, tree := range []mssmt.Tree{fullTree, smolTree} {
+		root, err := tree.Root(ctx)
+		require.NoError(t, err)
+		require.True(t, mssmt.IsEqualNode(emptyTree[0], root))
+	}
+}
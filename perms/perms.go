@@ -20,6 +20,10 @@ var (
 			Entity: "daemon",
 			Action: "read",
 		}},
+		"/taprpc.TaprootAssets/GetDatabaseHealth": {{
+			Entity: "daemon",
+			Action: "read",
+		}},
 		"/taprpc.TaprootAssets/ListAssets": {{
 			Entity: "assets",
 			Action: "read",
@@ -28,10 +32,22 @@ var (
 			Entity: "assets",
 			Action: "read",
 		}},
+		"/taprpc.TaprootAssets/SetUtxoNote": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/FetchUtxoNote": {{
+			Entity: "assets",
+			Action: "read",
+		}},
 		"/taprpc.TaprootAssets/ListGroups": {{
 			Entity: "assets",
 			Action: "read",
 		}},
+		"/taprpc.TaprootAssets/QueryGroupSummary": {{
+			Entity: "assets",
+			Action: "read",
+		}},
 		"/taprpc.TaprootAssets/ListBalances": {{
 			Entity: "assets",
 			Action: "read",
@@ -40,6 +56,22 @@ var (
 			Entity: "assets",
 			Action: "read",
 		}},
+		"/taprpc.TaprootAssets/ListCoinSelections": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/GenerateStatement": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/QueryFeeSpend": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/DiffAssetSnapshots": {{
+			Entity: "assets",
+			Action: "read",
+		}},
 		"/taprpc.TaprootAssets/QueryAddrs": {{
 			Entity: "addresses",
 			Action: "read",
@@ -56,6 +88,46 @@ var (
 			Entity: "addresses",
 			Action: "read",
 		}},
+		"/taprpc.TaprootAssets/SetAddrNote": {{
+			Entity: "addresses",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/SetAddrExpiry": {{
+			Entity: "addresses",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/ArchiveAddr": {{
+			Entity: "addresses",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/UnarchiveAddr": {{
+			Entity: "addresses",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/NewStaticAddr": {{
+			Entity: "addresses",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/ListStaticAddrs": {{
+			Entity: "addresses",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/ImportStaticPaymentTx": {{
+			Entity: "addresses",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/AddContact": {{
+			Entity: "addresses",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/ListContacts": {{
+			Entity: "addresses",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/DeleteContact": {{
+			Entity: "addresses",
+			Action: "write",
+		}},
 		"/taprpc.TaprootAssets/VerifyProof": {{
 			Entity: "proofs",
 			Action: "read",
@@ -68,14 +140,54 @@ var (
 			Entity: "proofs",
 			Action: "read",
 		}},
+		"/taprpc.TaprootAssets/BulkImportProofs": {{
+			Entity: "proofs",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/ProofImportStatus": {{
+			Entity: "proofs",
+			Action: "read",
+		}},
 		"/taprpc.TaprootAssets/SendAsset": {{
 			Entity: "assets",
 			Action: "write",
 		}},
+		"/taprpc.TaprootAssets/SendToScriptKey": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/SendStaticPayment": {{
+			Entity: "assets",
+			Action: "write",
+		}},
 		"/taprpc.TaprootAssets/BurnAsset": {{
 			Entity: "assets",
 			Action: "write",
 		}},
+		"/taprpc.TaprootAssets/BumpTransferFee": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/CancelTransfer": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/SetSendLimit": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/RemoveSendLimit": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/ListSendLimits": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/ListSendTotals": {{
+			Entity: "assets",
+			Action: "read",
+		}},
 		"/taprpc.TaprootAssets/FetchAssetMeta": {{
 			Entity: "assets",
 			Action: "read",
@@ -140,6 +252,54 @@ var (
 			Entity: "assets",
 			Action: "write",
 		}},
+		"/assetwalletrpc.AssetWallet/ValidateVirtualPsbt": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/assetwalletrpc.AssetWallet/InspectVirtualPsbt": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/assetwalletrpc.AssetWallet/CreateMuSig2Session": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/RegisterMuSig2Nonce": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/SignMuSig2Session": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/RegisterMuSig2PartialSig": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/FinalizeMuSig2Session": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/AbortMuSig2Session": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/ListMuSig2Sessions": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/assetwalletrpc.AssetWallet/ExportAnchorPsbt": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/ImportSignedAnchorPsbt": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/CancelAnchorExport": {{
+			Entity: "assets",
+			Action: "write",
+		}},
 		"/mintrpc.Mint/MintAsset": {{
 			Entity: "mint",
 			Action: "write",
@@ -152,10 +312,50 @@ var (
 			Entity: "mint",
 			Action: "write",
 		}},
+		"/mintrpc.Mint/CreateGroupSession": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/RegisterGroupSessionNonce": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/SignGroupSession": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/RegisterGroupSessionPartialSig": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/FinalizeGroupSession": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/AbortGroupSession": {{
+			Entity: "mint",
+			Action: "write",
+		}},
 		"/mintrpc.Mint/FinalizeBatch": {{
 			Entity: "mint",
 			Action: "write",
 		}},
+		"/mintrpc.Mint/SubmitGenesisSigs": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/PreviewBatch": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/ScheduleBatch": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/GetBatchSchedule": {{
+			Entity: "mint",
+			Action: "read",
+		}},
 		"/mintrpc.Mint/CancelBatch": {{
 			Entity: "mint",
 			Action: "write",
@@ -196,6 +396,10 @@ var (
 			Entity: "universe",
 			Action: "read",
 		}},
+		"/universerpc.Universe/QueryAssetMeta": {{
+			Entity: "universe",
+			Action: "read",
+		}},
 		"/universerpc.Universe/InsertProof": {{
 			Entity: "universe",
 			Action: "write",
@@ -236,6 +440,42 @@ var (
 			Entity: "universe",
 			Action: "read",
 		}},
+		"/universerpc.Universe/ListFederationProfiles": {{
+			Entity: "universe",
+			Action: "read",
+		}},
+		"/universerpc.Universe/SaveFederationProfile": {{
+			Entity: "universe",
+			Action: "write",
+		}},
+		"/universerpc.Universe/ApplyFederationProfile": {{
+			Entity: "universe",
+			Action: "write",
+		}},
+		"/universerpc.Universe/DeleteFederationProfile": {{
+			Entity: "universe",
+			Action: "write",
+		}},
+		"/universerpc.Universe/SubscribeLeaves": {{
+			Entity: "universe",
+			Action: "read",
+		}},
+		"/universerpc.Universe/SubscribeUniverseRoots": {{
+			Entity: "universe",
+			Action: "read",
+		}},
+		"/universerpc.Universe/PushProof": {{
+			Entity: "universe",
+			Action: "write",
+		}},
+		"/universerpc.Universe/NotifyRootUpdate": {{
+			Entity: "universe",
+			Action: "write",
+		}},
+		"/universerpc.Universe/GossipServerList": {{
+			Entity: "universe",
+			Action: "read",
+		}},
 		"/rfqrpc.Rfq/AddAssetBuyOrder": {{
 			Entity: "rfq",
 			Action: "write",
@@ -287,13 +527,18 @@ var (
 	//
 	// For now, these are the Universe related read/write methods. We permit
 	// InsertProof as a valid proof requires an on-chain transaction, so we
-	// gain a layer of DoS defense.
+	// gain a layer of DoS defense. Root update notifications and server
+	// lists are exchanged with federation members that don't have a
+	// macaroon, but are only accepted if they are signed with the identity
+	// key of the member.
 	defaultMacaroonWhitelist = map[string]struct{}{
-		"/universerpc.Universe/AssetRoots":      {},
-		"/universerpc.Universe/QueryAssetRoots": {},
-		"/universerpc.Universe/AssetLeafKeys":   {},
-		"/universerpc.Universe/AssetLeaves":     {},
-		"/universerpc.Universe/Info":            {},
+		"/universerpc.Universe/AssetRoots":       {},
+		"/universerpc.Universe/QueryAssetRoots":  {},
+		"/universerpc.Universe/AssetLeafKeys":    {},
+		"/universerpc.Universe/AssetLeaves":      {},
+		"/universerpc.Universe/Info":             {},
+		"/universerpc.Universe/NotifyRootUpdate": {},
+		"/universerpc.Universe/GossipServerList": {},
 	}
 )
 
@@ -314,6 +559,13 @@ func MacaroonWhitelist(allowUniPublicAccessRead bool,
 		whitelist["/universerpc.Universe/QueryProof"] = struct{}{}
 	}
 
+	// The metadata of the assets in the universe can be resolved by meta
+	// hash, without fetching the issuance proof. Only the metadata of
+	// assets that have a universe leaf is returned.
+	if allowUniPublicAccessRead {
+		whitelist["/universerpc.Universe/QueryAssetMeta"] = struct{}{}
+	}
+
 	// Conditionally whitelist universe server write methods.
 	if allowUniPublicAccessWrite || allowPublicUniProofCourier {
 		whitelist["/universerpc.Universe/InsertProof"] = struct{}{}
@@ -0,0 +1,549 @@
+package proof
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// DefaultBulkImportWorkers is the default number of proof files that
+	// are verified in parallel for a single bulk import job.
+	DefaultBulkImportWorkers = 8
+
+	// DefaultBulkImportInitialBackoff is the default time the importer
+	// waits before retrying a job that failed for a reason unrelated to
+	// its proof files. The backoff is doubled after each failed attempt.
+	DefaultBulkImportInitialBackoff = 5 * time.Second
+
+	// DefaultBulkImportMaxBackoff is the default maximum time the importer
+	// waits between two attempts to process a failed job.
+	DefaultBulkImportMaxBackoff = 10 * time.Minute
+
+	// defaultBulkImportTimeout is the default timeout used for database
+	// interactions of the bulk importer that aren't tied to a job.
+	defaultBulkImportTimeout = time.Minute
+)
+
+var (
+	// ErrImportJobNotFound is returned when a bulk import job with the
+	// given ID can't be found.
+	ErrImportJobNotFound = errors.New("proof import job not found")
+
+	// ErrEmptyImportJob is returned when a bulk import job is submitted
+	// without any proof files.
+	ErrEmptyImportJob = errors.New("proof import job must contain at " +
+		"least one proof file")
+)
+
+// ImportStatus is the status of a single proof file that is part of a bulk
+// import job.
+type ImportStatus uint8
+
+const (
+	// ImportStatusPending indicates that the proof file hasn't been
+	// processed yet.
+	ImportStatusPending ImportStatus = 0
+
+	// ImportStatusImported indicates that the proof file was verified and
+	// successfully imported into the archive.
+	ImportStatusImported ImportStatus = 1
+
+	// ImportStatusDuplicate indicates that the proof file was skipped
+	// because the archive already contains a proof for the same asset.
+	ImportStatusDuplicate ImportStatus = 2
+
+	// ImportStatusInvalid indicates that the proof file could not be
+	// decoded or failed verification. The reason is recorded with the
+	// item.
+	ImportStatusInvalid ImportStatus = 3
+)
+
+// String returns a human-readable string representation of the import status.
+func (s ImportStatus) String() string {
+	switch s {
+	case ImportStatusPending:
+		return "pending"
+	case ImportStatusImported:
+		return "imported"
+	case ImportStatusDuplicate:
+		return "duplicate"
+	case ImportStatusInvalid:
+		return "invalid"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(s))
+	}
+}
+
+// ImportItem is a single proof file that is part of a bulk import job.
+type ImportItem struct {
+	// Index is the position of the proof file within the job, as it was
+	// submitted by the caller.
+	Index uint32
+
+	// Blob is the raw proof file.
+	Blob Blob
+
+	// Status is the current import status of the proof file.
+	Status ImportStatus
+
+	// Reason is a human-readable explanation of why the proof file was
+	// rejected. This is only set if the status is ImportStatusInvalid.
+	Reason string
+}
+
+// ImportProgress is a summary of the number of items of a bulk import job per
+// import status.
+type ImportProgress struct {
+	// Total is the total number of proof files within the job.
+	Total int
+
+	// Pending is the number of proof files that still need to be
+	// processed.
+	Pending int
+
+	// Imported is the number of proof files that were imported.
+	Imported int
+
+	// Duplicate is the number of proof files that were already present in
+	// the archive.
+	Duplicate int
+
+	// Invalid is the number of proof files that were rejected.
+	Invalid int
+}
+
+// Done returns true if all proof files of the job have been processed.
+func (p ImportProgress) Done() bool {
+	return p.Pending == 0
+}
+
+// ImportJob is a bulk import job consisting of many proof files that are
+// validated and imported independently of each other.
+type ImportJob struct {
+	// ID is the unique identifier of the job.
+	ID int64
+
+	// CreatedAt is the time the job was submitted.
+	CreatedAt time.Time
+
+	// Items is the set of proof files of the job, ordered by their index.
+	Items []*ImportItem
+}
+
+// Progress returns a summary of the current state of the job.
+func (j *ImportJob) Progress() ImportProgress {
+	progress := ImportProgress{
+		Total: len(j.Items),
+	}
+	for _, item := range j.Items {
+		switch item.Status {
+		case ImportStatusPending:
+			progress.Pending++
+		case ImportStatusImported:
+			progress.Imported++
+		case ImportStatusDuplicate:
+			progress.Duplicate++
+		case ImportStatusInvalid:
+			progress.Invalid++
+		}
+	}
+
+	return progress
+}
+
+// ImportItemUpdate is an event that is sent to subscribers of the bulk
+// importer each time a proof file of a job has been processed.
+type ImportItemUpdate struct {
+	// JobID is the ID of the job the item belongs to.
+	JobID int64
+
+	// Index is the index of the item within the job.
+	Index uint32
+
+	// Status is the new status of the item.
+	Status ImportStatus
+
+	// Reason is the reason the item was rejected, if any.
+	Reason string
+}
+
+// ImportJobStore is the persistence layer of the bulk importer. It makes sure
+// a job can be resumed after a restart.
+type ImportJobStore interface {
+	// NewImportJob stores a new bulk import job with all items in the
+	// pending state and returns the ID of the job.
+	NewImportJob(ctx context.Context, blobs []Blob) (int64, error)
+
+	// UpdateImportItem updates the status of a single item of a job.
+	UpdateImportItem(ctx context.Context, jobID int64, index uint32,
+		status ImportStatus, reason string) error
+
+	// FetchImportJob returns the job with the given ID, including all its
+	// items. If the job doesn't exist, ErrImportJobNotFound is returned.
+	FetchImportJob(ctx context.Context, jobID int64) (*ImportJob, error)
+
+	// PendingImportJobs returns the IDs of all jobs that still have at
+	// least one item in the pending state.
+	PendingImportJobs(ctx context.Context) ([]int64, error)
+}
+
+// BulkImporterConfig houses all the items the bulk importer needs to carry
+// out its duties.
+type BulkImporterConfig struct {
+	// Archive is the proof archive the verified proofs are imported into.
+	Archive Archiver
+
+	// Verifier is used to verify each proof file before it is imported.
+	Verifier Verifier
+
+	// HeaderVerifier is used to verify the block headers of the proofs.
+	HeaderVerifier HeaderVerifier
+
+	// MerkleVerifier is used to verify the merkle proofs of the anchor
+	// transactions.
+	MerkleVerifier MerkleVerifier
+
+	// GroupVerifier is used to verify the group keys of grouped assets.
+	GroupVerifier GroupVerifier
+
+	// ChainLookupGen is used to create chain lookups for the verification
+	// of time locks.
+	ChainLookupGen ChainLookupGenerator
+
+	// Store is used to persist the jobs and their progress.
+	Store ImportJobStore
+
+	// NumWorkers is the maximum number of proof files of a single job that
+	// are processed in parallel.
+	NumWorkers int
+
+	// InitialBackoff is the time to wait before retrying a job that failed
+	// for a reason unrelated to its proof files, for example a database
+	// error. The backoff is doubled after each failed attempt.
+	InitialBackoff time.Duration
+
+	// MaxBackoff is the maximum time to wait between two attempts to
+	// process a failed job.
+	MaxBackoff time.Duration
+}
+
+// BulkImporter imports large sets of proof files asynchronously. Each proof
+// file is validated and imported independently, so a single invalid proof
+// doesn't cause the whole set to be rejected. The progress of each job is
+// persisted, which allows a job to be resumed after a restart.
+type BulkImporter struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg *BulkImporterConfig
+
+	// activeJobs is the set of jobs that are currently being processed.
+	activeJobs   map[int64]struct{}
+	activeJobsMu sync.Mutex
+
+	// eventDistributor is used to notify subscribers about processed
+	// items.
+	eventDistributor *fn.EventDistributor[*ImportItemUpdate]
+
+	// ContextGuard provides a wait group and main quit channel that can be
+	// used to create guarded contexts.
+	*fn.ContextGuard
+}
+
+// NewBulkImporter creates a new bulk importer based on the passed config.
+func NewBulkImporter(cfg *BulkImporterConfig) *BulkImporter {
+	return &BulkImporter{
+		cfg:              cfg,
+		activeJobs:       make(map[int64]struct{}),
+		eventDistributor: fn.NewEventDistributor[*ImportItemUpdate](),
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: defaultBulkImportTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start starts the bulk importer and resumes all jobs that weren't completed
+// before the last shutdown.
+func (b *BulkImporter) Start() error {
+	var startErr error
+	b.startOnce.Do(func() {
+		log.Info("Starting bulk proof importer")
+
+		ctx, cancel := b.WithCtxQuit()
+		defer cancel()
+
+		jobIDs, err := b.cfg.Store.PendingImportJobs(ctx)
+		if err != nil {
+			startErr = fmt.Errorf("unable to fetch pending proof "+
+				"import jobs: %w", err)
+			return
+		}
+
+		for _, jobID := range jobIDs {
+			log.Infof("Resuming proof import job %d", jobID)
+			b.launchJob(jobID)
+		}
+	})
+
+	return startErr
+}
+
+// Stop stops the bulk importer. Jobs that are interrupted are resumed on the
+// next start.
+func (b *BulkImporter) Stop() error {
+	b.stopOnce.Do(func() {
+		log.Info("Stopping bulk proof importer")
+
+		close(b.Quit)
+		b.Wg.Wait()
+	})
+
+	return nil
+}
+
+// SubmitJob persists a new bulk import job for the given proof files and
+// starts processing it in the background. The returned ID can be used to
+// query the progress of the job.
+func (b *BulkImporter) SubmitJob(ctx context.Context,
+	blobs []Blob) (int64, error) {
+
+	if len(blobs) == 0 {
+		return 0, ErrEmptyImportJob
+	}
+
+	jobID, err := b.cfg.Store.NewImportJob(ctx, blobs)
+	if err != nil {
+		return 0, fmt.Errorf("unable to store proof import job: %w",
+			err)
+	}
+
+	log.Infof("Submitted proof import job %d with %d proof files", jobID,
+		len(blobs))
+
+	b.launchJob(jobID)
+
+	return jobID, nil
+}
+
+// FetchJob returns the current state of the job with the given ID.
+func (b *BulkImporter) FetchJob(ctx context.Context,
+	jobID int64) (*ImportJob, error) {
+
+	return b.cfg.Store.FetchImportJob(ctx, jobID)
+}
+
+// RegisterSubscriber adds a new subscriber that is notified each time an item
+// of any job has been processed.
+func (b *BulkImporter) RegisterSubscriber(
+	receiver *fn.EventReceiver[*ImportItemUpdate]) {
+
+	b.eventDistributor.RegisterSubscriber(receiver)
+}
+
+// RemoveSubscriber removes the given subscriber and also stops it from
+// processing events.
+func (b *BulkImporter) RemoveSubscriber(
+	subscriber *fn.EventReceiver[*ImportItemUpdate]) error {
+
+	return b.eventDistributor.RemoveSubscriber(subscriber)
+}
+
+// launchJob starts processing the job with the given ID in a new goroutine,
+// unless it is already being processed.
+func (b *BulkImporter) launchJob(jobID int64) {
+	b.activeJobsMu.Lock()
+	defer b.activeJobsMu.Unlock()
+
+	if _, ok := b.activeJobs[jobID]; ok {
+		return
+	}
+	b.activeJobs[jobID] = struct{}{}
+
+	b.Wg.Add(1)
+	go func() {
+		defer b.Wg.Done()
+		defer func() {
+			b.activeJobsMu.Lock()
+			delete(b.activeJobs, jobID)
+			b.activeJobsMu.Unlock()
+		}()
+
+		b.runJob(jobID)
+	}()
+}
+
+// runJob processes the job with the given ID until all its items are
+// processed or the importer is stopped. Failures that aren't caused by the
+// proof files themselves only affect this job, so they're retried with an
+// exponential backoff instead of being reported to the main server. Items that
+// were already processed are skipped on each new attempt.
+func (b *BulkImporter) runJob(jobID int64) {
+	backoff := b.cfg.InitialBackoff
+	if backoff <= 0 {
+		backoff = DefaultBulkImportInitialBackoff
+	}
+	maxBackoff := b.cfg.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = DefaultBulkImportMaxBackoff
+	}
+
+	for attempt := 1; ; attempt++ {
+		err := b.processJob(jobID)
+		switch {
+		case err == nil:
+			return
+
+		// An interrupted job will be resumed on the next start, so
+		// there's nothing to report.
+		case errors.Is(err, context.Canceled):
+			log.Infof("Proof import job %d interrupted", jobID)
+			return
+		}
+
+		log.Errorf("Attempt %d to process proof import job %d failed, "+
+			"retrying in %v: %v", attempt, jobID, backoff, err)
+
+		select {
+		case <-time.After(backoff):
+			backoff = min(backoff*2, maxBackoff)
+
+		case <-b.Quit:
+			log.Infof("Proof import job %d interrupted", jobID)
+			return
+		}
+	}
+}
+
+// processJob processes all pending items of the job with the given ID.
+func (b *BulkImporter) processJob(jobID int64) error {
+	ctx, cancel := b.WithCtxQuitNoTimeout()
+	defer cancel()
+
+	job, err := b.cfg.Store.FetchImportJob(ctx, jobID)
+	if err != nil {
+		return fmt.Errorf("unable to fetch proof import job %d: %w",
+			jobID, err)
+	}
+
+	numWorkers := b.cfg.NumWorkers
+	if numWorkers <= 0 {
+		numWorkers = DefaultBulkImportWorkers
+	}
+
+	errGroup, ctx := errgroup.WithContext(ctx)
+	errGroup.SetLimit(numWorkers)
+
+	for idx := range job.Items {
+		item := job.Items[idx]
+		if item.Status != ImportStatusPending {
+			continue
+		}
+
+		errGroup.Go(func() error {
+			status, reason, err := b.importItem(ctx, item)
+			if err != nil {
+				return err
+			}
+
+			err = b.cfg.Store.UpdateImportItem(
+				ctx, jobID, item.Index, status, reason,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to update proof "+
+					"import item %d: %w", item.Index, err)
+			}
+
+			b.eventDistributor.NotifySubscribers(&ImportItemUpdate{
+				JobID:  jobID,
+				Index:  item.Index,
+				Status: status,
+				Reason: reason,
+			})
+
+			return nil
+		})
+	}
+
+	if err := errGroup.Wait(); err != nil {
+		return err
+	}
+
+	log.Infof("Finished processing proof import job %d", jobID)
+
+	return nil
+}
+
+// importItem validates and imports a single proof file. Problems with the
+// proof file itself are reported through the returned status and reason, an
+// error is only returned if the item couldn't be processed at all.
+func (b *BulkImporter) importItem(ctx context.Context,
+	item *ImportItem) (ImportStatus, string, error) {
+
+	proofFile, err := DecodeFile(item.Blob)
+	if err != nil {
+		return ImportStatusInvalid, fmt.Sprintf("unable to decode "+
+			"proof file: %v", err), nil
+	}
+
+	lastProof, err := proofFile.LastProof()
+	if err != nil {
+		return ImportStatusInvalid, fmt.Sprintf("unable to extract "+
+			"last proof: %v", err), nil
+	}
+
+	lastAsset := lastProof.Asset
+	loc := Locator{
+		AssetID:   fn.Ptr(lastAsset.ID()),
+		ScriptKey: *lastAsset.ScriptKey.PubKey,
+		OutPoint:  fn.Ptr(lastProof.OutPoint()),
+	}
+	if lastAsset.GroupKey != nil {
+		loc.GroupKey = &lastAsset.GroupKey.GroupPubKey
+	}
+
+	haveProof, err := b.cfg.Archive.HasProof(ctx, loc)
+	if err != nil {
+		return 0, "", fmt.Errorf("unable to look up proof: %w", err)
+	}
+	if haveProof {
+		return ImportStatusDuplicate, "", nil
+	}
+
+	_, err = b.cfg.Verifier.Verify(
+		ctx, bytes.NewReader(item.Blob), b.cfg.HeaderVerifier,
+		b.cfg.MerkleVerifier, b.cfg.GroupVerifier,
+		b.cfg.ChainLookupGen,
+	)
+	switch {
+	// A cancelled context isn't the fault of the proof, so we'll retry
+	// the item once the job is resumed.
+	case ctx.Err() != nil:
+		return 0, "", ctx.Err()
+
+	case err != nil:
+		return ImportStatusInvalid, fmt.Sprintf("unable to verify "+
+			"proof: %v", err), nil
+	}
+
+	err = b.cfg.Archive.ImportProofs(
+		ctx, b.cfg.HeaderVerifier, b.cfg.MerkleVerifier,
+		b.cfg.GroupVerifier, b.cfg.ChainLookupGen, false,
+		&AnnotatedProof{
+			Locator: loc,
+			Blob:    item.Blob,
+		},
+	)
+	if err != nil {
+		return 0, "", fmt.Errorf("unable to import proof: %w", err)
+	}
+
+	return ImportStatusImported, "", nil
+}
@@ -0,0 +1,376 @@
+package proof
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// mockImportJobStore is an in-memory implementation of the ImportJobStore
+// interface.
+type mockImportJobStore struct {
+	sync.Mutex
+
+	nextID int64
+	jobs   map[int64]*ImportJob
+}
+
+func newMockImportJobStore() *mockImportJobStore {
+	return &mockImportJobStore{
+		jobs: make(map[int64]*ImportJob),
+	}
+}
+
+func (m *mockImportJobStore) NewImportJob(_ context.Context,
+	blobs []Blob) (int64, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.nextID++
+	job := &ImportJob{
+		ID:        m.nextID,
+		CreatedAt: time.Now(),
+	}
+	for idx := range blobs {
+		job.Items = append(job.Items, &ImportItem{
+			Index: uint32(idx),
+			Blob:  blobs[idx],
+		})
+	}
+	m.jobs[job.ID] = job
+
+	return job.ID, nil
+}
+
+func (m *mockImportJobStore) UpdateImportItem(_ context.Context, jobID int64,
+	index uint32, status ImportStatus, reason string) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	job, ok := m.jobs[jobID]
+	if !ok {
+		return ErrImportJobNotFound
+	}
+
+	job.Items[index].Status = status
+	job.Items[index].Reason = reason
+
+	return nil
+}
+
+func (m *mockImportJobStore) FetchImportJob(_ context.Context,
+	jobID int64) (*ImportJob, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	job, ok := m.jobs[jobID]
+	if !ok {
+		return nil, ErrImportJobNotFound
+	}
+
+	// Return a deep copy, so the importer can't modify our state.
+	jobCopy := *job
+	jobCopy.Items = nil
+	for _, item := range job.Items {
+		itemCopy := *item
+		jobCopy.Items = append(jobCopy.Items, &itemCopy)
+	}
+
+	return &jobCopy, nil
+}
+
+func (m *mockImportJobStore) PendingImportJobs(
+	context.Context) ([]int64, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	var jobIDs []int64
+	for id, job := range m.jobs {
+		if !job.Progress().Done() {
+			jobIDs = append(jobIDs, id)
+		}
+	}
+
+	return jobIDs, nil
+}
+
+// mockArchive is a simple in-memory archive that only supports the methods
+// used by the bulk importer.
+type mockArchive struct {
+	Archiver
+
+	sync.Mutex
+
+	proofs map[[32]byte]Blob
+}
+
+func newMockArchive() *mockArchive {
+	return &mockArchive{
+		proofs: make(map[[32]byte]Blob),
+	}
+}
+
+func (m *mockArchive) HasProof(_ context.Context, id Locator) (bool, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	key, err := id.Hash()
+	if err != nil {
+		return false, err
+	}
+
+	_, ok := m.proofs[key]
+	return ok, nil
+}
+
+func (m *mockArchive) ImportProofs(_ context.Context, _ HeaderVerifier,
+	_ MerkleVerifier, _ GroupVerifier, _ ChainLookupGenerator, _ bool,
+	proofs ...*AnnotatedProof) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	for _, p := range proofs {
+		key, err := p.Locator.Hash()
+		if err != nil {
+			return err
+		}
+
+		m.proofs[key] = p.Blob
+	}
+
+	return nil
+}
+
+// flakyArchive is an archive that fails a given number of proof look ups
+// before delegating to the wrapped archive.
+type flakyArchive struct {
+	*mockArchive
+
+	failures atomic.Int32
+}
+
+func (f *flakyArchive) HasProof(ctx context.Context, id Locator) (bool,
+	error) {
+
+	if f.failures.Add(-1) >= 0 {
+		return false, fmt.Errorf("database unavailable")
+	}
+
+	return f.mockArchive.HasProof(ctx, id)
+}
+
+// rejectingVerifier is a verifier that rejects a specific set of proof files
+// and accepts all others.
+type rejectingVerifier struct {
+	rejected [][]byte
+}
+
+func (r *rejectingVerifier) Verify(_ context.Context, blobReader io.Reader,
+	_ HeaderVerifier, _ MerkleVerifier, _ GroupVerifier,
+	_ ChainLookupGenerator) (*AssetSnapshot, error) {
+
+	blob, err := io.ReadAll(blobReader)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rejected := range r.rejected {
+		if bytes.Equal(rejected, blob) {
+			return nil, fmt.Errorf("invalid test proof")
+		}
+	}
+
+	return &AssetSnapshot{}, nil
+}
+
+// randProofFile creates a random, encoded single-proof file.
+func randProofFile(t *testing.T) Blob {
+	testBlocks := readTestData(t)
+	oddTxBlock := testBlocks[0]
+
+	genesis := asset.RandGenesis(t, asset.Collectible)
+	scriptKey := test.RandPubKey(t)
+	p := RandProof(t, genesis, scriptKey, oddTxBlock, 0, 1)
+
+	f, err := NewFile(V0, p)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, f.Encode(&buf))
+
+	return buf.Bytes()
+}
+
+func newTestBulkImporter(store ImportJobStore, archive Archiver,
+	verifier Verifier) *BulkImporter {
+
+	return NewBulkImporter(&BulkImporterConfig{
+		Archive:        archive,
+		Verifier:       verifier,
+		HeaderVerifier: MockHeaderVerifier,
+		MerkleVerifier: MockMerkleVerifier,
+		GroupVerifier:  MockGroupVerifier,
+		ChainLookupGen: MockChainLookup,
+		Store:          store,
+		NumWorkers:     2,
+		InitialBackoff: 10 * time.Millisecond,
+	})
+}
+
+// waitForJob waits until all items of the given job have been processed.
+func waitForJob(t *testing.T, importer *BulkImporter, jobID int64) *ImportJob {
+	var job *ImportJob
+	require.Eventually(t, func() bool {
+		var err error
+		job, err = importer.FetchJob(context.Background(), jobID)
+		require.NoError(t, err)
+
+		return job.Progress().Done()
+	}, testTimeout, 10*time.Millisecond)
+
+	return job
+}
+
+// TestBulkImporter tests that a bulk import job reports the correct status
+// for each item and that failing items don't affect the other items.
+func TestBulkImporter(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx          = context.Background()
+		store        = newMockImportJobStore()
+		archive      = newMockArchive()
+		validProof1  = randProofFile(t)
+		validProof2  = randProofFile(t)
+		invalidProof = randProofFile(t)
+		verifier     = &rejectingVerifier{
+			rejected: [][]byte{invalidProof},
+		}
+		importer = newTestBulkImporter(store, archive, verifier)
+	)
+
+	require.NoError(t, importer.Start())
+	t.Cleanup(func() {
+		require.NoError(t, importer.Stop())
+	})
+
+	_, err := importer.SubmitJob(ctx, nil)
+	require.ErrorIs(t, err, ErrEmptyImportJob)
+
+	// We'll first import a single proof, so we can later test the
+	// duplicate detection.
+	jobID, err := importer.SubmitJob(ctx, []Blob{validProof1})
+	require.NoError(t, err)
+
+	job := waitForJob(t, importer, jobID)
+	require.Equal(t, ImportStatusImported, job.Items[0].Status)
+
+	// Now we submit a job with a mix of valid, duplicate and invalid
+	// proofs.
+	blobs := []Blob{
+		validProof1, validProof2, invalidProof, test.RandBytes(50),
+	}
+	jobID, err = importer.SubmitJob(ctx, blobs)
+	require.NoError(t, err)
+
+	job = waitForJob(t, importer, jobID)
+	require.Equal(t, ImportProgress{
+		Total:     4,
+		Imported:  1,
+		Duplicate: 1,
+		Invalid:   2,
+	}, job.Progress())
+
+	require.Equal(t, ImportStatusDuplicate, job.Items[0].Status)
+	require.Equal(t, ImportStatusImported, job.Items[1].Status)
+	require.Equal(t, ImportStatusInvalid, job.Items[2].Status)
+	require.Contains(t, job.Items[2].Reason, "invalid test proof")
+	require.Equal(t, ImportStatusInvalid, job.Items[3].Status)
+	require.Contains(t, job.Items[3].Reason, "unable to decode")
+
+	require.Len(t, archive.proofs, 2)
+}
+
+// TestBulkImporterResume tests that jobs that weren't completed before a
+// shutdown are resumed on startup.
+func TestBulkImporterResume(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = context.Background()
+		store   = newMockImportJobStore()
+		archive = newMockArchive()
+	)
+
+	// We'll add a job to the store directly, simulating a job that was
+	// submitted before a restart.
+	blobs := []Blob{randProofFile(t), randProofFile(t)}
+	jobID, err := store.NewImportJob(ctx, blobs)
+	require.NoError(t, err)
+
+	// We also mark the first item as already processed, it should not be
+	// processed again.
+	err = store.UpdateImportItem(ctx, jobID, 0, ImportStatusImported, "")
+	require.NoError(t, err)
+
+	importer := newTestBulkImporter(store, archive, &rejectingVerifier{})
+	require.NoError(t, importer.Start())
+	t.Cleanup(func() {
+		require.NoError(t, importer.Stop())
+	})
+
+	job := waitForJob(t, importer, jobID)
+	require.Equal(t, ImportProgress{
+		Total:    2,
+		Imported: 2,
+	}, job.Progress())
+
+	// Only the second proof should've been imported by the importer.
+	require.Len(t, archive.proofs, 1)
+}
+
+// TestBulkImporterRetry tests that a job that fails for a reason unrelated to
+// its proof files is retried instead of being abandoned.
+func TestBulkImporterRetry(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = context.Background()
+		store   = newMockImportJobStore()
+		archive = &flakyArchive{
+			mockArchive: newMockArchive(),
+		}
+	)
+	archive.failures.Store(3)
+
+	importer := newTestBulkImporter(store, archive, &rejectingVerifier{})
+	require.NoError(t, importer.Start())
+	t.Cleanup(func() {
+		require.NoError(t, importer.Stop())
+	})
+
+	blobs := []Blob{randProofFile(t), randProofFile(t)}
+	jobID, err := importer.SubmitJob(ctx, blobs)
+	require.NoError(t, err)
+
+	job := waitForJob(t, importer, jobID)
+	require.Equal(t, ImportProgress{
+		Total:    2,
+		Imported: 2,
+	}, job.Progress())
+	require.Len(t, archive.proofs, 2)
+}
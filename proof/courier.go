@@ -15,6 +15,7 @@ import (
 	"github.com/lightninglabs/lightning-node-connect/hashmailrpc"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/rpccompress"
 	"github.com/lightninglabs/taproot-assets/taprpc"
 	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
 	"google.golang.org/grpc"
@@ -82,6 +83,9 @@ type CourierCfg struct {
 	// parameters.
 	UniverseRpcCfg *UniverseRpcCourierCfg
 
+	// HttpsCfg contains HTTPS protocol specific config parameters.
+	HttpsCfg *HttpsCourierCfg
+
 	// TransferLog is a log for recording proof delivery and retrieval
 	// attempts.
 	TransferLog TransferLog
@@ -89,6 +93,11 @@ type CourierCfg struct {
 	// LocalArchive is an archive that can be used to fetch proofs from the
 	// local archive.
 	LocalArchive Archiver
+
+	// Compression is the compression configuration of the connections to
+	// universe RPC proof couriers. If this is nil, the default compression
+	// preferences are used.
+	Compression *rpccompress.ConnConfig
 }
 
 // CourierDispatch is an interface that abstracts away the different proof
@@ -117,63 +126,76 @@ func NewCourierDispatch(cfg *CourierCfg) *URLDispatch {
 func (u *URLDispatch) NewCourier(addr *url.URL,
 	recipient Recipient) (Courier, error) {
 
-	subscribers := make(map[uint64]*fn.EventReceiver[fn.Event])
-
 	// Create new courier addr based on URL scheme.
-	switch addr.Scheme {
-	case HashmailCourierType:
-		cfg := u.cfg.HashMailCfg
-		backoffHandler := NewBackoffHandler(
-			cfg.BackoffCfg, u.cfg.TransferLog,
-		)
+	driver, ok := fetchCourierDriver(addr.Scheme)
+	if !ok {
+		return nil, fmt.Errorf("unknown courier address protocol "+
+			"(consider updating tapd): %v", addr.Scheme)
+	}
 
-		hashMailBox, err := NewHashMailBox(addr)
-		if err != nil {
-			return nil, fmt.Errorf("unable to make mailbox: %w",
-				err)
-		}
+	return driver.New(u.cfg, addr, recipient)
+}
 
-		return &HashMailCourier{
-			cfg:           u.cfg,
-			backoffHandle: backoffHandler,
-			recipient:     recipient,
-			mailbox:       hashMailBox,
-			subscribers:   subscribers,
-		}, nil
-
-	case UniverseRpcCourierType:
-		cfg := u.cfg.UniverseRpcCfg
-		backoffHandler := NewBackoffHandler(
-			cfg.BackoffCfg, u.cfg.TransferLog,
-		)
+// newHashMailCourier creates a new hashmail proof courier for the given
+// address.
+func newHashMailCourier(cfg *CourierCfg, addr *url.URL,
+	recipient Recipient) (Courier, error) {
 
-		// Connect to the universe RPC server.
-		dialOpts, err := serverDialOpts()
-		if err != nil {
-			return nil, err
-		}
+	backoffHandler := NewBackoffHandler(
+		cfg.HashMailCfg.BackoffCfg, cfg.TransferLog,
+	)
 
-		serverAddr := fmt.Sprintf("%s:%s", addr.Hostname(), addr.Port())
-		conn, err := grpc.Dial(serverAddr, dialOpts...)
-		if err != nil {
-			return nil, err
-		}
+	hashMailBox, err := NewHashMailBox(addr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to make mailbox: %w", err)
+	}
 
-		client := unirpc.NewUniverseClient(conn)
+	return &HashMailCourier{
+		cfg:           cfg,
+		backoffHandle: backoffHandler,
+		recipient:     recipient,
+		mailbox:       hashMailBox,
+		subscribers:   make(map[uint64]*fn.EventReceiver[fn.Event]),
+	}, nil
+}
 
-		return &UniverseRpcCourier{
-			recipient:     recipient,
-			client:        client,
-			backoffHandle: backoffHandler,
-			cfg:           u.cfg,
-			subscribers:   subscribers,
-			rawConn:       conn,
-		}, nil
+// newUniverseRpcCourier creates a new universe RPC proof courier for the given
+// address.
+func newUniverseRpcCourier(cfg *CourierCfg, addr *url.URL,
+	recipient Recipient) (Courier, error) {
 
-	default:
-		return nil, fmt.Errorf("unknown courier address protocol "+
-			"(consider updating tapd): %v", addr.Scheme)
+	backoffHandler := NewBackoffHandler(
+		cfg.UniverseRpcCfg.BackoffCfg, cfg.TransferLog,
+	)
+
+	// Connect to the universe RPC server.
+	dialOpts, err := serverDialOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	// Proofs are highly compressible, so we negotiate compression with
+	// the courier.
+	serverAddr := fmt.Sprintf("%s:%s", addr.Hostname(), addr.Port())
+	dialOpts = append(dialOpts, rpccompress.DialOptions(
+		cfg.Compression.ForHost(serverAddr),
+	)...)
+
+	conn, err := grpc.Dial(serverAddr, dialOpts...)
+	if err != nil {
+		return nil, err
 	}
+
+	client := unirpc.NewUniverseClient(conn)
+
+	return &UniverseRpcCourier{
+		recipient:     recipient,
+		client:        client,
+		backoffHandle: backoffHandler,
+		cfg:           cfg,
+		subscribers:   make(map[uint64]*fn.EventReceiver[fn.Event]),
+		rawConn:       conn,
+	}, nil
 }
 
 // A compile-time assertion to ensure that the URLDispatch meets the
@@ -205,15 +227,13 @@ func ValidateCourierAddress(addr *url.URL) error {
 		return fmt.Errorf("proof courier URI address port unspecified")
 	}
 
-	switch addr.Scheme {
-	case HashmailCourierType, UniverseRpcCourierType:
-		// Valid and known courier address protocol.
-		return nil
-
-	default:
+	// Only protocols with a registered courier driver are valid.
+	if _, ok := fetchCourierDriver(addr.Scheme); !ok {
 		return fmt.Errorf("unknown courier address protocol "+
 			"(consider updating tapd): %v", addr.Scheme)
 	}
+
+	return nil
 }
 
 // ProofMailbox represents an abstract store-and-forward mailbox that can be
@@ -1057,6 +1077,7 @@ func (c *UniverseRpcCourier) DeliverProof(ctx context.Context,
 		// as this doesn't matter for the courrier.
 		rpcAsset, err := taprpc.MarshalAsset(
 			ctx, &proofAsset, true, true, nil, fn.None[uint32](),
+			nil,
 		)
 		if err != nil {
 			return err
@@ -0,0 +1,110 @@
+package proof
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"sync"
+)
+
+// CourierDriver represents a concrete driver of the Courier interface. A driver
+// is identified by the globally unique URL scheme of the proof courier
+// addresses it handles, along with a 'New()' method which is responsible for
+// initializing a courier for such an address. External packages can register
+// their own drivers to add custom proof transports.
+type CourierDriver struct {
+	// Scheme is the URL scheme of the proof courier addresses this driver
+	// is responsible for.
+	Scheme string
+
+	// New creates a new courier instance for the given courier address and
+	// recipient. The general courier config is passed along so drivers can
+	// make use of the transfer log and the local proof archive.
+	New func(cfg *CourierCfg, addr *url.URL,
+		recipient Recipient) (Courier, error)
+}
+
+var (
+	courierDrivers     = make(map[string]*CourierDriver)
+	courierRegisterMtx sync.Mutex
+)
+
+// RegisteredCouriers returns a slice of all currently registered courier
+// drivers, ordered by their scheme.
+//
+// NOTE: This function is safe for concurrent access.
+func RegisteredCouriers() []*CourierDriver {
+	courierRegisterMtx.Lock()
+	defer courierRegisterMtx.Unlock()
+
+	drivers := make([]*CourierDriver, 0, len(courierDrivers))
+	for _, driver := range courierDrivers {
+		drivers = append(drivers, driver)
+	}
+
+	sort.Slice(drivers, func(i, j int) bool {
+		return drivers[i].Scheme < drivers[j].Scheme
+	})
+
+	return drivers
+}
+
+// RegisterCourier registers a CourierDriver which is capable of driving a
+// concrete Courier interface for the driver's URL scheme. In the case that a
+// driver for the scheme has already been registered, an error is returned.
+//
+// NOTE: This function is safe for concurrent access.
+func RegisterCourier(driver *CourierDriver) error {
+	if driver == nil || driver.Scheme == "" || driver.New == nil {
+		return fmt.Errorf("courier driver must have a scheme and a " +
+			"constructor")
+	}
+
+	courierRegisterMtx.Lock()
+	defer courierRegisterMtx.Unlock()
+
+	if _, ok := courierDrivers[driver.Scheme]; ok {
+		return fmt.Errorf("courier for scheme %v already registered",
+			driver.Scheme)
+	}
+
+	courierDrivers[driver.Scheme] = driver
+	return nil
+}
+
+// fetchCourierDriver returns the courier driver registered for the given URL
+// scheme, if any.
+//
+// NOTE: This function is safe for concurrent access.
+func fetchCourierDriver(scheme string) (*CourierDriver, bool) {
+	courierRegisterMtx.Lock()
+	defer courierRegisterMtx.Unlock()
+
+	driver, ok := courierDrivers[scheme]
+	return driver, ok
+}
+
+// init registers the proof couriers that ship with tapd.
+func init() {
+	builtinDrivers := []*CourierDriver{{
+		Scheme: HashmailCourierType,
+		New:    newHashMailCourier,
+	}, {
+		Scheme: UniverseRpcCourierType,
+		New:    newUniverseRpcCourier,
+	}, {
+		Scheme: HttpsCourierType,
+		New: func(cfg *CourierCfg, addr *url.URL,
+			recipient Recipient) (Courier, error) {
+
+			return NewHttpsCourier(cfg, addr, recipient)
+		},
+	}}
+
+	for _, driver := range builtinDrivers {
+		if err := RegisterCourier(driver); err != nil {
+			panic(fmt.Sprintf("unable to register courier %v: %v",
+				driver.Scheme, err))
+		}
+	}
+}
@@ -0,0 +1,470 @@
+package proof
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/neutrino/cache/lru"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+const (
+	// DefaultCourierFailureThreshold is the default number of consecutive
+	// delivery failures after which a proof courier endpoint is considered
+	// unhealthy.
+	DefaultCourierFailureThreshold = 3
+
+	// DefaultCourierHealthCooldown is the default duration after the last
+	// failure of an unhealthy proof courier endpoint after which it is
+	// considered healthy again.
+	DefaultCourierHealthCooldown = 10 * time.Minute
+
+	// DefaultCourierStickinessSize is the default number of recipients for
+	// which the proof courier endpoint that last worked is remembered.
+	DefaultCourierStickinessSize = 10_000
+)
+
+// CourierEndpointStats are the health statistics of a single proof courier
+// endpoint.
+type CourierEndpointStats struct {
+	// Addr is the address of the proof courier endpoint.
+	Addr string
+
+	// Successes is the number of successful proof deliveries and
+	// retrievals.
+	Successes uint64
+
+	// Failures is the number of failed proof deliveries.
+	Failures uint64
+
+	// ConsecutiveFailures is the number of failed proof deliveries since
+	// the last successful one.
+	ConsecutiveFailures uint32
+
+	// LastSuccess is the time of the last successful proof delivery or
+	// retrieval.
+	LastSuccess time.Time
+
+	// LastFailure is the time of the last failed proof delivery.
+	LastFailure time.Time
+
+	// Healthy is false if the endpoint failed too often in a row recently.
+	Healthy bool
+}
+
+// stickyEndpoint is the address of the proof courier endpoint that last worked
+// for a recipient.
+type stickyEndpoint string
+
+// Size determines how big this entry would be in the cache.
+func (s stickyEndpoint) Size() (uint64, error) {
+	return 1, nil
+}
+
+// CourierHealth tracks the health of proof courier endpoints and remembers
+// which endpoint last worked for each recipient.
+type CourierHealth struct {
+	// failureThreshold is the number of consecutive failures after which
+	// an endpoint is considered unhealthy.
+	failureThreshold uint32
+
+	// cooldown is the duration after the last failure after which an
+	// unhealthy endpoint is considered healthy again.
+	cooldown time.Duration
+
+	// endpoints maps the address of each endpoint to its statistics.
+	endpoints map[string]*CourierEndpointStats
+
+	// sticky maps the script key of a recipient to the endpoint that last
+	// worked for it.
+	sticky *lru.Cache[asset.SerializedKey, stickyEndpoint]
+
+	mtx sync.Mutex
+}
+
+// NewCourierHealth creates a new proof courier health tracker.
+func NewCourierHealth(failureThreshold uint32,
+	cooldown time.Duration) *CourierHealth {
+
+	return &CourierHealth{
+		failureThreshold: failureThreshold,
+		cooldown:         cooldown,
+		endpoints:        make(map[string]*CourierEndpointStats),
+		sticky: lru.NewCache[asset.SerializedKey, stickyEndpoint](
+			DefaultCourierStickinessSize,
+		),
+	}
+}
+
+// endpointStats returns the statistics of the given endpoint, creating them if
+// they don't exist yet.
+//
+// NOTE: The mutex must be held when calling this method.
+func (h *CourierHealth) endpointStats(addr *url.URL) *CourierEndpointStats {
+	stats, ok := h.endpoints[addr.String()]
+	if !ok {
+		stats = &CourierEndpointStats{
+			Addr: addr.String(),
+		}
+		h.endpoints[addr.String()] = stats
+	}
+
+	return stats
+}
+
+// isHealthy returns true if the given endpoint hasn't failed too often in a
+// row recently.
+//
+// NOTE: The mutex must be held when calling this method.
+func (h *CourierHealth) isHealthy(stats *CourierEndpointStats,
+	now time.Time) bool {
+
+	if stats.ConsecutiveFailures < h.failureThreshold {
+		return true
+	}
+
+	return now.Sub(stats.LastFailure) >= h.cooldown
+}
+
+// RecordSuccess records a successful proof delivery or retrieval through the
+// given endpoint. The endpoint becomes the preferred one for the recipient.
+func (h *CourierHealth) RecordSuccess(addr *url.URL, recipient Recipient) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	stats := h.endpointStats(addr)
+	stats.Successes++
+	stats.ConsecutiveFailures = 0
+	stats.LastSuccess = time.Now()
+
+	if recipient.ScriptKey == nil {
+		return
+	}
+
+	scriptKey := asset.ToSerialized(recipient.ScriptKey)
+	_, err := h.sticky.Put(scriptKey, stickyEndpoint(addr.String()))
+	if err != nil {
+		log.Warnf("Unable to remember proof courier for recipient "+
+			"%x: %v", scriptKey[:], err)
+	}
+}
+
+// RecordFailure records a failed proof delivery through the given endpoint.
+func (h *CourierHealth) RecordFailure(addr *url.URL) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	stats := h.endpointStats(addr)
+	stats.Failures++
+	stats.ConsecutiveFailures++
+	stats.LastFailure = time.Now()
+}
+
+// Order returns the given endpoints in the order they should be tried for the
+// recipient. The endpoint that last worked for the recipient comes first,
+// followed by all healthy endpoints in their given order. Unhealthy endpoints
+// come last, ordered by their number of consecutive failures.
+func (h *CourierHealth) Order(endpoints []*url.URL,
+	recipient Recipient) []*url.URL {
+
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	var sticky stickyEndpoint
+	if recipient.ScriptKey != nil {
+		scriptKey := asset.ToSerialized(recipient.ScriptKey)
+		sticky, _ = h.sticky.Get(scriptKey)
+	}
+
+	now := time.Now()
+	rank := func(addr *url.URL) (bool, bool, uint32) {
+		stats := h.endpointStats(addr)
+		return addr.String() == string(sticky),
+			h.isHealthy(stats, now), stats.ConsecutiveFailures
+	}
+
+	ordered := make([]*url.URL, len(endpoints))
+	copy(ordered, endpoints)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		stickyI, healthyI, failuresI := rank(ordered[i])
+		stickyJ, healthyJ, failuresJ := rank(ordered[j])
+
+		switch {
+		case stickyI != stickyJ:
+			return stickyI
+
+		case healthyI != healthyJ:
+			return healthyI
+
+		case !healthyI:
+			return failuresI < failuresJ
+
+		default:
+			return false
+		}
+	})
+
+	return ordered
+}
+
+// Stats returns the statistics of all known endpoints, ordered by their
+// address.
+func (h *CourierHealth) Stats() []CourierEndpointStats {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	now := time.Now()
+	stats := make([]CourierEndpointStats, 0, len(h.endpoints))
+	for _, endpoint := range h.endpoints {
+		endpointStats := *endpoint
+		endpointStats.Healthy = h.isHealthy(endpoint, now)
+
+		stats = append(stats, endpointStats)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Addr < stats[j].Addr
+	})
+
+	return stats
+}
+
+// FailoverDispatch is a proof courier dispatch that fails over between
+// multiple configured proof courier endpoints. If the courier address of a
+// transfer is one of the configured endpoints, all of them are tried, in the
+// order given by their health. Any other courier address is used on its own.
+type FailoverDispatch struct {
+	// dispatch is used to create the courier of each endpoint.
+	dispatch CourierDispatch
+
+	// endpoints is the set of configured proof courier endpoints, in the
+	// order they were configured.
+	endpoints []*url.URL
+
+	// health tracks the health of the endpoints.
+	health *CourierHealth
+}
+
+// NewFailoverDispatch creates a new failover proof courier dispatch for the
+// given endpoints.
+func NewFailoverDispatch(dispatch CourierDispatch, endpoints []*url.URL,
+	health *CourierHealth) *FailoverDispatch {
+
+	return &FailoverDispatch{
+		dispatch:  dispatch,
+		endpoints: endpoints,
+		health:    health,
+	}
+}
+
+// candidates returns the endpoints that can be used for a transfer with the
+// given courier address. The given address always comes first.
+func (f *FailoverDispatch) candidates(addr *url.URL) []*url.URL {
+	isConfigured := fn.Any(f.endpoints, func(endpoint *url.URL) bool {
+		return endpoint.String() == addr.String()
+	})
+	if !isConfigured {
+		return []*url.URL{addr}
+	}
+
+	candidates := []*url.URL{addr}
+	for _, endpoint := range f.endpoints {
+		if endpoint.String() != addr.String() {
+			candidates = append(candidates, endpoint)
+		}
+	}
+
+	return candidates
+}
+
+// NewCourier instantiates a new courier service handle that fails over
+// between all endpoints that can be used for the given courier address.
+func (f *FailoverDispatch) NewCourier(addr *url.URL,
+	recipient Recipient) (Courier, error) {
+
+	endpoints := f.health.Order(f.candidates(addr), recipient)
+
+	courier := &FailoverCourier{
+		dispatch:    f.dispatch,
+		health:      f.health,
+		recipient:   recipient,
+		endpoints:   endpoints,
+		couriers:    make(map[string]Courier),
+		subscribers: make(map[uint64]*fn.EventReceiver[fn.Event]),
+	}
+
+	// We create the courier of the first endpoint right away, so an
+	// invalid courier address is reported to the caller directly.
+	if _, err := courier.endpointCourier(endpoints[0]); err != nil {
+		return nil, err
+	}
+
+	return courier, nil
+}
+
+// A compile-time assertion to ensure that the FailoverDispatch meets the
+// CourierDispatch interface.
+var _ CourierDispatch = (*FailoverDispatch)(nil)
+
+// FailoverCourier is a proof courier that tries a list of proof courier
+// endpoints in order until a proof was delivered or retrieved successfully.
+type FailoverCourier struct {
+	// dispatch is used to create the courier of each endpoint.
+	dispatch CourierDispatch
+
+	// health tracks the health of the endpoints.
+	health *CourierHealth
+
+	// recipient is the recipient of the proofs.
+	recipient Recipient
+
+	// endpoints is the list of endpoints, in the order they are tried.
+	endpoints []*url.URL
+
+	// couriers maps the address of each endpoint to its courier, once it
+	// was created.
+	couriers map[string]Courier
+
+	// subscribers is the set of subscribers that are notified of proof
+	// courier events.
+	subscribers map[uint64]*fn.EventReceiver[fn.Event]
+
+	mtx sync.Mutex
+}
+
+// endpointCourier returns the courier of the given endpoint, creating it if it
+// doesn't exist yet.
+func (c *FailoverCourier) endpointCourier(addr *url.URL) (Courier, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if courier, ok := c.couriers[addr.String()]; ok {
+		return courier, nil
+	}
+
+	courier, err := c.dispatch.NewCourier(addr, c.recipient)
+	if err != nil {
+		return nil, err
+	}
+	courier.SetSubscribers(c.subscribers)
+
+	c.couriers[addr.String()] = courier
+
+	return courier, nil
+}
+
+// tryEndpoints calls the given function with the courier of each endpoint in
+// order, until it succeeds. Failures are only recorded in the health tracker
+// if recordFailures is true. If any endpoint failed with a backoff error, that
+// error is returned, so the caller can retry later. Otherwise, the error of
+// the last endpoint is returned.
+func (c *FailoverCourier) tryEndpoints(ctx context.Context,
+	recordFailures bool, f func(Courier) error) error {
+
+	var (
+		backoffErr error
+		lastErr    error
+	)
+	for _, addr := range c.endpoints {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		courier, err := c.endpointCourier(addr)
+		if err == nil {
+			err = f(courier)
+		}
+		if err == nil {
+			c.health.RecordSuccess(addr, c.recipient)
+			return nil
+		}
+
+		log.Warnf("Proof courier %v failed for asset %v: %v",
+			addr.Host, c.recipient.AssetID, err)
+
+		if recordFailures {
+			c.health.RecordFailure(addr)
+		}
+
+		var backoffExecErr *BackoffExecError
+		if errors.As(err, &backoffExecErr) && backoffErr == nil {
+			backoffErr = err
+		}
+		lastErr = err
+	}
+
+	if backoffErr != nil {
+		return backoffErr
+	}
+
+	return lastErr
+}
+
+// DeliverProof attempts to deliver a proof to the receiver through each
+// endpoint in order, until one of them succeeds.
+func (c *FailoverCourier) DeliverProof(ctx context.Context,
+	annotatedProof *AnnotatedProof) error {
+
+	return c.tryEndpoints(ctx, true, func(courier Courier) error {
+		return courier.DeliverProof(ctx, annotatedProof)
+	})
+}
+
+// ReceiveProof attempts to obtain a proof through each endpoint in order,
+// until one of them succeeds. A failed retrieval doesn't count against the
+// health of an endpoint, as the sender might just not have delivered the proof
+// to it.
+func (c *FailoverCourier) ReceiveProof(ctx context.Context,
+	loc Locator) (*AnnotatedProof, error) {
+
+	var annotatedProof *AnnotatedProof
+	err := c.tryEndpoints(ctx, false, func(courier Courier) error {
+		var err error
+		annotatedProof, err = courier.ReceiveProof(ctx, loc)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return annotatedProof, nil
+}
+
+// SetSubscribers sets the set of subscribers that will be notified of proof
+// courier related events.
+func (c *FailoverCourier) SetSubscribers(
+	subscribers map[uint64]*fn.EventReceiver[fn.Event]) {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.subscribers = subscribers
+	for _, courier := range c.couriers {
+		courier.SetSubscribers(subscribers)
+	}
+}
+
+// Close stops the couriers of all endpoints.
+func (c *FailoverCourier) Close() error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	var closeErr error
+	for addr, courier := range c.couriers {
+		if err := courier.Close(); err != nil {
+			closeErr = fmt.Errorf("unable to close proof courier "+
+				"%v: %w", addr, err)
+		}
+	}
+
+	return closeErr
+}
+
+// A compile-time assertion to ensure that the FailoverCourier meets the
+// Courier interface.
+var _ Courier = (*FailoverCourier)(nil)
@@ -0,0 +1,205 @@
+package proof
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// failoverTestCourier is a proof courier that fails or succeeds depending on
+// the configuration of its endpoint.
+type failoverTestCourier struct {
+	addr       string
+	dispatcher *failoverTestDispatcher
+}
+
+func (c *failoverTestCourier) DeliverProof(context.Context,
+	*AnnotatedProof) error {
+
+	return c.dispatcher.attempt(c.addr)
+}
+
+func (c *failoverTestCourier) ReceiveProof(context.Context,
+	Locator) (*AnnotatedProof, error) {
+
+	if err := c.dispatcher.attempt(c.addr); err != nil {
+		return nil, err
+	}
+
+	return &AnnotatedProof{}, nil
+}
+
+func (c *failoverTestCourier) Probe(context.Context) error {
+	return c.dispatcher.attempt(c.addr)
+}
+
+func (c *failoverTestCourier) SetSubscribers(
+	map[uint64]*fn.EventReceiver[fn.Event]) {
+}
+
+func (c *failoverTestCourier) Close() error {
+	return nil
+}
+
+// failoverTestDispatcher creates test couriers and records the order in which
+// their endpoints are used.
+type failoverTestDispatcher struct {
+	sync.Mutex
+
+	failing  map[string]error
+	attempts []string
+}
+
+func (d *failoverTestDispatcher) NewCourier(addr *url.URL,
+	_ Recipient) (Courier, error) {
+
+	return &failoverTestCourier{
+		addr:       addr.String(),
+		dispatcher: d,
+	}, nil
+}
+
+func (d *failoverTestDispatcher) attempt(addr string) error {
+	d.Lock()
+	defer d.Unlock()
+
+	d.attempts = append(d.attempts, addr)
+	return d.failing[addr]
+}
+
+func (d *failoverTestDispatcher) popAttempts() []string {
+	d.Lock()
+	defer d.Unlock()
+
+	attempts := d.attempts
+	d.attempts = nil
+
+	return attempts
+}
+
+// TestFailoverCourier tests that the failover courier tries the configured
+// endpoints in order of their health and sticks to the endpoint that last
+// worked for a recipient.
+func TestFailoverCourier(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	parseAddr := func(addr string) *url.URL {
+		courierAddr, err := url.Parse(addr)
+		require.NoError(t, err)
+
+		return courierAddr
+	}
+	first := parseAddr("universerpc://first:10029")
+	second := parseAddr("universerpc://second:10029")
+	third := parseAddr("universerpc://third:10029")
+	other := parseAddr("universerpc://other:10029")
+
+	dispatcher := &failoverTestDispatcher{
+		failing: make(map[string]error),
+	}
+	health := NewCourierHealth(2, time.Hour)
+	failover := NewFailoverDispatch(
+		dispatcher, []*url.URL{first, second, third}, health,
+	)
+
+	alice := Recipient{
+		ScriptKey: test.RandPubKey(t),
+	}
+	bob := Recipient{
+		ScriptKey: test.RandPubKey(t),
+	}
+
+	deliver := func(addr *url.URL, recipient Recipient) error {
+		courier, err := failover.NewCourier(addr, recipient)
+		require.NoError(t, err)
+		defer courier.Close()
+
+		return courier.DeliverProof(ctx, &AnnotatedProof{})
+	}
+
+	// With all endpoints working, the courier of the transfer is used.
+	require.NoError(t, deliver(second, alice))
+	require.Equal(t, []string{second.String()}, dispatcher.popAttempts())
+
+	// A courier address that isn't configured is used on its own.
+	dispatcher.failing[other.String()] = fmt.Errorf("other down")
+	require.ErrorContains(t, deliver(other, alice), "other down")
+	require.Equal(t, []string{other.String()}, dispatcher.popAttempts())
+
+	// If the first endpoint fails, the others are tried in their
+	// configured order.
+	dispatcher.failing[first.String()] = fmt.Errorf("first down")
+	require.NoError(t, deliver(first, bob))
+	require.Equal(
+		t, []string{first.String(), second.String()},
+		dispatcher.popAttempts(),
+	)
+
+	// Bob now sticks to the second endpoint, even though the first one is
+	// the courier of the transfer.
+	delete(dispatcher.failing, first.String())
+	require.NoError(t, deliver(first, bob))
+	require.Equal(t, []string{second.String()}, dispatcher.popAttempts())
+
+	// After failing too often in a row, the first endpoint is unhealthy
+	// and tried last.
+	dispatcher.failing[first.String()] = fmt.Errorf("first down")
+	for i := 0; i < 2; i++ {
+		courier, err := failover.NewCourier(first, Recipient{})
+		require.NoError(t, err)
+
+		dispatcher.failing[second.String()] = fmt.Errorf("down")
+		dispatcher.failing[third.String()] = fmt.Errorf("down")
+		require.Error(t, courier.DeliverProof(ctx, &AnnotatedProof{}))
+	}
+	delete(dispatcher.failing, second.String())
+	delete(dispatcher.failing, third.String())
+	_ = dispatcher.popAttempts()
+
+	carol := Recipient{
+		ScriptKey: test.RandPubKey(t),
+	}
+	require.NoError(t, deliver(first, carol))
+	require.Equal(t, []string{second.String()}, dispatcher.popAttempts())
+
+	// A backoff error of any endpoint is returned, so the caller can retry
+	// later.
+	backoffErr := &BackoffExecError{execErr: fmt.Errorf("backoff")}
+	dispatcher.failing[first.String()] = backoffErr
+	dispatcher.failing[second.String()] = fmt.Errorf("down")
+	dispatcher.failing[third.String()] = fmt.Errorf("down")
+	err := deliver(first, Recipient{})
+	require.ErrorIs(t, err, backoffErr)
+
+	// Failed retrievals don't count against the health of an endpoint.
+	stats := health.Stats()
+	require.Len(t, stats, 4)
+	statsByAddr := make(map[string]CourierEndpointStats)
+	for _, endpointStats := range stats {
+		statsByAddr[endpointStats.Addr] = endpointStats
+	}
+	thirdFailures := statsByAddr[third.String()].Failures
+
+	courier, err := failover.NewCourier(third, Recipient{})
+	require.NoError(t, err)
+	_, err = courier.ReceiveProof(ctx, Locator{})
+	require.Error(t, err)
+
+	stats = health.Stats()
+	for _, endpointStats := range stats {
+		statsByAddr[endpointStats.Addr] = endpointStats
+	}
+	require.Equal(t, thirdFailures, statsByAddr[third.String()].Failures)
+	require.False(t, statsByAddr[first.String()].Healthy)
+	require.True(t, statsByAddr[other.String()].Healthy)
+	require.EqualValues(t, 4, statsByAddr[second.String()].Successes)
+}
@@ -0,0 +1,173 @@
+package proof
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+// ErrProbeUnsupported is returned when probing a proof courier that doesn't
+// support probes.
+var ErrProbeUnsupported = errors.New("proof courier doesn't support probes")
+
+// CourierProber is implemented by proof couriers that can check whether their
+// endpoint is reachable and accepts requests, without delivering a proof.
+type CourierProber interface {
+	// Probe checks whether the courier endpoint is reachable and accepts
+	// requests.
+	Probe(ctx context.Context) error
+}
+
+// ProbeCourier creates a courier for the given address and recipient with the
+// given dispatch and probes it. If the courier doesn't support probes,
+// ErrProbeUnsupported is returned.
+func ProbeCourier(ctx context.Context, dispatch CourierDispatch,
+	addr *url.URL, recipient Recipient) error {
+
+	courier, err := dispatch.NewCourier(addr, recipient)
+	if err != nil {
+		return fmt.Errorf("unable to create proof courier: %w", err)
+	}
+	defer courier.Close()
+
+	prober, ok := courier.(CourierProber)
+	if !ok {
+		return ErrProbeUnsupported
+	}
+
+	return prober.Probe(ctx)
+}
+
+// waitForConnReady establishes the given gRPC connection if it isn't yet and
+// waits until it is ready, or the context expires.
+func waitForConnReady(ctx context.Context, conn *grpc.ClientConn) error {
+	conn.Connect()
+
+	for {
+		state := conn.GetState()
+		switch state {
+		case connectivity.Ready:
+			return nil
+
+		case connectivity.Shutdown:
+			return fmt.Errorf("connection shut down")
+		}
+
+		if !conn.WaitForStateChange(ctx, state) {
+			return fmt.Errorf("connection not ready (state=%v): %w",
+				state, ctx.Err())
+		}
+	}
+}
+
+// Probe checks whether the hashmail server is reachable by establishing a
+// connection to it.
+//
+// NOTE: This is part of the CourierProber interface.
+func (h *HashMailBox) Probe(ctx context.Context) error {
+	return waitForConnReady(ctx, h.rawConn)
+}
+
+// Probe checks whether the hashmail server of the courier is reachable.
+//
+// NOTE: This is part of the CourierProber interface.
+func (h *HashMailCourier) Probe(ctx context.Context) error {
+	prober, ok := h.mailbox.(CourierProber)
+	if !ok {
+		return ErrProbeUnsupported
+	}
+
+	return prober.Probe(ctx)
+}
+
+// Probe checks whether the universe server of the courier is reachable and
+// accepts requests by querying its info.
+//
+// NOTE: This is part of the CourierProber interface.
+func (c *UniverseRpcCourier) Probe(ctx context.Context) error {
+	_, err := c.client.Info(ctx, &unirpc.InfoRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to query universe server info: %w",
+			err)
+	}
+
+	return nil
+}
+
+// Probe checks whether the HTTPS courier is reachable. Any response that
+// isn't a server error counts as success, as the courier doesn't need to
+// serve its base URL.
+//
+// NOTE: This is part of the CourierProber interface.
+func (h *HttpsCourier) Probe(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodHead, h.baseURL.String(), nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("proof courier returned status %v",
+			resp.Status)
+	}
+
+	return nil
+}
+
+// Probe checks whether any of the endpoints of the courier is reachable. The
+// probes don't count towards the health of the endpoints, as no proof was
+// transferred.
+//
+// NOTE: This is part of the CourierProber interface.
+func (c *FailoverCourier) Probe(ctx context.Context) error {
+	var lastErr error
+	for _, addr := range c.endpoints {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		courier, err := c.endpointCourier(addr)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		prober, ok := courier.(CourierProber)
+		if !ok {
+			lastErr = ErrProbeUnsupported
+			continue
+		}
+
+		lastErr = prober.Probe(ctx)
+		if lastErr == nil {
+			return nil
+		}
+
+		log.Debugf("Probe of proof courier %v failed: %v", addr.Host,
+			lastErr)
+	}
+
+	return lastErr
+}
+
+// A compile-time assertion to ensure that the built-in couriers meet the
+// CourierProber interface.
+var (
+	_ CourierProber = (*HashMailCourier)(nil)
+	_ CourierProber = (*UniverseRpcCourier)(nil)
+	_ CourierProber = (*HttpsCourier)(nil)
+	_ CourierProber = (*FailoverCourier)(nil)
+)
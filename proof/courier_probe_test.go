@@ -0,0 +1,107 @@
+package proof
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// noProbeCourier is a proof courier that doesn't support probes.
+type noProbeCourier struct {
+	Courier
+}
+
+func (c *noProbeCourier) Close() error {
+	return nil
+}
+
+// TestProbeHttpsCourier tests that an HTTPS proof courier passes a probe as
+// long as it doesn't respond with a server error.
+func TestProbeHttpsCourier(t *testing.T) {
+	t.Parallel()
+
+	var status atomic.Int32
+	status.Store(http.StatusNotFound)
+	server := httptest.NewTLSServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, http.MethodHead, r.Method)
+			w.WriteHeader(int(status.Load()))
+		},
+	))
+	t.Cleanup(server.Close)
+
+	dispatch := NewCourierDispatch(&CourierCfg{
+		HttpsCfg: &HttpsCourierCfg{
+			Client: server.Client(),
+		},
+		TransferLog: &mockTransferLog{},
+	})
+	addr, err := url.Parse(server.URL + "/courier")
+	require.NoError(t, err)
+
+	recipient := Recipient{
+		ScriptKey: test.RandPubKey(t),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	require.NoError(t, ProbeCourier(ctx, dispatch, addr, recipient))
+
+	status.Store(http.StatusServiceUnavailable)
+	require.Error(t, ProbeCourier(ctx, dispatch, addr, recipient))
+
+	// A courier that can't be reached at all fails the probe as well.
+	server.Close()
+	require.Error(t, ProbeCourier(ctx, dispatch, addr, recipient))
+}
+
+// TestProbeFailoverCourier tests that a failover courier passes a probe if any
+// of its endpoints is reachable, without changing their health.
+func TestProbeFailoverCourier(t *testing.T) {
+	t.Parallel()
+
+	first, err := url.Parse("universerpc://first:10029")
+	require.NoError(t, err)
+	second, err := url.Parse("universerpc://second:10029")
+	require.NoError(t, err)
+
+	dispatcher := &failoverTestDispatcher{
+		failing: map[string]error{
+			first.String(): fmt.Errorf("first down"),
+		},
+	}
+	health := NewCourierHealth(1, 0)
+	failover := NewFailoverDispatch(
+		dispatcher, []*url.URL{first, second}, health,
+	)
+
+	ctx := context.Background()
+	require.NoError(t, ProbeCourier(ctx, failover, first, Recipient{}))
+	require.Equal(
+		t, []string{first.String(), second.String()},
+		dispatcher.popAttempts(),
+	)
+	for _, stats := range health.Stats() {
+		require.Zero(t, stats.Successes)
+		require.Zero(t, stats.Failures)
+	}
+
+	dispatcher.failing[second.String()] = fmt.Errorf("second down")
+	err = ProbeCourier(ctx, failover, first, Recipient{})
+	require.ErrorContains(t, err, "second down")
+
+	// A courier that doesn't support probes is reported as such.
+	mockDispatch := &MockProofCourierDispatcher{
+		Courier: &noProbeCourier{},
+	}
+	err = ProbeCourier(ctx, mockDispatch, first, Recipient{})
+	require.ErrorIs(t, err, ErrProbeUnsupported)
+}
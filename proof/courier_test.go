@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"net/url"
 	"testing"
 
 	"github.com/lightninglabs/taproot-assets/asset"
@@ -113,3 +114,44 @@ func TestUniverseRpcCourierLocalArchiveShortCut(t *testing.T) {
 	})
 	require.ErrorContains(t, err, "is missing outpoint")
 }
+
+// TestRegisterCourier tests that a custom proof courier can be registered and
+// is then used for courier addresses with its scheme.
+func TestRegisterCourier(t *testing.T) {
+	t.Parallel()
+
+	const scheme = "mockcourier"
+	addr, err := url.Parse(scheme + "://localhost:1234")
+	require.NoError(t, err)
+
+	// Before the courier is registered, its addresses are rejected.
+	require.ErrorContains(
+		t, ValidateCourierAddress(addr), "unknown courier address",
+	)
+
+	mockCourier := NewMockProofCourier()
+	driver := &CourierDriver{
+		Scheme: scheme,
+		New: func(*CourierCfg, *url.URL, Recipient) (Courier, error) {
+			return mockCourier, nil
+		},
+	}
+	require.NoError(t, RegisterCourier(driver))
+
+	// A scheme can only be registered once, which also protects the
+	// built-in couriers from being replaced.
+	require.Error(t, RegisterCourier(driver))
+	require.Error(t, RegisterCourier(&CourierDriver{
+		Scheme: HashmailCourierType,
+		New:    driver.New,
+	}))
+	require.Error(t, RegisterCourier(&CourierDriver{Scheme: "nonew"}))
+
+	require.Contains(t, RegisteredCouriers(), driver)
+	require.NoError(t, ValidateCourierAddress(addr))
+
+	dispatch := NewCourierDispatch(&CourierCfg{})
+	courier, err := dispatch.NewCourier(addr, Recipient{})
+	require.NoError(t, err)
+	require.Equal(t, mockCourier, courier)
+}
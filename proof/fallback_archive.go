@@ -0,0 +1,280 @@
+package proof
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+// RemoteProofFetcher fetches proof files from a remote source, for example
+// the universe servers of the federation.
+type RemoteProofFetcher interface {
+	// FetchRemoteProof fetches the full proof file of the asset identified
+	// by the given locator. The name of the source the proof was fetched
+	// from is returned along with the proof file.
+	FetchRemoteProof(ctx context.Context, loc Locator) (Blob, string,
+		error)
+}
+
+// ProofRecoveredEvent is emitted when a proof that was missing from the local
+// archive was fetched from a remote source and re-populated in the archive.
+type ProofRecoveredEvent struct {
+	// Locator is the locator of the recovered proof.
+	Locator Locator
+
+	// Source is the name of the remote source the proof was fetched from.
+	Source string
+
+	// timestamp is the time the proof was recovered.
+	timestamp time.Time
+}
+
+// NewProofRecoveredEvent creates a new proof recovered event.
+func NewProofRecoveredEvent(loc Locator, source string) *ProofRecoveredEvent {
+	return &ProofRecoveredEvent{
+		Locator:   loc,
+		Source:    source,
+		timestamp: time.Now().UTC(),
+	}
+}
+
+// Timestamp returns the time the proof was recovered.
+//
+// NOTE: This is part of the fn.Event interface.
+func (e *ProofRecoveredEvent) Timestamp() time.Time {
+	return e.timestamp
+}
+
+// A compile-time assertion to ensure ProofRecoveredEvent satisfies the
+// fn.Event interface.
+var _ fn.Event = (*ProofRecoveredEvent)(nil)
+
+// FallbackArchiverCfg is the config for the FallbackArchiver.
+type FallbackArchiverCfg struct {
+	// Archive is the local archive proofs are fetched from first, and
+	// that remotely fetched proofs are imported into. The archive MUST
+	// verify proofs on import, like the MultiArchiver does.
+	Archive Archiver
+
+	// Fetcher is used to fetch the proofs that are missing from the local
+	// archive.
+	Fetcher RemoteProofFetcher
+
+	// HeaderVerifier is used to verify the block headers of remotely
+	// fetched proofs.
+	HeaderVerifier HeaderVerifier
+
+	// MerkleVerifier is used to verify the merkle proofs of remotely
+	// fetched proofs.
+	MerkleVerifier MerkleVerifier
+
+	// GroupVerifier is used to verify the group keys of remotely fetched
+	// proofs.
+	GroupVerifier GroupVerifier
+
+	// ChainLookupGen is used to look up chain data when verifying
+	// remotely fetched proofs.
+	ChainLookupGen ChainLookupGenerator
+}
+
+// FallbackArchiver is an Archiver that falls back to fetching a proof from a
+// remote source if it is missing from the local archive, for example because
+// it was pruned. A remotely fetched proof is verified and re-populated in the
+// local archive, so the remote source is only queried once per proof.
+type FallbackArchiver struct {
+	cfg FallbackArchiverCfg
+
+	// eventDistributor is used to notify subscribers about proofs that
+	// were recovered from a remote source.
+	eventDistributor *fn.EventDistributor[fn.Event]
+}
+
+// NewFallbackArchiver creates a new FallbackArchiver from the given config.
+func NewFallbackArchiver(cfg FallbackArchiverCfg) *FallbackArchiver {
+	return &FallbackArchiver{
+		cfg:              cfg,
+		eventDistributor: fn.NewEventDistributor[fn.Event](),
+	}
+}
+
+// FetchProof fetches a proof for an asset uniquely identified by the passed
+// locator. If the proof is missing from the local archive, it is fetched from
+// the remote source and imported into the local archive.
+//
+// NOTE: This is part of the Archiver interface.
+func (f *FallbackArchiver) FetchProof(ctx context.Context,
+	loc Locator) (Blob, error) {
+
+	proofBlob, err := f.cfg.Archive.FetchProof(ctx, loc)
+	if !errors.Is(err, ErrProofNotFound) {
+		return proofBlob, err
+	}
+
+	// Remote sources index proofs by their asset ID and outpoint, so we
+	// can't look up the proof without them.
+	if loc.AssetID == nil || loc.OutPoint == nil {
+		return nil, err
+	}
+
+	log.Infof("Proof for asset_id=%v, script_key=%x, outpoint=%v not "+
+		"found locally, fetching from remote source", loc.AssetID,
+		loc.ScriptKey.SerializeCompressed(), loc.OutPoint)
+
+	proofBlob, source, remoteErr := f.cfg.Fetcher.FetchRemoteProof(
+		ctx, loc,
+	)
+	if remoteErr != nil {
+		log.Debugf("Unable to fetch proof from remote source: %v",
+			remoteErr)
+
+		return nil, fmt.Errorf("%w: unable to fetch from remote "+
+			"source: %v", ErrProofNotFound, remoteErr)
+	}
+
+	if err := f.importRemoteProof(ctx, loc, proofBlob); err != nil {
+		return nil, fmt.Errorf("unable to import proof fetched from "+
+			"%v: %w", source, err)
+	}
+
+	log.Infof("Recovered proof for asset_id=%v, outpoint=%v from %v",
+		loc.AssetID, loc.OutPoint, source)
+
+	f.eventDistributor.NotifySubscribers(
+		NewProofRecoveredEvent(loc, source),
+	)
+
+	return proofBlob, nil
+}
+
+// importRemoteProof makes sure the given remotely fetched proof file is for
+// the asset identified by the given locator, then verifies and imports it into
+// the local archive.
+func (f *FallbackArchiver) importRemoteProof(ctx context.Context, loc Locator,
+	proofBlob Blob) error {
+
+	proofFile, err := DecodeFile(proofBlob)
+	if err != nil {
+		return fmt.Errorf("unable to decode proof file: %w", err)
+	}
+
+	lastProof, err := proofFile.LastProof()
+	if err != nil {
+		return fmt.Errorf("unable to extract last proof: %w", err)
+	}
+
+	// A valid proof for a different asset must not end up in the archive
+	// under the wrong locator.
+	if err := checkProofLocator(lastProof, loc); err != nil {
+		return err
+	}
+
+	return f.cfg.Archive.ImportProofs(
+		ctx, f.cfg.HeaderVerifier, f.cfg.MerkleVerifier,
+		f.cfg.GroupVerifier, f.cfg.ChainLookupGen, false,
+		&AnnotatedProof{
+			Locator: loc,
+			Blob:    proofBlob,
+		},
+	)
+}
+
+// checkProofLocator makes sure the given proof is for the asset identified by
+// the given locator.
+func checkProofLocator(p *Proof, loc Locator) error {
+	var (
+		proofAsset = p.Asset
+		assetID    = proofAsset.ID()
+		outPoint   = p.OutPoint()
+	)
+
+	switch {
+	case loc.AssetID != nil && assetID != *loc.AssetID:
+		return fmt.Errorf("proof is for asset %v instead of %v",
+			assetID, loc.AssetID)
+
+	case !proofAsset.ScriptKey.PubKey.IsEqual(&loc.ScriptKey):
+		return fmt.Errorf("proof is for script key %x instead of %x",
+			proofAsset.ScriptKey.PubKey.SerializeCompressed(),
+			loc.ScriptKey.SerializeCompressed())
+
+	case loc.OutPoint != nil && outPoint != *loc.OutPoint:
+		return fmt.Errorf("proof is for outpoint %v instead of %v",
+			outPoint, loc.OutPoint)
+	}
+
+	return nil
+}
+
+// HasProof returns true if the proof for the given locator exists in the
+// local archive. The remote source is not queried.
+//
+// NOTE: This is part of the Archiver interface.
+func (f *FallbackArchiver) HasProof(ctx context.Context,
+	id Locator) (bool, error) {
+
+	return f.cfg.Archive.HasProof(ctx, id)
+}
+
+// FetchProofs fetches all proofs for assets uniquely identified by the passed
+// asset ID from the local archive.
+//
+// NOTE: This is part of the Archiver interface.
+func (f *FallbackArchiver) FetchProofs(ctx context.Context,
+	id asset.ID) ([]*AnnotatedProof, error) {
+
+	return f.cfg.Archive.FetchProofs(ctx, id)
+}
+
+// ImportProofs imports the given proofs into the local archive.
+//
+// NOTE: This is part of the Archiver interface.
+func (f *FallbackArchiver) ImportProofs(ctx context.Context,
+	headerVerifier HeaderVerifier, merkleVerifier MerkleVerifier,
+	groupVerifier GroupVerifier, chainLookupGen ChainLookupGenerator,
+	replace bool, proofs ...*AnnotatedProof) error {
+
+	return f.cfg.Archive.ImportProofs(
+		ctx, headerVerifier, merkleVerifier, groupVerifier,
+		chainLookupGen, replace, proofs...,
+	)
+}
+
+// RegisterSubscriber adds a new subscriber that is notified of proofs that
+// were recovered from the remote source. As the events aren't persisted,
+// delivering existing events isn't supported.
+//
+// NOTE: This is part of the fn.EventPublisher interface.
+func (f *FallbackArchiver) RegisterSubscriber(
+	receiver *fn.EventReceiver[fn.Event], deliverExisting bool,
+	_ time.Time) error {
+
+	if deliverExisting {
+		return fmt.Errorf("delivering existing proof recovery events " +
+			"is not supported")
+	}
+
+	f.eventDistributor.RegisterSubscriber(receiver)
+
+	return nil
+}
+
+// RemoveSubscriber removes the given subscriber of proof recovery events and
+// stops it from processing events.
+//
+// NOTE: This is part of the fn.EventPublisher interface.
+func (f *FallbackArchiver) RemoveSubscriber(
+	subscriber *fn.EventReceiver[fn.Event]) error {
+
+	return f.eventDistributor.RemoveSubscriber(subscriber)
+}
+
+// A compile-time assertion to ensure FallbackArchiver satisfies the Archiver
+// and fn.EventPublisher interfaces.
+var (
+	_ Archiver                               = (*FallbackArchiver)(nil)
+	_ fn.EventPublisher[fn.Event, time.Time] = (*FallbackArchiver)(nil)
+)
@@ -0,0 +1,149 @@
+package proof
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// mockRemoteFetcher is a mock RemoteProofFetcher that serves a fixed set of
+// proof files.
+type mockRemoteFetcher struct {
+	proofs map[asset.ID]Blob
+	calls  int
+}
+
+// FetchRemoteProof returns the proof file stored for the asset ID of the given
+// locator.
+func (m *mockRemoteFetcher) FetchRemoteProof(_ context.Context,
+	loc Locator) (Blob, string, error) {
+
+	m.calls++
+
+	proofBlob, ok := m.proofs[*loc.AssetID]
+	if !ok {
+		return nil, "", fmt.Errorf("proof not found on remote")
+	}
+
+	return proofBlob, "universe.example.com:10029", nil
+}
+
+// TestFallbackArchiver tests that proofs missing from the local archive are
+// fetched from the remote source, checked against the locator, imported into
+// the local archive and announced to subscribers.
+func TestFallbackArchiver(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	fileArchive, err := NewFileArchiver(t.TempDir())
+	require.NoError(t, err)
+
+	testBlocks := readTestData(t)
+	oddTxBlock := testBlocks[0]
+
+	genesis := asset.RandGenesis(t, asset.Collectible)
+	scriptKey := test.RandPubKey(t)
+	remoteProof := RandProof(t, genesis, scriptKey, oddTxBlock, 0, 1)
+
+	file, err := NewFile(V0, remoteProof)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, file.Encode(&buf))
+
+	assetID := remoteProof.Asset.ID()
+	fetcher := &mockRemoteFetcher{
+		proofs: map[asset.ID]Blob{
+			assetID: buf.Bytes(),
+		},
+	}
+	archive := NewFallbackArchiver(FallbackArchiverCfg{
+		Archive: NewMultiArchiver(
+			NewMockVerifier(t), testTimeout, fileArchive,
+		),
+		Fetcher:        fetcher,
+		HeaderVerifier: MockHeaderVerifier,
+		MerkleVerifier: MockMerkleVerifier,
+		GroupVerifier:  MockGroupVerifier,
+		ChainLookupGen: MockChainLookup,
+	})
+
+	events := fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize)
+	err = archive.RegisterSubscriber(events, false, time.Time{})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, archive.RemoveSubscriber(events))
+	})
+
+	loc := Locator{
+		AssetID:   &assetID,
+		ScriptKey: *remoteProof.Asset.ScriptKey.PubKey,
+		OutPoint:  fn.Ptr(remoteProof.OutPoint()),
+	}
+
+	// The proof is missing locally, so it's fetched from the remote source
+	// and subscribers are notified about the recovery.
+	proofBlob, err := archive.FetchProof(ctx, loc)
+	require.NoError(t, err)
+	require.Equal(t, buf.Bytes(), []byte(proofBlob))
+	require.Equal(t, 1, fetcher.calls)
+
+	select {
+	case event := <-events.NewItemCreated.ChanOut():
+		recovered, ok := event.(*ProofRecoveredEvent)
+		require.True(t, ok)
+		require.Equal(t, loc, recovered.Locator)
+		require.Equal(t, "universe.example.com:10029", recovered.Source)
+
+	case <-time.After(testTimeout):
+		t.Fatalf("no proof recovered event received")
+	}
+
+	// The proof was re-populated in the local archive, so the remote
+	// source isn't queried again.
+	hasProof, err := archive.HasProof(ctx, loc)
+	require.NoError(t, err)
+	require.True(t, hasProof)
+
+	proofBlob, err = archive.FetchProof(ctx, loc)
+	require.NoError(t, err)
+	require.Equal(t, buf.Bytes(), []byte(proofBlob))
+	require.Equal(t, 1, fetcher.calls)
+
+	// A proof the remote source serves for a different script key than the
+	// requested one is rejected.
+	otherLoc := Locator{
+		AssetID:   &assetID,
+		ScriptKey: *test.RandPubKey(t),
+		OutPoint:  loc.OutPoint,
+	}
+	_, err = archive.FetchProof(ctx, otherLoc)
+	require.ErrorContains(t, err, "proof is for script key")
+	require.Equal(t, 2, fetcher.calls)
+
+	// Without an outpoint, the proof can't be looked up remotely.
+	_, err = archive.FetchProof(ctx, Locator{
+		AssetID:   randAssetID(),
+		ScriptKey: loc.ScriptKey,
+	})
+	require.ErrorIs(t, err, ErrProofNotFound)
+	require.Equal(t, 2, fetcher.calls)
+
+	// If the remote source doesn't have the proof either, the proof is
+	// still reported as not found.
+	_, err = archive.FetchProof(ctx, Locator{
+		AssetID:   randAssetID(),
+		ScriptKey: loc.ScriptKey,
+		OutPoint:  loc.OutPoint,
+	})
+	require.ErrorIs(t, err, ErrProofNotFound)
+	require.Equal(t, 3, fetcher.calls)
+}
@@ -32,6 +32,10 @@ var (
 	// ErrProofFileInvalid is the error that's returned when a proof file is
 	// invalid.
 	ErrProofFileInvalid = errors.New("proof file is invalid")
+
+	// ErrProofFileTooLarge is the error that's returned when a proof file
+	// exceeds the configured maximum size.
+	ErrProofFileTooLarge = errors.New("proof file too large")
 )
 
 // Version denotes the versioning scheme for proof files.
@@ -61,6 +65,12 @@ const (
 	// much smaller, assuming they don't all have additional inputs. But we
 	// must cap this value somewhere to avoid OOM attacks.
 	FileMaxSizeBytes = 500 * 1024 * 1024
+
+	// DefaultMaxInboundFileSize is the default maximum size of a proof file
+	// we accept from an RPC client. This is much lower than
+	// FileMaxSizeBytes, as even long proof chains of normal assets are only
+	// a few megabytes in size.
+	DefaultMaxInboundFileSize = 64 * 1024 * 1024
 )
 
 // hashedProof is a struct that contains an encoded proof and its chained
@@ -174,6 +184,15 @@ func (f *File) Encode(w io.Writer) error {
 
 // Decode decodes a proof file from `r`.
 func (f *File) Decode(r io.Reader) error {
+	return f.decode(r, nil)
+}
+
+// decode reads the proof file from the given reader. If onProof is set, it is
+// called for each proof right after it was read and its checksum was verified,
+// before the next proof is read from the reader. At that point, the file only
+// contains the proofs read so far. An error returned by onProof aborts
+// decoding.
+func (f *File) decode(r io.Reader, onProof func(*hashedProof) error) error {
 	var prefixMagicBytes [PrefixMagicBytesLength]byte
 	num, err := r.Read(prefixMagicBytes[:])
 	if err != nil {
@@ -210,7 +229,7 @@ func (f *File) Decode(r io.Reader) error {
 	}
 
 	var prevHash, currentHash, proofHash [sha256.Size]byte
-	f.proofs = make([]*hashedProof, numProofs)
+	f.proofs = make([]*hashedProof, 0, numProofs)
 	for i := uint64(0); i < numProofs; i++ {
 		// We need to find out how many bytes we expect for the proof,
 		// so we can limit the TLV reader.
@@ -248,11 +267,18 @@ func (f *File) Decode(r io.Reader) error {
 			return ErrInvalidChecksum
 		}
 
-		f.proofs[i] = &hashedProof{
+		p := &hashedProof{
 			proofBytes: proofBytes,
 			hash:       currentHash,
 		}
+		f.proofs = append(f.proofs, p)
 		prevHash = currentHash
+
+		if onProof != nil {
+			if err := onProof(p); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
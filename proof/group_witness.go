@@ -0,0 +1,182 @@
+package proof
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"runtime"
+
+	"github.com/lightninglabs/neutrino/cache/lru"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/vm"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// DefaultGroupWitnessCacheSize is the default number of verified
+	// genesis group witnesses that are cached.
+	DefaultGroupWitnessCacheSize = 50_000
+)
+
+var (
+	// DefaultGroupWitnessVerifier is the group witness verifier that is
+	// shared by all proof verifications and the universe ingestion path.
+	DefaultGroupWitnessVerifier = NewGroupWitnessVerifier(
+		runtime.NumCPU(), DefaultGroupWitnessCacheSize,
+	)
+)
+
+// groupWitnessKey is the key of a verified genesis group witness in the cache.
+type groupWitnessKey struct {
+	// groupKey is the tweaked group key of the asset.
+	groupKey asset.SerializedKey
+
+	// assetID is the ID of the genesis asset.
+	assetID asset.ID
+}
+
+// cachedGroupWitness is the hash of the full encoding of a genesis asset,
+// including its witness, that was verified successfully.
+type cachedGroupWitness [sha256.Size]byte
+
+// Size determines how big this entry would be in the cache.
+func (c cachedGroupWitness) Size() (uint64, error) {
+	return 1, nil
+}
+
+// GroupWitnessVerifier verifies the witnesses of genesis assets that prove
+// their membership in an asset group. Verifying the Schnorr signatures is CPU
+// bound, so the number of concurrent verifications is limited to the number
+// of workers, and successfully verified witnesses are cached by their group
+// key and asset ID.
+type GroupWitnessVerifier struct {
+	// workers limits the number of concurrent verifications.
+	workers chan struct{}
+
+	// cache maps the group key and asset ID of a genesis asset to the
+	// hash of the asset that was verified.
+	cache *lru.Cache[groupWitnessKey, cachedGroupWitness]
+}
+
+// NewGroupWitnessVerifier creates a new group witness verifier that runs at
+// most numWorkers verifications concurrently and caches up to cacheSize
+// verified witnesses.
+func NewGroupWitnessVerifier(numWorkers int,
+	cacheSize uint64) *GroupWitnessVerifier {
+
+	if numWorkers <= 0 {
+		numWorkers = runtime.NumCPU()
+	}
+
+	return &GroupWitnessVerifier{
+		workers: make(chan struct{}, numWorkers),
+		cache: lru.NewCache[groupWitnessKey, cachedGroupWitness](
+			cacheSize,
+		),
+	}
+}
+
+// Verify verifies the group witness of the given genesis asset. If the exact
+// same asset was already verified before, the cached result is used. Assets
+// with a time lock are never cached, as the result depends on the block height
+// they are verified against.
+func (v *GroupWitnessVerifier) Verify(ctx context.Context,
+	genesisAsset *asset.Asset, opts ...vm.NewEngineOpt) error {
+
+	if !genesisAsset.HasGenesisWitnessForGroup() {
+		return fmt.Errorf("asset has no genesis group witness")
+	}
+
+	cacheable := genesisAsset.LockTime == 0 &&
+		genesisAsset.RelativeLockTime == 0
+
+	var (
+		key       groupWitnessKey
+		assetHash cachedGroupWitness
+	)
+	if cacheable {
+		key = groupWitnessKey{
+			groupKey: asset.ToSerialized(
+				&genesisAsset.GroupKey.GroupPubKey,
+			),
+			assetID: genesisAsset.ID(),
+		}
+
+		h := sha256.New()
+		if err := genesisAsset.Encode(h); err != nil {
+			return fmt.Errorf("unable to encode asset: %w", err)
+		}
+		copy(assetHash[:], h.Sum(nil))
+
+		cached, err := v.cache.Get(key)
+		if err == nil && cached == assetHash {
+			return nil
+		}
+	}
+
+	select {
+	case v.workers <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() {
+		<-v.workers
+	}()
+
+	engine, err := vm.New(genesisAsset, nil, nil, opts...)
+	if err != nil {
+		return err
+	}
+	if err := engine.Execute(); err != nil {
+		return err
+	}
+
+	if cacheable {
+		if _, err := v.cache.Put(key, assetHash); err != nil {
+			log.Warnf("Unable to cache group witness for asset "+
+				"%v: %v", key.assetID, err)
+		}
+	}
+
+	return nil
+}
+
+// VerifyBatch verifies the group witnesses of all genesis assets in the given
+// list that don't have a time lock, using all workers of the verifier. Assets
+// without a genesis group witness are skipped. This can be used to warm up
+// the cache before verifying the full proofs of a large set of grouped
+// assets.
+func (v *GroupWitnessVerifier) VerifyBatch(ctx context.Context,
+	assets []*asset.Asset) error {
+
+	errGroup, ctx := errgroup.WithContext(ctx)
+	errGroup.SetLimit(cap(v.workers))
+
+	for idx := range assets {
+		genesisAsset := assets[idx]
+
+		switch {
+		case !genesisAsset.HasGenesisWitnessForGroup():
+			continue
+
+		case genesisAsset.LockTime != 0 ||
+			genesisAsset.RelativeLockTime != 0:
+
+			continue
+		}
+
+		errGroup.Go(func() error {
+			err := v.Verify(
+				ctx, genesisAsset, vm.WithSkipTimeLockValidation(),
+			)
+			if err != nil {
+				return fmt.Errorf("invalid group witness for "+
+					"asset %v: %w", genesisAsset.ID(), err)
+			}
+
+			return nil
+		})
+	}
+
+	return errGroup.Wait()
+}
@@ -0,0 +1,136 @@
+package proof
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/vm"
+	"github.com/stretchr/testify/require"
+)
+
+// randGroupedGenesisAsset creates a random genesis asset with a valid group
+// witness.
+func randGroupedGenesisAsset(t testing.TB) *asset.Asset {
+	t.Helper()
+
+	scriptKey := test.PubToKeyDesc(test.RandPubKey(t))
+	genesis := asset.RandGenesis(t, asset.Normal)
+	amount := uint64(1000)
+
+	protoAsset := asset.NewAssetNoErr(
+		t, genesis, amount, 0, 0, asset.NewScriptKeyBip86(scriptKey),
+		nil,
+	)
+	groupKey := asset.RandGroupKey(t, genesis, protoAsset)
+
+	_, assets, err := commitment.Mint(
+		nil, genesis, groupKey, &commitment.AssetDetails{
+			Type:      asset.Normal,
+			ScriptKey: scriptKey,
+			Amount:    &amount,
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, assets, 1)
+	require.True(t, assets[0].HasGenesisWitnessForGroup())
+
+	return assets[0]
+}
+
+// TestGroupWitnessVerifier tests that valid group witnesses are cached and
+// invalid ones are rejected, even if a valid witness for the same asset was
+// cached before.
+func TestGroupWitnessVerifier(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	verifier := NewGroupWitnessVerifier(2, 10)
+	skipLocks := vm.WithSkipTimeLockValidation()
+
+	validAsset := randGroupedGenesisAsset(t)
+	require.NoError(t, verifier.Verify(ctx, validAsset, skipLocks))
+
+	key := groupWitnessKey{
+		groupKey: asset.ToSerialized(
+			&validAsset.GroupKey.GroupPubKey,
+		),
+		assetID: validAsset.ID(),
+	}
+	_, err := verifier.cache.Get(key)
+	require.NoError(t, err)
+
+	// A different witness for the same group key and asset ID must not
+	// be accepted because of the cached result.
+	invalidAsset := validAsset.Copy()
+	invalidAsset.PrevWitnesses[0].TxWitness[0][0] ^= 1
+	require.Error(t, verifier.Verify(ctx, invalidAsset, skipLocks))
+
+	// Assets without a genesis group witness can't be verified.
+	ungroupedAsset := validAsset.Copy()
+	ungroupedAsset.GroupKey = nil
+	require.Error(t, verifier.Verify(ctx, ungroupedAsset, skipLocks))
+
+	// A batch verification skips assets without a group witness, but
+	// fails on any invalid group witness.
+	batchAssets := []*asset.Asset{
+		randGroupedGenesisAsset(t), ungroupedAsset,
+		randGroupedGenesisAsset(t),
+	}
+	require.NoError(t, verifier.VerifyBatch(ctx, batchAssets))
+
+	batchAssets = append(batchAssets, invalidAsset)
+	require.Error(t, verifier.VerifyBatch(ctx, batchAssets))
+}
+
+// BenchmarkGroupWitnessVerifier benchmarks the verification of a universe
+// with many grouped assets, both with an empty cache and with all group
+// witnesses already cached.
+func BenchmarkGroupWitnessVerifier(b *testing.B) {
+	const numAssets = 100
+
+	ctx := context.Background()
+	assets := make([]*asset.Asset, numAssets)
+	for idx := range assets {
+		assets[idx] = randGroupedGenesisAsset(b)
+	}
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for _, genesisAsset := range assets {
+				engine, err := vm.New(
+					genesisAsset, nil, nil,
+					vm.WithSkipTimeLockValidation(),
+				)
+				require.NoError(b, err)
+				require.NoError(b, engine.Execute())
+			}
+		}
+	})
+
+	b.Run("pool_cold_cache", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			verifier := NewGroupWitnessVerifier(
+				0, DefaultGroupWitnessCacheSize,
+			)
+			require.NoError(b, verifier.VerifyBatch(ctx, assets))
+		}
+	})
+
+	b.Run("pool_warm_cache", func(b *testing.B) {
+		verifier := NewGroupWitnessVerifier(
+			0, DefaultGroupWitnessCacheSize,
+		)
+		require.NoError(b, verifier.VerifyBatch(ctx, assets))
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			require.NoError(b, verifier.VerifyBatch(ctx, assets))
+		}
+	})
+}
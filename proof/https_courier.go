@@ -0,0 +1,504 @@
+package proof
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+const (
+	// HttpsCourierType is a courier that uses a REST API served over
+	// HTTPS to deliver proofs. Uploads are chunked and can be resumed, so
+	// proofs can be delivered through standard web infrastructure.
+	HttpsCourierType = "https"
+
+	// DefaultHttpsChunkSize is the default maximum number of bytes that
+	// are uploaded to an HTTPS proof courier in a single request.
+	DefaultHttpsChunkSize = 256 * 1024
+
+	// httpsUploadOffsetHeader is the header that carries the number of
+	// bytes of a proof the courier already received.
+	httpsUploadOffsetHeader = "Upload-Offset"
+
+	// httpsUploadLengthHeader is the header that carries the total size
+	// of a proof in bytes.
+	httpsUploadLengthHeader = "Upload-Length"
+
+	// httpsChunkContentType is the content type of an upload chunk.
+	httpsChunkContentType = "application/offset+octet-stream"
+
+	// httpsChunkSizeParam is the courier address query parameter that
+	// overwrites the configured chunk size for that courier.
+	httpsChunkSizeParam = "chunksize"
+
+	// httpsNumTriesParam is the courier address query parameter that
+	// overwrites the configured number of transfer attempts for that
+	// courier.
+	httpsNumTriesParam = "numtries"
+
+	// httpsInitialBackoffParam is the courier address query parameter that
+	// overwrites the configured initial backoff for that courier.
+	httpsInitialBackoffParam = "initialbackoff"
+
+	// httpsMaxBackoffParam is the courier address query parameter that
+	// overwrites the configured maximum backoff for that courier.
+	httpsMaxBackoffParam = "maxbackoff"
+)
+
+var (
+	// errHttpsProofNotFound is returned if the HTTPS proof courier doesn't
+	// have a complete proof for a recipient (yet).
+	errHttpsProofNotFound = errors.New("proof not found on courier")
+)
+
+// HttpsCourierCfg is the config for the HTTPS proof courier.
+type HttpsCourierCfg struct {
+	// ChunkSize is the maximum number of bytes uploaded in a single
+	// request.
+	ChunkSize uint64 `long:"chunksize" description:"The maximum number of bytes of a proof uploaded in a single request."`
+
+	// RequestTimeout is the maximum time a single request to the courier
+	// may take.
+	RequestTimeout time.Duration `long:"requesttimeout" description:"The maximum time a single request to the proof courier may take."`
+
+	// BackoffCfg configures the behaviour of the proof delivery
+	// functionality.
+	BackoffCfg *BackoffCfg
+
+	// Client is the HTTP client used to talk to the courier. If nil, a
+	// default client is created.
+	Client *http.Client `no-flag:"true"`
+}
+
+// HttpsCourier is an HTTPS proof courier service handle. It implements the
+// Courier interface.
+//
+// The courier serves a single resource per recipient under
+// <courier_addr>/proofs/<id>, where the ID is derived from the recipient:
+//   - HEAD returns the number of bytes received so far in the Upload-Offset
+//     header and the total size in the Upload-Length header.
+//   - PATCH appends the request body at the offset given in the
+//     Upload-Offset header and returns the new offset.
+//   - GET returns the proof once it was uploaded completely.
+//   - DELETE removes the proof.
+type HttpsCourier struct {
+	// recipient describes the recipient of the proof.
+	recipient Recipient
+
+	// baseURL is the courier address without any query parameters.
+	baseURL url.URL
+
+	// client is the HTTP client used to talk to the courier.
+	client *http.Client
+
+	// chunkSize is the maximum number of bytes uploaded in a single
+	// request.
+	chunkSize uint64
+
+	// cfg is the general courier configuration.
+	cfg *CourierCfg
+
+	// backoffHandle is a handle to the backoff procedure used in proof
+	// delivery.
+	backoffHandle *BackoffHandler
+
+	// subscribers is a map of components that want to be notified on new
+	// events, keyed by their subscription ID.
+	subscribers map[uint64]*fn.EventReceiver[fn.Event]
+
+	// subscriberMtx guards the subscribers map and access to the
+	// subscriptionID.
+	subscriberMtx sync.Mutex
+}
+
+// NewHttpsCourier creates a new HTTPS proof courier for the given courier
+// address. The chunk size and the retry behaviour can be overwritten per
+// courier through the query parameters of the address.
+func NewHttpsCourier(cfg *CourierCfg, addr *url.URL,
+	recipient Recipient) (*HttpsCourier, error) {
+
+	httpsCfg := cfg.HttpsCfg
+	if httpsCfg == nil {
+		return nil, fmt.Errorf("https proof courier not configured")
+	}
+
+	chunkSize := httpsCfg.ChunkSize
+	if chunkSize == 0 {
+		chunkSize = DefaultHttpsChunkSize
+	}
+
+	var backoffCfg BackoffCfg
+	if httpsCfg.BackoffCfg != nil {
+		backoffCfg = *httpsCfg.BackoffCfg
+	}
+
+	query := addr.Query()
+	for param, values := range query {
+		if len(values) == 0 {
+			continue
+		}
+		value := values[0]
+
+		var err error
+		switch param {
+		case httpsChunkSizeParam:
+			chunkSize, err = strconv.ParseUint(value, 10, 64)
+			if err == nil && chunkSize == 0 {
+				err = fmt.Errorf("must be positive")
+			}
+
+		case httpsNumTriesParam:
+			backoffCfg.NumTries, err = strconv.Atoi(value)
+
+		case httpsInitialBackoffParam:
+			backoffCfg.InitialBackoff, err = time.ParseDuration(
+				value,
+			)
+
+		case httpsMaxBackoffParam:
+			backoffCfg.MaxBackoff, err = time.ParseDuration(value)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid proof courier address "+
+				"parameter %v=%v: %w", param, value, err)
+		}
+	}
+
+	client := httpsCfg.Client
+	if client == nil {
+		client = &http.Client{
+			Timeout: httpsCfg.RequestTimeout,
+		}
+	}
+
+	baseURL := *addr
+	baseURL.RawQuery = ""
+	baseURL.Fragment = ""
+
+	return &HttpsCourier{
+		recipient: recipient,
+		baseURL:   baseURL,
+		client:    client,
+		chunkSize: chunkSize,
+		cfg:       cfg,
+		backoffHandle: NewBackoffHandler(
+			&backoffCfg, cfg.TransferLog,
+		),
+		subscribers: make(map[uint64]*fn.EventReceiver[fn.Event]),
+	}, nil
+}
+
+// proofURL returns the URL of the proof resource of the recipient.
+func (h *HttpsCourier) proofURL() string {
+	sid := deriveSenderStreamID(h.recipient)
+	proofURL := h.baseURL.JoinPath("proofs", hex.EncodeToString(sid[:]))
+
+	return proofURL.String()
+}
+
+// DeliverProof attempts to deliver a proof file to the receiver. The proof is
+// uploaded in chunks. If an attempt fails, the next one continues the upload
+// at the offset the courier already received.
+func (h *HttpsCourier) DeliverProof(ctx context.Context,
+	annotatedProof *AnnotatedProof) error {
+
+	log.Infof("HTTPS proof courier attempting to deliver proof (size=%d) "+
+		"for send event (asset_id=%v, amt=%v)",
+		len(annotatedProof.Blob), h.recipient.AssetID,
+		h.recipient.Amount)
+
+	deliverFunc := func() error {
+		return h.uploadProof(ctx, annotatedProof.Blob)
+	}
+	err := h.backoffHandle.Exec(
+		ctx, annotatedProof.Locator, SendTransferType, deliverFunc,
+		h.publishSubscriberEvent,
+	)
+	if err != nil {
+		return fmt.Errorf("proof backoff delivery attempt has "+
+			"failed: %w", err)
+	}
+
+	return nil
+}
+
+// uploadProof uploads the remaining chunks of the given proof, starting at the
+// offset the courier already received.
+func (h *HttpsCourier) uploadProof(ctx context.Context, blob Blob) error {
+	offset, length, err := h.uploadOffset(ctx)
+	if err != nil {
+		return err
+	}
+
+	// An upload of a different proof to the same recipient can't be
+	// resumed, so we start over.
+	if offset > 0 && length != uint64(len(blob)) {
+		log.Debugf("Discarding partial proof upload (offset=%d, "+
+			"length=%d, new_length=%d)", offset, length, len(blob))
+
+		if err := h.deleteProof(ctx); err != nil {
+			return err
+		}
+		offset = 0
+	}
+
+	if offset > 0 {
+		log.Infof("Resuming proof upload at offset %d of %d", offset,
+			len(blob))
+	}
+
+	for offset < uint64(len(blob)) {
+		end := offset + h.chunkSize
+		if end > uint64(len(blob)) {
+			end = uint64(len(blob))
+		}
+
+		offset, err = h.uploadChunk(
+			ctx, offset, uint64(len(blob)), blob[offset:end],
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// uploadOffset returns the number of bytes of the recipient's proof the courier
+// already received and the announced total size of the proof.
+func (h *HttpsCourier) uploadOffset(ctx context.Context) (uint64, uint64,
+	error) {
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodHead, h.proofURL(), nil,
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return 0, 0, fmt.Errorf("unable to query upload offset: %w",
+			err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		return 0, 0, nil
+
+	case http.StatusOK, http.StatusNoContent:
+
+	default:
+		return 0, 0, fmt.Errorf("unable to query upload offset: %v",
+			resp.Status)
+	}
+
+	offset, err := parseUintHeader(resp.Header, httpsUploadOffsetHeader)
+	if err != nil {
+		return 0, 0, err
+	}
+	length, err := parseUintHeader(resp.Header, httpsUploadLengthHeader)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return offset, length, nil
+}
+
+// uploadChunk uploads a single chunk of a proof at the given offset and
+// returns the new offset reported by the courier.
+func (h *HttpsCourier) uploadChunk(ctx context.Context, offset,
+	length uint64, chunk []byte) (uint64, error) {
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPatch, h.proofURL(), bytes.NewReader(chunk),
+	)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", httpsChunkContentType)
+	req.Header.Set(
+		httpsUploadOffsetHeader, strconv.FormatUint(offset, 10),
+	)
+	req.Header.Set(
+		httpsUploadLengthHeader, strconv.FormatUint(length, 10),
+	)
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("unable to upload proof chunk: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK &&
+		resp.StatusCode != http.StatusNoContent {
+
+		return 0, fmt.Errorf("unable to upload proof chunk at offset "+
+			"%d: %v", offset, resp.Status)
+	}
+
+	newOffset, err := parseUintHeader(resp.Header, httpsUploadOffsetHeader)
+	if err != nil {
+		return 0, err
+	}
+	if newOffset <= offset {
+		return 0, fmt.Errorf("courier didn't accept proof chunk at "+
+			"offset %d", offset)
+	}
+
+	return newOffset, nil
+}
+
+// deleteProof removes the recipient's proof from the courier.
+func (h *HttpsCourier) deleteProof(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodDelete, h.proofURL(), nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("unable to delete proof: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+
+	default:
+		return fmt.Errorf("unable to delete proof: %v", resp.Status)
+	}
+}
+
+// ReceiveProof attempts to obtain a proof file from the courier service. Once
+// the proof was received, it is removed from the courier.
+func (h *HttpsCourier) ReceiveProof(ctx context.Context,
+	loc Locator) (*AnnotatedProof, error) {
+
+	var proofBlob Blob
+	receiveFunc := func() error {
+		var err error
+		proofBlob, err = h.fetchProof(ctx)
+		return err
+	}
+	err := h.backoffHandle.Exec(
+		ctx, loc, ReceiveTransferType, receiveFunc,
+		h.publishSubscriberEvent,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("proof backoff receive attempt has "+
+			"failed: %w", err)
+	}
+
+	// The proof is of no use to anyone else, but we don't want to fail
+	// the receive if the clean up fails.
+	if err := h.deleteProof(ctx); err != nil {
+		log.Warnf("Unable to remove received proof from courier: %v",
+			err)
+	}
+
+	return &AnnotatedProof{
+		Locator: loc,
+		Blob:    proofBlob,
+	}, nil
+}
+
+// fetchProof downloads the recipient's proof from the courier.
+func (h *HttpsCourier) fetchProof(ctx context.Context) (Blob, error) {
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodGet, h.proofURL(), nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch proof: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+
+	case http.StatusNotFound:
+		return nil, errHttpsProofNotFound
+
+	default:
+		return nil, fmt.Errorf("unable to fetch proof: %v", resp.Status)
+	}
+
+	blob, err := io.ReadAll(io.LimitReader(resp.Body, FileMaxSizeBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read proof: %w", err)
+	}
+	if len(blob) > FileMaxSizeBytes {
+		return nil, fmt.Errorf("proof exceeds maximum size of %d bytes",
+			FileMaxSizeBytes)
+	}
+
+	return blob, nil
+}
+
+// SetSubscribers sets the subscribers for the courier. This method is
+// thread-safe.
+func (h *HttpsCourier) SetSubscribers(
+	subscribers map[uint64]*fn.EventReceiver[fn.Event]) {
+
+	h.subscriberMtx.Lock()
+	defer h.subscriberMtx.Unlock()
+
+	h.subscribers = subscribers
+}
+
+// publishSubscriberEvent publishes an event to all subscribers.
+func (h *HttpsCourier) publishSubscriberEvent(event fn.Event) {
+	// Lock the subscriber mutex to ensure that we don't modify the
+	// subscriber map while we're iterating over it.
+	h.subscriberMtx.Lock()
+	defer h.subscriberMtx.Unlock()
+
+	for _, sub := range h.subscribers {
+		sub.NewItemCreated.ChanIn() <- event
+	}
+}
+
+// Close closes the idle connections of the courier's HTTP client.
+func (h *HttpsCourier) Close() error {
+	h.client.CloseIdleConnections()
+	return nil
+}
+
+// A compile-time assertion to ensure the HttpsCourier meets the proof.Courier
+// interface.
+var _ Courier = (*HttpsCourier)(nil)
+
+// parseUintHeader parses the unsigned integer value of the given header.
+func parseUintHeader(header http.Header, key string) (uint64, error) {
+	value := header.Get(key)
+	if value == "" {
+		return 0, fmt.Errorf("courier response is missing %v header",
+			key)
+	}
+
+	num, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %v header: %w", key, err)
+	}
+
+	return num, nil
+}
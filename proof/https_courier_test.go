@@ -0,0 +1,199 @@
+package proof
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// mockTransferLog is a transfer log that doesn't record anything.
+type mockTransferLog struct{}
+
+func (m *mockTransferLog) LogProofTransferAttempt(context.Context, Locator,
+	TransferType) error {
+
+	return nil
+}
+
+func (m *mockTransferLog) QueryProofTransferLog(context.Context, Locator,
+	TransferType) ([]time.Time, error) {
+
+	return nil, nil
+}
+
+// mockHttpsCourierServer is a minimal in-memory implementation of the HTTPS
+// proof courier protocol that rejects every n-th chunk upload.
+type mockHttpsCourierServer struct {
+	sync.Mutex
+
+	uploads map[string][]byte
+	lengths map[string]uint64
+
+	numChunks  int
+	failEveryN int
+}
+
+func newMockHttpsCourierServer(failEveryN int) *mockHttpsCourierServer {
+	return &mockHttpsCourierServer{
+		uploads:    make(map[string][]byte),
+		lengths:    make(map[string]uint64),
+		failEveryN: failEveryN,
+	}
+}
+
+func (m *mockHttpsCourierServer) ServeHTTP(w http.ResponseWriter,
+	r *http.Request) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	id := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+	upload, ok := m.uploads[id]
+	length := m.lengths[id]
+
+	switch r.Method {
+	case http.MethodHead:
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set(
+			httpsUploadOffsetHeader, strconv.Itoa(len(upload)),
+		)
+		w.Header().Set(
+			httpsUploadLengthHeader, strconv.FormatUint(length, 10),
+		)
+		w.WriteHeader(http.StatusOK)
+
+	case http.MethodPatch:
+		m.numChunks++
+		if m.failEveryN > 0 && m.numChunks%m.failEveryN == 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		offset, _ := strconv.Atoi(
+			r.Header.Get(httpsUploadOffsetHeader),
+		)
+		if offset != len(upload) {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		chunk, _ := io.ReadAll(r.Body)
+		m.uploads[id] = append(upload, chunk...)
+		m.lengths[id], _ = strconv.ParseUint(
+			r.Header.Get(httpsUploadLengthHeader), 10, 64,
+		)
+		newOffset := strconv.Itoa(len(m.uploads[id]))
+		w.Header().Set(httpsUploadOffsetHeader, newOffset)
+		w.WriteHeader(http.StatusNoContent)
+
+	case http.MethodGet:
+		if !ok || uint64(len(upload)) != length {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = w.Write(upload)
+
+	case http.MethodDelete:
+		delete(m.uploads, id)
+		delete(m.lengths, id)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// TestHttpsCourier tests that a proof can be delivered through the HTTPS proof
+// courier, that failed chunk uploads are resumed and that the proof can be
+// received afterwards.
+func TestHttpsCourier(t *testing.T) {
+	t.Parallel()
+
+	courierServer := newMockHttpsCourierServer(3)
+	server := httptest.NewTLSServer(courierServer)
+	t.Cleanup(server.Close)
+
+	cfg := &CourierCfg{
+		HttpsCfg: &HttpsCourierCfg{
+			ChunkSize: 1000,
+			BackoffCfg: &BackoffCfg{
+				SkipInitDelay:  true,
+				NumTries:       1,
+				InitialBackoff: time.Millisecond,
+				MaxBackoff:     time.Millisecond,
+			},
+			Client: server.Client(),
+		},
+		TransferLog: &mockTransferLog{},
+	}
+
+	// The address overwrites the configured chunk size and number of
+	// tries.
+	addr, err := url.Parse(
+		server.URL + "/courier?chunksize=100&numtries=10",
+	)
+	require.NoError(t, err)
+
+	recipient := Recipient{
+		ScriptKey: test.RandPubKey(t),
+		AssetID:   asset.RandID(t),
+		Amount:    42,
+	}
+	locator := Locator{
+		AssetID:   fn.Ptr(recipient.AssetID),
+		ScriptKey: *recipient.ScriptKey,
+	}
+
+	sender, err := NewHttpsCourier(cfg, addr, recipient)
+	require.NoError(t, err)
+	require.EqualValues(t, 100, sender.chunkSize)
+	require.Equal(t, 10, sender.backoffHandle.cfg.NumTries)
+
+	// Nothing was delivered yet, so there is nothing to receive.
+	receiver, err := NewHttpsCourier(cfg, addr, recipient)
+	require.NoError(t, err)
+	receiver.backoffHandle.cfg.NumTries = 1
+
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	_, err = receiver.ReceiveProof(ctx, locator)
+	require.ErrorContains(t, err, errHttpsProofNotFound.Error())
+
+	// Every third chunk upload fails, so the delivery only succeeds if the
+	// upload is resumed on retry.
+	blob := Blob(test.RandBytes(1050))
+	err = sender.DeliverProof(ctx, &AnnotatedProof{
+		Locator: locator,
+		Blob:    blob,
+	})
+	require.NoError(t, err)
+	require.Greater(t, courierServer.numChunks, 11)
+
+	received, err := receiver.ReceiveProof(ctx, locator)
+	require.NoError(t, err)
+	require.Equal(t, blob, received.Blob)
+
+	// The proof is removed from the courier once it was received.
+	require.Empty(t, courierServer.uploads)
+
+	// Invalid parameters in the courier address are rejected.
+	addr, err = url.Parse(server.URL + "?chunksize=0")
+	require.NoError(t, err)
+	_, err = NewHttpsCourier(cfg, addr, recipient)
+	require.ErrorContains(t, err, "invalid proof courier address")
+}
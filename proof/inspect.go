@@ -0,0 +1,361 @@
+package proof
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/commitment"
+)
+
+// TaprootProofKind denotes what a taproot proof proves about its output.
+type TaprootProofKind uint8
+
+const (
+	// TaprootProofKindUnknown is a taproot proof that has neither a
+	// commitment nor a tapscript proof.
+	TaprootProofKindUnknown TaprootProofKind = iota
+
+	// TaprootProofKindCommitment is a taproot proof that proves the
+	// inclusion or exclusion of an asset in a Taproot Asset commitment.
+	TaprootProofKindCommitment
+
+	// TaprootProofKindTapscript is a taproot proof that proves that an
+	// output doesn't contain any Taproot Asset commitment.
+	TaprootProofKindTapscript
+)
+
+// String returns a human-readable string for the taproot proof kind.
+func (k TaprootProofKind) String() string {
+	switch k {
+	case TaprootProofKindCommitment:
+		return "commitment"
+
+	case TaprootProofKindTapscript:
+		return "tapscript"
+
+	default:
+		return "unknown"
+	}
+}
+
+// TaprootProofSummary is a summary of an inclusion or exclusion proof.
+type TaprootProofSummary struct {
+	// OutputIndex is the index of the anchor transaction output the proof
+	// is for.
+	OutputIndex uint32
+
+	// InternalKey is the internal key of the output.
+	InternalKey *btcec.PublicKey
+
+	// Kind is the kind of the proof.
+	Kind TaprootProofKind
+
+	// HasTapSibling is true if the output commits to a tapscript sibling
+	// next to the Taproot Asset commitment.
+	HasTapSibling bool
+}
+
+// summarizeTaprootProof creates the summary of the given taproot proof.
+func summarizeTaprootProof(p *TaprootProof) TaprootProofSummary {
+	summary := TaprootProofSummary{
+		OutputIndex: p.OutputIndex,
+		InternalKey: p.InternalKey,
+	}
+
+	switch {
+	case p.CommitmentProof != nil:
+		summary.Kind = TaprootProofKindCommitment
+		summary.HasTapSibling =
+			p.CommitmentProof.TapSiblingPreimage != nil
+
+	case p.TapscriptProof != nil:
+		summary.Kind = TaprootProofKindTapscript
+	}
+
+	return summary
+}
+
+// TransitionSummary is a summary of a single state transition proof.
+type TransitionSummary struct {
+	// Index is the index of the proof within its file, starting with the
+	// genesis proof at index zero.
+	Index uint32
+
+	// Version is the version of the state transition proof.
+	Version TransitionVersion
+
+	// AssetID is the ID of the resulting asset.
+	AssetID asset.ID
+
+	// AssetType is the type of the resulting asset.
+	AssetType asset.Type
+
+	// Amount is the amount of the resulting asset.
+	Amount uint64
+
+	// ScriptKey is the script key of the resulting asset.
+	ScriptKey *btcec.PublicKey
+
+	// GroupKey is the group key of the resulting asset, if it has one.
+	GroupKey *btcec.PublicKey
+
+	// IsGenesis is true if this is the minting proof of the asset.
+	IsGenesis bool
+
+	// IsBurn is true if the state transition burns the asset.
+	IsBurn bool
+
+	// IsSplit is true if the resulting asset is the result of a split.
+	IsSplit bool
+
+	// PrevOut is the previous on-chain outpoint of the asset.
+	PrevOut wire.OutPoint
+
+	// AnchorTxid is the ID of the anchor transaction.
+	AnchorTxid chainhash.Hash
+
+	// AnchorOutPoint is the outpoint that commits to the resulting asset.
+	AnchorOutPoint wire.OutPoint
+
+	// BlockHash is the hash of the block the anchor transaction was
+	// confirmed in.
+	BlockHash chainhash.Hash
+
+	// BlockHeight is the height of the block the anchor transaction was
+	// confirmed in.
+	BlockHeight uint32
+
+	// InclusionProof is the summary of the inclusion proof.
+	InclusionProof TaprootProofSummary
+
+	// ExclusionProofs are the summaries of the exclusion proofs.
+	ExclusionProofs []TaprootProofSummary
+
+	// SplitRootProof is the summary of the split root proof, if there is
+	// one.
+	SplitRootProof *TaprootProofSummary
+
+	// NumPrevWitnesses is the number of inputs of the resulting asset.
+	NumPrevWitnesses int
+
+	// NumAdditionalInputs is the number of nested proof files for the
+	// additional inputs of the resulting asset.
+	NumAdditionalInputs int
+
+	// HasMetaReveal is true if the proof reveals the asset meta data.
+	HasMetaReveal bool
+
+	// HasGenesisReveal is true if the proof reveals the asset genesis.
+	HasGenesisReveal bool
+
+	// HasGroupKeyReveal is true if the proof reveals the group key
+	// derivation.
+	HasGroupKeyReveal bool
+
+	// HasChallengeWitness is true if the proof is an ownership proof.
+	HasChallengeWitness bool
+
+	// Verified is true if the proof was partially verified.
+	Verified bool
+
+	// VerifyErr is the error of the partial verification. It is nil if
+	// the proof wasn't verified or passed the verification.
+	VerifyErr error
+}
+
+// Inspection is a structured breakdown of a proof blob.
+type Inspection struct {
+	// IsFile is true if the blob is a proof file and not a single state
+	// transition proof.
+	IsFile bool
+
+	// Transitions are the summaries of all state transition proofs,
+	// starting with the oldest one.
+	Transitions []TransitionSummary
+}
+
+// Inspect decodes the given proof blob, which can be either a proof file or a
+// single state transition proof, and returns a breakdown of all its state
+// transitions. Nothing is imported or stored.
+//
+// If verify is true, each state transition is also partially verified with
+// VerifyOffline. The block headers, group keys and asset witnesses aren't
+// verified, as that requires access to the chain and the local database.
+func Inspect(blob Blob, verify bool) (*Inspection, error) {
+	var (
+		inspection Inspection
+		proofs     []*Proof
+	)
+	switch {
+	case IsSingleProof(blob):
+		p, err := Decode(blob)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode proof: %w",
+				err)
+		}
+
+		proofs = []*Proof{p}
+
+	case IsProofFile(blob):
+		if err := CheckMaxFileSize(blob); err != nil {
+			return nil, err
+		}
+
+		f, err := DecodeFile(blob)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode proof file: "+
+				"%w", err)
+		}
+
+		for i := 0; i < f.NumProofs(); i++ {
+			p, err := f.ProofAt(uint32(i))
+			if err != nil {
+				return nil, err
+			}
+
+			proofs = append(proofs, p)
+		}
+		inspection.IsFile = true
+
+	default:
+		return nil, fmt.Errorf("invalid raw proof, could not " +
+			"identify decoding format")
+	}
+
+	var prev *Proof
+	for idx, p := range proofs {
+		summary := summarizeTransition(uint32(idx), p)
+		if verify {
+			summary.Verified = true
+			summary.VerifyErr = p.VerifyOffline(prev)
+		}
+
+		inspection.Transitions = append(inspection.Transitions, summary)
+		prev = p
+	}
+
+	return &inspection, nil
+}
+
+// summarizeTransition creates the summary of the given state transition
+// proof.
+func summarizeTransition(idx uint32, p *Proof) TransitionSummary {
+	summary := TransitionSummary{
+		Index:               idx,
+		Version:             p.Version,
+		AssetID:             p.Asset.ID(),
+		AssetType:           p.Asset.Type,
+		Amount:              p.Asset.Amount,
+		ScriptKey:           p.Asset.ScriptKey.PubKey,
+		IsGenesis:           p.Asset.IsGenesisAsset(),
+		IsBurn:              p.Asset.IsBurn(),
+		IsSplit:             p.Asset.HasSplitCommitmentWitness(),
+		PrevOut:             p.PrevOut,
+		AnchorTxid:          p.AnchorTx.TxHash(),
+		AnchorOutPoint:      p.OutPoint(),
+		BlockHash:           p.BlockHeader.BlockHash(),
+		BlockHeight:         p.BlockHeight,
+		InclusionProof:      summarizeTaprootProof(&p.InclusionProof),
+		NumPrevWitnesses:    len(p.Asset.PrevWitnesses),
+		NumAdditionalInputs: len(p.AdditionalInputs),
+		HasMetaReveal:       p.MetaReveal != nil,
+		HasGenesisReveal:    p.GenesisReveal != nil,
+		HasGroupKeyReveal:   p.GroupKeyReveal != nil,
+		HasChallengeWitness: len(p.ChallengeWitness) > 0,
+	}
+
+	if p.Asset.GroupKey != nil {
+		groupKey := p.Asset.GroupKey.GroupPubKey
+		summary.GroupKey = &groupKey
+	}
+
+	for idx := range p.ExclusionProofs {
+		summary.ExclusionProofs = append(
+			summary.ExclusionProofs,
+			summarizeTaprootProof(&p.ExclusionProofs[idx]),
+		)
+	}
+
+	if p.SplitRootProof != nil {
+		splitRootProof := summarizeTaprootProof(p.SplitRootProof)
+		summary.SplitRootProof = &splitRootProof
+	}
+
+	return summary
+}
+
+// VerifyOffline partially verifies the proof without access to the chain. It
+// checks that:
+//
+//  1. The proof has a known version and the resulting asset is valid.
+//  2. The anchor transaction spends the previous asset output, which must be
+//     the anchor output of the given previous proof, if there is one.
+//  3. The anchor transaction is included in the merkle root of the block
+//     header.
+//  4. The inclusion, split root and exclusion proofs are valid.
+//  5. The genesis and group key reveals are consistent with the asset.
+//
+// Unlike Verify, the block header isn't checked against the chain, the group
+// key isn't checked against the known groups and the asset witnesses aren't
+// validated.
+func (p *Proof) VerifyOffline(prev *Proof) error {
+	if p.IsUnknownVersion() {
+		return ErrUnknownVersion
+	}
+
+	if err := p.Asset.Validate(); err != nil {
+		return fmt.Errorf("failed to validate proof asset: %w", err)
+	}
+
+	if prev != nil && p.PrevOut != prev.OutPoint() {
+		return fmt.Errorf("%w: prev output mismatch",
+			commitment.ErrInvalidTaprootProof)
+	}
+	if !txSpendsPrevOut(&p.AnchorTx, &p.PrevOut) {
+		return fmt.Errorf("%w: doesn't spend prev output",
+			commitment.ErrInvalidTaprootProof)
+	}
+
+	err := DefaultMerkleVerifier(
+		&p.AnchorTx, &p.TxMerkleProof, p.BlockHeader.MerkleRoot,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to validate merkle proof: %w", err)
+	}
+
+	if _, err := p.VerifyProofs(); err != nil {
+		return fmt.Errorf("error verifying proofs: %w", err)
+	}
+
+	isGenesisAsset := p.Asset.IsGenesisAsset()
+	switch {
+	case !isGenesisAsset && p.GenesisReveal != nil:
+		return ErrNonGenesisAssetWithGenesisReveal
+
+	case !isGenesisAsset && p.MetaReveal != nil:
+		return ErrNonGenesisAssetWithMetaReveal
+
+	case isGenesisAsset:
+		if err := p.verifyGenesisReveal(); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case !isGenesisAsset && p.GroupKeyReveal != nil:
+		return ErrNonGenesisAssetWithGroupKeyReveal
+
+	case p.GroupKeyReveal != nil && p.Asset.GroupKey == nil:
+		return ErrGroupKeyRequired
+
+	case p.GroupKeyReveal != nil:
+		if err := p.verifyGroupKeyReveal(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package proof
+
+import (
+	"encoding/hex"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestInspect tests that proof files and single proofs are broken down into
+// their state transitions and partially verified.
+func TestInspect(t *testing.T) {
+	t.Parallel()
+
+	readHex := func(fileName string) []byte {
+		proofHex, err := os.ReadFile(fileName)
+		require.NoError(t, err)
+
+		proofBytes, err := hex.DecodeString(
+			strings.Trim(string(proofHex), "\n"),
+		)
+		require.NoError(t, err)
+
+		return proofBytes
+	}
+
+	fileBytes := readHex(proofFileHexFileName)
+	f, err := DecodeFile(fileBytes)
+	require.NoError(t, err)
+
+	// Without verification, the file is only broken down.
+	inspection, err := Inspect(fileBytes, false)
+	require.NoError(t, err)
+	require.True(t, inspection.IsFile)
+	require.Len(t, inspection.Transitions, f.NumProofs())
+
+	for idx, summary := range inspection.Transitions {
+		p, err := f.ProofAt(uint32(idx))
+		require.NoError(t, err)
+
+		require.EqualValues(t, idx, summary.Index)
+		require.Equal(t, p.Asset.ID(), summary.AssetID)
+		require.Equal(t, p.Asset.Amount, summary.Amount)
+		require.Equal(t, p.AnchorTx.TxHash(), summary.AnchorTxid)
+		require.Equal(t, p.OutPoint(), summary.AnchorOutPoint)
+		require.Equal(t, p.BlockHeight, summary.BlockHeight)
+		require.Equal(
+			t, p.InclusionProof.OutputIndex,
+			summary.InclusionProof.OutputIndex,
+		)
+		require.Len(t, summary.ExclusionProofs, len(p.ExclusionProofs))
+		require.Equal(t, idx == 0, summary.IsGenesis)
+		require.False(t, summary.Verified)
+		require.NoError(t, summary.VerifyErr)
+
+		// Each transition spends the anchor output of the previous one.
+		if idx > 0 {
+			require.Equal(
+				t, inspection.Transitions[idx-1].AnchorOutPoint,
+				summary.PrevOut,
+			)
+		}
+	}
+
+	// All transitions of the valid file pass the partial verification.
+	inspection, err = Inspect(fileBytes, true)
+	require.NoError(t, err)
+	for _, summary := range inspection.Transitions {
+		require.True(t, summary.Verified)
+		require.NoError(t, summary.VerifyErr)
+	}
+
+	// A single proof is inspected as well. Without the previous proof,
+	// only the proof itself is verified.
+	proofBytes, err := f.RawProofAt(uint32(f.NumProofs() - 1))
+	require.NoError(t, err)
+	inspection, err = Inspect(proofBytes, true)
+	require.NoError(t, err)
+	require.False(t, inspection.IsFile)
+	require.Len(t, inspection.Transitions, 1)
+	require.NoError(t, inspection.Transitions[0].VerifyErr)
+
+	// The anchor transaction of this proof isn't part of the block it
+	// claims, which the partial verification detects.
+	inspection, err = Inspect(readHex(proofHexFileName), true)
+	require.NoError(t, err)
+	require.ErrorIs(
+		t, inspection.Transitions[0].VerifyErr, ErrInvalidTxMerkleProof,
+	)
+
+	// A tampered proof is still broken down, but fails the partial
+	// verification.
+	p, err := Decode(proofBytes)
+	require.NoError(t, err)
+	p.InclusionProof.OutputIndex++
+	tamperedBytes, err := Encode(p)
+	require.NoError(t, err)
+
+	inspection, err = Inspect(tamperedBytes, true)
+	require.NoError(t, err)
+	require.Len(t, inspection.Transitions, 1)
+	require.Error(t, inspection.Transitions[0].VerifyErr)
+
+	// Blobs that are neither a proof nor a proof file are rejected.
+	_, err = Inspect([]byte("not a proof"), false)
+	require.Error(t, err)
+}
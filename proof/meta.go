@@ -42,6 +42,10 @@ const (
 	// minted asset to express the decimal display of the minted asset.
 	MetadataDecDisplayKey = "decimal_display"
 
+	// MetadataTickerKey is the JSON key used in the metadata field of a
+	// minted asset to express the ticker symbol of the minted asset.
+	MetadataTickerKey = "ticker"
+
 	// maxDecDisplay is the maximum value of decimal display that a user can
 	// define when minting assets. Since the uint64 max value has 19 decimal
 	// places we will allow for a max of 12 decimal places.
@@ -86,6 +90,14 @@ var (
 	// ErrDecDisplayMissing is returned if the decimal display key is
 	// not present in a JSON object.
 	ErrDecDisplayMissing = errors.New("decimal display field missing")
+
+	// ErrTickerMissing is returned if the ticker key is not present in a
+	// JSON object.
+	ErrTickerMissing = errors.New("ticker field missing")
+
+	// ErrTickerInvalidType is returned if the value in a JSON object
+	// assigned to the ticker key is not a string.
+	ErrTickerInvalidType = errors.New("ticker JSON field is not a string")
 )
 
 // MetaReveal is an optional TLV type that can be added to the proof of a
@@ -239,6 +251,17 @@ func (m *MetaReveal) GetDecDisplay() (map[string]interface{}, uint32, error) {
 		return metaJSON, 0, ErrDecDisplayMissing
 	}
 
+	decDisplayVal, err := parseDecDisplay(decDisplay)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return metaJSON, decDisplayVal, nil
+}
+
+// parseDecDisplay type and range checks the value stored for the decimal
+// display key of a JSON object.
+func parseDecDisplay(decDisplay interface{}) (uint32, error) {
 	// We have to typecheck the value stored for the decimal display key;
 	// it could be another JSON type.
 	switch decDisplayVal := decDisplay.(type) {
@@ -246,26 +269,51 @@ func (m *MetaReveal) GetDecDisplay() (map[string]interface{}, uint32, error) {
 		// Any Number-like values are stored in a float64. We need to
 		// range check the decoded value.
 		if decDisplayVal < 0 {
-			return nil, 0, fmt.Errorf("decoded decimal display "+
-				"value is negative: %v", decDisplayVal)
+			return 0, fmt.Errorf("decoded decimal display value "+
+				"is negative: %v", decDisplayVal)
 		}
 
 		if decDisplayVal > float64(MaxDecDisplay) {
-			return nil, 0, fmt.Errorf("%w: %v",
-				ErrDecDisplayTooLarge, decDisplayVal)
+			return 0, fmt.Errorf("%w: %v", ErrDecDisplayTooLarge,
+				decDisplayVal)
 		}
 
 		// Ensure that the decoded value is a whole number.
 		if math.Round(decDisplayVal) != decDisplayVal {
-			return nil, 0, fmt.Errorf("decoded decimal display "+
-				"value is not an integer: %v", decDisplayVal)
+			return 0, fmt.Errorf("decoded decimal display value "+
+				"is not an integer: %v", decDisplayVal)
 		}
 
-		return metaJSON, uint32(decDisplayVal), nil
+		return uint32(decDisplayVal), nil
 
 	default:
-		return nil, 0, ErrDecDisplayInvalidType
+		return 0, ErrDecDisplayInvalidType
+	}
+}
+
+// GetTicker attempts to decode metadata as JSON and return the ticker symbol
+// of the asset. The ticker is returned as is, without any normalization.
+func (m *MetaReveal) GetTicker() (string, error) {
+	if m == nil || m.Type != MetaJson {
+		return "", ErrNotJSON
 	}
+
+	metaJSON, err := DecodeMetaJSON(m.Data)
+	if err != nil {
+		return "", err
+	}
+
+	ticker, ok := metaJSON[MetadataTickerKey]
+	if !ok {
+		return "", ErrTickerMissing
+	}
+
+	tickerStr, ok := ticker.(string)
+	if !ok {
+		return "", ErrTickerInvalidType
+	}
+
+	return tickerStr, nil
 }
 
 // SetDecDisplay attempts to set the decimal display value in existing JSON
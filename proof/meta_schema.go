@@ -0,0 +1,186 @@
+package proof
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+const (
+	// MetadataNameKey is the JSON key used in the metadata field of a
+	// minted asset to express the human-readable name of the minted asset.
+	MetadataNameKey = "name"
+
+	// MetadataIconURIKey is the JSON key used in the metadata field of a
+	// minted asset to express the URI of an icon of the minted asset.
+	MetadataIconURIKey = "icon_uri"
+
+	// MaxTickerLength is the maximum length of a ticker symbol in bytes.
+	MaxTickerLength = 16
+)
+
+var (
+	// ErrNameInvalidType is returned if the value in a JSON object
+	// assigned to the name key is not a string.
+	ErrNameInvalidType = errors.New("name JSON field is not a string")
+
+	// ErrTickerInvalid is returned if the ticker symbol is empty, too long
+	// or contains characters other than ASCII letters, digits, dots, dashes
+	// and underscores.
+	ErrTickerInvalid = errors.New("invalid ticker")
+
+	// ErrIconURIInvalidType is returned if the value in a JSON object
+	// assigned to the icon URI key is not a string.
+	ErrIconURIInvalidType = errors.New("icon URI JSON field is not a " +
+		"string")
+
+	// ErrIconURIInvalid is returned if the icon URI is not an absolute
+	// URI.
+	ErrIconURIInvalid = errors.New("invalid icon URI")
+)
+
+// MetaJSONFields are the well-known fields of JSON metadata. A field is only
+// set if its key is present in the metadata. Any other keys are ignored.
+type MetaJSONFields struct {
+	// DecDisplay is the decimal display of the asset.
+	DecDisplay fn.Option[uint32]
+
+	// Name is the human-readable name of the asset.
+	Name fn.Option[string]
+
+	// Ticker is the ticker symbol of the asset.
+	Ticker fn.Option[string]
+
+	// IconURI is the URI of an icon of the asset.
+	IconURI fn.Option[string]
+}
+
+// JSONFields decodes the metadata as JSON and validates the well-known fields
+// against their schema. An error is returned if any of the well-known fields
+// is malformed.
+//
+// NOTE: This check is stricter than Validate and is only meant to be applied
+// to new metadata. Assets minted before may carry metadata that doesn't
+// follow the schema.
+func (m *MetaReveal) JSONFields() (*MetaJSONFields, error) {
+	if m == nil || m.Type != MetaJson {
+		return nil, ErrNotJSON
+	}
+
+	metaJSON, err := DecodeMetaJSON(m.Data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+
+	var fields MetaJSONFields
+	if value, ok := metaJSON[MetadataDecDisplayKey]; ok {
+		decDisplay, err := parseDecDisplay(value)
+		if err != nil {
+			return nil, err
+		}
+
+		fields.DecDisplay = fn.Some(decDisplay)
+	}
+
+	if value, ok := metaJSON[MetadataNameKey]; ok {
+		name, ok := value.(string)
+		if !ok {
+			return nil, ErrNameInvalidType
+		}
+
+		if err := asset.ValidateAssetName(name); err != nil {
+			return nil, fmt.Errorf("invalid name: %w", err)
+		}
+
+		fields.Name = fn.Some(name)
+	}
+
+	if value, ok := metaJSON[MetadataTickerKey]; ok {
+		ticker, ok := value.(string)
+		if !ok {
+			return nil, ErrTickerInvalidType
+		}
+
+		if err := IsValidTicker(ticker); err != nil {
+			return nil, err
+		}
+
+		fields.Ticker = fn.Some(ticker)
+	}
+
+	if value, ok := metaJSON[MetadataIconURIKey]; ok {
+		iconURI, ok := value.(string)
+		if !ok {
+			return nil, ErrIconURIInvalidType
+		}
+
+		if err := IsValidIconURI(iconURI); err != nil {
+			return nil, err
+		}
+
+		fields.IconURI = fn.Some(iconURI)
+	}
+
+	return &fields, nil
+}
+
+// IsValidTicker checks that the ticker symbol is non-empty, below the maximum
+// length and only consists of ASCII letters, digits, dots, dashes and
+// underscores. These are the same characters the ticker registry of the wallet
+// accepts.
+func IsValidTicker(ticker string) error {
+	if len(ticker) == 0 || len(ticker) > MaxTickerLength {
+		return fmt.Errorf("%w: must be between 1 and %d bytes",
+			ErrTickerInvalid, MaxTickerLength)
+	}
+
+	for _, char := range ticker {
+		if !isTickerChar(char) {
+			return fmt.Errorf("%w: %q contains character %q",
+				ErrTickerInvalid, ticker, char)
+		}
+	}
+
+	return nil
+}
+
+// isTickerChar returns true if the given character can be used in a ticker.
+func isTickerChar(char rune) bool {
+	switch {
+	case char > unicode.MaxASCII:
+		return false
+
+	case unicode.IsLetter(char), unicode.IsDigit(char):
+		return true
+
+	default:
+		return char == '.' || char == '-' || char == '_'
+	}
+}
+
+// IsValidIconURI checks that the icon URI is an absolute URI without any
+// whitespace.
+func IsValidIconURI(iconURI string) error {
+	if strings.ContainsFunc(iconURI, unicode.IsSpace) {
+		return fmt.Errorf("%w: contains whitespace", ErrIconURIInvalid)
+	}
+
+	uri, err := url.Parse(iconURI)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrIconURIInvalid, err)
+	}
+
+	if !uri.IsAbs() || (uri.Host == "" && uri.Opaque == "" &&
+		uri.Path == "") {
+
+		return fmt.Errorf("%w: %v is not an absolute URI",
+			ErrIconURIInvalid, iconURI)
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package proof
 import (
 	"testing"
 
+	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/stretchr/testify/require"
 )
 
@@ -78,3 +79,149 @@ func TestValidateMetaReveal(t *testing.T) {
 		})
 	}
 }
+
+// TestMetaJSONFields tests that the well-known fields of JSON metadata are
+// parsed and validated against their schema.
+func TestMetaJSONFields(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		reveal         *MetaReveal
+		expectedFields *MetaJSONFields
+		expectedErr    error
+	}{
+		{
+			name: "opaque meta",
+			reveal: &MetaReveal{
+				Type: MetaOpaque,
+				Data: []byte("data"),
+			},
+			expectedErr: ErrNotJSON,
+		},
+		{
+			name: "invalid JSON",
+			reveal: &MetaReveal{
+				Type: MetaJson,
+				Data: []byte("invalid"),
+			},
+			expectedErr: ErrInvalidJSON,
+		},
+		{
+			name: "no well-known fields",
+			reveal: &MetaReveal{
+				Type: MetaJson,
+				Data: []byte(`{"key": "value"}`),
+			},
+			expectedFields: &MetaJSONFields{},
+		},
+		{
+			name: "all well-known fields",
+			reveal: &MetaReveal{
+				Type: MetaJson,
+				Data: []byte(`{"decimal_display": 2, ` +
+					`"name": "Foo Coin", ` +
+					`"ticker": "FOO", ` +
+					`"icon_uri": "ipfs://foo", ` +
+					`"key": "value"}`),
+			},
+			expectedFields: &MetaJSONFields{
+				DecDisplay: fn.Some(uint32(2)),
+				Name:       fn.Some("Foo Coin"),
+				Ticker:     fn.Some("FOO"),
+				IconURI:    fn.Some("ipfs://foo"),
+			},
+		},
+		{
+			name: "decimal display too large",
+			reveal: &MetaReveal{
+				Type: MetaJson,
+				Data: []byte(`{"decimal_display": 13}`),
+			},
+			expectedErr: ErrDecDisplayTooLarge,
+		},
+		{
+			name: "name not a string",
+			reveal: &MetaReveal{
+				Type: MetaJson,
+				Data: []byte(`{"name": 1}`),
+			},
+			expectedErr: ErrNameInvalidType,
+		},
+		{
+			name: "ticker not a string",
+			reveal: &MetaReveal{
+				Type: MetaJson,
+				Data: []byte(`{"ticker": ["FOO"]}`),
+			},
+			expectedErr: ErrTickerInvalidType,
+		},
+		{
+			name: "ticker with space",
+			reveal: &MetaReveal{
+				Type: MetaJson,
+				Data: []byte(`{"ticker": "FOO BAR"}`),
+			},
+			expectedErr: ErrTickerInvalid,
+		},
+		{
+			name: "ticker with look-alike character",
+			reveal: &MetaReveal{
+				Type: MetaJson,
+				Data: []byte(`{"ticker": "УSDT"}`),
+			},
+			expectedErr: ErrTickerInvalid,
+		},
+		{
+			name: "ticker with separators",
+			reveal: &MetaReveal{
+				Type: MetaJson,
+				Data: []byte(`{"ticker": "BTC.b-2_x"}`),
+			},
+			expectedFields: &MetaJSONFields{
+				Ticker: fn.Some("BTC.b-2_x"),
+			},
+		},
+		{
+			name: "ticker too long",
+			reveal: &MetaReveal{
+				Type: MetaJson,
+				Data: []byte(`{"ticker": "ABCDEFGHIJKLMNOPQ"}`),
+			},
+			expectedErr: ErrTickerInvalid,
+		},
+		{
+			name: "relative icon URI",
+			reveal: &MetaReveal{
+				Type: MetaJson,
+				Data: []byte(`{"icon_uri": "/icon.png"}`),
+			},
+			expectedErr: ErrIconURIInvalid,
+		},
+		{
+			name: "icon URI not a string",
+			reveal: &MetaReveal{
+				Type: MetaJson,
+				Data: []byte(`{"icon_uri": true}`),
+			},
+			expectedErr: ErrIconURIInvalidType,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			fields, err := tc.reveal.JSONFields()
+			if tc.expectedErr != nil {
+				require.ErrorIs(tt, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(tt, err)
+			require.Equal(tt, tc.expectedFields, fields)
+		})
+	}
+}
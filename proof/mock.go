@@ -276,6 +276,8 @@ type MockProofCourier struct {
 	currentProofs map[asset.SerializedKey]*AnnotatedProof
 
 	subscribers map[uint64]*fn.EventReceiver[fn.Event]
+
+	probeErr error
 }
 
 // NewMockProofCourier returns a new mock proof courier.
@@ -346,6 +348,22 @@ func (m *MockProofCourier) ReceiveProof(_ context.Context,
 	}, nil
 }
 
+// SetProbeErr sets the error that is returned when the courier is probed.
+func (m *MockProofCourier) SetProbeErr(err error) {
+	m.Lock()
+	defer m.Unlock()
+
+	m.probeErr = err
+}
+
+// Probe returns the error set with SetProbeErr.
+func (m *MockProofCourier) Probe(context.Context) error {
+	m.Lock()
+	defer m.Unlock()
+
+	return m.probeErr
+}
+
 // SetSubscribers sets the set of subscribers that will be notified
 // of proof courier related events.
 func (m *MockProofCourier) SetSubscribers(
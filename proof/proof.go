@@ -194,6 +194,17 @@ func CheckMaxFileSize(blob Blob) error {
 	return nil
 }
 
+// CheckFileSize checks that the given blob is not larger than the given
+// maximum size. A maximum size of zero disables the check.
+func CheckFileSize(blob Blob, maxSize uint64) error {
+	if maxSize != 0 && uint64(len(blob)) > maxSize {
+		return fmt.Errorf("%w: %d bytes exceeds maximum of %d bytes",
+			ErrProofFileTooLarge, len(blob), maxSize)
+	}
+
+	return nil
+}
+
 // UpdateCallback is a callback that is called when proofs are updated because
 // of a re-org.
 type UpdateCallback func([]*Proof) error
@@ -5,7 +5,9 @@ import (
 	"context"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -14,6 +16,7 @@ import (
 
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -24,6 +27,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/commitment"
 	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/vm"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/stretchr/testify/require"
 )
@@ -693,6 +697,147 @@ func TestProofFileVerification(t *testing.T) {
 	require.ErrorIs(t, err, ErrUnknownVersion)
 }
 
+// TestProofFileBatchVerification tests that the key spend signatures of a
+// proof file are verified in a batch, and that an invalid signature is
+// attributed to the right proof.
+func TestProofFileBatchVerification(t *testing.T) {
+	proofHex, err := os.ReadFile(proofFileHexFileName)
+	require.NoError(t, err)
+
+	proofBytes, err := hex.DecodeString(
+		strings.Trim(string(proofHex), "\n"),
+	)
+	require.NoError(t, err)
+
+	f := &File{}
+	require.NoError(t, f.Decode(bytes.NewReader(proofBytes)))
+
+	proofs := make([]*Proof, f.NumProofs())
+	for idx := range proofs {
+		proofs[idx], err = f.ProofAt(uint32(idx))
+		require.NoError(t, err)
+	}
+
+	// The transfer proofs of the file have key spend signatures that end
+	// up in the signature cache.
+	sigCache, err := batchVerifyKeySpendSigs(proofs)
+	require.NoError(t, err)
+	require.NotNil(t, sigCache)
+
+	var (
+		lastIdx   = len(proofs) - 1
+		lastProof = proofs[lastIdx]
+		prevAsset = &proofs[lastIdx-1].Asset
+	)
+	sigs := lastProof.keySpendSigs(prevAsset)
+	require.NotEmpty(t, sigs)
+	for _, sig := range sigs {
+		require.True(
+			t, sigCache.Exists(sig.SigHash, sig.Sig, sig.PubKey),
+		)
+	}
+
+	// We now invalidate the signature of the last proof, which should be
+	// identified when verifying the file.
+	witnessAsset := &lastProof.Asset
+	if witnessAsset.HasSplitCommitmentWitness() {
+		splitCommitment := witnessAsset.PrevWitnesses[0].SplitCommitment
+		witnessAsset = &splitCommitment.RootAsset
+	}
+	txWitness := witnessAsset.PrevWitnesses[0].TxWitness
+	require.Len(t, txWitness, 1)
+	txWitness[0][schnorr.SignatureSize-1] ^= 1
+
+	require.NoError(t, f.ReplaceProofAt(uint32(lastIdx), *lastProof))
+
+	_, err = f.Verify(
+		context.Background(), MockHeaderVerifier, MockMerkleVerifier,
+		MockGroupVerifier, MockChainLookup,
+	)
+	require.ErrorContains(
+		t, err, fmt.Sprintf("invalid witness in proof %d", lastIdx),
+	)
+
+	var batchErr *vm.BatchSigError
+	require.ErrorAs(t, err, &batchErr)
+}
+
+// countingReader is an io.Reader that counts the number of bytes read.
+type countingReader struct {
+	r         io.Reader
+	bytesRead int
+}
+
+// Read reads from the underlying reader and counts the bytes read.
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.bytesRead += n
+	return n, err
+}
+
+// TestBaseVerifierStreaming tests that the base verifier verifies each proof
+// of a file as soon as it was read and aborts on the first invalid transition
+// without reading the rest of the file.
+func TestBaseVerifierStreaming(t *testing.T) {
+	proofHex, err := os.ReadFile(proofFileHexFileName)
+	require.NoError(t, err)
+
+	proofBytes, err := hex.DecodeString(
+		strings.Trim(string(proofHex), "\n"),
+	)
+	require.NoError(t, err)
+
+	f := &File{}
+	require.NoError(t, f.Decode(bytes.NewReader(proofBytes)))
+	require.Greater(t, f.NumProofs(), 1)
+
+	expected, err := f.Verify(
+		context.Background(), MockHeaderVerifier, MockMerkleVerifier,
+		MockGroupVerifier, MockChainLookup,
+	)
+	require.NoError(t, err)
+
+	// A valid file should result in the same snapshot as a full
+	// verification.
+	verifier := &BaseVerifier{}
+	snapshot, err := verifier.Verify(
+		context.Background(), bytes.NewReader(proofBytes),
+		MockHeaderVerifier, MockMerkleVerifier, MockGroupVerifier,
+		MockChainLookup,
+	)
+	require.NoError(t, err)
+	require.Equal(t, expected.Asset, snapshot.Asset)
+	require.Equal(t, expected.OutPoint, snapshot.OutPoint)
+
+	// If the first transition is invalid, we should abort before reading
+	// the remaining proofs of the file.
+	errInvalidHeader := errors.New("invalid header")
+	var numHeaders int
+	invalidHeader := func(wire.BlockHeader, uint32) error {
+		numHeaders++
+		return errInvalidHeader
+	}
+
+	reader := &countingReader{r: bytes.NewReader(proofBytes)}
+	_, err = verifier.Verify(
+		context.Background(), reader, invalidHeader, MockMerkleVerifier,
+		MockGroupVerifier, MockChainLookup,
+	)
+	require.ErrorIs(t, err, errInvalidHeader)
+	require.Equal(t, 1, numHeaders)
+	require.Less(t, reader.bytesRead, len(proofBytes))
+
+	// A truncated file is still rejected as long as all proofs that were
+	// read are valid.
+	_, err = verifier.Verify(
+		context.Background(),
+		bytes.NewReader(proofBytes[:len(proofBytes)-1]),
+		MockHeaderVerifier, MockMerkleVerifier, MockGroupVerifier,
+		MockChainLookup,
+	)
+	require.ErrorContains(t, err, "unable to parse proof")
+}
+
 // TestProofVerification ensures that the proof encoding and decoding works as
 // expected.
 func TestProofVerification(t *testing.T) {
@@ -3,6 +3,7 @@ package proof
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"runtime"
@@ -43,8 +44,10 @@ type Verifier interface {
 		chainLookupGen ChainLookupGenerator) (*AssetSnapshot, error)
 }
 
-// BaseVerifier implements a simple verifier that loads the entire proof file
-// into memory and then verifies it all at once.
+// BaseVerifier implements a simple verifier that verifies each proof of a
+// proof file as soon as it was read from the passed reader. This means an
+// invalid state transition is detected before the remainder of the file is
+// read and parsed.
 type BaseVerifier struct {
 }
 
@@ -56,16 +59,58 @@ func (b *BaseVerifier) Verify(ctx context.Context, blobReader io.Reader,
 	groupVerifier GroupVerifier,
 	chainLookupGen ChainLookupGenerator) (*AssetSnapshot, error) {
 
-	var proofFile File
-	err := proofFile.Decode(blobReader)
-	if err != nil {
+	var (
+		proofFile File
+		prev      *AssetSnapshot
+		verifyErr error
+	)
+
+	// The chain lookup only ever sees the proofs that were read so far,
+	// which are all the proofs a state transition can depend on.
+	chainLookup := chainLookupGen.GenFileChainLookup(&proofFile)
+	err := proofFile.decode(blobReader, func(p *hashedProof) error {
+		select {
+		case <-ctx.Done():
+			verifyErr = ctx.Err()
+			return verifyErr
+		default:
+		}
+
+		// Check only for the proof file version and not file
+		// emptiness, since an empty proof file should return a nil
+		// error.
+		if proofFile.IsUnknownVersion() {
+			verifyErr = ErrUnknownVersion
+			return verifyErr
+		}
+
+		var decodedProof Proof
+		err := decodedProof.Decode(bytes.NewReader(p.proofBytes))
+		if err != nil {
+			return fmt.Errorf("unable to decode proof: %w", err)
+		}
+
+		prev, verifyErr = decodedProof.Verify(
+			ctx, prev, headerVerifier, merkleVerifier,
+			groupVerifier, chainLookup,
+		)
+		return verifyErr
+	})
+	switch {
+	case verifyErr != nil:
+		return nil, verifyErr
+
+	case err != nil:
 		return nil, fmt.Errorf("unable to parse proof: %w", err)
 	}
 
-	return proofFile.Verify(
-		ctx, headerVerifier, merkleVerifier, groupVerifier,
-		chainLookupGen.GenFileChainLookup(&proofFile),
-	)
+	// An empty file never triggers the callback above, so we need to check
+	// the version here as well.
+	if proofFile.IsUnknownVersion() {
+		return nil, ErrUnknownVersion
+	}
+
+	return prev, nil
 }
 
 // verifyTaprootProof attempts to verify a TaprootProof for inclusion or
@@ -227,7 +272,8 @@ func (p *Proof) verifyExclusionProofs() (*commitment.TapCommitmentVersion,
 func (p *Proof) verifyAssetStateTransition(ctx context.Context,
 	prev *AssetSnapshot, headerVerifier HeaderVerifier,
 	merkleVerifier MerkleVerifier, groupVerifier GroupVerifier,
-	chainLookup asset.ChainLookup) (bool, error) {
+	chainLookup asset.ChainLookup,
+	sigCache *txscript.SigCache) (bool, error) {
 
 	// Determine whether we have an asset split based on the resulting
 	// asset's witness. If so, extract the root asset from the split asset.
@@ -263,7 +309,7 @@ func (p *Proof) verifyAssetStateTransition(ctx context.Context,
 	// available CPUs. We'll also pass in a context, which'll enable us to
 	// bail out as soon as any of the active goroutines encounters an
 	// error.
-	errGroup, ctx := errgroup.WithContext(ctx)
+	errGroup, groupCtx := errgroup.WithContext(ctx)
 	errGroup.SetLimit(runtime.NumCPU())
 
 	var assetsMtx sync.Mutex
@@ -272,7 +318,7 @@ func (p *Proof) verifyAssetStateTransition(ctx context.Context,
 
 		errGroup.Go(func() error {
 			result, err := inputProof.Verify(
-				ctx, headerVerifier, merkleVerifier,
+				groupCtx, headerVerifier, merkleVerifier,
 				groupVerifier, chainLookup,
 			)
 			if err != nil {
@@ -307,6 +353,21 @@ func (p *Proof) verifyAssetStateTransition(ctx context.Context,
 		vm.WithChainLookup(chainLookup),
 		vm.WithBlockHeight(p.BlockHeight),
 	}
+	if sigCache != nil {
+		verifyOpts = append(verifyOpts, vm.WithSigCache(sigCache))
+	}
+
+	// The group witness of a genesis asset is verified through the shared
+	// group witness verifier, which limits the number of concurrent
+	// signature verifications and caches the result.
+	if newAsset.HasGenesisWitnessForGroup() && len(splitAssets) == 0 &&
+		len(prevAssets) == 0 {
+
+		return false, DefaultGroupWitnessVerifier.Verify(
+			ctx, newAsset, verifyOpts...,
+		)
+	}
+
 	engine, err := vm.New(newAsset, splitAssets, prevAssets, verifyOpts...)
 	if err != nil {
 		return false, err
@@ -510,6 +571,20 @@ func (p *Proof) Verify(ctx context.Context, prev *AssetSnapshot,
 	groupVerifier GroupVerifier,
 	chainLookup asset.ChainLookup) (*AssetSnapshot, error) {
 
+	return p.verify(
+		ctx, prev, headerVerifier, merkleVerifier, groupVerifier,
+		chainLookup, nil,
+	)
+}
+
+// verify verifies the proof like Verify does. Signatures of the state
+// transition that are found in the given signature cache are assumed to be
+// valid and aren't verified again.
+func (p *Proof) verify(ctx context.Context, prev *AssetSnapshot,
+	headerVerifier HeaderVerifier, merkleVerifier MerkleVerifier,
+	groupVerifier GroupVerifier, chainLookup asset.ChainLookup,
+	sigCache *txscript.SigCache) (*AssetSnapshot, error) {
+
 	// 0. Check only for the proof version.
 	if p.IsUnknownVersion() {
 		return nil, ErrUnknownVersion
@@ -627,7 +702,7 @@ func (p *Proof) Verify(ctx context.Context, prev *AssetSnapshot,
 	default:
 		splitAsset, err = p.verifyAssetStateTransition(
 			ctx, prev, headerVerifier, merkleVerifier,
-			groupVerifier, chainLookup,
+			groupVerifier, chainLookup, sigCache,
 		)
 	}
 	if err != nil {
@@ -729,22 +804,36 @@ func (f *File) Verify(ctx context.Context, headerVerifier HeaderVerifier,
 		return nil, ErrUnknownVersion
 	}
 
-	var prev *AssetSnapshot
+	decodedProofs := make([]*Proof, len(f.proofs))
 	for idx := range f.proofs {
+		decodedProof, err := f.ProofAt(uint32(idx))
+		if err != nil {
+			return nil, err
+		}
+
+		decodedProofs[idx] = decodedProof
+	}
+
+	// We first verify the key spend signatures of all state transitions
+	// in the file in a single batch, which is a lot faster than verifying
+	// them one by one. The script engine then skips them during the full
+	// verification of each proof below.
+	sigCache, err := batchVerifyKeySpendSigs(decodedProofs)
+	if err != nil {
+		return nil, err
+	}
+
+	var prev *AssetSnapshot
+	for _, decodedProof := range decodedProofs {
 		select {
 		case <-ctx.Done():
 			return nil, ctx.Err()
 		default:
 		}
 
-		decodedProof, err := f.ProofAt(uint32(idx))
-		if err != nil {
-			return nil, err
-		}
-
-		result, err := decodedProof.Verify(
+		result, err := decodedProof.verify(
 			ctx, prev, headerVerifier, merkleVerifier,
-			groupVerifier, chainLookup,
+			groupVerifier, chainLookup, sigCache,
 		)
 		if err != nil {
 			return nil, err
@@ -754,3 +843,82 @@ func (f *File) Verify(ctx context.Context, headerVerifier HeaderVerifier,
 
 	return prev, nil
 }
+
+// keySpendSigs returns the key spend signatures of the state transition of the
+// proof, given the asset of the previous proof in the file. Genesis proofs,
+// ownership proofs and proofs with additional inputs are skipped, as their
+// signatures are verified separately.
+func (p *Proof) keySpendSigs(prevAsset *asset.Asset) []vm.SchnorrSig {
+	if prevAsset == nil || p.ChallengeWitness != nil ||
+		len(p.AdditionalInputs) > 0 {
+
+		return nil
+	}
+
+	newAsset := &p.Asset
+	var splitAssets []*commitment.SplitAsset
+	if newAsset.HasSplitCommitmentWitness() {
+		splitAssets = []*commitment.SplitAsset{{
+			Asset:       *newAsset,
+			OutputIndex: p.InclusionProof.OutputIndex,
+		}}
+		newAsset = &newAsset.PrevWitnesses[0].SplitCommitment.RootAsset
+	}
+
+	prevAssets := commitment.InputSet{
+		asset.PrevID{
+			OutPoint: p.PrevOut,
+			ID:       prevAsset.Genesis.ID(),
+			ScriptKey: asset.ToSerialized(
+				prevAsset.ScriptKey.PubKey,
+			),
+		}: prevAsset,
+	}
+
+	engine, err := vm.New(newAsset, splitAssets, prevAssets)
+	if err != nil {
+		return nil
+	}
+
+	return engine.KeySpendSigs()
+}
+
+// batchVerifyKeySpendSigs verifies the key spend signatures of the state
+// transitions of the given consecutive proofs in a single batch, and returns a
+// signature cache that contains them. If the batch is invalid, the proof with
+// the first invalid signature is identified in the returned error.
+func batchVerifyKeySpendSigs(proofs []*Proof) (*txscript.SigCache, error) {
+	var (
+		sigs      []vm.SchnorrSig
+		sigProofs []int
+		prevAsset *asset.Asset
+	)
+	for idx, p := range proofs {
+		proofSigs := p.keySpendSigs(prevAsset)
+		for range proofSigs {
+			sigProofs = append(sigProofs, idx)
+		}
+		sigs = append(sigs, proofSigs...)
+
+		prevAsset = &p.Asset
+	}
+
+	if len(sigs) == 0 {
+		return nil, nil
+	}
+
+	sigCache := txscript.NewSigCache(uint(len(sigs)))
+	err := vm.VerifySchnorrBatch(sigs, sigCache)
+
+	var batchErr *vm.BatchSigError
+	if errors.As(err, &batchErr) {
+		return nil, fmt.Errorf("invalid witness in proof %d: %w",
+			sigProofs[batchErr.Index], err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to batch verify signatures: %w",
+			err)
+	}
+
+	return sigCache, nil
+}
@@ -0,0 +1,132 @@
+package taprootassets
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	wrpc "github.com/lightninglabs/taproot-assets/taprpc/assetwalletrpc"
+)
+
+// ExportAnchorPsbt carries out a transfer up to the point where the BTC level
+// anchor transaction needs to be signed, and returns the funded anchor
+// transaction so it can be signed by an external signer.
+func (r *rpcServer) ExportAnchorPsbt(ctx context.Context,
+	req *wrpc.ExportAnchorPsbtRequest) (*wrpc.ExportAnchorPsbtResponse,
+	error) {
+
+	var parcel tapfreighter.Parcel
+	switch {
+	case len(req.TapAddrs) != 0 && len(req.VirtualPsbts) != 0:
+		return nil, fmt.Errorf("only one of addrs or virtual PSBTs " +
+			"can be specified")
+
+	case len(req.TapAddrs) != 0:
+		tapAddrs, err := r.resolveSendAddrs(ctx, req.TapAddrs)
+		if err != nil {
+			return nil, err
+		}
+
+		feeRate, err := checkFeeRateSanity(req.FeeRate)
+		if err != nil {
+			return nil, err
+		}
+
+		parcel = tapfreighter.NewAddressParcel(
+			feeRate,
+			fn.None[tapfreighter.MultiCommitmentSelectStrategy](),
+			tapAddrs...,
+		)
+
+	case len(req.VirtualPsbts) != 0:
+		vPackets, err := decodeVirtualPackets(req.VirtualPsbts)
+		if err != nil {
+			return nil, err
+		}
+
+		inputCommitments, err := r.fetchInputCommitments(ctx, vPackets)
+		if err != nil {
+			return nil, err
+		}
+
+		parcel = tapfreighter.NewPreSignedParcel(
+			vPackets, inputCommitments,
+		)
+
+	default:
+		return nil, fmt.Errorf("either addrs or virtual PSBTs must " +
+			"be specified")
+	}
+
+	export, err := r.cfg.ChainPorter.RequestAnchorExport(parcel)
+	if err != nil {
+		return nil, fmt.Errorf("unable to export anchor "+
+			"transaction: %w", err)
+	}
+
+	anchorPsbt, err := serialize(export.FundedPsbt.Pkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize anchor PSBT: %w",
+			err)
+	}
+
+	return &wrpc.ExportAnchorPsbtResponse{
+		AnchorPsbt: anchorPsbt,
+		AnchorTxid: export.AnchorTxHash().String(),
+	}, nil
+}
+
+// ImportSignedAnchorPsbt continues the transfer of a previously exported
+// anchor transaction with the externally signed version of it.
+func (r *rpcServer) ImportSignedAnchorPsbt(ctx context.Context,
+	req *wrpc.ImportSignedAnchorPsbtRequest) (*taprpc.SendAssetResponse,
+	error) {
+
+	signedPkt, err := psbt.NewFromRawBytes(
+		bytes.NewReader(req.SignedPsbt), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding signed PSBT: %w", err)
+	}
+
+	resp, err := r.cfg.ChainPorter.ImportSignedAnchor(ctx, signedPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to import signed anchor "+
+			"transaction: %w", err)
+	}
+
+	parcel, err := marshalOutboundParcel(resp)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
+			err)
+	}
+
+	return &taprpc.SendAssetResponse{
+		Transfer: parcel,
+	}, nil
+}
+
+// CancelAnchorExport cancels the transfer of a previously exported anchor
+// transaction.
+func (r *rpcServer) CancelAnchorExport(ctx context.Context,
+	req *wrpc.CancelAnchorExportRequest) (*wrpc.CancelAnchorExportResponse,
+	error) {
+
+	anchorTxHash, err := chainhash.NewHashFromStr(req.AnchorTxid)
+	if err != nil {
+		return nil, fmt.Errorf("invalid anchor txid: %w", err)
+	}
+
+	err = r.cfg.ChainPorter.CancelAnchorExport(ctx, *anchorTxHash)
+	if err != nil {
+		return nil, fmt.Errorf("unable to cancel anchor export: %w",
+			err)
+	}
+
+	return &wrpc.CancelAnchorExportResponse{}, nil
+}
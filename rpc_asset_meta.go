@@ -0,0 +1,64 @@
+package taprootassets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+)
+
+// marshalDecodedMeta converts the well-known fields of JSON asset metadata to
+// their RPC representation.
+func marshalDecodedMeta(fields *proof.MetaJSONFields) *taprpc.DecodedAssetMeta {
+	decodedMeta := &taprpc.DecodedAssetMeta{
+		Name:    fields.Name.UnwrapOr(""),
+		Ticker:  fields.Ticker.UnwrapOr(""),
+		IconUri: fields.IconURI.UnwrapOr(""),
+	}
+	fields.DecDisplay.WhenSome(func(decDisplay uint32) {
+		decodedMeta.DecimalDisplay = &taprpc.DecimalDisplay{
+			DecimalDisplay: decDisplay,
+		}
+	})
+
+	return decodedMeta
+}
+
+// decodeAssetMeta fetches the metadata of the asset with the given ID and
+// returns its decimal display and its decoded well-known fields. The decoded
+// fields are nil if the asset doesn't have JSON metadata, or if its JSON
+// metadata doesn't follow the schema.
+func (r *rpcServer) decodeAssetMeta(ctx context.Context,
+	id asset.ID) (uint32, *taprpc.DecodedAssetMeta, error) {
+
+	meta, err := r.cfg.AssetStore.FetchAssetMetaForAsset(ctx, id)
+	if err != nil {
+		return 0, nil, fmt.Errorf("unable to fetch asset meta for "+
+			"asset_id=%v :%v", id, err)
+	}
+
+	_, decDisplay, err := meta.GetDecDisplay()
+	if err != nil && !errors.Is(err, proof.ErrNotJSON) {
+		return 0, nil, fmt.Errorf("unable to extract decimal display "+
+			"for asset_id=%v :%v", id, err)
+	}
+
+	if meta == nil || meta.Type != proof.MetaJson {
+		return decDisplay, nil, nil
+	}
+
+	// Assets minted before the schema was enforced may carry metadata that
+	// doesn't follow it, which isn't an error.
+	fields, err := meta.JSONFields()
+	if err != nil {
+		rpcsLog.Tracef("Asset meta of asset_id=%v doesn't follow the "+
+			"schema: %v", id, err)
+
+		return decDisplay, nil, nil
+	}
+
+	return decDisplay, marshalDecodedMeta(fields), nil
+}
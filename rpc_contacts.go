@@ -0,0 +1,118 @@
+package taprootassets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+)
+
+// errNoContactBook is returned by the contact RPCs if the daemon runs without
+// a contact book.
+var errNoContactBook = errors.New("contact book not available")
+
+// AddContact stores a Taproot Asset address under a label in the contact book,
+// so it can be sent to by its label.
+func (r *rpcServer) AddContact(ctx context.Context,
+	req *taprpc.AddContactRequest) (*taprpc.AddContactResponse, error) {
+
+	if r.cfg.ContactBook == nil {
+		return nil, errNoContactBook
+	}
+
+	contact, err := address.NewContact(
+		req.Label, req.Addr, req.Group, req.Notes, &r.cfg.ChainParams,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("[AddContact]: storing contact %v with addr %v",
+		contact.Label, contact.Addr)
+
+	err = r.cfg.ContactBook.UpsertContact(ctx, contact)
+	if err != nil {
+		return nil, fmt.Errorf("unable to store contact: %w", err)
+	}
+
+	// We fetch the contact again to return its usage stats, which are kept
+	// if the contact already existed.
+	stored, err := r.cfg.ContactBook.FetchContact(ctx, contact.Label)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch contact: %w", err)
+	}
+
+	return &taprpc.AddContactResponse{
+		Contact: marshalContact(stored),
+	}, nil
+}
+
+// ListContacts lists the contacts of the contact book, optionally limited to
+// the ones of a group.
+func (r *rpcServer) ListContacts(ctx context.Context,
+	req *taprpc.ListContactsRequest) (*taprpc.ListContactsResponse, error) {
+
+	if r.cfg.ContactBook == nil {
+		return nil, errNoContactBook
+	}
+
+	var query address.ContactQuery
+	if req.Group != "" {
+		query.Group = &req.Group
+	}
+
+	contacts, err := r.cfg.ContactBook.QueryContacts(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query contacts: %w", err)
+	}
+
+	return &taprpc.ListContactsResponse{
+		Contacts: fn.Map(contacts, marshalContact),
+	}, nil
+}
+
+// DeleteContact removes a contact from the contact book.
+func (r *rpcServer) DeleteContact(ctx context.Context,
+	req *taprpc.DeleteContactRequest) (*taprpc.DeleteContactResponse,
+	error) {
+
+	if r.cfg.ContactBook == nil {
+		return nil, errNoContactBook
+	}
+
+	if req.Label == "" {
+		return nil, address.ErrContactLabelEmpty
+	}
+
+	rpcsLog.Infof("[DeleteContact]: removing contact %v", req.Label)
+
+	err := r.cfg.ContactBook.DeleteContact(ctx, req.Label)
+	if err != nil {
+		return nil, fmt.Errorf("unable to delete contact: %w", err)
+	}
+
+	return &taprpc.DeleteContactResponse{}, nil
+}
+
+// marshalContact converts a contact of the contact book to its RPC
+// representation.
+func marshalContact(contact *address.Contact) *taprpc.Contact {
+	var lastUsed int64
+	if !contact.LastUsed.IsZero() {
+		lastUsed = contact.LastUsed.Unix()
+	}
+
+	return &taprpc.Contact{
+		Label:     contact.Label,
+		Addr:      contact.Addr,
+		Group:     contact.Group,
+		Notes:     contact.Notes,
+		CreatedAt: contact.CreatedAt.Unix(),
+		LastUsed:  lastUsed,
+		TotalSent: contact.TotalSent,
+		NumSends:  contact.NumSends,
+	}
+}
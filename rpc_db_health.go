@@ -0,0 +1,77 @@
+package taprootassets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapdb"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+)
+
+// GetDatabaseHealth reports the schema version and the integrity of the
+// database, so operators can verify it after an upgrade.
+func (r *rpcServer) GetDatabaseHealth(ctx context.Context,
+	_ *taprpc.DatabaseHealthRequest) (*taprpc.DatabaseHealthResponse,
+	error) {
+
+	health := r.cfg.DatabaseConfig.Health
+	if health == nil {
+		return nil, fmt.Errorf("database health report not available")
+	}
+
+	report, err := health.Report(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create database health "+
+			"report: %w", err)
+	}
+
+	if !report.Healthy() {
+		rpcsLog.Warnf("Database health check found issues: "+
+			"version=%d, dirty=%v, pending=%v, checks=%v",
+			report.SchemaVersion, report.Dirty,
+			report.PendingMigrations, report.IntegrityChecks)
+	}
+
+	return marshalDBHealth(report), nil
+}
+
+// marshalDBHealth converts the given database health report to its RPC
+// representation.
+func marshalDBHealth(
+	report *tapdb.HealthReport) *taprpc.DatabaseHealthResponse {
+
+	rows := report.RowCounts
+	checks := make(
+		[]*taprpc.IntegrityCheck, 0, len(report.IntegrityChecks),
+	)
+	for _, check := range report.IntegrityChecks {
+		checks = append(checks, &taprpc.IntegrityCheck{
+			Name:       check.Name,
+			Violations: check.Violations,
+		})
+	}
+
+	return &taprpc.DatabaseHealthResponse{
+		SchemaVersion: report.SchemaVersion,
+		Dirty:         report.Dirty,
+		LatestVersion: uint32(report.LatestVersion),
+		PendingMigrations: fn.Map(
+			report.PendingMigrations, func(v uint) uint32 {
+				return uint32(v)
+			},
+		),
+		RowCounts: &taprpc.DatabaseRowCounts{
+			NumAssets:         rows.NumAssets,
+			NumAssetProofs:    rows.NumAssetProofs,
+			NumTransfers:      rows.NumTransfers,
+			NumAddrs:          rows.NumAddrs,
+			NumAddrEvents:     rows.NumAddrEvents,
+			NumUniverseRoots:  rows.NumUniverseRoots,
+			NumUniverseLeaves: rows.NumUniverseLeaves,
+			NumMssmtNodes:     rows.NumMssmtNodes,
+		},
+		IntegrityChecks: checks,
+		Healthy:         report.Healthy(),
+	}
+}
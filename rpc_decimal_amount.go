@@ -0,0 +1,54 @@
+package taprootassets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+)
+
+// unitsFromDecimalAmount converts the given caller-supplied decimal amount into
+// units of the given asset, according to the decimal display of the asset.
+func (r *rpcServer) unitsFromDecimalAmount(ctx context.Context, id asset.ID,
+	decimalAmount string) (uint64, error) {
+
+	decDisplay, err := r.DecDisplayForAssetID(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+
+	return taprpc.ParseDecimalAmount(decimalAmount, decDisplay)
+}
+
+// checkSendDecimalAmount makes sure the given addresses sum up to the given
+// caller-supplied decimal amount, if the caller supplied one. This protects
+// against sending a multiple of the intended amount because of a mix-up of
+// units and decimal amounts.
+func (r *rpcServer) checkSendDecimalAmount(ctx context.Context,
+	decimalAmount string, tapAddrs []*address.Tap) error {
+
+	if decimalAmount == "" {
+		return nil
+	}
+
+	units, err := r.unitsFromDecimalAmount(
+		ctx, tapAddrs[0].AssetID, decimalAmount,
+	)
+	if err != nil {
+		return err
+	}
+
+	var total uint64
+	for _, tapAddr := range tapAddrs {
+		total += tapAddr.Amount
+	}
+
+	if total != units {
+		return fmt.Errorf("addresses sum up to %d units, but the "+
+			"decimal amount is %d units", total, units)
+	}
+
+	return nil
+}
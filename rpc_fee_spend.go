@@ -0,0 +1,116 @@
+package taprootassets
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"golang.org/x/exp/maps"
+)
+
+// QueryFeeSpend attributes the chain fees of the outbound transfers to the
+// assets of their outputs and sums them up per asset and period.
+func (r *rpcServer) QueryFeeSpend(ctx context.Context,
+	req *taprpc.FeeSpendRequest) (*taprpc.FeeSpendResponse, error) {
+
+	if req.StartTimestamp < 0 || req.EndTimestamp < 0 {
+		return nil, fmt.Errorf("timestamps must be non-negative")
+	}
+	if req.EndTimestamp != 0 && req.EndTimestamp < req.StartTimestamp {
+		return nil, fmt.Errorf("end timestamp must not be before " +
+			"start timestamp")
+	}
+
+	maxInterval := uint64(math.MaxInt64 / int64(time.Second))
+	if req.IntervalSeconds > maxInterval {
+		return nil, fmt.Errorf("interval must not exceed %d seconds",
+			maxInterval)
+	}
+
+	query := tapfreighter.FeeSpendQuery{
+		Interval: time.Duration(req.IntervalSeconds) * time.Second,
+	}
+	if req.StartTimestamp != 0 {
+		query.StartTime = fn.Some(time.Unix(req.StartTimestamp, 0))
+	}
+	if req.EndTimestamp != 0 {
+		query.EndTime = fn.Some(time.Unix(req.EndTimestamp, 0))
+	}
+
+	weigher := tapfreighter.ProRataFeeWeigher
+	if len(req.OutputWeights) > 0 {
+		weights := make(
+			map[chainhash.Hash][]uint64, len(req.OutputWeights),
+		)
+		for _, outputWeights := range req.OutputWeights {
+			txid, err := chainhash.NewHashFromStr(
+				outputWeights.AnchorTxid,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("invalid anchor txid: "+
+					"%w", err)
+			}
+
+			if _, ok := weights[*txid]; ok {
+				return nil, fmt.Errorf("duplicate weights for "+
+					"anchor txid %v", txid)
+			}
+
+			weights[*txid] = outputWeights.Weights
+		}
+
+		weigher = tapfreighter.FixedFeeWeigher(weights)
+	}
+
+	periods, err := tapfreighter.AggregateFeeSpend(
+		ctx, r.cfg.AssetStore, query, weigher,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to aggregate fee spend: %w", err)
+	}
+
+	resp := &taprpc.FeeSpendResponse{
+		Periods: make([]*taprpc.FeeSpendPeriod, 0, len(periods)),
+	}
+	for _, period := range periods {
+		resp.Periods = append(
+			resp.Periods, marshalFeeSpendPeriod(period),
+		)
+	}
+
+	return resp, nil
+}
+
+// marshalFeeSpendPeriod converts the fee spend of a period to its RPC
+// representation. The fees are sorted by asset ID to make the response
+// deterministic.
+func marshalFeeSpendPeriod(
+	period tapfreighter.FeeSpendPeriod) *taprpc.FeeSpendPeriod {
+
+	rpcPeriod := &taprpc.FeeSpendPeriod{
+		Fees: make([]*taprpc.AssetFeeSpend, 0, len(period.Fees)),
+	}
+	if !period.Start.IsZero() {
+		rpcPeriod.StartTimestamp = period.Start.Unix()
+	}
+
+	assetIDs := maps.Keys(period.Fees)
+	sort.Slice(assetIDs, func(i, j int) bool {
+		return bytes.Compare(assetIDs[i][:], assetIDs[j][:]) < 0
+	})
+	for _, assetID := range assetIDs {
+		rpcPeriod.Fees = append(rpcPeriod.Fees, &taprpc.AssetFeeSpend{
+			AssetId: fn.CopySlice(assetID[:]),
+			FeeSats: period.Fees[assetID],
+		})
+	}
+
+	return rpcPeriod
+}
@@ -0,0 +1,238 @@
+package taprootassets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/lightninglabs/taproot-assets/taprpc/mintrpc"
+)
+
+// CreateGroupSession starts a MuSig2 signing session for the group witness of
+// an asset in the pending batch whose group key is shared between multiple
+// issuers.
+func (r *rpcServer) CreateGroupSession(ctx context.Context,
+	req *mintrpc.CreateGroupSessionRequest) (*mintrpc.GroupSessionResponse,
+	error) {
+
+	if req.AssetName == "" {
+		return nil, fmt.Errorf("asset name must be set")
+	}
+
+	if req.LocalKey == nil || req.LocalKey.KeyLoc == nil {
+		return nil, fmt.Errorf("local key and its locator must be set")
+	}
+	localKey, err := taprpc.UnmarshalKeyDescriptor(req.LocalKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid local key: %w", err)
+	}
+
+	signers := make([]*btcec.PublicKey, 0, len(req.Signers))
+	for idx, rawSigner := range req.Signers {
+		signer, err := btcec.ParsePubKey(rawSigner)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signer %d: %w", idx,
+				err)
+		}
+
+		signers = append(signers, signer)
+	}
+
+	// The virtual transaction the group witness signs is only known for
+	// the unsealed seedlings of the pending batch, which are only listed
+	// in verbose mode.
+	batches, err := r.cfg.AssetMinter.ListBatches(
+		tapgarden.ListBatchesParams{
+			Verbose: true,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list batches: %w", err)
+	}
+
+	var group *tapgarden.PendingAssetGroup
+	for _, batch := range batches {
+		if batch.State() != tapgarden.BatchStatePending {
+			continue
+		}
+
+		seedling, ok := batch.UnsealedSeedlings[req.AssetName]
+		if ok && seedling.PendingAssetGroup != nil {
+			group = seedling.PendingAssetGroup
+			break
+		}
+	}
+	if group == nil {
+		return nil, fmt.Errorf("no unsealed grouped asset named %v in "+
+			"the pending batch", req.AssetName)
+	}
+
+	session, err := r.cfg.AssetMinter.GroupSessions().CreateSession(
+		ctx, group, localKey, signers,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalGroupSessionResponse(session), nil
+}
+
+// RegisterGroupSessionNonce registers the public nonce of a remote issuer of a
+// group signing session.
+func (r *rpcServer) RegisterGroupSessionNonce(ctx context.Context,
+	req *mintrpc.RegisterGroupSessionNonceRequest) (
+	*mintrpc.GroupSessionResponse, error) {
+
+	id, err := parseMuSig2SessionID(req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := btcec.ParsePubKey(req.Signer)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signer: %w", err)
+	}
+
+	if len(req.PubNonce) != musig2.PubNonceSize {
+		return nil, fmt.Errorf("public nonce must be %d bytes",
+			musig2.PubNonceSize)
+	}
+	var nonce [musig2.PubNonceSize]byte
+	copy(nonce[:], req.PubNonce)
+
+	session, err := r.cfg.AssetMinter.GroupSessions().RegisterNonce(
+		ctx, id, signer, nonce,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalGroupSessionResponse(session), nil
+}
+
+// SignGroupSession creates the partial signature of the local issuer of a
+// group signing session.
+func (r *rpcServer) SignGroupSession(ctx context.Context,
+	req *mintrpc.SignGroupSessionRequest) (*mintrpc.GroupSessionResponse,
+	error) {
+
+	id, err := parseMuSig2SessionID(req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := r.cfg.AssetMinter.GroupSessions().Sign(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalGroupSessionResponse(session), nil
+}
+
+// RegisterGroupSessionPartialSig registers the partial signature of a remote
+// issuer of a group signing session.
+func (r *rpcServer) RegisterGroupSessionPartialSig(ctx context.Context,
+	req *mintrpc.RegisterGroupSessionPartialSigRequest) (
+	*mintrpc.GroupSessionResponse, error) {
+
+	id, err := parseMuSig2SessionID(req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := btcec.ParsePubKey(req.Signer)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signer: %w", err)
+	}
+
+	session, err := r.cfg.AssetMinter.GroupSessions().RegisterPartialSig(
+		ctx, id, signer, req.PartialSig,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalGroupSessionResponse(session), nil
+}
+
+// FinalizeGroupSession combines the partial signatures of all issuers of a
+// group signing session into the group witness of the asset.
+func (r *rpcServer) FinalizeGroupSession(ctx context.Context,
+	req *mintrpc.FinalizeGroupSessionRequest) (
+	*mintrpc.FinalizeGroupSessionResponse, error) {
+
+	id, err := parseMuSig2SessionID(req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	witness, err := r.cfg.AssetMinter.GroupSessions().Finalize(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mintrpc.FinalizeGroupSessionResponse{
+		GroupWitness: &taprpc.GroupWitness{
+			GenesisId: fn.CopySlice(witness.GenID[:]),
+			Witness:   witness.Witness,
+		},
+	}, nil
+}
+
+// AbortGroupSession cancels a group signing session.
+func (r *rpcServer) AbortGroupSession(ctx context.Context,
+	req *mintrpc.AbortGroupSessionRequest) (
+	*mintrpc.AbortGroupSessionResponse, error) {
+
+	id, err := parseMuSig2SessionID(req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.cfg.AssetMinter.GroupSessions().AbortSession(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mintrpc.AbortGroupSessionResponse{}, nil
+}
+
+// marshalGroupSessionResponse converts a group signing session to its RPC
+// representation and wraps it in a response.
+func marshalGroupSessionResponse(
+	session *tapgarden.GroupSession) *mintrpc.GroupSessionResponse {
+
+	rpcSession := &mintrpc.GroupSession{
+		SessionId: fn.CopySlice(session.ID[:]),
+		AssetId:   fn.CopySlice(session.GenID[:]),
+		GroupKey:  schnorr.SerializePubKey(session.GroupKey),
+		SigHash:   fn.CopySlice(session.SigHash[:]),
+		Witness:   session.Witness,
+	}
+
+	for _, participant := range session.Participants {
+		rpcParticipant := &mintrpc.GroupSessionParticipant{
+			PubKey:     participant.PubKey.SerializeCompressed(),
+			Local:      participant.Local,
+			PartialSig: participant.PartialSig,
+		}
+		participant.PubNonce.WhenSome(
+			func(nonce [musig2.PubNonceSize]byte) {
+				rpcParticipant.PubNonce = nonce[:]
+			},
+		)
+
+		rpcSession.Participants = append(
+			rpcSession.Participants, rpcParticipant,
+		)
+	}
+
+	return &mintrpc.GroupSessionResponse{
+		Session: rpcSession,
+	}
+}
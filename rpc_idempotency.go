@@ -0,0 +1,157 @@
+package taprootassets
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/tapdb"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// MaxIdempotencyKeyLength is the maximum length in bytes of a
+	// caller-supplied idempotency key.
+	MaxIdempotencyKeyLength = 255
+
+	// sendAssetMethod is the method name idempotency keys of SendAsset
+	// calls are stored under.
+	sendAssetMethod = "SendAsset"
+
+	// mintAssetMethod is the method name idempotency keys of MintAsset
+	// calls are stored under.
+	mintAssetMethod = "MintAsset"
+)
+
+var (
+	// ErrIdempotencyKeyReused is returned if an idempotency key is re-used
+	// for a request that differs from the original request.
+	ErrIdempotencyKeyReused = errors.New("idempotency key was already " +
+		"used for a different request")
+
+	// ErrIdempotencyKeyInFlight is returned if a call with the same
+	// idempotency key is still being processed.
+	ErrIdempotencyKeyInFlight = errors.New("a call with the same " +
+		"idempotency key is still in progress")
+)
+
+// validateIdempotencyKey checks that a caller-supplied idempotency key is
+// within the allowed length. An empty key means no key was supplied.
+func validateIdempotencyKey(key string) error {
+	if len(key) > MaxIdempotencyKeyLength {
+		return fmt.Errorf("idempotency key exceeds maximum length of "+
+			"%d bytes", MaxIdempotencyKeyLength)
+	}
+
+	return nil
+}
+
+// lockIdempotencyKey marks the given idempotency key of the given method as
+// in flight. The returned function must be called to release the key once the
+// call completed.
+func (r *rpcServer) lockIdempotencyKey(method, key string) (func(), error) {
+	inFlightKey := method + "/" + key
+
+	r.idempotencyMtx.Lock()
+	defer r.idempotencyMtx.Unlock()
+
+	if _, ok := r.idempotencyInFlight[inFlightKey]; ok {
+		return nil, ErrIdempotencyKeyInFlight
+	}
+	r.idempotencyInFlight[inFlightKey] = struct{}{}
+
+	return func() {
+		r.idempotencyMtx.Lock()
+		defer r.idempotencyMtx.Unlock()
+
+		delete(r.idempotencyInFlight, inFlightKey)
+	}, nil
+}
+
+// withIdempotency executes the given call, unless the caller supplied an
+// idempotency key that was already used for the same request of the given
+// method. In that case the stored response of the original call is returned
+// instead, after it was marked as replayed with the given function. Only
+// successful calls are stored, so a failed call can be retried with the same
+// key.
+func withIdempotency[T proto.Message](ctx context.Context, r *rpcServer,
+	method, key string, req proto.Message, newResp func() T,
+	setReplayed func(T), call func() (T, error)) (T, error) {
+
+	var zero T
+
+	if err := validateIdempotencyKey(key); err != nil {
+		return zero, err
+	}
+
+	store := r.cfg.IdempotencyStore
+	if key == "" || store == nil {
+		return call()
+	}
+
+	reqBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return zero, fmt.Errorf("unable to serialize request: %w", err)
+	}
+	reqHash := sha256.Sum256(reqBytes)
+
+	release, err := r.lockIdempotencyKey(method, key)
+	if err != nil {
+		return zero, err
+	}
+	defer release()
+
+	stored, err := store.FetchResponse(ctx, key, method)
+	switch {
+	case err == nil:
+		if stored.RequestHash != reqHash {
+			return zero, ErrIdempotencyKeyReused
+		}
+
+		resp := newResp()
+		if err := proto.Unmarshal(stored.Response, resp); err != nil {
+			return zero, fmt.Errorf("unable to deserialize stored "+
+				"response: %w", err)
+		}
+
+		rpcsLog.Debugf("Returning stored response of %v call with "+
+			"idempotency key %v", method, key)
+
+		setReplayed(resp)
+
+		return resp, nil
+
+	case !errors.Is(err, tapdb.ErrIdempotencyKeyNotFound):
+		return zero, fmt.Errorf("unable to fetch stored response: %w",
+			err)
+	}
+
+	resp, err := call()
+	if err != nil {
+		return zero, err
+	}
+
+	// The call succeeded at this point, so we return its response even if
+	// we fail to store it. A retry with the same key would then execute
+	// the call again, which we can't prevent anymore. We don't use the
+	// call's context for storing the response, as the caller might have
+	// given up waiting already, which is the very case a retry with the
+	// same key is meant for.
+	respBytes, err := proto.Marshal(resp)
+	if err == nil {
+		ctxt, cancel := context.WithTimeout(
+			context.Background(), tapdb.DefaultStoreTimeout,
+		)
+		err = store.StoreResponse(
+			ctxt, key, method, reqHash, respBytes,
+		)
+		cancel()
+	}
+	if err != nil {
+		rpcsLog.Errorf("Unable to store response of %v call with "+
+			"idempotency key %v: %v", method, key, err)
+	}
+
+	return resp, nil
+}
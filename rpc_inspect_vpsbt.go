@@ -0,0 +1,146 @@
+package taprootassets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	wrpc "github.com/lightninglabs/taproot-assets/taprpc/assetwalletrpc"
+)
+
+// InspectVirtualPsbt returns a readable breakdown of a virtual PSBT or of a
+// pending transfer.
+func (r *rpcServer) InspectVirtualPsbt(ctx context.Context,
+	req *wrpc.InspectVirtualPsbtRequest) (*wrpc.InspectVirtualPsbtResponse,
+	error) {
+
+	var inspections []*tappsbt.Inspection
+	switch {
+	case len(req.GetVirtualPsbt()) > 0:
+		vPkt, err := tappsbt.Decode(req.GetVirtualPsbt())
+		if err != nil {
+			return nil, fmt.Errorf("error decoding packet: %w", err)
+		}
+
+		inspections = append(inspections, tappsbt.Inspect(vPkt))
+
+	case req.GetPendingAnchorTxid() != "":
+		txid, err := chainhash.NewHashFromStr(
+			req.GetPendingAnchorTxid(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid anchor txid: %w", err)
+		}
+
+		parcels, err := r.cfg.AssetStore.QueryParcels(ctx, txid, true)
+		if err != nil {
+			return nil, fmt.Errorf("unable to query pending "+
+				"transfers: %w", err)
+		}
+		if len(parcels) == 0 {
+			return nil, fmt.Errorf("no pending transfer with "+
+				"anchor txid %v", txid)
+		}
+
+		parcel := parcels[0]
+		inspections = append(
+			inspections, tapfreighter.InspectParcel(parcel),
+		)
+		for _, vPkt := range parcel.PassiveAssets {
+			inspections = append(
+				inspections, tappsbt.Inspect(vPkt),
+			)
+		}
+
+	default:
+		return nil, fmt.Errorf("virtual PSBT or pending anchor txid " +
+			"must be set")
+	}
+
+	return &wrpc.InspectVirtualPsbtResponse{
+		Inspections: fn.Map(inspections, marshalInspection),
+	}, nil
+}
+
+// marshalInspection converts the breakdown of a virtual packet to its RPC
+// representation.
+func marshalInspection(
+	inspection *tappsbt.Inspection) *wrpc.VirtualPsbtInspection {
+
+	rpcInspection := &wrpc.VirtualPsbtInspection{
+		Version: uint32(inspection.Version),
+		Network: inspection.Network,
+		MissingWitnesses: fn.Map(
+			inspection.MissingWitnesses, func(idx int) int32 {
+				return int32(idx)
+			},
+		),
+	}
+	if inspection.AssetID != nil {
+		rpcInspection.AssetId = fn.CopySlice(inspection.AssetID[:])
+	}
+
+	for _, in := range inspection.Inputs {
+		prevID := &taprpc.PrevInputAsset{
+			AnchorPoint: in.PrevID.OutPoint.String(),
+			AssetId:     fn.CopySlice(in.PrevID.ID[:]),
+			ScriptKey:   fn.CopySlice(in.PrevID.ScriptKey[:]),
+			Amount:      in.Amount,
+		}
+		rpcInspection.Inputs = append(
+			rpcInspection.Inputs, &wrpc.VirtualInputSummary{
+				Index:       int32(in.Index),
+				PrevId:      prevID,
+				Amount:      in.Amount,
+				HasAsset:    in.HasAsset,
+				HasProof:    in.HasProof,
+				AnchorValue: in.AnchorValue,
+				Signed:      in.Signed,
+			},
+		)
+	}
+
+	for _, out := range inspection.Outputs {
+		rpcOut := &wrpc.VirtualOutputSummary{
+			Index:                int32(out.Index),
+			Type:                 out.Type.String(),
+			Amount:               out.Amount,
+			Interactive:          out.Interactive,
+			AnchorOutputIndex:    out.AnchorOutputIndex,
+			HasTapSibling:        out.HasTapSibling,
+			HasAsset:             out.HasAsset,
+			HasSplitCommitment:   out.HasSplitCommitment,
+			HasProofSuffix:       out.HasProofSuffix,
+			ProofDeliveryAddress: out.ProofDeliveryAddress,
+		}
+		if out.ScriptKey != nil {
+			rpcOut.ScriptKey = out.ScriptKey.SerializeCompressed()
+		}
+		if out.AnchorInternalKey != nil {
+			rpcOut.AnchorInternalKey =
+				out.AnchorInternalKey.SerializeCompressed()
+		}
+
+		rpcInspection.Outputs = append(rpcInspection.Outputs, rpcOut)
+	}
+
+	for _, anchorOut := range inspection.AnchorOutputs {
+		rpcInspection.AnchorOutputs = append(
+			rpcInspection.AnchorOutputs, &wrpc.AnchorOutputSummary{
+				AnchorOutputIndex: anchorOut.AnchorOutputIndex,
+				OutputIndexes: fn.Map(
+					anchorOut.OutputIndexes,
+					func(idx int) int32 {
+						return int32(idx)
+					},
+				),
+			},
+		)
+	}
+
+	return rpcInspection
+}
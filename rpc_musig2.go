@@ -0,0 +1,351 @@
+package taprootassets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapmusig"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	wrpc "github.com/lightninglabs/taproot-assets/taprpc/assetwalletrpc"
+)
+
+// CreateMuSig2Session starts a new MuSig2 signing session for the key spend
+// path of a virtual PSBT input whose script key is the combined key of
+// several signers.
+func (r *rpcServer) CreateMuSig2Session(ctx context.Context,
+	req *wrpc.CreateMuSig2SessionRequest) (*wrpc.MuSig2SessionResponse,
+	error) {
+
+	if len(req.VirtualPsbt) == 0 {
+		return nil, fmt.Errorf("virtual PSBT must be set")
+	}
+
+	vPkt, err := tappsbt.Decode(req.VirtualPsbt)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding packet: %w", err)
+	}
+
+	if req.LocalKey == nil || req.LocalKey.KeyLoc == nil {
+		return nil, fmt.Errorf("local key and its locator must be set")
+	}
+	localKey, err := taprpc.UnmarshalKeyDescriptor(req.LocalKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid local key: %w", err)
+	}
+
+	signers := make([]*btcec.PublicKey, 0, len(req.Signers))
+	for idx, rawSigner := range req.Signers {
+		signer, err := btcec.ParsePubKey(rawSigner)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signer %d: %w", idx,
+				err)
+		}
+
+		signers = append(signers, signer)
+	}
+
+	session, err := r.cfg.MuSig2Sessions.CreateSession(
+		ctx, vPkt, req.InputIndex, localKey, signers,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalMuSig2SessionResponse(session)
+}
+
+// RegisterMuSig2Nonce registers the public nonce of a remote signer of a
+// MuSig2 session.
+func (r *rpcServer) RegisterMuSig2Nonce(ctx context.Context,
+	req *wrpc.RegisterMuSig2NonceRequest) (*wrpc.MuSig2SessionResponse,
+	error) {
+
+	id, err := parseMuSig2SessionID(req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := btcec.ParsePubKey(req.Signer)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signer: %w", err)
+	}
+
+	if len(req.PubNonce) != musig2.PubNonceSize {
+		return nil, fmt.Errorf("public nonce must be %d bytes",
+			musig2.PubNonceSize)
+	}
+	var nonce [musig2.PubNonceSize]byte
+	copy(nonce[:], req.PubNonce)
+
+	session, err := r.cfg.MuSig2Sessions.RegisterNonce(
+		ctx, id, signer, nonce,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalMuSig2SessionResponse(session)
+}
+
+// SignMuSig2Session creates the partial signature of the local signer of a
+// MuSig2 session.
+func (r *rpcServer) SignMuSig2Session(ctx context.Context,
+	req *wrpc.SignMuSig2SessionRequest) (*wrpc.MuSig2SessionResponse,
+	error) {
+
+	id, err := parseMuSig2SessionID(req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := r.cfg.MuSig2Sessions.Sign(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalMuSig2SessionResponse(session)
+}
+
+// RegisterMuSig2PartialSig registers the partial signature of a remote signer
+// of a MuSig2 session.
+func (r *rpcServer) RegisterMuSig2PartialSig(ctx context.Context,
+	req *wrpc.RegisterMuSig2PartialSigRequest) (*wrpc.MuSig2SessionResponse,
+	error) {
+
+	id, err := parseMuSig2SessionID(req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := btcec.ParsePubKey(req.Signer)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signer: %w", err)
+	}
+
+	if len(req.PartialSig) == 0 {
+		return nil, fmt.Errorf("partial signature must be set")
+	}
+
+	session, err := r.cfg.MuSig2Sessions.RegisterPartialSig(
+		ctx, id, signer, req.PartialSig,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalMuSig2SessionResponse(session)
+}
+
+// FinalizeMuSig2Session combines the partial signatures of all signers of a
+// MuSig2 session into the witness of the signed input.
+func (r *rpcServer) FinalizeMuSig2Session(ctx context.Context,
+	req *wrpc.FinalizeMuSig2SessionRequest) (
+	*wrpc.FinalizeMuSig2SessionResponse, error) {
+
+	id, err := parseMuSig2SessionID(req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	var vPkt *tappsbt.VPacket
+	if len(req.VirtualPsbt) > 0 {
+		vPkt, err = tappsbt.Decode(req.VirtualPsbt)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding packet: %w", err)
+		}
+	}
+
+	signedPkt, err := r.cfg.MuSig2Sessions.Finalize(ctx, id, vPkt)
+	if err != nil {
+		return nil, err
+	}
+
+	vPsbtBytes, err := serialize(signedPkt)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing packet: %w", err)
+	}
+
+	return &wrpc.FinalizeMuSig2SessionResponse{
+		VirtualPsbt: vPsbtBytes,
+	}, nil
+}
+
+// AbortMuSig2Session cancels a MuSig2 session that isn't finalized yet.
+func (r *rpcServer) AbortMuSig2Session(ctx context.Context,
+	req *wrpc.AbortMuSig2SessionRequest) (*wrpc.AbortMuSig2SessionResponse,
+	error) {
+
+	id, err := parseMuSig2SessionID(req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.cfg.MuSig2Sessions.AbortSession(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return &wrpc.AbortMuSig2SessionResponse{}, nil
+}
+
+// ListMuSig2Sessions lists all MuSig2 sessions, optionally filtered by their
+// state.
+func (r *rpcServer) ListMuSig2Sessions(ctx context.Context,
+	req *wrpc.ListMuSig2SessionsRequest) (*wrpc.ListMuSig2SessionsResponse,
+	error) {
+
+	state := fn.None[tapmusig.SessionState]()
+	if req.State != wrpc.MuSig2State_MUSIG2_STATE_UNKNOWN {
+		nativeState, err := unmarshalMuSig2SessionState(req.State)
+		if err != nil {
+			return nil, err
+		}
+
+		state = fn.Some(nativeState)
+	}
+
+	sessions, err := r.cfg.MuSig2Sessions.ListSessions(ctx, state)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list musig2 sessions: %w",
+			err)
+	}
+
+	resp := &wrpc.ListMuSig2SessionsResponse{
+		Sessions: make([]*wrpc.MuSig2Session, 0, len(sessions)),
+	}
+	for _, session := range sessions {
+		rpcSession, err := marshalMuSig2Session(session)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Sessions = append(resp.Sessions, rpcSession)
+	}
+
+	return resp, nil
+}
+
+// parseMuSig2SessionID parses the given raw MuSig2 session ID.
+func parseMuSig2SessionID(rawID []byte) ([32]byte, error) {
+	var id [32]byte
+	if len(rawID) != len(id) {
+		return id, fmt.Errorf("session ID must be %d bytes", len(id))
+	}
+
+	copy(id[:], rawID)
+
+	return id, nil
+}
+
+// unmarshalMuSig2SessionState converts the RPC MuSig2 session state to its
+// native counterpart.
+func unmarshalMuSig2SessionState(
+	state wrpc.MuSig2State) (tapmusig.SessionState, error) {
+
+	switch state {
+	case wrpc.MuSig2State_MUSIG2_STATE_COLLECTING_NONCES:
+		return tapmusig.StateCollectingNonces, nil
+
+	case wrpc.MuSig2State_MUSIG2_STATE_COLLECTING_SIGS:
+		return tapmusig.StateCollectingSigs, nil
+
+	case wrpc.MuSig2State_MUSIG2_STATE_FINALIZED:
+		return tapmusig.StateFinalized, nil
+
+	case wrpc.MuSig2State_MUSIG2_STATE_ABORTED:
+		return tapmusig.StateAborted, nil
+
+	default:
+		return 0, fmt.Errorf("unknown musig2 session state: %v", state)
+	}
+}
+
+// marshalMuSig2SessionState converts the native MuSig2 session state to its
+// RPC counterpart.
+func marshalMuSig2SessionState(
+	state tapmusig.SessionState) wrpc.MuSig2State {
+
+	switch state {
+	case tapmusig.StateCollectingNonces:
+		return wrpc.MuSig2State_MUSIG2_STATE_COLLECTING_NONCES
+
+	case tapmusig.StateCollectingSigs:
+		return wrpc.MuSig2State_MUSIG2_STATE_COLLECTING_SIGS
+
+	case tapmusig.StateFinalized:
+		return wrpc.MuSig2State_MUSIG2_STATE_FINALIZED
+
+	case tapmusig.StateAborted:
+		return wrpc.MuSig2State_MUSIG2_STATE_ABORTED
+
+	default:
+		return wrpc.MuSig2State_MUSIG2_STATE_UNKNOWN
+	}
+}
+
+// marshalMuSig2Session converts a MuSig2 session to its RPC representation.
+func marshalMuSig2Session(
+	session *tapmusig.Session) (*wrpc.MuSig2Session, error) {
+
+	vPsbtBytes, err := serialize(session.VPacket)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing packet: %w", err)
+	}
+
+	rpcSession := &wrpc.MuSig2Session{
+		SessionId:   fn.CopySlice(session.ID[:]),
+		VirtualPsbt: vPsbtBytes,
+		InputIndex:  session.InputIndex,
+		LocalKey: &taprpc.KeyLocator{
+			KeyFamily: int32(session.LocalKey.Family),
+			KeyIndex:  int32(session.LocalKey.Index),
+		},
+		CombinedKey: schnorr.SerializePubKey(
+			session.CombinedKey,
+		),
+		SigHash:          fn.CopySlice(session.SigHash[:]),
+		State:            marshalMuSig2SessionState(session.State),
+		CreationTimeUnix: session.CreationTime.Unix(),
+	}
+	if session.FinalSig != nil {
+		rpcSession.FinalSig = session.FinalSig.Serialize()
+	}
+
+	for _, participant := range session.Participants {
+		rpcParticipant := &wrpc.MuSig2Participant{
+			PubKey:     participant.PubKey.SerializeCompressed(),
+			Local:      participant.Local,
+			PartialSig: participant.PartialSig,
+		}
+		participant.PubNonce.WhenSome(
+			func(nonce [musig2.PubNonceSize]byte) {
+				rpcParticipant.PubNonce = nonce[:]
+			},
+		)
+
+		rpcSession.Participants = append(
+			rpcSession.Participants, rpcParticipant,
+		)
+	}
+
+	return rpcSession, nil
+}
+
+// marshalMuSig2SessionResponse wraps the RPC representation of the given
+// MuSig2 session in a response.
+func marshalMuSig2SessionResponse(
+	session *tapmusig.Session) (*wrpc.MuSig2SessionResponse, error) {
+
+	rpcSession, err := marshalMuSig2Session(session)
+	if err != nil {
+		return nil, err
+	}
+
+	return &wrpc.MuSig2SessionResponse{
+		Session: rpcSession,
+	}, nil
+}
@@ -0,0 +1,105 @@
+package taprootassets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/tapdb"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+)
+
+// listAssetsMethod is the full name of the ListAssets RPC method.
+const listAssetsMethod = "/taprpc.TaprootAssets/ListAssets"
+
+// readSnapshotClient returns the identity of the caller that a read snapshot
+// is bound to. Callers with a macaroon that is valid for the method with the
+// given full name are identified by it. As most universe methods don't require
+// a macaroon and each read snapshot holds on to a database connection, all
+// other callers can only use read snapshots if each client is subject to its
+// own universe rate limit. They're identified by their IP address then.
+func (r *rpcServer) readSnapshotClient(ctx context.Context,
+	fullMethod string) (string, error) {
+
+	limiter := r.universeRateLimiter
+	if macID, ok := limiter.macaroonID(ctx, fullMethod); ok {
+		return macaroonClientID(macID), nil
+	}
+
+	if !limiter.clientsLimited() {
+		return "", fmt.Errorf("read snapshots require a valid " +
+			"macaroon unless per-client universe rate limits are " +
+			"configured")
+	}
+
+	return peerClientID(ctx), nil
+}
+
+// withReadSnapshot attaches the read snapshot the caller requested to the
+// returned context, together with the snapshot details that are reported back
+// to the caller. The returned function must be called once the call completes,
+// to release the snapshot if the caller asked for it. If no snapshot was
+// requested, the context is returned as is and the snapshot details are nil.
+//
+// NOTE: Rate limited methods must only call this after the rate limiter
+// allowed the call, as opening a snapshot is expensive.
+func (r *rpcServer) withReadSnapshot(ctx context.Context, fullMethod string,
+	req *taprpc.ReadSnapshotRequest) (context.Context, *taprpc.ReadSnapshot,
+	func(), error) {
+
+	noop := func() {}
+
+	switch {
+	case req == nil || (!req.Open && req.Token == ""):
+		return ctx, nil, noop, nil
+
+	case req.Open && req.Token != "":
+		return nil, nil, noop, fmt.Errorf("cannot open a new read " +
+			"snapshot and use an existing one at the same time")
+	}
+
+	snapshots := r.cfg.DatabaseConfig.ReadSnapshots
+	if snapshots == nil {
+		return nil, nil, noop, fmt.Errorf("read snapshots are not " +
+			"available")
+	}
+
+	client, err := r.readSnapshotClient(ctx, fullMethod)
+	if err != nil {
+		return nil, nil, noop, err
+	}
+
+	var token tapdb.ReadSnapshotToken
+	if req.Open {
+		token, _, err = snapshots.Open(ctx, client)
+		if err != nil {
+			return nil, nil, noop, fmt.Errorf("unable to open "+
+				"read snapshot: %w", err)
+		}
+
+		rpcsLog.Debugf("Opened read snapshot %v for client %v", token,
+			client)
+	} else {
+		token, err = tapdb.ParseReadSnapshotToken(req.Token)
+		if err != nil {
+			return nil, nil, noop, err
+		}
+	}
+
+	snapshotCtx, expiry, err := snapshots.Attach(ctx, token, client)
+	if err != nil {
+		return nil, nil, noop, fmt.Errorf("unable to use read "+
+			"snapshot: %w", err)
+	}
+
+	done := noop
+	if req.Release {
+		done = func() {
+			snapshots.Close(token)
+		}
+	}
+
+	return snapshotCtx, &taprpc.ReadSnapshot{
+		Token:  token.String(),
+		Expiry: expiry.Unix(),
+	}, done, nil
+}
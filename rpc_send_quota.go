@@ -0,0 +1,199 @@
+package taprootassets
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+)
+
+// errNoSendQuotaLog is returned by the send limit RPCs if the daemon runs
+// without a send quota log.
+var errNoSendQuotaLog = errors.New("send quota log not available")
+
+// SetSendLimit sets the maximum cumulative amount of an asset that can be sent
+// to a destination script key, replacing any existing limit.
+func (r *rpcServer) SetSendLimit(ctx context.Context,
+	req *taprpc.SetSendLimitRequest) (*taprpc.SetSendLimitResponse, error) {
+
+	if r.cfg.SendQuotaLog == nil {
+		return nil, errNoSendQuotaLog
+	}
+
+	if req.Limit == nil {
+		return nil, fmt.Errorf("limit must be set")
+	}
+
+	scriptKey, assetID, err := parseSendDestination(
+		req.Limit.ScriptKey, req.Limit.AssetId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The limit is stored as a signed integer, so we can't accept amounts
+	// that wouldn't fit.
+	if req.Limit.MaxAmount > math.MaxInt64 {
+		return nil, fmt.Errorf("max amount %d exceeds maximum of %d",
+			req.Limit.MaxAmount, int64(math.MaxInt64))
+	}
+
+	rpcsLog.Infof("[SetSendLimit]: limiting sends of asset %v to script "+
+		"key %x to %d", assetID, scriptKey.SerializeCompressed(),
+		req.Limit.MaxAmount)
+
+	err = r.cfg.SendQuotaLog.SetSendLimit(ctx, tapfreighter.SendLimit{
+		ScriptKey: scriptKey,
+		AssetID:   assetID,
+		MaxAmount: req.Limit.MaxAmount,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to set send limit: %w", err)
+	}
+
+	return &taprpc.SetSendLimitResponse{}, nil
+}
+
+// RemoveSendLimit removes the limit of the cumulative amount of an asset that
+// can be sent to a destination script key.
+func (r *rpcServer) RemoveSendLimit(ctx context.Context,
+	req *taprpc.RemoveSendLimitRequest) (*taprpc.RemoveSendLimitResponse,
+	error) {
+
+	if r.cfg.SendQuotaLog == nil {
+		return nil, errNoSendQuotaLog
+	}
+
+	scriptKey, assetID, err := parseSendDestination(
+		req.ScriptKey, req.AssetId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("[RemoveSendLimit]: removing limit of asset %v to "+
+		"script key %x", assetID, scriptKey.SerializeCompressed())
+
+	err = r.cfg.SendQuotaLog.RemoveSendLimit(ctx, scriptKey, assetID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to remove send limit: %w", err)
+	}
+
+	return &taprpc.RemoveSendLimitResponse{}, nil
+}
+
+// ListSendLimits lists all limits of the cumulative amounts of assets that can
+// be sent to destination script keys.
+func (r *rpcServer) ListSendLimits(ctx context.Context,
+	_ *taprpc.ListSendLimitsRequest) (*taprpc.ListSendLimitsResponse,
+	error) {
+
+	if r.cfg.SendQuotaLog == nil {
+		return nil, errNoSendQuotaLog
+	}
+
+	limits, err := r.cfg.SendQuotaLog.QuerySendLimits(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query send limits: %w", err)
+	}
+
+	return &taprpc.ListSendLimitsResponse{
+		Limits: fn.Map(limits, marshalSendLimit),
+	}, nil
+}
+
+// ListSendTotals lists the cumulative amounts of assets sent to destination
+// script keys, together with their limits.
+func (r *rpcServer) ListSendTotals(ctx context.Context,
+	req *taprpc.ListSendTotalsRequest) (*taprpc.ListSendTotalsResponse,
+	error) {
+
+	if r.cfg.SendQuotaLog == nil {
+		return nil, errNoSendQuotaLog
+	}
+
+	var query tapfreighter.SendTotalsQuery
+	if len(req.ScriptKey) != 0 {
+		scriptKey, err := btcec.ParsePubKey(req.ScriptKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid script key: %w", err)
+		}
+		query.ScriptKey = scriptKey
+	}
+	if len(req.AssetId) != 0 {
+		if len(req.AssetId) != sha256.Size {
+			return nil, fmt.Errorf("invalid asset id length")
+		}
+
+		var assetID asset.ID
+		copy(assetID[:], req.AssetId)
+		query.AssetID = &assetID
+	}
+
+	totals, err := r.cfg.SendQuotaLog.QuerySendTotals(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query send totals: %w", err)
+	}
+
+	return &taprpc.ListSendTotalsResponse{
+		Totals: fn.Map(totals, marshalSendTotal),
+	}, nil
+}
+
+// parseSendDestination parses the script key and asset ID of a send limit.
+// The script key must be given in its compressed form, as the limits are
+// matched against the exact keys of the transfer outputs.
+func parseSendDestination(rawScriptKey,
+	rawAssetID []byte) (*btcec.PublicKey, asset.ID, error) {
+
+	var assetID asset.ID
+	if len(rawScriptKey) != btcec.PubKeyBytesLenCompressed {
+		return nil, assetID, fmt.Errorf("script key must be a %d byte "+
+			"compressed public key", btcec.PubKeyBytesLenCompressed)
+	}
+
+	scriptKey, err := btcec.ParsePubKey(rawScriptKey)
+	if err != nil {
+		return nil, assetID, fmt.Errorf("invalid script key: %w", err)
+	}
+
+	if len(rawAssetID) != sha256.Size {
+		return nil, assetID, fmt.Errorf("invalid asset id length")
+	}
+	copy(assetID[:], rawAssetID)
+
+	return scriptKey, assetID, nil
+}
+
+// marshalSendLimit converts a send limit to its RPC representation.
+func marshalSendLimit(limit *tapfreighter.SendLimit) *taprpc.SendLimit {
+	return &taprpc.SendLimit{
+		ScriptKey: limit.ScriptKey.SerializeCompressed(),
+		AssetId:   fn.CopySlice(limit.AssetID[:]),
+		MaxAmount: limit.MaxAmount,
+	}
+}
+
+// marshalSendTotal converts a cumulative send total to its RPC representation.
+func marshalSendTotal(total *tapfreighter.SendTotal) *taprpc.SendTotal {
+	rpcTotal := &taprpc.SendTotal{
+		ScriptKey:    total.ScriptKey.SerializeCompressed(),
+		AssetId:      fn.CopySlice(total.AssetID[:]),
+		TotalAmount:  total.TotalAmount,
+		NumSends:     total.NumSends,
+		LastSendTime: total.LastSendTime.Unix(),
+	}
+	total.Limit.WhenSome(func(maxAmount uint64) {
+		rpcTotal.HasLimit = true
+		rpcTotal.MaxAmount = maxAmount
+	})
+
+	return rpcTotal
+}
@@ -0,0 +1,90 @@
+package taprootassets
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"golang.org/x/exp/maps"
+)
+
+// DiffAssetSnapshots lists the local asset UTXOs that were created and spent
+// between two block heights, together with the balance changes per asset.
+func (r *rpcServer) DiffAssetSnapshots(ctx context.Context,
+	req *taprpc.SnapshotDiffRequest) (*taprpc.SnapshotDiffResponse,
+	error) {
+
+	params := tapfreighter.SnapshotDiffParams{
+		FromHeight: req.StartHeight,
+		ToHeight:   req.EndHeight,
+	}
+	if params.ToHeight == 0 {
+		_, bestHeight, err := r.cfg.Lnd.ChainKit.GetBestBlock(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch best block: %w",
+				err)
+		}
+
+		params.ToHeight = uint32(bestHeight)
+	}
+
+	diff, err := tapfreighter.DiffSnapshots(
+		ctx, r.cfg.AssetStore, r.cfg.AddrBook,
+		NewLndRpcWalletAnchor(r.cfg.Lnd), params,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to diff snapshots: %w", err)
+	}
+
+	return marshalSnapshotDiff(diff), nil
+}
+
+// marshalSnapshotChange converts a created or spent UTXO of a snapshot diff to
+// its RPC representation.
+func marshalSnapshotChange(
+	change tapfreighter.SnapshotChange) *taprpc.SnapshotChange {
+
+	return &taprpc.SnapshotChange{
+		AssetId:        fn.CopySlice(change.AssetID[:]),
+		ScriptKey:      fn.CopySlice(change.ScriptKey[:]),
+		Amount:         change.Amount,
+		AnchorOutpoint: change.AnchorPoint.String(),
+		BlockHeight:    change.Height,
+	}
+}
+
+// marshalSnapshotDiff converts a snapshot diff to its RPC representation. The
+// deltas are sorted by asset ID to make the response deterministic.
+func marshalSnapshotDiff(
+	diff *tapfreighter.SnapshotDiff) *taprpc.SnapshotDiffResponse {
+
+	resp := &taprpc.SnapshotDiffResponse{
+		StartHeight: diff.Params.FromHeight,
+		EndHeight:   diff.Params.ToHeight,
+		Created:     fn.Map(diff.Created, marshalSnapshotChange),
+		Spent:       fn.Map(diff.Spent, marshalSnapshotChange),
+		Deltas: make(
+			[]*taprpc.SnapshotDelta, 0, len(diff.Deltas),
+		),
+	}
+
+	assetIDs := maps.Keys(diff.Deltas)
+	sort.Slice(assetIDs, func(i, j int) bool {
+		return bytes.Compare(assetIDs[i][:], assetIDs[j][:]) < 0
+	})
+	for _, assetID := range assetIDs {
+		delta := diff.Deltas[assetID]
+		resp.Deltas = append(resp.Deltas, &taprpc.SnapshotDelta{
+			AssetId: fn.CopySlice(assetID[:]),
+			Created: delta.Created,
+			Spent:   delta.Spent,
+			Net:     delta.Net(),
+		})
+	}
+
+	return resp
+}
@@ -0,0 +1,122 @@
+package taprootassets
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"golang.org/x/exp/maps"
+)
+
+// GenerateStatement lists all transfers between the local node and a
+// counterparty over a period, together with the totals per asset.
+func (r *rpcServer) GenerateStatement(ctx context.Context,
+	req *taprpc.StatementRequest) (*taprpc.StatementResponse, error) {
+
+	if len(req.ScriptKey) != btcec.PubKeyBytesLenCompressed {
+		return nil, fmt.Errorf("script key must be %d bytes",
+			btcec.PubKeyBytesLenCompressed)
+	}
+	scriptKey, err := btcec.ParsePubKey(req.ScriptKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid script key: %w", err)
+	}
+
+	if req.StartTimestamp < 0 || req.EndTimestamp < 0 {
+		return nil, fmt.Errorf("timestamps must be non-negative")
+	}
+	if req.EndTimestamp != 0 && req.EndTimestamp < req.StartTimestamp {
+		return nil, fmt.Errorf("end timestamp must not be before " +
+			"start timestamp")
+	}
+
+	params := tapfreighter.StatementParams{
+		ScriptKey: *scriptKey,
+		Addrs:     make([]*address.Tap, 0, len(req.Addrs)),
+	}
+	if req.StartTimestamp != 0 {
+		params.StartTime = fn.Some(time.Unix(req.StartTimestamp, 0))
+	}
+	if req.EndTimestamp != 0 {
+		params.EndTime = fn.Some(time.Unix(req.EndTimestamp, 0))
+	}
+
+	for _, addrStr := range req.Addrs {
+		addr, err := r.decodeKnownAddr(ctx, addrStr)
+		if err != nil {
+			return nil, err
+		}
+
+		params.Addrs = append(params.Addrs, addr)
+	}
+
+	statement, err := tapfreighter.GenerateStatement(
+		ctx, r.cfg.AssetStore, r.cfg.AddrBook, params,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate statement: %w", err)
+	}
+
+	return marshalStatement(statement), nil
+}
+
+// marshalStatementDirection converts the direction of a statement entry to its
+// RPC counterpart.
+func marshalStatementDirection(
+	direction tapfreighter.StatementDirection) taprpc.StatementDirection {
+
+	if direction == tapfreighter.StatementInbound {
+		return taprpc.StatementDirection_STATEMENT_DIRECTION_INBOUND
+	}
+
+	return taprpc.StatementDirection_STATEMENT_DIRECTION_OUTBOUND
+}
+
+// marshalStatement converts a statement to its RPC representation. The totals
+// are sorted by asset ID to make the response deterministic.
+func marshalStatement(
+	statement *tapfreighter.Statement) *taprpc.StatementResponse {
+
+	resp := &taprpc.StatementResponse{
+		Entries: make(
+			[]*taprpc.StatementEntry, 0, len(statement.Entries),
+		),
+		Totals: make(
+			[]*taprpc.StatementTotals, 0, len(statement.Totals),
+		),
+	}
+
+	for _, entry := range statement.Entries {
+		direction := marshalStatementDirection(entry.Direction)
+		resp.Entries = append(resp.Entries, &taprpc.StatementEntry{
+			Direction:      direction,
+			Timestamp:      entry.Time.Unix(),
+			AssetId:        fn.CopySlice(entry.AssetID[:]),
+			Amount:         entry.Amount,
+			AnchorOutpoint: entry.AnchorPoint.String(),
+			HasProof:       entry.HasProof,
+		})
+	}
+
+	assetIDs := maps.Keys(statement.Totals)
+	sort.Slice(assetIDs, func(i, j int) bool {
+		return bytes.Compare(assetIDs[i][:], assetIDs[j][:]) < 0
+	})
+	for _, assetID := range assetIDs {
+		totals := statement.Totals[assetID]
+		resp.Totals = append(resp.Totals, &taprpc.StatementTotals{
+			AssetId:  fn.CopySlice(assetID[:]),
+			Sent:     totals.Sent,
+			Received: totals.Received,
+		})
+	}
+
+	return resp
+}
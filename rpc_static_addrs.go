@@ -0,0 +1,202 @@
+package taprootassets
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+)
+
+// NewStaticAddr creates a new reusable static address for an asset. The chain
+// is scanned for payments to the static address from the current block on.
+func (r *rpcServer) NewStaticAddr(ctx context.Context,
+	req *taprpc.NewStaticAddrRequest) (*taprpc.StaticAddr, error) {
+
+	courierAddr := r.defaultCourierAddr.Load()
+	if req.ProofCourierAddr != "" {
+		var err error
+		courierAddr, err = proof.ParseCourierAddress(
+			req.ProofCourierAddr,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proof courier "+
+				"address: %w", err)
+		}
+	}
+
+	if courierAddr == nil {
+		return nil, fmt.Errorf("no proof courier address provided")
+	}
+
+	if len(req.AssetId) != 32 {
+		return nil, fmt.Errorf("invalid asset id length")
+	}
+
+	var assetID asset.ID
+	copy(assetID[:], req.AssetId)
+
+	assetVersion, err := taprpc.UnmarshalAssetVersion(req.AssetVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	birthHeight, err := r.cfg.ChainBridge.CurrentHeight(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch current height: %w",
+			err)
+	}
+
+	rpcsLog.Infof("[NewStaticAddr]: making new static addr: asset_id=%x, "+
+		"birth_height=%d", assetID[:], birthHeight)
+
+	staticAddr, err := r.cfg.AddrBook.NewStaticAddr(
+		ctx, assetID, assetVersion, *courierAddr, birthHeight,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to make new static addr: %w",
+			err)
+	}
+
+	return marshalStaticAddr(*staticAddr)
+}
+
+// ListStaticAddrs lists all static addresses of the daemon.
+func (r *rpcServer) ListStaticAddrs(ctx context.Context,
+	_ *taprpc.ListStaticAddrsRequest) (*taprpc.ListStaticAddrsResponse,
+	error) {
+
+	staticAddrs, err := r.cfg.AddrBook.ListStaticAddrs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list static addrs: %w", err)
+	}
+
+	rpcAddrs, err := fn.MapErr(staticAddrs, marshalStaticAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &taprpc.ListStaticAddrsResponse{
+		Addrs: rpcAddrs,
+	}, nil
+}
+
+// ImportStaticPaymentTx inspects an unconfirmed transaction for payments to
+// the static addresses of the daemon.
+func (r *rpcServer) ImportStaticPaymentTx(_ context.Context,
+	req *taprpc.ImportStaticPaymentTxRequest) (
+	*taprpc.ImportStaticPaymentTxResponse, error) {
+
+	var tx wire.MsgTx
+	if err := tx.Deserialize(bytes.NewReader(req.RawTx)); err != nil {
+		return nil, fmt.Errorf("unable to decode transaction: %w", err)
+	}
+
+	rpcsLog.Debugf("[ImportStaticPaymentTx]: inspecting tx %v",
+		tx.TxHash())
+
+	err := r.cfg.AssetCustodian.InspectStaticPaymentTx(&tx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to inspect transaction: %w",
+			err)
+	}
+
+	return &taprpc.ImportStaticPaymentTxResponse{}, nil
+}
+
+// SendStaticPayment sends assets to a reusable static address. The payment is
+// made to a new single-use address derived from the static address, and the
+// anchor transaction carries the announcement the receiver finds it with.
+func (r *rpcServer) SendStaticPayment(ctx context.Context,
+	req *taprpc.SendStaticPaymentRequest) (
+	*taprpc.SendStaticPaymentResponse, error) {
+
+	if req.Amt == 0 {
+		return nil, fmt.Errorf("amount must be greater than zero")
+	}
+
+	staticAddr, err := address.DecodeStaticAddress(
+		req.StaticAddr, &r.cfg.ChainParams,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid static addr: %w", err)
+	}
+
+	feeRate, err := checkFeeRateSanity(req.FeeRate)
+	if err != nil {
+		return nil, err
+	}
+
+	payment, err := r.cfg.AddrBook.NewStaticPayment(
+		ctx, staticAddr, req.Amt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create static payment: %w",
+			err)
+	}
+
+	paymentAddr, err := payment.Addr.EncodeAddress()
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode payment addr: %w",
+			err)
+	}
+
+	rpcsLog.Infof("[SendStaticPayment]: paying static addr %v with "+
+		"payment addr %v", staticAddr, paymentAddr)
+
+	parcel, err := tapfreighter.NewStaticAddressParcel(
+		feeRate, fn.None[tapfreighter.MultiCommitmentSelectStrategy](),
+		payment,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.cfg.ChainPorter.RequestShipment(parcel)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, err := marshalOutboundParcel(resp)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
+			err)
+	}
+
+	return &taprpc.SendStaticPaymentResponse{
+		Transfer:    transfer,
+		PaymentAddr: paymentAddr,
+	}, nil
+}
+
+// marshalStaticAddr converts a static address to its RPC representation.
+func marshalStaticAddr(
+	staticAddr address.StaticAddrWithKeyInfo) (*taprpc.StaticAddr, error) {
+
+	encoded, err := staticAddr.EncodeAddress()
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode static addr: %w", err)
+	}
+
+	assetVersion, err := taprpc.MarshalAssetVersion(
+		staticAddr.AssetVersion,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &taprpc.StaticAddr{
+		Encoded:          encoded,
+		AssetId:          fn.CopySlice(staticAddr.AssetID[:]),
+		AssetVersion:     assetVersion,
+		ProofCourierAddr: staticAddr.ProofCourierAddr.String(),
+		CreationTimeUnix: staticAddr.CreationTime.Unix(),
+		ScanHeight:       staticAddr.ScanHeight,
+	}, nil
+}
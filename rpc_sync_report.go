@@ -0,0 +1,72 @@
+package taprootassets
+
+import (
+	"errors"
+
+	"github.com/lightninglabs/taproot-assets/taprpc/universerpc"
+	"github.com/lightninglabs/taproot-assets/universe"
+)
+
+// marshalSyncTotals converts the given sync totals to their RPC
+// representation.
+func marshalSyncTotals(totals universe.SyncTotals) *universerpc.SyncTotals {
+	return &universerpc.SyncTotals{
+		Inserted:           totals.Inserted,
+		SkippedDuplicate:   totals.SkippedDuplicate,
+		FailedVerification: totals.FailedVerification,
+	}
+}
+
+// marshalLeafSyncStatus converts the given leaf sync status to its RPC
+// representation.
+func marshalLeafSyncStatus(
+	status universe.LeafSyncStatus) universerpc.LeafSyncStatus {
+
+	switch status {
+	case universe.LeafSyncInserted:
+		return universerpc.LeafSyncStatus_LEAF_SYNC_STATUS_INSERTED
+
+	case universe.LeafSyncSkippedDuplicate:
+		return universerpc.
+			LeafSyncStatus_LEAF_SYNC_STATUS_SKIPPED_DUPLICATE
+
+	case universe.LeafSyncFailedVerification:
+		return universerpc.
+			LeafSyncStatus_LEAF_SYNC_STATUS_FAILED_VERIFICATION
+
+	default:
+		return universerpc.LeafSyncStatus_LEAF_SYNC_STATUS_UNKNOWN
+	}
+}
+
+// marshalLeafSyncFailure converts the error a leaf failed verification with to
+// the RPC failure class.
+func marshalLeafSyncFailure(err error) universerpc.LeafSyncFailure {
+	switch {
+	case errors.Is(err, universe.ErrLeafNotInRoot):
+		return universerpc.LeafSyncFailure_LEAF_SYNC_FAILURE_NOT_IN_ROOT
+
+	case errors.Is(err, universe.ErrLeafRejected):
+		return universerpc.LeafSyncFailure_LEAF_SYNC_FAILURE_REJECTED
+
+	default:
+		return universerpc.LeafSyncFailure_LEAF_SYNC_FAILURE_UNKNOWN
+	}
+}
+
+// marshalLeafSyncOutcome converts the outcome of the sync of a single leaf to
+// its RPC representation.
+func marshalLeafSyncOutcome(
+	outcome universe.LeafSyncOutcome) *universerpc.LeafSyncOutcome {
+
+	rpcOutcome := &universerpc.LeafSyncOutcome{
+		LeafKey: marshalLeafKey(outcome.Key),
+		Status:  marshalLeafSyncStatus(outcome.Status),
+	}
+	if outcome.Err != nil {
+		rpcOutcome.Failure = marshalLeafSyncFailure(outcome.Err)
+		rpcOutcome.Error = outcome.Err.Error()
+	}
+
+	return rpcOutcome
+}
@@ -0,0 +1,99 @@
+package taprootassets
+
+import (
+	"fmt"
+	"time"
+
+	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
+	"github.com/lightninglabs/taproot-assets/universe"
+)
+
+// marshalSyncSchedules turns the given federation sync schedules into their
+// RPC counterparts.
+func marshalSyncSchedules(schedules *universe.SyncSchedules) (
+	[]*unirpc.FederationSyncSchedule, error) {
+
+	rpcSchedules := make(
+		[]*unirpc.FederationSyncSchedule, 0,
+		len(schedules.Universes)+len(schedules.Servers),
+	)
+	for _, schedule := range schedules.Universes {
+		uniID, err := MarshalUniID(schedule.UniverseID)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcSchedule := &unirpc.FederationSyncSchedule{
+			Target: &unirpc.FederationSyncSchedule_UniverseId{
+				UniverseId: uniID,
+			},
+			SyncIntervalSeconds: uint64(
+				schedule.SyncInterval / time.Second,
+			),
+		}
+		rpcSchedules = append(rpcSchedules, rpcSchedule)
+	}
+
+	for _, schedule := range schedules.Servers {
+		rpcSchedule := &unirpc.FederationSyncSchedule{
+			Target: &unirpc.FederationSyncSchedule_ServerHost{
+				ServerHost: schedule.ServerHost,
+			},
+			SyncIntervalSeconds: uint64(
+				schedule.SyncInterval / time.Second,
+			),
+		}
+		rpcSchedules = append(rpcSchedules, rpcSchedule)
+	}
+
+	return rpcSchedules, nil
+}
+
+// UnmarshalSyncSchedules parses and validates the given RPC federation sync
+// schedules.
+func UnmarshalSyncSchedules(
+	rpcSchedules []*unirpc.FederationSyncSchedule) (*universe.SyncSchedules,
+	error) {
+
+	var schedules universe.SyncSchedules
+	for _, rpcSchedule := range rpcSchedules {
+		interval := time.Duration(rpcSchedule.SyncIntervalSeconds) *
+			time.Second
+
+		switch target := rpcSchedule.Target.(type) {
+		case *unirpc.FederationSyncSchedule_UniverseId:
+			uniID, err := UnmarshalUniID(target.UniverseId)
+			if err != nil {
+				return nil, fmt.Errorf("invalid universe in "+
+					"sync schedule: %w", err)
+			}
+
+			schedules.Universes = append(
+				schedules.Universes,
+				&universe.FedUniSyncSchedule{
+					UniverseID:   uniID,
+					SyncInterval: interval,
+				},
+			)
+
+		case *unirpc.FederationSyncSchedule_ServerHost:
+			schedules.Servers = append(
+				schedules.Servers,
+				&universe.FedServerSyncSchedule{
+					ServerHost:   target.ServerHost,
+					SyncInterval: interval,
+				},
+			)
+
+		default:
+			return nil, fmt.Errorf("sync schedule must specify " +
+				"a universe or a server")
+		}
+	}
+
+	if err := schedules.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &schedules, nil
+}
@@ -0,0 +1,56 @@
+package taprootassets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+)
+
+// checkSendTicker makes sure all given addresses are for the asset the
+// caller-supplied ticker resolves to, if the caller supplied one. The resolved
+// asset ID or group key is returned, so it can be included in the response as
+// an explicit confirmation of the asset that is sent.
+func (r *rpcServer) checkSendTicker(ctx context.Context, ticker string,
+	tapAddrs []*address.Tap) (*taprpc.ResolvedTicker, error) {
+
+	if ticker == "" {
+		return nil, nil
+	}
+
+	if r.cfg.TickerResolver == nil {
+		return nil, fmt.Errorf("ticker resolution is not available")
+	}
+
+	resolved, err := r.cfg.TickerResolver.Resolve(ctx, ticker)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve ticker: %w", err)
+	}
+
+	for idx, addr := range tapAddrs {
+		if resolved.Matches(addr.AssetID, addr.GroupKey) {
+			continue
+		}
+
+		return nil, fmt.Errorf("%w: addr %d is for asset %v, but "+
+			"ticker %v resolved to %v",
+			tapfreighter.ErrTickerMismatch, idx, addr.AssetID,
+			ticker, resolved)
+	}
+
+	rpcTicker := &taprpc.ResolvedTicker{
+		Ticker: resolved.Ticker,
+		Source: resolved.Source.String(),
+	}
+	if resolved.GroupKey != nil {
+		rpcTicker.GroupKey = schnorr.SerializePubKey(resolved.GroupKey)
+	} else {
+		rpcTicker.AssetId = fn.ByteSlice(resolved.AssetID)
+	}
+
+	return rpcTicker, nil
+}
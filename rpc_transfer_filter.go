@@ -0,0 +1,129 @@
+package taprootassets
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+)
+
+const (
+	// defaultTransferPageSize is the number of transfers in a page if the
+	// caller doesn't specify a limit.
+	defaultTransferPageSize = 100
+
+	// maxTransferPageSize is the maximum number of transfers in a page.
+	maxTransferPageSize = 1000
+)
+
+// unmarshalTransferFilter turns the given RPC transfer filter into a parcel
+// query.
+func (r *rpcServer) unmarshalTransferFilter(
+	filter *taprpc.TransferFilter) (*tapfreighter.ParcelQuery, error) {
+
+	query := &tapfreighter.ParcelQuery{
+		Limit:      defaultTransferPageSize,
+		Descending: filter.Descending,
+	}
+
+	if len(filter.AssetId) != 0 {
+		var assetID asset.ID
+		if len(filter.AssetId) != len(assetID) {
+			return nil, fmt.Errorf("invalid asset ID length in " +
+				"transfer filter")
+		}
+
+		copy(assetID[:], filter.AssetId)
+		query.AssetID = fn.Some(assetID)
+	}
+
+	if len(filter.GroupKey) != 0 {
+		groupKey, err := btcec.ParsePubKey(filter.GroupKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid group key in transfer "+
+				"filter: %w", err)
+		}
+
+		query.GroupKey = fn.Some(*groupKey)
+	}
+
+	if filter.Addr != "" {
+		addr, err := address.DecodeAddress(
+			filter.Addr, &r.cfg.ChainParams,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address in transfer "+
+				"filter: %w", err)
+		}
+
+		query.ScriptKey = fn.Some(addr.ScriptKey)
+	}
+
+	switch filter.State {
+	case taprpc.TransferStateFilter_TRANSFER_STATE_FILTER_ANY:
+
+	case taprpc.TransferStateFilter_TRANSFER_STATE_FILTER_PENDING:
+		query.Confirmed = fn.Some(false)
+
+	case taprpc.TransferStateFilter_TRANSFER_STATE_FILTER_CONFIRMED:
+		query.Confirmed = fn.Some(true)
+
+	default:
+		return nil, fmt.Errorf("invalid state in transfer filter: %v",
+			filter.State)
+	}
+
+	if filter.StartTimeUnixSeconds < 0 || filter.EndTimeUnixSeconds < 0 {
+		return nil, fmt.Errorf("transfer filter times must be " +
+			"non-negative")
+	}
+	if filter.StartTimeUnixSeconds != 0 {
+		query.StartTime = fn.Some(
+			time.Unix(filter.StartTimeUnixSeconds, 0),
+		)
+	}
+	if filter.EndTimeUnixSeconds != 0 {
+		query.EndTime = fn.Some(time.Unix(filter.EndTimeUnixSeconds, 0))
+	}
+
+	switch {
+	case filter.Cursor < 0:
+		return nil, fmt.Errorf("invalid cursor in transfer filter: %d",
+			filter.Cursor)
+
+	case filter.Cursor > 0:
+		query.Cursor = fn.Some(filter.Cursor)
+	}
+
+	switch {
+	case filter.Limit < 0 || filter.Limit > maxTransferPageSize:
+		return nil, fmt.Errorf("invalid limit in transfer filter, "+
+			"must be between 1 and %d: %d", maxTransferPageSize,
+			filter.Limit)
+
+	case filter.Limit > 0:
+		query.Limit = filter.Limit
+	}
+
+	return query, nil
+}
+
+// queryTransferPage fetches a single page of the transfers that match the
+// given query.
+func (r *rpcServer) queryTransferPage(ctx context.Context,
+	query tapfreighter.ParcelQuery,
+	anchorTxHash *chainhash.Hash) (*tapfreighter.ParcelPage, error) {
+
+	if anchorTxHash != nil {
+		query.AnchorTxHash = fn.Some(*anchorTxHash)
+	}
+
+	return r.cfg.AssetStore.QueryParcelsPage(ctx, query)
+}
@@ -0,0 +1,149 @@
+package rpccompress
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// TestParseConnConfig tests parsing the compression config of outgoing
+// connections.
+func TestParseConnConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := ParseConnConfig("gzip, zstd", []string{
+		"universe.example.com:10029=none",
+		"other.example.com:10029=zstd",
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, Preferences{Gzip, Zstd}, cfg.Default)
+	require.Empty(t, cfg.ForHost("universe.example.com:10029"))
+	require.Equal(
+		t, Preferences{Zstd}, cfg.ForHost("other.example.com:10029"),
+	)
+	require.Equal(t, cfg.Default, cfg.ForHost("unknown.example.com:1"))
+	require.Equal(t, "gzip,zstd", cfg.Default.String())
+	require.Equal(
+		t, None, cfg.ForHost("universe.example.com:10029").String(),
+	)
+
+	_, err = ParseConnConfig("brotli", nil)
+	require.ErrorContains(t, err, "unknown compressor")
+
+	_, err = ParseConnConfig("zstd", []string{"zstd"})
+	require.ErrorContains(t, err, "invalid compression override")
+}
+
+// startServer starts a gRPC health server that negotiates compression with
+// the given preferences and returns a client connection to it that uses the
+// given client preferences.
+func startServer(t *testing.T, serverPrefs,
+	clientPrefs Preferences) healthpb.HealthClient {
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		UnaryServerInterceptor(serverPrefs, func(string) bool {
+			return true
+		}),
+	))
+	healthpb.RegisterHealthServer(server, health.NewServer())
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	opts := append([]grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(context.Context,
+			string) (net.Conn, error) {
+
+			return listener.Dial()
+		}),
+	}, DialOptions(clientPrefs)...)
+
+	conn, err := grpc.Dial("bufnet", opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, conn.Close())
+	})
+
+	return healthpb.NewHealthClient(conn)
+}
+
+// TestCompressionNegotiation tests that the compression of requests and
+// responses is negotiated between client and server.
+//
+// NOTE: This test isn't run in parallel, as it checks the global compressor
+// stats.
+func TestCompressionNegotiation(t *testing.T) {
+	ctx := context.Background()
+
+	// The client prefers gzip, so the server uses it for its responses,
+	// and once the server advertised its preferences, the client uses it
+	// for its requests as well.
+	client := startServer(t, DefaultPreferences(), Preferences{Gzip})
+
+	before := AllStats()
+	for i := 0; i < 2; i++ {
+		_, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+		require.NoError(t, err)
+	}
+	after := AllStats()
+
+	require.Greater(
+		t, after[Gzip].SentCompressed, before[Gzip].SentCompressed,
+	)
+	require.Greater(
+		t, after[Gzip].ReceivedCompressed,
+		before[Gzip].ReceivedCompressed,
+	)
+	require.Equal(t, before[Zstd], after[Zstd])
+
+	// If the client disables compression, nothing is compressed in either
+	// direction.
+	client = startServer(t, DefaultPreferences(), Preferences{})
+
+	before = AllStats()
+	for i := 0; i < 2; i++ {
+		_, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+		require.NoError(t, err)
+	}
+	require.Equal(t, before, AllStats())
+
+	// The same is true if the server disables compression.
+	client = startServer(t, Preferences{}, DefaultPreferences())
+
+	before = AllStats()
+	for i := 0; i < 2; i++ {
+		_, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+		require.NoError(t, err)
+	}
+	require.Equal(t, before, AllStats())
+
+	// With the default preferences on both sides, zstd is used.
+	client = startServer(t, DefaultPreferences(), DefaultPreferences())
+
+	before = AllStats()
+	for i := 0; i < 2; i++ {
+		_, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+		require.NoError(t, err)
+	}
+	after = AllStats()
+
+	require.Greater(
+		t, after[Zstd].SentUncompressed, before[Zstd].SentUncompressed,
+	)
+	require.Greater(
+		t, after[Zstd].ReceivedUncompressed,
+		before[Zstd].ReceivedUncompressed,
+	)
+}
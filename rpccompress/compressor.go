@@ -0,0 +1,320 @@
+package rpccompress
+
+import (
+	"io"
+	"sync"
+	"sync/atomic"
+
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/encoding/gzip"
+)
+
+const (
+	// Zstd is the name of the zstd gRPC compressor.
+	Zstd = "zstd"
+
+	// Gzip is the name of the gzip gRPC compressor.
+	Gzip = gzip.Name
+
+	// None is the name used in the config to disable compression.
+	None = "none"
+)
+
+// supportedCompressors is the list of compressors this package registers with
+// gRPC, in the order of preference.
+var supportedCompressors = []string{Zstd, Gzip}
+
+// byteCounters counts the bytes that passed through a compressor in one
+// direction, before and after compression.
+type byteCounters struct {
+	uncompressed atomic.Uint64
+	compressed   atomic.Uint64
+}
+
+// compressorStats holds the byte counters of a single compressor.
+type compressorStats struct {
+	// sent counts the bytes of the messages that were compressed before
+	// being sent.
+	sent byteCounters
+
+	// received counts the bytes of the messages that were decompressed
+	// after being received.
+	received byteCounters
+}
+
+// stats holds the byte counters of all registered compressors, keyed by the
+// compressor name.
+var stats = map[string]*compressorStats{
+	Zstd: {},
+	Gzip: {},
+}
+
+// Stats is a snapshot of the number of bytes a compressor processed.
+type Stats struct {
+	// SentUncompressed is the size of all sent messages before
+	// compression.
+	SentUncompressed uint64
+
+	// SentCompressed is the size of all sent messages after compression.
+	SentCompressed uint64
+
+	// ReceivedUncompressed is the size of all received messages after
+	// decompression.
+	ReceivedUncompressed uint64
+
+	// ReceivedCompressed is the size of all received messages before
+	// decompression.
+	ReceivedCompressed uint64
+}
+
+// SentRatio returns the compression ratio of all sent messages, which is the
+// uncompressed size divided by the compressed size. If nothing was sent, the
+// ratio is zero.
+func (s Stats) SentRatio() float64 {
+	if s.SentCompressed == 0 {
+		return 0
+	}
+
+	return float64(s.SentUncompressed) / float64(s.SentCompressed)
+}
+
+// ReceivedRatio returns the compression ratio of all received messages, which
+// is the uncompressed size divided by the compressed size. If nothing was
+// received, the ratio is zero.
+func (s Stats) ReceivedRatio() float64 {
+	if s.ReceivedCompressed == 0 {
+		return 0
+	}
+
+	return float64(s.ReceivedUncompressed) /
+		float64(s.ReceivedCompressed)
+}
+
+// AllStats returns a snapshot of the stats of all registered compressors, keyed
+// by the compressor name.
+func AllStats() map[string]Stats {
+	snapshot := make(map[string]Stats, len(stats))
+	for name, s := range stats {
+		snapshot[name] = Stats{
+			SentUncompressed:     s.sent.uncompressed.Load(),
+			SentCompressed:       s.sent.compressed.Load(),
+			ReceivedUncompressed: s.received.uncompressed.Load(),
+			ReceivedCompressed:   s.received.compressed.Load(),
+		}
+	}
+
+	return snapshot
+}
+
+func init() {
+	// The gzip package registered its compressor when it was imported, so
+	// we can wrap it to count the bytes that pass through it.
+	encoding.RegisterCompressor(&countingCompressor{
+		Compressor: encoding.GetCompressor(Gzip),
+		stats:      stats[Gzip],
+	})
+	encoding.RegisterCompressor(&countingCompressor{
+		Compressor: newZstdCompressor(),
+		stats:      stats[Zstd],
+	})
+}
+
+// countingWriter is an io.Writer that counts the bytes written to it.
+type countingWriter struct {
+	io.Writer
+	counter *atomic.Uint64
+}
+
+// Write writes the given bytes to the underlying writer and counts them.
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.Writer.Write(p)
+	c.counter.Add(uint64(n))
+
+	return n, err
+}
+
+// countingWriteCloser is an io.WriteCloser that counts the bytes written to
+// it.
+type countingWriteCloser struct {
+	countingWriter
+	closer io.Closer
+}
+
+// Close closes the underlying writer.
+func (c *countingWriteCloser) Close() error {
+	return c.closer.Close()
+}
+
+// countingReader is an io.Reader that counts the bytes read from it.
+type countingReader struct {
+	io.Reader
+	counter *atomic.Uint64
+}
+
+// Read reads from the underlying reader and counts the bytes read.
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.Reader.Read(p)
+	c.counter.Add(uint64(n))
+
+	return n, err
+}
+
+// countingCompressor wraps a gRPC compressor and counts the bytes that pass
+// through it, before and after compression.
+type countingCompressor struct {
+	encoding.Compressor
+	stats *compressorStats
+}
+
+// Compress returns a writer that compresses the bytes written to it into the
+// given writer.
+//
+// NOTE: This is part of the encoding.Compressor interface.
+func (c *countingCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	compressor, err := c.Compressor.Compress(&countingWriter{
+		Writer:  w,
+		counter: &c.stats.sent.compressed,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &countingWriteCloser{
+		countingWriter: countingWriter{
+			Writer:  compressor,
+			counter: &c.stats.sent.uncompressed,
+		},
+		closer: compressor,
+	}, nil
+}
+
+// Decompress returns a reader that decompresses the bytes read from the given
+// reader.
+//
+// NOTE: This is part of the encoding.Compressor interface.
+func (c *countingCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	decompressor, err := c.Compressor.Decompress(&countingReader{
+		Reader:  r,
+		counter: &c.stats.received.compressed,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &countingReader{
+		Reader:  decompressor,
+		counter: &c.stats.received.uncompressed,
+	}, nil
+}
+
+// zstdCompressor is a gRPC compressor that uses zstd. Encoders and decoders
+// are pooled, as they are expensive to create. gRPC doesn't ship a zstd codec,
+// so we use the one of klauspost/compress, which golang-migrate already pulls
+// into our module graph at the same version.
+type zstdCompressor struct {
+	encoders sync.Pool
+	decoders sync.Pool
+}
+
+// newZstdCompressor creates a new zstd gRPC compressor.
+func newZstdCompressor() *zstdCompressor {
+	return &zstdCompressor{}
+}
+
+// Name returns the name of the compressor.
+//
+// NOTE: This is part of the encoding.Compressor interface.
+func (z *zstdCompressor) Name() string {
+	return Zstd
+}
+
+// zstdWriter is a zstd encoder that is returned to the pool once closed.
+type zstdWriter struct {
+	*zstd.Encoder
+	pool *sync.Pool
+}
+
+// Close flushes the compressed data and returns the encoder to the pool.
+func (z *zstdWriter) Close() error {
+	defer z.pool.Put(z.Encoder)
+
+	return z.Encoder.Close()
+}
+
+// Compress returns a writer that compresses the bytes written to it into the
+// given writer.
+//
+// NOTE: This is part of the encoding.Compressor interface.
+func (z *zstdCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	encoder, ok := z.encoders.Get().(*zstd.Encoder)
+	if !ok {
+		var err error
+		encoder, err = zstd.NewWriter(
+			w, zstd.WithEncoderConcurrency(1),
+		)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		encoder.Reset(w)
+	}
+
+	return &zstdWriter{
+		Encoder: encoder,
+		pool:    &z.encoders,
+	}, nil
+}
+
+// zstdReader is a zstd decoder that is returned to the pool once the whole
+// message was read.
+type zstdReader struct {
+	decoder *zstd.Decoder
+	pool    *sync.Pool
+}
+
+// Read decompresses bytes from the underlying reader. Once the end of the
+// message is reached, the decoder is returned to the pool.
+func (z *zstdReader) Read(p []byte) (int, error) {
+	if z.decoder == nil {
+		return 0, io.EOF
+	}
+
+	n, err := z.decoder.Read(p)
+	if err == io.EOF {
+		// We release the reference to the underlying reader before
+		// returning the decoder to the pool.
+		_ = z.decoder.Reset(nil)
+		z.pool.Put(z.decoder)
+		z.decoder = nil
+	}
+
+	return n, err
+}
+
+// Decompress returns a reader that decompresses the bytes read from the given
+// reader.
+//
+// NOTE: This is part of the encoding.Compressor interface.
+func (z *zstdCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	decoder, ok := z.decoders.Get().(*zstd.Decoder)
+	if !ok {
+		// With a concurrency of one, the decoder decodes synchronously
+		// and doesn't start any goroutines, so it's safe to just drop
+		// it if a message is never read to the end.
+		var err error
+		decoder, err = zstd.NewReader(
+			r, zstd.WithDecoderConcurrency(1),
+		)
+		if err != nil {
+			return nil, err
+		}
+	} else if err := decoder.Reset(r); err != nil {
+		return nil, err
+	}
+
+	return &zstdReader{
+		decoder: decoder,
+		pool:    &z.decoders,
+	}, nil
+}
@@ -0,0 +1,282 @@
+package rpccompress
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// AcceptHeader is the gRPC header used to advertise the compressors a
+	// peer accepts, in the order of preference. A client sends it to tell
+	// the server which compressors it wants responses to be compressed
+	// with, a server sends it to tell the client which compressors it can
+	// decompress requests with.
+	AcceptHeader = "tap-accept-compression"
+)
+
+// Preferences is an ordered list of the compressors that may be used on a
+// connection, most preferred first. An empty list disables compression.
+type Preferences []string
+
+// DefaultPreferences returns the default compression preferences, which
+// prefer zstd over gzip.
+func DefaultPreferences() Preferences {
+	return slices.Clone(supportedCompressors)
+}
+
+// ParsePreferences parses a comma-separated list of compressors, or "none" to
+// disable compression.
+func ParsePreferences(s string) (Preferences, error) {
+	s = strings.TrimSpace(s)
+	if s == None || s == "" {
+		return Preferences{}, nil
+	}
+
+	var prefs Preferences
+	for _, name := range strings.Split(s, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !slices.Contains(supportedCompressors, name) {
+			return nil, fmt.Errorf("unknown compressor %q, must "+
+				"be one of %v or %v", name,
+				supportedCompressors, None)
+		}
+
+		if !slices.Contains(prefs, name) {
+			prefs = append(prefs, name)
+		}
+	}
+
+	return prefs, nil
+}
+
+// String returns the config representation of the preferences.
+func (p Preferences) String() string {
+	if len(p) == 0 {
+		return None
+	}
+
+	return strings.Join(p, ",")
+}
+
+// choose returns the most preferred compressor that is also contained in the
+// given list of compressors the peer supports.
+func (p Preferences) choose(peerSupported []string) (string, bool) {
+	for _, name := range p {
+		if slices.Contains(peerSupported, name) {
+			return name, true
+		}
+	}
+
+	return "", false
+}
+
+// ConnConfig is the compression configuration for outgoing connections. It
+// allows the compression of individual connections to be configured
+// differently than the default.
+type ConnConfig struct {
+	// Default is the compression preference for all connections that
+	// don't have an override.
+	Default Preferences
+
+	// Overrides is the compression preference for individual
+	// connections, keyed by the target host.
+	Overrides map[string]Preferences
+}
+
+// ParseConnConfig parses the compression configuration for outgoing
+// connections from the default preferences and a list of per-connection
+// overrides in the format <host>=<preferences>.
+func ParseConnConfig(defaultPrefs string,
+	overrides []string) (*ConnConfig, error) {
+
+	prefs, err := ParsePreferences(defaultPrefs)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &ConnConfig{
+		Default:   prefs,
+		Overrides: make(map[string]Preferences, len(overrides)),
+	}
+	for _, override := range overrides {
+		host, hostPrefs, ok := strings.Cut(override, "=")
+		if !ok || host == "" {
+			return nil, fmt.Errorf("invalid compression override "+
+				"%q, expected format <host>=<compressors>",
+				override)
+		}
+
+		cfg.Overrides[host], err = ParsePreferences(hostPrefs)
+		if err != nil {
+			return nil, fmt.Errorf("invalid compression override "+
+				"for %v: %w", host, err)
+		}
+	}
+
+	return cfg, nil
+}
+
+// ForHost returns the compression preference for a connection to the given
+// host.
+func (c *ConnConfig) ForHost(host string) Preferences {
+	if c == nil {
+		return DefaultPreferences()
+	}
+
+	if prefs, ok := c.Overrides[host]; ok {
+		return prefs
+	}
+
+	return c.Default
+}
+
+// parseAcceptHeader returns the compressors listed in the accept header of
+// the given metadata, and whether the header was present.
+func parseAcceptHeader(md metadata.MD) ([]string, bool) {
+	values := md.Get(AcceptHeader)
+	if len(values) == 0 {
+		return nil, false
+	}
+
+	var names []string
+	for _, name := range strings.Split(values[0], ",") {
+		name = strings.TrimSpace(name)
+		if name != "" && name != None {
+			names = append(names, name)
+		}
+	}
+
+	return names, true
+}
+
+// UnaryServerInterceptor returns a server interceptor that negotiates the
+// compression of the responses of all methods the given filter matches. The
+// response is compressed with the most preferred compressor the client
+// supports. If the client advertised its own preferences, only those
+// compressors are considered. The compressors the server accepts for requests
+// are advertised to the client in the response header.
+func UnaryServerInterceptor(prefs Preferences,
+	filter func(fullMethod string) bool) grpc.UnaryServerInterceptor {
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (any, error) {
+
+		if len(prefs) == 0 || !filter(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		// Compression is best effort, so we never fail the call if it
+		// can't be negotiated.
+		err := grpc.SetHeader(
+			ctx, metadata.Pairs(AcceptHeader, prefs.String()),
+		)
+		if err != nil {
+			return handler(ctx, req)
+		}
+
+		supported, err := grpc.ClientSupportedCompressors(ctx)
+		if err != nil {
+			return handler(ctx, req)
+		}
+
+		// Clients that advertise their own preferences may restrict
+		// the compressors further, for example to disable compression
+		// for a connection that is already compressed.
+		md, _ := metadata.FromIncomingContext(ctx)
+		if accepted, ok := parseAcceptHeader(md); ok {
+			notAccepted := func(name string) bool {
+				return !slices.Contains(accepted, name)
+			}
+			supported = slices.DeleteFunc(supported, notAccepted)
+		}
+
+		if name, ok := prefs.choose(supported); ok {
+			_ = grpc.SetSendCompressor(ctx, name)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// clientNegotiator negotiates the compression of the requests of a single
+// client connection. Requests are only compressed once the server advertised
+// a compressor it accepts, as servers that don't support it would reject the
+// request otherwise.
+type clientNegotiator struct {
+	prefs Preferences
+
+	// compressor is the compressor requests are compressed with, or an
+	// empty string if the server hasn't advertised a compressor we
+	// support yet.
+	compressor string
+
+	mtx sync.RWMutex
+}
+
+// requestCompressor returns the compressor requests should be compressed
+// with, if any.
+func (c *clientNegotiator) requestCompressor() (string, bool) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	return c.compressor, c.compressor != ""
+}
+
+// handleHeader updates the request compressor from the compressors the server
+// advertised in the given response header.
+func (c *clientNegotiator) handleHeader(header metadata.MD) {
+	accepted, ok := parseAcceptHeader(header)
+	if !ok {
+		return
+	}
+
+	name, _ := c.prefs.choose(accepted)
+
+	c.mtx.Lock()
+	c.compressor = name
+	c.mtx.Unlock()
+}
+
+// unaryInterceptor returns a client interceptor that advertises our
+// compression preferences to the server and compresses requests with the
+// negotiated compressor.
+func (c *clientNegotiator) unaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any,
+		cc *grpc.ClientConn, invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption) error {
+
+		ctx = metadata.AppendToOutgoingContext(
+			ctx, AcceptHeader, c.prefs.String(),
+		)
+
+		if name, ok := c.requestCompressor(); ok {
+			opts = append(opts, grpc.UseCompressor(name))
+		}
+
+		var header metadata.MD
+		opts = append(opts, grpc.Header(&header))
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		c.handleHeader(header)
+
+		return err
+	}
+}
+
+// DialOptions returns the dial options that negotiate the compression of a
+// new client connection with the given preferences.
+func DialOptions(prefs Preferences) []grpc.DialOption {
+	negotiator := &clientNegotiator{
+		prefs: prefs,
+	}
+
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(negotiator.unaryInterceptor()),
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"sort"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -180,7 +182,17 @@ type rpcServer struct {
 
 	cfg *Config
 
-	proofQueryRateLimiter *rate.Limiter
+	universeRateLimiter *universeRateLimiter
+
+	// defaultCourierAddr is the proof courier address used for new
+	// addresses that don't specify one. It starts out as the address of
+	// the config, but can be changed at runtime.
+	defaultCourierAddr atomic.Pointer[url.URL]
+
+	// idempotencyInFlight is the set of idempotency keys of calls that are
+	// currently being processed.
+	idempotencyInFlight map[string]struct{}
+	idempotencyMtx      sync.Mutex
 
 	quit chan struct{}
 	wg   sync.WaitGroup
@@ -191,15 +203,19 @@ func newRPCServer(interceptor signal.Interceptor,
 	interceptorChain *rpcperms.InterceptorChain,
 	cfg *Config) (*rpcServer, error) {
 
-	return &rpcServer{
+	r := &rpcServer{
 		interceptor:      interceptor,
 		interceptorChain: interceptorChain,
 		quit:             make(chan struct{}),
-		proofQueryRateLimiter: rate.NewLimiter(
-			cfg.UniverseQueriesPerSecond, cfg.UniverseQueriesBurst,
+		universeRateLimiter: newUniverseRateLimiter(
+			cfg, interceptorChain,
 		),
-		cfg: cfg,
-	}, nil
+		idempotencyInFlight: make(map[string]struct{}),
+		cfg:                 cfg,
+	}
+	r.defaultCourierAddr.Store(cfg.DefaultProofCourierAddr)
+
+	return r, nil
 }
 
 // TODO(roasbeef): build in batching for asset creation?
@@ -417,10 +433,31 @@ func (r *rpcServer) GetInfo(ctx context.Context,
 }
 
 // MintAsset attempts to mint the set of assets (async by default to ensure
-// proper batching) specified in the request.
+// proper batching) specified in the request. If the caller supplies an
+// idempotency key, a retried call with the same key returns the original
+// result instead of adding the asset to the batch again.
 func (r *rpcServer) MintAsset(ctx context.Context,
 	req *mintrpc.MintAssetRequest) (*mintrpc.MintAssetResponse, error) {
 
+	return withIdempotency(
+		ctx, r, mintAssetMethod, req.IdempotencyKey, req,
+		func() *mintrpc.MintAssetResponse {
+			return &mintrpc.MintAssetResponse{}
+		},
+		func(resp *mintrpc.MintAssetResponse) {
+			resp.Replayed = true
+		},
+		func() (*mintrpc.MintAssetResponse, error) {
+			return r.mintAsset(ctx, req)
+		},
+	)
+}
+
+// mintAsset adds the asset specified in the request to the pending minting
+// batch.
+func (r *rpcServer) mintAsset(ctx context.Context,
+	req *mintrpc.MintAssetRequest) (*mintrpc.MintAssetResponse, error) {
+
 	if req.Asset == nil {
 		return nil, fmt.Errorf("asset cannot be nil")
 	}
@@ -587,6 +624,13 @@ func (r *rpcServer) MintAsset(ctx context.Context,
 		groupTapscriptRoot = bytes.Clone(req.Asset.GroupTapscriptRoot)
 	}
 
+	siblingTapTree, err := taprpc.UnmarshalTapscriptSibling(
+		req.Asset.GetSiblingFullTree(), req.Asset.GetSiblingBranch(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	seedling := &tapgarden.Seedling{
 		AssetVersion:   assetVersion,
 		AssetType:      asset.Type(req.Asset.AssetType),
@@ -594,6 +638,7 @@ func (r *rpcServer) MintAsset(ctx context.Context,
 		Amount:         req.Asset.Amount,
 		EnableEmission: req.Asset.NewGroupedAsset,
 		Meta:           seedlingMeta,
+		SiblingTapTree: siblingTapTree,
 	}
 
 	rpcsLog.Infof("[MintAsset]: version=%v, type=%v, name=%v, amt=%v, "+
@@ -707,10 +752,23 @@ func (r *rpcServer) FundBatch(_ context.Context,
 		return nil, err
 	}
 
+	var externalPsbt fn.Option[*psbt.Packet]
+	if len(req.ExternalPsbt) != 0 {
+		pkt, err := psbt.NewFromRawBytes(
+			bytes.NewReader(req.ExternalPsbt), false,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding external PSBT: "+
+				"%w", err)
+		}
+		externalPsbt = fn.Some(pkt)
+	}
+
 	batch, err := r.cfg.AssetMinter.FundBatch(
 		tapgarden.FundParams{
 			FeeRate:        feeRateOpt,
 			SiblingTapTree: tapTreeOpt,
+			ExternalPsbt:   externalPsbt,
 		},
 	)
 	if err != nil {
@@ -809,6 +867,172 @@ func (r *rpcServer) FinalizeBatch(_ context.Context,
 	}, nil
 }
 
+// SubmitGenesisSigs submits the externally signed genesis transaction of a
+// finalized, externally funded batch, which is then broadcast.
+func (r *rpcServer) SubmitGenesisSigs(_ context.Context,
+	req *mintrpc.SubmitGenesisSigsRequest) (
+	*mintrpc.SubmitGenesisSigsResponse, error) {
+
+	batchKey, err := btcec.ParsePubKey(req.BatchKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid batch key: %w", err)
+	}
+
+	signedPkt, err := psbt.NewFromRawBytes(
+		bytes.NewReader(req.SignedPsbt), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding signed PSBT: %w", err)
+	}
+
+	batch, err := r.cfg.AssetMinter.SubmitGenesisSigs(
+		tapgarden.ExternalSigParams{
+			BatchKey:   batchKey,
+			SignedPsbt: signedPkt,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to submit genesis signatures: "+
+			"%w", err)
+	}
+
+	rpcBatch, err := marshalMintingBatch(batch, req.ShortResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mintrpc.SubmitGenesisSigsResponse{
+		Batch: rpcBatch,
+	}, nil
+}
+
+// PreviewBatch returns a dry-run of the finalization of the current pending
+// batch, without broadcasting or persisting anything.
+func (r *rpcServer) PreviewBatch(_ context.Context,
+	req *mintrpc.PreviewBatchRequest) (*mintrpc.PreviewBatchResponse,
+	error) {
+
+	feeRate, err := checkFeeRateSanity(req.FeeRate)
+	if err != nil {
+		return nil, err
+	}
+
+	preview, err := r.cfg.AssetMinter.PreviewBatch(
+		tapgarden.PreviewParams{
+			FeeRate: fn.MaybeSome(feeRate),
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to preview batch: %w", err)
+	}
+
+	resp := &mintrpc.PreviewBatchResponse{
+		FeeRate:           uint32(preview.FeeRate),
+		Vsize:             int64(preview.VSize),
+		ChainFeeSats:      int64(preview.ChainFees),
+		GenesisOutpoint:   preview.GenesisPoint.String(),
+		AnchorOutputIndex: preview.AnchorOutputIndex,
+		Final:             preview.Final,
+		Assets: make(
+			[]*mintrpc.AssetPreview, 0, len(preview.Assets),
+		),
+	}
+	if preview.BatchKey != nil {
+		resp.BatchKey = preview.BatchKey.SerializeCompressed()
+	}
+	for _, assetPreview := range preview.Assets {
+		resp.Assets = append(resp.Assets, &mintrpc.AssetPreview{
+			Name:    assetPreview.AssetName,
+			AssetId: fn.CopySlice(assetPreview.AssetID[:]),
+		})
+	}
+
+	return resp, nil
+}
+
+// ScheduleBatch sets the schedule at which the pending batch is finalized
+// automatically, or removes the current schedule if none is given.
+func (r *rpcServer) ScheduleBatch(_ context.Context,
+	req *mintrpc.ScheduleBatchRequest) (*mintrpc.ScheduleBatchResponse,
+	error) {
+
+	var schedule tapgarden.BatchSchedule
+	if req.Schedule != nil {
+		feeRate, err := checkFeeRateSanity(req.Schedule.FeeRate)
+		if err != nil {
+			return nil, err
+		}
+
+		if req.Schedule.FinalizeHeight != 0 {
+			schedule.Height = fn.Some(req.Schedule.FinalizeHeight)
+		}
+		if req.Schedule.FinalizeTime != 0 {
+			schedule.Time = fn.Some(
+				time.Unix(req.Schedule.FinalizeTime, 0),
+			)
+		}
+		if req.Schedule.MinSeedlings != 0 {
+			schedule.MinSeedlings = fn.Some(
+				int(req.Schedule.MinSeedlings),
+			)
+		}
+		schedule.FeeRate = fn.MaybeSome(feeRate)
+
+		// A schedule that only sets a fee rate would be taken as a
+		// request to remove the schedule, so we reject it here.
+		if schedule.IsEmpty() {
+			return nil, fmt.Errorf("batch schedule needs at " +
+				"least one finalization condition")
+		}
+	}
+
+	rpcsLog.Infof("[ScheduleBatch]: setting batch schedule: %v", schedule)
+
+	if err := r.cfg.AssetMinter.ScheduleBatch(schedule); err != nil {
+		return nil, fmt.Errorf("unable to schedule batch: %w", err)
+	}
+
+	return &mintrpc.ScheduleBatchResponse{}, nil
+}
+
+// GetBatchSchedule returns the current schedule at which the pending batch is
+// finalized automatically, if there is one.
+func (r *rpcServer) GetBatchSchedule(_ context.Context,
+	_ *mintrpc.GetBatchScheduleRequest) (*mintrpc.GetBatchScheduleResponse,
+	error) {
+
+	schedule, err := r.cfg.AssetMinter.BatchSchedule()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch batch schedule: %w",
+			err)
+	}
+
+	resp := &mintrpc.GetBatchScheduleResponse{}
+	schedule.WhenSome(func(s tapgarden.BatchSchedule) {
+		resp.Schedule = marshalBatchSchedule(s)
+	})
+
+	return resp, nil
+}
+
+// marshalBatchSchedule converts a batch schedule to its RPC representation.
+func marshalBatchSchedule(
+	schedule tapgarden.BatchSchedule) *mintrpc.BatchSchedule {
+
+	rpcSchedule := &mintrpc.BatchSchedule{
+		FinalizeHeight: schedule.Height.UnwrapOr(0),
+		FeeRate:        uint32(schedule.FeeRate.UnwrapOr(0)),
+	}
+	schedule.Time.WhenSome(func(t time.Time) {
+		rpcSchedule.FinalizeTime = t.Unix()
+	})
+	schedule.MinSeedlings.WhenSome(func(n int) {
+		rpcSchedule.MinSeedlings = uint32(n)
+	})
+
+	return rpcSchedule
+}
+
 // CancelBatch attempts to cancel the current pending batch.
 func (r *rpcServer) CancelBatch(_ context.Context,
 	_ *mintrpc.CancelBatchRequest) (*mintrpc.CancelBatchResponse,
@@ -960,6 +1184,15 @@ func (r *rpcServer) ListAssets(ctx context.Context,
 			"and include_leased")
 	}
 
+	// Read from the database snapshot the caller requested, if any.
+	ctx, snapshot, releaseSnapshot, err := r.withReadSnapshot(
+		ctx, listAssetsMethod, req.ReadSnapshot,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer releaseSnapshot()
+
 	rpcAssets, err := r.fetchRpcAssets(
 		ctx, req.WithWitness, req.IncludeSpent, req.IncludeLeased,
 	)
@@ -979,6 +1212,7 @@ func (r *rpcServer) ListAssets(ctx context.Context,
 	return &taprpc.ListAssetResponse{
 		Assets:               rpcAssets,
 		UnconfirmedTransfers: uint64(len(outboundParcels)),
+		ReadSnapshot:         snapshot,
 	}, nil
 }
 
@@ -1010,14 +1244,14 @@ func (r *rpcServer) fetchRpcAssets(ctx context.Context, withWitness,
 func (r *rpcServer) MarshalChainAsset(ctx context.Context, a *asset.ChainAsset,
 	withWitness bool, keyRing taprpc.KeyLookup) (*taprpc.Asset, error) {
 
-	decDisplay, err := r.DecDisplayForAssetID(ctx, a.ID())
+	decDisplay, decodedMeta, err := r.decodeAssetMeta(ctx, a.ID())
 	if err != nil {
 		return nil, err
 	}
 
 	rpcAsset, err := taprpc.MarshalAsset(
 		ctx, a.Asset, a.IsSpent, withWitness, keyRing,
-		fn.Some(decDisplay),
+		fn.Some(decDisplay), decodedMeta,
 	)
 	if err != nil {
 		return nil, err
@@ -1139,6 +1373,7 @@ func (r *rpcServer) ListUtxos(ctx context.Context,
 			InternalKey:      u.InternalKey.PubKey.SerializeCompressed(),
 			TaprootAssetRoot: u.TaprootAssetRoot,
 			MerkleRoot:       u.MerkleRoot,
+			Note:             u.Note,
 		}
 	}
 
@@ -1168,6 +1403,44 @@ func (r *rpcServer) ListUtxos(ctx context.Context,
 	}, nil
 }
 
+// SetUtxoNote sets the user-defined note of an asset anchor output. An empty
+// note removes an existing note.
+func (r *rpcServer) SetUtxoNote(ctx context.Context,
+	req *taprpc.SetUtxoNoteRequest) (*taprpc.SetUtxoNoteResponse, error) {
+
+	outpoint, err := wire.NewOutPointFromString(req.Outpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid outpoint: %w", err)
+	}
+
+	err = r.cfg.AssetStore.SetUtxoNote(ctx, *outpoint, req.Note)
+	if err != nil {
+		return nil, fmt.Errorf("unable to set UTXO note: %w", err)
+	}
+
+	return &taprpc.SetUtxoNoteResponse{}, nil
+}
+
+// FetchUtxoNote returns the user-defined note of an asset anchor output.
+func (r *rpcServer) FetchUtxoNote(ctx context.Context,
+	req *taprpc.FetchUtxoNoteRequest) (*taprpc.FetchUtxoNoteResponse,
+	error) {
+
+	outpoint, err := wire.NewOutPointFromString(req.Outpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid outpoint: %w", err)
+	}
+
+	note, err := r.cfg.AssetStore.UtxoNote(ctx, *outpoint)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch UTXO note: %w", err)
+	}
+
+	return &taprpc.FetchUtxoNoteResponse{
+		Note: note,
+	}, nil
+}
+
 // ListGroups lists known groups and the assets held in each group.
 func (r *rpcServer) ListGroups(ctx context.Context,
 	_ *taprpc.ListGroupsRequest) (*taprpc.ListGroupsResponse, error) {
@@ -1216,6 +1489,87 @@ func (r *rpcServer) ListGroups(ctx context.Context,
 	return &taprpc.ListGroupsResponse{Groups: groupsWithAssets}, nil
 }
 
+// QueryGroupSummary aggregates the balance, the UTXOs and the outbound transfer
+// history of all asset IDs within an asset group.
+func (r *rpcServer) QueryGroupSummary(ctx context.Context,
+	req *taprpc.QueryGroupSummaryRequest) (
+	*taprpc.QueryGroupSummaryResponse, error) {
+
+	groupKey, err := btcec.ParsePubKey(req.GroupKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid group key: %w", err)
+	}
+
+	summary, err := r.cfg.AssetStore.QueryGroupSummary(ctx, groupKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query group summary: %w", err)
+	}
+
+	resp := &taprpc.QueryGroupSummaryResponse{
+		GroupKey: summary.GroupKey.SerializeCompressed(),
+		Balance:  summary.Balance,
+		Members: make(
+			[]*taprpc.GroupMemberSummary, 0, len(summary.Members),
+		),
+		Utxos: make([]*taprpc.GroupUtxo, len(summary.Utxos)),
+		Transfers: make(
+			[]*taprpc.AssetTransfer, len(summary.Transfers),
+		),
+	}
+
+	for _, member := range summary.Members {
+		resp.Members = append(resp.Members, &taprpc.GroupMemberSummary{
+			AssetId:      fn.ByteSlice(member.ID),
+			Tag:          member.Tag,
+			AssetType:    taprpc.AssetType(member.Type),
+			Balance:      member.Balance,
+			NumUtxos:     uint32(member.NumUtxos),
+			NumTransfers: uint32(member.NumTransfers),
+		})
+	}
+	sort.Slice(resp.Members, func(i, j int) bool {
+		return bytes.Compare(
+			resp.Members[i].AssetId, resp.Members[j].AssetId,
+		) < 0
+	})
+
+	for idx, utxo := range summary.Utxos {
+		rpcUtxo := &taprpc.GroupUtxo{
+			Outpoint: utxo.OutPoint.String(),
+			Balance:  utxo.Balance,
+		}
+		for assetID, balance := range utxo.MemberBalances {
+			rpcUtxo.MemberBalances = append(
+				rpcUtxo.MemberBalances,
+				&taprpc.GroupMemberBalance{
+					AssetId: fn.ByteSlice(assetID),
+					Balance: balance,
+				},
+			)
+		}
+		sort.Slice(rpcUtxo.MemberBalances, func(i, j int) bool {
+			return bytes.Compare(
+				rpcUtxo.MemberBalances[i].AssetId,
+				rpcUtxo.MemberBalances[j].AssetId,
+			) < 0
+		})
+
+		resp.Utxos[idx] = rpcUtxo
+	}
+
+	for idx := range summary.Transfers {
+		resp.Transfers[idx], err = marshalOutboundParcel(
+			summary.Transfers[idx],
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal parcel: %w",
+				err)
+		}
+	}
+
+	return resp, nil
+}
+
 // ListBalances lists the asset balances owned by the daemon.
 func (r *rpcServer) ListBalances(ctx context.Context,
 	req *taprpc.ListBalancesRequest) (*taprpc.ListBalancesResponse, error) {
@@ -1280,13 +1634,39 @@ func (r *rpcServer) ListTransfers(ctx context.Context,
 		}
 	}
 
-	parcels, err := r.cfg.AssetStore.QueryParcels(ctx, anchorTxHash, false)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query parcels: %w", err)
+	// If the caller supplied a filter, we only return a single page of the
+	// matching transfers.
+	var (
+		parcels    []*tapfreighter.OutboundParcel
+		nextCursor int64
+	)
+	if req.Filter != nil {
+		query, err := r.unmarshalTransferFilter(req.Filter)
+		if err != nil {
+			return nil, err
+		}
+
+		page, err := r.queryTransferPage(ctx, *query, anchorTxHash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query parcels: %w",
+				err)
+		}
+
+		parcels = page.Parcels
+		nextCursor = page.NextCursor.UnwrapOr(0)
+	} else {
+		parcels, err = r.cfg.AssetStore.QueryParcels(
+			ctx, anchorTxHash, false,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query parcels: %w",
+				err)
+		}
 	}
 
 	resp := &taprpc.ListTransfersResponse{
-		Transfers: make([]*taprpc.AssetTransfer, len(parcels)),
+		Transfers:  make([]*taprpc.AssetTransfer, len(parcels)),
+		NextCursor: nextCursor,
 	}
 
 	for idx := range parcels {
@@ -1300,6 +1680,80 @@ func (r *rpcServer) ListTransfers(ctx context.Context,
 	return resp, nil
 }
 
+// ListCoinSelections lists the recorded outcomes of the asset coin selections
+// of past transfers, most recent first.
+func (r *rpcServer) ListCoinSelections(ctx context.Context,
+	req *taprpc.ListCoinSelectionsRequest) (
+	*taprpc.ListCoinSelectionsResponse, error) {
+
+	if r.cfg.CoinSelectionLog == nil {
+		return nil, fmt.Errorf("coin selection log not available")
+	}
+
+	if req.Limit < 0 {
+		return nil, fmt.Errorf("limit must be non-negative")
+	}
+
+	query := tapfreighter.CoinSelectionQuery{
+		Limit: req.Limit,
+	}
+
+	if len(req.AssetId) != 0 {
+		var assetID asset.ID
+		if len(req.AssetId) != len(assetID) {
+			return nil, fmt.Errorf("invalid asset ID length")
+		}
+
+		copy(assetID[:], req.AssetId)
+		query.AssetID = &assetID
+	}
+
+	if len(req.GroupKey) != 0 {
+		groupKey, err := btcec.ParsePubKey(req.GroupKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid group key: %w", err)
+		}
+
+		query.GroupKey = groupKey
+	}
+
+	if req.StartTimestamp > 0 {
+		query.From = time.Unix(req.StartTimestamp, 0)
+	}
+
+	records, err := r.cfg.CoinSelectionLog.QueryCoinSelections(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query coin selections: %w",
+			err)
+	}
+
+	resp := &taprpc.ListCoinSelectionsResponse{
+		CoinSelections: make([]*taprpc.CoinSelection, len(records)),
+	}
+	for idx, record := range records {
+		rpcRecord := &taprpc.CoinSelection{
+			SelectionTimestamp: record.SelectionTime.Unix(),
+			Strategy:           record.Strategy.String(),
+			TargetAmount:       record.TargetAmt,
+			NumCandidates:      record.NumCandidates,
+			NumSelected:        record.NumSelected,
+			SelectedAmount:     record.SelectedAmt,
+			ChangeAmount:       record.ChangeAmt,
+			FragmentationScore: record.FragmentationScore,
+		}
+		if record.AssetID != nil {
+			rpcRecord.AssetId = fn.ByteSlice(*record.AssetID)
+		}
+		if groupKey := record.GroupKey; groupKey != nil {
+			rpcRecord.GroupKey = groupKey.SerializeCompressed()
+		}
+
+		resp.CoinSelections[idx] = rpcRecord
+	}
+
+	return resp, nil
+}
+
 // QueryAddrs queries the set of Taproot Asset addresses stored in the database.
 func (r *rpcServer) QueryAddrs(ctx context.Context,
 	req *taprpc.QueryAddrRequest) (*taprpc.QueryAddrResponse, error) {
@@ -1319,6 +1773,12 @@ func (r *rpcServer) QueryAddrs(ctx context.Context,
 		query.CreatedAfter = time.Unix(req.CreatedAfter, 0)
 	}
 
+	state, err := taprpc.UnmarshalAddrState(req.State)
+	if err != nil {
+		return nil, err
+	}
+	query.State = state
+
 	rpcsLog.Debugf("[QueryAddrs]: addr query params: %v",
 		spew.Sdump(query))
 
@@ -1327,6 +1787,7 @@ func (r *rpcServer) QueryAddrs(ctx context.Context,
 		return nil, fmt.Errorf("unable to query addrs: %w", err)
 	}
 
+	now := time.Now()
 	addrs := make([]*taprpc.Addr, len(dbAddrs))
 	for i, dbAddr := range dbAddrs {
 		// TODO(roasbeef): just stop storing the hrp in the addr?
@@ -1337,6 +1798,21 @@ func (r *rpcServer) QueryAddrs(ctx context.Context,
 			return nil, fmt.Errorf("unable to marshal addr: %w",
 				err)
 		}
+
+		addrs[i].Note = dbAddr.Note
+		addrs[i].State, err = taprpc.MarshalAddrState(
+			dbAddr.State(now),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if !dbAddr.ExpiryTime.IsZero() {
+			addrs[i].ExpiryTime = dbAddr.ExpiryTime.Unix()
+		}
+		if !dbAddr.ArchivedAt.IsZero() {
+			addrs[i].ArchivedAt = dbAddr.ArchivedAt.Unix()
+		}
 	}
 
 	rpcsLog.Debugf("[QueryAddrs]: returning %v addrs", len(addrs))
@@ -1352,7 +1828,7 @@ func (r *rpcServer) NewAddr(ctx context.Context,
 
 	// Parse the proof courier address if one was provided, otherwise use
 	// the default specified in the config.
-	courierAddr := r.cfg.DefaultProofCourierAddr
+	courierAddr := r.defaultCourierAddr.Load()
 	if req.ProofCourierAddr != "" {
 		var err error
 		courierAddr, err = proof.ParseCourierAddress(
@@ -1378,6 +1854,25 @@ func (r *rpcServer) NewAddr(ctx context.Context,
 	var assetID asset.ID
 	copy(assetID[:], req.AssetId)
 
+	// If the caller supplied the amount as a decimal number, we convert it
+	// into asset units.
+	if req.DecimalAmt != "" {
+		units, err := r.unitsFromDecimalAmount(
+			ctx, assetID, req.DecimalAmt,
+		)
+		switch {
+		case err != nil:
+			return nil, err
+
+		case req.Amt != 0 && req.Amt != units:
+			return nil, fmt.Errorf("amount of %d units doesn't "+
+				"match decimal amount of %d units", req.Amt,
+				units)
+		}
+
+		req.Amt = units
+	}
+
 	rpcsLog.Infof("[NewAddr]: making new addr: asset_id=%x, amt=%v",
 		assetID[:], req.Amt)
 
@@ -1414,6 +1909,7 @@ func (r *rpcServer) NewAddr(ctx context.Context,
 		addr, err = r.cfg.AddrBook.NewAddress(
 			ctx, addrVersion, assetID, req.Amt, tapscriptSibling,
 			*courierAddr, address.WithAssetVersion(assetVersion),
+			address.WithNumConfs(req.NumConfs),
 		)
 		if err != nil {
 			return nil, fmt.Errorf("unable to make new addr: %w",
@@ -1464,6 +1960,7 @@ func (r *rpcServer) NewAddr(ctx context.Context,
 			ctx, addrVersion, assetID, req.Amt, *scriptKey,
 			internalKey, tapscriptSibling, *courierAddr,
 			address.WithAssetVersion(assetVersion),
+			address.WithNumConfs(req.NumConfs),
 		)
 		if err != nil {
 			return nil, fmt.Errorf("unable to make new addr: %w",
@@ -1513,7 +2010,7 @@ func (r *rpcServer) VerifyProof(ctx context.Context,
 			"single encoded mint or transition proof")
 	}
 
-	if err := proof.CheckMaxFileSize(req.RawProofFile); err != nil {
+	if err := r.checkProofFileSize(req.RawProofFile); err != nil {
 		return nil, fmt.Errorf("invalid proof file: %w", err)
 	}
 
@@ -1554,11 +2051,26 @@ func (r *rpcServer) VerifyProof(ctx context.Context,
 	}, nil
 }
 
+// checkProofFileSize makes sure the given proof file received over RPC doesn't
+// exceed the configured maximum size, so we never parse attacker sized blobs.
+func (r *rpcServer) checkProofFileSize(blob proof.Blob) error {
+	if err := proof.CheckMaxFileSize(blob); err != nil {
+		return err
+	}
+
+	return proof.CheckFileSize(blob, r.cfg.RPCConfig.MaxProofFileSize)
+}
+
 // DecodeProof attempts to decode a given proof file that claims to be anchored
 // at the specified genesis point.
 func (r *rpcServer) DecodeProof(ctx context.Context,
 	req *taprpc.DecodeProofRequest) (*taprpc.DecodeProofResponse, error) {
 
+	if req.VerifyTransitions && !req.WithTransitions {
+		return nil, fmt.Errorf("verify_transitions requires " +
+			"with_transitions to be set")
+	}
+
 	var rpcProof *taprpc.DecodedProof
 	switch {
 	case proof.IsSingleProof(req.RawProof):
@@ -1579,7 +2091,7 @@ func (r *rpcServer) DecodeProof(ctx context.Context,
 		rpcProof.NumberOfProofs = 1
 
 	case proof.IsProofFile(req.RawProof):
-		if err := proof.CheckMaxFileSize(req.RawProof); err != nil {
+		if err := r.checkProofFileSize(req.RawProof); err != nil {
 			return nil, fmt.Errorf("invalid proof file: %w", err)
 		}
 
@@ -1620,19 +2132,105 @@ func (r *rpcServer) DecodeProof(ctx context.Context,
 			"identify decoding format")
 	}
 
-	return &taprpc.DecodeProofResponse{
+	resp := &taprpc.DecodeProofResponse{
 		DecodedProof: rpcProof,
-	}, nil
-}
+	}
 
-// marshalProof turns a transition proof into an RPC DecodedProof.
-func (r *rpcServer) marshalProof(ctx context.Context, p *proof.Proof,
-	withPrevWitnesses, withMetaReveal bool) (*taprpc.DecodedProof, error) {
+	if req.WithTransitions {
+		inspection, err := proof.Inspect(
+			req.RawProof, req.VerifyTransitions,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to inspect proof: %w",
+				err)
+		}
 
-	var (
-		rpcMeta        *taprpc.AssetMeta
-		rpcGenesis     = p.GenesisReveal
-		rpcGroupKey    = p.GroupKeyReveal
+		resp.Transitions = fn.Map(
+			inspection.Transitions, marshalTransitionSummary,
+		)
+	}
+
+	return resp, nil
+}
+
+// marshalTaprootProofSummary turns a taproot proof summary into its RPC
+// counterpart.
+func marshalTaprootProofSummary(
+	summary proof.TaprootProofSummary) *taprpc.TaprootProofSummary {
+
+	rpcSummary := &taprpc.TaprootProofSummary{
+		OutputIndex:   summary.OutputIndex,
+		Kind:          summary.Kind.String(),
+		HasTapSibling: summary.HasTapSibling,
+	}
+	if summary.InternalKey != nil {
+		internalKey := summary.InternalKey
+		rpcSummary.InternalKey = internalKey.SerializeCompressed()
+	}
+
+	return rpcSummary
+}
+
+// marshalTransitionSummary turns a state transition summary into its RPC
+// counterpart.
+func marshalTransitionSummary(
+	summary proof.TransitionSummary) *taprpc.ProofTransitionSummary {
+
+	rpcSummary := &taprpc.ProofTransitionSummary{
+		Index:          summary.Index,
+		Version:        uint32(summary.Version),
+		AssetId:        fn.ByteSlice(summary.AssetID),
+		AssetType:      taprpc.AssetType(summary.AssetType),
+		Amount:         summary.Amount,
+		IsGenesis:      summary.IsGenesis,
+		IsBurn:         summary.IsBurn,
+		IsSplit:        summary.IsSplit,
+		PrevOut:        summary.PrevOut.String(),
+		AnchorTxid:     summary.AnchorTxid.String(),
+		AnchorOutpoint: summary.AnchorOutPoint.String(),
+		BlockHash:      summary.BlockHash.String(),
+		BlockHeight:    summary.BlockHeight,
+		InclusionProof: marshalTaprootProofSummary(
+			summary.InclusionProof,
+		),
+		ExclusionProofs: fn.Map(
+			summary.ExclusionProofs, marshalTaprootProofSummary,
+		),
+		NumPrevWitnesses:    uint32(summary.NumPrevWitnesses),
+		NumAdditionalInputs: uint32(summary.NumAdditionalInputs),
+		HasMetaReveal:       summary.HasMetaReveal,
+		HasGenesisReveal:    summary.HasGenesisReveal,
+		HasGroupKeyReveal:   summary.HasGroupKeyReveal,
+		HasChallengeWitness: summary.HasChallengeWitness,
+		Verified:            summary.Verified,
+	}
+
+	if summary.ScriptKey != nil {
+		rpcSummary.ScriptKey = summary.ScriptKey.SerializeCompressed()
+	}
+	if summary.GroupKey != nil {
+		rpcSummary.GroupKey = summary.GroupKey.SerializeCompressed()
+	}
+	if summary.SplitRootProof != nil {
+		rpcSummary.SplitRootProof = marshalTaprootProofSummary(
+			*summary.SplitRootProof,
+		)
+	}
+	if summary.VerifyErr != nil {
+		rpcSummary.VerifyError = summary.VerifyErr.Error()
+	}
+
+	return rpcSummary
+}
+
+// marshalProof turns a transition proof into an RPC DecodedProof.
+func (r *rpcServer) marshalProof(ctx context.Context, p *proof.Proof,
+	withPrevWitnesses, withMetaReveal bool) (*taprpc.DecodedProof, error) {
+
+	var (
+		rpcMeta        *taprpc.AssetMeta
+		rpcGenesis     = p.GenesisReveal
+		rpcGroupKey    = p.GroupKeyReveal
 		anchorOutpoint = wire.OutPoint{
 			Hash:  p.AnchorTx.TxHash(),
 			Index: p.InclusionProof.OutputIndex,
@@ -1832,6 +2430,98 @@ func (r *rpcServer) ExportProof(ctx context.Context,
 	}, nil
 }
 
+// BulkImportProofs submits a set of proof files to be verified and imported in
+// the background. The returned job ID can be used to query the progress of the
+// import.
+func (r *rpcServer) BulkImportProofs(ctx context.Context,
+	req *taprpc.BulkImportProofsRequest) (*taprpc.BulkImportProofsResponse,
+	error) {
+
+	if len(req.RawProofFiles) == 0 {
+		return nil, fmt.Errorf("at least one proof file must be " +
+			"specified")
+	}
+
+	blobs := make([]proof.Blob, len(req.RawProofFiles))
+	for idx, rawProofFile := range req.RawProofFiles {
+		blobs[idx] = rawProofFile
+	}
+
+	jobID, err := r.cfg.ProofImporter.SubmitJob(ctx, blobs)
+	if err != nil {
+		return nil, fmt.Errorf("unable to submit import job: %w", err)
+	}
+
+	return &taprpc.BulkImportProofsResponse{
+		JobId: jobID,
+	}, nil
+}
+
+// ProofImportStatus returns the progress of a bulk proof import job, including
+// the status of each individual proof file.
+func (r *rpcServer) ProofImportStatus(ctx context.Context,
+	req *taprpc.ProofImportStatusRequest) (
+	*taprpc.ProofImportStatusResponse, error) {
+
+	job, err := r.cfg.ProofImporter.FetchJob(ctx, req.JobId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch import job: %w", err)
+	}
+
+	progress := job.Progress()
+	resp := &taprpc.ProofImportStatusResponse{
+		JobId:        job.ID,
+		CreatedAt:    job.CreatedAt.Unix(),
+		NumTotal:     uint32(progress.Total),
+		NumPending:   uint32(progress.Pending),
+		NumImported:  uint32(progress.Imported),
+		NumDuplicate: uint32(progress.Duplicate),
+		NumInvalid:   uint32(progress.Invalid),
+		Items:        make([]*taprpc.ProofImportItem, len(job.Items)),
+	}
+	for idx, item := range job.Items {
+		status, err := marshalProofImportStatus(item.Status)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Items[idx] = &taprpc.ProofImportItem{
+			Index:  item.Index,
+			Status: status,
+			Reason: item.Reason,
+		}
+	}
+
+	return resp, nil
+}
+
+// marshalProofImportStatus turns the status of a proof import item into its
+// RPC counterpart.
+func marshalProofImportStatus(
+	status proof.ImportStatus) (taprpc.ProofImportItemStatus, error) {
+
+	switch status {
+	case proof.ImportStatusPending:
+		return taprpc.ProofImportItemStatus_PROOF_IMPORT_STATUS_PENDING,
+			nil
+
+	case proof.ImportStatusImported:
+		return taprpc.
+			ProofImportItemStatus_PROOF_IMPORT_STATUS_IMPORTED, nil
+
+	case proof.ImportStatusDuplicate:
+		return taprpc.
+			ProofImportItemStatus_PROOF_IMPORT_STATUS_DUPLICATE, nil
+
+	case proof.ImportStatusInvalid:
+		return taprpc.ProofImportItemStatus_PROOF_IMPORT_STATUS_INVALID,
+			nil
+
+	default:
+		return 0, fmt.Errorf("unknown proof import status: %v", status)
+	}
+}
+
 // ImportProof attempts to import a proof file into the daemon. If successful, a
 // new asset will be inserted on disk, spendable using the specified target
 // script key, and internal key.
@@ -1843,6 +2533,9 @@ func (r *rpcServer) ImportProof(ctx context.Context,
 	if len(req.ProofFile) == 0 {
 		return nil, fmt.Errorf("proof file must be specified")
 	}
+	if err := r.checkProofFileSize(req.ProofFile); err != nil {
+		return nil, fmt.Errorf("invalid proof file: %w", err)
+	}
 
 	// We need to parse the proof file and extract the last proof, so we can
 	// get the locator that is required for storage.
@@ -1879,6 +2572,127 @@ func (r *rpcServer) ImportProof(ctx context.Context,
 	return &tapdevrpc.ImportProofResponse{}, nil
 }
 
+// addrTaprootOutputKey decodes the given Taproot Asset address and derives the
+// Taproot output key it expects assets to be sent to, which is what addresses
+// are identified by in the address book.
+func (r *rpcServer) addrTaprootOutputKey(ctx context.Context,
+	addrStr string) (*btcec.PublicKey, error) {
+
+	addr, err := r.decodeKnownAddr(ctx, addrStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return addr.TaprootOutputKey()
+}
+
+// decodeKnownAddr decodes the given Taproot Asset address and attaches the
+// genesis of its asset, which is required to derive its Taproot output key.
+// This only works for assets we know about.
+func (r *rpcServer) decodeKnownAddr(ctx context.Context,
+	addrStr string) (*address.Tap, error) {
+
+	addr, err := address.DecodeAddress(addrStr, &r.cfg.ChainParams)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode addr: %w", err)
+	}
+
+	assetGroup, err := r.cfg.TapAddrBook.QueryAssetGroup(
+		ctx, addr.AssetID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unknown asset=%x: %w", addr.AssetID[:],
+			err)
+	}
+
+	addr.AttachGenesis(*assetGroup.Genesis)
+
+	return addr, nil
+}
+
+// SetAddrNote sets the user-defined note of a Taproot Asset address. An empty
+// note removes an existing note.
+func (r *rpcServer) SetAddrNote(ctx context.Context,
+	req *taprpc.SetAddrNoteRequest) (*taprpc.SetAddrNoteResponse, error) {
+
+	taprootOutputKey, err := r.addrTaprootOutputKey(ctx, req.Addr)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.cfg.AddrBook.SetAddrNote(ctx, taprootOutputKey, req.Note)
+	if err != nil {
+		return nil, fmt.Errorf("unable to set addr note: %w", err)
+	}
+
+	return &taprpc.SetAddrNoteResponse{}, nil
+}
+
+// SetAddrExpiry sets the expiry time of a Taproot Asset address. An expiry time
+// of zero removes an existing expiry.
+func (r *rpcServer) SetAddrExpiry(ctx context.Context,
+	req *taprpc.SetAddrExpiryRequest) (*taprpc.SetAddrExpiryResponse,
+	error) {
+
+	if req.ExpiryTime < 0 {
+		return nil, fmt.Errorf("expiry time must not be negative")
+	}
+
+	taprootOutputKey, err := r.addrTaprootOutputKey(ctx, req.Addr)
+	if err != nil {
+		return nil, err
+	}
+
+	var expiry time.Time
+	if req.ExpiryTime > 0 {
+		expiry = time.Unix(req.ExpiryTime, 0)
+	}
+
+	err = r.cfg.AddrBook.SetAddrExpiry(ctx, taprootOutputKey, expiry)
+	if err != nil {
+		return nil, fmt.Errorf("unable to set addr expiry: %w", err)
+	}
+
+	return &taprpc.SetAddrExpiryResponse{}, nil
+}
+
+// ArchiveAddr archives a Taproot Asset address, so the daemon stops watching
+// for new inbound transfers to it.
+func (r *rpcServer) ArchiveAddr(ctx context.Context,
+	req *taprpc.ArchiveAddrRequest) (*taprpc.ArchiveAddrResponse, error) {
+
+	taprootOutputKey, err := r.addrTaprootOutputKey(ctx, req.Addr)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.cfg.AddrBook.ArchiveAddr(ctx, taprootOutputKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to archive addr: %w", err)
+	}
+
+	return &taprpc.ArchiveAddrResponse{}, nil
+}
+
+// UnarchiveAddr unarchives a Taproot Asset address, so the daemon watches for
+// new inbound transfers to it again.
+func (r *rpcServer) UnarchiveAddr(ctx context.Context,
+	req *taprpc.UnarchiveAddrRequest) (*taprpc.UnarchiveAddrResponse,
+	error) {
+
+	taprootOutputKey, err := r.addrTaprootOutputKey(ctx, req.Addr)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.cfg.AddrBook.UnarchiveAddr(ctx, taprootOutputKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unarchive addr: %w", err)
+	}
+
+	return &taprpc.UnarchiveAddrResponse{}, nil
+}
+
 // AddrReceives lists all receives for incoming asset transfers for addresses
 // that were created previously.
 func (r *rpcServer) AddrReceives(ctx context.Context,
@@ -1985,6 +2799,7 @@ func (r *rpcServer) FundVirtualPsbt(ctx context.Context,
 
 		fundedVPkt, err = r.cfg.AssetWallet.FundPacket(
 			ctx, desc, vPkt,
+			fn.None[tapfreighter.MultiCommitmentSelectStrategy](),
 		)
 		if err != nil {
 			return nil, fmt.Errorf("error funding packet: %w", err)
@@ -2016,7 +2831,11 @@ func (r *rpcServer) FundVirtualPsbt(ctx context.Context,
 			return nil, fmt.Errorf("no recipients specified")
 		}
 
-		fundedVPkt, err = r.cfg.AssetWallet.FundAddressSend(ctx, addr)
+		fundedVPkt, err = r.cfg.AssetWallet.FundAddressSend(
+			ctx,
+			fn.None[tapfreighter.MultiCommitmentSelectStrategy](),
+			addr,
+		)
 		if err != nil {
 			return nil, fmt.Errorf("error funding address send: "+
 				"%w", err)
@@ -2037,6 +2856,16 @@ func (r *rpcServer) FundVirtualPsbt(ctx context.Context,
 		return nil, fmt.Errorf("error creating passive assets: %w", err)
 	}
 
+	// The active and passive packets are anchored in the same anchor
+	// transaction, so we apply the ordering policy to all of them before
+	// the packets are handed out for signing.
+	err = r.cfg.AssetWallet.OrderAnchorOutputs(
+		ctx, []*tappsbt.VPacket{fundedVPkt.VPacket}, passivePackets,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error ordering anchor outputs: %w", err)
+	}
+
 	// Serialize the active and passive packets into the response now.
 	response := &wrpc.FundVirtualPsbtResponse{
 		PassiveAssetPsbts: make([][]byte, len(passivePackets)),
@@ -2148,7 +2977,34 @@ func (r *rpcServer) AnchorVirtualPsbts(ctx context.Context,
 		}
 	}
 
-	// Query the asset store to gather tap commitments for all inputs.
+	inputCommitments, err := r.fetchInputCommitments(ctx, vPackets)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.cfg.ChainPorter.RequestShipment(
+		tapfreighter.NewPreSignedParcel(vPackets, inputCommitments),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error requesting delivery: %w", err)
+	}
+
+	parcel, err := marshalOutboundParcel(resp)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
+			err)
+	}
+
+	return &taprpc.SendAssetResponse{
+		Transfer: parcel,
+	}, nil
+}
+
+// fetchInputCommitments queries the asset store to gather the tap commitments
+// for all inputs of the given virtual packets.
+func (r *rpcServer) fetchInputCommitments(ctx context.Context,
+	vPackets []*tappsbt.VPacket) (tappsbt.InputCommitments, error) {
+
 	inputCommitments := make(tappsbt.InputCommitments, len(vPackets))
 	for _, vPkt := range vPackets {
 		for idx := range vPkt.Inputs {
@@ -2187,22 +3043,7 @@ func (r *rpcServer) AnchorVirtualPsbts(ctx context.Context,
 			prevID.OutPoint.String())
 	}
 
-	resp, err := r.cfg.ChainPorter.RequestShipment(
-		tapfreighter.NewPreSignedParcel(vPackets, inputCommitments),
-	)
-	if err != nil {
-		return nil, fmt.Errorf("error requesting delivery: %w", err)
-	}
-
-	parcel, err := marshalOutboundParcel(resp)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
-			err)
-	}
-
-	return &taprpc.SendAssetResponse{
-		Transfer: parcel,
-	}, nil
+	return inputCommitments, nil
 }
 
 // CommitVirtualPsbts creates the output commitments and proofs for the given
@@ -2973,6 +3814,8 @@ func marshalAddr(addr *address.Tap,
 		TaprootOutputKey: taprootOutputKey,
 		AssetType:        taprpc.AssetType(addr.AssetType()),
 		ProofCourierAddr: addr.ProofCourierAddr.String(),
+		NumConfs:         addr.NumConfs,
+		Features:         marshalAddrFeatures(addr),
 	}
 
 	if addr.GroupKey != nil {
@@ -2982,6 +3825,25 @@ func marshalAddr(addr *address.Tap,
 	return rpcAddr, nil
 }
 
+// marshalAddrFeatures converts the features an address signals to their RPC
+// representation, sorted by their bit.
+func marshalAddrFeatures(addr *address.Tap) []*taprpc.AddrFeature {
+	features := addr.FeatureVector()
+	bits := maps.Keys(features.Features())
+	sort.Slice(bits, func(i, j int) bool {
+		return bits[i] < bits[j]
+	})
+
+	return fn.Map(bits, func(bit lnwire.FeatureBit) *taprpc.AddrFeature {
+		return &taprpc.AddrFeature{
+			Bit:      uint32(bit),
+			Name:     features.Name(bit),
+			Required: bit.IsRequired(),
+			Known:    features.IsKnown(bit),
+		}
+	})
+}
+
 // marshalAddrEvent turns an address event into its RPC counterpart.
 func marshalAddrEvent(event *address.Event,
 	db address.Storage) (*taprpc.AddrEvent, error) {
@@ -3061,52 +3923,51 @@ func marshalAddrEventStatus(status address.Status) (taprpc.AddrEventStatus,
 // SendAsset uses one or multiple passed Taproot Asset address(es) to attempt to
 // complete an asset send. The method returns information w.r.t the on chain
 // send, as well as the proof file information the receiver needs to fully
-// receive the asset.
-func (r *rpcServer) SendAsset(_ context.Context,
+// receive the asset. If the caller supplies an idempotency key, a retried call
+// with the same key returns the original transfer instead of sending again.
+func (r *rpcServer) SendAsset(ctx context.Context,
 	req *taprpc.SendAssetRequest) (*taprpc.SendAssetResponse, error) {
 
-	if len(req.TapAddrs) == 0 {
-		return nil, fmt.Errorf("at least one addr is required")
+	return withIdempotency(
+		ctx, r, sendAssetMethod, req.IdempotencyKey, req,
+		func() *taprpc.SendAssetResponse {
+			return &taprpc.SendAssetResponse{}
+		},
+		func(resp *taprpc.SendAssetResponse) {
+			resp.Replayed = true
+		},
+		func() (*taprpc.SendAssetResponse, error) {
+			return r.sendAsset(ctx, req)
+		},
+	)
+}
+
+// sendAsset sends assets to the addresses specified in the request. Instead of
+// an address, the label of a contact in the contact book can be specified. If
+// the caller supplied an asset ticker, the send is rejected unless all
+// addresses are for the asset the ticker resolves to.
+func (r *rpcServer) sendAsset(ctx context.Context,
+	req *taprpc.SendAssetRequest) (*taprpc.SendAssetResponse, error) {
+
+	tapAddrs, err := r.resolveSendAddrs(ctx, req.TapAddrs)
+	if err != nil {
+		return nil, err
 	}
 
-	var (
-		tapAddrs = make([]*address.Tap, len(req.TapAddrs))
-		err      error
+	// If the caller specified the ticker of the asset they expect to send,
+	// we make sure the addresses are actually for that asset.
+	resolvedTicker, err := r.checkSendTicker(
+		ctx, req.AssetTicker, tapAddrs,
 	)
-	for idx := range req.TapAddrs {
-		if req.TapAddrs[idx] == "" {
-			return nil, fmt.Errorf("addr %d must be specified", idx)
-		}
-
-		tapAddrs[idx], err = address.DecodeAddress(
-			req.TapAddrs[idx], &r.cfg.ChainParams,
-		)
-		if err != nil {
-			return nil, err
-		}
+	if err != nil {
+		return nil, err
+	}
 
-		// Ensure all addrs are of the same asset ID. Within a single
-		// transfer (=a single virtual packet), we expect only to have
-		// inputs and outputs of the same asset ID. Multiple assets can
-		// be moved in a single BTC level anchor output, but the
-		// expectation is that they would be in separate virtual
-		// packets, one for each asset ID. They would then be merged
-		// into the same anchor output in the wallet's
-		// AnchorVirtualTransactions call.
-		//
-		// TODO(guggero): Support creating multiple virtual packets, one
-		// for each asset ID when the user wants to send multiple asset
-		// IDs at the same time without going through the PSBT flow.
-		//
-		// TODO(guggero): Revisit after we have a way to send fungible
-		// assets with different IDs to an address (non-interactive).
-		if idx > 0 {
-			if tapAddrs[idx].AssetID != tapAddrs[0].AssetID {
-				return nil, fmt.Errorf("all addrs must be of "+
-					"the same asset ID %v",
-					tapAddrs[0].AssetID)
-			}
-		}
+	// If the caller specified the amount they expect to send as a decimal
+	// number, we make sure the addresses actually add up to it.
+	err = r.checkSendDecimalAmount(ctx, req.DecimalAmount, tapAddrs)
+	if err != nil {
+		return nil, err
 	}
 
 	feeRate, err := checkFeeRateSanity(req.FeeRate)
@@ -3114,13 +3975,29 @@ func (r *rpcServer) SendAsset(_ context.Context,
 		return nil, err
 	}
 
-	resp, err := r.cfg.ChainPorter.RequestShipment(
-		tapfreighter.NewAddressParcel(feeRate, tapAddrs...),
+	addrParcel := tapfreighter.NewAddressParcel(
+		feeRate, fn.None[tapfreighter.MultiCommitmentSelectStrategy](),
+		tapAddrs...,
 	)
+	if err := addrParcel.SetNumConfs(req.NumConfs); err != nil {
+		return nil, err
+	}
+
+	resp, err := r.cfg.ChainPorter.RequestShipment(addrParcel)
 	if err != nil {
 		return nil, err
 	}
 
+	// The send itself succeeded, so we only log a failure to update the
+	// usage stats of the contacts we sent to.
+	if r.cfg.ContactBook != nil {
+		err := r.cfg.ContactBook.RecordSends(ctx, tapAddrs)
+		if err != nil {
+			rpcsLog.Warnf("Unable to record sends to contacts: %v",
+				err)
+		}
+	}
+
 	parcel, err := marshalOutboundParcel(resp)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
@@ -3128,24 +4005,178 @@ func (r *rpcServer) SendAsset(_ context.Context,
 	}
 
 	return &taprpc.SendAssetResponse{
-		Transfer: parcel,
+		Transfer:       parcel,
+		ResolvedTicker: resolvedTicker,
 	}, nil
 }
 
-// BurnAsset burns the given number of units of a given asset by sending them
-// to a provably un-spendable script key. Burning means irrevocably destroying
-// a certain number of assets, reducing the total supply of the asset. Because
-// burning is such a destructive and non-reversible operation, some specific
-// values need to be set in the request to avoid accidental burns.
-func (r *rpcServer) BurnAsset(ctx context.Context,
+// SendToScriptKey sends assets interactively to a raw script key and anchor
+// output internal key that the receiver handed out out of band.
+func (r *rpcServer) SendToScriptKey(ctx context.Context,
+	req *taprpc.SendToScriptKeyRequest) (*taprpc.SendToScriptKeyResponse,
+	error) {
+
+	if len(req.AssetId) != sha256.Size {
+		return nil, fmt.Errorf("asset ID must be 32 bytes")
+	}
+	if req.Amt == 0 {
+		return nil, fmt.Errorf("amount must be greater than zero")
+	}
+
+	scriptKey, err := btcec.ParsePubKey(req.ScriptKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid script key: %w", err)
+	}
+	internalKey, err := btcec.ParsePubKey(req.AnchorInternalKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid anchor internal key: %w", err)
+	}
+
+	assetVersion, err := taprpc.UnmarshalAssetVersion(req.AssetVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	feeRate, err := checkFeeRateSanity(req.FeeRate)
+	if err != nil {
+		return nil, err
+	}
+
+	dest := &tapfreighter.ScriptKeyDestination{
+		Amount:            req.Amt,
+		ScriptKey:         *scriptKey,
+		AnchorInternalKey: *internalKey,
+		AssetVersion:      assetVersion,
+	}
+	copy(dest.AssetID[:], req.AssetId)
+
+	resp, err := r.cfg.ChainPorter.RequestShipment(
+		tapfreighter.NewScriptKeyParcel(
+			feeRate,
+			fn.None[tapfreighter.MultiCommitmentSelectStrategy](),
+			dest,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	parcel, err := marshalOutboundParcel(resp)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
+			err)
+	}
+
+	return &taprpc.SendToScriptKeyResponse{
+		Transfer: parcel,
+	}, nil
+}
+
+// resolveSendAddrs decodes the given addresses or contact labels of a send and
+// makes sure they are all for the same asset.
+func (r *rpcServer) resolveSendAddrs(ctx context.Context,
+	rawAddrs []string) ([]*address.Tap, error) {
+
+	if len(rawAddrs) == 0 {
+		return nil, fmt.Errorf("at least one addr is required")
+	}
+
+	var (
+		tapAddrs = make([]*address.Tap, len(rawAddrs))
+		err      error
+	)
+	for idx := range rawAddrs {
+		if rawAddrs[idx] == "" {
+			return nil, fmt.Errorf("addr %d must be specified", idx)
+		}
+
+		tapAddrs[idx], err = r.resolveTapAddr(ctx, rawAddrs[idx])
+		if err != nil {
+			return nil, err
+		}
+
+		// Ensure all addrs are of the same asset ID. Within a single
+		// transfer (=a single virtual packet), we expect only to have
+		// inputs and outputs of the same asset ID. Multiple assets can
+		// be moved in a single BTC level anchor output, but the
+		// expectation is that they would be in separate virtual
+		// packets, one for each asset ID. They would then be merged
+		// into the same anchor output in the wallet's
+		// AnchorVirtualTransactions call.
+		//
+		// TODO(guggero): Support creating multiple virtual packets, one
+		// for each asset ID when the user wants to send multiple asset
+		// IDs at the same time without going through the PSBT flow.
+		//
+		// TODO(guggero): Revisit after we have a way to send fungible
+		// assets with different IDs to an address (non-interactive).
+		if idx > 0 {
+			if tapAddrs[idx].AssetID != tapAddrs[0].AssetID {
+				return nil, fmt.Errorf("all addrs must be of "+
+					"the same asset ID %v",
+					tapAddrs[0].AssetID)
+			}
+		}
+	}
+
+	return tapAddrs, nil
+}
+
+// resolveTapAddr decodes the given Taproot Asset address. If the string isn't
+// a valid address, it is looked up as the label of a contact in the contact
+// book instead.
+func (r *rpcServer) resolveTapAddr(ctx context.Context,
+	addrOrLabel string) (*address.Tap, error) {
+
+	addr, decodeErr := address.DecodeAddress(
+		addrOrLabel, &r.cfg.ChainParams,
+	)
+	if decodeErr == nil {
+		return addr, nil
+	}
+
+	if r.cfg.ContactBook == nil {
+		return nil, decodeErr
+	}
+
+	contact, err := r.cfg.ContactBook.FetchContact(ctx, addrOrLabel)
+	switch {
+	// Neither an address nor a known label, so the original decoding
+	// error is the more helpful one.
+	case errors.Is(err, address.ErrContactNotFound):
+		return nil, decodeErr
+
+	case err != nil:
+		return nil, err
+	}
+
+	addr, err = address.DecodeAddress(contact.Addr, &r.cfg.ChainParams)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address of contact %v: %w",
+			contact.Label, err)
+	}
+
+	return addr, nil
+}
+
+// BurnAsset burns the given number of units of a given asset by sending them
+// to a provably un-spendable script key. Burning means irrevocably destroying
+// a certain number of assets, reducing the total supply of the asset. Because
+// burning is such a destructive and non-reversible operation, some specific
+// values need to be set in the request to avoid accidental burns.
+func (r *rpcServer) BurnAsset(ctx context.Context,
 	in *taprpc.BurnAssetRequest) (*taprpc.BurnAssetResponse, error) {
 
 	rpcsLog.Debug("Executing asset burn")
 
-	var assetID asset.ID
+	constraints := tapfreighter.CommitmentConstraints{
+		MinAmt: in.AmountToBurn,
+	}
 	switch {
 	case len(in.GetAssetId()) > 0:
+		var assetID asset.ID
 		copy(assetID[:], in.GetAssetId())
+		constraints.AssetID = &assetID
 
 	case len(in.GetAssetIdStr()) > 0:
 		assetIDBytes, err := hex.DecodeString(in.GetAssetIdStr())
@@ -3154,10 +4185,23 @@ func (r *rpcServer) BurnAsset(ctx context.Context,
 				err)
 		}
 
+		var assetID asset.ID
 		copy(assetID[:], assetIDBytes)
+		constraints.AssetID = &assetID
+
+	// If only the group key is given, the burn can span multiple asset
+	// IDs of the group.
+	case len(in.GetGroupKey()) > 0:
+		groupKey, err := btcec.ParsePubKey(in.GetGroupKey())
+		if err != nil {
+			return nil, fmt.Errorf("error parsing group key: %w",
+				err)
+		}
+		constraints.GroupKey = groupKey
 
 	default:
-		return nil, fmt.Errorf("asset ID must be specified")
+		return nil, fmt.Errorf("asset ID or group key must be " +
+			"specified")
 	}
 
 	if in.AmountToBurn == 0 {
@@ -3169,86 +4213,205 @@ func (r *rpcServer) BurnAsset(ctx context.Context,
 			"accidental asset burns")
 	}
 
-	var groupKey *btcec.PublicKey
-	assetGroup, err := r.cfg.TapAddrBook.QueryAssetGroup(ctx, assetID)
-	switch {
-	case err == nil && assetGroup.GroupKey != nil:
-		// We found the asset group, so we can use the group key to
-		// burn the asset.
-		groupKey = &assetGroup.GroupPubKey
-	case errors.Is(err, address.ErrAssetGroupUnknown):
-		// We don't know the asset group, so we'll try to burn the
-		// asset using the asset ID only.
-		rpcsLog.Debug("Asset group key not found, asset may not be " +
-			"part of a group")
-	case err != nil:
-		return nil, fmt.Errorf("error querying asset group: %w", err)
+	// If an asset ID was given, we also constrain the coin selection to
+	// its group, if it has one.
+	if constraints.AssetID != nil {
+		assetID := *constraints.AssetID
+		assetGroup, err := r.cfg.TapAddrBook.QueryAssetGroup(
+			ctx, assetID,
+		)
+		switch {
+		case err == nil && assetGroup.GroupKey != nil:
+			// We found the asset group, so we can use the group
+			// key to burn the asset.
+			constraints.GroupKey = &assetGroup.GroupPubKey
+		case errors.Is(err, address.ErrAssetGroupUnknown):
+			// We don't know the asset group, so we'll try to burn
+			// the asset using the asset ID only.
+			rpcsLog.Debug("Asset group key not found, asset may " +
+				"not be part of a group")
+		case err != nil:
+			return nil, fmt.Errorf("error querying asset group: "+
+				"%w", err)
+		}
 	}
 
-	var serializedGroupKey []byte
-	if groupKey != nil {
-		serializedGroupKey = groupKey.SerializeCompressed()
+	var (
+		assetIDBytes       []byte
+		serializedGroupKey []byte
+	)
+	if constraints.AssetID != nil {
+		assetIDBytes = constraints.AssetID[:]
+	}
+	if constraints.GroupKey != nil {
+		serializedGroupKey = constraints.GroupKey.SerializeCompressed()
 	}
 
 	rpcsLog.Infof("Burning asset (asset_id=%x, group_key=%x, "+
-		"burn_amount=%d)", assetID[:], serializedGroupKey,
+		"burn_amount=%d)", assetIDBytes, serializedGroupKey,
 		in.AmountToBurn)
 
-	fundResp, err := r.cfg.AssetWallet.FundBurn(
-		ctx, &tapsend.FundingDescriptor{
-			ID:       assetID,
-			GroupKey: groupKey,
-			Amount:   in.AmountToBurn,
-		},
-	)
+	resp, burnProofs, err := r.burnAssets(ctx, constraints)
 	if err != nil {
-		return nil, fmt.Errorf("error funding burn: %w", err)
+		return nil, err
 	}
 
-	// Now we can sign the packet and send it to the chain.
-	_, err = r.cfg.AssetWallet.SignVirtualPacket(fundResp.VPacket)
+	parcel, err := marshalOutboundParcel(resp)
 	if err != nil {
-		return nil, fmt.Errorf("error signing packet: %w", err)
+		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
+			err)
+	}
+
+	// There is one burn output per burned asset ID.
+	rpcBurnProofs := make([]*taprpc.DecodedProof, len(burnProofs))
+	for idx := range burnProofs {
+		rpcBurnProofs[idx], err = r.marshalProof(
+			ctx, burnProofs[idx], true, false,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding burn proof: %w",
+				err)
+		}
+	}
+
+	return &taprpc.BurnAssetResponse{
+		BurnTransfer: parcel,
+		BurnProof:    rpcBurnProofs[0],
+		BurnProofs:   rpcBurnProofs,
+	}, nil
+}
+
+// burnAssets burns the total amount of units of the asset specified by the
+// given constraints in a single anchor transaction. As many inputs as needed
+// are spent and, if only a group key is given, the burn can span multiple asset
+// IDs of the group. The proofs of all burn outputs are returned along with the
+// transfer.
+func (r *rpcServer) burnAssets(ctx context.Context,
+	constraints tapfreighter.CommitmentConstraints) (
+	*tapfreighter.OutboundParcel, []*proof.Proof, error) {
+
+	fundResp, err := r.cfg.AssetWallet.FundBatchBurn(ctx, constraints)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error funding burn: %w", err)
+	}
+
+	// Now we can sign the packets and send them to the chain.
+	for _, vPkt := range fundResp.VPackets {
+		_, err = r.cfg.AssetWallet.SignVirtualPacket(vPkt)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error signing packet: %w",
+				err)
+		}
 	}
 
 	resp, err := r.cfg.ChainPorter.RequestShipment(
 		tapfreighter.NewPreSignedParcel(
-			[]*tappsbt.VPacket{fundResp.VPacket},
-			fundResp.InputCommitments,
+			fundResp.VPackets, fundResp.InputCommitments,
 		),
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	parcel, err := marshalOutboundParcel(resp)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
-			err)
-	}
+	// The transfer outputs are in the same order as the outputs of the
+	// virtual packets, so we can use them to find the burn proofs.
+	var (
+		burnProofs []*proof.Proof
+		outIdx     int
+	)
+	for _, vPkt := range fundResp.VPackets {
+		for _, vOut := range vPkt.Outputs {
+			tOut := resp.Outputs[outIdx]
+			outIdx++
 
-	var burnProof *taprpc.DecodedProof
-	for idx := range resp.Outputs {
-		vOut := fundResp.VPacket.Outputs[idx]
-		tOut := resp.Outputs[idx]
-		if vOut.Asset.IsBurn() {
-			p, err := proof.Decode(tOut.ProofSuffix)
-			if err != nil {
-				return nil, fmt.Errorf("error decoding "+
-					"burn proof: %w", err)
+			if !vOut.Asset.IsBurn() {
+				continue
 			}
 
-			burnProof, err = r.marshalProof(ctx, p, true, false)
+			p, err := proof.Decode(tOut.ProofSuffix)
 			if err != nil {
-				return nil, fmt.Errorf("error decoding "+
+				return nil, nil, fmt.Errorf("error decoding "+
 					"burn proof: %w", err)
 			}
+
+			burnProofs = append(burnProofs, p)
 		}
 	}
 
-	return &taprpc.BurnAssetResponse{
-		BurnTransfer: parcel,
-		BurnProof:    burnProof,
+	if len(burnProofs) == 0 {
+		return nil, nil, fmt.Errorf("no burn outputs in transfer")
+	}
+
+	return resp, burnProofs, nil
+}
+
+// BumpTransferFee replaces the anchor transaction of a pending transfer that is
+// still waiting to confirm with a version that pays a higher fee rate.
+func (r *rpcServer) BumpTransferFee(ctx context.Context,
+	req *taprpc.BumpTransferFeeRequest) (*taprpc.BumpTransferFeeResponse,
+	error) {
+
+	if req.AnchorTxid == "" {
+		return nil, fmt.Errorf("anchor txid must be set")
+	}
+
+	anchorTxHash, err := chainhash.NewHashFromStr(req.AnchorTxid)
+	if err != nil {
+		return nil, fmt.Errorf("invalid anchor txid: %w", err)
+	}
+
+	feeRate, err := checkFeeRateSanity(req.FeeRate)
+	if err != nil {
+		return nil, err
+	}
+	if feeRate == nil {
+		return nil, fmt.Errorf("fee rate must be set")
+	}
+
+	parcel, err := r.cfg.ChainPorter.BumpTransferFee(
+		ctx, *anchorTxHash, *feeRate,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to bump transfer fee: %w", err)
+	}
+
+	rpcTransfer, err := marshalOutboundParcel(parcel)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal transfer: %w", err)
+	}
+
+	return &taprpc.BumpTransferFeeResponse{
+		Transfer: rpcTransfer,
+	}, nil
+}
+
+// CancelTransfer cancels a pending transfer whose anchor transaction never made
+// it to the network and releases its inputs.
+func (r *rpcServer) CancelTransfer(ctx context.Context,
+	req *taprpc.CancelTransferRequest) (*taprpc.CancelTransferResponse,
+	error) {
+
+	if req.AnchorTxid == "" {
+		return nil, fmt.Errorf("anchor txid must be set")
+	}
+
+	anchorTxHash, err := chainhash.NewHashFromStr(req.AnchorTxid)
+	if err != nil {
+		return nil, fmt.Errorf("invalid anchor txid: %w", err)
+	}
+
+	parcel, err := r.cfg.ChainPorter.CancelParcel(ctx, *anchorTxHash)
+	if err != nil {
+		return nil, fmt.Errorf("unable to cancel transfer: %w", err)
+	}
+
+	rpcTransfer, err := marshalOutboundParcel(parcel)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal transfer: %w", err)
+	}
+
+	return &taprpc.CancelTransferResponse{
+		Transfer: rpcTransfer,
 	}, nil
 }
 
@@ -3329,6 +4492,7 @@ func marshalOutboundParcel(
 		AnchorTxChainFees:  parcel.ChainFees,
 		Inputs:             rpcInputs,
 		Outputs:            rpcOutputs,
+		NumConfs:           parcel.NumConfs,
 	}, nil
 }
 
@@ -4335,6 +5499,55 @@ func (r *rpcServer) FetchAssetMeta(ctx context.Context,
 	}, nil
 }
 
+// QueryAssetMeta returns the full metadata of an asset that has a leaf in one
+// of the local universes, identified by its meta hash.
+func (r *rpcServer) QueryAssetMeta(ctx context.Context,
+	req *unirpc.AssetMetaRequest) (*taprpc.AssetMeta, error) {
+
+	var metaHashBytes []byte
+	switch {
+	case len(req.GetMetaHash()) != 0:
+		metaHashBytes = req.GetMetaHash()
+
+	case req.GetMetaHashStr() != "":
+		var err error
+		metaHashBytes, err = hex.DecodeString(req.GetMetaHashStr())
+		if err != nil {
+			return nil, fmt.Errorf("error hex decoding meta hash: "+
+				"%w", err)
+		}
+
+	default:
+		return nil, fmt.Errorf("meta hash must be set")
+	}
+
+	if len(metaHashBytes) != asset.MetaHashLen {
+		return nil, fmt.Errorf("meta hash must be %d bytes",
+			asset.MetaHashLen)
+	}
+
+	var metaHash [asset.MetaHashLen]byte
+	copy(metaHash[:], metaHashBytes)
+
+	// Check the rate limiter to see if the client exceeded its quota or we
+	// need to wait at all. If not then this'll be a noop.
+	err := r.universeRateLimiter.Wait(ctx, queryAssetMetaMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	assetMeta, err := r.cfg.UniverseArchive.FetchAssetMeta(ctx, metaHash)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch asset meta: %w", err)
+	}
+
+	return &taprpc.AssetMeta{
+		Data:     assetMeta.Data,
+		Type:     taprpc.AssetMetaType(assetMeta.Type),
+		MetaHash: metaHash[:],
+	}, nil
+}
+
 // MarshalUniProofType marshals the universe proof type into the RPC
 // counterpart.
 func MarshalUniProofType(
@@ -4471,11 +5684,21 @@ func (r *rpcServer) MultiverseRoot(ctx context.Context,
 func (r *rpcServer) AssetRoots(ctx context.Context,
 	req *unirpc.AssetRootRequest) (*unirpc.AssetRootResponse, error) {
 
-	// Check the rate limiter to see if we need to wait at all. If not then
-	// this'll be a noop.
-	if err := r.proofQueryRateLimiter.Wait(ctx); err != nil {
+	// Check the rate limiter to see if the client exceeded its quota or we
+	// need to wait at all. If not then this'll be a noop.
+	err := r.universeRateLimiter.Wait(ctx, assetRootsMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read from the database snapshot the caller requested, if any.
+	ctx, snapshot, releaseSnapshot, err := r.withReadSnapshot(
+		ctx, universeMethodPrefix+assetRootsMethod, req.ReadSnapshot,
+	)
+	if err != nil {
 		return nil, err
 	}
+	defer releaseSnapshot()
 
 	// First, we'll retrieve the full set of known asset Universe roots.
 	assetRoots, err := r.cfg.UniverseArchive.RootNodes(
@@ -4492,6 +5715,7 @@ func (r *rpcServer) AssetRoots(ctx context.Context,
 
 	resp := &unirpc.AssetRootResponse{
 		UniverseRoots: make(map[string]*unirpc.UniverseRoot),
+		ReadSnapshot:  snapshot,
 	}
 
 	// Retrieve config for use in filtering asset roots based on sync export
@@ -4663,21 +5887,47 @@ func (r *rpcServer) QueryAssetRoots(ctx context.Context,
 			"given universe")
 	}
 
-	// Check the rate limiter to see if we need to wait at all. If not then
-	// this'll be a noop.
-	if err = r.proofQueryRateLimiter.Wait(ctx); err != nil {
+	// Check the rate limiter to see if the client exceeded its quota or we
+	// need to wait at all. If not then this'll be a noop.
+	err = r.universeRateLimiter.Wait(ctx, queryAssetRootsMethod)
+	if err != nil {
 		return nil, err
 	}
 
-	// Query for both a issaunce and transfer universe root.
-	assetRoots, err := r.queryAssetProofRoots(ctx, universeID)
+	// Read from the database snapshot the caller requested, if any.
+	ctx, snapshot, releaseSnapshot, err := r.withReadSnapshot(
+		ctx, universeMethodPrefix+queryAssetRootsMethod,
+		req.ReadSnapshot,
+	)
 	if err != nil {
 		return nil, err
 	}
+	defer releaseSnapshot()
 
-	// If no roots were found and the universe ID had no group key, the
-	// asset may be a grouped asset.
-	mayBeGrouped := assetRoots.IssuanceRoot.Id == nil &&
+	assetRoots, err := r.queryAssetOrGroupRoots(ctx, universeID)
+	if err != nil {
+		return nil, err
+	}
+	assetRoots.ReadSnapshot = snapshot
+
+	return assetRoots, nil
+}
+
+// queryAssetOrGroupRoots attempts to locate the current Universe roots for a
+// specific asset. If no roots are found for the asset ID, the roots of the
+// asset group the asset belongs to are returned instead.
+func (r *rpcServer) queryAssetOrGroupRoots(ctx context.Context,
+	universeID universe.Identifier) (*unirpc.QueryRootResponse, error) {
+
+	// Query for both a issaunce and transfer universe root.
+	assetRoots, err := r.queryAssetProofRoots(ctx, universeID)
+	if err != nil {
+		return nil, err
+	}
+
+	// If no roots were found and the universe ID had no group key, the
+	// asset may be a grouped asset.
+	mayBeGrouped := assetRoots.IssuanceRoot.Id == nil &&
 		assetRoots.TransferRoot.Id == nil && universeID.GroupKey == nil
 
 	// We already found some universe roots, or the original
@@ -4862,18 +6112,36 @@ func (r *rpcServer) AssetLeafKeys(ctx context.Context,
 		return nil, fmt.Errorf("invalid request limit")
 	}
 
-	// Check the rate limiter to see if we need to wait at all. If not then
-	// this'll be a noop.
-	if err = r.proofQueryRateLimiter.Wait(ctx); err != nil {
+	// Check the rate limiter to see if the client exceeded its quota or we
+	// need to wait at all. If not then this'll be a noop.
+	err = r.universeRateLimiter.Wait(ctx, assetLeafKeysMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read from the database snapshot the caller requested, if any.
+	ctx, snapshot, releaseSnapshot, err := r.withReadSnapshot(
+		ctx, universeMethodPrefix+assetLeafKeysMethod, req.ReadSnapshot,
+	)
+	if err != nil {
 		return nil, err
 	}
+	defer releaseSnapshot()
 
+	// Clients can restrict the query to a block height range, to only
+	// catch up on the proofs produced since their last sync.
 	leafKeys, err := r.cfg.UniverseArchive.UniverseLeafKeys(
 		ctx, universe.UniverseLeafKeysQuery{
 			Id:            universeID,
 			SortDirection: universe.SortDirection(req.Direction),
 			Offset:        req.Offset,
 			Limit:         req.Limit,
+			MinBlockHeight: unmarshalHeightBound(
+				req.MinBlockHeight,
+			),
+			MaxBlockHeight: unmarshalHeightBound(
+				req.MaxBlockHeight,
+			),
 		},
 	)
 	if err != nil {
@@ -4881,13 +6149,18 @@ func (r *rpcServer) AssetLeafKeys(ctx context.Context,
 	}
 
 	resp := &unirpc.AssetLeafKeyResponse{
-		AssetKeys: make([]*unirpc.AssetKey, len(leafKeys)),
+		AssetKeys:    make([]*unirpc.AssetKey, len(leafKeys)),
+		ReadSnapshot: snapshot,
 	}
 
 	for i, leafKey := range leafKeys {
 		resp.AssetKeys[i] = marshalLeafKey(leafKey)
 	}
 
+	r.signUniverseResponse(
+		ctx, "/universerpc.Universe/AssetLeafKeys", resp,
+	)
+
 	return resp, nil
 }
 
@@ -4896,15 +6169,22 @@ func marshalAssetLeaf(ctx context.Context, keys taprpc.KeyLookup,
 	decDisplay fn.Option[uint32]) (*unirpc.AssetLeaf, error) {
 
 	rpcAsset, err := taprpc.MarshalAsset(
-		ctx, assetLeaf.Asset, false, true, keys, decDisplay,
+		ctx, assetLeaf.Asset, false, true, keys, decDisplay, nil,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	extensions, err := assetLeaf.Extensions.Bytes()
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode leaf extensions: %w",
+			err)
+	}
+
 	return &unirpc.AssetLeaf{
-		Asset: rpcAsset,
-		Proof: assetLeaf.RawProof,
+		Asset:      rpcAsset,
+		Proof:      assetLeaf.RawProof,
+		Extensions: extensions,
 	}, nil
 }
 
@@ -4929,11 +6209,21 @@ func (r *rpcServer) AssetLeaves(ctx context.Context,
 		return nil, err
 	}
 
-	// Check the rate limiter to see if we need to wait at all. If not then
-	// this'll be a noop.
-	if err = r.proofQueryRateLimiter.Wait(ctx); err != nil {
+	// Check the rate limiter to see if the client exceeded its quota or we
+	// need to wait at all. If not then this'll be a noop.
+	err = r.universeRateLimiter.Wait(ctx, assetLeavesMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read from the database snapshot the caller requested, if any.
+	ctx, snapshot, releaseSnapshot, err := r.withReadSnapshot(
+		ctx, universeMethodPrefix+assetLeavesMethod, req.ReadSnapshot,
+	)
+	if err != nil {
 		return nil, err
 	}
+	defer releaseSnapshot()
 
 	assetLeaves, err := r.cfg.UniverseArchive.MintingLeaves(ctx, universeID)
 	if err != nil {
@@ -4941,7 +6231,8 @@ func (r *rpcServer) AssetLeaves(ctx context.Context,
 	}
 
 	resp := &unirpc.AssetLeafResponse{
-		Leaves: make([]*unirpc.AssetLeaf, len(assetLeaves)),
+		Leaves:       make([]*unirpc.AssetLeaf, len(assetLeaves)),
+		ReadSnapshot: snapshot,
 	}
 	for i, assetLeaf := range assetLeaves {
 		assetLeaf := assetLeaf
@@ -5154,11 +6445,21 @@ func (r *rpcServer) QueryProof(ctx context.Context,
 			"given universe")
 	}
 
-	// Check the rate limiter to see if we need to wait at all. If not then
-	// this'll be a noop.
-	if err = r.proofQueryRateLimiter.Wait(ctx); err != nil {
+	// Check the rate limiter to see if the client exceeded its quota or we
+	// need to wait at all. If not then this'll be a noop.
+	err = r.universeRateLimiter.Wait(ctx, queryProofMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read from the database snapshot the caller requested, if any.
+	ctx, snapshot, releaseSnapshot, err := r.withReadSnapshot(
+		ctx, universeMethodPrefix+queryProofMethod, req.ReadSnapshot,
+	)
+	if err != nil {
 		return nil, err
 	}
+	defer releaseSnapshot()
 
 	// Attempt to retrieve the proof given the candidate set of universe
 	// IDs.
@@ -5199,7 +6500,15 @@ func (r *rpcServer) QueryProof(ctx context.Context,
 		"leafKey=%x)", universeID.StringForLog(),
 		leafKey.UniverseKey())
 
-	return r.marshalUniverseProofLeaf(ctx, req, firstProof)
+	resp, err := r.marshalUniverseProofLeaf(ctx, req, firstProof)
+	if err != nil {
+		return nil, err
+	}
+	resp.ReadSnapshot = snapshot
+
+	r.signUniverseResponse(ctx, "/universerpc.Universe/QueryProof", resp)
+
+	return resp, nil
 }
 
 // unmarshalAssetLeaf unmarshals an asset leaf from the RPC form.
@@ -5216,14 +6525,27 @@ func unmarshalAssetLeaf(leaf *unirpc.AssetLeaf) (*universe.Leaf, error) {
 	// TODO(roasbeef): double check posted file format everywhere
 	//  * raw proof, or within file?
 
+	// Older clients and servers don't send any extensions.
+	var extensions universe.LeafExtensions
+	if len(leaf.Extensions) > 0 {
+		extensions, err = universe.DecodeLeafExtensions(
+			bytes.NewReader(leaf.Extensions),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode leaf "+
+				"extensions: %w", err)
+		}
+	}
+
 	return &universe.Leaf{
 		GenesisWithGroup: universe.GenesisWithGroup{
 			Genesis:  proofAsset.Genesis,
 			GroupKey: proofAsset.GroupKey,
 		},
-		RawProof: leaf.Proof,
-		Asset:    &proofAsset,
-		Amt:      proofAsset.Amount,
+		RawProof:   leaf.Proof,
+		Asset:      &proofAsset,
+		Amt:        proofAsset.Amount,
+		Extensions: extensions,
 	}, nil
 }
 
@@ -5281,9 +6603,10 @@ func (r *rpcServer) InsertProof(ctx context.Context,
 			"given universe")
 	}
 
-	// Check the rate limiter to see if we need to wait at all. If not then
-	// this'll be a noop.
-	if err = r.proofQueryRateLimiter.Wait(ctx); err != nil {
+	// Check the rate limiter to see if the client exceeded its quota or we
+	// need to wait at all. If not then this'll be a noop.
+	err = r.universeRateLimiter.Wait(ctx, insertProofMethod)
+	if err != nil {
 		return nil, err
 	}
 
@@ -5302,6 +6625,12 @@ func (r *rpcServer) InsertProof(ctx context.Context,
 	rpcsLog.Debugf("[InsertProof]: proof inserted, new universe root: %x",
 		universeRootHash[:])
 
+	// Let the federation know our root changed, so its members can pull
+	// the new leaf right away.
+	r.cfg.UniverseFederation.NotifyRootChange(
+		universeID, newUniverseState.UniverseRoot, assetLeaf,
+	)
+
 	return r.marshalUniverseProofLeaf(ctx, req.Key, newUniverseState)
 }
 
@@ -5309,9 +6638,52 @@ func (r *rpcServer) InsertProof(ctx context.Context,
 func (r *rpcServer) Info(ctx context.Context,
 	_ *unirpc.InfoRequest) (*unirpc.InfoResponse, error) {
 
-	return &unirpc.InfoResponse{
+	resp := &unirpc.InfoResponse{
 		RuntimeId: r.cfg.RuntimeID,
-	}, nil
+	}
+
+	// We advertise our sync protocol capabilities in the response, so
+	// syncing peers can negotiate the sync session parameters and pace
+	// their queries according to our rate limit. Older peers will just
+	// ignore the additional fields.
+	syncInfo := universe.LocalSyncProtocolInfo(
+		r.cfg.ChainParams.NetworkID(),
+	)
+	syncInfo.ServerVersion = Version()
+	qps, burst := r.universeRateLimiter.limits()
+	if qps != rate.Inf {
+		syncInfo.QueriesPerSecond = float64(qps)
+		syncInfo.QueriesBurst = burst
+	}
+
+	if err := marshalSyncProtocolInfo(syncInfo, resp); err != nil {
+		return nil, fmt.Errorf("unable to marshal sync protocol info: "+
+			"%w", err)
+	}
+
+	return resp, nil
+}
+
+// NotifyRootUpdate notifies the Universe server that a universe root of one of
+// its federation members changed, so the server can pull the new leaves right
+// away instead of waiting for the next periodic sync. The notification is only
+// accepted if it is signed with the identity key that was set for the
+// federation member it originates from.
+func (r *rpcServer) NotifyRootUpdate(ctx context.Context,
+	req *unirpc.NotifyRootUpdateRequest) (*unirpc.NotifyRootUpdateResponse,
+	error) {
+
+	notif, err := unmarshalRootNotification(req)
+	if err != nil {
+		return nil, fmt.Errorf("invalid root notification: %w", err)
+	}
+
+	err = r.cfg.UniverseFederation.HandleRootNotification(ctx, notif)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.NotifyRootUpdateResponse{}, nil
 }
 
 // unmarshalUniverseSyncType maps an RPC universe sync type into a concrete
@@ -5384,11 +6756,16 @@ func (r *rpcServer) marshalUniverseDiff(ctx context.Context,
 			}
 		}
 
+		totals := marshalSyncTotals(diff.Totals())
+		outcomes := fn.Map(diff.LeafOutcomes, marshalLeafSyncOutcome)
+
 		resp.SyncedUniverses = append(
 			resp.SyncedUniverses, &unirpc.SyncedUniverse{
 				OldAssetRoot:   oldUniRoot,
 				NewAssetRoot:   newUniRoot,
 				NewAssetLeaves: leaves,
+				Totals:         totals,
+				LeafOutcomes:   outcomes,
 			},
 		)
 		return nil
@@ -5397,6 +6774,8 @@ func (r *rpcServer) marshalUniverseDiff(ctx context.Context,
 		return nil, err
 	}
 
+	resp.Totals = marshalSyncTotals(universe.SumSyncTotals(uniDiff))
+
 	return resp, nil
 }
 
@@ -5422,24 +6801,49 @@ func (r *rpcServer) SyncUniverse(ctx context.Context,
 
 	uniAddr := universe.NewServerAddrFromStr(req.UniverseHost)
 
-	// Obtain the general and universe specific federation sync configs.
-	queryFedSyncConfigs := r.cfg.FederationDB.QueryFederationSyncConfigs
-	globalConfigs, uniSyncConfigs, err := queryFedSyncConfigs(ctx)
+	// Obtain the general and universe specific federation sync configs,
+	// including the configured asset filter.
+	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("unable to query federation sync "+
-			"config(s): %w", err)
+		return nil, err
 	}
 
-	syncConfigs := universe.SyncConfigs{
-		GlobalSyncConfigs: globalConfigs,
-		UniSyncConfigs:    uniSyncConfigs,
+	// A dry run only compares the local universe with the remote one,
+	// without inserting anything.
+	if req.DryRun {
+		dryRunSyncer, ok := r.cfg.UniverseSyncer.(universe.DryRunSyncer)
+		if !ok {
+			return nil, fmt.Errorf("universe syncer doesn't " +
+				"support dry runs")
+		}
+
+		reports, err := dryRunSyncer.SyncUniverseDryRun(
+			ctx, uniAddr, syncMode, *syncConfigs, syncTargets...,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to compare universe: "+
+				"%w", err)
+		}
+
+		divergences, err := fn.MapErr(reports, marshalDivergenceReport)
+		if err != nil {
+			return nil, err
+		}
+
+		return &unirpc.SyncResponse{
+			Divergences: divergences,
+		}, nil
 	}
 
+	// We use the streaming sync, so a sync that is interrupted, for
+	// example because the client gave up waiting, resumes from its last
+	// checkpoint when it is requested again.
+	//
 	// TODO(roasbeef): add layer of indirection in front of?
 	//  * just interface interaction
 	// TODO(ffranr): Sync via the FederationEnvoy rather than syncer.
-	universeDiff, err := r.cfg.UniverseSyncer.SyncUniverse(
-		ctx, uniAddr, syncMode, syncConfigs, syncTargets...,
+	universeDiff, err := r.cfg.UniverseSyncer.SyncUniverseStream(
+		ctx, uniAddr, syncMode, *syncConfigs, nil, syncTargets...,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to sync universe: %w", err)
@@ -5448,12 +6852,47 @@ func (r *rpcServer) SyncUniverse(ctx context.Context,
 	return r.marshalUniverseDiff(ctx, universeDiff)
 }
 
+// marshalDivergenceReport marshals the report of a dry run sync of a universe
+// into the RPC form.
+func marshalDivergenceReport(
+	report universe.DivergenceReport) (*unirpc.UniverseDivergence, error) {
+
+	uniID, err := MarshalUniID(report.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteRoot, err := marshalUniverseRoot(report.RemoteRoot)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal remote root: %w", err)
+	}
+
+	divergence := &unirpc.UniverseDivergence{
+		Id:          uniID,
+		RemoteRoot:  remoteRoot,
+		InSync:      report.InSync(),
+		RemoteOnly:  fn.Map(report.RemoteOnly, marshalLeafKey),
+		LocalOnly:   fn.Map(report.LocalOnly, marshalLeafKey),
+		Conflicting: fn.Map(report.Conflicting, marshalLeafKey),
+	}
+	if report.LocalRoot != nil {
+		divergence.LocalRoot = marshalMssmtNode(report.LocalRoot)
+	}
+
+	return divergence, nil
+}
+
 func marshalUniverseServer(
 	server universe.ServerAddr) *unirpc.UniverseFederationServer {
 
 	return &unirpc.UniverseFederationServer{
 		Host: server.HostStr(),
 		Id:   int32(server.ID),
+		IdentityKey: fn.MapOptionZ(
+			server.IdentityKey, func(k btcec.PublicKey) []byte {
+				return k.SerializeCompressed()
+			},
+		),
 	}
 }
 
@@ -5475,9 +6914,39 @@ func (r *rpcServer) ListFederationServers(ctx context.Context,
 }
 
 func unmarshalUniverseServer(
-	server *unirpc.UniverseFederationServer) universe.ServerAddr {
+	server *unirpc.UniverseFederationServer) (universe.ServerAddr, error) {
+
+	addr := universe.NewServerAddr(int64(server.Id), server.Host)
+	if len(server.IdentityKey) != 0 {
+		key, err := btcec.ParsePubKey(server.IdentityKey)
+		if err != nil {
+			return addr, fmt.Errorf("invalid identity key of "+
+				"server %v: %w", server.Host, err)
+		}
+
+		addr.IdentityKey = fn.Some(*key)
+	}
 
-	return universe.NewServerAddr(int64(server.Id), server.Host)
+	return addr, nil
+}
+
+// unmarshalUniverseServers unmarshals a slice of federation servers from their
+// RPC form.
+func unmarshalUniverseServers(
+	servers []*unirpc.UniverseFederationServer) ([]universe.ServerAddr,
+	error) {
+
+	addrs := make([]universe.ServerAddr, 0, len(servers))
+	for _, server := range servers {
+		addr, err := unmarshalUniverseServer(server)
+		if err != nil {
+			return nil, err
+		}
+
+		addrs = append(addrs, addr)
+	}
+
+	return addrs, nil
 }
 
 // AddFederationServer adds a new server to the federation of the local
@@ -5487,7 +6956,10 @@ func (r *rpcServer) AddFederationServer(ctx context.Context,
 	req *unirpc.AddFederationServerRequest,
 ) (*unirpc.AddFederationServerResponse, error) {
 
-	serversToAdd := fn.Map(req.Servers, unmarshalUniverseServer)
+	serversToAdd, err := unmarshalUniverseServers(req.Servers)
+	if err != nil {
+		return nil, err
+	}
 
 	for idx := range serversToAdd {
 		server := serversToAdd[idx]
@@ -5496,14 +6968,15 @@ func (r *rpcServer) AddFederationServer(ctx context.Context,
 		// that we can actually connect to it and that it isn't
 		// ourselves.
 		err := CheckFederationServer(
-			r.cfg.RuntimeID, universe.DefaultTimeout, server,
+			r.cfg.RuntimeID, r.cfg.ChainParams.NetworkID(),
+			universe.DefaultTimeout, server, r.cfg.UniverseDialNet,
 		)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	err := r.cfg.UniverseFederation.AddServer(serversToAdd...)
+	err = r.cfg.UniverseFederation.AddServer(serversToAdd...)
 	if err != nil {
 		return nil, err
 	}
@@ -5517,12 +6990,15 @@ func (r *rpcServer) DeleteFederationServer(ctx context.Context,
 	req *unirpc.DeleteFederationServerRequest,
 ) (*unirpc.DeleteFederationServerResponse, error) {
 
-	serversToDel := fn.Map(req.Servers, unmarshalUniverseServer)
+	serversToDel, err := unmarshalUniverseServers(req.Servers)
+	if err != nil {
+		return nil, err
+	}
 
 	// Remove the servers from the proofs sync log. This is necessary before
 	// we can remove the servers from the database because of a foreign
 	// key constraint.
-	err := r.cfg.FederationDB.DeleteProofsSyncLogEntries(
+	err = r.cfg.FederationDB.DeleteProofsSyncLogEntries(
 		ctx, serversToDel...,
 	)
 	if err != nil {
@@ -5543,6 +7019,14 @@ func (r *rpcServer) SetFederationSyncConfig(ctx context.Context,
 	req *unirpc.SetFederationSyncConfigRequest) (
 	*unirpc.SetFederationSyncConfigResponse, error) {
 
+	// We parse the sync schedules first, so an invalid schedule doesn't
+	// leave us with a partially applied config.
+	syncSchedules, err := UnmarshalSyncSchedules(req.SyncSchedules)
+	if err != nil {
+		return nil, fmt.Errorf("invalid federation sync schedules: %w",
+			err)
+	}
+
 	// Unmarshal global sync configs.
 	globalSyncConfig := make(
 		[]*universe.FedGlobalSyncConfig, len(req.GlobalSyncConfigs),
@@ -5579,7 +7063,7 @@ func (r *rpcServer) SetFederationSyncConfig(ctx context.Context,
 	}
 
 	// Update asset (asset/asset group) specific sync configs.
-	err := r.cfg.FederationDB.UpsertFederationSyncConfig(
+	err = r.cfg.FederationDB.UpsertFederationSyncConfig(
 		ctx, globalSyncConfig, assetSyncConfigs,
 	)
 	if err != nil {
@@ -5587,6 +7071,16 @@ func (r *rpcServer) SetFederationSyncConfig(ctx context.Context,
 			"config: %w", err)
 	}
 
+	if len(req.SyncSchedules) != 0 {
+		err = r.cfg.UniverseFederation.UpsertSyncSchedules(
+			ctx, syncSchedules,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to set federation sync "+
+				"schedules: %w", err)
+		}
+	}
+
 	return &unirpc.SetFederationSyncConfigResponse{}, nil
 }
 
@@ -5604,6 +7098,38 @@ func (r *rpcServer) QueryFederationSyncConfig(ctx context.Context,
 			"config(s): %w", err)
 	}
 
+	globalConfigRPC, uniConfigRPCs, err := marshalFedSyncConfigs(
+		globalConfigs, uniSyncConfigs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	syncSchedules, err := r.cfg.UniverseFederation.QuerySyncSchedules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query federation sync "+
+			"schedules: %w", err)
+	}
+
+	rpcSyncSchedules, err := marshalSyncSchedules(syncSchedules)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.QueryFederationSyncConfigResponse{
+		GlobalSyncConfigs: globalConfigRPC,
+		AssetSyncConfigs:  uniConfigRPCs,
+		SyncSchedules:     rpcSyncSchedules,
+	}, nil
+}
+
+// marshalFedSyncConfigs turns the given global and universe specific
+// federation sync configs into their RPC counterparts.
+func marshalFedSyncConfigs(globalConfigs []*universe.FedGlobalSyncConfig,
+	uniSyncConfigs []*universe.FedUniSyncConfig) (
+	[]*unirpc.GlobalFederationSyncConfig,
+	[]*unirpc.AssetFederationSyncConfig, error) {
+
 	// Marshal the general sync config into the RPC form.
 	globalConfigRPC := make(
 		[]*unirpc.GlobalFederationSyncConfig, len(globalConfigs),
@@ -5613,7 +7139,7 @@ func (r *rpcServer) QueryFederationSyncConfig(ctx context.Context,
 
 		proofTypeRpc, err := MarshalUniProofType(globalConfig.ProofType)
 		if err != nil {
-			return nil, fmt.Errorf("unable to unmarshal "+
+			return nil, nil, fmt.Errorf("unable to unmarshal "+
 				"proof type: %w", err)
 		}
 
@@ -5632,15 +7158,341 @@ func (r *rpcServer) QueryFederationSyncConfig(ctx context.Context,
 		uniSyncConfig := uniSyncConfigs[i]
 		uniConfigRPC, err := MarshalAssetFedSyncCfg(*uniSyncConfig)
 		if err != nil {
-			return nil, fmt.Errorf("unable to marshal universe "+
-				"specific federation sync config: %w", err)
+			return nil, nil, fmt.Errorf("unable to marshal "+
+				"universe specific federation sync config: %w",
+				err)
 		}
 		uniConfigRPCs[i] = uniConfigRPC
 	}
 
-	return &unirpc.QueryFederationSyncConfigResponse{
-		GlobalSyncConfigs: globalConfigRPC,
-		AssetSyncConfigs:  uniConfigRPCs,
+	return globalConfigRPC, uniConfigRPCs, nil
+}
+
+// marshalFederationProfile turns a federation profile into its RPC
+// counterpart.
+func marshalFederationProfile(
+	profile *universe.FederationProfile) (*unirpc.FederationProfile,
+	error) {
+
+	globalConfigs, uniConfigs, err := marshalFedSyncConfigs(
+		profile.GlobalSyncConfigs, profile.UniSyncConfigs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	servers := fn.Map(profile.Servers, marshalUniverseServer)
+
+	return &unirpc.FederationProfile{
+		Name:              profile.Name,
+		Servers:           servers,
+		GlobalSyncConfigs: globalConfigs,
+		AssetSyncConfigs:  uniConfigs,
+		Active:            profile.Active,
+	}, nil
+}
+
+// ListFederationProfiles lists the stored federation profiles.
+func (r *rpcServer) ListFederationProfiles(ctx context.Context,
+	_ *unirpc.ListFederationProfilesRequest) (
+	*unirpc.ListFederationProfilesResponse, error) {
+
+	profiles, err := r.cfg.UniverseFederation.ListFederationProfiles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list federation profiles: "+
+			"%w", err)
+	}
+
+	rpcProfiles := make([]*unirpc.FederationProfile, len(profiles))
+	for idx := range profiles {
+		rpcProfiles[idx], err = marshalFederationProfile(profiles[idx])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &unirpc.ListFederationProfilesResponse{
+		Profiles: rpcProfiles,
+	}, nil
+}
+
+// SaveFederationProfile stores the current set of federation servers and sync
+// configs as a profile with the given name.
+func (r *rpcServer) SaveFederationProfile(ctx context.Context,
+	req *unirpc.SaveFederationProfileRequest) (
+	*unirpc.SaveFederationProfileResponse, error) {
+
+	if req.Name == "" {
+		return nil, fmt.Errorf("profile name must be set")
+	}
+
+	profile, err := r.cfg.UniverseFederation.SaveFederationProfile(
+		ctx, req.Name,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to save federation profile: %w",
+			err)
+	}
+
+	rpcProfile, err := marshalFederationProfile(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.SaveFederationProfileResponse{
+		Profile: rpcProfile,
+	}, nil
+}
+
+// ApplyFederationProfile switches the federation to the profile with the
+// given name.
+func (r *rpcServer) ApplyFederationProfile(ctx context.Context,
+	req *unirpc.ApplyFederationProfileRequest) (
+	*unirpc.ApplyFederationProfileResponse, error) {
+
+	err := r.cfg.UniverseFederation.ApplyFederationProfile(ctx, req.Name)
+	if err != nil {
+		return nil, fmt.Errorf("unable to apply federation profile: "+
+			"%w", err)
+	}
+
+	return &unirpc.ApplyFederationProfileResponse{}, nil
+}
+
+// DeleteFederationProfile deletes the federation profile with the given name.
+func (r *rpcServer) DeleteFederationProfile(ctx context.Context,
+	req *unirpc.DeleteFederationProfileRequest) (
+	*unirpc.DeleteFederationProfileResponse, error) {
+
+	err := r.cfg.UniverseFederation.DeleteFederationProfile(ctx, req.Name)
+	if err != nil {
+		return nil, fmt.Errorf("unable to delete federation profile: "+
+			"%w", err)
+	}
+
+	return &unirpc.DeleteFederationProfileResponse{}, nil
+}
+
+// PushProof immediately pushes a single proof leaf of the local universe to
+// the given federation servers, or to all of them if no servers are given.
+func (r *rpcServer) PushProof(ctx context.Context,
+	req *unirpc.PushProofRequest) (*unirpc.PushProofResponse, error) {
+
+	if req.Key == nil {
+		return nil, fmt.Errorf("universe key must be set")
+	}
+
+	universeID, err := UnmarshalUniID(req.Key.Id)
+	if err != nil {
+		return nil, err
+	}
+	if universeID.ProofType == universe.ProofTypeUnspecified {
+		return nil, fmt.Errorf("proof type must be specified")
+	}
+
+	leafKey, err := unmarshalLeafKey(req.Key.LeafKey)
+	if err != nil {
+		return nil, err
+	}
+
+	servers, err := unmarshalUniverseServers(req.Servers)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.cfg.UniverseFederation.PushProofLeaf(
+		ctx, universeID, leafKey, servers...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &unirpc.PushProofResponse{
+		Key:     req.Key,
+		Results: make([]*unirpc.PushProofResult, 0, len(results)),
+	}
+	for _, result := range results {
+		rpcResult := &unirpc.PushProofResult{
+			Server:  marshalUniverseServer(result.Server),
+			Success: result.Err == nil,
+		}
+		if result.Err != nil {
+			rpcResult.Error = result.Err.Error()
+		}
+
+		resp.Results = append(resp.Results, rpcResult)
+	}
+
+	return resp, nil
+}
+
+// SubscribeLeaves subscribes to the proof leaves that are inserted or updated
+// in the given universes. If no universe is given, the leaves of all universes
+// are streamed.
+func (r *rpcServer) SubscribeLeaves(req *unirpc.SubscribeLeavesRequest,
+	stream unirpc.Universe_SubscribeLeavesServer) error {
+
+	ids := make([]universe.Identifier, 0, len(req.Ids))
+	for _, rpcID := range req.Ids {
+		id, err := UnmarshalUniID(rpcID)
+		if err != nil {
+			return fmt.Errorf("invalid universe ID: %w", err)
+		}
+
+		ids = append(ids, id)
+	}
+
+	receiver := fn.NewEventReceiver[*universe.LeafEvent](
+		fn.DefaultQueueSize,
+	)
+	defer receiver.Stop()
+
+	err := r.cfg.UniverseArchive.RegisterLeafSubscriber(receiver, ids...)
+	if err != nil {
+		return fmt.Errorf("unable to register leaf subscriber: %w", err)
+	}
+
+	// Remove the subscriber when we're done, so the archive doesn't block
+	// on delivering new events to a stopped receiver.
+	defer func() {
+		err := r.cfg.UniverseArchive.RemoveLeafSubscriber(receiver)
+		if err != nil {
+			rpcsLog.Errorf("Error removing leaf subscriber: %v",
+				err)
+		}
+	}()
+
+	ctx := stream.Context()
+	for {
+		select {
+		case event := <-receiver.NewItemCreated.ChanOut():
+			rpcEvent, err := r.marshalLeafEvent(ctx, event)
+			if err != nil {
+				return fmt.Errorf("unable to marshal leaf "+
+					"event: %w", err)
+			}
+
+			if err := stream.Send(rpcEvent); err != nil {
+				return fmt.Errorf("unable to send leaf "+
+					"event: %w", err)
+			}
+
+		case <-ctx.Done():
+			// Don't return an error if a normal context
+			// cancellation has occurred.
+			if errors.Is(ctx.Err(), context.Canceled) {
+				return nil
+			}
+
+			return ctx.Err()
+
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// SubscribeUniverseRoots subscribes to changes of the roots of the local
+// universes of the given assets and asset groups. If no asset or group is
+// given, the root changes of all universes are streamed.
+func (r *rpcServer) SubscribeUniverseRoots(
+	req *unirpc.SubscribeUniverseRootsRequest,
+	stream unirpc.Universe_SubscribeUniverseRootsServer) error {
+
+	var filter universe.RootFilter
+	for _, rawID := range req.AssetIds {
+		if len(rawID) != sha256.Size {
+			return fmt.Errorf("invalid asset id length")
+		}
+
+		var assetID asset.ID
+		copy(assetID[:], rawID)
+		filter.AssetIDs = append(filter.AssetIDs, assetID)
+	}
+	for _, rawKey := range req.GroupKeys {
+		groupKey, err := parseUserKey(rawKey)
+		if err != nil {
+			return fmt.Errorf("invalid group key: %w", err)
+		}
+
+		filter.GroupKeys = append(filter.GroupKeys, groupKey)
+	}
+
+	receiver := fn.NewEventReceiver[*universe.RootEvent](
+		fn.DefaultQueueSize,
+	)
+	defer receiver.Stop()
+
+	r.cfg.UniverseRootEvents.RegisterSubscriber(receiver, filter)
+
+	// Remove the subscriber when we're done, so the notifier doesn't block
+	// on delivering new events to a stopped receiver.
+	defer func() {
+		err := r.cfg.UniverseRootEvents.RemoveSubscriber(receiver)
+		if err != nil {
+			rpcsLog.Errorf("Error removing root subscriber: %v",
+				err)
+		}
+	}()
+
+	ctx := stream.Context()
+	for {
+		select {
+		case event := <-receiver.NewItemCreated.ChanOut():
+			rpcRoot, err := marshalUniverseRoot(event.Root)
+			if err != nil {
+				return fmt.Errorf("unable to marshal universe "+
+					"root: %w", err)
+			}
+
+			err = stream.Send(&unirpc.UniverseRootEvent{
+				Root:      rpcRoot,
+				Timestamp: event.Timestamp().Unix(),
+			})
+			if err != nil {
+				return fmt.Errorf("unable to send root event: "+
+					"%w", err)
+			}
+
+		case <-ctx.Done():
+			// Don't return an error if a normal context
+			// cancellation has occurred.
+			if errors.Is(ctx.Err(), context.Canceled) {
+				return nil
+			}
+
+			return ctx.Err()
+
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// marshalLeafEvent maps a universe leaf event to its RPC counterpart.
+func (r *rpcServer) marshalLeafEvent(ctx context.Context,
+	event *universe.LeafEvent) (*unirpc.UniverseLeafEvent, error) {
+
+	rpcID, err := MarshalUniID(event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	decDisplay, err := r.DecDisplayForAssetID(ctx, event.Leaf.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	rpcLeaf, err := r.marshalAssetLeaf(ctx, event.Leaf, fn.Some(decDisplay))
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.UniverseLeafEvent{
+		Id:        rpcID,
+		LeafKey:   marshalLeafKey(event.Key),
+		Leaf:      rpcLeaf,
+		Timestamp: event.Timestamp().Unix(),
 	}, nil
 }
 
@@ -5898,12 +7750,20 @@ func (r *rpcServer) QueryEvents(ctx context.Context,
 		return nil, fmt.Errorf("end time cannot be before start time")
 	}
 
-	stats, err := r.cfg.UniverseStats.QueryAssetStatsPerDay(
-		ctx, universe.GroupedStatsQuery{
-			StartTime: startTime,
-			EndTime:   endTime,
-		},
-	)
+	query := universe.GroupedStatsQuery{
+		StartTime: startTime,
+		EndTime:   endTime,
+	}
+	if len(req.AssetIdFilter) > 0 {
+		if len(req.AssetIdFilter) != sha256.Size {
+			return nil, fmt.Errorf("asset ID filter must be %d "+
+				"bytes", sha256.Size)
+		}
+
+		copy(query.AssetIDFilter[:], req.AssetIdFilter)
+	}
+
+	stats, err := r.cfg.UniverseStats.QueryAssetStatsPerDay(ctx, query)
 	if err != nil {
 		return nil, fmt.Errorf("error querying stats: %w", err)
 	}
@@ -5912,11 +7772,21 @@ func (r *rpcServer) QueryEvents(ctx context.Context,
 		Events: make([]*unirpc.GroupedUniverseEvents, len(stats)),
 	}
 	for day, s := range stats {
-		rpcStats.Events[day] = &unirpc.GroupedUniverseEvents{
+		event := &unirpc.GroupedUniverseEvents{
 			Date:           s.Date,
 			SyncEvents:     s.NumTotalSyncs,
 			NewProofEvents: s.NumTotalProofs,
 		}
+
+		if len(req.AssetIdFilter) > 0 {
+			event.SupplyDeltas = &unirpc.AssetSupplyDeltas{
+				MintedAmount: s.SupplyDeltas.MintedAmount,
+				BurnedAmount: s.SupplyDeltas.BurnedAmount,
+				NumTransfers: s.SupplyDeltas.NumTransfers,
+			}
+		}
+
+		rpcStats.Events[day] = event
 	}
 
 	return rpcStats, nil
@@ -6602,6 +8472,56 @@ func (r *rpcServer) DeclareScriptKey(ctx context.Context,
 	}, nil
 }
 
+// ValidateVirtualPsbt statelessly validates a virtual PSBT built by an external
+// party, without signing anything or touching the wallet. The input
+// commitments are derived from the proofs of the virtual inputs.
+func (r *rpcServer) ValidateVirtualPsbt(_ context.Context,
+	req *wrpc.ValidateVirtualPsbtRequest) (
+	*wrpc.ValidateVirtualPsbtResponse, error) {
+
+	if len(req.VirtualPsbt) == 0 {
+		return nil, fmt.Errorf("virtual PSBT must be set")
+	}
+
+	vPkt, err := tappsbt.Decode(req.VirtualPsbt)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding packet: %w", err)
+	}
+
+	inputCommitments, findings := tapsend.InputCommitmentsFromProofs(vPkt)
+	findings = append(
+		findings, tapsend.ValidateVPacket(vPkt, inputCommitments)...,
+	)
+
+	resp := &wrpc.ValidateVirtualPsbtResponse{
+		Valid: len(findings) == 0,
+	}
+	for _, f := range findings {
+		resp.Findings = append(resp.Findings, marshalVPacketFinding(f))
+	}
+
+	return resp, nil
+}
+
+// marshalVPacketFinding converts a virtual packet validation finding to its
+// RPC representation.
+func marshalVPacketFinding(f tapsend.VPacketFinding) *wrpc.VirtualPsbtFinding {
+	inputIndex, outputIndex := int32(-1), int32(-1)
+	f.InputIndex.WhenSome(func(idx int) {
+		inputIndex = int32(idx)
+	})
+	f.OutputIndex.WhenSome(func(idx int) {
+		outputIndex = int32(idx)
+	})
+
+	return &wrpc.VirtualPsbtFinding{
+		Check:       string(f.Check),
+		InputIndex:  inputIndex,
+		OutputIndex: outputIndex,
+		Message:     f.Message,
+	}
+}
+
 // serialize is a helper function that serializes a serializable object into a
 // byte slice.
 func serialize(s interface{ Serialize(io.Writer) error }) ([]byte, error) {
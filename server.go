@@ -15,13 +15,18 @@ import (
 	"github.com/davecgh/go-spew/spew"
 	proxy "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/address"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/monitoring"
 	"github.com/lightninglabs/taproot-assets/perms"
+	"github.com/lightninglabs/taproot-assets/rpccompress"
 	"github.com/lightninglabs/taproot-assets/rpcperms"
 	"github.com/lightninglabs/taproot-assets/tapchannel"
 	cmsg "github.com/lightninglabs/taproot-assets/tapchannelmsg"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
 	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/lightninglabs/taproot-assets/universe"
 	"github.com/lightningnetwork/lnd"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/channeldb"
@@ -60,6 +65,10 @@ type Server struct {
 	*rpcServer
 	macaroonService *lndclient.MacaroonService
 
+	// reloadMtx serializes config reloads, so two reloads that are
+	// triggered at the same time don't interleave.
+	reloadMtx sync.Mutex
+
 	quit chan struct{}
 	wg   sync.WaitGroup
 }
@@ -182,11 +191,52 @@ func (s *Server) initialize(interceptorChain *rpcperms.InterceptorChain) error {
 		return fmt.Errorf("unable to start chain porter: %w", err)
 	}
 
+	if err := s.cfg.ProofImporter.Start(); err != nil {
+		return fmt.Errorf("unable to start proof importer: %w", err)
+	}
+
+	if err := s.cfg.Webhooks.Start(); err != nil {
+		return fmt.Errorf("unable to start webhook dispatcher: %w", err)
+	}
+
+	if s.cfg.SpendWatcher != nil {
+		if err := s.cfg.SpendWatcher.Start(); err != nil {
+			return fmt.Errorf("unable to start spend watcher: %w",
+				err)
+		}
+	}
+
+	if err := s.cfg.InvoiceManager.Start(); err != nil {
+		return fmt.Errorf("unable to start invoice manager: %w", err)
+	}
+
+	// If requested, we make sure the multiverse trees are consistent with
+	// their universes before the federation starts syncing new leaves.
+	if s.cfg.UniverseCheckInvariants {
+		if err := s.checkMultiverseInvariants(); err != nil {
+			return err
+		}
+	}
+
 	if err := s.cfg.UniverseFederation.Start(); err != nil {
 		return fmt.Errorf("unable to start universe "+
 			"federation: %w", err)
 	}
 
+	if err := s.cfg.UniverseAuditor.Start(); err != nil {
+		return fmt.Errorf("unable to start universe auditor: %w", err)
+	}
+
+	if err := s.cfg.UniverseRootEvents.Start(); err != nil {
+		return fmt.Errorf("unable to start universe root event "+
+			"notifier: %w", err)
+	}
+
+	if err := s.cfg.UniverseSnapshots.Start(); err != nil {
+		return fmt.Errorf("unable to start universe snapshot "+
+			"importer: %w", err)
+	}
+
 	// Start the request for quote (RFQ) manager.
 	if err := s.cfg.RfqManager.Start(); err != nil {
 		return fmt.Errorf("unable to start RFQ manager: %w", err)
@@ -244,6 +294,51 @@ func (s *Server) initialize(interceptorChain *rpcperms.InterceptorChain) error {
 	return nil
 }
 
+// checkMultiverseInvariants checks that the issuance and transfer multiverse
+// trees are consistent with their universes. Each inconsistency found is
+// logged and an error is returned if there are any. The check is aborted if
+// the server is shut down while it is running.
+func (s *Server) checkMultiverseInvariants() error {
+	srvrLog.Infof("Checking multiverse invariants")
+
+	guard := &fn.ContextGuard{
+		Quit: s.quit,
+	}
+	ctx, cancel := guard.WithCtxQuitNoTimeout()
+	defer cancel()
+
+	// The quit channel is only closed once the server is stopped, so we
+	// also abort the check if the user requests a shutdown before the
+	// server is fully started.
+	go func() {
+		select {
+		case <-s.cfg.SignalInterceptor.ShutdownChannel():
+			cancel()
+
+		case <-ctx.Done():
+		}
+	}()
+
+	inconsistencies, err := s.cfg.UniverseArchive.CheckMultiverseInvariants(
+		ctx,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to check multiverse invariants: %w",
+			err)
+	}
+
+	for _, inconsistency := range inconsistencies {
+		srvrLog.Errorf("Multiverse inconsistency: %v", inconsistency)
+	}
+
+	if len(inconsistencies) > 0 {
+		return fmt.Errorf("found %d multiverse inconsistencies",
+			len(inconsistencies))
+	}
+
+	return nil
+}
+
 // RunUntilShutdown runs the main Taproot Asset server loop until a signal is
 // received to shut down the process.
 func (s *Server) RunUntilShutdown(mainErrChan <-chan error) error {
@@ -332,6 +427,18 @@ func (s *Server) RunUntilShutdown(mainErrChan <-chan error) error {
 		},
 	)
 	serverOpts = append(serverOpts, rpcServerOpts...)
+
+	// Universe responses are dominated by proofs, which are highly
+	// compressible, so we compress them with the compressor we negotiate
+	// with each client.
+	isUniverseMethod := func(fullMethod string) bool {
+		return strings.HasPrefix(fullMethod, universeMethodPrefix)
+	}
+	serverOpts = append(serverOpts, grpc.ChainUnaryInterceptor(
+		rpccompress.UnaryServerInterceptor(
+			s.cfg.UniverseCompression, isUniverseMethod,
+		),
+	))
 	serverOpts = append(serverOpts, ServerMaxMsgReceiveSize)
 
 	keepAliveParams := keepalive.ServerParameters{
@@ -387,6 +494,13 @@ func (s *Server) RunUntilShutdown(mainErrChan <-chan error) error {
 		// Provide Prometheus collectors with access to Universe stats.
 		s.cfg.Prometheus.UniverseStats = s.cfg.UniverseStats
 
+		// Provide Prometheus collectors with access to the Universe
+		// sync metrics, if the syncer exposes them.
+		syncMetrics, ok := s.cfg.UniverseSyncer.(universe.SyncMetrics)
+		if ok {
+			s.cfg.Prometheus.UniverseSyncMetrics = syncMetrics
+		}
+
 		// Provide Prometheus collectors with access to the asset store.
 		s.cfg.Prometheus.AssetStore = s.cfg.AssetStore
 
@@ -394,6 +508,14 @@ func (s *Server) RunUntilShutdown(mainErrChan <-chan error) error {
 		// minter.
 		s.cfg.Prometheus.AssetMinter = s.cfg.AssetMinter
 
+		// Provide Prometheus collectors with access to the coin
+		// selection metrics.
+		s.cfg.Prometheus.CoinSelectionLog = s.cfg.CoinSelectionLog
+
+		// Provide Prometheus collectors with access to the health of
+		// the proof courier endpoints.
+		s.cfg.Prometheus.ProofCourierHealth = s.cfg.ProofCourierHealth
+
 		promExporter, err := monitoring.NewPrometheusExporter(
 			&s.cfg.Prometheus,
 		)
@@ -451,6 +573,53 @@ func (s *Server) StartAsSubserver(lndGrpc *lndclient.GrpcLndServices) error {
 	return nil
 }
 
+// Start initializes and starts all subsystems of the server, without serving
+// any RPC. This is used when tapd is embedded as a library into another Go
+// daemon, which then accesses the subsystems directly. The server is stopped
+// with Stop.
+func (s *Server) Start() error {
+	if atomic.AddInt32(&s.started, 1) != 1 {
+		return nil
+	}
+
+	if err := s.initialize(nil); err != nil {
+		return fmt.Errorf("unable to initialize server: %w", err)
+	}
+
+	return nil
+}
+
+// AssetMinter returns the minter that creates new assets in batches.
+func (s *Server) AssetMinter() tapgarden.Planter {
+	return s.cfg.AssetMinter
+}
+
+// AssetWallet returns the wallet that funds and signs asset transfers.
+func (s *Server) AssetWallet() tapfreighter.Wallet {
+	return s.cfg.AssetWallet
+}
+
+// ChainPorter returns the porter that sends asset transfers on chain.
+func (s *Server) ChainPorter() tapfreighter.Porter {
+	return s.cfg.ChainPorter
+}
+
+// AddrBook returns the book of Taproot Asset addresses.
+func (s *Server) AddrBook() *address.Book {
+	return s.cfg.AddrBook
+}
+
+// UniverseArchive returns the local universe.
+func (s *Server) UniverseArchive() *universe.Archive {
+	return s.cfg.UniverseArchive
+}
+
+// UniverseFederation returns the envoy that syncs the local universe with
+// the universe federation.
+func (s *Server) UniverseFederation() *universe.FederationEnvoy {
+	return s.cfg.UniverseFederation
+}
+
 // ValidateMacaroon extracts the macaroon from the context's gRPC metadata,
 // checks its signature, makes sure all specified permissions for the called
 // method are contained within and finally ensures all caveat conditions are
@@ -627,6 +796,17 @@ func (s *Server) Stop() error {
 
 	srvrLog.Infof("Stopping Main Server")
 
+	// Release the resources acquired for the config last, even if one of
+	// the subsystems fails to stop.
+	defer func() {
+		for i := len(s.cfg.ShutdownFuncs) - 1; i >= 0; i-- {
+			if err := s.cfg.ShutdownFuncs[i](); err != nil {
+				srvrLog.Errorf("Error releasing server "+
+					"resource: %v", err)
+			}
+		}
+	}()
+
 	if err := s.rpcServer.Stop(); err != nil {
 		return err
 	}
@@ -645,10 +825,44 @@ func (s *Server) Stop() error {
 		return err
 	}
 
+	if err := s.cfg.ProofImporter.Stop(); err != nil {
+		return err
+	}
+
+	if err := s.cfg.Webhooks.Stop(); err != nil {
+		return err
+	}
+
+	if s.cfg.DatabaseConfig.ReadSnapshots != nil {
+		s.cfg.DatabaseConfig.ReadSnapshots.Stop()
+	}
+
+	if s.cfg.SpendWatcher != nil {
+		if err := s.cfg.SpendWatcher.Stop(); err != nil {
+			return err
+		}
+	}
+
+	if err := s.cfg.InvoiceManager.Stop(); err != nil {
+		return err
+	}
+
 	if err := s.cfg.UniverseFederation.Start(); err != nil {
 		return err
 	}
 
+	if err := s.cfg.UniverseAuditor.Stop(); err != nil {
+		return err
+	}
+
+	if err := s.cfg.UniverseRootEvents.Stop(); err != nil {
+		return err
+	}
+
+	if err := s.cfg.UniverseSnapshots.Stop(); err != nil {
+		return err
+	}
+
 	if err := s.cfg.RfqManager.Stop(); err != nil {
 		return err
 	}
@@ -20,10 +20,18 @@ import (
 	"github.com/jessevdk/go-flags"
 	"github.com/lightninglabs/lndclient"
 	tap "github.com/lightninglabs/taproot-assets"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/compliance"
 	"github.com/lightninglabs/taproot-assets/monitoring"
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/rfq"
+	"github.com/lightninglabs/taproot-assets/rpccompress"
+	"github.com/lightninglabs/taproot-assets/tapchannel"
 	"github.com/lightninglabs/taproot-assets/tapdb"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/tapsend"
+	"github.com/lightninglabs/taproot-assets/webhook"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/cert"
 	"github.com/lightningnetwork/lnd/lncfg"
@@ -58,6 +66,16 @@ const (
 	defaultMainnetFederationServer = "universe.lightning.finance:10029"
 	defaultTestnetFederationServer = "testnet.universe.lightning.finance:10029"
 
+	// defaultMainnetFederationProfile is the name of the built-in
+	// federation profile that contains the default mainnet universe
+	// server.
+	defaultMainnetFederationProfile = "mainnet"
+
+	// defaultTestnetFederationProfile is the name of the built-in
+	// federation profile that contains the default testnet universe
+	// server.
+	defaultTestnetFederationProfile = "testnet"
+
 	// DefaultAutogenValidity is the default validity of a self-signed
 	// certificate. The value corresponds to 14 months
 	// (14 months * 30 days * 24 hours).
@@ -79,6 +97,19 @@ const (
 	// DatabaseBackendPostgres is the name of the Postgres database backend.
 	DatabaseBackendPostgres = "postgres"
 
+	// UniverseTreeStoreMemory is the name of the tree store that keeps the
+	// nodes of in-memory universe trees in memory.
+	UniverseTreeStoreMemory = "memory"
+
+	// UniverseTreeStoreDatabase is the name of the tree store that keeps
+	// the nodes of in-memory universe trees in the database.
+	UniverseTreeStoreDatabase = "database"
+
+	// memUniverseTreePrefix is the prefix of the namespaces of the trees of
+	// an in-memory universe that are kept in the database, which keeps them
+	// apart from the trees of the database backed universe.
+	memUniverseTreePrefix = "mem-universe-"
+
 	// defaultProofTransferBackoffResetWait is the default amount of time
 	// we'll wait before resetting the backoff of a proof transfer.
 	defaultProofTransferBackoffResetWait = 10 * time.Minute
@@ -99,6 +130,22 @@ const (
 	// use for waiting for a receiver to acknowledge a proof transfer.
 	defaultProofTransferReceiverAckTimeout = time.Hour * 6
 
+	// defaultProofTransferRequestTimeout is the default timeout of a single
+	// request to an HTTPS proof courier.
+	defaultProofTransferRequestTimeout = time.Minute
+
+	// defaultRemoteSignerTimeout is the default timeout for connecting to
+	// the remote signer at startup.
+	defaultRemoteSignerTimeout = 5 * time.Second
+
+	// defaultGossipMaxServers is the default number of federation servers
+	// up to which servers learned through federation gossip are added.
+	defaultGossipMaxServers = 10
+
+	// defaultGossipMaxAge is the default maximum age of gossiped federation
+	// server lists.
+	defaultGossipMaxAge = 24 * time.Hour
+
 	// defaultUniverseSyncInterval is the default interval that we'll use
 	// to sync Universe state with the federation.
 	defaultUniverseSyncInterval = time.Minute * 10
@@ -121,6 +168,18 @@ const (
 	// of 10 queries.
 	defaultUniverseQueriesBurst = 10
 
+	// defaultUniverseClientQueriesBurst is the default query budget of
+	// each individual universe client.
+	defaultUniverseClientQueriesBurst = 20
+
+	// defaultStatsEventRetention is the default amount of time raw
+	// universe sync and proof events are kept for before they're
+	// downsampled into daily aggregates.
+	defaultStatsEventRetention = time.Hour * 24 * 90
+
+	// defaultTorSOCKS is the default host:port of Tor's SOCKS5 proxy.
+	defaultTorSOCKS = "localhost:9050"
+
 	// defaultProofRetrievalDelay is the default time duration the custodian
 	// waits having identified an asset transfer on-chain and before
 	// retrieving the corresponding proof via the proof courier service.
@@ -198,7 +257,7 @@ var (
 // ChainConfig houses the configuration options that govern which chain/network
 // we operate on.
 type ChainConfig struct {
-	Network string `long:"network" description:"network to run on" choice:"mainnet" choice:"regtest" choice:"testnet" choice:"simnet" choice:"signet"`
+	Network string `long:"network" description:"network to run on" choice:"mainnet" choice:"regtest" choice:"testnet" choice:"testnet4" choice:"simnet" choice:"signet"`
 
 	SigNetChallenge string `long:"signetchallenge" description:"Connect to a custom signet network defined by this challenge instead of using the global default signet test network -- Can be specified multiple times"`
 }
@@ -228,6 +287,8 @@ type RpcConfig struct {
 	AllowPublicUniProofCourier bool `long:"allow-public-uni-proof-courier" description:"Disable macaroon authentication for universe proof courier RPC endpoints."`
 	AllowPublicStats           bool `long:"allow-public-stats" description:"Disable macaroon authentication for stats RPC endpoints."`
 
+	MaxProofFileSize uint64 `long:"max-proof-file-size" description:"The maximum size in bytes of a proof file that is accepted over RPC. Larger proof files are rejected before they are parsed. A value of 0 means no limit."`
+
 	RestCORS []string `long:"restcors" description:"Add an ip:port/hostname to allow cross origin access from. To allow all origins, set as \"*\"."`
 
 	LetsEncryptDir    string `long:"letsencryptdir" description:"The directory to store Let's Encrypt certificates within"`
@@ -255,6 +316,49 @@ type LndConfig struct {
 	TLSPath string `long:"tlspath" description:"Path to lnd tls certificate"`
 }
 
+// RemoteSignerConfig is the config of the remote signer all asset-level
+// signing is delegated to. The remote signer is an lnd node that holds the
+// private keys of the wallet the lnd node tapd is connected to was created
+// from. That lnd node then only needs to hold the public keys of the wallet,
+// for example because it is itself a watch-only node in remote signing mode.
+//
+// nolint: lll
+type RemoteSignerConfig struct {
+	Enable bool `long:"enable" description:"Delegate all asset-level signing (group witnesses of newly minted assets, script key spends of virtual transactions and MuSig2 partial signatures) to the remote signer instead of the lnd node tapd is connected to."`
+
+	RPCHost string `long:"rpchost" description:"The remote signer's RPC host:port"`
+
+	MacaroonPath string `long:"macaroonpath" description:"The macaroon to use for authenticating with the remote signer. It must carry the permissions of the signer and read-only macaroons of lnd."`
+
+	TLSCertPath string `long:"tlscertpath" description:"The TLS certificate to use for establishing the remote signer's identity"`
+
+	Timeout time.Duration `long:"timeout" description:"The timeout for connecting to the remote signer at startup."`
+}
+
+// Validate returns an error if the remote signer is enabled but the
+// configuration is incomplete.
+func (c *RemoteSignerConfig) Validate() error {
+	if !c.Enable {
+		return nil
+	}
+
+	switch {
+	case c.RPCHost == "":
+		return fmt.Errorf("remotesigner.rpchost must be set")
+
+	case c.MacaroonPath == "":
+		return fmt.Errorf("remotesigner.macaroonpath must be set")
+
+	case c.TLSCertPath == "":
+		return fmt.Errorf("remotesigner.tlscertpath must be set")
+
+	case c.Timeout <= 0:
+		return fmt.Errorf("remotesigner.timeout must be positive")
+	}
+
+	return nil
+}
+
 // UniverseConfig is the config that houses any Universe related config
 // values.
 //
@@ -270,9 +374,105 @@ type UniverseConfig struct {
 
 	StatsCacheDuration time.Duration `long:"stats-cache-duration" description:"The amount of time to cache stats for before refreshing them."`
 
+	StatsEventRetention time.Duration `long:"stats-event-retention" description:"The amount of time raw universe sync and proof events are kept for. Older events are periodically downsampled into daily aggregates, which are kept forever. The total and per day stats stay the same, but the individual events are lost. If zero, all raw events are kept."`
+
 	UniverseQueriesPerSecond rate.Limit `long:"max-qps" description:"The maximum number of queries per second across the set of active universe queries that is permitted. Anything above this starts to get rate limited."`
 
 	UniverseQueriesBurst int `long:"req-burst-budget" description:"The burst budget for the universe query rate limiting."`
+
+	ClientQueriesPerSecond rate.Limit `long:"client-max-qps" description:"The rate in queries per second at which the query budget of each individual universe client is refilled. Clients are identified by their macaroon if they supply a valid one, otherwise by their IP address. Clients that exhausted their budget are rejected. If zero, clients aren't rate limited individually."`
+
+	ClientQueriesBurst int `long:"client-burst-budget" description:"The query budget of each individual universe client."`
+
+	MethodCosts []string `long:"method-cost" description:"The share of the query budget a call to a universe RPC method costs a client, in the format <method>=<cost>. Overrides the default costs of AssetRoots=1, QueryAssetRoots=1, AssetLeafKeys=1, QueryProof=2, QueryAssetMeta=1, AssetLeaves=5 and InsertProof=10. Can be specified multiple times."`
+
+	SignResponses bool `long:"sign-responses" description:"If set, the responses of proof and leaf key queries are signed with the identity key of the lnd node. The signature and the signing time are returned in the signature field of the response, allowing clients to detect responses that were tampered with by untrusted proxies or caches."`
+
+	InMemory bool `long:"in-memory" description:"If set, all universe trees and proof leaves are kept in memory instead of the database. All universe data is lost on shutdown and universe statistics aren't collected, so this is only meant for tests and short-lived, ephemeral universe servers."`
+
+	TreeStore string `long:"tree-store" description:"The store for the nodes of the universe and multiverse trees of an in-memory universe. With 'memory', all nodes are kept in memory. With 'database', the nodes are kept in the database and only the children of recently used branch nodes are cached in memory, which bounds the memory used for very large universes. The trees in the database are wiped on startup, as all other in-memory universe data is lost on shutdown." choice:"memory" choice:"database"`
+
+	TreeStoreCacheSize uint64 `long:"tree-store-cache-size" description:"The number of branch nodes the database tree store keeps cached in memory. If zero, a default of 100000 is used."`
+
+	AllowAssetIDs []string `long:"allow-asset-id" description:"The hex encoded ID of an asset whose universe may be synced with the federation. If any asset ID or group key is allowed, universes of all other assets are neither accepted nor served through federation sync. Can be specified multiple times."`
+
+	AllowGroupKeys []string `long:"allow-group-key" description:"The hex encoded key of an asset group whose universe may be synced with the federation. If any asset ID or group key is allowed, universes of all other assets are neither accepted nor served through federation sync. Can be specified multiple times."`
+
+	DenyAssetIDs []string `long:"deny-asset-id" description:"The hex encoded ID of an asset whose universe is neither accepted nor served through federation sync, regardless of any other sync config. Can be specified multiple times."`
+
+	DenyGroupKeys []string `long:"deny-group-key" description:"The hex encoded key of an asset group whose universe is neither accepted nor served through federation sync, regardless of any other sync config. Can be specified multiple times."`
+
+	PublicHost string `long:"public-host" description:"The host:port under which other federation members can reach this universe server. If set, federation members are notified whenever one of the universe roots changes, so they can pull the new leaves right away instead of waiting for the next sync interval. The notifications are signed with the identity key of the lnd node and are only accepted by members that added this server with its identity key."`
+
+	FederationGossip bool `long:"federation-gossip" description:"If set, the lists of live federation servers are fetched from the federation members after each sync. The lists are signed with the identity key of the lnd node of their origin and contain the time each server was last synced with. A list is only accepted if it originates from the member it was fetched from and is signed with the identity key that was set for that member. Other members only accept this universe server's own list if public-host is set."`
+
+	FederationGossipMaxServers int `long:"federation-gossip-max-servers" description:"The number of federation servers up to which servers learned through federation gossip are added automatically, if they were recently seen alive and are reachable. If zero, gossiped servers are never added."`
+
+	FederationGossipMaxAge time.Duration `long:"federation-gossip-max-age" description:"The maximum age of a gossiped federation server list, and the maximum time since the last successful sync with a server for it to be gossiped or added automatically."`
+
+	FetchMissingProofs bool `long:"fetch-missing-proofs" description:"If set, proofs that are requested over RPC but are missing from the local proof archive, for example because they were pruned, are fetched from the universe servers of the federation. Fetched proofs are fully verified before they are stored in the local archive again, and a proof_recovered webhook event is sent. This reveals the assets and outpoints of the missing proofs to the federation servers."`
+
+	Watch []string `long:"watch" description:"A rule of the issuance watch-list, in the format asset=<asset ID>, group=<group key> or tag=<pattern>. Whenever a new issuance leaf that matches a rule is synced from the federation, a warning is logged and an issuance_alert webhook event is sent. This can for example be used to detect unauthorized issuance into an asset group. Can be specified multiple times."`
+
+	Compression string `long:"compression" description:"The compressors used for universe federation sync and universe RPC proof transfers, as a comma-separated list in the order of preference. Supported are zstd and gzip, 'none' disables compression. Compression is negotiated with each remote party, so only a compressor both sides support is used. This applies to the responses of this universe server as well as to the connections to remote universe servers and proof couriers."`
+
+	CompressionOverrides []string `long:"compression-server" description:"The compressors used for the connection to an individual universe server or proof courier, in the format <host:port>=<compressors>. Overrides the compression option for that connection, for example to disable compression for a server that is reached through an already compressed link. Can be specified multiple times."`
+
+	AuditInterval time.Duration `long:"audit-interval" description:"The interval at which all local universe trees are audited in the background. Each stored proof is checked against its leaf key, and each universe root is recomputed from its leaves and compared with the stored root. Inconsistencies are logged as warnings. If zero, no background audits are performed."`
+
+	CheckInvariants bool `long:"check-invariants" description:"If set, the issuance and transfer multiverse trees are checked for consistency with their universes on startup, before the federation starts syncing. Each inconsistency found is logged and tapd refuses to start if there are any. This can take a while for large universes."`
+
+	AuditQuarantine bool `long:"audit-quarantine" description:"If set, leaves that don't pass a background audit are moved out of their universe tree into a quarantine table, and the universe and multiverse roots are updated accordingly. Not supported for in-memory universes."`
+
+	StrictIDs bool `long:"strict-ids" description:"If set, the universe IDs of the federation sync schedules and proof sync log are checked for consistency when they are loaded from the database. Instead of silently preferring the group key, an error is returned if the stored asset ID is malformed, no proof type is stored, or the proof type or asset of a synced leaf contradicts its universe ID. This surfaces data corruption instead of masking it."`
+
+	SnapshotSigners []string `long:"snapshot-signer" description:"The hex encoded x-only public key of a trusted universe snapshot signer. Snapshots are only imported if they are signed by enough trusted signers. Can be specified multiple times."`
+
+	SnapshotThreshold int `long:"snapshot-threshold" description:"The number of distinct trusted signers that need to sign a universe snapshot before it is imported. Defaults to 1."`
+
+	SnapshotFiles []string `long:"snapshot-file" description:"The path to a signed universe snapshot that is imported on startup, unless the local universe already matches it. The proofs of an imported snapshot are fully verified in the background. Can be specified multiple times."`
+}
+
+// TorConfig is the config that houses the values for dialing remote universe
+// servers through Tor.
+//
+// nolint: lll
+type TorConfig struct {
+	Active bool `long:"active" description:"If true, remote universe servers are dialed through Tor's SOCKS5 proxy. This is required to sync with federation servers that are onion services."`
+
+	SOCKS string `long:"socks" description:"The host:port that Tor's exposed SOCKS5 proxy is listening on."`
+
+	StreamIsolation bool `long:"streamisolation" description:"If true, a new Tor circuit is created for each connection to a universe server."`
+
+	SkipProxyForClearNetTargets bool `long:"skip-proxy-for-clearnet-targets" description:"If true, universe servers that aren't onion services are dialed directly instead of through Tor. This reveals the IP address of the node to those servers."`
+}
+
+// Validate checks that the Tor config values are valid.
+func (t *TorConfig) Validate() error {
+	if !t.Active {
+		return nil
+	}
+
+	if _, _, err := net.SplitHostPort(t.SOCKS); err != nil {
+		return fmt.Errorf("invalid Tor SOCKS address %q: %w", t.SOCKS,
+			err)
+	}
+
+	return nil
+}
+
+// DialNet returns the network used to dial remote universe servers, or nil if
+// Tor isn't active and universe servers should be dialed directly.
+func (t *TorConfig) DialNet() tor.Net {
+	if !t.Active {
+		return nil
+	}
+
+	return &tor.ProxyNet{
+		SOCKS:                       t.SOCKS,
+		StreamIsolation:             t.StreamIsolation,
+		SkipProxyForClearNetTargets: t.SkipProxyForClearNetTargets,
+	}
 }
 
 // AddressConfig is the config that houses any address Book related config
@@ -281,6 +481,65 @@ type AddrBookConfig struct {
 	DisableSyncer bool `long:"disable-syncer" description:"If true, tapd will not try to sync issuance proofs for unknown assets when creating an address."`
 }
 
+// WalletConfig is the config that houses any asset wallet related config
+// values.
+//
+// nolint: lll
+type WalletConfig struct {
+	AnchorOrdering string `long:"anchor-ordering" description:"The policy used to order the inputs and outputs of anchor transactions. 'lexicographic' orders them deterministically, similar to BIP-69, with the asset carrying outputs sorted by their internal key first, 'random' shuffles them." choice:"none" choice:"lexicographic" choice:"random"`
+
+	CoinSelectStrategy string `long:"coin-select-strategy" description:"The default strategy used to select the asset inputs of a transfer. 'largest-first' uses the fewest inputs, 'smallest-first' consolidates small UTXOs over time, 'exact-match' tries to find inputs that match the amount exactly to avoid creating change, 'random' selects inputs in random order to make transfers harder to link." choice:"largest-first" choice:"smallest-first" choice:"exact-match" choice:"random"`
+
+	CourierPreflight        string        `long:"courier-preflight" description:"The policy used to check the proof courier endpoints of the receivers of a transfer before it is committed to. 'off' doesn't check them, 'warn' probes them and logs a warning for each unreachable one, 'abort' probes them and aborts the transfer if any of them is unreachable." choice:"off" choice:"warn" choice:"abort"`
+	CourierPreflightTimeout time.Duration `long:"courier-preflight-timeout" description:"The maximum time a single proof courier endpoint is probed for before a transfer is committed to."`
+
+	ReceiveCpfpAdvisory        bool          `long:"receive-cpfp-advisory" description:"If true, tapd reports inbound asset transfers that stay unconfirmed for too long to subscribers, together with a plan for bumping their fee through child-pays-for-parent from the BTC value of the received anchor output. The fee is not bumped automatically."`
+	ReceiveCpfpMinUnconfirmed  time.Duration `long:"receive-cpfp-min-unconfirmed" description:"The minimum time an inbound asset transfer needs to stay unconfirmed before a fee bump is suggested for it."`
+	ReceiveCpfpConfTarget      uint32        `long:"receive-cpfp-conf-target" description:"The confirmation target used to estimate the fee rate of a suggested child-pays-for-parent package."`
+	ReceiveCpfpMaxFeeRateSatVB uint64        `long:"receive-cpfp-max-fee-rate" description:"The maximum fee rate in sat/vByte of a suggested child-pays-for-parent package. Transfers that require a higher fee rate are not reported. A value of 0 means no limit."`
+
+	ReceiveZeroConf          bool     `long:"receive-zero-conf" description:"If true, qualifying inbound asset transfers are credited with an unconfirmed/risky label as soon as their anchor transaction is detected in the mempool. The credit is removed again if the anchor transaction is replaced or double spent. Intended for point-of-sale use cases."`
+	ReceiveZeroConfAssetIDs  []string `long:"receive-zero-conf-asset-id" description:"The hex encoded ID of an asset that qualifies for zero-conf receives. Can be specified multiple times. If none is specified, all assets qualify."`
+	ReceiveZeroConfMaxAmount uint64   `long:"receive-zero-conf-max-amount" description:"The maximum amount of a single inbound asset transfer that qualifies for zero-conf receives. A value of 0 means no limit."`
+
+	ForceCloseSweepBumpInterval    uint32 `long:"force-close-sweep-bump-interval" description:"The number of blocks to wait after a time-locked asset output of a force closed channel matured (or after the last fee bump) before bumping the fee of its sweep."`
+	ForceCloseSweepConfTarget      uint32 `long:"force-close-sweep-conf-target" description:"The confirmation target used to estimate the fee rate when bumping the sweep of an asset output of a force closed channel."`
+	ForceCloseSweepMaxFeeRateSatVB uint64 `long:"force-close-sweep-max-fee-rate" description:"The maximum fee rate in sat/vByte to bump the sweep of an asset output of a force closed channel to. A value of 0 means no limit."`
+
+	PassiveProofBackupAddr string `long:"passive-proof-backup-addr" description:"The address of an off-site proof courier, usually a universe server (universerpc://host:port), the updated proofs of passive assets are pushed to after each transfer. This allows passive assets to be recovered even if the local proof archive is lost before the next backup. If empty, no proofs are pushed."`
+
+	SendBatchWindow time.Duration `long:"send-batch-window" description:"The amount of time outbound address transfers are held back for after the first one is requested, so all transfers requested within the window are shipped in a single anchor transaction to amortize the chain fees. Only transfers with the same fee rate, coin selection strategy, address version and number of confirmations are batched. If any transfer of a batch fails, all of them fail. If zero, each transfer is shipped right away."`
+
+	IncrementalRelayFeeSatVB uint64 `long:"incremental-relay-fee" description:"The incremental relay fee rate in sat/vByte of the chain backend. When the fee of a transfer is bumped, the replacement anchor transaction pays at least this fee rate for its own size on top of the fee of the replaced transaction, as required by BIP-125. Must not be lower than the incrementalrelayfee setting of the backend."`
+
+	SpendWatchInterval time.Duration `long:"spend-watch-interval" description:"If non-zero, the anchor outputs of all our assets are watched for spends, both in the mempool and on chain. If an anchor output is spent by a transaction that didn't originate from this node, the assets in it are marked as at risk and an anchor_spend_alert webhook event is sent, which catches key compromises or wallet cross-talk early. The interval determines how often the set of watched outputs is refreshed and the mempool is checked. If zero, anchor outputs aren't watched."`
+}
+
+// WebhookConfig is the config that houses the webhook related config values.
+//
+// nolint: lll
+type WebhookConfig struct {
+	Endpoints []string `long:"endpoint" description:"A webhook endpoint asset transfer events are POSTed to as JSON. The format is a semicolon separated list of key=value pairs: 'url=<url>;events=<type>,<type>;assets=<asset_id>,<asset_id>;auth=<header name>:<header value>;secret=<hmac secret>'. Only url is mandatory. Valid event types are receive_detected, receive_confirmed, receive_completed, send_broadcast, send_confirmed, send_completed, burn_broadcast, burn_confirmed, issuance_alert, proof_recovered and anchor_spend_alert. Can be specified multiple times."`
+
+	MaxAttempts int `long:"max-attempts" description:"The number of attempts to deliver an event to an endpoint before giving up."`
+
+	InitialBackoff time.Duration `long:"initial-backoff" description:"The time to wait before retrying a failed delivery. The backoff is doubled after each failed attempt."`
+}
+
+// ComplianceConfig is the config that houses the settings of the external
+// compliance policy service transfers are screened with.
+//
+// nolint: lll
+type ComplianceConfig struct {
+	URL string `long:"url" description:"The URL of an external compliance policy service. If set, the context of every outbound transfer (before it is signed and broadcast) and every inbound transfer (before its proof is imported) is POSTed to it as JSON and the transfer only proceeds if the service responds with a verdict that allows it."`
+
+	AuthHeader string `long:"auth" description:"An optional header sent with every request to the policy service, in the format '<header name>:<header value>'."`
+
+	FailMode string `long:"fail-mode" description:"Determines what happens with a transfer if the policy service can't be reached or returns an invalid response." choice:"open" choice:"closed"`
+
+	Timeout time.Duration `long:"timeout" description:"The maximum time to wait for a verdict of the policy service."`
+}
+
 // ExperimentalConfig houses experimental tapd cli configuration options.
 type ExperimentalConfig struct {
 	Rfq rfq.CliConfig `group:"rfq" namespace:"rfq"`
@@ -316,6 +575,9 @@ type Config struct {
 	DefaultProofCourierAddr string                       `long:"proofcourieraddr" description:"Default proof courier service address."`
 	HashMailCourier         *proof.HashMailCourierCfg    `group:"hashmailcourier" namespace:"hashmailcourier"`
 	UniverseRpcCourier      *proof.UniverseRpcCourierCfg `group:"universerpccourier" namespace:"universerpccourier"`
+	HttpsCourier            *proof.HttpsCourierCfg       `group:"httpscourier" namespace:"httpscourier"`
+
+	FallbackProofCourierAddrs []string `long:"fallbackproofcourieraddr" description:"Additional proof courier service addresses that are tried if a proof can't be delivered to or retrieved from the courier of a transfer. Only used for transfers whose courier is the default or one of the fallback couriers. Healthy couriers are tried first, and the courier that last worked for a receiver is preferred. Can be specified multiple times."`
 
 	CustodianProofRetrievalDelay time.Duration `long:"custodianproofretrievaldelay" description:"The number of seconds the custodian waits after identifying an asset transfer on-chain and before retrieving the corresponding proof."`
 
@@ -324,14 +586,24 @@ type Config struct {
 
 	Lnd *LndConfig `group:"lnd" namespace:"lnd"`
 
+	RemoteSigner *RemoteSignerConfig `group:"remotesigner" namespace:"remotesigner"`
+
 	DatabaseBackend string                `long:"databasebackend" description:"The database backend to use for storing all asset related data." choice:"sqlite" choice:"postgres"`
 	Sqlite          *tapdb.SqliteConfig   `group:"sqlite" namespace:"sqlite"`
 	Postgres        *tapdb.PostgresConfig `group:"postgres" namespace:"postgres"`
 
 	Universe *UniverseConfig `group:"universe" namespace:"universe"`
 
+	Tor *TorConfig `group:"tor" namespace:"tor"`
+
 	AddrBook *AddrBookConfig `group:"address" namespace:"address"`
 
+	Wallet *WalletConfig `group:"wallet" namespace:"wallet"`
+
+	Webhook *WebhookConfig `group:"webhook" namespace:"webhook"`
+
+	Compliance *ComplianceConfig `group:"compliance" namespace:"compliance"`
+
 	Prometheus monitoring.PrometheusConfig `group:"prometheus" namespace:"prometheus"`
 
 	Experimental *ExperimentalConfig `group:"experimental" namespace:"experimental"`
@@ -373,6 +645,7 @@ func DefaultConfig() Config {
 			WSPongWait:        lnrpc.DefaultPongWait,
 			LetsEncryptDir:    defaultLetsEncryptDir,
 			LetsEncryptListen: defaultLetsEncryptListen,
+			MaxProofFileSize:  proof.DefaultMaxInboundFileSize,
 		},
 		ChainConf: &ChainConfig{
 			Network: defaultNetwork,
@@ -381,6 +654,9 @@ func DefaultConfig() Config {
 			Host:         "localhost:10009",
 			MacaroonPath: defaultLndMacaroonPath,
 		},
+		RemoteSigner: &RemoteSignerConfig{
+			Timeout: defaultRemoteSignerTimeout,
+		},
 		DatabaseBackend: DatabaseBackendSqlite,
 		Sqlite: &tapdb.SqliteConfig{
 			DatabaseFileName: defaultSqliteDatabasePath,
@@ -412,6 +688,17 @@ func DefaultConfig() Config {
 				MaxBackoff:       defaultProofTransferMaxBackoff,
 			},
 		},
+		HttpsCourier: &proof.HttpsCourierCfg{
+			ChunkSize:      proof.DefaultHttpsChunkSize,
+			RequestTimeout: defaultProofTransferRequestTimeout,
+			BackoffCfg: &proof.BackoffCfg{
+				SkipInitDelay:    true,
+				BackoffResetWait: defaultProofTransferBackoffResetWait,
+				NumTries:         defaultProofTransferNumTries,
+				InitialBackoff:   defaultProofTransferInitialBackoff,
+				MaxBackoff:       defaultProofTransferMaxBackoff,
+			},
+		},
 		CustodianProofRetrievalDelay: defaultProofRetrievalDelay,
 		Universe: &UniverseConfig{
 			SyncInterval: defaultUniverseSyncInterval,
@@ -419,10 +706,44 @@ func DefaultConfig() Config {
 				defaultUniverseMaxQps,
 			),
 			UniverseQueriesBurst: defaultUniverseQueriesBurst,
+			ClientQueriesBurst:   defaultUniverseClientQueriesBurst,
+			StatsEventRetention:  defaultStatsEventRetention,
+			TreeStore:            UniverseTreeStoreMemory,
+			Compression: rpccompress.DefaultPreferences().
+				String(),
+			FederationGossipMaxServers: defaultGossipMaxServers,
+			FederationGossipMaxAge:     defaultGossipMaxAge,
+		},
+		Tor: &TorConfig{
+			SOCKS: defaultTorSOCKS,
 		},
 		AddrBook: &AddrBookConfig{
 			DisableSyncer: false,
 		},
+		Wallet: &WalletConfig{
+			AnchorOrdering:            tapsend.OutputOrderingNone.String(),
+			ReceiveCpfpMinUnconfirmed: tapgarden.DefaultCpfpMinUnconfirmed,
+			ReceiveCpfpConfTarget:     tapgarden.DefaultCpfpConfTarget,
+			ForceCloseSweepBumpInterval: tapchannel.
+				DefaultSweepBumpInterval,
+			ForceCloseSweepConfTarget: tapchannel.
+				DefaultSweepConfTarget,
+			CoinSelectStrategy: tapfreighter.PreferMaxAmount.
+				String(),
+			CourierPreflight: tapfreighter.CourierPreflightOff.
+				String(),
+			CourierPreflightTimeout: tapfreighter.
+				DefaultCourierPreflightTimeout,
+			IncrementalRelayFeeSatVB: 1,
+		},
+		Webhook: &WebhookConfig{
+			MaxAttempts:    webhook.DefaultMaxAttempts,
+			InitialBackoff: webhook.DefaultInitialBackoff,
+		},
+		Compliance: &ComplianceConfig{
+			FailMode: compliance.FailOpen.String(),
+			Timeout:  compliance.DefaultTimeout,
+		},
 		Experimental: &ExperimentalConfig{},
 	}
 }
@@ -452,37 +773,16 @@ func LoadConfig(interceptor signal.Interceptor) (*Config, btclog.Logger, error)
 		os.Exit(0)
 	}
 
-	// If the config file path has not been modified by the user, then
-	// we'll use the default config file path. However, if the user has
-	// modified their tapddir, then we should assume they intend to use
-	// the config file within it.
-	configFileDir := CleanAndExpandPath(preCfg.TapdDir)
-	configFilePath := CleanAndExpandPath(preCfg.ConfigFile)
-	switch {
-	// User specified --tapddir but no --configfile. Update the config
-	// file path to the tapd config directory, but don't require it to
-	// exist.
-	case configFileDir != DefaultTapdDir &&
-		configFilePath == DefaultConfigFile:
-
-		configFilePath = filepath.Join(
-			configFileDir, defaultConfigFileName,
-		)
-
-	// User did specify an explicit --configfile, so we check that it does
-	// exist under that path to avoid surprises.
-	case configFilePath != DefaultConfigFile:
-		if !fileExists(configFilePath) {
-			return nil, nil, fmt.Errorf("specified config file does "+
-				"not exist in %s", configFilePath)
-		}
+	configFilePath, err := resolveConfigFile(&preCfg)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	// Next, load any additional configuration options from the file.
 	var configFileError error
 	cfg := preCfg
 	fileParser := flags.NewParser(&cfg, flags.Default)
-	err := flags.NewIniParser(fileParser).ParseFile(configFilePath)
+	err = flags.NewIniParser(fileParser).ParseFile(configFilePath)
 	if err != nil {
 		// If it's a parsing related error, then we'll return
 		// immediately, otherwise we can proceed as possibly the config
@@ -544,6 +844,38 @@ func LoadConfig(interceptor signal.Interceptor) (*Config, btclog.Logger, error)
 	return cleanCfg, cfgLogger, nil
 }
 
+// resolveConfigFile returns the path of the config file to load, given the
+// pre-parsed command line options.
+func resolveConfigFile(preCfg *Config) (string, error) {
+	// If the config file path has not been modified by the user, then
+	// we'll use the default config file path. However, if the user has
+	// modified their tapddir, then we should assume they intend to use
+	// the config file within it.
+	configFileDir := CleanAndExpandPath(preCfg.TapdDir)
+	configFilePath := CleanAndExpandPath(preCfg.ConfigFile)
+	switch {
+	// User specified --tapddir but no --configfile. Update the config
+	// file path to the tapd config directory, but don't require it to
+	// exist.
+	case configFileDir != DefaultTapdDir &&
+		configFilePath == DefaultConfigFile:
+
+		configFilePath = filepath.Join(
+			configFileDir, defaultConfigFileName,
+		)
+
+	// User did specify an explicit --configfile, so we check that it does
+	// exist under that path to avoid surprises.
+	case configFilePath != DefaultConfigFile:
+		if !fileExists(configFilePath) {
+			return "", fmt.Errorf("specified config file does "+
+				"not exist in %s", configFilePath)
+		}
+	}
+
+	return configFilePath, nil
+}
+
 // usageError is an error type that signals a problem with the supplied flags.
 type usageError struct {
 	err error
@@ -616,6 +948,8 @@ func ValidateConfig(cfg Config, cfgLogger btclog.Logger) (*Config, error) {
 		cfg.ActiveNetParams = chaincfg.MainNetParams
 	case "testnet":
 		cfg.ActiveNetParams = chaincfg.TestNet3Params
+	case "testnet4":
+		cfg.ActiveNetParams = address.TestNet4Params
 	case "regtest":
 		cfg.ActiveNetParams = chaincfg.RegressionNetParams
 	case "simnet":
@@ -723,6 +1057,19 @@ func ValidateConfig(cfg Config, cfgLogger btclog.Logger) (*Config, error) {
 		return nil, fmt.Errorf("must specify --lnd.macaroonpath")
 	}
 
+	// Make sure the remote signer, if enabled, can be connected to.
+	if err := cfg.RemoteSigner.Validate(); err != nil {
+		return nil, err
+	}
+	if cfg.RemoteSigner.Enable {
+		cfg.RemoteSigner.MacaroonPath = lncfg.CleanAndExpandPath(
+			cfg.RemoteSigner.MacaroonPath,
+		)
+		cfg.RemoteSigner.TLSCertPath = lncfg.CleanAndExpandPath(
+			cfg.RemoteSigner.TLSCertPath,
+		)
+	}
+
 	// Adjust the default lnd macaroon path if only the network is
 	// specified.
 	if cfg.ChainConf.Network != defaultNetwork &&
@@ -833,6 +1180,11 @@ func ValidateConfig(cfg Config, cfgLogger btclog.Logger) (*Config, error) {
 		}
 	}
 
+	// Validate the Tor config.
+	if err := cfg.Tor.Validate(); err != nil {
+		return nil, mkErr("error in Tor config: %v", err)
+	}
+
 	// Validate the experimental command line config.
 	err = cfg.Experimental.Validate()
 	if err != nil {
@@ -1098,6 +1450,41 @@ func CleanAndExpandPath(path string) string {
 	return filepath.Clean(os.ExpandEnv(path))
 }
 
+// getRemoteSigner returns an instance of the lnd services proxy connected to
+// the remote signer.
+func getRemoteSigner(network string,
+	cfg *RemoteSignerConfig) (*lndclient.GrpcLndServices, error) {
+
+	ctxt, cancel := context.WithTimeout(context.Background(), cfg.Timeout)
+	defer cancel()
+
+	// The remote signer usually doesn't have a chain backend, so we don't
+	// wait for it to be synced to the chain.
+	return lndclient.NewLndServices(&lndclient.LndServicesConfig{
+		LndAddress:            cfg.RPCHost,
+		Network:               lndclient.Network(network),
+		CustomMacaroonPath:    cfg.MacaroonPath,
+		TLSPath:               cfg.TLSCertPath,
+		CheckVersion:          minimalCompatibleVersion,
+		BlockUntilChainSynced: false,
+		BlockUntilUnlocked:    true,
+		CallerCtx:             ctxt,
+	})
+}
+
+// dialRemoteSigner connects to the remote signer and returns its lnd services
+// together with a function that closes the connection.
+func dialRemoteSigner(network string,
+	cfg *RemoteSignerConfig) (*lndclient.LndServices, func(), error) {
+
+	remoteSigner, err := getRemoteSigner(network, cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &remoteSigner.LndServices, remoteSigner.Close, nil
+}
+
 // getLnd returns an instance of the lnd services proxy.
 func getLnd(network string, cfg *LndConfig,
 	interceptor signal.Interceptor) (*lndclient.GrpcLndServices, error) {
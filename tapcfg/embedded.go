@@ -0,0 +1,201 @@
+package tapcfg
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btclog"
+	"github.com/lightninglabs/lndclient"
+	tap "github.com/lightninglabs/taproot-assets"
+	"github.com/lightningnetwork/lnd/signal"
+)
+
+// embeddedOptions holds the dependencies of an embedded server that can be
+// injected by the host daemon.
+type embeddedOptions struct {
+	// lndServices is the connection to lnd. If it is nil, the server dials
+	// lnd using the lnd section of the config.
+	lndServices *lndclient.LndServices
+
+	// db is the database of the server. If it is nil, the server opens the
+	// database configured in the config.
+	db Database
+
+	// logger is used to log the setup of the server.
+	logger btclog.Logger
+
+	// enableChannelFeatures indicates whether the Taproot Asset channel
+	// features should be enabled.
+	enableChannelFeatures bool
+
+	// errChan receives critical errors of the server's subsystems.
+	errChan chan<- error
+}
+
+// EmbeddedOption is a functional option that modifies the dependencies of an
+// embedded server.
+type EmbeddedOption func(*embeddedOptions)
+
+// WithLndServices makes the embedded server use the given lnd connection
+// instead of dialing lnd itself. The lnd section of the config is ignored.
+func WithLndServices(lndServices *lndclient.LndServices) EmbeddedOption {
+	return func(o *embeddedOptions) {
+		o.lndServices = lndServices
+	}
+}
+
+// WithDatabase makes the embedded server use the given database instead of
+// opening the database configured in the config.
+func WithDatabase(db Database) EmbeddedOption {
+	return func(o *embeddedOptions) {
+		o.db = db
+	}
+}
+
+// WithLogger makes the embedded server log its setup to the given logger.
+func WithLogger(logger btclog.Logger) EmbeddedOption {
+	return func(o *embeddedOptions) {
+		o.logger = logger
+	}
+}
+
+// WithChannelFeatures enables the Taproot Asset channel features of the
+// embedded server. This requires the host daemon to hook the server into lnd.
+func WithChannelFeatures() EmbeddedOption {
+	return func(o *embeddedOptions) {
+		o.enableChannelFeatures = true
+	}
+}
+
+// WithErrorChan makes the embedded server report critical errors of its
+// subsystems on the given channel. The host daemon should shut down the
+// server when it receives an error.
+func WithErrorChan(errChan chan<- error) EmbeddedOption {
+	return func(o *embeddedOptions) {
+		o.errChan = errChan
+	}
+}
+
+// NewEmbeddedServer creates a Taproot Asset server that runs within another Go
+// daemon. The server doesn't listen for RPC connections, instead its
+// subsystems are used directly after starting it with Start. The given config
+// must have been validated with ValidateConfig.
+//
+// NOTE: This lives in tapcfg instead of the taprootassets package, as that
+// package can't import the config without an import cycle.
+func NewEmbeddedServer(cfg *Config, opts ...EmbeddedOption) (*tap.Server,
+	error) {
+
+	options := &embeddedOptions{
+		logger: btclog.Disabled,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	cfgLogger := options.logger
+
+	// Everything we acquire here is released once the server is stopped,
+	// or right away if we fail to create the server.
+	var shutdownFuncs []func() error
+	success := false
+	defer func() {
+		if !success {
+			releaseResources(shutdownFuncs, cfgLogger)
+		}
+	}()
+
+	// Without an error channel of the host daemon, we still need to drain
+	// the errors so the subsystems don't block, so we log them instead.
+	errChan := options.errChan
+	if errChan == nil {
+		drainChan, stopDrain := drainErrors(cfgLogger)
+		shutdownFuncs = append(shutdownFuncs, stopDrain)
+		errChan = drainChan
+	}
+
+	lndServices := options.lndServices
+	if lndServices == nil {
+		cfgLogger.Infof("Attempting to establish connection to lnd...")
+
+		lndConn, err := getLnd(
+			cfg.ChainConf.Network, cfg.Lnd, signal.Interceptor{},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to connect to lnd "+
+				"node: %w", err)
+		}
+		shutdownFuncs = append(shutdownFuncs, func() error {
+			lndConn.Close()
+			return nil
+		})
+
+		cfgLogger.Infof("lnd connection initialized")
+
+		lndServices = &lndConn.LndServices
+	}
+
+	db := options.db
+	if db == nil {
+		var err error
+		db, err = openDatabase(cfg, cfgLogger)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	serverCfg, err := genServerConfig(
+		cfg, cfgLogger, db, lndServices, options.enableChannelFeatures,
+		errChan,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate server config: %w",
+			err)
+	}
+
+	// No RPC is served, so there is nothing to authenticate.
+	serverCfg.RPCConfig = &tap.RPCConfig{
+		NoMacaroons:      true,
+		MaxProofFileSize: cfg.RpcConf.MaxProofFileSize,
+	}
+
+	// The resources acquired here must outlive any acquired while
+	// generating the config, so they are released after those.
+	serverCfg.ShutdownFuncs = append(
+		shutdownFuncs, serverCfg.ShutdownFuncs...,
+	)
+	success = true
+
+	return tap.NewServer(serverCfg), nil
+}
+
+// drainErrors returns a channel whose errors are logged to the given logger,
+// and a function that stops logging them.
+func drainErrors(logger btclog.Logger) (chan error, func() error) {
+	var (
+		errChan = make(chan error)
+		quit    = make(chan struct{})
+		done    = make(chan struct{})
+	)
+	go func() {
+		defer close(done)
+
+		for {
+			select {
+			case err := <-errChan:
+				logger.Errorf("Embedded server error: %v", err)
+
+			case <-quit:
+				return
+			}
+		}
+	}()
+
+	stop := func() error {
+		close(quit)
+		<-done
+
+		return nil
+	}
+
+	return errChan, stop
+}
@@ -0,0 +1,45 @@
+package tapcfg
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btclog"
+	"github.com/stretchr/testify/require"
+)
+
+const testTimeout = time.Second
+
+// TestDrainErrors makes sure the errors of an embedded server are drained
+// until the drain is stopped, and that stopping it ends its goroutine.
+func TestDrainErrors(t *testing.T) {
+	t.Parallel()
+
+	errChan, stop := drainErrors(btclog.Disabled)
+
+	select {
+	case errChan <- errors.New("subsystem error"):
+	case <-time.After(testTimeout):
+		t.Fatalf("error wasn't drained")
+	}
+
+	stopped := make(chan error)
+	go func() {
+		stopped <- stop()
+	}()
+
+	select {
+	case err := <-stopped:
+		require.NoError(t, err)
+	case <-time.After(testTimeout):
+		t.Fatalf("drain goroutine didn't exit")
+	}
+
+	// Nobody drains the channel anymore once the drain is stopped.
+	select {
+	case errChan <- errors.New("late error"):
+		t.Fatalf("error drained after stop")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
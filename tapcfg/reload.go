@@ -0,0 +1,84 @@
+package tapcfg
+
+import (
+	"fmt"
+
+	"github.com/jessevdk/go-flags"
+	tap "github.com/lightninglabs/taproot-assets"
+)
+
+// LoadReloadableConfig loads the config file and the command line options
+// again, the same way LoadConfig does on startup, and returns the part of the
+// configuration that can be applied to a running daemon. All other options
+// only take effect after a restart. The network of the running daemon is used
+// to pick the default values that depend on it.
+func LoadReloadableConfig(runningCfg *Config) (*tap.ReloadableConfig,
+	error) {
+
+	preCfg := DefaultConfig()
+	if _, err := flags.Parse(&preCfg); err != nil {
+		return nil, err
+	}
+
+	configFilePath, err := resolveConfigFile(&preCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unlike on startup, a config file we can't read is an error, as the
+	// config would otherwise be reset to the defaults.
+	cfg := preCfg
+	fileParser := flags.NewParser(&cfg, flags.Default)
+	err = flags.NewIniParser(fileParser).ParseFile(configFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse config file: %w", err)
+	}
+
+	// The command line options take precedence, same as on startup.
+	flagParser := flags.NewParser(&cfg, flags.Default)
+	if _, err := flagParser.Parse(); err != nil {
+		return nil, err
+	}
+	cfg.ChainConf.Network = runningCfg.ChainConf.Network
+
+	methodCosts, err := tap.ParseUniverseMethodCosts(
+		cfg.Universe.MethodCosts,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse universe method "+
+			"costs: %w", err)
+	}
+
+	courierAddr, err := parseDefaultCourierAddr(&cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	reloadCfg := &tap.ReloadableConfig{
+		FederationServers:        cfg.Universe.FederationServers,
+		UniverseSyncInterval:     cfg.Universe.SyncInterval,
+		UniverseQueriesPerSecond: cfg.Universe.UniverseQueriesPerSecond,
+		UniverseQueriesBurst:     cfg.Universe.UniverseQueriesBurst,
+		UniverseClientQPS:        cfg.Universe.ClientQueriesPerSecond,
+		UniverseClientBurst:      cfg.Universe.ClientQueriesBurst,
+		UniverseMethodCosts:      methodCosts,
+		DefaultProofCourierAddr:  courierAddr,
+	}
+	if err := reloadCfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return reloadCfg, nil
+}
+
+// ReloadConfig loads the reloadable part of the configuration and applies it
+// to the given running server. If the new configuration is invalid, the server
+// keeps running with its current configuration.
+func ReloadConfig(runningCfg *Config, server *tap.Server) error {
+	reloadCfg, err := LoadReloadableConfig(runningCfg)
+	if err != nil {
+		return fmt.Errorf("unable to load config: %w", err)
+	}
+
+	return server.ReloadConfig(reloadCfg)
+}
@@ -3,49 +3,60 @@ package tapcfg
 import (
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
 	"database/sql"
 	"encoding/binary"
+	"encoding/hex"
 	"fmt"
+	"net/http"
+	"net/url"
+	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btclog"
 	"github.com/lightninglabs/lndclient"
 	tap "github.com/lightninglabs/taproot-assets"
 	"github.com/lightninglabs/taproot-assets/address"
 	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/compliance"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/invoice"
+	"github.com/lightninglabs/taproot-assets/mssmt"
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/rfq"
+	"github.com/lightninglabs/taproot-assets/rpccompress"
 	"github.com/lightninglabs/taproot-assets/tapchannel"
 	"github.com/lightninglabs/taproot-assets/tapdb"
 	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
 	"github.com/lightninglabs/taproot-assets/tapfreighter"
 	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/tapmusig"
 	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightninglabs/taproot-assets/tapsend"
 	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightninglabs/taproot-assets/webhook"
 	"github.com/lightningnetwork/lnd"
 	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/signal"
+	"google.golang.org/grpc"
 )
 
-// databaseBackend is an interface that contains all methods our different
-// database backends implement.
-type databaseBackend interface {
+// Database is an interface that contains all methods our different database
+// backends implement, such as tapdb.SqliteStore and tapdb.PostgresStore.
+type Database interface {
 	tapdb.BatchedQuerier
+	tapdb.SchemaVersioner
 	WithTx(tx *sql.Tx) *sqlc.Queries
 }
 
-// genServerConfig generates a server config from the given tapd config.
-//
-// NOTE: The RPCConfig and SignalInterceptor fields must be set by the caller
-// after generating the server config.
-func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
-	lndServices *lndclient.LndServices, enableChannelFeatures bool,
-	mainErrChan chan<- error) (*tap.Config, error) {
-
-	var err error
-
-	// Now that we know where the database will live, we'll go ahead and
-	// open up the default implementation of it.
-	var db databaseBackend
+// openDatabase opens the database backend selected in the given tapd config.
+func openDatabase(cfg *Config, cfgLogger btclog.Logger) (Database, error) {
+	var (
+		db  Database
+		err error
+	)
 	switch cfg.DatabaseBackend {
 	case DatabaseBackendSqlite:
 		cfgLogger.Infof("Opening sqlite3 database at: %v",
@@ -65,6 +76,68 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		return nil, fmt.Errorf("unable to open database: %w", err)
 	}
 
+	return db, nil
+}
+
+// parseDefaultCourierAddr parses the default proof courier address of the
+// given config. If the address wasn't changed from its default value, the
+// universe proof courier of the mainnet federation server is used on mainnet.
+// On any other network, such as regtest, we can't use a universe proof courier
+// by default, as we don't know what server to pick. So we fall back to using
+// the hashmail courier, which works in all cases.
+func parseDefaultCourierAddr(cfg *Config) (*url.URL, error) {
+	courierAddr := cfg.DefaultProofCourierAddr
+	switch {
+	case courierAddr == "":
+		courierAddr = fmt.Sprintf(
+			"%s://%s", proof.HashmailCourierType,
+			fallbackHashMailAddr,
+		)
+
+	// An address that was set explicitly is used as is.
+	case courierAddr != defaultProofCourierAddr:
+
+	case cfg.ChainConf.Network == "mainnet":
+		courierAddr = fmt.Sprintf(
+			"%s://%s", proof.UniverseRpcCourierType,
+			defaultMainnetFederationServer,
+		)
+
+	case cfg.ChainConf.Network != "testnet":
+		courierAddr = fmt.Sprintf(
+			"%s://%s", proof.HashmailCourierType,
+			fallbackHashMailAddr,
+		)
+	}
+
+	proofCourierAddr, err := proof.ParseCourierAddress(courierAddr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse default proof "+
+			"courier address: %w", err)
+	}
+
+	return proofCourierAddr, nil
+}
+
+// genServerConfig generates a server config from the given tapd config, using
+// the given database.
+//
+// NOTE: The RPCConfig and SignalInterceptor fields must be set by the caller
+// after generating the server config.
+func genServerConfig(cfg *Config, cfgLogger btclog.Logger, db Database,
+	lndServices *lndclient.LndServices, enableChannelFeatures bool,
+	mainErrChan chan<- error) (*tap.Config, error) {
+
+	// Connections we open here are released once the server is stopped,
+	// or right away if we fail to generate the config.
+	var shutdownFuncs []func() error
+	success := false
+	defer func() {
+		if !success {
+			releaseResources(shutdownFuncs, cfgLogger)
+		}
+	}()
+
 	defaultClock := clock.NewDefaultClock()
 	rksDB := tapdb.NewTransactionExecutor(
 		db, func(tx *sql.Tx) tapdb.KeyStore {
@@ -89,10 +162,28 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 			return db.WithTx(tx)
 		},
 	)
-	tapChainParams := address.ParamsForChain(cfg.ActiveNetParams.Name)
+	tapChainParams, err := address.ParamsFromChaincfg(&cfg.ActiveNetParams)
+	if err != nil {
+		return nil, err
+	}
 	tapdbAddrBook := tapdb.NewTapAddressBook(
 		addrBookDB, &tapChainParams, defaultClock,
 	)
+	contactBookDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.ContactStore {
+			return db.WithTx(tx)
+		},
+	)
+	contactBook := tapdb.NewContactBook(contactBookDB, defaultClock)
+	tickerDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.TickerStore {
+			return db.WithTx(tx)
+		},
+	)
+	tickerRegistry := tapdb.NewTickerRegistry(tickerDB, defaultClock)
+	tickerResolver := tapfreighter.NewTickerResolver(
+		tickerRegistry, tickerRegistry,
+	)
 	assetStore := tapdb.NewAssetStore(assetDB, defaultClock)
 
 	keyRing := tap.NewLndRpcKeyRing(lndServices)
@@ -112,7 +203,41 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 			return db.WithTx(tx)
 		},
 	)
-	multiverse := tapdb.NewMultiverseStore(multiverseDB)
+
+	// The universe trees are either kept in the database or, for tests
+	// and ephemeral universe servers, only in memory.
+	var (
+		multiverse interface {
+			universe.MultiverseArchive
+			proof.NotifyArchiver
+		}
+		newBaseTree func(id universe.Identifier) universe.BaseBackend
+
+		// leafQuarantine is used to quarantine corrupted leaves found
+		// by the universe auditor. It's only available for the
+		// database backed universe.
+		leafQuarantine universe.LeafQuarantine
+	)
+	if cfg.Universe.InMemory {
+		cfgLogger.Infof("Using in-memory universe, all universe data " +
+			"will be lost on shutdown")
+
+		memMultiverse, err := newMemMultiverse(cfg, cfgLogger, db)
+		if err != nil {
+			return nil, err
+		}
+		multiverse = memMultiverse
+		newBaseTree = memMultiverse.NewBaseTree
+	} else {
+		multiverseStore := tapdb.NewMultiverseStore(multiverseDB)
+		multiverse = multiverseStore
+		leafQuarantine = multiverseStore
+		newBaseTree = func(
+			id universe.Identifier) universe.BaseBackend {
+
+			return tapdb.NewBaseUniverseTree(uniDB, id)
+		}
+	}
 
 	uniStatsDB := tapdb.NewTransactionExecutor(
 		db, func(tx *sql.Tx) tapdb.UniverseStatsStore {
@@ -127,6 +252,9 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		)
 		statsOpts = append(statsOpts, cacheOpt)
 	}
+	statsOpts = append(statsOpts, tapdb.WithStatsEventRetention(
+		cfg.Universe.StatsEventRetention,
+	))
 
 	universeStats := tapdb.NewUniverseStats(
 		uniStatsDB, defaultClock, statsOpts...,
@@ -138,18 +266,16 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 	groupVerifier := tapgarden.GenGroupVerifier(
 		context.Background(), assetMintingStore,
 	)
+	unconfirmedAnchors := universe.NewUnconfirmedAnchors()
 	uniCfg := universe.ArchiveConfig{
-		NewBaseTree: func(id universe.Identifier) universe.BaseBackend {
-			return tapdb.NewBaseUniverseTree(
-				uniDB, id,
-			)
-		},
+		NewBaseTree:          newBaseTree,
 		HeaderVerifier:       headerVerifier,
 		MerkleVerifier:       proof.DefaultMerkleVerifier,
 		GroupVerifier:        groupVerifier,
 		ChainLookupGenerator: chainBridge,
 		Multiverse:           multiverse,
 		UniverseStats:        universeStats,
+		UnconfirmedAnchors:   unconfirmedAnchors,
 	}
 
 	federationStore := tapdb.NewTransactionExecutor(db,
@@ -157,8 +283,23 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 			return db.WithTx(tx)
 		},
 	)
+	var federationOpts []tapdb.UniverseFederationOption
+	if cfg.Universe.StrictIDs {
+		federationOpts = append(
+			federationOpts, tapdb.WithStrictUniIDs(),
+		)
+	}
 	federationDB := tapdb.NewUniverseFederationDB(
-		federationStore, defaultClock,
+		federationStore, defaultClock, federationOpts...,
+	)
+
+	idempotencyDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.IdempotencyKeyStore {
+			return db.WithTx(tx)
+		},
+	)
+	idempotencyStore := tapdb.NewIdempotencyStore(
+		idempotencyDB, defaultClock,
 	)
 
 	proofFileStore, err := proof.NewFileArchiver(cfg.networkDir)
@@ -170,7 +311,58 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		assetStore, proofFileStore,
 	)
 
-	federationMembers := cfg.Universe.FederationServers
+	proofImportDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.ProofImportStore {
+			return db.WithTx(tx)
+		},
+	)
+	proofImportStore := tapdb.NewProofImportJobs(
+		proofImportDB, defaultClock,
+	)
+	proofImporter := proof.NewBulkImporter(&proof.BulkImporterConfig{
+		Archive:        proofArchive,
+		Verifier:       &proof.BaseVerifier{},
+		HeaderVerifier: headerVerifier,
+		MerkleVerifier: proof.DefaultMerkleVerifier,
+		GroupVerifier:  groupVerifier,
+		ChainLookupGen: chainBridge,
+		Store:          proofImportStore,
+		NumWorkers:     proof.DefaultBulkImportWorkers,
+	})
+
+	fedAssetFilter, err := universe.NewFedAssetFilter(
+		cfg.Universe.AllowAssetIDs, cfg.Universe.AllowGroupKeys,
+		cfg.Universe.DenyAssetIDs, cfg.Universe.DenyGroupKeys,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid universe asset filter: %w", err)
+	}
+
+	watchRules := make([]*universe.WatchRule, 0, len(cfg.Universe.Watch))
+	for _, ruleStr := range cfg.Universe.Watch {
+		rule, err := universe.ParseWatchRule(ruleStr)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse universe "+
+				"watch rule: %w", err)
+		}
+
+		watchRules = append(watchRules, rule)
+	}
+
+	var (
+		federationMembers  = cfg.Universe.FederationServers
+		federationProfiles []universe.FederationProfile
+
+		// The built-in federation profiles only allow exporting
+		// proofs by default, same as a fresh federation sync config.
+		globalSyncConfigs = []*universe.FedGlobalSyncConfig{{
+			ProofType:       universe.ProofTypeIssuance,
+			AllowSyncExport: true,
+		}, {
+			ProofType:       universe.ProofTypeTransfer,
+			AllowSyncExport: true,
+		}}
+	)
 	switch cfg.ChainConf.Network {
 	case "mainnet":
 		cfgLogger.Infof("Configuring %v as initial Universe "+
@@ -179,15 +371,17 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		federationMembers = append(
 			federationMembers, defaultMainnetFederationServer,
 		)
-
-		// For mainnet, we need to overwrite the default universe proof
-		// courier address to use the mainnet server.
-		if cfg.DefaultProofCourierAddr == defaultProofCourierAddr {
-			cfg.DefaultProofCourierAddr = fmt.Sprintf(
-				"%s://%s", proof.UniverseRpcCourierType,
-				defaultMainnetFederationServer,
-			)
-		}
+		federationProfiles = append(
+			federationProfiles, universe.FederationProfile{
+				Name: defaultMainnetFederationProfile,
+				Servers: []universe.ServerAddr{
+					universe.NewServerAddrFromStr(
+						defaultMainnetFederationServer,
+					),
+				},
+				GlobalSyncConfigs: globalSyncConfigs,
+			},
+		)
 
 	case "testnet":
 		cfgLogger.Infof("Configuring %v as initial Universe "+
@@ -196,42 +390,45 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		federationMembers = append(
 			federationMembers, defaultTestnetFederationServer,
 		)
-
-	default:
-		// For any other network, such as regtest, we can't use a
-		// universe proof courier by default, as we don't know what
-		// server to pick. So if there is no explicit value set, we
-		// fall back to using the hashmail courier, which works in all
-		// cases.
-		if cfg.DefaultProofCourierAddr == defaultProofCourierAddr {
-			cfg.DefaultProofCourierAddr = fmt.Sprintf(
-				"%s://%s", proof.HashmailCourierType,
-				fallbackHashMailAddr,
-			)
-		}
+		federationProfiles = append(
+			federationProfiles, universe.FederationProfile{
+				Name: defaultTestnetFederationProfile,
+				Servers: []universe.ServerAddr{
+					universe.NewServerAddrFromStr(
+						defaultTestnetFederationServer,
+					),
+				},
+				GlobalSyncConfigs: globalSyncConfigs,
+			},
+		)
 	}
 
-	// If no default proof courier address is set, use the fallback hashmail
-	// address.
-	fallbackHashmailCourierAddr := fmt.Sprintf(
-		"%s://%s", proof.HashmailCourierType, fallbackHashMailAddr,
-	)
-	proofCourierAddr, err := proof.ParseCourierAddress(
-		fallbackHashmailCourierAddr,
-	)
+	proofCourierAddr, err := parseDefaultCourierAddr(cfg)
 	if err != nil {
-		return nil, fmt.Errorf("unable to parse fallback proof "+
-			"courier address: %w", err)
+		return nil, err
+	}
+
+	// All configured proof courier endpoints, the default one first, can
+	// be used to fail over if one of them is down.
+	courierEndpoints := []*url.URL{proofCourierAddr}
+	for _, addr := range cfg.FallbackProofCourierAddrs {
+		fallbackAddr, err := proof.ParseCourierAddress(addr)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse fallback "+
+				"proof courier address: %w", err)
+		}
+
+		courierEndpoints = append(courierEndpoints, fallbackAddr)
 	}
 
-	// If default proof courier address is set, use it as the default.
-	if cfg.DefaultProofCourierAddr != "" {
-		proofCourierAddr, err = proof.ParseCourierAddress(
-			cfg.DefaultProofCourierAddr,
+	var passiveProofBackupAddr *url.URL
+	if cfg.Wallet.PassiveProofBackupAddr != "" {
+		passiveProofBackupAddr, err = proof.ParseCourierAddress(
+			cfg.Wallet.PassiveProofBackupAddr,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("unable to parse default proof "+
-				"courier address: %w", err)
+			return nil, fmt.Errorf("unable to parse passive proof "+
+				"backup address: %w", err)
 		}
 	}
 
@@ -250,17 +447,189 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 				},
 			)
 		},
-		SafeDepth: cfg.ReOrgSafeDepth,
-		ErrChan:   mainErrChan,
+		SafeDepth:     cfg.ReOrgSafeDepth,
+		ReOrgNotifier: unconfirmedAnchors,
+		ErrChan:       mainErrChan,
 	})
 
 	baseUni := universe.NewArchive(uniCfg)
 
+	if cfg.Universe.AuditQuarantine && leafQuarantine == nil {
+		return nil, fmt.Errorf("universe audit quarantine isn't " +
+			"supported for in-memory universes")
+	}
+	universeAuditor := universe.NewAuditor(universe.AuditorConfig{
+		Universe:            baseUni,
+		Quarantine:          leafQuarantine,
+		AuditInterval:       cfg.Universe.AuditInterval,
+		QuarantineCorrupted: cfg.Universe.AuditQuarantine,
+	})
+
+	universeRootEvents := universe.NewRootEventNotifier(
+		universe.RootEventNotifierConfig{
+			Multiverse: multiverse,
+		},
+	)
+
+	if len(cfg.Universe.SnapshotFiles) > 0 &&
+		len(cfg.Universe.SnapshotSigners) == 0 {
+
+		return nil, fmt.Errorf("universe snapshots require at least " +
+			"one trusted snapshot signer")
+	}
+	snapshotSigners := make(
+		[]*btcec.PublicKey, 0, len(cfg.Universe.SnapshotSigners),
+	)
+	for _, signerStr := range cfg.Universe.SnapshotSigners {
+		signerBytes, err := hex.DecodeString(signerStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid snapshot signer %v: %w",
+				signerStr, err)
+		}
+
+		signerKey, err := schnorr.ParsePubKey(signerBytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid snapshot signer %v: %w",
+				signerStr, err)
+		}
+
+		snapshotSigners = append(snapshotSigners, signerKey)
+	}
+
+	snapshotDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.UniverseSnapshotStore {
+			return db.WithTx(tx)
+		},
+	)
+	universeSnapshots := universe.NewSnapshotImporter(
+		universe.SnapshotImporterConfig{
+			Multiverse: multiverse,
+			Verifier:   baseUni,
+			Store: tapdb.NewUniverseSnapshots(
+				snapshotDB, defaultClock,
+			),
+			Quarantine:     leafQuarantine,
+			TrustedSigners: snapshotSigners,
+			SigThreshold:   cfg.Universe.SnapshotThreshold,
+			SnapshotFiles:  cfg.Universe.SnapshotFiles,
+		},
+	)
+
+	remoteRootDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.RemoteRootStore {
+			return db.WithTx(tx)
+		},
+	)
+	remoteRootCache := tapdb.NewRemoteUniverseRoots(
+		remoteRootDB, defaultClock,
+	)
+
+	syncCheckpointDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.SyncCheckpointStore {
+			return db.WithTx(tx)
+		},
+	)
+	syncCheckpoints := tapdb.NewUniverseSyncCheckpoints(
+		syncCheckpointDB, defaultClock,
+	)
+
+	federationProfileDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.FederationProfileStore {
+			return db.WithTx(tx)
+		},
+	)
+	federationProfileStore := tapdb.NewFederationProfiles(
+		federationProfileDB, defaultClock,
+	)
+
+	// If Tor is active, all connections to remote universe servers are
+	// made through its SOCKS proxy.
+	universeDialNet := cfg.Tor.DialNet()
+
+	// Connections to remote universe servers negotiate compression with
+	// the configured preferences, which can be overridden per server.
+	uniCompression, err := rpccompress.ParseConnConfig(
+		cfg.Universe.Compression, cfg.Universe.CompressionOverrides,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse universe compression "+
+			"config: %w", err)
+	}
+	compressionOpts := func(addr universe.ServerAddr) []grpc.DialOption {
+		return rpccompress.DialOptions(
+			uniCompression.ForHost(addr.HostStr()),
+		)
+	}
+
+	newRemoteDiffEngine := func(
+		addr universe.ServerAddr) (universe.DiffEngine, error) {
+
+		return tap.NewRpcUniverseDiff(
+			addr, universeDialNet, compressionOpts(addr)...,
+		)
+	}
+	newRemoteRegistrar := func(
+		addr universe.ServerAddr) (universe.Registrar, error) {
+
+		return tap.NewRpcUniverseRegistrar(
+			addr, universeDialNet, compressionOpts(addr)...,
+		)
+	}
+	newRootNotifier := func(
+		addr universe.ServerAddr) (universe.RootNotifier, error) {
+
+		return tap.NewRpcRootNotifier(
+			addr, universeDialNet, compressionOpts(addr)...,
+		)
+	}
+
+	// Root change notifications are only sent out if we know the address
+	// under which the federation members can reach us. They are signed
+	// with the identity key of our lnd node, so the federation members
+	// can authenticate them.
+	var (
+		localUniverseAddr fn.Option[universe.ServerAddr]
+		rootNotifSigner   universe.ResponseSigner
+	)
+	if cfg.Universe.PublicHost != "" {
+		localUniverseAddr = fn.Some(
+			universe.NewServerAddrFromStr(cfg.Universe.PublicHost),
+		)
+		rootNotifSigner = tap.NewLndRpcResponseSigner(lndServices)
+	}
+
+	// If enabled, the lists of live federation servers are fetched from
+	// the federation members, and our own list is handed out signed with
+	// the identity key of our lnd node.
+	var (
+		gossipSigner     universe.ResponseSigner
+		gossipMaxServers int
+		gossipMaxAge     time.Duration
+		newListFetcher   func(universe.ServerAddr) (
+			universe.ServerListFetcher, error)
+	)
+	if cfg.Universe.FederationGossip {
+		gossipSigner = tap.NewLndRpcResponseSigner(lndServices)
+		gossipMaxServers = cfg.Universe.FederationGossipMaxServers
+		gossipMaxAge = cfg.Universe.FederationGossipMaxAge
+		newListFetcher = func(addr universe.ServerAddr) (
+			universe.ServerListFetcher, error) {
+
+			return tap.NewRpcServerListFetcher(
+				addr, universeDialNet,
+				compressionOpts(addr)...,
+			)
+		}
+	}
+
 	universeSyncer := universe.NewSimpleSyncer(universe.SimpleSyncCfg{
 		LocalDiffEngine:     baseUni,
-		NewRemoteDiffEngine: tap.NewRpcUniverseDiff,
+		NewRemoteDiffEngine: newRemoteDiffEngine,
 		LocalRegistrar:      baseUni,
 		SyncBatchSize:       defaultUniverseSyncBatchSize,
+		RemoteRootCache:     remoteRootCache,
+		SyncCheckpoints:     syncCheckpoints,
+		Network:             tapChainParams.NetworkID(),
 	})
 
 	var runtimeIDBytes [8]byte
@@ -276,15 +645,28 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 			UniverseSyncer:          universeSyncer,
 			LocalRegistrar:          baseUni,
 			SyncInterval:            cfg.Universe.SyncInterval,
-			NewRemoteRegistrar:      tap.NewRpcUniverseRegistrar,
+			NewRemoteRegistrar:      newRemoteRegistrar,
 			StaticFederationMembers: federationMembers,
 			ServerChecker: func(addr universe.ServerAddr) error {
 				return tap.CheckFederationServer(
-					runtimeID, universe.DefaultTimeout,
-					addr,
+					runtimeID, tapChainParams.NetworkID(),
+					universe.DefaultTimeout, addr,
+					universeDialNet,
 				)
 			},
-			ErrChan: mainErrChan,
+			FederationProfiles: federationProfileStore,
+			DefaultProfiles:    federationProfiles,
+			AssetFilter:        fedAssetFilter,
+			LocalAddr:          localUniverseAddr,
+			NewRootNotifier:    newRootNotifier,
+			RootNotifSigner:    rootNotifSigner,
+			LocalDiffEngine:    baseUni,
+			WatchRules:         watchRules,
+			GossipSigner:       gossipSigner,
+			NewListFetcher:     newListFetcher,
+			GossipMaxServers:   gossipMaxServers,
+			GossipMaxAge:       gossipMaxAge,
+			ErrChan:            mainErrChan,
 		},
 	)
 
@@ -300,8 +682,112 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 	}
 	addrBook := address.NewBook(addrBookConfig)
 
-	virtualTxSigner := tap.NewLndRpcVirtualTxSigner(lndServices)
-	coinSelect := tapfreighter.NewCoinSelect(assetStore)
+	anchorOrdering, err := tapsend.ParseOutputOrdering(
+		cfg.Wallet.AnchorOrdering,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	courierPreflight, err := tapfreighter.ParseCourierPreflightPolicy(
+		cfg.Wallet.CourierPreflight,
+	)
+	if err != nil {
+		return nil, err
+	}
+	courierProbeTimeout := cfg.Wallet.CourierPreflightTimeout
+
+	coinSelectStrategy, err := tapfreighter.ParseCoinSelectStrategy(
+		cfg.Wallet.CoinSelectStrategy,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	receiveCpfpMaxFeeRate := chainfee.SatPerKVByte(
+		cfg.Wallet.ReceiveCpfpMaxFeeRateSatVB * 1000,
+	).FeePerKWeight()
+	receiveCpfpCfg := tapgarden.CpfpConfig{
+		Enabled:        cfg.Wallet.ReceiveCpfpAdvisory,
+		MinUnconfirmed: cfg.Wallet.ReceiveCpfpMinUnconfirmed,
+		ConfTarget:     cfg.Wallet.ReceiveCpfpConfTarget,
+		MaxFeeRate:     receiveCpfpMaxFeeRate,
+		CheckInterval:  tapgarden.DefaultCpfpCheckInterval,
+	}
+
+	receiveZeroConfCfg := tapgarden.ZeroConfConfig{
+		Enabled:       cfg.Wallet.ReceiveZeroConf,
+		MaxAmount:     cfg.Wallet.ReceiveZeroConfMaxAmount,
+		CheckInterval: tapgarden.DefaultZeroConfCheckInterval,
+	}
+	for _, assetIDStr := range cfg.Wallet.ReceiveZeroConfAssetIDs {
+		assetIDBytes, err := hex.DecodeString(assetIDStr)
+		if err != nil || len(assetIDBytes) != sha256.Size {
+			return nil, fmt.Errorf("invalid zero-conf receive "+
+				"asset ID: %v", assetIDStr)
+		}
+
+		var assetID asset.ID
+		copy(assetID[:], assetIDBytes)
+		receiveZeroConfCfg.AssetIDs = append(
+			receiveZeroConfCfg.AssetIDs, assetID,
+		)
+	}
+
+	// All asset-level signing is done by the lnd node we're connected to,
+	// unless it is delegated to a remote signer that holds the private
+	// keys.
+	signerServices := lndServices
+	if cfg.RemoteSigner.Enable {
+		cfgLogger.Infof("Connecting to remote signer at %v",
+			cfg.RemoteSigner.RPCHost)
+
+		dial := func() (*lndclient.LndServices, func(), error) {
+			return dialRemoteSigner(
+				cfg.ChainConf.Network, cfg.RemoteSigner,
+			)
+		}
+		remoteSigner, closeSigner, err := connectRemoteSigner(
+			lndServices, dial,
+		)
+		if err != nil {
+			return nil, err
+		}
+		shutdownFuncs = append(shutdownFuncs, closeSigner)
+
+		signerServices = remoteSigner
+	}
+	virtualTxSigner := tap.NewLndRpcVirtualTxSigner(signerServices)
+	coinSelectionDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.CoinSelectionStore {
+			return db.WithTx(tx)
+		},
+	)
+	coinSelectionLog := tapdb.NewCoinSelectionMetrics(coinSelectionDB)
+	coinSelect := tapfreighter.NewCoinSelect(
+		assetStore, tapfreighter.WithCoinSelectionLog(coinSelectionLog),
+	)
+	sendQuotaDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.SendQuotaStore {
+			return db.WithTx(tx)
+		},
+	)
+	sendQuotaLog := tapdb.NewSendQuotas(sendQuotaDB, defaultClock)
+	anchorExportDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.AnchorExportStore {
+			return db.WithTx(tx)
+		},
+	)
+	healthDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.HealthStore {
+			return db.WithTx(tx)
+		},
+	)
+	dbHealth := tapdb.NewDatabaseHealth(healthDB, db)
+	readSnapshots := tapdb.NewReadSnapshots(
+		db, tapdb.DefaultReadSnapshotTTL, tapdb.DefaultMaxReadSnapshots,
+		tapdb.DefaultMaxReadSnapshotsPerClient,
+	)
 	assetWallet := tapfreighter.NewAssetWallet(&tapfreighter.WalletConfig{
 		CoinSelector:     coinSelect,
 		AssetProofs:      proofArchive,
@@ -312,17 +798,30 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		WitnessValidator: &tap.WitnessValidatorV0{},
 		Wallet:           walletAnchor,
 		ChainParams:      &tapChainParams,
+		AnchorOrdering:   anchorOrdering,
+
+		CoinSelectStrategy: coinSelectStrategy,
+		GroupKeyIndex:      tapdbAddrBook,
 	})
 
-	// Addresses can have different proof couriers configured, but both
+	// Addresses can have different proof couriers configured, but all
 	// types of couriers that currently exist will receive this config upon
 	// initialization.
-	proofCourierDispatcher := proof.NewCourierDispatch(&proof.CourierCfg{
+	urlDispatcher := proof.NewCourierDispatch(&proof.CourierCfg{
 		HashMailCfg:    cfg.HashMailCourier,
 		UniverseRpcCfg: cfg.UniverseRpcCourier,
+		HttpsCfg:       cfg.HttpsCourier,
 		TransferLog:    assetStore,
 		LocalArchive:   proofArchive,
+		Compression:    uniCompression,
 	})
+	courierHealth := proof.NewCourierHealth(
+		proof.DefaultCourierFailureThreshold,
+		proof.DefaultCourierHealthCooldown,
+	)
+	proofCourierDispatcher := proof.NewFailoverDispatch(
+		urlDispatcher, courierEndpoints, courierHealth,
+	)
 
 	multiNotifier := proof.NewMultiArchiveNotifier(assetStore, multiverse)
 
@@ -374,6 +873,39 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 	porterProofReader := proof.NewMultiArchiveNotifier(
 		assetStore, multiverse, proofFileStore,
 	)
+	// If a compliance policy service is configured, all outbound and
+	// inbound transfers are screened with it.
+	var complianceChecker *compliance.Checker
+	if cfg.Compliance.URL != "" {
+		failMode, err := compliance.ParseFailMode(
+			cfg.Compliance.FailMode,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		screener, err := compliance.NewHTTPScreener(
+			cfg.Compliance.URL, cfg.Compliance.AuthHeader,
+			&http.Client{
+				Timeout: cfg.Compliance.Timeout,
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create compliance "+
+				"screener: %w", err)
+		}
+
+		complianceChecker = compliance.NewChecker(&compliance.Config{
+			Screener: screener,
+			FailMode: failMode,
+			Timeout:  cfg.Compliance.Timeout,
+		})
+	}
+
+	incrementalRelayFee := chainfee.SatPerKVByte(
+		cfg.Wallet.IncrementalRelayFeeSatVB * 1000,
+	).FeePerKWeight()
+
 	chainPorter := tapfreighter.NewChainPorter(
 		&tapfreighter.ChainPorterConfig{
 			Signer:      virtualTxSigner,
@@ -390,6 +922,15 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 			ProofWriter:            proofFileStore,
 			ProofCourierDispatcher: proofCourierDispatcher,
 			ProofWatcher:           reOrgWatcher,
+			Compliance:             complianceChecker,
+			SendQuotaLog:           sendQuotaLog,
+			AnchorExportLog:        tapdb.NewAnchorExports(anchorExportDB),
+			PassiveProofBackupAddr: passiveProofBackupAddr,
+			SendBatchWindow:        cfg.Wallet.SendBatchWindow,
+			IncrementalRelayFee:    incrementalRelayFee,
+			AnchorOrdering:         anchorOrdering,
+			CourierPreflight:       courierPreflight,
+			CourierProbeTimeout:    courierProbeTimeout,
 			ErrChan:                mainErrChan,
 		},
 	)
@@ -449,6 +990,14 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 			DefaultCourierAddr: proofCourierAddr,
 		},
 	)
+	sweepMonitorCfg := tapchannel.SweepMonitorCfg{
+		FeeBumper:    lndServices.WalletKit,
+		BumpInterval: cfg.Wallet.ForceCloseSweepBumpInterval,
+		ConfTarget:   cfg.Wallet.ForceCloseSweepConfTarget,
+		MaxFeeRate: chainfee.SatPerKVByte(
+			cfg.Wallet.ForceCloseSweepMaxFeeRateSatVB * 1000,
+		).FeePerKWeight(),
+	}
 	auxSweeper := tapchannel.NewAuxSweeper(
 		&tapchannel.AuxSweeperCfg{
 			AddrBook:           addrBook,
@@ -462,10 +1011,134 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 			GroupVerifier: tapgarden.GenGroupVerifier(
 				context.Background(), assetMintingStore,
 			),
-			ChainBridge: chainBridge,
+			ChainBridge:  chainBridge,
+			SweepMonitor: sweepMonitorCfg,
+		},
+	)
+
+	assetCustodian := tapgarden.NewCustodian(
+		&tapgarden.CustodianConfig{
+			ChainParams:  &tapChainParams,
+			WalletAnchor: walletAnchor,
+			ChainBridge:  chainBridge,
+			GroupVerifier: tapgarden.GenGroupVerifier(
+				context.Background(), assetMintingStore,
+			),
+			AddrBook:               addrBook,
+			ProofArchive:           proofArchive,
+			ProofNotifier:          multiNotifier,
+			ErrChan:                mainErrChan,
+			ProofCourierDispatcher: proofCourierDispatcher,
+			ProofRetrievalDelay:    cfg.CustodianProofRetrievalDelay,
+			ProofWatcher:           reOrgWatcher,
+			Cpfp:                   receiveCpfpCfg,
+			ZeroConf:               receiveZeroConfCfg,
+			Compliance:             complianceChecker,
 		},
 	)
 
+	// If enabled, proofs that are requested over RPC but are missing from
+	// the local archive are fetched from the federation and stored again.
+	// Only the RPC server uses the fallback archive, so our own subsystems
+	// never leak which proofs they look up.
+	var (
+		rpcProofArchive proof.Archiver = proofArchive
+		proofRecoveries fn.EventPublisher[fn.Event, time.Time]
+	)
+	if cfg.Universe.FetchMissingProofs {
+		proofFetcher := tap.NewUniverseProofFetcher(
+			tap.UniverseProofFetcherCfg{
+				Servers:         federationDB.UniverseServers,
+				LocalArchive:    proofArchive,
+				QueryAssetGroup: tapdbAddrBook.QueryAssetGroup,
+				DialNet:         universeDialNet,
+				DialOpts:        compressionOpts,
+			},
+		)
+		fallbackArchive := proof.NewFallbackArchiver(
+			proof.FallbackArchiverCfg{
+				Archive:        proofArchive,
+				Fetcher:        proofFetcher,
+				HeaderVerifier: headerVerifier,
+				MerkleVerifier: proof.DefaultMerkleVerifier,
+				GroupVerifier:  groupVerifier,
+				ChainLookupGen: chainBridge,
+			},
+		)
+
+		rpcProofArchive = fallbackArchive
+		proofRecoveries = fallbackArchive
+	}
+
+	// If enabled, the anchor outputs of our assets are watched for spends
+	// that didn't originate from this node.
+	var (
+		spendWatcher *tapfreighter.SpendWatcher
+		spendAlerts  fn.EventPublisher[fn.Event, time.Time]
+	)
+	if cfg.Wallet.SpendWatchInterval != 0 {
+		spendWatcher = tapfreighter.NewSpendWatcher(
+			&tapfreighter.SpendWatcherConfig{
+				Anchors:       assetStore,
+				ChainBridge:   chainBridge,
+				Wallet:        walletAnchor,
+				ExportLog:     assetStore,
+				CheckInterval: cfg.Wallet.SpendWatchInterval,
+			},
+		)
+		spendAlerts = spendWatcher
+	}
+
+	var webhookEndpoints []*webhook.Endpoint
+	for _, endpointStr := range cfg.Webhook.Endpoints {
+		endpoint, err := webhook.ParseEndpoint(endpointStr)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse webhook "+
+				"endpoint: %w", err)
+		}
+
+		webhookEndpoints = append(webhookEndpoints, endpoint)
+	}
+	webhooks := webhook.NewDispatcher(&webhook.Config{
+		Endpoints:       webhookEndpoints,
+		ReceiveEvents:   assetCustodian,
+		SendEvents:      chainPorter,
+		IssuanceAlerts:  universeFederation,
+		ProofRecoveries: proofRecoveries,
+		SpendAlerts:     spendAlerts,
+		HTTPClient: &http.Client{
+			Timeout: webhook.DefaultRequestTimeout,
+		},
+		MaxAttempts:    cfg.Webhook.MaxAttempts,
+		InitialBackoff: cfg.Webhook.InitialBackoff,
+	})
+
+	invoiceDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.InvoiceStore {
+			return db.WithTx(tx)
+		},
+	)
+	invoiceStore := tapdb.NewAssetInvoices(invoiceDB, &tapChainParams)
+	invoiceManager := invoice.NewManager(&invoice.ManagerConfig{
+		Store:         invoiceStore,
+		AddrBook:      addrBook,
+		ReceiveEvents: assetCustodian,
+		Clock:         defaultClock,
+		ErrChan:       mainErrChan,
+	})
+
+	muSig2DB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.MuSig2SessionStore {
+			return db.WithTx(tx)
+		},
+	)
+	muSig2Sessions := tapmusig.NewManager(&tapmusig.ManagerConfig{
+		Store:     tapdb.NewMuSig2Sessions(muSig2DB),
+		Signer:    signerServices.Signer,
+		Validator: &tap.WitnessValidatorV0{},
+		Clock:     defaultClock,
+	})
+
 	// Parse the universe public access status.
 	universePublicAccess, err := tap.ParseUniversePublicAccessStatus(
 		cfg.Universe.PublicAccess,
@@ -475,15 +1148,32 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 			"access status: %w", err)
 	}
 
+	universeMethodCosts, err := tap.ParseUniverseMethodCosts(
+		cfg.Universe.MethodCosts,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse universe method "+
+			"costs: %w", err)
+	}
+
+	// If enabled, universe query responses are signed with the identity
+	// key of our lnd node.
+	var universeResponseSigner universe.ResponseSigner
+	if cfg.Universe.SignResponses {
+		universeResponseSigner = tap.NewLndRpcResponseSigner(
+			lndServices,
+		)
+	}
+
+	success = true
+
 	return &tap.Config{
 		DebugLevel:            cfg.DebugLevel,
 		RuntimeID:             runtimeID,
 		EnableChannelFeatures: enableChannelFeatures,
 		Lnd:                   lndServices,
-		ChainParams: address.ParamsForChain(
-			cfg.ActiveNetParams.Name,
-		),
-		ReOrgWatcher: reOrgWatcher,
+		ChainParams:           tapChainParams,
+		ReOrgWatcher:          reOrgWatcher,
 		AssetMinter: tapgarden.NewChainPlanter(tapgarden.PlanterConfig{
 			GardenKit: tapgarden.GardenKit{
 				Wallet:                walletAnchor,
@@ -493,6 +1183,7 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 				KeyRing:               keyRing,
 				GenSigner:             virtualTxSigner,
 				GenTxBuilder:          &tapscript.GroupTxBuilder{},
+				MuSig2Signer:          signerServices.Signer,
 				TxValidator:           &tap.ValidatorV0{},
 				ProofFiles:            proofFileStore,
 				Universe:              universeFederation,
@@ -502,38 +1193,43 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 			ProofUpdates: proofArchive,
 			ErrChan:      mainErrChan,
 		}),
-		AssetCustodian: tapgarden.NewCustodian(
-			&tapgarden.CustodianConfig{
-				ChainParams:  &tapChainParams,
-				WalletAnchor: walletAnchor,
-				ChainBridge:  chainBridge,
-				GroupVerifier: tapgarden.GenGroupVerifier(
-					context.Background(), assetMintingStore,
-				),
-				AddrBook:               addrBook,
-				ProofArchive:           proofArchive,
-				ProofNotifier:          multiNotifier,
-				ErrChan:                mainErrChan,
-				ProofCourierDispatcher: proofCourierDispatcher,
-				ProofRetrievalDelay:    cfg.CustodianProofRetrievalDelay, ProofWatcher: reOrgWatcher,
-			},
-		),
+		AssetCustodian:           assetCustodian,
 		ChainBridge:              chainBridge,
 		AddrBook:                 addrBook,
 		AddrBookDisableSyncer:    cfg.AddrBook.DisableSyncer,
+		ContactBook:              contactBook,
+		TickerResolver:           tickerResolver,
 		DefaultProofCourierAddr:  proofCourierAddr,
-		ProofArchive:             proofArchive,
+		ProofCourierHealth:       courierHealth,
+		ProofArchive:             rpcProofArchive,
+		ProofImporter:            proofImporter,
 		AssetWallet:              assetWallet,
 		CoinSelect:               coinSelect,
+		CoinSelectionLog:         coinSelectionLog,
+		SendQuotaLog:             sendQuotaLog,
 		ChainPorter:              chainPorter,
+		Webhooks:                 webhooks,
+		SpendWatcher:             spendWatcher,
+		InvoiceManager:           invoiceManager,
+		MuSig2Sessions:           muSig2Sessions,
 		UniverseArchive:          baseUni,
 		UniverseSyncer:           universeSyncer,
 		UniverseFederation:       universeFederation,
+		UniverseAuditor:          universeAuditor,
+		UniverseRootEvents:       universeRootEvents,
+		UniverseSnapshots:        universeSnapshots,
+		UniverseDialNet:          universeDialNet,
+		UniverseResponseSigner:   universeResponseSigner,
 		UniFedSyncAllAssets:      cfg.Universe.SyncAllAssets,
+		UniverseCheckInvariants:  cfg.Universe.CheckInvariants,
 		UniverseStats:            universeStats,
 		UniversePublicAccess:     universePublicAccess,
 		UniverseQueriesPerSecond: cfg.Universe.UniverseQueriesPerSecond,
 		UniverseQueriesBurst:     cfg.Universe.UniverseQueriesBurst,
+		UniverseClientQPS:        cfg.Universe.ClientQueriesPerSecond,
+		UniverseClientBurst:      cfg.Universe.ClientQueriesBurst,
+		UniverseMethodCosts:      universeMethodCosts,
+		UniverseCompression:      uniCompression.Default,
 		RfqManager:               rfqManager,
 		AuxLeafCreator:           auxLeafCreator,
 		AuxLeafSigner:            auxLeafSigner,
@@ -544,17 +1240,108 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		AuxSweeper:               auxSweeper,
 		LogWriter:                cfg.LogWriter,
 		DatabaseConfig: &tap.DatabaseConfig{
-			RootKeyStore: tapdb.NewRootKeyStore(rksDB),
-			MintingStore: assetMintingStore,
-			AssetStore:   assetStore,
-			TapAddrBook:  tapdbAddrBook,
-			Multiverse:   multiverse,
-			FederationDB: federationDB,
-		},
-		Prometheus: cfg.Prometheus,
+			RootKeyStore:     tapdb.NewRootKeyStore(rksDB),
+			MintingStore:     assetMintingStore,
+			AssetStore:       assetStore,
+			TapAddrBook:      tapdbAddrBook,
+			Multiverse:       multiverse,
+			FederationDB:     federationDB,
+			IdempotencyStore: idempotencyStore,
+			Health:           dbHealth,
+			ReadSnapshots:    readSnapshots,
+		},
+		Prometheus:    cfg.Prometheus,
+		ShutdownFuncs: shutdownFuncs,
+	}, nil
+}
+
+// connectRemoteSigner connects to the remote signer with the given dial
+// function and makes sure it holds the private keys of the wallet of the given
+// lnd node. The returned function closes the connection.
+func connectRemoteSigner(lndServices *lndclient.LndServices,
+	dial func() (*lndclient.LndServices, func(), error)) (
+	*lndclient.LndServices, func() error, error) {
+
+	remoteSigner, closeSigner, err := dial()
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to connect to remote "+
+			"signer: %w", err)
+	}
+
+	// The remote signer must hold the private keys of the wallet of our
+	// lnd node, which share the same node identity key.
+	if remoteSigner.NodePubkey != lndServices.NodePubkey {
+		closeSigner()
+
+		return nil, nil, fmt.Errorf("remote signer identity %x "+
+			"doesn't match lnd identity %x",
+			remoteSigner.NodePubkey[:], lndServices.NodePubkey[:])
+	}
+
+	return remoteSigner, func() error {
+		closeSigner()
+		return nil
 	}, nil
 }
 
+// releaseResources calls the given shutdown functions in reverse order and
+// logs any error they return.
+func releaseResources(shutdownFuncs []func() error, logger btclog.Logger) {
+	for i := len(shutdownFuncs) - 1; i >= 0; i-- {
+		if err := shutdownFuncs[i](); err != nil {
+			logger.Errorf("Error releasing server resource: %v",
+				err)
+		}
+	}
+}
+
+// newMemMultiverse creates the in-memory multiverse, with its tree nodes kept
+// in the configured tree store.
+func newMemMultiverse(cfg *Config, cfgLogger btclog.Logger,
+	db Database) (*universe.MemMultiverse, error) {
+
+	switch cfg.Universe.TreeStore {
+	case "", UniverseTreeStoreMemory:
+		return universe.NewMemMultiverse(), nil
+
+	case UniverseTreeStoreDatabase:
+		treeDB := tapdb.NewTransactionExecutor(
+			db, func(tx *sql.Tx) tapdb.TreeStore {
+				return db.WithTx(tx)
+			},
+		)
+
+		// The in-memory universe starts out empty, so we discard any
+		// trees that were left over from a previous run.
+		err := tapdb.DeleteTreesByNamespacePrefix(
+			context.Background(), treeDB, memUniverseTreePrefix,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to wipe universe tree "+
+				"store: %w", err)
+		}
+
+		cfgLogger.Infof("Keeping universe trees in the database")
+
+		branchCache := tapdb.NewTreeBranchCache(
+			cfg.Universe.TreeStoreCacheSize,
+		)
+
+		return universe.NewMemMultiverseWithStores(
+			func(namespace string) mssmt.TreeStore {
+				return tapdb.NewCachedTaprootAssetTreeStore(
+					treeDB, memUniverseTreePrefix+namespace,
+					branchCache,
+				)
+			},
+		), nil
+
+	default:
+		return nil, fmt.Errorf("unknown universe tree store: %s",
+			cfg.Universe.TreeStore)
+	}
+}
+
 // CreateServerFromConfig creates a new Taproot Asset server from the given CLI
 // config.
 func CreateServerFromConfig(cfg *Config, cfgLogger btclog.Logger,
@@ -583,9 +1370,16 @@ func CreateServerFromConfig(cfg *Config, cfgLogger btclog.Logger,
 
 	cfgLogger.Infof("lnd connection initialized")
 
+	// Now that we know where the database will live, we'll go ahead and
+	// open up the default implementation of it.
+	db, err := openDatabase(cfg, cfgLogger)
+	if err != nil {
+		return nil, err
+	}
+
 	serverCfg, err := genServerConfig(
-		cfg, cfgLogger, &lndConn.LndServices, enableChannelFeatures,
-		mainErrChan,
+		cfg, cfgLogger, db, &lndConn.LndServices,
+		enableChannelFeatures, mainErrChan,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to generate server config: %w",
@@ -608,6 +1402,7 @@ func CreateServerFromConfig(cfg *Config, cfgLogger btclog.Logger,
 		MacaroonPath:               cfg.RpcConf.MacaroonPath,
 		AllowPublicUniProofCourier: cfg.RpcConf.AllowPublicUniProofCourier,
 		AllowPublicStats:           cfg.RpcConf.AllowPublicStats,
+		MaxProofFileSize:           cfg.RpcConf.MaxProofFileSize,
 		LetsEncryptDir:             cfg.RpcConf.LetsEncryptDir,
 		LetsEncryptListen:          cfg.RpcConf.LetsEncryptListen,
 		LetsEncryptEmail:           cfg.RpcConf.LetsEncryptEmail,
@@ -622,8 +1417,13 @@ func ConfigureSubServer(srv *tap.Server, cfg *Config, cfgLogger btclog.Logger,
 	lndServices *lndclient.LndServices, litdIntegrated bool,
 	mainErrChan chan<- error) error {
 
+	db, err := openDatabase(cfg, cfgLogger)
+	if err != nil {
+		return err
+	}
+
 	serverCfg, err := genServerConfig(
-		cfg, cfgLogger, lndServices, litdIntegrated, mainErrChan,
+		cfg, cfgLogger, db, lndServices, litdIntegrated, mainErrChan,
 	)
 	if err != nil {
 		return fmt.Errorf("unable to generate server config: %w", err)
@@ -0,0 +1,97 @@
+package tapcfg
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btclog"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReleaseResources makes sure the resources of a server are released in
+// reverse order, even if releasing one of them fails.
+func TestReleaseResources(t *testing.T) {
+	t.Parallel()
+
+	var released []int
+	shutdownFuncs := []func() error{
+		func() error {
+			released = append(released, 0)
+			return nil
+		},
+		func() error {
+			released = append(released, 1)
+			return errors.New("unable to release")
+		},
+		func() error {
+			released = append(released, 2)
+			return nil
+		},
+	}
+
+	releaseResources(shutdownFuncs, btclog.Disabled)
+	require.Equal(t, []int{2, 1, 0}, released)
+}
+
+// TestConnectRemoteSigner makes sure the connection to the remote signer is
+// closed if it doesn't hold the keys of our lnd node, and is otherwise handed
+// to the caller to be closed on shutdown.
+func TestConnectRemoteSigner(t *testing.T) {
+	t.Parallel()
+
+	lndServices := &lndclient.LndServices{
+		NodePubkey: route.Vertex{0x02, 0x01},
+	}
+
+	testCases := []struct {
+		name      string
+		signerKey route.Vertex
+		dialErr   error
+		expectErr string
+	}{{
+		name:      "dial error",
+		dialErr:   errors.New("connection refused"),
+		expectErr: "unable to connect to remote signer",
+	}, {
+		name:      "identity mismatch",
+		signerKey: route.Vertex{0x02, 0x02},
+		expectErr: "doesn't match lnd identity",
+	}, {
+		name:      "matching identity",
+		signerKey: lndServices.NodePubkey,
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var closed bool
+			dial := func() (*lndclient.LndServices, func(), error) {
+				if tc.dialErr != nil {
+					return nil, nil, tc.dialErr
+				}
+
+				return &lndclient.LndServices{
+					NodePubkey: tc.signerKey,
+				}, func() { closed = true }, nil
+			}
+
+			signer, closeSigner, err := connectRemoteSigner(
+				lndServices, dial,
+			)
+			if tc.expectErr != "" {
+				require.ErrorContains(t, err, tc.expectErr)
+				require.Equal(t, tc.dialErr == nil, closed)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.signerKey, signer.NodePubkey)
+			require.False(t, closed)
+
+			require.NoError(t, closeSigner())
+			require.True(t, closed)
+		})
+	}
+}
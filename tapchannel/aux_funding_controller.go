@@ -662,7 +662,10 @@ func (f *FundingController) fundVirtualPacket(ctx context.Context,
 
 	// Fund the packet. This will derive an anchor internal key for us, but
 	// we'll overwrite that later on.
-	return f.cfg.AssetWallet.FundPacket(ctx, fundDesc, pktTemplate)
+	return f.cfg.AssetWallet.FundPacket(
+		ctx, fundDesc, pktTemplate,
+		fn.None[tapfreighter.MultiCommitmentSelectStrategy](),
+	)
 }
 
 // sendInputOwnershipProofs sends the input ownership proofs to the remote
@@ -0,0 +1,261 @@
+package tapchannel
+
+import (
+	"context"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	// DefaultSweepBumpInterval is the default number of blocks we wait
+	// after a time-locked asset output matured (or after the last fee bump)
+	// before we bump the fee of its sweep again.
+	DefaultSweepBumpInterval = 6
+
+	// DefaultSweepConfTarget is the default confirmation target used to
+	// estimate the fee rate for bumping a stuck sweep.
+	DefaultSweepConfTarget = 6
+
+	// sweepFeeRateIncrease is the minimum percentage the fee rate of a
+	// sweep is increased by with each bump, to make sure the replacement
+	// is accepted by the mempool even if the estimate didn't change.
+	sweepFeeRateIncrease = 25
+
+	// sweepConfHeightHintDelta is the number of blocks we look back when
+	// registering for the confirmation of a commitment or sweep
+	// transaction, as it might already be confirmed.
+	sweepConfHeightHintDelta = 1000
+)
+
+// SweepFeeBumper is used to bump the fee of the sweep of a contract output that
+// is currently handled by lnd's sweeper.
+type SweepFeeBumper interface {
+	// BumpFee re-offers the given outpoint to the sweeper with the given
+	// fee rate, which results in a replacement of the current sweep.
+	BumpFee(ctx context.Context, op wire.OutPoint,
+		feeRate chainfee.SatPerKWeight) error
+}
+
+// SweepMonitorCfg houses the configuration of the part of the AuxSweeper that
+// makes sure asset outputs of force closed channels are swept in time.
+type SweepMonitorCfg struct {
+	// FeeBumper is used to bump the fee of stuck sweeps. If this is nil,
+	// stuck sweeps are only reported in the logs.
+	FeeBumper SweepFeeBumper
+
+	// BumpInterval is the number of blocks we wait after an output matured
+	// (or after the last fee bump) before bumping the fee of its sweep.
+	BumpInterval uint32
+
+	// ConfTarget is the confirmation target used to estimate the fee rate
+	// of a bumped sweep.
+	ConfTarget uint32
+
+	// MaxFeeRate is the maximum fee rate we're willing to pay for a sweep.
+	// A value of zero means no limit.
+	MaxFeeRate chainfee.SatPerKWeight
+}
+
+// pendingSweep tracks a time-locked asset output of a force closed channel
+// that belongs to us until it is swept.
+type pendingSweep struct {
+	// contractPoint is the outpoint of the commitment output that carries
+	// our assets.
+	contractPoint wire.OutPoint
+
+	// chanPoint is the channel point of the force closed channel.
+	chanPoint wire.OutPoint
+
+	// commitTxid is the ID of the confirmed commitment transaction.
+	commitTxid chainhash.Hash
+
+	// csvDelay is the relative time lock of the contract output.
+	csvDelay uint32
+
+	// matureHeight is the height at which the output can be swept. This is
+	// only known once the commitment transaction confirmed.
+	matureHeight fn.Option[uint32]
+
+	// sweepTxid is the ID of the last sweep transaction that was broadcast
+	// for this output.
+	sweepTxid fn.Option[chainhash.Hash]
+
+	// lastBumpHeight is the height of the last fee bump.
+	lastBumpHeight uint32
+
+	// feeRate is the fee rate of the last fee bump.
+	feeRate chainfee.SatPerKWeight
+
+	// numBumps is the number of fee bumps we attempted so far.
+	numBumps uint32
+}
+
+// sweepCsvDelay returns the relative time lock of a contract output of the
+// given type.
+func sweepCsvDelay(witnessType input.WitnessType, csvDelay uint32) uint32 {
+	switch witnessType {
+	// Our output on the remote party's commitment is encumbered by a
+	// single block CSV delay.
+	case input.TaprootRemoteCommitSpend:
+		return 1
+
+	// Our output on our own commitment is encumbered by the CSV delay of
+	// the channel.
+	case input.TaprootLocalCommitSpend:
+		return csvDelay
+
+	// A revoked output can be swept immediately.
+	default:
+		return 0
+	}
+}
+
+// sweepMonitor keeps track of all asset outputs of force closed channels that
+// still need to be swept and decides when the fee of their sweep needs to be
+// bumped.
+//
+// NOTE: This is not thread safe and must only be accessed from the main event
+// loop of the AuxSweeper.
+type sweepMonitor struct {
+	cfg *SweepMonitorCfg
+
+	pending map[wire.OutPoint]*pendingSweep
+}
+
+// newSweepMonitor creates a new sweep monitor.
+func newSweepMonitor(cfg *SweepMonitorCfg) *sweepMonitor {
+	return &sweepMonitor{
+		cfg:     cfg,
+		pending: make(map[wire.OutPoint]*pendingSweep),
+	}
+}
+
+// trackContract starts tracking the given contract output. It returns false if
+// the output is already tracked.
+func (m *sweepMonitor) trackContract(p *pendingSweep) bool {
+	if _, ok := m.pending[p.contractPoint]; ok {
+		return false
+	}
+
+	m.pending[p.contractPoint] = p
+
+	return true
+}
+
+// commitConfirmed marks all outputs of the given commitment transaction as
+// confirmed at the given height, which determines their maturity.
+func (m *sweepMonitor) commitConfirmed(txid chainhash.Hash, height uint32) {
+	for _, p := range m.pending {
+		if p.commitTxid != txid {
+			continue
+		}
+
+		matureHeight := height + p.csvDelay
+		p.matureHeight = fn.Some(matureHeight)
+
+		log.Infof("Asset output %v of force closed channel %v "+
+			"confirmed at height %d, mature at height %d",
+			p.contractPoint, p.chanPoint, height, matureHeight)
+	}
+}
+
+// sweepBroadcast records that a sweep transaction with the given ID spending
+// the given outputs was broadcast.
+func (m *sweepMonitor) sweepBroadcast(sweepTxid chainhash.Hash,
+	spent []wire.OutPoint) {
+
+	for _, op := range spent {
+		p, ok := m.pending[op]
+		if !ok {
+			continue
+		}
+
+		p.sweepTxid = fn.Some(sweepTxid)
+	}
+}
+
+// sweepConfirmed stops tracking all outputs spent by the given sweep
+// transaction. It returns the outputs that were resolved.
+func (m *sweepMonitor) sweepConfirmed(
+	sweepTxid chainhash.Hash) []*pendingSweep {
+
+	var resolved []*pendingSweep
+	for op, p := range m.pending {
+		isSweep := fn.MapOptionZ(
+			p.sweepTxid, func(h chainhash.Hash) bool {
+				return h == sweepTxid
+			},
+		)
+		if !isSweep {
+			continue
+		}
+
+		resolved = append(resolved, p)
+		delete(m.pending, op)
+	}
+
+	return resolved
+}
+
+// sweepsToBump returns all outputs that matured at least the bump interval
+// ago, have no confirmed sweep yet and weren't bumped within the last bump
+// interval.
+func (m *sweepMonitor) sweepsToBump(height uint32) []*pendingSweep {
+	var toBump []*pendingSweep
+	for _, p := range m.pending {
+		// We don't know when the output matures before the
+		// commitment transaction confirmed.
+		if p.matureHeight.IsNone() {
+			continue
+		}
+
+		matureHeight := p.matureHeight.UnwrapOr(0)
+
+		if height < matureHeight+m.cfg.BumpInterval {
+			continue
+		}
+
+		if p.numBumps > 0 &&
+			height < p.lastBumpHeight+m.cfg.BumpInterval {
+
+			continue
+		}
+
+		toBump = append(toBump, p)
+	}
+
+	return toBump
+}
+
+// nextFeeRate returns the fee rate to use for the next bump of the given
+// sweep, based on the current fee estimate. The fee rate is always increased
+// compared to the last bump, but never exceeds the configured maximum. The
+// second return value is false if the fee rate can't be increased anymore.
+func (m *sweepMonitor) nextFeeRate(p *pendingSweep,
+	estimate chainfee.SatPerKWeight) (chainfee.SatPerKWeight, bool) {
+
+	feeRate := estimate
+	minIncrease := p.feeRate * (100 + sweepFeeRateIncrease) / 100
+	if feeRate < minIncrease {
+		feeRate = minIncrease
+	}
+
+	if m.cfg.MaxFeeRate > 0 && feeRate > m.cfg.MaxFeeRate {
+		feeRate = m.cfg.MaxFeeRate
+	}
+
+	return feeRate, feeRate > p.feeRate
+}
+
+// bumped records a fee bump of the given sweep at the given height.
+func (m *sweepMonitor) bumped(p *pendingSweep, height uint32,
+	feeRate chainfee.SatPerKWeight) {
+
+	p.lastBumpHeight = height
+	p.feeRate = feeRate
+	p.numBumps++
+}
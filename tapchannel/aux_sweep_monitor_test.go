@@ -0,0 +1,79 @@
+package tapchannel
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSweepMonitor tests that the sweep monitor tracks asset outputs of force
+// closed channels until they're swept and bumps stuck sweeps at the right
+// heights.
+func TestSweepMonitor(t *testing.T) {
+	t.Parallel()
+
+	m := newSweepMonitor(&SweepMonitorCfg{
+		BumpInterval: 6,
+		MaxFeeRate:   2000,
+	})
+
+	commitTxid := chainhash.Hash{1}
+	local := &pendingSweep{
+		contractPoint: wire.OutPoint{Hash: commitTxid, Index: 0},
+		commitTxid:    commitTxid,
+		csvDelay: sweepCsvDelay(
+			input.TaprootLocalCommitSpend, 144,
+		),
+	}
+	remote := &pendingSweep{
+		contractPoint: wire.OutPoint{Hash: commitTxid, Index: 1},
+		commitTxid:    commitTxid,
+		csvDelay: sweepCsvDelay(
+			input.TaprootRemoteCommitSpend, 144,
+		),
+	}
+	require.True(t, m.trackContract(local))
+	require.True(t, m.trackContract(remote))
+	require.False(t, m.trackContract(local))
+
+	// Before the commitment transaction confirmed, we don't know when the
+	// outputs mature, so nothing should be bumped.
+	require.Empty(t, m.sweepsToBump(1000))
+
+	// Once it confirmed, the remote output matures after a single block,
+	// the local one after the CSV delay.
+	m.commitConfirmed(commitTxid, 100)
+	require.Empty(t, m.sweepsToBump(106))
+	require.Equal(t, []*pendingSweep{remote}, m.sweepsToBump(107))
+	require.Len(t, m.sweepsToBump(250), 2)
+
+	// After a bump, we wait another interval before bumping again.
+	feeRate, ok := m.nextFeeRate(remote, 1000)
+	require.True(t, ok)
+	require.Equal(t, chainfee.SatPerKWeight(1000), feeRate)
+	m.bumped(remote, 107, feeRate)
+	require.Empty(t, m.sweepsToBump(112))
+	require.Equal(t, []*pendingSweep{remote}, m.sweepsToBump(113))
+
+	// The fee rate is increased even if the estimate didn't change, but
+	// never above the maximum.
+	feeRate, ok = m.nextFeeRate(remote, 1000)
+	require.True(t, ok)
+	require.Equal(t, chainfee.SatPerKWeight(1250), feeRate)
+	m.bumped(remote, 113, 2000)
+	_, ok = m.nextFeeRate(remote, 1000)
+	require.False(t, ok)
+
+	// A confirmed sweep resolves only the outputs it spends.
+	sweepTxid := chainhash.Hash{2}
+	m.sweepBroadcast(sweepTxid, []wire.OutPoint{remote.contractPoint})
+	require.Empty(t, m.sweepConfirmed(chainhash.Hash{3}))
+	require.Equal(
+		t, []*pendingSweep{remote}, m.sweepConfirmed(sweepTxid),
+	)
+	require.Equal(t, []*pendingSweep{local}, m.sweepsToBump(250))
+}
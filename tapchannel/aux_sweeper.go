@@ -11,6 +11,7 @@ import (
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightninglabs/taproot-assets/address"
@@ -112,6 +113,21 @@ type AuxSweeperCfg struct {
 
 	// ChainBridge is used to fetch blocks from the main chain.
 	ChainBridge tapgarden.ChainBridge
+
+	// SweepMonitor is the configuration of the monitor that makes sure
+	// time-locked asset outputs of force closed channels are swept in time
+	// once they mature.
+	SweepMonitor SweepMonitorCfg
+}
+
+// commitConf is sent once a commitment transaction with asset outputs we need
+// to sweep confirmed.
+type commitConf struct {
+	// txid is the ID of the commitment transaction.
+	txid chainhash.Hash
+
+	// height is the height the commitment transaction confirmed at.
+	height uint32
 }
 
 // AuxSweeper is used to sweep funds from a commitment transaction that has
@@ -125,6 +141,14 @@ type AuxSweeper struct {
 	sweepAddrReqs  chan *sweepAddrReq
 	broadcastReqs  chan *broadcastReq
 
+	// commitConfs and sweepConfs are used to notify the main event loop of
+	// confirmations of commitment and sweep transactions.
+	commitConfs chan commitConf
+	sweepConfs  chan chainhash.Hash
+
+	// monitor tracks the asset outputs that still need to be swept.
+	monitor *sweepMonitor
+
 	cfg *AuxSweeperCfg
 
 	quit chan struct{}
@@ -138,6 +162,9 @@ func NewAuxSweeper(cfg *AuxSweeperCfg) *AuxSweeper {
 		resolutionReqs: make(chan *resolutionReq),
 		sweepAddrReqs:  make(chan *sweepAddrReq),
 		broadcastReqs:  make(chan *broadcastReq),
+		commitConfs:    make(chan commitConf),
+		sweepConfs:     make(chan chainhash.Hash),
+		monitor:        newSweepMonitor(&cfg.SweepMonitor),
 		cfg:            cfg,
 		quit:           make(chan struct{}),
 	}
@@ -159,7 +186,7 @@ func (a *AuxSweeper) Start() error {
 
 // Stop stops the AuxSweeper.
 func (a *AuxSweeper) Stop() error {
-	if !a.stopped.CompareAndSwap(true, false) {
+	if !a.stopped.CompareAndSwap(false, true) {
 		return nil
 	}
 
@@ -1458,22 +1485,255 @@ func (a *AuxSweeper) registerAndBroadcastSweep(req *sweep.BumpRequest,
 func (a *AuxSweeper) contractResolver() {
 	defer a.wg.Done()
 
+	ctx, cancel := a.ctxQuit()
+	defer cancel()
+
+	// We need to know about new blocks to find out when time-locked asset
+	// outputs mature. If we can't get block notifications, we can still
+	// resolve contracts, we just won't be able to bump stuck sweeps.
+	newBlocks, blockErrs, err := a.cfg.ChainBridge.RegisterBlockEpochNtfn(
+		ctx,
+	)
+	if err != nil {
+		log.Errorf("Unable to register for block notifications, not "+
+			"monitoring asset sweeps: %v", err)
+	}
+
 	for {
 		select {
 		case req := <-a.resolutionReqs:
-			req.resp <- a.resolveContract(req.req)
+			resp := a.resolveContract(req.req)
+			if blob, err := resp.Unpack(); err == nil &&
+				len(blob) > 0 {
+
+				a.trackContract(ctx, req.req)
+			}
+
+			req.resp <- resp
 
 		case req := <-a.sweepAddrReqs:
 			req.resp <- a.sweepContracts(req.inputs, req.change)
 
 		case req := <-a.broadcastReqs:
-			req.resp <- a.registerAndBroadcastSweep(
+			err := a.registerAndBroadcastSweep(
 				req.req, req.tx, req.fee,
 			)
+			if err == nil {
+				a.trackSweep(ctx, req.req, req.tx)
+			}
+
+			req.resp <- err
+
+		case conf := <-a.commitConfs:
+			a.monitor.commitConfirmed(conf.txid, conf.height)
+
+		case sweepTxid := <-a.sweepConfs:
+			resolved := a.monitor.sweepConfirmed(sweepTxid)
+			for _, p := range resolved {
+				log.Infof("Asset output %v of force closed "+
+					"channel %v swept in txid=%v",
+					p.contractPoint, p.chanPoint, sweepTxid)
+			}
+
+		case height := <-newBlocks:
+			a.bumpStuckSweeps(ctx, uint32(height))
+
+		case err := <-blockErrs:
+			log.Errorf("Unable to receive block notifications, "+
+				"not monitoring asset sweeps anymore: %v", err)
+
+			newBlocks, blockErrs = nil, nil
+
+		case <-a.quit:
+			return
+		}
+	}
+}
 
+// ctxQuit returns a context that is canceled once the AuxSweeper is stopped.
+func (a *AuxSweeper) ctxQuit() (context.Context, func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		select {
 		case <-a.quit:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return ctx, cancel
+}
+
+// trackContract starts monitoring the asset output of a resolved contract
+// until it is swept. We wait for the commitment transaction to confirm to find
+// out when the output matures.
+func (a *AuxSweeper) trackContract(ctx context.Context,
+	req lnwallet.ResolutionReq) {
+
+	commitTxid := req.CommitTx.TxHash()
+	p := &pendingSweep{
+		contractPoint: req.ContractPoint,
+		chanPoint:     req.ChanPoint,
+		commitTxid:    commitTxid,
+		csvDelay:      sweepCsvDelay(req.Type, req.CsvDelay),
+	}
+	if !a.monitor.trackContract(p) {
+		return
+	}
+
+	log.Infof("Monitoring asset output %v of force closed channel %v "+
+		"(csv_delay=%d) until it is swept", p.contractPoint,
+		p.chanPoint, p.csvDelay)
+
+	if int(req.ContractPoint.Index) >= len(req.CommitTx.TxOut) {
+		return
+	}
+	pkScript := req.CommitTx.TxOut[req.ContractPoint.Index].PkScript
+
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+
+		height, ok := a.waitForConf(ctx, commitTxid, pkScript)
+		if !ok {
+			return
+		}
+
+		conf := commitConf{
+			txid:   commitTxid,
+			height: height,
+		}
+		fn.SendOrQuit(a.commitConfs, conf, a.quit)
+	}()
+}
+
+// trackSweep records the broadcast of a sweep transaction for our asset
+// outputs and waits for it to confirm.
+func (a *AuxSweeper) trackSweep(ctx context.Context, req *sweep.BumpRequest,
+	sweepTx *wire.MsgTx) {
+
+	if len(sweepTx.TxOut) == 0 {
+		return
+	}
+
+	spent := fn.Map(req.Inputs, func(i input.Input) wire.OutPoint {
+		return i.OutPoint()
+	})
+
+	sweepTxid := sweepTx.TxHash()
+	a.monitor.sweepBroadcast(sweepTxid, spent)
+
+	pkScript := sweepTx.TxOut[0].PkScript
+
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+
+		// If this sweep is replaced by a fee bump, it will never
+		// confirm, and we'll only exit on shutdown.
+		_, ok := a.waitForConf(ctx, sweepTxid, pkScript)
+		if !ok {
 			return
 		}
+
+		fn.SendOrQuit(a.sweepConfs, sweepTxid, a.quit)
+	}()
+}
+
+// waitForConf waits for the given transaction to confirm and returns the
+// confirmation height. False is returned if the wait was aborted.
+func (a *AuxSweeper) waitForConf(ctx context.Context, txid chainhash.Hash,
+	pkScript []byte) (uint32, bool) {
+
+	heightHint, err := a.cfg.ChainBridge.CurrentHeight(ctx)
+	if err != nil {
+		log.Errorf("Unable to fetch current height: %v", err)
+		return 0, false
+	}
+
+	// The transaction might already be confirmed, so we start looking a
+	// bit further back.
+	if heightHint > sweepConfHeightHintDelta {
+		heightHint -= sweepConfHeightHintDelta
+	}
+
+	confEvent, errChan, err := a.cfg.ChainBridge.RegisterConfirmationsNtfn(
+		ctx, &txid, pkScript, 1, heightHint, false, nil,
+	)
+	if err != nil {
+		log.Errorf("Unable to register for confirmation of txid=%v: "+
+			"%v", txid, err)
+		return 0, false
+	}
+
+	select {
+	case conf := <-confEvent.Confirmed:
+		return conf.BlockHeight, true
+
+	case err := <-errChan:
+		log.Errorf("Error waiting for confirmation of txid=%v: %v",
+			txid, err)
+		return 0, false
+
+	case <-a.quit:
+		return 0, false
+	}
+}
+
+// bumpStuckSweeps bumps the fee of all sweeps of matured asset outputs that
+// didn't confirm within the configured bump interval.
+func (a *AuxSweeper) bumpStuckSweeps(ctx context.Context, height uint32) {
+	toBump := a.monitor.sweepsToBump(height)
+	if len(toBump) == 0 {
+		return
+	}
+
+	feeBumper := a.cfg.SweepMonitor.FeeBumper
+	if feeBumper == nil {
+		for _, p := range toBump {
+			log.Warnf("Asset output %v of force closed channel %v "+
+				"matured at height %d but isn't swept yet",
+				p.contractPoint, p.chanPoint,
+				p.matureHeight.UnwrapOr(0))
+
+			a.monitor.bumped(p, height, p.feeRate)
+		}
+
+		return
+	}
+
+	estimate, err := a.cfg.ChainBridge.EstimateFee(
+		ctx, a.cfg.SweepMonitor.ConfTarget,
+	)
+	if err != nil {
+		log.Errorf("Unable to estimate fee for sweep bump: %v", err)
+		return
+	}
+
+	for _, p := range toBump {
+		feeRate, ok := a.monitor.nextFeeRate(p, estimate)
+		if !ok {
+			log.Warnf("Sweep of asset output %v already at max "+
+				"fee rate %v, not bumping", p.contractPoint,
+				p.feeRate)
+
+			a.monitor.bumped(p, height, p.feeRate)
+			continue
+		}
+
+		log.Infof("Bumping fee of sweep of asset output %v of force "+
+			"closed channel %v to %v (attempt %d)",
+			p.contractPoint, p.chanPoint, feeRate, p.numBumps+1)
+
+		err := feeBumper.BumpFee(ctx, p.contractPoint, feeRate)
+		if err != nil {
+			log.Errorf("Unable to bump fee of sweep of asset "+
+				"output %v: %v", p.contractPoint, err)
+		}
+
+		// We record the attempt even if it failed, so we don't retry
+		// on every block.
+		a.monitor.bumped(p, height, feeRate)
 	}
 }
 
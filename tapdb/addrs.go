@@ -45,6 +45,18 @@ type (
 	// AddrManaged is a type alias for setting an address as managed.
 	AddrManaged = sqlc.SetAddrManagedParams
 
+	// AddrExpiry is a type alias for setting the expiry time of an
+	// address.
+	AddrExpiry = sqlc.SetAddrExpiryParams
+
+	// AddrArchived is a type alias for archiving or unarchiving an
+	// address.
+	AddrArchived = sqlc.SetAddrArchivedParams
+
+	// NewAddrNote is a type alias for the params to set the note of an
+	// address.
+	NewAddrNote = sqlc.UpsertAddrNoteParams
+
 	// UpsertAddrEvent is a type alias for creating a new address event or
 	// updating an existing one.
 	UpsertAddrEvent = sqlc.UpsertAddrEventParams
@@ -77,6 +89,34 @@ type (
 	// KeyLocator is a type alias for fetching the key locator information
 	// for an internal key.
 	KeyLocator = sqlc.FetchInternalKeyLocatorRow
+
+	// NewStaticAddr is a type alias for the params to create a new static
+	// address.
+	NewStaticAddr = sqlc.InsertStaticAddrParams
+
+	// StaticAddrRow is a type alias for the full static address row with
+	// key locator information.
+	StaticAddrRow = sqlc.QueryStaticAddrsRow
+
+	// StaticAddrScanBlock is a type alias for the hash of a block that was
+	// scanned for static address payments.
+	StaticAddrScanBlock = sqlc.StaticAddrScanBlock
+
+	// StaticAddrScanBlockParams is a type alias for the params to store
+	// the hash of a scanned block.
+	StaticAddrScanBlockParams = sqlc.UpsertStaticAddrScanBlockParams
+
+	// PruneStaticAddrScanBlocksParams is a type alias for the params to
+	// prune the hashes of scanned blocks.
+	PruneStaticAddrScanBlocksParams = sqlc.PruneStaticAddrScanBlocksParams
+
+	// StaticPaymentEventsQuery is a type alias for the params to query the
+	// events of static address payments.
+	StaticPaymentEventsQuery = sqlc.QueryStaticPaymentEventsParams
+
+	// StaticPaymentEvent is a type alias for the event of a static address
+	// payment.
+	StaticPaymentEvent = sqlc.QueryStaticPaymentEventsRow
 )
 
 // AddrBook is an interface that represents the storage backed needed to create
@@ -116,6 +156,20 @@ type AddrBook interface {
 	// wallet.
 	SetAddrManaged(ctx context.Context, arg AddrManaged) error
 
+	// SetAddrExpiry sets the expiry time of an address.
+	SetAddrExpiry(ctx context.Context, arg AddrExpiry) error
+
+	// SetAddrArchived sets the time an address was archived at.
+	SetAddrArchived(ctx context.Context, arg AddrArchived) error
+
+	// UpsertAddrNote inserts a new or updates the existing note of an
+	// address.
+	UpsertAddrNote(ctx context.Context, arg NewAddrNote) error
+
+	// DeleteAddrNote removes the note of the address with the given
+	// Taproot output key.
+	DeleteAddrNote(ctx context.Context, taprootOutputKey []byte) error
+
 	// UpsertManagedUTXO inserts a new or updates an existing managed UTXO
 	// to disk and returns the primary key.
 	UpsertManagedUTXO(ctx context.Context, arg RawManagedUTXO) (int64,
@@ -161,6 +215,45 @@ type AddrBook interface {
 	// FetchInternalKeyLocator fetches the key locator for an internal key.
 	FetchInternalKeyLocator(ctx context.Context, rawKey []byte) (KeyLocator,
 		error)
+
+	// InsertStaticAddr inserts a new static address into the database.
+	InsertStaticAddr(ctx context.Context, arg NewStaticAddr) (int64, error)
+
+	// QueryStaticAddrs returns all static addresses.
+	QueryStaticAddrs(ctx context.Context) ([]StaticAddrRow, error)
+
+	// SetStaticAddrsScanHeight sets the scan height of all static
+	// addresses that were scanned up to a lower height.
+	SetStaticAddrsScanHeight(ctx context.Context, scanHeight int32) error
+
+	// RollbackStaticAddrsScanHeight sets the scan height of all static
+	// addresses that were scanned up to a higher height.
+	RollbackStaticAddrsScanHeight(ctx context.Context,
+		scanHeight int32) error
+
+	// UpsertStaticAddrScanBlock stores the hash of a block that was scanned
+	// for static address payments.
+	UpsertStaticAddrScanBlock(ctx context.Context,
+		arg StaticAddrScanBlockParams) error
+
+	// QueryStaticAddrScanBlocks returns the stored hashes of the scanned
+	// blocks, sorted by descending height.
+	QueryStaticAddrScanBlocks(ctx context.Context) ([]StaticAddrScanBlock,
+		error)
+
+	// PruneStaticAddrScanBlocks removes the hashes of all scanned blocks
+	// outside the given height range.
+	PruneStaticAddrScanBlocks(ctx context.Context,
+		arg PruneStaticAddrScanBlocksParams) error
+
+	// QueryStaticPaymentEvents returns the events of payments to static
+	// addresses that confirmed above the given height and are below the
+	// given status.
+	QueryStaticPaymentEvents(ctx context.Context,
+		arg StaticPaymentEventsQuery) ([]StaticPaymentEvent, error)
+
+	// DeleteAddrEvent deletes the address event with the given ID.
+	DeleteAddrEvent(ctx context.Context, id int64) error
 }
 
 // AddrBookTxOptions defines the set of db txn options the AddrBook
@@ -304,6 +397,7 @@ func (t *TapAddressBook) InsertAddrs(ctx context.Context,
 				AssetType:        assetGen.AssetType,
 				CreationTime:     addr.CreationTime.UTC(),
 				ProofCourierAddr: proofCourierAddrBytes,
+				NumConfs:         int32(addr.NumConfs),
 			})
 			if err != nil {
 				return fmt.Errorf("unable to insert addr: %w",
@@ -348,6 +442,8 @@ func (t *TapAddressBook) QueryAddrs(ctx context.Context,
 			NumOffset:     params.Offset,
 			NumLimit:      limit,
 			UnmanagedOnly: params.UnmanagedOnly,
+			AddrState:     int16(params.State),
+			Now:           sqlTime(t.clock.Now()),
 		})
 		if err != nil {
 			return err
@@ -463,6 +559,7 @@ func (t *TapAddressBook) QueryAddrs(ctx context.Context,
 				address.WithAssetVersion(
 					asset.Version(addr.AssetVersion),
 				),
+				address.WithNumConfs(uint32(addr.NumConfs)),
 			)
 			if err != nil {
 				return fmt.Errorf("unable to make addr: %w", err)
@@ -480,6 +577,13 @@ func (t *TapAddressBook) QueryAddrs(ctx context.Context,
 				TaprootOutputKey: *taprootOutputKey,
 				CreationTime:     addr.CreationTime.UTC(),
 				ManagedAfter:     addr.ManagedFrom.Time.UTC(),
+				Note:             addr.Note.String,
+				ExpiryTime: extractSqlTime(
+					addr.ExpiryTime,
+				),
+				ArchivedAt: extractSqlTime(
+					addr.ArchivedAt,
+				),
 			})
 		}
 
@@ -613,6 +717,7 @@ func fetchAddr(ctx context.Context, db AddrBook, params *address.ChainParams,
 		groupWitness, *scriptKey, *internalKey, uint64(dbAddr.Amount),
 		tapscriptSibling, params, *proofCourierAddr,
 		address.WithAssetVersion(asset.Version(dbAddr.AssetVersion)),
+		address.WithNumConfs(uint32(dbAddr.NumConfs)),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to make addr: %w", err)
@@ -628,6 +733,9 @@ func fetchAddr(ctx context.Context, db AddrBook, params *address.ChainParams,
 		InternalKeyDesc:  internalKeyDesc,
 		TaprootOutputKey: *taprootOutputKey,
 		CreationTime:     dbAddr.CreationTime.UTC(),
+		Note:             dbAddr.Note.String,
+		ExpiryTime:       extractSqlTime(dbAddr.ExpiryTime),
+		ArchivedAt:       extractSqlTime(dbAddr.ArchivedAt),
 	}, nil
 }
 
@@ -650,6 +758,90 @@ func (t *TapAddressBook) SetAddrManaged(ctx context.Context,
 	})
 }
 
+// SetAddrNote sets the user-defined note of the address with the given Taproot
+// output key. An empty note removes an existing note.
+func (t *TapAddressBook) SetAddrNote(ctx context.Context,
+	taprootOutputKey *btcec.PublicKey, note string) error {
+
+	if err := validateNote(note); err != nil {
+		return err
+	}
+
+	outputKey := schnorr.SerializePubKey(taprootOutputKey)
+
+	var writeTxOpts AddrBookTxOptions
+	return t.db.ExecTx(ctx, &writeTxOpts, func(db AddrBook) error {
+		_, err := db.FetchAddrByTaprootOutputKey(ctx, outputKey)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return address.ErrNoAddr
+
+		case err != nil:
+			return err
+		}
+
+		if note == "" {
+			return db.DeleteAddrNote(ctx, outputKey)
+		}
+
+		return db.UpsertAddrNote(ctx, NewAddrNote{
+			TaprootOutputKey: outputKey,
+			Note:             note,
+			UpdatedAt:        t.clock.Now().UTC(),
+		})
+	})
+}
+
+// SetAddrExpiry sets the expiry time of the address with the given Taproot
+// output key. A zero time removes an existing expiry.
+func (t *TapAddressBook) SetAddrExpiry(ctx context.Context,
+	taprootOutputKey *btcec.PublicKey, expiry time.Time) error {
+
+	outputKey := schnorr.SerializePubKey(taprootOutputKey)
+
+	var writeTxOpts AddrBookTxOptions
+	return t.db.ExecTx(ctx, &writeTxOpts, func(db AddrBook) error {
+		_, err := db.FetchAddrByTaprootOutputKey(ctx, outputKey)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return address.ErrNoAddr
+
+		case err != nil:
+			return err
+		}
+
+		return db.SetAddrExpiry(ctx, AddrExpiry{
+			TaprootOutputKey: outputKey,
+			ExpiryTime:       sqlTime(expiry),
+		})
+	})
+}
+
+// SetAddrArchived archives the address with the given Taproot output key at
+// the given time. A zero time unarchives the address.
+func (t *TapAddressBook) SetAddrArchived(ctx context.Context,
+	taprootOutputKey *btcec.PublicKey, archivedAt time.Time) error {
+
+	outputKey := schnorr.SerializePubKey(taprootOutputKey)
+
+	var writeTxOpts AddrBookTxOptions
+	return t.db.ExecTx(ctx, &writeTxOpts, func(db AddrBook) error {
+		_, err := db.FetchAddrByTaprootOutputKey(ctx, outputKey)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return address.ErrNoAddr
+
+		case err != nil:
+			return err
+		}
+
+		return db.SetAddrArchived(ctx, AddrArchived{
+			TaprootOutputKey: outputKey,
+			ArchivedAt:       sqlTime(archivedAt),
+		})
+	})
+}
+
 // InsertInternalKey inserts an internal key into the database to make sure it
 // is identified as a local key later on when importing proofs. The key can be
 // an internal key for an asset script key or the internal key of an anchor
@@ -644,3 +644,114 @@ func TestAddressEventQuery(t *testing.T) {
 		})
 	}
 }
+
+// TestAddrLifecycle tests that addresses can be expired, archived and
+// unarchived, and that they can be queried by their lifecycle state.
+func TestAddrLifecycle(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	testClock := clock.NewTestClock(now)
+	addrBook, _ := newAddrBook(t, testClock)
+	ctx := context.Background()
+
+	var writeTxOpts AddrBookTxOptions
+
+	const numAddrs = 3
+	proofCourierAddr := address.RandProofCourierAddr(t)
+	addrs := make([]address.AddrWithKeyInfo, numAddrs)
+	for i := 0; i < numAddrs; i++ {
+		addr, assetGen, assetGroup := address.RandAddr(
+			t, chainParams, proofCourierAddr,
+		)
+		addrs[i] = *addr
+
+		err := addrBook.db.ExecTx(
+			ctx, &writeTxOpts,
+			insertFullAssetGen(ctx, assetGen, assetGroup),
+		)
+		require.NoError(t, err)
+	}
+	require.NoError(t, addrBook.InsertAddrs(ctx, addrs...))
+
+	queryState := func(state address.AddrState) []address.AddrWithKeyInfo {
+		dbAddrs, err := addrBook.QueryAddrs(ctx, address.QueryParams{
+			State: state,
+		})
+		require.NoError(t, err)
+
+		return dbAddrs
+	}
+
+	// All addresses start out active.
+	require.Len(t, queryState(address.AddrStateAll), numAddrs)
+	require.Len(t, queryState(address.AddrStateActive), numAddrs)
+	require.Empty(t, queryState(address.AddrStateExpired))
+	require.Empty(t, queryState(address.AddrStateArchived))
+
+	// The first address expires in an hour, the second one is archived.
+	expiry := now.Add(time.Hour)
+	err := addrBook.SetAddrExpiry(ctx, &addrs[0].TaprootOutputKey, expiry)
+	require.NoError(t, err)
+
+	err = addrBook.SetAddrArchived(ctx, &addrs[1].TaprootOutputKey, now)
+	require.NoError(t, err)
+
+	dbAddr, err := addrBook.AddrByTaprootOutput(
+		ctx, &addrs[0].TaprootOutputKey,
+	)
+	require.NoError(t, err)
+	require.Equal(t, expiry, dbAddr.ExpiryTime)
+	require.True(t, dbAddr.ArchivedAt.IsZero())
+	require.Equal(t, address.AddrStateActive, dbAddr.State(now))
+
+	dbAddr, err = addrBook.AddrByTaprootOutput(
+		ctx, &addrs[1].TaprootOutputKey,
+	)
+	require.NoError(t, err)
+	require.Equal(t, now, dbAddr.ArchivedAt)
+	require.Equal(t, address.AddrStateArchived, dbAddr.State(now))
+
+	require.Len(t, queryState(address.AddrStateActive), 2)
+	require.Empty(t, queryState(address.AddrStateExpired))
+	archived := queryState(address.AddrStateArchived)
+	require.Len(t, archived, 1)
+	require.Equal(
+		t, addrs[1].TaprootOutputKey, archived[0].TaprootOutputKey,
+	)
+
+	// Once the expiry time has passed, the first address is expired.
+	testClock.SetTime(expiry.Add(time.Second))
+
+	active := queryState(address.AddrStateActive)
+	require.Len(t, active, 1)
+	require.Equal(t, addrs[2].TaprootOutputKey, active[0].TaprootOutputKey)
+
+	expired := queryState(address.AddrStateExpired)
+	require.Len(t, expired, 1)
+	require.Equal(
+		t, addrs[0].TaprootOutputKey, expired[0].TaprootOutputKey,
+	)
+	require.Equal(
+		t, address.AddrStateExpired,
+		expired[0].State(testClock.Now()),
+	)
+
+	// Unarchiving and removing the expiry makes the addresses active
+	// again.
+	err = addrBook.SetAddrArchived(
+		ctx, &addrs[1].TaprootOutputKey, time.Time{},
+	)
+	require.NoError(t, err)
+
+	err = addrBook.SetAddrExpiry(
+		ctx, &addrs[0].TaprootOutputKey, time.Time{},
+	)
+	require.NoError(t, err)
+
+	require.Len(t, queryState(address.AddrStateActive), numAddrs)
+
+	// Unknown addresses can't be archived.
+	err = addrBook.SetAddrArchived(ctx, test.RandPubKey(t), now)
+	require.ErrorIs(t, err, address.ErrNoAddr)
+}
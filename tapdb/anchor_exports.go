@@ -0,0 +1,385 @@
+package tapdb
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/tapsend"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+type (
+	// NewAnchorExport is used to insert a new anchor export.
+	NewAnchorExport = sqlc.InsertAnchorExportParams
+
+	// NewAnchorExportPacket is used to insert a virtual packet of an
+	// anchor export.
+	NewAnchorExportPacket = sqlc.InsertAnchorExportPacketParams
+
+	// NewAnchorExportLockedUTXO is used to insert a BTC output that was
+	// locked to fund an anchor export.
+	NewAnchorExportLockedUTXO = sqlc.InsertAnchorExportLockedUTXOParams
+
+	// AnchorExportRow is an anchor export as stored in the database.
+	AnchorExportRow = sqlc.AnchorExport
+
+	// AnchorExportPacketRow is a virtual packet of an anchor export as
+	// stored in the database.
+	AnchorExportPacketRow = sqlc.AnchorExportPacket
+)
+
+// AnchorExportStore is the set of queries needed to persist anchor
+// transactions that are exported for external signing.
+type AnchorExportStore interface {
+	// InsertAnchorExport inserts a new anchor export.
+	InsertAnchorExport(ctx context.Context, arg NewAnchorExport) error
+
+	// InsertAnchorExportPacket inserts a virtual packet of an anchor
+	// export.
+	InsertAnchorExportPacket(ctx context.Context,
+		arg NewAnchorExportPacket) error
+
+	// InsertAnchorExportLockedUTXO inserts a BTC output that was locked to
+	// fund an anchor export.
+	InsertAnchorExportLockedUTXO(ctx context.Context,
+		arg NewAnchorExportLockedUTXO) error
+
+	// FetchAnchorExport fetches the anchor export with the given anchor
+	// transaction hash.
+	FetchAnchorExport(ctx context.Context,
+		anchorTxid []byte) (AnchorExportRow, error)
+
+	// QueryAnchorExports returns all anchor exports.
+	QueryAnchorExports(ctx context.Context) ([]AnchorExportRow, error)
+
+	// FetchAnchorExportPackets fetches all virtual packets of the anchor
+	// export with the given anchor transaction hash.
+	FetchAnchorExportPackets(ctx context.Context,
+		anchorTxid []byte) ([]AnchorExportPacketRow, error)
+
+	// FetchAnchorExportLockedUTXOs fetches the serialized outpoints of all
+	// BTC outputs that were locked to fund the anchor export with the
+	// given anchor transaction hash.
+	FetchAnchorExportLockedUTXOs(ctx context.Context,
+		anchorTxid []byte) ([][]byte, error)
+
+	// DeleteAnchorExport deletes the anchor export with the given anchor
+	// transaction hash and returns the number of deleted rows.
+	DeleteAnchorExport(ctx context.Context, anchorTxid []byte) (int64,
+		error)
+}
+
+// AnchorExportStoreTxOptions defines the set of db txn options the
+// AnchorExportStore understands.
+type AnchorExportStoreTxOptions struct {
+	// readOnly governs if a read only transaction is needed or not.
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (r *AnchorExportStoreTxOptions) ReadOnly() bool {
+	return r.readOnly
+}
+
+// NewAnchorExportStoreReadTx creates a new read transaction option set.
+func NewAnchorExportStoreReadTx() AnchorExportStoreTxOptions {
+	return AnchorExportStoreTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedAnchorExportStore is the main storage interface for anchor exports.
+// It supports all the basic queries as well as running the set of queries in
+// a single database transaction.
+type BatchedAnchorExportStore interface {
+	AnchorExportStore
+
+	BatchedTx[AnchorExportStore]
+}
+
+// AnchorExports is a database backed implementation of the
+// tapfreighter.AnchorExportLog interface.
+type AnchorExports struct {
+	db BatchedAnchorExportStore
+}
+
+// NewAnchorExports creates a new database backed anchor export log.
+func NewAnchorExports(db BatchedAnchorExportStore) *AnchorExports {
+	return &AnchorExports{
+		db: db,
+	}
+}
+
+// StoreAnchorExport stores the given anchor export.
+//
+// NOTE: This is part of the tapfreighter.AnchorExportLog interface.
+func (a *AnchorExports) StoreAnchorExport(ctx context.Context,
+	export *tapfreighter.AnchorExport) error {
+
+	var psbtBuf bytes.Buffer
+	if err := export.FundedPsbt.Pkt.Serialize(&psbtBuf); err != nil {
+		return fmt.Errorf("%w: %w", ErrEncodePsbt, err)
+	}
+
+	anchorTxHash := export.AnchorTxHash()
+	anchorTxid := anchorTxHash[:]
+
+	var writeTx AnchorExportStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTx, func(q AnchorExportStore) error {
+		err := q.InsertAnchorExport(ctx, NewAnchorExport{
+			AnchorTxid:        anchorTxid,
+			FundedPsbt:        psbtBuf.Bytes(),
+			ChangeOutputIndex: export.FundedPsbt.ChangeOutputIndex,
+			ChainFees:         export.FundedPsbt.ChainFees,
+			TargetFeeRate:     int64(export.TargetFeeRate),
+			CreationTime:      export.CreationTime.UTC(),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert anchor export: %w",
+				err)
+		}
+
+		err = insertExportPackets(
+			ctx, q, anchorTxid, false, export.VirtualPackets,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = insertExportPackets(
+			ctx, q, anchorTxid, true, export.PassiveAssets,
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, op := range export.FundedPsbt.LockedUTXOs {
+			opBytes, err := encodeOutpoint(op)
+			if err != nil {
+				return fmt.Errorf("unable to encode outpoint: "+
+					"%w", err)
+			}
+
+			err = q.InsertAnchorExportLockedUTXO(
+				ctx, NewAnchorExportLockedUTXO{
+					AnchorTxid: anchorTxid,
+					Outpoint:   opBytes,
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("unable to insert locked "+
+					"UTXO: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// insertExportPackets stores the given active or passive virtual packets of an
+// anchor export.
+func insertExportPackets(ctx context.Context, q AnchorExportStore,
+	anchorTxid []byte, passive bool, vPackets []*tappsbt.VPacket) error {
+
+	for idx, vPkt := range vPackets {
+		vPktBytes, err := encodeVPacket(vPkt)
+		if err != nil {
+			return err
+		}
+
+		err = q.InsertAnchorExportPacket(ctx, NewAnchorExportPacket{
+			AnchorTxid:    anchorTxid,
+			Passive:       passive,
+			PacketIndex:   int32(idx),
+			VirtualPacket: vPktBytes,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert anchor export "+
+				"packet: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// FetchAnchorExport returns the anchor export with the given anchor
+// transaction hash.
+//
+// NOTE: This is part of the tapfreighter.AnchorExportLog interface.
+func (a *AnchorExports) FetchAnchorExport(ctx context.Context,
+	anchorTxHash chainhash.Hash) (*tapfreighter.AnchorExport, error) {
+
+	var (
+		export *tapfreighter.AnchorExport
+		readTx = NewAnchorExportStoreReadTx()
+	)
+	err := a.db.ExecTx(ctx, &readTx, func(q AnchorExportStore) error {
+		dbExport, err := q.FetchAnchorExport(ctx, anchorTxHash[:])
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return fmt.Errorf("%w: %v",
+				tapfreighter.ErrAnchorExportNotFound,
+				anchorTxHash)
+
+		case err != nil:
+			return fmt.Errorf("unable to fetch anchor export: %w",
+				err)
+		}
+
+		export, err = fetchAnchorExport(ctx, q, dbExport)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return export, nil
+}
+
+// PendingAnchorExports returns all anchor exports whose signed anchor
+// transaction wasn't imported yet.
+//
+// NOTE: This is part of the tapfreighter.AnchorExportLog interface.
+func (a *AnchorExports) PendingAnchorExports(
+	ctx context.Context) ([]*tapfreighter.AnchorExport, error) {
+
+	var (
+		exports []*tapfreighter.AnchorExport
+		readTx  = NewAnchorExportStoreReadTx()
+	)
+	err := a.db.ExecTx(ctx, &readTx, func(q AnchorExportStore) error {
+		dbExports, err := q.QueryAnchorExports(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to query anchor exports: %w",
+				err)
+		}
+
+		exports = make([]*tapfreighter.AnchorExport, 0, len(dbExports))
+		for _, dbExport := range dbExports {
+			export, err := fetchAnchorExport(ctx, q, dbExport)
+			if err != nil {
+				return err
+			}
+
+			exports = append(exports, export)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return exports, nil
+}
+
+// fetchAnchorExport decodes the given anchor export and fetches its virtual
+// packets and locked UTXOs.
+func fetchAnchorExport(ctx context.Context, q AnchorExportStore,
+	dbExport AnchorExportRow) (*tapfreighter.AnchorExport, error) {
+
+	pkt, err := psbt.NewFromRawBytes(
+		bytes.NewReader(dbExport.FundedPsbt), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode funded PSBT: %w", err)
+	}
+
+	export := &tapfreighter.AnchorExport{
+		FundedPsbt: &tapsend.FundedPsbt{
+			Pkt:               pkt,
+			ChangeOutputIndex: dbExport.ChangeOutputIndex,
+			ChainFees:         dbExport.ChainFees,
+		},
+		TargetFeeRate: chainfee.SatPerKWeight(dbExport.TargetFeeRate),
+		CreationTime:  dbExport.CreationTime.UTC(),
+	}
+
+	dbPackets, err := q.FetchAnchorExportPackets(ctx, dbExport.AnchorTxid)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch anchor export "+
+			"packets: %w", err)
+	}
+
+	for _, dbPacket := range dbPackets {
+		vPkt, err := tappsbt.NewFromRawBytes(
+			bytes.NewReader(dbPacket.VirtualPacket), false,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode virtual "+
+				"packet: %w", err)
+		}
+
+		if dbPacket.Passive {
+			export.PassiveAssets = append(
+				export.PassiveAssets, vPkt,
+			)
+		} else {
+			export.VirtualPackets = append(
+				export.VirtualPackets, vPkt,
+			)
+		}
+	}
+
+	dbOutpoints, err := q.FetchAnchorExportLockedUTXOs(
+		ctx, dbExport.AnchorTxid,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch locked UTXOs: %w", err)
+	}
+
+	for _, dbOutpoint := range dbOutpoints {
+		var op wire.OutPoint
+		err := readOutPoint(bytes.NewReader(dbOutpoint), 0, 0, &op)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode outpoint: %w",
+				err)
+		}
+
+		export.FundedPsbt.LockedUTXOs = append(
+			export.FundedPsbt.LockedUTXOs, op,
+		)
+	}
+
+	return export, nil
+}
+
+// DeleteAnchorExport removes the anchor export with the given anchor
+// transaction hash.
+//
+// NOTE: This is part of the tapfreighter.AnchorExportLog interface.
+func (a *AnchorExports) DeleteAnchorExport(ctx context.Context,
+	anchorTxHash chainhash.Hash) error {
+
+	var writeTx AnchorExportStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTx, func(q AnchorExportStore) error {
+		numDeleted, err := q.DeleteAnchorExport(ctx, anchorTxHash[:])
+		if err != nil {
+			return fmt.Errorf("unable to delete anchor export: %w",
+				err)
+		}
+
+		if numDeleted == 0 {
+			return fmt.Errorf("%w: %v",
+				tapfreighter.ErrAnchorExportNotFound,
+				anchorTxHash)
+		}
+
+		return nil
+	})
+}
+
+// A compile-time assertion to make sure AnchorExports satisfies the
+// tapfreighter.AnchorExportLog interface.
+var _ tapfreighter.AnchorExportLog = (*AnchorExports)(nil)
@@ -0,0 +1,145 @@
+package tapdb
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/tapsend"
+	"github.com/stretchr/testify/require"
+)
+
+// randAnchorExport creates a random anchor export with the given number of
+// active and passive packets.
+func randAnchorExport(t *testing.T, numActive,
+	numPassive int) *tapfreighter.AnchorExport {
+
+	fundedTx := wire.NewMsgTx(2)
+	fundedTx.AddTxIn(&wire.TxIn{PreviousOutPoint: test.RandOp(t)})
+	fundedTx.AddTxIn(&wire.TxIn{PreviousOutPoint: test.RandOp(t)})
+	fundedTx.AddTxOut(&wire.TxOut{
+		Value:    1000,
+		PkScript: bytes.Repeat([]byte{1}, 34),
+	})
+	pkt, err := psbt.NewFromUnsignedTx(fundedTx)
+	require.NoError(t, err)
+
+	export := &tapfreighter.AnchorExport{
+		FundedPsbt: &tapsend.FundedPsbt{
+			Pkt:               pkt,
+			ChangeOutputIndex: 0,
+			ChainFees:         345,
+			LockedUTXOs: []wire.OutPoint{
+				fundedTx.TxIn[1].PreviousOutPoint,
+			},
+		},
+		TargetFeeRate: 2500,
+		CreationTime:  time.Now().UTC().Truncate(time.Second),
+	}
+	for i := 0; i < numActive; i++ {
+		export.VirtualPackets = append(
+			export.VirtualPackets, randVPacket(t),
+		)
+	}
+	for i := 0; i < numPassive; i++ {
+		export.PassiveAssets = append(
+			export.PassiveAssets, randVPacket(t),
+		)
+	}
+
+	return export
+}
+
+// assertAnchorExportEqual asserts that the two anchor exports are equal.
+func assertAnchorExportEqual(t *testing.T, expected,
+	actual *tapfreighter.AnchorExport) {
+
+	t.Helper()
+
+	var expectedBuf, actualBuf bytes.Buffer
+	require.NoError(t, expected.FundedPsbt.Pkt.Serialize(&expectedBuf))
+	require.NoError(t, actual.FundedPsbt.Pkt.Serialize(&actualBuf))
+	require.Equal(t, expectedBuf.Bytes(), actualBuf.Bytes())
+
+	require.Equal(
+		t, expected.FundedPsbt.ChangeOutputIndex,
+		actual.FundedPsbt.ChangeOutputIndex,
+	)
+	require.Equal(
+		t, expected.FundedPsbt.ChainFees, actual.FundedPsbt.ChainFees,
+	)
+	require.Equal(
+		t, expected.FundedPsbt.LockedUTXOs,
+		actual.FundedPsbt.LockedUTXOs,
+	)
+	require.Equal(t, expected.TargetFeeRate, actual.TargetFeeRate)
+	require.Equal(
+		t, expected.CreationTime.Unix(), actual.CreationTime.Unix(),
+	)
+
+	assertPackets := func(expected, actual []*tappsbt.VPacket) {
+		require.Len(t, actual, len(expected))
+		for idx := range expected {
+			assertVPacketEqual(t, expected[idx], actual[idx])
+		}
+	}
+	assertPackets(expected.VirtualPackets, actual.VirtualPackets)
+	assertPackets(expected.PassiveAssets, actual.PassiveAssets)
+}
+
+// TestAnchorExportStore tests that anchor exports can be stored, fetched,
+// listed and deleted.
+func TestAnchorExportStore(t *testing.T) {
+	t.Parallel()
+
+	db := NewTestDB(t)
+	store := NewAnchorExports(
+		NewTransactionExecutor(db, func(tx *sql.Tx) AnchorExportStore {
+			return db.WithTx(tx)
+		}),
+	)
+	ctx := context.Background()
+
+	export := randAnchorExport(t, 2, 1)
+	require.NoError(t, store.StoreAnchorExport(ctx, export))
+
+	// Unknown exports can neither be fetched nor deleted.
+	unknownHash := test.RandHash()
+	_, err := store.FetchAnchorExport(ctx, unknownHash)
+	require.ErrorIs(t, err, tapfreighter.ErrAnchorExportNotFound)
+	err = store.DeleteAnchorExport(ctx, unknownHash)
+	require.ErrorIs(t, err, tapfreighter.ErrAnchorExportNotFound)
+
+	dbExport, err := store.FetchAnchorExport(ctx, export.AnchorTxHash())
+	require.NoError(t, err)
+	assertAnchorExportEqual(t, export, dbExport)
+
+	// A second export without passive assets is listed after the first
+	// one.
+	second := randAnchorExport(t, 1, 0)
+	second.CreationTime = export.CreationTime.Add(time.Second)
+	require.NoError(t, store.StoreAnchorExport(ctx, second))
+
+	pending, err := store.PendingAnchorExports(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	assertAnchorExportEqual(t, export, pending[0])
+	assertAnchorExportEqual(t, second, pending[1])
+
+	// Once deleted, the export is gone along with its packets.
+	require.NoError(t, store.DeleteAnchorExport(ctx, export.AnchorTxHash()))
+	_, err = store.FetchAnchorExport(ctx, export.AnchorTxHash())
+	require.ErrorIs(t, err, tapfreighter.ErrAnchorExportNotFound)
+
+	pending, err = store.PendingAnchorExports(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assertAnchorExportEqual(t, second, pending[0])
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/tapscript"
 	"github.com/lightninglabs/taproot-assets/tapsend"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"golang.org/x/exp/maps"
 )
 
@@ -128,6 +129,14 @@ type (
 	// NewAssetMeta wraps the params needed to insert a new asset meta on
 	// disk.
 	NewAssetMeta = sqlc.UpsertAssetMetaParams
+
+	// NewBatchSchedule wraps the params needed to store the schedule of
+	// the pending batch on disk.
+	NewBatchSchedule = sqlc.UpsertMintBatchScheduleParams
+
+	// StoredBatchSchedule is the schedule of the pending batch as it is
+	// stored on disk.
+	StoredBatchSchedule = sqlc.FetchMintBatchScheduleRow
 )
 
 // PendingAssetStore is a sub-set of the main sqlc.Querier interface that
@@ -235,6 +244,18 @@ type PendingAssetStore interface {
 	// FetchAssetMetaForAsset fetches the asset meta for a given asset.
 	FetchAssetMetaForAsset(ctx context.Context,
 		assetID []byte) (sqlc.FetchAssetMetaForAssetRow, error)
+
+	// UpsertMintBatchSchedule inserts or replaces the schedule of the
+	// pending batch.
+	UpsertMintBatchSchedule(ctx context.Context,
+		arg NewBatchSchedule) error
+
+	// FetchMintBatchSchedule fetches the schedule of the pending batch.
+	FetchMintBatchSchedule(ctx context.Context) (StoredBatchSchedule,
+		error)
+
+	// DeleteMintBatchSchedule removes the schedule of the pending batch.
+	DeleteMintBatchSchedule(ctx context.Context) error
 }
 
 var (
@@ -1275,6 +1296,80 @@ func (a *AssetMintingStore) CommitBatchTapSibling(ctx context.Context,
 	})
 }
 
+// StoreBatchSchedule stores the schedule for finalizing the pending batch,
+// replacing any existing schedule.
+func (a *AssetMintingStore) StoreBatchSchedule(ctx context.Context,
+	schedule tapgarden.BatchSchedule) error {
+
+	feeRate := fn.MapOptionZ(
+		schedule.FeeRate, sqlInt64[chainfee.SatPerKWeight],
+	)
+	dbSchedule := NewBatchSchedule{
+		FinalizeHeight: sqlOptInt32(schedule.Height),
+		FinalizeTime:   sqlOptTime(schedule.Time),
+		MinSeedlings:   sqlOptInt32(schedule.MinSeedlings),
+		FeeRate:        feeRate,
+	}
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
+		return q.UpsertMintBatchSchedule(ctx, dbSchedule)
+	})
+}
+
+// FetchBatchSchedule fetches the stored schedule for finalizing the pending
+// batch, if there is one.
+func (a *AssetMintingStore) FetchBatchSchedule(
+	ctx context.Context) (fn.Option[tapgarden.BatchSchedule], error) {
+
+	var dbSchedule StoredBatchSchedule
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q PendingAssetStore) error {
+		var err error
+		dbSchedule, err = q.FetchMintBatchSchedule(ctx)
+		return err
+	})
+	switch {
+	case errors.Is(dbErr, sql.ErrNoRows):
+		return fn.None[tapgarden.BatchSchedule](), nil
+	case dbErr != nil:
+		return fn.None[tapgarden.BatchSchedule](), dbErr
+	}
+
+	var schedule tapgarden.BatchSchedule
+	if dbSchedule.FinalizeHeight.Valid {
+		schedule.Height = fn.Some(extractSqlInt32[uint32](
+			dbSchedule.FinalizeHeight,
+		))
+	}
+	if dbSchedule.FinalizeTime.Valid {
+		schedule.Time = fn.Some(extractSqlTime(dbSchedule.FinalizeTime))
+	}
+	if dbSchedule.MinSeedlings.Valid {
+		schedule.MinSeedlings = fn.Some(extractSqlInt32[int](
+			dbSchedule.MinSeedlings,
+		))
+	}
+	if dbSchedule.FeeRate.Valid {
+		schedule.FeeRate = fn.Some(
+			extractSqlInt64[chainfee.SatPerKWeight](
+				dbSchedule.FeeRate,
+			),
+		)
+	}
+
+	return fn.Some(schedule), nil
+}
+
+// DeleteBatchSchedule removes the stored schedule for finalizing the pending
+// batch.
+func (a *AssetMintingStore) DeleteBatchSchedule(ctx context.Context) error {
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
+		return q.DeleteMintBatchSchedule(ctx)
+	})
+}
+
 // encodeOutpoint encodes the outpoint point in Bitcoin wire format, returning
 // the final result.
 func encodeOutpoint(outPoint wire.OutPoint) ([]byte, error) {
@@ -26,6 +26,7 @@ import (
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/clock"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/maps"
 )
@@ -1081,6 +1082,53 @@ func TestCommitBatchChainActions(t *testing.T) {
 	}
 }
 
+// TestBatchSchedule tests that the schedule of the pending batch can be
+// stored, replaced, fetched and deleted.
+func TestBatchSchedule(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	assetStore, _, _ := newAssetStore(t)
+
+	// Without a stored schedule, we should get an empty option back.
+	schedule, err := assetStore.FetchBatchSchedule(ctx)
+	require.NoError(t, err)
+	require.True(t, schedule.IsNone())
+
+	// Now we'll store a schedule with all conditions set, and make sure
+	// we get the exact same schedule back.
+	fullSchedule := tapgarden.BatchSchedule{
+		Height:       fn.Some(uint32(1234)),
+		Time:         fn.Some(time.Unix(1_700_000_000, 0).UTC()),
+		MinSeedlings: fn.Some(3),
+		FeeRate:      fn.Some(chainfee.SatPerKWeight(2500)),
+	}
+	require.NoError(t, assetStore.StoreBatchSchedule(ctx, fullSchedule))
+
+	schedule, err = assetStore.FetchBatchSchedule(ctx)
+	require.NoError(t, err)
+	require.Equal(t, fn.Some(fullSchedule), schedule)
+
+	// Storing another schedule replaces the existing one, including the
+	// conditions that are no longer set.
+	partialSchedule := tapgarden.BatchSchedule{
+		MinSeedlings: fn.Some(2),
+	}
+	err = assetStore.StoreBatchSchedule(ctx, partialSchedule)
+	require.NoError(t, err)
+
+	schedule, err = assetStore.FetchBatchSchedule(ctx)
+	require.NoError(t, err)
+	require.Equal(t, fn.Some(partialSchedule), schedule)
+
+	// Finally, once deleted, the schedule should be gone.
+	require.NoError(t, assetStore.DeleteBatchSchedule(ctx))
+
+	schedule, err = assetStore.FetchBatchSchedule(ctx)
+	require.NoError(t, err)
+	require.True(t, schedule.IsNone())
+}
+
 // TestDuplicateGroupKey tests that if we attempt to insert a group key with
 // the exact same tweaked key blob, then the noop UPSERT logic triggers, and we
 // get the ID of that same key.
@@ -11,6 +11,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/davecgh/go-spew/spew"
@@ -23,6 +24,8 @@ import (
 	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
 	"github.com/lightninglabs/taproot-assets/tapfreighter"
 	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/tapsend"
+	"github.com/lightninglabs/taproot-assets/universe"
 	"github.com/lightningnetwork/lnd/clock"
 	"github.com/lightningnetwork/lnd/keychain"
 )
@@ -82,6 +85,13 @@ type (
 	// ManagedUTXORow wraps a managed UTXO listing row.
 	ManagedUTXORow = sqlc.FetchManagedUTXOsRow
 
+	// UtxoNote wraps the user-defined note of an anchor output.
+	UtxoNote = sqlc.UtxoNote
+
+	// NewUtxoNote wraps the params needed to set the note of an anchor
+	// output.
+	NewUtxoNote = sqlc.UpsertUtxoNoteParams
+
 	// UpdateUTXOLease wraps the params needed to lease a managed UTXO.
 	UpdateUTXOLease = sqlc.UpdateUTXOLeaseParams
 
@@ -106,6 +116,13 @@ type (
 	// AssetTransferRow wraps a single transfer row.
 	AssetTransferRow = sqlc.QueryAssetTransfersRow
 
+	// TransferPageQuery allows callers to fetch a filtered page of
+	// transfers.
+	TransferPageQuery = sqlc.QueryAssetTransfersPageParams
+
+	// AssetTransferPageRow wraps a single transfer row of a page.
+	AssetTransferPageRow = sqlc.QueryAssetTransfersPageRow
+
 	// TransferInput tracks the inputs to an asset transfer.
 	TransferInput = sqlc.AssetTransferInput
 
@@ -136,6 +153,26 @@ type (
 	// ReAnchorParams wraps the params needed to re-anchor a passive asset.
 	ReAnchorParams = sqlc.ReAnchorPassiveAssetsParams
 
+	// ReplaceAnchorTxParams wraps the params needed to replace the
+	// unconfirmed anchor transaction of a transfer.
+	ReplaceAnchorTxParams = sqlc.ReplaceTransferAnchorTxParams
+
+	// AnchorPsbtUpdate wraps the params needed to update the funded anchor
+	// PSBT of a transfer.
+	AnchorPsbtUpdate = sqlc.UpdateTransferAnchorPsbtParams
+
+	// OutpointUpdate wraps the params needed to update the outpoint of a
+	// managed UTXO.
+	OutpointUpdate = sqlc.UpdateManagedUTXOOutpointParams
+
+	// ProofSuffixUpdate wraps the params needed to update the proof suffix
+	// of a transfer output.
+	ProofSuffixUpdate = sqlc.UpdateTransferOutputProofSuffixParams
+
+	// PassiveProofUpdate wraps the params needed to update the new proof of
+	// a passive asset.
+	PassiveProofUpdate = sqlc.UpdatePassiveAssetProofParams
+
 	// LogProofTransAttemptParams is a type alias for the params needed to
 	// log a proof transfer attempt.
 	LogProofTransAttemptParams = sqlc.LogProofTransferAttemptParams
@@ -240,6 +277,19 @@ type ActiveAssetsStore interface {
 	// FetchManagedUTXOs fetches all managed UTXOs.
 	FetchManagedUTXOs(context.Context) ([]ManagedUTXORow, error)
 
+	// UpsertUtxoNote inserts a new or updates the existing note of an
+	// anchor output.
+	UpsertUtxoNote(ctx context.Context, arg NewUtxoNote) error
+
+	// DeleteUtxoNote removes the note of the given anchor output.
+	DeleteUtxoNote(ctx context.Context, outpoint []byte) error
+
+	// FetchUtxoNote fetches the note of the given anchor output.
+	FetchUtxoNote(ctx context.Context, outpoint []byte) (UtxoNote, error)
+
+	// FetchUtxoNotes fetches the notes of all anchor outputs.
+	FetchUtxoNotes(ctx context.Context) ([]UtxoNote, error)
+
 	// ApplyPendingOutput applies a transfer output (new amount and script
 	// key) based on the existing script key of an asset.
 	ApplyPendingOutput(ctx context.Context, arg ApplyPendingOutput) (int64,
@@ -291,6 +341,11 @@ type ActiveAssetsStore interface {
 		query sqlc.QueryAssetTransfersParams) ([]AssetTransferRow,
 		error)
 
+	// QueryAssetTransfersPage queries for a filtered page of asset
+	// transfers in the db.
+	QueryAssetTransfersPage(ctx context.Context,
+		query TransferPageQuery) ([]AssetTransferPageRow, error)
+
 	// DeleteAssetWitnesses deletes the witnesses on disk associated with a
 	// given asset ID.
 	DeleteAssetWitnesses(ctx context.Context, assetID int64) error
@@ -317,6 +372,52 @@ type ActiveAssetsStore interface {
 	// the passed params.
 	ReAnchorPassiveAssets(ctx context.Context, arg ReAnchorParams) error
 
+	// ReplaceTransferAnchorTx replaces an unconfirmed anchor transaction
+	// with a new version that spends the same inputs.
+	ReplaceTransferAnchorTx(ctx context.Context,
+		arg ReplaceAnchorTxParams) error
+
+	// UpdateTransferAnchorPsbt updates the funded anchor PSBT of a
+	// transfer.
+	UpdateTransferAnchorPsbt(ctx context.Context,
+		arg AnchorPsbtUpdate) error
+
+	// UpdateManagedUTXOOutpoint updates the outpoint of a managed UTXO.
+	UpdateManagedUTXOOutpoint(ctx context.Context,
+		arg OutpointUpdate) error
+
+	// UpdateTransferOutputProofSuffix updates the proof suffix of a
+	// transfer output.
+	UpdateTransferOutputProofSuffix(ctx context.Context,
+		arg ProofSuffixUpdate) error
+
+	// UpdatePassiveAssetProof updates the new proof of a passive asset of
+	// a transfer.
+	UpdatePassiveAssetProof(ctx context.Context,
+		arg PassiveProofUpdate) error
+
+	// DeleteAssetTransferInputs deletes all inputs of a transfer.
+	DeleteAssetTransferInputs(ctx context.Context, transferID int64) error
+
+	// DeleteAssetTransferOutputs deletes all outputs of a transfer.
+	DeleteAssetTransferOutputs(ctx context.Context, transferID int64) error
+
+	// DeletePassiveAssets deletes all passive assets of a transfer.
+	DeletePassiveAssets(ctx context.Context, transferID int64) error
+
+	// DeleteAssetTransfer deletes a transfer. Its inputs, outputs and
+	// passive assets must be deleted first.
+	DeleteAssetTransfer(ctx context.Context, id int64) error
+
+	// DeleteUnconfirmedChainTx deletes a chain transaction that hasn't
+	// been confirmed yet.
+	DeleteUnconfirmedChainTx(ctx context.Context, txid []byte) error
+
+	// MarkTransferBroadcastAttempted marks the transfer with the given
+	// anchor transaction as handed to the chain backend for broadcast.
+	MarkTransferBroadcastAttempted(ctx context.Context,
+		anchorTxid []byte) error
+
 	// FetchAssetMetaByHash fetches the asset meta for a given meta hash.
 	//
 	// TODO(roasbeef): split into MetaStore?
@@ -417,6 +518,9 @@ type ManagedUTXO struct {
 	// TapscriptSibling is the serialized tapscript sibling preimage of
 	// this asset. This will usually be blank.
 	TapscriptSibling []byte
+
+	// Note is an optional user-defined annotation of the UTXO.
+	Note string
 }
 
 // AssetHumanReadable is a subset of the base asset struct that only includes
@@ -1089,24 +1193,77 @@ func (a *AssetStore) FetchAllAssets(ctx context.Context, includeSpent,
 	return a.dbAssetsToChainAssets(dbAssets, assetWitnesses)
 }
 
+// WatchedAnchors returns the anchor outputs of all our unspent assets, so they
+// can be watched for spends.
+func (a *AssetStore) WatchedAnchors(
+	ctx context.Context) ([]*tapfreighter.WatchedAnchor, error) {
+
+	assets, err := a.FetchAllAssets(ctx, false, true, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		anchors   []*tapfreighter.WatchedAnchor
+		anchorIdx = make(map[wire.OutPoint]int)
+	)
+	for _, chainAsset := range assets {
+		op := chainAsset.AnchorOutpoint
+		if idx, ok := anchorIdx[op]; ok {
+			anchors[idx].AssetIDs = append(
+				anchors[idx].AssetIDs, chainAsset.ID(),
+			)
+
+			continue
+		}
+
+		anchorTx := chainAsset.AnchorTx
+		if anchorTx == nil || int(op.Index) >= len(anchorTx.TxOut) {
+			return nil, fmt.Errorf("anchor transaction of %v "+
+				"unknown", op)
+		}
+
+		anchorIdx[op] = len(anchors)
+		anchors = append(anchors, &tapfreighter.WatchedAnchor{
+			OutPoint:   op,
+			PkScript:   anchorTx.TxOut[op.Index].PkScript,
+			HeightHint: chainAsset.AnchorBlockHeight,
+			AssetIDs:   []asset.ID{chainAsset.ID()},
+		})
+	}
+
+	return anchors, nil
+}
+
 // FetchManagedUTXOs fetches all UTXOs we manage.
 func (a *AssetStore) FetchManagedUTXOs(ctx context.Context) (
 	[]*ManagedUTXO, error) {
 
 	var (
 		utxos []ManagedUTXORow
+		notes []UtxoNote
 		err   error
 	)
 
 	readOpts := NewAssetStoreReadTx()
 	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
 		utxos, err = q.FetchManagedUTXOs(ctx)
+		if err != nil {
+			return err
+		}
+
+		notes, err = q.FetchUtxoNotes(ctx)
 		return err
 	})
 	if dbErr != nil {
 		return nil, dbErr
 	}
 
+	utxoNotes := make(map[string]string, len(notes))
+	for _, n := range notes {
+		utxoNotes[string(n.Outpoint)] = n.Note
+	}
+
 	managedUtxos := make([]*ManagedUTXO, len(utxos))
 	for i, u := range utxos {
 		var anchorPoint wire.OutPoint
@@ -1137,6 +1294,7 @@ func (a *AssetStore) FetchManagedUTXOs(ctx context.Context) (
 			TaprootAssetRoot: u.TaprootAssetRoot,
 			MerkleRoot:       u.MerkleRoot,
 			TapscriptSibling: u.TapscriptSibling,
+			Note:             utxoNotes[string(u.Outpoint)],
 		}
 	}
 
@@ -2100,6 +2258,13 @@ func (a *AssetStore) LogPendingParcel(ctx context.Context,
 	}
 	anchorTxBytes := txBuf.Bytes()
 
+	anchorPsbtBytes, changeOutputIndex, err := encodeFundedPsbt(
+		spend.FundedAnchorPsbt,
+	)
+	if err != nil {
+		return err
+	}
+
 	var writeTxOpts AssetStoreTxOptions
 	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
 		// First, we'll insert the new transaction that anchors the new
@@ -2117,10 +2282,14 @@ func (a *AssetStore) LogPendingParcel(ctx context.Context,
 		// The transfer itself is just a shell which the inputs and
 		// outputs will reference. We'll insert this next, so we can
 		// use its ID.
+		heightHint := int32(spend.AnchorTxHeightHint)
 		transferID, err := q.InsertAssetTransfer(ctx, NewAssetTransfer{
-			HeightHint:       int32(spend.AnchorTxHeightHint),
-			AnchorTxid:       newAnchorTXID[:],
-			TransferTimeUnix: spend.TransferTime,
+			HeightHint:              heightHint,
+			AnchorTxid:              newAnchorTXID[:],
+			TransferTimeUnix:        spend.TransferTime,
+			AnchorPsbt:              anchorPsbtBytes,
+			AnchorChangeOutputIndex: changeOutputIndex,
+			NumConfs:                int32(spend.NumConfs),
 		})
 		if err != nil {
 			return fmt.Errorf("unable to insert asset transfer: "+
@@ -2171,6 +2340,52 @@ func (a *AssetStore) LogPendingParcel(ctx context.Context,
 	})
 }
 
+// encodeFundedPsbt serializes the PSBT of the given funded anchor transaction
+// and returns it along with its change output index. If the funded PSBT is
+// nil, empty values are returned.
+func encodeFundedPsbt(fundedPsbt *tapsend.FundedPsbt) ([]byte, sql.NullInt32,
+	error) {
+
+	if fundedPsbt == nil || fundedPsbt.Pkt == nil {
+		return nil, sql.NullInt32{}, nil
+	}
+
+	var psbtBuf bytes.Buffer
+	if err := fundedPsbt.Pkt.Serialize(&psbtBuf); err != nil {
+		return nil, sql.NullInt32{}, fmt.Errorf("unable to encode "+
+			"anchor psbt: %w", err)
+	}
+
+	return psbtBuf.Bytes(), sqlInt32(fundedPsbt.ChangeOutputIndex), nil
+}
+
+// decodeFundedPsbt parses the funded anchor transaction of a transfer from its
+// serialized PSBT and change output index. If no PSBT was stored, nil is
+// returned.
+func decodeFundedPsbt(rawPsbt []byte, changeOutputIndex sql.NullInt32,
+	chainFees int64) (*tapsend.FundedPsbt, error) {
+
+	if len(rawPsbt) == 0 {
+		return nil, nil
+	}
+
+	pkt, err := psbt.NewFromRawBytes(bytes.NewReader(rawPsbt), false)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode anchor psbt: %w", err)
+	}
+
+	changeIndex := int32(-1)
+	if changeOutputIndex.Valid {
+		changeIndex = changeOutputIndex.Int32
+	}
+
+	return &tapsend.FundedPsbt{
+		Pkt:               pkt,
+		ChangeOutputIndex: changeIndex,
+		ChainFees:         chainFees,
+	}, nil
+}
+
 // insertAssetTransferInput inserts a new asset transfer input into the DB.
 func insertAssetTransferInput(ctx context.Context, q ActiveAssetsStore,
 	transferID int64, input tapfreighter.TransferInput,
@@ -3044,62 +3259,447 @@ func (a *AssetStore) QueryParcels(ctx context.Context,
 		}
 
 		for idx := range dbTransfers {
-			dbT := dbTransfers[idx]
-
-			inputs, err := fetchAssetTransferInputs(ctx, q, dbT.ID)
+			transfer, err := fetchOutboundParcel(
+				ctx, q, dbTransfers[idx],
+			)
 			if err != nil {
-				return fmt.Errorf("unable to fetch transfer "+
-					"inputs: %w", err)
+				return err
 			}
 
-			outputs, err := fetchAssetTransferOutputs(
-				ctx, q, dbT.ID,
+			transfers = append(transfers, transfer)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return transfers, nil
+}
+
+// fetchOutboundParcel fetches the inputs, outputs and anchor transaction of the
+// given transfer and assembles them into an outbound parcel.
+func fetchOutboundParcel(ctx context.Context, q ActiveAssetsStore,
+	dbT AssetTransferRow) (*tapfreighter.OutboundParcel, error) {
+
+	inputs, err := fetchAssetTransferInputs(ctx, q, dbT.ID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch transfer inputs: %w",
+			err)
+	}
+
+	outputs, err := fetchAssetTransferOutputs(ctx, q, dbT.ID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch transfer outputs: %w",
+			err)
+	}
+
+	// We know that the anchor transaction is the same for each output, we
+	// can just fetch the first.
+	if len(outputs) == 0 {
+		return nil, fmt.Errorf("no outputs for transfer")
+	}
+
+	anchorTXID := outputs[0].Anchor.OutPoint.Hash[:]
+	dbAnchorTx, err := q.FetchChainTx(ctx, anchorTXID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch chain tx: %w", err)
+	}
+
+	anchorTx := wire.NewMsgTx(2)
+	err = anchorTx.Deserialize(bytes.NewReader(dbAnchorTx.RawTx))
+	if err != nil {
+		return nil, fmt.Errorf("unable to deserialize anchor tx: %w",
+			err)
+	}
+
+	fundedPsbt, err := decodeFundedPsbt(
+		dbT.AnchorPsbt, dbT.AnchorChangeOutputIndex,
+		dbAnchorTx.ChainFees,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tapfreighter.OutboundParcel{
+		AnchorTx:           anchorTx,
+		AnchorTxHeightHint: uint32(dbT.HeightHint),
+		TransferTime:       dbT.TransferTimeUnix.UTC(),
+		ChainFees:          dbAnchorTx.ChainFees,
+		Inputs:             inputs,
+		Outputs:            outputs,
+		FundedAnchorPsbt:   fundedPsbt,
+		NumConfs:           uint32(dbT.NumConfs),
+		BroadcastAttempted: dbT.BroadcastAttempted,
+	}, nil
+}
+
+// QueryParcelsPage returns a single page of the parcels that match the filters
+// of the given query.
+func (a *AssetStore) QueryParcelsPage(ctx context.Context,
+	query tapfreighter.ParcelQuery) (*tapfreighter.ParcelPage, error) {
+
+	if query.Limit <= 0 {
+		return nil, fmt.Errorf("invalid page limit: %d", query.Limit)
+	}
+
+	// We fetch one more transfer than requested, so we know whether there
+	// is a next page.
+	pageQuery := TransferPageQuery{
+		StartTime: sqlOptTime(query.StartTime),
+		EndTime:   sqlOptTime(query.EndTime),
+		Cursor:    fn.MapOptionZ(query.Cursor, sqlInt64[int64]),
+		NumLimit:  query.Limit + 1,
+	}
+	query.Confirmed.WhenSome(func(confirmed bool) {
+		pageQuery.Confirmed = confirmed
+	})
+	query.AnchorTxHash.WhenSome(func(hash chainhash.Hash) {
+		pageQuery.AnchorTxHash = hash[:]
+	})
+	query.AssetID.WhenSome(func(id asset.ID) {
+		pageQuery.AssetID = id[:]
+	})
+	query.GroupKey.WhenSome(func(key btcec.PublicKey) {
+		pageQuery.GroupKey = key.SerializeCompressed()
+	})
+	query.ScriptKey.WhenSome(func(key btcec.PublicKey) {
+		pageQuery.ScriptKey = key.SerializeCompressed()
+	})
+
+	pageQuery.SortDirection = sqlInt16(universe.SortAscending)
+	if query.Descending {
+		pageQuery.SortDirection = sqlInt16(universe.SortDescending)
+	}
+
+	var page tapfreighter.ParcelPage
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		page = tapfreighter.ParcelPage{}
+
+		dbTransfers, err := q.QueryAssetTransfersPage(ctx, pageQuery)
+		if err != nil {
+			return err
+		}
+
+		if len(dbTransfers) > int(query.Limit) {
+			dbTransfers = dbTransfers[:query.Limit]
+			page.NextCursor = fn.Some(
+				dbTransfers[len(dbTransfers)-1].ID,
+			)
+		}
+
+		for idx := range dbTransfers {
+			transfer, err := fetchOutboundParcel(
+				ctx, q, AssetTransferRow(dbTransfers[idx]),
 			)
 			if err != nil {
-				return fmt.Errorf("unable to fetch transfer "+
-					"outputs: %w", err)
+				return err
 			}
 
-			// We know that the anchor transaction is the same for
-			// each output, we can just fetch the first.
-			if len(outputs) == 0 {
-				return fmt.Errorf("no outputs for transfer")
+			page.Parcels = append(page.Parcels, transfer)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return &page, nil
+}
+
+// ReplaceParcelAnchorTx replaces the unconfirmed anchor transaction of the
+// pending parcel identified by the given anchor transaction hash with the
+// anchor transaction of the given parcel. The anchor outputs, proof suffixes
+// and funded anchor PSBT are updated to the ones of the given parcel. Because
+// the passive assets aren't part of a parcel loaded from disk, their proofs
+// are updated to reference the new anchor transaction directly.
+func (a *AssetStore) ReplaceParcelAnchorTx(ctx context.Context,
+	oldAnchorTxHash chainhash.Hash,
+	parcel *tapfreighter.OutboundParcel) error {
+
+	newAnchorTxHash := parcel.AnchorTx.TxHash()
+	var txBuf bytes.Buffer
+	if err := parcel.AnchorTx.Serialize(&txBuf); err != nil {
+		return err
+	}
+
+	anchorPsbtBytes, changeOutputIndex, err := encodeFundedPsbt(
+		parcel.FundedAnchorPsbt,
+	)
+	if err != nil {
+		return err
+	}
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		dbTransfers, err := q.QueryAssetTransfers(ctx, TransferQuery{
+			UnconfOnly:   true,
+			AnchorTxHash: oldAnchorTxHash[:],
+		})
+		if err != nil {
+			return fmt.Errorf("unable to query asset transfers: %w",
+				err)
+		}
+		if len(dbTransfers) != 1 {
+			return fmt.Errorf("no pending transfer found for "+
+				"anchor tx %v", oldAnchorTxHash)
+		}
+		transferID := dbTransfers[0].ID
+
+		err = q.ReplaceTransferAnchorTx(ctx, ReplaceAnchorTxParams{
+			NewTxid:   newAnchorTxHash[:],
+			RawTx:     txBuf.Bytes(),
+			ChainFees: parcel.ChainFees,
+			OldTxid:   oldAnchorTxHash[:],
+		})
+		if err != nil {
+			return fmt.Errorf("unable to replace anchor tx: %w",
+				err)
+		}
+
+		err = q.UpdateTransferAnchorPsbt(ctx, AnchorPsbtUpdate{
+			ID:                      transferID,
+			AnchorPsbt:              anchorPsbtBytes,
+			AnchorChangeOutputIndex: changeOutputIndex,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to update anchor psbt: %w",
+				err)
+		}
+
+		// The outputs of the new anchor transaction are at the same
+		// index as before, so we only need to update the hash of all
+		// anchor outpoints.
+		anchorOutputs := make(fn.Set[uint32])
+		for _, out := range parcel.Outputs {
+			anchorOutputs.Add(out.Anchor.OutPoint.Index)
+		}
+		if parcel.PassiveAssetsAnchor != nil {
+			passiveOutpoint := parcel.PassiveAssetsAnchor.OutPoint
+			anchorOutputs.Add(passiveOutpoint.Index)
+		}
+		for outputIndex := range anchorOutputs {
+			err := updateAnchorOutpoint(
+				ctx, q, wire.OutPoint{
+					Hash:  oldAnchorTxHash,
+					Index: outputIndex,
+				}, newAnchorTxHash,
+			)
+			if err != nil {
+				return err
 			}
+		}
 
-			anchorTXID := outputs[0].Anchor.OutPoint.Hash[:]
-			dbAnchorTx, err := q.FetchChainTx(ctx, anchorTXID)
+		// The outputs are always returned in the order they were
+		// inserted, which is the same order as in the parcel.
+		dbOutputs, err := q.FetchTransferOutputs(ctx, transferID)
+		if err != nil {
+			return fmt.Errorf("unable to fetch transfer outputs: "+
+				"%w", err)
+		}
+		if len(dbOutputs) != len(parcel.Outputs) {
+			return fmt.Errorf("expected %d transfer outputs, got "+
+				"%d", len(parcel.Outputs), len(dbOutputs))
+		}
+		for idx, dbOutput := range dbOutputs {
+			suffix := parcel.Outputs[idx].ProofSuffix
+			err := q.UpdateTransferOutputProofSuffix(
+				ctx, ProofSuffixUpdate{
+					OutputID:    dbOutput.OutputID,
+					ProofSuffix: suffix,
+				},
+			)
 			if err != nil {
-				return fmt.Errorf("unable to fetch chain tx: "+
-					"%w", err)
+				return fmt.Errorf("unable to update proof "+
+					"suffix: %w", err)
 			}
+		}
+
+		return replacePassiveAssetsAnchorTx(
+			ctx, q, transferID, parcel.AnchorTx,
+		)
+	})
+}
+
+// MarkParcelBroadcastAttempted persists that the anchor transaction of the
+// pending parcel with the given anchor transaction hash is about to be
+// broadcast. From then on, the parcel can't be assumed to be unknown to the
+// network anymore.
+func (a *AssetStore) MarkParcelBroadcastAttempted(ctx context.Context,
+	anchorTxHash chainhash.Hash) error {
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		return q.MarkTransferBroadcastAttempted(ctx, anchorTxHash[:])
+	})
+}
+
+// DeletePendingParcel removes the pending parcel with the given anchor
+// transaction hash from the database, which must not have been broadcast yet.
+// All database entries written when logging the parcel are removed and the
+// asset inputs of the parcel are released, so they can be spent again.
+func (a *AssetStore) DeletePendingParcel(ctx context.Context,
+	anchorTxHash chainhash.Hash) error {
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		dbTransfers, err := q.QueryAssetTransfers(ctx, TransferQuery{
+			UnconfOnly:   true,
+			AnchorTxHash: anchorTxHash[:],
+		})
+		if err != nil {
+			return fmt.Errorf("unable to query asset transfers: %w",
+				err)
+		}
+		if len(dbTransfers) != 1 {
+			return fmt.Errorf("no pending transfer found for "+
+				"anchor tx %v", anchorTxHash)
+		}
+		transferID := dbTransfers[0].ID
 
-			anchorTx := wire.NewMsgTx(2)
-			err = anchorTx.Deserialize(bytes.NewReader(
-				dbAnchorTx.RawTx,
-			))
+		// The inputs of the transfer were leased until the transfer
+		// confirms, so we need to release them again.
+		dbInputs, err := q.FetchTransferInputs(ctx, transferID)
+		if err != nil {
+			return fmt.Errorf("unable to fetch transfer inputs: %w",
+				err)
+		}
+		for _, dbInput := range dbInputs {
+			err := q.DeleteUTXOLease(ctx, dbInput.AnchorPoint)
 			if err != nil {
-				return fmt.Errorf("unable to deserialize "+
-					"anchor tx: %w", err)
+				return fmt.Errorf("unable to release input: %w",
+					err)
 			}
+		}
+
+		// The managed UTXOs of the anchor outputs were created for this
+		// transfer, so they need to go once the outputs referencing
+		// them are removed.
+		anchorOutpoints := make(map[string][]byte)
+		dbOutputs, err := q.FetchTransferOutputs(ctx, transferID)
+		if err != nil {
+			return fmt.Errorf("unable to fetch transfer outputs: "+
+				"%w", err)
+		}
+		for _, dbOutput := range dbOutputs {
+			outpoint := dbOutput.AnchorOutpoint
+			anchorOutpoints[string(outpoint)] = outpoint
+		}
+		passiveAssets, err := q.QueryPassiveAssets(ctx, transferID)
+		if err != nil {
+			return fmt.Errorf("unable to query passive assets: %w",
+				err)
+		}
+		for _, passiveAsset := range passiveAssets {
+			outpoint := passiveAsset.Outpoint
+			anchorOutpoints[string(outpoint)] = outpoint
+		}
+
+		if err := q.DeleteAssetTransferInputs(ctx, transferID); err != nil {
+			return fmt.Errorf("unable to delete transfer inputs: %w",
+				err)
+		}
+		err = q.DeleteAssetTransferOutputs(ctx, transferID)
+		if err != nil {
+			return fmt.Errorf("unable to delete transfer outputs: "+
+				"%w", err)
+		}
+		if err := q.DeletePassiveAssets(ctx, transferID); err != nil {
+			return fmt.Errorf("unable to delete passive assets: %w",
+				err)
+		}
+		if err := q.DeleteAssetTransfer(ctx, transferID); err != nil {
+			return fmt.Errorf("unable to delete transfer: %w", err)
+		}
 
-			transfer := &tapfreighter.OutboundParcel{
-				AnchorTx:           anchorTx,
-				AnchorTxHeightHint: uint32(dbT.HeightHint),
-				TransferTime:       dbT.TransferTimeUnix.UTC(),
-				ChainFees:          dbAnchorTx.ChainFees,
-				Inputs:             inputs,
-				Outputs:            outputs,
+		for _, outpoint := range anchorOutpoints {
+			if err := q.DeleteManagedUTXO(ctx, outpoint); err != nil {
+				return fmt.Errorf("unable to delete anchor "+
+					"output: %w", err)
 			}
-			transfers = append(transfers, transfer)
+		}
+
+		err = q.DeleteUnconfirmedChainTx(ctx, anchorTxHash[:])
+		if err != nil {
+			return fmt.Errorf("unable to delete anchor tx: %w", err)
 		}
 
 		return nil
 	})
-	if dbErr != nil {
-		return nil, dbErr
+}
+
+// updateAnchorOutpoint updates the outpoint of the managed UTXO at the given
+// outpoint to reference the given new anchor transaction hash.
+func updateAnchorOutpoint(ctx context.Context, q ActiveAssetsStore,
+	oldOutpoint wire.OutPoint, newAnchorTxHash chainhash.Hash) error {
+
+	oldOutpointBytes, err := encodeOutpoint(oldOutpoint)
+	if err != nil {
+		return err
+	}
+	newOutpointBytes, err := encodeOutpoint(wire.OutPoint{
+		Hash:  newAnchorTxHash,
+		Index: oldOutpoint.Index,
+	})
+	if err != nil {
+		return err
 	}
 
-	return transfers, nil
+	err = q.UpdateManagedUTXOOutpoint(ctx, OutpointUpdate{
+		NewOutpoint: newOutpointBytes,
+		OldOutpoint: oldOutpointBytes,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update anchor outpoint %v: %w",
+			oldOutpoint, err)
+	}
+
+	return nil
+}
+
+// replacePassiveAssetsAnchorTx updates the proofs of all passive assets of the
+// given transfer to reference the given new anchor transaction.
+func replacePassiveAssetsAnchorTx(ctx context.Context, q ActiveAssetsStore,
+	transferID int64, anchorTx *wire.MsgTx) error {
+
+	passiveAssets, err := q.QueryPassiveAssets(ctx, transferID)
+	if err != nil {
+		return fmt.Errorf("failed to query passive assets: %w", err)
+	}
+
+	for _, passiveAsset := range passiveAssets {
+		var passiveProof proof.Proof
+		err := passiveProof.Decode(
+			bytes.NewReader(passiveAsset.NewProof),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to decode passive asset "+
+				"proof: %w", err)
+		}
+
+		passiveProof.AnchorTx = *anchorTx
+
+		var proofBuf bytes.Buffer
+		if err := passiveProof.Encode(&proofBuf); err != nil {
+			return fmt.Errorf("unable to encode passive asset "+
+				"proof: %w", err)
+		}
+
+		err = q.UpdatePassiveAssetProof(ctx, PassiveProofUpdate{
+			NewProof:   proofBuf.Bytes(),
+			TransferID: transferID,
+			AssetID:    passiveAsset.AssetID,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to update passive asset "+
+				"proof: %w", err)
+		}
+	}
+
+	return nil
 }
 
 // ErrAssetMetaNotFound is returned when an asset meta is not found in the
@@ -3216,3 +3816,7 @@ var _ tapfreighter.CoinLister = (*AssetStore)(nil)
 // A compile-time constraint to ensure that AssetStore meets the
 // tapfreighter.ExportLog interface.
 var _ tapfreighter.ExportLog = (*AssetStore)(nil)
+
+// A compile-time constraint to ensure that AssetStore meets the
+// tapfreighter.AnchorLister interface.
+var _ tapfreighter.AnchorLister = (*AssetStore)(nil)
@@ -4,12 +4,14 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha256"
+	"database/sql"
 	"math/rand"
 	"sort"
 	"testing"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
@@ -22,6 +24,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/tapfreighter"
 	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightninglabs/taproot-assets/tapsend"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/stretchr/testify/require"
@@ -1332,6 +1335,7 @@ func TestAssetExportLog(t *testing.T) {
 		AnchorTx:           newAnchorTx,
 		AnchorTxHeightHint: heightHint,
 		ChainFees:          chainFees,
+		NumConfs:           3,
 		// We'll actually modify only one of the assets. This simulates
 		// us create a split of the asset to send to another party.
 		Inputs: []tapfreighter.TransferInput{{
@@ -1614,6 +1618,522 @@ func TestAssetExportLog(t *testing.T) {
 	require.Len(t, parcels, 0)
 }
 
+// TestReplaceParcelAnchorTx tests that the anchor transaction of a pending
+// parcel can be replaced and that the transfer can be confirmed with the
+// replacement anchor transaction afterwards.
+func TestReplaceParcelAnchorTx(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, db := newAssetStore(t)
+	ctx := context.Background()
+
+	assetGen := newAssetGenerator(t, 1, 1)
+	assetGen.genAssets(t, assetsStore, []assetDesc{{
+		assetGen:    assetGen.assetGens[0],
+		anchorPoint: assetGen.anchorPoints[0],
+		amt:         16,
+	}})
+
+	allAssets, err := assetsStore.FetchAllAssets(ctx, true, false, nil)
+	require.NoError(t, err)
+	require.Len(t, allAssets, 1)
+	inputAsset := allAssets[0]
+
+	// The anchor transaction has an asset output at index 0 and a BTC
+	// change output at index 1.
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: assetGen.anchorPoints[0],
+	})
+	anchorTx.AddTxOut(&wire.TxOut{
+		PkScript: bytes.Repeat([]byte{0x01}, 34),
+		Value:    1000,
+	})
+	anchorTx.AddTxOut(&wire.TxOut{
+		PkScript: bytes.Repeat([]byte{0x02}, 34),
+		Value:    50_000,
+	})
+	anchorPkt, err := psbt.NewFromUnsignedTx(anchorTx)
+	require.NoError(t, err)
+	anchorTxHash := anchorTx.TxHash()
+
+	scriptKey := asset.NewScriptKeyBip86(keychain.KeyDescriptor{
+		PubKey: test.RandPubKey(t),
+		KeyLocator: keychain.KeyLocator{
+			Index:  uint32(rand.Int31()),
+			Family: keychain.KeyFamily(rand.Int31()),
+		},
+	})
+	parcel := &tapfreighter.OutboundParcel{
+		AnchorTx:           anchorTx,
+		AnchorTxHeightHint: 1450,
+		ChainFees:          100,
+		Inputs: []tapfreighter.TransferInput{{
+			PrevID: asset.PrevID{
+				OutPoint: assetGen.anchorPoints[0],
+				ID:       inputAsset.ID(),
+				ScriptKey: asset.ToSerialized(
+					inputAsset.ScriptKey.PubKey,
+				),
+			},
+			Amount: inputAsset.Amount,
+		}},
+		Outputs: []tapfreighter.TransferOutput{{
+			Anchor: tapfreighter.Anchor{
+				Value: 1000,
+				OutPoint: wire.OutPoint{
+					Hash:  anchorTxHash,
+					Index: 0,
+				},
+				InternalKey: keychain.KeyDescriptor{
+					PubKey: test.RandPubKey(t),
+				},
+				TaprootAssetRoot: bytes.Repeat([]byte{0x1}, 32),
+				MerkleRoot:       bytes.Repeat([]byte{0x1}, 32),
+			},
+			ScriptKey:      scriptKey,
+			ScriptKeyLocal: true,
+			Amount:         inputAsset.Amount,
+			WitnessData: []asset.Witness{{
+				PrevID:    &asset.PrevID{},
+				TxWitness: [][]byte{{0x01}},
+			}},
+			AssetVersion: asset.V0,
+			ProofSuffix:  bytes.Repeat([]byte{0x01}, 100),
+		}},
+		FundedAnchorPsbt: &tapsend.FundedPsbt{
+			Pkt:               anchorPkt,
+			ChangeOutputIndex: 1,
+			ChainFees:         100,
+		},
+	}
+	leaseOwner := fn.ToArray[[32]byte](test.RandBytes(32))
+	require.NoError(t, assetsStore.LogPendingParcel(
+		ctx, parcel, leaseOwner, time.Now().Add(time.Hour),
+	))
+
+	// The funded anchor PSBT should be returned along with the parcel.
+	parcels, err := assetsStore.QueryParcels(ctx, &anchorTxHash, true)
+	require.NoError(t, err)
+	require.Len(t, parcels, 1)
+	require.NotNil(t, parcels[0].FundedAnchorPsbt)
+	require.EqualValues(t, 1, parcels[0].FundedAnchorPsbt.ChangeOutputIndex)
+	require.Equal(
+		t, anchorTxHash,
+		parcels[0].FundedAnchorPsbt.Pkt.UnsignedTx.TxHash(),
+	)
+
+	// We now replace the anchor transaction with one that pays a higher
+	// fee from its change output.
+	newAnchorTx := anchorTx.Copy()
+	newAnchorTx.TxOut[1].Value -= 1000
+	newAnchorTxHash := newAnchorTx.TxHash()
+	newAnchorPkt, err := psbt.NewFromUnsignedTx(newAnchorTx)
+	require.NoError(t, err)
+
+	newParcel := parcel.Copy()
+	newParcel.AnchorTx = newAnchorTx
+	newParcel.ChainFees = 1100
+	newParcel.FundedAnchorPsbt = &tapsend.FundedPsbt{
+		Pkt:               newAnchorPkt,
+		ChangeOutputIndex: 1,
+		ChainFees:         1100,
+	}
+	newParcel.Outputs[0].Anchor.OutPoint.Hash = newAnchorTxHash
+	newParcel.Outputs[0].ProofSuffix = bytes.Repeat([]byte{0x02}, 100)
+
+	require.NoError(t, assetsStore.ReplaceParcelAnchorTx(
+		ctx, anchorTxHash, newParcel,
+	))
+
+	// The parcel can no longer be found by its original anchor
+	// transaction, only by the replacement.
+	parcels, err = assetsStore.QueryParcels(ctx, &anchorTxHash, true)
+	require.NoError(t, err)
+	require.Empty(t, parcels)
+
+	parcels, err = assetsStore.QueryParcels(ctx, &newAnchorTxHash, true)
+	require.NoError(t, err)
+	require.Len(t, parcels, 1)
+	require.Equal(t, newAnchorTxHash, parcels[0].AnchorTx.TxHash())
+	require.EqualValues(t, 1100, parcels[0].ChainFees)
+	require.Equal(
+		t, newParcel.Outputs[0].Anchor.OutPoint,
+		parcels[0].Outputs[0].Anchor.OutPoint,
+	)
+	require.Equal(
+		t, newParcel.Outputs[0].ProofSuffix,
+		parcels[0].Outputs[0].ProofSuffix,
+	)
+	require.Equal(
+		t, newAnchorTxHash,
+		parcels[0].FundedAnchorPsbt.Pkt.UnsignedTx.TxHash(),
+	)
+
+	// The managed UTXO of the asset output should reference the
+	// replacement as well.
+	utxos, err := assetsStore.FetchManagedUTXOs(ctx)
+	require.NoError(t, err)
+	newOutpoint := newParcel.Outputs[0].Anchor.OutPoint
+	require.True(t, fn.Any(utxos, func(u *ManagedUTXO) bool {
+		return u.OutPoint == newOutpoint
+	}))
+	require.False(t, fn.Any(utxos, func(u *ManagedUTXO) bool {
+		return u.OutPoint.Hash == anchorTxHash
+	}))
+
+	// Finally, the transfer can be confirmed with the replacement.
+	assetID := inputAsset.ID()
+	proofs := map[asset.SerializedKey]*proof.AnnotatedProof{
+		asset.ToSerialized(scriptKey.PubKey): {
+			Locator: proof.Locator{
+				AssetID:   &assetID,
+				ScriptKey: *scriptKey.PubKey,
+			},
+			Blob: newParcel.Outputs[0].ProofSuffix,
+		},
+	}
+	err = assetsStore.ConfirmParcelDelivery(
+		ctx, &tapfreighter.AssetConfirmEvent{
+			AnchorTXID:  newAnchorTxHash,
+			TxIndex:     1,
+			BlockHeight: 100,
+			BlockHash:   chainhash.Hash{0x01},
+			FinalProofs: proofs,
+		},
+	)
+	require.NoError(t, err)
+
+	chainTx, err := db.FetchChainTx(ctx, newAnchorTxHash[:])
+	require.NoError(t, err)
+	require.EqualValues(t, 1100, chainTx.ChainFees)
+
+	parcels, err = assetsStore.PendingParcels(ctx)
+	require.NoError(t, err)
+	require.Empty(t, parcels)
+}
+
+// TestDeletePendingParcel tests that a pending parcel can be deleted and that
+// its input is released again afterwards.
+func TestDeletePendingParcel(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, db := newAssetStore(t)
+	ctx := context.Background()
+
+	assetGen := newAssetGenerator(t, 1, 1)
+	assetGen.genAssets(t, assetsStore, []assetDesc{{
+		assetGen:    assetGen.assetGens[0],
+		anchorPoint: assetGen.anchorPoints[0],
+		amt:         16,
+	}})
+
+	allAssets, err := assetsStore.FetchAllAssets(ctx, true, false, nil)
+	require.NoError(t, err)
+	require.Len(t, allAssets, 1)
+	inputAsset := allAssets[0]
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: assetGen.anchorPoints[0],
+	})
+	anchorTx.AddTxOut(&wire.TxOut{
+		PkScript: bytes.Repeat([]byte{0x01}, 34),
+		Value:    1000,
+	})
+	anchorTxHash := anchorTx.TxHash()
+
+	scriptKey := asset.NewScriptKeyBip86(keychain.KeyDescriptor{
+		PubKey: test.RandPubKey(t),
+		KeyLocator: keychain.KeyLocator{
+			Index:  uint32(rand.Int31()),
+			Family: keychain.KeyFamily(rand.Int31()),
+		},
+	})
+	parcel := &tapfreighter.OutboundParcel{
+		AnchorTx:           anchorTx,
+		AnchorTxHeightHint: 1450,
+		ChainFees:          100,
+		Inputs: []tapfreighter.TransferInput{{
+			PrevID: asset.PrevID{
+				OutPoint: assetGen.anchorPoints[0],
+				ID:       inputAsset.ID(),
+				ScriptKey: asset.ToSerialized(
+					inputAsset.ScriptKey.PubKey,
+				),
+			},
+			Amount: inputAsset.Amount,
+		}},
+		Outputs: []tapfreighter.TransferOutput{{
+			Anchor: tapfreighter.Anchor{
+				Value: 1000,
+				OutPoint: wire.OutPoint{
+					Hash:  anchorTxHash,
+					Index: 0,
+				},
+				InternalKey: keychain.KeyDescriptor{
+					PubKey: test.RandPubKey(t),
+				},
+				TaprootAssetRoot: bytes.Repeat([]byte{0x1}, 32),
+				MerkleRoot:       bytes.Repeat([]byte{0x1}, 32),
+			},
+			ScriptKey: scriptKey,
+			Amount:    inputAsset.Amount,
+			WitnessData: []asset.Witness{{
+				PrevID:    &asset.PrevID{},
+				TxWitness: [][]byte{{0x01}},
+			}},
+			AssetVersion: asset.V0,
+			ProofSuffix:  bytes.Repeat([]byte{0x01}, 100),
+		}},
+	}
+	leaseOwner := fn.ToArray[[32]byte](test.RandBytes(32))
+	require.NoError(t, assetsStore.LogPendingParcel(
+		ctx, parcel, leaseOwner, time.Now().Add(time.Hour),
+	))
+
+	// The input asset is now leased, so it can't be selected anymore.
+	unleasedAssets, err := assetsStore.FetchAllAssets(
+		ctx, false, false, nil,
+	)
+	require.NoError(t, err)
+	require.Empty(t, unleasedAssets)
+
+	// A freshly logged parcel was never broadcast. Once marked, the
+	// broadcast attempt is persisted.
+	parcels, err := assetsStore.PendingParcels(ctx)
+	require.NoError(t, err)
+	require.Len(t, parcels, 1)
+	require.False(t, parcels[0].BroadcastAttempted)
+
+	require.NoError(t, assetsStore.MarkParcelBroadcastAttempted(
+		ctx, anchorTxHash,
+	))
+	parcels, err = assetsStore.PendingParcels(ctx)
+	require.NoError(t, err)
+	require.Len(t, parcels, 1)
+	require.True(t, parcels[0].BroadcastAttempted)
+
+	// Deleting a parcel that doesn't exist should fail.
+	err = assetsStore.DeletePendingParcel(ctx, chainhash.Hash{0x01})
+	require.ErrorContains(t, err, "no pending transfer found")
+
+	require.NoError(t, assetsStore.DeletePendingParcel(ctx, anchorTxHash))
+
+	// The parcel, its anchor output and its anchor transaction should be
+	// gone.
+	parcels, err = assetsStore.PendingParcels(ctx)
+	require.NoError(t, err)
+	require.Empty(t, parcels)
+
+	utxos, err := assetsStore.FetchManagedUTXOs(ctx)
+	require.NoError(t, err)
+	require.False(t, fn.Any(utxos, func(u *ManagedUTXO) bool {
+		return u.OutPoint.Hash == anchorTxHash
+	}))
+
+	_, err = db.FetchChainTx(ctx, anchorTxHash[:])
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	// And the input asset can be selected again.
+	unleasedAssets, err = assetsStore.FetchAllAssets(
+		ctx, false, false, nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, unleasedAssets, 1)
+}
+
+// TestQueryParcelsPage tests that outbound parcels can be filtered and fetched
+// page by page.
+func TestQueryParcelsPage(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	const numParcels = 3
+	assetGen := newAssetGenerator(t, numParcels, numParcels)
+	descs := make([]assetDesc, numParcels)
+	for idx := range descs {
+		descs[idx] = assetDesc{
+			assetGen:    assetGen.assetGens[idx],
+			anchorPoint: assetGen.anchorPoints[idx],
+			keyGroup:    assetGen.groupKeys[idx],
+			amt:         16,
+		}
+	}
+	assetGen.genAssets(t, assetsStore, descs)
+
+	allAssets, err := assetsStore.FetchAllAssets(ctx, true, false, nil)
+	require.NoError(t, err)
+	require.Len(t, allAssets, numParcels)
+
+	// We log one parcel for each asset, each a day after the previous one.
+	startTime := time.Now().Add(-time.Hour * 24 * numParcels).UTC()
+	anchorTxHashes := make([]chainhash.Hash, numParcels)
+	scriptKeys := make([]asset.ScriptKey, numParcels)
+	for idx, inputAsset := range allAssets {
+		anchorTx := wire.NewMsgTx(2)
+		anchorTx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: inputAsset.AnchorOutpoint,
+		})
+		anchorTx.AddTxOut(&wire.TxOut{
+			PkScript: bytes.Repeat([]byte{0x01}, 34),
+			Value:    1000,
+		})
+		anchorTxHashes[idx] = anchorTx.TxHash()
+
+		scriptKeys[idx] = asset.NewScriptKeyBip86(
+			keychain.KeyDescriptor{
+				PubKey: test.RandPubKey(t),
+			},
+		)
+		parcel := &tapfreighter.OutboundParcel{
+			AnchorTx:           anchorTx,
+			AnchorTxHeightHint: 1450,
+			TransferTime: startTime.Add(
+				time.Hour * 24 * time.Duration(idx),
+			),
+			ChainFees: 100,
+			Inputs: []tapfreighter.TransferInput{{
+				PrevID: asset.PrevID{
+					OutPoint: inputAsset.AnchorOutpoint,
+					ID:       inputAsset.ID(),
+					ScriptKey: asset.ToSerialized(
+						inputAsset.ScriptKey.PubKey,
+					),
+				},
+				Amount: inputAsset.Amount,
+			}},
+			Outputs: []tapfreighter.TransferOutput{{
+				Anchor: tapfreighter.Anchor{
+					Value: 1000,
+					OutPoint: wire.OutPoint{
+						Hash:  anchorTxHashes[idx],
+						Index: 0,
+					},
+					InternalKey: keychain.KeyDescriptor{
+						PubKey: test.RandPubKey(t),
+					},
+					TaprootAssetRoot: bytes.Repeat(
+						[]byte{0x1}, 32,
+					),
+					MerkleRoot: bytes.Repeat(
+						[]byte{0x1}, 32,
+					),
+				},
+				ScriptKey: scriptKeys[idx],
+				Amount:    inputAsset.Amount,
+				WitnessData: []asset.Witness{{
+					PrevID:    &asset.PrevID{},
+					TxWitness: [][]byte{{0x01}},
+				}},
+				AssetVersion: asset.V0,
+				ProofSuffix:  bytes.Repeat([]byte{0x01}, 100),
+			}},
+		}
+		leaseOwner := fn.ToArray[[32]byte](test.RandBytes(32))
+		require.NoError(t, assetsStore.LogPendingParcel(
+			ctx, parcel, leaseOwner, time.Now().Add(time.Hour),
+		))
+	}
+
+	// queryHashes returns the anchor TX hashes of the parcels of a single
+	// page, and the cursor of the next page.
+	queryHashes := func(query tapfreighter.ParcelQuery) ([]chainhash.Hash,
+		fn.Option[int64]) {
+
+		page, err := assetsStore.QueryParcelsPage(ctx, query)
+		require.NoError(t, err)
+
+		hashes := fn.Map(
+			page.Parcels,
+			func(p *tapfreighter.OutboundParcel) chainhash.Hash {
+				return p.AnchorTx.TxHash()
+			},
+		)
+
+		return hashes, page.NextCursor
+	}
+
+	// Without any filters, we page through all parcels in both directions.
+	hashes, cursor := queryHashes(tapfreighter.ParcelQuery{Limit: 2})
+	require.Equal(t, anchorTxHashes[:2], hashes)
+	require.True(t, cursor.IsSome())
+
+	hashes, cursor = queryHashes(tapfreighter.ParcelQuery{
+		Cursor: cursor,
+		Limit:  2,
+	})
+	require.Equal(t, anchorTxHashes[2:], hashes)
+	require.True(t, cursor.IsNone())
+
+	hashes, cursor = queryHashes(tapfreighter.ParcelQuery{
+		Descending: true,
+		Limit:      2,
+	})
+	require.Equal(t, []chainhash.Hash{
+		anchorTxHashes[2], anchorTxHashes[1],
+	}, hashes)
+
+	hashes, cursor = queryHashes(tapfreighter.ParcelQuery{
+		Descending: true,
+		Cursor:     cursor,
+		Limit:      2,
+	})
+	require.Equal(t, anchorTxHashes[:1], hashes)
+	require.True(t, cursor.IsNone())
+
+	// Each of the filters only selects the matching parcels.
+	hashes, _ = queryHashes(tapfreighter.ParcelQuery{
+		AssetID: fn.Some(allAssets[1].ID()),
+		Limit:   numParcels,
+	})
+	require.Equal(t, anchorTxHashes[1:2], hashes)
+
+	hashes, _ = queryHashes(tapfreighter.ParcelQuery{
+		GroupKey: fn.Some(allAssets[2].GroupKey.GroupPubKey),
+		Limit:    numParcels,
+	})
+	require.Equal(t, anchorTxHashes[2:], hashes)
+
+	hashes, _ = queryHashes(tapfreighter.ParcelQuery{
+		ScriptKey: fn.Some(*scriptKeys[0].PubKey),
+		Limit:     numParcels,
+	})
+	require.Equal(t, anchorTxHashes[:1], hashes)
+
+	hashes, _ = queryHashes(tapfreighter.ParcelQuery{
+		AnchorTxHash: fn.Some(anchorTxHashes[1]),
+		Limit:        numParcels,
+	})
+	require.Equal(t, anchorTxHashes[1:2], hashes)
+
+	hashes, _ = queryHashes(tapfreighter.ParcelQuery{
+		StartTime: fn.Some(startTime.Add(time.Hour)),
+		EndTime:   fn.Some(startTime.Add(time.Hour * 47)),
+		Limit:     numParcels,
+	})
+	require.Equal(t, anchorTxHashes[1:2], hashes)
+
+	// None of the parcels is confirmed yet.
+	hashes, _ = queryHashes(tapfreighter.ParcelQuery{
+		Confirmed: fn.Some(false),
+		Limit:     numParcels,
+	})
+	require.Equal(t, anchorTxHashes, hashes)
+
+	hashes, _ = queryHashes(tapfreighter.ParcelQuery{
+		Confirmed: fn.Some(true),
+		Limit:     numParcels,
+	})
+	require.Empty(t, hashes)
+
+	// A page without a limit is rejected.
+	_, err = assetsStore.QueryParcelsPage(ctx, tapfreighter.ParcelQuery{})
+	require.ErrorContains(t, err, "invalid page limit")
+}
+
 // TestAssetGroupWitnessUpsert tests that if you try to insert another asset
 // group witness with the same asset_gen_id, then only one is actually created.
 func TestAssetGroupWitnessUpsert(t *testing.T) {
@@ -0,0 +1,247 @@
+package tapdb
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+)
+
+const (
+	// fragmentationScoreScale is the factor the fragmentation score is
+	// scaled with to store it as parts per million.
+	fragmentationScoreScale = 1_000_000
+)
+
+type (
+	// NewCoinSelection is used to insert a new coin selection record.
+	NewCoinSelection = sqlc.InsertCoinSelectionParams
+
+	// CoinSelectionQuery is used to query coin selection records.
+	CoinSelectionQuery = sqlc.QueryCoinSelectionsParams
+
+	// CoinSelection is a coin selection record as stored in the database.
+	CoinSelection = sqlc.CoinSelection
+)
+
+// CoinSelectionStore is the set of queries needed to store coin selection
+// records.
+type CoinSelectionStore interface {
+	// InsertCoinSelection inserts a new coin selection record.
+	InsertCoinSelection(ctx context.Context, arg NewCoinSelection) (int64,
+		error)
+
+	// QueryCoinSelections returns the coin selection records that match
+	// the given query, most recent first.
+	QueryCoinSelections(ctx context.Context,
+		arg CoinSelectionQuery) ([]CoinSelection, error)
+
+	// LatestCoinSelections returns the most recent coin selection record
+	// of each asset ID or group key.
+	LatestCoinSelections(ctx context.Context) ([]CoinSelection, error)
+}
+
+// CoinSelectionStoreTxOptions defines the set of db txn options the
+// CoinSelectionStore understands.
+type CoinSelectionStoreTxOptions struct {
+	// readOnly governs if a read only transaction is needed or not.
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (r *CoinSelectionStoreTxOptions) ReadOnly() bool {
+	return r.readOnly
+}
+
+// NewCoinSelectionStoreReadTx creates a new read transaction option set.
+func NewCoinSelectionStoreReadTx() CoinSelectionStoreTxOptions {
+	return CoinSelectionStoreTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedCoinSelectionStore is the main storage interface for coin selection
+// records. It supports all the basic queries as well as running the set of
+// queries in a single database transaction.
+type BatchedCoinSelectionStore interface {
+	CoinSelectionStore
+
+	BatchedTx[CoinSelectionStore]
+}
+
+// CoinSelectionMetrics is a database backed implementation of the
+// tapfreighter.CoinSelectionLog interface.
+type CoinSelectionMetrics struct {
+	db BatchedCoinSelectionStore
+}
+
+// NewCoinSelectionMetrics creates a new database backed coin selection log.
+func NewCoinSelectionMetrics(
+	db BatchedCoinSelectionStore) *CoinSelectionMetrics {
+
+	return &CoinSelectionMetrics{
+		db: db,
+	}
+}
+
+// LogCoinSelection persists a new coin selection record.
+//
+// NOTE: This is part of the tapfreighter.CoinSelectionLog interface.
+func (c *CoinSelectionMetrics) LogCoinSelection(ctx context.Context,
+	record *tapfreighter.CoinSelectionRecord) error {
+
+	var assetID, groupKey []byte
+	if record.AssetID != nil {
+		assetID = fn.CopySlice(record.AssetID[:])
+	}
+	if record.GroupKey != nil {
+		groupKey = record.GroupKey.SerializeCompressed()
+	}
+
+	score := math.Round(
+		record.FragmentationScore * fragmentationScoreScale,
+	)
+
+	var writeTx CoinSelectionStoreTxOptions
+	return c.db.ExecTx(ctx, &writeTx, func(q CoinSelectionStore) error {
+		id, err := q.InsertCoinSelection(ctx, NewCoinSelection{
+			SelectionTime:         record.SelectionTime.UTC(),
+			AssetID:               assetID,
+			GroupKey:              groupKey,
+			Strategy:              int16(record.Strategy),
+			TargetAmt:             int64(record.TargetAmt),
+			NumCandidates:         int32(record.NumCandidates),
+			NumSelected:           int32(record.NumSelected),
+			SelectedAmt:           int64(record.SelectedAmt),
+			ChangeAmt:             int64(record.ChangeAmt),
+			FragmentationScorePpm: int32(score),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert coin selection: %w",
+				err)
+		}
+
+		record.ID = id
+
+		return nil
+	})
+}
+
+// QueryCoinSelections returns the coin selection records that match the given
+// query, most recent first.
+//
+// NOTE: This is part of the tapfreighter.CoinSelectionLog interface.
+func (c *CoinSelectionMetrics) QueryCoinSelections(ctx context.Context,
+	query tapfreighter.CoinSelectionQuery) (
+	[]*tapfreighter.CoinSelectionRecord, error) {
+
+	dbQuery := CoinSelectionQuery{
+		SelectedAfter: query.From.UTC(),
+		NumLimit:      math.MaxInt32,
+	}
+	if query.AssetID != nil {
+		dbQuery.AssetID = fn.CopySlice(query.AssetID[:])
+	}
+	if query.GroupKey != nil {
+		dbQuery.GroupKey = query.GroupKey.SerializeCompressed()
+	}
+	if query.Limit != 0 {
+		dbQuery.NumLimit = query.Limit
+	}
+
+	var (
+		dbRecords []CoinSelection
+		readTx    = NewCoinSelectionStoreReadTx()
+	)
+	err := c.db.ExecTx(ctx, &readTx, func(q CoinSelectionStore) error {
+		var err error
+		dbRecords, err = q.QueryCoinSelections(ctx, dbQuery)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to query coin selections: %w",
+			err)
+	}
+
+	return parseCoinSelections(dbRecords)
+}
+
+// LatestCoinSelections returns the most recent coin selection record of each
+// asset ID or group key.
+//
+// NOTE: This is part of the tapfreighter.CoinSelectionLog interface.
+func (c *CoinSelectionMetrics) LatestCoinSelections(
+	ctx context.Context) ([]*tapfreighter.CoinSelectionRecord, error) {
+
+	var (
+		dbRecords []CoinSelection
+		readTx    = NewCoinSelectionStoreReadTx()
+	)
+	err := c.db.ExecTx(ctx, &readTx, func(q CoinSelectionStore) error {
+		var err error
+		dbRecords, err = q.LatestCoinSelections(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to query latest coin "+
+			"selections: %w", err)
+	}
+
+	return parseCoinSelections(dbRecords)
+}
+
+// parseCoinSelections converts the given database records into coin selection
+// records.
+func parseCoinSelections(
+	dbRecords []CoinSelection) ([]*tapfreighter.CoinSelectionRecord,
+	error) {
+
+	records := make([]*tapfreighter.CoinSelectionRecord, 0, len(dbRecords))
+	for _, dbRecord := range dbRecords {
+		record := &tapfreighter.CoinSelectionRecord{
+			ID:            dbRecord.ID,
+			SelectionTime: dbRecord.SelectionTime.UTC(),
+			Strategy: tapfreighter.MultiCommitmentSelectStrategy(
+				dbRecord.Strategy,
+			),
+			TargetAmt:     uint64(dbRecord.TargetAmt),
+			NumCandidates: uint32(dbRecord.NumCandidates),
+			NumSelected:   uint32(dbRecord.NumSelected),
+			SelectedAmt:   uint64(dbRecord.SelectedAmt),
+			ChangeAmt:     uint64(dbRecord.ChangeAmt),
+			FragmentationScore: float64(
+				dbRecord.FragmentationScorePpm,
+			) / fragmentationScoreScale,
+		}
+
+		if len(dbRecord.AssetID) > 0 {
+			var assetID asset.ID
+			copy(assetID[:], dbRecord.AssetID)
+			record.AssetID = &assetID
+		}
+
+		if len(dbRecord.GroupKey) > 0 {
+			groupKey, err := btcec.ParsePubKey(dbRecord.GroupKey)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse group "+
+					"key: %w", err)
+			}
+			record.GroupKey = groupKey
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// A compile-time assertion to make sure CoinSelectionMetrics satisfies the
+// tapfreighter.CoinSelectionLog interface.
+var _ tapfreighter.CoinSelectionLog = (*CoinSelectionMetrics)(nil)
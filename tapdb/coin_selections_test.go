@@ -0,0 +1,120 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCoinSelectionMetrics tests that coin selection records can be logged
+// and queried.
+func TestCoinSelectionMetrics(t *testing.T) {
+	t.Parallel()
+
+	db := NewTestDB(t)
+	metrics := NewCoinSelectionMetrics(NewTransactionExecutor(
+		db, func(tx *sql.Tx) CoinSelectionStore {
+			return db.WithTx(tx)
+		},
+	))
+	ctx := context.Background()
+
+	assetID := asset.RandID(t)
+	groupKey := test.RandPubKey(t)
+	now := time.Now().UTC().Truncate(time.Second)
+
+	newRecord := func(offset time.Duration,
+		candidates uint32) *tapfreighter.CoinSelectionRecord {
+
+		return &tapfreighter.CoinSelectionRecord{
+			SelectionTime:      now.Add(offset),
+			AssetID:            &assetID,
+			Strategy:           tapfreighter.PreferMaxAmount,
+			TargetAmt:          100,
+			NumCandidates:      candidates,
+			NumSelected:        2,
+			SelectedAmt:        150,
+			ChangeAmt:          50,
+			FragmentationScore: 0.25,
+		}
+	}
+
+	records := []*tapfreighter.CoinSelectionRecord{
+		newRecord(0, 3),
+		newRecord(time.Minute, 4),
+		newRecord(2*time.Minute, 5),
+	}
+
+	groupRecord := newRecord(3*time.Minute, 10)
+	groupRecord.AssetID = nil
+	groupRecord.GroupKey = groupKey
+	records = append(records, groupRecord)
+
+	for _, record := range records {
+		require.NoError(t, metrics.LogCoinSelection(ctx, record))
+		require.NotZero(t, record.ID)
+	}
+
+	// Querying by asset ID should return the records of that asset, most
+	// recent first.
+	dbRecords, err := metrics.QueryCoinSelections(
+		ctx, tapfreighter.CoinSelectionQuery{
+			AssetID: &assetID,
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, dbRecords, 3)
+	for i, dbRecord := range dbRecords {
+		assertEqualCoinSelection(t, records[2-i], dbRecord)
+	}
+
+	// The limit and start time should be respected.
+	dbRecords, err = metrics.QueryCoinSelections(
+		ctx, tapfreighter.CoinSelectionQuery{
+			From:  now.Add(time.Minute),
+			Limit: 2,
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, dbRecords, 2)
+	assertEqualCoinSelection(t, groupRecord, dbRecords[0])
+	assertEqualCoinSelection(t, records[2], dbRecords[1])
+
+	// The group key filter should only return the group record.
+	dbRecords, err = metrics.QueryCoinSelections(
+		ctx, tapfreighter.CoinSelectionQuery{
+			GroupKey: groupKey,
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, dbRecords, 1)
+	assertEqualCoinSelection(t, groupRecord, dbRecords[0])
+
+	// Finally, the latest records should contain one entry per asset.
+	dbRecords, err = metrics.LatestCoinSelections(ctx)
+	require.NoError(t, err)
+	require.Len(t, dbRecords, 2)
+	assertEqualCoinSelection(t, records[2], dbRecords[0])
+	assertEqualCoinSelection(t, groupRecord, dbRecords[1])
+}
+
+// assertEqualCoinSelection asserts that the two coin selection records are
+// equal.
+func assertEqualCoinSelection(t *testing.T, expected,
+	actual *tapfreighter.CoinSelectionRecord) {
+
+	t.Helper()
+
+	require.True(t, expected.SelectionTime.Equal(actual.SelectionTime))
+
+	expectedCopy, actualCopy := *expected, *actual
+	expectedCopy.SelectionTime = time.Time{}
+	actualCopy.SelectionTime = time.Time{}
+	require.Equal(t, expectedCopy, actualCopy)
+}
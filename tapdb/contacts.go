@@ -0,0 +1,292 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+type (
+	// NewAddrContact is used to insert or update a contact.
+	NewAddrContact = sqlc.UpsertAddrContactParams
+
+	// AddrContactSend is used to update the usage stats of a contact.
+	AddrContactSend = sqlc.RecordAddrContactSendParams
+
+	// AddrContact is a contact as stored in the database.
+	AddrContact = sqlc.AddrContact
+)
+
+// ContactStore is the set of queries needed to maintain the contact book.
+type ContactStore interface {
+	// UpsertAddrContact inserts or updates a contact, keeping the usage
+	// stats of an existing contact.
+	UpsertAddrContact(ctx context.Context, arg NewAddrContact) error
+
+	// FetchAddrContact returns the contact with the given label.
+	FetchAddrContact(ctx context.Context, label string) (AddrContact,
+		error)
+
+	// QueryAddrContacts returns all contacts, optionally limited to the
+	// given group.
+	QueryAddrContacts(ctx context.Context,
+		groupName sql.NullString) ([]AddrContact, error)
+
+	// DeleteAddrContact deletes the contact with the given label and
+	// returns the number of deleted rows.
+	DeleteAddrContact(ctx context.Context, label string) (int64, error)
+
+	// QueryAddrContactTotals returns the total amount sent to each of the
+	// contacts with the given address.
+	QueryAddrContactTotals(ctx context.Context, tapAddr string) ([]int64,
+		error)
+
+	// RecordAddrContactSend updates the usage stats of all contacts with
+	// the given address.
+	RecordAddrContactSend(ctx context.Context, arg AddrContactSend) error
+}
+
+// ContactStoreTxOptions defines the set of db txn options the ContactStore
+// understands.
+type ContactStoreTxOptions struct {
+	// readOnly governs if a read only transaction is needed or not.
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (r *ContactStoreTxOptions) ReadOnly() bool {
+	return r.readOnly
+}
+
+// NewContactStoreReadTx creates a new read transaction option set.
+func NewContactStoreReadTx() ContactStoreTxOptions {
+	return ContactStoreTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedContactStore is the main storage interface for the contact book. It
+// supports all the basic queries as well as running the set of queries in a
+// single database transaction.
+type BatchedContactStore interface {
+	ContactStore
+
+	BatchedTx[ContactStore]
+}
+
+// ContactBook is a database backed implementation of the address.ContactBook
+// interface.
+type ContactBook struct {
+	db BatchedContactStore
+
+	clock clock.Clock
+}
+
+// NewContactBook creates a new database backed contact book.
+func NewContactBook(db BatchedContactStore, clock clock.Clock) *ContactBook {
+	return &ContactBook{
+		db:    db,
+		clock: clock,
+	}
+}
+
+// UpsertContact stores the given contact. If a contact with the same label
+// already exists, its address, group and notes are replaced while its usage
+// stats are kept.
+//
+// NOTE: This is part of the address.ContactBook interface.
+func (c *ContactBook) UpsertContact(ctx context.Context,
+	contact *address.Contact) error {
+
+	if contact.Label == "" {
+		return address.ErrContactLabelEmpty
+	}
+
+	var writeTx ContactStoreTxOptions
+	return c.db.ExecTx(ctx, &writeTx, func(q ContactStore) error {
+		return q.UpsertAddrContact(ctx, NewAddrContact{
+			Label:     contact.Label,
+			TapAddr:   contact.Addr,
+			GroupName: contact.Group,
+			Notes:     contact.Notes,
+			CreatedAt: c.clock.Now().UTC(),
+		})
+	})
+}
+
+// FetchContact returns the contact with the given label or
+// address.ErrContactNotFound if it doesn't exist.
+//
+// NOTE: This is part of the address.ContactBook interface.
+func (c *ContactBook) FetchContact(ctx context.Context,
+	label string) (*address.Contact, error) {
+
+	var (
+		dbContact AddrContact
+		readTx    = NewContactStoreReadTx()
+	)
+	err := c.db.ExecTx(ctx, &readTx, func(q ContactStore) error {
+		var err error
+		dbContact, err = q.FetchAddrContact(ctx, label)
+		return err
+	})
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, fmt.Errorf("%w: %v", address.ErrContactNotFound,
+			label)
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to fetch contact: %w", err)
+	}
+
+	return unmarshalContact(dbContact), nil
+}
+
+// QueryContacts returns all contacts that match the given query, ordered by
+// their label.
+//
+// NOTE: This is part of the address.ContactBook interface.
+func (c *ContactBook) QueryContacts(ctx context.Context,
+	query address.ContactQuery) ([]*address.Contact, error) {
+
+	// An empty group is a valid filter that selects all contacts without
+	// a group, so we can't use sqlStr here.
+	var groupName sql.NullString
+	if query.Group != nil {
+		groupName = sql.NullString{
+			String: *query.Group,
+			Valid:  true,
+		}
+	}
+
+	var (
+		dbContacts []AddrContact
+		readTx     = NewContactStoreReadTx()
+	)
+	err := c.db.ExecTx(ctx, &readTx, func(q ContactStore) error {
+		var err error
+		dbContacts, err = q.QueryAddrContacts(ctx, groupName)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to query contacts: %w", err)
+	}
+
+	contacts := make([]*address.Contact, 0, len(dbContacts))
+	for _, dbContact := range dbContacts {
+		contacts = append(contacts, unmarshalContact(dbContact))
+	}
+
+	return contacts, nil
+}
+
+// DeleteContact removes the contact with the given label or returns
+// address.ErrContactNotFound if it doesn't exist.
+//
+// NOTE: This is part of the address.ContactBook interface.
+func (c *ContactBook) DeleteContact(ctx context.Context, label string) error {
+	var writeTx ContactStoreTxOptions
+	return c.db.ExecTx(ctx, &writeTx, func(q ContactStore) error {
+		numDeleted, err := q.DeleteAddrContact(ctx, label)
+		if err != nil {
+			return err
+		}
+
+		if numDeleted == 0 {
+			return fmt.Errorf("%w: %v", address.ErrContactNotFound,
+				label)
+		}
+
+		return nil
+	})
+}
+
+// RecordSends updates the usage stats of all contacts with the given addresses
+// after they were sent to in a transfer.
+//
+// NOTE: This is part of the address.ContactBook interface.
+func (c *ContactBook) RecordSends(ctx context.Context,
+	addrs []*address.Tap) error {
+
+	sendTime := c.clock.Now().UTC()
+
+	var writeTx ContactStoreTxOptions
+	return c.db.ExecTx(ctx, &writeTx, func(q ContactStore) error {
+		for _, addr := range addrs {
+			addrStr, err := addr.EncodeAddress()
+			if err != nil {
+				return fmt.Errorf("unable to encode address: "+
+					"%w", err)
+			}
+
+			// The totals are stored as signed integers. Rather than
+			// letting them overflow, we refuse to record a send
+			// that can't be accounted for.
+			if addr.Amount > math.MaxInt64 {
+				return fmt.Errorf("%w: amount %d of address %v",
+					address.ErrContactTotalOverflow,
+					addr.Amount, addrStr)
+			}
+
+			totals, err := q.QueryAddrContactTotals(ctx, addrStr)
+			if err != nil {
+				return fmt.Errorf("unable to query contact "+
+					"totals: %w", err)
+			}
+			for _, total := range totals {
+				if total > math.MaxInt64-int64(addr.Amount) {
+					return fmt.Errorf("%w: total %d plus "+
+						"amount %d of address %v",
+						address.ErrContactTotalOverflow,
+						total, addr.Amount, addrStr)
+				}
+			}
+
+			err = q.RecordAddrContactSend(ctx, AddrContactSend{
+				Amount: int64(addr.Amount),
+				SendTime: sql.NullTime{
+					Time:  sendTime,
+					Valid: true,
+				},
+				TapAddr: addrStr,
+			})
+			if err != nil {
+				return fmt.Errorf("unable to record send: %w",
+					err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// unmarshalContact converts a contact as stored in the database into its
+// address package representation.
+func unmarshalContact(dbContact AddrContact) *address.Contact {
+	contact := &address.Contact{
+		Label:     dbContact.Label,
+		Addr:      dbContact.TapAddr,
+		Group:     dbContact.GroupName,
+		Notes:     dbContact.Notes,
+		CreatedAt: dbContact.CreatedAt.UTC(),
+		TotalSent: uint64(dbContact.TotalSent),
+		NumSends:  uint64(dbContact.NumSends),
+	}
+	if dbContact.LastUsed.Valid {
+		contact.LastUsed = dbContact.LastUsed.Time.UTC()
+	}
+
+	return contact
+}
+
+// A compile-time assertion to make sure ContactBook satisfies the
+// address.ContactBook interface.
+var _ address.ContactBook = (*ContactBook)(nil)
@@ -0,0 +1,171 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestContactBook tests that contacts can be stored, queried by group and
+// deleted, and that their usage stats are updated when they are sent to.
+func TestContactBook(t *testing.T) {
+	t.Parallel()
+
+	db := NewTestDB(t)
+	now := time.Now().UTC().Truncate(time.Second)
+	contacts := NewContactBook(NewTransactionExecutor(
+		db, func(tx *sql.Tx) ContactStore {
+			return db.WithTx(tx)
+		},
+	), clock.NewTestClock(now))
+	ctx := context.Background()
+
+	proofCourierAddr := address.RandProofCourierAddr(t)
+	newAddr := func(amount uint64) (*address.Tap, string) {
+		addr, _, _ := address.RandAddr(
+			t, chainParams, proofCourierAddr,
+		)
+		addr.Amount = amount
+
+		addrStr, err := addr.EncodeAddress()
+		require.NoError(t, err)
+
+		return addr.Tap, addrStr
+	}
+
+	aliceAddr, aliceAddrStr := newAddr(1000)
+	_, bobAddrStr := newAddr(1000)
+	_, carolAddrStr := newAddr(1000)
+
+	alice, err := address.NewContact(
+		"alice", aliceAddrStr, "exchanges", "hot wallet", chainParams,
+	)
+	require.NoError(t, err)
+	bob, err := address.NewContact(
+		"bob", bobAddrStr, "exchanges", "", chainParams,
+	)
+	require.NoError(t, err)
+	carol, err := address.NewContact(
+		"carol", carolAddrStr, "", "", chainParams,
+	)
+	require.NoError(t, err)
+
+	// Invalid contacts are rejected.
+	_, err = address.NewContact("", aliceAddrStr, "", "", chainParams)
+	require.ErrorIs(t, err, address.ErrContactLabelEmpty)
+	_, err = address.NewContact("dave", "tapbc1xyz", "", "", chainParams)
+	require.Error(t, err)
+
+	for _, contact := range []*address.Contact{alice, bob, carol} {
+		require.NoError(t, contacts.UpsertContact(ctx, contact))
+	}
+
+	dbAlice, err := contacts.FetchContact(ctx, "alice")
+	require.NoError(t, err)
+	require.Equal(t, aliceAddrStr, dbAlice.Addr)
+	require.Equal(t, "exchanges", dbAlice.Group)
+	require.Equal(t, "hot wallet", dbAlice.Notes)
+	require.Equal(t, now, dbAlice.CreatedAt)
+	require.True(t, dbAlice.LastUsed.IsZero())
+
+	_, err = contacts.FetchContact(ctx, "dave")
+	require.ErrorIs(t, err, address.ErrContactNotFound)
+
+	labels := func(query address.ContactQuery) []string {
+		result, err := contacts.QueryContacts(ctx, query)
+		require.NoError(t, err)
+
+		return fn.Map(result, func(c *address.Contact) string {
+			return c.Label
+		})
+	}
+	require.Equal(
+		t, []string{"alice", "bob", "carol"},
+		labels(address.ContactQuery{}),
+	)
+	require.Equal(
+		t, []string{"alice", "bob"},
+		labels(address.ContactQuery{Group: fn.Ptr("exchanges")}),
+	)
+	require.Equal(
+		t, []string{"carol"},
+		labels(address.ContactQuery{Group: fn.Ptr("")}),
+	)
+
+	// Sending to an address updates the usage stats of the contact, other
+	// addresses are ignored.
+	otherAddr, _ := newAddr(1000)
+	require.NoError(t, contacts.RecordSends(
+		ctx, []*address.Tap{aliceAddr, otherAddr},
+	))
+	require.NoError(t, contacts.RecordSends(
+		ctx, []*address.Tap{aliceAddr},
+	))
+
+	dbAlice, err = contacts.FetchContact(ctx, "alice")
+	require.NoError(t, err)
+	require.Equal(t, now, dbAlice.LastUsed)
+	require.EqualValues(t, 2, dbAlice.NumSends)
+	require.Equal(t, 2*aliceAddr.Amount, dbAlice.TotalSent)
+
+	// Updating a contact keeps its usage stats.
+	alice.Notes = "cold wallet"
+	require.NoError(t, contacts.UpsertContact(ctx, alice))
+
+	dbAlice, err = contacts.FetchContact(ctx, "alice")
+	require.NoError(t, err)
+	require.Equal(t, "cold wallet", dbAlice.Notes)
+	require.EqualValues(t, 2, dbAlice.NumSends)
+
+	// A send that would overflow the total of a contact is refused, and
+	// none of the sends of the transfer are recorded.
+	daveAddr, daveAddrStr := newAddr(math.MaxInt64)
+	dave, err := address.NewContact(
+		"dave", daveAddrStr, "", "", chainParams,
+	)
+	require.NoError(t, err)
+	require.NoError(t, contacts.UpsertContact(ctx, dave))
+
+	require.NoError(t, contacts.RecordSends(
+		ctx, []*address.Tap{daveAddr},
+	))
+	err = contacts.RecordSends(
+		ctx, []*address.Tap{aliceAddr, daveAddr},
+	)
+	require.ErrorIs(t, err, address.ErrContactTotalOverflow)
+
+	dbAlice, err = contacts.FetchContact(ctx, "alice")
+	require.NoError(t, err)
+	require.EqualValues(t, 2, dbAlice.NumSends)
+
+	dbDave, err := contacts.FetchContact(ctx, "dave")
+	require.NoError(t, err)
+	require.EqualValues(t, math.MaxInt64, dbDave.TotalSent)
+	require.EqualValues(t, 1, dbDave.NumSends)
+
+	hugeAddr, hugeAddrStr := newAddr(math.MaxInt64 + 1)
+	huge, err := address.NewContact(
+		"erin", hugeAddrStr, "", "", chainParams,
+	)
+	require.NoError(t, err)
+	require.NoError(t, contacts.UpsertContact(ctx, huge))
+
+	err = contacts.RecordSends(ctx, []*address.Tap{hugeAddr})
+	require.ErrorIs(t, err, address.ErrContactTotalOverflow)
+	require.NoError(t, contacts.DeleteContact(ctx, "dave"))
+	require.NoError(t, contacts.DeleteContact(ctx, "erin"))
+
+	require.NoError(t, contacts.DeleteContact(ctx, "bob"))
+	err = contacts.DeleteContact(ctx, "bob")
+	require.ErrorIs(t, err, address.ErrContactNotFound)
+	require.Equal(
+		t, []string{"alice", "carol"}, labels(address.ContactQuery{}),
+	)
+}
@@ -0,0 +1,441 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+type (
+	// NewFederationProfile is used to insert or update a federation
+	// profile.
+	NewFederationProfile = sqlc.UpsertFederationProfileParams
+
+	// FederationProfile is a federation profile as stored in the database.
+	FederationProfile = sqlc.FederationProfile
+
+	// NewFederationProfileServer is used to add a universe server to a
+	// federation profile.
+	NewFederationProfileServer = sqlc.InsertFederationProfileServerParams
+
+	// NewFedProfileSyncConfig is used to add a sync config to a federation
+	// profile.
+	NewFedProfileSyncConfig = sqlc.InsertFederationProfileSyncConfigParams
+
+	// FedProfileSyncConfig is a sync config of a federation profile as
+	// stored in the database.
+	FedProfileSyncConfig = sqlc.FetchFederationProfileSyncConfigsRow
+)
+
+// FederationProfileStore is the set of queries needed to persist named
+// federation profiles.
+type FederationProfileStore interface {
+	// UpsertFederationProfile inserts or updates a federation profile and
+	// returns its primary key.
+	UpsertFederationProfile(ctx context.Context,
+		arg NewFederationProfile) (int64, error)
+
+	// InsertFederationProfileServer adds a universe server to a profile.
+	InsertFederationProfileServer(ctx context.Context,
+		arg NewFederationProfileServer) error
+
+	// DeleteFederationProfileServers removes all universe servers of a
+	// profile.
+	DeleteFederationProfileServers(ctx context.Context,
+		profileID int64) error
+
+	// InsertFederationProfileSyncConfig adds a sync config to a profile.
+	InsertFederationProfileSyncConfig(ctx context.Context,
+		arg NewFedProfileSyncConfig) error
+
+	// DeleteFederationProfileSyncConfigs removes all sync configs of a
+	// profile.
+	DeleteFederationProfileSyncConfigs(ctx context.Context,
+		profileID int64) error
+
+	// FetchFederationProfile fetches the profile with the given name.
+	FetchFederationProfile(ctx context.Context,
+		name string) (FederationProfile, error)
+
+	// FetchFederationProfiles fetches all profiles, sorted by name.
+	FetchFederationProfiles(ctx context.Context) ([]FederationProfile,
+		error)
+
+	// FetchFederationProfileServers fetches the universe servers of a
+	// profile.
+	FetchFederationProfileServers(ctx context.Context,
+		profileID int64) ([]string, error)
+
+	// FetchFederationProfileSyncConfigs fetches the sync configs of a
+	// profile.
+	FetchFederationProfileSyncConfigs(ctx context.Context,
+		profileID int64) ([]FedProfileSyncConfig, error)
+
+	// DeleteFederationProfile deletes the profile with the given name.
+	DeleteFederationProfile(ctx context.Context, name string) error
+
+	// SetActiveFederationProfile marks the profile with the given name as
+	// active and all other profiles as inactive.
+	SetActiveFederationProfile(ctx context.Context, name string) error
+}
+
+// FederationProfileTxOptions defines the set of db txn options the
+// FederationProfileStore understands.
+type FederationProfileTxOptions struct {
+	// readOnly governs if a read only transaction is needed or not.
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (f *FederationProfileTxOptions) ReadOnly() bool {
+	return f.readOnly
+}
+
+// NewFederationProfileReadTx creates a new read transaction option set.
+func NewFederationProfileReadTx() FederationProfileTxOptions {
+	return FederationProfileTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedFederationProfileStore is the main storage interface for federation
+// profiles. It supports all the basic queries as well as running the set of
+// queries in a single database transaction.
+type BatchedFederationProfileStore interface {
+	FederationProfileStore
+
+	BatchedTx[FederationProfileStore]
+}
+
+// FederationProfiles is a database backed implementation of the
+// universe.FederationProfileStore interface.
+type FederationProfiles struct {
+	db BatchedFederationProfileStore
+
+	clock clock.Clock
+}
+
+// NewFederationProfiles creates a new database backed federation profile
+// store.
+func NewFederationProfiles(db BatchedFederationProfileStore,
+	clock clock.Clock) *FederationProfiles {
+
+	return &FederationProfiles{
+		db:    db,
+		clock: clock,
+	}
+}
+
+// StoreFederationProfile stores the given profile, replacing any existing
+// profile with the same name.
+//
+// NOTE: This is part of the universe.FederationProfileStore interface.
+func (f *FederationProfiles) StoreFederationProfile(ctx context.Context,
+	profile universe.FederationProfile) error {
+
+	if profile.Name == "" {
+		return fmt.Errorf("federation profile name must be set")
+	}
+
+	var writeTx FederationProfileTxOptions
+	return f.db.ExecTx(ctx, &writeTx, func(q FederationProfileStore) error {
+		profileID, err := q.UpsertFederationProfile(
+			ctx, NewFederationProfile{
+				Name:      profile.Name,
+				UpdatedAt: f.clock.Now().UTC(),
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to upsert federation "+
+				"profile: %w", err)
+		}
+
+		// We replace the full content of an existing profile, so we
+		// start by removing all of its servers and configs.
+		err = q.DeleteFederationProfileServers(ctx, profileID)
+		if err != nil {
+			return err
+		}
+		err = q.DeleteFederationProfileSyncConfigs(ctx, profileID)
+		if err != nil {
+			return err
+		}
+
+		seenServers := make(map[string]struct{}, len(profile.Servers))
+		for _, server := range profile.Servers {
+			host := server.HostStr()
+			if _, ok := seenServers[host]; ok {
+				continue
+			}
+			seenServers[host] = struct{}{}
+
+			err := q.InsertFederationProfileServer(
+				ctx, NewFederationProfileServer{
+					ProfileID:  profileID,
+					ServerHost: host,
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("unable to insert profile "+
+					"server: %w", err)
+			}
+		}
+
+		// Global configs are stored without an asset ID or group key.
+		for _, config := range profile.GlobalSyncConfigs {
+			newConfig := NewFedProfileSyncConfig{
+				ProfileID:       profileID,
+				ProofType:       config.ProofType.String(),
+				AllowSyncInsert: config.AllowSyncInsert,
+				AllowSyncExport: config.AllowSyncExport,
+			}
+			err := q.InsertFederationProfileSyncConfig(
+				ctx, newConfig,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to insert profile "+
+					"sync config: %w", err)
+			}
+		}
+
+		for _, config := range profile.UniSyncConfigs {
+			uniID := config.UniverseID
+			newConfig := NewFedProfileSyncConfig{
+				ProfileID:       profileID,
+				ProofType:       uniID.ProofType.String(),
+				AllowSyncInsert: config.AllowSyncInsert,
+				AllowSyncExport: config.AllowSyncExport,
+			}
+
+			// The group key supersedes the asset ID.
+			if uniID.GroupKey != nil {
+				newConfig.GroupKey =
+					uniID.GroupKey.SerializeCompressed()
+			} else {
+				newConfig.AssetID = uniID.AssetID[:]
+			}
+
+			err := q.InsertFederationProfileSyncConfig(
+				ctx, newConfig,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to insert profile "+
+					"sync config: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// FetchFederationProfile returns the profile with the given name. If no such
+// profile exists, universe.ErrNoFederationProfile is returned.
+//
+// NOTE: This is part of the universe.FederationProfileStore interface.
+func (f *FederationProfiles) FetchFederationProfile(ctx context.Context,
+	name string) (*universe.FederationProfile, error) {
+
+	var (
+		profile *universe.FederationProfile
+		readTx  = NewFederationProfileReadTx()
+	)
+	err := f.db.ExecTx(ctx, &readTx, func(q FederationProfileStore) error {
+		dbProfile, err := q.FetchFederationProfile(ctx, name)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return universe.ErrNoFederationProfile
+
+		case err != nil:
+			return fmt.Errorf("unable to fetch federation "+
+				"profile: %w", err)
+		}
+
+		profile, err = fetchFederationProfile(ctx, q, dbProfile)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+// ListFederationProfiles returns all stored profiles, sorted by name.
+//
+// NOTE: This is part of the universe.FederationProfileStore interface.
+func (f *FederationProfiles) ListFederationProfiles(
+	ctx context.Context) ([]*universe.FederationProfile, error) {
+
+	var (
+		profiles []*universe.FederationProfile
+		readTx   = NewFederationProfileReadTx()
+	)
+	err := f.db.ExecTx(ctx, &readTx, func(q FederationProfileStore) error {
+		dbProfiles, err := q.FetchFederationProfiles(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to fetch federation "+
+				"profiles: %w", err)
+		}
+
+		profiles = make(
+			[]*universe.FederationProfile, 0, len(dbProfiles),
+		)
+		for _, dbProfile := range dbProfiles {
+			profile, err := fetchFederationProfile(
+				ctx, q, dbProfile,
+			)
+			if err != nil {
+				return err
+			}
+
+			profiles = append(profiles, profile)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+// DeleteFederationProfile deletes the profile with the given name. If no such
+// profile exists, universe.ErrNoFederationProfile is returned.
+//
+// NOTE: This is part of the universe.FederationProfileStore interface.
+func (f *FederationProfiles) DeleteFederationProfile(ctx context.Context,
+	name string) error {
+
+	var writeTx FederationProfileTxOptions
+	return f.db.ExecTx(ctx, &writeTx, func(q FederationProfileStore) error {
+		err := assertFederationProfileExists(ctx, q, name)
+		if err != nil {
+			return err
+		}
+
+		return q.DeleteFederationProfile(ctx, name)
+	})
+}
+
+// SetActiveFederationProfile marks the profile with the given name as the
+// active one. If no such profile exists, universe.ErrNoFederationProfile is
+// returned.
+//
+// NOTE: This is part of the universe.FederationProfileStore interface.
+func (f *FederationProfiles) SetActiveFederationProfile(ctx context.Context,
+	name string) error {
+
+	var writeTx FederationProfileTxOptions
+	return f.db.ExecTx(ctx, &writeTx, func(q FederationProfileStore) error {
+		err := assertFederationProfileExists(ctx, q, name)
+		if err != nil {
+			return err
+		}
+
+		return q.SetActiveFederationProfile(ctx, name)
+	})
+}
+
+// assertFederationProfileExists returns universe.ErrNoFederationProfile if no
+// profile with the given name exists.
+func assertFederationProfileExists(ctx context.Context,
+	q FederationProfileStore, name string) error {
+
+	_, err := q.FetchFederationProfile(ctx, name)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return universe.ErrNoFederationProfile
+
+	case err != nil:
+		return fmt.Errorf("unable to fetch federation profile: %w", err)
+	}
+
+	return nil
+}
+
+// fetchFederationProfile fetches the servers and sync configs of the given
+// database profile.
+func fetchFederationProfile(ctx context.Context, q FederationProfileStore,
+	dbProfile FederationProfile) (*universe.FederationProfile, error) {
+
+	hosts, err := q.FetchFederationProfileServers(ctx, dbProfile.ID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch profile servers: %w",
+			err)
+	}
+
+	dbConfigs, err := q.FetchFederationProfileSyncConfigs(
+		ctx, dbProfile.ID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch profile sync "+
+			"configs: %w", err)
+	}
+
+	profile := &universe.FederationProfile{
+		Name:    dbProfile.Name,
+		Servers: fn.Map(hosts, universe.NewServerAddrFromStr),
+		Active:  dbProfile.IsActive,
+	}
+	for _, dbConfig := range dbConfigs {
+		proofType, err := universe.ParseStrProofType(
+			dbConfig.ProofType,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// Configs without an asset ID or group key are global configs.
+		if dbConfig.AssetID == nil && dbConfig.GroupKey == nil {
+			globalConfig := &universe.FedGlobalSyncConfig{
+				ProofType:       proofType,
+				AllowSyncInsert: dbConfig.AllowSyncInsert,
+				AllowSyncExport: dbConfig.AllowSyncExport,
+			}
+			profile.GlobalSyncConfigs = append(
+				profile.GlobalSyncConfigs, globalConfig,
+			)
+
+			continue
+		}
+
+		uniID := universe.Identifier{
+			ProofType: proofType,
+		}
+		if dbConfig.GroupKey != nil {
+			uniID.GroupKey, err = btcec.ParsePubKey(
+				dbConfig.GroupKey,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse group "+
+					"key: %w", err)
+			}
+		} else {
+			copy(uniID.AssetID[:], dbConfig.AssetID)
+		}
+
+		profile.UniSyncConfigs = append(
+			profile.UniSyncConfigs, &universe.FedUniSyncConfig{
+				UniverseID:      uniID,
+				AllowSyncInsert: dbConfig.AllowSyncInsert,
+				AllowSyncExport: dbConfig.AllowSyncExport,
+			},
+		)
+	}
+
+	return profile, nil
+}
+
+// A compile-time assertion to make sure FederationProfiles satisfies the
+// universe.FederationProfileStore interface.
+var _ universe.FederationProfileStore = (*FederationProfiles)(nil)
@@ -0,0 +1,134 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestFederationProfiles(t *testing.T) *FederationProfiles {
+	db := NewTestDB(t)
+
+	dbTxer := NewTransactionExecutor(db,
+		func(tx *sql.Tx) FederationProfileStore {
+			return db.WithTx(tx)
+		},
+	)
+
+	testClock := clock.NewTestClock(time.Unix(1700000000, 0))
+
+	return NewFederationProfiles(dbTxer, testClock)
+}
+
+// TestFederationProfiles tests that federation profiles can be stored,
+// replaced, activated and deleted.
+func TestFederationProfiles(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = context.Background()
+		profiles = newTestFederationProfiles(t)
+		server1  = universe.NewServerAddrFromStr("server1:10029")
+		server2  = universe.NewServerAddrFromStr("server2:10029")
+		server3  = universe.NewServerAddrFromStr("server3:10029")
+	)
+
+	// Without any stored profile, we should get the proper error.
+	_, err := profiles.FetchFederationProfile(ctx, "public")
+	require.ErrorIs(t, err, universe.ErrNoFederationProfile)
+	require.ErrorIs(
+		t, profiles.SetActiveFederationProfile(ctx, "public"),
+		universe.ErrNoFederationProfile,
+	)
+
+	public := universe.FederationProfile{
+		Name:    "public",
+		Servers: []universe.ServerAddr{server1, server2},
+		GlobalSyncConfigs: []*universe.FedGlobalSyncConfig{{
+			ProofType:       universe.ProofTypeIssuance,
+			AllowSyncExport: true,
+		}, {
+			ProofType:       universe.ProofTypeTransfer,
+			AllowSyncInsert: true,
+			AllowSyncExport: true,
+		}},
+	}
+
+	// The group key of a universe supersedes its asset ID, so only one of
+	// them is stored.
+	assetUniID := randUniverseID(t, false)
+	assetUniID.GroupKey = nil
+	groupUniID := randUniverseID(t, true)
+	groupUniID.AssetID = asset.ID{}
+
+	private := universe.FederationProfile{
+		Name:    "private",
+		Servers: []universe.ServerAddr{server3},
+		UniSyncConfigs: []*universe.FedUniSyncConfig{{
+			UniverseID:      assetUniID,
+			AllowSyncInsert: true,
+		}, {
+			UniverseID:      groupUniID,
+			AllowSyncInsert: true,
+			AllowSyncExport: true,
+		}},
+	}
+	require.NoError(t, profiles.StoreFederationProfile(ctx, public))
+	require.NoError(t, profiles.StoreFederationProfile(ctx, private))
+
+	assertProfile := func(expected universe.FederationProfile) {
+		t.Helper()
+
+		profile, err := profiles.FetchFederationProfile(
+			ctx, expected.Name,
+		)
+		require.NoError(t, err)
+		require.Equal(t, expected, *profile)
+	}
+
+	assertProfile(public)
+	assertProfile(private)
+
+	// The profiles are listed in alphabetical order.
+	allProfiles, err := profiles.ListFederationProfiles(ctx)
+	require.NoError(t, err)
+	require.Len(t, allProfiles, 2)
+	require.Equal(t, private, *allProfiles[0])
+	require.Equal(t, public, *allProfiles[1])
+
+	// Only a single profile can be active at a time.
+	require.NoError(t, profiles.SetActiveFederationProfile(ctx, "public"))
+	public.Active = true
+	assertProfile(public)
+	assertProfile(private)
+
+	require.NoError(t, profiles.SetActiveFederationProfile(ctx, "private"))
+	public.Active = false
+	private.Active = true
+	assertProfile(public)
+	assertProfile(private)
+
+	// Storing a profile with an existing name replaces its servers and
+	// configs, but keeps it active.
+	private.Servers = []universe.ServerAddr{server1, server3}
+	private.UniSyncConfigs = private.UniSyncConfigs[1:]
+	require.NoError(t, profiles.StoreFederationProfile(ctx, private))
+	assertProfile(private)
+
+	// Finally, a deleted profile can't be fetched anymore.
+	require.NoError(t, profiles.DeleteFederationProfile(ctx, "private"))
+	_, err = profiles.FetchFederationProfile(ctx, "private")
+	require.ErrorIs(t, err, universe.ErrNoFederationProfile)
+	require.ErrorIs(
+		t, profiles.DeleteFederationProfile(ctx, "private"),
+		universe.ErrNoFederationProfile,
+	)
+
+	assertProfile(public)
+}
@@ -0,0 +1,181 @@
+package tapdb
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+)
+
+// GroupMemberSummary is the summary of a single asset ID within an asset
+// group.
+type GroupMemberSummary struct {
+	// ID is the asset ID of the group member.
+	ID asset.ID
+
+	// Tag is the human-readable tag of the group member.
+	Tag string
+
+	// Type is the type of the group member.
+	Type asset.Type
+
+	// Balance is the sum of the amounts of all unspent assets of the group
+	// member, including leased ones.
+	Balance uint64
+
+	// NumUtxos is the number of anchor outputs that hold unspent assets of
+	// the group member.
+	NumUtxos int
+
+	// NumTransfers is the number of outbound transfers that spent assets
+	// of the group member.
+	NumTransfers int
+}
+
+// GroupUtxo is an anchor output that holds unspent assets of an asset group.
+type GroupUtxo struct {
+	// OutPoint is the anchor output.
+	OutPoint wire.OutPoint
+
+	// Balance is the sum of the amounts of all group assets held by the
+	// anchor output.
+	Balance uint64
+
+	// MemberBalances is the per asset ID breakdown of the balance.
+	MemberBalances map[asset.ID]uint64
+}
+
+// GroupSummary aggregates the balance, the UTXOs and the transfer history of
+// all asset IDs within an asset group.
+type GroupSummary struct {
+	// GroupKey is the tweaked group key of the asset group.
+	GroupKey *btcec.PublicKey
+
+	// Balance is the sum of the balances of all group members.
+	Balance uint64
+
+	// Members is the per asset ID breakdown of the group. Members that
+	// were fully spent are included with a zero balance.
+	Members map[asset.ID]*GroupMemberSummary
+
+	// Utxos is the set of anchor outputs that hold unspent group assets,
+	// sorted by outpoint.
+	Utxos []*GroupUtxo
+
+	// Transfers is the set of outbound transfers that spent at least one
+	// group asset.
+	Transfers []*tapfreighter.OutboundParcel
+}
+
+// QueryGroupSummary aggregates the balances, the UTXOs and the outbound
+// transfers of all asset IDs within the asset group with the given group key.
+func (a *AssetStore) QueryGroupSummary(ctx context.Context,
+	groupKey *btcec.PublicKey) (*GroupSummary, error) {
+
+	if groupKey == nil {
+		return nil, fmt.Errorf("group key must be set")
+	}
+
+	// We include spent assets, so members that were fully spent are still
+	// known when we look at the transfer history.
+	filter := &AssetQueryFilters{
+		CommitmentConstraints: tapfreighter.CommitmentConstraints{
+			GroupKey: groupKey,
+		},
+	}
+	groupAssets, err := a.FetchAllAssets(ctx, true, true, filter)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch group assets: %w", err)
+	}
+
+	summary := &GroupSummary{
+		GroupKey: groupKey,
+		Members:  make(map[asset.ID]*GroupMemberSummary),
+	}
+	utxos := make(map[wire.OutPoint]*GroupUtxo)
+	memberUtxos := make(map[asset.ID]map[wire.OutPoint]struct{})
+	for _, groupAsset := range groupAssets {
+		assetID := groupAsset.ID()
+
+		member, ok := summary.Members[assetID]
+		if !ok {
+			member = &GroupMemberSummary{
+				ID:   assetID,
+				Tag:  groupAsset.Genesis.Tag,
+				Type: groupAsset.Type,
+			}
+			summary.Members[assetID] = member
+			memberUtxos[assetID] = make(map[wire.OutPoint]struct{})
+		}
+
+		if groupAsset.IsSpent {
+			continue
+		}
+
+		member.Balance += groupAsset.Amount
+		summary.Balance += groupAsset.Amount
+
+		anchorPoint := groupAsset.AnchorOutpoint
+		utxo, ok := utxos[anchorPoint]
+		if !ok {
+			utxo = &GroupUtxo{
+				OutPoint:       anchorPoint,
+				MemberBalances: make(map[asset.ID]uint64),
+			}
+			utxos[anchorPoint] = utxo
+		}
+		utxo.Balance += groupAsset.Amount
+		utxo.MemberBalances[assetID] += groupAsset.Amount
+
+		memberUtxos[assetID][anchorPoint] = struct{}{}
+	}
+
+	for assetID, anchorPoints := range memberUtxos {
+		summary.Members[assetID].NumUtxos = len(anchorPoints)
+	}
+
+	summary.Utxos = make([]*GroupUtxo, 0, len(utxos))
+	for _, utxo := range utxos {
+		summary.Utxos = append(summary.Utxos, utxo)
+	}
+	sort.Slice(summary.Utxos, func(i, j int) bool {
+		opI, opJ := summary.Utxos[i].OutPoint, summary.Utxos[j].OutPoint
+		if c := bytes.Compare(opI.Hash[:], opJ.Hash[:]); c != 0 {
+			return c < 0
+		}
+
+		return opI.Index < opJ.Index
+	})
+
+	// Finally, we collect all outbound transfers that spent at least one
+	// asset of the group.
+	parcels, err := a.QueryParcels(ctx, nil, false)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query transfers: %w", err)
+	}
+
+	for _, parcel := range parcels {
+		spentMembers := make(map[asset.ID]struct{})
+		for _, input := range parcel.Inputs {
+			if _, ok := summary.Members[input.ID]; ok {
+				spentMembers[input.ID] = struct{}{}
+			}
+		}
+
+		if len(spentMembers) == 0 {
+			continue
+		}
+
+		for assetID := range spentMembers {
+			summary.Members[assetID].NumTransfers++
+		}
+		summary.Transfers = append(summary.Transfers, parcel)
+	}
+
+	return summary, nil
+}
@@ -0,0 +1,109 @@
+package tapdb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/stretchr/testify/require"
+)
+
+// TestQueryGroupSummary tests that the balances and UTXOs of all asset IDs
+// within an asset group are aggregated correctly.
+func TestQueryGroupSummary(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	assetGen := newAssetGenerator(t, 5, 2)
+
+	// The second and third assets are reissuances into the group of the
+	// first asset.
+	groupAnchorGen := assetGen.assetGens[0]
+	groupAnchorGen.FirstPrevOut = assetGen.anchorPoints[0]
+
+	assetGen.genAssets(t, assetsStore, []assetDesc{{
+		assetGen:    assetGen.assetGens[0],
+		anchorPoint: assetGen.anchorPoints[0],
+		keyGroup:    assetGen.groupKeys[0],
+		amt:         100,
+	}, {
+		assetGen:       assetGen.assetGens[1],
+		groupAnchorGen: &groupAnchorGen,
+		anchorPoint:    assetGen.anchorPoints[0],
+		keyGroup:       assetGen.groupKeys[0],
+		amt:            20,
+	}, {
+		assetGen:       assetGen.assetGens[2],
+		groupAnchorGen: &groupAnchorGen,
+		anchorPoint:    assetGen.anchorPoints[1],
+		keyGroup:       assetGen.groupKeys[0],
+		amt:            30,
+		spent:          true,
+	}, {
+		assetGen:    assetGen.assetGens[3],
+		anchorPoint: assetGen.anchorPoints[2],
+		keyGroup:    assetGen.groupKeys[1],
+		amt:         7,
+	}, {
+		assetGen:    assetGen.assetGens[4],
+		anchorPoint: assetGen.anchorPoints[0],
+		noGroupKey:  true,
+		amt:         8,
+	}})
+
+	balances, err := assetsStore.QueryAssetBalancesByGroup(ctx, nil)
+	require.NoError(t, err)
+
+	// Find the group key of the first group, by looking for the group
+	// with the aggregated balance of its unspent assets.
+	var groupKey asset.SerializedKey
+	for key, balance := range balances {
+		if balance.Balance == 120 {
+			groupKey = key
+		}
+	}
+	require.NotEqual(t, asset.SerializedKey{}, groupKey)
+
+	summary, err := assetsStore.QueryGroupSummary(
+		ctx, balances[groupKey].GroupKey,
+	)
+	require.NoError(t, err)
+
+	require.EqualValues(t, 120, summary.Balance)
+	require.Len(t, summary.Members, 3)
+	require.Empty(t, summary.Transfers)
+
+	// The asset IDs are derived while generating the assets, so we look
+	// them up by their tags.
+	memberIDs := make(map[string]asset.ID, len(summary.Members))
+	for id, member := range summary.Members {
+		require.Equal(t, id, member.ID)
+		memberIDs[member.Tag] = id
+	}
+	id1 := memberIDs[assetGen.assetGens[0].Tag]
+	id2 := memberIDs[assetGen.assetGens[1].Tag]
+	id3 := memberIDs[assetGen.assetGens[2].Tag]
+
+	require.EqualValues(t, 100, summary.Members[id1].Balance)
+	require.Equal(t, 1, summary.Members[id1].NumUtxos)
+	require.EqualValues(t, 20, summary.Members[id2].Balance)
+	require.Equal(t, 1, summary.Members[id2].NumUtxos)
+
+	// The fully spent member is still part of the summary, but without a
+	// balance.
+	require.Zero(t, summary.Members[id3].Balance)
+	require.Zero(t, summary.Members[id3].NumUtxos)
+
+	// Both unspent members share the same anchor output, which shouldn't
+	// include the ungrouped asset.
+	require.Len(t, summary.Utxos, 1)
+	utxo := summary.Utxos[0]
+	require.Equal(t, assetGen.anchorPoints[0], utxo.OutPoint)
+	require.EqualValues(t, 120, utxo.Balance)
+	require.Equal(t, map[asset.ID]uint64{
+		id1: 100,
+		id2: 20,
+	}, utxo.MemberBalances)
+}
@@ -0,0 +1,284 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+)
+
+const (
+	// CheckOrphanedUniverseLeaves is the name of the integrity check that
+	// counts universe leaves without a corresponding MS-SMT leaf node.
+	CheckOrphanedUniverseLeaves = "orphaned_universe_leaves"
+
+	// CheckTransfersWithoutOutputs is the name of the integrity check that
+	// counts transfers without any outputs.
+	CheckTransfersWithoutOutputs = "transfers_without_outputs"
+
+	// CheckTransfersWithoutInputs is the name of the integrity check that
+	// counts transfers without any inputs.
+	CheckTransfersWithoutInputs = "transfers_without_inputs"
+)
+
+// TableRowCounts is the number of rows of the key tables of the database.
+type TableRowCounts = sqlc.QueryTableRowCountsRow
+
+// HealthStore is the set of queries needed to check the health of the
+// database.
+type HealthStore interface {
+	// QueryTableRowCounts returns the number of rows of the key tables.
+	QueryTableRowCounts(ctx context.Context) (TableRowCounts, error)
+
+	// CountOrphanedUniverseLeaves returns the number of universe leaves
+	// without a corresponding MS-SMT leaf node.
+	CountOrphanedUniverseLeaves(ctx context.Context) (int64, error)
+
+	// CountTransfersWithoutOutputs returns the number of transfers without
+	// any outputs.
+	CountTransfersWithoutOutputs(ctx context.Context) (int64, error)
+
+	// CountTransfersWithoutInputs returns the number of transfers without
+	// any inputs.
+	CountTransfersWithoutInputs(ctx context.Context) (int64, error)
+}
+
+// HealthStoreTxOptions defines the set of db txn options the HealthStore
+// understands.
+type HealthStoreTxOptions struct {
+	// readOnly governs if a read only transaction is needed or not.
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (r *HealthStoreTxOptions) ReadOnly() bool {
+	return r.readOnly
+}
+
+// NewHealthStoreReadTx creates a new read transaction option set.
+func NewHealthStoreReadTx() HealthStoreTxOptions {
+	return HealthStoreTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedHealthStore supports all the health queries as well as running them
+// in a single database transaction.
+type BatchedHealthStore interface {
+	HealthStore
+
+	BatchedTx[HealthStore]
+}
+
+// SchemaVersioner reports the version of the database schema.
+type SchemaVersioner interface {
+	// SchemaVersion returns the version of the last applied migration and
+	// whether that migration failed halfway through.
+	SchemaVersion(ctx context.Context) (int64, bool, error)
+}
+
+// SchemaVersion returns the version of the last applied migration and whether
+// that migration failed halfway through, leaving the database dirty. If no
+// migration was ever applied, version -1 is returned.
+//
+// NOTE: The migration table is maintained by the migration library and isn't
+// part of the sqlc schema, so we query it directly.
+func (s *BaseDB) SchemaVersion(ctx context.Context) (int64, bool, error) {
+	var (
+		version int64
+		dirty   bool
+	)
+	err := s.DB.QueryRowContext(
+		ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1",
+	).Scan(&version, &dirty)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return -1, false, nil
+
+	case err != nil:
+		return 0, false, fmt.Errorf("unable to query schema version: "+
+			"%w", err)
+	}
+
+	return version, dirty, nil
+}
+
+// IntegrityCheck is the result of a single referential integrity check.
+type IntegrityCheck struct {
+	// Name is the name of the check.
+	Name string
+
+	// Violations is the number of rows that violate the check.
+	Violations int64
+}
+
+// HealthReport describes the schema version and the integrity of the
+// database.
+type HealthReport struct {
+	// SchemaVersion is the version of the last applied migration, or -1 if
+	// no migration was applied yet.
+	SchemaVersion int64
+
+	// Dirty is true if the last migration failed halfway through.
+	Dirty bool
+
+	// LatestVersion is the latest migration version known to this
+	// daemon.
+	LatestVersion uint
+
+	// PendingMigrations are the versions of the migrations that are known
+	// to this daemon but weren't applied yet.
+	PendingMigrations []uint
+
+	// RowCounts is the number of rows of the key tables.
+	RowCounts TableRowCounts
+
+	// IntegrityChecks are the results of the referential integrity
+	// checks.
+	IntegrityChecks []IntegrityCheck
+}
+
+// Healthy returns true if all migrations were applied cleanly and none of the
+// integrity checks found any violations.
+func (h *HealthReport) Healthy() bool {
+	if h.Dirty || len(h.PendingMigrations) > 0 {
+		return false
+	}
+
+	for _, check := range h.IntegrityChecks {
+		if check.Violations > 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DatabaseHealth reports the schema version and the integrity of the
+// database.
+type DatabaseHealth struct {
+	db BatchedHealthStore
+
+	versions SchemaVersioner
+}
+
+// NewDatabaseHealth creates a new database health reporter.
+func NewDatabaseHealth(db BatchedHealthStore,
+	versions SchemaVersioner) *DatabaseHealth {
+
+	return &DatabaseHealth{
+		db:       db,
+		versions: versions,
+	}
+}
+
+// Report returns the schema version, the pending migrations, the row counts
+// of the key tables and the results of the integrity checks of the database.
+func (d *DatabaseHealth) Report(ctx context.Context) (*HealthReport, error) {
+	version, dirty, err := d.versions.SchemaVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	knownVersions, err := migrationVersions(sqlSchemas)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &HealthReport{
+		SchemaVersion: version,
+		Dirty:         dirty,
+		LatestVersion: LatestMigrationVersion,
+	}
+	for _, known := range knownVersions {
+		if int64(known) > version && known <= LatestMigrationVersion {
+			report.PendingMigrations = append(
+				report.PendingMigrations, known,
+			)
+		}
+	}
+
+	readTx := NewHealthStoreReadTx()
+	err = d.db.ExecTx(ctx, &readTx, func(q HealthStore) error {
+		rowCounts, err := q.QueryTableRowCounts(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to count rows: %w", err)
+		}
+		report.RowCounts = rowCounts
+
+		checks := []struct {
+			name  string
+			count func(context.Context) (int64, error)
+		}{{
+			name:  CheckOrphanedUniverseLeaves,
+			count: q.CountOrphanedUniverseLeaves,
+		}, {
+			name:  CheckTransfersWithoutOutputs,
+			count: q.CountTransfersWithoutOutputs,
+		}, {
+			name:  CheckTransfersWithoutInputs,
+			count: q.CountTransfersWithoutInputs,
+		}}
+
+		report.IntegrityChecks = make([]IntegrityCheck, len(checks))
+		for idx, check := range checks {
+			violations, err := check.count(ctx)
+			if err != nil {
+				return fmt.Errorf("unable to run integrity "+
+					"check %v: %w", check.name, err)
+			}
+
+			report.IntegrityChecks[idx] = IntegrityCheck{
+				Name:       check.name,
+				Violations: violations,
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// migrationVersions returns the sorted versions of the up migrations in the
+// given file system.
+func migrationVersions(schemas fs.FS) ([]uint, error) {
+	files, err := fs.Glob(schemas, "sqlc/migrations/*.up.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]uint, 0, len(files))
+	for _, file := range files {
+		name := strings.TrimPrefix(file, "sqlc/migrations/")
+		prefix, _, ok := strings.Cut(name, "_")
+		if !ok {
+			return nil, fmt.Errorf("invalid migration file "+
+				"name: %v", file)
+		}
+
+		version, err := strconv.ParseUint(prefix, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration file name "+
+				"%v: %w", file, err)
+		}
+
+		versions = append(versions, uint(version))
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i] < versions[j]
+	})
+
+	return versions, nil
+}
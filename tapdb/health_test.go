@@ -0,0 +1,79 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDatabaseHealth tests that the health report contains the schema version
+// and detects transfers without inputs or outputs.
+func TestDatabaseHealth(t *testing.T) {
+	t.Parallel()
+
+	db := NewTestDB(t)
+	health := NewDatabaseHealth(NewTransactionExecutor(
+		db, func(tx *sql.Tx) HealthStore {
+			return db.WithTx(tx)
+		},
+	), db.BaseDB)
+	ctx := context.Background()
+
+	// A freshly migrated database is healthy.
+	report, err := health.Report(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, LatestMigrationVersion, report.SchemaVersion)
+	require.False(t, report.Dirty)
+	require.Empty(t, report.PendingMigrations)
+	require.Equal(t, TableRowCounts{}, report.RowCounts)
+	require.Len(t, report.IntegrityChecks, 3)
+	require.True(t, report.Healthy())
+
+	// A transfer without any inputs or outputs violates two of the
+	// checks.
+	txid := test.RandBytes(32)
+	_, err = db.UpsertChainTx(ctx, sqlc.UpsertChainTxParams{
+		Txid:  txid,
+		RawTx: test.RandBytes(100),
+	})
+	require.NoError(t, err)
+	_, err = db.InsertAssetTransfer(ctx, sqlc.InsertAssetTransferParams{
+		HeightHint:       100,
+		TransferTimeUnix: time.Now(),
+		AnchorTxid:       txid,
+	})
+	require.NoError(t, err)
+
+	report, err = health.Report(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, report.RowCounts.NumTransfers)
+	require.False(t, report.Healthy())
+	require.Equal(t, []IntegrityCheck{{
+		Name: CheckOrphanedUniverseLeaves,
+	}, {
+		Name:       CheckTransfersWithoutOutputs,
+		Violations: 1,
+	}, {
+		Name:       CheckTransfersWithoutInputs,
+		Violations: 1,
+	}}, report.IntegrityChecks)
+}
+
+// TestMigrationVersions tests that the versions of the embedded migrations
+// are contiguous and end at the latest migration version.
+func TestMigrationVersions(t *testing.T) {
+	t.Parallel()
+
+	versions, err := migrationVersions(sqlSchemas)
+	require.NoError(t, err)
+	require.Len(t, versions, LatestMigrationVersion)
+
+	for idx, version := range versions {
+		require.EqualValues(t, idx+1, version)
+	}
+}
@@ -0,0 +1,168 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+var (
+	// ErrIdempotencyKeyNotFound is returned if no response is stored for
+	// an idempotency key.
+	ErrIdempotencyKeyNotFound = errors.New("idempotency key not found")
+)
+
+type (
+	// NewIdempotentResponse is used to store the response of a call made
+	// with an idempotency key.
+	NewIdempotentResponse = sqlc.InsertIdempotentResponseParams
+
+	// IdempotentResponseQuery is used to fetch the response stored for an
+	// idempotency key.
+	IdempotentResponseQuery = sqlc.FetchIdempotentResponseParams
+
+	// IdempotencyKey is an idempotency key record as stored in the
+	// database.
+	IdempotencyKey = sqlc.IdempotencyKey
+)
+
+// IdempotencyKeyStore is the set of queries needed to store the responses of
+// calls made with an idempotency key.
+type IdempotencyKeyStore interface {
+	// InsertIdempotentResponse stores the response of a call made with an
+	// idempotency key.
+	InsertIdempotentResponse(ctx context.Context,
+		arg NewIdempotentResponse) error
+
+	// FetchIdempotentResponse fetches the response stored for the given
+	// idempotency key and method.
+	FetchIdempotentResponse(ctx context.Context,
+		arg IdempotentResponseQuery) (IdempotencyKey, error)
+}
+
+// IdempotencyKeyStoreTxOptions defines the set of db txn options the
+// IdempotencyKeyStore understands.
+type IdempotencyKeyStoreTxOptions struct {
+	// readOnly governs if a read only transaction is needed or not.
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (r *IdempotencyKeyStoreTxOptions) ReadOnly() bool {
+	return r.readOnly
+}
+
+// NewIdempotencyKeyStoreReadTx creates a new read transaction option set.
+func NewIdempotencyKeyStoreReadTx() IdempotencyKeyStoreTxOptions {
+	return IdempotencyKeyStoreTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedIdempotencyKeyStore is the main storage interface for idempotency
+// keys. It supports all the basic queries as well as running the set of
+// queries in a single database transaction.
+type BatchedIdempotencyKeyStore interface {
+	IdempotencyKeyStore
+
+	BatchedTx[IdempotencyKeyStore]
+}
+
+// IdempotentResponse is the stored result of a call that was made with an
+// idempotency key.
+type IdempotentResponse struct {
+	// RequestHash is the SHA256 hash of the serialized request of the
+	// original call.
+	RequestHash [32]byte
+
+	// Response is the serialized response of the original call.
+	Response []byte
+}
+
+// IdempotencyStore is a database backed store for the responses of calls
+// that were made with a caller-supplied idempotency key.
+type IdempotencyStore struct {
+	db BatchedIdempotencyKeyStore
+
+	clock clock.Clock
+}
+
+// NewIdempotencyStore creates a new database backed idempotency key store.
+func NewIdempotencyStore(db BatchedIdempotencyKeyStore,
+	clock clock.Clock) *IdempotencyStore {
+
+	return &IdempotencyStore{
+		db:    db,
+		clock: clock,
+	}
+}
+
+// StoreResponse stores the serialized response of a call to the given method
+// that was made with the given idempotency key.
+func (s *IdempotencyStore) StoreResponse(ctx context.Context, key,
+	method string, requestHash [32]byte, response []byte) error {
+
+	newResp := NewIdempotentResponse{
+		IdempotencyKey: key,
+		Method:         method,
+		RequestHash:    fn.CopySlice(requestHash[:]),
+		Response:       response,
+		CreationTime:   s.clock.Now().UTC(),
+	}
+
+	var writeOpts IdempotencyKeyStoreTxOptions
+	return s.db.ExecTx(ctx, &writeOpts, func(q IdempotencyKeyStore) error {
+		return q.InsertIdempotentResponse(ctx, newResp)
+	})
+}
+
+// FetchResponse returns the response stored for a call to the given method
+// that was made with the given idempotency key. ErrIdempotencyKeyNotFound is
+// returned if no such call was recorded.
+func (s *IdempotencyStore) FetchResponse(ctx context.Context, key,
+	method string) (*IdempotentResponse, error) {
+
+	var (
+		resp     *IdempotentResponse
+		readOpts = NewIdempotencyKeyStoreReadTx()
+	)
+	dbErr := s.db.ExecTx(ctx, &readOpts, func(q IdempotencyKeyStore) error {
+		dbResp, err := q.FetchIdempotentResponse(
+			ctx, IdempotentResponseQuery{
+				IdempotencyKey: key,
+				Method:         method,
+			},
+		)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return ErrIdempotencyKeyNotFound
+
+		case err != nil:
+			return err
+		}
+
+		if len(dbResp.RequestHash) != 32 {
+			return fmt.Errorf("invalid request hash length %d",
+				len(dbResp.RequestHash))
+		}
+
+		resp = &IdempotentResponse{
+			Response: dbResp.Response,
+		}
+		copy(resp.RequestHash[:], dbResp.RequestHash)
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return resp, nil
+}
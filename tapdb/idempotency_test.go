@@ -0,0 +1,65 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestIdempotencyStore tests that responses can be stored for an idempotency
+// key and method, and that a key can't be stored twice for the same method.
+func TestIdempotencyStore(t *testing.T) {
+	t.Parallel()
+
+	db := NewTestDB(t)
+	store := NewIdempotencyStore(NewTransactionExecutor(
+		db, func(tx *sql.Tx) IdempotencyKeyStore {
+			return db.WithTx(tx)
+		},
+	), clock.NewTestClock(time.Now()))
+	ctx := context.Background()
+
+	const (
+		key    = "payout-1234"
+		method = "SendAsset"
+	)
+
+	// Nothing is stored for an unused key.
+	_, err := store.FetchResponse(ctx, key, method)
+	require.ErrorIs(t, err, ErrIdempotencyKeyNotFound)
+
+	reqHash := test.RandHash()
+	resp := test.RandBytes(100)
+	require.NoError(t, store.StoreResponse(ctx, key, method, reqHash, resp))
+
+	stored, err := store.FetchResponse(ctx, key, method)
+	require.NoError(t, err)
+	require.Equal(t, [32]byte(reqHash), stored.RequestHash)
+	require.Equal(t, resp, stored.Response)
+
+	// The same key is independent for a different method.
+	_, err = store.FetchResponse(ctx, key, "MintAsset")
+	require.ErrorIs(t, err, ErrIdempotencyKeyNotFound)
+
+	otherResp := test.RandBytes(100)
+	err = store.StoreResponse(ctx, key, "MintAsset", reqHash, otherResp)
+	require.NoError(t, err)
+
+	stored, err = store.FetchResponse(ctx, key, "MintAsset")
+	require.NoError(t, err)
+	require.Equal(t, otherResp, stored.Response)
+
+	// Storing a second response for the same key and method must fail, the
+	// original response is kept.
+	err = store.StoreResponse(ctx, key, method, reqHash, otherResp)
+	require.Error(t, err)
+
+	stored, err = store.FetchResponse(ctx, key, method)
+	require.NoError(t, err)
+	require.Equal(t, resp, stored.Response)
+}
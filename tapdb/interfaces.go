@@ -217,6 +217,16 @@ func (t *TransactionExecutor[Q]) ExecTx(ctx context.Context,
 		time.Sleep(retryDelay)
 	}
 
+	// If a read snapshot is attached to the context, read-only
+	// transactions are executed within the transaction of the snapshot.
+	// There's nothing to commit or retry in that case.
+	snapshot, ok := readSnapshotFromContext(ctx)
+	if ok && txOptions.ReadOnly() {
+		return snapshot.exec(func(tx *sql.Tx) error {
+			return MapSQLError(txBody(t.createQuery(tx)))
+		})
+	}
+
 	for i := 0; i < t.opts.numRetries; i++ {
 		// Create the db transaction.
 		tx, err := t.BatchedQuerier.BeginTx(ctx, txOptions)
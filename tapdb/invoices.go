@@ -0,0 +1,213 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/invoice"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+)
+
+type (
+	// NewAssetInvoice is used to insert a new asset invoice.
+	NewAssetInvoice = sqlc.InsertAssetInvoiceParams
+
+	// AssetInvoiceQuery is used to query asset invoices.
+	AssetInvoiceQuery = sqlc.QueryAssetInvoicesParams
+
+	// AssetInvoice is a single asset invoice as stored in the database.
+	AssetInvoice = sqlc.QueryAssetInvoicesRow
+
+	// AssetInvoiceState is used to update the state of an asset invoice.
+	AssetInvoiceState = sqlc.UpdateAssetInvoiceStateParams
+)
+
+// InvoiceStore is the set of queries needed to store asset invoices. As
+// invoices are bound to addresses, it includes all the address book queries.
+type InvoiceStore interface {
+	AddrBook
+
+	// InsertAssetInvoice inserts a new asset invoice for an existing
+	// address.
+	InsertAssetInvoice(ctx context.Context, arg NewAssetInvoice) (int64,
+		error)
+
+	// QueryAssetInvoices returns all asset invoices that match the given
+	// query.
+	QueryAssetInvoices(ctx context.Context,
+		arg AssetInvoiceQuery) ([]AssetInvoice, error)
+
+	// UpdateAssetInvoiceState updates the state of an asset invoice.
+	UpdateAssetInvoiceState(ctx context.Context,
+		arg AssetInvoiceState) error
+}
+
+// InvoiceStoreTxOptions defines the set of db txn options the InvoiceStore
+// understands.
+type InvoiceStoreTxOptions struct {
+	// readOnly governs if a read only transaction is needed or not.
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (r *InvoiceStoreTxOptions) ReadOnly() bool {
+	return r.readOnly
+}
+
+// NewInvoiceStoreReadTx creates a new read transaction option set.
+func NewInvoiceStoreReadTx() InvoiceStoreTxOptions {
+	return InvoiceStoreTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedInvoiceStore is the main storage interface for asset invoices. It
+// supports all the basic queries as well as running the set of queries in a
+// single database transaction.
+type BatchedInvoiceStore interface {
+	InvoiceStore
+
+	BatchedTx[InvoiceStore]
+}
+
+// AssetInvoices is a database backed implementation of the invoice.Store
+// interface.
+type AssetInvoices struct {
+	db     BatchedInvoiceStore
+	params *address.ChainParams
+}
+
+// NewAssetInvoices creates a new database backed asset invoice store.
+func NewAssetInvoices(db BatchedInvoiceStore,
+	params *address.ChainParams) *AssetInvoices {
+
+	return &AssetInvoices{
+		db:     db,
+		params: params,
+	}
+}
+
+// InsertInvoice inserts a new invoice for an address that already exists in
+// the address book and sets its ID.
+//
+// NOTE: This is part of the invoice.Store interface.
+func (a *AssetInvoices) InsertInvoice(ctx context.Context,
+	inv *invoice.Invoice) error {
+
+	var writeTx InvoiceStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTx, func(q InvoiceStore) error {
+		id, err := q.InsertAssetInvoice(ctx, NewAssetInvoice{
+			TaprootOutputKey: schnorr.SerializePubKey(
+				&inv.Addr.TaprootOutputKey,
+			),
+			Memo:         inv.Memo,
+			CreationTime: inv.CreationTime.UTC(),
+			ExpiryTime:   inv.Expiry.UTC(),
+			State:        int16(inv.State),
+			AmtReceived:  int64(inv.AmtReceived),
+			StateTime:    inv.StateTime.UTC(),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert invoice: %w", err)
+		}
+
+		inv.ID = id
+
+		return nil
+	})
+}
+
+// QueryInvoices returns all invoices that match the given query.
+//
+// NOTE: This is part of the invoice.Store interface.
+func (a *AssetInvoices) QueryInvoices(ctx context.Context,
+	params invoice.QueryParams) ([]*invoice.Invoice, error) {
+
+	var query AssetInvoiceQuery
+	if params.State != nil {
+		query.State = sql.NullInt16{
+			Int16: int16(*params.State),
+			Valid: true,
+		}
+	}
+	if params.TaprootOutputKey != nil {
+		query.TaprootOutputKey = schnorr.SerializePubKey(
+			params.TaprootOutputKey,
+		)
+	}
+
+	var (
+		invoices []*invoice.Invoice
+		readTx   = NewInvoiceStoreReadTx()
+	)
+	err := a.db.ExecTx(ctx, &readTx, func(q InvoiceStore) error {
+		dbInvoices, err := q.QueryAssetInvoices(ctx, query)
+		if err != nil {
+			return fmt.Errorf("unable to query invoices: %w", err)
+		}
+
+		invoices = make([]*invoice.Invoice, 0, len(dbInvoices))
+		for _, dbInvoice := range dbInvoices {
+			taprootOutputKey, err := schnorr.ParsePubKey(
+				dbInvoice.TaprootOutputKey,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to decode taproot "+
+					"output key: %w", err)
+			}
+
+			addr, err := fetchAddr(
+				ctx, q, a.params, taprootOutputKey,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to fetch address of "+
+					"invoice %d: %w", dbInvoice.ID, err)
+			}
+
+			invoices = append(invoices, &invoice.Invoice{
+				ID:           dbInvoice.ID,
+				Addr:         addr,
+				Memo:         dbInvoice.Memo,
+				CreationTime: dbInvoice.CreationTime.UTC(),
+				Expiry:       dbInvoice.ExpiryTime.UTC(),
+				State:        invoice.State(dbInvoice.State),
+				AmtReceived:  uint64(dbInvoice.AmtReceived),
+				StateTime:    dbInvoice.StateTime.UTC(),
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return invoices, nil
+}
+
+// UpdateInvoiceState persists the state, the received amount and the state
+// time of the given invoice.
+//
+// NOTE: This is part of the invoice.Store interface.
+func (a *AssetInvoices) UpdateInvoiceState(ctx context.Context,
+	inv *invoice.Invoice) error {
+
+	var writeTx InvoiceStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTx, func(q InvoiceStore) error {
+		return q.UpdateAssetInvoiceState(ctx, AssetInvoiceState{
+			State:       int16(inv.State),
+			AmtReceived: int64(inv.AmtReceived),
+			StateTime:   inv.StateTime.UTC(),
+			ID:          inv.ID,
+		})
+	})
+}
+
+// A compile-time assertion to make sure AssetInvoices satisfies the
+// invoice.Store interface.
+var _ invoice.Store = (*AssetInvoices)(nil)
@@ -0,0 +1,124 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/invoice"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAssetInvoices tests that invoices can be inserted, queried and updated.
+func TestAssetInvoices(t *testing.T) {
+	t.Parallel()
+
+	testClock := clock.NewTestClock(time.Now())
+	db := NewTestDB(t)
+	addrBook := NewTapAddressBook(
+		NewTransactionExecutor(db, func(tx *sql.Tx) AddrBook {
+			return db.WithTx(tx)
+		}),
+		chainParams, testClock,
+	)
+	invoiceStore := NewAssetInvoices(
+		NewTransactionExecutor(db, func(tx *sql.Tx) InvoiceStore {
+			return db.WithTx(tx)
+		}),
+		chainParams,
+	)
+	ctx := context.Background()
+
+	// Create two addresses that we'll bind the invoices to.
+	var writeTxOpts AddrBookTxOptions
+	proofCourierAddr := address.RandProofCourierAddr(t)
+	addrs := make([]address.AddrWithKeyInfo, 2)
+	for i := range addrs {
+		addr, assetGen, assetGroup := address.RandAddr(
+			t, chainParams, proofCourierAddr,
+		)
+		addrs[i] = *addr
+
+		err := addrBook.db.ExecTx(
+			ctx, &writeTxOpts,
+			insertFullAssetGen(ctx, assetGen, assetGroup),
+		)
+		require.NoError(t, err)
+	}
+	require.NoError(t, addrBook.InsertAddrs(ctx, addrs...))
+
+	now := testClock.Now().UTC().Truncate(time.Second)
+	invoices := make([]*invoice.Invoice, len(addrs))
+	for i := range addrs {
+		invoices[i] = &invoice.Invoice{
+			Addr:         &addrs[i],
+			Memo:         "invoice memo",
+			CreationTime: now,
+			Expiry:       now.Add(invoice.DefaultExpiry),
+			State:        invoice.StateOpen,
+			StateTime:    now,
+		}
+		require.NoError(t, invoiceStore.InsertInvoice(ctx, invoices[i]))
+		require.NotZero(t, invoices[i].ID)
+	}
+
+	// An invoice can only be created for an address that exists.
+	unknownAddr, _, _ := address.RandAddr(t, chainParams, proofCourierAddr)
+	err := invoiceStore.InsertInvoice(ctx, &invoice.Invoice{
+		Addr:         unknownAddr,
+		CreationTime: now,
+		Expiry:       now,
+		StateTime:    now,
+	})
+	require.Error(t, err)
+
+	// Without any filter, we should get both invoices back.
+	dbInvoices, err := invoiceStore.QueryInvoices(
+		ctx, invoice.QueryParams{},
+	)
+	require.NoError(t, err)
+	require.Len(t, dbInvoices, len(invoices))
+	for i := range invoices {
+		assertEqualInvoice(t, invoices[i], dbInvoices[i])
+	}
+
+	// Mark the first invoice as paid.
+	invoices[0].State = invoice.StatePaid
+	invoices[0].AmtReceived = invoices[0].Amount()
+	invoices[0].StateTime = now.Add(time.Minute)
+	require.NoError(t, invoiceStore.UpdateInvoiceState(ctx, invoices[0]))
+
+	// Filtering by state should now only return the updated invoice.
+	paid := invoice.StatePaid
+	dbInvoices, err = invoiceStore.QueryInvoices(ctx, invoice.QueryParams{
+		State: &paid,
+	})
+	require.NoError(t, err)
+	require.Len(t, dbInvoices, 1)
+	assertEqualInvoice(t, invoices[0], dbInvoices[0])
+
+	// And filtering by the address should return the other one.
+	dbInvoices, err = invoiceStore.QueryInvoices(ctx, invoice.QueryParams{
+		TaprootOutputKey: &addrs[1].TaprootOutputKey,
+	})
+	require.NoError(t, err)
+	require.Len(t, dbInvoices, 1)
+	assertEqualInvoice(t, invoices[1], dbInvoices[0])
+}
+
+// assertEqualInvoice asserts that the two invoices are equal.
+func assertEqualInvoice(t *testing.T, expected, actual *invoice.Invoice) {
+	t.Helper()
+
+	require.Equal(t, expected.ID, actual.ID)
+	require.Equal(t, expected.Memo, actual.Memo)
+	require.Equal(t, expected.State, actual.State)
+	require.Equal(t, expected.AmtReceived, actual.AmtReceived)
+	require.True(t, expected.CreationTime.Equal(actual.CreationTime))
+	require.True(t, expected.Expiry.Equal(actual.Expiry))
+	require.True(t, expected.StateTime.Equal(actual.StateTime))
+	assertEqualAddr(t, *expected.Addr, *actual.Addr)
+}
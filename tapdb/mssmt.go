@@ -60,6 +60,11 @@ type TreeStore interface {
 	// DeleteAllNodes deletes all nodes from the store.
 	DeleteAllNodes(ctx context.Context, namespace string) (int64, error)
 
+	// DeleteNodesByNamespacePattern deletes all nodes, and with them the
+	// roots, of all namespaces that match the given SQL LIKE pattern.
+	DeleteNodesByNamespacePattern(ctx context.Context,
+		pattern string) (int64, error)
+
 	// DeleteRoot deletes a root node from the store.
 	DeleteRoot(ctx context.Context, namespace string) (int64, error)
 
@@ -104,6 +109,10 @@ type BatchedTreeStore interface {
 type TaprootAssetTreeStore struct {
 	db        BatchedTreeStore
 	namespace string
+
+	// branchCache is an optional cache for the children of recently used
+	// branch nodes.
+	branchCache *TreeBranchCache
 }
 
 // NewTaprootAssetTreeStore creates a new TaprootAssetTreeStore instance given
@@ -118,6 +127,31 @@ func NewTaprootAssetTreeStore(db BatchedTreeStore,
 	}
 }
 
+// NewCachedTaprootAssetTreeStore creates a new TaprootAssetTreeStore instance
+// that keeps the children of recently used branch nodes in the given cache,
+// which may be shared with other tree stores.
+func NewCachedTaprootAssetTreeStore(db BatchedTreeStore, namespace string,
+	branchCache *TreeBranchCache) *TaprootAssetTreeStore {
+
+	return &TaprootAssetTreeStore{
+		db:          db,
+		namespace:   namespace,
+		branchCache: branchCache,
+	}
+}
+
+// DeleteTreesByNamespacePrefix deletes all nodes and roots of all trees whose
+// namespace starts with the given prefix.
+func DeleteTreesByNamespacePrefix(ctx context.Context, db BatchedTreeStore,
+	prefix string) error {
+
+	var writeTxOpts TreeStoreTxOptions
+	return db.ExecTx(ctx, &writeTxOpts, func(dbTx TreeStore) error {
+		_, err := dbTx.DeleteNodesByNamespacePattern(ctx, prefix+"%")
+		return err
+	})
+}
+
 var _ mssmt.TreeStore = (*TaprootAssetTreeStore)(nil)
 
 // Update updates the persistent tree in the passed-in update closure using the
@@ -125,18 +159,35 @@ var _ mssmt.TreeStore = (*TaprootAssetTreeStore)(nil)
 func (t *TaprootAssetTreeStore) Update(ctx context.Context,
 	update func(tx mssmt.TreeStoreUpdateTx) error) error {
 
+	// Branches read or written within the transaction are only added to
+	// the cache once the transaction is committed. The body may be retried,
+	// so only the last attempt counts.
+	var updateTx *taprootAssetTreeStoreTx
 	txBody := func(dbTx TreeStore) error {
-		updateTx := &taprootAssetTreeStoreTx{
-			ctx:       ctx,
-			dbTx:      dbTx,
-			namespace: t.namespace,
+		updateTx = &taprootAssetTreeStoreTx{
+			ctx:         ctx,
+			dbTx:        dbTx,
+			namespace:   t.namespace,
+			branchCache: t.branchCache,
+			pending:     make(map[mssmt.NodeHash]*cachedChildren),
 		}
 
 		return update(updateTx)
 	}
 
 	var writeTxOpts TreeStoreTxOptions
-	return t.db.ExecTx(ctx, &writeTxOpts, txBody)
+	err := t.db.ExecTx(ctx, &writeTxOpts, txBody)
+	if err != nil {
+		return err
+	}
+
+	if t.branchCache != nil && updateTx != nil {
+		for hash, children := range updateTx.pending {
+			t.branchCache.put(t.namespace, hash, children)
+		}
+	}
+
+	return nil
 }
 
 // View gives a view of the persistent tree in the passed view closure using
@@ -146,9 +197,10 @@ func (t *TaprootAssetTreeStore) View(ctx context.Context,
 
 	txBody := func(dbTx TreeStore) error {
 		viewTx := &taprootAssetTreeStoreTx{
-			ctx:       ctx,
-			dbTx:      dbTx,
-			namespace: t.namespace,
+			ctx:         ctx,
+			dbTx:        dbTx,
+			namespace:   t.namespace,
+			branchCache: t.branchCache,
 		}
 
 		return update(viewTx)
@@ -165,6 +217,56 @@ type taprootAssetTreeStoreTx struct {
 	ctx       context.Context
 	dbTx      TreeStore
 	namespace string
+
+	// branchCache is the optional cache for the children of recently used
+	// branch nodes.
+	branchCache *TreeBranchCache
+
+	// pending holds the children of the branches that are added to the
+	// cache once the update transaction is committed. It is nil for view
+	// transactions, which add branches to the cache directly.
+	pending map[mssmt.NodeHash]*cachedChildren
+}
+
+// cachedChildren returns the cached children of the branch with the given
+// hash.
+func (t *taprootAssetTreeStoreTx) cachedChildren(
+	hashKey mssmt.NodeHash) (*cachedChildren, bool) {
+
+	if t.branchCache == nil {
+		return nil, false
+	}
+
+	if children, ok := t.pending[hashKey]; ok {
+		return children, true
+	}
+
+	// Only committed branches can be found in the cache, so a branch that
+	// was deleted in the current transaction is never returned.
+	return t.branchCache.get(t.namespace, hashKey)
+}
+
+// cacheChildren adds the children of the branch with the given hash to the
+// cache, if both of them can be cached.
+func (t *taprootAssetTreeStoreTx) cacheChildren(hashKey mssmt.NodeHash, left,
+	right mssmt.Node) {
+
+	if t.branchCache == nil || !isCacheableChild(left) ||
+		!isCacheableChild(right) {
+
+		return
+	}
+
+	children := &cachedChildren{
+		left:  left,
+		right: right,
+	}
+	if t.pending != nil {
+		t.pending[hashKey] = children
+		return
+	}
+
+	t.branchCache.put(t.namespace, hashKey, children)
 }
 
 // InsertBranch stores a new branch keyed by its NodeHash.
@@ -231,12 +333,22 @@ func (t *taprootAssetTreeStoreTx) DeleteRoot() error {
 
 // DeleteRoot deletes all nodes, including branch nodes, of the MS-SMT.
 func (t *taprootAssetTreeStoreTx) DeleteAllNodes() error {
+	if t.branchCache != nil {
+		clear(t.pending)
+		t.branchCache.wipe(t.namespace)
+	}
+
 	_, err := t.dbTx.DeleteAllNodes(t.ctx, t.namespace)
 	return err
 }
 
 // DeleteBranch deletes the branch node keyed by the given NodeHash.
 func (t *taprootAssetTreeStoreTx) DeleteBranch(hashKey mssmt.NodeHash) error {
+	if t.branchCache != nil {
+		delete(t.pending, hashKey)
+		t.branchCache.delete(t.namespace, hashKey)
+	}
+
 	_, err := t.dbTx.DeleteNode(t.ctx, DelNode{
 		HashKey:   hashKey[:],
 		Namespace: t.namespace,
@@ -280,6 +392,10 @@ func newKey(data []byte) ([32]byte, error) {
 func (t *taprootAssetTreeStoreTx) GetChildren(height int, hashKey mssmt.NodeHash) (
 	mssmt.Node, mssmt.Node, error) {
 
+	if children, ok := t.cachedChildren(hashKey); ok {
+		return children.left, children.right, nil
+	}
+
 	dbRows, err := t.dbTx.FetchChildren(t.ctx, ChildQuery{
 		HashKey:   hashKey[:],
 		Namespace: t.namespace,
@@ -352,6 +468,11 @@ func (t *taprootAssetTreeStoreTx) GetChildren(height int, hashKey mssmt.NodeHash
 		}
 	}
 
+	// We only cache the children of branches that were actually found.
+	if len(dbRows) > 0 {
+		t.cacheChildren(hashKey, left, right)
+	}
+
 	return left, right, nil
 }
 
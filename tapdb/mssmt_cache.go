@@ -0,0 +1,121 @@
+package tapdb
+
+import (
+	"github.com/lightninglabs/neutrino/cache"
+	"github.com/lightninglabs/neutrino/cache/lru"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+)
+
+const (
+	// DefaultTreeBranchCacheSize is the default number of branch nodes
+	// whose children are kept in a tree branch cache.
+	DefaultTreeBranchCacheSize = 100_000
+)
+
+// branchCacheKey is the key of a cached branch node. Branches are cached per
+// tree, as the same branch may exist in multiple trees.
+type branchCacheKey struct {
+	namespace string
+	hash      mssmt.NodeHash
+}
+
+// cachedChildren holds the children of a cached branch node.
+type cachedChildren struct {
+	left  mssmt.Node
+	right mssmt.Node
+}
+
+// Size returns the number of cache slots the children of a branch occupy,
+// which is always one.
+//
+// NOTE: This is part of the cache.Value interface.
+func (c *cachedChildren) Size() (uint64, error) {
+	return 1, nil
+}
+
+// A compile-time assertion to ensure cachedChildren satisfies the cache.Value
+// interface.
+var _ cache.Value = (*cachedChildren)(nil)
+
+// TreeBranchCache is a bounded LRU cache for the children of recently used
+// branch nodes, which can be shared by any number of tree stores. Only
+// branches whose children are branches themselves are cached, so the memory
+// used by the cache doesn't depend on the size of the leaf values.
+type TreeBranchCache struct {
+	cache *lru.Cache[branchCacheKey, *cachedChildren]
+}
+
+// NewTreeBranchCache creates a new branch cache that holds the children of the
+// given number of branch nodes. If the size is zero,
+// DefaultTreeBranchCacheSize is used.
+func NewTreeBranchCache(size uint64) *TreeBranchCache {
+	if size == 0 {
+		size = DefaultTreeBranchCacheSize
+	}
+
+	return &TreeBranchCache{
+		cache: lru.NewCache[branchCacheKey, *cachedChildren](size),
+	}
+}
+
+// get returns the cached children of the branch with the given hash.
+func (c *TreeBranchCache) get(namespace string,
+	hash mssmt.NodeHash) (*cachedChildren, bool) {
+
+	children, err := c.cache.Get(branchCacheKey{
+		namespace: namespace,
+		hash:      hash,
+	})
+	if err != nil {
+		return nil, false
+	}
+
+	return children, true
+}
+
+// put adds the children of the branch with the given hash to the cache.
+func (c *TreeBranchCache) put(namespace string, hash mssmt.NodeHash,
+	children *cachedChildren) {
+
+	_, _ = c.cache.Put(branchCacheKey{
+		namespace: namespace,
+		hash:      hash,
+	}, children)
+}
+
+// delete removes the branch with the given hash from the cache.
+func (c *TreeBranchCache) delete(namespace string, hash mssmt.NodeHash) {
+	c.cache.Delete(branchCacheKey{
+		namespace: namespace,
+		hash:      hash,
+	})
+}
+
+// wipe removes all branches of the tree with the given namespace from the
+// cache.
+func (c *TreeBranchCache) wipe(namespace string) {
+	var keys []branchCacheKey
+	c.cache.Range(func(key branchCacheKey, _ *cachedChildren) bool {
+		if key.namespace == namespace {
+			keys = append(keys, key)
+		}
+
+		return true
+	})
+
+	for _, key := range keys {
+		c.cache.Delete(key)
+	}
+}
+
+// isCacheableChild returns true if the given child node can be cached. Leaves
+// aren't cached, as their values can be arbitrarily large.
+func isCacheableChild(node mssmt.Node) bool {
+	switch node.(type) {
+	case *mssmt.LeafNode, *mssmt.CompactedLeafNode:
+		return false
+
+	default:
+		return true
+	}
+}
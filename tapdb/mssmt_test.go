@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"testing"
 
+	"github.com/lightninglabs/taproot-assets/internal/test"
 	"github.com/lightninglabs/taproot-assets/mssmt"
 	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
 	"github.com/stretchr/testify/require"
@@ -721,3 +722,134 @@ func TestTreeNamespaceIsolation(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+// TestCachedTreeStore tests that a tree store with a branch cache behaves the
+// same as one without, and that deleted branches are removed from the cache.
+func TestCachedTreeStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := NewTestDB(t)
+	treeDB := NewTransactionExecutor(db, func(tx *sql.Tx) TreeStore {
+		return db.WithTx(tx)
+	})
+
+	// We use a tiny cache, so the cache eviction is exercised as well.
+	branchCache := NewTreeBranchCache(8)
+	cachedStore := NewCachedTaprootAssetTreeStore(
+		treeDB, "cached", branchCache,
+	)
+	cachedTree := mssmt.NewCompactedTree(cachedStore)
+	plainTree := mssmt.NewCompactedTree(
+		NewTaprootAssetTreeStore(treeDB, "plain"),
+	)
+
+	keys := make([][32]byte, 50)
+	for i := range keys {
+		keys[i] = test.RandHash()
+		leaf := mssmt.NewLeafNode(
+			test.RandBytes(32), mssmt.RandLeafAmount(),
+		)
+
+		_, err := plainTree.Insert(ctx, keys[i], leaf)
+		require.NoError(t, err)
+		_, err = cachedTree.Insert(ctx, keys[i], leaf)
+		require.NoError(t, err)
+	}
+
+	assertEqualTrees := func() {
+		t.Helper()
+
+		plainRoot, err := plainTree.Root(ctx)
+		require.NoError(t, err)
+		cachedRoot, err := cachedTree.Root(ctx)
+		require.NoError(t, err)
+		require.True(t, mssmt.IsEqualNode(plainRoot, cachedRoot))
+
+		for _, key := range keys {
+			plainProof, err := plainTree.MerkleProof(ctx, key)
+			require.NoError(t, err)
+			cachedProof, err := cachedTree.MerkleProof(ctx, key)
+			require.NoError(t, err)
+
+			require.Len(t, cachedProof.Nodes, len(plainProof.Nodes))
+			for i, node := range plainProof.Nodes {
+				require.True(t, mssmt.IsEqualNode(
+					node, cachedProof.Nodes[i],
+				))
+			}
+		}
+	}
+	assertEqualTrees()
+	require.NotZero(t, branchCache.cache.Len())
+
+	// Deleting leaves removes branches from the tree, which must not be
+	// served from the cache anymore.
+	for _, key := range keys[:25] {
+		_, err := plainTree.Delete(ctx, key)
+		require.NoError(t, err)
+		_, err = cachedTree.Delete(ctx, key)
+		require.NoError(t, err)
+	}
+	keys = keys[25:]
+	assertEqualTrees()
+
+	// Deleting all nodes of the tree also removes all its branches from the
+	// cache.
+	root, err := cachedTree.Root(ctx)
+	require.NoError(t, err)
+	err = cachedStore.Update(ctx, func(tx mssmt.TreeStoreUpdateTx) error {
+		if err := tx.DeleteAllNodes(); err != nil {
+			return err
+		}
+
+		return tx.DeleteRoot()
+	})
+	require.NoError(t, err)
+	require.Zero(t, branchCache.cache.Len())
+
+	err = cachedStore.View(ctx, func(tx mssmt.TreeStoreViewTx) error {
+		left, right, err := tx.GetChildren(0, root.NodeHash())
+		require.NoError(t, err)
+		require.True(t, mssmt.IsEqualNode(mssmt.EmptyTree[1], left))
+		require.True(t, mssmt.IsEqualNode(mssmt.EmptyTree[1], right))
+
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+// TestDeleteTreesByNamespacePrefix tests that only the trees whose namespace
+// starts with the given prefix are deleted.
+func TestDeleteTreesByNamespacePrefix(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := NewTestDB(t)
+	treeDB := NewTransactionExecutor(db, func(tx *sql.Tx) TreeStore {
+		return db.WithTx(tx)
+	})
+
+	newTree := func(namespace string) mssmt.Tree {
+		return mssmt.NewCompactedTree(
+			NewTaprootAssetTreeStore(treeDB, namespace),
+		)
+	}
+	namespaces := []string{"mem-a", "mem-b", "other"}
+	for _, namespace := range namespaces {
+		leaf := mssmt.NewLeafNode(test.RandBytes(32), 1)
+		_, err := newTree(namespace).Insert(ctx, test.RandHash(), leaf)
+		require.NoError(t, err)
+	}
+
+	err := DeleteTreesByNamespacePrefix(ctx, treeDB, "mem-")
+	require.NoError(t, err)
+
+	for _, namespace := range namespaces {
+		root, err := newTree(namespace).Root(ctx)
+		require.NoError(t, err)
+
+		isEmpty := mssmt.IsEqualNode(mssmt.EmptyTree[0], root)
+		require.Equal(t, namespace != "other", isEmpty, namespace)
+	}
+}
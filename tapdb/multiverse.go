@@ -54,6 +54,9 @@ type (
 	// QueryMultiverseLeaves is used to query for a set of leaves based on
 	// the proof type and asset ID (or group key)
 	QueryMultiverseLeaves = sqlc.QueryMultiverseLeavesParams
+
+	// UniverseAssetMeta is the meta of an asset that has a universe leaf.
+	UniverseAssetMeta = sqlc.FetchUniverseAssetMetaRow
 )
 
 // BaseMultiverseStore is used to interact with a set of base universe
@@ -75,6 +78,11 @@ type BaseMultiverseStore interface {
 	// given target namespace (proof type in this case).
 	FetchMultiverseRoot(ctx context.Context,
 		proofNamespace string) (MultiverseRoot, error)
+
+	// FetchUniverseAssetMeta fetches the asset meta with the given hash,
+	// if an asset with that meta has a universe leaf.
+	FetchUniverseAssetMeta(ctx context.Context,
+		metaDataHash []byte) (UniverseAssetMeta, error)
 }
 
 // BaseMultiverseOptions is the set of options for multiverse queries.
@@ -397,17 +405,21 @@ const numMaxCachedPages = 1000
 // leafQuery is a wrapper around the existing UniverseLeafKeysQuery struct that
 // doesn't include a pointer so it can be safely used as a map key.
 type leafQuery struct {
-	sortDirection universe.SortDirection
-	offset        int32
-	limit         int32
+	sortDirection  universe.SortDirection
+	offset         int32
+	limit          int32
+	minBlockHeight fn.Option[uint32]
+	maxBlockHeight fn.Option[uint32]
 }
 
 // newLeafQuery creates a new leaf query.
 func newLeafQuery(q universe.UniverseLeafKeysQuery) leafQuery {
 	return leafQuery{
-		sortDirection: q.SortDirection,
-		offset:        q.Offset,
-		limit:         q.Limit,
+		sortDirection:  q.SortDirection,
+		offset:         q.Offset,
+		limit:          q.Limit,
+		minBlockHeight: q.MinBlockHeight,
+		maxBlockHeight: q.MaxBlockHeight,
 	}
 }
 
@@ -527,6 +539,11 @@ type MultiverseStore struct {
 	// proofs. And since the custodian is only interested in transfer
 	// proofs, we only signal on transfer proofs.
 	transferProofDistributor *fn.EventDistributor[proof.Blob]
+
+	// leafEventDistributor is an event distributor that will be used to
+	// notify subscribers about all new proof leaves that are added to the
+	// multiverse, filtered by the universes they are interested in.
+	leafEventDistributor *universe.LeafEventDistributor
 }
 
 // NewMultiverseStore creates a new multiverse DB store handle.
@@ -537,6 +554,7 @@ func NewMultiverseStore(db BatchedMultiverse) *MultiverseStore {
 		proofCache:               newProofCache(),
 		leafKeysCache:            newUniverseLeafCache(),
 		transferProofDistributor: fn.NewEventDistributor[proof.Blob](),
+		leafEventDistributor:     universe.NewLeafEventDistributor(),
 	}
 }
 
@@ -604,13 +622,51 @@ func (b *MultiverseStore) MultiverseRootNode(ctx context.Context,
 	return fn.Some(rootNode), nil
 }
 
+// FetchAssetMeta returns the meta reveal with the given hash of an asset that
+// has a leaf in one of the universes. The meta of assets that are only known
+// to the local wallet isn't returned.
+//
+// NOTE: This is part of the universe.MultiverseArchive interface.
+func (b *MultiverseStore) FetchAssetMeta(ctx context.Context,
+	metaHash [asset.MetaHashLen]byte) (*proof.MetaReveal, error) {
+
+	var assetMeta *proof.MetaReveal
+
+	readTx := NewBaseMultiverseReadTx()
+	dbErr := b.db.ExecTx(ctx, &readTx, func(db BaseMultiverseStore) error {
+		dbMeta, err := db.FetchUniverseAssetMeta(ctx, metaHash[:])
+		if err != nil {
+			return err
+		}
+
+		assetMeta = &proof.MetaReveal{
+			Data: dbMeta.MetaDataBlob,
+			Type: proof.MetaType(dbMeta.MetaDataType.Int16),
+		}
+
+		return nil
+	})
+	switch {
+	case errors.Is(dbErr, sql.ErrNoRows):
+		return nil, universe.ErrNoUniverseMeta
+	case dbErr != nil:
+		return nil, dbErr
+	}
+
+	return assetMeta, nil
+}
+
 // UniverseRootNode returns the Universe root node for the given asset ID.
 func (b *MultiverseStore) UniverseRootNode(ctx context.Context,
 	id universe.Identifier) (universe.Root, error) {
 
+	// Reads within a read snapshot bypass the cache, as it might contain
+	// data that is newer than the snapshot.
+	snapshotRead := InReadSnapshot(ctx)
+
 	// First, we'll check the root node cache to see if we already have it.
 	rootNode := b.rootNodeCache.fetchRoot(id)
-	if rootNode != nil {
+	if rootNode != nil && !snapshotRead {
 		return *rootNode, nil
 	}
 
@@ -620,7 +676,7 @@ func (b *MultiverseStore) UniverseRootNode(ctx context.Context,
 	// Check to see if the cache was populated while we were waiting for
 	// the lock.
 	rootNode = b.rootNodeCache.fetchRoot(id)
-	if rootNode != nil {
+	if rootNode != nil && !snapshotRead {
 		return *rootNode, nil
 	}
 
@@ -658,7 +714,9 @@ func (b *MultiverseStore) UniverseRootNode(ctx context.Context,
 		AssetName: universeRoot.AssetName,
 	}
 
-	b.rootNodeCache.cacheRoot(id, dbRoot)
+	if !snapshotRead {
+		b.rootNodeCache.cacheRoot(id, dbRoot)
+	}
 
 	return dbRoot, nil
 }
@@ -667,9 +725,13 @@ func (b *MultiverseStore) UniverseRootNode(ctx context.Context,
 func (b *MultiverseStore) UniverseLeafKeys(ctx context.Context,
 	q universe.UniverseLeafKeysQuery) ([]universe.LeafKey, error) {
 
+	// Reads within a read snapshot bypass the cache, as it might contain
+	// data that is newer than the snapshot.
+	snapshotRead := InReadSnapshot(ctx)
+
 	// First, check to see if we have the leaf keys cached.
 	leafKeys := b.leafKeysCache.fetchLeafKeys(q)
-	if len(leafKeys) > 0 {
+	if len(leafKeys) > 0 && !snapshotRead {
 		return leafKeys, nil
 	}
 
@@ -680,7 +742,7 @@ func (b *MultiverseStore) UniverseLeafKeys(ctx context.Context,
 	// While we were waiting for the lock, the cache might have been
 	// populated, so we'll check that now.
 	leafKeys = b.leafKeysCache.fetchLeafKeys(q)
-	if len(leafKeys) > 0 {
+	if len(leafKeys) > 0 && !snapshotRead {
 		return leafKeys, nil
 	}
 
@@ -700,7 +762,9 @@ func (b *MultiverseStore) UniverseLeafKeys(ctx context.Context,
 		return nil, dbErr
 	}
 
-	b.leafKeysCache.cacheLeafKeys(q, leafKeys)
+	if !snapshotRead {
+		b.leafKeysCache.cacheLeafKeys(q, leafKeys)
+	}
 
 	return leafKeys, nil
 }
@@ -710,9 +774,13 @@ func (b *MultiverseStore) UniverseLeafKeys(ctx context.Context,
 func (b *MultiverseStore) RootNodes(ctx context.Context,
 	q universe.RootNodesQuery) ([]universe.Root, error) {
 
+	// Reads within a read snapshot bypass the cache, as it might contain
+	// data that is newer than the snapshot.
+	snapshotRead := InReadSnapshot(ctx)
+
 	// Attempt to read directly from the root node cache.
 	rootNodes := b.rootNodeCache.fetchRoots(q, false)
-	if len(rootNodes) > 0 {
+	if len(rootNodes) > 0 && !snapshotRead {
 		log.Tracef("read %d root nodes from cache", len(rootNodes))
 		return rootNodes, nil
 	}
@@ -723,7 +791,7 @@ func (b *MultiverseStore) RootNodes(ctx context.Context,
 	// Check to see if the cache was populated while we were waiting for
 	// the mutex.
 	rootNodes = b.rootNodeCache.fetchRoots(q, true)
-	if len(rootNodes) > 0 {
+	if len(rootNodes) > 0 && !snapshotRead {
 		log.Tracef("read %d root nodes from cache", len(rootNodes))
 		return rootNodes, nil
 	}
@@ -835,7 +903,9 @@ func (b *MultiverseStore) RootNodes(ctx context.Context,
 		len(uniRoots), time.Since(now))
 
 	// Cache all the root nodes we just read from the database.
-	b.rootNodeCache.cacheRoots(q, uniRoots)
+	if !snapshotRead {
+		b.rootNodeCache.cacheRoots(q, uniRoots)
+	}
 
 	return uniRoots, nil
 }
@@ -848,9 +918,13 @@ func (b *MultiverseStore) FetchProofLeaf(ctx context.Context,
 	id universe.Identifier,
 	universeKey universe.LeafKey) ([]*universe.Proof, error) {
 
+	// Reads within a read snapshot bypass the cache, as it might contain
+	// data that is newer than the snapshot.
+	snapshotRead := InReadSnapshot(ctx)
+
 	// First, check the cached to see if we already have this proof.
 	proof := b.proofCache.fetchProof(id, universeKey)
-	if len(proof) > 0 {
+	if len(proof) > 0 && !snapshotRead {
 		return proof, nil
 	}
 
@@ -910,11 +984,113 @@ func (b *MultiverseStore) FetchProofLeaf(ctx context.Context,
 	}
 
 	// Insert the proof we just read up into the main cache.
-	b.proofCache.insertProof(id, universeKey, proofs)
+	if !snapshotRead {
+		b.proofCache.insertProof(id, universeKey, proofs)
+	}
 
 	return proofs, nil
 }
 
+// FetchProofLeaves returns the proof leaves for a batch of leaf keys of the
+// target universe. All proofs that aren't cached yet are fetched within a
+// single database transaction, which is considerably faster than fetching the
+// proofs one by one when syncing many leaves. The proofs are returned in the
+// same order as the keys. If no proof is found for any of the keys,
+// universe.ErrNoUniverseProofFound is returned.
+func (b *MultiverseStore) FetchProofLeaves(ctx context.Context,
+	id universe.Identifier,
+	universeKeys []universe.LeafKey) ([]*universe.Proof, error) {
+
+	// We first serve as many proofs as possible from the cache and only
+	// fetch the missing ones from the database. Reads within a read
+	// snapshot bypass the cache, as it might contain data that is newer
+	// than the snapshot.
+	snapshotRead := InReadSnapshot(ctx)
+	keyProofs := make([][]*universe.Proof, len(universeKeys))
+	var (
+		missingKeys []universe.LeafKey
+		missingIdx  []int
+	)
+	for i, universeKey := range universeKeys {
+		if !snapshotRead {
+			keyProofs[i] = b.proofCache.fetchProof(id, universeKey)
+		}
+		if len(keyProofs[i]) == 0 {
+			missingKeys = append(missingKeys, universeKey)
+			missingIdx = append(missingIdx, i)
+		}
+	}
+
+	if len(missingKeys) > 0 {
+		multiverseNS, err := namespaceForProof(id.ProofType)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			readTx        = NewBaseUniverseReadTx()
+			missingProofs [][]*universe.Proof
+		)
+		dbErr := b.db.ExecTx(ctx, &readTx, func(
+			dbTx BaseMultiverseStore) error {
+
+			var err error
+			missingProofs, err = universeFetchProofLeaves(
+				ctx, id, missingKeys, dbTx,
+			)
+			if err != nil {
+				return err
+			}
+
+			// All proofs of the batch share the same multiverse
+			// root and inclusion proof, so we only need to obtain
+			// them once.
+			multiverseTree := mssmt.NewCompactedTree(
+				newTreeStoreWrapperTx(dbTx, multiverseNS),
+			)
+			multiverseRoot, err := multiverseTree.Root(ctx)
+			if err != nil {
+				return err
+			}
+
+			multiverseInclusionProof, err :=
+				multiverseTree.MerkleProof(ctx, id.Bytes())
+			if err != nil {
+				return err
+			}
+
+			for _, proofs := range missingProofs {
+				for _, p := range proofs {
+					p.MultiverseRoot = multiverseRoot
+					p.MultiverseInclusionProof =
+						multiverseInclusionProof
+				}
+			}
+
+			return nil
+		})
+		if dbErr != nil {
+			return nil, dbErr
+		}
+
+		for i, proofs := range missingProofs {
+			keyProofs[missingIdx[i]] = proofs
+			if !snapshotRead {
+				b.proofCache.insertProof(
+					id, missingKeys[i], proofs,
+				)
+			}
+		}
+	}
+
+	var allProofs []*universe.Proof
+	for _, proofs := range keyProofs {
+		allProofs = append(allProofs, proofs...)
+	}
+
+	return allProofs, nil
+}
+
 // FetchProof fetches a proof for an asset uniquely identified by the passed
 // Locator. The returned blob contains the encoded full proof file, representing
 // the complete provenance of the asset.
@@ -1033,6 +1209,9 @@ func (b *MultiverseStore) UpsertProofLeaf(ctx context.Context,
 	if id.ProofType == universe.ProofTypeTransfer {
 		b.transferProofDistributor.NotifySubscribers(leaf.RawProof)
 	}
+	b.leafEventDistributor.NotifySubscribers(
+		universe.NewLeafEvent(id, key, leaf),
+	)
 
 	return issuanceProof, nil
 }
@@ -1091,6 +1270,13 @@ func (b *MultiverseStore) UpsertProofLeafBatch(ctx context.Context,
 			)
 		}
 	}
+	b.leafEventDistributor.NotifySubscribers(
+		fn.Map(items, func(item *universe.Item) *universe.LeafEvent {
+			return universe.NewLeafEvent(
+				item.ID, item.Key, item.Leaf,
+			)
+		})...,
+	)
 
 	// Invalidate the root node cache for all the assets we just inserted.
 	idsToDelete := fn.NewSet(fn.Map(items, func(item *universe.Item) treeID {
@@ -1287,6 +1473,30 @@ func (b *MultiverseStore) RemoveSubscriber(
 	return b.transferProofDistributor.RemoveSubscriber(subscriber)
 }
 
+// RegisterLeafSubscriber adds a new subscriber that is notified of every proof
+// leaf that is upserted into one of the given universes. If no universe is
+// given, the subscriber is notified of the leaves of all universes.
+//
+// NOTE: This is part of the universe.MultiverseArchive interface.
+func (b *MultiverseStore) RegisterLeafSubscriber(
+	receiver *fn.EventReceiver[*universe.LeafEvent],
+	ids ...universe.Identifier) error {
+
+	b.leafEventDistributor.RegisterSubscriber(receiver, ids...)
+
+	return nil
+}
+
+// RemoveLeafSubscriber removes the given leaf subscriber and also stops it
+// from processing events.
+//
+// NOTE: This is part of the universe.MultiverseArchive interface.
+func (b *MultiverseStore) RemoveLeafSubscriber(
+	receiver *fn.EventReceiver[*universe.LeafEvent]) error {
+
+	return b.leafEventDistributor.RemoveSubscriber(receiver)
+}
+
 // A compile-time interface to ensure MultiverseStore meets the
 // proof.NotifyArchiver interface.
 var _ proof.NotifyArchiver = (*MultiverseStore)(nil)
@@ -0,0 +1,375 @@
+package tapdb
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightninglabs/taproot-assets/tapmusig"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+type (
+	// NewMuSig2Session is used to insert a new MuSig2 session.
+	NewMuSig2Session = sqlc.InsertMuSig2SessionParams
+
+	// MuSig2SessionUpdate is used to update an existing MuSig2 session.
+	MuSig2SessionUpdate = sqlc.UpdateMuSig2SessionParams
+
+	// MuSig2SessionSigner is used to insert or update a signer of a MuSig2
+	// session.
+	MuSig2SessionSigner = sqlc.UpsertMuSig2SessionSignerParams
+
+	// MuSig2Session is a MuSig2 session as stored in the database.
+	MuSig2Session = sqlc.Musig2Session
+
+	// MuSig2Signer is a signer of a MuSig2 session as stored in the
+	// database.
+	MuSig2Signer = sqlc.Musig2SessionSigner
+)
+
+// MuSig2SessionStore is the set of queries needed to store MuSig2 signing
+// sessions.
+type MuSig2SessionStore interface {
+	// InsertMuSig2Session inserts a new MuSig2 session.
+	InsertMuSig2Session(ctx context.Context, arg NewMuSig2Session) error
+
+	// UpdateMuSig2Session updates the virtual packet, final signature and
+	// state of a MuSig2 session.
+	UpdateMuSig2Session(ctx context.Context, arg MuSig2SessionUpdate) error
+
+	// UpsertMuSig2SessionSigner inserts a signer of a MuSig2 session or
+	// updates its nonce and partial signature.
+	UpsertMuSig2SessionSigner(ctx context.Context,
+		arg MuSig2SessionSigner) error
+
+	// FetchMuSig2Session fetches the MuSig2 session with the given ID.
+	FetchMuSig2Session(ctx context.Context,
+		sessionID []byte) (MuSig2Session, error)
+
+	// QueryMuSig2Sessions returns all MuSig2 sessions, optionally
+	// filtered by state.
+	QueryMuSig2Sessions(ctx context.Context,
+		state sql.NullInt16) ([]MuSig2Session, error)
+
+	// FetchMuSig2SessionSigners fetches all signers of the MuSig2 session
+	// with the given ID.
+	FetchMuSig2SessionSigners(ctx context.Context,
+		sessionID []byte) ([]MuSig2Signer, error)
+}
+
+// MuSig2SessionTxOptions defines the set of db txn options the
+// MuSig2SessionStore understands.
+type MuSig2SessionTxOptions struct {
+	// readOnly governs if a read only transaction is needed or not.
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (r *MuSig2SessionTxOptions) ReadOnly() bool {
+	return r.readOnly
+}
+
+// NewMuSig2SessionReadTx creates a new read transaction option set.
+func NewMuSig2SessionReadTx() MuSig2SessionTxOptions {
+	return MuSig2SessionTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedMuSig2SessionStore is the main storage interface for MuSig2
+// sessions. It supports all the basic queries as well as running the set of
+// queries in a single database transaction.
+type BatchedMuSig2SessionStore interface {
+	MuSig2SessionStore
+
+	BatchedTx[MuSig2SessionStore]
+}
+
+// MuSig2Sessions is a database backed implementation of the
+// tapmusig.SessionStore interface.
+type MuSig2Sessions struct {
+	db BatchedMuSig2SessionStore
+}
+
+// NewMuSig2Sessions creates a new database backed MuSig2 session store.
+func NewMuSig2Sessions(db BatchedMuSig2SessionStore) *MuSig2Sessions {
+	return &MuSig2Sessions{
+		db: db,
+	}
+}
+
+// encodeVPacket serializes the given virtual packet.
+func encodeVPacket(vPkt *tappsbt.VPacket) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := vPkt.Serialize(&buf); err != nil {
+		return nil, fmt.Errorf("unable to encode virtual packet: %w",
+			err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// upsertSigners inserts or updates all signers of the given session.
+func upsertSigners(ctx context.Context, q MuSig2SessionStore,
+	session *tapmusig.Session) error {
+
+	for _, participant := range session.Participants {
+		var pubNonce []byte
+		participant.PubNonce.WhenSome(
+			func(n [musig2.PubNonceSize]byte) {
+				pubNonce = n[:]
+			},
+		)
+
+		err := q.UpsertMuSig2SessionSigner(ctx, MuSig2SessionSigner{
+			SessionID:  session.ID[:],
+			SignerKey:  participant.PubKey.SerializeCompressed(),
+			IsLocal:    participant.Local,
+			PubNonce:   pubNonce,
+			PartialSig: participant.PartialSig,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to store signer: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// InsertSession stores a new session along with its signers.
+//
+// NOTE: This is part of the tapmusig.SessionStore interface.
+func (m *MuSig2Sessions) InsertSession(ctx context.Context,
+	session *tapmusig.Session) error {
+
+	vPktBytes, err := encodeVPacket(session.VPacket)
+	if err != nil {
+		return err
+	}
+
+	var finalSig []byte
+	if session.FinalSig != nil {
+		finalSig = session.FinalSig.Serialize()
+	}
+
+	var writeTx MuSig2SessionTxOptions
+	return m.db.ExecTx(ctx, &writeTx, func(q MuSig2SessionStore) error {
+		err := q.InsertMuSig2Session(ctx, NewMuSig2Session{
+			SessionID:      session.ID[:],
+			VirtualPacket:  vPktBytes,
+			InputIndex:     int32(session.InputIndex),
+			LocalKeyFamily: int32(session.LocalKey.Family),
+			LocalKeyIndex:  int32(session.LocalKey.Index),
+			CombinedKey: schnorr.SerializePubKey(
+				session.CombinedKey,
+			),
+			SigHash:      session.SigHash[:],
+			FinalSig:     finalSig,
+			State:        int16(session.State),
+			CreationTime: session.CreationTime.UTC(),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert musig2 session: %w",
+				err)
+		}
+
+		return upsertSigners(ctx, q, session)
+	})
+}
+
+// UpdateSession persists the state, the virtual packet, the final signature
+// and the contributions of all signers of the given session.
+//
+// NOTE: This is part of the tapmusig.SessionStore interface.
+func (m *MuSig2Sessions) UpdateSession(ctx context.Context,
+	session *tapmusig.Session) error {
+
+	vPktBytes, err := encodeVPacket(session.VPacket)
+	if err != nil {
+		return err
+	}
+
+	var finalSig []byte
+	if session.FinalSig != nil {
+		finalSig = session.FinalSig.Serialize()
+	}
+
+	var writeTx MuSig2SessionTxOptions
+	return m.db.ExecTx(ctx, &writeTx, func(q MuSig2SessionStore) error {
+		err := q.UpdateMuSig2Session(ctx, MuSig2SessionUpdate{
+			VirtualPacket: vPktBytes,
+			FinalSig:      finalSig,
+			State:         int16(session.State),
+			SessionID:     session.ID[:],
+		})
+		if err != nil {
+			return fmt.Errorf("unable to update musig2 session: %w",
+				err)
+		}
+
+		return upsertSigners(ctx, q, session)
+	})
+}
+
+// FetchSession returns the session with the given ID.
+//
+// NOTE: This is part of the tapmusig.SessionStore interface.
+func (m *MuSig2Sessions) FetchSession(ctx context.Context,
+	id [32]byte) (*tapmusig.Session, error) {
+
+	var (
+		session *tapmusig.Session
+		readTx  = NewMuSig2SessionReadTx()
+	)
+	err := m.db.ExecTx(ctx, &readTx, func(q MuSig2SessionStore) error {
+		dbSession, err := q.FetchMuSig2Session(ctx, id[:])
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return fmt.Errorf("%w: %x", tapmusig.ErrSessionNotFound,
+				id[:])
+
+		case err != nil:
+			return fmt.Errorf("unable to fetch musig2 session: %w",
+				err)
+		}
+
+		session, err = fetchSession(ctx, q, dbSession)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// QuerySessions returns all sessions, optionally filtered by state.
+//
+// NOTE: This is part of the tapmusig.SessionStore interface.
+func (m *MuSig2Sessions) QuerySessions(ctx context.Context,
+	state fn.Option[tapmusig.SessionState]) ([]*tapmusig.Session, error) {
+
+	var stateFilter sql.NullInt16
+	state.WhenSome(func(s tapmusig.SessionState) {
+		stateFilter = sql.NullInt16{
+			Int16: int16(s),
+			Valid: true,
+		}
+	})
+
+	var (
+		sessions []*tapmusig.Session
+		readTx   = NewMuSig2SessionReadTx()
+	)
+	err := m.db.ExecTx(ctx, &readTx, func(q MuSig2SessionStore) error {
+		dbSessions, err := q.QueryMuSig2Sessions(ctx, stateFilter)
+		if err != nil {
+			return fmt.Errorf("unable to query musig2 sessions: %w",
+				err)
+		}
+
+		sessions = make([]*tapmusig.Session, 0, len(dbSessions))
+		for _, dbSession := range dbSessions {
+			session, err := fetchSession(ctx, q, dbSession)
+			if err != nil {
+				return err
+			}
+
+			sessions = append(sessions, session)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// fetchSession decodes the given session and fetches its signers.
+func fetchSession(ctx context.Context, q MuSig2SessionStore,
+	dbSession MuSig2Session) (*tapmusig.Session, error) {
+
+	session := &tapmusig.Session{
+		InputIndex: uint32(dbSession.InputIndex),
+		LocalKey: keychain.KeyLocator{
+			Family: keychain.KeyFamily(dbSession.LocalKeyFamily),
+			Index:  uint32(dbSession.LocalKeyIndex),
+		},
+		State:        tapmusig.SessionState(dbSession.State),
+		CreationTime: dbSession.CreationTime.UTC(),
+	}
+	copy(session.ID[:], dbSession.SessionID)
+	copy(session.SigHash[:], dbSession.SigHash)
+
+	var err error
+	session.VPacket, err = tappsbt.NewFromRawBytes(
+		bytes.NewReader(dbSession.VirtualPacket), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode virtual packet: %w",
+			err)
+	}
+
+	session.CombinedKey, err = schnorr.ParsePubKey(dbSession.CombinedKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode combined key: %w", err)
+	}
+
+	if len(dbSession.FinalSig) != 0 {
+		session.FinalSig, err = schnorr.ParseSignature(
+			dbSession.FinalSig,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode final "+
+				"signature: %w", err)
+		}
+	}
+
+	dbSigners, err := q.FetchMuSig2SessionSigners(ctx, dbSession.SessionID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch signers: %w", err)
+	}
+
+	session.Participants = make([]*tapmusig.Participant, 0, len(dbSigners))
+	for _, dbSigner := range dbSigners {
+		pubKey, err := btcec.ParsePubKey(dbSigner.SignerKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode signer key: "+
+				"%w", err)
+		}
+
+		participant := &tapmusig.Participant{
+			PubKey:     pubKey,
+			Local:      dbSigner.IsLocal,
+			PartialSig: dbSigner.PartialSig,
+		}
+		if len(dbSigner.PubNonce) == musig2.PubNonceSize {
+			var nonce [musig2.PubNonceSize]byte
+			copy(nonce[:], dbSigner.PubNonce)
+			participant.PubNonce = fn.Some(nonce)
+		}
+
+		session.Participants = append(
+			session.Participants, participant,
+		)
+	}
+
+	return session, nil
+}
+
+// A compile-time assertion to make sure MuSig2Sessions satisfies the
+// tapmusig.SessionStore interface.
+var _ tapmusig.SessionStore = (*MuSig2Sessions)(nil)
@@ -0,0 +1,181 @@
+package tapdb
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapmusig"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// assertVPacketEqual asserts that the two virtual packets encode to the same
+// bytes.
+func assertVPacketEqual(t *testing.T, expected, actual *tappsbt.VPacket) {
+	var expectedBuf, actualBuf bytes.Buffer
+	require.NoError(t, expected.Serialize(&expectedBuf))
+	require.NoError(t, actual.Serialize(&actualBuf))
+	require.Equal(t, expectedBuf.Bytes(), actualBuf.Bytes())
+}
+
+// randVPacket creates a random virtual packet for an interactive send.
+func randVPacket(t *testing.T) *tappsbt.VPacket {
+	return tappsbt.ForInteractiveSend(
+		asset.RandID(t), 1000, asset.RandScriptKey(t), 0, 0, 0,
+		keychain.KeyDescriptor{
+			PubKey: test.RandPubKey(t),
+		}, asset.V1, chainParams,
+	)
+}
+
+// TestMuSig2SessionStore tests that MuSig2 sessions can be inserted, updated
+// and queried.
+func TestMuSig2SessionStore(t *testing.T) {
+	t.Parallel()
+
+	db := NewTestDB(t)
+	store := NewMuSig2Sessions(
+		NewTransactionExecutor(db, func(tx *sql.Tx) MuSig2SessionStore {
+			return db.WithTx(tx)
+		}),
+	)
+	ctx := context.Background()
+
+	localKey := test.RandPubKey(t)
+	remoteKey := test.RandPubKey(t)
+	localNonce := [musig2.PubNonceSize]byte{1, 2, 3}
+
+	session := &tapmusig.Session{
+		ID:         [32]byte{1},
+		VPacket:    randVPacket(t),
+		InputIndex: 0,
+		LocalKey: keychain.KeyLocator{
+			Family: keychain.KeyFamily(212),
+			Index:  7,
+		},
+		CombinedKey: test.RandPubKey(t),
+		SigHash:     [32]byte{2},
+		Participants: []*tapmusig.Participant{{
+			PubKey:   localKey,
+			Local:    true,
+			PubNonce: fn.Some(localNonce),
+		}, {
+			PubKey: remoteKey,
+		}},
+		State:        tapmusig.StateCollectingNonces,
+		CreationTime: time.Now().UTC().Truncate(time.Second),
+	}
+	require.NoError(t, store.InsertSession(ctx, session))
+
+	// Unknown sessions can't be fetched.
+	_, err := store.FetchSession(ctx, [32]byte{9})
+	require.ErrorIs(t, err, tapmusig.ErrSessionNotFound)
+
+	assertSession := func(expected *tapmusig.Session) {
+		t.Helper()
+
+		dbSession, err := store.FetchSession(ctx, expected.ID)
+		require.NoError(t, err)
+
+		require.Equal(t, expected.ID, dbSession.ID)
+		require.Equal(t, expected.InputIndex, dbSession.InputIndex)
+		require.Equal(t, expected.LocalKey, dbSession.LocalKey)
+		require.Equal(
+			t, schnorr.SerializePubKey(expected.CombinedKey),
+			schnorr.SerializePubKey(dbSession.CombinedKey),
+		)
+		require.Equal(t, expected.SigHash, dbSession.SigHash)
+		require.Equal(t, expected.State, dbSession.State)
+		require.Equal(
+			t, expected.CreationTime.Unix(),
+			dbSession.CreationTime.Unix(),
+		)
+		assertVPacketEqual(t, expected.VPacket, dbSession.VPacket)
+
+		if expected.FinalSig == nil {
+			require.Nil(t, dbSession.FinalSig)
+		} else {
+			require.True(t, expected.FinalSig.IsEqual(
+				dbSession.FinalSig,
+			))
+		}
+
+		require.Len(t, dbSession.Participants, 2)
+		for _, p := range expected.Participants {
+			var dbP *tapmusig.Participant
+			for _, candidate := range dbSession.Participants {
+				if candidate.PubKey.IsEqual(p.PubKey) {
+					dbP = candidate
+				}
+			}
+			require.NotNil(t, dbP)
+			require.Equal(t, p.Local, dbP.Local)
+			require.Equal(t, p.PubNonce, dbP.PubNonce)
+			require.Equal(t, p.PartialSig, dbP.PartialSig)
+		}
+	}
+	assertSession(session)
+
+	// Contribute the remote nonce and both partial signatures, then
+	// finalize the session.
+	remoteNonce := [musig2.PubNonceSize]byte{4, 5, 6}
+	session.Participants[1].PubNonce = fn.Some(remoteNonce)
+	session.State = tapmusig.StateCollectingSigs
+	require.NoError(t, store.UpdateSession(ctx, session))
+	assertSession(session)
+
+	session.Participants[0].PartialSig = bytes.Repeat([]byte{7}, 32)
+	session.Participants[1].PartialSig = bytes.Repeat([]byte{8}, 32)
+	session.FinalSig, err = schnorr.ParseSignature(
+		append(
+			schnorr.SerializePubKey(test.RandPubKey(t)),
+			bytes.Repeat([]byte{1}, 32)...,
+		),
+	)
+	require.NoError(t, err)
+	session.VPacket = randVPacket(t)
+	session.State = tapmusig.StateFinalized
+	require.NoError(t, store.UpdateSession(ctx, session))
+	assertSession(session)
+
+	// A second, aborted session is only returned when not filtering for
+	// finalized sessions.
+	aborted := &tapmusig.Session{
+		ID:          [32]byte{3},
+		VPacket:     randVPacket(t),
+		CombinedKey: test.RandPubKey(t),
+		Participants: []*tapmusig.Participant{{
+			PubKey: localKey,
+			Local:  true,
+		}, {
+			PubKey: remoteKey,
+		}},
+		State:        tapmusig.StateAborted,
+		CreationTime: session.CreationTime.Add(time.Second),
+	}
+	require.NoError(t, store.InsertSession(ctx, aborted))
+
+	sessions, err := store.QuerySessions(
+		ctx, fn.None[tapmusig.SessionState](),
+	)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	require.Equal(t, session.ID, sessions[0].ID)
+	require.Equal(t, aborted.ID, sessions[1].ID)
+
+	sessions, err = store.QuerySessions(
+		ctx, fn.Some(tapmusig.StateFinalized),
+	)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, session.ID, sessions[0].ID)
+}
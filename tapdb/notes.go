@@ -0,0 +1,94 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/wire"
+)
+
+const (
+	// MaxNoteLength is the maximum length in bytes of a user-defined note
+	// of an address or an anchor output.
+	MaxNoteLength = 1024
+)
+
+var (
+	// ErrNoteTooLong is returned if a user-defined note exceeds the
+	// maximum note length.
+	ErrNoteTooLong = fmt.Errorf("note exceeds maximum length of %d bytes",
+		MaxNoteLength)
+)
+
+// validateNote makes sure the given user-defined note can be stored.
+func validateNote(note string) error {
+	if len(note) > MaxNoteLength {
+		return ErrNoteTooLong
+	}
+
+	return nil
+}
+
+// SetUtxoNote sets the user-defined note of the anchor output with the given
+// outpoint. An empty note removes an existing note. Notes are keyed by
+// outpoint, so a note can be set before the output is known to the wallet and
+// is kept after the output was spent.
+func (a *AssetStore) SetUtxoNote(ctx context.Context, outpoint wire.OutPoint,
+	note string) error {
+
+	if err := validateNote(note); err != nil {
+		return err
+	}
+
+	outpointBytes, err := encodeOutpoint(outpoint)
+	if err != nil {
+		return err
+	}
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		if note == "" {
+			return q.DeleteUtxoNote(ctx, outpointBytes)
+		}
+
+		return q.UpsertUtxoNote(ctx, NewUtxoNote{
+			Outpoint:  outpointBytes,
+			Note:      note,
+			UpdatedAt: a.clock.Now().UTC(),
+		})
+	})
+}
+
+// UtxoNote returns the user-defined note of the anchor output with the given
+// outpoint. An empty string is returned if no note is set.
+func (a *AssetStore) UtxoNote(ctx context.Context,
+	outpoint wire.OutPoint) (string, error) {
+
+	outpointBytes, err := encodeOutpoint(outpoint)
+	if err != nil {
+		return "", err
+	}
+
+	var note string
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		dbNote, err := q.FetchUtxoNote(ctx, outpointBytes)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil
+
+		case err != nil:
+			return err
+		}
+
+		note = dbNote.Note
+		return nil
+	})
+	if dbErr != nil {
+		return "", dbErr
+	}
+
+	return note, nil
+}
@@ -0,0 +1,143 @@
+package tapdb
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAddrNotes tests that notes can be set on addresses, are returned when
+// querying addresses and can be removed again.
+func TestAddrNotes(t *testing.T) {
+	t.Parallel()
+
+	testClock := clock.NewTestClock(time.Now())
+	addrBook, _ := newAddrBook(t, testClock)
+
+	ctx := context.Background()
+
+	proofCourierAddr := address.RandProofCourierAddr(t)
+	addr, assetGen, assetGroup := address.RandAddr(
+		t, chainParams, proofCourierAddr,
+	)
+
+	var writeTxOpts AddrBookTxOptions
+	err := addrBook.db.ExecTx(
+		ctx, &writeTxOpts,
+		insertFullAssetGen(ctx, assetGen, assetGroup),
+	)
+	require.NoError(t, err)
+	require.NoError(t, addrBook.InsertAddrs(ctx, *addr))
+
+	// An address without a note should be returned with an empty note.
+	dbAddr, err := addrBook.AddrByTaprootOutput(ctx, &addr.TaprootOutputKey)
+	require.NoError(t, err)
+	require.Empty(t, dbAddr.Note)
+
+	// Setting a note on an unknown address should fail.
+	unknownKey := test.RandPubKey(t)
+	err = addrBook.SetAddrNote(ctx, unknownKey, "unknown")
+	require.ErrorIs(t, err, address.ErrNoAddr)
+
+	// A note that is too long should be rejected.
+	err = addrBook.SetAddrNote(
+		ctx, &addr.TaprootOutputKey,
+		strings.Repeat("a", MaxNoteLength+1),
+	)
+	require.ErrorIs(t, err, ErrNoteTooLong)
+
+	// Set the note, then overwrite it. Only the latest note should be
+	// returned, both for a single address and in the address listing.
+	err = addrBook.SetAddrNote(ctx, &addr.TaprootOutputKey, "tranche 2")
+	require.NoError(t, err)
+	err = addrBook.SetAddrNote(ctx, &addr.TaprootOutputKey, "tranche 3")
+	require.NoError(t, err)
+
+	dbAddr, err = addrBook.AddrByTaprootOutput(ctx, &addr.TaprootOutputKey)
+	require.NoError(t, err)
+	require.Equal(t, "tranche 3", dbAddr.Note)
+
+	dbAddrs, err := addrBook.QueryAddrs(ctx, address.QueryParams{})
+	require.NoError(t, err)
+	require.Len(t, dbAddrs, 1)
+	require.Equal(t, "tranche 3", dbAddrs[0].Note)
+
+	// An empty note removes the existing note.
+	err = addrBook.SetAddrNote(ctx, &addr.TaprootOutputKey, "")
+	require.NoError(t, err)
+
+	dbAddr, err = addrBook.AddrByTaprootOutput(ctx, &addr.TaprootOutputKey)
+	require.NoError(t, err)
+	require.Empty(t, dbAddr.Note)
+}
+
+// TestUtxoNotes tests that notes can be set on anchor outputs, are returned
+// when listing the managed UTXOs and can be removed again.
+func TestUtxoNotes(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	assetGen := newAssetGenerator(t, 1, 1)
+	assetGen.genAssets(t, assetsStore, []assetDesc{{
+		assetGen:    assetGen.assetGens[0],
+		anchorPoint: assetGen.anchorPoints[0],
+		amt:         10,
+	}})
+	anchorPoint := assetGen.anchorPoints[0]
+
+	note, err := assetsStore.UtxoNote(ctx, anchorPoint)
+	require.NoError(t, err)
+	require.Empty(t, note)
+
+	// A note that is too long should be rejected.
+	err = assetsStore.SetUtxoNote(
+		ctx, anchorPoint, strings.Repeat("a", MaxNoteLength+1),
+	)
+	require.ErrorIs(t, err, ErrNoteTooLong)
+
+	// Set the note, then overwrite it. Only the latest note should be
+	// returned.
+	err = assetsStore.SetUtxoNote(ctx, anchorPoint, "cold storage")
+	require.NoError(t, err)
+	err = assetsStore.SetUtxoNote(ctx, anchorPoint, "cold storage 3")
+	require.NoError(t, err)
+
+	note, err = assetsStore.UtxoNote(ctx, anchorPoint)
+	require.NoError(t, err)
+	require.Equal(t, "cold storage 3", note)
+
+	utxos, err := assetsStore.FetchManagedUTXOs(ctx)
+	require.NoError(t, err)
+	require.Len(t, utxos, 1)
+	require.Equal(t, anchorPoint, utxos[0].OutPoint)
+	require.Equal(t, "cold storage 3", utxos[0].Note)
+
+	// Notes can also be set on outputs the wallet doesn't know about (yet),
+	// those shouldn't show up in the listing.
+	unknownPoint := test.RandOp(t)
+	err = assetsStore.SetUtxoNote(ctx, unknownPoint, "incoming")
+	require.NoError(t, err)
+
+	utxos, err = assetsStore.FetchManagedUTXOs(ctx)
+	require.NoError(t, err)
+	require.Len(t, utxos, 1)
+
+	// An empty note removes the existing note.
+	require.NoError(t, assetsStore.SetUtxoNote(ctx, anchorPoint, ""))
+
+	note, err = assetsStore.UtxoNote(ctx, anchorPoint)
+	require.NoError(t, err)
+	require.Empty(t, note)
+
+	utxos, err = assetsStore.FetchManagedUTXOs(ctx)
+	require.NoError(t, err)
+	require.Empty(t, utxos[0].Note)
+}
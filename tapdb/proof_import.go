@@ -0,0 +1,247 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+type (
+	// NewProofImportItem is used to insert a new item of a bulk proof
+	// import job.
+	NewProofImportItem = sqlc.InsertProofImportItemParams
+
+	// ProofImportItemUpdate is used to update the status of an item of a
+	// bulk proof import job.
+	ProofImportItemUpdate = sqlc.UpdateProofImportItemParams
+
+	// ProofImportJob is a bulk proof import job as stored in the database.
+	ProofImportJob = sqlc.ProofImportJob
+
+	// ProofImportItem is an item of a bulk proof import job as returned by
+	// a query.
+	ProofImportItem = sqlc.FetchProofImportItemsRow
+)
+
+// ProofImportStore is the set of queries needed to persist bulk proof import
+// jobs.
+type ProofImportStore interface {
+	// InsertProofImportJob inserts a new bulk proof import job and
+	// returns its ID.
+	InsertProofImportJob(ctx context.Context,
+		createdAt time.Time) (int64, error)
+
+	// InsertProofImportItem inserts a new item of a bulk proof import job.
+	InsertProofImportItem(ctx context.Context,
+		arg NewProofImportItem) error
+
+	// UpdateProofImportItem updates the status of an item of a bulk proof
+	// import job.
+	UpdateProofImportItem(ctx context.Context,
+		arg ProofImportItemUpdate) error
+
+	// FetchProofImportJob fetches the bulk proof import job with the given
+	// ID.
+	FetchProofImportJob(ctx context.Context,
+		jobID int64) (ProofImportJob, error)
+
+	// FetchProofImportItems fetches all items of the bulk proof import job
+	// with the given ID, ordered by their index.
+	FetchProofImportItems(ctx context.Context,
+		jobID int64) ([]ProofImportItem, error)
+
+	// FetchPendingProofImportJobs returns the IDs of all bulk proof import
+	// jobs that still have pending items.
+	FetchPendingProofImportJobs(ctx context.Context) ([]int64, error)
+}
+
+// ProofImportTxOptions defines the set of db txn options the ProofImportStore
+// understands.
+type ProofImportTxOptions struct {
+	// readOnly governs if a read only transaction is needed or not.
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (p *ProofImportTxOptions) ReadOnly() bool {
+	return p.readOnly
+}
+
+// NewProofImportReadTx creates a new read transaction option set.
+func NewProofImportReadTx() ProofImportTxOptions {
+	return ProofImportTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedProofImportStore is the main storage interface for the bulk proof
+// importer. It supports all the basic queries as well as running the set of
+// queries in a single database transaction.
+type BatchedProofImportStore interface {
+	ProofImportStore
+
+	BatchedTx[ProofImportStore]
+}
+
+// ProofImportJobs is a database backed implementation of the
+// proof.ImportJobStore interface.
+type ProofImportJobs struct {
+	db BatchedProofImportStore
+
+	clock clock.Clock
+}
+
+// NewProofImportJobs creates a new database backed bulk proof import job
+// store.
+func NewProofImportJobs(db BatchedProofImportStore,
+	clock clock.Clock) *ProofImportJobs {
+
+	return &ProofImportJobs{
+		db:    db,
+		clock: clock,
+	}
+}
+
+// NewImportJob stores a new bulk import job with all items in the pending
+// state and returns the ID of the job.
+//
+// NOTE: This is part of the proof.ImportJobStore interface.
+func (p *ProofImportJobs) NewImportJob(ctx context.Context,
+	blobs []proof.Blob) (int64, error) {
+
+	var (
+		jobID   int64
+		writeTx ProofImportTxOptions
+	)
+	err := p.db.ExecTx(ctx, &writeTx, func(q ProofImportStore) error {
+		var err error
+		jobID, err = q.InsertProofImportJob(ctx, p.clock.Now().UTC())
+		if err != nil {
+			return fmt.Errorf("unable to insert job: %w", err)
+		}
+
+		for idx := range blobs {
+			err := q.InsertProofImportItem(ctx, NewProofImportItem{
+				JobID:     jobID,
+				ItemIndex: int32(idx),
+				ProofFile: blobs[idx],
+				Status:    int16(proof.ImportStatusPending),
+			})
+			if err != nil {
+				return fmt.Errorf("unable to insert item %d: "+
+					"%w", idx, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return jobID, nil
+}
+
+// UpdateImportItem updates the status of a single item of a job.
+//
+// NOTE: This is part of the proof.ImportJobStore interface.
+func (p *ProofImportJobs) UpdateImportItem(ctx context.Context, jobID int64,
+	index uint32, status proof.ImportStatus, reason string) error {
+
+	var writeTx ProofImportTxOptions
+	return p.db.ExecTx(ctx, &writeTx, func(q ProofImportStore) error {
+		return q.UpdateProofImportItem(ctx, ProofImportItemUpdate{
+			Status:    int16(status),
+			Reason:    sqlStr(reason),
+			JobID:     jobID,
+			ItemIndex: int32(index),
+		})
+	})
+}
+
+// FetchImportJob returns the job with the given ID, including all its items.
+//
+// NOTE: This is part of the proof.ImportJobStore interface.
+func (p *ProofImportJobs) FetchImportJob(ctx context.Context,
+	jobID int64) (*proof.ImportJob, error) {
+
+	var (
+		job    *proof.ImportJob
+		readTx = NewProofImportReadTx()
+	)
+	err := p.db.ExecTx(ctx, &readTx, func(q ProofImportStore) error {
+		dbJob, err := q.FetchProofImportJob(ctx, jobID)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return proof.ErrImportJobNotFound
+
+		case err != nil:
+			return fmt.Errorf("unable to fetch job: %w", err)
+		}
+
+		dbItems, err := q.FetchProofImportItems(ctx, jobID)
+		if err != nil {
+			return fmt.Errorf("unable to fetch items: %w", err)
+		}
+
+		job = &proof.ImportJob{
+			ID:        dbJob.ID,
+			CreatedAt: dbJob.CreatedAt.UTC(),
+			Items:     fn.Map(dbItems, parseProofImportItem),
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// parseProofImportItem converts a database bulk proof import item into its
+// proof package representation.
+func parseProofImportItem(i ProofImportItem) *proof.ImportItem {
+	return &proof.ImportItem{
+		Index:  uint32(i.ItemIndex),
+		Blob:   i.ProofFile,
+		Status: proof.ImportStatus(i.Status),
+		Reason: i.Reason.String,
+	}
+}
+
+// PendingImportJobs returns the IDs of all jobs that still have at least one
+// item in the pending state.
+//
+// NOTE: This is part of the proof.ImportJobStore interface.
+func (p *ProofImportJobs) PendingImportJobs(
+	ctx context.Context) ([]int64, error) {
+
+	var (
+		jobIDs []int64
+		readTx = NewProofImportReadTx()
+	)
+	err := p.db.ExecTx(ctx, &readTx, func(q ProofImportStore) error {
+		var err error
+		jobIDs, err = q.FetchPendingProofImportJobs(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return jobIDs, nil
+}
+
+// A compile-time assertion to make sure ProofImportJobs satisfies the
+// proof.ImportJobStore interface.
+var _ proof.ImportJobStore = (*ProofImportJobs)(nil)
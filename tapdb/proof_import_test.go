@@ -0,0 +1,109 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestProofImportJobs(t *testing.T,
+	clock clock.Clock) *ProofImportJobs {
+
+	db := NewTestDB(t)
+
+	dbTxer := NewTransactionExecutor(db,
+		func(tx *sql.Tx) ProofImportStore {
+			return db.WithTx(tx)
+		},
+	)
+
+	return NewProofImportJobs(dbTxer, clock)
+}
+
+// TestProofImportJobs tests that bulk proof import jobs and the status of
+// their items can be persisted and queried.
+func TestProofImportJobs(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx       = context.Background()
+		now       = time.Unix(1700000000, 0).UTC()
+		testClock = clock.NewTestClock(now)
+		store     = newTestProofImportJobs(t, testClock)
+	)
+
+	// Fetching an unknown job should return the proper error.
+	_, err := store.FetchImportJob(ctx, 1234)
+	require.ErrorIs(t, err, proof.ErrImportJobNotFound)
+
+	// Without any jobs, there should be nothing pending.
+	pending, err := store.PendingImportJobs(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	// We'll now add two jobs, both should be pending.
+	blobs := []proof.Blob{
+		test.RandBytes(100), test.RandBytes(200), test.RandBytes(300),
+	}
+	jobID1, err := store.NewImportJob(ctx, blobs)
+	require.NoError(t, err)
+
+	jobID2, err := store.NewImportJob(ctx, blobs[:1])
+	require.NoError(t, err)
+
+	pending, err = store.PendingImportJobs(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []int64{jobID1, jobID2}, pending)
+
+	job, err := store.FetchImportJob(ctx, jobID1)
+	require.NoError(t, err)
+	require.Equal(t, jobID1, job.ID)
+	require.Equal(t, now, job.CreatedAt)
+	require.Len(t, job.Items, len(blobs))
+	for idx, item := range job.Items {
+		require.EqualValues(t, idx, item.Index)
+		require.Equal(t, blobs[idx], item.Blob)
+		require.Equal(t, proof.ImportStatusPending, item.Status)
+		require.Empty(t, item.Reason)
+	}
+
+	// We now update the items of the first job. Once all of them are no
+	// longer pending, the job should not be returned as pending anymore.
+	err = store.UpdateImportItem(
+		ctx, jobID1, 0, proof.ImportStatusImported, "",
+	)
+	require.NoError(t, err)
+	err = store.UpdateImportItem(
+		ctx, jobID1, 1, proof.ImportStatusInvalid, "bad proof",
+	)
+	require.NoError(t, err)
+
+	pending, err = store.PendingImportJobs(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []int64{jobID1, jobID2}, pending)
+
+	err = store.UpdateImportItem(
+		ctx, jobID1, 2, proof.ImportStatusDuplicate, "",
+	)
+	require.NoError(t, err)
+
+	pending, err = store.PendingImportJobs(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []int64{jobID2}, pending)
+
+	job, err = store.FetchImportJob(ctx, jobID1)
+	require.NoError(t, err)
+	require.Equal(t, proof.ImportProgress{
+		Total:     3,
+		Imported:  1,
+		Duplicate: 1,
+		Invalid:   1,
+	}, job.Progress())
+	require.Equal(t, "bad proof", job.Items[1].Reason)
+}
@@ -0,0 +1,291 @@
+package tapdb
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultReadSnapshotTTL is the default duration a read snapshot is
+	// kept open for after it was created.
+	DefaultReadSnapshotTTL = time.Minute
+
+	// DefaultMaxReadSnapshots is the default maximum number of read
+	// snapshots that can be open at the same time. As each snapshot holds
+	// on to a database connection, this is kept well below the size of
+	// the connection pool.
+	DefaultMaxReadSnapshots = 4
+
+	// DefaultMaxReadSnapshotsPerClient is the default maximum number of
+	// read snapshots a single client can have open at the same time, so a
+	// single client can't take all of them.
+	DefaultMaxReadSnapshotsPerClient = 1
+
+	// readSnapshotTokenSize is the size of a read snapshot token in bytes.
+	readSnapshotTokenSize = 16
+)
+
+var (
+	// ErrReadSnapshotNotFound is returned if a read snapshot token is
+	// unknown, which is also the case once the snapshot expired.
+	ErrReadSnapshotNotFound = errors.New("read snapshot not found or " +
+		"expired")
+
+	// ErrTooManyReadSnapshots is returned if a new read snapshot is
+	// requested while the maximum number of snapshots is already open,
+	// either in total or by the requesting client.
+	ErrTooManyReadSnapshots = errors.New("too many open read snapshots")
+)
+
+// ReadSnapshotToken identifies an open read snapshot.
+type ReadSnapshotToken [readSnapshotTokenSize]byte
+
+// String returns the hex encoded token.
+func (t ReadSnapshotToken) String() string {
+	return hex.EncodeToString(t[:])
+}
+
+// ParseReadSnapshotToken parses a hex encoded read snapshot token.
+func ParseReadSnapshotToken(s string) (ReadSnapshotToken, error) {
+	var token ReadSnapshotToken
+
+	tokenBytes, err := hex.DecodeString(s)
+	if err != nil {
+		return token, fmt.Errorf("invalid read snapshot token: %w", err)
+	}
+	if len(tokenBytes) != readSnapshotTokenSize {
+		return token, fmt.Errorf("invalid read snapshot token length "+
+			"%d, expected %d", len(tokenBytes),
+			readSnapshotTokenSize)
+	}
+
+	copy(token[:], tokenBytes)
+
+	return token, nil
+}
+
+// readSnapshot is an open read-only database transaction that all read-only
+// transactions of the calls using the snapshot are executed in.
+type readSnapshot struct {
+	// tx is the read-only transaction the snapshot is taken in.
+	tx *sql.Tx
+
+	// client identifies the client that opened the snapshot. Only that
+	// client can use the snapshot.
+	client string
+
+	// expiry is the time at which the snapshot is closed.
+	expiry time.Time
+
+	// timer closes the snapshot once it expires.
+	timer *time.Timer
+
+	// closed is true once the transaction of the snapshot was rolled back.
+	closed bool
+
+	// mtx serializes the use of the transaction, as a single transaction
+	// can only execute one query at a time.
+	mtx sync.Mutex
+}
+
+// exec executes the given function with the transaction of the snapshot.
+func (r *readSnapshot) exec(f func(tx *sql.Tx) error) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.closed {
+		return ErrReadSnapshotNotFound
+	}
+
+	return f(r.tx)
+}
+
+// close rolls back the transaction of the snapshot.
+func (r *readSnapshot) close() {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.closed {
+		return
+	}
+
+	r.timer.Stop()
+	r.closed = true
+
+	if err := r.tx.Rollback(); err != nil {
+		log.Warnf("Unable to roll back read snapshot: %v", err)
+	}
+}
+
+// readSnapshotCtxKey is the context key a read snapshot is stored under.
+type readSnapshotCtxKey struct{}
+
+// readSnapshotFromContext returns the read snapshot attached to the given
+// context, if any.
+func readSnapshotFromContext(ctx context.Context) (*readSnapshot, bool) {
+	snapshot, ok := ctx.Value(readSnapshotCtxKey{}).(*readSnapshot)
+	return snapshot, ok
+}
+
+// InReadSnapshot returns true if the given context has a read snapshot
+// attached. Reads made with such a context must bypass any in-memory caches,
+// as those might contain data that is newer than the snapshot.
+func InReadSnapshot(ctx context.Context) bool {
+	_, ok := readSnapshotFromContext(ctx)
+	return ok
+}
+
+// ReadSnapshots manages the read snapshots of the database. A read snapshot
+// is a long-lived read-only transaction that is identified by a token. All
+// read-only transactions executed with a context the snapshot is attached to
+// run within that transaction, so a sequence of calls observes a single
+// consistent state of the database, while concurrent writes continue to be
+// committed. Snapshots are bound to the client that opened them and are closed
+// once they expire.
+type ReadSnapshots struct {
+	db BatchedQuerier
+
+	ttl time.Duration
+
+	maxSnapshots int
+
+	maxPerClient int
+
+	snapshots map[ReadSnapshotToken]*readSnapshot
+
+	mtx sync.Mutex
+}
+
+// NewReadSnapshots creates a new read snapshot manager for the given
+// database.
+func NewReadSnapshots(db BatchedQuerier, ttl time.Duration, maxSnapshots,
+	maxPerClient int) *ReadSnapshots {
+
+	return &ReadSnapshots{
+		db:           db,
+		ttl:          ttl,
+		maxSnapshots: maxSnapshots,
+		maxPerClient: maxPerClient,
+		snapshots:    make(map[ReadSnapshotToken]*readSnapshot),
+	}
+}
+
+// Open creates a new read snapshot of the current state of the database for
+// the given client and returns its token together with the time the snapshot
+// expires at.
+func (r *ReadSnapshots) Open(ctx context.Context,
+	client string) (ReadSnapshotToken, time.Time, error) {
+
+	var token ReadSnapshotToken
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if len(r.snapshots) >= r.maxSnapshots {
+		return token, time.Time{}, ErrTooManyReadSnapshots
+	}
+
+	var numClientSnapshots int
+	for _, snapshot := range r.snapshots {
+		if snapshot.client == client {
+			numClientSnapshots++
+		}
+	}
+	if numClientSnapshots >= r.maxPerClient {
+		return token, time.Time{}, ErrTooManyReadSnapshots
+	}
+
+	if _, err := rand.Read(token[:]); err != nil {
+		return token, time.Time{}, fmt.Errorf("unable to create read "+
+			"snapshot token: %w", err)
+	}
+
+	// The transaction outlives the call that created it, so it must not
+	// be bound to the context of the call.
+	readTx := NewAssetStoreReadTx()
+	tx, err := r.db.BeginTx(context.Background(), &readTx)
+	if err != nil {
+		return token, time.Time{}, fmt.Errorf("unable to begin read "+
+			"snapshot: %w", MapSQLError(err))
+	}
+
+	// Both SQLite and Postgres only take the snapshot of a transaction
+	// with its first read, so we do that right away.
+	var numRoots int64
+	err = tx.QueryRowContext(
+		ctx, "SELECT COUNT(*) FROM mssmt_roots",
+	).Scan(&numRoots)
+	if err != nil {
+		_ = tx.Rollback()
+		return token, time.Time{}, fmt.Errorf("unable to take read "+
+			"snapshot: %w", MapSQLError(err))
+	}
+
+	snapshot := &readSnapshot{
+		tx:     tx,
+		client: client,
+		expiry: time.Now().Add(r.ttl),
+	}
+	snapshot.timer = time.AfterFunc(r.ttl, func() {
+		r.Close(token)
+	})
+	r.snapshots[token] = snapshot
+
+	log.Debugf("Opened read snapshot %v, expiry=%v", token,
+		snapshot.expiry)
+
+	return token, snapshot.expiry, nil
+}
+
+// Attach returns a copy of the given context that the read snapshot with the
+// given token is attached to, together with the time the snapshot expires at.
+// A snapshot can only be attached for the client that opened it.
+func (r *ReadSnapshots) Attach(ctx context.Context, token ReadSnapshotToken,
+	client string) (context.Context, time.Time, error) {
+
+	r.mtx.Lock()
+	snapshot, ok := r.snapshots[token]
+	r.mtx.Unlock()
+
+	if !ok || snapshot.client != client {
+		return nil, time.Time{}, ErrReadSnapshotNotFound
+	}
+
+	return context.WithValue(ctx, readSnapshotCtxKey{}, snapshot),
+		snapshot.expiry, nil
+}
+
+// Close closes the read snapshot with the given token. Closing an unknown
+// snapshot is a no-op.
+func (r *ReadSnapshots) Close(token ReadSnapshotToken) {
+	r.mtx.Lock()
+	snapshot, ok := r.snapshots[token]
+	delete(r.snapshots, token)
+	r.mtx.Unlock()
+
+	if !ok {
+		return
+	}
+
+	snapshot.close()
+
+	log.Debugf("Closed read snapshot %v", token)
+}
+
+// Stop closes all open read snapshots.
+func (r *ReadSnapshots) Stop() {
+	r.mtx.Lock()
+	snapshots := r.snapshots
+	r.snapshots = make(map[ReadSnapshotToken]*readSnapshot)
+	r.mtx.Unlock()
+
+	for _, snapshot := range snapshots {
+		snapshot.close()
+	}
+}
@@ -0,0 +1,133 @@
+package tapdb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReadSnapshots tests that reads made within a read snapshot don't observe
+// writes that were committed after the snapshot was taken, while other reads
+// do.
+func TestReadSnapshots(t *testing.T) {
+	t.Parallel()
+
+	db := NewTestDB(t)
+	multiverse, _ := newTestMultiverseWithDb(db.BaseDB)
+	ctx := context.Background()
+
+	snapshots := NewReadSnapshots(db.BaseDB, time.Minute, 2, 1)
+	t.Cleanup(snapshots.Stop)
+
+	id := randUniverseID(t, false)
+	id.ProofType = universe.ProofTypeIssuance
+
+	insertLeaf := func() universe.LeafKey {
+		leaf := randMintingLeaf(
+			t, asset.RandGenesis(t, asset.Normal), id.GroupKey,
+		)
+		key := randLeafKey(t)
+		_, err := multiverse.UpsertProofLeaf(ctx, id, key, &leaf, nil)
+		require.NoError(t, err)
+
+		return key
+	}
+
+	firstKey := insertLeaf()
+
+	const client, otherClient = "client", "other-client"
+	token, expiry, err := snapshots.Open(ctx, client)
+	require.NoError(t, err)
+	require.True(t, expiry.After(time.Now()))
+
+	parsedToken, err := ParseReadSnapshotToken(token.String())
+	require.NoError(t, err)
+	require.Equal(t, token, parsedToken)
+
+	snapshotCtx, _, err := snapshots.Attach(ctx, token, client)
+	require.NoError(t, err)
+	require.True(t, InReadSnapshot(snapshotCtx))
+	require.False(t, InReadSnapshot(ctx))
+
+	// Other clients can't use the snapshot.
+	_, _, err = snapshots.Attach(ctx, token, otherClient)
+	require.ErrorIs(t, err, ErrReadSnapshotNotFound)
+
+	// A leaf inserted after the snapshot was taken is visible to regular
+	// reads, which also populates the caches.
+	secondKey := insertLeaf()
+
+	keysQuery := universe.UniverseLeafKeysQuery{Id: id}
+	leafKeys, err := multiverse.UniverseLeafKeys(ctx, keysQuery)
+	require.NoError(t, err)
+	require.Len(t, leafKeys, 2)
+
+	root, err := multiverse.UniverseRootNode(ctx, id)
+	require.NoError(t, err)
+
+	// Reads within the snapshot bypass the caches and only see the state
+	// at the time the snapshot was taken.
+	leafKeys, err = multiverse.UniverseLeafKeys(snapshotCtx, keysQuery)
+	require.NoError(t, err)
+	require.Len(t, leafKeys, 1)
+
+	snapshotRoot, err := multiverse.UniverseRootNode(snapshotCtx, id)
+	require.NoError(t, err)
+	require.NotEqual(t, root.Node.NodeHash(), snapshotRoot.Node.NodeHash())
+
+	_, err = multiverse.FetchProofLeaf(snapshotCtx, id, firstKey)
+	require.NoError(t, err)
+
+	_, err = multiverse.FetchProofLeaf(snapshotCtx, id, secondKey)
+	require.ErrorIs(t, err, universe.ErrNoUniverseProofFound)
+
+	// Only the configured number of snapshots can be open at a time, both
+	// per client and in total.
+	_, _, err = snapshots.Open(ctx, client)
+	require.ErrorIs(t, err, ErrTooManyReadSnapshots)
+
+	secondToken, _, err := snapshots.Open(ctx, otherClient)
+	require.NoError(t, err)
+
+	_, _, err = snapshots.Open(ctx, "third-client")
+	require.ErrorIs(t, err, ErrTooManyReadSnapshots)
+
+	// Once closed, a snapshot can't be used anymore.
+	snapshots.Close(token)
+	snapshots.Close(secondToken)
+
+	_, _, err = snapshots.Attach(ctx, token, client)
+	require.ErrorIs(t, err, ErrReadSnapshotNotFound)
+
+	_, err = multiverse.UniverseLeafKeys(snapshotCtx, keysQuery)
+	require.ErrorIs(t, err, ErrReadSnapshotNotFound)
+}
+
+// TestReadSnapshotExpiry tests that a read snapshot is closed once it expires.
+func TestReadSnapshotExpiry(t *testing.T) {
+	t.Parallel()
+
+	db := NewTestDB(t)
+	ctx := context.Background()
+
+	const ttl = 50 * time.Millisecond
+	snapshots := NewReadSnapshots(db.BaseDB, ttl, 1, 1)
+	t.Cleanup(snapshots.Stop)
+
+	const client = "client"
+	token, _, err := snapshots.Open(ctx, client)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		_, _, err := snapshots.Attach(ctx, token, client)
+		return err != nil
+	}, time.Second, ttl/5)
+
+	// The expired snapshot no longer counts towards the limit.
+	_, _, err = snapshots.Open(ctx, client)
+	require.NoError(t, err)
+}
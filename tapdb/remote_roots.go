@@ -0,0 +1,169 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+type (
+	// NewRemoteUniverseRoot is used to insert or update the cached root of
+	// a universe on a remote server.
+	NewRemoteUniverseRoot = sqlc.UpsertRemoteUniverseRootParams
+
+	// RemoteUniverseRootQuery is used to query for the cached root of a
+	// universe on a remote server.
+	RemoteUniverseRootQuery = sqlc.FetchRemoteUniverseRootParams
+
+	// RemoteUniverseRoot is the cached root of a universe on a remote
+	// server.
+	RemoteUniverseRoot = sqlc.FetchRemoteUniverseRootRow
+)
+
+// RemoteRootStore is the set of queries needed to cache the roots of
+// universes on remote servers.
+type RemoteRootStore interface {
+	// UpsertRemoteUniverseRoot inserts or updates the cached root of a
+	// universe on a remote server.
+	UpsertRemoteUniverseRoot(ctx context.Context,
+		arg NewRemoteUniverseRoot) error
+
+	// FetchRemoteUniverseRoot fetches the cached root of a universe on a
+	// remote server.
+	FetchRemoteUniverseRoot(ctx context.Context,
+		arg RemoteUniverseRootQuery) (RemoteUniverseRoot, error)
+
+	// DeleteRemoteUniverseRoots deletes all cached roots of the given
+	// remote server.
+	DeleteRemoteUniverseRoots(ctx context.Context, serverHost string) error
+}
+
+// RemoteRootTxOptions defines the set of db txn options the RemoteRootStore
+// understands.
+type RemoteRootTxOptions struct {
+	// readOnly governs if a read only transaction is needed or not.
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (r *RemoteRootTxOptions) ReadOnly() bool {
+	return r.readOnly
+}
+
+// NewRemoteRootReadTx creates a new read transaction option set.
+func NewRemoteRootReadTx() RemoteRootTxOptions {
+	return RemoteRootTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedRemoteRootStore is the main storage interface for the remote
+// universe root cache. It supports all the basic queries as well as running
+// the set of queries in a single database transaction.
+type BatchedRemoteRootStore interface {
+	RemoteRootStore
+
+	BatchedTx[RemoteRootStore]
+}
+
+// RemoteUniverseRoots is a database backed implementation of the
+// universe.RemoteRootCache interface.
+type RemoteUniverseRoots struct {
+	db BatchedRemoteRootStore
+
+	clock clock.Clock
+}
+
+// NewRemoteUniverseRoots creates a new database backed remote universe root
+// cache.
+func NewRemoteUniverseRoots(db BatchedRemoteRootStore,
+	clock clock.Clock) *RemoteUniverseRoots {
+
+	return &RemoteUniverseRoots{
+		db:    db,
+		clock: clock,
+	}
+}
+
+// FetchRemoteRoot returns the cached root of the given universe on the given
+// remote server. If no root is cached, universe.ErrNoRemoteRoot is returned.
+//
+// NOTE: This is part of the universe.RemoteRootCache interface.
+func (r *RemoteUniverseRoots) FetchRemoteRoot(ctx context.Context,
+	host universe.ServerAddr, id universe.Identifier) (mssmt.Node, error) {
+
+	var (
+		root   mssmt.Node
+		readTx = NewRemoteRootReadTx()
+	)
+	err := r.db.ExecTx(ctx, &readTx, func(q RemoteRootStore) error {
+		dbRoot, err := q.FetchRemoteUniverseRoot(
+			ctx, RemoteUniverseRootQuery{
+				ServerHost:    host.HostStr(),
+				NamespaceRoot: id.String(),
+			},
+		)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return universe.ErrNoRemoteRoot
+
+		case err != nil:
+			return fmt.Errorf("unable to fetch remote root: %w",
+				err)
+		}
+
+		var nodeHash mssmt.NodeHash
+		copy(nodeHash[:], dbRoot.RootHash)
+		root = mssmt.NewComputedBranch(nodeHash, uint64(dbRoot.RootSum))
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return root, nil
+}
+
+// StoreRemoteRoot caches the root of a universe on the given remote server
+// after it was successfully synced.
+//
+// NOTE: This is part of the universe.RemoteRootCache interface.
+func (r *RemoteUniverseRoots) StoreRemoteRoot(ctx context.Context,
+	host universe.ServerAddr, root universe.Root) error {
+
+	rootHash := root.Node.NodeHash()
+
+	var writeTx RemoteRootTxOptions
+	return r.db.ExecTx(ctx, &writeTx, func(q RemoteRootStore) error {
+		return q.UpsertRemoteUniverseRoot(ctx, NewRemoteUniverseRoot{
+			ServerHost:    host.HostStr(),
+			NamespaceRoot: root.ID.String(),
+			RootHash:      rootHash[:],
+			RootSum:       int64(root.Node.NodeSum()),
+			LastSynced:    r.clock.Now().UTC(),
+		})
+	})
+}
+
+// DeleteRemoteRoots deletes all cached roots of the given remote server.
+func (r *RemoteUniverseRoots) DeleteRemoteRoots(ctx context.Context,
+	host universe.ServerAddr) error {
+
+	var writeTx RemoteRootTxOptions
+	return r.db.ExecTx(ctx, &writeTx, func(q RemoteRootStore) error {
+		return q.DeleteRemoteUniverseRoots(ctx, host.HostStr())
+	})
+}
+
+// A compile-time assertion to make sure RemoteUniverseRoots satisfies the
+// universe.RemoteRootCache interface.
+var _ universe.RemoteRootCache = (*RemoteUniverseRoots)(nil)
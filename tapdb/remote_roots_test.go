@@ -0,0 +1,88 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRemoteUniverseRoots(t *testing.T) *RemoteUniverseRoots {
+	db := NewTestDB(t)
+
+	dbTxer := NewTransactionExecutor(db,
+		func(tx *sql.Tx) RemoteRootStore {
+			return db.WithTx(tx)
+		},
+	)
+
+	testClock := clock.NewTestClock(time.Unix(1700000000, 0))
+
+	return NewRemoteUniverseRoots(dbTxer, testClock)
+}
+
+// TestRemoteUniverseRoots tests that the roots of remote universes can be
+// cached, updated and deleted per server.
+func TestRemoteUniverseRoots(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = context.Background()
+		cache   = newTestRemoteUniverseRoots(t)
+		server1 = universe.NewServerAddrFromStr("server1:10029")
+		server2 = universe.NewServerAddrFromStr("server2:10029")
+		id      = randUniverseID(t, false)
+	)
+
+	randRoot := func() universe.Root {
+		var nodeHash mssmt.NodeHash
+		copy(nodeHash[:], test.RandBytes(32))
+
+		return universe.Root{
+			ID: id,
+			Node: mssmt.NewComputedBranch(
+				nodeHash, uint64(test.RandInt[uint32]()),
+			),
+		}
+	}
+
+	// Without any cached root, we should get the proper error.
+	_, err := cache.FetchRemoteRoot(ctx, server1, id)
+	require.ErrorIs(t, err, universe.ErrNoRemoteRoot)
+
+	// We now store a root for both servers, they should be kept apart.
+	root1, root2 := randRoot(), randRoot()
+	require.NoError(t, cache.StoreRemoteRoot(ctx, server1, root1))
+	require.NoError(t, cache.StoreRemoteRoot(ctx, server2, root2))
+
+	cachedRoot, err := cache.FetchRemoteRoot(ctx, server1, id)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(root1.Node, cachedRoot))
+
+	cachedRoot, err = cache.FetchRemoteRoot(ctx, server2, id)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(root2.Node, cachedRoot))
+
+	// Storing a new root for the same universe should replace the old one.
+	root3 := randRoot()
+	require.NoError(t, cache.StoreRemoteRoot(ctx, server1, root3))
+
+	cachedRoot, err = cache.FetchRemoteRoot(ctx, server1, id)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(root3.Node, cachedRoot))
+
+	// Finally, deleting the roots of one server shouldn't affect the other.
+	require.NoError(t, cache.DeleteRemoteRoots(ctx, server1))
+
+	_, err = cache.FetchRemoteRoot(ctx, server1, id)
+	require.ErrorIs(t, err, universe.ErrNoRemoteRoot)
+
+	_, err = cache.FetchRemoteRoot(ctx, server2, id)
+	require.NoError(t, err)
+}
@@ -0,0 +1,367 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+type (
+	// NewSendTotal is used to add an amount to the cumulative send total
+	// of a destination.
+	NewSendTotal = sqlc.AddSendTotalParams
+
+	// SendTotalRevert is used to remove an amount from the cumulative send
+	// total of a destination.
+	SendTotalRevert = sqlc.SubtractSendTotalParams
+
+	// SendTotalsQuery is used to query the cumulative send totals.
+	SendTotalsQuery = sqlc.QuerySendTotalsParams
+
+	// SendTotalRow is a cumulative send total along with the send limit of
+	// its destination.
+	SendTotalRow = sqlc.QuerySendTotalsRow
+
+	// NewSendLimit is used to insert or update a send limit.
+	NewSendLimit = sqlc.UpsertSendLimitParams
+
+	// SendLimitKey identifies the send limit of a destination and asset.
+	SendLimitKey = sqlc.FetchSendLimitParams
+
+	// SendLimitDelete is used to delete a send limit.
+	SendLimitDelete = sqlc.DeleteSendLimitParams
+
+	// SendLimitRow is a send limit as stored in the database.
+	SendLimitRow = sqlc.QuerySendLimitsRow
+)
+
+// SendQuotaStore is the set of queries needed to track the cumulative amounts
+// sent to destinations and their send limits.
+type SendQuotaStore interface {
+	// AddSendTotal adds an amount to the cumulative send total of a
+	// destination and asset.
+	AddSendTotal(ctx context.Context, arg NewSendTotal) error
+
+	// SubtractSendTotal removes an amount from the cumulative send total
+	// of a destination and asset.
+	SubtractSendTotal(ctx context.Context, arg SendTotalRevert) error
+
+	// QuerySendTotals returns the cumulative send totals that match the
+	// given query, along with the send limits of their destinations.
+	QuerySendTotals(ctx context.Context,
+		arg SendTotalsQuery) ([]SendTotalRow, error)
+
+	// UpsertSendLimit inserts or updates the send limit of a destination
+	// and asset.
+	UpsertSendLimit(ctx context.Context, arg NewSendLimit) error
+
+	// FetchSendLimit returns the send limit of a destination and asset.
+	FetchSendLimit(ctx context.Context, arg SendLimitKey) (int64, error)
+
+	// QuerySendLimits returns all send limits.
+	QuerySendLimits(ctx context.Context) ([]SendLimitRow, error)
+
+	// DeleteSendLimit deletes the send limit of a destination and asset
+	// and returns the number of deleted rows.
+	DeleteSendLimit(ctx context.Context, arg SendLimitDelete) (int64,
+		error)
+}
+
+// SendQuotaStoreTxOptions defines the set of db txn options the
+// SendQuotaStore understands.
+type SendQuotaStoreTxOptions struct {
+	// readOnly governs if a read only transaction is needed or not.
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (r *SendQuotaStoreTxOptions) ReadOnly() bool {
+	return r.readOnly
+}
+
+// NewSendQuotaStoreReadTx creates a new read transaction option set.
+func NewSendQuotaStoreReadTx() SendQuotaStoreTxOptions {
+	return SendQuotaStoreTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedSendQuotaStore is the main storage interface for send quotas. It
+// supports all the basic queries as well as running the set of queries in a
+// single database transaction.
+type BatchedSendQuotaStore interface {
+	SendQuotaStore
+
+	BatchedTx[SendQuotaStore]
+}
+
+// SendQuotas is a database backed implementation of the
+// tapfreighter.SendQuotaLog interface.
+type SendQuotas struct {
+	db BatchedSendQuotaStore
+
+	clock clock.Clock
+}
+
+// NewSendQuotas creates a new database backed send quota log.
+func NewSendQuotas(db BatchedSendQuotaStore, clock clock.Clock) *SendQuotas {
+	return &SendQuotas{
+		db:    db,
+		clock: clock,
+	}
+}
+
+// RecordSends adds the given amounts to the cumulative send totals of their
+// destinations. If any of the sends would exceed the limit of its
+// destination, nothing is recorded and tapfreighter.ErrSendLimitExceeded is
+// returned.
+//
+// NOTE: This is part of the tapfreighter.SendQuotaLog interface.
+func (s *SendQuotas) RecordSends(ctx context.Context,
+	sends []tapfreighter.DestinationSend) error {
+
+	sendTime := s.clock.Now().UTC()
+
+	// Checking the limits and adding the amounts happens in the same
+	// transaction, so concurrent sends can't exceed a limit together.
+	var writeTx SendQuotaStoreTxOptions
+	return s.db.ExecTx(ctx, &writeTx, func(q SendQuotaStore) error {
+		for _, send := range sends {
+			scriptKey := send.ScriptKey.SerializeCompressed()
+			assetID := fn.CopySlice(send.AssetID[:])
+
+			err := checkSendLimit(ctx, q, send, scriptKey, assetID)
+			if err != nil {
+				return err
+			}
+
+			err = q.AddSendTotal(ctx, NewSendTotal{
+				ScriptKey: scriptKey,
+				AssetID:   assetID,
+				Amount:    int64(send.Amount),
+				SendTime:  sendTime,
+			})
+			if err != nil {
+				return fmt.Errorf("unable to add send total: "+
+					"%w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// checkSendLimit makes sure the given send doesn't exceed the send limit of
+// its destination, if one is set.
+func checkSendLimit(ctx context.Context, q SendQuotaStore,
+	send tapfreighter.DestinationSend, scriptKey, assetID []byte) error {
+
+	maxAmount, err := q.FetchSendLimit(ctx, SendLimitKey{
+		ScriptKey: scriptKey,
+		AssetID:   assetID,
+	})
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil
+
+	case err != nil:
+		return fmt.Errorf("unable to fetch send limit: %w", err)
+	}
+
+	totals, err := q.QuerySendTotals(ctx, SendTotalsQuery{
+		ScriptKey: scriptKey,
+		AssetID:   assetID,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to query send totals: %w", err)
+	}
+
+	var total uint64
+	if len(totals) > 0 {
+		total = uint64(totals[0].TotalAmount)
+	}
+
+	if total+send.Amount > uint64(maxAmount) {
+		return fmt.Errorf("%w: script_key=%x, asset_id=%v, "+
+			"total=%d, amount=%d, limit=%d",
+			tapfreighter.ErrSendLimitExceeded, scriptKey,
+			send.AssetID, total, send.Amount, maxAmount)
+	}
+
+	return nil
+}
+
+// RevertSends removes the given, previously recorded amounts from the
+// cumulative send totals of their destinations.
+//
+// NOTE: This is part of the tapfreighter.SendQuotaLog interface.
+func (s *SendQuotas) RevertSends(ctx context.Context,
+	sends []tapfreighter.DestinationSend) error {
+
+	var writeTx SendQuotaStoreTxOptions
+	return s.db.ExecTx(ctx, &writeTx, func(q SendQuotaStore) error {
+		for _, send := range sends {
+			err := q.SubtractSendTotal(ctx, SendTotalRevert{
+				Amount:    int64(send.Amount),
+				ScriptKey: send.ScriptKey.SerializeCompressed(),
+				AssetID:   fn.CopySlice(send.AssetID[:]),
+			})
+			if err != nil {
+				return fmt.Errorf("unable to subtract send "+
+					"total: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// QuerySendTotals returns the cumulative send totals that match the given
+// query.
+//
+// NOTE: This is part of the tapfreighter.SendQuotaLog interface.
+func (s *SendQuotas) QuerySendTotals(ctx context.Context,
+	query tapfreighter.SendTotalsQuery) ([]*tapfreighter.SendTotal,
+	error) {
+
+	var dbQuery SendTotalsQuery
+	if query.ScriptKey != nil {
+		dbQuery.ScriptKey = query.ScriptKey.SerializeCompressed()
+	}
+	if query.AssetID != nil {
+		dbQuery.AssetID = fn.CopySlice(query.AssetID[:])
+	}
+
+	var (
+		dbTotals []SendTotalRow
+		readTx   = NewSendQuotaStoreReadTx()
+	)
+	err := s.db.ExecTx(ctx, &readTx, func(q SendQuotaStore) error {
+		var err error
+		dbTotals, err = q.QuerySendTotals(ctx, dbQuery)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to query send totals: %w", err)
+	}
+
+	totals := make([]*tapfreighter.SendTotal, 0, len(dbTotals))
+	for _, dbTotal := range dbTotals {
+		scriptKey, err := btcec.ParsePubKey(dbTotal.ScriptKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse script key: %w",
+				err)
+		}
+
+		total := &tapfreighter.SendTotal{
+			ScriptKey:    scriptKey,
+			TotalAmount:  uint64(dbTotal.TotalAmount),
+			NumSends:     uint64(dbTotal.NumSends),
+			LastSendTime: dbTotal.LastSendTime.UTC(),
+		}
+		copy(total.AssetID[:], dbTotal.AssetID)
+
+		if dbTotal.MaxAmount.Valid {
+			total.Limit = fn.Some(uint64(dbTotal.MaxAmount.Int64))
+		}
+
+		totals = append(totals, total)
+	}
+
+	return totals, nil
+}
+
+// SetSendLimit sets the limit of the cumulative amount of an asset that can be
+// sent to a destination, replacing any existing limit.
+//
+// NOTE: This is part of the tapfreighter.SendQuotaLog interface.
+func (s *SendQuotas) SetSendLimit(ctx context.Context,
+	limit tapfreighter.SendLimit) error {
+
+	var writeTx SendQuotaStoreTxOptions
+	return s.db.ExecTx(ctx, &writeTx, func(q SendQuotaStore) error {
+		return q.UpsertSendLimit(ctx, NewSendLimit{
+			ScriptKey: limit.ScriptKey.SerializeCompressed(),
+			AssetID:   fn.CopySlice(limit.AssetID[:]),
+			MaxAmount: int64(limit.MaxAmount),
+		})
+	})
+}
+
+// RemoveSendLimit removes the limit of the cumulative amount of the given
+// asset that can be sent to the given destination.
+//
+// NOTE: This is part of the tapfreighter.SendQuotaLog interface.
+func (s *SendQuotas) RemoveSendLimit(ctx context.Context,
+	scriptKey *btcec.PublicKey, assetID asset.ID) error {
+
+	var writeTx SendQuotaStoreTxOptions
+	return s.db.ExecTx(ctx, &writeTx, func(q SendQuotaStore) error {
+		numDeleted, err := q.DeleteSendLimit(ctx, SendLimitDelete{
+			ScriptKey: scriptKey.SerializeCompressed(),
+			AssetID:   fn.CopySlice(assetID[:]),
+		})
+		if err != nil {
+			return err
+		}
+
+		if numDeleted == 0 {
+			return tapfreighter.ErrSendLimitNotFound
+		}
+
+		return nil
+	})
+}
+
+// QuerySendLimits returns all send limits.
+//
+// NOTE: This is part of the tapfreighter.SendQuotaLog interface.
+func (s *SendQuotas) QuerySendLimits(
+	ctx context.Context) ([]*tapfreighter.SendLimit, error) {
+
+	var (
+		dbLimits []SendLimitRow
+		readTx   = NewSendQuotaStoreReadTx()
+	)
+	err := s.db.ExecTx(ctx, &readTx, func(q SendQuotaStore) error {
+		var err error
+		dbLimits, err = q.QuerySendLimits(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to query send limits: %w", err)
+	}
+
+	limits := make([]*tapfreighter.SendLimit, 0, len(dbLimits))
+	for _, dbLimit := range dbLimits {
+		scriptKey, err := btcec.ParsePubKey(dbLimit.ScriptKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse script key: %w",
+				err)
+		}
+
+		limit := &tapfreighter.SendLimit{
+			ScriptKey: scriptKey,
+			MaxAmount: uint64(dbLimit.MaxAmount),
+		}
+		copy(limit.AssetID[:], dbLimit.AssetID)
+
+		limits = append(limits, limit)
+	}
+
+	return limits, nil
+}
+
+// A compile-time assertion to make sure SendQuotas satisfies the
+// tapfreighter.SendQuotaLog interface.
+var _ tapfreighter.SendQuotaLog = (*SendQuotas)(nil)
@@ -0,0 +1,133 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSendQuotas tests that the cumulative amounts sent to destinations are
+// tracked and that send limits are enforced.
+func TestSendQuotas(t *testing.T) {
+	t.Parallel()
+
+	db := NewTestDB(t)
+	now := time.Now().UTC().Truncate(time.Second)
+	quotas := NewSendQuotas(NewTransactionExecutor(
+		db, func(tx *sql.Tx) SendQuotaStore {
+			return db.WithTx(tx)
+		},
+	), clock.NewTestClock(now))
+	ctx := context.Background()
+
+	dest1, dest2 := test.RandPubKey(t), test.RandPubKey(t)
+	assetID := asset.RandID(t)
+
+	send := func(dest int, amount uint64) tapfreighter.DestinationSend {
+		scriptKey := dest1
+		if dest == 2 {
+			scriptKey = dest2
+		}
+
+		return tapfreighter.DestinationSend{
+			ScriptKey: scriptKey,
+			AssetID:   assetID,
+			Amount:    amount,
+		}
+	}
+
+	record := func(sends ...tapfreighter.DestinationSend) error {
+		return quotas.RecordSends(ctx, sends)
+	}
+
+	// Without any limits, all sends are recorded.
+	require.NoError(t, record(send(1, 100), send(2, 50)))
+	require.NoError(t, record(send(1, 20)))
+
+	totals, err := quotas.QuerySendTotals(
+		ctx, tapfreighter.SendTotalsQuery{ScriptKey: dest1},
+	)
+	require.NoError(t, err)
+	require.Len(t, totals, 1)
+	require.True(t, totals[0].ScriptKey.IsEqual(dest1))
+	require.Equal(t, assetID, totals[0].AssetID)
+	require.EqualValues(t, 120, totals[0].TotalAmount)
+	require.EqualValues(t, 2, totals[0].NumSends)
+	require.Equal(t, now, totals[0].LastSendTime)
+	require.True(t, totals[0].Limit.IsNone())
+
+	totals, err = quotas.QuerySendTotals(
+		ctx, tapfreighter.SendTotalsQuery{AssetID: &assetID},
+	)
+	require.NoError(t, err)
+	require.Len(t, totals, 2)
+
+	otherID := asset.RandID(t)
+	totals, err = quotas.QuerySendTotals(
+		ctx, tapfreighter.SendTotalsQuery{AssetID: &otherID},
+	)
+	require.NoError(t, err)
+	require.Empty(t, totals)
+
+	// We now limit the first destination. A send within the limit is
+	// still recorded.
+	require.NoError(t, quotas.SetSendLimit(ctx, tapfreighter.SendLimit{
+		ScriptKey: dest1,
+		AssetID:   assetID,
+		MaxAmount: 150,
+	}))
+	require.NoError(t, record(send(1, 30)))
+
+	// A transfer that exceeds the limit for one of its destinations is
+	// rejected as a whole.
+	err = record(send(2, 10), send(1, 1))
+	require.ErrorIs(t, err, tapfreighter.ErrSendLimitExceeded)
+
+	totals, err = quotas.QuerySendTotals(
+		ctx, tapfreighter.SendTotalsQuery{},
+	)
+	require.NoError(t, err)
+	require.Len(t, totals, 2)
+	totalsByKey := make(map[asset.SerializedKey]*tapfreighter.SendTotal)
+	for _, total := range totals {
+		totalsByKey[asset.ToSerialized(total.ScriptKey)] = total
+	}
+	total1 := totalsByKey[asset.ToSerialized(dest1)]
+	require.EqualValues(t, 150, total1.TotalAmount)
+	require.Equal(t, fn.Some[uint64](150), total1.Limit)
+	total2 := totalsByKey[asset.ToSerialized(dest2)]
+	require.EqualValues(t, 50, total2.TotalAmount)
+	require.EqualValues(t, 1, total2.NumSends)
+
+	// Reverting a send frees up the quota again.
+	require.NoError(t, quotas.RevertSends(
+		ctx, []tapfreighter.DestinationSend{send(1, 30)},
+	))
+	require.NoError(t, record(send(1, 25)))
+
+	limits, err := quotas.QuerySendLimits(ctx)
+	require.NoError(t, err)
+	require.Len(t, limits, 1)
+	require.True(t, limits[0].ScriptKey.IsEqual(dest1))
+	require.Equal(t, assetID, limits[0].AssetID)
+	require.EqualValues(t, 150, limits[0].MaxAmount)
+
+	// Once the limit is removed, the destination is unconstrained again.
+	require.NoError(t, quotas.RemoveSendLimit(ctx, dest1, assetID))
+	err = quotas.RemoveSendLimit(ctx, dest1, assetID)
+	require.ErrorIs(t, err, tapfreighter.ErrSendLimitNotFound)
+
+	require.NoError(t, record(send(1, 1_000)))
+
+	limits, err = quotas.QuerySendLimits(ctx)
+	require.NoError(t, err)
+	require.Empty(t, limits)
+}
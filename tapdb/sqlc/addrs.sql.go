@@ -14,8 +14,8 @@ import (
 const fetchAddrByTaprootOutputKey = `-- name: FetchAddrByTaprootOutputKey :one
 SELECT
     version, asset_version, genesis_asset_id, group_key, tapscript_sibling,
-    taproot_output_key, amount, asset_type, creation_time, managed_from,
-    proof_courier_addr,
+    addrs.taproot_output_key, amount, asset_type, creation_time, managed_from,
+    proof_courier_addr, num_confs, expiry_time, archived_at,
     script_keys.tweaked_script_key,
     script_keys.tweak AS script_key_tweak,
     script_keys.declared_known AS script_key_declared_known,
@@ -24,7 +24,8 @@ SELECT
     raw_script_keys.key_index AS script_key_index,
     taproot_keys.raw_key AS raw_taproot_key,
     taproot_keys.key_family AS taproot_key_family,
-    taproot_keys.key_index AS taproot_key_index
+    taproot_keys.key_index AS taproot_key_index,
+    addr_notes.note AS note
 FROM addrs
 JOIN script_keys
   ON addrs.script_key_id = script_keys.script_key_id
@@ -32,7 +33,9 @@ JOIN internal_keys raw_script_keys
   ON script_keys.internal_key_id = raw_script_keys.key_id
 JOIN internal_keys taproot_keys
   ON addrs.taproot_key_id = taproot_keys.key_id
-WHERE taproot_output_key = $1
+LEFT JOIN addr_notes
+  ON addrs.taproot_output_key = addr_notes.taproot_output_key
+WHERE addrs.taproot_output_key = $1
 `
 
 type FetchAddrByTaprootOutputKeyRow struct {
@@ -47,6 +50,9 @@ type FetchAddrByTaprootOutputKeyRow struct {
 	CreationTime           time.Time
 	ManagedFrom            sql.NullTime
 	ProofCourierAddr       []byte
+	NumConfs               int32
+	ExpiryTime             sql.NullTime
+	ArchivedAt             sql.NullTime
 	TweakedScriptKey       []byte
 	ScriptKeyTweak         []byte
 	ScriptKeyDeclaredKnown sql.NullBool
@@ -56,6 +62,7 @@ type FetchAddrByTaprootOutputKeyRow struct {
 	RawTaprootKey          []byte
 	TaprootKeyFamily       int32
 	TaprootKeyIndex        int32
+	Note                   sql.NullString
 }
 
 func (q *Queries) FetchAddrByTaprootOutputKey(ctx context.Context, taprootOutputKey []byte) (FetchAddrByTaprootOutputKeyRow, error) {
@@ -73,6 +80,9 @@ func (q *Queries) FetchAddrByTaprootOutputKey(ctx context.Context, taprootOutput
 		&i.CreationTime,
 		&i.ManagedFrom,
 		&i.ProofCourierAddr,
+		&i.NumConfs,
+		&i.ExpiryTime,
+		&i.ArchivedAt,
 		&i.TweakedScriptKey,
 		&i.ScriptKeyTweak,
 		&i.ScriptKeyDeclaredKnown,
@@ -82,6 +92,7 @@ func (q *Queries) FetchAddrByTaprootOutputKey(ctx context.Context, taprootOutput
 		&i.RawTaprootKey,
 		&i.TaprootKeyFamily,
 		&i.TaprootKeyIndex,
+		&i.Note,
 	)
 	return i, err
 }
@@ -205,8 +216,8 @@ func (q *Queries) FetchAddrEventByAddrKeyAndOutpoint(ctx context.Context, arg Fe
 const fetchAddrs = `-- name: FetchAddrs :many
 SELECT 
     version, asset_version, genesis_asset_id, group_key, tapscript_sibling,
-    taproot_output_key, amount, asset_type, creation_time, managed_from,
-    proof_courier_addr,
+    addrs.taproot_output_key, amount, asset_type, creation_time, managed_from,
+    proof_courier_addr, num_confs, expiry_time, archived_at,
     script_keys.tweaked_script_key,
     script_keys.tweak AS script_key_tweak,
     script_keys.declared_known AS script_key_declared_known,
@@ -215,7 +226,8 @@ SELECT
     raw_script_keys.key_index AS script_key_index,
     taproot_keys.raw_key AS raw_taproot_key, 
     taproot_keys.key_family AS taproot_key_family,
-    taproot_keys.key_index AS taproot_key_index
+    taproot_keys.key_index AS taproot_key_index,
+    addr_notes.note AS note
 FROM addrs
 JOIN script_keys
     ON addrs.script_key_id = script_keys.script_key_id
@@ -223,18 +235,27 @@ JOIN internal_keys raw_script_keys
     ON script_keys.internal_key_id = raw_script_keys.key_id
 JOIN internal_keys taproot_keys
     ON addrs.taproot_key_id = taproot_keys.key_id
+LEFT JOIN addr_notes
+    ON addrs.taproot_output_key = addr_notes.taproot_output_key
 WHERE creation_time >= $1
     AND creation_time <= $2
     AND ($3 = false OR
          (CASE WHEN managed_from IS NULL THEN true ELSE false END) = $3)
+    AND ($4 = 0 OR
+         ($4 = 1 AND archived_at IS NULL AND
+          (expiry_time IS NULL OR expiry_time > $5)) OR
+         ($4 = 2 AND archived_at IS NULL AND expiry_time <= $5) OR
+         ($4 = 3 AND archived_at IS NOT NULL))
 ORDER BY addrs.creation_time
-LIMIT $5 OFFSET $4
+LIMIT $7 OFFSET $6
 `
 
 type FetchAddrsParams struct {
 	CreatedAfter  time.Time
 	CreatedBefore time.Time
 	UnmanagedOnly interface{}
+	AddrState     interface{}
+	Now           sql.NullTime
 	NumOffset     int32
 	NumLimit      int32
 }
@@ -251,6 +272,9 @@ type FetchAddrsRow struct {
 	CreationTime           time.Time
 	ManagedFrom            sql.NullTime
 	ProofCourierAddr       []byte
+	NumConfs               int32
+	ExpiryTime             sql.NullTime
+	ArchivedAt             sql.NullTime
 	TweakedScriptKey       []byte
 	ScriptKeyTweak         []byte
 	ScriptKeyDeclaredKnown sql.NullBool
@@ -260,6 +284,7 @@ type FetchAddrsRow struct {
 	RawTaprootKey          []byte
 	TaprootKeyFamily       int32
 	TaprootKeyIndex        int32
+	Note                   sql.NullString
 }
 
 func (q *Queries) FetchAddrs(ctx context.Context, arg FetchAddrsParams) ([]FetchAddrsRow, error) {
@@ -267,6 +292,8 @@ func (q *Queries) FetchAddrs(ctx context.Context, arg FetchAddrsParams) ([]Fetch
 		arg.CreatedAfter,
 		arg.CreatedBefore,
 		arg.UnmanagedOnly,
+		arg.AddrState,
+		arg.Now,
 		arg.NumOffset,
 		arg.NumLimit,
 	)
@@ -289,6 +316,9 @@ func (q *Queries) FetchAddrs(ctx context.Context, arg FetchAddrsParams) ([]Fetch
 			&i.CreationTime,
 			&i.ManagedFrom,
 			&i.ProofCourierAddr,
+			&i.NumConfs,
+			&i.ExpiryTime,
+			&i.ArchivedAt,
 			&i.TweakedScriptKey,
 			&i.ScriptKeyTweak,
 			&i.ScriptKeyDeclaredKnown,
@@ -298,6 +328,7 @@ func (q *Queries) FetchAddrs(ctx context.Context, arg FetchAddrsParams) ([]Fetch
 			&i.RawTaprootKey,
 			&i.TaprootKeyFamily,
 			&i.TaprootKeyIndex,
+			&i.Note,
 		); err != nil {
 			return nil, err
 		}
@@ -316,8 +347,10 @@ const insertAddr = `-- name: InsertAddr :one
 INSERT INTO addrs (
     version, asset_version, genesis_asset_id, group_key, script_key_id,
     taproot_key_id, tapscript_sibling, taproot_output_key, amount, asset_type,
-    creation_time, proof_courier_addr
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) RETURNING id
+    creation_time, proof_courier_addr, num_confs
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+) RETURNING id
 `
 
 type InsertAddrParams struct {
@@ -333,6 +366,7 @@ type InsertAddrParams struct {
 	AssetType        int16
 	CreationTime     time.Time
 	ProofCourierAddr []byte
+	NumConfs         int32
 }
 
 func (q *Queries) InsertAddr(ctx context.Context, arg InsertAddrParams) (int64, error) {
@@ -349,6 +383,7 @@ func (q *Queries) InsertAddr(ctx context.Context, arg InsertAddrParams) (int64,
 		arg.AssetType,
 		arg.CreationTime,
 		arg.ProofCourierAddr,
+		arg.NumConfs,
 	)
 	var id int64
 	err := row.Scan(&id)
@@ -408,6 +443,38 @@ func (q *Queries) QueryEventIDs(ctx context.Context, arg QueryEventIDsParams) ([
 	return items, nil
 }
 
+const setAddrArchived = `-- name: SetAddrArchived :exec
+UPDATE addrs
+SET archived_at = $2
+WHERE taproot_output_key = $1
+`
+
+type SetAddrArchivedParams struct {
+	TaprootOutputKey []byte
+	ArchivedAt       sql.NullTime
+}
+
+func (q *Queries) SetAddrArchived(ctx context.Context, arg SetAddrArchivedParams) error {
+	_, err := q.db.ExecContext(ctx, setAddrArchived, arg.TaprootOutputKey, arg.ArchivedAt)
+	return err
+}
+
+const setAddrExpiry = `-- name: SetAddrExpiry :exec
+UPDATE addrs
+SET expiry_time = $2
+WHERE taproot_output_key = $1
+`
+
+type SetAddrExpiryParams struct {
+	TaprootOutputKey []byte
+	ExpiryTime       sql.NullTime
+}
+
+func (q *Queries) SetAddrExpiry(ctx context.Context, arg SetAddrExpiryParams) error {
+	_, err := q.db.ExecContext(ctx, setAddrExpiry, arg.TaprootOutputKey, arg.ExpiryTime)
+	return err
+}
+
 const setAddrManaged = `-- name: SetAddrManaged :exec
 WITH target_addr(addr_id) AS (
     SELECT id
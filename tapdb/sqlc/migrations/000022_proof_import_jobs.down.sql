@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS proof_import_items_status_idx;
+DROP TABLE IF EXISTS proof_import_items;
+DROP TABLE IF EXISTS proof_import_jobs;
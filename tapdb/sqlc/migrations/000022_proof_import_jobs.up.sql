@@ -0,0 +1,35 @@
+-- proof_import_jobs stores bulk proof import jobs. Each job consists of a set
+-- of proof files that are validated and imported independently of each other.
+CREATE TABLE IF NOT EXISTS proof_import_jobs (
+    id BIGINT PRIMARY KEY,
+
+    -- The time the job was submitted.
+    created_at TIMESTAMP NOT NULL
+);
+
+-- proof_import_items stores the individual proof files of a bulk import job
+-- along with their current import status.
+CREATE TABLE IF NOT EXISTS proof_import_items (
+    id BIGINT PRIMARY KEY,
+
+    -- The ID of the job the proof file belongs to.
+    job_id BIGINT NOT NULL REFERENCES proof_import_jobs(id) ON DELETE CASCADE,
+
+    -- The position of the proof file within the job.
+    item_index INTEGER NOT NULL CHECK(item_index >= 0),
+
+    -- The raw proof file.
+    proof_file BLOB NOT NULL,
+
+    -- The import status of the proof file (pending, imported, duplicate,
+    -- invalid).
+    status SMALLINT NOT NULL CHECK(status IN (0, 1, 2, 3)),
+
+    -- The reason the proof file was rejected, if it was.
+    reason TEXT,
+
+    UNIQUE(job_id, item_index)
+);
+
+CREATE INDEX IF NOT EXISTS proof_import_items_status_idx
+    ON proof_import_items (status);
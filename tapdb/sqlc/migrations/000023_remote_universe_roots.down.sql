@@ -0,0 +1 @@
+DROP TABLE IF EXISTS universe_remote_roots;
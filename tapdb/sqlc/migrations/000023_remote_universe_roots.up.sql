@@ -0,0 +1,23 @@
+-- universe_remote_roots stores the last root we've seen for a universe on a
+-- remote universe server, at the time we last successfully synced it. If the
+-- remote root is unchanged on the next sync, we can skip fetching its leaves.
+CREATE TABLE IF NOT EXISTS universe_remote_roots (
+    id BIGINT PRIMARY KEY,
+
+    -- The host of the remote universe server.
+    server_host TEXT NOT NULL,
+
+    -- The namespace of the universe on the remote server.
+    namespace_root VARCHAR NOT NULL,
+
+    -- The root hash of the remote universe.
+    root_hash BLOB NOT NULL CHECK(length(root_hash) = 32),
+
+    -- The root sum of the remote universe.
+    root_sum BIGINT NOT NULL CHECK(root_sum >= 0),
+
+    -- The time the remote universe was last synced.
+    last_synced TIMESTAMP NOT NULL,
+
+    UNIQUE(server_host, namespace_root)
+);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS asset_invoices_state_idx;
+DROP TABLE IF EXISTS asset_invoices;
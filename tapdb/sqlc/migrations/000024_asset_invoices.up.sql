@@ -0,0 +1,29 @@
+-- asset_invoices stores payment requests for a specific amount of an asset.
+-- Each invoice is bound to a unique address that is only used for the
+-- invoice, the amount of the address is the invoice amount.
+CREATE TABLE IF NOT EXISTS asset_invoices (
+    id BIGINT PRIMARY KEY,
+
+    -- The address the invoice is paid to.
+    addr_id BIGINT NOT NULL UNIQUE REFERENCES addrs(id),
+
+    -- An optional description of the invoice.
+    memo TEXT NOT NULL,
+
+    -- The time the invoice was created.
+    creation_time TIMESTAMP NOT NULL,
+
+    -- The time after which the invoice is expired if it wasn't paid.
+    expiry_time TIMESTAMP NOT NULL,
+
+    -- The payment state of the invoice.
+    state SMALLINT NOT NULL,
+
+    -- The total amount of asset units received on the invoice address.
+    amt_received BIGINT NOT NULL CHECK(amt_received >= 0),
+
+    -- The time of the last state change of the invoice.
+    state_time TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS asset_invoices_state_idx ON asset_invoices(state);
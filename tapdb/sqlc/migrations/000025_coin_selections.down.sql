@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS coin_selections_time_idx;
+DROP TABLE IF EXISTS coin_selections;
@@ -0,0 +1,43 @@
+-- coin_selections stores a record of each successful asset coin selection,
+-- which can be used to tune the coin selection and to detect a fragmentation
+-- buildup of the asset UTXOs.
+CREATE TABLE IF NOT EXISTS coin_selections (
+    id BIGINT PRIMARY KEY,
+
+    -- The time the coins were selected.
+    selection_time TIMESTAMP NOT NULL,
+
+    -- The asset ID the coins were selected for, if the selection was
+    -- constrained by asset ID.
+    asset_id BLOB CHECK(length(asset_id) = 32),
+
+    -- The group key the coins were selected for, if the selection was
+    -- constrained by group key.
+    group_key BLOB CHECK(length(group_key) = 33),
+
+    -- The coin selection strategy that was used.
+    strategy SMALLINT NOT NULL,
+
+    -- The amount that needed to be covered by the selected coins.
+    target_amt BIGINT NOT NULL CHECK(target_amt >= 0),
+
+    -- The number of coins that were eligible for the selection.
+    num_candidates INTEGER NOT NULL CHECK(num_candidates >= 0),
+
+    -- The number of coins that were selected.
+    num_selected INTEGER NOT NULL CHECK(num_selected >= 0),
+
+    -- The total amount of the selected coins.
+    selected_amt BIGINT NOT NULL CHECK(selected_amt >= 0),
+
+    -- The amount that goes back to the wallet as change.
+    change_amt BIGINT NOT NULL CHECK(change_amt >= 0),
+
+    -- The fragmentation score of the eligible coins in parts per million.
+    fragmentation_score_ppm INTEGER NOT NULL CHECK(
+        fragmentation_score_ppm BETWEEN 0 AND 1000000
+    )
+);
+
+CREATE INDEX IF NOT EXISTS coin_selections_time_idx
+    ON coin_selections(selection_time);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS utxo_notes;
+DROP TABLE IF EXISTS addr_notes;
@@ -0,0 +1,27 @@
+-- addr_notes stores a user-editable annotation for each Taproot Asset
+-- address, so addresses can be tagged without an external database.
+CREATE TABLE IF NOT EXISTS addr_notes (
+    -- The tweaked taproot output key of the annotated address.
+    taproot_output_key BLOB PRIMARY KEY
+        REFERENCES addrs(taproot_output_key),
+
+    -- The free-form note of the address.
+    note TEXT NOT NULL,
+
+    -- The time the note was last changed.
+    updated_at TIMESTAMP NOT NULL
+);
+
+-- utxo_notes stores a user-editable annotation for each asset anchor output.
+-- The notes are keyed by outpoint rather than by managed UTXO, so they remain
+-- available after the UTXO was spent.
+CREATE TABLE IF NOT EXISTS utxo_notes (
+    -- The serialized outpoint of the annotated anchor output.
+    outpoint BLOB PRIMARY KEY,
+
+    -- The free-form note of the anchor output.
+    note TEXT NOT NULL,
+
+    -- The time the note was last changed.
+    updated_at TIMESTAMP NOT NULL
+);
@@ -0,0 +1,25 @@
+-- idempotency_keys stores the response of each state changing RPC call that
+-- was made with a caller-supplied idempotency key, so a retried call with the
+-- same key returns the original result instead of being executed again.
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    id BIGINT PRIMARY KEY,
+
+    -- The caller-supplied idempotency key.
+    idempotency_key TEXT NOT NULL,
+
+    -- The RPC method the key was used for.
+    method TEXT NOT NULL,
+
+    -- The SHA256 hash of the serialized request, used to detect a key that
+    -- is re-used for a different request.
+    request_hash BLOB NOT NULL CHECK(length(request_hash) = 32),
+
+    -- The serialized response of the original call, which references the
+    -- resulting transfer or minting batch.
+    response BLOB NOT NULL,
+
+    -- The time the original call completed.
+    creation_time TIMESTAMP NOT NULL,
+
+    UNIQUE(idempotency_key, method)
+);
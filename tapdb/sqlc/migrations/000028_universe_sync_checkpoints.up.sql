@@ -0,0 +1,36 @@
+-- universe_sync_checkpoints stores the progress of a streaming sync of a
+-- universe with a remote universe server, so an interrupted sync can be
+-- resumed instead of being restarted from scratch.
+CREATE TABLE IF NOT EXISTS universe_sync_checkpoints (
+    id BIGINT PRIMARY KEY,
+
+    -- The host of the remote universe server.
+    server_host TEXT NOT NULL,
+
+    -- The namespace of the universe that is being synced.
+    namespace_root VARCHAR NOT NULL,
+
+    -- The root hash of the remote universe the sync was started for.
+    remote_root_hash BLOB NOT NULL CHECK(length(remote_root_hash) = 32),
+
+    -- The root sum of the remote universe the sync was started for.
+    remote_root_sum BIGINT NOT NULL CHECK(remote_root_sum >= 0),
+
+    -- The root hash of the local universe at the checkpoint, or NULL if the
+    -- local universe was still empty.
+    local_root_hash BLOB CHECK(length(local_root_hash) = 32),
+
+    -- The root sum of the local universe at the checkpoint.
+    local_root_sum BIGINT NOT NULL CHECK(local_root_sum >= 0),
+
+    -- The number of remote leaf keys that were processed.
+    leaf_offset INTEGER NOT NULL CHECK(leaf_offset >= 0),
+
+    -- The number of new leaves that were inserted into the local universe.
+    num_synced BIGINT NOT NULL CHECK(num_synced >= 0),
+
+    -- The time the checkpoint was last updated.
+    updated_at TIMESTAMP NOT NULL,
+
+    UNIQUE(server_host, namespace_root)
+);
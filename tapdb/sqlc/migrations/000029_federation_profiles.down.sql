@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS federation_profile_sync_configs;
+DROP TABLE IF EXISTS federation_profile_servers;
+DROP TABLE IF EXISTS federation_profiles;
@@ -0,0 +1,57 @@
+-- federation_profiles stores named federation profiles. A profile bundles a
+-- set of universe servers with a set of federation sync configs, so the
+-- federation can be switched between different setups in one step.
+CREATE TABLE IF NOT EXISTS federation_profiles (
+    id BIGINT PRIMARY KEY,
+
+    -- The unique name of the profile.
+    name TEXT UNIQUE NOT NULL CHECK(length(name) > 0),
+
+    -- Whether the profile is the one that was last applied to the
+    -- federation.
+    is_active BOOLEAN NOT NULL DEFAULT FALSE,
+
+    -- The time the profile was last updated.
+    updated_at TIMESTAMP NOT NULL
+);
+
+-- federation_profile_servers stores the universe servers of a federation
+-- profile.
+CREATE TABLE IF NOT EXISTS federation_profile_servers (
+    -- The ID of the profile the server belongs to.
+    profile_id BIGINT NOT NULL REFERENCES federation_profiles(id) ON DELETE CASCADE,
+
+    -- The host of the universe server.
+    server_host TEXT NOT NULL,
+
+    UNIQUE(profile_id, server_host)
+);
+
+-- federation_profile_sync_configs stores the federation sync configs of a
+-- federation profile. Configs without an asset ID and group key are global
+-- (proof type specific) configs, all others are universe specific configs.
+CREATE TABLE IF NOT EXISTS federation_profile_sync_configs (
+    id BIGINT PRIMARY KEY,
+
+    -- The ID of the profile the config belongs to.
+    profile_id BIGINT NOT NULL REFERENCES federation_profiles(id) ON DELETE CASCADE,
+
+    -- The byte serialized ID of the asset the config applies to.
+    asset_id BLOB CHECK(length(asset_id) = 32),
+
+    -- The byte serialized compressed group key of the asset group the config
+    -- applies to.
+    group_key BLOB CHECK(length(group_key) = 33),
+
+    -- The proof type the config applies to.
+    proof_type TEXT NOT NULL CHECK(proof_type IN ('issuance', 'transfer')),
+
+    -- Whether remote proof insertion via federation sync is allowed.
+    allow_sync_insert BOOLEAN NOT NULL,
+
+    -- Whether remote proof export via federation sync is allowed.
+    allow_sync_export BOOLEAN NOT NULL,
+
+    -- The asset ID and group key can't be set at the same time.
+    CHECK (asset_id IS NULL OR group_key IS NULL)
+);
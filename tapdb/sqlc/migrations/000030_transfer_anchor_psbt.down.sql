@@ -0,0 +1,2 @@
+ALTER TABLE asset_transfers DROP COLUMN anchor_change_output_index;
+ALTER TABLE asset_transfers DROP COLUMN anchor_psbt;
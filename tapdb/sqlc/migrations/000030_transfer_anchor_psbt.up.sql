@@ -0,0 +1,6 @@
+-- The funded but unsigned anchor transaction PSBT of a transfer is required
+-- to re-sign the anchor transaction when bumping its fee. The change output
+-- index denotes the BTC change output the additional fee is deducted from, or
+-- -1 if there is no change output.
+ALTER TABLE asset_transfers ADD COLUMN anchor_psbt BLOB;
+ALTER TABLE asset_transfers ADD COLUMN anchor_change_output_index INTEGER;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS send_limits;
+DROP TABLE IF EXISTS send_totals;
@@ -0,0 +1,41 @@
+-- send_totals tracks the cumulative amount of each asset that was sent to a
+-- destination script key, which allows simple velocity controls without an
+-- external ledger.
+CREATE TABLE IF NOT EXISTS send_totals (
+    id BIGINT PRIMARY KEY,
+
+    -- The destination script key the asset was sent to.
+    script_key BLOB NOT NULL CHECK(length(script_key) = 33),
+
+    -- The ID of the asset that was sent.
+    asset_id BLOB NOT NULL CHECK(length(asset_id) = 32),
+
+    -- The cumulative amount of the asset sent to the script key.
+    total_amount BIGINT NOT NULL CHECK(total_amount >= 0),
+
+    -- The number of sends to the script key.
+    num_sends BIGINT NOT NULL CHECK(num_sends >= 0),
+
+    -- The time of the last send to the script key.
+    last_send_time TIMESTAMP NOT NULL,
+
+    UNIQUE(script_key, asset_id)
+);
+
+-- send_limits stores the optional limit of the cumulative amount of an asset
+-- that can be sent to a destination script key.
+CREATE TABLE IF NOT EXISTS send_limits (
+    id BIGINT PRIMARY KEY,
+
+    -- The destination script key the limit applies to.
+    script_key BLOB NOT NULL CHECK(length(script_key) = 33),
+
+    -- The ID of the asset the limit applies to.
+    asset_id BLOB NOT NULL CHECK(length(asset_id) = 32),
+
+    -- The maximum cumulative amount of the asset that can be sent to the
+    -- script key.
+    max_amount BIGINT NOT NULL CHECK(max_amount >= 0),
+
+    UNIQUE(script_key, asset_id)
+);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS addr_contacts_group_name_idx;
+DROP INDEX IF EXISTS addr_contacts_tap_addr_idx;
+DROP TABLE IF EXISTS addr_contacts;
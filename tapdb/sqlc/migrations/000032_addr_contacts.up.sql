@@ -0,0 +1,37 @@
+-- addr_contacts is the address book of outbound destinations. Each entry maps
+-- a unique label to a Taproot Asset address of a recurring counterparty, so
+-- the address doesn't need to be pasted for every send.
+CREATE TABLE IF NOT EXISTS addr_contacts (
+    id BIGINT PRIMARY KEY,
+
+    -- The unique, user-defined label of the contact.
+    label TEXT NOT NULL UNIQUE CHECK(length(label) > 0),
+
+    -- The bech32m encoded Taproot Asset address of the contact.
+    tap_addr TEXT NOT NULL,
+
+    -- The optional group the contact belongs to, an empty string means the
+    -- contact isn't part of a group.
+    group_name TEXT NOT NULL,
+
+    -- Free-form notes about the contact.
+    notes TEXT NOT NULL,
+
+    -- The time the contact was created.
+    created_at TIMESTAMP NOT NULL,
+
+    -- The time the address of the contact was last sent to, if ever.
+    last_used TIMESTAMP,
+
+    -- The cumulative amount of asset units sent to the contact.
+    total_sent BIGINT NOT NULL CHECK(total_sent >= 0),
+
+    -- The number of sends to the contact.
+    num_sends BIGINT NOT NULL CHECK(num_sends >= 0)
+);
+
+CREATE INDEX IF NOT EXISTS addr_contacts_tap_addr_idx
+    ON addr_contacts(tap_addr);
+
+CREATE INDEX IF NOT EXISTS addr_contacts_group_name_idx
+    ON addr_contacts(group_name);
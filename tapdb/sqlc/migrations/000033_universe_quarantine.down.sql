@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS universe_quarantined_leaves_namespace_idx;
+DROP TABLE IF EXISTS universe_quarantined_leaves;
@@ -0,0 +1,31 @@
+-- universe_quarantined_leaves stores the universe leaves that didn't pass a
+-- universe audit and were moved out of their universe tree. The leaves are
+-- kept so they can be inspected, or re-inserted once the cause of the
+-- corruption is known.
+CREATE TABLE IF NOT EXISTS universe_quarantined_leaves (
+    id BIGINT PRIMARY KEY,
+
+    -- The namespace of the universe tree the leaf was removed from.
+    namespace_root VARCHAR NOT NULL,
+
+    -- The key of the leaf within the universe tree.
+    leaf_node_key BLOB NOT NULL,
+
+    -- The outpoint and script key the leaf was stored under.
+    minting_point BLOB NOT NULL,
+    script_key_bytes BLOB NOT NULL,
+
+    -- The raw proof blob of the leaf and its sum, if the leaf node could
+    -- still be read from the tree.
+    raw_proof BLOB,
+    leaf_sum BIGINT,
+
+    -- The reason the leaf didn't pass the audit.
+    reason TEXT NOT NULL,
+
+    -- The time the leaf was quarantined.
+    quarantined_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS universe_quarantined_leaves_namespace_idx
+    ON universe_quarantined_leaves(namespace_root);
@@ -0,0 +1,31 @@
+-- universe_snapshots stores the signed universe snapshots that were imported
+-- without verifying their proofs first. Every snapshot stays pending until
+-- all of its leaves were verified in the background.
+CREATE TABLE IF NOT EXISTS universe_snapshots (
+    id BIGINT PRIMARY KEY,
+
+    -- The namespace of the universe tree the snapshot was imported into.
+    namespace_root VARCHAR NOT NULL,
+
+    -- The asset ID, group key and proof type that identify the universe.
+    asset_id BLOB,
+    group_key BLOB,
+    proof_type TEXT NOT NULL,
+
+    -- The block height at which the snapshot was taken.
+    height INTEGER NOT NULL,
+
+    -- The signed root of the universe as of the snapshot.
+    root_hash BLOB NOT NULL,
+    root_sum BIGINT NOT NULL,
+
+    -- The time the snapshot was imported.
+    imported_at TIMESTAMP NOT NULL,
+
+    -- The time the background verification of the snapshot completed, and
+    -- the number of leaves that failed the verification.
+    verified_at TIMESTAMP,
+    num_invalid_leaves BIGINT NOT NULL DEFAULT 0,
+
+    UNIQUE(namespace_root, height)
+);
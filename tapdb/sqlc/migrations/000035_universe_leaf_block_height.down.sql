@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS universe_leaves_block_height_idx;
+ALTER TABLE universe_leaves DROP COLUMN block_height;
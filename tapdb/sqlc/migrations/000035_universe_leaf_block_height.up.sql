@@ -0,0 +1,7 @@
+-- Add a field to store the block height of the anchor transaction of each
+-- universe leaf, so leaves can be queried by block height range. Existing
+-- leaves will have this set to NULL until they are inserted again.
+ALTER TABLE universe_leaves ADD COLUMN block_height INTEGER;
+
+CREATE INDEX IF NOT EXISTS universe_leaves_block_height_idx
+    ON universe_leaves(leaf_node_namespace, block_height);
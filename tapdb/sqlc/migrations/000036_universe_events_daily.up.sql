@@ -0,0 +1,51 @@
+-- universe_events_daily stores the daily aggregates of the sync and new proof
+-- events of a universe. Raw events in universe_events that are older than the
+-- configured retention period are downsampled into this table, one row per
+-- universe and UTC day, so the event tables don't grow without bound.
+CREATE TABLE IF NOT EXISTS universe_events_daily (
+    universe_root_id BIGINT NOT NULL REFERENCES universe_roots(id),
+
+    -- day_timestamp is the Unix timestamp of the start (midnight UTC) of the
+    -- day the events are aggregated for.
+    day_timestamp BIGINT NOT NULL,
+
+    sync_events BIGINT NOT NULL DEFAULT 0,
+
+    new_proof_events BIGINT NOT NULL DEFAULT 0,
+
+    PRIMARY KEY (universe_root_id, day_timestamp)
+);
+
+CREATE INDEX IF NOT EXISTS universe_events_daily_day_idx
+    ON universe_events_daily(day_timestamp);
+
+-- The raw events are partitioned into the ones we keep and the ones we
+-- downsample by their timestamp, so we index it.
+CREATE INDEX IF NOT EXISTS universe_events_timestamp_idx
+    ON universe_events(event_timestamp);
+
+-- The universe stats must include the downsampled events, otherwise the total
+-- number of syncs and proofs would shrink over time.
+DROP VIEW universe_stats;
+
+CREATE VIEW universe_stats AS
+WITH events AS (
+    SELECT
+        universe_root_id,
+        CASE WHEN event_type = 'SYNC' THEN 1 ELSE 0 END AS sync_events,
+        CASE WHEN event_type = 'NEW_PROOF' THEN 1 ELSE 0 END AS new_proof_events
+    FROM universe_events
+    UNION ALL
+    SELECT universe_root_id, sync_events, new_proof_events
+    FROM universe_events_daily
+)
+SELECT
+    CAST(SUM(events.sync_events) AS BIGINT) AS total_asset_syncs,
+    CAST(SUM(events.new_proof_events) AS BIGINT) AS total_asset_proofs,
+    roots.asset_id,
+    roots.group_key,
+    roots.proof_type
+FROM events
+JOIN universe_roots roots
+  ON events.universe_root_id = roots.id
+GROUP BY roots.asset_id, roots.group_key, roots.proof_type;
@@ -0,0 +1 @@
+ALTER TABLE universe_leaves DROP COLUMN extensions;
@@ -0,0 +1,4 @@
+-- extensions is the TLV encoded extension space of a universe leaf, which
+-- carries additional metadata about the leaf. It isn't part of the leaf's
+-- MS-SMT node, so it doesn't affect the universe root.
+ALTER TABLE universe_leaves ADD COLUMN extensions BLOB;
@@ -0,0 +1,23 @@
+-- asset_tickers is the local registry of ticker symbols. Each entry pins a
+-- normalized ticker to either a single asset or an asset group. Pinned tickers
+-- take precedence over the tickers declared in the meta data of assets.
+CREATE TABLE IF NOT EXISTS asset_tickers (
+    id BIGINT PRIMARY KEY,
+
+    -- The normalized, upper case ticker symbol.
+    ticker TEXT NOT NULL UNIQUE CHECK(length(ticker) > 0),
+
+    -- The ID of the asset the ticker is pinned to, if it isn't pinned to an
+    -- asset group.
+    asset_id BLOB CHECK(length(asset_id) = 32),
+
+    -- The tweaked key of the asset group the ticker is pinned to, if it isn't
+    -- pinned to a single asset.
+    group_key BLOB CHECK(length(group_key) = 33),
+
+    -- The time the ticker was pinned.
+    created_at TIMESTAMP NOT NULL,
+
+    -- A ticker is pinned to either an asset or an asset group.
+    CHECK((asset_id IS NULL) != (group_key IS NULL))
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS federation_server_sync_schedules;
+DROP TABLE IF EXISTS federation_uni_sync_schedules;
@@ -0,0 +1,33 @@
+-- federation_uni_sync_schedules overrides the interval at which a single
+-- universe is synced with the federation. Universes with a schedule are synced
+-- on their own instead of with the periodic full sync.
+CREATE TABLE IF NOT EXISTS federation_uni_sync_schedules (
+    -- namespace is the string representation of the universe identifier, and
+    -- ensures that there are no duplicate schedules.
+    namespace VARCHAR NOT NULL PRIMARY KEY,
+
+    -- The ID of the asset of the universe, if it isn't a grouped asset.
+    asset_id BLOB CHECK(length(asset_id) = 32),
+
+    -- The compressed group key of the universe, if it's a grouped asset.
+    group_key BLOB CHECK(length(group_key) = 33),
+
+    -- The proof type of the universe.
+    proof_type TEXT NOT NULL CHECK(proof_type IN ('issuance', 'transfer')),
+
+    -- The interval in seconds at which the universe is synced.
+    sync_interval_secs BIGINT NOT NULL CHECK(sync_interval_secs > 0),
+
+    -- A schedule is either for an asset or an asset group universe.
+    CHECK((asset_id IS NULL) != (group_key IS NULL))
+);
+
+-- federation_server_sync_schedules overrides the interval of the periodic full
+-- sync with a single federation server.
+CREATE TABLE IF NOT EXISTS federation_server_sync_schedules (
+    -- The host of the universe server the schedule applies to.
+    server_host TEXT NOT NULL PRIMARY KEY,
+
+    -- The interval in seconds at which the server is synced.
+    sync_interval_secs BIGINT NOT NULL CHECK(sync_interval_secs > 0)
+);
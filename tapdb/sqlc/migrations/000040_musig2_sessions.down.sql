@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS musig2_session_signers;
+DROP INDEX IF EXISTS musig2_sessions_state_idx;
+DROP TABLE IF EXISTS musig2_sessions;
@@ -0,0 +1,59 @@
+-- musig2_sessions stores the MuSig2 signing sessions for the key spend path of
+-- asset script keys that are shared between multiple signers. The secret
+-- nonce of the local signer is only known to lnd, everything else needed to
+-- resume a session after a restart is stored here.
+CREATE TABLE IF NOT EXISTS musig2_sessions (
+    -- The session ID, as assigned by lnd.
+    session_id BLOB PRIMARY KEY CHECK(length(session_id) = 32),
+
+    -- The serialized virtual packet the session signs an input of. Once the
+    -- session is finalized, the packet contains the final witness.
+    virtual_packet BLOB NOT NULL,
+
+    -- The index of the input of the virtual packet that is signed.
+    input_index INTEGER NOT NULL CHECK(input_index >= 0),
+
+    -- The key family and index of the local signer's key in lnd.
+    local_key_family INTEGER NOT NULL,
+    local_key_index INTEGER NOT NULL,
+
+    -- The x-only combined public key of all signers, with the taproot tweak
+    -- applied. This is the script key of the signed input.
+    combined_key BLOB NOT NULL CHECK(length(combined_key) = 32),
+
+    -- The signature hash of the input that is signed.
+    sig_hash BLOB NOT NULL CHECK(length(sig_hash) = 32),
+
+    -- The final Schnorr signature, once all partial signatures are combined.
+    final_sig BLOB CHECK(length(final_sig) = 64),
+
+    -- The state of the session.
+    state SMALLINT NOT NULL,
+
+    -- The time the session was created.
+    creation_time TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS musig2_sessions_state_idx
+    ON musig2_sessions(state);
+
+-- musig2_session_signers stores the signers of a MuSig2 session along with the
+-- public nonce and partial signature each of them contributed.
+CREATE TABLE IF NOT EXISTS musig2_session_signers (
+    session_id BLOB NOT NULL REFERENCES musig2_sessions(session_id)
+        ON DELETE CASCADE,
+
+    -- The compressed public key of the signer.
+    signer_key BLOB NOT NULL CHECK(length(signer_key) = 33),
+
+    -- Whether this signer is the local lnd node.
+    is_local BOOLEAN NOT NULL,
+
+    -- The public nonce of the signer, once it was contributed.
+    pub_nonce BLOB CHECK(length(pub_nonce) = 66),
+
+    -- The partial signature of the signer, once it was contributed.
+    partial_sig BLOB CHECK(length(partial_sig) = 32),
+
+    PRIMARY KEY (session_id, signer_key)
+);
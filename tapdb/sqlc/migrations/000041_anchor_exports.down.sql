@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS anchor_export_locked_utxos;
+DROP TABLE IF EXISTS anchor_export_packets;
+DROP TABLE IF EXISTS anchor_exports;
@@ -0,0 +1,52 @@
+-- anchor_exports stores the funded but unsigned anchor transactions of
+-- transfers that are signed by an external signer, until the signed anchor
+-- transaction is imported.
+CREATE TABLE IF NOT EXISTS anchor_exports (
+    -- The hash of the anchor transaction, which doesn't change once the
+    -- transaction is signed.
+    anchor_txid BLOB PRIMARY KEY CHECK(length(anchor_txid) = 32),
+
+    -- The serialized funded anchor PSBT.
+    funded_psbt BLOB NOT NULL,
+
+    -- The index of the change output of the anchor transaction.
+    change_output_index INTEGER NOT NULL,
+
+    -- The chain fees in sats the funded anchor transaction pays.
+    chain_fees BIGINT NOT NULL,
+
+    -- The fee rate in sat/kw the anchor transaction was funded with.
+    target_fee_rate BIGINT NOT NULL,
+
+    creation_time TIMESTAMP NOT NULL
+);
+
+-- anchor_export_packets stores the virtual packets that are anchored by an
+-- exported anchor transaction.
+CREATE TABLE IF NOT EXISTS anchor_export_packets (
+    anchor_txid BLOB NOT NULL REFERENCES anchor_exports(anchor_txid)
+        ON DELETE CASCADE,
+
+    -- Whether the packet re-anchors a passive asset.
+    passive BOOLEAN NOT NULL,
+
+    -- The position of the packet in the list of active or passive packets.
+    packet_index INTEGER NOT NULL,
+
+    -- The serialized virtual packet.
+    virtual_packet BLOB NOT NULL,
+
+    PRIMARY KEY (anchor_txid, passive, packet_index)
+);
+
+-- anchor_export_locked_utxos stores the BTC outputs the wallet locked to fund
+-- an exported anchor transaction.
+CREATE TABLE IF NOT EXISTS anchor_export_locked_utxos (
+    anchor_txid BLOB NOT NULL REFERENCES anchor_exports(anchor_txid)
+        ON DELETE CASCADE,
+
+    -- The serialized outpoint of the locked output.
+    outpoint BLOB NOT NULL,
+
+    PRIMARY KEY (anchor_txid, outpoint)
+);
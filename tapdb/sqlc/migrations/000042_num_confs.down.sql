@@ -0,0 +1,2 @@
+ALTER TABLE asset_transfers DROP COLUMN num_confs;
+ALTER TABLE addrs DROP COLUMN num_confs;
@@ -0,0 +1,7 @@
+-- The number of confirmations the anchor transaction of a transfer needs to
+-- reach before the transfer is completed.
+ALTER TABLE asset_transfers ADD COLUMN num_confs INTEGER NOT NULL DEFAULT 1;
+
+-- The number of confirmations an address asks the sender to wait for. Zero
+-- means the sender uses its default of a single confirmation.
+ALTER TABLE addrs ADD COLUMN num_confs INTEGER NOT NULL DEFAULT 0;
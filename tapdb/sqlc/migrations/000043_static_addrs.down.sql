@@ -0,0 +1 @@
+DROP TABLE IF EXISTS static_addrs;
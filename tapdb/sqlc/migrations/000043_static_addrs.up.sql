@@ -0,0 +1,38 @@
+-- static_addrs stores the reusable static addresses of the daemon. Payments to
+-- a static address are received through single-use addresses in the addrs
+-- table, which are derived once a payment is found on chain.
+CREATE TABLE IF NOT EXISTS static_addrs (
+    id BIGINT PRIMARY KEY,
+
+    -- version is the version of the static address format.
+    version SMALLINT NOT NULL,
+
+    -- asset_version is the asset version the payments are made with.
+    asset_version SMALLINT NOT NULL,
+
+    -- asset_id is the ID of the asset the static address receives.
+    asset_id BLOB NOT NULL CHECK(length(asset_id) = 32),
+
+    -- scan_key_id points to the internal key the shared secrets of the
+    -- payments are derived with.
+    scan_key_id BIGINT NOT NULL UNIQUE REFERENCES internal_keys(key_id),
+
+    -- spend_key_id points to the internal key that is tweaked into the
+    -- script keys of the payments.
+    spend_key_id BIGINT NOT NULL REFERENCES internal_keys(key_id),
+
+    -- taproot_key_id points to the internal key of the anchor outputs of the
+    -- payments.
+    taproot_key_id BIGINT NOT NULL REFERENCES internal_keys(key_id),
+
+    -- proof_courier_addr is the address of the proof courier the proofs of
+    -- the payments are delivered through.
+    proof_courier_addr BLOB NOT NULL,
+
+    -- creation_time is the time the static address was created.
+    creation_time TIMESTAMP NOT NULL,
+
+    -- scan_height is the height of the last block that was scanned for
+    -- payments to the static address.
+    scan_height INTEGER NOT NULL
+);
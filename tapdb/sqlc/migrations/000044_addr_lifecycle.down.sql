@@ -0,0 +1,2 @@
+ALTER TABLE addrs DROP COLUMN expiry_time;
+ALTER TABLE addrs DROP COLUMN archived_at;
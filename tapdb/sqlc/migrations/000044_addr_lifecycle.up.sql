@@ -0,0 +1,6 @@
+-- The time after which an address no longer accepts new inbound transfers.
+-- NULL means the address never expires.
+ALTER TABLE addrs ADD COLUMN expiry_time TIMESTAMP;
+
+-- The time an address was archived at. NULL means the address isn't archived.
+ALTER TABLE addrs ADD COLUMN archived_at TIMESTAMP;
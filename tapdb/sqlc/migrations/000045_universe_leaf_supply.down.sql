@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS universe_leaves_insertion_timestamp_idx;
+ALTER TABLE universe_leaves DROP COLUMN is_burn;
+ALTER TABLE universe_leaves DROP COLUMN amount;
+ALTER TABLE universe_leaves DROP COLUMN insertion_timestamp;
@@ -0,0 +1,15 @@
+-- insertion_timestamp is the Unix timestamp of the time a universe leaf was
+-- first inserted. It allows the supply changes of an asset to be charted per
+-- day. Existing leaves will have this set to NULL, as the time they were
+-- inserted at is unknown.
+ALTER TABLE universe_leaves ADD COLUMN insertion_timestamp BIGINT;
+
+-- amount is the number of asset units of the leaf. The MS-SMT node of a
+-- transfer leaf only counts the transfer, so the amount is stored separately.
+ALTER TABLE universe_leaves ADD COLUMN amount BIGINT;
+
+-- is_burn is true if the leaf is the proof of an asset burn.
+ALTER TABLE universe_leaves ADD COLUMN is_burn BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS universe_leaves_insertion_timestamp_idx
+    ON universe_leaves(insertion_timestamp);
@@ -0,0 +1,8 @@
+-- broadcast_attempted is true once the anchor transaction of a transfer was
+-- handed to the chain backend for broadcast. A pending transfer whose anchor
+-- transaction was never broadcast can safely be cancelled. We can't know
+-- whether the anchor transaction of an existing transfer made it to the
+-- network, so we conservatively assume it did.
+ALTER TABLE asset_transfers ADD COLUMN broadcast_attempted BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE asset_transfers SET broadcast_attempted = TRUE;
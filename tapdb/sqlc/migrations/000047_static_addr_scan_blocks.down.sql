@@ -0,0 +1 @@
+DROP TABLE IF EXISTS static_addr_scan_blocks;
@@ -0,0 +1,11 @@
+-- static_addr_scan_blocks stores the hashes of the most recent blocks that
+-- were scanned for payments to static addresses. If the chain no longer
+-- contains one of them, the block was re-organized out and the payments found
+-- in it need to be scanned for again.
+CREATE TABLE IF NOT EXISTS static_addr_scan_blocks (
+    -- height is the height of the scanned block.
+    height INTEGER PRIMARY KEY,
+
+    -- block_hash is the hash of the block that was scanned at the height.
+    block_hash BLOB NOT NULL CHECK(length(block_hash) = 32)
+);
@@ -0,0 +1 @@
+ALTER TABLE universe_servers DROP COLUMN identity_key;
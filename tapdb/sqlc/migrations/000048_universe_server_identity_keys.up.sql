@@ -0,0 +1,4 @@
+-- identity_key is the compressed identity key of a federation server, as set
+-- by the operator. Signed messages that federation members send us, like root
+-- change notifications, are only accepted if they verify against this key.
+ALTER TABLE universe_servers ADD COLUMN identity_key BLOB CHECK(length(identity_key) = 33);
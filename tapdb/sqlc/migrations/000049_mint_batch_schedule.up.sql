@@ -0,0 +1,21 @@
+-- mint_batch_schedule stores the schedule at which the pending minting batch
+-- is finalized automatically. There is at most one pending batch, so there is
+-- at most one schedule as well.
+CREATE TABLE IF NOT EXISTS mint_batch_schedule (
+    -- id is always 1, which makes sure only a single schedule is stored.
+    id INTEGER PRIMARY KEY CHECK(id = 1),
+
+    -- finalize_height is the block height at which the batch is finalized.
+    finalize_height INTEGER CHECK(finalize_height > 0),
+
+    -- finalize_time is the wall clock time at which the batch is finalized.
+    finalize_time TIMESTAMP,
+
+    -- min_seedlings is the number of seedlings the batch needs to contain
+    -- for it to be finalized.
+    min_seedlings INTEGER CHECK(min_seedlings > 0),
+
+    -- fee_rate is the fee rate in sat/kw used for the genesis transaction
+    -- when the batch is finalized.
+    fee_rate BIGINT CHECK(fee_rate > 0)
+);
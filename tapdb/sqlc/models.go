@@ -24,6 +24,21 @@ type Addr struct {
 	CreationTime     time.Time
 	ManagedFrom      sql.NullTime
 	ProofCourierAddr []byte
+	NumConfs         int32
+	ExpiryTime       sql.NullTime
+	ArchivedAt       sql.NullTime
+}
+
+type AddrContact struct {
+	ID        int64
+	Label     string
+	TapAddr   string
+	GroupName string
+	Notes     string
+	CreatedAt time.Time
+	LastUsed  sql.NullTime
+	TotalSent int64
+	NumSends  int64
 }
 
 type AddrEvent struct {
@@ -38,6 +53,33 @@ type AddrEvent struct {
 	AssetID             sql.NullInt64
 }
 
+type AddrNote struct {
+	TaprootOutputKey []byte
+	Note             string
+	UpdatedAt        time.Time
+}
+
+type AnchorExport struct {
+	AnchorTxid        []byte
+	FundedPsbt        []byte
+	ChangeOutputIndex int32
+	ChainFees         int64
+	TargetFeeRate     int64
+	CreationTime      time.Time
+}
+
+type AnchorExportLockedUtxo struct {
+	AnchorTxid []byte
+	Outpoint   []byte
+}
+
+type AnchorExportPacket struct {
+	AnchorTxid    []byte
+	Passive       bool
+	PacketIndex   int32
+	VirtualPacket []byte
+}
+
 type Asset struct {
 	AssetID                  int64
 	GenesisID                int64
@@ -69,6 +111,17 @@ type AssetGroupWitness struct {
 	GroupKeyID   int64
 }
 
+type AssetInvoice struct {
+	ID           int64
+	AddrID       int64
+	Memo         string
+	CreationTime time.Time
+	ExpiryTime   time.Time
+	State        int16
+	AmtReceived  int64
+	StateTime    time.Time
+}
+
 type AssetMintingBatch struct {
 	BatchID           int64
 	BatchState        int16
@@ -102,11 +155,23 @@ type AssetSeedling struct {
 	GroupTapscriptRoot []byte
 }
 
+type AssetTicker struct {
+	ID        int64
+	Ticker    string
+	AssetID   []byte
+	GroupKey  []byte
+	CreatedAt time.Time
+}
+
 type AssetTransfer struct {
-	ID               int64
-	HeightHint       int32
-	AnchorTxnID      int64
-	TransferTimeUnix time.Time
+	ID                      int64
+	HeightHint              int32
+	AnchorTxnID             int64
+	TransferTimeUnix        time.Time
+	AnchorPsbt              []byte
+	AnchorChangeOutputIndex sql.NullInt32
+	NumConfs                int32
+	BroadcastAttempted      bool
 }
 
 type AssetTransferInput struct {
@@ -165,12 +230,48 @@ type ChainTxn struct {
 	TxIndex     sql.NullInt32
 }
 
+type CoinSelection struct {
+	ID                    int64
+	SelectionTime         time.Time
+	AssetID               []byte
+	GroupKey              []byte
+	Strategy              int16
+	TargetAmt             int64
+	NumCandidates         int32
+	NumSelected           int32
+	SelectedAmt           int64
+	ChangeAmt             int64
+	FragmentationScorePpm int32
+}
+
 type FederationGlobalSyncConfig struct {
 	ProofType       string
 	AllowSyncInsert bool
 	AllowSyncExport bool
 }
 
+type FederationProfile struct {
+	ID        int64
+	Name      string
+	IsActive  bool
+	UpdatedAt time.Time
+}
+
+type FederationProfileServer struct {
+	ProfileID  int64
+	ServerHost string
+}
+
+type FederationProfileSyncConfig struct {
+	ID              int64
+	ProfileID       int64
+	AssetID         []byte
+	GroupKey        []byte
+	ProofType       string
+	AllowSyncInsert bool
+	AllowSyncExport bool
+}
+
 type FederationProofSyncLog struct {
 	ID             int64
 	Status         string
@@ -182,6 +283,11 @@ type FederationProofSyncLog struct {
 	ServersID      int64
 }
 
+type FederationServerSyncSchedule struct {
+	ServerHost       string
+	SyncIntervalSecs int64
+}
+
 type FederationUniSyncConfig struct {
 	Namespace       string
 	AssetID         []byte
@@ -191,6 +297,14 @@ type FederationUniSyncConfig struct {
 	AllowSyncExport bool
 }
 
+type FederationUniSyncSchedule struct {
+	Namespace        string
+	AssetID          []byte
+	GroupKey         []byte
+	ProofType        string
+	SyncIntervalSecs int64
+}
+
 type GenesisAsset struct {
 	GenAssetID     int64
 	AssetID        []byte
@@ -219,6 +333,15 @@ type GenesisPoint struct {
 	AnchorTxID sql.NullInt64
 }
 
+type IdempotencyKey struct {
+	ID             int64
+	IdempotencyKey string
+	Method         string
+	RequestHash    []byte
+	Response       []byte
+	CreationTime   time.Time
+}
+
 type InternalKey struct {
 	KeyID     int64
 	RawKey    []byte
@@ -287,6 +410,35 @@ type MultiverseRoot struct {
 	ProofType     string
 }
 
+type MintBatchSchedule struct {
+	ID             int32
+	FinalizeHeight sql.NullInt32
+	FinalizeTime   sql.NullTime
+	MinSeedlings   sql.NullInt32
+	FeeRate        sql.NullInt64
+}
+
+type Musig2Session struct {
+	SessionID      []byte
+	VirtualPacket  []byte
+	InputIndex     int32
+	LocalKeyFamily int32
+	LocalKeyIndex  int32
+	CombinedKey    []byte
+	SigHash        []byte
+	FinalSig       []byte
+	State          int16
+	CreationTime   time.Time
+}
+
+type Musig2SessionSigner struct {
+	SessionID  []byte
+	SignerKey  []byte
+	IsLocal    bool
+	PubNonce   []byte
+	PartialSig []byte
+}
+
 type PassiveAsset struct {
 	PassiveID       int64
 	TransferID      int64
@@ -298,6 +450,20 @@ type PassiveAsset struct {
 	NewProof        []byte
 }
 
+type ProofImportItem struct {
+	ID        int64
+	JobID     int64
+	ItemIndex int32
+	ProofFile []byte
+	Status    int16
+	Reason    sql.NullString
+}
+
+type ProofImportJob struct {
+	ID        int64
+	CreatedAt time.Time
+}
+
 type ProofTransferLog struct {
 	TransferType     string
 	ProofLocatorHash []byte
@@ -312,6 +478,40 @@ type ScriptKey struct {
 	DeclaredKnown    sql.NullBool
 }
 
+type SendLimit struct {
+	ID        int64
+	ScriptKey []byte
+	AssetID   []byte
+	MaxAmount int64
+}
+
+type SendTotal struct {
+	ID           int64
+	ScriptKey    []byte
+	AssetID      []byte
+	TotalAmount  int64
+	NumSends     int64
+	LastSendTime time.Time
+}
+
+type StaticAddr struct {
+	ID               int64
+	Version          int16
+	AssetVersion     int16
+	AssetID          []byte
+	ScanKeyID        int64
+	SpendKeyID       int64
+	TaprootKeyID     int64
+	ProofCourierAddr []byte
+	CreationTime     time.Time
+	ScanHeight       int32
+}
+
+type StaticAddrScanBlock struct {
+	Height    int32
+	BlockHash []byte
+}
+
 type TapscriptEdge struct {
 	EdgeID     int64
 	RootHashID int64
@@ -338,14 +538,47 @@ type UniverseEvent struct {
 	EventTimestamp int64
 }
 
+type UniverseEventsDaily struct {
+	UniverseRootID int64
+	DayTimestamp   int64
+	SyncEvents     int64
+	NewProofEvents int64
+}
+
 type UniverseLeafe struct {
-	ID                int64
-	AssetGenesisID    int64
-	MintingPoint      []byte
-	ScriptKeyBytes    []byte
-	UniverseRootID    int64
-	LeafNodeKey       []byte
-	LeafNodeNamespace string
+	ID                 int64
+	AssetGenesisID     int64
+	MintingPoint       []byte
+	ScriptKeyBytes     []byte
+	UniverseRootID     int64
+	LeafNodeKey        []byte
+	LeafNodeNamespace  string
+	BlockHeight        sql.NullInt32
+	Extensions         []byte
+	InsertionTimestamp sql.NullInt64
+	Amount             sql.NullInt64
+	IsBurn             bool
+}
+
+type UniverseQuarantinedLeafe struct {
+	ID             int64
+	NamespaceRoot  string
+	LeafNodeKey    []byte
+	MintingPoint   []byte
+	ScriptKeyBytes []byte
+	RawProof       []byte
+	LeafSum        sql.NullInt64
+	Reason         string
+	QuarantinedAt  time.Time
+}
+
+type UniverseRemoteRoot struct {
+	ID            int64
+	ServerHost    string
+	NamespaceRoot string
+	RootHash      []byte
+	RootSum       int64
+	LastSynced    time.Time
 }
 
 type UniverseRoot struct {
@@ -360,6 +593,34 @@ type UniverseServer struct {
 	ID           int64
 	ServerHost   string
 	LastSyncTime time.Time
+	IdentityKey  []byte
+}
+
+type UniverseSnapshot struct {
+	ID               int64
+	NamespaceRoot    string
+	AssetID          []byte
+	GroupKey         []byte
+	ProofType        string
+	Height           int32
+	RootHash         []byte
+	RootSum          int64
+	ImportedAt       time.Time
+	VerifiedAt       sql.NullTime
+	NumInvalidLeaves int64
+}
+
+type UniverseSyncCheckpoint struct {
+	ID             int64
+	ServerHost     string
+	NamespaceRoot  string
+	RemoteRootHash []byte
+	RemoteRootSum  int64
+	LocalRootHash  []byte
+	LocalRootSum   int64
+	LeafOffset     int32
+	NumSynced      int64
+	UpdatedAt      time.Time
 }
 
 type UniverseStat struct {
@@ -369,3 +630,9 @@ type UniverseStat struct {
 	GroupKey         []byte
 	ProofType        string
 }
+
+type UtxoNote struct {
+	Outpoint  []byte
+	Note      string
+	UpdatedAt time.Time
+}
@@ -38,6 +38,18 @@ func (q *Queries) DeleteNode(ctx context.Context, arg DeleteNodeParams) (int64,
 	return result.RowsAffected()
 }
 
+const deleteNodesByNamespacePattern = `-- name: DeleteNodesByNamespacePattern :execrows
+DELETE FROM mssmt_nodes WHERE namespace LIKE $1
+`
+
+func (q *Queries) DeleteNodesByNamespacePattern(ctx context.Context, namespace string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteNodesByNamespacePattern, namespace)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const deleteRoot = `-- name: DeleteRoot :execrows
 DELETE FROM mssmt_roots WHERE namespace = $1
 `
@@ -11,6 +11,7 @@ import (
 )
 
 type Querier interface {
+	AddSendTotal(ctx context.Context, arg AddSendTotalParams) error
 	AllAssets(ctx context.Context) ([]Asset, error)
 	AllInternalKeys(ctx context.Context) ([]InternalKey, error)
 	AllMintingBatches(ctx context.Context) ([]AllMintingBatchesRow, error)
@@ -23,27 +24,61 @@ type Querier interface {
 	BindMintingBatchWithTx(ctx context.Context, arg BindMintingBatchWithTxParams) error
 	ConfirmChainAnchorTx(ctx context.Context, arg ConfirmChainAnchorTxParams) error
 	ConfirmChainTx(ctx context.Context, arg ConfirmChainTxParams) error
+	CountOrphanedUniverseLeaves(ctx context.Context) (int64, error)
+	CountTransfersWithoutInputs(ctx context.Context) (int64, error)
+	CountTransfersWithoutOutputs(ctx context.Context) (int64, error)
+	DeleteAddrContact(ctx context.Context, label string) (int64, error)
+	DeleteAddrEvent(ctx context.Context, id int64) error
+	DeleteAddrNote(ctx context.Context, taprootOutputKey []byte) error
 	DeleteAllNodes(ctx context.Context, namespace string) (int64, error)
+	DeleteAnchorExport(ctx context.Context, anchorTxid []byte) (int64, error)
+	DeleteAssetTicker(ctx context.Context, ticker string) (int64, error)
+	DeleteAssetTransfer(ctx context.Context, id int64) error
+	DeleteAssetTransferInputs(ctx context.Context, transferID int64) error
+	DeleteAssetTransferOutputs(ctx context.Context, transferID int64) error
 	DeleteAssetWitnesses(ctx context.Context, assetID int64) error
 	DeleteExpiredUTXOLeases(ctx context.Context, now sql.NullTime) error
+	DeleteFederationProfile(ctx context.Context, name string) error
+	DeleteFederationProfileServers(ctx context.Context, profileID int64) error
+	DeleteFederationProfileSyncConfigs(ctx context.Context, profileID int64) error
 	DeleteFederationProofSyncLog(ctx context.Context, arg DeleteFederationProofSyncLogParams) error
+	DeleteFederationServerSyncSchedule(ctx context.Context, serverHost string) error
+	DeleteFederationUniSyncSchedule(ctx context.Context, namespace string) error
+	DeleteLeafProofSyncLog(ctx context.Context, arg DeleteLeafProofSyncLogParams) error
 	DeleteManagedUTXO(ctx context.Context, outpoint []byte) error
+	DeleteMintBatchSchedule(ctx context.Context) error
+	DeleteMuSig2Session(ctx context.Context, sessionID []byte) error
 	DeleteMultiverseLeaf(ctx context.Context, arg DeleteMultiverseLeafParams) error
 	DeleteNode(ctx context.Context, arg DeleteNodeParams) (int64, error)
+	DeleteNodesByNamespacePattern(ctx context.Context, namespace string) (int64, error)
+	DeletePassiveAssets(ctx context.Context, transferID int64) error
+	DeleteRemoteUniverseRoots(ctx context.Context, serverHost string) error
 	DeleteRoot(ctx context.Context, namespace string) (int64, error)
+	DeleteSendLimit(ctx context.Context, arg DeleteSendLimitParams) (int64, error)
 	DeleteTapscriptTreeEdges(ctx context.Context, rootHash []byte) error
 	DeleteTapscriptTreeNodes(ctx context.Context) error
 	DeleteTapscriptTreeRoot(ctx context.Context, rootHash []byte) error
 	DeleteUTXOLease(ctx context.Context, outpoint []byte) error
+	DeleteUnconfirmedChainTx(ctx context.Context, txid []byte) error
 	DeleteUniverseEvents(ctx context.Context, namespaceRoot string) error
+	DeleteUniverseEventsBefore(ctx context.Context, cutoffTimestamp int64) (int64, error)
+	DeleteUniverseEventsDaily(ctx context.Context, namespaceRoot string) error
+	DeleteUniverseLeaf(ctx context.Context, arg DeleteUniverseLeafParams) error
 	DeleteUniverseLeaves(ctx context.Context, namespace string) error
 	DeleteUniverseRoot(ctx context.Context, namespaceRoot string) error
 	DeleteUniverseServer(ctx context.Context, arg DeleteUniverseServerParams) error
+	DeleteUniverseSyncCheckpoint(ctx context.Context, arg DeleteUniverseSyncCheckpointParams) error
+	DeleteUtxoNote(ctx context.Context, outpoint []byte) error
+	DownsampleUniverseEvents(ctx context.Context, cutoffTimestamp int64) error
 	FetchAddrByTaprootOutputKey(ctx context.Context, taprootOutputKey []byte) (FetchAddrByTaprootOutputKeyRow, error)
+	FetchAddrContact(ctx context.Context, label string) (AddrContact, error)
 	FetchAddrEvent(ctx context.Context, id int64) (FetchAddrEventRow, error)
 	FetchAddrEventByAddrKeyAndOutpoint(ctx context.Context, arg FetchAddrEventByAddrKeyAndOutpointParams) (FetchAddrEventByAddrKeyAndOutpointRow, error)
 	FetchAddrs(ctx context.Context, arg FetchAddrsParams) ([]FetchAddrsRow, error)
 	FetchAllNodes(ctx context.Context) ([]MssmtNode, error)
+	FetchAnchorExport(ctx context.Context, anchorTxid []byte) (AnchorExport, error)
+	FetchAnchorExportLockedUTXOs(ctx context.Context, anchorTxid []byte) ([][]byte, error)
+	FetchAnchorExportPackets(ctx context.Context, anchorTxid []byte) ([]AnchorExportPacket, error)
 	FetchAssetID(ctx context.Context, arg FetchAssetIDParams) ([]int64, error)
 	FetchAssetMeta(ctx context.Context, metaID int64) (FetchAssetMetaRow, error)
 	FetchAssetMetaByHash(ctx context.Context, metaDataHash []byte) (FetchAssetMetaByHashRow, error)
@@ -51,6 +86,7 @@ type Querier interface {
 	FetchAssetProof(ctx context.Context, arg FetchAssetProofParams) ([]FetchAssetProofRow, error)
 	FetchAssetProofs(ctx context.Context) ([]FetchAssetProofsRow, error)
 	FetchAssetProofsByAssetID(ctx context.Context, assetID []byte) ([]FetchAssetProofsByAssetIDRow, error)
+	FetchAssetTicker(ctx context.Context, ticker string) (AssetTicker, error)
 	FetchAssetWitnesses(ctx context.Context, assetID sql.NullInt64) ([]FetchAssetWitnessesRow, error)
 	FetchAssetsByAnchorTx(ctx context.Context, anchorUtxoID sql.NullInt64) ([]Asset, error)
 	// We use a LEFT JOIN here as not every asset has a group key, so this'll
@@ -61,6 +97,10 @@ type Querier interface {
 	FetchChainTx(ctx context.Context, txid []byte) (ChainTxn, error)
 	FetchChildren(ctx context.Context, arg FetchChildrenParams) ([]FetchChildrenRow, error)
 	FetchChildrenSelfJoin(ctx context.Context, arg FetchChildrenSelfJoinParams) ([]FetchChildrenSelfJoinRow, error)
+	FetchFederationProfile(ctx context.Context, name string) (FederationProfile, error)
+	FetchFederationProfileServers(ctx context.Context, profileID int64) ([]string, error)
+	FetchFederationProfileSyncConfigs(ctx context.Context, profileID int64) ([]FetchFederationProfileSyncConfigsRow, error)
+	FetchFederationProfiles(ctx context.Context) ([]FederationProfile, error)
 	FetchGenesisByAssetID(ctx context.Context, assetID []byte) (GenesisInfoView, error)
 	FetchGenesisByID(ctx context.Context, genAssetID int64) (FetchGenesisByIDRow, error)
 	FetchGenesisID(ctx context.Context, arg FetchGenesisIDParams) (int64, error)
@@ -69,58 +109,102 @@ type Querier interface {
 	// Sort and limit to return the genesis ID for initial genesis of the group.
 	FetchGroupByGroupKey(ctx context.Context, groupKey []byte) (FetchGroupByGroupKeyRow, error)
 	FetchGroupedAssets(ctx context.Context) ([]FetchGroupedAssetsRow, error)
+	FetchIdempotentResponse(ctx context.Context, arg FetchIdempotentResponseParams) (IdempotencyKey, error)
 	FetchInternalKeyLocator(ctx context.Context, rawKey []byte) (FetchInternalKeyLocatorRow, error)
 	FetchManagedUTXO(ctx context.Context, arg FetchManagedUTXOParams) (FetchManagedUTXORow, error)
 	FetchManagedUTXOs(ctx context.Context) ([]FetchManagedUTXOsRow, error)
+	FetchMintBatchSchedule(ctx context.Context) (FetchMintBatchScheduleRow, error)
 	FetchMintingBatch(ctx context.Context, rawKey []byte) (FetchMintingBatchRow, error)
 	FetchMintingBatchesByInverseState(ctx context.Context, batchState int16) ([]FetchMintingBatchesByInverseStateRow, error)
+	FetchMuSig2Session(ctx context.Context, sessionID []byte) (Musig2Session, error)
+	FetchMuSig2SessionSigners(ctx context.Context, sessionID []byte) ([]Musig2SessionSigner, error)
 	FetchMultiverseRoot(ctx context.Context, namespaceRoot string) (FetchMultiverseRootRow, error)
+	FetchPendingProofImportJobs(ctx context.Context) ([]int64, error)
+	FetchProofImportItems(ctx context.Context, jobID int64) ([]FetchProofImportItemsRow, error)
+	FetchProofImportJob(ctx context.Context, jobID int64) (ProofImportJob, error)
+	FetchRemoteUniverseRoot(ctx context.Context, arg FetchRemoteUniverseRootParams) (FetchRemoteUniverseRootRow, error)
 	FetchRootNode(ctx context.Context, namespace string) (MssmtNode, error)
 	FetchScriptKeyByTweakedKey(ctx context.Context, tweakedScriptKey []byte) (FetchScriptKeyByTweakedKeyRow, error)
 	FetchScriptKeyIDByTweakedKey(ctx context.Context, tweakedScriptKey []byte) (int64, error)
 	FetchSeedlingByID(ctx context.Context, seedlingID int64) (AssetSeedling, error)
 	FetchSeedlingID(ctx context.Context, arg FetchSeedlingIDParams) (int64, error)
 	FetchSeedlingsForBatch(ctx context.Context, rawKey []byte) ([]FetchSeedlingsForBatchRow, error)
+	FetchSendLimit(ctx context.Context, arg FetchSendLimitParams) (int64, error)
 	// Sort the nodes by node_index here instead of returning the indices.
 	FetchTapscriptTree(ctx context.Context, rootHash []byte) ([]FetchTapscriptTreeRow, error)
 	FetchTransferInputs(ctx context.Context, transferID int64) ([]FetchTransferInputsRow, error)
 	FetchTransferOutputs(ctx context.Context, transferID int64) ([]FetchTransferOutputsRow, error)
+	FetchUniverseAssetMeta(ctx context.Context, metaDataHash []byte) (FetchUniverseAssetMetaRow, error)
 	FetchUniverseKeys(ctx context.Context, arg FetchUniverseKeysParams) ([]FetchUniverseKeysRow, error)
 	FetchUniverseRoot(ctx context.Context, namespace string) (FetchUniverseRootRow, error)
+	FetchUniverseSyncCheckpoint(ctx context.Context, arg FetchUniverseSyncCheckpointParams) (FetchUniverseSyncCheckpointRow, error)
+	FetchUtxoNote(ctx context.Context, outpoint []byte) (UtxoNote, error)
+	FetchUtxoNotes(ctx context.Context) ([]UtxoNote, error)
 	GenesisAssets(ctx context.Context) ([]GenesisAsset, error)
 	GenesisPoints(ctx context.Context) ([]GenesisPoint, error)
 	GetRootKey(ctx context.Context, id []byte) (Macaroon, error)
 	HasAssetProof(ctx context.Context, tweakedScriptKey []byte) (bool, error)
 	InsertAddr(ctx context.Context, arg InsertAddrParams) (int64, error)
+	InsertAnchorExport(ctx context.Context, arg InsertAnchorExportParams) error
+	InsertAnchorExportLockedUTXO(ctx context.Context, arg InsertAnchorExportLockedUTXOParams) error
+	InsertAnchorExportPacket(ctx context.Context, arg InsertAnchorExportPacketParams) error
+	InsertAssetInvoice(ctx context.Context, arg InsertAssetInvoiceParams) (int64, error)
 	InsertAssetSeedling(ctx context.Context, arg InsertAssetSeedlingParams) error
 	InsertAssetSeedlingIntoBatch(ctx context.Context, arg InsertAssetSeedlingIntoBatchParams) error
 	InsertAssetTransfer(ctx context.Context, arg InsertAssetTransferParams) (int64, error)
 	InsertAssetTransferInput(ctx context.Context, arg InsertAssetTransferInputParams) error
 	InsertAssetTransferOutput(ctx context.Context, arg InsertAssetTransferOutputParams) error
 	InsertBranch(ctx context.Context, arg InsertBranchParams) error
+	InsertCoinSelection(ctx context.Context, arg InsertCoinSelectionParams) (int64, error)
 	InsertCompactedLeaf(ctx context.Context, arg InsertCompactedLeafParams) error
+	InsertFederationProfileServer(ctx context.Context, arg InsertFederationProfileServerParams) error
+	InsertFederationProfileSyncConfig(ctx context.Context, arg InsertFederationProfileSyncConfigParams) error
+	InsertIdempotentResponse(ctx context.Context, arg InsertIdempotentResponseParams) error
 	InsertLeaf(ctx context.Context, arg InsertLeafParams) error
+	InsertMuSig2Session(ctx context.Context, arg InsertMuSig2SessionParams) error
 	InsertNewProofEvent(ctx context.Context, arg InsertNewProofEventParams) error
 	InsertNewSyncEvent(ctx context.Context, arg InsertNewSyncEventParams) error
 	InsertPassiveAsset(ctx context.Context, arg InsertPassiveAssetParams) error
+	InsertProofImportItem(ctx context.Context, arg InsertProofImportItemParams) error
+	InsertProofImportJob(ctx context.Context, createdAt time.Time) (int64, error)
+	InsertQuarantinedLeaf(ctx context.Context, arg InsertQuarantinedLeafParams) error
 	InsertRootKey(ctx context.Context, arg InsertRootKeyParams) error
+	InsertStaticAddr(ctx context.Context, arg InsertStaticAddrParams) (int64, error)
 	InsertUniverseServer(ctx context.Context, arg InsertUniverseServerParams) error
+	LatestCoinSelections(ctx context.Context) ([]CoinSelection, error)
 	LogProofTransferAttempt(ctx context.Context, arg LogProofTransferAttemptParams) error
 	LogServerSync(ctx context.Context, arg LogServerSyncParams) error
+	MarkTransferBroadcastAttempted(ctx context.Context, anchorTxid []byte) error
+	MarkUniverseSnapshotVerified(ctx context.Context, arg MarkUniverseSnapshotVerifiedParams) error
 	NewMintingBatch(ctx context.Context, arg NewMintingBatchParams) error
+	PruneStaticAddrScanBlocks(ctx context.Context, arg PruneStaticAddrScanBlocksParams) error
+	QueryAddrContactTotals(ctx context.Context, tapAddr string) ([]int64, error)
+	QueryAddrContacts(ctx context.Context, groupName sql.NullString) ([]AddrContact, error)
+	QueryAnchorExports(ctx context.Context) ([]AnchorExport, error)
 	// We use a LEFT JOIN here as not every asset has a group key, so this'll
 	// generate rows that have NULL values for the group key fields if an asset
 	// doesn't have a group key. See the comment in fetchAssetSprouts for a work
 	// around that needs to be used with this query until a sqlc bug is fixed.
 	QueryAssetBalancesByAsset(ctx context.Context, assetIDFilter []byte) ([]QueryAssetBalancesByAssetRow, error)
 	QueryAssetBalancesByGroup(ctx context.Context, keyGroupFilter []byte) ([]QueryAssetBalancesByGroupRow, error)
+	QueryAssetInvoices(ctx context.Context, arg QueryAssetInvoicesParams) ([]QueryAssetInvoicesRow, error)
+	QueryAssetMetasByType(ctx context.Context, metaType sql.NullInt16) ([]QueryAssetMetasByTypeRow, error)
 	QueryAssetStatsPerDayPostgres(ctx context.Context, arg QueryAssetStatsPerDayPostgresParams) ([]QueryAssetStatsPerDayPostgresRow, error)
 	QueryAssetStatsPerDaySqlite(ctx context.Context, arg QueryAssetStatsPerDaySqliteParams) ([]QueryAssetStatsPerDaySqliteRow, error)
+	QueryAssetSupplyPerDayPostgres(ctx context.Context, arg QueryAssetSupplyPerDayPostgresParams) ([]QueryAssetSupplyPerDayPostgresRow, error)
+	QueryAssetSupplyPerDaySqlite(ctx context.Context, arg QueryAssetSupplyPerDaySqliteParams) ([]QueryAssetSupplyPerDaySqliteRow, error)
+	QueryAssetTickers(ctx context.Context) ([]AssetTicker, error)
 	// We'll use this clause to filter out for only transfers that are
 	// unconfirmed. But only if the unconf_only field is set.
 	// Here we have another optional query clause to select a given transfer
 	// based on the anchor_tx_hash, but only if it's specified.
 	QueryAssetTransfers(ctx context.Context, arg QueryAssetTransfersParams) ([]QueryAssetTransfersRow, error)
+	// All filters are optional and only applied if the argument is specified.
+	// A transfer matches an asset ID or group key if any of its inputs spends an
+	// asset with that ID or group key.
+	// A transfer matches a script key if any of its outputs pays to it.
+	// The cursor is the ID of the last transfer of the previous page.
+	QueryAssetTransfersPage(ctx context.Context, arg QueryAssetTransfersPageParams) ([]QueryAssetTransfersPageRow, error)
 	// We use a LEFT JOIN here as not every asset has a group key, so this'll
 	// generate rows that have NULL values for the group key fields if an asset
 	// doesn't have a group key. See the comment in fetchAssetSprouts for a work
@@ -130,15 +214,27 @@ type Querier interface {
 	// make the entire statement evaluate to true, if none of these extra args are
 	// specified.
 	QueryAssets(ctx context.Context, arg QueryAssetsParams) ([]QueryAssetsRow, error)
+	QueryCoinSelections(ctx context.Context, arg QueryCoinSelectionsParams) ([]CoinSelection, error)
 	QueryEventIDs(ctx context.Context, arg QueryEventIDsParams) ([]QueryEventIDsRow, error)
 	QueryFederationGlobalSyncConfigs(ctx context.Context) ([]FederationGlobalSyncConfig, error)
 	// Join on mssmt_nodes to get leaf related fields.
 	// Join on genesis_info_view to get leaf related fields.
 	QueryFederationProofSyncLog(ctx context.Context, arg QueryFederationProofSyncLogParams) ([]QueryFederationProofSyncLogRow, error)
+	QueryFederationServerSyncSchedules(ctx context.Context) ([]FederationServerSyncSchedule, error)
 	QueryFederationUniSyncConfigs(ctx context.Context) ([]FederationUniSyncConfig, error)
+	QueryFederationUniSyncSchedules(ctx context.Context) ([]FederationUniSyncSchedule, error)
+	QueryMuSig2Sessions(ctx context.Context, state sql.NullInt16) ([]Musig2Session, error)
 	QueryMultiverseLeaves(ctx context.Context, arg QueryMultiverseLeavesParams) ([]QueryMultiverseLeavesRow, error)
 	QueryPassiveAssets(ctx context.Context, transferID int64) ([]QueryPassiveAssetsRow, error)
+	QueryPendingUniverseSnapshots(ctx context.Context) ([]UniverseSnapshot, error)
 	QueryProofTransferAttempts(ctx context.Context, arg QueryProofTransferAttemptsParams) ([]time.Time, error)
+	QueryQuarantinedLeaves(ctx context.Context, namespaceRoot string) ([]UniverseQuarantinedLeafe, error)
+	QuerySendLimits(ctx context.Context) ([]QuerySendLimitsRow, error)
+	QuerySendTotals(ctx context.Context, arg QuerySendTotalsParams) ([]QuerySendTotalsRow, error)
+	QueryStaticAddrScanBlocks(ctx context.Context) ([]StaticAddrScanBlock, error)
+	QueryStaticAddrs(ctx context.Context) ([]QueryStaticAddrsRow, error)
+	QueryStaticPaymentEvents(ctx context.Context, arg QueryStaticPaymentEventsParams) ([]QueryStaticPaymentEventsRow, error)
+	QueryTableRowCounts(ctx context.Context) (QueryTableRowCountsRow, error)
 	// TODO(roasbeef): use the universe id instead for the grouping? so namespace
 	// root, simplifies queries
 	QueryUniverseAssetStats(ctx context.Context, arg QueryUniverseAssetStatsParams) ([]QueryUniverseAssetStatsRow, error)
@@ -146,37 +242,66 @@ type Querier interface {
 	QueryUniverseServers(ctx context.Context, arg QueryUniverseServersParams) ([]UniverseServer, error)
 	QueryUniverseStats(ctx context.Context) (QueryUniverseStatsRow, error)
 	ReAnchorPassiveAssets(ctx context.Context, arg ReAnchorPassiveAssetsParams) error
+	RecordAddrContactSend(ctx context.Context, arg RecordAddrContactSendParams) error
+	ReplaceTransferAnchorTx(ctx context.Context, arg ReplaceTransferAnchorTxParams) error
+	RollbackStaticAddrsScanHeight(ctx context.Context, scanHeight int32) error
+	SetActiveFederationProfile(ctx context.Context, name string) error
+	SetAddrArchived(ctx context.Context, arg SetAddrArchivedParams) error
+	SetAddrExpiry(ctx context.Context, arg SetAddrExpiryParams) error
 	SetAddrManaged(ctx context.Context, arg SetAddrManagedParams) error
 	SetAssetSpent(ctx context.Context, arg SetAssetSpentParams) (int64, error)
+	SetStaticAddrsScanHeight(ctx context.Context, scanHeight int32) error
+	SubtractSendTotal(ctx context.Context, arg SubtractSendTotalParams) error
 	UniverseLeaves(ctx context.Context) ([]UniverseLeafe, error)
 	UniverseRoots(ctx context.Context, arg UniverseRootsParams) ([]UniverseRootsRow, error)
+	UpdateAssetInvoiceState(ctx context.Context, arg UpdateAssetInvoiceStateParams) error
 	UpdateBatchGenesisTx(ctx context.Context, arg UpdateBatchGenesisTxParams) error
+	UpdateManagedUTXOOutpoint(ctx context.Context, arg UpdateManagedUTXOOutpointParams) error
 	UpdateMintingBatchState(ctx context.Context, arg UpdateMintingBatchStateParams) error
+	UpdateMuSig2Session(ctx context.Context, arg UpdateMuSig2SessionParams) error
+	UpdatePassiveAssetProof(ctx context.Context, arg UpdatePassiveAssetProofParams) error
+	UpdateProofImportItem(ctx context.Context, arg UpdateProofImportItemParams) error
+	UpdateTransferAnchorPsbt(ctx context.Context, arg UpdateTransferAnchorPsbtParams) error
+	UpdateTransferOutputProofSuffix(ctx context.Context, arg UpdateTransferOutputProofSuffixParams) error
 	UpdateUTXOLease(ctx context.Context, arg UpdateUTXOLeaseParams) error
+	UpsertAddrContact(ctx context.Context, arg UpsertAddrContactParams) error
 	UpsertAddrEvent(ctx context.Context, arg UpsertAddrEventParams) (int64, error)
+	UpsertAddrNote(ctx context.Context, arg UpsertAddrNoteParams) error
 	UpsertAsset(ctx context.Context, arg UpsertAssetParams) (int64, error)
 	UpsertAssetGroupKey(ctx context.Context, arg UpsertAssetGroupKeyParams) (int64, error)
 	UpsertAssetGroupWitness(ctx context.Context, arg UpsertAssetGroupWitnessParams) (int64, error)
 	UpsertAssetMeta(ctx context.Context, arg UpsertAssetMetaParams) (int64, error)
 	UpsertAssetProofByID(ctx context.Context, arg UpsertAssetProofByIDParams) error
+	UpsertAssetTicker(ctx context.Context, arg UpsertAssetTickerParams) error
 	UpsertAssetWitness(ctx context.Context, arg UpsertAssetWitnessParams) error
 	UpsertChainTx(ctx context.Context, arg UpsertChainTxParams) (int64, error)
 	UpsertFederationGlobalSyncConfig(ctx context.Context, arg UpsertFederationGlobalSyncConfigParams) error
+	UpsertFederationProfile(ctx context.Context, arg UpsertFederationProfileParams) (int64, error)
 	UpsertFederationProofSyncLog(ctx context.Context, arg UpsertFederationProofSyncLogParams) (int64, error)
+	UpsertFederationServerSyncSchedule(ctx context.Context, arg UpsertFederationServerSyncScheduleParams) error
 	UpsertFederationUniSyncConfig(ctx context.Context, arg UpsertFederationUniSyncConfigParams) error
+	UpsertFederationUniSyncSchedule(ctx context.Context, arg UpsertFederationUniSyncScheduleParams) error
 	UpsertGenesisAsset(ctx context.Context, arg UpsertGenesisAssetParams) (int64, error)
 	UpsertGenesisPoint(ctx context.Context, prevOut []byte) (int64, error)
 	UpsertInternalKey(ctx context.Context, arg UpsertInternalKeyParams) (int64, error)
 	UpsertManagedUTXO(ctx context.Context, arg UpsertManagedUTXOParams) (int64, error)
+	UpsertMintBatchSchedule(ctx context.Context, arg UpsertMintBatchScheduleParams) error
+	UpsertMuSig2SessionSigner(ctx context.Context, arg UpsertMuSig2SessionSignerParams) error
 	UpsertMultiverseLeaf(ctx context.Context, arg UpsertMultiverseLeafParams) (int64, error)
 	UpsertMultiverseRoot(ctx context.Context, arg UpsertMultiverseRootParams) (int64, error)
+	UpsertRemoteUniverseRoot(ctx context.Context, arg UpsertRemoteUniverseRootParams) error
 	UpsertRootNode(ctx context.Context, arg UpsertRootNodeParams) error
 	UpsertScriptKey(ctx context.Context, arg UpsertScriptKeyParams) (int64, error)
+	UpsertSendLimit(ctx context.Context, arg UpsertSendLimitParams) error
+	UpsertStaticAddrScanBlock(ctx context.Context, arg UpsertStaticAddrScanBlockParams) error
 	UpsertTapscriptTreeEdge(ctx context.Context, arg UpsertTapscriptTreeEdgeParams) (int64, error)
 	UpsertTapscriptTreeNode(ctx context.Context, rawNode []byte) (int64, error)
 	UpsertTapscriptTreeRootHash(ctx context.Context, arg UpsertTapscriptTreeRootHashParams) (int64, error)
 	UpsertUniverseLeaf(ctx context.Context, arg UpsertUniverseLeafParams) error
 	UpsertUniverseRoot(ctx context.Context, arg UpsertUniverseRootParams) (int64, error)
+	UpsertUniverseSnapshot(ctx context.Context, arg UpsertUniverseSnapshotParams) error
+	UpsertUniverseSyncCheckpoint(ctx context.Context, arg UpsertUniverseSyncCheckpointParams) error
+	UpsertUtxoNote(ctx context.Context, arg UpsertUtxoNoteParams) error
 }
 
 var _ Querier = (*Queries)(nil)
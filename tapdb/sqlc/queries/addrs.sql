@@ -2,14 +2,16 @@
 INSERT INTO addrs (
     version, asset_version, genesis_asset_id, group_key, script_key_id,
     taproot_key_id, tapscript_sibling, taproot_output_key, amount, asset_type,
-    creation_time, proof_courier_addr
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) RETURNING id;
+    creation_time, proof_courier_addr, num_confs
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+) RETURNING id;
 
 -- name: FetchAddrs :many
 SELECT 
     version, asset_version, genesis_asset_id, group_key, tapscript_sibling,
-    taproot_output_key, amount, asset_type, creation_time, managed_from,
-    proof_courier_addr,
+    addrs.taproot_output_key, amount, asset_type, creation_time, managed_from,
+    proof_courier_addr, num_confs, expiry_time, archived_at,
     script_keys.tweaked_script_key,
     script_keys.tweak AS script_key_tweak,
     script_keys.declared_known AS script_key_declared_known,
@@ -18,7 +20,8 @@ SELECT
     raw_script_keys.key_index AS script_key_index,
     taproot_keys.raw_key AS raw_taproot_key, 
     taproot_keys.key_family AS taproot_key_family,
-    taproot_keys.key_index AS taproot_key_index
+    taproot_keys.key_index AS taproot_key_index,
+    addr_notes.note AS note
 FROM addrs
 JOIN script_keys
     ON addrs.script_key_id = script_keys.script_key_id
@@ -26,18 +29,25 @@ JOIN internal_keys raw_script_keys
     ON script_keys.internal_key_id = raw_script_keys.key_id
 JOIN internal_keys taproot_keys
     ON addrs.taproot_key_id = taproot_keys.key_id
+LEFT JOIN addr_notes
+    ON addrs.taproot_output_key = addr_notes.taproot_output_key
 WHERE creation_time >= @created_after
     AND creation_time <= @created_before
     AND (@unmanaged_only = false OR
          (CASE WHEN managed_from IS NULL THEN true ELSE false END) = @unmanaged_only)
+    AND (@addr_state = 0 OR
+         (@addr_state = 1 AND archived_at IS NULL AND
+          (expiry_time IS NULL OR expiry_time > @now)) OR
+         (@addr_state = 2 AND archived_at IS NULL AND expiry_time <= @now) OR
+         (@addr_state = 3 AND archived_at IS NOT NULL))
 ORDER BY addrs.creation_time
 LIMIT @num_limit OFFSET @num_offset;
 
 -- name: FetchAddrByTaprootOutputKey :one
 SELECT
     version, asset_version, genesis_asset_id, group_key, tapscript_sibling,
-    taproot_output_key, amount, asset_type, creation_time, managed_from,
-    proof_courier_addr,
+    addrs.taproot_output_key, amount, asset_type, creation_time, managed_from,
+    proof_courier_addr, num_confs, expiry_time, archived_at,
     script_keys.tweaked_script_key,
     script_keys.tweak AS script_key_tweak,
     script_keys.declared_known AS script_key_declared_known,
@@ -46,7 +56,8 @@ SELECT
     raw_script_keys.key_index AS script_key_index,
     taproot_keys.raw_key AS raw_taproot_key,
     taproot_keys.key_family AS taproot_key_family,
-    taproot_keys.key_index AS taproot_key_index
+    taproot_keys.key_index AS taproot_key_index,
+    addr_notes.note AS note
 FROM addrs
 JOIN script_keys
   ON addrs.script_key_id = script_keys.script_key_id
@@ -54,7 +65,9 @@ JOIN internal_keys raw_script_keys
   ON script_keys.internal_key_id = raw_script_keys.key_id
 JOIN internal_keys taproot_keys
   ON addrs.taproot_key_id = taproot_keys.key_id
-WHERE taproot_output_key = $1;
+LEFT JOIN addr_notes
+  ON addrs.taproot_output_key = addr_notes.taproot_output_key
+WHERE addrs.taproot_output_key = $1;
 
 -- name: SetAddrManaged :exec
 WITH target_addr(addr_id) AS (
@@ -66,6 +79,16 @@ UPDATE addrs
 SET managed_from = $2
 WHERE id = (SELECT addr_id FROM target_addr);
 
+-- name: SetAddrExpiry :exec
+UPDATE addrs
+SET expiry_time = $2
+WHERE taproot_output_key = $1;
+
+-- name: SetAddrArchived :exec
+UPDATE addrs
+SET archived_at = $2
+WHERE taproot_output_key = $1;
+
 -- name: UpsertAddrEvent :one
 WITH target_addr(addr_id) AS (
     SELECT id
@@ -0,0 +1,48 @@
+-- name: InsertAnchorExport :exec
+INSERT INTO anchor_exports (
+    anchor_txid, funded_psbt, change_output_index, chain_fees,
+    target_fee_rate, creation_time
+) VALUES (
+    @anchor_txid, @funded_psbt, @change_output_index, @chain_fees,
+    @target_fee_rate, @creation_time
+);
+
+-- name: InsertAnchorExportPacket :exec
+INSERT INTO anchor_export_packets (
+    anchor_txid, passive, packet_index, virtual_packet
+) VALUES (
+    @anchor_txid, @passive, @packet_index, @virtual_packet
+);
+
+-- name: InsertAnchorExportLockedUTXO :exec
+INSERT INTO anchor_export_locked_utxos (
+    anchor_txid, outpoint
+) VALUES (
+    @anchor_txid, @outpoint
+);
+
+-- name: FetchAnchorExport :one
+SELECT *
+FROM anchor_exports
+WHERE anchor_txid = @anchor_txid;
+
+-- name: QueryAnchorExports :many
+SELECT *
+FROM anchor_exports
+ORDER BY creation_time;
+
+-- name: FetchAnchorExportPackets :many
+SELECT *
+FROM anchor_export_packets
+WHERE anchor_txid = @anchor_txid
+ORDER BY passive, packet_index;
+
+-- name: FetchAnchorExportLockedUTXOs :many
+SELECT outpoint
+FROM anchor_export_locked_utxos
+WHERE anchor_txid = @anchor_txid
+ORDER BY outpoint;
+
+-- name: DeleteAnchorExport :execrows
+DELETE FROM anchor_exports
+WHERE anchor_txid = @anchor_txid;
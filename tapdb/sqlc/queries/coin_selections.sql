@@ -0,0 +1,31 @@
+-- name: InsertCoinSelection :one
+INSERT INTO coin_selections (
+    selection_time, asset_id, group_key, strategy, target_amt,
+    num_candidates, num_selected, selected_amt, change_amt,
+    fragmentation_score_ppm
+) VALUES (
+    @selection_time, @asset_id, @group_key, @strategy, @target_amt,
+    @num_candidates, @num_selected, @selected_amt, @change_amt,
+    @fragmentation_score_ppm
+) RETURNING id;
+
+-- name: QueryCoinSelections :many
+SELECT *
+FROM coin_selections
+WHERE selection_time >= @selected_after
+    AND (sqlc.narg('asset_id') IS NULL OR
+         asset_id = sqlc.narg('asset_id'))
+    AND (sqlc.narg('group_key') IS NULL OR
+         group_key = sqlc.narg('group_key'))
+ORDER BY id DESC
+LIMIT @num_limit;
+
+-- name: LatestCoinSelections :many
+SELECT *
+FROM coin_selections
+WHERE id IN (
+    SELECT MAX(id)
+    FROM coin_selections
+    GROUP BY asset_id, group_key
+)
+ORDER BY id;
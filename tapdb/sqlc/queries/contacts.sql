@@ -0,0 +1,37 @@
+-- name: UpsertAddrContact :exec
+INSERT INTO addr_contacts (
+    label, tap_addr, group_name, notes, created_at, total_sent, num_sends
+) VALUES (
+    @label, @tap_addr, @group_name, @notes, @created_at, 0, 0
+) ON CONFLICT (label)
+    DO UPDATE SET tap_addr = EXCLUDED.tap_addr,
+        group_name = EXCLUDED.group_name,
+        notes = EXCLUDED.notes;
+
+-- name: FetchAddrContact :one
+SELECT *
+FROM addr_contacts
+WHERE label = @label;
+
+-- name: QueryAddrContacts :many
+SELECT *
+FROM addr_contacts
+WHERE (group_name = sqlc.narg('group_name') OR
+       sqlc.narg('group_name') IS NULL)
+ORDER BY label;
+
+-- name: DeleteAddrContact :execrows
+DELETE FROM addr_contacts
+WHERE label = @label;
+
+-- name: QueryAddrContactTotals :many
+SELECT total_sent
+FROM addr_contacts
+WHERE tap_addr = @tap_addr;
+
+-- name: RecordAddrContactSend :exec
+UPDATE addr_contacts
+SET total_sent = total_sent + @amount,
+    num_sends = num_sends + 1,
+    last_used = @send_time
+WHERE tap_addr = @tap_addr;
@@ -0,0 +1,62 @@
+-- name: UpsertFederationProfile :one
+INSERT INTO federation_profiles (
+    name, updated_at
+) VALUES (
+    @name, @updated_at
+) ON CONFLICT (name)
+    DO UPDATE SET updated_at = EXCLUDED.updated_at
+RETURNING id;
+
+-- name: InsertFederationProfileServer :exec
+INSERT INTO federation_profile_servers (
+    profile_id, server_host
+) VALUES (
+    @profile_id, @server_host
+);
+
+-- name: DeleteFederationProfileServers :exec
+DELETE FROM federation_profile_servers
+WHERE profile_id = @profile_id;
+
+-- name: InsertFederationProfileSyncConfig :exec
+INSERT INTO federation_profile_sync_configs (
+    profile_id, asset_id, group_key, proof_type, allow_sync_insert,
+    allow_sync_export
+) VALUES (
+    @profile_id, @asset_id, @group_key, @proof_type, @allow_sync_insert,
+    @allow_sync_export
+);
+
+-- name: DeleteFederationProfileSyncConfigs :exec
+DELETE FROM federation_profile_sync_configs
+WHERE profile_id = @profile_id;
+
+-- name: FetchFederationProfile :one
+SELECT *
+FROM federation_profiles
+WHERE name = @name;
+
+-- name: FetchFederationProfiles :many
+SELECT *
+FROM federation_profiles
+ORDER BY name;
+
+-- name: FetchFederationProfileServers :many
+SELECT server_host
+FROM federation_profile_servers
+WHERE profile_id = @profile_id
+ORDER BY server_host;
+
+-- name: FetchFederationProfileSyncConfigs :many
+SELECT asset_id, group_key, proof_type, allow_sync_insert, allow_sync_export
+FROM federation_profile_sync_configs
+WHERE profile_id = @profile_id
+ORDER BY id;
+
+-- name: DeleteFederationProfile :exec
+DELETE FROM federation_profiles
+WHERE name = @name;
+
+-- name: SetActiveFederationProfile :exec
+UPDATE federation_profiles
+SET is_active = (name = @name);
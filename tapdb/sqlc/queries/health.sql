@@ -0,0 +1,38 @@
+-- name: QueryTableRowCounts :one
+SELECT
+    (SELECT COUNT(*) FROM assets) AS num_assets,
+    (SELECT COUNT(*) FROM asset_proofs) AS num_asset_proofs,
+    (SELECT COUNT(*) FROM asset_transfers) AS num_transfers,
+    (SELECT COUNT(*) FROM addrs) AS num_addrs,
+    (SELECT COUNT(*) FROM addr_events) AS num_addr_events,
+    (SELECT COUNT(*) FROM universe_roots) AS num_universe_roots,
+    (SELECT COUNT(*) FROM universe_leaves) AS num_universe_leaves,
+    (SELECT COUNT(*) FROM mssmt_nodes) AS num_mssmt_nodes;
+
+-- name: CountOrphanedUniverseLeaves :one
+SELECT COUNT(*)
+FROM universe_leaves leaves
+WHERE NOT EXISTS (
+    SELECT 1
+    FROM mssmt_nodes nodes
+    WHERE nodes.key = leaves.leaf_node_key AND
+        nodes.namespace = leaves.leaf_node_namespace
+);
+
+-- name: CountTransfersWithoutOutputs :one
+SELECT COUNT(*)
+FROM asset_transfers transfers
+WHERE NOT EXISTS (
+    SELECT 1
+    FROM asset_transfer_outputs outputs
+    WHERE outputs.transfer_id = transfers.id
+);
+
+-- name: CountTransfersWithoutInputs :one
+SELECT COUNT(*)
+FROM asset_transfers transfers
+WHERE NOT EXISTS (
+    SELECT 1
+    FROM asset_transfer_inputs inputs
+    WHERE inputs.transfer_id = transfers.id
+);
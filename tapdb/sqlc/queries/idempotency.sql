@@ -0,0 +1,12 @@
+-- name: InsertIdempotentResponse :exec
+INSERT INTO idempotency_keys (
+    idempotency_key, method, request_hash, response, creation_time
+) VALUES (
+    @idempotency_key, @method, @request_hash, @response, @creation_time
+);
+
+-- name: FetchIdempotentResponse :one
+SELECT *
+FROM idempotency_keys
+WHERE idempotency_key = @idempotency_key
+    AND method = @method;
@@ -0,0 +1,26 @@
+-- name: InsertAssetInvoice :one
+INSERT INTO asset_invoices (
+    addr_id, memo, creation_time, expiry_time, state, amt_received,
+    state_time
+) VALUES (
+    (SELECT id FROM addrs WHERE taproot_output_key = @taproot_output_key),
+    @memo, @creation_time, @expiry_time, @state, @amt_received, @state_time
+) RETURNING id;
+
+-- name: QueryAssetInvoices :many
+SELECT invoices.id, addrs.taproot_output_key, invoices.memo,
+    invoices.creation_time, invoices.expiry_time, invoices.state,
+    invoices.amt_received, invoices.state_time
+FROM asset_invoices invoices
+JOIN addrs
+    ON invoices.addr_id = addrs.id
+WHERE invoices.state = COALESCE(sqlc.narg('state'), invoices.state)
+    AND addrs.taproot_output_key = COALESCE(
+        sqlc.narg('taproot_output_key'), addrs.taproot_output_key
+    )
+ORDER BY invoices.id;
+
+-- name: UpdateAssetInvoiceState :exec
+UPDATE asset_invoices
+SET state = @state, amt_received = @amt_received, state_time = @state_time
+WHERE id = @id;
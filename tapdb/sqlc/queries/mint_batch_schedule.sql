@@ -0,0 +1,18 @@
+-- name: UpsertMintBatchSchedule :exec
+INSERT INTO mint_batch_schedule (
+    id, finalize_height, finalize_time, min_seedlings, fee_rate
+) VALUES (
+    1, @finalize_height, @finalize_time, @min_seedlings, @fee_rate
+) ON CONFLICT (id)
+    DO UPDATE SET finalize_height = EXCLUDED.finalize_height,
+        finalize_time = EXCLUDED.finalize_time,
+        min_seedlings = EXCLUDED.min_seedlings,
+        fee_rate = EXCLUDED.fee_rate;
+
+-- name: FetchMintBatchSchedule :one
+SELECT finalize_height, finalize_time, min_seedlings, fee_rate
+FROM mint_batch_schedule
+WHERE id = 1;
+
+-- name: DeleteMintBatchSchedule :exec
+DELETE FROM mint_batch_schedule;
@@ -54,6 +54,9 @@ DELETE FROM mssmt_nodes WHERE hash_key = $1 AND namespace = $2;
 -- name: DeleteAllNodes :execrows
 DELETE FROM mssmt_nodes WHERE namespace = $1;
 
+-- name: DeleteNodesByNamespacePattern :execrows
+DELETE FROM mssmt_nodes WHERE namespace LIKE $1;
+
 -- name: DeleteRoot :execrows
 DELETE FROM mssmt_roots WHERE namespace = $1;
 
@@ -0,0 +1,44 @@
+-- name: InsertMuSig2Session :exec
+INSERT INTO musig2_sessions (
+    session_id, virtual_packet, input_index, local_key_family,
+    local_key_index, combined_key, sig_hash, final_sig, state, creation_time
+) VALUES (
+    @session_id, @virtual_packet, @input_index, @local_key_family,
+    @local_key_index, @combined_key, @sig_hash, @final_sig, @state,
+    @creation_time
+);
+
+-- name: UpdateMuSig2Session :exec
+UPDATE musig2_sessions
+SET virtual_packet = @virtual_packet, final_sig = @final_sig, state = @state
+WHERE session_id = @session_id;
+
+-- name: UpsertMuSig2SessionSigner :exec
+INSERT INTO musig2_session_signers (
+    session_id, signer_key, is_local, pub_nonce, partial_sig
+) VALUES (
+    @session_id, @signer_key, @is_local, @pub_nonce, @partial_sig
+) ON CONFLICT (session_id, signer_key)
+    DO UPDATE SET pub_nonce = EXCLUDED.pub_nonce,
+        partial_sig = EXCLUDED.partial_sig;
+
+-- name: FetchMuSig2Session :one
+SELECT *
+FROM musig2_sessions
+WHERE session_id = @session_id;
+
+-- name: QueryMuSig2Sessions :many
+SELECT *
+FROM musig2_sessions
+WHERE state = COALESCE(sqlc.narg('state'), state)
+ORDER BY creation_time, session_id;
+
+-- name: FetchMuSig2SessionSigners :many
+SELECT *
+FROM musig2_session_signers
+WHERE session_id = @session_id
+ORDER BY signer_key;
+
+-- name: DeleteMuSig2Session :exec
+DELETE FROM musig2_sessions
+WHERE session_id = @session_id;
@@ -0,0 +1,32 @@
+-- name: UpsertAddrNote :exec
+INSERT INTO addr_notes (
+    taproot_output_key, note, updated_at
+) VALUES (
+    @taproot_output_key, @note, @updated_at
+) ON CONFLICT (taproot_output_key)
+    DO UPDATE SET note = EXCLUDED.note, updated_at = EXCLUDED.updated_at;
+
+-- name: DeleteAddrNote :exec
+DELETE FROM addr_notes
+WHERE taproot_output_key = @taproot_output_key;
+
+-- name: UpsertUtxoNote :exec
+INSERT INTO utxo_notes (
+    outpoint, note, updated_at
+) VALUES (
+    @outpoint, @note, @updated_at
+) ON CONFLICT (outpoint)
+    DO UPDATE SET note = EXCLUDED.note, updated_at = EXCLUDED.updated_at;
+
+-- name: DeleteUtxoNote :exec
+DELETE FROM utxo_notes
+WHERE outpoint = @outpoint;
+
+-- name: FetchUtxoNote :one
+SELECT *
+FROM utxo_notes
+WHERE outpoint = @outpoint;
+
+-- name: FetchUtxoNotes :many
+SELECT *
+FROM utxo_notes;
@@ -0,0 +1,35 @@
+-- name: InsertProofImportJob :one
+INSERT INTO proof_import_jobs (
+    created_at
+) VALUES (
+    @created_at
+) RETURNING id;
+
+-- name: InsertProofImportItem :exec
+INSERT INTO proof_import_items (
+    job_id, item_index, proof_file, status
+) VALUES (
+    @job_id, @item_index, @proof_file, @status
+);
+
+-- name: UpdateProofImportItem :exec
+UPDATE proof_import_items
+SET status = @status, reason = @reason
+WHERE job_id = @job_id AND item_index = @item_index;
+
+-- name: FetchProofImportJob :one
+SELECT *
+FROM proof_import_jobs
+WHERE id = @job_id;
+
+-- name: FetchProofImportItems :many
+SELECT item_index, proof_file, status, reason
+FROM proof_import_items
+WHERE job_id = @job_id
+ORDER BY item_index;
+
+-- name: FetchPendingProofImportJobs :many
+SELECT DISTINCT job_id
+FROM proof_import_items
+WHERE status = 0
+ORDER BY job_id;
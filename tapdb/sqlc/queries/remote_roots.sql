@@ -0,0 +1,18 @@
+-- name: UpsertRemoteUniverseRoot :exec
+INSERT INTO universe_remote_roots (
+    server_host, namespace_root, root_hash, root_sum, last_synced
+) VALUES (
+    @server_host, @namespace_root, @root_hash, @root_sum, @last_synced
+) ON CONFLICT (server_host, namespace_root)
+    DO UPDATE SET root_hash = EXCLUDED.root_hash,
+        root_sum = EXCLUDED.root_sum,
+        last_synced = EXCLUDED.last_synced;
+
+-- name: FetchRemoteUniverseRoot :one
+SELECT root_hash, root_sum, last_synced
+FROM universe_remote_roots
+WHERE server_host = @server_host AND namespace_root = @namespace_root;
+
+-- name: DeleteRemoteUniverseRoots :exec
+DELETE FROM universe_remote_roots
+WHERE server_host = @server_host;
@@ -0,0 +1,50 @@
+-- name: AddSendTotal :exec
+INSERT INTO send_totals (
+    script_key, asset_id, total_amount, num_sends, last_send_time
+) VALUES (
+    @script_key, @asset_id, @amount, 1, @send_time
+) ON CONFLICT (script_key, asset_id)
+    DO UPDATE SET total_amount = send_totals.total_amount + EXCLUDED.total_amount,
+        num_sends = send_totals.num_sends + 1,
+        last_send_time = EXCLUDED.last_send_time;
+
+-- name: SubtractSendTotal :exec
+UPDATE send_totals
+SET total_amount = total_amount - @amount,
+    num_sends = num_sends - 1
+WHERE script_key = @script_key AND asset_id = @asset_id;
+
+-- name: QuerySendTotals :many
+SELECT totals.script_key, totals.asset_id, totals.total_amount,
+    totals.num_sends, totals.last_send_time, limits.max_amount
+FROM send_totals totals
+LEFT JOIN send_limits limits
+    ON totals.script_key = limits.script_key
+        AND totals.asset_id = limits.asset_id
+WHERE (totals.script_key = sqlc.narg('script_key') OR
+       sqlc.narg('script_key') IS NULL)
+    AND (totals.asset_id = sqlc.narg('asset_id') OR
+         sqlc.narg('asset_id') IS NULL)
+ORDER BY totals.script_key, totals.asset_id;
+
+-- name: UpsertSendLimit :exec
+INSERT INTO send_limits (
+    script_key, asset_id, max_amount
+) VALUES (
+    @script_key, @asset_id, @max_amount
+) ON CONFLICT (script_key, asset_id)
+    DO UPDATE SET max_amount = EXCLUDED.max_amount;
+
+-- name: FetchSendLimit :one
+SELECT max_amount
+FROM send_limits
+WHERE script_key = @script_key AND asset_id = @asset_id;
+
+-- name: QuerySendLimits :many
+SELECT script_key, asset_id, max_amount
+FROM send_limits
+ORDER BY script_key, asset_id;
+
+-- name: DeleteSendLimit :execrows
+DELETE FROM send_limits
+WHERE script_key = @script_key AND asset_id = @asset_id;
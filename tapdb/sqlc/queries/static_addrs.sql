@@ -0,0 +1,75 @@
+-- name: InsertStaticAddr :one
+INSERT INTO static_addrs (
+    version, asset_version, asset_id, scan_key_id, spend_key_id,
+    taproot_key_id, proof_courier_addr, creation_time, scan_height
+) VALUES (
+    @version, @asset_version, @asset_id, @scan_key_id, @spend_key_id,
+    @taproot_key_id, @proof_courier_addr, @creation_time, @scan_height
+) RETURNING id;
+
+-- name: QueryStaticAddrs :many
+SELECT
+    version, asset_version, asset_id, proof_courier_addr, creation_time,
+    scan_height,
+    scan_keys.raw_key AS raw_scan_key,
+    scan_keys.key_family AS scan_key_family,
+    scan_keys.key_index AS scan_key_index,
+    spend_keys.raw_key AS raw_spend_key,
+    spend_keys.key_family AS spend_key_family,
+    spend_keys.key_index AS spend_key_index,
+    taproot_keys.raw_key AS raw_taproot_key,
+    taproot_keys.key_family AS taproot_key_family,
+    taproot_keys.key_index AS taproot_key_index
+FROM static_addrs
+JOIN internal_keys scan_keys
+  ON static_addrs.scan_key_id = scan_keys.key_id
+JOIN internal_keys spend_keys
+  ON static_addrs.spend_key_id = spend_keys.key_id
+JOIN internal_keys taproot_keys
+  ON static_addrs.taproot_key_id = taproot_keys.key_id
+ORDER BY static_addrs.id;
+
+-- name: SetStaticAddrsScanHeight :exec
+UPDATE static_addrs
+SET scan_height = @scan_height
+WHERE scan_height < @scan_height;
+
+-- name: RollbackStaticAddrsScanHeight :exec
+UPDATE static_addrs
+SET scan_height = @scan_height
+WHERE scan_height > @scan_height;
+
+-- name: UpsertStaticAddrScanBlock :exec
+INSERT INTO static_addr_scan_blocks (
+    height, block_hash
+) VALUES (
+    @height, @block_hash
+)
+ON CONFLICT (height)
+    DO UPDATE SET block_hash = EXCLUDED.block_hash;
+
+-- name: QueryStaticAddrScanBlocks :many
+SELECT height, block_hash
+FROM static_addr_scan_blocks
+ORDER BY height DESC;
+
+-- name: PruneStaticAddrScanBlocks :exec
+DELETE FROM static_addr_scan_blocks
+WHERE height < @min_height OR height > @max_height;
+
+-- name: QueryStaticPaymentEvents :many
+SELECT
+    addr_events.id, chain_txns.txid, addr_events.chain_txn_output_index
+FROM addr_events
+JOIN chain_txns
+  ON addr_events.chain_txn_id = chain_txns.txn_id
+JOIN addrs
+  ON addr_events.addr_id = addrs.id
+JOIN static_addrs
+  ON addrs.taproot_key_id = static_addrs.taproot_key_id
+WHERE chain_txns.block_height > @min_height
+  AND addr_events.status < @max_status;
+
+-- name: DeleteAddrEvent :exec
+DELETE FROM addr_events
+WHERE id = @id;
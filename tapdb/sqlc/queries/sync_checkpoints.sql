@@ -0,0 +1,25 @@
+-- name: UpsertUniverseSyncCheckpoint :exec
+INSERT INTO universe_sync_checkpoints (
+    server_host, namespace_root, remote_root_hash, remote_root_sum,
+    local_root_hash, local_root_sum, leaf_offset, num_synced, updated_at
+) VALUES (
+    @server_host, @namespace_root, @remote_root_hash, @remote_root_sum,
+    @local_root_hash, @local_root_sum, @leaf_offset, @num_synced, @updated_at
+) ON CONFLICT (server_host, namespace_root)
+    DO UPDATE SET remote_root_hash = EXCLUDED.remote_root_hash,
+        remote_root_sum = EXCLUDED.remote_root_sum,
+        local_root_hash = EXCLUDED.local_root_hash,
+        local_root_sum = EXCLUDED.local_root_sum,
+        leaf_offset = EXCLUDED.leaf_offset,
+        num_synced = EXCLUDED.num_synced,
+        updated_at = EXCLUDED.updated_at;
+
+-- name: FetchUniverseSyncCheckpoint :one
+SELECT remote_root_hash, remote_root_sum, local_root_hash, local_root_sum,
+    leaf_offset, num_synced, updated_at
+FROM universe_sync_checkpoints
+WHERE server_host = @server_host AND namespace_root = @namespace_root;
+
+-- name: DeleteUniverseSyncCheckpoint :exec
+DELETE FROM universe_sync_checkpoints
+WHERE server_host = @server_host AND namespace_root = @namespace_root;
@@ -0,0 +1,33 @@
+-- name: UpsertFederationUniSyncSchedule :exec
+INSERT INTO federation_uni_sync_schedules (
+    namespace, asset_id, group_key, proof_type, sync_interval_secs
+) VALUES (
+    @namespace, @asset_id, @group_key, @proof_type, @sync_interval_secs
+) ON CONFLICT (namespace)
+    DO UPDATE SET sync_interval_secs = EXCLUDED.sync_interval_secs;
+
+-- name: DeleteFederationUniSyncSchedule :exec
+DELETE FROM federation_uni_sync_schedules
+WHERE namespace = @namespace;
+
+-- name: QueryFederationUniSyncSchedules :many
+SELECT *
+FROM federation_uni_sync_schedules
+ORDER BY namespace;
+
+-- name: UpsertFederationServerSyncSchedule :exec
+INSERT INTO federation_server_sync_schedules (
+    server_host, sync_interval_secs
+) VALUES (
+    @server_host, @sync_interval_secs
+) ON CONFLICT (server_host)
+    DO UPDATE SET sync_interval_secs = EXCLUDED.sync_interval_secs;
+
+-- name: DeleteFederationServerSyncSchedule :exec
+DELETE FROM federation_server_sync_schedules
+WHERE server_host = @server_host;
+
+-- name: QueryFederationServerSyncSchedules :many
+SELECT *
+FROM federation_server_sync_schedules
+ORDER BY server_host;
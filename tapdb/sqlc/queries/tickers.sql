@@ -0,0 +1,37 @@
+-- name: UpsertAssetTicker :exec
+INSERT INTO asset_tickers (
+    ticker, asset_id, group_key, created_at
+) VALUES (
+    @ticker, @asset_id, @group_key, @created_at
+) ON CONFLICT (ticker)
+    DO UPDATE SET asset_id = EXCLUDED.asset_id,
+        group_key = EXCLUDED.group_key,
+        created_at = EXCLUDED.created_at;
+
+-- name: FetchAssetTicker :one
+SELECT *
+FROM asset_tickers
+WHERE ticker = @ticker;
+
+-- name: QueryAssetTickers :many
+SELECT *
+FROM asset_tickers
+ORDER BY ticker;
+
+-- name: DeleteAssetTicker :execrows
+DELETE FROM asset_tickers
+WHERE ticker = @ticker;
+
+-- name: QueryAssetMetasByType :many
+SELECT genesis_assets.asset_id, genesis_assets.asset_tag,
+       groups.tweaked_group_key, assets_meta.meta_data_blob
+FROM genesis_assets
+JOIN assets_meta
+    ON genesis_assets.meta_data_id = assets_meta.meta_id
+LEFT JOIN asset_group_witnesses wit
+    ON wit.gen_asset_id = genesis_assets.gen_asset_id
+LEFT JOIN asset_groups groups
+    ON wit.group_key_id = groups.group_id
+WHERE assets_meta.meta_data_type = @meta_type AND
+      assets_meta.meta_data_blob IS NOT NULL
+ORDER BY genesis_assets.gen_asset_id;
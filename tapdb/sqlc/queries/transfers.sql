@@ -5,9 +5,11 @@ WITH target_txn(txn_id) AS (
     WHERE txid = @anchor_txid
 )
 INSERT INTO asset_transfers (
-    height_hint, anchor_txn_id, transfer_time_unix
+    height_hint, anchor_txn_id, transfer_time_unix, anchor_psbt,
+    anchor_change_output_index, num_confs
 ) VALUES (
-    @height_hint, (SELECT txn_id FROM target_txn), @transfer_time_unix
+    @height_hint, (SELECT txn_id FROM target_txn), @transfer_time_unix,
+    @anchor_psbt, @anchor_change_output_index, @num_confs
 ) RETURNING id;
 
 -- name: InsertAssetTransferInput :exec
@@ -30,7 +32,8 @@ INSERT INTO asset_transfer_outputs (
 
 -- name: QueryAssetTransfers :many
 SELECT
-    id, height_hint, txns.txid, transfer_time_unix
+    id, height_hint, txns.txid, transfer_time_unix, anchor_psbt,
+    anchor_change_output_index, num_confs, broadcast_attempted
 FROM asset_transfers transfers
 JOIN chain_txns txns
     ON transfers.anchor_txn_id = txns.txn_id
@@ -45,6 +48,62 @@ AND (txns.txid = sqlc.narg('anchor_tx_hash') OR
     sqlc.narg('anchor_tx_hash') IS NULL)
 ORDER BY transfer_time_unix;
 
+-- name: QueryAssetTransfersPage :many
+SELECT
+    id, height_hint, txns.txid, transfer_time_unix, anchor_psbt,
+    anchor_change_output_index, num_confs, broadcast_attempted
+FROM asset_transfers transfers
+JOIN chain_txns txns
+    ON transfers.anchor_txn_id = txns.txn_id
+-- All filters are optional and only applied if the argument is specified.
+WHERE (sqlc.narg('confirmed') IS NULL OR
+    (CASE WHEN txns.block_hash IS NULL THEN false ELSE true END) =
+        sqlc.narg('confirmed'))
+AND (txns.txid = sqlc.narg('anchor_tx_hash') OR
+    sqlc.narg('anchor_tx_hash') IS NULL)
+AND (transfers.transfer_time_unix >= sqlc.narg('start_time') OR
+    sqlc.narg('start_time') IS NULL)
+AND (transfers.transfer_time_unix < sqlc.narg('end_time') OR
+    sqlc.narg('end_time') IS NULL)
+
+-- A transfer matches an asset ID or group key if any of its inputs spends an
+-- asset with that ID or group key.
+AND (sqlc.narg('asset_id') IS NULL OR EXISTS (
+    SELECT 1
+    FROM asset_transfer_inputs inputs
+    WHERE inputs.transfer_id = transfers.id
+        AND inputs.asset_id = sqlc.narg('asset_id')
+))
+AND (sqlc.narg('group_key') IS NULL OR EXISTS (
+    SELECT 1
+    FROM asset_transfer_inputs inputs
+    JOIN genesis_assets gen
+        ON inputs.asset_id = gen.asset_id
+    JOIN key_group_info_view groups
+        ON gen.gen_asset_id = groups.gen_asset_id
+    WHERE inputs.transfer_id = transfers.id
+        AND groups.tweaked_group_key = sqlc.narg('group_key')
+))
+
+-- A transfer matches a script key if any of its outputs pays to it.
+AND (sqlc.narg('script_key') IS NULL OR EXISTS (
+    SELECT 1
+    FROM asset_transfer_outputs outputs
+    JOIN script_keys
+        ON outputs.script_key = script_keys.script_key_id
+    WHERE outputs.transfer_id = transfers.id
+        AND script_keys.tweaked_script_key = sqlc.narg('script_key')
+))
+
+-- The cursor is the ID of the last transfer of the previous page.
+AND (sqlc.narg('cursor') IS NULL OR
+    (sqlc.narg('sort_direction') = 0 AND transfers.id > sqlc.narg('cursor')) OR
+    (sqlc.narg('sort_direction') = 1 AND transfers.id < sqlc.narg('cursor')))
+ORDER BY
+    CASE WHEN sqlc.narg('sort_direction') = 0 THEN transfers.id END ASC,
+    CASE WHEN sqlc.narg('sort_direction') = 1 THEN transfers.id END DESC
+LIMIT @num_limit;
+
 -- name: FetchTransferInputs :many
 SELECT input_id, anchor_point, asset_id, script_key, amount
 FROM asset_transfer_inputs inputs
@@ -170,3 +229,57 @@ FROM passive_assets as passive
     JOIN managed_utxos utxos
         ON passive.new_anchor_utxo = utxos.utxo_id
 WHERE passive.transfer_id = @transfer_id;
+
+-- name: ReplaceTransferAnchorTx :exec
+UPDATE chain_txns
+SET txid = @new_txid, raw_tx = @raw_tx, chain_fees = @chain_fees
+WHERE txid = @old_txid AND block_hash IS NULL;
+
+-- name: UpdateTransferAnchorPsbt :exec
+UPDATE asset_transfers
+SET anchor_psbt = $2, anchor_change_output_index = $3
+WHERE id = $1;
+
+-- name: UpdateManagedUTXOOutpoint :exec
+UPDATE managed_utxos
+SET outpoint = @new_outpoint
+WHERE outpoint = @old_outpoint;
+
+-- name: UpdateTransferOutputProofSuffix :exec
+UPDATE asset_transfer_outputs
+SET proof_suffix = $2
+WHERE output_id = $1;
+
+-- name: UpdatePassiveAssetProof :exec
+UPDATE passive_assets
+SET new_proof = @new_proof
+WHERE transfer_id = @transfer_id AND asset_id = @asset_id;
+
+-- name: MarkTransferBroadcastAttempted :exec
+UPDATE asset_transfers
+SET broadcast_attempted = TRUE
+WHERE anchor_txn_id = (
+    SELECT txn_id
+    FROM chain_txns
+    WHERE txid = @anchor_txid
+);
+
+-- name: DeleteAssetTransferInputs :exec
+DELETE FROM asset_transfer_inputs
+WHERE transfer_id = $1;
+
+-- name: DeleteAssetTransferOutputs :exec
+DELETE FROM asset_transfer_outputs
+WHERE transfer_id = $1;
+
+-- name: DeletePassiveAssets :exec
+DELETE FROM passive_assets
+WHERE transfer_id = $1;
+
+-- name: DeleteAssetTransfer :exec
+DELETE FROM asset_transfers
+WHERE id = $1;
+
+-- name: DeleteUnconfirmedChainTx :exec
+DELETE FROM chain_txns
+WHERE txid = $1 AND block_hash IS NULL;
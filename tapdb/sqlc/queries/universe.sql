@@ -32,6 +32,15 @@ WITH root_id AS (
 DELETE FROM universe_events
 WHERE universe_root_id = (SELECT id from root_id);
 
+-- name: DeleteUniverseEventsDaily :exec
+WITH root_id AS (
+    SELECT id
+    FROM universe_roots
+    WHERE namespace_root = @namespace_root
+)
+DELETE FROM universe_events_daily
+WHERE universe_root_id = (SELECT id from root_id);
+
 -- name: DeleteUniverseRoot :exec
 DELETE FROM universe_roots
 WHERE namespace_root = @namespace_root;
@@ -39,23 +48,61 @@ WHERE namespace_root = @namespace_root;
 -- name: UpsertUniverseLeaf :exec
 INSERT INTO universe_leaves (
     asset_genesis_id, script_key_bytes, universe_root_id, leaf_node_key, 
-    leaf_node_namespace, minting_point
+    leaf_node_namespace, minting_point, block_height, extensions,
+    insertion_timestamp, amount, is_burn
 ) VALUES (
     @asset_genesis_id, @script_key_bytes, @universe_root_id, @leaf_node_key,
-    @leaf_node_namespace, @minting_point
+    @leaf_node_namespace, @minting_point, @block_height, @extensions,
+    @insertion_timestamp, @amount, @is_burn
 ) ON CONFLICT (minting_point, script_key_bytes)
-    -- This is a NOP, minting_point and script_key_bytes are the unique fields
-    -- that caused the conflict.
+    -- minting_point and script_key_bytes are the unique fields that caused
+    -- the conflict. We only update the block height, which fills it in for
+    -- leaves that were inserted before it was tracked, and the extensions.
+    -- Existing extensions are kept if the new leaf doesn't carry any. The
+    -- insertion timestamp is kept, so a leaf is only counted once.
     DO UPDATE SET minting_point = EXCLUDED.minting_point,
-                  script_key_bytes = EXCLUDED.script_key_bytes;
+                  script_key_bytes = EXCLUDED.script_key_bytes,
+                  block_height = EXCLUDED.block_height,
+                  extensions = COALESCE(
+                      EXCLUDED.extensions, universe_leaves.extensions
+                  ),
+                  amount = EXCLUDED.amount,
+                  is_burn = EXCLUDED.is_burn;
 
 -- name: DeleteUniverseLeaves :exec
 DELETE FROM universe_leaves
 WHERE leaf_node_namespace = @namespace;
 
+-- name: DeleteUniverseLeaf :exec
+DELETE FROM universe_leaves
+WHERE leaf_node_namespace = @namespace AND leaf_node_key = @leaf_node_key;
+
+-- name: DeleteLeafProofSyncLog :exec
+DELETE FROM federation_proof_sync_log
+WHERE proof_leaf_id IN (
+    SELECT id
+    FROM universe_leaves
+    WHERE leaf_node_namespace = @namespace AND leaf_node_key = @leaf_node_key
+);
+
+-- name: InsertQuarantinedLeaf :exec
+INSERT INTO universe_quarantined_leaves (
+    namespace_root, leaf_node_key, minting_point, script_key_bytes, raw_proof,
+    leaf_sum, reason, quarantined_at
+) VALUES (
+    @namespace_root, @leaf_node_key, @minting_point, @script_key_bytes,
+    @raw_proof, @leaf_sum, @reason, @quarantined_at
+);
+
+-- name: QueryQuarantinedLeaves :many
+SELECT *
+FROM universe_quarantined_leaves
+WHERE namespace_root = @namespace_root
+ORDER BY id;
+
 -- name: QueryUniverseLeaves :many
 SELECT leaves.script_key_bytes, gen.gen_asset_id, nodes.value genesis_proof, 
-       nodes.sum sum_amt, gen.asset_id
+       nodes.sum sum_amt, gen.asset_id, leaves.extensions
 FROM universe_leaves leaves
 JOIN mssmt_nodes nodes
     ON leaves.leaf_node_key = nodes.key AND
@@ -74,11 +121,34 @@ WHERE leaves.leaf_node_namespace = @namespace
 SELECT leaves.minting_point, leaves.script_key_bytes
 FROM universe_leaves leaves
 WHERE leaves.leaf_node_namespace = @namespace
+    -- Leaves with an unknown block height match any block height range, so a
+    -- ranged query never misses a leaf.
+    AND (leaves.block_height IS NULL OR
+         leaves.block_height >= sqlc.narg('min_block_height') OR
+         sqlc.narg('min_block_height') IS NULL)
+    AND (leaves.block_height IS NULL OR
+         leaves.block_height <= sqlc.narg('max_block_height') OR
+         sqlc.narg('max_block_height') IS NULL)
 ORDER BY 
     CASE WHEN sqlc.narg('sort_direction') = 0 THEN leaves.id END ASC,
     CASE WHEN sqlc.narg('sort_direction') = 1 THEN leaves.id END DESC
 LIMIT @num_limit OFFSET @num_offset;
 
+-- name: FetchUniverseAssetMeta :one
+SELECT assets_meta.meta_data_hash, assets_meta.meta_data_blob,
+    assets_meta.meta_data_type
+FROM assets_meta
+WHERE assets_meta.meta_data_hash = $1
+    -- Only the meta of assets that have a universe leaf is returned, so
+    -- metadata that is only known to the local wallet isn't exposed.
+    AND EXISTS (
+        SELECT 1
+        FROM universe_leaves leaves
+        JOIN genesis_assets gen
+            ON leaves.asset_genesis_id = gen.gen_asset_id
+        WHERE gen.meta_data_id = assets_meta.meta_id
+    );
+
 -- name: UniverseLeaves :many
 SELECT * FROM universe_leaves;
 
@@ -101,9 +171,9 @@ LIMIT @num_limit OFFSET @num_offset;
 
 -- name: InsertUniverseServer :exec
 INSERT INTO universe_servers(
-    server_host, last_sync_time
+    server_host, last_sync_time, identity_key
 ) VALUES (
-    @server_host, @last_sync_time
+    @server_host, @last_sync_time, @identity_key
 );
 
 -- name: DeleteUniverseServer :exec
@@ -179,6 +249,32 @@ INSERT INTO universe_events (
     @event_time, @event_timestamp
 );
 
+-- name: DownsampleUniverseEvents :exec
+INSERT INTO universe_events_daily (
+    universe_root_id, day_timestamp, sync_events, new_proof_events
+)
+SELECT
+    universe_root_id,
+    event_timestamp - (event_timestamp % 86400) AS day_timestamp,
+    SUM(CASE WHEN event_type = 'SYNC' THEN 1 ELSE 0 END) AS sync_events,
+    SUM(CASE WHEN event_type = 'NEW_PROOF' THEN 1 ELSE 0 END) AS new_proof_events
+FROM universe_events
+WHERE event_type IN ('SYNC', 'NEW_PROOF') AND
+      event_timestamp < @cutoff_timestamp
+GROUP BY universe_root_id, day_timestamp
+ON CONFLICT (universe_root_id, day_timestamp)
+    -- Events of a day may be downsampled in several rounds, so we add them
+    -- to the existing aggregate.
+    DO UPDATE SET
+        sync_events = universe_events_daily.sync_events +
+            EXCLUDED.sync_events,
+        new_proof_events = universe_events_daily.new_proof_events +
+            EXCLUDED.new_proof_events;
+
+-- name: DeleteUniverseEventsBefore :execrows
+DELETE FROM universe_events
+WHERE event_timestamp < @cutoff_timestamp;
+
 -- name: QueryUniverseStats :one
 WITH stats AS (
     SELECT total_asset_syncs, total_asset_proofs
@@ -313,24 +409,92 @@ ORDER BY
 LIMIT @num_limit OFFSET @num_offset;
 
 -- name: QueryAssetStatsPerDaySqlite :many
+WITH events AS (
+    SELECT
+        event_timestamp,
+        CASE WHEN event_type = 'SYNC' THEN 1 ELSE 0 END AS sync_events,
+        CASE WHEN event_type = 'NEW_PROOF' THEN 1 ELSE 0 END AS new_proof_events
+    FROM universe_events
+    WHERE event_type IN ('SYNC', 'NEW_PROOF') AND
+          event_timestamp >= @start_time AND event_timestamp <= @end_time
+    UNION ALL
+    -- The downsampled events of a day are included if any part of the day
+    -- falls into the queried time range.
+    SELECT day_timestamp, sync_events, new_proof_events
+    FROM universe_events_daily
+    WHERE day_timestamp + 86400 > @start_time AND
+          day_timestamp <= @end_time
+)
 SELECT
     cast(strftime('%Y-%m-%d', datetime(event_timestamp, 'unixepoch')) as text) AS day,
-    SUM(CASE WHEN event_type = 'SYNC' THEN 1 ELSE 0 END) AS sync_events,
-    SUM(CASE WHEN event_type = 'NEW_PROOF' THEN 1 ELSE 0 END) AS new_proof_events
-FROM universe_events
-WHERE event_type IN ('SYNC', 'NEW_PROOF') AND
-      event_timestamp >= @start_time AND event_timestamp <= @end_time
+    CAST(SUM(sync_events) AS BIGINT) AS sync_events,
+    CAST(SUM(new_proof_events) AS BIGINT) AS new_proof_events
+FROM events
 GROUP BY day
 ORDER BY day;
 
 -- name: QueryAssetStatsPerDayPostgres :many
+WITH events AS (
+    SELECT
+        event_timestamp,
+        CASE WHEN event_type = 'SYNC' THEN 1 ELSE 0 END AS sync_events,
+        CASE WHEN event_type = 'NEW_PROOF' THEN 1 ELSE 0 END AS new_proof_events
+    FROM universe_events
+    WHERE event_type IN ('SYNC', 'NEW_PROOF') AND
+          event_timestamp >= @start_time AND event_timestamp <= @end_time
+    UNION ALL
+    -- The downsampled events of a day are included if any part of the day
+    -- falls into the queried time range.
+    SELECT day_timestamp, sync_events, new_proof_events
+    FROM universe_events_daily
+    WHERE day_timestamp + 86400 > @start_time AND
+          day_timestamp <= @end_time
+)
 SELECT
     to_char(to_timestamp(event_timestamp), 'YYYY-MM-DD') AS day,
-    SUM(CASE WHEN event_type = 'SYNC' THEN 1 ELSE 0 END) AS sync_events,
-    SUM(CASE WHEN event_type = 'NEW_PROOF' THEN 1 ELSE 0 END) AS new_proof_events
-FROM universe_events
-WHERE event_type IN ('SYNC', 'NEW_PROOF') AND
-      event_timestamp >= @start_time AND event_timestamp <= @end_time
+    CAST(SUM(sync_events) AS BIGINT) AS sync_events,
+    CAST(SUM(new_proof_events) AS BIGINT) AS new_proof_events
+FROM events
+GROUP BY day
+ORDER BY day;
+
+-- name: QueryAssetSupplyPerDaySqlite :many
+SELECT
+    cast(strftime('%Y-%m-%d', datetime(leaves.insertion_timestamp, 'unixepoch')) as text) AS day,
+    CAST(SUM(CASE WHEN roots.proof_type = 'issuance' THEN leaves.amount
+             ELSE 0 END) AS BIGINT) AS minted_amount,
+    CAST(SUM(CASE WHEN roots.proof_type = 'transfer' AND leaves.is_burn
+             THEN leaves.amount ELSE 0 END) AS BIGINT) AS burned_amount,
+    CAST(SUM(CASE WHEN roots.proof_type = 'transfer' AND NOT leaves.is_burn
+             THEN 1 ELSE 0 END) AS BIGINT) AS num_transfers
+FROM universe_leaves leaves
+JOIN universe_roots roots
+    ON leaves.universe_root_id = roots.id
+JOIN genesis_info_view gen
+    ON leaves.asset_genesis_id = gen.gen_asset_id
+WHERE gen.asset_id = @asset_id AND
+      leaves.insertion_timestamp >= @start_time AND
+      leaves.insertion_timestamp <= @end_time
+GROUP BY day
+ORDER BY day;
+
+-- name: QueryAssetSupplyPerDayPostgres :many
+SELECT
+    to_char(to_timestamp(leaves.insertion_timestamp), 'YYYY-MM-DD') AS day,
+    CAST(SUM(CASE WHEN roots.proof_type = 'issuance' THEN leaves.amount
+             ELSE 0 END) AS BIGINT) AS minted_amount,
+    CAST(SUM(CASE WHEN roots.proof_type = 'transfer' AND leaves.is_burn
+             THEN leaves.amount ELSE 0 END) AS BIGINT) AS burned_amount,
+    CAST(SUM(CASE WHEN roots.proof_type = 'transfer' AND NOT leaves.is_burn
+             THEN 1 ELSE 0 END) AS BIGINT) AS num_transfers
+FROM universe_leaves leaves
+JOIN universe_roots roots
+    ON leaves.universe_root_id = roots.id
+JOIN genesis_info_view gen
+    ON leaves.asset_genesis_id = gen.gen_asset_id
+WHERE gen.asset_id = @asset_id AND
+      leaves.insertion_timestamp >= @start_time AND
+      leaves.insertion_timestamp <= @end_time
 GROUP BY day
 ORDER BY day;
 
@@ -0,0 +1,24 @@
+-- name: UpsertUniverseSnapshot :exec
+INSERT INTO universe_snapshots (
+    namespace_root, asset_id, group_key, proof_type, height, root_hash,
+    root_sum, imported_at
+) VALUES (
+    @namespace_root, @asset_id, @group_key, @proof_type, @height, @root_hash,
+    @root_sum, @imported_at
+) ON CONFLICT (namespace_root, height)
+    DO UPDATE SET root_hash = EXCLUDED.root_hash,
+        root_sum = EXCLUDED.root_sum,
+        imported_at = EXCLUDED.imported_at,
+        verified_at = NULL,
+        num_invalid_leaves = 0;
+
+-- name: QueryPendingUniverseSnapshots :many
+SELECT *
+FROM universe_snapshots
+WHERE verified_at IS NULL
+ORDER BY id;
+
+-- name: MarkUniverseSnapshotVerified :exec
+UPDATE universe_snapshots
+SET verified_at = @verified_at, num_invalid_leaves = @num_invalid_leaves
+WHERE namespace_root = @namespace_root AND height = @height;
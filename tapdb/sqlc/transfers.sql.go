@@ -67,6 +67,36 @@ func (q *Queries) ApplyPendingOutput(ctx context.Context, arg ApplyPendingOutput
 	return asset_id, err
 }
 
+const deleteAssetTransfer = `-- name: DeleteAssetTransfer :exec
+DELETE FROM asset_transfers
+WHERE id = $1
+`
+
+func (q *Queries) DeleteAssetTransfer(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteAssetTransfer, id)
+	return err
+}
+
+const deleteAssetTransferInputs = `-- name: DeleteAssetTransferInputs :exec
+DELETE FROM asset_transfer_inputs
+WHERE transfer_id = $1
+`
+
+func (q *Queries) DeleteAssetTransferInputs(ctx context.Context, transferID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteAssetTransferInputs, transferID)
+	return err
+}
+
+const deleteAssetTransferOutputs = `-- name: DeleteAssetTransferOutputs :exec
+DELETE FROM asset_transfer_outputs
+WHERE transfer_id = $1
+`
+
+func (q *Queries) DeleteAssetTransferOutputs(ctx context.Context, transferID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteAssetTransferOutputs, transferID)
+	return err
+}
+
 const deleteAssetWitnesses = `-- name: DeleteAssetWitnesses :exec
 DELETE FROM asset_witnesses
 WHERE asset_id = $1
@@ -77,6 +107,26 @@ func (q *Queries) DeleteAssetWitnesses(ctx context.Context, assetID int64) error
 	return err
 }
 
+const deletePassiveAssets = `-- name: DeletePassiveAssets :exec
+DELETE FROM passive_assets
+WHERE transfer_id = $1
+`
+
+func (q *Queries) DeletePassiveAssets(ctx context.Context, transferID int64) error {
+	_, err := q.db.ExecContext(ctx, deletePassiveAssets, transferID)
+	return err
+}
+
+const deleteUnconfirmedChainTx = `-- name: DeleteUnconfirmedChainTx :exec
+DELETE FROM chain_txns
+WHERE txid = $1 AND block_hash IS NULL
+`
+
+func (q *Queries) DeleteUnconfirmedChainTx(ctx context.Context, txid []byte) error {
+	_, err := q.db.ExecContext(ctx, deleteUnconfirmedChainTx, txid)
+	return err
+}
+
 const fetchTransferInputs = `-- name: FetchTransferInputs :many
 SELECT input_id, anchor_point, asset_id, script_key, amount
 FROM asset_transfer_inputs inputs
@@ -248,23 +298,35 @@ const insertAssetTransfer = `-- name: InsertAssetTransfer :one
 WITH target_txn(txn_id) AS (
     SELECT txn_id
     FROM chain_txns
-    WHERE txid = $3
+    WHERE txid = $6
 )
 INSERT INTO asset_transfers (
-    height_hint, anchor_txn_id, transfer_time_unix
+    height_hint, anchor_txn_id, transfer_time_unix, anchor_psbt,
+    anchor_change_output_index, num_confs
 ) VALUES (
-    $1, (SELECT txn_id FROM target_txn), $2
+    $1, (SELECT txn_id FROM target_txn), $2,
+    $3, $4, $5
 ) RETURNING id
 `
 
 type InsertAssetTransferParams struct {
-	HeightHint       int32
-	TransferTimeUnix time.Time
-	AnchorTxid       []byte
+	HeightHint              int32
+	TransferTimeUnix        time.Time
+	AnchorPsbt              []byte
+	AnchorChangeOutputIndex sql.NullInt32
+	NumConfs                int32
+	AnchorTxid              []byte
 }
 
 func (q *Queries) InsertAssetTransfer(ctx context.Context, arg InsertAssetTransferParams) (int64, error) {
-	row := q.db.QueryRowContext(ctx, insertAssetTransfer, arg.HeightHint, arg.TransferTimeUnix, arg.AnchorTxid)
+	row := q.db.QueryRowContext(ctx, insertAssetTransfer,
+		arg.HeightHint,
+		arg.TransferTimeUnix,
+		arg.AnchorPsbt,
+		arg.AnchorChangeOutputIndex,
+		arg.NumConfs,
+		arg.AnchorTxid,
+	)
 	var id int64
 	err := row.Scan(&id)
 	return id, err
@@ -415,9 +477,25 @@ func (q *Queries) LogProofTransferAttempt(ctx context.Context, arg LogProofTrans
 	return err
 }
 
+const markTransferBroadcastAttempted = `-- name: MarkTransferBroadcastAttempted :exec
+UPDATE asset_transfers
+SET broadcast_attempted = TRUE
+WHERE anchor_txn_id = (
+    SELECT txn_id
+    FROM chain_txns
+    WHERE txid = $1
+)
+`
+
+func (q *Queries) MarkTransferBroadcastAttempted(ctx context.Context, anchorTxid []byte) error {
+	_, err := q.db.ExecContext(ctx, markTransferBroadcastAttempted, anchorTxid)
+	return err
+}
+
 const queryAssetTransfers = `-- name: QueryAssetTransfers :many
 SELECT
-    id, height_hint, txns.txid, transfer_time_unix
+    id, height_hint, txns.txid, transfer_time_unix, anchor_psbt,
+    anchor_change_output_index, num_confs, broadcast_attempted
 FROM asset_transfers transfers
 JOIN chain_txns txns
     ON transfers.anchor_txn_id = txns.txn_id
@@ -435,10 +513,14 @@ type QueryAssetTransfersParams struct {
 }
 
 type QueryAssetTransfersRow struct {
-	ID               int64
-	HeightHint       int32
-	Txid             []byte
-	TransferTimeUnix time.Time
+	ID                      int64
+	HeightHint              int32
+	Txid                    []byte
+	TransferTimeUnix        time.Time
+	AnchorPsbt              []byte
+	AnchorChangeOutputIndex sql.NullInt32
+	NumConfs                int32
+	BroadcastAttempted      bool
 }
 
 // We'll use this clause to filter out for only transfers that are
@@ -459,6 +541,134 @@ func (q *Queries) QueryAssetTransfers(ctx context.Context, arg QueryAssetTransfe
 			&i.HeightHint,
 			&i.Txid,
 			&i.TransferTimeUnix,
+			&i.AnchorPsbt,
+			&i.AnchorChangeOutputIndex,
+			&i.NumConfs,
+			&i.BroadcastAttempted,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const queryAssetTransfersPage = `-- name: QueryAssetTransfersPage :many
+SELECT
+    id, height_hint, txns.txid, transfer_time_unix, anchor_psbt,
+    anchor_change_output_index, num_confs, broadcast_attempted
+FROM asset_transfers transfers
+JOIN chain_txns txns
+    ON transfers.anchor_txn_id = txns.txn_id
+WHERE ($1 IS NULL OR
+    (CASE WHEN txns.block_hash IS NULL THEN false ELSE true END) =
+        $1)
+AND (txns.txid = $2 OR
+    $2 IS NULL)
+AND (transfers.transfer_time_unix >= $3 OR
+    $3 IS NULL)
+AND (transfers.transfer_time_unix < $4 OR
+    $4 IS NULL)
+
+AND ($5 IS NULL OR EXISTS (
+    SELECT 1
+    FROM asset_transfer_inputs inputs
+    WHERE inputs.transfer_id = transfers.id
+        AND inputs.asset_id = $5
+))
+AND ($6 IS NULL OR EXISTS (
+    SELECT 1
+    FROM asset_transfer_inputs inputs
+    JOIN genesis_assets gen
+        ON inputs.asset_id = gen.asset_id
+    JOIN key_group_info_view groups
+        ON gen.gen_asset_id = groups.gen_asset_id
+    WHERE inputs.transfer_id = transfers.id
+        AND groups.tweaked_group_key = $6
+))
+
+AND ($7 IS NULL OR EXISTS (
+    SELECT 1
+    FROM asset_transfer_outputs outputs
+    JOIN script_keys
+        ON outputs.script_key = script_keys.script_key_id
+    WHERE outputs.transfer_id = transfers.id
+        AND script_keys.tweaked_script_key = $7
+))
+
+AND ($8 IS NULL OR
+    ($9 = 0 AND transfers.id > $8) OR
+    ($9 = 1 AND transfers.id < $8))
+ORDER BY
+    CASE WHEN $9 = 0 THEN transfers.id END ASC,
+    CASE WHEN $9 = 1 THEN transfers.id END DESC
+LIMIT $10
+`
+
+type QueryAssetTransfersPageParams struct {
+	Confirmed     interface{}
+	AnchorTxHash  []byte
+	StartTime     sql.NullTime
+	EndTime       sql.NullTime
+	AssetID       []byte
+	GroupKey      []byte
+	ScriptKey     []byte
+	Cursor        sql.NullInt64
+	SortDirection interface{}
+	NumLimit      int32
+}
+
+type QueryAssetTransfersPageRow struct {
+	ID                      int64
+	HeightHint              int32
+	Txid                    []byte
+	TransferTimeUnix        time.Time
+	AnchorPsbt              []byte
+	AnchorChangeOutputIndex sql.NullInt32
+	NumConfs                int32
+	BroadcastAttempted      bool
+}
+
+// All filters are optional and only applied if the argument is specified.
+// A transfer matches an asset ID or group key if any of its inputs spends an
+// asset with that ID or group key.
+// A transfer matches a script key if any of its outputs pays to it.
+// The cursor is the ID of the last transfer of the previous page.
+func (q *Queries) QueryAssetTransfersPage(ctx context.Context, arg QueryAssetTransfersPageParams) ([]QueryAssetTransfersPageRow, error) {
+	rows, err := q.db.QueryContext(ctx, queryAssetTransfersPage,
+		arg.Confirmed,
+		arg.AnchorTxHash,
+		arg.StartTime,
+		arg.EndTime,
+		arg.AssetID,
+		arg.GroupKey,
+		arg.ScriptKey,
+		arg.Cursor,
+		arg.SortDirection,
+		arg.NumLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []QueryAssetTransfersPageRow
+	for rows.Next() {
+		var i QueryAssetTransfersPageRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.HeightHint,
+			&i.Txid,
+			&i.TransferTimeUnix,
+			&i.AnchorPsbt,
+			&i.AnchorChangeOutputIndex,
+			&i.NumConfs,
+			&i.BroadcastAttempted,
 		); err != nil {
 			return nil, err
 		}
@@ -584,3 +794,92 @@ func (q *Queries) ReAnchorPassiveAssets(ctx context.Context, arg ReAnchorPassive
 	_, err := q.db.ExecContext(ctx, reAnchorPassiveAssets, arg.NewAnchorUtxoID, arg.AssetID)
 	return err
 }
+
+const replaceTransferAnchorTx = `-- name: ReplaceTransferAnchorTx :exec
+UPDATE chain_txns
+SET txid = $1, raw_tx = $2, chain_fees = $3
+WHERE txid = $4 AND block_hash IS NULL
+`
+
+type ReplaceTransferAnchorTxParams struct {
+	NewTxid   []byte
+	RawTx     []byte
+	ChainFees int64
+	OldTxid   []byte
+}
+
+func (q *Queries) ReplaceTransferAnchorTx(ctx context.Context, arg ReplaceTransferAnchorTxParams) error {
+	_, err := q.db.ExecContext(ctx, replaceTransferAnchorTx,
+		arg.NewTxid,
+		arg.RawTx,
+		arg.ChainFees,
+		arg.OldTxid,
+	)
+	return err
+}
+
+const updateManagedUTXOOutpoint = `-- name: UpdateManagedUTXOOutpoint :exec
+UPDATE managed_utxos
+SET outpoint = $1
+WHERE outpoint = $2
+`
+
+type UpdateManagedUTXOOutpointParams struct {
+	NewOutpoint []byte
+	OldOutpoint []byte
+}
+
+func (q *Queries) UpdateManagedUTXOOutpoint(ctx context.Context, arg UpdateManagedUTXOOutpointParams) error {
+	_, err := q.db.ExecContext(ctx, updateManagedUTXOOutpoint, arg.NewOutpoint, arg.OldOutpoint)
+	return err
+}
+
+const updatePassiveAssetProof = `-- name: UpdatePassiveAssetProof :exec
+UPDATE passive_assets
+SET new_proof = $1
+WHERE transfer_id = $2 AND asset_id = $3
+`
+
+type UpdatePassiveAssetProofParams struct {
+	NewProof   []byte
+	TransferID int64
+	AssetID    int64
+}
+
+func (q *Queries) UpdatePassiveAssetProof(ctx context.Context, arg UpdatePassiveAssetProofParams) error {
+	_, err := q.db.ExecContext(ctx, updatePassiveAssetProof, arg.NewProof, arg.TransferID, arg.AssetID)
+	return err
+}
+
+const updateTransferAnchorPsbt = `-- name: UpdateTransferAnchorPsbt :exec
+UPDATE asset_transfers
+SET anchor_psbt = $2, anchor_change_output_index = $3
+WHERE id = $1
+`
+
+type UpdateTransferAnchorPsbtParams struct {
+	ID                      int64
+	AnchorPsbt              []byte
+	AnchorChangeOutputIndex sql.NullInt32
+}
+
+func (q *Queries) UpdateTransferAnchorPsbt(ctx context.Context, arg UpdateTransferAnchorPsbtParams) error {
+	_, err := q.db.ExecContext(ctx, updateTransferAnchorPsbt, arg.ID, arg.AnchorPsbt, arg.AnchorChangeOutputIndex)
+	return err
+}
+
+const updateTransferOutputProofSuffix = `-- name: UpdateTransferOutputProofSuffix :exec
+UPDATE asset_transfer_outputs
+SET proof_suffix = $2
+WHERE output_id = $1
+`
+
+type UpdateTransferOutputProofSuffixParams struct {
+	OutputID    int64
+	ProofSuffix []byte
+}
+
+func (q *Queries) UpdateTransferOutputProofSuffix(ctx context.Context, arg UpdateTransferOutputProofSuffixParams) error {
+	_, err := q.db.ExecContext(ctx, updateTransferOutputProofSuffix, arg.OutputID, arg.ProofSuffix)
+	return err
+}
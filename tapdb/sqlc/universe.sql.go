@@ -49,6 +49,25 @@ func (q *Queries) DeleteFederationProofSyncLog(ctx context.Context, arg DeleteFe
 	return err
 }
 
+const deleteLeafProofSyncLog = `-- name: DeleteLeafProofSyncLog :exec
+DELETE FROM federation_proof_sync_log
+WHERE proof_leaf_id IN (
+    SELECT id
+    FROM universe_leaves
+    WHERE leaf_node_namespace = $1 AND leaf_node_key = $2
+)
+`
+
+type DeleteLeafProofSyncLogParams struct {
+	Namespace   string
+	LeafNodeKey []byte
+}
+
+func (q *Queries) DeleteLeafProofSyncLog(ctx context.Context, arg DeleteLeafProofSyncLogParams) error {
+	_, err := q.db.ExecContext(ctx, deleteLeafProofSyncLog, arg.Namespace, arg.LeafNodeKey)
+	return err
+}
+
 const deleteMultiverseLeaf = `-- name: DeleteMultiverseLeaf :exec
 DELETE FROM multiverse_leaves
 WHERE leaf_node_namespace = $1 AND leaf_node_key = $2
@@ -79,6 +98,49 @@ func (q *Queries) DeleteUniverseEvents(ctx context.Context, namespaceRoot string
 	return err
 }
 
+const deleteUniverseEventsBefore = `-- name: DeleteUniverseEventsBefore :execrows
+DELETE FROM universe_events
+WHERE event_timestamp < $1
+`
+
+func (q *Queries) DeleteUniverseEventsBefore(ctx context.Context, cutoffTimestamp int64) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteUniverseEventsBefore, cutoffTimestamp)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteUniverseEventsDaily = `-- name: DeleteUniverseEventsDaily :exec
+WITH root_id AS (
+    SELECT id
+    FROM universe_roots
+    WHERE namespace_root = $1
+)
+DELETE FROM universe_events_daily
+WHERE universe_root_id = (SELECT id from root_id)
+`
+
+func (q *Queries) DeleteUniverseEventsDaily(ctx context.Context, namespaceRoot string) error {
+	_, err := q.db.ExecContext(ctx, deleteUniverseEventsDaily, namespaceRoot)
+	return err
+}
+
+const deleteUniverseLeaf = `-- name: DeleteUniverseLeaf :exec
+DELETE FROM universe_leaves
+WHERE leaf_node_namespace = $1 AND leaf_node_key = $2
+`
+
+type DeleteUniverseLeafParams struct {
+	Namespace   string
+	LeafNodeKey []byte
+}
+
+func (q *Queries) DeleteUniverseLeaf(ctx context.Context, arg DeleteUniverseLeafParams) error {
+	_, err := q.db.ExecContext(ctx, deleteUniverseLeaf, arg.Namespace, arg.LeafNodeKey)
+	return err
+}
+
 const deleteUniverseLeaves = `-- name: DeleteUniverseLeaves :exec
 DELETE FROM universe_leaves
 WHERE leaf_node_namespace = $1
@@ -114,6 +176,34 @@ func (q *Queries) DeleteUniverseServer(ctx context.Context, arg DeleteUniverseSe
 	return err
 }
 
+const downsampleUniverseEvents = `-- name: DownsampleUniverseEvents :exec
+INSERT INTO universe_events_daily (
+    universe_root_id, day_timestamp, sync_events, new_proof_events
+)
+SELECT
+    universe_root_id,
+    event_timestamp - (event_timestamp % 86400) AS day_timestamp,
+    SUM(CASE WHEN event_type = 'SYNC' THEN 1 ELSE 0 END) AS sync_events,
+    SUM(CASE WHEN event_type = 'NEW_PROOF' THEN 1 ELSE 0 END) AS new_proof_events
+FROM universe_events
+WHERE event_type IN ('SYNC', 'NEW_PROOF') AND
+      event_timestamp < $1
+GROUP BY universe_root_id, day_timestamp
+ON CONFLICT (universe_root_id, day_timestamp)
+    -- Events of a day may be downsampled in several rounds, so we add them
+    -- to the existing aggregate.
+    DO UPDATE SET
+        sync_events = universe_events_daily.sync_events +
+            EXCLUDED.sync_events,
+        new_proof_events = universe_events_daily.new_proof_events +
+            EXCLUDED.new_proof_events
+`
+
+func (q *Queries) DownsampleUniverseEvents(ctx context.Context, cutoffTimestamp int64) error {
+	_, err := q.db.ExecContext(ctx, downsampleUniverseEvents, cutoffTimestamp)
+	return err
+}
+
 const fetchMultiverseRoot = `-- name: FetchMultiverseRoot :one
 SELECT proof_type, n.hash_key as multiverse_root_hash, n.sum as multiverse_root_sum
 FROM multiverse_roots r
@@ -138,21 +228,60 @@ func (q *Queries) FetchMultiverseRoot(ctx context.Context, namespaceRoot string)
 	return i, err
 }
 
+const fetchUniverseAssetMeta = `-- name: FetchUniverseAssetMeta :one
+SELECT assets_meta.meta_data_hash, assets_meta.meta_data_blob,
+    assets_meta.meta_data_type
+FROM assets_meta
+WHERE assets_meta.meta_data_hash = $1
+    -- Only the meta of assets that have a universe leaf is returned, so
+    -- metadata that is only known to the local wallet isn't exposed.
+    AND EXISTS (
+        SELECT 1
+        FROM universe_leaves leaves
+        JOIN genesis_assets gen
+            ON leaves.asset_genesis_id = gen.gen_asset_id
+        WHERE gen.meta_data_id = assets_meta.meta_id
+    )
+`
+
+type FetchUniverseAssetMetaRow struct {
+	MetaDataHash []byte
+	MetaDataBlob []byte
+	MetaDataType sql.NullInt16
+}
+
+func (q *Queries) FetchUniverseAssetMeta(ctx context.Context, metaDataHash []byte) (FetchUniverseAssetMetaRow, error) {
+	row := q.db.QueryRowContext(ctx, fetchUniverseAssetMeta, metaDataHash)
+	var i FetchUniverseAssetMetaRow
+	err := row.Scan(&i.MetaDataHash, &i.MetaDataBlob, &i.MetaDataType)
+	return i, err
+}
+
 const fetchUniverseKeys = `-- name: FetchUniverseKeys :many
 SELECT leaves.minting_point, leaves.script_key_bytes
 FROM universe_leaves leaves
 WHERE leaves.leaf_node_namespace = $1
+    -- Leaves with an unknown block height match any block height range, so a
+    -- ranged query never misses a leaf.
+    AND (leaves.block_height IS NULL OR
+         leaves.block_height >= $2 OR
+         $2 IS NULL)
+    AND (leaves.block_height IS NULL OR
+         leaves.block_height <= $3 OR
+         $3 IS NULL)
 ORDER BY 
-    CASE WHEN $2 = 0 THEN leaves.id END ASC,
-    CASE WHEN $2 = 1 THEN leaves.id END DESC
-LIMIT $4 OFFSET $3
+    CASE WHEN $4 = 0 THEN leaves.id END ASC,
+    CASE WHEN $4 = 1 THEN leaves.id END DESC
+LIMIT $6 OFFSET $5
 `
 
 type FetchUniverseKeysParams struct {
-	Namespace     string
-	SortDirection interface{}
-	NumOffset     int32
-	NumLimit      int32
+	Namespace      string
+	MinBlockHeight sql.NullInt32
+	MaxBlockHeight sql.NullInt32
+	SortDirection  interface{}
+	NumOffset      int32
+	NumLimit       int32
 }
 
 type FetchUniverseKeysRow struct {
@@ -163,6 +292,8 @@ type FetchUniverseKeysRow struct {
 func (q *Queries) FetchUniverseKeys(ctx context.Context, arg FetchUniverseKeysParams) ([]FetchUniverseKeysRow, error) {
 	rows, err := q.db.QueryContext(ctx, fetchUniverseKeys,
 		arg.Namespace,
+		arg.MinBlockHeight,
+		arg.MaxBlockHeight,
 		arg.SortDirection,
 		arg.NumOffset,
 		arg.NumLimit,
@@ -324,21 +455,57 @@ func (q *Queries) InsertNewSyncEvent(ctx context.Context, arg InsertNewSyncEvent
 	return err
 }
 
+const insertQuarantinedLeaf = `-- name: InsertQuarantinedLeaf :exec
+INSERT INTO universe_quarantined_leaves (
+    namespace_root, leaf_node_key, minting_point, script_key_bytes, raw_proof,
+    leaf_sum, reason, quarantined_at
+) VALUES (
+    $1, $2, $3, $4,
+    $5, $6, $7, $8
+)
+`
+
+type InsertQuarantinedLeafParams struct {
+	NamespaceRoot  string
+	LeafNodeKey    []byte
+	MintingPoint   []byte
+	ScriptKeyBytes []byte
+	RawProof       []byte
+	LeafSum        sql.NullInt64
+	Reason         string
+	QuarantinedAt  time.Time
+}
+
+func (q *Queries) InsertQuarantinedLeaf(ctx context.Context, arg InsertQuarantinedLeafParams) error {
+	_, err := q.db.ExecContext(ctx, insertQuarantinedLeaf,
+		arg.NamespaceRoot,
+		arg.LeafNodeKey,
+		arg.MintingPoint,
+		arg.ScriptKeyBytes,
+		arg.RawProof,
+		arg.LeafSum,
+		arg.Reason,
+		arg.QuarantinedAt,
+	)
+	return err
+}
+
 const insertUniverseServer = `-- name: InsertUniverseServer :exec
 INSERT INTO universe_servers(
-    server_host, last_sync_time
+    server_host, last_sync_time, identity_key
 ) VALUES (
-    $1, $2
+    $1, $2, $3
 )
 `
 
 type InsertUniverseServerParams struct {
 	ServerHost   string
 	LastSyncTime time.Time
+	IdentityKey  []byte
 }
 
 func (q *Queries) InsertUniverseServer(ctx context.Context, arg InsertUniverseServerParams) error {
-	_, err := q.db.ExecContext(ctx, insertUniverseServer, arg.ServerHost, arg.LastSyncTime)
+	_, err := q.db.ExecContext(ctx, insertUniverseServer, arg.ServerHost, arg.LastSyncTime, arg.IdentityKey)
 	return err
 }
 
@@ -359,13 +526,27 @@ func (q *Queries) LogServerSync(ctx context.Context, arg LogServerSyncParams) er
 }
 
 const queryAssetStatsPerDayPostgres = `-- name: QueryAssetStatsPerDayPostgres :many
+WITH events AS (
+    SELECT
+        event_timestamp,
+        CASE WHEN event_type = 'SYNC' THEN 1 ELSE 0 END AS sync_events,
+        CASE WHEN event_type = 'NEW_PROOF' THEN 1 ELSE 0 END AS new_proof_events
+    FROM universe_events
+    WHERE event_type IN ('SYNC', 'NEW_PROOF') AND
+          event_timestamp >= $1 AND event_timestamp <= $2
+    UNION ALL
+    -- The downsampled events of a day are included if any part of the day
+    -- falls into the queried time range.
+    SELECT day_timestamp, sync_events, new_proof_events
+    FROM universe_events_daily
+    WHERE day_timestamp + 86400 > $1 AND
+          day_timestamp <= $2
+)
 SELECT
     to_char(to_timestamp(event_timestamp), 'YYYY-MM-DD') AS day,
-    SUM(CASE WHEN event_type = 'SYNC' THEN 1 ELSE 0 END) AS sync_events,
-    SUM(CASE WHEN event_type = 'NEW_PROOF' THEN 1 ELSE 0 END) AS new_proof_events
-FROM universe_events
-WHERE event_type IN ('SYNC', 'NEW_PROOF') AND
-      event_timestamp >= $1 AND event_timestamp <= $2
+    CAST(SUM(sync_events) AS BIGINT) AS sync_events,
+    CAST(SUM(new_proof_events) AS BIGINT) AS new_proof_events
+FROM events
 GROUP BY day
 ORDER BY day
 `
@@ -405,13 +586,27 @@ func (q *Queries) QueryAssetStatsPerDayPostgres(ctx context.Context, arg QueryAs
 }
 
 const queryAssetStatsPerDaySqlite = `-- name: QueryAssetStatsPerDaySqlite :many
+WITH events AS (
+    SELECT
+        event_timestamp,
+        CASE WHEN event_type = 'SYNC' THEN 1 ELSE 0 END AS sync_events,
+        CASE WHEN event_type = 'NEW_PROOF' THEN 1 ELSE 0 END AS new_proof_events
+    FROM universe_events
+    WHERE event_type IN ('SYNC', 'NEW_PROOF') AND
+          event_timestamp >= $1 AND event_timestamp <= $2
+    UNION ALL
+    -- The downsampled events of a day are included if any part of the day
+    -- falls into the queried time range.
+    SELECT day_timestamp, sync_events, new_proof_events
+    FROM universe_events_daily
+    WHERE day_timestamp + 86400 > $1 AND
+          day_timestamp <= $2
+)
 SELECT
     cast(strftime('%Y-%m-%d', datetime(event_timestamp, 'unixepoch')) as text) AS day,
-    SUM(CASE WHEN event_type = 'SYNC' THEN 1 ELSE 0 END) AS sync_events,
-    SUM(CASE WHEN event_type = 'NEW_PROOF' THEN 1 ELSE 0 END) AS new_proof_events
-FROM universe_events
-WHERE event_type IN ('SYNC', 'NEW_PROOF') AND
-      event_timestamp >= $1 AND event_timestamp <= $2
+    CAST(SUM(sync_events) AS BIGINT) AS sync_events,
+    CAST(SUM(new_proof_events) AS BIGINT) AS new_proof_events
+FROM events
 GROUP BY day
 ORDER BY day
 `
@@ -450,6 +645,130 @@ func (q *Queries) QueryAssetStatsPerDaySqlite(ctx context.Context, arg QueryAsse
 	return items, nil
 }
 
+const queryAssetSupplyPerDayPostgres = `-- name: QueryAssetSupplyPerDayPostgres :many
+SELECT
+    to_char(to_timestamp(leaves.insertion_timestamp), 'YYYY-MM-DD') AS day,
+    CAST(SUM(CASE WHEN roots.proof_type = 'issuance' THEN leaves.amount
+             ELSE 0 END) AS BIGINT) AS minted_amount,
+    CAST(SUM(CASE WHEN roots.proof_type = 'transfer' AND leaves.is_burn
+             THEN leaves.amount ELSE 0 END) AS BIGINT) AS burned_amount,
+    CAST(SUM(CASE WHEN roots.proof_type = 'transfer' AND NOT leaves.is_burn
+             THEN 1 ELSE 0 END) AS BIGINT) AS num_transfers
+FROM universe_leaves leaves
+JOIN universe_roots roots
+    ON leaves.universe_root_id = roots.id
+JOIN genesis_info_view gen
+    ON leaves.asset_genesis_id = gen.gen_asset_id
+WHERE gen.asset_id = $1 AND
+      leaves.insertion_timestamp >= $2 AND
+      leaves.insertion_timestamp <= $3
+GROUP BY day
+ORDER BY day
+`
+
+type QueryAssetSupplyPerDayPostgresParams struct {
+	AssetID   []byte
+	StartTime sql.NullInt64
+	EndTime   sql.NullInt64
+}
+
+type QueryAssetSupplyPerDayPostgresRow struct {
+	Day          string
+	MintedAmount int64
+	BurnedAmount int64
+	NumTransfers int64
+}
+
+func (q *Queries) QueryAssetSupplyPerDayPostgres(ctx context.Context, arg QueryAssetSupplyPerDayPostgresParams) ([]QueryAssetSupplyPerDayPostgresRow, error) {
+	rows, err := q.db.QueryContext(ctx, queryAssetSupplyPerDayPostgres, arg.AssetID, arg.StartTime, arg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []QueryAssetSupplyPerDayPostgresRow
+	for rows.Next() {
+		var i QueryAssetSupplyPerDayPostgresRow
+		if err := rows.Scan(
+			&i.Day,
+			&i.MintedAmount,
+			&i.BurnedAmount,
+			&i.NumTransfers,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const queryAssetSupplyPerDaySqlite = `-- name: QueryAssetSupplyPerDaySqlite :many
+SELECT
+    cast(strftime('%Y-%m-%d', datetime(leaves.insertion_timestamp, 'unixepoch')) as text) AS day,
+    CAST(SUM(CASE WHEN roots.proof_type = 'issuance' THEN leaves.amount
+             ELSE 0 END) AS BIGINT) AS minted_amount,
+    CAST(SUM(CASE WHEN roots.proof_type = 'transfer' AND leaves.is_burn
+             THEN leaves.amount ELSE 0 END) AS BIGINT) AS burned_amount,
+    CAST(SUM(CASE WHEN roots.proof_type = 'transfer' AND NOT leaves.is_burn
+             THEN 1 ELSE 0 END) AS BIGINT) AS num_transfers
+FROM universe_leaves leaves
+JOIN universe_roots roots
+    ON leaves.universe_root_id = roots.id
+JOIN genesis_info_view gen
+    ON leaves.asset_genesis_id = gen.gen_asset_id
+WHERE gen.asset_id = $1 AND
+      leaves.insertion_timestamp >= $2 AND
+      leaves.insertion_timestamp <= $3
+GROUP BY day
+ORDER BY day
+`
+
+type QueryAssetSupplyPerDaySqliteParams struct {
+	AssetID   []byte
+	StartTime sql.NullInt64
+	EndTime   sql.NullInt64
+}
+
+type QueryAssetSupplyPerDaySqliteRow struct {
+	Day          string
+	MintedAmount int64
+	BurnedAmount int64
+	NumTransfers int64
+}
+
+func (q *Queries) QueryAssetSupplyPerDaySqlite(ctx context.Context, arg QueryAssetSupplyPerDaySqliteParams) ([]QueryAssetSupplyPerDaySqliteRow, error) {
+	rows, err := q.db.QueryContext(ctx, queryAssetSupplyPerDaySqlite, arg.AssetID, arg.StartTime, arg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []QueryAssetSupplyPerDaySqliteRow
+	for rows.Next() {
+		var i QueryAssetSupplyPerDaySqliteRow
+		if err := rows.Scan(
+			&i.Day,
+			&i.MintedAmount,
+			&i.BurnedAmount,
+			&i.NumTransfers,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const queryFederationGlobalSyncConfigs = `-- name: QueryFederationGlobalSyncConfigs :many
 SELECT proof_type, allow_sync_insert, allow_sync_export
 FROM federation_global_sync_config
@@ -702,6 +1021,46 @@ func (q *Queries) QueryMultiverseLeaves(ctx context.Context, arg QueryMultiverse
 	return items, nil
 }
 
+const queryQuarantinedLeaves = `-- name: QueryQuarantinedLeaves :many
+SELECT id, namespace_root, leaf_node_key, minting_point, script_key_bytes, raw_proof, leaf_sum, reason, quarantined_at
+FROM universe_quarantined_leaves
+WHERE namespace_root = $1
+ORDER BY id
+`
+
+func (q *Queries) QueryQuarantinedLeaves(ctx context.Context, namespaceRoot string) ([]UniverseQuarantinedLeafe, error) {
+	rows, err := q.db.QueryContext(ctx, queryQuarantinedLeaves, namespaceRoot)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UniverseQuarantinedLeafe
+	for rows.Next() {
+		var i UniverseQuarantinedLeafe
+		if err := rows.Scan(
+			&i.ID,
+			&i.NamespaceRoot,
+			&i.LeafNodeKey,
+			&i.MintingPoint,
+			&i.ScriptKeyBytes,
+			&i.RawProof,
+			&i.LeafSum,
+			&i.Reason,
+			&i.QuarantinedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const queryUniverseAssetStats = `-- name: QueryUniverseAssetStats :many
 
 WITH asset_supply AS (
@@ -866,7 +1225,7 @@ func (q *Queries) QueryUniverseAssetStats(ctx context.Context, arg QueryUniverse
 
 const queryUniverseLeaves = `-- name: QueryUniverseLeaves :many
 SELECT leaves.script_key_bytes, gen.gen_asset_id, nodes.value genesis_proof, 
-       nodes.sum sum_amt, gen.asset_id
+       nodes.sum sum_amt, gen.asset_id, leaves.extensions
 FROM universe_leaves leaves
 JOIN mssmt_nodes nodes
     ON leaves.leaf_node_key = nodes.key AND
@@ -894,6 +1253,7 @@ type QueryUniverseLeavesRow struct {
 	GenesisProof   []byte
 	SumAmt         int64
 	AssetID        []byte
+	Extensions     []byte
 }
 
 func (q *Queries) QueryUniverseLeaves(ctx context.Context, arg QueryUniverseLeavesParams) ([]QueryUniverseLeavesRow, error) {
@@ -911,6 +1271,7 @@ func (q *Queries) QueryUniverseLeaves(ctx context.Context, arg QueryUniverseLeav
 			&i.GenesisProof,
 			&i.SumAmt,
 			&i.AssetID,
+			&i.Extensions,
 		); err != nil {
 			return nil, err
 		}
@@ -926,7 +1287,7 @@ func (q *Queries) QueryUniverseLeaves(ctx context.Context, arg QueryUniverseLeav
 }
 
 const queryUniverseServers = `-- name: QueryUniverseServers :many
-SELECT id, server_host, last_sync_time FROM universe_servers
+SELECT id, server_host, last_sync_time, identity_key FROM universe_servers
 WHERE (id = $1 OR $1 IS NULL) AND
       (server_host = $2
            OR $2 IS NULL)
@@ -946,7 +1307,12 @@ func (q *Queries) QueryUniverseServers(ctx context.Context, arg QueryUniverseSer
 	var items []UniverseServer
 	for rows.Next() {
 		var i UniverseServer
-		if err := rows.Scan(&i.ID, &i.ServerHost, &i.LastSyncTime); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.ServerHost,
+			&i.LastSyncTime,
+			&i.IdentityKey,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -1022,7 +1388,7 @@ func (q *Queries) QueryUniverseStats(ctx context.Context) (QueryUniverseStatsRow
 }
 
 const universeLeaves = `-- name: UniverseLeaves :many
-SELECT id, asset_genesis_id, minting_point, script_key_bytes, universe_root_id, leaf_node_key, leaf_node_namespace FROM universe_leaves
+SELECT id, asset_genesis_id, minting_point, script_key_bytes, universe_root_id, leaf_node_key, leaf_node_namespace, block_height, extensions, insertion_timestamp, amount, is_burn FROM universe_leaves
 `
 
 func (q *Queries) UniverseLeaves(ctx context.Context) ([]UniverseLeafe, error) {
@@ -1042,6 +1408,11 @@ func (q *Queries) UniverseLeaves(ctx context.Context) ([]UniverseLeafe, error) {
 			&i.UniverseRootID,
 			&i.LeafNodeKey,
 			&i.LeafNodeNamespace,
+			&i.BlockHeight,
+			&i.Extensions,
+			&i.InsertionTimestamp,
+			&i.Amount,
+			&i.IsBurn,
 		); err != nil {
 			return nil, err
 		}
@@ -1304,24 +1675,40 @@ func (q *Queries) UpsertMultiverseRoot(ctx context.Context, arg UpsertMultiverse
 const upsertUniverseLeaf = `-- name: UpsertUniverseLeaf :exec
 INSERT INTO universe_leaves (
     asset_genesis_id, script_key_bytes, universe_root_id, leaf_node_key, 
-    leaf_node_namespace, minting_point
+    leaf_node_namespace, minting_point, block_height, extensions,
+    insertion_timestamp, amount, is_burn
 ) VALUES (
     $1, $2, $3, $4,
-    $5, $6
+    $5, $6, $7, $8,
+    $9, $10, $11
 ) ON CONFLICT (minting_point, script_key_bytes)
-    -- This is a NOP, minting_point and script_key_bytes are the unique fields
-    -- that caused the conflict.
+    -- minting_point and script_key_bytes are the unique fields that caused
+    -- the conflict. We only update the block height, which fills it in for
+    -- leaves that were inserted before it was tracked, and the extensions.
+    -- Existing extensions are kept if the new leaf doesn't carry any. The
+    -- insertion timestamp is kept, so a leaf is only counted once.
     DO UPDATE SET minting_point = EXCLUDED.minting_point,
-                  script_key_bytes = EXCLUDED.script_key_bytes
+                  script_key_bytes = EXCLUDED.script_key_bytes,
+                  block_height = EXCLUDED.block_height,
+                  extensions = COALESCE(
+                      EXCLUDED.extensions, universe_leaves.extensions
+                  ),
+                  amount = EXCLUDED.amount,
+                  is_burn = EXCLUDED.is_burn
 `
 
 type UpsertUniverseLeafParams struct {
-	AssetGenesisID    int64
-	ScriptKeyBytes    []byte
-	UniverseRootID    int64
-	LeafNodeKey       []byte
-	LeafNodeNamespace string
-	MintingPoint      []byte
+	AssetGenesisID     int64
+	ScriptKeyBytes     []byte
+	UniverseRootID     int64
+	LeafNodeKey        []byte
+	LeafNodeNamespace  string
+	MintingPoint       []byte
+	BlockHeight        sql.NullInt32
+	Extensions         []byte
+	InsertionTimestamp sql.NullInt64
+	Amount             sql.NullInt64
+	IsBurn             bool
 }
 
 func (q *Queries) UpsertUniverseLeaf(ctx context.Context, arg UpsertUniverseLeafParams) error {
@@ -1332,6 +1719,11 @@ func (q *Queries) UpsertUniverseLeaf(ctx context.Context, arg UpsertUniverseLeaf
 		arg.LeafNodeKey,
 		arg.LeafNodeNamespace,
 		arg.MintingPoint,
+		arg.BlockHeight,
+		arg.Extensions,
+		arg.InsertionTimestamp,
+		arg.Amount,
+		arg.IsBurn,
 	)
 	return err
 }
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/internal/test"
 	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
 	"github.com/stretchr/testify/require"
@@ -48,6 +49,13 @@ func sqlInt32[T constraints.Integer](num T) sql.NullInt32 {
 	}
 }
 
+// sqlOptInt32 turns an optional numerical integer type into the NullInt32 that
+// sql/sqlc uses when an integer field can be permitted to be NULL. None maps
+// to NULL.
+func sqlOptInt32[T constraints.Integer](num fn.Option[T]) sql.NullInt32 {
+	return fn.MapOptionZ(num, sqlInt32[T])
+}
+
 // sqlInt16 turns a numerical integer type into the NullInt16 that sql/sqlc
 // uses when an integer field can be permitted to be NULL.
 //
@@ -82,6 +90,41 @@ func sqlStr(s string) sql.NullString {
 	}
 }
 
+// sqlOptTime turns an optional time into the NullTime that sql/sqlc uses when
+// a time can be permitted to be NULL. None maps to NULL. The time is converted
+// to UTC, so it can be compared with the stored times.
+func sqlOptTime(t fn.Option[time.Time]) sql.NullTime {
+	return fn.MapOptionZ(t, func(t time.Time) sql.NullTime {
+		return sql.NullTime{
+			Time:  t.UTC(),
+			Valid: true,
+		}
+	})
+}
+
+// sqlTime turns a time into the NullTime that sql/sqlc uses when a time can
+// be permitted to be NULL. The zero time maps to NULL. The time is converted to
+// UTC, so it can be compared with the stored times.
+func sqlTime(t time.Time) sql.NullTime {
+	if t.IsZero() {
+		return sql.NullTime{}
+	}
+
+	return sql.NullTime{
+		Time:  t.UTC(),
+		Valid: true,
+	}
+}
+
+// extractSqlTime turns a NullTime into a UTC time. NULL maps to the zero time.
+func extractSqlTime(t sql.NullTime) time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+
+	return t.Time.UTC()
+}
+
 // extractSqlInt64 turns a NullInt64 into a numerical type. This can be useful
 // when reading directly from the database, as this function handles extracting
 // the inner value from the "option"-like struct.
@@ -0,0 +1,308 @@
+package tapdb
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+// InsertStaticAddr inserts a new static address into the database.
+func (t *TapAddressBook) InsertStaticAddr(ctx context.Context,
+	addr *address.StaticAddrWithKeyInfo) error {
+
+	var writeTxOpts AddrBookTxOptions
+	return t.db.ExecTx(ctx, &writeTxOpts, func(db AddrBook) error {
+		scanKeyID, err := insertInternalKey(ctx, db, addr.ScanKeyDesc)
+		if err != nil {
+			return fmt.Errorf("unable to insert scan key: %w", err)
+		}
+
+		spendKeyID, err := insertInternalKey(
+			ctx, db, addr.SpendKeyDesc,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to insert spend key: %w",
+				err)
+		}
+
+		taprootKeyID, err := insertInternalKey(
+			ctx, db, addr.InternalKeyDesc,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to insert internal "+
+				"taproot key: %w", err)
+		}
+
+		_, err = db.InsertStaticAddr(ctx, NewStaticAddr{
+			Version:      int16(addr.Version),
+			AssetVersion: int16(addr.AssetVersion),
+			AssetID:      addr.AssetID[:],
+			ScanKeyID:    scanKeyID,
+			SpendKeyID:   spendKeyID,
+			TaprootKeyID: taprootKeyID,
+			ProofCourierAddr: []byte(
+				addr.ProofCourierAddr.String(),
+			),
+			CreationTime: addr.CreationTime.UTC(),
+			ScanHeight:   int32(addr.ScanHeight),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert static addr: %w",
+				err)
+		}
+
+		return nil
+	})
+}
+
+// parseKeyDesc parses a raw public key and its locator into a key
+// descriptor.
+func parseKeyDesc(rawKey []byte, family, index int32) (keychain.KeyDescriptor,
+	error) {
+
+	pubKey, err := btcec.ParsePubKey(rawKey)
+	if err != nil {
+		return keychain.KeyDescriptor{}, err
+	}
+
+	return keychain.KeyDescriptor{
+		PubKey: pubKey,
+		KeyLocator: keychain.KeyLocator{
+			Family: keychain.KeyFamily(family),
+			Index:  uint32(index),
+		},
+	}, nil
+}
+
+// QueryStaticAddrs returns all static addresses.
+func (t *TapAddressBook) QueryStaticAddrs(
+	ctx context.Context) ([]address.StaticAddrWithKeyInfo, error) {
+
+	var addrs []address.StaticAddrWithKeyInfo
+
+	readOpts := NewAddrBookReadTx()
+	err := t.db.ExecTx(ctx, &readOpts, func(db AddrBook) error {
+		dbAddrs, err := db.QueryStaticAddrs(ctx)
+		if err != nil {
+			return err
+		}
+
+		addrs = make([]address.StaticAddrWithKeyInfo, 0, len(dbAddrs))
+		for _, dbAddr := range dbAddrs {
+			scanKey, err := parseKeyDesc(
+				dbAddr.RawScanKey, dbAddr.ScanKeyFamily,
+				dbAddr.ScanKeyIndex,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to parse scan key: "+
+					"%w", err)
+			}
+
+			spendKey, err := parseKeyDesc(
+				dbAddr.RawSpendKey, dbAddr.SpendKeyFamily,
+				dbAddr.SpendKeyIndex,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to parse spend "+
+					"key: %w", err)
+			}
+
+			internalKey, err := parseKeyDesc(
+				dbAddr.RawTaprootKey, dbAddr.TaprootKeyFamily,
+				dbAddr.TaprootKeyIndex,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to parse internal "+
+					"key: %w", err)
+			}
+
+			proofCourierAddr, err := url.ParseRequestURI(
+				string(dbAddr.ProofCourierAddr),
+			)
+			if err != nil {
+				return fmt.Errorf("unable to parse proof "+
+					"courier address: %w", err)
+			}
+
+			var assetID asset.ID
+			copy(assetID[:], dbAddr.AssetID)
+
+			addrs = append(addrs, address.StaticAddrWithKeyInfo{
+				StaticAddr: &address.StaticAddr{
+					Version: address.StaticVersion(
+						dbAddr.Version,
+					),
+					ChainParams: t.params,
+					AssetVersion: asset.Version(
+						dbAddr.AssetVersion,
+					),
+					AssetID:          assetID,
+					ScanKey:          *scanKey.PubKey,
+					SpendKey:         *spendKey.PubKey,
+					InternalKey:      *internalKey.PubKey,
+					ProofCourierAddr: *proofCourierAddr,
+				},
+				ScanKeyDesc:     scanKey,
+				SpendKeyDesc:    spendKey,
+				InternalKeyDesc: internalKey,
+				CreationTime:    dbAddr.CreationTime.UTC(),
+				ScanHeight:      uint32(dbAddr.ScanHeight),
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return addrs, nil
+}
+
+// staticAddrScanDepth is the number of most recently scanned blocks whose hash
+// is kept to detect re-organizations. A day worth of blocks is far deeper than
+// any re-organization we expect.
+const staticAddrScanDepth = 144
+
+// SetStaticAddrsScanHeight records that all static addresses were scanned for
+// incoming payments up to and including the given height, which is the height
+// of the block with the given hash.
+func (t *TapAddressBook) SetStaticAddrsScanHeight(ctx context.Context,
+	height uint32, blockHash chainhash.Hash) error {
+
+	var writeTxOpts AddrBookTxOptions
+	return t.db.ExecTx(ctx, &writeTxOpts, func(db AddrBook) error {
+		err := db.SetStaticAddrsScanHeight(ctx, int32(height))
+		if err != nil {
+			return err
+		}
+
+		err = db.UpsertStaticAddrScanBlock(
+			ctx, StaticAddrScanBlockParams{
+				Height:    int32(height),
+				BlockHash: blockHash[:],
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to store scanned block: %w",
+				err)
+		}
+
+		return db.PruneStaticAddrScanBlocks(
+			ctx, PruneStaticAddrScanBlocksParams{
+				MinHeight: int32(height) - staticAddrScanDepth,
+				MaxHeight: int32(height),
+			},
+		)
+	})
+}
+
+// StaticAddrScanBlocks returns the hashes of the most recently scanned blocks,
+// keyed by their height.
+func (t *TapAddressBook) StaticAddrScanBlocks(
+	ctx context.Context) (map[uint32]chainhash.Hash, error) {
+
+	blocks := make(map[uint32]chainhash.Hash)
+
+	readOpts := NewAddrBookReadTx()
+	err := t.db.ExecTx(ctx, &readOpts, func(db AddrBook) error {
+		dbBlocks, err := db.QueryStaticAddrScanBlocks(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, dbBlock := range dbBlocks {
+			blockHash, err := chainhash.NewHash(dbBlock.BlockHash)
+			if err != nil {
+				return err
+			}
+
+			blocks[uint32(dbBlock.Height)] = *blockHash
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return blocks, nil
+}
+
+// RollbackStaticAddrsScan resets the scan height of all static addresses that
+// were scanned above the given height, because the blocks above it were
+// re-organized out of the chain. The payments to static addresses that were
+// found in these blocks and weren't completed yet are unclaimed by deleting
+// their events, so they are found again when the blocks of the new chain are
+// scanned. The anchor outpoints of the unclaimed payments are returned.
+func (t *TapAddressBook) RollbackStaticAddrsScan(ctx context.Context,
+	height uint32) ([]wire.OutPoint, error) {
+
+	var (
+		writeTxOpts AddrBookTxOptions
+		outpoints   []wire.OutPoint
+	)
+	err := t.db.ExecTx(ctx, &writeTxOpts, func(db AddrBook) error {
+		outpoints = nil
+
+		err := db.RollbackStaticAddrsScanHeight(ctx, int32(height))
+		if err != nil {
+			return fmt.Errorf("unable to roll back scan height: %w",
+				err)
+		}
+
+		err = db.PruneStaticAddrScanBlocks(
+			ctx, PruneStaticAddrScanBlocksParams{
+				MinHeight: 0,
+				MaxHeight: int32(height),
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to remove scanned blocks: %w",
+				err)
+		}
+
+		events, err := db.QueryStaticPaymentEvents(
+			ctx, StaticPaymentEventsQuery{
+				MinHeight: sqlInt32(height),
+				MaxStatus: int16(address.StatusCompleted),
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to query static payment "+
+				"events: %w", err)
+		}
+
+		for _, event := range events {
+			txHash, err := chainhash.NewHash(event.Txid)
+			if err != nil {
+				return err
+			}
+
+			err = db.DeleteAddrEvent(ctx, event.ID)
+			if err != nil {
+				return fmt.Errorf("unable to delete static "+
+					"payment event: %w", err)
+			}
+
+			outpoints = append(outpoints, wire.OutPoint{
+				Hash:  *txHash,
+				Index: uint32(event.ChainTxnOutputIndex),
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return outpoints, nil
+}
@@ -0,0 +1,174 @@
+package tapdb
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// randStaticAddr returns a random static address that was created at the
+// given height.
+func randStaticAddr(t *testing.T,
+	birthHeight uint32) *address.StaticAddrWithKeyInfo {
+
+	scanKey, _ := test.RandKeyDesc(t)
+	spendKey, _ := test.RandKeyDesc(t)
+	internalKey, _ := test.RandKeyDesc(t)
+
+	return &address.StaticAddrWithKeyInfo{
+		StaticAddr: &address.StaticAddr{
+			Version:          address.StaticV0,
+			ChainParams:      chainParams,
+			AssetVersion:     asset.V1,
+			AssetID:          asset.RandID(t),
+			ScanKey:          *scanKey.PubKey,
+			SpendKey:         *spendKey.PubKey,
+			InternalKey:      *internalKey.PubKey,
+			ProofCourierAddr: address.RandProofCourierAddr(t),
+		},
+		ScanKeyDesc:     scanKey,
+		SpendKeyDesc:    spendKey,
+		InternalKeyDesc: internalKey,
+		CreationTime:    time.Now().UTC().Truncate(time.Second),
+		ScanHeight:      birthHeight,
+	}
+}
+
+// TestStaticAddrs tests that static addresses can be stored and queried, and
+// that their scan height is only ever moved forward.
+func TestStaticAddrs(t *testing.T) {
+	t.Parallel()
+
+	testClock := clock.NewTestClock(time.Now())
+	addrBook, _ := newAddrBook(t, testClock)
+	ctx := context.Background()
+
+	addrs := []*address.StaticAddrWithKeyInfo{
+		randStaticAddr(t, 100), randStaticAddr(t, 200),
+	}
+	for _, addr := range addrs {
+		require.NoError(t, addrBook.InsertStaticAddr(ctx, addr))
+	}
+
+	dbAddrs, err := addrBook.QueryStaticAddrs(ctx)
+	require.NoError(t, err)
+	require.Len(t, dbAddrs, len(addrs))
+	for idx := range addrs {
+		require.Equal(t, *addrs[idx], dbAddrs[idx])
+	}
+
+	// Scanning up to a height between the two birth heights only moves
+	// the scan height of the older address.
+	require.NoError(t, addrBook.SetStaticAddrsScanHeight(
+		ctx, 150, test.RandHash(),
+	))
+
+	dbAddrs, err = addrBook.QueryStaticAddrs(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 150, dbAddrs[0].ScanHeight)
+	require.EqualValues(t, 200, dbAddrs[1].ScanHeight)
+
+	require.NoError(t, addrBook.SetStaticAddrsScanHeight(
+		ctx, 250, test.RandHash(),
+	))
+
+	dbAddrs, err = addrBook.QueryStaticAddrs(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 250, dbAddrs[0].ScanHeight)
+	require.EqualValues(t, 250, dbAddrs[1].ScanHeight)
+}
+
+// TestStaticAddrsScanRollback tests that the hashes of the scanned blocks are
+// stored and pruned, and that rolling back the scan resets the scan height and
+// unclaims the incomplete payments found above the fork height.
+func TestStaticAddrsScanRollback(t *testing.T) {
+	t.Parallel()
+
+	testClock := clock.NewTestClock(time.Now())
+	addrBook, _ := newAddrBook(t, testClock)
+	ctx := context.Background()
+
+	staticAddr := randStaticAddr(t, 100)
+	require.NoError(t, addrBook.InsertStaticAddr(ctx, staticAddr))
+
+	// Only the hashes of the most recent blocks are kept.
+	blockHashes := make(map[uint32]chainhash.Hash)
+	for _, height := range []uint32{10, 150, 160, 170} {
+		blockHashes[height] = test.RandHash()
+		require.NoError(t, addrBook.SetStaticAddrsScanHeight(
+			ctx, height, blockHashes[height],
+		))
+	}
+	delete(blockHashes, 10)
+
+	scanBlocks, err := addrBook.StaticAddrScanBlocks(ctx)
+	require.NoError(t, err)
+	require.Equal(t, blockHashes, scanBlocks)
+
+	// We now create payments to the static address. Only the incomplete
+	// payment that confirmed above the fork height should be unclaimed.
+	newPayment := func(height int32,
+		status address.Status) *address.Event {
+
+		addr, assetGen, assetGroup := address.RandAddr(
+			t, chainParams, staticAddr.ProofCourierAddr,
+		)
+		addr.InternalKey = staticAddr.InternalKey
+		addr.InternalKeyDesc = staticAddr.InternalKeyDesc
+
+		var writeTxOpts AddrBookTxOptions
+		err := addrBook.db.ExecTx(
+			ctx, &writeTxOpts,
+			insertFullAssetGen(ctx, assetGen, assetGroup),
+		)
+		require.NoError(t, err)
+		require.NoError(t, addrBook.InsertAddrs(ctx, *addr))
+
+		walletTx := randWalletTx()
+		confirmTx(walletTx)
+		walletTx.BlockHeight = height
+
+		event, err := addrBook.GetOrCreateEvent(
+			ctx, status, addr, walletTx,
+			uint32(rand.Intn(len(walletTx.Tx.TxOut))),
+		)
+		require.NoError(t, err)
+
+		return event
+	}
+
+	unclaimed := newPayment(160, address.StatusTransactionConfirmed)
+	newPayment(160, address.StatusCompleted)
+	newPayment(140, address.StatusTransactionConfirmed)
+
+	outpoints, err := addrBook.RollbackStaticAddrsScan(ctx, 150)
+	require.NoError(t, err)
+	require.Equal(t, []wire.OutPoint{unclaimed.Outpoint}, outpoints)
+
+	events, err := addrBook.QueryAddrEvents(ctx, address.EventQueryParams{})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	for _, event := range events {
+		require.NotEqual(t, unclaimed.Outpoint, event.Outpoint)
+	}
+
+	dbAddrs, err := addrBook.QueryStaticAddrs(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 150, dbAddrs[0].ScanHeight)
+
+	scanBlocks, err = addrBook.StaticAddrScanBlocks(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[uint32]chainhash.Hash{
+		150: blockHashes[150],
+	}, scanBlocks)
+}
@@ -0,0 +1,215 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+type (
+	// NewSyncCheckpoint is used to insert or update the checkpoint of a
+	// streaming universe sync.
+	NewSyncCheckpoint = sqlc.UpsertUniverseSyncCheckpointParams
+
+	// SyncCheckpointQuery is used to query for the checkpoint of a
+	// streaming universe sync.
+	SyncCheckpointQuery = sqlc.FetchUniverseSyncCheckpointParams
+
+	// SyncCheckpointDelete is used to delete the checkpoint of a streaming
+	// universe sync.
+	SyncCheckpointDelete = sqlc.DeleteUniverseSyncCheckpointParams
+
+	// SyncCheckpoint is the checkpoint of a streaming universe sync as
+	// stored in the database.
+	SyncCheckpoint = sqlc.FetchUniverseSyncCheckpointRow
+)
+
+// SyncCheckpointStore is the set of queries needed to persist the progress of
+// streaming universe syncs.
+type SyncCheckpointStore interface {
+	// UpsertUniverseSyncCheckpoint inserts or updates the checkpoint of
+	// the sync of a universe with a remote server.
+	UpsertUniverseSyncCheckpoint(ctx context.Context,
+		arg NewSyncCheckpoint) error
+
+	// FetchUniverseSyncCheckpoint fetches the checkpoint of the sync of a
+	// universe with a remote server.
+	FetchUniverseSyncCheckpoint(ctx context.Context,
+		arg SyncCheckpointQuery) (SyncCheckpoint, error)
+
+	// DeleteUniverseSyncCheckpoint deletes the checkpoint of the sync of a
+	// universe with a remote server.
+	DeleteUniverseSyncCheckpoint(ctx context.Context,
+		arg SyncCheckpointDelete) error
+}
+
+// SyncCheckpointTxOptions defines the set of db txn options the
+// SyncCheckpointStore understands.
+type SyncCheckpointTxOptions struct {
+	// readOnly governs if a read only transaction is needed or not.
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (r *SyncCheckpointTxOptions) ReadOnly() bool {
+	return r.readOnly
+}
+
+// NewSyncCheckpointReadTx creates a new read transaction option set.
+func NewSyncCheckpointReadTx() SyncCheckpointTxOptions {
+	return SyncCheckpointTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedSyncCheckpointStore is the main storage interface for universe sync
+// checkpoints. It supports all the basic queries as well as running the set of
+// queries in a single database transaction.
+type BatchedSyncCheckpointStore interface {
+	SyncCheckpointStore
+
+	BatchedTx[SyncCheckpointStore]
+}
+
+// UniverseSyncCheckpoints is a database backed implementation of the
+// universe.SyncCheckpointStore interface.
+type UniverseSyncCheckpoints struct {
+	db BatchedSyncCheckpointStore
+
+	clock clock.Clock
+}
+
+// NewUniverseSyncCheckpoints creates a new database backed universe sync
+// checkpoint store.
+func NewUniverseSyncCheckpoints(db BatchedSyncCheckpointStore,
+	clock clock.Clock) *UniverseSyncCheckpoints {
+
+	return &UniverseSyncCheckpoints{
+		db:    db,
+		clock: clock,
+	}
+}
+
+// FetchSyncCheckpoint returns the checkpoint of the sync of the given universe
+// with the given remote server. If no checkpoint is stored,
+// universe.ErrNoSyncCheckpoint is returned.
+//
+// NOTE: This is part of the universe.SyncCheckpointStore interface.
+func (u *UniverseSyncCheckpoints) FetchSyncCheckpoint(ctx context.Context,
+	host universe.ServerAddr,
+	id universe.Identifier) (*universe.SyncCheckpoint, error) {
+
+	var (
+		checkpoint *universe.SyncCheckpoint
+		readTx     = NewSyncCheckpointReadTx()
+	)
+	err := u.db.ExecTx(ctx, &readTx, func(q SyncCheckpointStore) error {
+		dbCheckpoint, err := q.FetchUniverseSyncCheckpoint(
+			ctx, SyncCheckpointQuery{
+				ServerHost:    host.HostStr(),
+				NamespaceRoot: id.String(),
+			},
+		)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return universe.ErrNoSyncCheckpoint
+
+		case err != nil:
+			return fmt.Errorf("unable to fetch sync checkpoint: %w",
+				err)
+		}
+
+		var remoteHash mssmt.NodeHash
+		copy(remoteHash[:], dbCheckpoint.RemoteRootHash)
+
+		checkpoint = &universe.SyncCheckpoint{
+			ID: id,
+			RemoteRoot: mssmt.NewComputedBranch(
+				remoteHash, uint64(dbCheckpoint.RemoteRootSum),
+			),
+			Offset:    dbCheckpoint.LeafOffset,
+			NumSynced: uint64(dbCheckpoint.NumSynced),
+		}
+
+		// The local root is only set if the local universe wasn't
+		// empty at the time the checkpoint was stored.
+		if len(dbCheckpoint.LocalRootHash) != 0 {
+			var localHash mssmt.NodeHash
+			copy(localHash[:], dbCheckpoint.LocalRootHash)
+
+			checkpoint.LocalRoot = mssmt.NewComputedBranch(
+				localHash, uint64(dbCheckpoint.LocalRootSum),
+			)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return checkpoint, nil
+}
+
+// StoreSyncCheckpoint stores the checkpoint of the sync of a universe with the
+// given remote server, replacing any existing checkpoint.
+//
+// NOTE: This is part of the universe.SyncCheckpointStore interface.
+func (u *UniverseSyncCheckpoints) StoreSyncCheckpoint(ctx context.Context,
+	host universe.ServerAddr, checkpoint universe.SyncCheckpoint) error {
+
+	if checkpoint.RemoteRoot == nil {
+		return fmt.Errorf("sync checkpoint is missing remote root")
+	}
+
+	remoteHash := checkpoint.RemoteRoot.NodeHash()
+	newCheckpoint := NewSyncCheckpoint{
+		ServerHost:     host.HostStr(),
+		NamespaceRoot:  checkpoint.ID.String(),
+		RemoteRootHash: remoteHash[:],
+		RemoteRootSum:  int64(checkpoint.RemoteRoot.NodeSum()),
+		LeafOffset:     checkpoint.Offset,
+		NumSynced:      int64(checkpoint.NumSynced),
+		UpdatedAt:      u.clock.Now().UTC(),
+	}
+	if checkpoint.LocalRoot != nil {
+		localHash := checkpoint.LocalRoot.NodeHash()
+		newCheckpoint.LocalRootHash = localHash[:]
+		newCheckpoint.LocalRootSum = int64(
+			checkpoint.LocalRoot.NodeSum(),
+		)
+	}
+
+	var writeTx SyncCheckpointTxOptions
+	return u.db.ExecTx(ctx, &writeTx, func(q SyncCheckpointStore) error {
+		return q.UpsertUniverseSyncCheckpoint(ctx, newCheckpoint)
+	})
+}
+
+// DeleteSyncCheckpoint removes the checkpoint of the sync of the given
+// universe with the given remote server, if one exists.
+//
+// NOTE: This is part of the universe.SyncCheckpointStore interface.
+func (u *UniverseSyncCheckpoints) DeleteSyncCheckpoint(ctx context.Context,
+	host universe.ServerAddr, id universe.Identifier) error {
+
+	var writeTx SyncCheckpointTxOptions
+	return u.db.ExecTx(ctx, &writeTx, func(q SyncCheckpointStore) error {
+		return q.DeleteUniverseSyncCheckpoint(ctx, SyncCheckpointDelete{
+			ServerHost:    host.HostStr(),
+			NamespaceRoot: id.String(),
+		})
+	})
+}
+
+// A compile-time assertion to make sure UniverseSyncCheckpoints satisfies the
+// universe.SyncCheckpointStore interface.
+var _ universe.SyncCheckpointStore = (*UniverseSyncCheckpoints)(nil)
@@ -0,0 +1,125 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSyncCheckpoints(t *testing.T) *UniverseSyncCheckpoints {
+	db := NewTestDB(t)
+
+	dbTxer := NewTransactionExecutor(db,
+		func(tx *sql.Tx) SyncCheckpointStore {
+			return db.WithTx(tx)
+		},
+	)
+
+	testClock := clock.NewTestClock(time.Unix(1700000000, 0))
+
+	return NewUniverseSyncCheckpoints(dbTxer, testClock)
+}
+
+// TestUniverseSyncCheckpoints tests that the checkpoints of streaming universe
+// syncs can be stored, updated and deleted per server.
+func TestUniverseSyncCheckpoints(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx         = context.Background()
+		checkpoints = newTestSyncCheckpoints(t)
+		server1     = universe.NewServerAddrFromStr("server1:10029")
+		server2     = universe.NewServerAddrFromStr("server2:10029")
+		id          = randUniverseID(t, false)
+	)
+
+	randNode := func() mssmt.Node {
+		var nodeHash mssmt.NodeHash
+		copy(nodeHash[:], test.RandBytes(32))
+
+		return mssmt.NewComputedBranch(
+			nodeHash, uint64(test.RandInt[uint32]()),
+		)
+	}
+
+	assertCheckpoint := func(host universe.ServerAddr,
+		expected universe.SyncCheckpoint) {
+
+		checkpoint, err := checkpoints.FetchSyncCheckpoint(
+			ctx, host, id,
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, expected.ID, checkpoint.ID)
+		require.True(t, mssmt.IsEqualNode(
+			expected.RemoteRoot, checkpoint.RemoteRoot,
+		))
+		if expected.LocalRoot == nil {
+			require.Nil(t, checkpoint.LocalRoot)
+		} else {
+			require.True(t, mssmt.IsEqualNode(
+				expected.LocalRoot, checkpoint.LocalRoot,
+			))
+		}
+		require.Equal(t, expected.Offset, checkpoint.Offset)
+		require.Equal(t, expected.NumSynced, checkpoint.NumSynced)
+	}
+
+	// Without any stored checkpoint, we should get the proper error.
+	_, err := checkpoints.FetchSyncCheckpoint(ctx, server1, id)
+	require.ErrorIs(t, err, universe.ErrNoSyncCheckpoint)
+
+	// We store a checkpoint without a local root for the first server and
+	// one with a local root for the second server, they should be kept
+	// apart.
+	checkpoint1 := universe.SyncCheckpoint{
+		ID:         id,
+		RemoteRoot: randNode(),
+		Offset:     100,
+		NumSynced:  42,
+	}
+	checkpoint2 := universe.SyncCheckpoint{
+		ID:         id,
+		RemoteRoot: randNode(),
+		LocalRoot:  randNode(),
+		Offset:     200,
+		NumSynced:  7,
+	}
+	err = checkpoints.StoreSyncCheckpoint(ctx, server1, checkpoint1)
+	require.NoError(t, err)
+	err = checkpoints.StoreSyncCheckpoint(ctx, server2, checkpoint2)
+	require.NoError(t, err)
+
+	assertCheckpoint(server1, checkpoint1)
+	assertCheckpoint(server2, checkpoint2)
+
+	// Storing a new checkpoint for the same universe should replace the
+	// old one.
+	checkpoint3 := universe.SyncCheckpoint{
+		ID:         id,
+		RemoteRoot: checkpoint1.RemoteRoot,
+		LocalRoot:  randNode(),
+		Offset:     200,
+		NumSynced:  142,
+	}
+	err = checkpoints.StoreSyncCheckpoint(ctx, server1, checkpoint3)
+	require.NoError(t, err)
+
+	assertCheckpoint(server1, checkpoint3)
+
+	// Finally, deleting the checkpoint of one server shouldn't affect the
+	// other.
+	require.NoError(t, checkpoints.DeleteSyncCheckpoint(ctx, server1, id))
+
+	_, err = checkpoints.FetchSyncCheckpoint(ctx, server1, id)
+	require.ErrorIs(t, err, universe.ErrNoSyncCheckpoint)
+
+	assertCheckpoint(server2, checkpoint2)
+}
@@ -0,0 +1,301 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+type (
+	// NewAssetTicker is used to insert or update a ticker registry entry.
+	NewAssetTicker = sqlc.UpsertAssetTickerParams
+
+	// AssetTicker is a ticker registry entry as stored in the database.
+	AssetTicker = sqlc.AssetTicker
+
+	// AssetMetaRow is the meta data of an asset together with the asset's
+	// ID, name and group key.
+	AssetMetaRow = sqlc.QueryAssetMetasByTypeRow
+)
+
+// TickerStore is the set of queries needed to maintain the ticker registry
+// and to look up tickers in the meta data of assets.
+type TickerStore interface {
+	// UpsertAssetTicker inserts or updates a ticker registry entry.
+	UpsertAssetTicker(ctx context.Context, arg NewAssetTicker) error
+
+	// FetchAssetTicker returns the registry entry of the given ticker.
+	FetchAssetTicker(ctx context.Context, ticker string) (AssetTicker,
+		error)
+
+	// QueryAssetTickers returns all registry entries.
+	QueryAssetTickers(ctx context.Context) ([]AssetTicker, error)
+
+	// DeleteAssetTicker deletes the registry entry of the given ticker and
+	// returns the number of deleted rows.
+	DeleteAssetTicker(ctx context.Context, ticker string) (int64, error)
+
+	// QueryAssetMetasByType returns the revealed meta data of all assets
+	// with the given meta type.
+	QueryAssetMetasByType(ctx context.Context,
+		metaType sql.NullInt16) ([]AssetMetaRow, error)
+}
+
+// TickerStoreTxOptions defines the set of db txn options the TickerStore
+// understands.
+type TickerStoreTxOptions struct {
+	// readOnly governs if a read only transaction is needed or not.
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (r *TickerStoreTxOptions) ReadOnly() bool {
+	return r.readOnly
+}
+
+// NewTickerStoreReadTx creates a new read transaction option set.
+func NewTickerStoreReadTx() TickerStoreTxOptions {
+	return TickerStoreTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedTickerStore is the main storage interface for the ticker registry. It
+// supports all the basic queries as well as running the set of queries in a
+// single database transaction.
+type BatchedTickerStore interface {
+	TickerStore
+
+	BatchedTx[TickerStore]
+}
+
+// TickerRegistry is a database backed implementation of the
+// tapfreighter.TickerRegistry and tapfreighter.TickerMetaSource interfaces.
+type TickerRegistry struct {
+	db BatchedTickerStore
+
+	clock clock.Clock
+}
+
+// NewTickerRegistry creates a new database backed ticker registry.
+func NewTickerRegistry(db BatchedTickerStore,
+	clock clock.Clock) *TickerRegistry {
+
+	return &TickerRegistry{
+		db:    db,
+		clock: clock,
+	}
+}
+
+// UpsertTicker pins the given ticker to its asset or asset group, replacing
+// any existing mapping of the ticker.
+//
+// NOTE: This is part of the tapfreighter.TickerRegistry interface.
+func (r *TickerRegistry) UpsertTicker(ctx context.Context,
+	ticker *tapfreighter.AssetTicker) error {
+
+	normalized, err := tapfreighter.NormalizeTicker(ticker.Ticker)
+	if err != nil {
+		return err
+	}
+
+	newTicker := NewAssetTicker{
+		Ticker:    normalized,
+		CreatedAt: r.clock.Now().UTC(),
+	}
+	if ticker.GroupKey != nil {
+		newTicker.GroupKey = ticker.GroupKey.SerializeCompressed()
+	} else {
+		newTicker.AssetID = fn.CopySlice(ticker.AssetID[:])
+	}
+
+	var writeTx TickerStoreTxOptions
+	return r.db.ExecTx(ctx, &writeTx, func(q TickerStore) error {
+		return q.UpsertAssetTicker(ctx, newTicker)
+	})
+}
+
+// FetchTicker returns the registry entry of the given normalized ticker or
+// tapfreighter.ErrTickerNotFound if it doesn't exist.
+//
+// NOTE: This is part of the tapfreighter.TickerRegistry interface.
+func (r *TickerRegistry) FetchTicker(ctx context.Context,
+	ticker string) (*tapfreighter.AssetTicker, error) {
+
+	var (
+		dbTicker AssetTicker
+		readTx   = NewTickerStoreReadTx()
+	)
+	err := r.db.ExecTx(ctx, &readTx, func(q TickerStore) error {
+		var err error
+		dbTicker, err = q.FetchAssetTicker(ctx, ticker)
+		return err
+	})
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, fmt.Errorf("%w: %v", tapfreighter.ErrTickerNotFound,
+			ticker)
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to fetch ticker: %w", err)
+	}
+
+	return unmarshalAssetTicker(dbTicker)
+}
+
+// QueryTickers returns all registry entries, ordered by ticker.
+//
+// NOTE: This is part of the tapfreighter.TickerRegistry interface.
+func (r *TickerRegistry) QueryTickers(
+	ctx context.Context) ([]*tapfreighter.AssetTicker, error) {
+
+	var (
+		dbTickers []AssetTicker
+		readTx    = NewTickerStoreReadTx()
+	)
+	err := r.db.ExecTx(ctx, &readTx, func(q TickerStore) error {
+		var err error
+		dbTickers, err = q.QueryAssetTickers(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to query tickers: %w", err)
+	}
+
+	tickers := make([]*tapfreighter.AssetTicker, 0, len(dbTickers))
+	for _, dbTicker := range dbTickers {
+		ticker, err := unmarshalAssetTicker(dbTicker)
+		if err != nil {
+			return nil, err
+		}
+
+		tickers = append(tickers, ticker)
+	}
+
+	return tickers, nil
+}
+
+// DeleteTicker removes the registry entry of the given normalized ticker or
+// returns tapfreighter.ErrTickerNotFound if it doesn't exist.
+//
+// NOTE: This is part of the tapfreighter.TickerRegistry interface.
+func (r *TickerRegistry) DeleteTicker(ctx context.Context,
+	ticker string) error {
+
+	var writeTx TickerStoreTxOptions
+	return r.db.ExecTx(ctx, &writeTx, func(q TickerStore) error {
+		numDeleted, err := q.DeleteAssetTicker(ctx, ticker)
+		if err != nil {
+			return err
+		}
+
+		if numDeleted == 0 {
+			return fmt.Errorf("%w: %v",
+				tapfreighter.ErrTickerNotFound, ticker)
+		}
+
+		return nil
+	})
+}
+
+// MetaTickers returns a mapping for each known asset whose JSON meta data
+// declares the given normalized ticker. The meta data is only stored once its
+// hash was verified against the asset genesis.
+//
+// NOTE: This is part of the tapfreighter.TickerMetaSource interface.
+func (r *TickerRegistry) MetaTickers(ctx context.Context,
+	ticker string) ([]*tapfreighter.AssetTicker, error) {
+
+	var (
+		dbMetas []AssetMetaRow
+		readTx  = NewTickerStoreReadTx()
+	)
+	err := r.db.ExecTx(ctx, &readTx, func(q TickerStore) error {
+		var err error
+		dbMetas, err = q.QueryAssetMetasByType(
+			ctx, sqlInt16(proof.MetaJson),
+		)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to query asset meta data: %w",
+			err)
+	}
+
+	var tickers []*tapfreighter.AssetTicker
+	for _, dbMeta := range dbMetas {
+		meta := proof.MetaReveal{
+			Type: proof.MetaJson,
+			Data: dbMeta.MetaDataBlob,
+		}
+
+		// Meta data without a valid ticker simply doesn't declare one.
+		metaTicker, err := meta.GetTicker()
+		if err != nil {
+			continue
+		}
+		metaTicker, err = tapfreighter.NormalizeTicker(metaTicker)
+		if err != nil || metaTicker != ticker {
+			continue
+		}
+
+		assetTicker := &tapfreighter.AssetTicker{
+			Ticker:    metaTicker,
+			AssetName: dbMeta.AssetTag,
+			Source:    tapfreighter.TickerSourceMeta,
+		}
+		copy(assetTicker.AssetID[:], dbMeta.AssetID)
+
+		if len(dbMeta.TweakedGroupKey) > 0 {
+			assetTicker.GroupKey, err = btcec.ParsePubKey(
+				dbMeta.TweakedGroupKey,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse group "+
+					"key: %w", err)
+			}
+		}
+
+		tickers = append(tickers, assetTicker)
+	}
+
+	return tickers, nil
+}
+
+// unmarshalAssetTicker converts a ticker registry entry as stored in the
+// database into its tapfreighter representation.
+func unmarshalAssetTicker(
+	dbTicker AssetTicker) (*tapfreighter.AssetTicker, error) {
+
+	ticker := &tapfreighter.AssetTicker{
+		Ticker: dbTicker.Ticker,
+		Source: tapfreighter.TickerSourceRegistry,
+	}
+	copy(ticker.AssetID[:], dbTicker.AssetID)
+
+	if len(dbTicker.GroupKey) > 0 {
+		var err error
+		ticker.GroupKey, err = btcec.ParsePubKey(dbTicker.GroupKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse group key of "+
+				"ticker %v: %w", dbTicker.Ticker, err)
+		}
+	}
+
+	return ticker, nil
+}
+
+// A compile-time assertion to make sure TickerRegistry satisfies the
+// tapfreighter.TickerRegistry and tapfreighter.TickerMetaSource interfaces.
+var _ tapfreighter.TickerRegistry = (*TickerRegistry)(nil)
+var _ tapfreighter.TickerMetaSource = (*TickerRegistry)(nil)
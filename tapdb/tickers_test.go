@@ -0,0 +1,149 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTickerRegistry tests that tickers are resolved from the meta data of the
+// known assets, that collisions are detected and that pinning a ticker in the
+// registry takes precedence.
+func TestTickerRegistry(t *testing.T) {
+	t.Parallel()
+
+	db := NewTestDB(t)
+	registry := NewTickerRegistry(NewTransactionExecutor(
+		db, func(tx *sql.Tx) TickerStore {
+			return db.WithTx(tx)
+		},
+	), clock.NewTestClock(time.Now()))
+	resolver := tapfreighter.NewTickerResolver(registry, registry)
+	ctx := context.Background()
+
+	// issueAsset inserts the issuance proof of a new asset with the given
+	// meta data into a universe, which also stores the meta data.
+	issueAsset := func(groupKey *btcec.PublicKey,
+		meta *proof.MetaReveal) asset.Genesis {
+
+		t.Helper()
+
+		id := randUniverseID(t, groupKey != nil)
+		id.GroupKey = groupKey
+
+		assetGen := asset.RandGenesis(t, asset.Normal)
+		assetGen.MetaHash = meta.MetaHash()
+		id.AssetID = assetGen.ID()
+
+		baseUniverse, _ := newTestUniverseWithDb(db.BaseDB, id)
+		leaf := randMintingLeaf(t, assetGen, groupKey)
+		_, err := baseUniverse.RegisterIssuance(
+			ctx, randLeafKey(t), &leaf, meta,
+		)
+		require.NoError(t, err)
+
+		return assetGen
+	}
+	jsonMeta := func(data string) *proof.MetaReveal {
+		return &proof.MetaReveal{
+			Type: proof.MetaJson,
+			Data: []byte(data),
+		}
+	}
+
+	// Two different assets declare the same ticker, only differing in
+	// case, while two assets of the same group declare another ticker.
+	usdt1 := issueAsset(nil, jsonMeta(`{"ticker": "usdt"}`))
+	usdt2 := issueAsset(nil, jsonMeta(`{"ticker": "USDT"}`))
+
+	groupPriv, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	groupKey := groupPriv.PubKey()
+	issueAsset(groupKey, jsonMeta(`{"ticker": "gold"}`))
+	issueAsset(groupKey, jsonMeta(`{"ticker": "GOLD", "issuer": "x"}`))
+
+	// Meta data that isn't JSON or doesn't declare a ticker is ignored.
+	issueAsset(nil, &proof.MetaReveal{
+		Type: proof.MetaOpaque,
+		Data: []byte(`{"ticker": "USDT"}`),
+	})
+	issueAsset(nil, jsonMeta(`{"decimal_display": 2}`))
+
+	metaTickers, err := registry.MetaTickers(ctx, "USDT")
+	require.NoError(t, err)
+	require.Len(t, metaTickers, 2)
+
+	_, err = resolver.Resolve(ctx, "usdt")
+	require.ErrorIs(t, err, tapfreighter.ErrTickerCollision)
+
+	_, err = resolver.Resolve(ctx, "unknown")
+	require.ErrorIs(t, err, tapfreighter.ErrTickerNotFound)
+
+	_, err = resolver.Resolve(ctx, "not a ticker")
+	require.ErrorIs(t, err, tapfreighter.ErrTickerInvalid)
+
+	// The assets of the same group resolve to the group.
+	gold, err := resolver.Resolve(ctx, " Gold ")
+	require.NoError(t, err)
+	require.Equal(t, "GOLD", gold.Ticker)
+	require.Equal(t, tapfreighter.TickerSourceMeta, gold.Source)
+	require.Equal(
+		t, schnorr.SerializePubKey(groupKey),
+		schnorr.SerializePubKey(gold.GroupKey),
+	)
+	require.True(t, gold.Matches(asset.RandID(t), groupKey))
+	require.False(t, gold.Matches(usdt1.ID(), nil))
+
+	// Pinning the ticker in the registry resolves the collision.
+	err = registry.UpsertTicker(ctx, &tapfreighter.AssetTicker{
+		Ticker:  "usdt",
+		AssetID: usdt1.ID(),
+	})
+	require.NoError(t, err)
+
+	usdt, err := resolver.Resolve(ctx, "USDT")
+	require.NoError(t, err)
+	require.Equal(t, tapfreighter.TickerSourceRegistry, usdt.Source)
+	require.Equal(t, usdt1.ID(), usdt.AssetID)
+	require.Nil(t, usdt.GroupKey)
+	require.True(t, usdt.Matches(usdt1.ID(), nil))
+	require.False(t, usdt.Matches(usdt2.ID(), nil))
+
+	// A ticker can also be pinned to a group and re-pinned to another
+	// asset.
+	err = registry.UpsertTicker(ctx, &tapfreighter.AssetTicker{
+		Ticker:   "xau",
+		GroupKey: groupKey,
+	})
+	require.NoError(t, err)
+	err = registry.UpsertTicker(ctx, &tapfreighter.AssetTicker{
+		Ticker:  "usdt",
+		AssetID: usdt2.ID(),
+	})
+	require.NoError(t, err)
+
+	tickers, err := registry.QueryTickers(ctx)
+	require.NoError(t, err)
+	require.Len(t, tickers, 2)
+	require.Equal(t, "USDT", tickers[0].Ticker)
+	require.Equal(t, usdt2.ID(), tickers[0].AssetID)
+	require.Equal(t, "XAU", tickers[1].Ticker)
+	require.True(t, tickers[1].GroupKey.IsEqual(groupKey))
+
+	// Once the pinned ticker is removed, the collision is detected again.
+	require.NoError(t, registry.DeleteTicker(ctx, "USDT"))
+	err = registry.DeleteTicker(ctx, "USDT")
+	require.ErrorIs(t, err, tapfreighter.ErrTickerNotFound)
+
+	_, err = resolver.Resolve(ctx, "usdt")
+	require.ErrorIs(t, err, tapfreighter.ErrTickerCollision)
+}
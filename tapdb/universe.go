@@ -6,6 +6,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/wire"
@@ -50,6 +51,20 @@ type (
 
 	// DeleteMultiverseLeaf is used to delete a multiverse leaf.
 	DeleteMultiverseLeaf = sqlc.DeleteMultiverseLeafParams
+
+	// DeleteUniverseLeaf is used to delete a single universe leaf.
+	DeleteUniverseLeaf = sqlc.DeleteUniverseLeafParams
+
+	// DeleteLeafProofSyncLog is used to delete the proof sync log entries
+	// of a single universe leaf.
+	DeleteLeafProofSyncLog = sqlc.DeleteLeafProofSyncLogParams
+
+	// NewQuarantinedLeaf is used to insert a quarantined universe leaf.
+	NewQuarantinedLeaf = sqlc.InsertQuarantinedLeafParams
+
+	// QuarantinedLeaf is a universe leaf that was moved out of its
+	// universe tree.
+	QuarantinedLeaf = sqlc.UniverseQuarantinedLeafe
 )
 
 // BaseUniverseStore is the main interface for the Taproot Asset universe store.
@@ -80,6 +95,10 @@ type BaseUniverseStore interface {
 	// DeleteUniverseEvents is used to delete a universe sync event.
 	DeleteUniverseEvents(ctx context.Context, namespace string) error
 
+	// DeleteUniverseEventsDaily is used to delete the downsampled daily
+	// events of a universe.
+	DeleteUniverseEventsDaily(ctx context.Context, namespace string) error
+
 	// FetchUniverseRoot fetches the root of a universe based on the
 	// namespace key, which is a function of the asset ID and the group
 	// key.
@@ -110,6 +129,23 @@ type BaseUniverseStore interface {
 	// DeleteMultiverseLeaf deletes a multiverse leaf from the database.
 	DeleteMultiverseLeaf(ctx context.Context,
 		arg DeleteMultiverseLeaf) error
+
+	// DeleteUniverseLeaf deletes a single leaf of a universe tree.
+	DeleteUniverseLeaf(ctx context.Context, arg DeleteUniverseLeaf) error
+
+	// DeleteLeafProofSyncLog deletes all proof sync log entries that
+	// reference the given universe leaf.
+	DeleteLeafProofSyncLog(ctx context.Context,
+		arg DeleteLeafProofSyncLog) error
+
+	// InsertQuarantinedLeaf stores a leaf that was moved out of its
+	// universe tree.
+	InsertQuarantinedLeaf(ctx context.Context, arg NewQuarantinedLeaf) error
+
+	// QueryQuarantinedLeaves returns all quarantined leaves of the
+	// universe with the given namespace.
+	QueryQuarantinedLeaves(ctx context.Context,
+		namespaceRoot string) ([]QuarantinedLeaf, error)
 }
 
 // BaseUniverseStoreOptions is the set of options for universe tree queries.
@@ -434,14 +470,35 @@ func universeUpsertProofLeaf(ctx context.Context, dbTx BaseUniverseStore,
 		return nil, err
 	}
 
+	// A proof without a block height has an unknown anchor height, which
+	// we store as NULL so the leaf matches any block height range.
+	var blockHeight sql.NullInt32
+	if leafProof.BlockHeight != 0 {
+		blockHeight = sqlInt32(leafProof.BlockHeight)
+	}
+
+	extensions, err := leaf.Extensions.Bytes()
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode leaf extensions: %w",
+			err)
+	}
+
+	// The amount and burn flag are stored next to the leaf, as the sum of
+	// a transfer leaf in the tree doesn't reflect the amount, and they're
+	// needed to chart the supply changes of an asset over time.
 	scriptKeyBytes := schnorr.SerializePubKey(key.ScriptKey.PubKey)
 	err = dbTx.UpsertUniverseLeaf(ctx, UpsertUniverseLeaf{
-		AssetGenesisID:    assetGenID,
-		ScriptKeyBytes:    scriptKeyBytes,
-		UniverseRootID:    universeRootID,
-		LeafNodeKey:       smtKey[:],
-		LeafNodeNamespace: namespace,
-		MintingPoint:      mintingPointBytes,
+		AssetGenesisID:     assetGenID,
+		ScriptKeyBytes:     scriptKeyBytes,
+		UniverseRootID:     universeRootID,
+		LeafNodeKey:        smtKey[:],
+		LeafNodeNamespace:  namespace,
+		MintingPoint:       mintingPointBytes,
+		BlockHeight:        blockHeight,
+		Extensions:         extensions,
+		InsertionTimestamp: sqlInt64(time.Now().UTC().Unix()),
+		Amount:             sqlInt64(leafProof.Asset.Amount),
+		IsBurn:             leafProof.Asset.IsBurn(),
 	})
 	if err != nil {
 		return nil, err
@@ -586,6 +643,67 @@ func universeFetchProofLeaf(ctx context.Context,
 	id universe.Identifier, universeKey universe.LeafKey,
 	dbTx BaseUniverseStore) ([]*universe.Proof, error) {
 
+	// First, we'll make a new instance of the universe tree, as we'll query
+	// it directly to obtain the set of leaves we care about.
+	universeTree := mssmt.NewCompactedTree(
+		newTreeStoreWrapperTx(dbTx, id.String()),
+	)
+
+	// Each response will include a merkle proof of inclusion for the root,
+	// so we'll obtain that now.
+	rootNode, err := universeTree.Root(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return treeFetchProofLeaf(
+		ctx, id, universeTree, rootNode, universeKey, dbTx,
+	)
+}
+
+// universeFetchProofLeaves returns the proof leaves for each of the given
+// universe leaf keys of the target universe, in the same order as the keys.
+// The universe tree root is only obtained once for the whole batch. If no
+// proof is found for any of the keys, universe.ErrNoUniverseProofFound is
+// returned.
+//
+// NOTE: This function accepts a database transaction and is called when making
+// broader DB updates.
+func universeFetchProofLeaves(ctx context.Context, id universe.Identifier,
+	universeKeys []universe.LeafKey,
+	dbTx BaseUniverseStore) ([][]*universe.Proof, error) {
+
+	universeTree := mssmt.NewCompactedTree(
+		newTreeStoreWrapperTx(dbTx, id.String()),
+	)
+	rootNode, err := universeTree.Root(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	proofs := make([][]*universe.Proof, len(universeKeys))
+	for i, universeKey := range universeKeys {
+		proofs[i], err = treeFetchProofLeaf(
+			ctx, id, universeTree, rootNode, universeKey, dbTx,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return proofs, nil
+}
+
+// treeFetchProofLeaf returns the proof leaves for the target universe key
+// from the given universe tree with the given root.
+//
+// If the given universe leaf key doesn't have a script key specified, then a
+// proof will be returned for each minting outpoint.
+func treeFetchProofLeaf(ctx context.Context, id universe.Identifier,
+	universeTree mssmt.Tree, rootNode mssmt.Node,
+	universeKey universe.LeafKey,
+	dbTx BaseUniverseStore) ([]*universe.Proof, error) {
+
 	namespace := id.String()
 
 	// Depending on the universeKey, we'll either be fetching the details of
@@ -604,19 +722,6 @@ func universeFetchProofLeaf(ctx context.Context,
 
 	var proofs []*universe.Proof
 
-	// First, we'll make a new instance of the universe tree, as we'll query
-	// it directly to obtain the set of leaves we care about.
-	universeTree := mssmt.NewCompactedTree(
-		newTreeStoreWrapperTx(dbTx, namespace),
-	)
-
-	// Each response will include a merkle proof of inclusion for the root,
-	// so we'll obtain that now.
-	rootNode, err := universeTree.Root(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	// Now that we have the tree, we'll query the set of Universe leaves we
 	// have directly to determine which ones we care about.
 	//
@@ -673,6 +778,13 @@ func universeFetchProofLeaf(ctx context.Context,
 			return fmt.Errorf("unable to decode proof: %w", err)
 		}
 
+		extensions, err := universe.DecodeLeafExtensions(
+			bytes.NewReader(leaf.Extensions),
+		)
+		if err != nil {
+			return err
+		}
+
 		issuanceProof := &universe.Proof{
 			LeafKey:                universeKey,
 			UniverseRoot:           rootNode,
@@ -681,9 +793,10 @@ func universeFetchProofLeaf(ctx context.Context,
 				GenesisWithGroup: universe.GenesisWithGroup{
 					Genesis: leafAssetGen,
 				},
-				RawProof: leaf.GenesisProof,
-				Asset:    &leafAsset,
-				Amt:      uint64(leaf.SumAmt),
+				RawProof:   leaf.GenesisProof,
+				Asset:      &leafAsset,
+				Amt:        uint64(leaf.SumAmt),
+				Extensions: extensions,
 			},
 		}
 		if id.GroupKey != nil {
@@ -718,9 +831,11 @@ func mintingKeys(ctx context.Context, dbTx BaseUniverseStore,
 
 	universeKeys, err := dbTx.FetchUniverseKeys(
 		ctx, UniverseLeafKeysQuery{
-			Namespace:     namespace,
-			SortDirection: sqlInt16(q.SortDirection),
-			NumOffset:     q.Offset,
+			Namespace:      namespace,
+			MinBlockHeight: sqlOptInt32(q.MinBlockHeight),
+			MaxBlockHeight: sqlOptInt32(q.MaxBlockHeight),
+			SortDirection:  sqlInt16(q.SortDirection),
+			NumOffset:      q.Offset,
 			NumLimit: func() int32 {
 				if q.Limit == 0 {
 					return universe.MaxPageSize
@@ -831,15 +946,23 @@ func (b *BaseUniverseTree) MintingLeaves(
 					err)
 			}
 
+			extensions, err := universe.DecodeLeafExtensions(
+				bytes.NewReader(dbLeaf.Extensions),
+			)
+			if err != nil {
+				return err
+			}
+
 			// Now that we have the leaves, we'll encode them all
 			// into the set of minting leaves.
 			leaf := universe.Leaf{
 				GenesisWithGroup: universe.GenesisWithGroup{
 					Genesis: leafAssetGen,
 				},
-				RawProof: dbLeaf.GenesisProof,
-				Asset:    &genProof.Asset,
-				Amt:      uint64(dbLeaf.SumAmt),
+				RawProof:   dbLeaf.GenesisProof,
+				Asset:      &genProof.Asset,
+				Amt:        uint64(dbLeaf.SumAmt),
+				Extensions: extensions,
 			}
 			if b.id.GroupKey != nil {
 				leaf.GroupKey = &asset.GroupKey{
@@ -898,6 +1021,11 @@ func deleteUniverseTree(ctx context.Context,
 		return fmt.Errorf("failed to delete universe events: "+
 			"%w", err)
 	}
+	err = db.DeleteUniverseEventsDaily(ctx, namespace)
+	if err != nil {
+		return fmt.Errorf("failed to delete daily universe "+
+			"events: %w", err)
+	}
 
 	// Delete the universe root from the universe table.
 	err = db.DeleteUniverseRoot(ctx, namespace)
@@ -62,6 +62,22 @@ type (
 
 	// QueryUniServersParams is used to query for universe servers.
 	QueryUniServersParams = sqlc.QueryUniverseServersParams
+
+	// UpsertFedUniSyncScheduleParams is used to set the sync interval of
+	// a single universe.
+	UpsertFedUniSyncScheduleParams = sqlc.UpsertFederationUniSyncScheduleParams
+
+	// FedUniSyncSchedule is the universe specific sync schedule returned
+	// from a query.
+	FedUniSyncSchedule = sqlc.FederationUniSyncSchedule
+
+	// UpsertFedServerSyncScheduleParams is used to set the sync interval
+	// of a single federation server.
+	UpsertFedServerSyncScheduleParams = sqlc.UpsertFederationServerSyncScheduleParams
+
+	// FedServerSyncSchedule is the server specific sync schedule returned
+	// from a query.
+	FedServerSyncSchedule = sqlc.FederationServerSyncSchedule
 )
 
 var (
@@ -123,6 +139,36 @@ type FederationSyncConfigStore interface {
 	// federation sync configs.
 	QueryFederationUniSyncConfigs(ctx context.Context) ([]FedUniSyncConfigs,
 		error)
+
+	// UpsertFederationUniSyncSchedule inserts or updates the sync interval
+	// of a single universe.
+	UpsertFederationUniSyncSchedule(ctx context.Context,
+		arg UpsertFedUniSyncScheduleParams) error
+
+	// DeleteFederationUniSyncSchedule removes the sync interval of the
+	// universe with the given namespace.
+	DeleteFederationUniSyncSchedule(ctx context.Context,
+		namespace string) error
+
+	// QueryFederationUniSyncSchedules returns all universe specific sync
+	// schedules.
+	QueryFederationUniSyncSchedules(
+		ctx context.Context) ([]FedUniSyncSchedule, error)
+
+	// UpsertFederationServerSyncSchedule inserts or updates the sync
+	// interval of a single federation server.
+	UpsertFederationServerSyncSchedule(ctx context.Context,
+		arg UpsertFedServerSyncScheduleParams) error
+
+	// DeleteFederationServerSyncSchedule removes the sync interval of the
+	// federation server with the given host.
+	DeleteFederationServerSyncSchedule(ctx context.Context,
+		serverHost string) error
+
+	// QueryFederationServerSyncSchedules returns all server specific sync
+	// schedules.
+	QueryFederationServerSyncSchedules(
+		ctx context.Context) ([]FedServerSyncSchedule, error)
 }
 
 // UniverseServerStore is used to manage the set of Universe servers as part
@@ -189,11 +235,42 @@ type UniverseFederationDB struct {
 
 	globalCfg *atomic.Pointer[globalSyncCfgs]
 	assetCfgs *atomic.Pointer[assetSyncCfgs]
+
+	opts federationOpts
+}
+
+// federationOpts are the options that can be used to modify the way the
+// UniverseFederationDB loads its data.
+type federationOpts struct {
+	// strictUniIDs, if set, makes sure the universe IDs loaded from the
+	// database are consistent, instead of silently preferring the group
+	// key.
+	strictUniIDs bool
+}
+
+// UniverseFederationOption is a functional option that can be used to modify
+// the way the UniverseFederationDB is created.
+type UniverseFederationOption func(*federationOpts)
+
+// WithStrictUniIDs is a functional option that makes the UniverseFederationDB
+// return an error if a universe ID loaded from the database is malformed or
+// contradicts the leaf it is loaded with, instead of masking the data
+// corruption.
+func WithStrictUniIDs() UniverseFederationOption {
+	return func(o *federationOpts) {
+		o.strictUniIDs = true
+	}
 }
 
 // NewUniverseFederationDB makes a new Universe federation DB.
 func NewUniverseFederationDB(db BatchedUniverseServerStore,
-	clock clock.Clock) *UniverseFederationDB {
+	clock clock.Clock,
+	options ...UniverseFederationOption) *UniverseFederationDB {
+
+	var opts federationOpts
+	for _, o := range options {
+		o(&opts)
+	}
 
 	var (
 		globalCfgPtr atomic.Pointer[globalSyncCfgs]
@@ -208,7 +285,25 @@ func NewUniverseFederationDB(db BatchedUniverseServerStore,
 		clock:     clock,
 		globalCfg: &globalCfgPtr,
 		assetCfgs: &assetCfgsPtr,
+		opts:      opts,
+	}
+}
+
+// newUniID creates a universe ID from the raw arguments loaded from the
+// database, using the strict constructor if strict universe IDs are enabled.
+func (u *UniverseFederationDB) newUniID(assetIDBytes []byte,
+	groupKeyBytes []byte, proofTypeStr string) (universe.Identifier,
+	error) {
+
+	if u.opts.strictUniIDs {
+		return universe.NewUniIDFromRawArgsStrict(
+			assetIDBytes, groupKeyBytes, proofTypeStr,
+		)
 	}
+
+	return universe.NewUniIDFromRawArgs(
+		assetIDBytes, groupKeyBytes, proofTypeStr,
+	)
 }
 
 // UniverseServers returns the set of servers in the federation.
@@ -226,13 +321,23 @@ func (u *UniverseFederationDB) UniverseServers(
 			return err
 		}
 
-		uniServers = fn.Map(servers,
-			func(s sqlc.UniverseServer) universe.ServerAddr {
-				return universe.NewServerAddr(
-					s.ID, s.ServerHost,
-				)
-			},
-		)
+		uniServers = make([]universe.ServerAddr, 0, len(servers))
+		for _, s := range servers {
+			addr := universe.NewServerAddr(s.ID, s.ServerHost)
+
+			if len(s.IdentityKey) != 0 {
+				key, err := btcec.ParsePubKey(s.IdentityKey)
+				if err != nil {
+					return fmt.Errorf("invalid identity "+
+						"key of server %v: %w",
+						s.ServerHost, err)
+				}
+
+				addr.IdentityKey = fn.Some(*key)
+			}
+
+			uniServers = append(uniServers, addr)
+		}
 
 		return nil
 	})
@@ -250,6 +355,12 @@ func (u *UniverseFederationDB) AddServers(ctx context.Context,
 			addr := NewUniverseServer{
 				ServerHost:   a.HostStr(),
 				LastSyncTime: time.Now(),
+				IdentityKey: fn.MapOptionZ(
+					a.IdentityKey,
+					func(k btcec.PublicKey) []byte {
+						return k.SerializeCompressed()
+					},
+				),
 			}
 			return db.InsertUniverseServer(ctx, addr)
 		})
@@ -308,6 +419,32 @@ func (u *UniverseFederationDB) LogNewSyncs(ctx context.Context,
 	})
 }
 
+// ServerSyncTimes returns the last time we synced with each server of the
+// federation, keyed by the server's host string.
+func (u *UniverseFederationDB) ServerSyncTimes(
+	ctx context.Context) (map[string]time.Time, error) {
+
+	syncTimes := make(map[string]time.Time)
+
+	readTx := NewUniverseFederationReadTx()
+	dbErr := u.db.ExecTx(ctx, &readTx, func(db UniverseServerStore) error {
+		servers, err := db.QueryUniverseServers(
+			ctx, QueryUniServersParams{},
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, server := range servers {
+			syncTimes[server.ServerHost] = server.LastSyncTime.UTC()
+		}
+
+		return nil
+	})
+
+	return syncTimes, dbErr
+}
+
 // UpsertFederationProofSyncLog upserts a federation proof sync log entry for a
 // given universe server and proof.
 func (u *UniverseFederationDB) UpsertFederationProofSyncLog(
@@ -404,7 +541,7 @@ func (u *UniverseFederationDB) QueryFederationProofSyncLog(
 		for idx := range logEntries {
 			entry := logEntries[idx]
 
-			parsedLogEntry, err := fetchProofSyncLogEntry(
+			parsedLogEntry, err := u.fetchProofSyncLogEntry(
 				ctx, entry, db,
 			)
 			if err != nil {
@@ -463,7 +600,7 @@ func (u *UniverseFederationDB) FetchPendingProofsSyncLog(ctx context.Context,
 		for idx := range logEntries {
 			entry := logEntries[idx]
 
-			parsedLogEntry, err := fetchProofSyncLogEntry(
+			parsedLogEntry, err := u.fetchProofSyncLogEntry(
 				ctx, entry, db,
 			)
 			if err != nil {
@@ -483,7 +620,8 @@ func (u *UniverseFederationDB) FetchPendingProofsSyncLog(ctx context.Context,
 }
 
 // fetchProofSyncLogEntry returns a proof sync log entry given a DB row.
-func fetchProofSyncLogEntry(ctx context.Context, entry ProofSyncLogEntry,
+func (u *UniverseFederationDB) fetchProofSyncLogEntry(ctx context.Context,
+	entry ProofSyncLogEntry,
 	dbTx UniverseServerStore) (*universe.ProofSyncLogEntry, error) {
 
 	// Fetch asset genesis for the leaf.
@@ -551,14 +689,23 @@ func fetchProofSyncLogEntry(ctx context.Context, entry ProofSyncLogEntry,
 		return nil, err
 	}
 
-	uniID, err := universe.NewUniIDFromRawArgs(
-		entry.UniAssetID, entry.UniGroupKey,
-		entry.UniProofType,
+	uniID, err := u.newUniID(
+		entry.UniAssetID, entry.UniGroupKey, entry.UniProofType,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	// In strict mode, we also make sure the universe ID doesn't
+	// contradict the leaf that was synced.
+	if u.opts.strictUniIDs {
+		err := universe.ValidateUniIDLeaf(uniID, &leafAsset)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proof sync log entry: "+
+				"%w", err)
+		}
+	}
+
 	return &universe.ProofSyncLogEntry{
 		Timestamp:      entry.Timestamp,
 		SyncStatus:     status,
@@ -826,6 +973,143 @@ func (u *UniverseFederationDB) QueryFederationSyncConfigs(
 	return globalConfigs, uniConfigs, nil
 }
 
+// UpsertSyncSchedules upserts the given universe and server specific sync
+// schedules. A schedule with a zero sync interval is removed.
+func (u *UniverseFederationDB) UpsertSyncSchedules(ctx context.Context,
+	schedules *universe.SyncSchedules) error {
+
+	var writeTx UniverseFederationOptions
+	return u.db.ExecTx(ctx, &writeTx, func(db UniverseServerStore) error {
+		for _, schedule := range schedules.Universes {
+			uniID := schedule.UniverseID
+			namespace := uniID.String()
+
+			if schedule.SyncInterval == 0 {
+				err := db.DeleteFederationUniSyncSchedule(
+					ctx, namespace,
+				)
+				if err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			// The group key supersedes the asset ID.
+			var groupKey, assetIDBytes []byte
+			if uniID.GroupKey != nil {
+				groupKey = uniID.GroupKey.SerializeCompressed()
+			} else {
+				assetIDBytes = uniID.AssetID[:]
+			}
+
+			err := db.UpsertFederationUniSyncSchedule(
+				ctx, UpsertFedUniSyncScheduleParams{
+					Namespace: namespace,
+					AssetID:   assetIDBytes,
+					GroupKey:  groupKey,
+					ProofType: uniID.ProofType.String(),
+					SyncIntervalSecs: int64(
+						schedule.SyncInterval.Seconds(),
+					),
+				},
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, schedule := range schedules.Servers {
+			if schedule.SyncInterval == 0 {
+				err := db.DeleteFederationServerSyncSchedule(
+					ctx, schedule.ServerHost,
+				)
+				if err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			err := db.UpsertFederationServerSyncSchedule(
+				ctx, UpsertFedServerSyncScheduleParams{
+					ServerHost: schedule.ServerHost,
+					SyncIntervalSecs: int64(
+						schedule.SyncInterval.Seconds(),
+					),
+				},
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// QuerySyncSchedules returns the universe and server specific sync schedules.
+func (u *UniverseFederationDB) QuerySyncSchedules(
+	ctx context.Context) (*universe.SyncSchedules, error) {
+
+	var (
+		readTx    = NewUniverseFederationReadTx()
+		schedules universe.SyncSchedules
+	)
+	err := u.db.ExecTx(ctx, &readTx, func(db UniverseServerStore) error {
+		uniSchedules, err := db.QueryFederationUniSyncSchedules(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, schedule := range uniSchedules {
+			uniID, err := u.newUniID(
+				schedule.AssetID, schedule.GroupKey,
+				schedule.ProofType,
+			)
+			if err != nil {
+				return err
+			}
+
+			schedules.Universes = append(
+				schedules.Universes,
+				&universe.FedUniSyncSchedule{
+					UniverseID: uniID,
+					SyncInterval: time.Duration(
+						schedule.SyncIntervalSecs,
+					) * time.Second,
+				},
+			)
+		}
+
+		serverSchedules, err := db.QueryFederationServerSyncSchedules(
+			ctx,
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, schedule := range serverSchedules {
+			schedules.Servers = append(
+				schedules.Servers,
+				&universe.FedServerSyncSchedule{
+					ServerHost: schedule.ServerHost,
+					SyncInterval: time.Duration(
+						schedule.SyncIntervalSecs,
+					) * time.Second,
+				},
+			)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &schedules, nil
+}
+
 // Check at compile time that we implement the correct interfaces.
 var (
 	_ universe.FederationLog          = (*UniverseFederationDB)(nil)
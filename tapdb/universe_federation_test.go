@@ -85,6 +85,27 @@ func TestUniverseFederationCRUD(t *testing.T) {
 	addrToUpdate := addrs[rand.Int()%len(dbAddrs)]
 	err = fedDB.LogNewSyncs(ctx, addrToUpdate)
 	require.NoError(t, err)
+
+	// Finally, we'll add a server with an identity key, which should be
+	// returned as well.
+	keyAddr := universe.NewServerAddrFromStr("localhost:20000")
+	keyAddr.IdentityKey = fn.Some(*test.RandPubKey(t))
+	require.NoError(t, fedDB.AddServers(ctx, keyAddr))
+
+	dbAddrs, err = fedDB.UniverseServers(ctx)
+	require.NoError(t, err)
+
+	dbKeyAddr, err := fn.First(
+		fn.Map(dbAddrs, fn.Ptr[universe.ServerAddr]),
+		func(a *universe.ServerAddr) bool {
+			return a.HostStr() == keyAddr.HostStr()
+		},
+	)
+	require.NoError(t, err)
+	require.True(t, dbKeyAddr.IdentityKey.IsSome())
+	require.True(t, dbKeyAddr.IdentityKey.UnwrapToPtr().IsEqual(
+		keyAddr.IdentityKey.UnwrapToPtr(),
+	))
 }
 
 // TestFederationProofSyncLogCRUD tests that we can add, modify, and remove
@@ -365,6 +386,67 @@ func assertProofSyncLogLeaf(t *testing.T, actualLeaf universe.Leaf,
 	actualLeaf.Asset.DeepEqual(logLeaf.Asset)
 }
 
+// TestFederationProofSyncLogStrict tests that a proof sync log entry whose
+// universe ID contradicts its leaf is only rejected in strict mode.
+func TestFederationProofSyncLogStrict(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = context.Background()
+		db       = NewTestDB(t)
+		dbHandle = newDbHandleFromDb(db.BaseDB)
+		fedStore = dbHandle.UniverseFederationStore
+	)
+	strictStore := NewUniverseFederationDB(
+		fedStore.db, clock.NewDefaultClock(), WithStrictUniIDs(),
+	)
+
+	testAsset, testAnnotatedProof := dbHandle.AddRandomAssetProof(t)
+	uniProof := dbHandle.AddUniProofLeaf(t, testAsset, testAnnotatedProof)
+	uniID := universe.NewUniIDFromAsset(*testAsset)
+
+	servers := dbHandle.AddRandomServerAddrs(t, 1)
+	_, err := fedStore.UpsertFederationProofSyncLog(
+		ctx, uniID, uniProof.LeafKey, servers[0],
+		universe.SyncDirectionPush, universe.ProofSyncStatusPending,
+		false,
+	)
+	require.NoError(t, err)
+
+	// As long as the universe ID is consistent, the strict store returns
+	// the same entries.
+	syncDirectionPush := universe.SyncDirectionPush
+	entries, err := strictStore.FetchPendingProofsSyncLog(
+		ctx, &syncDirectionPush,
+	)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, uniID.String(), entries[0].UniID.String())
+
+	// We now corrupt the proof type of the universe root, so it
+	// contradicts the leaf.
+	wrongProofType := universe.ProofTypeTransfer
+	if uniID.ProofType == universe.ProofTypeTransfer {
+		wrongProofType = universe.ProofTypeIssuance
+	}
+	_, err = db.ExecContext(
+		ctx, "UPDATE universe_roots SET proof_type = $1",
+		wrongProofType.String(),
+	)
+	require.NoError(t, err)
+
+	// The default store masks the corruption, while the strict store
+	// surfaces it.
+	entries, err = fedStore.FetchPendingProofsSyncLog(
+		ctx, &syncDirectionPush,
+	)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	_, err = strictStore.FetchPendingProofsSyncLog(ctx, &syncDirectionPush)
+	require.ErrorIs(t, err, universe.ErrInconsistentUniID)
+}
+
 // TestFederationConfigDefault tests that we're able to fetch the default
 // federation config.
 func TestFederationConfigDefault(t *testing.T) {
@@ -513,3 +595,93 @@ func TestFederationConfigCRUD(t *testing.T) {
 	localCfg = fn.MakeSlice(groupNewCfg, assetCfg)
 	require.Equal(t, localCfg, dbLocalCfg)
 }
+
+// TestFederationSyncSchedulesCRUD tests that universe and server specific sync
+// schedules can be upserted, queried and removed.
+func TestFederationSyncSchedulesCRUD(t *testing.T) {
+	t.Parallel()
+
+	testClock := clock.NewTestClock(time.Now())
+	fedDB, _ := newTestFederationDb(t, testClock)
+
+	ctx := context.Background()
+
+	// Initially, there are no schedules.
+	schedules, err := fedDB.QuerySyncSchedules(ctx)
+	require.NoError(t, err)
+	require.Empty(t, schedules.Universes)
+	require.Empty(t, schedules.Servers)
+
+	groupID := universe.Identifier{
+		GroupKey:  test.RandPubKey(t),
+		ProofType: universe.ProofTypeIssuance,
+	}
+	assetID := universe.Identifier{
+		ProofType: universe.ProofTypeTransfer,
+	}
+	copy(assetID.AssetID[:], test.RandBytes(32))
+
+	err = fedDB.UpsertSyncSchedules(ctx, &universe.SyncSchedules{
+		Universes: []*universe.FedUniSyncSchedule{{
+			UniverseID:   groupID,
+			SyncInterval: time.Minute,
+		}, {
+			UniverseID:   assetID,
+			SyncInterval: 24 * time.Hour,
+		}},
+		Servers: []*universe.FedServerSyncSchedule{{
+			ServerHost:   "universe.example.com:10029",
+			SyncInterval: time.Hour,
+		}},
+	})
+	require.NoError(t, err)
+
+	// intervalsByID returns the sync interval of each scheduled universe
+	// by its namespace.
+	intervalsByID := func(
+		s *universe.SyncSchedules) map[string]time.Duration {
+
+		intervals := make(map[string]time.Duration)
+		for _, schedule := range s.Universes {
+			id := schedule.UniverseID
+			intervals[id.String()] = schedule.SyncInterval
+		}
+
+		return intervals
+	}
+
+	schedules, err = fedDB.QuerySyncSchedules(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]time.Duration{
+		groupID.String(): time.Minute,
+		assetID.String(): 24 * time.Hour,
+	}, intervalsByID(schedules))
+	require.Len(t, schedules.Servers, 1)
+	require.Equal(
+		t, "universe.example.com:10029",
+		schedules.Servers[0].ServerHost,
+	)
+	require.Equal(t, time.Hour, schedules.Servers[0].SyncInterval)
+
+	// Upserting an existing schedule updates its interval, while a zero
+	// interval removes it.
+	err = fedDB.UpsertSyncSchedules(ctx, &universe.SyncSchedules{
+		Universes: []*universe.FedUniSyncSchedule{{
+			UniverseID:   groupID,
+			SyncInterval: 5 * time.Minute,
+		}, {
+			UniverseID: assetID,
+		}},
+		Servers: []*universe.FedServerSyncSchedule{{
+			ServerHost: "universe.example.com:10029",
+		}},
+	})
+	require.NoError(t, err)
+
+	schedules, err = fedDB.QuerySyncSchedules(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]time.Duration{
+		groupID.String(): 5 * time.Minute,
+	}, intervalsByID(schedules))
+	require.Empty(t, schedules.Servers)
+}
@@ -0,0 +1,171 @@
+package tapdb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/universe"
+)
+
+// QuarantineLeaf removes the leaf with the given key from the target universe
+// and stores it, together with the reason, in the quarantine table. The
+// universe root and the multiverse leaf that commits to it are updated
+// accordingly. If the removed leaf was the last leaf of the universe, the
+// whole universe is deleted.
+//
+// NOTE: This implements the universe.LeafQuarantine interface.
+func (b *MultiverseStore) QuarantineLeaf(ctx context.Context,
+	id universe.Identifier, key universe.LeafKey, reason string) error {
+
+	if key.ScriptKey == nil || key.ScriptKey.PubKey == nil {
+		return fmt.Errorf("leaf key has no script key")
+	}
+
+	mintingPointBytes, err := encodeOutpoint(key.OutPoint)
+	if err != nil {
+		return err
+	}
+
+	scriptKeyBytes := schnorr.SerializePubKey(key.ScriptKey.PubKey)
+
+	var (
+		writeTx   BaseUniverseStoreOptions
+		namespace = id.String()
+		smtKey    = key.UniverseKey()
+	)
+	quarantineLeaf := func(dbTx BaseMultiverseStore) error {
+		universeTree := mssmt.NewCompactedTree(
+			newTreeStoreWrapperTx(dbTx, namespace),
+		)
+
+		// We keep the content of the leaf node, if it can still be
+		// read from the tree, so it can be inspected later.
+		quarantined := NewQuarantinedLeaf{
+			NamespaceRoot:  namespace,
+			LeafNodeKey:    smtKey[:],
+			MintingPoint:   mintingPointBytes,
+			ScriptKeyBytes: scriptKeyBytes,
+			Reason:         reason,
+			QuarantinedAt:  time.Now().UTC(),
+		}
+		leafNode, err := universeTree.Get(ctx, smtKey)
+		if err != nil {
+			return fmt.Errorf("unable to fetch leaf: %w", err)
+		}
+		if !leafNode.IsEmpty() {
+			quarantined.RawProof = leafNode.Value
+			quarantined.LeafSum = sqlInt64(leafNode.NodeSum())
+		}
+
+		_, err = universeTree.Delete(ctx, smtKey)
+		if err != nil {
+			return fmt.Errorf("unable to delete leaf: %w", err)
+		}
+
+		// The sync log references the leaf, so it needs to be removed
+		// before the leaf itself.
+		err = dbTx.DeleteLeafProofSyncLog(ctx, DeleteLeafProofSyncLog{
+			Namespace:   namespace,
+			LeafNodeKey: smtKey[:],
+		})
+		if err != nil {
+			return fmt.Errorf("unable to delete proof sync log: %w",
+				err)
+		}
+
+		err = dbTx.DeleteUniverseLeaf(ctx, DeleteUniverseLeaf{
+			Namespace:   namespace,
+			LeafNodeKey: smtKey[:],
+		})
+		if err != nil {
+			return fmt.Errorf("unable to delete universe leaf: %w",
+				err)
+		}
+
+		err = dbTx.InsertQuarantinedLeaf(ctx, quarantined)
+		if err != nil {
+			return fmt.Errorf("unable to insert quarantined leaf: "+
+				"%w", err)
+		}
+
+		universeRoot, err := universeTree.Root(ctx)
+		if err != nil {
+			return err
+		}
+
+		// Finally, the multiverse leaf needs to commit to the new
+		// universe root.
+		multiverseNS, err := namespaceForProof(id.ProofType)
+		if err != nil {
+			return err
+		}
+		multiverseTree := mssmt.NewCompactedTree(
+			newTreeStoreWrapperTx(dbTx, multiverseNS),
+		)
+		multiverseLeafKey := id.Bytes()
+
+		// If this was the last leaf, there's nothing left of the
+		// universe, so we remove it completely.
+		if universeRoot.NodeHash() == mssmt.EmptyTreeRootHash {
+			_, err = multiverseTree.Delete(ctx, multiverseLeafKey)
+			if err != nil {
+				return err
+			}
+
+			return deleteUniverseTree(ctx, dbTx, id)
+		}
+
+		universeRootHash := universeRoot.NodeHash()
+		assetGroupSum := universeRoot.NodeSum()
+		if id.ProofType == universe.ProofTypeIssuance {
+			assetGroupSum = 1
+		}
+
+		_, err = multiverseTree.Insert(
+			ctx, multiverseLeafKey, mssmt.NewLeafNode(
+				universeRootHash[:], assetGroupSum,
+			),
+		)
+		return err
+	}
+	dbErr := b.db.ExecTx(ctx, &writeTx, quarantineLeaf)
+	if dbErr != nil {
+		return dbErr
+	}
+
+	// Wipe the cache items from this node.
+	b.rootNodeCache.wipeCache()
+
+	idStr := treeID(id.String())
+	b.proofCache.Delete(idStr)
+	b.leafKeysCache.wipeCache(idStr)
+
+	return nil
+}
+
+// QuarantinedLeaves returns all leaves that were quarantined from the universe
+// with the given identifier.
+func (b *MultiverseStore) QuarantinedLeaves(ctx context.Context,
+	id universe.Identifier) ([]QuarantinedLeaf, error) {
+
+	var leaves []QuarantinedLeaf
+
+	readTx := NewBaseUniverseReadTx()
+	dbErr := b.db.ExecTx(ctx, &readTx, func(db BaseMultiverseStore) error {
+		var err error
+		leaves, err = db.QueryQuarantinedLeaves(ctx, id.String())
+		return err
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return leaves, nil
+}
+
+// A compile-time assertion to ensure MultiverseStore meets the
+// universe.LeafQuarantine interface.
+var _ universe.LeafQuarantine = (*MultiverseStore)(nil)
@@ -0,0 +1,220 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+type (
+	// NewUniverseSnapshot is used to insert or update an imported
+	// universe snapshot.
+	NewUniverseSnapshot = sqlc.UpsertUniverseSnapshotParams
+
+	// UniverseSnapshotVerified is used to mark an imported universe
+	// snapshot as verified.
+	UniverseSnapshotVerified = sqlc.MarkUniverseSnapshotVerifiedParams
+
+	// UniverseSnapshot is an imported universe snapshot.
+	UniverseSnapshot = sqlc.UniverseSnapshot
+)
+
+// UniverseSnapshotStore is the set of queries needed to keep track of
+// imported universe snapshots.
+type UniverseSnapshotStore interface {
+	// UpsertUniverseSnapshot inserts or updates an imported universe
+	// snapshot.
+	UpsertUniverseSnapshot(ctx context.Context,
+		arg NewUniverseSnapshot) error
+
+	// QueryPendingUniverseSnapshots returns all imported universe
+	// snapshots that weren't verified yet.
+	QueryPendingUniverseSnapshots(
+		ctx context.Context) ([]UniverseSnapshot, error)
+
+	// MarkUniverseSnapshotVerified marks an imported universe snapshot as
+	// verified.
+	MarkUniverseSnapshotVerified(ctx context.Context,
+		arg UniverseSnapshotVerified) error
+}
+
+// UniverseSnapshotTxOptions defines the set of db txn options the
+// UniverseSnapshotStore understands.
+type UniverseSnapshotTxOptions struct {
+	// readOnly governs if a read only transaction is needed or not.
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (u *UniverseSnapshotTxOptions) ReadOnly() bool {
+	return u.readOnly
+}
+
+// NewUniverseSnapshotReadTx creates a new read transaction option set.
+func NewUniverseSnapshotReadTx() UniverseSnapshotTxOptions {
+	return UniverseSnapshotTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedUniverseSnapshotStore is the main storage interface for imported
+// universe snapshots. It supports all the basic queries as well as running
+// the set of queries in a single database transaction.
+type BatchedUniverseSnapshotStore interface {
+	UniverseSnapshotStore
+
+	BatchedTx[UniverseSnapshotStore]
+}
+
+// UniverseSnapshots is a database backed implementation of the
+// universe.SnapshotStore interface.
+type UniverseSnapshots struct {
+	db BatchedUniverseSnapshotStore
+
+	clock clock.Clock
+}
+
+// NewUniverseSnapshots creates a new database backed universe snapshot store.
+func NewUniverseSnapshots(db BatchedUniverseSnapshotStore,
+	clock clock.Clock) *UniverseSnapshots {
+
+	return &UniverseSnapshots{
+		db:    db,
+		clock: clock,
+	}
+}
+
+// AddSnapshot records a newly imported snapshot as pending verification.
+//
+// NOTE: This is part of the universe.SnapshotStore interface.
+func (u *UniverseSnapshots) AddSnapshot(ctx context.Context,
+	snapshot universe.SnapshotRecord) error {
+
+	var groupKey []byte
+	if snapshot.ID.GroupKey != nil {
+		groupKey = schnorr.SerializePubKey(snapshot.ID.GroupKey)
+	}
+
+	rootHash := snapshot.Root.NodeHash()
+
+	var writeTx UniverseSnapshotTxOptions
+	return u.db.ExecTx(ctx, &writeTx, func(q UniverseSnapshotStore) error {
+		return q.UpsertUniverseSnapshot(ctx, NewUniverseSnapshot{
+			NamespaceRoot: snapshot.ID.String(),
+			AssetID:       snapshot.ID.AssetID[:],
+			GroupKey:      groupKey,
+			ProofType:     snapshot.ID.ProofType.String(),
+			Height:        int32(snapshot.Height),
+			RootHash:      rootHash[:],
+			RootSum:       int64(snapshot.Root.NodeSum()),
+			ImportedAt:    snapshot.ImportedAt.UTC(),
+		})
+	})
+}
+
+// PendingSnapshots returns all imported snapshots that weren't verified yet.
+//
+// NOTE: This is part of the universe.SnapshotStore interface.
+func (u *UniverseSnapshots) PendingSnapshots(
+	ctx context.Context) ([]universe.SnapshotRecord, error) {
+
+	var (
+		snapshots []universe.SnapshotRecord
+		readTx    = NewUniverseSnapshotReadTx()
+	)
+	err := u.db.ExecTx(ctx, &readTx, func(q UniverseSnapshotStore) error {
+		dbSnapshots, err := q.QueryPendingUniverseSnapshots(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to query snapshots: %w", err)
+		}
+
+		snapshots = make(
+			[]universe.SnapshotRecord, 0, len(dbSnapshots),
+		)
+		for _, dbSnapshot := range dbSnapshots {
+			snapshot, err := parseUniverseSnapshot(dbSnapshot)
+			if err != nil {
+				return err
+			}
+
+			snapshots = append(snapshots, snapshot)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshots, nil
+}
+
+// MarkSnapshotVerified marks the snapshot of the given universe at the given
+// height as verified.
+//
+// NOTE: This is part of the universe.SnapshotStore interface.
+func (u *UniverseSnapshots) MarkSnapshotVerified(ctx context.Context,
+	id universe.Identifier, height uint32, numInvalid int) error {
+
+	var writeTx UniverseSnapshotTxOptions
+	return u.db.ExecTx(ctx, &writeTx, func(q UniverseSnapshotStore) error {
+		return q.MarkUniverseSnapshotVerified(
+			ctx, UniverseSnapshotVerified{
+				VerifiedAt: sql.NullTime{
+					Time:  u.clock.Now().UTC(),
+					Valid: true,
+				},
+				NumInvalidLeaves: int64(numInvalid),
+				NamespaceRoot:    id.String(),
+				Height:           int32(height),
+			},
+		)
+	})
+}
+
+// parseUniverseSnapshot converts a database snapshot into a snapshot record.
+func parseUniverseSnapshot(
+	dbSnapshot UniverseSnapshot) (universe.SnapshotRecord, error) {
+
+	proofType, err := universe.ParseStrProofType(dbSnapshot.ProofType)
+	if err != nil {
+		return universe.SnapshotRecord{}, err
+	}
+
+	id := universe.Identifier{
+		ProofType: proofType,
+	}
+	copy(id.AssetID[:], dbSnapshot.AssetID)
+
+	if len(dbSnapshot.GroupKey) != 0 {
+		id.GroupKey, err = schnorr.ParsePubKey(dbSnapshot.GroupKey)
+		if err != nil {
+			return universe.SnapshotRecord{}, fmt.Errorf("unable "+
+				"to parse group key: %w", err)
+		}
+	}
+
+	var nodeHash mssmt.NodeHash
+	copy(nodeHash[:], dbSnapshot.RootHash)
+
+	return universe.SnapshotRecord{
+		ID:     id,
+		Height: uint32(dbSnapshot.Height),
+		Root: mssmt.NewComputedBranch(
+			nodeHash, uint64(dbSnapshot.RootSum),
+		),
+		ImportedAt: dbSnapshot.ImportedAt.UTC(),
+	}, nil
+}
+
+// A compile-time assertion to make sure UniverseSnapshots satisfies the
+// universe.SnapshotStore interface.
+var _ universe.SnapshotStore = (*UniverseSnapshots)(nil)
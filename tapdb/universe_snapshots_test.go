@@ -0,0 +1,104 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestUniverseSnapshots(t *testing.T) *UniverseSnapshots {
+	db := NewTestDB(t)
+
+	dbTxer := NewTransactionExecutor(db,
+		func(tx *sql.Tx) UniverseSnapshotStore {
+			return db.WithTx(tx)
+		},
+	)
+
+	testClock := clock.NewTestClock(time.Unix(1700000000, 0))
+
+	return NewUniverseSnapshots(dbTxer, testClock)
+}
+
+// TestUniverseSnapshots tests that imported universe snapshots are kept as
+// pending until they are marked as verified, and that re-importing a snapshot
+// resets its verification state.
+func TestUniverseSnapshots(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx   = context.Background()
+		store = newTestUniverseSnapshots(t)
+	)
+
+	randSnapshot := func(id universe.Identifier) universe.SnapshotRecord {
+		var nodeHash mssmt.NodeHash
+		copy(nodeHash[:], test.RandBytes(32))
+
+		return universe.SnapshotRecord{
+			ID:     id,
+			Height: test.RandInt[uint32](),
+			Root: mssmt.NewComputedBranch(
+				nodeHash, uint64(test.RandInt[uint32]()),
+			),
+			ImportedAt: time.Unix(1700000000, 0),
+		}
+	}
+
+	// Without any imported snapshot, nothing is pending.
+	pending, err := store.PendingSnapshots(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	// We import a snapshot of an asset ID universe and one of an asset
+	// group universe, both should be pending.
+	snapshot1 := randSnapshot(randUniverseID(t, false))
+	snapshot2 := randSnapshot(randUniverseID(t, true))
+	require.NoError(t, store.AddSnapshot(ctx, snapshot1))
+	require.NoError(t, store.AddSnapshot(ctx, snapshot2))
+
+	assertPending := func(expected ...universe.SnapshotRecord) {
+		t.Helper()
+
+		pending, err := store.PendingSnapshots(ctx)
+		require.NoError(t, err)
+		require.Len(t, pending, len(expected))
+
+		for idx := range expected {
+			require.Equal(
+				t, expected[idx].ID.String(),
+				pending[idx].ID.String(),
+			)
+			require.Equal(
+				t, expected[idx].Height, pending[idx].Height,
+			)
+			require.True(t, mssmt.IsEqualNode(
+				expected[idx].Root, pending[idx].Root,
+			))
+			require.Equal(
+				t, expected[idx].ImportedAt.UTC(),
+				pending[idx].ImportedAt,
+			)
+		}
+	}
+	assertPending(snapshot1, snapshot2)
+
+	// Once the first snapshot is verified, only the second one is left.
+	err = store.MarkSnapshotVerified(ctx, snapshot1.ID, snapshot1.Height, 2)
+	require.NoError(t, err)
+	assertPending(snapshot2)
+
+	// Importing the first snapshot again with a new root makes it pending
+	// again.
+	reimported := randSnapshot(snapshot1.ID)
+	reimported.Height = snapshot1.Height
+	require.NoError(t, store.AddSnapshot(ctx, reimported))
+	assertPending(reimported, snapshot2)
+}
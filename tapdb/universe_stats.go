@@ -6,6 +6,7 @@ import (
 	"database/sql"
 	"fmt"
 	"math"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -56,6 +57,22 @@ type (
 	// AssetStatsPerDayQueryPg is the query used to fetch the asset stats
 	// for a given day (for Postgres).
 	AssetStatsPerDayQueryPg = sqlc.QueryAssetStatsPerDayPostgresParams
+
+	// AssetSupplyPerDay is the supply change record of an asset for a
+	// given day.
+	AssetSupplyPerDay = sqlc.QueryAssetSupplyPerDaySqliteRow
+
+	// AssetSupplyPerDayPg is the supply change record of an asset for a
+	// given day (for Postgres).
+	AssetSupplyPerDayPg = sqlc.QueryAssetSupplyPerDayPostgresRow
+
+	// AssetSupplyPerDayQuery is the query used to fetch the supply changes
+	// of an asset per day.
+	AssetSupplyPerDayQuery = sqlc.QueryAssetSupplyPerDaySqliteParams
+
+	// AssetSupplyPerDayQueryPg is the query used to fetch the supply
+	// changes of an asset per day (for Postgres).
+	AssetSupplyPerDayQueryPg = sqlc.QueryAssetSupplyPerDayPostgresParams
 )
 
 // UniverseStatsStore is an interface that defines the methods required to
@@ -84,6 +101,26 @@ type UniverseStatsStore interface {
 	// grouped by day in a Postgres specific format.
 	QueryAssetStatsPerDayPostgres(ctx context.Context,
 		q AssetStatsPerDayQueryPg) ([]AssetStatsPerDayPg, error)
+
+	// QueryAssetSupplyPerDaySqlite returns the supply changes of a given
+	// asset grouped by day in a SQLite specific format.
+	QueryAssetSupplyPerDaySqlite(ctx context.Context,
+		q AssetSupplyPerDayQuery) ([]AssetSupplyPerDay, error)
+
+	// QueryAssetSupplyPerDayPostgres returns the supply changes of a given
+	// asset grouped by day in a Postgres specific format.
+	QueryAssetSupplyPerDayPostgres(ctx context.Context,
+		q AssetSupplyPerDayQueryPg) ([]AssetSupplyPerDayPg, error)
+
+	// DownsampleUniverseEvents adds all sync and new proof events before
+	// the given Unix timestamp to the daily event aggregates.
+	DownsampleUniverseEvents(ctx context.Context,
+		cutoffTimestamp int64) error
+
+	// DeleteUniverseEventsBefore deletes all raw events before the given
+	// Unix timestamp and returns the number of deleted events.
+	DeleteUniverseEventsBefore(ctx context.Context,
+		cutoffTimestamp int64) (int64, error)
 }
 
 // UniverseStatsOptions defines the set of txn options for the universe stats.
@@ -119,12 +156,29 @@ type eventQuery struct {
 
 	// endTime is the end time of the query.
 	endTime int64
+
+	// assetID is the asset to also query the supply changes for.
+	assetID asset.ID
 }
 
 // eventQueryBucket is the interval that we'll use to bucket similar queries
 // into.
 const eventQueryBucket = time.Hour
 
+const (
+	// eventDownsampleBucket is the interval raw universe events are
+	// aggregated into when they're downsampled.
+	eventDownsampleBucket = 24 * time.Hour
+
+	// eventDownsampleDelay is the delay after startup before old raw
+	// universe events are downsampled for the first time.
+	eventDownsampleDelay = time.Minute
+
+	// eventDownsampleInterval is the interval at which old raw universe
+	// events are downsampled.
+	eventDownsampleInterval = time.Hour
+)
+
 // newEventQuery creates a new event query from the given query.
 func newEventQuery(q universe.GroupedStatsQuery) eventQuery {
 	// For both the start and time time, we'll round down to the nearest
@@ -135,6 +189,7 @@ func newEventQuery(q universe.GroupedStatsQuery) eventQuery {
 	return eventQuery{
 		startTime: startTime,
 		endTime:   endTime,
+		assetID:   q.AssetIDFilter,
 	}
 }
 
@@ -297,6 +352,8 @@ type UniverseStats struct {
 	syncStatsMtx     sync.Mutex
 	syncStatsCache   *atomicSyncStatsCache
 	syncStatsRefresh *time.Timer
+
+	downsampleTimer *time.Timer
 }
 
 // statsOpts defines the set of options that can be used to configure the
@@ -304,6 +361,11 @@ type UniverseStats struct {
 type statsOpts struct {
 	// cacheDuration is the duration that the stats will be cached for.
 	cacheDuration time.Duration
+
+	// eventRetention is the duration raw universe events are kept for
+	// before they're downsampled into daily aggregates. A value of zero
+	// keeps all raw events.
+	eventRetention time.Duration
 }
 
 // UniverseStatOption is a functional option that can be used to modify the way
@@ -325,8 +387,19 @@ func WithStatsCacheDuration(d time.Duration) UniverseStatsOption {
 	}
 }
 
+// WithStatsEventRetention is a functional option that can be used to set the
+// amount of time raw universe events are kept for before they're downsampled
+// into daily aggregates. A value of zero keeps all raw events.
+func WithStatsEventRetention(d time.Duration) UniverseStatsOption {
+	return func(o *statsOpts) {
+		o.eventRetention = d
+	}
+}
+
 // NewUniverseStats creates a new instance of the UniverseStats backed by the
 // database.
+// If an event retention is set, old raw events are downsampled periodically in
+// the background.
 func NewUniverseStats(db BatchedUniverseStats, clock clock.Clock,
 	options ...UniverseStatsOption) *UniverseStats {
 
@@ -338,7 +411,7 @@ func NewUniverseStats(db BatchedUniverseStats, clock clock.Clock,
 	atomicStatsCache := newAtomicSyncStatsCache()
 	atomicStatsCache.wipe()
 
-	return &UniverseStats{
+	stats := &UniverseStats{
 		db:               db,
 		clock:            clock,
 		opts:             opts,
@@ -349,6 +422,72 @@ func NewUniverseStats(db BatchedUniverseStats, clock clock.Clock,
 		eventsCacheLogger: newCacheLogger("universe_asset_events"),
 		syncStatsCache:    atomicStatsCache,
 	}
+
+	if opts.eventRetention > 0 {
+		stats.downsampleTimer = time.AfterFunc(
+			eventDownsampleDelay,
+			stats.downsampleEventsPeriodically,
+		)
+	}
+
+	return stats
+}
+
+// DownsampleEvents adds all raw sync and new proof events that are older than
+// the event retention to the daily event aggregates, then deletes them. Only
+// whole days are downsampled, so the raw events of a day are either all kept
+// or all aggregated. The number of deleted raw events is returned.
+func (u *UniverseStats) DownsampleEvents(ctx context.Context) (int64, error) {
+	if u.opts.eventRetention == 0 {
+		return 0, nil
+	}
+
+	// We round the cutoff down to the start of the UTC day, so we never
+	// aggregate a partial day.
+	cutoff := u.clock.Now().UTC().Add(-u.opts.eventRetention).Truncate(
+		eventDownsampleBucket,
+	)
+
+	var (
+		writeTxOpts UniverseStatsOptions
+		numDeleted  int64
+	)
+	downsample := func(db UniverseStatsStore) error {
+		err := db.DownsampleUniverseEvents(ctx, cutoff.Unix())
+		if err != nil {
+			return fmt.Errorf("unable to aggregate events: %w", err)
+		}
+
+		numDeleted, err = db.DeleteUniverseEventsBefore(
+			ctx, cutoff.Unix(),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to delete events: %w", err)
+		}
+
+		return nil
+	}
+	if err := u.db.ExecTx(ctx, &writeTxOpts, downsample); err != nil {
+		return 0, err
+	}
+
+	log.Debugf("Downsampled %d universe events before %v", numDeleted,
+		cutoff)
+
+	return numDeleted, nil
+}
+
+// downsampleEventsPeriodically downsamples the old raw events, then schedules
+// the next run.
+//
+// NOTE: This MUST be run as the call back of a time.AfterFunc.
+func (u *UniverseStats) downsampleEventsPeriodically() {
+	_, err := u.DownsampleEvents(context.Background())
+	if err != nil {
+		log.Warnf("Unable to downsample universe events: %v", err)
+	}
+
+	u.downsampleTimer.Reset(eventDownsampleInterval)
 }
 
 // LogSyncEvent logs a sync event for the target universe.
@@ -729,6 +868,16 @@ func (u *UniverseStats) QueryAssetStatsPerDay(ctx context.Context,
 		return nil, dbErr
 	}
 
+	// If the query is for a specific asset, we also add the changes to
+	// its supply for each day.
+	var zeroID asset.ID
+	if q.AssetIDFilter != zeroID {
+		results, err = u.addAssetSupplyPerDay(ctx, q, results)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// We have a fresh result, so we'll cache it now.
 	if _, err := u.assetEventsCache.Put(query, results); err != nil {
 		log.Errorf("unable to query events cache: %v", err)
@@ -737,6 +886,104 @@ func (u *UniverseStats) QueryAssetStatsPerDay(ctx context.Context,
 	return results, nil
 }
 
+// addAssetSupplyPerDay queries the supply changes of the asset of the given
+// query per day and adds them to the given per-day stats. Days that only saw
+// supply changes are added to the stats, which remain sorted by date.
+func (u *UniverseStats) addAssetSupplyPerDay(ctx context.Context,
+	q universe.GroupedStatsQuery,
+	stats []*universe.GroupedStats) ([]*universe.GroupedStats, error) {
+
+	var (
+		readTx = NewUniverseStatsReadTx()
+		deltas = make(map[string]universe.AssetSupplyDeltas)
+	)
+	dbErr := u.db.ExecTx(ctx, &readTx, func(db UniverseStatsStore) error {
+		var (
+			assetID   = q.AssetIDFilter[:]
+			startTime = sqlInt64(q.StartTime.UTC().Unix())
+			endTime   = sqlInt64(q.EndTime.UTC().Unix())
+		)
+		switch u.db.Backend() {
+		case sqlc.BackendTypeSqlite:
+			rows, err := db.QueryAssetSupplyPerDaySqlite(
+				ctx, AssetSupplyPerDayQuery{
+					AssetID:   assetID,
+					StartTime: startTime,
+					EndTime:   endTime,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			for _, r := range rows {
+				deltas[r.Day] = universe.AssetSupplyDeltas{
+					MintedAmount: uint64(r.MintedAmount),
+					BurnedAmount: uint64(r.BurnedAmount),
+					NumTransfers: uint64(r.NumTransfers),
+				}
+			}
+
+			return nil
+
+		case sqlc.BackendTypePostgres:
+			rows, err := db.QueryAssetSupplyPerDayPostgres(
+				ctx, AssetSupplyPerDayQueryPg{
+					AssetID:   assetID,
+					StartTime: startTime,
+					EndTime:   endTime,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			for _, r := range rows {
+				deltas[r.Day] = universe.AssetSupplyDeltas{
+					MintedAmount: uint64(r.MintedAmount),
+					BurnedAmount: uint64(r.BurnedAmount),
+					NumTransfers: uint64(r.NumTransfers),
+				}
+			}
+
+			return nil
+
+		default:
+			return fmt.Errorf("unknown backend type: %v",
+				u.db.Backend())
+		}
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to query asset supply per day: "+
+			"%w", dbErr)
+	}
+
+	for _, dayStats := range stats {
+		dayDeltas, ok := deltas[dayStats.Date]
+		if !ok {
+			continue
+		}
+
+		dayStats.SupplyDeltas = dayDeltas
+		delete(deltas, dayStats.Date)
+	}
+
+	// Any remaining days didn't see any universe events, but still saw
+	// supply changes of the asset.
+	for day, dayDeltas := range deltas {
+		stats = append(stats, &universe.GroupedStats{
+			SupplyDeltas: dayDeltas,
+			Date:         day,
+		})
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Date < stats[j].Date
+	})
+
+	return stats, nil
+}
+
 // QuerySyncStats attempts to query the stats for the target universe.  For a
 // given asset ID, tag, or type, the set of universe stats is returned which
 // lists information such as the total number of syncs and known proofs for a
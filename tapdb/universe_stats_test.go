@@ -13,6 +13,7 @@ import (
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
 	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
 	"github.com/lightninglabs/taproot-assets/universe"
 	"github.com/lightningnetwork/lnd/clock"
@@ -255,6 +256,237 @@ func TestUniverseStatsEvents(t *testing.T) {
 	})
 }
 
+// TestUniverseStatsDownsampling tests that old raw universe events are
+// downsampled into daily aggregates without changing the total and per day
+// stats.
+func TestUniverseStatsDownsampling(t *testing.T) {
+	t.Parallel()
+
+	db := NewTestDB(t)
+	ctx := context.Background()
+
+	start := time.Now().UTC().Add(-10 * 24 * time.Hour)
+	testClock := clock.NewTestClock(start)
+	statsDB, _ := newUniverseStatsWithDB(db.BaseDB, testClock)
+
+	const numAssets = 2
+	sh := newUniStatsHarness(t, numAssets, db.BaseDB, statsDB)
+
+	// We log a new proof event for each asset on the first day, and a sync
+	// event for the first asset on the first and second day.
+	for i := 0; i < numAssets; i++ {
+		sh.logProofEventByIndex(i)
+	}
+	sh.logSyncEventByIndex(0)
+	testClock.SetTime(start.Add(24 * time.Hour))
+	sh.logSyncEventByIndex(0)
+
+	// One more sync event is logged today, which is within the retention
+	// period.
+	testClock.SetTime(time.Now().UTC())
+	sh.logSyncEventByIndex(1)
+
+	// queryStats queries the stats with a fresh instance, so we don't get
+	// any cached results.
+	queryStats := func() (universe.AggregateStats,
+		[]*universe.GroupedStats, []universe.AssetSyncSnapshot) {
+
+		t.Helper()
+
+		stats, _ := newUniverseStatsWithDB(db.BaseDB, testClock)
+
+		aggStats, err := stats.AggregateSyncStats(ctx)
+		require.NoError(t, err)
+
+		dayStats, err := stats.QueryAssetStatsPerDay(
+			ctx, universe.GroupedStatsQuery{
+				StartTime: start,
+				EndTime:   testClock.Now(),
+			},
+		)
+		require.NoError(t, err)
+
+		syncStats, err := stats.QuerySyncStats(
+			ctx, universe.SyncStatsQuery{
+				SortBy: universe.SortByAssetID,
+			},
+		)
+		require.NoError(t, err)
+
+		return aggStats, dayStats, syncStats.SyncStats
+	}
+
+	aggStats, dayStats, syncStats := queryStats()
+	require.EqualValues(t, numAssets, aggStats.NumTotalProofs)
+	require.EqualValues(t, 3, aggStats.NumTotalSyncs)
+	require.Len(t, dayStats, 3)
+
+	// Without a retention, nothing is downsampled.
+	numDeleted, err := statsDB.DownsampleEvents(ctx)
+	require.NoError(t, err)
+	require.Zero(t, numDeleted)
+
+	// With a retention of five days, all events of the first two days are
+	// downsampled, while the one of today is kept.
+	statsDB.opts.eventRetention = 5 * 24 * time.Hour
+	numDeleted, err = statsDB.DownsampleEvents(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, numAssets+2, numDeleted)
+
+	newAggStats, newDayStats, newSyncStats := queryStats()
+	require.Equal(t, aggStats, newAggStats)
+	require.Equal(t, dayStats, newDayStats)
+	require.Equal(t, syncStats, newSyncStats)
+
+	// Downsampling again is a no-op.
+	numDeleted, err = statsDB.DownsampleEvents(ctx)
+	require.NoError(t, err)
+	require.Zero(t, numDeleted)
+
+	// Events that are logged for an already downsampled day are added to
+	// the existing aggregate of the day.
+	testClock.SetTime(start)
+	sh.logSyncEventByIndex(1)
+	testClock.SetTime(time.Now().UTC())
+
+	numDeleted, err = statsDB.DownsampleEvents(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, numDeleted)
+
+	newAggStats, newDayStats, _ = queryStats()
+	require.EqualValues(t, 4, newAggStats.NumTotalSyncs)
+	require.Len(t, newDayStats, 3)
+	require.Equal(
+		t, dayStats[0].NumTotalSyncs+1, newDayStats[0].NumTotalSyncs,
+	)
+
+	// Deleting a universe also deletes its downsampled events.
+	_, err = sh.assetUniverses[0].DeleteUniverse(ctx)
+	require.NoError(t, err)
+
+	newAggStats, _, _ = queryStats()
+	require.EqualValues(t, numAssets-1, newAggStats.NumTotalProofs)
+	require.EqualValues(t, 2, newAggStats.NumTotalSyncs)
+}
+
+// insertSupplyLeaf inserts a leaf for an asset of the given genesis and amount
+// into the given universe tree. If burn is true, the asset is a burn.
+func insertSupplyLeaf(t *testing.T, tree *BaseUniverseTree,
+	assetGen asset.Genesis, amount uint64, burn bool) {
+
+	proofAsset := asset.RandAssetWithValues(
+		t, assetGen, nil, asset.RandScriptKey(t),
+	)
+	proofAsset.Amount = amount
+
+	if burn {
+		prevID := asset.PrevID{
+			OutPoint:  test.RandOp(t),
+			ID:        assetGen.ID(),
+			ScriptKey: asset.ToSerialized(test.RandPubKey(t)),
+		}
+		proofAsset.PrevWitnesses = []asset.Witness{{
+			PrevID: &prevID,
+		}}
+		proofAsset.ScriptKey = asset.NewScriptKey(
+			asset.DeriveBurnKey(prevID),
+		)
+		require.True(t, proofAsset.IsBurn())
+	}
+
+	leafProof := randProof(t, proofAsset)
+	leafProof.GenesisReveal = &assetGen
+
+	var proofBuf bytes.Buffer
+	require.NoError(t, leafProof.Encode(&proofBuf))
+
+	leaf := &universe.Leaf{
+		GenesisWithGroup: universe.GenesisWithGroup{
+			Genesis: assetGen,
+		},
+		RawProof: proofBuf.Bytes(),
+		Asset:    &leafProof.Asset,
+		Amt:      amount,
+	}
+
+	_, err := tree.RegisterIssuance(
+		context.Background(), randLeafKey(t), leaf, nil,
+	)
+	require.NoError(t, err)
+}
+
+// TestUniverseStatsSupplyPerDay tests that the supply changes of an asset are
+// added to the per day stats if the query is for a specific asset.
+func TestUniverseStatsSupplyPerDay(t *testing.T) {
+	t.Parallel()
+
+	db := NewTestDB(t)
+	ctx := context.Background()
+
+	testClock := clock.NewTestClock(time.Now())
+	statsDB, _ := newUniverseStatsWithDB(db.BaseDB, testClock)
+
+	// We create an issuance and a transfer universe for our asset, and an
+	// issuance universe for another asset that shouldn't be counted.
+	assetGen := asset.RandGenesis(t, asset.Normal)
+	otherGen := asset.RandGenesis(t, asset.Normal)
+
+	newTree := func(gen asset.Genesis,
+		proofType universe.ProofType) *BaseUniverseTree {
+
+		id := randUniverseID(t, false, withProofType(proofType))
+		id.AssetID = gen.ID()
+		id.GroupKey = nil
+
+		tree, _ := newTestUniverseWithDb(db.BaseDB, id)
+		return tree
+	}
+	issuanceTree := newTree(assetGen, universe.ProofTypeIssuance)
+	transferTree := newTree(assetGen, universe.ProofTypeTransfer)
+	otherTree := newTree(otherGen, universe.ProofTypeIssuance)
+
+	insertSupplyLeaf(t, issuanceTree, assetGen, 1000, false)
+	insertSupplyLeaf(t, issuanceTree, assetGen, 500, false)
+	insertSupplyLeaf(t, transferTree, assetGen, 200, false)
+	insertSupplyLeaf(t, transferTree, assetGen, 300, false)
+	insertSupplyLeaf(t, transferTree, assetGen, 100, true)
+	insertSupplyLeaf(t, otherTree, otherGen, 700, false)
+
+	query := universe.GroupedStatsQuery{
+		StartTime: testClock.Now().Add(-time.Hour),
+		EndTime:   testClock.Now().Add(time.Hour),
+	}
+
+	// Without an asset filter, no supply changes are returned, and as no
+	// events were logged, there are no stats at all.
+	dayStats, err := statsDB.QueryAssetStatsPerDay(ctx, query)
+	require.NoError(t, err)
+	require.Empty(t, dayStats)
+
+	// With the filter, the supply changes of today are returned, even
+	// though there were no events.
+	query.AssetIDFilter = assetGen.ID()
+	dayStats, err = statsDB.QueryAssetStatsPerDay(ctx, query)
+	require.NoError(t, err)
+	require.Len(t, dayStats, 1)
+	require.Equal(
+		t, testClock.Now().UTC().Format(time.DateOnly),
+		dayStats[0].Date,
+	)
+	require.Equal(t, universe.AssetSupplyDeltas{
+		MintedAmount: 1500,
+		BurnedAmount: 100,
+		NumTransfers: 2,
+	}, dayStats[0].SupplyDeltas)
+
+	// Leaves outside of the queried time range aren't counted.
+	query.StartTime = testClock.Now().Add(time.Hour)
+	query.EndTime = testClock.Now().Add(2 * time.Hour)
+	dayStats, err = statsDB.QueryAssetStatsPerDay(ctx, query)
+	require.NoError(t, err)
+	require.Empty(t, dayStats)
+}
+
 // TestUniverseQuerySyncStatsSorting tests that we're able to properly sort the
 // response using any of the available params.
 func TestUniverseQuerySyncStatsSorting(t *testing.T) {
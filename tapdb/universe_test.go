@@ -7,6 +7,7 @@ import (
 	"math"
 	"math/rand"
 	"reflect"
+	"slices"
 	"testing"
 	"time"
 
@@ -475,6 +476,61 @@ func TestUniverseMetaBlob(t *testing.T) {
 	require.Equal(t, assetGen.ID(), uniProof.Leaf.Genesis.ID())
 }
 
+// TestUniverseAssetMeta tests that the meta of an asset can be fetched from
+// the multiverse by its hash, but only if the asset has a universe leaf.
+func TestUniverseAssetMeta(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db := NewTestDB(t)
+	dbMultiverse, _ := newTestMultiverseWithDb(db.BaseDB)
+	memMultiverse := universe.NewMemMultiverse()
+	archives := []universe.MultiverseArchive{dbMultiverse, memMultiverse}
+
+	// Metadata that is only known to the local wallet must not be
+	// returned.
+	walletMeta := &proof.MetaReveal{
+		Data: test.RandBytes(50),
+	}
+	walletMetaHash := walletMeta.MetaHash()
+	_, err := db.UpsertAssetMeta(ctx, NewAssetMeta{
+		MetaDataHash: walletMetaHash[:],
+		MetaDataBlob: walletMeta.Data,
+	})
+	require.NoError(t, err)
+
+	for _, archive := range archives {
+		_, err := archive.FetchAssetMeta(ctx, walletMetaHash)
+		require.ErrorIs(t, err, universe.ErrNoUniverseMeta)
+	}
+
+	// Once an asset with a meta has an issuance leaf, its meta can be
+	// fetched by hash.
+	meta := &proof.MetaReveal{
+		Type: proof.MetaJson,
+		Data: []byte(`{"name":"foo"}`),
+	}
+	assetGen := asset.RandGenesis(t, asset.Normal)
+	assetGen.MetaHash = meta.MetaHash()
+
+	id := randUniverseID(t, false)
+	leaf := randMintingLeaf(t, assetGen, id.GroupKey)
+	key := randLeafKey(t)
+
+	for _, archive := range archives {
+		_, err := archive.UpsertProofLeaf(ctx, id, key, &leaf, meta)
+		require.NoError(t, err)
+
+		fetchedMeta, err := archive.FetchAssetMeta(ctx, meta.MetaHash())
+		require.NoError(t, err)
+		require.Equal(t, meta, fetchedMeta)
+
+		_, err = archive.FetchAssetMeta(ctx, walletMetaHash)
+		require.ErrorIs(t, err, universe.ErrNoUniverseMeta)
+	}
+}
+
 func insertRandLeaf(t *testing.T, ctx context.Context, tree *BaseUniverseTree,
 	assetGen *asset.Genesis) (*universe.Proof, error) {
 
@@ -1073,3 +1129,804 @@ func TestMultiverseRootSum(t *testing.T) {
 		})
 	}
 }
+
+// TestMultiverseFetchProofLeaves tests that a batch of proof leaves can be
+// fetched at once, and that the result matches fetching the proofs one by one.
+func TestMultiverseFetchProofLeaves(t *testing.T) {
+	t.Parallel()
+
+	multiverse, _ := newTestMultiverse(t)
+	ctx := context.Background()
+
+	const numLeaves = 5
+
+	id := randUniverseID(t, false)
+	id.ProofType = universe.ProofTypeIssuance
+
+	keys := make([]universe.LeafKey, numLeaves)
+	for i := range keys {
+		assetGen := asset.RandGenesis(t, asset.Normal)
+		leaf := randMintingLeaf(t, assetGen, id.GroupKey)
+
+		keys[i] = randLeafKey(t)
+		_, err := multiverse.UpsertProofLeaf(
+			ctx, id, keys[i], &leaf, nil,
+		)
+		require.NoError(t, err)
+	}
+
+	// We fetch a single proof first, so the batch is served partially
+	// from the cache.
+	_, err := multiverse.FetchProofLeaf(ctx, id, keys[2])
+	require.NoError(t, err)
+
+	// The proofs should be returned in the order of the keys, so we
+	// reverse the keys to make sure the order isn't the insertion order.
+	slices.Reverse(keys)
+	proofs, err := multiverse.FetchProofLeaves(ctx, id, keys)
+	require.NoError(t, err)
+	require.Len(t, proofs, numLeaves)
+
+	for i, key := range keys {
+		singleProofs, err := multiverse.FetchProofLeaf(ctx, id, key)
+		require.NoError(t, err)
+		require.Len(t, singleProofs, 1)
+
+		expected, batchProof := singleProofs[0], proofs[i]
+		require.Equal(
+			t, key.UniverseKey(), batchProof.LeafKey.UniverseKey(),
+		)
+		require.Equal(
+			t, expected.Leaf.RawProof, batchProof.Leaf.RawProof,
+		)
+		require.True(t, mssmt.IsEqualNode(
+			expected.UniverseRoot, batchProof.UniverseRoot,
+		))
+		require.True(t, mssmt.IsEqualNode(
+			expected.MultiverseRoot, batchProof.MultiverseRoot,
+		))
+		require.Equal(
+			t, expected.UniverseInclusionProof,
+			batchProof.UniverseInclusionProof,
+		)
+		require.Equal(
+			t, expected.MultiverseInclusionProof,
+			batchProof.MultiverseInclusionProof,
+		)
+	}
+
+	// If any of the keys is unknown, the whole batch should fail.
+	_, err = multiverse.FetchProofLeaves(
+		ctx, id, append(keys, randLeafKey(t)),
+	)
+	require.ErrorIs(t, err, universe.ErrNoUniverseProofFound)
+}
+
+// TestMultiverseLeafSubscription tests that subscribers are notified of new
+// proof leaves of the universes they subscribed to.
+func TestMultiverseLeafSubscription(t *testing.T) {
+	t.Parallel()
+
+	multiverse, _ := newTestMultiverse(t)
+	ctx := context.Background()
+
+	id1 := randUniverseID(t, false)
+	id2 := randUniverseID(t, false)
+
+	// The first subscriber is only interested in the first universe, while
+	// the second one receives the leaves of all universes.
+	sub1 := fn.NewEventReceiver[*universe.LeafEvent](fn.DefaultQueueSize)
+	sub2 := fn.NewEventReceiver[*universe.LeafEvent](fn.DefaultQueueSize)
+	require.NoError(t, multiverse.RegisterLeafSubscriber(sub1, id1))
+	require.NoError(t, multiverse.RegisterLeafSubscriber(sub2))
+
+	upsertLeaf := func(id universe.Identifier) universe.LeafKey {
+		assetGen := asset.RandGenesis(t, asset.Normal)
+		leaf := randMintingLeaf(t, assetGen, id.GroupKey)
+		key := randLeafKey(t)
+
+		_, err := multiverse.UpsertProofLeaf(ctx, id, key, &leaf, nil)
+		require.NoError(t, err)
+
+		return key
+	}
+
+	assertEvent := func(sub *fn.EventReceiver[*universe.LeafEvent],
+		id universe.Identifier, key universe.LeafKey) {
+
+		t.Helper()
+
+		select {
+		case event := <-sub.NewItemCreated.ChanOut():
+			require.Equal(t, id.String(), event.ID.String())
+			require.Equal(
+				t, key.UniverseKey(), event.Key.UniverseKey(),
+			)
+			require.NotNil(t, event.Leaf)
+
+		case <-time.After(time.Second):
+			t.Fatalf("no leaf event received")
+		}
+	}
+
+	assertNoEvent := func(sub *fn.EventReceiver[*universe.LeafEvent]) {
+		t.Helper()
+
+		select {
+		case event := <-sub.NewItemCreated.ChanOut():
+			t.Fatalf("unexpected leaf event: %v", event.ID.String())
+
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+
+	// A leaf in the first universe should be sent to both subscribers.
+	key1 := upsertLeaf(id1)
+	assertEvent(sub1, id1, key1)
+	assertEvent(sub2, id1, key1)
+
+	// A leaf in the second universe should only be sent to the second
+	// subscriber.
+	key2 := upsertLeaf(id2)
+	assertEvent(sub2, id2, key2)
+	assertNoEvent(sub1)
+
+	// Once removed, a subscriber shouldn't receive any more events and
+	// can't be removed a second time.
+	require.NoError(t, multiverse.RemoveLeafSubscriber(sub2))
+	require.Error(t, multiverse.RemoveLeafSubscriber(sub2))
+
+	key3 := upsertLeaf(id1)
+	assertEvent(sub1, id1, key3)
+
+	require.NoError(t, multiverse.RemoveLeafSubscriber(sub1))
+}
+
+// proofNodeHashes returns the hashes of the sibling nodes of a merkle proof.
+// The node types differ between tree stores, so merkle proofs from different
+// stores can only be compared by their hashes.
+func proofNodeHashes(p *mssmt.Proof) []mssmt.NodeHash {
+	return fn.Map(p.Nodes, func(n mssmt.Node) mssmt.NodeHash {
+		return n.NodeHash()
+	})
+}
+
+// TestMemMultiverseParity tests that the in-memory multiverse behaves exactly
+// like the database backed multiverse for the same sequence of operations.
+func TestMemMultiverseParity(t *testing.T) {
+	t.Parallel()
+
+	db := NewTestDB(t)
+	dbMultiverse, _ := newTestMultiverseWithDb(db.BaseDB)
+	memMultiverse := universe.NewMemMultiverse()
+	ctx := context.Background()
+
+	archives := []universe.MultiverseArchive{dbMultiverse, memMultiverse}
+
+	// assertEqualProof asserts that two universe proofs for the same leaf
+	// are equal.
+	assertEqualProof := func(expected, actual *universe.Proof) {
+		t.Helper()
+
+		require.Equal(
+			t, expected.LeafKey.UniverseKey(),
+			actual.LeafKey.UniverseKey(),
+		)
+		require.Equal(t, expected.Leaf.RawProof, actual.Leaf.RawProof)
+		require.Equal(t, expected.Leaf.Genesis, actual.Leaf.Genesis)
+		require.Equal(t, expected.Leaf.Amt, actual.Leaf.Amt)
+		require.True(t, mssmt.IsEqualNode(
+			expected.UniverseRoot, actual.UniverseRoot,
+		))
+		require.True(t, mssmt.IsEqualNode(
+			expected.MultiverseRoot, actual.MultiverseRoot,
+		))
+		require.Equal(
+			t, proofNodeHashes(expected.UniverseInclusionProof),
+			proofNodeHashes(actual.UniverseInclusionProof),
+		)
+		require.Equal(
+			t, proofNodeHashes(expected.MultiverseInclusionProof),
+			proofNodeHashes(actual.MultiverseInclusionProof),
+		)
+	}
+
+	// upsert inserts the given leaf into both archives and asserts that
+	// the returned proofs are equal.
+	upsert := func(id universe.Identifier, key universe.LeafKey,
+		leaf *universe.Leaf) {
+
+		t.Helper()
+
+		var proofs []*universe.Proof
+		for _, archive := range archives {
+			p, err := archive.UpsertProofLeaf(ctx, id, key, leaf, nil)
+			require.NoError(t, err)
+
+			proofs = append(proofs, p)
+		}
+
+		assertEqualProof(proofs[0], proofs[1])
+	}
+
+	// newUniverse creates a new universe ID. Universes without a group key
+	// only contain leaves of a single asset, so we also return the genesis
+	// of that asset.
+	newUniverse := func(group bool,
+		proofType universe.ProofType) (universe.Identifier,
+		asset.Genesis) {
+
+		id := randUniverseID(t, group, withProofType(proofType))
+		assetGen := asset.RandGenesis(t, asset.Normal)
+		if !group {
+			id.GroupKey = nil
+			id.AssetID = assetGen.ID()
+		}
+
+		return id, assetGen
+	}
+	newLeaf := func(id universe.Identifier,
+		assetGen asset.Genesis) *universe.Leaf {
+
+		if id.GroupKey != nil {
+			assetGen = asset.RandGenesis(t, asset.Normal)
+		}
+		leaf := randMintingLeaf(t, assetGen, id.GroupKey)
+
+		return &leaf
+	}
+
+	var (
+		ids      []universe.Identifier
+		geneses  = make(map[string]asset.Genesis)
+		leafKeys = make(map[string][]universe.LeafKey)
+	)
+	for _, group := range []bool{false, true} {
+		for _, proofType := range []universe.ProofType{
+			universe.ProofTypeIssuance, universe.ProofTypeTransfer,
+		} {
+
+			id, assetGen := newUniverse(group, proofType)
+			ids = append(ids, id)
+			geneses[id.String()] = assetGen
+		}
+	}
+
+	// We insert a few leaves into each universe one by one.
+	for _, id := range ids {
+		for j := 0; j < 3; j++ {
+			key := randLeafKey(t)
+			upsert(id, key, newLeaf(id, geneses[id.String()]))
+			leafKeys[id.String()] = append(
+				leafKeys[id.String()], key,
+			)
+		}
+	}
+
+	// Upserting a leaf with an existing key replaces it.
+	replaceID := ids[0]
+	replaceKey := leafKeys[replaceID.String()][1]
+	upsert(
+		replaceID, replaceKey,
+		newLeaf(replaceID, geneses[replaceID.String()]),
+	)
+
+	// A batch of leaves is inserted into a new and an existing universe.
+	batchID, _ := newUniverse(true, universe.ProofTypeIssuance)
+	ids = append(ids, batchID)
+	var items []*universe.Item
+	for _, id := range []universe.Identifier{batchID, ids[3]} {
+		for j := 0; j < 2; j++ {
+			key := randLeafKey(t)
+			items = append(items, &universe.Item{
+				ID:   id,
+				Key:  key,
+				Leaf: newLeaf(id, asset.Genesis{}),
+			})
+			leafKeys[id.String()] = append(
+				leafKeys[id.String()], key,
+			)
+		}
+	}
+	for _, archive := range archives {
+		require.NoError(t, archive.UpsertProofLeafBatch(ctx, items))
+	}
+
+	// A batch that fails midway is applied atomically, so none of its
+	// leaves are inserted, even into a universe that didn't exist before.
+	failedID, _ := newUniverse(true, universe.ProofTypeIssuance)
+	failedItems := []*universe.Item{
+		{
+			ID:   failedID,
+			Key:  randLeafKey(t),
+			Leaf: newLeaf(failedID, asset.Genesis{}),
+		},
+		{
+			ID:   ids[3],
+			Key:  randLeafKey(t),
+			Leaf: newLeaf(ids[3], asset.Genesis{}),
+		},
+		{
+			ID:   ids[3],
+			Key:  leafKeys[ids[3].String()][0],
+			Leaf: newLeaf(ids[3], asset.Genesis{}),
+		},
+		{
+			ID: universe.Identifier{
+				GroupKey:  failedID.GroupKey,
+				ProofType: universe.ProofTypeUnspecified,
+			},
+			Key:  randLeafKey(t),
+			Leaf: newLeaf(failedID, asset.Genesis{}),
+		},
+	}
+	ids = append(ids, failedID)
+	for _, archive := range archives {
+		before, err := archive.MultiverseRootNode(
+			ctx, universe.ProofTypeIssuance,
+		)
+		require.NoError(t, err)
+
+		err = archive.UpsertProofLeafBatch(ctx, failedItems)
+		require.Error(t, err)
+
+		after, err := archive.MultiverseRootNode(
+			ctx, universe.ProofTypeIssuance,
+		)
+		require.NoError(t, err)
+		require.True(t, mssmt.IsEqualNode(
+			before.UnwrapToPtr().Node, after.UnwrapToPtr().Node,
+		))
+
+		_, err = archive.UniverseRootNode(ctx, failedID)
+		require.ErrorIs(t, err, universe.ErrNoUniverseRoot)
+	}
+
+	// assertInvariants asserts that the multiverse trees of both archives
+	// are consistent with their universes.
+	assertInvariants := func() {
+		t.Helper()
+
+		for _, archive := range archives {
+			for _, proofType := range []universe.ProofType{
+				universe.ProofTypeIssuance,
+				universe.ProofTypeTransfer,
+			} {
+
+				found, err :=
+					universe.CheckMultiverseInvariants(
+						ctx, archive, proofType,
+					)
+				require.NoError(t, err)
+				require.Empty(t, found)
+			}
+		}
+	}
+	assertInvariants()
+
+	// assertParity asserts that all queries return the same results for
+	// both archives.
+	assertParity := func() {
+		t.Helper()
+
+		for _, proofType := range []universe.ProofType{
+			universe.ProofTypeIssuance, universe.ProofTypeTransfer,
+		} {
+
+			dbRoot, err := dbMultiverse.MultiverseRootNode(
+				ctx, proofType,
+			)
+			require.NoError(t, err)
+			memRoot, err := memMultiverse.MultiverseRootNode(
+				ctx, proofType,
+			)
+			require.NoError(t, err)
+			require.True(t, mssmt.IsEqualNode(
+				dbRoot.UnwrapToPtr().Node,
+				memRoot.UnwrapToPtr().Node,
+			))
+
+			dbLeaves, err := dbMultiverse.FetchLeaves(
+				ctx, nil, proofType,
+			)
+			require.NoError(t, err)
+			memLeaves, err := memMultiverse.FetchLeaves(
+				ctx, nil, proofType,
+			)
+			require.NoError(t, err)
+			require.Len(t, memLeaves, len(dbLeaves))
+			for _, dbLeaf := range dbLeaves {
+				assertIDInList(t, memLeaves, dbLeaf.ID)
+			}
+		}
+
+		for _, q := range []universe.RootNodesQuery{
+			{WithAmountsById: true},
+			{SortDirection: universe.SortDescending},
+			{Offset: 1, Limit: 2},
+		} {
+
+			dbRoots, err := dbMultiverse.RootNodes(ctx, q)
+			require.NoError(t, err)
+			memRoots, err := memMultiverse.RootNodes(ctx, q)
+			require.NoError(t, err)
+			require.Len(t, memRoots, len(dbRoots))
+
+			for i := range dbRoots {
+				dbRoot, memRoot := dbRoots[i], memRoots[i]
+				require.Equal(
+					t, dbRoot.ID.String(), memRoot.ID.String(),
+				)
+				require.True(t, mssmt.IsEqualNode(
+					dbRoot.Node, memRoot.Node,
+				))
+				require.Equal(t, dbRoot.AssetName, memRoot.AssetName)
+				require.Equal(
+					t, dbRoot.GroupedAssets,
+					memRoot.GroupedAssets,
+				)
+			}
+		}
+
+		for _, id := range ids {
+			dbRoot, dbErr := dbMultiverse.UniverseRootNode(ctx, id)
+			memRoot, memErr := memMultiverse.UniverseRootNode(
+				ctx, id,
+			)
+			if dbErr != nil {
+				require.ErrorIs(t, dbErr, universe.ErrNoUniverseRoot)
+				require.ErrorIs(
+					t, memErr, universe.ErrNoUniverseRoot,
+				)
+
+				continue
+			}
+			require.NoError(t, memErr)
+			require.True(t, mssmt.IsEqualNode(
+				dbRoot.Node, memRoot.Node,
+			))
+			require.Equal(t, dbRoot.AssetName, memRoot.AssetName)
+
+			q := universe.UniverseLeafKeysQuery{
+				Id:            id,
+				SortDirection: universe.SortDescending,
+				Offset:        1,
+			}
+			dbKeys, err := dbMultiverse.UniverseLeafKeys(ctx, q)
+			require.NoError(t, err)
+			memKeys, err := memMultiverse.UniverseLeafKeys(ctx, q)
+			require.NoError(t, err)
+			require.Equal(
+				t, fn.Map(dbKeys, universe.LeafKey.UniverseKey),
+				fn.Map(memKeys, universe.LeafKey.UniverseKey),
+			)
+
+			keys := leafKeys[id.String()]
+			dbProofs, err := dbMultiverse.FetchProofLeaves(
+				ctx, id, keys,
+			)
+			require.NoError(t, err)
+			memProofs, err := memMultiverse.FetchProofLeaves(
+				ctx, id, keys,
+			)
+			require.NoError(t, err)
+			require.Len(t, memProofs, len(dbProofs))
+			for i := range dbProofs {
+				assertEqualProof(dbProofs[i], memProofs[i])
+			}
+
+			// An unknown key results in the same error.
+			_, err = dbMultiverse.FetchProofLeaf(
+				ctx, id, randLeafKey(t),
+			)
+			require.ErrorIs(t, err, universe.ErrNoUniverseProofFound)
+			_, err = memMultiverse.FetchProofLeaf(
+				ctx, id, randLeafKey(t),
+			)
+			require.ErrorIs(t, err, universe.ErrNoUniverseProofFound)
+		}
+	}
+	assertParity()
+
+	// Finally, we delete a universe from both archives and make sure they
+	// still agree.
+	for _, archive := range archives {
+		_, err := archive.DeleteUniverse(ctx, ids[1])
+		require.NoError(t, err)
+	}
+
+	// The database backed multiverse only invalidates the cached proofs of
+	// the deleted universe, so the cached proofs of the other universes
+	// still reference the old multiverse root. We use a fresh instance to
+	// compare the actual state of the database.
+	dbMultiverse, _ = newTestMultiverseWithDb(db.BaseDB)
+	ids = slices.Delete(ids, 1, 2)
+	archives[0] = dbMultiverse
+	assertParity()
+	assertInvariants()
+}
+
+// TestUniverseLeafKeysBlockHeight tests that the leaf keys of a universe can
+// be filtered by the block height of their proofs, and that leaves with an
+// unknown block height match any range.
+func TestUniverseLeafKeysBlockHeight(t *testing.T) {
+	t.Parallel()
+
+	db := NewTestDB(t)
+	dbMultiverse, _ := newTestMultiverseWithDb(db.BaseDB)
+	memMultiverse := universe.NewMemMultiverse()
+	ctx := context.Background()
+
+	archives := []universe.MultiverseArchive{dbMultiverse, memMultiverse}
+
+	id := randUniverseID(t, false)
+	assetGen := asset.RandGenesis(t, asset.Normal)
+	id.AssetID = assetGen.ID()
+
+	// We insert one leaf for each block height, where a height of zero
+	// means the height is unknown.
+	heights := []uint32{0, 100, 200, 300}
+	keysByHeight := make(map[uint32]universe.LeafKey)
+	for _, height := range heights {
+		leaf := randMintingLeaf(t, assetGen, nil)
+
+		var leafProof proof.Proof
+		err := leafProof.Decode(bytes.NewReader(leaf.RawProof))
+		require.NoError(t, err)
+		leafProof.BlockHeight = height
+
+		var proofBuf bytes.Buffer
+		require.NoError(t, leafProof.Encode(&proofBuf))
+		leaf.RawProof = proofBuf.Bytes()
+
+		key := randLeafKey(t)
+		for _, archive := range archives {
+			_, err := archive.UpsertProofLeaf(
+				ctx, id, key, &leaf, nil,
+			)
+			require.NoError(t, err)
+		}
+		keysByHeight[height] = key
+	}
+
+	testCases := []struct {
+		name      string
+		minHeight fn.Option[uint32]
+		maxHeight fn.Option[uint32]
+		expected  []uint32
+	}{{
+		name:     "no range",
+		expected: []uint32{0, 100, 200, 300},
+	}, {
+		name:      "min height",
+		minHeight: fn.Some[uint32](200),
+		expected:  []uint32{0, 200, 300},
+	}, {
+		name:      "max height",
+		maxHeight: fn.Some[uint32](150),
+		expected:  []uint32{0, 100},
+	}, {
+		name:      "min and max height",
+		minHeight: fn.Some[uint32](100),
+		maxHeight: fn.Some[uint32](200),
+		expected:  []uint32{0, 100, 200},
+	}, {
+		name:      "empty range",
+		minHeight: fn.Some[uint32](301),
+		expected:  []uint32{0},
+	}}
+
+	for _, tc := range testCases {
+		expected := fn.Map(tc.expected, func(height uint32) [32]byte {
+			return keysByHeight[height].UniverseKey()
+		})
+
+		q := universe.UniverseLeafKeysQuery{
+			Id:             id,
+			MinBlockHeight: tc.minHeight,
+			MaxBlockHeight: tc.maxHeight,
+		}
+		for _, archive := range archives {
+			keys, err := archive.UniverseLeafKeys(ctx, q)
+			require.NoError(t, err, tc.name)
+			require.ElementsMatch(
+				t, expected,
+				fn.Map(keys, universe.LeafKey.UniverseKey),
+				tc.name,
+			)
+		}
+	}
+}
+
+// TestUniverseLeafExtensions tests that the extensions of a universe leaf are
+// stored alongside the leaf, and that they don't change the universe root.
+func TestUniverseLeafExtensions(t *testing.T) {
+	t.Parallel()
+
+	db := NewTestDB(t)
+	dbMultiverse, _ := newTestMultiverseWithDb(db.BaseDB)
+	memMultiverse := universe.NewMemMultiverse()
+	ctx := context.Background()
+
+	id := randUniverseID(t, false)
+	assetGen := asset.RandGenesis(t, asset.Normal)
+	id.AssetID = assetGen.ID()
+
+	key := randLeafKey(t)
+	leaf := randMintingLeaf(t, assetGen, id.GroupKey)
+
+	extensions := universe.LeafExtensions{
+		1: []byte("anchor height"),
+		3: []byte("courier hint"),
+	}
+	leafWithExt := leaf
+	leafWithExt.Extensions = extensions
+
+	for _, archive := range []universe.MultiverseArchive{
+		dbMultiverse, memMultiverse,
+	} {
+		// The extensions aren't part of the leaf node, so replacing
+		// the plain leaf with one with extensions keeps the root.
+		plainProof, err := archive.UpsertProofLeaf(
+			ctx, id, key, &leaf, nil,
+		)
+		require.NoError(t, err)
+
+		extProof, err := archive.UpsertProofLeaf(
+			ctx, id, key, &leafWithExt, nil,
+		)
+		require.NoError(t, err)
+		require.True(t, mssmt.IsEqualNode(
+			plainProof.UniverseRoot, extProof.UniverseRoot,
+		))
+
+		proofs, err := archive.FetchProofLeaf(ctx, id, key)
+		require.NoError(t, err)
+		require.Len(t, proofs, 1)
+		require.Equal(t, extensions, proofs[0].Leaf.Extensions)
+	}
+
+	// Upserting the leaf again without extensions keeps the existing
+	// ones in the database.
+	_, err := dbMultiverse.UpsertProofLeaf(ctx, id, key, &leaf, nil)
+	require.NoError(t, err)
+
+	dbMultiverse, _ = newTestMultiverseWithDb(db.BaseDB)
+	proofs, err := dbMultiverse.FetchProofLeaf(ctx, id, key)
+	require.NoError(t, err)
+	require.Len(t, proofs, 1)
+	require.Equal(t, extensions, proofs[0].Leaf.Extensions)
+}
+
+// TestMultiverseQuarantineLeaf tests that quarantined leaves are removed from
+// their universe, and that the universe and multiverse roots are updated
+// accordingly.
+func TestMultiverseQuarantineLeaf(t *testing.T) {
+	t.Parallel()
+
+	multiverse, _ := newTestMultiverse(t)
+	memMultiverse := universe.NewMemMultiverse()
+	ctx := context.Background()
+
+	const numLeaves = 3
+
+	id := randUniverseID(t, false)
+	id.ProofType = universe.ProofTypeIssuance
+
+	// We also insert another universe to make sure it isn't affected by
+	// quarantining the leaves of the first one.
+	otherID := randUniverseID(t, false)
+	otherID.ProofType = universe.ProofTypeIssuance
+
+	keys := make([]universe.LeafKey, numLeaves)
+	leaves := make([]universe.Leaf, numLeaves)
+	for i := range keys {
+		assetGen := asset.RandGenesis(t, asset.Normal)
+		leaves[i] = randMintingLeaf(t, assetGen, id.GroupKey)
+		keys[i] = randLeafKey(t)
+
+		_, err := multiverse.UpsertProofLeaf(
+			ctx, id, keys[i], &leaves[i], nil,
+		)
+		require.NoError(t, err)
+	}
+
+	otherLeaf := randMintingLeaf(
+		t, asset.RandGenesis(t, asset.Normal), otherID.GroupKey,
+	)
+	otherKey := randLeafKey(t)
+	_, err := multiverse.UpsertProofLeaf(
+		ctx, otherID, otherKey, &otherLeaf, nil,
+	)
+	require.NoError(t, err)
+	_, err = memMultiverse.UpsertProofLeaf(
+		ctx, otherID, otherKey, &otherLeaf, nil,
+	)
+	require.NoError(t, err)
+
+	// The in-memory multiverse only receives the leaves that aren't
+	// quarantined, so its roots are the expected roots after the
+	// quarantine.
+	for i := 1; i < numLeaves; i++ {
+		_, err := memMultiverse.UpsertProofLeaf(
+			ctx, id, keys[i], &leaves[i], nil,
+		)
+		require.NoError(t, err)
+	}
+
+	// We fetch the leaf first, so it is cached.
+	_, err = multiverse.FetchProofLeaf(ctx, id, keys[0])
+	require.NoError(t, err)
+
+	err = multiverse.QuarantineLeaf(ctx, id, keys[0], "corrupted")
+	require.NoError(t, err)
+
+	_, err = multiverse.FetchProofLeaf(ctx, id, keys[0])
+	require.ErrorIs(t, err, universe.ErrNoUniverseProofFound)
+
+	leafKeys, err := multiverse.UniverseLeafKeys(
+		ctx, universe.UniverseLeafKeysQuery{
+			Id: id,
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, leafKeys, numLeaves-1)
+
+	assertRoots := func() {
+		t.Helper()
+
+		for _, uniID := range []universe.Identifier{id, otherID} {
+			dbRoot, dbErr := multiverse.UniverseRootNode(ctx, uniID)
+			memRoot, memErr := memMultiverse.UniverseRootNode(
+				ctx, uniID,
+			)
+			require.Equal(t, memErr, dbErr)
+			if memErr != nil {
+				continue
+			}
+
+			require.True(
+				t, mssmt.IsEqualNode(memRoot.Node, dbRoot.Node),
+			)
+		}
+
+		dbRoot, err := multiverse.MultiverseRootNode(
+			ctx, universe.ProofTypeIssuance,
+		)
+		require.NoError(t, err)
+		memRoot, err := memMultiverse.MultiverseRootNode(
+			ctx, universe.ProofTypeIssuance,
+		)
+		require.NoError(t, err)
+		require.True(t, mssmt.IsEqualNode(
+			memRoot.UnwrapToPtr().Node, dbRoot.UnwrapToPtr().Node,
+		))
+	}
+	assertRoots()
+
+	// The leaf is kept in the quarantine table, together with the reason.
+	quarantined, err := multiverse.QuarantinedLeaves(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, quarantined, 1)
+
+	smtKey := keys[0].UniverseKey()
+	require.Equal(t, smtKey[:], quarantined[0].LeafNodeKey)
+	require.EqualValues(t, leaves[0].RawProof, quarantined[0].RawProof)
+	require.EqualValues(t, leaves[0].Amt, quarantined[0].LeafSum.Int64)
+	require.Equal(t, "corrupted", quarantined[0].Reason)
+
+	// Once the remaining leaves are quarantined as well, the universe is
+	// removed completely.
+	for i := 1; i < numLeaves; i++ {
+		err := multiverse.QuarantineLeaf(ctx, id, keys[i], "corrupted")
+		require.NoError(t, err)
+	}
+	_, err = memMultiverse.DeleteUniverse(ctx, id)
+	require.NoError(t, err)
+
+	assertRoots()
+
+	quarantined, err = multiverse.QuarantinedLeaves(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, quarantined, numLeaves)
+}
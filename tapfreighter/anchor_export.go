@@ -0,0 +1,254 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/tapsend"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+var (
+	// ErrAnchorExportNotFound is returned if a signed anchor transaction is
+	// imported or an export is cancelled for which no exported anchor
+	// transaction is known.
+	ErrAnchorExportNotFound = errors.New("anchor export not found")
+
+	// ErrAnchorExportUnsupported is returned if an anchor transaction
+	// should be exported but no anchor export log is configured.
+	ErrAnchorExportUnsupported = errors.New("external anchor signing " +
+		"not supported")
+)
+
+// AnchorExport is a funded but unsigned anchor transaction of a transfer that
+// is signed by an external signer, for example a hardware wallet or an
+// air-gapped machine. The virtual packets are already signed on the asset
+// level.
+type AnchorExport struct {
+	// FundedPsbt is the funded anchor transaction that needs to be signed
+	// by the external signer.
+	FundedPsbt *tapsend.FundedPsbt
+
+	// VirtualPackets is the list of active virtual packets of the
+	// transfer.
+	VirtualPackets []*tappsbt.VPacket
+
+	// PassiveAssets is the list of virtual packets that re-anchor the
+	// passive assets of the transfer.
+	PassiveAssets []*tappsbt.VPacket
+
+	// TargetFeeRate is the fee rate the anchor transaction was funded
+	// with.
+	TargetFeeRate chainfee.SatPerKWeight
+
+	// CreationTime is the time the anchor transaction was exported.
+	CreationTime time.Time
+}
+
+// AnchorTxHash returns the hash of the anchor transaction. The inputs of the
+// anchor transaction are all segwit inputs, so the hash doesn't change once
+// the transaction is signed.
+func (e *AnchorExport) AnchorTxHash() chainhash.Hash {
+	return e.FundedPsbt.Pkt.UnsignedTx.TxHash()
+}
+
+// AnchorExportLog is used to persist the anchor transactions that were
+// exported for external signing, so the transfer can be continued once the
+// signed transaction is imported, even after a restart.
+type AnchorExportLog interface {
+	// StoreAnchorExport stores the given anchor export.
+	StoreAnchorExport(ctx context.Context, export *AnchorExport) error
+
+	// FetchAnchorExport returns the anchor export with the given anchor
+	// transaction hash. If none is known, ErrAnchorExportNotFound is
+	// returned.
+	FetchAnchorExport(ctx context.Context,
+		anchorTxHash chainhash.Hash) (*AnchorExport, error)
+
+	// PendingAnchorExports returns all anchor exports whose signed anchor
+	// transaction wasn't imported yet.
+	PendingAnchorExports(ctx context.Context) ([]*AnchorExport, error)
+
+	// DeleteAnchorExport removes the anchor export with the given anchor
+	// transaction hash.
+	DeleteAnchorExport(ctx context.Context,
+		anchorTxHash chainhash.Hash) error
+}
+
+// RequestAnchorExport requests a transfer whose anchor transaction is signed
+// externally. The porter carries out the transfer up to the point where the
+// anchor transaction needs to be signed. The funded anchor transaction is
+// then persisted and returned instead of being signed by the wallet. The
+// transfer is continued with ImportSignedAnchor.
+//
+// NOTE: The asset inputs of the transfer are only leased for the default
+// coin lease duration until the signed anchor transaction is imported.
+func (p *ChainPorter) RequestAnchorExport(req Parcel) (*AnchorExport, error) {
+	if p.cfg.AnchorExportLog == nil {
+		return nil, ErrAnchorExportUnsupported
+	}
+
+	// Only parcels that pass through the anchor signing state can be
+	// signed externally.
+	switch req.(type) {
+	case *AddressParcel, *PreSignedParcel:
+	default:
+		return nil, fmt.Errorf("parcel of type %T can't be signed "+
+			"externally", req)
+	}
+
+	err := req.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate parcel: %w", err)
+	}
+
+	kit := req.kit()
+	kit.exportChan = make(chan *AnchorExport, 1)
+
+	if !fn.SendOrQuit(p.exportReqs, req, p.Quit) {
+		return nil, fmt.Errorf("ChainPorter shutting down")
+	}
+
+	select {
+	case err := <-kit.errChan:
+		return nil, err
+
+	case export := <-kit.exportChan:
+		return export, nil
+
+	case <-p.Quit:
+		return nil, fmt.Errorf("ChainPorter shutting down")
+	}
+}
+
+// exportAnchor persists the funded anchor transaction of the given package
+// and delivers it to the caller that requested the export.
+func (p *ChainPorter) exportAnchor(ctx context.Context,
+	pkg *sendPackage) error {
+
+	export := &AnchorExport{
+		FundedPsbt:     pkg.AnchorTx.FundedPsbt,
+		VirtualPackets: pkg.VirtualPackets,
+		PassiveAssets:  pkg.PassiveAssets,
+		TargetFeeRate:  pkg.AnchorTx.TargetFeeRate,
+		CreationTime:   time.Now(),
+	}
+
+	log.Infof("Exporting anchor_txid=%v for external signing",
+		export.AnchorTxHash())
+
+	err := p.cfg.AnchorExportLog.StoreAnchorExport(ctx, export)
+	if err != nil {
+		return fmt.Errorf("unable to store anchor export: %w", err)
+	}
+
+	pkg.Parcel.kit().exportChan <- export
+
+	return nil
+}
+
+// ImportSignedAnchor continues the transfer of a previously exported anchor
+// transaction with the given, externally signed version of it. The signed
+// anchor transaction is finalized, and the transfer is then logged and
+// broadcast like any other transfer.
+func (p *ChainPorter) ImportSignedAnchor(ctx context.Context,
+	signedPkt *psbt.Packet) (*OutboundParcel, error) {
+
+	if p.cfg.AnchorExportLog == nil {
+		return nil, ErrAnchorExportUnsupported
+	}
+
+	anchorTxHash := signedPkt.UnsignedTx.TxHash()
+	export, err := p.cfg.AnchorExportLog.FetchAnchorExport(
+		ctx, anchorTxHash,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch anchor export: %w", err)
+	}
+
+	log.Infof("Importing signed anchor_txid=%v", anchorTxHash)
+
+	anchorTx, err := p.cfg.AssetWallet.FinalizeAnchorVirtualTransactions(
+		&AnchorVTxnsParams{
+			FeeRate:        export.TargetFeeRate,
+			ActivePackets:  export.VirtualPackets,
+			PassivePackets: export.PassiveAssets,
+		}, export.FundedPsbt, signedPkt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to finalize anchor "+
+			"transaction: %w", err)
+	}
+
+	// The transfer was validated and screened before the anchor
+	// transaction was exported, and the finalized transaction matches the
+	// exported one. So we can continue with logging the transfer.
+	parcel, err := p.RequestShipment(NewPreAnchoredParcel(
+		export.VirtualPackets, export.PassiveAssets, anchorTx,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	// The transfer is now tracked by the export log, so the export is no
+	// longer needed.
+	err = p.cfg.AnchorExportLog.DeleteAnchorExport(ctx, anchorTxHash)
+	if err != nil {
+		log.Warnf("Unable to delete anchor export %v: %v",
+			anchorTxHash, err)
+	}
+
+	return parcel, nil
+}
+
+// CancelAnchorExport cancels the transfer of the exported anchor transaction
+// with the given hash. The export is removed and the BTC inputs the wallet
+// added to pay for the fees are released. The asset inputs are released once
+// their lease expires.
+func (p *ChainPorter) CancelAnchorExport(ctx context.Context,
+	anchorTxHash chainhash.Hash) error {
+
+	if p.cfg.AnchorExportLog == nil {
+		return ErrAnchorExportUnsupported
+	}
+
+	export, err := p.cfg.AnchorExportLog.FetchAnchorExport(
+		ctx, anchorTxHash,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to fetch anchor export: %w", err)
+	}
+
+	log.Infof("Cancelling anchor export anchor_txid=%v", anchorTxHash)
+
+	err = p.cfg.AnchorExportLog.DeleteAnchorExport(ctx, anchorTxHash)
+	if err != nil {
+		return fmt.Errorf("unable to delete anchor export: %w", err)
+	}
+
+	p.unlockInputs(ctx, &sendPackage{
+		AnchorTx: &tapsend.AnchorTransaction{
+			FundedPsbt: export.FundedPsbt,
+		},
+	})
+
+	return nil
+}
+
+// PendingAnchorExports returns all anchor transactions that were exported for
+// external signing but whose signed version wasn't imported yet.
+func (p *ChainPorter) PendingAnchorExports(
+	ctx context.Context) ([]*AnchorExport, error) {
+
+	if p.cfg.AnchorExportLog == nil {
+		return nil, nil
+	}
+
+	return p.cfg.AnchorExportLog.PendingAnchorExports(ctx)
+}
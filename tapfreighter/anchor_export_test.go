@@ -0,0 +1,127 @@
+package tapfreighter
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapsend"
+	"github.com/stretchr/testify/require"
+)
+
+// mockAnchorExportLog is an in-memory anchor export log.
+type mockAnchorExportLog struct {
+	exports map[chainhash.Hash]*AnchorExport
+}
+
+func (m *mockAnchorExportLog) StoreAnchorExport(_ context.Context,
+	export *AnchorExport) error {
+
+	m.exports[export.AnchorTxHash()] = export
+	return nil
+}
+
+func (m *mockAnchorExportLog) FetchAnchorExport(_ context.Context,
+	anchorTxHash chainhash.Hash) (*AnchorExport, error) {
+
+	export, ok := m.exports[anchorTxHash]
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", ErrAnchorExportNotFound,
+			anchorTxHash)
+	}
+
+	return export, nil
+}
+
+func (m *mockAnchorExportLog) PendingAnchorExports(
+	context.Context) ([]*AnchorExport, error) {
+
+	exports := make([]*AnchorExport, 0, len(m.exports))
+	for _, export := range m.exports {
+		exports = append(exports, export)
+	}
+
+	return exports, nil
+}
+
+func (m *mockAnchorExportLog) DeleteAnchorExport(_ context.Context,
+	anchorTxHash chainhash.Hash) error {
+
+	delete(m.exports, anchorTxHash)
+	return nil
+}
+
+// TestAnchorExport tests that exported anchor transactions can only be
+// requested for parcels that pass through the anchor signing state, and that
+// cancelling an export releases the BTC inputs the wallet locked for it.
+func TestAnchorExport(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Without an anchor export log, anchor transactions can't be signed
+	// externally.
+	porter := NewChainPorter(&ChainPorterConfig{})
+	_, err := porter.RequestAnchorExport(NewPreSignedParcel(nil, nil))
+	require.ErrorIs(t, err, ErrAnchorExportUnsupported)
+
+	exportLog := &mockAnchorExportLog{
+		exports: make(map[chainhash.Hash]*AnchorExport),
+	}
+	wallet := &mockCancelWallet{}
+	porter = NewChainPorter(&ChainPorterConfig{
+		Wallet:          wallet,
+		AnchorExportLog: exportLog,
+	})
+
+	// Pre-anchored parcels are already signed.
+	_, err = porter.RequestAnchorExport(
+		NewPreAnchoredParcel(nil, nil, nil),
+	)
+	require.ErrorContains(t, err, "can't be signed externally")
+
+	// The first input of the anchor transaction spends assets, the second
+	// one was added by the wallet to pay for fees.
+	fundedTx := wire.NewMsgTx(2)
+	fundedTx.AddTxIn(&wire.TxIn{PreviousOutPoint: test.RandOp(t)})
+	fundedTx.AddTxIn(&wire.TxIn{PreviousOutPoint: test.RandOp(t)})
+	fundedTx.AddTxOut(&wire.TxOut{Value: 1000})
+	pkt, err := psbt.NewFromUnsignedTx(fundedTx)
+	require.NoError(t, err)
+
+	feeInput := fundedTx.TxIn[1].PreviousOutPoint
+	export := &AnchorExport{
+		FundedPsbt: &tapsend.FundedPsbt{
+			Pkt:         pkt,
+			LockedUTXOs: []wire.OutPoint{feeInput},
+		},
+	}
+	require.NoError(t, exportLog.StoreAnchorExport(ctx, export))
+
+	pending, err := porter.PendingAnchorExports(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+
+	// Importing an anchor transaction that wasn't exported fails.
+	otherTx := fundedTx.Copy()
+	otherTx.TxIn[1].PreviousOutPoint = test.RandOp(t)
+	otherPkt, err := psbt.NewFromUnsignedTx(otherTx)
+	require.NoError(t, err)
+	_, err = porter.ImportSignedAnchor(ctx, otherPkt)
+	require.ErrorIs(t, err, ErrAnchorExportNotFound)
+
+	// Cancelling the export removes it and unlocks the fee input.
+	err = porter.CancelAnchorExport(ctx, otherTx.TxHash())
+	require.ErrorIs(t, err, ErrAnchorExportNotFound)
+
+	require.NoError(t, porter.CancelAnchorExport(ctx, fundedTx.TxHash()))
+	require.Equal(t, []wire.OutPoint{feeInput}, wallet.unlocked)
+
+	pending, err = porter.PendingAnchorExports(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+}
@@ -0,0 +1,137 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+var (
+	// ErrParcelNotCancellable is returned if a parcel should be cancelled
+	// that isn't known to be waiting for its anchor transaction to be
+	// broadcast.
+	ErrParcelNotCancellable = errors.New("parcel is not waiting for " +
+		"broadcast")
+
+	// ErrParcelCancelled is returned if the anchor transaction of a parcel
+	// should be broadcast after the parcel was cancelled.
+	ErrParcelCancelled = errors.New("parcel was cancelled")
+
+	// ErrAnchorTxPublished is returned if a parcel should be cancelled
+	// whose anchor transaction is known to the wallet, either because it
+	// is in the mempool or because it confirmed.
+	ErrAnchorTxPublished = errors.New("anchor transaction was published")
+)
+
+// CancelParcel cancels the pending parcel with the given anchor transaction
+// hash, which must have been logged to disk but not yet broadcast
+// successfully. This is the case if the broadcast of the anchor transaction
+// failed or if the daemon restarted before the broadcast completed. The
+// parcel is removed from disk, its asset and BTC inputs are released and its
+// sends are removed from the send quota log again.
+//
+// Parcels in any state before they are logged to disk don't need to be
+// cancelled, as a failure in those states releases their inputs and nothing
+// is persisted.
+//
+// If the anchor transaction was ever handed to the chain backend, it might
+// have reached the network even if the broadcast reported an error. Such a
+// parcel is only cancelled if the wallet knows of neither an unconfirmed nor
+// a confirmed transaction with the anchor transaction hash.
+func (p *ChainPorter) CancelParcel(ctx context.Context,
+	anchorTxHash chainhash.Hash) (*OutboundParcel, error) {
+
+	// We hold the lock for the whole cancellation, so the parcel can't be
+	// broadcast in the meantime.
+	p.unbroadcastMtx.Lock()
+	defer p.unbroadcastMtx.Unlock()
+
+	parcel, ok := p.unbroadcast[anchorTxHash]
+	if !ok {
+		return nil, fmt.Errorf("%w: anchor_txid=%v",
+			ErrParcelNotCancellable, anchorTxHash)
+	}
+
+	if parcel.BroadcastAttempted {
+		published, err := p.anchorTxPublished(ctx, anchorTxHash)
+		if err != nil {
+			return nil, err
+		}
+		if published {
+			return nil, fmt.Errorf("%w: anchor_txid=%v",
+				ErrAnchorTxPublished, anchorTxHash)
+		}
+	}
+
+	sends, err := parcelSends(parcel)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive sends of parcel: %w",
+			err)
+	}
+
+	log.Infof("Cancelling transfer anchor_txid=%v", anchorTxHash)
+
+	err = p.cfg.ExportLog.DeletePendingParcel(ctx, anchorTxHash)
+	if err != nil {
+		return nil, fmt.Errorf("unable to delete pending parcel: %w",
+			err)
+	}
+	delete(p.unbroadcast, anchorTxHash)
+
+	p.revertSends(ctx, sends)
+
+	// The asset inputs were released together with the parcel, the
+	// remaining inputs of the anchor transaction were added by the wallet
+	// to pay for the fees and need to be unlocked.
+	assetInputs := fn.NewSet(fn.Map(
+		parcel.Inputs, func(in TransferInput) wire.OutPoint {
+			return in.OutPoint
+		},
+	)...)
+	for _, txIn := range parcel.AnchorTx.TxIn {
+		op := txIn.PreviousOutPoint
+		if assetInputs.Contains(op) {
+			continue
+		}
+
+		err := p.cfg.Wallet.UnlockInput(ctx, op)
+		if err != nil {
+			log.Warnf("Unable to unlock input %v: %v", op, err)
+		}
+	}
+
+	return parcel, nil
+}
+
+// trackUnbroadcast marks the given parcel as logged to disk but not yet
+// broadcast, which allows it to be cancelled.
+func (p *ChainPorter) trackUnbroadcast(parcel *OutboundParcel) {
+	p.unbroadcastMtx.Lock()
+	defer p.unbroadcastMtx.Unlock()
+
+	p.unbroadcast[parcel.AnchorTx.TxHash()] = parcel
+}
+
+// anchorTxPublished returns true if the wallet knows of a transaction with the
+// given hash, either in the mempool or in the chain.
+func (p *ChainPorter) anchorTxPublished(ctx context.Context,
+	anchorTxHash chainhash.Hash) (bool, error) {
+
+	walletTxns, err := p.cfg.Wallet.ListTransactions(ctx, 0, -1, "")
+	if err != nil {
+		return false, fmt.Errorf("unable to list wallet transactions: "+
+			"%w", err)
+	}
+
+	for _, walletTx := range walletTxns {
+		if walletTx.TxHash == anchorTxHash.String() {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
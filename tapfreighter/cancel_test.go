@@ -0,0 +1,157 @@
+package tapfreighter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// mockCancelExportLog is an export log that only records deleted parcels.
+type mockCancelExportLog struct {
+	ExportLog
+
+	deleted []chainhash.Hash
+}
+
+func (m *mockCancelExportLog) DeletePendingParcel(_ context.Context,
+	anchorTxHash chainhash.Hash) error {
+
+	m.deleted = append(m.deleted, anchorTxHash)
+	return nil
+}
+
+// mockCancelWallet is a wallet anchor that records unlocked inputs and knows
+// a static set of transactions.
+type mockCancelWallet struct {
+	WalletAnchor
+
+	unlocked []wire.OutPoint
+
+	txns []lndclient.Transaction
+}
+
+func (m *mockCancelWallet) ListTransactions(context.Context, int32, int32,
+	string) ([]lndclient.Transaction, error) {
+
+	return m.txns, nil
+}
+
+func (m *mockCancelWallet) UnlockInput(_ context.Context,
+	op wire.OutPoint) error {
+
+	m.unlocked = append(m.unlocked, op)
+	return nil
+}
+
+// mockCancelSendQuotaLog is a send quota log that only records reverted
+// sends.
+type mockCancelSendQuotaLog struct {
+	SendQuotaLog
+
+	reverted []DestinationSend
+}
+
+func (m *mockCancelSendQuotaLog) RevertSends(_ context.Context,
+	sends []DestinationSend) error {
+
+	m.reverted = append(m.reverted, sends...)
+	return nil
+}
+
+// TestCancelParcel tests that only parcels that weren't broadcast yet can be
+// cancelled and that cancelling a parcel releases all of its inputs.
+func TestCancelParcel(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	exportLog := &mockCancelExportLog{}
+	wallet := &mockCancelWallet{}
+	quotaLog := &mockCancelSendQuotaLog{}
+	porter := NewChainPorter(&ChainPorterConfig{
+		ExportLog:    exportLog,
+		Wallet:       wallet,
+		SendQuotaLog: quotaLog,
+	})
+
+	// The first input of the anchor transaction spends assets, the second
+	// one was added by the wallet to pay for fees. The output goes to a
+	// remote script key.
+	parcel := newFeeBumpParcel(t)
+	assetInput := parcel.AnchorTx.TxIn[0].PreviousOutPoint
+	feeInput := parcel.AnchorTx.TxIn[1].PreviousOutPoint
+	parcel.Inputs = []TransferInput{{
+		PrevID: asset.PrevID{
+			OutPoint: assetInput,
+		},
+		Amount: 10,
+	}}
+	scriptKey := asset.NewScriptKey(test.RandPubKey(t))
+	parcel.Outputs[0].ScriptKey = scriptKey
+	parcel.Outputs[0].Amount = 10
+	anchorTxHash := parcel.AnchorTx.TxHash()
+
+	// A parcel the porter doesn't know about can't be cancelled.
+	_, err := porter.CancelParcel(ctx, anchorTxHash)
+	require.ErrorIs(t, err, ErrParcelNotCancellable)
+	require.Empty(t, exportLog.deleted)
+
+	porter.trackUnbroadcast(parcel)
+
+	cancelled, err := porter.CancelParcel(ctx, anchorTxHash)
+	require.NoError(t, err)
+	require.Equal(t, parcel, cancelled)
+	require.Equal(t, []chainhash.Hash{anchorTxHash}, exportLog.deleted)
+	require.Equal(t, []wire.OutPoint{feeInput}, wallet.unlocked)
+
+	require.Len(t, quotaLog.reverted, 1)
+	require.Equal(t, scriptKey.PubKey, quotaLog.reverted[0].ScriptKey)
+	require.EqualValues(t, 10, quotaLog.reverted[0].Amount)
+
+	// A parcel can only be cancelled once.
+	_, err = porter.CancelParcel(ctx, anchorTxHash)
+	require.ErrorIs(t, err, ErrParcelNotCancellable)
+}
+
+// TestCancelParcelBroadcastAttempted tests that a parcel whose anchor
+// transaction was handed to the chain backend can only be cancelled if the
+// wallet doesn't know the anchor transaction.
+func TestCancelParcelBroadcastAttempted(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	exportLog := &mockCancelExportLog{}
+	wallet := &mockCancelWallet{}
+	porter := NewChainPorter(&ChainPorterConfig{
+		ExportLog:    exportLog,
+		Wallet:       wallet,
+		SendQuotaLog: &mockCancelSendQuotaLog{},
+	})
+
+	parcel := newFeeBumpParcel(t)
+	parcel.BroadcastAttempted = true
+	anchorTxHash := parcel.AnchorTx.TxHash()
+	porter.trackUnbroadcast(parcel)
+
+	// The anchor transaction is in the mempool, so the parcel must not be
+	// cancelled.
+	wallet.txns = []lndclient.Transaction{{
+		Tx:     parcel.AnchorTx,
+		TxHash: anchorTxHash.String(),
+	}}
+	_, err := porter.CancelParcel(ctx, anchorTxHash)
+	require.ErrorIs(t, err, ErrAnchorTxPublished)
+	require.Empty(t, exportLog.deleted)
+
+	// If the broadcast never reached the wallet, the parcel can be
+	// cancelled.
+	wallet.txns = nil
+	_, err = porter.CancelParcel(ctx, anchorTxHash)
+	require.NoError(t, err)
+	require.Equal(t, []chainhash.Hash{anchorTxHash}, exportLog.deleted)
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
 	"strings"
 	"sync"
 	"time"
@@ -16,6 +17,7 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/compliance"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/tapgarden"
@@ -27,6 +29,13 @@ import (
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
+const (
+	// passiveProofBackupTimeout is the maximum time we spend on pushing a
+	// single passive asset proof to the passive proof backup courier,
+	// including all retries of the courier.
+	passiveProofBackupTimeout = 2 * time.Minute
+)
+
 // ProofImporter is used to import proofs into the local proof archive after we
 // complete a trransfer.
 type ProofImporter interface {
@@ -94,6 +103,60 @@ type ChainPorterConfig struct {
 	// to be confirmed safely with a minimum number of confirmations.
 	ProofWatcher proof.Watcher
 
+	// Compliance is used to screen outbound transfers with an external
+	// policy service before they are committed to. If this is nil, no
+	// screening takes place.
+	Compliance *compliance.Checker
+
+	// SendQuotaLog is used to keep track of the cumulative amounts sent to
+	// each destination and to enforce per-destination send limits. If
+	// this is nil, no send quotas are tracked.
+	SendQuotaLog SendQuotaLog
+
+	// AnchorExportLog is used to persist the anchor transactions that are
+	// exported for external signing. If this is nil, anchor transactions
+	// can't be signed externally.
+	AnchorExportLog AnchorExportLog
+
+	// CourierPreflight is the policy that defines how the proof courier
+	// endpoints of the receivers of a transfer are checked before the
+	// transfer is committed to.
+	CourierPreflight CourierPreflightPolicy
+
+	// CourierProbeTimeout is the maximum time a single proof courier
+	// endpoint is probed for during the pre-flight check. If this is zero,
+	// DefaultCourierPreflightTimeout is used.
+	CourierProbeTimeout time.Duration
+
+	// PassiveProofBackupAddr is the address of an off-site proof courier,
+	// usually a universe server, that the updated proof files of passive
+	// assets are pushed to after each transfer. This allows the passive
+	// assets to be recovered even if the local proof archive is lost
+	// before the next backup. If this is nil, no proofs are pushed.
+	PassiveProofBackupAddr *url.URL
+
+	// SendBatchWindow is the amount of time address parcels are held back
+	// for after the first one is requested, so all parcels requested
+	// within the window can be shipped in a single anchor transaction to
+	// amortize the chain fees. Only parcels that use the same fee rate,
+	// coin selection strategy, address version and number of
+	// confirmations are batched. If any parcel of a batch fails, the
+	// error is reported to all of them. If this is zero, each parcel is
+	// shipped on its own right away.
+	SendBatchWindow time.Duration
+
+	// IncrementalRelayFee is the incremental relay fee rate of the chain
+	// backend. When the fee of a transfer is bumped, the replacement
+	// anchor transaction needs to pay at least this fee rate for its own
+	// size on top of the fee of the replaced transaction. If this is zero,
+	// DefaultIncrementalRelayFee is used.
+	IncrementalRelayFee chainfee.SatPerKWeight
+
+	// AnchorOrdering is the policy used to order the outputs of anchor
+	// transactions. It's applied again to the outputs of a replacement
+	// anchor transaction when the fee of a transfer is bumped.
+	AnchorOrdering tapsend.OutputOrdering
+
 	// ErrChan is the main error channel the custodian will report back
 	// critical errors to the main server.
 	ErrChan chan<- error
@@ -119,6 +182,27 @@ type ChainPorter struct {
 	// subscriberMtx guards the subscribers map.
 	subscriberMtx sync.Mutex
 
+	// confWaiters is a map of the channels that notify the transfers that
+	// wait for their anchor transaction to confirm about a replacement of
+	// that transaction, keyed by the hash of the awaited transaction.
+	confWaiters map[chainhash.Hash]chan *OutboundParcel
+
+	// confWaitersMtx guards the confWaiters map. It is also held for the
+	// whole duration of a fee bump.
+	confWaitersMtx sync.Mutex
+
+	// unbroadcast is the set of parcels that were logged to disk but whose
+	// anchor transaction hasn't been broadcast successfully yet, keyed by
+	// the hash of their anchor transaction. Only those parcels can be
+	// cancelled.
+	unbroadcast map[chainhash.Hash]*OutboundParcel
+
+	// unbroadcastMtx guards the unbroadcast map. It is also held while
+	// broadcasting an anchor transaction and for the whole duration of a
+	// cancellation, so a parcel can't be broadcast and cancelled at the
+	// same time.
+	unbroadcastMtx sync.Mutex
+
 	*fn.ContextGuard
 }
 
@@ -132,6 +216,8 @@ func NewChainPorter(cfg *ChainPorterConfig) *ChainPorter {
 		cfg:         cfg,
 		exportReqs:  make(chan Parcel),
 		subscribers: subscribers,
+		confWaiters: make(map[chainhash.Hash]chan *OutboundParcel),
+		unbroadcast: make(map[chainhash.Hash]*OutboundParcel),
 		ContextGuard: &fn.ContextGuard{
 			DefaultTimeout: tapgarden.DefaultTimeout,
 			Quit:           make(chan struct{}),
@@ -169,6 +255,12 @@ func (p *ChainPorter) Start() error {
 				"anchor_txid=%v",
 				outboundParcel.AnchorTx.TxHash().String())
 
+			// The parcel remains cancellable until it is broadcast
+			// again. If it was handed to the chain backend before
+			// the restart, a cancellation first checks that the
+			// anchor transaction didn't make it to the network.
+			p.trackUnbroadcast(outboundParcel)
+
 			// At this point the asset porter should be running.
 			// It should therefore pick up the pending parcels from
 			// the channel and attempt to deliver them.
@@ -245,9 +337,42 @@ func (p *ChainPorter) QueryParcels(ctx context.Context,
 func (p *ChainPorter) assetsPorter() {
 	defer p.Wg.Done()
 
+	// If batching is enabled, address parcels are collected in batches
+	// until the batching window that starts with the first of them closes.
+	var (
+		batches    = make(map[parcelBatchKey]*parcelBatch)
+		batchTimer <-chan time.Time
+	)
+
 	for {
 		select {
 		case req := <-p.exportReqs:
+			addrParcel, ok := p.batchableParcel(req)
+			if ok {
+				key := newParcelBatchKey(addrParcel)
+				batch, ok := batches[key]
+				if !ok {
+					batch = newParcelBatch()
+					batches[key] = batch
+				}
+
+				if batch.add(addrParcel) {
+					if batchTimer == nil {
+						batchTimer = time.After(
+							p.cfg.SendBatchWindow,
+						)
+					}
+
+					continue
+				}
+
+				// The parcel sends to an address that is
+				// already part of the batch, so it is shipped
+				// on its own.
+				log.Debugf("Not batching parcel that sends " +
+					"to an address of the pending batch")
+			}
+
 			// The request either has a destination address we want
 			// to send to, or a send package is already initialized.
 			sendPkg := req.pkg()
@@ -258,6 +383,14 @@ func (p *ChainPorter) assetsPorter() {
 			// package.
 			go p.advanceState(sendPkg, req.kit())
 
+		case <-batchTimer:
+			for _, batch := range batches {
+				p.shipBatch(batch)
+			}
+
+			batches = make(map[parcelBatchKey]*parcelBatch)
+			batchTimer = nil
+
 		case <-p.Quit:
 			return
 		}
@@ -319,12 +452,14 @@ func (p *ChainPorter) waitForTransferTxConf(pkg *sendPackage) error {
 	outboundPkg := pkg.OutboundPkg
 
 	txHash := outboundPkg.AnchorTx.TxHash()
-	log.Infof("Waiting for confirmation of transfer_txid=%v", txHash)
+	numConfs := max(outboundPkg.NumConfs, 1)
+	log.Infof("Waiting for %d confirmation(s) of transfer_txid=%v",
+		numConfs, txHash)
 
 	confCtx, confCancel := p.WithCtxQuitNoTimeout()
 	confNtfn, errChan, err := p.cfg.ChainBridge.RegisterConfirmationsNtfn(
-		confCtx, &txHash, outboundPkg.AnchorTx.TxOut[0].PkScript, 1,
-		outboundPkg.AnchorTxHeightHint, true, nil,
+		confCtx, &txHash, outboundPkg.AnchorTx.TxOut[0].PkScript,
+		numConfs, outboundPkg.AnchorTxHeightHint, true, nil,
 	)
 	if err != nil {
 		return fmt.Errorf("unable to register for package tx conf: %w",
@@ -334,6 +469,12 @@ func (p *ChainPorter) waitForTransferTxConf(pkg *sendPackage) error {
 	// Launch a goroutine that'll notify us when the transaction confirms.
 	defer confCancel()
 
+	// If the fee of the transfer is bumped, the anchor transaction we're
+	// waiting for is replaced, and we need to wait for the replacement
+	// instead.
+	replacedChan := p.registerConfWaiter(txHash)
+	defer p.removeConfWaiter(txHash)
+
 	var confEvent *chainntnfs.TxConfirmation
 	select {
 	case confEvent = <-confNtfn.Confirmed:
@@ -341,6 +482,16 @@ func (p *ChainPorter) waitForTransferTxConf(pkg *sendPackage) error {
 		pkg.TransferTxConfEvent = confEvent
 		pkg.SendState = SendStateStoreProofs
 
+	case newParcel := <-replacedChan:
+		log.Infof("Transfer_txid=%v was replaced by %v, waiting for "+
+			"replacement to confirm", txHash,
+			newParcel.AnchorTx.TxHash())
+
+		// We remain in the current state, so we'll wait for the
+		// replacement transaction next.
+		pkg.replaceAnchorTx(newParcel)
+		return nil
+
 	case err := <-errChan:
 		return fmt.Errorf("error whilst waiting for package tx "+
 			"confirmation: %w", err)
@@ -766,6 +917,9 @@ func (p *ChainPorter) transferReceiverProof(pkg *sendPackage) error {
 
 	// Load passive asset proof files from archive.
 	passiveAssetProofFiles := map[asset.ID][]*proof.AnnotatedProof{}
+	passiveBackups := make(
+		[]passiveProofBackup, 0, len(pkg.OutboundPkg.PassiveAssets),
+	)
 	for idx := range pkg.OutboundPkg.PassiveAssets {
 		passivePkt := pkg.OutboundPkg.PassiveAssets[idx]
 		passiveOut := passivePkt.Outputs[0]
@@ -783,13 +937,22 @@ func (p *ChainPorter) transferReceiverProof(pkg *sendPackage) error {
 				"proof file: %w", err)
 		}
 
+		passiveProof := &proof.AnnotatedProof{
+			Locator: proofLocator,
+			Blob:    proofFileBlob,
+		}
 		passiveAssetProofFiles[passiveOut.Asset.ID()] = append(
 			passiveAssetProofFiles[passiveOut.Asset.ID()],
-			&proof.AnnotatedProof{
-				Locator: proofLocator,
-				Blob:    proofFileBlob,
-			},
+			passiveProof,
 		)
+		passiveBackups = append(passiveBackups, passiveProofBackup{
+			recipient: proof.Recipient{
+				ScriptKey: passiveOut.ScriptKey.PubKey,
+				AssetID:   passiveOut.Asset.ID(),
+				Amount:    passiveOut.Asset.Amount,
+			},
+			proof: passiveProof,
+		})
 	}
 
 	// At this point we have the confirmation signal, so we can mark the
@@ -813,9 +976,79 @@ func (p *ChainPorter) transferReceiverProof(pkg *sendPackage) error {
 
 	pkg.SendState = SendStateComplete
 
+	// Finally, we push the updated passive asset proofs to the backup
+	// location, if one is configured. A failed backup doesn't affect the
+	// transfer itself, as the proofs are safely stored locally. So we
+	// don't make the caller wait for it.
+	if p.cfg.PassiveProofBackupAddr != nil && len(passiveBackups) > 0 {
+		p.Wg.Add(1)
+		go p.backupPassiveProofs(passiveBackups)
+	}
+
 	return nil
 }
 
+// passiveProofBackup is an updated passive asset proof file that is pushed to
+// the passive proof backup courier.
+type passiveProofBackup struct {
+	// recipient identifies the passive asset the proof belongs to.
+	recipient proof.Recipient
+
+	// proof is the updated proof file of the passive asset.
+	proof *proof.AnnotatedProof
+}
+
+// backupPassiveProofs pushes the given passive asset proof files to the
+// configured passive proof backup courier. Each proof gets its own timeout, and
+// failures are only logged, so a failed backup doesn't prevent the remaining
+// proofs from being backed up.
+//
+// NOTE: This method MUST be called as a goroutine.
+func (p *ChainPorter) backupPassiveProofs(backups []passiveProofBackup) {
+	defer p.Wg.Done()
+
+	backupAddr := p.cfg.PassiveProofBackupAddr
+
+	log.Infof("Backing up %d passive asset proofs to %v", len(backups),
+		backupAddr.Host)
+
+	for _, backup := range backups {
+		err := p.backupPassiveProof(backupAddr, backup)
+		if err != nil {
+			scriptKey := backup.recipient.ScriptKey
+			log.Errorf("Unable to back up passive asset proof "+
+				"(asset_id=%v, script_key=%x): %v",
+				backup.recipient.AssetID,
+				scriptKey.SerializeCompressed(), err)
+		}
+
+		select {
+		case <-p.Quit:
+			return
+		default:
+		}
+	}
+}
+
+// backupPassiveProof pushes a single passive asset proof file to the passive
+// proof backup courier at the given address.
+func (p *ChainPorter) backupPassiveProof(backupAddr *url.URL,
+	backup passiveProofBackup) error {
+
+	ctx, cancel := p.WithCtxQuitCustomTimeout(passiveProofBackupTimeout)
+	defer cancel()
+
+	courier, err := p.cfg.ProofCourierDispatcher.NewCourier(
+		backupAddr, backup.recipient,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to initiate courier: %w", err)
+	}
+	defer courier.Close()
+
+	return courier.DeliverProof(ctx, backup.proof)
+}
+
 // importLocalAddresses imports the addresses for outputs that go to ourselves,
 // from the given outbound parcel.
 func (p *ChainPorter) importLocalAddresses(ctx context.Context,
@@ -880,19 +1113,35 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 		defer cancel()
 
 		// We know that the porter is only initialized with this state
-		// for a send to an address parcel. If not, something was called
-		// incorrectly.
-		addrParcel, ok := currentPkg.Parcel.(*AddressParcel)
-		if !ok {
-			return nil, fmt.Errorf("unable to cast parcel to " +
-				"address parcel")
-		}
-		fundSendRes, err := p.cfg.AssetWallet.FundAddressSend(
-			ctx, addrParcel.destAddrs...,
+		// for a send to an address or script key parcel. If not,
+		// something was called incorrectly.
+		var (
+			fundSendRes *FundedVPacket
+			err         error
 		)
-		if err != nil {
-			return nil, fmt.Errorf("unable to fund address send: "+
-				"%w", err)
+		switch parcel := currentPkg.Parcel.(type) {
+		case *AddressParcel:
+			fundSendRes, err = p.cfg.AssetWallet.FundAddressSend(
+				ctx, parcel.coinSelectStrategy,
+				parcel.destAddrs...,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to fund "+
+					"address send: %w", err)
+			}
+
+		case *ScriptKeyParcel:
+			fundSendRes, err = p.cfg.AssetWallet.FundScriptKeySend(
+				ctx, parcel.coinSelectStrategy, parcel.dest,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to fund script "+
+					"key send: %w", err)
+			}
+
+		default:
+			return nil, fmt.Errorf("unable to fund parcel of "+
+				"type %T", currentPkg.Parcel)
 		}
 
 		currentPkg.VirtualPackets = []*tappsbt.VPacket{
@@ -907,12 +1156,39 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 	// At this point, we have everything we need to sign our _virtual_
 	// transaction on the Taproot Asset layer.
 	case SendStateVirtualSign:
+		ctx, cancel := p.WithCtxQuitNoTimeout()
+		defer cancel()
+
 		vPackets := currentPkg.VirtualPackets
 		err := tapsend.ValidateVPacketVersions(vPackets)
 		if err != nil {
 			return nil, err
 		}
 
+		// The passive assets are re-anchored in the same anchor
+		// transaction, so we create their packets before the anchor
+		// output indexes are decided.
+		wallet := p.cfg.AssetWallet
+		currentPkg.PassiveAssets, err = wallet.CreatePassiveAssets(
+			ctx, vPackets, currentPkg.InputCommitments,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create passive "+
+				"assets: %w", err)
+		}
+
+		// With all packets of the anchor transaction known, we apply
+		// the ordering policy to their anchor outputs at once. This
+		// needs to happen before signing, as the anchor output index is
+		// part of the split commitment.
+		err = wallet.OrderAnchorOutputs(
+			ctx, vPackets, currentPkg.PassiveAssets,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to order anchor "+
+				"outputs: %w", err)
+		}
+
 		// Now we'll use the signer to sign all the inputs for the new
 		// Taproot Asset leaves. The witness data for each input will be
 		// assigned for us.
@@ -921,13 +1197,21 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 
 			logPacket(vPkt, "Generating Taproot Asset witnesses")
 
-			_, err := p.cfg.AssetWallet.SignVirtualPacket(vPkt)
+			_, err := wallet.SignVirtualPacket(vPkt)
 			if err != nil {
 				return nil, fmt.Errorf("unable to sign and "+
 					"commit virtual packet: %w", err)
 			}
 		}
 
+		log.Debugf("Signing %d passive assets",
+			len(currentPkg.PassiveAssets))
+		err = wallet.SignPassiveAssets(currentPkg.PassiveAssets)
+		if err != nil {
+			return nil, fmt.Errorf("unable to sign passive "+
+				"assets: %w", err)
+		}
+
 		currentPkg.SendState = SendStateAnchorSign
 
 		return &currentPkg, nil
@@ -946,10 +1230,18 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 		)
 
 		// First, use a manual fee rate if specified by the parcel.
-		addrParcel, ok := currentPkg.Parcel.(*AddressParcel)
+		var manualFeeRate *chainfee.SatPerKWeight
+		switch parcel := currentPkg.Parcel.(type) {
+		case *AddressParcel:
+			manualFeeRate = parcel.transferFeeRate
+
+		case *ScriptKeyParcel:
+			manualFeeRate = parcel.transferFeeRate
+		}
+
 		switch {
-		case ok && addrParcel.transferFeeRate != nil:
-			feeRate = *addrParcel.transferFeeRate
+		case manualFeeRate != nil:
+			feeRate = *manualFeeRate
 			log.Infof("sending with manual fee rate")
 
 		default:
@@ -972,36 +1264,73 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 				"commitments")
 		}
 
-		// Gather passive assets virtual packets and sign them.
+		// Pre-signed parcels skip the virtual signing state, so we
+		// gather their passive assets virtual packets and sign them
+		// here.
 		wallet := p.cfg.AssetWallet
+		_, preSigned := currentPkg.Parcel.(*PreSignedParcel)
+		if preSigned {
+			passiveAssets, err := wallet.CreatePassiveAssets(
+				ctx, currentPkg.VirtualPackets,
+				currentPkg.InputCommitments,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to create "+
+					"passive assets: %w", err)
+			}
 
-		currentPkg.PassiveAssets, err = wallet.CreatePassiveAssets(
-			ctx, currentPkg.VirtualPackets,
-			currentPkg.InputCommitments,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("unable to create passive "+
-				"assets: %w", err)
+			log.Debugf("Signing %d passive assets",
+				len(passiveAssets))
+			err = wallet.SignPassiveAssets(passiveAssets)
+			if err != nil {
+				return nil, fmt.Errorf("unable to sign "+
+					"passive assets: %w", err)
+			}
+
+			currentPkg.PassiveAssets = passiveAssets
 		}
 
-		log.Debugf("Signing %d passive assets",
-			len(currentPkg.PassiveAssets))
-		err = wallet.SignPassiveAssets(currentPkg.PassiveAssets)
-		if err != nil {
-			return nil, fmt.Errorf("unable to sign passive "+
-				"assets: %w", err)
+		anchorParams := &AnchorVTxnsParams{
+			FeeRate:        feeRate,
+			ActivePackets:  currentPkg.VirtualPackets,
+			PassivePackets: currentPkg.PassiveAssets,
 		}
 
-		anchorTx, err := wallet.AnchorVirtualTransactions(
-			ctx, &AnchorVTxnsParams{
-				FeeRate:        feeRate,
-				ActivePackets:  currentPkg.VirtualPackets,
-				PassivePackets: currentPkg.PassiveAssets,
-			},
-		)
-		if err != nil {
-			return nil, fmt.Errorf("unable to anchor virtual "+
-				"transactions: %w", err)
+		// Payments to static addresses are announced to their
+		// receivers with an extra output of the anchor transaction.
+		addrParcel, ok := currentPkg.Parcel.(*AddressParcel)
+		if ok {
+			anchorParams.ExtraOutputs = addrParcel.announcements
+		}
+
+		anchorParams.PreSigned = preSigned
+
+		// If the anchor transaction is signed externally, we only fund
+		// it here. The hash of the final transaction is already known
+		// at this point, as all inputs are segwit inputs.
+		var anchorTx *tapsend.AnchorTransaction
+		if currentPkg.externalAnchorSign() {
+			fundedPsbt, err := wallet.FundAnchorVirtualTransactions(
+				ctx, anchorParams,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to fund anchor "+
+					"transaction: %w", err)
+			}
+
+			anchorTx = &tapsend.AnchorTransaction{
+				FundedPsbt:    fundedPsbt,
+				FinalTx:       fundedPsbt.Pkt.UnsignedTx.Copy(),
+				TargetFeeRate: feeRate,
+			}
+		} else {
+			anchorTx, err = wallet.AnchorVirtualTransactions(
+				ctx, anchorParams,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to anchor "+
+					"virtual transactions: %w", err)
+			}
 		}
 
 		// We keep the original funded PSBT with all the wallet's output
@@ -1031,6 +1360,40 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 				"package: %w", err)
 		}
 
+		// Before we commit to the transfer, we give the compliance
+		// policy service the chance to reject it.
+		err = p.screenTransfer(ctx, &currentPkg)
+		if err != nil {
+			p.unlockInputs(ctx, &currentPkg)
+
+			return nil, err
+		}
+
+		// We also make sure the proofs can be delivered to the
+		// receivers, as they can't claim their assets otherwise.
+		err = p.preflightCouriers(ctx, &currentPkg)
+		if err != nil {
+			p.unlockInputs(ctx, &currentPkg)
+
+			return nil, err
+		}
+
+		// An externally signed anchor transaction is handed to the
+		// caller now. The transfer continues once the signed anchor
+		// transaction is imported.
+		if currentPkg.externalAnchorSign() {
+			err = p.exportAnchor(ctx, &currentPkg)
+			if err != nil {
+				p.unlockInputs(ctx, &currentPkg)
+
+				return nil, err
+			}
+
+			currentPkg.SendState = SendStateAnchorExported
+
+			return &currentPkg, nil
+		}
+
 		currentPkg.SendState = SendStateLogCommit
 
 		return &currentPkg, nil
@@ -1074,10 +1437,28 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 		}
 		currentPkg.OutboundPkg = parcel
 
+		// Transfers to addresses might need to wait for more than a
+		// single confirmation.
+		addrParcel, ok := currentPkg.Parcel.(*AddressParcel)
+		if ok {
+			parcel.NumConfs = addrParcel.NumConfs()
+		}
+
 		// Don't allow shutdown while we're attempting to store proofs.
 		ctx, cancel = p.CtxBlocking()
 		defer cancel()
 
+		// The amounts sent to each destination are recorded before we
+		// commit to the transfer, so a send that exceeds a send limit
+		// is rejected.
+		sends := destinationSends(currentPkg.VirtualPackets, isLocalKey)
+		err = p.recordSends(ctx, sends)
+		if err != nil {
+			p.unlockInputs(ctx, &currentPkg)
+
+			return nil, err
+		}
+
 		log.Infof("Committing pending parcel to disk")
 
 		err = p.cfg.ExportLog.LogPendingParcel(
@@ -1085,12 +1466,17 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 			time.Now().Add(defaultBroadcastCoinLeaseDuration),
 		)
 		if err != nil {
+			p.revertSends(ctx, sends)
 			p.unlockInputs(ctx, &currentPkg)
 
 			return nil, fmt.Errorf("unable to write send pkg to "+
 				"disk: %w", err)
 		}
 
+		// Until its anchor transaction is broadcast, the parcel can
+		// still be cancelled.
+		p.trackUnbroadcast(parcel)
+
 		// We've logged the state transition to disk, so now we can
 		// move onto the broadcast phase.
 		currentPkg.SendState = SendStateBroadcast
@@ -1112,6 +1498,32 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 		}
 
 		txHash := currentPkg.OutboundPkg.AnchorTx.TxHash()
+
+		// We hold the lock during the broadcast, so the parcel can't
+		// be cancelled while its anchor transaction is published.
+		p.unbroadcastMtx.Lock()
+		defer p.unbroadcastMtx.Unlock()
+
+		parcel, ok := p.unbroadcast[txHash]
+		if !ok {
+			return nil, fmt.Errorf("%w: anchor_txid=%v",
+				ErrParcelCancelled, txHash)
+		}
+
+		// Before handing the anchor transaction to the chain backend,
+		// we persist that it might reach the network from now on. A
+		// cancellation then needs to make sure it didn't.
+		if !parcel.BroadcastAttempted {
+			err := p.cfg.ExportLog.MarkParcelBroadcastAttempted(
+				ctx, txHash,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to mark "+
+					"broadcast attempt: %w", err)
+			}
+			parcel.BroadcastAttempted = true
+		}
+
 		log.Infof("Broadcasting new transfer tx, txid=%v", txHash)
 
 		// With the public key imported, we can now broadcast to the
@@ -1142,6 +1554,9 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 				"transaction %v: %w", txHash, err)
 		}
 
+		// Once broadcast, the parcel can no longer be cancelled.
+		delete(p.unbroadcast, txHash)
+
 		// With the transaction broadcast, we'll deliver a
 		// notification via the transaction broadcast response channel.
 		currentPkg.deliverTxBroadcastResp()
@@ -1195,6 +1610,93 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 	}
 }
 
+// screenTransfer screens the outbound transfer of the given package with the
+// compliance policy service, if one is configured.
+func (p *ChainPorter) screenTransfer(ctx context.Context,
+	pkg *sendPackage) error {
+
+	if p.cfg.Compliance == nil {
+		return nil
+	}
+
+	// If we know the addresses we're sending to, we include them in the
+	// transfer context.
+	addrs := make(map[asset.SerializedKey]string)
+	if addrParcel, ok := pkg.Parcel.(*AddressParcel); ok {
+		for _, addr := range addrParcel.destAddrs {
+			addrStr, err := addr.EncodeAddress()
+			if err != nil {
+				return fmt.Errorf("unable to encode address: "+
+					"%w", err)
+			}
+
+			addrs[asset.ToSerialized(&addr.ScriptKey)] = addrStr
+		}
+	}
+
+	transfer := &compliance.Transfer{
+		Direction:  compliance.DirectionSend,
+		AnchorTxid: pkg.AnchorTx.FinalTx.TxHash().String(),
+	}
+	for _, vPkt := range pkg.VirtualPackets {
+		for _, vOut := range vPkt.Outputs {
+			if vOut.Asset == nil {
+				continue
+			}
+
+			transferAsset := compliance.NewTransferAsset(vOut.Asset)
+			transferAsset.AnchorOutputIndex = vOut.AnchorOutputIndex
+
+			scriptKey := vOut.ScriptKey
+			if scriptKey.TweakedScriptKey != nil {
+				transferAsset.Local = p.cfg.KeyRing.IsLocalKey(
+					ctx, scriptKey.RawKey,
+				)
+			}
+			if scriptKey.PubKey != nil {
+				key := asset.ToSerialized(scriptKey.PubKey)
+				transferAsset.Address = addrs[key]
+			}
+
+			transfer.Assets = append(transfer.Assets, transferAsset)
+		}
+	}
+
+	return p.cfg.Compliance.Check(ctx, transfer)
+}
+
+// recordSends records the given amounts sent to external destinations with
+// the send quota log, if one is configured.
+func (p *ChainPorter) recordSends(ctx context.Context,
+	sends []DestinationSend) error {
+
+	if p.cfg.SendQuotaLog == nil || len(sends) == 0 {
+		return nil
+	}
+
+	err := p.cfg.SendQuotaLog.RecordSends(ctx, sends)
+	if err != nil {
+		return fmt.Errorf("unable to record sends: %w", err)
+	}
+
+	return nil
+}
+
+// revertSends removes the given amounts from the send quota log again, after
+// the transfer they were recorded for failed.
+func (p *ChainPorter) revertSends(ctx context.Context,
+	sends []DestinationSend) {
+
+	if p.cfg.SendQuotaLog == nil || len(sends) == 0 {
+		return
+	}
+
+	err := p.cfg.SendQuotaLog.RevertSends(ctx, sends)
+	if err != nil {
+		log.Warnf("Unable to revert recorded sends: %v", err)
+	}
+}
+
 // unlockInputs unlocks the inputs that were locked for the given package.
 func (p *ChainPorter) unlockInputs(ctx context.Context, pkg *sendPackage) {
 	if pkg == nil || pkg.AnchorTx == nil || pkg.AnchorTx.FundedPsbt == nil {
@@ -1,17 +1,116 @@
 package tapfreighter
 
 import (
+	"context"
+	"fmt"
 	"math/rand"
+	"net/url"
 	"testing"
 	"time"
 
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightningnetwork/lnd/build"
+	"github.com/stretchr/testify/require"
 )
 
 func TestRunChainPorter(t *testing.T) {
 	t.Parallel()
 }
 
+// TestBackupPassiveProofs tests that the updated passive asset proofs are
+// pushed to the configured backup courier.
+func TestBackupPassiveProofs(t *testing.T) {
+	t.Parallel()
+
+	courier := proof.NewMockProofCourier()
+	porter := NewChainPorter(&ChainPorterConfig{
+		ProofCourierDispatcher: &proof.MockProofCourierDispatcher{
+			Courier: courier,
+		},
+		PassiveProofBackupAddr: &url.URL{
+			Scheme: proof.UniverseRpcCourierType,
+			Host:   "backup.example.com:10029",
+		},
+	})
+
+	backups := make([]passiveProofBackup, 3)
+	for idx := range backups {
+		scriptKey := test.RandPubKey(t)
+		assetID := asset.RandID(t)
+		backups[idx] = passiveProofBackup{
+			recipient: proof.Recipient{
+				ScriptKey: scriptKey,
+				AssetID:   assetID,
+				Amount:    uint64(idx + 1),
+			},
+			proof: &proof.AnnotatedProof{
+				Locator: proof.Locator{
+					AssetID:   fn.Ptr(assetID),
+					ScriptKey: *scriptKey,
+				},
+				Blob:          test.RandBytes(100),
+				AssetSnapshot: &proof.AssetSnapshot{},
+			},
+		}
+	}
+
+	porter.Wg.Add(1)
+	porter.backupPassiveProofs(backups)
+
+	ctx := context.Background()
+	for _, backup := range backups {
+		backedUp, err := courier.ReceiveProof(ctx, backup.proof.Locator)
+		require.NoError(t, err)
+		require.Equal(t, backup.proof.Blob, backedUp.Blob)
+	}
+
+	// A backup that fails doesn't prevent the remaining proofs from being
+	// backed up.
+	failingCourier := proof.NewMockProofCourier()
+	porter.cfg.ProofCourierDispatcher = &failingCourierDispatcher{
+		MockProofCourierDispatcher: proof.MockProofCourierDispatcher{
+			Courier: failingCourier,
+		},
+		failFor: backups[0].recipient.AssetID,
+	}
+
+	porter.Wg.Add(1)
+	porter.backupPassiveProofs(backups)
+
+	_, err := failingCourier.ReceiveProof(ctx, backups[0].proof.Locator)
+	require.Error(t, err)
+	for _, backup := range backups[1:] {
+		backedUp, err := failingCourier.ReceiveProof(
+			ctx, backup.proof.Locator,
+		)
+		require.NoError(t, err)
+		require.Equal(t, backup.proof.Blob, backedUp.Blob)
+	}
+}
+
+// failingCourierDispatcher is a mock proof courier dispatcher that fails to
+// create a courier for the recipients of a single asset ID.
+type failingCourierDispatcher struct {
+	proof.MockProofCourierDispatcher
+
+	failFor asset.ID
+}
+
+// NewCourier instantiates a new courier service handle given a service URL
+// address.
+func (f *failingCourierDispatcher) NewCourier(addr *url.URL,
+	recipient proof.Recipient) (proof.Courier, error) {
+
+	if recipient.AssetID == f.failFor {
+		return nil, fmt.Errorf("unable to connect to courier")
+	}
+
+	return f.MockProofCourierDispatcher.NewCourier(addr, recipient)
+}
+
 func init() {
 	rand.Seed(time.Now().Unix())
 
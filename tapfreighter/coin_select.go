@@ -3,6 +3,7 @@ package tapfreighter
 import (
 	"context"
 	"fmt"
+	"math/rand"
 	"sort"
 	"sync"
 	"time"
@@ -12,11 +13,25 @@ import (
 	"github.com/lightninglabs/taproot-assets/fn"
 )
 
+const (
+	// maxExactMatchTries is the maximum number of steps the
+	// branch-and-bound search of the PreferExactMatch strategy takes
+	// before giving up.
+	maxExactMatchTries = 100_000
+)
+
 // NewCoinSelect creates a new CoinSelect.
-func NewCoinSelect(coinLister CoinLister) *CoinSelect {
-	return &CoinSelect{
+func NewCoinSelect(coinLister CoinLister,
+	opts ...CoinSelectOption) *CoinSelect {
+
+	coinSelect := &CoinSelect{
 		coinLister: coinLister,
 	}
+	for _, opt := range opts {
+		opt(coinSelect)
+	}
+
+	return coinSelect
 }
 
 // CoinSelect selects asset coins to spend in order to fund a send
@@ -24,6 +39,10 @@ func NewCoinSelect(coinLister CoinLister) *CoinSelect {
 type CoinSelect struct {
 	coinLister CoinLister
 
+	// selectionLog is an optional log that a record of each successful
+	// coin selection is written to.
+	selectionLog CoinSelectionLog
+
 	// coinLock is a read/write mutex that is used to ensure that only one
 	// goroutine is attempting to call any coin selection related methods at
 	// any time. This is necessary as some of the calls to the store (e.g.
@@ -102,6 +121,19 @@ func (s *CoinSelect) SelectCoins(ctx context.Context,
 		return nil, fmt.Errorf("unable to lease coin: %w", err)
 	}
 
+	// The coins are selected at this point, failing to record the metrics
+	// of the selection shouldn't fail the send.
+	if s.selectionLog != nil {
+		record := newCoinSelectionRecord(
+			constraints, strategy, compatibleCommitments,
+			selectedCoins,
+		)
+		err := s.selectionLog.LogCoinSelection(ctx, record)
+		if err != nil {
+			log.Warnf("Unable to log coin selection: %v", err)
+		}
+	}
+
 	return selectedCoins, nil
 }
 
@@ -138,48 +170,65 @@ func (s *CoinSelect) selectForAmount(minTotalAmount uint64,
 	strategy MultiCommitmentSelectStrategy) ([]*AnchoredCommitment,
 	error) {
 
-	// Select the first subset of eligible commitments which cumulatively
-	// sum to at least the minimum required amount.
-	var selectedCommitments []*AnchoredCommitment
-	amountSum := uint64(0)
-
 	switch strategy {
 	case PreferMaxAmount:
 		// Sort eligible commitments from the largest amount to
 		// smallest.
-		sort.Slice(
-			eligibleCommitments, func(i, j int) bool {
-				isLess := eligibleCommitments[i].Asset.Amount <
-					eligibleCommitments[j].Asset.Amount
-
-				// Negate the result to sort in descending
-				// order.
-				return !isLess
-			},
-		)
+		sortByAmount(eligibleCommitments, true)
 
-		// Select the first subset of eligible commitments which
-		// cumulatively sum to at least the minimum required amount.
-		for _, anchoredCommitment := range eligibleCommitments {
-			selectedCommitments = append(
-				selectedCommitments, anchoredCommitment,
-			)
-
-			// Keep track of the total amount of assets we've seen
-			// so far.
-			amountSum += anchoredCommitment.Asset.Amount
-			if amountSum >= minTotalAmount {
-				// At this point a target min amount was
-				// specified and has been reached.
-				break
-			}
+	case PreferMinAmount:
+		// Sort eligible commitments from the smallest amount to
+		// largest.
+		sortByAmount(eligibleCommitments, false)
+
+	case PreferExactMatch:
+		exactMatch := selectExactMatch(
+			minTotalAmount, eligibleCommitments,
+		)
+		if len(exactMatch) > 0 {
+			return exactMatch, nil
 		}
 
+		// There is no subset that matches the amount exactly, so we
+		// fall back to selecting the largest commitments first, which
+		// results in the smallest number of inputs.
+		log.Debugf("No exact match found for amount %d, falling back "+
+			"to %v", minTotalAmount, PreferMaxAmount)
+
+		sortByAmount(eligibleCommitments, true)
+
+	case PreferRandom:
+		// Shuffle the eligible commitments, so neither the number nor
+		// the size of the selected inputs reveal anything about the
+		// other coins in the wallet.
+		rand.Shuffle(len(eligibleCommitments), func(i, j int) {
+			eligibleCommitments[i], eligibleCommitments[j] =
+				eligibleCommitments[j], eligibleCommitments[i]
+		})
+
 	default:
 		return nil, fmt.Errorf("unknown multi coin selection "+
 			"strategy: %v", strategy)
 	}
 
+	// Select the first subset of eligible commitments which cumulatively
+	// sum to at least the minimum required amount.
+	var selectedCommitments []*AnchoredCommitment
+	amountSum := uint64(0)
+	for _, anchoredCommitment := range eligibleCommitments {
+		selectedCommitments = append(
+			selectedCommitments, anchoredCommitment,
+		)
+
+		// Keep track of the total amount of assets we've seen so far.
+		amountSum += anchoredCommitment.Asset.Amount
+		if amountSum >= minTotalAmount {
+			// At this point a target min amount was specified and
+			// has been reached.
+			break
+		}
+	}
+
 	// Having examined all the eligible commitments, return an error if the
 	// minimal funding amount was not reached.
 	if amountSum < minTotalAmount {
@@ -188,4 +237,80 @@ func (s *CoinSelect) selectForAmount(minTotalAmount uint64,
 	return selectedCommitments, nil
 }
 
+// sortByAmount sorts the given commitments by their asset amount, either in
+// descending or ascending order.
+func sortByAmount(commitments []*AnchoredCommitment, descending bool) {
+	sort.SliceStable(commitments, func(i, j int) bool {
+		if descending {
+			return commitments[i].Asset.Amount >
+				commitments[j].Asset.Amount
+		}
+
+		return commitments[i].Asset.Amount <
+			commitments[j].Asset.Amount
+	})
+}
+
+// selectExactMatch uses a depth-first branch-and-bound search to find a subset
+// of the given commitments that sums up to exactly the target amount. The
+// search considers the largest commitments first, so the first match found
+// tends to use few inputs. To bound the runtime for wallets with many
+// commitments, the search gives up after maxExactMatchTries steps. Nil is
+// returned if no exact match was found.
+func selectExactMatch(target uint64,
+	commitments []*AnchoredCommitment) []*AnchoredCommitment {
+
+	candidates := fn.CopySlice(commitments)
+	sortByAmount(candidates, true)
+
+	// remaining[i] is the sum of the amounts of all candidates starting at
+	// index i, which allows us to prune branches that can't reach the
+	// target anymore.
+	remaining := make([]uint64, len(candidates)+1)
+	for i := len(candidates) - 1; i >= 0; i-- {
+		remaining[i] = remaining[i+1] + candidates[i].Asset.Amount
+	}
+
+	var (
+		tries     int
+		selection []*AnchoredCommitment
+		search    func(idx int, sum uint64) bool
+	)
+	search = func(idx int, sum uint64) bool {
+		tries++
+
+		switch {
+		case sum == target:
+			return true
+
+		// Either we exhausted our budget, there are no more candidates
+		// or the remaining candidates can't reach the target anymore.
+		case tries > maxExactMatchTries, idx == len(candidates),
+			sum+remaining[idx] < target:
+
+			return false
+		}
+
+		// First, try to include the current candidate, if it doesn't
+		// overshoot the target.
+		amount := candidates[idx].Asset.Amount
+		if sum+amount <= target {
+			selection = append(selection, candidates[idx])
+			if search(idx+1, sum+amount) {
+				return true
+			}
+			selection = selection[:len(selection)-1]
+		}
+
+		// Then, try to exclude it.
+		return search(idx+1, sum)
+	}
+
+	if target == 0 || !search(0, 0) {
+		return nil
+	}
+
+	return selection
+}
+
 var _ CoinSelector = (*CoinSelect)(nil)
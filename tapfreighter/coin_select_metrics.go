@@ -0,0 +1,147 @@
+package tapfreighter
+
+import (
+	"context"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+)
+
+// CoinSelectionRecord describes the outcome of a single coin selection. As
+// the inputs of a transfer are selected exactly once when it is funded, each
+// record describes the coin selection of one transfer.
+type CoinSelectionRecord struct {
+	// ID is the database primary key of the record.
+	ID int64
+
+	// SelectionTime is the time the coins were selected.
+	SelectionTime time.Time
+
+	// AssetID is the asset ID the coins were selected for, if the send
+	// was constrained by asset ID.
+	AssetID *asset.ID
+
+	// GroupKey is the group key the coins were selected for, if the send
+	// was constrained by group key.
+	GroupKey *btcec.PublicKey
+
+	// Strategy is the strategy that was used to select the coins.
+	Strategy MultiCommitmentSelectStrategy
+
+	// TargetAmt is the amount of asset units that needed to be covered by
+	// the selected coins.
+	TargetAmt uint64
+
+	// NumCandidates is the number of coins that were eligible for the
+	// selection.
+	NumCandidates uint32
+
+	// NumSelected is the number of coins that were selected.
+	NumSelected uint32
+
+	// SelectedAmt is the total amount of asset units of the selected
+	// coins.
+	SelectedAmt uint64
+
+	// ChangeAmt is the amount of asset units that go back to the wallet
+	// as change.
+	ChangeAmt uint64
+
+	// FragmentationScore describes how fragmented the candidate coins
+	// were, see fragmentationScore.
+	FragmentationScore float64
+}
+
+// CoinSelectionQuery holds the set of query params for coin selection records.
+type CoinSelectionQuery struct {
+	// AssetID is the optional asset ID to filter by.
+	AssetID *asset.ID
+
+	// GroupKey is the optional group key to filter by.
+	GroupKey *btcec.PublicKey
+
+	// From is the optional time from which on records are returned.
+	From time.Time
+
+	// Limit is the maximum number of records to return, starting with the
+	// most recent one. If zero, all matching records are returned.
+	Limit int32
+}
+
+// CoinSelectionLog is used to persist and query coin selection records.
+type CoinSelectionLog interface {
+	// LogCoinSelection persists a new coin selection record.
+	LogCoinSelection(ctx context.Context, record *CoinSelectionRecord) error
+
+	// QueryCoinSelections returns the coin selection records that match
+	// the given query, most recent first.
+	QueryCoinSelections(ctx context.Context,
+		query CoinSelectionQuery) ([]*CoinSelectionRecord, error)
+
+	// LatestCoinSelections returns the most recent coin selection record
+	// of each asset ID or group key.
+	LatestCoinSelections(ctx context.Context) ([]*CoinSelectionRecord,
+		error)
+}
+
+// CoinSelectOption is a functional option for the coin selector.
+type CoinSelectOption func(*CoinSelect)
+
+// WithCoinSelectionLog instructs the coin selector to persist a record of
+// each successful coin selection to the given log.
+func WithCoinSelectionLog(selectionLog CoinSelectionLog) CoinSelectOption {
+	return func(s *CoinSelect) {
+		s.selectionLog = selectionLog
+	}
+}
+
+// fragmentationScore returns a score between 0 and 1 that describes how
+// fragmented the given coins are. A single coin (or no coin at all) has a
+// score of 0, the score approaches 1 as the largest coin holds an ever smaller
+// share of the total amount.
+func fragmentationScore(coins []*AnchoredCommitment) float64 {
+	var total, largest uint64
+	for _, coin := range coins {
+		total += coin.Asset.Amount
+		if coin.Asset.Amount > largest {
+			largest = coin.Asset.Amount
+		}
+	}
+
+	if total == 0 {
+		return 0
+	}
+
+	return 1 - float64(largest)/float64(total)
+}
+
+// newCoinSelectionRecord creates the record of a coin selection over the given
+// candidates.
+func newCoinSelectionRecord(constraints CommitmentConstraints,
+	strategy MultiCommitmentSelectStrategy,
+	candidates, selected []*AnchoredCommitment) *CoinSelectionRecord {
+
+	var selectedAmt uint64
+	for _, coin := range selected {
+		selectedAmt += coin.Asset.Amount
+	}
+
+	var changeAmt uint64
+	if selectedAmt > constraints.MinAmt {
+		changeAmt = selectedAmt - constraints.MinAmt
+	}
+
+	return &CoinSelectionRecord{
+		SelectionTime:      time.Now(),
+		AssetID:            constraints.AssetID,
+		GroupKey:           constraints.GroupKey,
+		Strategy:           strategy,
+		TargetAmt:          constraints.MinAmt,
+		NumCandidates:      uint32(len(candidates)),
+		NumSelected:        uint32(len(selected)),
+		SelectedAmt:        selectedAmt,
+		ChangeAmt:          changeAmt,
+		FragmentationScore: fragmentationScore(candidates),
+	}
+}
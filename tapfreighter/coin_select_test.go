@@ -2,6 +2,7 @@ package tapfreighter
 
 import (
 	"context"
+	"slices"
 	"testing"
 	"time"
 
@@ -222,6 +223,114 @@ func TestCoinSelection(t *testing.T) {
 				},
 			},
 		},
+		// Test that when the PreferMinAmount strategy is employed
+		// the smallest commitments are selected first.
+		{
+			name:           "prefer min amount",
+			minTotalAmount: 1000,
+			eligibleCommitments: []*AnchoredCommitment{
+				{
+					Asset: &asset.Asset{
+						Amount: 510,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 2000,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 490,
+					},
+				},
+			},
+			strategy: PreferMinAmount,
+			expectedCommitments: []*AnchoredCommitment{
+				{
+					Asset: &asset.Asset{
+						Amount: 490,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 510,
+					},
+				},
+			},
+		},
+
+		// Test that when the PreferExactMatch strategy is employed
+		// a subset matching the amount exactly is selected.
+		{
+			name:           "prefer exact match",
+			minTotalAmount: 1000,
+			eligibleCommitments: []*AnchoredCommitment{
+				{
+					Asset: &asset.Asset{
+						Amount: 700,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 2000,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 400,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 300,
+					},
+				},
+			},
+			strategy: PreferExactMatch,
+			expectedCommitments: []*AnchoredCommitment{
+				{
+					Asset: &asset.Asset{
+						Amount: 700,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 300,
+					},
+				},
+			},
+		},
+
+		// Test that the PreferExactMatch strategy falls back to the
+		// largest commitments if there is no exact match.
+		{
+			name:           "prefer exact match without match",
+			minTotalAmount: 1000,
+			eligibleCommitments: []*AnchoredCommitment{
+				{
+					Asset: &asset.Asset{
+						Amount: 700,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 450,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 2000,
+					},
+				},
+			},
+			strategy: PreferExactMatch,
+			expectedCommitments: []*AnchoredCommitment{{
+				Asset: &asset.Asset{
+					Amount: 2000,
+				},
+			}},
+		},
 		{
 			name:           "not enough assets",
 			minTotalAmount: 1000,
@@ -264,3 +373,282 @@ func TestCoinSelection(t *testing.T) {
 		})
 	}
 }
+
+// TestCoinSelectRandom tests that the PreferRandom strategy combines multiple
+// coins anchored in different outpoints to satisfy a single large amount and
+// doesn't always select the same coins.
+func TestCoinSelectRandom(t *testing.T) {
+	t.Parallel()
+
+	var eligibleCommitments []*AnchoredCommitment
+	for idx, amount := range []uint64{100, 200, 300, 400, 500, 600} {
+		eligibleCommitments = append(
+			eligibleCommitments, &AnchoredCommitment{
+				AnchorPoint: wire.OutPoint{
+					Index: uint32(idx),
+				},
+				Asset: &asset.Asset{
+					Amount: amount,
+				},
+			},
+		)
+	}
+
+	const minTotalAmount = 1000
+	coinSelect := NewCoinSelect(newMockCoinLister(eligibleCommitments))
+	selections := make(map[string]struct{})
+	for i := 0; i < 50; i++ {
+		selected, err := coinSelect.selectForAmount(
+			minTotalAmount, fn.CopySlice(eligibleCommitments),
+			PreferRandom,
+		)
+		require.NoError(t, err)
+
+		// No single coin is large enough, so we always need to combine
+		// coins of different anchor outpoints.
+		require.Greater(t, len(selected), 1)
+
+		var (
+			selectedSum uint64
+			selection   string
+		)
+		for _, c := range selected {
+			require.Contains(t, eligibleCommitments, c)
+			selectedSum += c.Asset.Amount
+			selection += c.AnchorPoint.String()
+		}
+		require.GreaterOrEqual(t, selectedSum, uint64(minTotalAmount))
+
+		// Removing the last selected coin must drop the sum below the
+		// target, otherwise we selected too many coins.
+		lastAmt := selected[len(selected)-1].Asset.Amount
+		require.Less(t, selectedSum-lastAmt, uint64(minTotalAmount))
+
+		selections[selection] = struct{}{}
+	}
+
+	// With 50 rounds, it's practically impossible to end up with the same
+	// selection every single time.
+	require.Greater(t, len(selections), 1)
+
+	// Selecting more than the wallet holds fails, no matter the order.
+	_, err := coinSelect.selectForAmount(
+		2200, fn.CopySlice(eligibleCommitments), PreferRandom,
+	)
+	require.ErrorIs(t, err, ErrMatchingAssetsNotFound)
+}
+
+// mockCoinSelectionLog is a mock implementation of the CoinSelectionLog
+// interface that keeps all records in memory.
+type mockCoinSelectionLog struct {
+	records []*CoinSelectionRecord
+}
+
+func (m *mockCoinSelectionLog) LogCoinSelection(_ context.Context,
+	record *CoinSelectionRecord) error {
+
+	m.records = append(m.records, record)
+
+	return nil
+}
+
+func (m *mockCoinSelectionLog) QueryCoinSelections(context.Context,
+	CoinSelectionQuery) ([]*CoinSelectionRecord, error) {
+
+	return m.records, nil
+}
+
+func (m *mockCoinSelectionLog) LatestCoinSelections(
+	context.Context) ([]*CoinSelectionRecord, error) {
+
+	return m.records, nil
+}
+
+// TestCoinSelectionMetrics tests that the coin selector records the metrics
+// of each successful coin selection.
+func TestCoinSelectionMetrics(t *testing.T) {
+	t.Parallel()
+
+	newCoin := func(amount uint64) *AnchoredCommitment {
+		return &AnchoredCommitment{
+			Asset: &asset.Asset{
+				Amount: amount,
+			},
+			Commitment: &commitment.TapCommitment{
+				Version: commitment.TapCommitmentV1,
+			},
+		}
+	}
+
+	// A single coin isn't fragmented at all, while many equal coins are
+	// heavily fragmented.
+	require.Zero(t, fragmentationScore(nil))
+	require.Zero(t, fragmentationScore([]*AnchoredCommitment{
+		newCoin(1000),
+	}))
+	require.InDelta(t, 0.75, fragmentationScore([]*AnchoredCommitment{
+		newCoin(10), newCoin(10), newCoin(10), newCoin(10),
+	}), 0.0001)
+
+	var (
+		ctxb         = context.Background()
+		selectionLog = &mockCoinSelectionLog{}
+		coinLister   = newMockCoinLister([]*AnchoredCommitment{
+			newCoin(600), newCoin(300), newCoin(100),
+		})
+		coinSelect = NewCoinSelect(
+			coinLister, WithCoinSelectionLog(selectionLog),
+		)
+	)
+
+	assetID := asset.RandID(t)
+	constraints := CommitmentConstraints{
+		AssetID: &assetID,
+		MinAmt:  800,
+	}
+	selected, err := coinSelect.SelectCoins(
+		ctxb, constraints, PreferMaxAmount, commitment.TapCommitmentV1,
+	)
+	require.NoError(t, err)
+	require.Len(t, selected, 2)
+
+	require.Len(t, selectionLog.records, 1)
+	record := selectionLog.records[0]
+	require.Equal(t, &assetID, record.AssetID)
+	require.Nil(t, record.GroupKey)
+	require.Equal(t, PreferMaxAmount, record.Strategy)
+	require.EqualValues(t, 800, record.TargetAmt)
+	require.EqualValues(t, 3, record.NumCandidates)
+	require.EqualValues(t, 2, record.NumSelected)
+	require.EqualValues(t, 900, record.SelectedAmt)
+	require.EqualValues(t, 100, record.ChangeAmt)
+	require.InDelta(t, 0.4, record.FragmentationScore, 0.0001)
+
+	// A failed selection isn't recorded.
+	<-coinLister.deleteSignals
+	<-coinLister.listSignals
+	<-coinLister.leaseSignals
+
+	constraints.MinAmt = 2000
+	_, err = coinSelect.SelectCoins(
+		ctxb, constraints, PreferMaxAmount, commitment.TapCommitmentV1,
+	)
+	require.ErrorIs(t, err, ErrMatchingAssetsNotFound)
+	require.Len(t, selectionLog.records, 1)
+}
+
+// TestCoinSelectStrategyParsing tests that all coin selection strategies can
+// be parsed from their string representation.
+func TestCoinSelectStrategyParsing(t *testing.T) {
+	t.Parallel()
+
+	strategies := []MultiCommitmentSelectStrategy{
+		PreferMaxAmount, PreferMinAmount, PreferExactMatch,
+		PreferRandom,
+	}
+	for _, strategy := range strategies {
+		parsed, err := ParseCoinSelectStrategy(strategy.String())
+		require.NoError(t, err)
+		require.Equal(t, strategy, parsed)
+	}
+
+	_, err := ParseCoinSelectStrategy("unknown")
+	require.ErrorContains(t, err, "unknown coin selection strategy")
+}
+
+// TestCoinSelectStrategyFragmentation simulates a series of sends from the
+// same wallet with each coin selection strategy and shows how the strategies
+// affect the fragmentation of the wallet's coins.
+func TestCoinSelectStrategyFragmentation(t *testing.T) {
+	t.Parallel()
+
+	// The wallet starts out with a few larger and many small coins, as is
+	// typical after receiving many small payments.
+	initialAmounts := []uint64{
+		5000, 3000, 10, 20, 30, 40, 50, 60, 70, 80, 90, 100,
+	}
+	sendAmounts := []uint64{
+		100, 250, 60, 1000, 30, 500, 150, 90, 400, 200,
+	}
+
+	type result struct {
+		numCoins      int
+		numInputs     int
+		numChange     int
+		fragmentation float64
+	}
+
+	simulate := func(t *testing.T,
+		strategy MultiCommitmentSelectStrategy) result {
+
+		newCoin := func(amount uint64) *AnchoredCommitment {
+			return &AnchoredCommitment{
+				Asset: &asset.Asset{
+					Amount: amount,
+				},
+			}
+		}
+		coins := fn.Map(initialAmounts, newCoin)
+
+		var (
+			res        result
+			coinSelect = NewCoinSelect(newMockCoinLister(nil))
+		)
+		for _, amount := range sendAmounts {
+			selected, err := coinSelect.selectForAmount(
+				amount, fn.CopySlice(coins), strategy,
+			)
+			require.NoError(t, err)
+
+			var selectedSum uint64
+			for _, coin := range selected {
+				selectedSum += coin.Asset.Amount
+			}
+			res.numInputs += len(selected)
+
+			// Remove the spent coins from the wallet and add the
+			// change coin, if there is one.
+			isUnspent := func(c *AnchoredCommitment) bool {
+				return !slices.Contains(selected, c)
+			}
+			coins = fn.Filter(coins, isUnspent)
+			if selectedSum > amount {
+				res.numChange++
+				coins = append(
+					coins, newCoin(selectedSum-amount),
+				)
+			}
+		}
+
+		res.numCoins = len(coins)
+		res.fragmentation = fragmentationScore(coins)
+
+		return res
+	}
+
+	largestFirst := simulate(t, PreferMaxAmount)
+	smallestFirst := simulate(t, PreferMinAmount)
+	exactMatch := simulate(t, PreferExactMatch)
+
+	t.Logf("largest-first: %+v", largestFirst)
+	t.Logf("smallest-first: %+v", smallestFirst)
+	t.Logf("exact-match: %+v", exactMatch)
+
+	// Spending the largest coins first never touches the small coins, so
+	// they stay in the wallet and every send creates change.
+	require.Equal(t, len(sendAmounts), largestFirst.numChange)
+	require.Equal(t, len(initialAmounts), largestFirst.numCoins)
+
+	// Spending the smallest coins first consolidates them, so the wallet
+	// ends up with fewer coins, at the cost of more inputs per send.
+	require.Less(t, smallestFirst.numCoins, largestFirst.numCoins)
+	require.Greater(t, smallestFirst.numInputs, largestFirst.numInputs)
+	require.Less(
+		t, smallestFirst.fragmentation, largestFirst.fragmentation,
+	)
+
+	// Looking for exact matches avoids creating change outputs where
+	// possible, which also reduces the number of coins in the wallet.
+	require.Less(t, exactMatch.numChange, largestFirst.numChange)
+	require.Less(t, exactMatch.numCoins, largestFirst.numCoins)
+}
@@ -0,0 +1,159 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/proof"
+)
+
+const (
+	// DefaultCourierPreflightTimeout is the default maximum time a single
+	// proof courier is probed for before a transfer is broadcast.
+	DefaultCourierPreflightTimeout = 10 * time.Second
+)
+
+// ErrCourierUnreachable is returned when a transfer is aborted because the
+// proof courier of one of its receivers can't be reached.
+var ErrCourierUnreachable = errors.New("proof courier unreachable")
+
+// CourierPreflightPolicy defines how the proof courier endpoints of the
+// receivers of a transfer are checked before the transfer is broadcast.
+type CourierPreflightPolicy uint8
+
+const (
+	// CourierPreflightOff is the policy that doesn't check the proof
+	// courier endpoints at all.
+	CourierPreflightOff CourierPreflightPolicy = iota
+
+	// CourierPreflightWarn is the policy that probes the proof courier
+	// endpoints and logs a warning for each unreachable one, but still
+	// broadcasts the transfer.
+	CourierPreflightWarn
+
+	// CourierPreflightAbort is the policy that probes the proof courier
+	// endpoints and aborts the transfer if any of them is unreachable.
+	CourierPreflightAbort
+)
+
+// String returns a human-readable representation of the policy.
+func (c CourierPreflightPolicy) String() string {
+	switch c {
+	case CourierPreflightOff:
+		return "off"
+	case CourierPreflightWarn:
+		return "warn"
+	case CourierPreflightAbort:
+		return "abort"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(c))
+	}
+}
+
+// ParseCourierPreflightPolicy parses a courier pre-flight policy from its
+// string representation.
+func ParseCourierPreflightPolicy(s string) (CourierPreflightPolicy, error) {
+	switch s {
+	case "", "off":
+		return CourierPreflightOff, nil
+	case "warn":
+		return CourierPreflightWarn, nil
+	case "abort":
+		return CourierPreflightAbort, nil
+	default:
+		return 0, fmt.Errorf("unknown courier preflight policy: %v", s)
+	}
+}
+
+// courierProbe is a proof courier endpoint that is probed before a transfer
+// is broadcast, together with one of the recipients it delivers proofs to.
+type courierProbe struct {
+	addr      *url.URL
+	recipient proof.Recipient
+}
+
+// courierProbes returns the distinct proof courier endpoints that the proofs
+// of the given package are delivered to.
+func courierProbes(pkg *sendPackage) []courierProbe {
+	var (
+		probes []courierProbe
+		seen   = make(map[string]struct{})
+	)
+	for _, vPkt := range pkg.VirtualPackets {
+		for _, vOut := range vPkt.Outputs {
+			addr := vOut.ProofDeliveryAddress
+			if addr == nil || vOut.Asset == nil ||
+				vOut.ScriptKey.PubKey == nil {
+
+				continue
+			}
+
+			if _, ok := seen[addr.String()]; ok {
+				continue
+			}
+			seen[addr.String()] = struct{}{}
+
+			probes = append(probes, courierProbe{
+				addr: addr,
+				recipient: proof.Recipient{
+					ScriptKey: vOut.ScriptKey.PubKey,
+					AssetID:   vOut.Asset.ID(),
+					Amount:    vOut.Amount,
+				},
+			})
+		}
+	}
+
+	return probes
+}
+
+// preflightCouriers probes the proof courier endpoints the proofs of the given
+// package are delivered to, so we don't broadcast a transfer whose proofs can
+// never be delivered. Depending on the policy, an unreachable endpoint is
+// either logged or aborts the transfer. Couriers that don't support probes are
+// skipped.
+func (p *ChainPorter) preflightCouriers(ctx context.Context,
+	pkg *sendPackage) error {
+
+	if p.cfg.CourierPreflight == CourierPreflightOff {
+		return nil
+	}
+
+	timeout := p.cfg.CourierProbeTimeout
+	if timeout == 0 {
+		timeout = DefaultCourierPreflightTimeout
+	}
+
+	for _, probe := range courierProbes(pkg) {
+		probeCtx, cancel := context.WithTimeout(ctx, timeout)
+		err := proof.ProbeCourier(
+			probeCtx, p.cfg.ProofCourierDispatcher, probe.addr,
+			probe.recipient,
+		)
+		cancel()
+
+		switch {
+		case err == nil:
+			log.Debugf("Proof courier %v passed preflight check",
+				probe.addr.Host)
+
+		case errors.Is(err, proof.ErrProbeUnsupported):
+			log.Debugf("Skipping preflight check of proof courier "+
+				"%v: %v", probe.addr.Host, err)
+
+		case p.cfg.CourierPreflight == CourierPreflightAbort:
+			return fmt.Errorf("%w: %v: %v", ErrCourierUnreachable,
+				probe.addr.Host, err)
+
+		default:
+			log.Warnf("Proof courier %v of transfer receiver "+
+				"failed preflight check, proof delivery might "+
+				"fail: %v", probe.addr.Host, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package tapfreighter
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPreflightCouriers tests that an unreachable proof courier only aborts a
+// transfer if the pre-flight policy says so.
+func TestPreflightCouriers(t *testing.T) {
+	t.Parallel()
+
+	courierAddr := &url.URL{
+		Scheme: proof.UniverseRpcCourierType,
+		Host:   "courier.example.com:10029",
+	}
+	newOutput := func(addr *url.URL) *tappsbt.VOutput {
+		return &tappsbt.VOutput{
+			Amount:               1,
+			Asset:                asset.RandAsset(t, asset.Normal),
+			ScriptKey:            asset.RandScriptKey(t),
+			ProofDeliveryAddress: addr,
+		}
+	}
+
+	// Only the distinct courier addresses of outputs that have one are
+	// probed.
+	pkg := &sendPackage{
+		VirtualPackets: []*tappsbt.VPacket{{
+			Outputs: []*tappsbt.VOutput{
+				newOutput(courierAddr), newOutput(nil),
+			},
+		}, {
+			Outputs: []*tappsbt.VOutput{newOutput(courierAddr)},
+		}},
+	}
+	require.Len(t, courierProbes(pkg), 1)
+
+	courier := proof.NewMockProofCourier()
+	courier.SetProbeErr(fmt.Errorf("courier down"))
+	dispatcher := &proof.MockProofCourierDispatcher{
+		Courier: courier,
+	}
+	newPorter := func(policy CourierPreflightPolicy) *ChainPorter {
+		return NewChainPorter(&ChainPorterConfig{
+			ProofCourierDispatcher: dispatcher,
+			CourierPreflight:       policy,
+		})
+	}
+
+	ctx := context.Background()
+	for _, policy := range []CourierPreflightPolicy{
+		CourierPreflightOff, CourierPreflightWarn,
+	} {
+		err := newPorter(policy).preflightCouriers(ctx, pkg)
+		require.NoError(t, err, policy.String())
+	}
+
+	err := newPorter(CourierPreflightAbort).preflightCouriers(ctx, pkg)
+	require.ErrorIs(t, err, ErrCourierUnreachable)
+
+	courier.SetProbeErr(nil)
+	err = newPorter(CourierPreflightAbort).preflightCouriers(ctx, pkg)
+	require.NoError(t, err)
+}
+
+// TestParseCourierPreflightPolicy tests that all courier pre-flight policies
+// can be parsed from their string representation.
+func TestParseCourierPreflightPolicy(t *testing.T) {
+	t.Parallel()
+
+	for _, policy := range []CourierPreflightPolicy{
+		CourierPreflightOff, CourierPreflightWarn,
+		CourierPreflightAbort,
+	} {
+		parsed, err := ParseCourierPreflightPolicy(policy.String())
+		require.NoError(t, err)
+		require.Equal(t, policy, parsed)
+	}
+
+	_, err := ParseCourierPreflightPolicy("maybe")
+	require.Error(t, err)
+}
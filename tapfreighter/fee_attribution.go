@@ -0,0 +1,242 @@
+package tapfreighter
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+// feeSpendPageSize is the number of outbound parcels that are fetched at once
+// when aggregating the fee spend.
+const feeSpendPageSize = 500
+
+// FeeWeigher returns the weight of each output of the given parcel. The chain
+// fees of the parcel are attributed to its outputs in proportion to these
+// weights.
+type FeeWeigher func(parcel *OutboundParcel) ([]uint64, error)
+
+// ProRataFeeWeigher weighs each output of a parcel by its amount. If none of
+// the outputs carries an amount, all outputs are weighed equally.
+func ProRataFeeWeigher(parcel *OutboundParcel) ([]uint64, error) {
+	weights := make([]uint64, len(parcel.Outputs))
+
+	var total uint64
+	for idx := range parcel.Outputs {
+		weights[idx] = parcel.Outputs[idx].Amount
+		total += weights[idx]
+	}
+
+	if total == 0 {
+		for idx := range weights {
+			weights[idx] = 1
+		}
+	}
+
+	return weights, nil
+}
+
+// FixedFeeWeigher returns a fee weigher that uses the caller-specified output
+// weights of a parcel, keyed by the hash of its anchor transaction. Parcels
+// without caller-specified weights are weighed pro-rata.
+func FixedFeeWeigher(weights map[chainhash.Hash][]uint64) FeeWeigher {
+	return func(parcel *OutboundParcel) ([]uint64, error) {
+		if parcel.AnchorTx == nil {
+			return ProRataFeeWeigher(parcel)
+		}
+
+		parcelWeights, ok := weights[parcel.AnchorTx.TxHash()]
+		if !ok {
+			return ProRataFeeWeigher(parcel)
+		}
+
+		if len(parcelWeights) != len(parcel.Outputs) {
+			return nil, fmt.Errorf("parcel %v has %d outputs, but "+
+				"%d weights were specified",
+				parcel.AnchorTx.TxHash(), len(parcel.Outputs),
+				len(parcelWeights))
+		}
+
+		return parcelWeights, nil
+	}
+}
+
+// OutputFee is the share of the chain fees of a parcel that is attributed to
+// one of its outputs.
+type OutputFee struct {
+	// OutputIndex is the index of the output within the parcel.
+	OutputIndex int
+
+	// AssetID is the ID of the asset of the output.
+	AssetID asset.ID
+
+	// Fee is the amount in sats of the chain fees attributed to the
+	// output.
+	Fee int64
+}
+
+// AttributeChainFees attributes the chain fees of the given parcel to its
+// outputs, in proportion to the weights of the given weigher. The remainder of
+// the integer division is attributed to the output with the largest weight, so
+// the attributed fees always sum up to the chain fees of the parcel.
+//
+// NOTE: Passive assets that are re-anchored by the parcel don't carry any of
+// its chain fees.
+func AttributeChainFees(parcel *OutboundParcel,
+	weigher FeeWeigher) ([]OutputFee, error) {
+
+	if len(parcel.Outputs) == 0 {
+		return nil, nil
+	}
+
+	weights, err := weigher(parcel)
+	if err != nil {
+		return nil, fmt.Errorf("unable to weigh outputs: %w", err)
+	}
+
+	if len(weights) != len(parcel.Outputs) {
+		return nil, fmt.Errorf("got %d weights for %d outputs",
+			len(weights), len(parcel.Outputs))
+	}
+
+	var (
+		totalWeight uint64
+		maxIdx      int
+	)
+	for idx, weight := range weights {
+		totalWeight += weight
+		if weight > weights[maxIdx] {
+			maxIdx = idx
+		}
+	}
+
+	if totalWeight == 0 {
+		return nil, fmt.Errorf("outputs have no weight")
+	}
+
+	fees := make([]OutputFee, len(parcel.Outputs))
+	var attributed int64
+	for idx := range parcel.Outputs {
+		assetID, err := outputAssetID(parcel, &parcel.Outputs[idx])
+		if err != nil {
+			return nil, err
+		}
+
+		// We compute the share in floating point, as the product of
+		// the fees and the weight can overflow.
+		share := float64(parcel.ChainFees) * float64(weights[idx]) /
+			float64(totalWeight)
+
+		fees[idx] = OutputFee{
+			OutputIndex: idx,
+			AssetID:     assetID,
+			Fee:         int64(share),
+		}
+		attributed += fees[idx].Fee
+	}
+
+	fees[maxIdx].Fee += parcel.ChainFees - attributed
+
+	return fees, nil
+}
+
+// FeeSpendQuery defines the parcels and periods the fee spend is aggregated
+// for.
+type FeeSpendQuery struct {
+	// StartTime only includes parcels created at or after this time.
+	StartTime fn.Option[time.Time]
+
+	// EndTime only includes parcels created before this time.
+	EndTime fn.Option[time.Time]
+
+	// Interval is the length of each period. Periods are aligned to
+	// multiples of the interval since the zero time. If it is zero, the
+	// fee spend is aggregated into a single period.
+	Interval time.Duration
+}
+
+// FeeSpendPeriod is the fee spend per asset within a single period.
+type FeeSpendPeriod struct {
+	// Start is the start of the period. If the fee spend is aggregated
+	// into a single period, this is the start time of the query.
+	Start time.Time
+
+	// Fees are the chain fees in sats attributed to each asset.
+	Fees map[asset.ID]int64
+}
+
+// AggregateFeeSpend attributes the chain fees of all parcels that match the
+// given query to their assets and sums them up per asset and period. The
+// periods are sorted by their start time, periods without any parcels are
+// omitted.
+func AggregateFeeSpend(ctx context.Context, parcels ParcelPager,
+	query FeeSpendQuery, weigher FeeWeigher) ([]FeeSpendPeriod, error) {
+
+	if query.Interval < 0 {
+		return nil, fmt.Errorf("invalid interval: %v", query.Interval)
+	}
+
+	pageQuery := ParcelQuery{
+		StartTime: query.StartTime,
+		EndTime:   query.EndTime,
+		Limit:     feeSpendPageSize,
+	}
+
+	periods := make(map[time.Time]*FeeSpendPeriod)
+	for {
+		page, err := parcels.QueryParcelsPage(ctx, pageQuery)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, parcel := range page.Parcels {
+			fees, err := AttributeChainFees(parcel, weigher)
+			if err != nil {
+				return nil, err
+			}
+
+			periodStart := query.StartTime.UnwrapOr(time.Time{})
+			if query.Interval > 0 {
+				periodStart = parcel.TransferTime.Truncate(
+					query.Interval,
+				)
+			}
+
+			// We normalize the start of the period, so the same
+			// instant always maps to the same period.
+			periodStart = periodStart.UTC().Round(0)
+
+			period, ok := periods[periodStart]
+			if !ok {
+				period = &FeeSpendPeriod{
+					Start: periodStart,
+					Fees:  make(map[asset.ID]int64),
+				}
+				periods[periodStart] = period
+			}
+
+			for _, fee := range fees {
+				period.Fees[fee.AssetID] += fee.Fee
+			}
+		}
+
+		if page.NextCursor.IsNone() {
+			break
+		}
+		pageQuery.Cursor = page.NextCursor
+	}
+
+	result := make([]FeeSpendPeriod, 0, len(periods))
+	for _, period := range periods {
+		result = append(result, *period)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Start.Before(result[j].Start)
+	})
+
+	return result, nil
+}
@@ -0,0 +1,162 @@
+package tapfreighter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/stretchr/testify/require"
+)
+
+// newFeeParcel creates a parcel of the given asset that pays the given fees
+// and has an output for each of the given amounts.
+func newFeeParcel(assetID asset.ID, at time.Time, fees int64,
+	amounts ...uint64) *OutboundParcel {
+
+	// We use the lock time to make the anchor transactions distinct.
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.LockTime = uint32(at.Unix())
+
+	parcel := &OutboundParcel{
+		AnchorTx:     anchorTx,
+		TransferTime: at,
+		ChainFees:    fees,
+		Inputs: []TransferInput{{
+			PrevID: asset.PrevID{ID: assetID},
+		}},
+	}
+	for _, amount := range amounts {
+		parcel.Outputs = append(parcel.Outputs, TransferOutput{
+			Amount: amount,
+		})
+	}
+
+	return parcel
+}
+
+// TestAttributeChainFees tests that the chain fees of a parcel are attributed
+// to its outputs in proportion to their weights.
+func TestAttributeChainFees(t *testing.T) {
+	t.Parallel()
+
+	assetID := asset.RandID(t)
+	parcel := newFeeParcel(assetID, time.Unix(1_700_000_000, 0), 1000,
+		100, 200, 0)
+
+	testCases := []struct {
+		name     string
+		weigher  FeeWeigher
+		expected []int64
+		valid    bool
+	}{{
+		name:    "pro-rata",
+		weigher: ProRataFeeWeigher,
+
+		// The remainder of the division goes to the largest output.
+		expected: []int64{333, 667, 0},
+		valid:    true,
+	}, {
+		name: "caller-specified",
+		weigher: FixedFeeWeigher(map[chainhash.Hash][]uint64{
+			parcel.AnchorTx.TxHash(): {1, 1, 2},
+		}),
+		expected: []int64{250, 250, 500},
+		valid:    true,
+	}, {
+		name: "caller-specified other parcel",
+		weigher: FixedFeeWeigher(map[chainhash.Hash][]uint64{
+			{1}: {1, 1, 2},
+		}),
+		expected: []int64{333, 667, 0},
+		valid:    true,
+	}, {
+		name: "weights mismatch",
+		weigher: FixedFeeWeigher(map[chainhash.Hash][]uint64{
+			parcel.AnchorTx.TxHash(): {1, 1},
+		}),
+		valid: false,
+	}, {
+		name: "no weight",
+		weigher: FixedFeeWeigher(map[chainhash.Hash][]uint64{
+			parcel.AnchorTx.TxHash(): {0, 0, 0},
+		}),
+		valid: false,
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fees, err := AttributeChainFees(parcel, tc.weigher)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, fees, len(tc.expected))
+			for idx, fee := range fees {
+				require.Equal(t, idx, fee.OutputIndex)
+				require.Equal(t, assetID, fee.AssetID)
+				require.Equal(t, tc.expected[idx], fee.Fee)
+			}
+		})
+	}
+}
+
+// TestAggregateFeeSpend tests that the fee spend is summed up per asset and
+// period.
+func TestAggregateFeeSpend(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = context.Background()
+		day     = time.Unix(1_700_006_400, 0).UTC()
+		assetA  = asset.RandID(t)
+		assetB  = asset.RandID(t)
+		nextDay = day.Add(24 * time.Hour)
+	)
+
+	pager := &mockParcelPager{
+		parcels: []*OutboundParcel{
+			newFeeParcel(assetA, day.Add(time.Hour), 100, 5, 5),
+			newFeeParcel(assetB, day.Add(2*time.Hour), 50, 1),
+			newFeeParcel(assetA, nextDay.Add(time.Hour), 30, 1, 2),
+		},
+	}
+
+	periods, err := AggregateFeeSpend(ctx, pager, FeeSpendQuery{
+		Interval: 24 * time.Hour,
+	}, ProRataFeeWeigher)
+	require.NoError(t, err)
+
+	require.Equal(t, []FeeSpendPeriod{{
+		Start: day,
+		Fees: map[asset.ID]int64{
+			assetA: 100,
+			assetB: 50,
+		},
+	}, {
+		Start: nextDay,
+		Fees: map[asset.ID]int64{
+			assetA: 30,
+		},
+	}}, periods)
+
+	// Without an interval, everything ends up in a single period that
+	// starts at the start of the query.
+	periods, err = AggregateFeeSpend(ctx, pager, FeeSpendQuery{
+		StartTime: fn.Some(day),
+	}, ProRataFeeWeigher)
+	require.NoError(t, err)
+
+	require.Equal(t, []FeeSpendPeriod{{
+		Start: day,
+		Fees: map[asset.ID]int64{
+			assetA: 130,
+			assetB: 50,
+		},
+	}}, periods)
+}
@@ -0,0 +1,387 @@
+package tapfreighter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/mempool"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapsend"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+var (
+	// DefaultIncrementalRelayFee is the default incremental relay fee rate
+	// of bitcoind and btcd, which is 1 sat/vByte.
+	DefaultIncrementalRelayFee = chainfee.SatPerKVByte(
+		1000,
+	).FeePerKWeight()
+
+	// ErrTransferNotPending is returned if the fee of a transfer should be
+	// bumped that isn't waiting for its anchor transaction to confirm.
+	ErrTransferNotPending = errors.New("transfer is not waiting for " +
+		"confirmation")
+
+	// ErrNoAnchorPsbt is returned if the funded anchor transaction PSBT
+	// of a transfer isn't known, which is required to re-sign the anchor
+	// transaction.
+	ErrNoAnchorPsbt = errors.New("funded anchor psbt of transfer unknown")
+
+	// ErrNoChangeOutput is returned if the anchor transaction of a
+	// transfer doesn't have a BTC change output the additional fee could
+	// be deducted from.
+	ErrNoChangeOutput = errors.New("anchor transaction has no change " +
+		"output")
+
+	// ErrFeeRateTooLow is returned if the fee rate of a replacement anchor
+	// transaction doesn't pay enough fees to replace the original one.
+	ErrFeeRateTooLow = errors.New("fee rate too low for replacement")
+
+	// ErrChangeValueTooLow is returned if the BTC change output of an
+	// anchor transaction isn't large enough to pay for the fee bump
+	// without becoming dust.
+	ErrChangeValueTooLow = errors.New("change output value too low to " +
+		"pay for fee bump")
+)
+
+// NewReplacementPsbt creates the funded but unsigned PSBT of a transaction that
+// replaces the anchor transaction of the given parcel and pays the given fee
+// rate. On top of the fee of the original transaction, the replacement must pay
+// the given incremental relay fee rate of the chain backend for its own size,
+// as required by BIP-125. The additional fee is deducted from the BTC change
+// output of the anchor transaction, all other outputs keep their value. Because
+// the value of the change output changes, the given ordering policy is applied
+// to the outputs that don't carry assets again. Because the replacement spends
+// the same inputs, it can be signed by the same wallet.
+func NewReplacementPsbt(parcel *OutboundParcel, feeRate,
+	incrementalRelayFee chainfee.SatPerKWeight,
+	ordering tapsend.OutputOrdering) (*tapsend.FundedPsbt, error) {
+
+	if parcel.FundedAnchorPsbt == nil ||
+		parcel.FundedAnchorPsbt.Pkt == nil {
+
+		return nil, ErrNoAnchorPsbt
+	}
+
+	fundedPsbt := parcel.FundedAnchorPsbt.Copy()
+	pkt := fundedPsbt.Pkt
+
+	changeIndex := fundedPsbt.ChangeOutputIndex
+	if changeIndex < 0 || int(changeIndex) >= len(pkt.UnsignedTx.TxOut) {
+		return nil, ErrNoChangeOutput
+	}
+
+	// Reducing the value of an output that anchors assets would change
+	// the anchor information of those assets, so the change output must be
+	// a pure BTC output. We also need to know the number of asset carrying
+	// outputs, which come first and can't be moved.
+	changeOutPoint := wire.OutPoint{
+		Hash:  parcel.AnchorTx.TxHash(),
+		Index: uint32(changeIndex),
+	}
+	var numAssetOutputs int
+	for _, out := range parcel.Outputs {
+		if out.Anchor.OutPoint == changeOutPoint {
+			return nil, fmt.Errorf("%w: output %d anchors assets",
+				ErrNoChangeOutput, changeIndex)
+		}
+
+		numAssetOutputs = max(
+			numAssetOutputs, int(out.Anchor.OutPoint.Index)+1,
+		)
+	}
+	if passiveAnchor := parcel.PassiveAssetsAnchor; passiveAnchor != nil {
+		if passiveAnchor.OutPoint == changeOutPoint {
+			return nil, fmt.Errorf("%w: output %d anchors passive "+
+				"assets", ErrNoChangeOutput, changeIndex)
+		}
+
+		numAssetOutputs = max(
+			numAssetOutputs, int(passiveAnchor.OutPoint.Index)+1,
+		)
+	}
+
+	// The replacement spends the same inputs with the same witness types,
+	// so the weight of the signed original transaction is a good estimate
+	// for the weight of the replacement.
+	weight := lntypes.WeightUnit(
+		blockchain.GetTransactionWeight(btcutil.NewTx(parcel.AnchorTx)),
+	)
+	newFee := feeRate.FeeForWeight(weight)
+
+	// BIP-125 requires the replacement to pay at least the fee of the
+	// original transaction plus the incremental relay fee for its own
+	// size.
+	oldFee := btcutil.Amount(parcel.ChainFees)
+	minFee := oldFee + incrementalRelayFee.FeeForWeight(weight)
+	if newFee < minFee {
+		return nil, fmt.Errorf("%w: fee of %v at %v is below the "+
+			"minimum of %v", ErrFeeRateTooLow, newFee,
+			feeRate.FeePerKVByte(), minFee)
+	}
+
+	changeOut := pkt.UnsignedTx.TxOut[changeIndex]
+	dustLimit := btcutil.Amount(mempool.GetDustThreshold(changeOut))
+	newChangeValue := btcutil.Amount(changeOut.Value) - (newFee - oldFee)
+	if newChangeValue < dustLimit {
+		return nil, fmt.Errorf("%w: value=%v, additional_fee=%v",
+			ErrChangeValueTooLow, btcutil.Amount(changeOut.Value),
+			newFee-oldFee)
+	}
+	changeOut.Value = int64(newChangeValue)
+
+	// With the lexicographic policy, the outputs after the asset carrying
+	// outputs are sorted by their value, so the change output might need
+	// to move.
+	order, err := tapsend.TrailingOutputOrder(
+		ordering, pkt.UnsignedTx, numAssetOutputs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to order anchor outputs: %w",
+			err)
+	}
+	if order != nil {
+		err = tapsend.ReorderAnchorOutputs(fundedPsbt, order)
+		if err != nil {
+			return nil, fmt.Errorf("unable to order anchor "+
+				"outputs: %w", err)
+		}
+	}
+
+	// Any signature of the original transaction is invalid for the
+	// replacement, so we make sure the wallet signs all inputs again.
+	for idx := range pkt.Inputs {
+		pIn := &pkt.Inputs[idx]
+		pIn.PartialSigs = nil
+		pIn.TaprootKeySpendSig = nil
+		pIn.TaprootScriptSpendSig = nil
+		pIn.FinalScriptSig = nil
+		pIn.FinalScriptWitness = nil
+	}
+
+	fundedPsbt.ChainFees = int64(newFee)
+	fundedPsbt.LockedUTXOs = nil
+
+	return fundedPsbt, nil
+}
+
+// ReplaceAnchorTx returns a copy of the parcel that references the given
+// replacement anchor transaction, which must only differ from the original
+// anchor transaction in the value of its BTC change output. Because the proof
+// suffixes commit to the anchor transaction, they are re-derived for the
+// replacement.
+func (o *OutboundParcel) ReplaceAnchorTx(anchorTx *wire.MsgTx,
+	fundedPsbt *tapsend.FundedPsbt) (*OutboundParcel, error) {
+
+	numOutputs := len(o.AnchorTx.TxOut)
+	if len(anchorTx.TxOut) != numOutputs {
+		return nil, fmt.Errorf("replacement anchor tx has %d outputs, "+
+			"expected %d", len(anchorTx.TxOut), numOutputs)
+	}
+
+	newParcel := o.Copy()
+	newParcel.AnchorTx = anchorTx.Copy()
+	newParcel.ChainFees = fundedPsbt.ChainFees
+	newParcel.FundedAnchorPsbt = fundedPsbt.Copy()
+
+	newTxHash := anchorTx.TxHash()
+	for idx := range newParcel.Outputs {
+		out := &newParcel.Outputs[idx]
+		out.Anchor.OutPoint.Hash = newTxHash
+
+		if len(out.ProofSuffix) == 0 {
+			continue
+		}
+
+		var suffix proof.Proof
+		err := suffix.Decode(bytes.NewReader(out.ProofSuffix))
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode proof suffix "+
+				"of output %d: %w", idx, err)
+		}
+
+		suffix.AnchorTx = *anchorTx.Copy()
+
+		var suffixBuf bytes.Buffer
+		if err := suffix.Encode(&suffixBuf); err != nil {
+			return nil, fmt.Errorf("unable to encode proof suffix "+
+				"of output %d: %w", idx, err)
+		}
+		out.ProofSuffix = suffixBuf.Bytes()
+	}
+
+	if newParcel.PassiveAssetsAnchor != nil {
+		newParcel.PassiveAssetsAnchor.OutPoint.Hash = newTxHash
+	}
+	for _, vPkt := range newParcel.PassiveAssets {
+		for _, vOut := range vPkt.Outputs {
+			if vOut.ProofSuffix != nil {
+				vOut.ProofSuffix.AnchorTx = *anchorTx.Copy()
+			}
+		}
+	}
+
+	return newParcel, nil
+}
+
+// BumpTransferFee replaces the anchor transaction of the pending transfer with
+// the given anchor transaction hash with a version that pays the given, higher
+// fee rate. The additional fee is deducted from the BTC change output of the
+// anchor transaction. The transfer must currently be waiting for its anchor
+// transaction to confirm, which it will continue to do for the replacement.
+func (p *ChainPorter) BumpTransferFee(ctx context.Context,
+	anchorTxHash chainhash.Hash,
+	feeRate chainfee.SatPerKWeight) (*OutboundParcel, error) {
+
+	// We hold the lock for the whole fee bump, so the transfer that waits
+	// for the anchor transaction can't continue with a stale anchor
+	// transaction in the meantime.
+	p.confWaitersMtx.Lock()
+	defer p.confWaitersMtx.Unlock()
+
+	replacedChan, ok := p.confWaiters[anchorTxHash]
+	if !ok {
+		return nil, fmt.Errorf("%w: anchor_txid=%v",
+			ErrTransferNotPending, anchorTxHash)
+	}
+
+	parcels, err := p.cfg.ExportLog.QueryParcels(ctx, &anchorTxHash, true)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query parcel: %w", err)
+	}
+	if len(parcels) != 1 {
+		return nil, fmt.Errorf("%w: anchor_txid=%v",
+			ErrTransferNotPending, anchorTxHash)
+	}
+	oldParcel := parcels[0]
+
+	incrementalRelayFee := p.cfg.IncrementalRelayFee
+	if incrementalRelayFee == 0 {
+		incrementalRelayFee = DefaultIncrementalRelayFee
+	}
+
+	replacementPsbt, err := NewReplacementPsbt(
+		oldParcel, feeRate, incrementalRelayFee, p.cfg.AnchorOrdering,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Bumping fee of transfer anchor_txid=%v to %v, new fee %d "+
+		"sats", anchorTxHash, feeRate.FeePerKVByte(),
+		replacementPsbt.ChainFees)
+
+	// The funded PSBT contains the derivation information of all inputs,
+	// including the asset anchor inputs, so the wallet can sign all of
+	// them. We sign a copy to keep the funded PSBT free of signatures.
+	signedPsbt, err := p.cfg.Wallet.SignPsbt(
+		ctx, replacementPsbt.Copy().Pkt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign psbt: %w", err)
+	}
+
+	if err := psbt.MaybeFinalizeAll(signedPsbt); err != nil {
+		return nil, fmt.Errorf("unable to finalize psbt: %w", err)
+	}
+	finalTx, err := psbt.Extract(signedPsbt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to extract psbt: %w", err)
+	}
+
+	newParcel, err := oldParcel.ReplaceAnchorTx(finalTx, replacementPsbt)
+	if err != nil {
+		return nil, err
+	}
+
+	// We log the replacement before publishing it, so we never end up
+	// with a published transaction that we don't know about on restart.
+	newTxHash := finalTx.TxHash()
+	err = p.cfg.ExportLog.ReplaceParcelAnchorTx(
+		ctx, anchorTxHash, newParcel,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to log replacement anchor tx: "+
+			"%w", err)
+	}
+
+	err = p.cfg.ChainBridge.PublishTransaction(ctx, finalTx)
+	if err != nil {
+		// The replacement wasn't accepted, so the original anchor
+		// transaction is still the one that is going to confirm.
+		revertErr := p.cfg.ExportLog.ReplaceParcelAnchorTx(
+			ctx, newTxHash, oldParcel,
+		)
+		if revertErr != nil {
+			log.Errorf("Unable to restore original anchor tx %v: "+
+				"%v", anchorTxHash, revertErr)
+		}
+
+		return nil, fmt.Errorf("unable to broadcast replacement "+
+			"transaction %v: %w", newTxHash, err)
+	}
+
+	log.Infof("Replaced transfer anchor_txid=%v with %v", anchorTxHash,
+		newTxHash)
+
+	// The channel is buffered, so this won't block even if the transfer
+	// just stopped waiting. Since we remove the waiter, it's the only
+	// replacement it can receive before registering for the new
+	// transaction.
+	delete(p.confWaiters, anchorTxHash)
+	replacedChan <- newParcel
+
+	return newParcel, nil
+}
+
+// registerConfWaiter registers a transfer that waits for the given anchor
+// transaction to confirm. The returned channel receives the parcel with the
+// replacement anchor transaction if the fee of the transfer is bumped.
+func (p *ChainPorter) registerConfWaiter(
+	anchorTxHash chainhash.Hash) <-chan *OutboundParcel {
+
+	p.confWaitersMtx.Lock()
+	defer p.confWaitersMtx.Unlock()
+
+	replacedChan := make(chan *OutboundParcel, 1)
+	p.confWaiters[anchorTxHash] = replacedChan
+
+	return replacedChan
+}
+
+// removeConfWaiter removes the transfer that waits for the given anchor
+// transaction to confirm. This blocks until any fee bump in progress is
+// completed.
+func (p *ChainPorter) removeConfWaiter(anchorTxHash chainhash.Hash) {
+	p.confWaitersMtx.Lock()
+	defer p.confWaitersMtx.Unlock()
+
+	delete(p.confWaiters, anchorTxHash)
+}
+
+// replaceAnchorTx updates the package to reference the given parcel with the
+// replacement anchor transaction.
+func (s *sendPackage) replaceAnchorTx(parcel *OutboundParcel) {
+	s.OutboundPkg = parcel
+
+	if s.AnchorTx != nil {
+		s.AnchorTx.FinalTx = parcel.AnchorTx
+		s.AnchorTx.ChainFees = parcel.ChainFees
+	}
+
+	for _, vPkt := range s.PassiveAssets {
+		for _, vOut := range vPkt.Outputs {
+			if vOut.ProofSuffix != nil {
+				vOut.ProofSuffix.AnchorTx = *parcel.AnchorTx
+			}
+		}
+	}
+}
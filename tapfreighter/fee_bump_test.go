@@ -0,0 +1,287 @@
+package tapfreighter
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapsend"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// testChangeValue is the value of the BTC change output of the test
+	// anchor transaction.
+	testChangeValue = 100_000
+
+	// testChainFees is the fee paid by the test anchor transaction.
+	testChainFees = 1_000
+)
+
+// newFeeBumpParcel creates a parcel with a signed anchor transaction that has
+// an asset anchor output at index 0 and a BTC change output at index 1.
+func newFeeBumpParcel(t *testing.T) *OutboundParcel {
+	p2trScript := func() []byte {
+		return append([]byte{0x51, 0x20}, test.RandBytes(32)...)
+	}
+
+	unsignedTx := wire.NewMsgTx(2)
+	for i := 0; i < 2; i++ {
+		unsignedTx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: test.RandOp(t),
+		})
+	}
+	unsignedTx.AddTxOut(&wire.TxOut{
+		Value:    1_000,
+		PkScript: p2trScript(),
+	})
+	unsignedTx.AddTxOut(&wire.TxOut{
+		Value:    testChangeValue,
+		PkScript: p2trScript(),
+	})
+
+	pkt, err := psbt.NewFromUnsignedTx(unsignedTx)
+	require.NoError(t, err)
+
+	signedTx := unsignedTx.Copy()
+	for _, txIn := range signedTx.TxIn {
+		txIn.Witness = wire.TxWitness{test.RandBytes(64)}
+	}
+	anchorTxHash := signedTx.TxHash()
+
+	suffix := proof.RandProof(
+		t, asset.RandGenesis(t, asset.Normal), test.RandPubKey(t),
+		wire.MsgBlock{Transactions: []*wire.MsgTx{signedTx}}, 0, 0,
+	)
+	var suffixBuf bytes.Buffer
+	require.NoError(t, suffix.Encode(&suffixBuf))
+
+	return &OutboundParcel{
+		AnchorTx:  signedTx,
+		ChainFees: testChainFees,
+		Outputs: []TransferOutput{{
+			Anchor: Anchor{
+				OutPoint: wire.OutPoint{
+					Hash:  anchorTxHash,
+					Index: 0,
+				},
+				Value: 1_000,
+			},
+			ProofSuffix: suffixBuf.Bytes(),
+		}},
+		FundedAnchorPsbt: &tapsend.FundedPsbt{
+			Pkt:               pkt,
+			ChangeOutputIndex: 1,
+			ChainFees:         testChainFees,
+		},
+	}
+}
+
+// TestNewReplacementPsbt tests that the replacement of an anchor transaction
+// deducts the additional fee from the change output and that all invalid fee
+// bumps are rejected.
+func TestNewReplacementPsbt(t *testing.T) {
+	t.Parallel()
+
+	const feeRate = chainfee.SatPerKWeight(10_000)
+
+	testCases := []struct {
+		name                string
+		modify              func(*OutboundParcel)
+		feeRate             chainfee.SatPerKWeight
+		incrementalRelayFee chainfee.SatPerKWeight
+		err                 error
+	}{{
+		name: "no anchor psbt",
+		modify: func(p *OutboundParcel) {
+			p.FundedAnchorPsbt = nil
+		},
+		feeRate: feeRate,
+		err:     ErrNoAnchorPsbt,
+	}, {
+		name: "no change output",
+		modify: func(p *OutboundParcel) {
+			p.FundedAnchorPsbt.ChangeOutputIndex = -1
+		},
+		feeRate: feeRate,
+		err:     ErrNoChangeOutput,
+	}, {
+		name: "change output anchors assets",
+		modify: func(p *OutboundParcel) {
+			p.FundedAnchorPsbt.ChangeOutputIndex = 0
+		},
+		feeRate: feeRate,
+		err:     ErrNoChangeOutput,
+	}, {
+		name:    "fee rate too low",
+		feeRate: chainfee.FeePerKwFloor,
+		err:     ErrFeeRateTooLow,
+	}, {
+		name:                "fee rate below incremental relay fee",
+		feeRate:             feeRate,
+		incrementalRelayFee: feeRate,
+		err:                 ErrFeeRateTooLow,
+	}, {
+		name:    "change value too low",
+		feeRate: 1_000_000,
+		err:     ErrChangeValueTooLow,
+	}, {
+		name: "output of other transaction at change index",
+		modify: func(p *OutboundParcel) {
+			p.Outputs = append(p.Outputs, TransferOutput{
+				Anchor: Anchor{
+					OutPoint: wire.OutPoint{
+						Hash:  test.RandHash(),
+						Index: 1,
+					},
+				},
+			})
+		},
+		feeRate: feeRate,
+	}, {
+		name:    "success",
+		feeRate: feeRate,
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			parcel := newFeeBumpParcel(tt)
+			if tc.modify != nil {
+				tc.modify(parcel)
+			}
+
+			// Signatures of the original transaction must be
+			// removed from the replacement.
+			if parcel.FundedAnchorPsbt != nil {
+				parcel.FundedAnchorPsbt.Pkt.Inputs[0].
+					FinalScriptWitness = []byte{0x01}
+			}
+
+			incrementalRelayFee := tc.incrementalRelayFee
+			if incrementalRelayFee == 0 {
+				incrementalRelayFee = DefaultIncrementalRelayFee
+			}
+
+			replacement, err := NewReplacementPsbt(
+				parcel, tc.feeRate, incrementalRelayFee,
+				tapsend.OutputOrderingNone,
+			)
+			if tc.err != nil {
+				require.ErrorIs(tt, err, tc.err)
+				return
+			}
+			require.NoError(tt, err)
+
+			extraFee := replacement.ChainFees - testChainFees
+			require.Greater(tt, extraFee, int64(0))
+
+			newPkt := replacement.Pkt
+			txOut := newPkt.UnsignedTx.TxOut
+			require.EqualValues(
+				tt, testChangeValue-extraFee, txOut[1].Value,
+			)
+			require.Equal(tt, parcel.AnchorTx.TxOut[0], txOut[0])
+			require.Nil(tt, newPkt.Inputs[0].FinalScriptWitness)
+
+			// The funded PSBT of the parcel must not be modified.
+			origPkt := parcel.FundedAnchorPsbt.Pkt
+			require.EqualValues(
+				tt, testChangeValue,
+				origPkt.UnsignedTx.TxOut[1].Value,
+			)
+		})
+	}
+}
+
+// TestNewReplacementPsbtOrdering tests that the lexicographic ordering policy
+// is applied again to the outputs of a replacement anchor transaction after
+// the value of its change output was reduced.
+func TestNewReplacementPsbtOrdering(t *testing.T) {
+	t.Parallel()
+
+	// We add an extra output right before the change output, which has a
+	// slightly lower value than the change output, so the outputs are in
+	// lexicographic order.
+	parcel := newFeeBumpParcel(t)
+	extraOut := &wire.TxOut{
+		Value:    testChangeValue - 10,
+		PkScript: test.RandBytes(34),
+	}
+	for _, tx := range []*wire.MsgTx{
+		parcel.AnchorTx, parcel.FundedAnchorPsbt.Pkt.UnsignedTx,
+	} {
+		tx.TxOut = []*wire.TxOut{
+			tx.TxOut[0], extraOut, tx.TxOut[1],
+		}
+	}
+	pkt := parcel.FundedAnchorPsbt.Pkt
+	pkt.Outputs = append(pkt.Outputs, psbt.POutput{})
+	parcel.FundedAnchorPsbt.ChangeOutputIndex = 2
+
+	// Once the fee is deducted from the change output, it has a lower
+	// value than the extra output and needs to come before it.
+	replacement, err := NewReplacementPsbt(
+		parcel, 10_000, DefaultIncrementalRelayFee,
+		tapsend.OutputOrderingLexicographic,
+	)
+	require.NoError(t, err)
+
+	txOuts := replacement.Pkt.UnsignedTx.TxOut
+	require.EqualValues(t, 1, replacement.ChangeOutputIndex)
+	require.Less(t, txOuts[1].Value, extraOut.Value)
+	require.Equal(t, extraOut, txOuts[2])
+	require.Equal(t, parcel.AnchorTx.TxOut[0], txOuts[0])
+}
+
+// TestReplaceAnchorTx tests that the anchor outpoints and proof suffixes of a
+// parcel are updated to the replacement anchor transaction.
+func TestReplaceAnchorTx(t *testing.T) {
+	t.Parallel()
+
+	parcel := newFeeBumpParcel(t)
+	oldTxHash := parcel.AnchorTx.TxHash()
+
+	replacement, err := NewReplacementPsbt(
+		parcel, 10_000, DefaultIncrementalRelayFee,
+		tapsend.OutputOrderingNone,
+	)
+	require.NoError(t, err)
+
+	newTx := replacement.Pkt.UnsignedTx.Copy()
+	for _, txIn := range newTx.TxIn {
+		txIn.Witness = wire.TxWitness{test.RandBytes(64)}
+	}
+	newTxHash := newTx.TxHash()
+
+	newParcel, err := parcel.ReplaceAnchorTx(newTx, replacement)
+	require.NoError(t, err)
+
+	require.Equal(t, newTxHash, newParcel.AnchorTx.TxHash())
+	require.Equal(t, replacement.ChainFees, newParcel.ChainFees)
+	require.Equal(
+		t, replacement.Pkt.UnsignedTx.TxHash(),
+		newParcel.FundedAnchorPsbt.Pkt.UnsignedTx.TxHash(),
+	)
+
+	newOut := newParcel.Outputs[0]
+	require.Equal(t, newTxHash, newOut.Anchor.OutPoint.Hash)
+
+	var suffix proof.Proof
+	require.NoError(t, suffix.Decode(bytes.NewReader(newOut.ProofSuffix)))
+	require.Equal(t, newTxHash, suffix.AnchorTx.TxHash())
+	require.Equal(t, newTxHash, suffix.OutPoint().Hash)
+
+	// The original parcel must remain unchanged.
+	require.Equal(t, oldTxHash, parcel.AnchorTx.TxHash())
+	require.Equal(t, oldTxHash, parcel.Outputs[0].Anchor.OutPoint.Hash)
+
+	// A replacement with different outputs is rejected.
+	newTx.AddTxOut(&wire.TxOut{Value: 1_000})
+	_, err = parcel.ReplaceAnchorTx(newTx, replacement)
+	require.Error(t, err)
+}
@@ -0,0 +1,126 @@
+package tapfreighter
+
+import (
+	"sort"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+)
+
+// InspectParcel returns a breakdown of the active part of the given outbound
+// parcel, in the same form as the breakdown of a virtual packet. The virtual
+// packets of a parcel aren't stored, so the breakdown is built from the inputs
+// and outputs of the transfer instead. Input assets, their proofs and the
+// values of their anchor outputs aren't part of the transfer and are therefore
+// reported as missing. The passive assets of the parcel are still stored as
+// virtual packets and can be inspected with tappsbt.Inspect.
+func InspectParcel(parcel *OutboundParcel) *tappsbt.Inspection {
+	inspection := &tappsbt.Inspection{}
+
+	// The witnesses of all inputs of an asset are carried by the split
+	// root output of that asset. Without a split, there is only a single
+	// output of the asset that carries them. The asset of an output is
+	// only known if it has a proof suffix or all inputs spend the same
+	// asset.
+	outputsPerAsset := make(map[asset.ID][]*TransferOutput)
+	for idx := range parcel.Outputs {
+		out := &parcel.Outputs[idx]
+		assetID, err := outputAssetID(parcel, out)
+		if err != nil {
+			continue
+		}
+
+		outputsPerAsset[assetID] = append(
+			outputsPerAsset[assetID], out,
+		)
+	}
+
+	witnesses := make(map[asset.ID][]asset.Witness)
+	for assetID, outputs := range outputsPerAsset {
+		for _, out := range outputs {
+			if out.Type.IsSplitRoot() || len(outputs) == 1 {
+				witnesses[assetID] = out.WitnessData
+				break
+			}
+		}
+	}
+
+	inputIndexes := make(map[asset.ID]int)
+	for idx, in := range parcel.Inputs {
+		// The index of the input among the inputs that spend the same
+		// asset is the index of its witness.
+		witnessIdx := inputIndexes[in.ID]
+		inputIndexes[in.ID]++
+
+		summary := tappsbt.InputSummary{
+			Index:  idx,
+			PrevID: in.PrevID,
+			Amount: in.Amount,
+		}
+
+		assetWitnesses := witnesses[in.ID]
+		if witnessIdx < len(assetWitnesses) {
+			summary.Signed =
+				len(assetWitnesses[witnessIdx].TxWitness) > 0
+		}
+
+		if !summary.Signed {
+			inspection.MissingWitnesses = append(
+				inspection.MissingWitnesses, idx,
+			)
+		}
+
+		inspection.Inputs = append(inspection.Inputs, summary)
+	}
+
+	if len(inputIndexes) == 1 {
+		for assetID := range inputIndexes {
+			inspection.AssetID = &assetID
+		}
+	}
+
+	anchorOutputs := make(map[uint32][]int)
+	for idx := range parcel.Outputs {
+		out := &parcel.Outputs[idx]
+		anchorIdx := out.Anchor.OutPoint.Index
+
+		summary := tappsbt.OutputSummary{
+			Index:             idx,
+			Type:              out.Type,
+			Amount:            out.Amount,
+			ScriptKey:         out.ScriptKey.PubKey,
+			AnchorOutputIndex: anchorIdx,
+			AnchorInternalKey: out.Anchor.InternalKey.PubKey,
+			HasTapSibling:     len(out.Anchor.TapscriptSibling) > 0,
+			HasAsset:          true,
+			HasProofSuffix:    len(out.ProofSuffix) > 0,
+			ProofDeliveryAddress: string(
+				out.ProofCourierAddr,
+			),
+		}
+		for _, witness := range out.WitnessData {
+			if witness.SplitCommitment != nil {
+				summary.HasSplitCommitment = true
+			}
+		}
+
+		inspection.Outputs = append(inspection.Outputs, summary)
+
+		anchorOutputs[anchorIdx] = append(anchorOutputs[anchorIdx], idx)
+	}
+
+	for anchorIdx, outputIndexes := range anchorOutputs {
+		inspection.AnchorOutputs = append(
+			inspection.AnchorOutputs, tappsbt.AnchorOutputSummary{
+				AnchorOutputIndex: anchorIdx,
+				OutputIndexes:     outputIndexes,
+			},
+		)
+	}
+	sort.Slice(inspection.AnchorOutputs, func(i, j int) bool {
+		return inspection.AnchorOutputs[i].AnchorOutputIndex <
+			inspection.AnchorOutputs[j].AnchorOutputIndex
+	})
+
+	return inspection
+}
@@ -0,0 +1,91 @@
+package tapfreighter
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/stretchr/testify/require"
+)
+
+// TestInspectParcel tests that the breakdown of a parcel lists the inputs
+// without a witness and maps the outputs to their anchor outputs.
+func TestInspectParcel(t *testing.T) {
+	t.Parallel()
+
+	assetID := asset.RandID(t)
+	parcel := &OutboundParcel{
+		Inputs: []TransferInput{{
+			PrevID: asset.PrevID{
+				OutPoint: test.RandOp(t),
+				ID:       assetID,
+			},
+			Amount: 20,
+		}, {
+			PrevID: asset.PrevID{
+				OutPoint: test.RandOp(t),
+				ID:       assetID,
+			},
+			Amount: 10,
+		}},
+		Outputs: []TransferOutput{{
+			Anchor: Anchor{
+				OutPoint: wire.OutPoint{Index: 1},
+			},
+			Type:      tappsbt.TypeSimple,
+			ScriptKey: asset.RandScriptKey(t),
+			Amount:    10,
+			WitnessData: []asset.Witness{{
+				PrevID:          &asset.ZeroPrevID,
+				SplitCommitment: &asset.SplitCommitment{},
+			}},
+			ProofCourierAddr: []byte("universerpc://localhost"),
+		}, {
+			Anchor: Anchor{
+				OutPoint: wire.OutPoint{Index: 0},
+			},
+			Type:      tappsbt.TypeSplitRoot,
+			ScriptKey: asset.RandScriptKey(t),
+			Amount:    20,
+
+			// Only the first of the two inputs is signed.
+			WitnessData: []asset.Witness{{
+				TxWitness: wire.TxWitness{{1, 2, 3}},
+			}, {}},
+		}, {
+			Anchor: Anchor{
+				OutPoint: wire.OutPoint{Index: 1},
+			},
+			Type:      tappsbt.TypeSimple,
+			ScriptKey: asset.RandScriptKey(t),
+		}},
+	}
+
+	inspection := InspectParcel(parcel)
+
+	require.Equal(t, &assetID, inspection.AssetID)
+
+	require.Len(t, inspection.Inputs, 2)
+	require.True(t, inspection.Inputs[0].Signed)
+	require.False(t, inspection.Inputs[1].Signed)
+	require.EqualValues(t, 10, inspection.Inputs[1].Amount)
+	require.Equal(t, []int{1}, inspection.MissingWitnesses)
+
+	require.Len(t, inspection.Outputs, 3)
+	require.True(t, inspection.Outputs[0].HasSplitCommitment)
+	require.False(t, inspection.Outputs[1].HasSplitCommitment)
+	require.Equal(
+		t, "universerpc://localhost",
+		inspection.Outputs[0].ProofDeliveryAddress,
+	)
+
+	require.Equal(t, []tappsbt.AnchorOutputSummary{{
+		AnchorOutputIndex: 0,
+		OutputIndexes:     []int{1},
+	}, {
+		AnchorOutputIndex: 1,
+		OutputIndexes:     []int{0, 2},
+	}}, inspection.AnchorOutputs)
+}
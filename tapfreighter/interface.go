@@ -18,7 +18,9 @@ import (
 	"github.com/lightninglabs/taproot-assets/tapgarden"
 	"github.com/lightninglabs/taproot-assets/tappsbt"
 	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightninglabs/taproot-assets/tapsend"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
 // CommitmentConstraints conveys the constraints on the type of Taproot asset
@@ -131,8 +133,62 @@ const (
 	// descending amounts and selects the first subset which cumulatively
 	// sums to at least the minimum target amount.
 	PreferMaxAmount MultiCommitmentSelectStrategy = iota
+
+	// PreferMinAmount is a strategy which considers commitments in order of
+	// ascending amounts and selects the first subset which cumulatively
+	// sums to at least the minimum target amount. This consolidates small
+	// commitments over time, at the cost of larger transfers.
+	PreferMinAmount
+
+	// PreferExactMatch is a strategy which uses a branch-and-bound search
+	// to find a subset of commitments that sums to exactly the minimum
+	// target amount, which avoids creating a change output. If no exact
+	// match can be found, it falls back to PreferMaxAmount.
+	PreferExactMatch
+
+	// PreferRandom is a strategy which considers commitments in random
+	// order and selects the first subset which cumulatively sums to at
+	// least the minimum target amount. Because the selected inputs don't
+	// follow a predictable pattern, it is harder for an observer to link
+	// the transfers of a wallet or to infer its remaining balance.
+	PreferRandom
 )
 
+// String returns a human-readable representation of the strategy.
+func (s MultiCommitmentSelectStrategy) String() string {
+	switch s {
+	case PreferMaxAmount:
+		return "largest-first"
+	case PreferMinAmount:
+		return "smallest-first"
+	case PreferExactMatch:
+		return "exact-match"
+	case PreferRandom:
+		return "random"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(s))
+	}
+}
+
+// ParseCoinSelectStrategy parses a coin selection strategy from its string
+// representation.
+func ParseCoinSelectStrategy(s string) (MultiCommitmentSelectStrategy,
+	error) {
+
+	switch s {
+	case "", "largest-first":
+		return PreferMaxAmount, nil
+	case "smallest-first":
+		return PreferMinAmount, nil
+	case "exact-match":
+		return PreferExactMatch, nil
+	case "random":
+		return PreferRandom, nil
+	default:
+		return 0, fmt.Errorf("unknown coin selection strategy: %v", s)
+	}
+}
+
 // CoinSelector is an interface that describes the functionality used in
 // selecting coins during the asset send process.
 type CoinSelector interface {
@@ -286,6 +342,22 @@ type OutboundParcel struct {
 	// Outputs represents the list of new assets that were created with this
 	// transfer.
 	Outputs []TransferOutput
+
+	// FundedAnchorPsbt is the funded but unsigned version of the anchor
+	// transaction, which is required to re-sign the anchor transaction
+	// when bumping its fee. This might be nil for transfers that were
+	// logged before the PSBT was persisted.
+	FundedAnchorPsbt *tapsend.FundedPsbt
+
+	// NumConfs is the number of confirmations the anchor transaction needs
+	// to reach before the proofs of the transfer are stored and the
+	// transfer is complete. Zero means a single confirmation.
+	NumConfs uint32
+
+	// BroadcastAttempted is true if the anchor transaction was handed to
+	// the chain backend for broadcast at least once. A parcel whose anchor
+	// transaction was never broadcast is unknown to the network.
+	BroadcastAttempted bool
 }
 
 // Copy creates a deep copy of the OutboundParcel.
@@ -297,6 +369,8 @@ func (o *OutboundParcel) Copy() *OutboundParcel {
 		PassiveAssets:      fn.CopyAll(o.PassiveAssets),
 		Inputs:             fn.CopySlice(o.Inputs),
 		Outputs:            fn.CopySlice(o.Outputs),
+		NumConfs:           o.NumConfs,
+		BroadcastAttempted: o.BroadcastAttempted,
 	}
 
 	if o.AnchorTx != nil {
@@ -317,6 +391,10 @@ func (o *OutboundParcel) Copy() *OutboundParcel {
 		}
 	}
 
+	if o.FundedAnchorPsbt != nil {
+		newParcel.FundedAnchorPsbt = o.FundedAnchorPsbt.Copy()
+	}
+
 	return newParcel
 }
 
@@ -345,6 +423,52 @@ type AssetConfirmEvent struct {
 	PassiveAssetProofFiles map[asset.ID][]*proof.AnnotatedProof
 }
 
+// ParcelQuery holds the optional filters, the sort order and the page size
+// of a paginated query for outbound parcels.
+type ParcelQuery struct {
+	// AnchorTxHash only selects the parcel with this anchor transaction.
+	AnchorTxHash fn.Option[chainhash.Hash]
+
+	// AssetID only selects parcels that spend an asset with this ID.
+	AssetID fn.Option[asset.ID]
+
+	// GroupKey only selects parcels that spend an asset of this group.
+	GroupKey fn.Option[btcec.PublicKey]
+
+	// ScriptKey only selects parcels with an output to this script key.
+	ScriptKey fn.Option[btcec.PublicKey]
+
+	// Confirmed only selects confirmed parcels if true, or unconfirmed
+	// parcels if false.
+	Confirmed fn.Option[bool]
+
+	// StartTime only selects parcels created at or after this time.
+	StartTime fn.Option[time.Time]
+
+	// EndTime only selects parcels created before this time.
+	EndTime fn.Option[time.Time]
+
+	// Descending returns the newest parcels first.
+	Descending bool
+
+	// Cursor is the NextCursor of the previous page. If it is None, the
+	// first page is returned.
+	Cursor fn.Option[int64]
+
+	// Limit is the maximum number of parcels in a page.
+	Limit int32
+}
+
+// ParcelPage is a single page of the result of a paginated parcel query.
+type ParcelPage struct {
+	// Parcels is the set of parcels of this page.
+	Parcels []*OutboundParcel
+
+	// NextCursor is the cursor to fetch the next page with. It is None if
+	// this is the last page.
+	NextCursor fn.Option[int64]
+}
+
 // ExportLog is used to track the state of outbound Taproot Asset parcels
 // (batched spends). This log is used by the ChainPorter to mark pending
 // outbound deliveries, and finally confirm the deliveries once they've been
@@ -369,6 +493,31 @@ type ExportLog interface {
 	// QueryParcels returns the set of confirmed or unconfirmed parcels.
 	QueryParcels(ctx context.Context, anchorTxHash *chainhash.Hash,
 		pending bool) ([]*OutboundParcel, error)
+
+	// QueryParcelsPage returns a single page of the parcels that match the
+	// filters of the given query.
+	QueryParcelsPage(ctx context.Context,
+		query ParcelQuery) (*ParcelPage, error)
+
+	// ReplaceParcelAnchorTx replaces the unconfirmed anchor transaction of
+	// the pending parcel identified by the given anchor transaction hash
+	// with the anchor transaction of the given parcel. The anchor outputs,
+	// proof suffixes and funded anchor PSBT are updated to the ones of the
+	// given parcel.
+	ReplaceParcelAnchorTx(ctx context.Context,
+		oldAnchorTxHash chainhash.Hash, parcel *OutboundParcel) error
+
+	// MarkParcelBroadcastAttempted persists that the anchor transaction
+	// of the pending parcel with the given anchor transaction hash is
+	// about to be broadcast.
+	MarkParcelBroadcastAttempted(ctx context.Context,
+		anchorTxHash chainhash.Hash) error
+
+	// DeletePendingParcel removes the pending parcel with the given anchor
+	// transaction hash, whose anchor transaction must not have been
+	// broadcast, and releases its asset inputs.
+	DeletePendingParcel(ctx context.Context,
+		anchorTxHash chainhash.Hash) error
 }
 
 // ChainBridge aliases into the ChainBridge of the tapgarden package.
@@ -404,6 +553,33 @@ type Porter interface {
 		anchorTxHash fn.Option[chainhash.Hash], pending bool,
 	) ([]*OutboundParcel, error)
 
+	// BumpTransferFee replaces the anchor transaction of the pending
+	// transfer with the given anchor transaction hash with a version that
+	// pays the given, higher fee rate.
+	BumpTransferFee(ctx context.Context, anchorTxHash chainhash.Hash,
+		feeRate chainfee.SatPerKWeight) (*OutboundParcel, error)
+
+	// CancelParcel cancels the pending parcel with the given anchor
+	// transaction hash, whose anchor transaction hasn't been broadcast
+	// successfully yet, and releases all of its inputs.
+	CancelParcel(ctx context.Context,
+		anchorTxHash chainhash.Hash) (*OutboundParcel, error)
+
+	// RequestAnchorExport requests a transfer whose anchor transaction is
+	// signed externally. The funded anchor transaction is returned instead
+	// of being signed by the wallet.
+	RequestAnchorExport(req Parcel) (*AnchorExport, error)
+
+	// ImportSignedAnchor continues the transfer of a previously exported
+	// anchor transaction with the given, externally signed version of it.
+	ImportSignedAnchor(ctx context.Context,
+		signedPkt *psbt.Packet) (*OutboundParcel, error)
+
+	// CancelAnchorExport cancels the transfer of the exported anchor
+	// transaction with the given hash.
+	CancelAnchorExport(ctx context.Context,
+		anchorTxHash chainhash.Hash) error
+
 	// Start signals that the asset minter should being operations.
 	Start() error
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taproot-assets/address"
@@ -64,6 +65,12 @@ const (
 	// SendStateComplete is the state which is reached once entire asset
 	// transfer process is complete.
 	SendStateComplete
+
+	// SendStateAnchorExported is the state a parcel whose anchor
+	// transaction is signed externally ends in after the funded anchor
+	// transaction was exported. The transfer continues in a new parcel
+	// once the signed anchor transaction is imported.
+	SendStateAnchorExported
 )
 
 // String returns a human-readable version of SendState.
@@ -96,6 +103,9 @@ func (s SendState) String() string {
 	case SendStateComplete:
 		return "SendStateComplete"
 
+	case SendStateAnchorExported:
+		return "SendStateAnchorExported"
+
 	default:
 		return fmt.Sprintf("<unknown_state(%d)>", s)
 	}
@@ -123,6 +133,11 @@ type parcelKit struct {
 
 	// errChan is the channel the error will be sent over.
 	errChan chan error
+
+	// exportChan is the channel the exported anchor transaction will be
+	// sent over. It is only set if the anchor transaction of the parcel
+	// should be signed externally.
+	exportChan chan *AnchorExport
 }
 
 // AddressParcel is the main request to issue an asset transfer. This packages a
@@ -137,6 +152,20 @@ type AddressParcel struct {
 	// transferFeeRate is an optional manually-set feerate specified when
 	// requesting an asset transfer.
 	transferFeeRate *chainfee.SatPerKWeight
+
+	// coinSelectStrategy is an optional coin selection strategy that
+	// overrides the default strategy of the wallet.
+	coinSelectStrategy fn.Option[MultiCommitmentSelectStrategy]
+
+	// numConfs is the number of confirmations the anchor transaction needs
+	// to reach before the transfer is complete. Zero means a single
+	// confirmation.
+	numConfs uint32
+
+	// announcements are the zero-value outputs that announce payments to
+	// static addresses to their receivers. They are added to the anchor
+	// transaction next to the asset carrying outputs.
+	announcements []*wire.TxOut
 }
 
 // A compile-time assertion to ensure AddressParcel implements the parcel
@@ -145,16 +174,72 @@ var _ Parcel = (*AddressParcel)(nil)
 
 // NewAddressParcel creates a new AddressParcel.
 func NewAddressParcel(feeRate *chainfee.SatPerKWeight,
+	coinSelectStrategy fn.Option[MultiCommitmentSelectStrategy],
 	destAddrs ...*address.Tap) *AddressParcel {
 
+	// We wait for as many confirmations as any of the receivers asks for.
+	var numConfs uint32
+	for _, destAddr := range destAddrs {
+		numConfs = max(numConfs, destAddr.NumConfs)
+	}
+
 	return &AddressParcel{
 		parcelKit: &parcelKit{
 			respChan: make(chan *OutboundParcel, 1),
 			errChan:  make(chan error, 1),
 		},
-		destAddrs:       destAddrs,
-		transferFeeRate: feeRate,
+		destAddrs:          destAddrs,
+		transferFeeRate:    feeRate,
+		coinSelectStrategy: coinSelectStrategy,
+		numConfs:           numConfs,
+	}
+}
+
+// NewStaticAddressParcel creates a new AddressParcel that pays to the
+// single-use addresses of the given static address payments. The anchor
+// transaction of the parcel also carries the announcement of each payment, so
+// the receivers can detect it.
+func NewStaticAddressParcel(feeRate *chainfee.SatPerKWeight,
+	coinSelectStrategy fn.Option[MultiCommitmentSelectStrategy],
+	payments ...*address.StaticPayment) (*AddressParcel, error) {
+
+	destAddrs := make([]*address.Tap, 0, len(payments))
+	announcements := make([]*wire.TxOut, 0, len(payments))
+	for _, payment := range payments {
+		txOut, err := payment.Announcement.TxOut()
+		if err != nil {
+			return nil, fmt.Errorf("unable to create static "+
+				"payment announcement: %w", err)
+		}
+
+		destAddrs = append(destAddrs, payment.Addr)
+		announcements = append(announcements, txOut)
+	}
+
+	parcel := NewAddressParcel(feeRate, coinSelectStrategy, destAddrs...)
+	parcel.announcements = announcements
+
+	return parcel, nil
+}
+
+// SetNumConfs sets the number of confirmations the anchor transaction needs
+// to reach before the transfer is complete. The number can't be lower than
+// what any of the destination addresses asks for.
+func (p *AddressParcel) SetNumConfs(numConfs uint32) error {
+	if numConfs > address.MaxNumConfs {
+		return fmt.Errorf("number of confirmations %d exceeds "+
+			"maximum of %d", numConfs, address.MaxNumConfs)
 	}
+
+	p.numConfs = max(p.numConfs, numConfs)
+
+	return nil
+}
+
+// NumConfs returns the number of confirmations the anchor transaction needs to
+// reach before the transfer is complete.
+func (p *AddressParcel) NumConfs() uint32 {
+	return max(p.numConfs, 1)
 }
 
 // pkg returns the send package that should be delivered.
@@ -198,6 +283,111 @@ func (p *AddressParcel) Validate() error {
 			return fmt.Errorf("invalid proof courier address: %w",
 				err)
 		}
+
+		// We can't send to an address that requires a feature we
+		// don't know about.
+		if err := tapAddr.ValidateFeatures(); err != nil {
+			return fmt.Errorf("unable to send to address: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ScriptKeyDestination is the destination of an interactive send to a raw
+// script key and anchor output internal key that the receiver exchanged out of
+// band, instead of a Taproot Asset address.
+type ScriptKeyDestination struct {
+	// AssetID is the ID of the asset to send.
+	AssetID asset.ID
+
+	// Amount is the amount of asset units to send.
+	Amount uint64
+
+	// ScriptKey is the script key of the receiver, as it will appear in
+	// the asset leaf.
+	ScriptKey btcec.PublicKey
+
+	// AnchorInternalKey is the internal key of the receiver's anchor
+	// output.
+	AnchorInternalKey btcec.PublicKey
+
+	// AssetVersion is the version of the asset the receiver gets.
+	AssetVersion asset.Version
+}
+
+// ScriptKeyParcel is a request to issue an interactive asset transfer to a raw
+// script key destination. Because there is no address, the proof of the
+// receiver isn't delivered through a proof courier. Instead, it is part of the
+// returned transfer and can be exported from the local proof archive once the
+// anchor transaction confirmed.
+type ScriptKeyParcel struct {
+	*parcelKit
+
+	// dest is the destination of the transfer.
+	dest *ScriptKeyDestination
+
+	// transferFeeRate is an optional manually-set feerate specified when
+	// requesting an asset transfer.
+	transferFeeRate *chainfee.SatPerKWeight
+
+	// coinSelectStrategy is an optional coin selection strategy that
+	// overrides the default strategy of the wallet.
+	coinSelectStrategy fn.Option[MultiCommitmentSelectStrategy]
+}
+
+// A compile-time assertion to ensure ScriptKeyParcel implements the parcel
+// interface.
+var _ Parcel = (*ScriptKeyParcel)(nil)
+
+// NewScriptKeyParcel creates a new ScriptKeyParcel.
+func NewScriptKeyParcel(feeRate *chainfee.SatPerKWeight,
+	coinSelectStrategy fn.Option[MultiCommitmentSelectStrategy],
+	dest *ScriptKeyDestination) *ScriptKeyParcel {
+
+	return &ScriptKeyParcel{
+		parcelKit: &parcelKit{
+			respChan: make(chan *OutboundParcel, 1),
+			errChan:  make(chan error, 1),
+		},
+		dest:               dest,
+		transferFeeRate:    feeRate,
+		coinSelectStrategy: coinSelectStrategy,
+	}
+}
+
+// pkg returns the send package that should be delivered.
+func (p *ScriptKeyParcel) pkg() *sendPackage {
+	log.Infof("Received send request of %d units of asset %v to script "+
+		"key %x", p.dest.Amount, p.dest.AssetID,
+		p.dest.ScriptKey.SerializeCompressed())
+
+	return &sendPackage{
+		Parcel: p,
+	}
+}
+
+// kit returns the parcel kit used for delivery.
+func (p *ScriptKeyParcel) kit() *parcelKit {
+	return p.parcelKit
+}
+
+// Validate validates the parcel. The validation focuses on the necessary fields
+// being present in order for the porter not to panic. Any business logic
+// validation is assumed to already have happened.
+func (p *ScriptKeyParcel) Validate() error {
+	if p.dest == nil {
+		return fmt.Errorf("no destination in script key parcel")
+	}
+
+	if p.dest.Amount == 0 {
+		return fmt.Errorf("amount must be greater than zero")
+	}
+
+	// Sending to the NUMS key would make the assets unspendable, which
+	// should be done through a burn instead.
+	if p.dest.ScriptKey.IsEqual(asset.NUMSPubKey) {
+		return fmt.Errorf("script key must not be the NUMS key")
 	}
 
 	return nil
@@ -517,6 +707,10 @@ func ConvertToTransfer(currentHeight uint32, activeTransfers []*tappsbt.VPacket,
 		PassiveAssetsAnchor: passiveAssetAnchor,
 	}
 
+	if anchorTx.FundedPsbt != nil {
+		parcel.FundedAnchorPsbt = anchorTx.FundedPsbt.Copy()
+	}
+
 	for pIdx := range activeTransfers {
 		vPkt := activeTransfers[pIdx]
 
@@ -685,6 +879,13 @@ func outputAnchor(anchorTx *tapsend.AnchorTransaction, vOut *tappsbt.VOutput,
 	}, nil
 }
 
+// externalAnchorSign returns true if the anchor transaction of the package
+// should be exported for external signing instead of being signed by the
+// wallet.
+func (s *sendPackage) externalAnchorSign() bool {
+	return s.Parcel != nil && s.Parcel.kit().exportChan != nil
+}
+
 // deliverTxBroadcastResp delivers a response for the parcel back to the
 // receiver over the response channel.
 func (s *sendPackage) deliverTxBroadcastResp() {
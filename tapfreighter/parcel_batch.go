@@ -0,0 +1,161 @@
+package tapfreighter
+
+import (
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// parcelBatchKey identifies the address parcels that can be batched into a
+// single anchor transaction. Only parcels that agree on all options that
+// influence the anchor transaction as a whole can be batched. The virtual
+// packet of a parcel only spends a single asset ID, so only parcels that send
+// the same asset ID can be batched as well.
+type parcelBatchKey struct {
+	assetID            asset.ID
+	feeRate            fn.Option[chainfee.SatPerKWeight]
+	coinSelectStrategy fn.Option[MultiCommitmentSelectStrategy]
+	addrVersion        address.Version
+	numConfs           uint32
+}
+
+// newParcelBatchKey returns the batch key of the given address parcel.
+func newParcelBatchKey(parcel *AddressParcel) parcelBatchKey {
+	return parcelBatchKey{
+		assetID:            parcel.destAddrs[0].AssetID,
+		feeRate:            fn.MaybeSome(parcel.transferFeeRate),
+		coinSelectStrategy: parcel.coinSelectStrategy,
+		addrVersion:        parcel.destAddrs[0].Version,
+		numConfs:           parcel.NumConfs(),
+	}
+}
+
+// parcelBatch is a set of address parcels that are waiting for the batching
+// window to close, so they can be shipped in a single anchor transaction.
+type parcelBatch struct {
+	// parcels are the parcels of the batch, in the order they were
+	// requested.
+	parcels []*AddressParcel
+
+	// scriptKeys is the set of script keys the parcels of the batch send
+	// to. A parcel that sends to one of those script keys again can't be
+	// added to the batch.
+	scriptKeys fn.Set[asset.SerializedKey]
+}
+
+// newParcelBatch creates a new, empty parcel batch.
+func newParcelBatch() *parcelBatch {
+	return &parcelBatch{
+		scriptKeys: fn.NewSet[asset.SerializedKey](),
+	}
+}
+
+// add adds the given parcel to the batch. False is returned if the parcel
+// sends to a script key that a parcel of the batch already sends to, in which
+// case the parcel isn't added.
+func (b *parcelBatch) add(parcel *AddressParcel) bool {
+	scriptKeys := make([]asset.SerializedKey, 0, len(parcel.destAddrs))
+	for _, addr := range parcel.destAddrs {
+		scriptKey := asset.ToSerialized(&addr.ScriptKey)
+		if b.scriptKeys.Contains(scriptKey) {
+			return false
+		}
+
+		scriptKeys = append(scriptKeys, scriptKey)
+	}
+
+	for _, scriptKey := range scriptKeys {
+		b.scriptKeys.Add(scriptKey)
+	}
+	b.parcels = append(b.parcels, parcel)
+
+	return true
+}
+
+// merge returns a single address parcel that sends to the addresses of all
+// parcels in the batch. The parcels of a batch share the same batch key, so
+// the options of the first parcel apply to all of them.
+func (b *parcelBatch) merge() *AddressParcel {
+	first := b.parcels[0]
+
+	var (
+		destAddrs     []*address.Tap
+		announcements []*wire.TxOut
+	)
+	for _, parcel := range b.parcels {
+		destAddrs = append(destAddrs, parcel.destAddrs...)
+		announcements = append(announcements, parcel.announcements...)
+	}
+
+	merged := NewAddressParcel(
+		first.transferFeeRate, first.coinSelectStrategy, destAddrs...,
+	)
+	merged.numConfs = first.numConfs
+	merged.announcements = announcements
+
+	return merged
+}
+
+// batchableParcel returns the given parcel as an address parcel if it can be
+// batched with other parcels. Parcels whose anchor transaction is signed
+// externally are never batched, as the export belongs to a single caller.
+func (p *ChainPorter) batchableParcel(req Parcel) (*AddressParcel, bool) {
+	if p.cfg.SendBatchWindow == 0 {
+		return nil, false
+	}
+
+	addrParcel, ok := req.(*AddressParcel)
+	if !ok || addrParcel.exportChan != nil {
+		return nil, false
+	}
+
+	return addrParcel, true
+}
+
+// shipBatch ships the parcels of the given batch. A batch of a single parcel
+// is shipped as is. Otherwise, the parcels are merged into a single parcel
+// and the outcome of the merged parcel is delivered to each of them.
+func (p *ChainPorter) shipBatch(batch *parcelBatch) {
+	if len(batch.parcels) == 1 {
+		parcel := batch.parcels[0]
+		go p.advanceState(parcel.pkg(), parcel.kit())
+
+		return
+	}
+
+	log.Infof("Shipping %d batched address parcels in a single anchor "+
+		"transaction", len(batch.parcels))
+
+	merged := batch.merge()
+
+	p.Wg.Add(1)
+	go p.fanOutBatch(merged.kit(), batch.parcels)
+
+	go p.advanceState(merged.pkg(), merged.kit())
+}
+
+// fanOutBatch delivers the response or error of a merged parcel to each of
+// the parcels it was merged from.
+//
+// NOTE: This method MUST be called as a goroutine.
+func (p *ChainPorter) fanOutBatch(mergedKit *parcelKit,
+	parcels []*AddressParcel) {
+
+	defer p.Wg.Done()
+
+	select {
+	case resp := <-mergedKit.respChan:
+		for _, parcel := range parcels {
+			parcel.respChan <- resp
+		}
+
+	case err := <-mergedKit.errChan:
+		for _, parcel := range parcels {
+			parcel.errChan <- err
+		}
+
+	case <-p.Quit:
+	}
+}
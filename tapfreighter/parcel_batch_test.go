@@ -0,0 +1,172 @@
+package tapfreighter
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParcelBatch tests that address parcels are only batched if they agree
+// on the options of the anchor transaction and don't send to the same script
+// key twice.
+func TestParcelBatch(t *testing.T) {
+	t.Parallel()
+
+	noStrategy := fn.None[MultiCommitmentSelectStrategy]()
+	newAddr := func() *address.Tap {
+		return &address.Tap{
+			ScriptKey: *test.RandPubKey(t),
+		}
+	}
+
+	feeRate := chainfee.SatPerKWeight(1000)
+	addr1, addr2, addr3 := newAddr(), newAddr(), newAddr()
+	parcel1 := NewAddressParcel(&feeRate, noStrategy, addr1)
+	parcel2 := NewAddressParcel(&feeRate, noStrategy, addr2, addr3)
+
+	// Parcels with the same options share a batch key, a different fee
+	// rate or number of confirmations results in a different key.
+	otherFeeRate := chainfee.SatPerKWeight(2000)
+	otherFeeParcel := NewAddressParcel(&otherFeeRate, noStrategy, addr1)
+	otherConfsParcel := NewAddressParcel(&feeRate, noStrategy, addr1)
+	require.NoError(t, otherConfsParcel.SetNumConfs(3))
+
+	key := newParcelBatchKey(parcel1)
+	require.Equal(t, key, newParcelBatchKey(parcel2))
+	require.NotEqual(t, key, newParcelBatchKey(otherFeeParcel))
+	require.NotEqual(t, key, newParcelBatchKey(otherConfsParcel))
+
+	batch := newParcelBatch()
+	require.True(t, batch.add(parcel1))
+	require.True(t, batch.add(parcel2))
+
+	// A parcel that sends to a script key of the batch again isn't
+	// added.
+	duplicateParcel := NewAddressParcel(&feeRate, noStrategy, addr3)
+	require.False(t, batch.add(duplicateParcel))
+	require.Len(t, batch.parcels, 2)
+
+	merged := batch.merge()
+	require.Equal(t, []*address.Tap{addr1, addr2, addr3}, merged.destAddrs)
+	require.Equal(t, feeRate, *merged.transferFeeRate)
+	require.Equal(t, key, newParcelBatchKey(merged))
+
+	// The merged parcel has its own response channels.
+	require.NotSame(t, parcel1.kit(), merged.kit())
+}
+
+// TestParcelBatchAssetIDs tests that address parcels that send different
+// asset IDs within the same batching window end up in different batches.
+func TestParcelBatchAssetIDs(t *testing.T) {
+	t.Parallel()
+
+	noStrategy := fn.None[MultiCommitmentSelectStrategy]()
+	newAddr := func(assetID asset.ID) *address.Tap {
+		return &address.Tap{
+			AssetID:   assetID,
+			ScriptKey: *test.RandPubKey(t),
+		}
+	}
+
+	feeRate := chainfee.SatPerKWeight(1000)
+	assetID1, assetID2 := asset.RandID(t), asset.RandID(t)
+	parcel1 := NewAddressParcel(&feeRate, noStrategy, newAddr(assetID1))
+	parcel2 := NewAddressParcel(&feeRate, noStrategy, newAddr(assetID2))
+	parcel3 := NewAddressParcel(&feeRate, noStrategy, newAddr(assetID1))
+
+	// We collect the parcels the same way the porter does within a
+	// single batching window.
+	batches := make(map[parcelBatchKey]*parcelBatch)
+	for _, parcel := range []*AddressParcel{parcel1, parcel2, parcel3} {
+		key := newParcelBatchKey(parcel)
+		batch, ok := batches[key]
+		if !ok {
+			batch = newParcelBatch()
+			batches[key] = batch
+		}
+
+		require.True(t, batch.add(parcel))
+	}
+
+	// Only the parcels that send the same asset ID are merged, so each
+	// merged parcel only sends a single asset ID.
+	require.Len(t, batches, 2)
+
+	batch1 := batches[newParcelBatchKey(parcel1)]
+	require.Equal(t, []*AddressParcel{parcel1, parcel3}, batch1.parcels)
+	for _, addr := range batch1.merge().destAddrs {
+		require.Equal(t, assetID1, addr.AssetID)
+	}
+
+	batch2 := batches[newParcelBatchKey(parcel2)]
+	require.Equal(t, []*AddressParcel{parcel2}, batch2.parcels)
+}
+
+// TestFanOutBatch tests that the outcome of a merged parcel is delivered to
+// all parcels it was merged from.
+func TestFanOutBatch(t *testing.T) {
+	t.Parallel()
+
+	porter := NewChainPorter(&ChainPorterConfig{
+		SendBatchWindow: 1,
+	})
+	defer close(porter.Quit)
+
+	noStrategy := fn.None[MultiCommitmentSelectStrategy]()
+	newParcels := func() []*AddressParcel {
+		return []*AddressParcel{
+			NewAddressParcel(nil, noStrategy, &address.Tap{}),
+			NewAddressParcel(nil, noStrategy, &address.Tap{}),
+		}
+	}
+
+	// A response is delivered to all parcels.
+	parcels := newParcels()
+	batch := &parcelBatch{parcels: parcels}
+	merged := batch.merge()
+
+	porter.Wg.Add(1)
+	go porter.fanOutBatch(merged.kit(), parcels)
+
+	resp := &OutboundParcel{}
+	merged.respChan <- resp
+	for _, parcel := range parcels {
+		require.Same(t, resp, <-parcel.respChan)
+	}
+
+	// An error is delivered to all parcels as well.
+	parcels = newParcels()
+	batch = &parcelBatch{parcels: parcels}
+	merged = batch.merge()
+
+	porter.Wg.Add(1)
+	go porter.fanOutBatch(merged.kit(), parcels)
+
+	merged.errChan <- fmt.Errorf("insufficient funds")
+	for _, parcel := range parcels {
+		require.ErrorContains(t, <-parcel.errChan, "insufficient")
+	}
+
+	// Only address parcels whose anchor transaction isn't exported are
+	// batched.
+	_, ok := porter.batchableParcel(parcels[0])
+	require.True(t, ok)
+
+	parcels[0].exportChan = make(chan *AnchorExport, 1)
+	_, ok = porter.batchableParcel(parcels[0])
+	require.False(t, ok)
+
+	_, ok = porter.batchableParcel(NewPendingParcel(&OutboundParcel{}))
+	require.False(t, ok)
+
+	var noBatching ChainPorter
+	noBatching.cfg = &ChainPorterConfig{}
+	_, ok = noBatching.batchableParcel(parcels[1])
+	require.False(t, ok)
+}
@@ -0,0 +1,78 @@
+package tapfreighter
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAddressParcelNumConfs tests that an address parcel waits for as many
+// confirmations as any of its destination addresses asks for.
+func TestAddressParcelNumConfs(t *testing.T) {
+	t.Parallel()
+
+	noStrategy := fn.None[MultiCommitmentSelectStrategy]()
+
+	// Without any explicit number, a single confirmation is used.
+	parcel := NewAddressParcel(nil, noStrategy, &address.Tap{})
+	require.EqualValues(t, 1, parcel.NumConfs())
+
+	// The highest number of any destination address is used.
+	parcel = NewAddressParcel(
+		nil, noStrategy, &address.Tap{NumConfs: 3},
+		&address.Tap{NumConfs: 6}, &address.Tap{},
+	)
+	require.EqualValues(t, 6, parcel.NumConfs())
+
+	// The number can be raised, but never lowered below what the
+	// receivers ask for.
+	require.NoError(t, parcel.SetNumConfs(2))
+	require.EqualValues(t, 6, parcel.NumConfs())
+
+	require.NoError(t, parcel.SetNumConfs(10))
+	require.EqualValues(t, 10, parcel.NumConfs())
+
+	err := parcel.SetNumConfs(address.MaxNumConfs + 1)
+	require.ErrorContains(t, err, "exceeds maximum")
+	require.EqualValues(t, 10, parcel.NumConfs())
+}
+
+// TestScriptKeyParcel tests that a script key parcel is validated and starts
+// with coin selection, like an address parcel.
+func TestScriptKeyParcel(t *testing.T) {
+	t.Parallel()
+
+	noStrategy := fn.None[MultiCommitmentSelectStrategy]()
+	dest := &ScriptKeyDestination{
+		AssetID:           asset.RandID(t),
+		Amount:            100,
+		ScriptKey:         *test.RandPubKey(t),
+		AnchorInternalKey: *test.RandPubKey(t),
+	}
+
+	parcel := NewScriptKeyParcel(nil, noStrategy, dest)
+	require.NoError(t, parcel.Validate())
+
+	pkg := parcel.pkg()
+	require.Equal(t, SendStateVirtualCommitmentSelect, pkg.SendState)
+	require.Equal(t, parcel, pkg.Parcel)
+
+	// A parcel without a destination or amount is invalid.
+	require.Error(t, NewScriptKeyParcel(nil, noStrategy, nil).Validate())
+
+	zeroAmt := *dest
+	zeroAmt.Amount = 0
+	err := NewScriptKeyParcel(nil, noStrategy, &zeroAmt).Validate()
+	require.ErrorContains(t, err, "greater than zero")
+
+	// Sending to the NUMS key is a burn and must not be done through a
+	// script key parcel.
+	numsKey := *dest
+	numsKey.ScriptKey = *asset.NUMSPubKey
+	err = NewScriptKeyParcel(nil, noStrategy, &numsKey).Validate()
+	require.ErrorContains(t, err, "NUMS key")
+}
@@ -0,0 +1,220 @@
+package tapfreighter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+)
+
+var (
+	// ErrSendLimitExceeded is returned if a send would exceed the limit of
+	// the cumulative amount of an asset sent to a destination.
+	ErrSendLimitExceeded = errors.New("send limit exceeded")
+
+	// ErrSendLimitNotFound is returned if a send limit that should be
+	// removed doesn't exist.
+	ErrSendLimitNotFound = errors.New("send limit not found")
+)
+
+// DestinationSend is the amount of an asset sent to a destination script key
+// in a single transfer.
+type DestinationSend struct {
+	// ScriptKey is the script key of the destination.
+	ScriptKey *btcec.PublicKey
+
+	// AssetID is the ID of the asset that is sent.
+	AssetID asset.ID
+
+	// Amount is the amount of the asset sent to the destination.
+	Amount uint64
+}
+
+// SendTotal is the cumulative amount of an asset sent to a destination script
+// key.
+type SendTotal struct {
+	// ScriptKey is the script key of the destination.
+	ScriptKey *btcec.PublicKey
+
+	// AssetID is the ID of the asset that was sent.
+	AssetID asset.ID
+
+	// TotalAmount is the cumulative amount of the asset sent to the
+	// destination.
+	TotalAmount uint64
+
+	// NumSends is the number of transfers that sent the asset to the
+	// destination.
+	NumSends uint64
+
+	// LastSendTime is the time of the last transfer to the destination.
+	LastSendTime time.Time
+
+	// Limit is the maximum cumulative amount of the asset that can be sent
+	// to the destination, if a limit is set.
+	Limit fn.Option[uint64]
+}
+
+// SendLimit is the maximum cumulative amount of an asset that can be sent to
+// a destination script key.
+type SendLimit struct {
+	// ScriptKey is the script key of the destination.
+	ScriptKey *btcec.PublicKey
+
+	// AssetID is the ID of the asset the limit applies to.
+	AssetID asset.ID
+
+	// MaxAmount is the maximum cumulative amount of the asset that can be
+	// sent to the destination.
+	MaxAmount uint64
+}
+
+// SendTotalsQuery is used to filter the cumulative send totals.
+type SendTotalsQuery struct {
+	// ScriptKey, if set, limits the totals to the given destination.
+	ScriptKey *btcec.PublicKey
+
+	// AssetID, if set, limits the totals to the given asset.
+	AssetID *asset.ID
+}
+
+// SendQuotaLog keeps track of the cumulative amounts of assets sent to
+// destination script keys and enforces optional per-destination limits.
+type SendQuotaLog interface {
+	// RecordSends adds the given amounts to the cumulative send totals of
+	// their destinations. If any of the sends would exceed the limit of
+	// its destination, nothing is recorded and ErrSendLimitExceeded is
+	// returned.
+	RecordSends(ctx context.Context, sends []DestinationSend) error
+
+	// RevertSends removes the given, previously recorded amounts from the
+	// cumulative send totals of their destinations.
+	RevertSends(ctx context.Context, sends []DestinationSend) error
+
+	// QuerySendTotals returns the cumulative send totals that match the
+	// given query.
+	QuerySendTotals(ctx context.Context,
+		query SendTotalsQuery) ([]*SendTotal, error)
+
+	// SetSendLimit sets the limit of the cumulative amount of an asset
+	// that can be sent to a destination, replacing any existing limit.
+	SetSendLimit(ctx context.Context, limit SendLimit) error
+
+	// RemoveSendLimit removes the limit of the cumulative amount of the
+	// given asset that can be sent to the given destination.
+	RemoveSendLimit(ctx context.Context, scriptKey *btcec.PublicKey,
+		assetID asset.ID) error
+
+	// QuerySendLimits returns all send limits.
+	QuerySendLimits(ctx context.Context) ([]*SendLimit, error)
+}
+
+// destinationSends returns the amounts sent to external destinations by the
+// given virtual packets, aggregated per destination script key and asset ID.
+// Outputs that go to a local script key and burns aren't counted.
+func destinationSends(vPackets []*tappsbt.VPacket,
+	isLocalKey func(asset.ScriptKey) bool) []DestinationSend {
+
+	type destination struct {
+		scriptKey asset.SerializedKey
+		assetID   asset.ID
+	}
+
+	var (
+		sends   []DestinationSend
+		indexes = make(map[destination]int)
+	)
+	for _, vPkt := range vPackets {
+		for _, vOut := range vPkt.Outputs {
+			if vOut.Asset == nil || vOut.Amount == 0 ||
+				vOut.ScriptKey.PubKey == nil {
+
+				continue
+			}
+
+			if vOut.Asset.IsBurn() || isLocalKey(vOut.ScriptKey) {
+				continue
+			}
+
+			dest := destination{
+				scriptKey: asset.ToSerialized(
+					vOut.ScriptKey.PubKey,
+				),
+				assetID: vOut.Asset.ID(),
+			}
+			if idx, ok := indexes[dest]; ok {
+				sends[idx].Amount += vOut.Amount
+				continue
+			}
+
+			indexes[dest] = len(sends)
+			sends = append(sends, DestinationSend{
+				ScriptKey: vOut.ScriptKey.PubKey,
+				AssetID:   dest.assetID,
+				Amount:    vOut.Amount,
+			})
+		}
+	}
+
+	return sends
+}
+
+// parcelSends returns the amounts sent to external destinations by the given
+// parcel, aggregated per destination script key and asset ID. This mirrors
+// destinationSends for parcels that were restored from disk and for which the
+// virtual packets are no longer available.
+func parcelSends(parcel *OutboundParcel) ([]DestinationSend, error) {
+	type destination struct {
+		scriptKey asset.SerializedKey
+		assetID   asset.ID
+	}
+
+	var (
+		sends   []DestinationSend
+		indexes = make(map[destination]int)
+	)
+	for idx, out := range parcel.Outputs {
+		if out.ScriptKeyLocal || out.Amount == 0 ||
+			out.ScriptKey.PubKey == nil || len(out.ProofSuffix) == 0 {
+
+			continue
+		}
+
+		// The asset ID of the output is only contained in the proof
+		// suffix, which commits to the full output asset.
+		var suffix proof.Proof
+		err := suffix.Decode(bytes.NewReader(out.ProofSuffix))
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode proof suffix "+
+				"of output %d: %w", idx, err)
+		}
+		if suffix.Asset.IsBurn() {
+			continue
+		}
+
+		dest := destination{
+			scriptKey: asset.ToSerialized(out.ScriptKey.PubKey),
+			assetID:   suffix.Asset.ID(),
+		}
+		if idx, ok := indexes[dest]; ok {
+			sends[idx].Amount += out.Amount
+			continue
+		}
+
+		indexes[dest] = len(sends)
+		sends = append(sends, DestinationSend{
+			ScriptKey: out.ScriptKey.PubKey,
+			AssetID:   dest.assetID,
+			Amount:    out.Amount,
+		})
+	}
+
+	return sends, nil
+}
@@ -0,0 +1,65 @@
+package tapfreighter
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDestinationSends tests that the amounts sent to external destinations
+// are aggregated per destination script key and asset ID.
+func TestDestinationSends(t *testing.T) {
+	t.Parallel()
+
+	asset1 := asset.RandAsset(t, asset.Normal)
+	asset2 := asset.RandAsset(t, asset.Normal)
+
+	remoteKey := asset.NewScriptKey(test.RandPubKey(t))
+	otherRemoteKey := asset.NewScriptKey(test.RandPubKey(t))
+	localKey := asset.NewScriptKey(test.RandPubKey(t))
+	isLocalKey := func(key asset.ScriptKey) bool {
+		return key.PubKey.IsEqual(localKey.PubKey)
+	}
+
+	newOutput := func(a *asset.Asset, scriptKey asset.ScriptKey,
+		amount uint64) *tappsbt.VOutput {
+
+		return &tappsbt.VOutput{
+			Amount:    amount,
+			ScriptKey: scriptKey,
+			Asset:     a,
+		}
+	}
+
+	vPackets := []*tappsbt.VPacket{{
+		Outputs: []*tappsbt.VOutput{
+			newOutput(asset1, localKey, 40),
+			newOutput(asset1, remoteKey, 10),
+			newOutput(asset1, otherRemoteKey, 5),
+			newOutput(asset1, remoteKey, 0),
+		},
+	}, {
+		Outputs: []*tappsbt.VOutput{
+			newOutput(asset1, remoteKey, 15),
+			newOutput(asset2, remoteKey, 7),
+		},
+	}}
+
+	sends := destinationSends(vPackets, isLocalKey)
+	require.Equal(t, []DestinationSend{{
+		ScriptKey: remoteKey.PubKey,
+		AssetID:   asset1.ID(),
+		Amount:    25,
+	}, {
+		ScriptKey: otherRemoteKey.PubKey,
+		AssetID:   asset1.ID(),
+		Amount:    5,
+	}, {
+		ScriptKey: remoteKey.PubKey,
+		AssetID:   asset2.ID(),
+		Amount:    7,
+	}}, sends)
+}
@@ -0,0 +1,308 @@
+package tapfreighter
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+// SnapshotDiffParams defines the block range a snapshot diff is computed
+// over.
+type SnapshotDiffParams struct {
+	// FromHeight is the height of the earlier snapshot. Changes confirmed
+	// at this height are already part of it and aren't included.
+	FromHeight uint32
+
+	// ToHeight is the height of the later snapshot. Changes confirmed at
+	// this height are included.
+	ToHeight uint32
+}
+
+// SnapshotChange is a single asset UTXO that was created or spent between two
+// snapshots.
+type SnapshotChange struct {
+	// AssetID is the ID of the asset of the UTXO.
+	AssetID asset.ID
+
+	// ScriptKey is the script key of the UTXO.
+	ScriptKey asset.SerializedKey
+
+	// Amount is the amount of asset units of the UTXO.
+	Amount uint64
+
+	// AnchorPoint is the on-chain output that anchors the UTXO.
+	AnchorPoint wire.OutPoint
+
+	// Height is the height of the block the transaction that created or
+	// spent the UTXO confirmed in.
+	Height uint32
+}
+
+// SnapshotDelta is the change of the balance of a single asset between two
+// snapshots.
+type SnapshotDelta struct {
+	// Created is the amount of asset units of all created UTXOs.
+	Created uint64
+
+	// Spent is the amount of asset units of all spent UTXOs.
+	Spent uint64
+}
+
+// Net returns the change of the balance, which is negative if more asset
+// units were spent than created.
+func (d *SnapshotDelta) Net() int64 {
+	return int64(d.Created) - int64(d.Spent)
+}
+
+// SnapshotDiff describes how the local asset UTXO set changed between two
+// block heights.
+type SnapshotDiff struct {
+	// Params are the parameters the diff was computed with.
+	Params SnapshotDiffParams
+
+	// Created are the UTXOs that were created, sorted by height.
+	Created []SnapshotChange
+
+	// Spent are the UTXOs that were spent, sorted by height.
+	Spent []SnapshotChange
+
+	// Deltas are the sums of the created and spent UTXOs per asset ID.
+	Deltas map[asset.ID]*SnapshotDelta
+}
+
+// WalletTxLister lists the on-chain transactions of the backing wallet.
+type WalletTxLister interface {
+	// ListTransactions returns all known transactions of the backing lnd
+	// node within the given block range.
+	ListTransactions(ctx context.Context, startHeight, endHeight int32,
+		account string) ([]lndclient.Transaction, error)
+}
+
+// DiffSnapshots computes how the local asset UTXO set changed between the two
+// heights of the given parameters. Spent UTXOs and created change outputs are
+// taken from the transfer log, whose anchor transactions are matched with the
+// wallet transactions that confirmed within the range. Created UTXOs of
+// inbound transfers are taken from the receive log. Minted assets and passive
+// assets that were only re-anchored aren't part of the diff.
+func DiffSnapshots(ctx context.Context, parcels ParcelPager,
+	events EventQuerier, wallet WalletTxLister,
+	params SnapshotDiffParams) (*SnapshotDiff, error) {
+
+	if params.ToHeight < params.FromHeight {
+		return nil, fmt.Errorf("end height %d is before start height "+
+			"%d", params.ToHeight, params.FromHeight)
+	}
+
+	diff := &SnapshotDiff{
+		Params: params,
+		Deltas: make(map[asset.ID]*SnapshotDelta),
+	}
+	if params.ToHeight == params.FromHeight {
+		return diff, nil
+	}
+
+	confHeights, err := walletConfHeights(ctx, wallet, params)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list wallet transactions: %w",
+			err)
+	}
+
+	err = outboundSnapshotChanges(ctx, parcels, confHeights, diff)
+	if err != nil {
+		return nil, fmt.Errorf("unable to collect outbound transfers: "+
+			"%w", err)
+	}
+
+	err = inboundSnapshotChanges(ctx, events, params, diff)
+	if err != nil {
+		return nil, fmt.Errorf("unable to collect inbound transfers: "+
+			"%w", err)
+	}
+
+	for _, changes := range [][]SnapshotChange{diff.Created, diff.Spent} {
+		sort.SliceStable(changes, func(i, j int) bool {
+			return changes[i].Height < changes[j].Height
+		})
+	}
+
+	return diff, nil
+}
+
+// delta returns the delta of the given asset, creating it if it doesn't exist
+// yet.
+func (d *SnapshotDiff) delta(assetID asset.ID) *SnapshotDelta {
+	delta, ok := d.Deltas[assetID]
+	if !ok {
+		delta = &SnapshotDelta{}
+		d.Deltas[assetID] = delta
+	}
+
+	return delta
+}
+
+// addCreated adds a created UTXO to the diff.
+func (d *SnapshotDiff) addCreated(change SnapshotChange) {
+	d.Created = append(d.Created, change)
+	d.delta(change.AssetID).Created += change.Amount
+}
+
+// addSpent adds a spent UTXO to the diff.
+func (d *SnapshotDiff) addSpent(change SnapshotChange) {
+	d.Spent = append(d.Spent, change)
+	d.delta(change.AssetID).Spent += change.Amount
+}
+
+// walletConfHeights returns the confirmation heights of all wallet
+// transactions that confirmed within the block range of the given parameters,
+// keyed by their hash.
+func walletConfHeights(ctx context.Context, wallet WalletTxLister,
+	params SnapshotDiffParams) (map[chainhash.Hash]uint32, error) {
+
+	walletTxns, err := wallet.ListTransactions(
+		ctx, int32(params.FromHeight+1), int32(params.ToHeight), "",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	confHeights := make(map[chainhash.Hash]uint32, len(walletTxns))
+	for _, walletTx := range walletTxns {
+		if walletTx.Tx == nil || walletTx.BlockHeight <= 0 {
+			continue
+		}
+
+		height := uint32(walletTx.BlockHeight)
+		if height <= params.FromHeight || height > params.ToHeight {
+			continue
+		}
+
+		confHeights[walletTx.Tx.TxHash()] = height
+	}
+
+	return confHeights, nil
+}
+
+// outboundSnapshotChanges adds the inputs of all confirmed outbound parcels
+// whose anchor transaction confirmed within the range as spent UTXOs, and
+// their outputs to local script keys as created UTXOs.
+func outboundSnapshotChanges(ctx context.Context, parcels ParcelPager,
+	confHeights map[chainhash.Hash]uint32, diff *SnapshotDiff) error {
+
+	if len(confHeights) == 0 {
+		return nil
+	}
+
+	query := ParcelQuery{
+		Confirmed: fn.Some(true),
+		Limit:     statementPageSize,
+	}
+	for {
+		page, err := parcels.QueryParcelsPage(ctx, query)
+		if err != nil {
+			return err
+		}
+
+		for _, parcel := range page.Parcels {
+			if parcel.AnchorTx == nil {
+				continue
+			}
+
+			height, ok := confHeights[parcel.AnchorTx.TxHash()]
+			if !ok {
+				continue
+			}
+
+			err := addParcelChanges(parcel, height, diff)
+			if err != nil {
+				return err
+			}
+		}
+
+		if page.NextCursor.IsNone() {
+			return nil
+		}
+		query.Cursor = page.NextCursor
+	}
+}
+
+// addParcelChanges adds the inputs of the given parcel as spent UTXOs and its
+// outputs to local script keys as created UTXOs.
+func addParcelChanges(parcel *OutboundParcel, height uint32,
+	diff *SnapshotDiff) error {
+
+	for _, input := range parcel.Inputs {
+		diff.addSpent(SnapshotChange{
+			AssetID:     input.ID,
+			ScriptKey:   input.ScriptKey,
+			Amount:      input.Amount,
+			AnchorPoint: input.OutPoint,
+			Height:      height,
+		})
+	}
+
+	for idx := range parcel.Outputs {
+		out := &parcel.Outputs[idx]
+
+		// Tombstones don't carry any value, so they aren't part of
+		// the UTXO set.
+		if !out.ScriptKeyLocal || out.Amount == 0 ||
+			out.ScriptKey.PubKey == nil {
+
+			continue
+		}
+
+		assetID, err := outputAssetID(parcel, out)
+		if err != nil {
+			return err
+		}
+
+		diff.addCreated(SnapshotChange{
+			AssetID:     assetID,
+			ScriptKey:   asset.ToSerialized(out.ScriptKey.PubKey),
+			Amount:      out.Amount,
+			AnchorPoint: out.Anchor.OutPoint,
+			Height:      height,
+		})
+	}
+
+	return nil
+}
+
+// inboundSnapshotChanges adds the inbound transfers to local addresses that
+// confirmed within the range of the given parameters as created UTXOs.
+func inboundSnapshotChanges(ctx context.Context, events EventQuerier,
+	params SnapshotDiffParams, diff *SnapshotDiff) error {
+
+	statusFrom := address.StatusTransactionConfirmed
+	addrEvents, err := events.QueryEvents(ctx, address.EventQueryParams{
+		StatusFrom: &statusFrom,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, event := range addrEvents {
+		height := event.ConfirmationHeight
+		if height <= params.FromHeight || height > params.ToHeight {
+			continue
+		}
+
+		addr := event.Addr.Tap
+		diff.addCreated(SnapshotChange{
+			AssetID:     addr.AssetID,
+			ScriptKey:   asset.ToSerialized(&addr.ScriptKey),
+			Amount:      addr.Amount,
+			AnchorPoint: event.Outpoint,
+			Height:      height,
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package tapfreighter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// mockSnapshotEventQuerier returns all of its events that have at least the
+// queried status.
+type mockSnapshotEventQuerier struct {
+	events []*address.Event
+}
+
+func (m *mockSnapshotEventQuerier) QueryEvents(_ context.Context,
+	query address.EventQueryParams) ([]*address.Event, error) {
+
+	var events []*address.Event
+	for _, event := range m.events {
+		if query.StatusFrom != nil && event.Status < *query.StatusFrom {
+			continue
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// TestDiffSnapshots tests that a snapshot diff only contains the UTXOs that
+// were created or spent by transfers confirmed within the block range, and
+// sums them up per asset.
+func TestDiffSnapshots(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx        = context.Background()
+		courier    = address.RandProofCourierAddr(t)
+		localKey   = asset.RandScriptKey(t)
+		peerKey    = asset.RandScriptKey(t)
+		chainParam = address.ParamsForChain(
+			chaincfg.RegressionNetParams.Name,
+		)
+	)
+
+	addr, _, _ := address.RandAddr(t, &chainParam, courier)
+	assetID := addr.AssetID
+
+	// newParcel creates a parcel that spends a single input of the given
+	// amount, sends the given amount to the peer and keeps the change.
+	newParcel := func(inAmt, sendAmt uint64) *OutboundParcel {
+		anchorTx := wire.NewMsgTx(2)
+		anchorTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, nil, nil))
+		anchorTx.AddTxOut(wire.NewTxOut(int64(inAmt), nil))
+
+		return &OutboundParcel{
+			AnchorTx: anchorTx,
+			Inputs: []TransferInput{{
+				PrevID: asset.PrevID{
+					OutPoint: test.RandOp(t),
+					ID:       assetID,
+				},
+				Amount: inAmt,
+			}},
+			Outputs: []TransferOutput{{
+				Anchor: Anchor{
+					OutPoint: test.RandOp(t),
+				},
+				ScriptKey: peerKey,
+				Amount:    sendAmt,
+			}, {
+				Anchor: Anchor{
+					OutPoint: test.RandOp(t),
+				},
+				ScriptKey:      localKey,
+				ScriptKeyLocal: true,
+				Amount:         inAmt - sendAmt,
+			}},
+		}
+	}
+
+	var (
+		before = newParcel(100, 10)
+		inside = newParcel(90, 30)
+		after  = newParcel(60, 60)
+	)
+	wallet := &mockSpendWallet{
+		txns: []lndclient.Transaction{{
+			Tx:          before.AnchorTx,
+			BlockHeight: 100,
+		}, {
+			Tx:          inside.AnchorTx,
+			BlockHeight: 150,
+		}, {
+			Tx:          after.AnchorTx,
+			BlockHeight: 201,
+		}},
+	}
+
+	newEvent := func(status address.Status, height uint32) *address.Event {
+		return &address.Event{
+			Addr:               addr,
+			Status:             status,
+			Outpoint:           test.RandOp(t),
+			ConfirmationHeight: height,
+		}
+	}
+	receive := newEvent(address.StatusCompleted, 200)
+	events := &mockSnapshotEventQuerier{
+		events: []*address.Event{
+			newEvent(address.StatusCompleted, 100),
+			receive,
+			newEvent(address.StatusTransactionDetected, 0),
+		},
+	}
+
+	diff, err := DiffSnapshots(
+		ctx, &mockParcelPager{
+			parcels: []*OutboundParcel{before, inside, after},
+		}, events, wallet, SnapshotDiffParams{
+			FromHeight: 100,
+			ToHeight:   200,
+		},
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, []SnapshotChange{{
+		AssetID:     assetID,
+		ScriptKey:   inside.Inputs[0].ScriptKey,
+		Amount:      90,
+		AnchorPoint: inside.Inputs[0].OutPoint,
+		Height:      150,
+	}}, diff.Spent)
+
+	require.Equal(t, []SnapshotChange{{
+		AssetID:     assetID,
+		ScriptKey:   asset.ToSerialized(localKey.PubKey),
+		Amount:      60,
+		AnchorPoint: inside.Outputs[1].Anchor.OutPoint,
+		Height:      150,
+	}, {
+		AssetID:     assetID,
+		ScriptKey:   asset.ToSerialized(&addr.ScriptKey),
+		Amount:      addr.Amount,
+		AnchorPoint: receive.Outpoint,
+		Height:      200,
+	}}, diff.Created)
+
+	delta := diff.Deltas[assetID]
+	require.Equal(t, &SnapshotDelta{
+		Created: 60 + addr.Amount,
+		Spent:   90,
+	}, delta)
+	require.Equal(t, int64(addr.Amount)-30, delta.Net())
+
+	// An empty range has no changes, and an inverted range is invalid.
+	diff, err = DiffSnapshots(
+		ctx, &mockParcelPager{}, events, wallet, SnapshotDiffParams{
+			FromHeight: 150,
+			ToHeight:   150,
+		},
+	)
+	require.NoError(t, err)
+	require.Empty(t, diff.Created)
+	require.Empty(t, diff.Spent)
+
+	_, err = DiffSnapshots(
+		ctx, &mockParcelPager{}, events, wallet, SnapshotDiffParams{
+			FromHeight: 200,
+			ToHeight:   100,
+		},
+	)
+	require.Error(t, err)
+}
@@ -0,0 +1,486 @@
+package tapfreighter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcwallet/waddrmgr"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+)
+
+const (
+	// DefaultSpendWatchInterval is the default interval at which the set
+	// of watched anchor outputs is refreshed and the mempool is checked
+	// for spends of them.
+	DefaultSpendWatchInterval = time.Minute
+)
+
+// WatchedAnchor is an anchor output that holds some of our assets and is
+// watched for spends.
+type WatchedAnchor struct {
+	// OutPoint is the anchor outpoint.
+	OutPoint wire.OutPoint
+
+	// PkScript is the output script of the anchor output.
+	PkScript []byte
+
+	// HeightHint is the block height the anchor transaction confirmed at,
+	// or zero if it isn't confirmed yet.
+	HeightHint uint32
+
+	// AssetIDs are the IDs of our assets anchored in the output.
+	AssetIDs []asset.ID
+}
+
+// AnchorLister lists the anchor outputs that hold our assets.
+type AnchorLister interface {
+	// WatchedAnchors returns the anchor outputs of all our unspent
+	// assets.
+	WatchedAnchors(ctx context.Context) ([]*WatchedAnchor, error)
+}
+
+// SpendNotifier is used to get notified about spends of on-chain outputs.
+type SpendNotifier interface {
+	// RegisterSpendNtfn registers an intent to be notified once the given
+	// outpoint is spent on chain.
+	RegisterSpendNtfn(ctx context.Context, outpoint *wire.OutPoint,
+		pkScript []byte,
+		heightHint int32) (chan *chainntnfs.SpendDetail, chan error,
+		error)
+
+	// CurrentHeight return the current height of the main chain.
+	CurrentHeight(context.Context) (uint32, error)
+}
+
+// AnchorSpendAlert is emitted when an anchor output holding our assets is
+// spent by a transaction that didn't originate from our chain porter. This
+// can be a sign of a key compromise, or of another wallet that uses the same
+// keys.
+type AnchorSpendAlert struct {
+	// Anchor is the anchor output that was spent.
+	Anchor WatchedAnchor
+
+	// SpendingTxHash is the hash of the spending transaction.
+	SpendingTxHash chainhash.Hash
+
+	// Confirmed is true if the spend was detected in a block, and false
+	// if it was detected in the mempool.
+	Confirmed bool
+
+	// timestamp is the time the spend was detected.
+	timestamp time.Time
+}
+
+// NewAnchorSpendAlert creates a new alert for the given anchor output that was
+// spent by the given transaction.
+func NewAnchorSpendAlert(anchor WatchedAnchor, spendingTxHash chainhash.Hash,
+	confirmed bool) *AnchorSpendAlert {
+
+	return &AnchorSpendAlert{
+		Anchor:         anchor,
+		SpendingTxHash: spendingTxHash,
+		Confirmed:      confirmed,
+		timestamp:      time.Now().UTC(),
+	}
+}
+
+// Timestamp returns the time the spend was detected.
+//
+// NOTE: This is part of the fn.Event interface.
+func (a *AnchorSpendAlert) Timestamp() time.Time {
+	return a.timestamp
+}
+
+// A compile-time assertion to ensure AnchorSpendAlert satisfies the fn.Event
+// interface.
+var _ fn.Event = (*AnchorSpendAlert)(nil)
+
+// SpendWatcherConfig houses the configuration of the SpendWatcher.
+type SpendWatcherConfig struct {
+	// Anchors is used to list the anchor outputs that should be watched.
+	Anchors AnchorLister
+
+	// ChainBridge is used to register for spend notifications.
+	ChainBridge SpendNotifier
+
+	// Wallet is used to find unconfirmed spends of the watched outputs.
+	Wallet WalletAnchor
+
+	// ExportLog is used to find out whether a spend originated from our
+	// chain porter.
+	ExportLog ExportLog
+
+	// CheckInterval is the interval at which the set of watched anchor
+	// outputs is refreshed and the mempool is checked for spends.
+	CheckInterval time.Duration
+}
+
+// watchedSpend is the state of a single watched anchor output.
+type watchedSpend struct {
+	anchor *WatchedAnchor
+
+	// cancel stops the spend notification of the anchor output.
+	cancel func()
+}
+
+// anchorSpend is a spend of a watched anchor output.
+type anchorSpend struct {
+	anchor    *WatchedAnchor
+	tx        *wire.MsgTx
+	confirmed bool
+}
+
+// SpendWatcher watches the anchor outputs of all our assets for spends, both
+// in the mempool and on chain. If an anchor output is spent by a transaction
+// that didn't originate from our chain porter, the assets in it are marked as
+// at risk and an alert is raised, which catches key compromises or wallet
+// cross-talk early.
+type SpendWatcher struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg *SpendWatcherConfig
+
+	// watched is the set of anchor outputs we currently watch, keyed by
+	// their outpoint. It's only accessed by the main goroutine.
+	watched map[wire.OutPoint]*watchedSpend
+
+	// ownSpends is the set of spending transactions that are known to
+	// originate from our chain porter. It's only accessed by the main
+	// goroutine.
+	ownSpends fn.Set[chainhash.Hash]
+
+	// spends receives the spends detected by the spend notifications.
+	spends chan *anchorSpend
+
+	// atRisk is the set of alerts of the anchor outputs that were spent by
+	// a third party, keyed by the anchor outpoint.
+	atRisk map[wire.OutPoint]*AnchorSpendAlert
+
+	// atRiskMtx guards the atRisk map.
+	atRiskMtx sync.RWMutex
+
+	// eventDistributor is used to notify subscribers about third-party
+	// spends.
+	eventDistributor *fn.EventDistributor[fn.Event]
+
+	*fn.ContextGuard
+}
+
+// NewSpendWatcher creates a new spend watcher from the given config.
+func NewSpendWatcher(cfg *SpendWatcherConfig) *SpendWatcher {
+	return &SpendWatcher{
+		cfg:              cfg,
+		watched:          make(map[wire.OutPoint]*watchedSpend),
+		ownSpends:        fn.NewSet[chainhash.Hash](),
+		spends:           make(chan *anchorSpend),
+		atRisk:           make(map[wire.OutPoint]*AnchorSpendAlert),
+		eventDistributor: fn.NewEventDistributor[fn.Event](),
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: tapgarden.DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start starts watching the anchor outputs for spends.
+func (w *SpendWatcher) Start() error {
+	w.startOnce.Do(func() {
+		log.Infof("Starting anchor spend watcher")
+
+		w.Wg.Add(1)
+		go w.watchSpends()
+	})
+
+	return nil
+}
+
+// Stop stops watching the anchor outputs for spends.
+func (w *SpendWatcher) Stop() error {
+	w.stopOnce.Do(func() {
+		log.Infof("Stopping anchor spend watcher")
+
+		close(w.Quit)
+		w.Wg.Wait()
+	})
+
+	return nil
+}
+
+// watchSpends is the main goroutine of the spend watcher.
+//
+// NOTE: This method MUST be called as a goroutine.
+func (w *SpendWatcher) watchSpends() {
+	defer w.Wg.Done()
+
+	defer func() {
+		for _, watched := range w.watched {
+			watched.cancel()
+		}
+	}()
+
+	ticker := time.NewTicker(w.cfg.CheckInterval)
+	defer ticker.Stop()
+
+	w.refresh()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.refresh()
+
+		case spend := <-w.spends:
+			w.checkSpend(spend)
+
+		case <-w.Quit:
+			return
+		}
+	}
+}
+
+// refresh updates the set of watched anchor outputs and checks the mempool
+// for spends of them. Failures are only logged, as they shouldn't stop the
+// watcher.
+func (w *SpendWatcher) refresh() {
+	ctx, cancel := w.WithCtxQuit()
+	defer cancel()
+
+	anchors, err := w.cfg.Anchors.WatchedAnchors(ctx)
+	if err != nil {
+		log.Errorf("Unable to list anchor outputs to watch: %v", err)
+		return
+	}
+
+	current := make(map[wire.OutPoint]*WatchedAnchor, len(anchors))
+	for _, anchor := range anchors {
+		current[anchor.OutPoint] = anchor
+	}
+
+	// We stop watching the anchor outputs that no longer hold any of our
+	// assets, which usually means we spent them ourselves.
+	for op, watched := range w.watched {
+		if _, ok := current[op]; ok {
+			continue
+		}
+
+		watched.cancel()
+		delete(w.watched, op)
+	}
+
+	for op, anchor := range current {
+		if _, ok := w.watched[op]; ok {
+			continue
+		}
+
+		if err := w.watchAnchor(anchor); err != nil {
+			log.Errorf("Unable to watch anchor output %v for "+
+				"spends: %v", op, err)
+		}
+	}
+
+	w.checkMempool(ctx)
+}
+
+// watchAnchor registers for a spend notification of the given anchor output.
+func (w *SpendWatcher) watchAnchor(anchor *WatchedAnchor) error {
+	heightHint := anchor.HeightHint
+	if heightHint == 0 {
+		ctx, cancel := w.WithCtxQuit()
+		currentHeight, err := w.cfg.ChainBridge.CurrentHeight(ctx)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("unable to fetch current height: %w",
+				err)
+		}
+
+		heightHint = currentHeight
+	}
+
+	ctx, cancel := w.WithCtxQuitNoTimeout()
+	spendChan, errChan, err := w.cfg.ChainBridge.RegisterSpendNtfn(
+		ctx, &anchor.OutPoint, anchor.PkScript, int32(heightHint),
+	)
+	if err != nil {
+		cancel()
+		return err
+	}
+
+	w.watched[anchor.OutPoint] = &watchedSpend{
+		anchor: anchor,
+		cancel: cancel,
+	}
+
+	w.Wg.Add(1)
+	go w.waitForSpend(ctx, anchor, spendChan, errChan)
+
+	return nil
+}
+
+// waitForSpend waits for the spend notification of the given anchor output and
+// hands it to the main goroutine.
+//
+// NOTE: This method MUST be called as a goroutine.
+func (w *SpendWatcher) waitForSpend(ctx context.Context, anchor *WatchedAnchor,
+	spendChan chan *chainntnfs.SpendDetail, errChan chan error) {
+
+	defer w.Wg.Done()
+
+	select {
+	case detail := <-spendChan:
+		spend := &anchorSpend{
+			anchor:    anchor,
+			tx:        detail.SpendingTx,
+			confirmed: true,
+		}
+
+		select {
+		case w.spends <- spend:
+		case <-ctx.Done():
+		case <-w.Quit:
+		}
+
+	case err := <-errChan:
+		log.Errorf("Error waiting for spend of anchor output %v: %v",
+			anchor.OutPoint, err)
+
+	case <-ctx.Done():
+	case <-w.Quit:
+	}
+}
+
+// checkMempool checks the unconfirmed wallet transactions for spends of the
+// watched anchor outputs.
+func (w *SpendWatcher) checkMempool(ctx context.Context) {
+	walletTxns, err := w.cfg.Wallet.ListTransactions(
+		ctx, 0, -1, waddrmgr.ImportedAddrAccountName,
+	)
+	if err != nil {
+		log.Errorf("Unable to list wallet transactions to check for "+
+			"anchor spends: %v", err)
+		return
+	}
+
+	for _, walletTx := range walletTxns {
+		if walletTx.Confirmations != 0 || walletTx.Tx == nil {
+			continue
+		}
+
+		for _, txIn := range walletTx.Tx.TxIn {
+			watched, ok := w.watched[txIn.PreviousOutPoint]
+			if !ok {
+				continue
+			}
+
+			w.checkSpend(&anchorSpend{
+				anchor: watched.anchor,
+				tx:     walletTx.Tx,
+			})
+		}
+	}
+}
+
+// checkSpend raises an alert if the given spend of an anchor output didn't
+// originate from our chain porter.
+func (w *SpendWatcher) checkSpend(spend *anchorSpend) {
+	op := spend.anchor.OutPoint
+	if w.IsAtRisk(op) {
+		return
+	}
+
+	txHash := spend.tx.TxHash()
+	if w.ownSpends.Contains(txHash) {
+		return
+	}
+
+	ctx, cancel := w.WithCtxQuit()
+	defer cancel()
+
+	parcels, err := w.cfg.ExportLog.QueryParcels(ctx, &txHash, false)
+	if err != nil {
+		log.Errorf("Unable to look up transfer of spend %v of anchor "+
+			"output %v: %v", txHash, op, err)
+		return
+	}
+
+	if len(parcels) > 0 {
+		w.ownSpends.Add(txHash)
+		return
+	}
+
+	log.Warnf("Anchor output %v holding assets %v was spent by "+
+		"transaction %v that didn't originate from this node "+
+		"(confirmed=%v), marking assets as at risk", op,
+		spend.anchor.AssetIDs, txHash, spend.confirmed)
+
+	alert := NewAnchorSpendAlert(*spend.anchor, txHash, spend.confirmed)
+
+	w.atRiskMtx.Lock()
+	w.atRisk[op] = alert
+	w.atRiskMtx.Unlock()
+
+	w.eventDistributor.NotifySubscribers(alert)
+}
+
+// IsAtRisk returns true if the given anchor output was spent by a transaction
+// that didn't originate from our chain porter.
+func (w *SpendWatcher) IsAtRisk(op wire.OutPoint) bool {
+	w.atRiskMtx.RLock()
+	defer w.atRiskMtx.RUnlock()
+
+	_, ok := w.atRisk[op]
+	return ok
+}
+
+// AtRiskAnchors returns the alerts of all anchor outputs that were spent by a
+// transaction that didn't originate from our chain porter.
+func (w *SpendWatcher) AtRiskAnchors() []AnchorSpendAlert {
+	w.atRiskMtx.RLock()
+	defer w.atRiskMtx.RUnlock()
+
+	alerts := make([]AnchorSpendAlert, 0, len(w.atRisk))
+	for _, alert := range w.atRisk {
+		alerts = append(alerts, *alert)
+	}
+
+	return alerts
+}
+
+// RegisterSubscriber adds a new subscriber that is notified of anchor outputs
+// that were spent by a third party. As the alerts aren't persisted,
+// delivering existing alerts isn't supported.
+//
+// NOTE: This is part of the fn.EventPublisher interface.
+func (w *SpendWatcher) RegisterSubscriber(
+	receiver *fn.EventReceiver[fn.Event], deliverExisting bool,
+	_ time.Time) error {
+
+	if deliverExisting {
+		return fmt.Errorf("delivering existing anchor spend alerts " +
+			"is not supported")
+	}
+
+	w.eventDistributor.RegisterSubscriber(receiver)
+
+	return nil
+}
+
+// RemoveSubscriber removes the given subscriber of anchor spend alerts and
+// stops it from processing events.
+//
+// NOTE: This is part of the fn.EventPublisher interface.
+func (w *SpendWatcher) RemoveSubscriber(
+	subscriber *fn.EventReceiver[fn.Event]) error {
+
+	return w.eventDistributor.RemoveSubscriber(subscriber)
+}
+
+// A compile-time assertion to ensure SpendWatcher satisfies the
+// fn.EventPublisher interface.
+var _ fn.EventPublisher[fn.Event, time.Time] = (*SpendWatcher)(nil)
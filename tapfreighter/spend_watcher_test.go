@@ -0,0 +1,215 @@
+package tapfreighter
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/stretchr/testify/require"
+)
+
+// testTimeout is the maximum time to wait for the spend watcher to react.
+const testTimeout = 5 * time.Second
+
+// mockAnchorLister is an anchor lister that returns a static set of anchor
+// outputs.
+type mockAnchorLister struct {
+	anchors []*WatchedAnchor
+}
+
+func (m *mockAnchorLister) WatchedAnchors(
+	context.Context) ([]*WatchedAnchor, error) {
+
+	return m.anchors, nil
+}
+
+// mockSpendNotifier is a spend notifier that records the registered spend
+// notifications, so a test can deliver spends to them.
+type mockSpendNotifier struct {
+	sync.Mutex
+
+	spendChans map[wire.OutPoint]chan *chainntnfs.SpendDetail
+}
+
+func (m *mockSpendNotifier) RegisterSpendNtfn(_ context.Context,
+	outpoint *wire.OutPoint, _ []byte,
+	_ int32) (chan *chainntnfs.SpendDetail, chan error, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	spendChan := make(chan *chainntnfs.SpendDetail, 1)
+	m.spendChans[*outpoint] = spendChan
+
+	return spendChan, make(chan error, 1), nil
+}
+
+func (m *mockSpendNotifier) CurrentHeight(context.Context) (uint32, error) {
+	return 100, nil
+}
+
+func (m *mockSpendNotifier) spendChan(
+	op wire.OutPoint) chan *chainntnfs.SpendDetail {
+
+	m.Lock()
+	defer m.Unlock()
+
+	return m.spendChans[op]
+}
+
+// mockSpendWallet is a wallet anchor that only lists a static set of wallet
+// transactions.
+type mockSpendWallet struct {
+	WalletAnchor
+
+	txns []lndclient.Transaction
+}
+
+func (m *mockSpendWallet) ListTransactions(context.Context, int32, int32,
+	string) ([]lndclient.Transaction, error) {
+
+	return m.txns, nil
+}
+
+// mockSpendExportLog is an export log that knows the anchor transactions of
+// our own transfers.
+type mockSpendExportLog struct {
+	ExportLog
+
+	ownTxns fn.Set[chainhash.Hash]
+}
+
+func (m *mockSpendExportLog) QueryParcels(_ context.Context,
+	anchorTxHash *chainhash.Hash, _ bool) ([]*OutboundParcel, error) {
+
+	if !m.ownTxns.Contains(*anchorTxHash) {
+		return nil, nil
+	}
+
+	return []*OutboundParcel{{}}, nil
+}
+
+// newSpendTx creates a transaction that spends the given outpoint.
+func newSpendTx(op wire.OutPoint) *wire.MsgTx {
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(wire.NewTxIn(&op, nil, nil))
+	tx.AddTxOut(wire.NewTxOut(1000, test.RandBytes(34)))
+
+	return tx
+}
+
+// TestSpendWatcher tests that only spends of anchor outputs that didn't
+// originate from our chain porter raise an alert, both if they're detected in
+// the mempool and on chain.
+func TestSpendWatcher(t *testing.T) {
+	t.Parallel()
+
+	newAnchor := func() *WatchedAnchor {
+		return &WatchedAnchor{
+			OutPoint: test.RandOp(t),
+			PkScript: test.RandBytes(34),
+			AssetIDs: []asset.ID{asset.RandID(t)},
+		}
+	}
+	var (
+		mempoolAnchor = newAnchor()
+		ownAnchor     = newAnchor()
+		chainAnchor   = newAnchor()
+
+		mempoolSpend = newSpendTx(mempoolAnchor.OutPoint)
+		ownSpend     = newSpendTx(ownAnchor.OutPoint)
+		chainSpend   = newSpendTx(chainAnchor.OutPoint)
+	)
+
+	notifier := &mockSpendNotifier{
+		spendChans: make(
+			map[wire.OutPoint]chan *chainntnfs.SpendDetail,
+		),
+	}
+	watcher := NewSpendWatcher(&SpendWatcherConfig{
+		Anchors: &mockAnchorLister{
+			anchors: []*WatchedAnchor{
+				mempoolAnchor, ownAnchor, chainAnchor,
+			},
+		},
+		ChainBridge: notifier,
+		Wallet: &mockSpendWallet{
+			txns: []lndclient.Transaction{{
+				Tx: mempoolSpend,
+			}, {
+				Tx: ownSpend,
+			}},
+		},
+		ExportLog: &mockSpendExportLog{
+			ownTxns: fn.NewSet(ownSpend.TxHash()),
+		},
+		CheckInterval: time.Hour,
+	})
+
+	alerts := fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize)
+	err := watcher.RegisterSubscriber(alerts, false, time.Time{})
+	require.NoError(t, err)
+
+	// Delivering existing alerts isn't supported, as they aren't persisted.
+	err = watcher.RegisterSubscriber(alerts, true, time.Time{})
+	require.Error(t, err)
+
+	require.NoError(t, watcher.Start())
+	t.Cleanup(func() {
+		require.NoError(t, watcher.Stop())
+	})
+
+	receiveAlert := func() *AnchorSpendAlert {
+		select {
+		case e := <-alerts.NewItemCreated.ChanOut():
+			alert, ok := e.(*AnchorSpendAlert)
+			require.True(t, ok)
+
+			return alert
+
+		case <-time.After(testTimeout):
+			t.Fatalf("no anchor spend alert received")
+			return nil
+		}
+	}
+
+	// The third-party spend in the mempool is detected right away, while
+	// our own unconfirmed spend is ignored.
+	alert := receiveAlert()
+	require.Equal(t, *mempoolAnchor, alert.Anchor)
+	require.Equal(t, mempoolSpend.TxHash(), alert.SpendingTxHash)
+	require.False(t, alert.Confirmed)
+	require.True(t, watcher.IsAtRisk(mempoolAnchor.OutPoint))
+	require.False(t, watcher.IsAtRisk(ownAnchor.OutPoint))
+
+	// Once all spend notifications are registered, we confirm our own
+	// spend and the third-party spend on chain.
+	require.Eventually(t, func() bool {
+		return notifier.spendChan(ownAnchor.OutPoint) != nil &&
+			notifier.spendChan(chainAnchor.OutPoint) != nil
+	}, testTimeout, 10*time.Millisecond)
+
+	notifier.spendChan(ownAnchor.OutPoint) <- &chainntnfs.SpendDetail{
+		SpendingTx: ownSpend,
+	}
+	notifier.spendChan(chainAnchor.OutPoint) <- &chainntnfs.SpendDetail{
+		SpendingTx: chainSpend,
+	}
+
+	alert = receiveAlert()
+	require.Equal(t, *chainAnchor, alert.Anchor)
+	require.Equal(t, chainSpend.TxHash(), alert.SpendingTxHash)
+	require.True(t, alert.Confirmed)
+
+	require.True(t, watcher.IsAtRisk(chainAnchor.OutPoint))
+	require.False(t, watcher.IsAtRisk(ownAnchor.OutPoint))
+	require.Len(t, watcher.AtRiskAnchors(), 2)
+}
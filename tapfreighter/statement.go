@@ -0,0 +1,331 @@
+package tapfreighter
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/proof"
+)
+
+// statementPageSize is the number of outbound parcels that are fetched at once
+// when generating a statement.
+const statementPageSize = 500
+
+// StatementDirection denotes whether assets were sent to or received from a
+// counterparty.
+type StatementDirection uint8
+
+const (
+	// StatementOutbound denotes assets that were sent to the counterparty.
+	StatementOutbound StatementDirection = iota
+
+	// StatementInbound denotes assets that were received from the
+	// counterparty.
+	StatementInbound
+)
+
+// String returns a human-readable representation of the direction.
+func (d StatementDirection) String() string {
+	switch d {
+	case StatementOutbound:
+		return "outbound"
+
+	case StatementInbound:
+		return "inbound"
+
+	default:
+		return fmt.Sprintf("unknown(%d)", d)
+	}
+}
+
+// StatementEntry is a single movement of assets between the local node and a
+// counterparty.
+type StatementEntry struct {
+	// Direction denotes whether the assets were sent or received.
+	Direction StatementDirection
+
+	// Time is the time the transfer was created or the incoming transfer
+	// was first detected.
+	Time time.Time
+
+	// AssetID is the ID of the transferred asset.
+	AssetID asset.ID
+
+	// Amount is the amount of asset units that were transferred.
+	Amount uint64
+
+	// AnchorPoint is the on-chain output that anchors the transferred
+	// assets. Together with the asset ID and the script key of the
+	// recipient, it locates the proof of the transfer.
+	AnchorPoint wire.OutPoint
+
+	// HasProof indicates that the proof of an inbound transfer was
+	// imported. Proofs of outbound transfers are always available.
+	HasProof bool
+}
+
+// StatementTotals are the amounts of a single asset that were moved between
+// the local node and a counterparty.
+type StatementTotals struct {
+	// Sent is the amount of asset units sent to the counterparty.
+	Sent uint64
+
+	// Received is the amount of asset units received from the
+	// counterparty.
+	Received uint64
+}
+
+// StatementParams defines the counterparty and the period of a statement.
+type StatementParams struct {
+	// ScriptKey is the script key of the counterparty. Outbound transfers
+	// are matched by outputs to this script key.
+	ScriptKey btcec.PublicKey
+
+	// Addrs are the local addresses that were handed out to the
+	// counterparty. Inbound transfers are matched by the events of these
+	// addresses.
+	Addrs []*address.Tap
+
+	// StartTime only includes transfers created at or after this time.
+	StartTime fn.Option[time.Time]
+
+	// EndTime only includes transfers created before this time.
+	EndTime fn.Option[time.Time]
+}
+
+// Statement lists all movements of assets between the local node and a
+// counterparty over a period, suitable for reconciliation.
+type Statement struct {
+	// Params are the parameters the statement was generated with.
+	Params StatementParams
+
+	// Entries are the movements of assets, sorted by time.
+	Entries []StatementEntry
+
+	// Totals are the sums of the entries per asset ID.
+	Totals map[asset.ID]*StatementTotals
+}
+
+// ParcelPager fetches outbound parcels page by page.
+type ParcelPager interface {
+	// QueryParcelsPage returns a single page of the parcels that match the
+	// filters of the given query.
+	QueryParcelsPage(ctx context.Context,
+		query ParcelQuery) (*ParcelPage, error)
+}
+
+// EventQuerier fetches the events of incoming transfers to local addresses.
+type EventQuerier interface {
+	// QueryEvents returns all events that match the given query.
+	QueryEvents(ctx context.Context,
+		query address.EventQueryParams) ([]*address.Event, error)
+}
+
+// GenerateStatement collects all transfers between the local node and the
+// counterparty of the given parameters from the transfer and receive logs.
+func GenerateStatement(ctx context.Context, parcels ParcelPager,
+	events EventQuerier, params StatementParams) (*Statement, error) {
+
+	statement := &Statement{
+		Params: params,
+		Totals: make(map[asset.ID]*StatementTotals),
+	}
+
+	outbound, err := outboundStatementEntries(ctx, parcels, params)
+	if err != nil {
+		return nil, fmt.Errorf("unable to collect outbound transfers: "+
+			"%w", err)
+	}
+
+	inbound, err := inboundStatementEntries(ctx, events, params)
+	if err != nil {
+		return nil, fmt.Errorf("unable to collect inbound transfers: "+
+			"%w", err)
+	}
+
+	statement.Entries = append(outbound, inbound...)
+	sort.SliceStable(statement.Entries, func(i, j int) bool {
+		return statement.Entries[i].Time.Before(
+			statement.Entries[j].Time,
+		)
+	})
+
+	for _, entry := range statement.Entries {
+		totals, ok := statement.Totals[entry.AssetID]
+		if !ok {
+			totals = &StatementTotals{}
+			statement.Totals[entry.AssetID] = totals
+		}
+
+		switch entry.Direction {
+		case StatementOutbound:
+			totals.Sent += entry.Amount
+
+		case StatementInbound:
+			totals.Received += entry.Amount
+		}
+	}
+
+	return statement, nil
+}
+
+// outboundStatementEntries returns an entry for each output of an outbound
+// parcel that pays to the script key of the counterparty.
+func outboundStatementEntries(ctx context.Context, parcels ParcelPager,
+	params StatementParams) ([]StatementEntry, error) {
+
+	query := ParcelQuery{
+		ScriptKey: fn.Some(params.ScriptKey),
+		StartTime: params.StartTime,
+		EndTime:   params.EndTime,
+		Limit:     statementPageSize,
+	}
+
+	var entries []StatementEntry
+	for {
+		page, err := parcels.QueryParcelsPage(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, parcel := range page.Parcels {
+			parcelEntries, err := parcelStatementEntries(
+				parcel, &params.ScriptKey,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			entries = append(entries, parcelEntries...)
+		}
+
+		if page.NextCursor.IsNone() {
+			return entries, nil
+		}
+		query.Cursor = page.NextCursor
+	}
+}
+
+// parcelStatementEntries returns an entry for each output of the given parcel
+// that pays to the given script key.
+func parcelStatementEntries(parcel *OutboundParcel,
+	scriptKey *btcec.PublicKey) ([]StatementEntry, error) {
+
+	var entries []StatementEntry
+	for idx := range parcel.Outputs {
+		out := &parcel.Outputs[idx]
+		if out.ScriptKey.PubKey == nil ||
+			!out.ScriptKey.PubKey.IsEqual(scriptKey) {
+
+			continue
+		}
+
+		assetID, err := outputAssetID(parcel, out)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, StatementEntry{
+			Direction:   StatementOutbound,
+			Time:        parcel.TransferTime,
+			AssetID:     assetID,
+			Amount:      out.Amount,
+			AnchorPoint: out.Anchor.OutPoint,
+			HasProof:    true,
+		})
+	}
+
+	return entries, nil
+}
+
+// outputAssetID returns the ID of the asset of the given parcel output. It is
+// taken from the proof suffix of the output, or from the inputs of the parcel
+// if they all spend the same asset.
+func outputAssetID(parcel *OutboundParcel,
+	out *TransferOutput) (asset.ID, error) {
+
+	if len(out.ProofSuffix) != 0 {
+		var suffix proof.Proof
+		err := suffix.Decode(bytes.NewReader(out.ProofSuffix))
+		if err != nil {
+			return asset.ID{}, fmt.Errorf("unable to decode proof "+
+				"suffix: %w", err)
+		}
+
+		return suffix.Asset.ID(), nil
+	}
+
+	if len(parcel.Inputs) == 0 {
+		return asset.ID{}, fmt.Errorf("parcel without inputs")
+	}
+
+	assetID := parcel.Inputs[0].ID
+	for _, input := range parcel.Inputs[1:] {
+		if input.ID != assetID {
+			return asset.ID{}, fmt.Errorf("unable to determine " +
+				"asset ID of output")
+		}
+	}
+
+	return assetID, nil
+}
+
+// inboundStatementEntries returns an entry for each incoming transfer to one
+// of the local addresses that were handed out to the counterparty.
+func inboundStatementEntries(ctx context.Context, events EventQuerier,
+	params StatementParams) ([]StatementEntry, error) {
+
+	var entries []StatementEntry
+	for _, addr := range params.Addrs {
+		taprootKey, err := addr.TaprootOutputKey()
+		if err != nil {
+			return nil, fmt.Errorf("unable to derive taproot "+
+				"output key of address: %w", err)
+		}
+
+		xOnlyKey := schnorr.SerializePubKey(taprootKey)
+		query := address.EventQueryParams{
+			AddrTaprootOutputKey: xOnlyKey,
+		}
+		params.StartTime.WhenSome(func(startTime time.Time) {
+			query.CreationTimeFrom = &startTime
+		})
+
+		addrEvents, err := events.QueryEvents(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, event := range addrEvents {
+			createdAt := event.CreationTime
+			isAfterEnd := fn.MapOptionZ(
+				params.EndTime, func(endTime time.Time) bool {
+					return !createdAt.Before(endTime)
+				},
+			)
+			if isAfterEnd {
+				continue
+			}
+
+			entries = append(entries, StatementEntry{
+				Direction:   StatementInbound,
+				Time:        event.CreationTime,
+				AssetID:     addr.AssetID,
+				Amount:      addr.Amount,
+				AnchorPoint: event.Outpoint,
+				HasProof:    event.HasProof,
+			})
+		}
+	}
+
+	return entries, nil
+}
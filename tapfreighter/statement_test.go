@@ -0,0 +1,176 @@
+package tapfreighter
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// mockParcelPager returns the parcels that pay to the queried script key, one
+// parcel per page.
+type mockParcelPager struct {
+	parcels []*OutboundParcel
+}
+
+func (m *mockParcelPager) QueryParcelsPage(_ context.Context,
+	query ParcelQuery) (*ParcelPage, error) {
+
+	cursor := query.Cursor.UnwrapOr(0)
+	if cursor >= int64(len(m.parcels)) {
+		return &ParcelPage{}, nil
+	}
+
+	page := &ParcelPage{
+		Parcels: []*OutboundParcel{m.parcels[cursor]},
+	}
+	if cursor+1 < int64(len(m.parcels)) {
+		page.NextCursor = fn.Some(cursor + 1)
+	}
+
+	return page, nil
+}
+
+// mockEventQuerier returns the events of the queried address that were created
+// at or after the queried time.
+type mockEventQuerier struct {
+	events []*address.Event
+}
+
+func (m *mockEventQuerier) QueryEvents(_ context.Context,
+	query address.EventQueryParams) ([]*address.Event, error) {
+
+	var events []*address.Event
+	for _, event := range m.events {
+		taprootKey, err := event.Addr.Tap.TaprootOutputKey()
+		if err != nil {
+			return nil, err
+		}
+
+		xOnlyKey := schnorr.SerializePubKey(taprootKey)
+		if !bytes.Equal(xOnlyKey, query.AddrTaprootOutputKey) {
+			continue
+		}
+
+		if query.CreationTimeFrom != nil &&
+			event.CreationTime.Before(*query.CreationTimeFrom) {
+
+			continue
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// TestGenerateStatement tests that a statement contains the transfers to and
+// from the counterparty within the given period, and sums them up per asset.
+func TestGenerateStatement(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx        = context.Background()
+		start      = time.Unix(1_700_000_000, 0)
+		end        = start.Add(time.Hour)
+		peerKey    = asset.RandScriptKey(t)
+		otherKey   = asset.RandScriptKey(t)
+		courier    = address.RandProofCourierAddr(t)
+		chainParam = address.ParamsForChain(
+			chaincfg.RegressionNetParams.Name,
+		)
+	)
+
+	// The address is handed out to the counterparty to receive assets
+	// from it.
+	addr, _, _ := address.RandAddr(t, &chainParam, courier)
+	assetID := addr.AssetID
+
+	newParcel := func(at time.Time, amounts ...uint64) *OutboundParcel {
+		parcel := &OutboundParcel{
+			TransferTime: at,
+			Inputs: []TransferInput{{
+				PrevID: asset.PrevID{ID: assetID},
+			}},
+		}
+		for idx, amount := range amounts {
+			scriptKey := peerKey
+			if idx > 0 {
+				scriptKey = otherKey
+			}
+
+			parcel.Outputs = append(parcel.Outputs, TransferOutput{
+				Anchor: Anchor{
+					OutPoint: test.RandOp(t),
+				},
+				ScriptKey: scriptKey,
+				Amount:    amount,
+			})
+		}
+
+		return parcel
+	}
+
+	// The first output of each parcel pays to the counterparty, the second
+	// one is the change.
+	pager := &mockParcelPager{
+		parcels: []*OutboundParcel{
+			newParcel(start.Add(time.Minute), 10, 90),
+			newParcel(start.Add(3*time.Minute), 5, 85),
+		},
+	}
+
+	newEvent := func(at time.Time) *address.Event {
+		return &address.Event{
+			CreationTime: at,
+			Addr:         addr,
+			Outpoint:     test.RandOp(t),
+			HasProof:     true,
+		}
+	}
+
+	// Only the second event is within the period of the statement.
+	querier := &mockEventQuerier{
+		events: []*address.Event{
+			newEvent(start.Add(-time.Minute)),
+			newEvent(start.Add(2 * time.Minute)),
+			newEvent(end),
+		},
+	}
+
+	statement, err := GenerateStatement(ctx, pager, querier,
+		StatementParams{
+			ScriptKey: *peerKey.PubKey,
+			Addrs:     []*address.Tap{addr.Tap},
+			StartTime: fn.Some(start),
+			EndTime:   fn.Some(end),
+		},
+	)
+	require.NoError(t, err)
+
+	require.Len(t, statement.Entries, 3)
+	require.Equal(t, StatementOutbound, statement.Entries[0].Direction)
+	require.Equal(t, StatementInbound, statement.Entries[1].Direction)
+	require.Equal(t, StatementOutbound, statement.Entries[2].Direction)
+	require.Equal(
+		t, querier.events[1].Outpoint, statement.Entries[1].AnchorPoint,
+	)
+	require.Equal(
+		t, pager.parcels[1].Outputs[0].Anchor.OutPoint,
+		statement.Entries[2].AnchorPoint,
+	)
+
+	require.Len(t, statement.Totals, 1)
+	require.Equal(t, &StatementTotals{
+		Sent:     15,
+		Received: addr.Amount,
+	}, statement.Totals[assetID])
+}
@@ -0,0 +1,264 @@
+package tapfreighter
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/asset"
+)
+
+const (
+	// MaxTickerLen is the maximum length of a ticker symbol.
+	MaxTickerLen = 16
+)
+
+var (
+	// ErrTickerInvalid is returned if a ticker symbol is empty, too long
+	// or contains characters other than letters, digits, dots, dashes
+	// and underscores.
+	ErrTickerInvalid = errors.New("invalid ticker")
+
+	// ErrTickerNotFound is returned if a ticker can't be resolved to an
+	// asset.
+	ErrTickerNotFound = errors.New("ticker not found")
+
+	// ErrTickerCollision is returned if a ticker resolves to more than one
+	// asset or asset group and isn't pinned in the local registry.
+	ErrTickerCollision = errors.New("ticker is ambiguous")
+
+	// ErrTickerMismatch is returned if an asset that should be sent
+	// doesn't match the asset the ticker resolved to.
+	ErrTickerMismatch = errors.New("asset doesn't match ticker")
+)
+
+// NormalizeTicker trims the given ticker symbol and converts it to upper case,
+// so tickers that only differ in case are treated as the same ticker. An error
+// is returned if the ticker isn't valid.
+func NormalizeTicker(ticker string) (string, error) {
+	ticker = strings.ToUpper(strings.TrimSpace(ticker))
+	if len(ticker) == 0 || len(ticker) > MaxTickerLen {
+		return "", fmt.Errorf("%w: length must be between 1 and %d",
+			ErrTickerInvalid, MaxTickerLen)
+	}
+
+	for _, r := range ticker {
+		if !isTickerChar(r) {
+			return "", fmt.Errorf("%w: unsupported character %q",
+				ErrTickerInvalid, r)
+		}
+	}
+
+	return ticker, nil
+}
+
+// isTickerChar returns true if the given character can be used in a ticker.
+// Only ASCII characters are allowed, so tickers can't be spoofed with
+// look-alike unicode characters.
+func isTickerChar(r rune) bool {
+	switch {
+	case r > unicode.MaxASCII:
+		return false
+
+	case unicode.IsLetter(r), unicode.IsDigit(r):
+		return true
+
+	default:
+		return r == '.' || r == '-' || r == '_'
+	}
+}
+
+// TickerSource denotes where the mapping of a ticker to an asset comes from.
+type TickerSource uint8
+
+const (
+	// TickerSourceRegistry is a ticker that was pinned to an asset in the
+	// local ticker registry.
+	TickerSourceRegistry TickerSource = iota
+
+	// TickerSourceMeta is a ticker that was declared in the verified
+	// genesis meta data of an asset.
+	TickerSourceMeta
+)
+
+// String returns a human-readable string for the ticker source.
+func (s TickerSource) String() string {
+	switch s {
+	case TickerSourceRegistry:
+		return "registry"
+
+	case TickerSourceMeta:
+		return "meta"
+
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(s))
+	}
+}
+
+// AssetTicker maps a ticker symbol to either a single asset or an asset
+// group.
+type AssetTicker struct {
+	// Ticker is the normalized ticker symbol.
+	Ticker string
+
+	// AssetID is the ID of the asset the ticker maps to. It is only used
+	// if the group key isn't set.
+	AssetID asset.ID
+
+	// GroupKey is the tweaked key of the asset group the ticker maps to.
+	// If set, the ticker covers all assets of the group.
+	GroupKey *btcec.PublicKey
+
+	// AssetName is the name of the asset, if known.
+	AssetName string
+
+	// Source is where the mapping comes from.
+	Source TickerSource
+}
+
+// specifier returns the string that uniquely identifies the asset or asset
+// group the ticker maps to.
+func (t *AssetTicker) specifier() string {
+	if t.GroupKey != nil {
+		return "group_key=" + hex.EncodeToString(
+			schnorr.SerializePubKey(t.GroupKey),
+		)
+	}
+
+	return "asset_id=" + t.AssetID.String()
+}
+
+// Matches returns true if the asset with the given ID and optional group key
+// is covered by the ticker.
+func (t *AssetTicker) Matches(assetID asset.ID,
+	groupKey *btcec.PublicKey) bool {
+
+	// Group keys are compared as x-only keys, as that's how they are
+	// identified in the universe.
+	if t.GroupKey != nil {
+		return groupKey != nil && bytes.Equal(
+			schnorr.SerializePubKey(t.GroupKey),
+			schnorr.SerializePubKey(groupKey),
+		)
+	}
+
+	return t.AssetID == assetID
+}
+
+// String returns a human-readable string for the ticker mapping.
+func (t *AssetTicker) String() string {
+	return fmt.Sprintf("%s (%s, source=%v)", t.Ticker, t.specifier(),
+		t.Source)
+}
+
+// TickerRegistry is the local registry of tickers. Pinning a ticker to an
+// asset in the registry takes precedence over the tickers declared in asset
+// meta data, which also resolves collisions.
+type TickerRegistry interface {
+	// UpsertTicker pins the given ticker to its asset or asset group,
+	// replacing any existing mapping of the ticker.
+	UpsertTicker(ctx context.Context, ticker *AssetTicker) error
+
+	// FetchTicker returns the registry entry of the given normalized
+	// ticker or ErrTickerNotFound if it doesn't exist.
+	FetchTicker(ctx context.Context, ticker string) (*AssetTicker, error)
+
+	// QueryTickers returns all registry entries, ordered by ticker.
+	QueryTickers(ctx context.Context) ([]*AssetTicker, error)
+
+	// DeleteTicker removes the registry entry of the given normalized
+	// ticker or returns ErrTickerNotFound if it doesn't exist.
+	DeleteTicker(ctx context.Context, ticker string) error
+}
+
+// TickerMetaSource looks up tickers in the verified genesis meta data of the
+// known assets.
+type TickerMetaSource interface {
+	// MetaTickers returns a mapping for each known asset whose JSON meta
+	// data declares the given normalized ticker.
+	MetaTickers(ctx context.Context, ticker string) ([]*AssetTicker, error)
+}
+
+// TickerResolver resolves user-friendly ticker symbols to assets.
+type TickerResolver struct {
+	registry TickerRegistry
+
+	metaSource TickerMetaSource
+}
+
+// NewTickerResolver creates a new ticker resolver that first looks up tickers
+// in the given registry and then in the meta data of the known assets.
+func NewTickerResolver(registry TickerRegistry,
+	metaSource TickerMetaSource) *TickerResolver {
+
+	return &TickerResolver{
+		registry:   registry,
+		metaSource: metaSource,
+	}
+}
+
+// Resolve resolves the given ticker to a single asset or asset group. A ticker
+// that is pinned in the local registry always resolves to the pinned asset.
+// Otherwise, the ticker must be declared in the meta data of exactly one asset
+// or of assets of the same group, otherwise ErrTickerCollision is returned.
+func (r *TickerResolver) Resolve(ctx context.Context,
+	ticker string) (*AssetTicker, error) {
+
+	ticker, err := NormalizeTicker(ticker)
+	if err != nil {
+		return nil, err
+	}
+
+	pinned, err := r.registry.FetchTicker(ctx, ticker)
+	switch {
+	case err == nil:
+		return pinned, nil
+
+	case !errors.Is(err, ErrTickerNotFound):
+		return nil, fmt.Errorf("unable to fetch ticker from registry: "+
+			"%w", err)
+	}
+
+	candidates, err := r.metaSource.MetaTickers(ctx, ticker)
+	if err != nil {
+		return nil, fmt.Errorf("unable to look up ticker in asset "+
+			"meta data: %w", err)
+	}
+
+	// Assets of the same group may all declare the same ticker, which
+	// isn't a collision, as the ticker then resolves to the group.
+	unique := make(map[string]*AssetTicker, len(candidates))
+	for _, candidate := range candidates {
+		if _, ok := unique[candidate.specifier()]; !ok {
+			unique[candidate.specifier()] = candidate
+		}
+	}
+
+	switch len(unique) {
+	case 0:
+		return nil, fmt.Errorf("%w: %v", ErrTickerNotFound, ticker)
+
+	case 1:
+		for _, resolved := range unique {
+			return resolved, nil
+		}
+	}
+
+	specifiers := make([]string, 0, len(unique))
+	for specifier := range unique {
+		specifiers = append(specifiers, specifier)
+	}
+	sort.Strings(specifiers)
+
+	return nil, fmt.Errorf("%w: %v is declared by %d different assets "+
+		"(%s), pin the ticker in the local registry to select one",
+		ErrTickerCollision, ticker, len(unique),
+		strings.Join(specifiers, ", "))
+}
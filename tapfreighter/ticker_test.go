@@ -0,0 +1,43 @@
+package tapfreighter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestNormalizeTicker tests that tickers are normalized to upper case and that
+// invalid tickers are rejected.
+func TestNormalizeTicker(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		ticker   string
+		expected string
+		valid    bool
+	}{
+		{ticker: "usdt", expected: "USDT", valid: true},
+		{ticker: "  Gold ", expected: "GOLD", valid: true},
+		{ticker: "BTC.b-2_x", expected: "BTC.B-2_X", valid: true},
+		{ticker: "", valid: false},
+		{ticker: "   ", valid: false},
+		{ticker: "ABCDEFGHIJKLMNOPQ", valid: false},
+		{ticker: "US DT", valid: false},
+		{ticker: "USD$", valid: false},
+
+		// The first letter is a cyrillic "U", which looks like the
+		// latin one.
+		{ticker: "УSDT", valid: false},
+	}
+
+	for _, tc := range testCases {
+		normalized, err := NormalizeTicker(tc.ticker)
+		if !tc.valid {
+			require.ErrorIs(t, err, ErrTickerInvalid, tc.ticker)
+			continue
+		}
+
+		require.NoError(t, err, tc.ticker)
+		require.Equal(t, tc.expected, normalized)
+	}
+}
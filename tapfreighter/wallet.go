@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/btcsuite/btcd/blockchain"
@@ -66,21 +67,42 @@ type Wallet interface {
 	// spend in order to pay the given address. It also returns supporting
 	// data which assists in processing the virtual transaction: passive
 	// asset re-anchors and the Taproot Asset level commitment of the
-	// selected assets.
+	// selected assets. If no coin selection strategy is given, the default
+	// strategy of the wallet is used.
 	FundAddressSend(ctx context.Context,
+		strategy fn.Option[MultiCommitmentSelectStrategy],
 		receiverAddrs ...*address.Tap) (*FundedVPacket, error)
 
+	// FundScriptKeySend funds an interactive virtual transaction, selecting
+	// assets to spend in order to pay the given raw script key destination.
+	// If no coin selection strategy is given, the default strategy of the
+	// wallet is used.
+	FundScriptKeySend(ctx context.Context,
+		strategy fn.Option[MultiCommitmentSelectStrategy],
+		dest *ScriptKeyDestination) (*FundedVPacket, error)
+
 	// FundPacket funds a virtual transaction, selecting assets to spend
 	// in order to pay the given recipient. The selected input is then added
-	// to the given virtual transaction.
+	// to the given virtual transaction. If no coin selection strategy is
+	// given, the default strategy of the wallet is used.
 	FundPacket(ctx context.Context, fundDesc *tapsend.FundingDescriptor,
-		vPkt *tappsbt.VPacket) (*FundedVPacket, error)
+		vPkt *tappsbt.VPacket,
+		strategy fn.Option[MultiCommitmentSelectStrategy]) (
+		*FundedVPacket, error)
 
 	// FundBurn funds a virtual transaction for burning the given amount of
 	// units of the given asset.
 	FundBurn(ctx context.Context,
 		fundDesc *tapsend.FundingDescriptor) (*FundedVPacket, error)
 
+	// FundBatchBurn funds the virtual transactions for burning the given
+	// total amount of units of the asset specified by the constraints. The
+	// burn can span multiple inputs and, if only a group key is given,
+	// multiple asset IDs of the group. All burn outputs are anchored in the
+	// same anchor transaction.
+	FundBatchBurn(ctx context.Context,
+		constraints CommitmentConstraints) (*FundedVPackets, error)
+
 	// SignVirtualPacket signs the virtual transaction of the given packet
 	// and returns the input indexes that were signed.
 	SignVirtualPacket(vPkt *tappsbt.VPacket,
@@ -93,6 +115,13 @@ type Wallet interface {
 		inputCommitments tappsbt.InputCommitments) ([]*tappsbt.VPacket,
 		error)
 
+	// OrderAnchorOutputs applies the configured ordering policy to the
+	// anchor outputs of the given active and passive packets, which must
+	// be all packets anchored in the same anchor transaction. This must be
+	// called before any of the packets is signed.
+	OrderAnchorOutputs(ctx context.Context,
+		activePackets, passivePackets []*tappsbt.VPacket) error
+
 	// SignPassiveAssets signs the given passive asset packets.
 	SignPassiveAssets(passiveAssets []*tappsbt.VPacket) error
 
@@ -107,6 +136,20 @@ type Wallet interface {
 	AnchorVirtualTransactions(ctx context.Context,
 		params *AnchorVTxnsParams) (*tapsend.AnchorTransaction, error)
 
+	// FundAnchorVirtualTransactions creates and funds, but doesn't sign, a
+	// BTC level anchor transaction that anchors all the virtual
+	// transactions of the given packets. The returned PSBT can be signed
+	// by an external signer.
+	FundAnchorVirtualTransactions(ctx context.Context,
+		params *AnchorVTxnsParams) (*tapsend.FundedPsbt, error)
+
+	// FinalizeAnchorVirtualTransactions finalizes the given signed version
+	// of a funded anchor transaction and creates the proof suffixes for
+	// all the virtual transactions of the given packets.
+	FinalizeAnchorVirtualTransactions(params *AnchorVTxnsParams,
+		fundedPsbt *tapsend.FundedPsbt,
+		signedPkt *psbt.Packet) (*tapsend.AnchorTransaction, error)
+
 	// SignOwnershipProof creates and signs an ownership proof for the given
 	// owned asset. The ownership proof consists of a valid witness of a
 	// signed virtual packet that spends the asset fully to the NUMS key.
@@ -157,6 +200,15 @@ type AnchorVTxnsParams struct {
 	// PassivePackets is a list of all the virtual transactions which
 	// re-anchor passive assets.
 	PassivePackets []*tappsbt.VPacket
+
+	// ExtraOutputs is a list of additional BTC level outputs that don't
+	// carry any assets, but should be added to the anchor transaction,
+	// for example the announcements of payments to static addresses.
+	ExtraOutputs []*wire.TxOut
+
+	// PreSigned indicates that the active packets were signed before they
+	// were handed to us, so their anchor output indexes can't be changed.
+	PreSigned bool
 }
 
 // WalletConfig holds the configuration for a new Wallet.
@@ -196,6 +248,18 @@ type WalletConfig struct {
 
 	// ChainParams is the chain params of the chain we operate on.
 	ChainParams *address.ChainParams
+
+	// AnchorOrdering is the policy used to order the inputs and outputs of
+	// the anchor transactions we create.
+	AnchorOrdering tapsend.OutputOrdering
+
+	// CoinSelectStrategy is the default strategy used to select the asset
+	// inputs of a transfer, if the send request doesn't specify one.
+	CoinSelectStrategy MultiCommitmentSelectStrategy
+
+	// GroupKeyIndex is used to query the group key of an asset, for sends
+	// that don't specify it themselves.
+	GroupKeyIndex tapsend.AssetGroupQuerier
 }
 
 // AssetWallet is an implementation of the Wallet interface that can create
@@ -224,6 +288,20 @@ type FundedVPacket struct {
 	InputCommitments tappsbt.InputCommitments
 }
 
+// FundedVPackets is the result from an attempt to fund a transfer that consists
+// of multiple virtual transactions which are all anchored in the same BTC level
+// transaction, for example a burn that spans multiple asset IDs of a group.
+type FundedVPackets struct {
+	// VPackets are the virtual transactions that were created to fund the
+	// transfer.
+	VPackets []*tappsbt.VPacket
+
+	// InputCommitments is a map from virtual package input index to its
+	// associated Taproot Asset commitment, for the inputs of all virtual
+	// transactions.
+	InputCommitments tappsbt.InputCommitments
+}
+
 // FundAddressSend funds a virtual transaction, selecting assets to spend in
 // order to pay the given address. It also returns supporting data which assists
 // in processing the virtual transaction: passive asset re-anchors and the
@@ -231,6 +309,7 @@ type FundedVPacket struct {
 //
 // NOTE: This is part of the Wallet interface.
 func (f *AssetWallet) FundAddressSend(ctx context.Context,
+	strategy fn.Option[MultiCommitmentSelectStrategy],
 	receiverAddrs ...*address.Tap) (*FundedVPacket, error) {
 
 	// We start by creating a new virtual transaction that will be used to
@@ -248,7 +327,7 @@ func (f *AssetWallet) FundAddressSend(ctx context.Context,
 		return nil, fmt.Errorf("unable to describe recipients: %w", err)
 	}
 
-	fundedVPkt, err := f.FundPacket(ctx, fundDesc, vPkt)
+	fundedVPkt, err := f.FundPacket(ctx, fundDesc, vPkt, strategy)
 	if err != nil {
 		return nil, err
 	}
@@ -256,6 +335,33 @@ func (f *AssetWallet) FundAddressSend(ctx context.Context,
 	return fundedVPkt, nil
 }
 
+// FundScriptKeySend funds an interactive virtual transaction, selecting assets
+// to spend in order to pay the given raw script key destination. If the
+// selected inputs exactly match the amount, the send is a full-value send
+// without a change output.
+//
+// NOTE: This is part of the Wallet interface.
+func (f *AssetWallet) FundScriptKeySend(ctx context.Context,
+	strategy fn.Option[MultiCommitmentSelectStrategy],
+	dest *ScriptKeyDestination) (*FundedVPacket, error) {
+
+	vPkt := tappsbt.ForInteractiveSend(
+		dest.AssetID, dest.Amount, asset.NewScriptKey(&dest.ScriptKey),
+		0, 0, 0, keychain.KeyDescriptor{
+			PubKey: &dest.AnchorInternalKey,
+		}, dest.AssetVersion, f.cfg.ChainParams,
+	)
+
+	fundDesc, err := tapsend.DescribeRecipients(
+		ctx, vPkt, f.cfg.GroupKeyIndex,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to describe recipients: %w", err)
+	}
+
+	return f.FundPacket(ctx, fundDesc, vPkt, strategy)
+}
+
 // createPassivePacket creates a virtual packet for the given passive asset.
 func createPassivePacket(params *address.ChainParams, passiveAsset *asset.Asset,
 	activePackets []*tappsbt.VPacket, anchorOutputIndex uint32,
@@ -354,8 +460,9 @@ func createPassivePacket(params *address.ChainParams, passiveAsset *asset.Asset,
 // pay the given recipient. The selected input is then added to the given
 // virtual transaction.
 func (f *AssetWallet) FundPacket(ctx context.Context,
-	fundDesc *tapsend.FundingDescriptor,
-	vPkt *tappsbt.VPacket) (*FundedVPacket, error) {
+	fundDesc *tapsend.FundingDescriptor, vPkt *tappsbt.VPacket,
+	strategy fn.Option[MultiCommitmentSelectStrategy]) (*FundedVPacket,
+	error) {
 
 	// The input and address networks must match.
 	if !address.IsForNet(vPkt.ChainParams.TapHRP, f.cfg.ChainParams) {
@@ -382,7 +489,8 @@ func (f *AssetWallet) FundPacket(ctx context.Context,
 	}
 
 	selectedCommitments, err := f.cfg.CoinSelector.SelectCoins(
-		ctx, constraints, PreferMaxAmount, *anchorVersion,
+		ctx, constraints, strategy.UnwrapOr(f.cfg.CoinSelectStrategy),
+		*anchorVersion,
 	)
 	if err != nil {
 		return nil, err
@@ -396,16 +504,46 @@ func (f *AssetWallet) FundPacket(ctx context.Context,
 func (f *AssetWallet) FundBurn(ctx context.Context,
 	fundDesc *tapsend.FundingDescriptor) (*FundedVPacket, error) {
 
-	// We need to find a commitment that has enough assets to satisfy this
-	// send request. We'll map the address to a set of constraints, so we
-	// can use that to do Taproot asset coin selection.
-	constraints := CommitmentConstraints{
+	fundedPkts, err := f.FundBatchBurn(ctx, CommitmentConstraints{
 		GroupKey: fundDesc.GroupKey,
 		AssetID:  &fundDesc.ID,
 		MinAmt:   fundDesc.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Because the asset ID was given, all selected inputs are of the same
+	// asset ID and there is exactly one virtual packet.
+	return &FundedVPacket{
+		VPacket:          fundedPkts.VPackets[0],
+		InputCommitments: fundedPkts.InputCommitments,
+	}, nil
+}
+
+// FundBatchBurn funds the virtual transactions for burning the given total
+// amount of units of the asset specified by the constraints. If only a group
+// key is given, the burn can span multiple asset IDs of the group. As many
+// inputs as needed are selected and one virtual packet is created per asset
+// ID, with all burn outputs being anchored in the same output of a single
+// anchor transaction.
+func (f *AssetWallet) FundBatchBurn(ctx context.Context,
+	constraints CommitmentConstraints) (*FundedVPackets, error) {
+
+	if constraints.AssetID == nil && constraints.GroupKey == nil {
+		return nil, fmt.Errorf("asset ID or group key must be " +
+			"specified")
+	}
+	if constraints.MinAmt == 0 {
+		return nil, fmt.Errorf("burn amount must be specified")
 	}
+
+	// We need to find a set of commitments that has enough assets to
+	// satisfy the burn request. The coin selection sums up the amounts of
+	// all the inputs it selects.
 	selectedCommitments, err := f.cfg.CoinSelector.SelectCoins(
-		ctx, constraints, PreferMaxAmount, commitment.TapCommitmentV2,
+		ctx, constraints, f.cfg.CoinSelectStrategy,
+		commitment.TapCommitmentV2,
 	)
 	if err != nil {
 		return nil, err
@@ -431,22 +569,167 @@ func (f *AssetWallet) FundBurn(ctx context.Context,
 		}
 	}()
 
-	activeAssets := fn.Filter(
-		selectedCommitments, func(c *AnchoredCommitment) bool {
-			return c.Asset.ID() == fundDesc.ID
-		},
+	alloc, err := allocateBurn(selectedCommitments, constraints.MinAmt)
+	if err != nil {
+		return nil, err
+	}
+
+	// All burn outputs go into the same anchor output.
+	burnInternalKey, err := f.cfg.KeyRing.DeriveNextKey(
+		ctx, asset.TaprootAssetsKeyFamily,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	fundedPkts := &FundedVPackets{
+		InputCommitments: make(tappsbt.InputCommitments),
+	}
+	for _, id := range alloc.assetIDs {
+		fundedPkt, err := f.fundBurnPacket(
+			ctx, alloc.inputs[id], alloc.burnAmts[id],
+			burnInternalKey,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fund burn of asset "+
+				"%v: %w", id, err)
+		}
+
+		fundedPkts.VPackets = append(
+			fundedPkts.VPackets, fundedPkt.VPacket,
+		)
+		for prevID, inputCmt := range fundedPkt.InputCommitments {
+			fundedPkts.InputCommitments[prevID] = inputCmt
+		}
+	}
+
+	// We want to avoid a BTC output being created that just sits there
+	// without an actual commitment in it. So if we are not getting any
+	// change or passive assets in this output, we'll not want to go through
+	// with it.
+	if alloc.changeID.IsNone() {
+		// A burn is an interactive transfer. So we don't expect there
+		// to be a tombstone unless there are passive assets in the same
+		// commitment, in which case the wallet has marked the change
+		// output as tappsbt.TypePassiveSplitRoot. If that's not the
+		// case, we'll return as burning all assets in an anchor output
+		// is not supported.
+		otherAssets, err := f.hasOtherAssets(
+			fundedPkts.InputCommitments, fundedPkts.VPackets,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if !otherAssets {
+			return nil, ErrFullBurnNotSupported
+		}
+	}
+
+	// All burn packets are anchored in the same anchor transaction, so the
+	// ordering policy is applied to all of them at once.
+	err = f.OrderAnchorOutputs(ctx, fundedPkts.VPackets, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to order anchor outputs: %w",
+			err)
+	}
+
+	// Don't release the coins we've selected, as so far we've been
+	// successful.
+	success = true
+	return fundedPkts, nil
+}
+
+// burnAllocation describes how a burn amount is distributed over the inputs
+// selected for the burn.
+type burnAllocation struct {
+	// assetIDs is the list of asset IDs to burn, in the order their inputs
+	// were selected in.
+	assetIDs []asset.ID
+
+	// inputs are the selected inputs, grouped by asset ID.
+	inputs map[asset.ID][]*AnchoredCommitment
+
+	// burnAmts is the number of units to burn per asset ID.
+	burnAmts map[asset.ID]uint64
+
+	// changeID is the asset ID whose inputs aren't burned completely, if
+	// any.
+	changeID fn.Option[asset.ID]
+}
+
+// allocateBurn distributes the given total burn amount over the selected
+// inputs. Each asset ID needs its own virtual packet, so the inputs are grouped
+// by asset ID. Because the coin selection stops as soon as the amount is
+// reached, only the asset ID of the last selected input can end up with
+// change.
+func allocateBurn(selected []*AnchoredCommitment,
+	totalAmt uint64) (*burnAllocation, error) {
+
+	alloc := &burnAllocation{
+		inputs:   make(map[asset.ID][]*AnchoredCommitment),
+		burnAmts: make(map[asset.ID]uint64),
+	}
+	remaining := totalAmt
+	for _, input := range selected {
+		id := input.Asset.ID()
+
+		// An input that doesn't contribute to the burn would end up as
+		// a second change output, so we refuse such a selection.
+		if remaining == 0 {
+			return nil, fmt.Errorf("selected input %v of asset %v "+
+				"isn't needed for the burn", input.AnchorPoint,
+				id)
+		}
+
+		if _, ok := alloc.inputs[id]; !ok {
+			alloc.assetIDs = append(alloc.assetIDs, id)
+		}
+		alloc.inputs[id] = append(alloc.inputs[id], input)
+
+		burnAmt := min(input.Asset.Amount, remaining)
+		if burnAmt < input.Asset.Amount {
+			alloc.changeID = fn.Some(id)
+		}
+
+		alloc.burnAmts[id] += burnAmt
+		remaining -= burnAmt
+	}
+
+	if remaining > 0 {
+		return nil, fmt.Errorf("selected inputs only cover %d of %d "+
+			"units to burn", totalAmt-remaining, totalAmt)
+	}
+
+	return alloc, nil
+}
+
+// fundBurnPacket funds a virtual transaction that burns the given amount of
+// units from the given inputs, which all must be of the same asset ID. The
+// burn output is anchored in the first anchor output, using the given internal
+// key.
+func (f *AssetWallet) fundBurnPacket(ctx context.Context,
+	inputs []*AnchoredCommitment, burnAmt uint64,
+	burnInternalKey keychain.KeyDescriptor) (*FundedVPacket, error) {
+
+	firstInput := inputs[0]
+	fundDesc := &tapsend.FundingDescriptor{
+		ID:     firstInput.Asset.ID(),
+		Amount: burnAmt,
+	}
+	if firstInput.Asset.GroupKey != nil {
+		fundDesc.GroupKey = &firstInput.Asset.GroupKey.GroupPubKey
+	}
 
 	maxVersion := asset.V0
-	for _, activeAsset := range activeAssets {
-		if activeAsset.Asset.Version > maxVersion {
-			maxVersion = activeAsset.Asset.Version
+	for _, input := range inputs {
+		if input.Asset.Version > maxVersion {
+			maxVersion = input.Asset.Version
 		}
 	}
 
 	// Now that we know what inputs we're going to spend, we know that by
 	// definition, we use the first input's info as the burn's PrevID.
-	firstInput := activeAssets[0]
 	firstPrevID := asset.PrevID{
 		OutPoint: firstInput.AnchorPoint,
 		ID:       firstInput.Asset.ID(),
@@ -455,12 +738,6 @@ func (f *AssetWallet) FundBurn(ctx context.Context,
 		),
 	}
 	burnKey := asset.NewScriptKey(asset.DeriveBurnKey(firstPrevID))
-	newInternalKey, err := f.cfg.KeyRing.DeriveNextKey(
-		ctx, asset.TaprootAssetsKeyFamily,
-	)
-	if err != nil {
-		return nil, err
-	}
 
 	// We want both the burn output and the change to be in the same anchor
 	// output, that's why we create the packet manually.
@@ -471,7 +748,7 @@ func (f *AssetWallet) FundBurn(ctx context.Context,
 			},
 		}},
 		Outputs: []*tappsbt.VOutput{{
-			Amount:            fundDesc.Amount,
+			Amount:            burnAmt,
 			Type:              tappsbt.TypeSimple,
 			Interactive:       true,
 			AnchorOutputIndex: 0,
@@ -482,48 +759,12 @@ func (f *AssetWallet) FundBurn(ctx context.Context,
 		Version:     tappsbt.V1,
 	}
 	vPkt.Outputs[0].SetAnchorInternalKey(
-		newInternalKey, f.cfg.ChainParams.HDCoinType,
+		burnInternalKey, f.cfg.ChainParams.HDCoinType,
 	)
 
 	// The virtual transaction is now ready to be further enriched with the
 	// split commitment and other data.
-	fundedPkt, err := f.fundPacketWithInputs(
-		ctx, fundDesc, vPkt, selectedCommitments,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	// We want to avoid a BTC output being created that just sits there
-	// without an actual commitment in it. So if we are not getting any
-	// change or passive assets in this output, we'll not want to go through
-	// with it.
-	firstOut := fundedPkt.VPacket.Outputs[0]
-	if len(fundedPkt.VPacket.Outputs) == 1 &&
-		firstOut.Amount == fundDesc.Amount {
-
-		// A burn is an interactive transfer. So we don't expect there
-		// to be a tombstone unless there are passive assets in the same
-		// commitment, in which case the wallet has marked the change
-		// output as tappsbt.TypePassiveSplitRoot. If that's not the
-		// case, we'll return as burning all assets in an anchor output
-		// is not supported.
-		otherAssets, err := f.hasOtherAssets(
-			fundedPkt.InputCommitments, []*tappsbt.VPacket{vPkt},
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		if !otherAssets {
-			return nil, ErrFullBurnNotSupported
-		}
-	}
-
-	// Don't release the coins we've selected, as so far we've been
-	// successful.
-	success = true
-	return fundedPkt, nil
+	return f.fundPacketWithInputs(ctx, fundDesc, vPkt, inputs)
 }
 
 // hasOtherAssets returns true if the given input commitments contain any other
@@ -1222,6 +1463,55 @@ func (f *AssetWallet) CreatePassiveAssets(ctx context.Context,
 	return passivePackets, nil
 }
 
+// OrderAnchorOutputs applies the configured ordering policy to the anchor
+// outputs of the given active and passive packets, which must be all packets
+// anchored in the same anchor transaction. The order is decided once over all
+// their anchor outputs, and the anchor output indexes of all packets are
+// re-assigned accordingly. The anchor output index is part of the split
+// commitment, so the output assets of the active packets whose indexes changed
+// are prepared again. This must therefore be called before any of the packets
+// is signed.
+func (f *AssetWallet) OrderAnchorOutputs(ctx context.Context,
+	activePackets, passivePackets []*tappsbt.VPacket) error {
+
+	if f.cfg.AnchorOrdering == tapsend.OutputOrderingNone {
+		return nil
+	}
+
+	allPackets := append([]*tappsbt.VPacket{}, activePackets...)
+	allPackets = append(allPackets, passivePackets...)
+
+	// We remember the current anchor output indexes of the active packets,
+	// so we know which of them need to be prepared again.
+	oldIndexes := fn.Map(activePackets, anchorOutputIndexes)
+
+	err := tapsend.OrderAnchorOutputs(f.cfg.AnchorOrdering, allPackets)
+	if err != nil {
+		return err
+	}
+
+	for idx, vPkt := range activePackets {
+		if slices.Equal(oldIndexes[idx], anchorOutputIndexes(vPkt)) {
+			continue
+		}
+
+		if err := tapsend.PrepareOutputAssets(ctx, vPkt); err != nil {
+			return fmt.Errorf("unable to create split commit: %w",
+				err)
+		}
+	}
+
+	return nil
+}
+
+// anchorOutputIndexes returns the anchor output indexes of the outputs of the
+// given virtual packet, in the order of the outputs.
+func anchorOutputIndexes(vPkt *tappsbt.VPacket) []uint32 {
+	return fn.Map(vPkt.Outputs, func(vOut *tappsbt.VOutput) uint32 {
+		return vOut.AnchorOutputIndex
+	})
+}
+
 // SignPassiveAssets signs the given passive asset packets.
 func (f *AssetWallet) SignPassiveAssets(
 	passiveAssets []*tappsbt.VPacket) error {
@@ -1250,6 +1540,48 @@ func (f *AssetWallet) SignPassiveAssets(
 func (f *AssetWallet) AnchorVirtualTransactions(ctx context.Context,
 	params *AnchorVTxnsParams) (*tapsend.AnchorTransaction, error) {
 
+	anchorPkt, err := f.FundAnchorVirtualTransactions(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// With all the input and output information in the packet, we
+	// can now ask lnd to sign it, and then extract the final
+	// version ourselves.
+	log.Debugf("Signing PSBT")
+	log.Tracef("PSBT: %s", spew.Sdump(anchorPkt))
+	signedPsbt, err := f.cfg.Wallet.SignPsbt(ctx, anchorPkt.Pkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign psbt: %w", err)
+	}
+	log.Debugf("Got signed PSBT")
+	log.Tracef("PSBT: %s", spew.Sdump(signedPsbt))
+
+	return f.FinalizeAnchorVirtualTransactions(
+		params, anchorPkt, signedPsbt,
+	)
+}
+
+// FundAnchorVirtualTransactions creates and funds, but doesn't sign, a BTC
+// level anchor transaction that anchors all the virtual transactions of the
+// given packets (for both sending and passive asset re-anchoring). The
+// returned PSBT can be signed by an external signer.
+func (f *AssetWallet) FundAnchorVirtualTransactions(ctx context.Context,
+	params *AnchorVTxnsParams) (*tapsend.FundedPsbt, error) {
+
+	// The anchor output indexes of pre-signed packets can't be changed
+	// anymore, so we can only make sure they were funded with the same
+	// ordering policy.
+	if params.PreSigned {
+		err := tapsend.ValidateAnchorOutputOrder(
+			f.cfg.AnchorOrdering, params.ActivePackets,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("pre-signed packets don't "+
+				"follow the anchor ordering policy: %w", err)
+		}
+	}
+
 	allPackets := append([]*tappsbt.VPacket{}, params.ActivePackets...)
 	allPackets = append(allPackets, params.PassivePackets...)
 	outputCommitments, err := tapsend.CreateOutputCommitments(allPackets)
@@ -1264,6 +1596,7 @@ func (f *AssetWallet) AnchorVirtualTransactions(ctx context.Context,
 	if err != nil {
 		return nil, fmt.Errorf("error creating anchor TX: %w", err)
 	}
+	numAssetOutputs := len(sendPacket.UnsignedTx.TxOut)
 
 	// TODO(roasbeef): also want to log the total fee to disk for
 	// accounting, etc.
@@ -1280,6 +1613,13 @@ func (f *AssetWallet) AnchorVirtualTransactions(ctx context.Context,
 		}
 	}
 
+	// Any extra outputs are appended after the asset carrying outputs, so
+	// the anchor output indexes of the virtual packets stay valid.
+	for _, txOut := range params.ExtraOutputs {
+		sendPacket.UnsignedTx.AddTxOut(txOut)
+		sendPacket.Outputs = append(sendPacket.Outputs, psbt.POutput{})
+	}
+
 	// Now that all the real outputs are in the PSBT, we'll also
 	// add our anchor inputs as well, since the wallet can sign for
 	// it itself.
@@ -1296,17 +1636,58 @@ func (f *AssetWallet) AnchorVirtualTransactions(ctx context.Context,
 	log.Infof("Received funded PSBT packet")
 	log.Tracef("Packet: %v", spew.Sdump(anchorPkt.Pkt))
 
-	// With all the input and output information in the packet, we
-	// can now ask lnd to sign it, and then extract the final
-	// version ourselves.
-	log.Debugf("Signing PSBT")
-	log.Tracef("PSBT: %s", spew.Sdump(anchorPkt))
-	signedPsbt, err := f.cfg.Wallet.SignPsbt(ctx, anchorPkt.Pkt)
+	// The inputs and the change output are only known after funding, so
+	// we apply the configured ordering policy to them now. The asset
+	// carrying outputs of all packets were already ordered before the
+	// packets were signed and keep their index, so they don't need to be
+	// signed again.
+	err = tapsend.OrderAnchorInputs(f.cfg.AnchorOrdering, anchorPkt.Pkt)
 	if err != nil {
-		return nil, fmt.Errorf("unable to sign psbt: %w", err)
+		return nil, fmt.Errorf("unable to order anchor inputs: %w", err)
+	}
+
+	order, err := tapsend.TrailingOutputOrder(
+		f.cfg.AnchorOrdering, anchorPkt.Pkt.UnsignedTx, numAssetOutputs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to order anchor outputs: %w",
+			err)
+	}
+	if order != nil {
+		err = tapsend.ReorderAnchorOutputs(anchorPkt, order)
+		if err != nil {
+			return nil, fmt.Errorf("unable to order anchor "+
+				"outputs: %w", err)
+		}
+	}
+
+	return anchorPkt, nil
+}
+
+// FinalizeAnchorVirtualTransactions finalizes the given signed version of a
+// funded anchor transaction and creates the proof suffixes for all the virtual
+// transactions of the given packets. The signed packet must spend the same
+// inputs and create the same outputs as the funded one.
+func (f *AssetWallet) FinalizeAnchorVirtualTransactions(
+	params *AnchorVTxnsParams, anchorPkt *tapsend.FundedPsbt,
+	signedPsbt *psbt.Packet) (*tapsend.AnchorTransaction, error) {
+
+	fundedTxHash := anchorPkt.Pkt.UnsignedTx.TxHash()
+	if signedPsbt.UnsignedTx.TxHash() != fundedTxHash {
+		return nil, fmt.Errorf("signed PSBT doesn't match funded "+
+			"anchor transaction %v", fundedTxHash)
+	}
+
+	// The output commitments are needed again for the proofs. Creating
+	// them is deterministic, so we end up with the same commitments that
+	// were used for funding.
+	allPackets := append([]*tappsbt.VPacket{}, params.ActivePackets...)
+	allPackets = append(allPackets, params.PassivePackets...)
+	outputCommitments, err := tapsend.CreateOutputCommitments(allPackets)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create new output "+
+			"commitments: %w", err)
 	}
-	log.Debugf("Got signed PSBT")
-	log.Tracef("PSBT: %s", spew.Sdump(signedPsbt))
 
 	// Before we finalize, we need to calculate the actual, final fees that
 	// we pay.
@@ -0,0 +1,108 @@
+package tapfreighter
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAllocateBurn tests that a burn amount is distributed correctly over
+// inputs of one or multiple asset IDs.
+func TestAllocateBurn(t *testing.T) {
+	t.Parallel()
+
+	newInput := func(a *asset.Asset, amt uint64) *AnchoredCommitment {
+		a = a.Copy()
+		a.Amount = amt
+
+		return &AnchoredCommitment{
+			Asset: a,
+		}
+	}
+
+	assetA := asset.RandAsset(t, asset.Normal)
+	assetB := asset.RandAsset(t, asset.Normal)
+	idA, idB := assetA.ID(), assetB.ID()
+
+	testCases := []struct {
+		name             string
+		inputs           []*AnchoredCommitment
+		amt              uint64
+		expectedIDs      []asset.ID
+		expectedBurnAmts map[asset.ID]uint64
+		expectedChange   fn.Option[asset.ID]
+		expectedErr      string
+	}{{
+		name: "single asset ID with change",
+		inputs: []*AnchoredCommitment{
+			newInput(assetA, 10), newInput(assetA, 10),
+		},
+		amt:         15,
+		expectedIDs: []asset.ID{idA},
+		expectedBurnAmts: map[asset.ID]uint64{
+			idA: 15,
+		},
+		expectedChange: fn.Some(idA),
+	}, {
+		name: "multiple asset IDs without change",
+		inputs: []*AnchoredCommitment{
+			newInput(assetA, 10), newInput(assetB, 5),
+		},
+		amt:         15,
+		expectedIDs: []asset.ID{idA, idB},
+		expectedBurnAmts: map[asset.ID]uint64{
+			idA: 10,
+			idB: 5,
+		},
+		expectedChange: fn.None[asset.ID](),
+	}, {
+		name: "multiple asset IDs with change",
+		inputs: []*AnchoredCommitment{
+			newInput(assetA, 10), newInput(assetB, 20),
+		},
+		amt:         25,
+		expectedIDs: []asset.ID{idA, idB},
+		expectedBurnAmts: map[asset.ID]uint64{
+			idA: 10,
+			idB: 15,
+		},
+		expectedChange: fn.Some(idB),
+	}, {
+		name: "insufficient inputs",
+		inputs: []*AnchoredCommitment{
+			newInput(assetA, 10),
+		},
+		amt:         11,
+		expectedErr: "only cover 10 of 11",
+	}, {
+		name: "unneeded input",
+		inputs: []*AnchoredCommitment{
+			newInput(assetA, 10), newInput(assetB, 10),
+		},
+		amt:         10,
+		expectedErr: "isn't needed for the burn",
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			alloc, err := allocateBurn(tc.inputs, tc.amt)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expectedIDs, alloc.assetIDs)
+			require.Equal(t, tc.expectedBurnAmts, alloc.burnAmts)
+			require.Equal(t, tc.expectedChange, alloc.changeID)
+
+			var numInputs int
+			for _, inputs := range alloc.inputs {
+				numInputs += len(inputs)
+			}
+			require.Equal(t, len(tc.inputs), numInputs)
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package tapgarden
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightninglabs/taproot-assets/tapsend"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"golang.org/x/exp/maps"
+)
+
+// PreviewParams are the options available to change how the pending batch is
+// previewed.
+type PreviewParams struct {
+	// FeeRate is the optional fee rate used to estimate the chain fees of
+	// the genesis transaction. If not set, the fee rate is estimated.
+	FeeRate fn.Option[chainfee.SatPerKWeight]
+}
+
+// SeedlingPreview is the preview of a single asset that would be minted by
+// the pending batch.
+type SeedlingPreview struct {
+	// AssetName is the name of the seedling.
+	AssetName string
+
+	// AssetID is the ID the asset would have if the batch was finalized
+	// with the previewed genesis transaction.
+	AssetID asset.ID
+}
+
+// BatchPreview is a dry-run of the finalization of the pending batch. It
+// describes the genesis transaction that would be broadcast, without actually
+// broadcasting it.
+type BatchPreview struct {
+	// BatchKey is the unique identifier of the previewed batch.
+	BatchKey *btcec.PublicKey
+
+	// FeeRate is the fee rate the chain fees were estimated with.
+	FeeRate chainfee.SatPerKWeight
+
+	// VSize is the estimated virtual size of the genesis transaction.
+	VSize int
+
+	// ChainFees is the estimated chain fee of the genesis transaction at
+	// the previewed fee rate.
+	ChainFees btcutil.Amount
+
+	// GenesisPoint is the first input of the genesis transaction, which
+	// is part of the genesis of all assets in the batch.
+	GenesisPoint wire.OutPoint
+
+	// AnchorOutputIndex is the index of the genesis transaction output
+	// that commits to the assets of the batch.
+	AnchorOutputIndex uint32
+
+	// Final is true if the batch is already funded, meaning the genesis
+	// point and asset IDs won't change when the batch is finalized. If
+	// the batch isn't funded yet, the wallet may pick different inputs
+	// when the batch is actually funded.
+	Final bool
+
+	// Assets are the previews of all assets in the batch.
+	Assets []SeedlingPreview
+}
+
+// previewBatch creates a preview of the given pending batch. If the batch
+// isn't funded yet, a temporary genesis packet is funded, and the inputs it
+// locked are released again once the preview is created.
+func (c *ChainPlanter) previewBatch(ctx context.Context, params PreviewParams,
+	batch *MintingBatch) (*BatchPreview, error) {
+
+	if batch == nil {
+		return nil, fmt.Errorf("no pending batch")
+	}
+	if !batch.HasSeedlings() {
+		return nil, fmt.Errorf("no seedlings in pending batch")
+	}
+
+	var (
+		batchKey = asset.ToSerialized(batch.BatchKey.PubKey)
+		feeRate  = params.FeeRate.UnwrapOr(0)
+		err      error
+	)
+	if params.FeeRate.IsNone() {
+		feeRate, err = c.cfg.ChainBridge.EstimateFee(
+			ctx, GenesisConfTarget,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to estimate fee: %w",
+				err)
+		}
+	}
+
+	// If the batch is already funded, the preview is based on the actual
+	// genesis packet of the batch.
+	genesisPacket := batch.GenesisPacket
+	if genesisPacket == nil {
+		genesisPacket, err = c.fundGenesisPsbt(ctx, batchKey, &feeRate)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fund preview "+
+				"genesis packet: %w", err)
+		}
+
+		// The preview packet is never broadcast, so we release the
+		// inputs the wallet locked for it right away.
+		defer func() {
+			for _, lockedUTXO := range genesisPacket.LockedUTXOs {
+				err := c.cfg.Wallet.UnlockInput(ctx, lockedUTXO)
+				if err != nil {
+					log.Warnf("Unable to unlock input %v "+
+						"of preview genesis packet: %v",
+						lockedUTXO, err)
+				}
+			}
+		}()
+	}
+
+	preview, err := newBatchPreview(batch, genesisPacket, feeRate)
+	if err != nil {
+		return nil, err
+	}
+	preview.Final = batch.IsFunded()
+
+	return preview, nil
+}
+
+// newBatchPreview creates a preview of the given batch, using the given funded
+// genesis packet to derive the genesis point and estimate the chain fees.
+func newBatchPreview(batch *MintingBatch, genesisPacket *tapsend.FundedPsbt,
+	feeRate chainfee.SatPerKWeight) (*BatchPreview, error) {
+
+	pkt := genesisPacket.Pkt
+	if pkt == nil || len(pkt.UnsignedTx.TxIn) == 0 {
+		return nil, fmt.Errorf("genesis packet has no inputs")
+	}
+
+	inputScripts := make([][]byte, 0, len(pkt.Inputs))
+	for idx := range pkt.Inputs {
+		witnessUtxo := pkt.Inputs[idx].WitnessUtxo
+		if witnessUtxo == nil {
+			return nil, fmt.Errorf("genesis packet input %d has "+
+				"no witness UTXO", idx)
+		}
+
+		inputScripts = append(inputScripts, witnessUtxo.PkScript)
+	}
+
+	vSize, chainFees := tapscript.EstimateFee(
+		inputScripts, pkt.UnsignedTx.TxOut, feeRate,
+	)
+
+	// The anchor output is always the first output, unless the wallet
+	// placed the change output there.
+	anchorOutputIndex := uint32(0)
+	if genesisPacket.ChangeOutputIndex == 0 {
+		anchorOutputIndex = 1
+	}
+
+	genesisPoint := extractGenesisOutpoint(pkt.UnsignedTx)
+	seedlingNames := SortSeedlings(maps.Values(batch.Seedlings))
+	assets := make([]SeedlingPreview, 0, len(seedlingNames))
+	for _, seedlingName := range seedlingNames {
+		seedling := batch.Seedlings[seedlingName]
+
+		assetGen := asset.Genesis{
+			FirstPrevOut: genesisPoint,
+			Tag:          seedling.AssetName,
+			OutputIndex:  anchorOutputIndex,
+			Type:         seedling.AssetType,
+		}
+		if seedling.Meta != nil {
+			assetGen.MetaHash = seedling.Meta.MetaHash()
+		}
+
+		assets = append(assets, SeedlingPreview{
+			AssetName: seedling.AssetName,
+			AssetID:   assetGen.ID(),
+		})
+	}
+
+	return &BatchPreview{
+		BatchKey:          batch.BatchKey.PubKey,
+		FeeRate:           feeRate,
+		VSize:             vSize,
+		ChainFees:         chainFees,
+		GenesisPoint:      genesisPoint,
+		AnchorOutputIndex: anchorOutputIndex,
+		Assets:            assets,
+	}, nil
+}
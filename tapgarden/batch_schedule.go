@@ -0,0 +1,81 @@
+package tapgarden
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	// DefaultBatchScheduleInterval is the default interval at which the
+	// planter checks whether a scheduled batch is due to be finalized.
+	DefaultBatchScheduleInterval = 10 * time.Second
+)
+
+// BatchSchedule describes when the pending minting batch should be finalized
+// automatically. The batch is finalized as soon as any of the set conditions
+// is met. An empty batch is never finalized, even if the schedule is due.
+type BatchSchedule struct {
+	// Height is the block height at which the batch is finalized.
+	Height fn.Option[uint32]
+
+	// Time is the wall clock time at which the batch is finalized.
+	Time fn.Option[time.Time]
+
+	// MinSeedlings is the number of seedlings the batch needs to contain
+	// for it to be finalized.
+	MinSeedlings fn.Option[int]
+
+	// FeeRate is the optional fee rate used for the genesis transaction
+	// when the batch is finalized.
+	FeeRate fn.Option[chainfee.SatPerKWeight]
+}
+
+// IsEmpty returns true if none of the finalization conditions are set.
+func (s BatchSchedule) IsEmpty() bool {
+	return s.Height.IsNone() && s.Time.IsNone() && s.MinSeedlings.IsNone()
+}
+
+// Validate makes sure the schedule can be used to finalize a batch.
+func (s BatchSchedule) Validate() error {
+	if s.IsEmpty() {
+		return fmt.Errorf("batch schedule needs at least one " +
+			"finalization condition")
+	}
+
+	minSeedlings := s.MinSeedlings.UnwrapOr(1)
+	if minSeedlings <= 0 {
+		return fmt.Errorf("invalid minimum number of seedlings: %d",
+			minSeedlings)
+	}
+
+	return nil
+}
+
+// isDue returns true if any of the finalization conditions of the schedule
+// are met, given the current block height, time and number of seedlings in
+// the pending batch.
+func (s BatchSchedule) isDue(height uint32, now time.Time,
+	numSeedlings int) bool {
+
+	heightReached := fn.MapOptionZ(s.Height, func(h uint32) bool {
+		return height >= h
+	})
+	timeReached := fn.MapOptionZ(s.Time, func(t time.Time) bool {
+		return !now.Before(t)
+	})
+	seedlingsReached := fn.MapOptionZ(s.MinSeedlings, func(n int) bool {
+		return numSeedlings >= n
+	})
+
+	return heightReached || timeReached || seedlingsReached
+}
+
+// String returns a human-readable representation of the schedule.
+func (s BatchSchedule) String() string {
+	return fmt.Sprintf("height=%v, time=%v, min_seedlings=%v",
+		s.Height.UnwrapOr(0), s.Time.UnwrapOr(time.Time{}),
+		s.MinSeedlings.UnwrapOr(0))
+}
@@ -79,6 +79,15 @@ type BatchCaretakerConfig struct {
 	// BroadcastCompleteChan is sent on, never both.
 	BroadcastErrChan chan error
 
+	// AwaitingSigsChan is used to signal back to the caller that the
+	// genesis TX of an externally funded batch is ready to be signed, and
+	// the caretaker now waits for the signatures.
+	AwaitingSigsChan chan struct{}
+
+	// ExternalSigReqs is used to deliver the signatures of an externally
+	// funded batch to the caretaker.
+	ExternalSigReqs chan *externalSigReq
+
 	// SignalCompletion is used to signal back to the BatchPlanter that
 	// their batch has been finalized.
 	SignalCompletion func()
@@ -126,6 +135,10 @@ type BatchCaretaker struct {
 	// the Taproot Asset commitment.
 	anchorOutputIndex uint32
 
+	// externallyFunded is true if the genesis TX of the batch was funded
+	// by an external wallet, which then also needs to sign it.
+	externallyFunded bool
+
 	// ContextGuard provides a wait group and main quit channel that can be
 	// used to create guarded contexts.
 	*fn.ContextGuard
@@ -136,10 +149,18 @@ type BatchCaretaker struct {
 //
 // TODO(roasbeef): rename to Cultivator?
 func NewBatchCaretaker(cfg *BatchCaretakerConfig) *BatchCaretaker {
+	var externallyFunded bool
+	if cfg.Batch.GenesisPacket != nil {
+		externallyFunded = isExternallyFunded(
+			cfg.Batch.GenesisPacket.Pkt,
+		)
+	}
+
 	return &BatchCaretaker{
-		batchKey:  asset.ToSerialized(cfg.Batch.BatchKey.PubKey),
-		cfg:       cfg,
-		confEvent: make(chan *chainntnfs.TxConfirmation, 1),
+		batchKey:         asset.ToSerialized(cfg.Batch.BatchKey.PubKey),
+		cfg:              cfg,
+		confEvent:        make(chan *chainntnfs.TxConfirmation, 1),
+		externallyFunded: externallyFunded,
 		ContextGuard: &fn.ContextGuard{
 			DefaultTimeout: DefaultTimeout,
 			Quit:           make(chan struct{}),
@@ -705,16 +726,29 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 			b.batchKey[:])
 
 		// First, we'll have the wallet sign the PSBT is created, which
-		// was then modified.
+		// was then modified. If the batch was funded externally, the
+		// external wallet signs it instead.
 		//
 		// TODO(roasbeef): only execute if finalized? or missing sig
-		ctx, cancel := b.WithCtxQuit()
-		defer cancel()
-		signedPkt, err := b.cfg.Wallet.SignAndFinalizePsbt(
-			ctx, b.cfg.Batch.GenesisPacket.Pkt,
+		var (
+			signedPkt *psbt.Packet
+			err       error
 		)
-		if err != nil {
-			return 0, fmt.Errorf("unable to sign psbt: %w", err)
+		if b.externallyFunded {
+			signedPkt, err = b.awaitExternalSigs()
+			if err != nil {
+				return 0, err
+			}
+		} else {
+			ctx, cancel := b.WithCtxQuit()
+			signedPkt, err = b.cfg.Wallet.SignAndFinalizePsbt(
+				ctx, b.cfg.Batch.GenesisPacket.Pkt,
+			)
+			cancel()
+			if err != nil {
+				return 0, fmt.Errorf("unable to sign psbt: %w",
+					err)
+			}
 		}
 
 		// Final TX sanity check.
@@ -751,6 +785,8 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 		//
 		// TODO(roasbeef): re-run during the broadcast phase to ensure
 		// it's fully imported?
+		ctx, cancel := b.WithCtxQuit()
+		defer cancel()
 		mintingOutputKey, merkleRoot, err := b.cfg.Batch.
 			MintingOutputKey(nil)
 		if err != nil {
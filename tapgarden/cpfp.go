@@ -0,0 +1,158 @@
+package tapgarden
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/tapsend"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// Fee bumps of inbound anchor transactions are only suggested, not carried
+// out. Spending the anchor output through the lnd wallet would sweep it to a
+// plain wallet output and destroy the received assets, and re-anchoring the
+// assets in a child transaction requires their proofs, which we only import
+// once the anchor transaction confirmed. Subscribers are notified with a plan
+// they can carry out themselves instead.
+
+const (
+	// DefaultCpfpMinUnconfirmed is the default time an inbound anchor
+	// transaction needs to stay unconfirmed before we suggest bumping it.
+	DefaultCpfpMinUnconfirmed = 6 * time.Hour
+
+	// DefaultCpfpConfTarget is the default confirmation target used to
+	// estimate the fee rate for the CPFP child transaction.
+	DefaultCpfpConfTarget = 6
+
+	// DefaultCpfpCheckInterval is the default interval at which we check
+	// for stalled inbound anchor transactions.
+	DefaultCpfpCheckInterval = 10 * time.Minute
+
+	// cpfpChildWeight is a conservative estimate of the weight of a CPFP
+	// child transaction that spends a single key spend P2TR anchor output
+	// and re-anchors the assets in a single P2TR output.
+	cpfpChildWeight = 4*(4+1+41+1+43+4) + 66
+)
+
+var (
+	// ErrCpfpFeeRateTooHigh is returned if the fee rate required for the
+	// CPFP child transaction exceeds the configured maximum.
+	ErrCpfpFeeRateTooHigh = errors.New("cpfp fee rate exceeds maximum")
+
+	// ErrCpfpAnchorValueTooLow is returned if the BTC value of the anchor
+	// output isn't large enough to pay for the CPFP child transaction
+	// while keeping the re-anchored assets above the dust limit.
+	ErrCpfpAnchorValueTooLow = errors.New("anchor output value too low " +
+		"to pay for cpfp")
+)
+
+// CpfpConfig houses the configuration for suggesting child-pays-for-parent
+// fee bumps of stalled inbound anchor transactions.
+type CpfpConfig struct {
+	// Enabled indicates whether stalled inbound anchor transactions should
+	// be reported to subscribers at all.
+	Enabled bool
+
+	// MinUnconfirmed is the minimum time an inbound anchor transaction
+	// needs to stay unconfirmed before we suggest bumping it.
+	MinUnconfirmed time.Duration
+
+	// ConfTarget is the confirmation target used to estimate the fee rate
+	// of the CPFP package.
+	ConfTarget uint32
+
+	// MaxFeeRate is the maximum fee rate of a suggested CPFP package.
+	// Transfers that would require a higher fee rate aren't reported.
+	MaxFeeRate chainfee.SatPerKWeight
+
+	// CheckInterval is the interval at which we check for stalled inbound
+	// anchor transactions.
+	CheckInterval time.Duration
+}
+
+// CpfpPlan describes a child-pays-for-parent transaction that spends the
+// anchor output of an inbound asset transfer and pays its fee from the BTC
+// value of that output.
+type CpfpPlan struct {
+	// Outpoint is the anchor output of the inbound transfer that is spent
+	// by the child transaction.
+	Outpoint wire.OutPoint
+
+	// FeeRate is the fee rate of the whole CPFP package.
+	FeeRate chainfee.SatPerKWeight
+
+	// ChildFee is the absolute fee paid by the child transaction.
+	ChildFee btcutil.Amount
+
+	// ChildOutputValue is the BTC value of the child output that
+	// re-anchors the received assets.
+	ChildOutputValue btcutil.Amount
+}
+
+// NewCpfpPlan creates a CPFP plan for the given inbound transfer. Because we
+// don't know the value of the inputs of the parent transaction, we
+// conservatively assume the parent doesn't pay any fee at all and let the
+// child pay for the weight of the whole package. The plan is only returned if
+// it passes all safety checks.
+func NewCpfpPlan(event *address.Event, parentTx *wire.MsgTx,
+	feeRate, maxFeeRate chainfee.SatPerKWeight) (*CpfpPlan, error) {
+
+	if maxFeeRate != 0 && feeRate > maxFeeRate {
+		return nil, fmt.Errorf("%w: %v > %v", ErrCpfpFeeRateTooHigh,
+			feeRate, maxFeeRate)
+	}
+
+	parentWeight := blockchain.GetTransactionWeight(btcutil.NewTx(parentTx))
+	packageWeight := lntypes.WeightUnit(parentWeight + cpfpChildWeight)
+	childFee := feeRate.FeeForWeight(packageWeight)
+
+	// The assets are re-anchored in the child output, which needs to stay
+	// above the dust limit.
+	if event.Amt < childFee+tapsend.DummyAmtSats {
+		return nil, fmt.Errorf("%w: value=%v, child_fee=%v",
+			ErrCpfpAnchorValueTooLow, event.Amt, childFee)
+	}
+
+	return &CpfpPlan{
+		Outpoint:         event.Outpoint,
+		FeeRate:          feeRate,
+		ChildFee:         childFee,
+		ChildOutputValue: event.Amt - childFee,
+	}, nil
+}
+
+// AssetReceiveCpfpEvent is an event that is sent to a subscriber once a CPFP
+// child transaction was planned for a stalled inbound asset transfer. The
+// child transaction isn't created or published by us.
+type AssetReceiveCpfpEvent struct {
+	// timestamp is the time the event was created.
+	timestamp time.Time
+
+	// Address is the address the assets were sent to.
+	Address address.Tap
+
+	// Plan is the CPFP plan for the inbound transfer.
+	Plan CpfpPlan
+}
+
+// Timestamp returns the timestamp of the event.
+func (e *AssetReceiveCpfpEvent) Timestamp() time.Time {
+	return e.timestamp
+}
+
+// NewAssetReceiveCpfpEvent creates a new AssetReceiveCpfpEvent.
+func NewAssetReceiveCpfpEvent(addr address.Tap,
+	plan CpfpPlan) *AssetReceiveCpfpEvent {
+
+	return &AssetReceiveCpfpEvent{
+		timestamp: time.Now().UTC(),
+		Address:   addr,
+		Plan:      plan,
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taproot-assets/address"
 	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/compliance"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightningnetwork/lnd/lnrpc"
@@ -124,6 +125,19 @@ type CustodianConfig struct {
 	// to be confirmed safely with a minimum number of confirmations.
 	ProofWatcher proof.Watcher
 
+	// Cpfp is the configuration for suggesting child-pays-for-parent fee
+	// bumps of stalled inbound anchor transactions to subscribers.
+	Cpfp CpfpConfig
+
+	// ZeroConf is the configuration for crediting inbound transfers before
+	// their anchor transaction confirmed.
+	ZeroConf ZeroConfConfig
+
+	// Compliance is used to screen inbound transfers with an external
+	// policy service before their proofs are imported. If this is nil, no
+	// screening takes place.
+	Compliance *compliance.Checker
+
 	// ErrChan is the main error channel the custodian will report back
 	// critical errors to the main server.
 	ErrChan chan<- error
@@ -159,6 +173,27 @@ type Custodian struct {
 	// address events of inbound assets.
 	events map[wire.OutPoint]*address.Event
 
+	// unconfirmedTxns is a map of all transaction outpoints of inbound
+	// assets that haven't confirmed yet and their anchor transaction.
+	unconfirmedTxns map[wire.OutPoint]*wire.MsgTx
+
+	// cpfpPlanned is the set of outpoints we already suggested a
+	// child-pays-for-parent fee bump for.
+	cpfpPlanned map[wire.OutPoint]struct{}
+
+	// zeroConfReceives is a map of all inbound transfers that were
+	// credited at zero confirmations and haven't confirmed yet, keyed by
+	// their anchor outpoint.
+	zeroConfReceives map[wire.OutPoint]*ZeroConfReceive
+
+	// zeroConfMtx guards the zero-conf receives map.
+	zeroConfMtx sync.RWMutex
+
+	// staticTxRequests is the channel through which unconfirmed
+	// transactions are handed to the main event loop to be inspected for
+	// payments to our static addresses.
+	staticTxRequests chan *staticTxRequest
+
 	// ContextGuard provides a wait group and main quit channel that can be
 	// used to create guarded contexts.
 	*fn.ContextGuard
@@ -178,6 +213,10 @@ func NewCustodian(cfg *CustodianConfig) *Custodian {
 		proofSubscription: proofSub,
 		statusEventsSubs:  statusEventsSubs,
 		events:            make(map[wire.OutPoint]*address.Event),
+		unconfirmedTxns:   make(map[wire.OutPoint]*wire.MsgTx),
+		cpfpPlanned:       make(map[wire.OutPoint]struct{}),
+		zeroConfReceives:  make(map[wire.OutPoint]*ZeroConfReceive),
+		staticTxRequests:  make(chan *staticTxRequest),
 		ContextGuard: &fn.ContextGuard{
 			DefaultTimeout: DefaultTimeout,
 			Quit:           make(chan struct{}),
@@ -202,6 +241,7 @@ func (c *Custodian) Start() error {
 		err := c.cfg.AddrBook.RegisterSubscriber(
 			c.addrSubscription, true, address.QueryParams{
 				UnmanagedOnly: true,
+				State:         address.AddrStateActive,
 			},
 		)
 		if err != nil {
@@ -378,16 +418,76 @@ func (c *Custodian) watchInboundAssets() {
 		}
 	}
 
+	// Payments to static addresses aren't detected by the wallet, so we
+	// scan each new block for their announcements. We subscribe to new
+	// blocks first, so we don't miss any while catching up.
+	blockChan, blockErrChan, err :=
+		c.cfg.ChainBridge.RegisterBlockEpochNtfn(ctxStream)
+	if err != nil {
+		reportErr(fmt.Errorf("unable to register for block epochs: %w",
+			err))
+		return
+	}
+
+	ctxt, cancel = c.WithCtxQuit()
+	bestHeight, err := c.cfg.ChainBridge.CurrentHeight(ctxt)
+	cancel()
+	if err != nil {
+		reportErr(fmt.Errorf("unable to fetch current height: %w",
+			err))
+		return
+	}
+
+	if err := c.scanStaticAddrs(bestHeight); err != nil {
+		reportErr(err)
+		return
+	}
+
+	// If enabled, we periodically check for inbound anchor transactions
+	// that are stalled in the mempool.
+	var cpfpTicks <-chan time.Time
+	if c.cfg.Cpfp.Enabled {
+		cpfpTicker := time.NewTicker(c.cfg.Cpfp.CheckInterval)
+		defer cpfpTicker.Stop()
+
+		cpfpTicks = cpfpTicker.C
+	}
+
+	// If enabled, we periodically check whether the anchor transactions of
+	// zero-conf receives were replaced or double spent.
+	var zeroConfTicks <-chan time.Time
+	if c.cfg.ZeroConf.Enabled {
+		zeroConfTicker := time.NewTicker(c.cfg.ZeroConf.CheckInterval)
+		defer zeroConfTicker.Stop()
+
+		zeroConfTicks = zeroConfTicker.C
+	}
+
 	log.Infof("Starting main custodian event loop")
 	for {
 		var err error
 		select {
+		case <-cpfpTicks:
+			c.planStalledReceives()
+
+		case <-zeroConfTicks:
+			c.checkZeroConfReceives()
+
 		case newAddr := <-c.addrSubscription.NewItemCreated.ChanOut():
 			err = c.importAddrToWallet(newAddr)
 
 		case tx := <-newTxChan:
 			err = c.inspectWalletTx(&tx)
 
+		case height := <-blockChan:
+			err = c.scanStaticAddrs(uint32(height))
+
+		case req := <-c.staticTxRequests:
+			req.errChan <- c.inspectStaticPaymentTx(req.tx)
+
+		case err = <-blockErrChan:
+			break
+
 		case newProof := <-c.proofSubscription.NewItemCreated.ChanOut():
 			log.Tracef("New proof received from notifier")
 			err = c.mapProofToEvent(newProof)
@@ -438,11 +538,25 @@ func (c *Custodian) inspectWalletTx(walletTx *lndclient.Transaction) error {
 		op := wire.OutPoint{Hash: txHash, Index: uint32(idx)}
 		event, ok := c.events[op]
 		if ok {
+			// Keep track of the anchor transaction while it is
+			// unconfirmed, so we can bump it if needed.
+			if event.ConfirmationHeight == 0 &&
+				walletTx.Confirmations == 0 {
+
+				c.unconfirmedTxns[op] = walletTx.Tx
+				c.creditZeroConf(
+					event.Addr.Tap, op, walletTx.Tx,
+				)
+			}
+
 			// Was this event previously unconfirmed, and we have
 			// received a conf now? Let's bump the state then.
 			if event.ConfirmationHeight == 0 &&
 				walletTx.Confirmations > 0 {
 
+				delete(c.unconfirmedTxns, op)
+				c.settleZeroConf(op)
+
 				var err error
 				ctxt, cancel := c.CtxBlocking()
 				event, err = c.cfg.AddrBook.GetOrCreateEvent(
@@ -510,6 +624,8 @@ func (c *Custodian) inspectWalletTx(walletTx *lndclient.Transaction) error {
 		// we skip it now, we'll receive another notification once the
 		// transaction is confirmed.
 		if walletTx.Confirmations == 0 {
+			c.unconfirmedTxns[op] = walletTx.Tx
+			c.creditZeroConf(addr, op, walletTx.Tx)
 			continue
 		}
 
@@ -544,6 +660,212 @@ func (c *Custodian) inspectWalletTx(walletTx *lndclient.Transaction) error {
 	return nil
 }
 
+// planStalledReceives checks all unconfirmed inbound anchor transactions and
+// notifies subscribers with a child-pays-for-parent plan for those that have
+// been unconfirmed for longer than the configured minimum time. The plan is
+// only a suggestion, we don't create or publish the child transaction. Each
+// transfer is only reported once.
+func (c *Custodian) planStalledReceives() {
+	cpfpCfg := c.cfg.Cpfp
+	for op, parentTx := range c.unconfirmedTxns {
+		event, ok := c.events[op]
+		if !ok || event.ConfirmationHeight != 0 {
+			delete(c.unconfirmedTxns, op)
+			continue
+		}
+
+		if _, ok := c.cpfpPlanned[op]; ok {
+			continue
+		}
+
+		if time.Since(event.CreationTime) < cpfpCfg.MinUnconfirmed {
+			continue
+		}
+
+		ctxt, cancel := c.WithCtxQuit()
+		feeRate, err := c.cfg.ChainBridge.EstimateFee(
+			ctxt, cpfpCfg.ConfTarget,
+		)
+		cancel()
+		if err != nil {
+			log.Errorf("Unable to estimate fee for CPFP of %v: %v",
+				op, err)
+			continue
+		}
+
+		plan, err := NewCpfpPlan(
+			event, parentTx, feeRate, cpfpCfg.MaxFeeRate,
+		)
+		if err != nil {
+			log.Warnf("Not suggesting CPFP for stalled inbound "+
+				"transfer %v: %v", op, err)
+			continue
+		}
+
+		c.cpfpPlanned[op] = struct{}{}
+
+		log.Infof("Inbound transfer %v unconfirmed since %v, suggesting "+
+			"CPFP at fee rate %v (child_fee=%v)", op,
+			event.CreationTime, plan.FeeRate, plan.ChildFee)
+
+		c.publishSubscriberStatusEvent(NewAssetReceiveCpfpEvent(
+			*event.Addr.Tap, *plan,
+		))
+	}
+}
+
+// creditZeroConf credits the inbound transfer to the given address at zero
+// confirmations if it qualifies for zero-conf receives and wasn't credited
+// before. Subscribers are notified with a risk labelled event.
+func (c *Custodian) creditZeroConf(addr *address.Tap, op wire.OutPoint,
+	anchorTx *wire.MsgTx) {
+
+	if !c.cfg.ZeroConf.Qualifies(addr) {
+		return
+	}
+
+	c.zeroConfMtx.Lock()
+	if _, ok := c.zeroConfReceives[op]; ok {
+		c.zeroConfMtx.Unlock()
+		return
+	}
+
+	receive := &ZeroConfReceive{
+		Address:       *addr,
+		OutPoint:      op,
+		DetectionTime: time.Now().UTC(),
+		Label:         ZeroConfUnconfirmed,
+		anchorTx:      anchorTx,
+	}
+	c.zeroConfReceives[op] = receive
+	c.zeroConfMtx.Unlock()
+
+	log.Infof("Crediting unconfirmed inbound transfer (asset_id=%x, "+
+		"amt=%d) in %v at zero confirmations", addr.AssetID[:],
+		addr.Amount, op)
+
+	c.publishSubscriberStatusEvent(NewAssetReceiveZeroConfEvent(*receive))
+}
+
+// settleZeroConf removes the zero-conf receive of the given anchor outpoint
+// after its anchor transaction confirmed and notifies subscribers that the
+// credit is no longer risky.
+func (c *Custodian) settleZeroConf(op wire.OutPoint) {
+	c.zeroConfMtx.Lock()
+	receive, ok := c.zeroConfReceives[op]
+	delete(c.zeroConfReceives, op)
+	c.zeroConfMtx.Unlock()
+
+	if !ok {
+		return
+	}
+
+	receive.Label = ZeroConfConfirmed
+	c.publishSubscriberStatusEvent(NewAssetReceiveZeroConfEvent(*receive))
+}
+
+// checkZeroConfReceives checks whether the anchor transactions of all
+// zero-conf receives are still known to the wallet and not double spent. The
+// credit of any conflicted receive is removed and subscribers are notified.
+// Failures are only logged, as they shouldn't affect the main event loop.
+func (c *Custodian) checkZeroConfReceives() {
+	c.zeroConfMtx.RLock()
+	numReceives := len(c.zeroConfReceives)
+	c.zeroConfMtx.RUnlock()
+
+	if numReceives == 0 {
+		return
+	}
+
+	ctxt, cancel := c.WithCtxQuit()
+	walletTxns, err := c.cfg.WalletAnchor.ListTransactions(
+		ctxt, 0, -1, waddrmgr.ImportedAddrAccountName,
+	)
+	cancel()
+	if err != nil {
+		log.Errorf("Unable to list wallet transactions to check "+
+			"zero-conf receives: %v", err)
+		return
+	}
+
+	var conflicted []ZeroConfReceive
+	c.zeroConfMtx.Lock()
+	for op, receive := range c.zeroConfReceives {
+		if !zeroConfConflicted(receive, walletTxns) {
+			continue
+		}
+
+		delete(c.zeroConfReceives, op)
+		receive.Label = ZeroConfConflicted
+		conflicted = append(conflicted, *receive)
+	}
+	c.zeroConfMtx.Unlock()
+
+	for _, receive := range conflicted {
+		log.Warnf("Removing credit of conflicted zero-conf receive in "+
+			"%v", receive.OutPoint)
+
+		// There is no point in bumping a transaction that was
+		// replaced.
+		delete(c.unconfirmedTxns, receive.OutPoint)
+
+		c.publishSubscriberStatusEvent(
+			NewAssetReceiveZeroConfEvent(receive),
+		)
+	}
+}
+
+// ZeroConfReceives returns all inbound transfers that are currently credited
+// at zero confirmations.
+func (c *Custodian) ZeroConfReceives() []ZeroConfReceive {
+	c.zeroConfMtx.RLock()
+	defer c.zeroConfMtx.RUnlock()
+
+	receives := make([]ZeroConfReceive, 0, len(c.zeroConfReceives))
+	for _, receive := range c.zeroConfReceives {
+		receives = append(receives, *receive)
+	}
+
+	return receives
+}
+
+// screenReceive screens the inbound transfer proven by the given proof blob
+// with the compliance policy service, if one is configured.
+func (c *Custodian) screenReceive(ctx context.Context, addr *address.Tap,
+	op wire.OutPoint, blob proof.Blob) error {
+
+	if c.cfg.Compliance == nil {
+		return nil
+	}
+
+	file, err := blob.AsFile()
+	if err != nil {
+		return fmt.Errorf("unable to decode proof file: %w", err)
+	}
+
+	lastProof, err := file.LastProof()
+	if err != nil {
+		return fmt.Errorf("unable to fetch last proof: %w", err)
+	}
+
+	addrStr, err := addr.EncodeAddress()
+	if err != nil {
+		return fmt.Errorf("unable to encode address: %w", err)
+	}
+
+	transferAsset := compliance.NewTransferAsset(&lastProof.Asset)
+	transferAsset.Address = addrStr
+	transferAsset.AnchorOutputIndex = op.Index
+	transferAsset.ProofChainLength = uint32(file.NumProofs())
+	transferAsset.Local = true
+
+	return c.cfg.Compliance.Check(ctx, &compliance.Transfer{
+		Direction:  compliance.DirectionReceive,
+		AnchorTxid: op.Hash.String(),
+		Assets:     []compliance.TransferAsset{transferAsset},
+	})
+}
+
 // receiveProof attempts to receive a proof for the given address and outpoint
 // via the proof courier service.
 func (c *Custodian) receiveProof(addr *address.Tap, op wire.OutPoint,
@@ -611,6 +933,22 @@ func (c *Custodian) receiveProof(addr *address.Tap, op wire.OutPoint,
 	ctx, cancel = c.CtxBlocking()
 	defer cancel()
 
+	// Before we import the proof, we give the compliance policy service
+	// the chance to reject the transfer. A rejected transfer is not a
+	// critical error, so we only report it to the subscribers.
+	err = c.screenReceive(ctx, addr, op, addrProof.Blob)
+	if errors.Is(err, compliance.ErrTransferRejected) {
+		c.publishSubscriberStatusEvent(NewAssetReceiveErrorEvent(
+			err, *addr, op, confHeight,
+			address.StatusTransactionConfirmed,
+		))
+
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
 	headerVerifier := GenHeaderVerifier(ctx, c.cfg.ChainBridge)
 	err = c.cfg.ProofArchive.ImportProofs(
 		ctx, headerVerifier, proof.DefaultMerkleVerifier,
@@ -674,8 +1012,18 @@ func (c *Custodian) mapToTapAddr(walletTx *lndclient.Transaction,
 		return nil, nil
 
 	// If we don't have an event yet, we'll create a new one further below.
+	// Unless the address expired or was archived, in which case we don't
+	// watch for new inbound transfers to it anymore.
 	case errors.Is(err, address.ErrNoEvent):
-		// Continue below.
+		state := addr.State(time.Now())
+		if state != address.AddrStateActive {
+			log.Infof("Ignoring inbound asset transfer "+
+				"(asset_id=%x) to %v Taproot Asset address %s "+
+				"in %s", addr.AssetID[:], state, addrStr,
+				op.String())
+
+			return nil, nil
+		}
 
 	// Something went wrong while querying for events.
 	case err != nil:
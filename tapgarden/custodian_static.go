@@ -0,0 +1,371 @@
+package tapgarden
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"golang.org/x/exp/maps"
+)
+
+// staticTxRequest is a request to inspect an unconfirmed transaction for
+// payments to our static addresses.
+type staticTxRequest struct {
+	// tx is the unconfirmed transaction to inspect.
+	tx *wire.MsgTx
+
+	// errChan receives the result of the inspection.
+	errChan chan error
+}
+
+// staticTxConf holds the confirmation details of a transaction that pays to a
+// static address.
+type staticTxConf struct {
+	// blockHash is the hash of the block that confirmed the transaction.
+	blockHash chainhash.Hash
+
+	// blockHeight is the height of the block that confirmed the
+	// transaction.
+	blockHeight uint32
+
+	// bestHeight is the current height of the best chain.
+	bestHeight uint32
+}
+
+// InspectStaticPaymentTx inspects the given unconfirmed transaction for
+// payments to our static addresses. Payments to static addresses aren't
+// reported by the lnd wallet, so an unconfirmed payment can only be detected
+// if the sender hands over the transaction. Found payments are tracked like
+// unconfirmed payments to regular addresses until they confirm.
+func (c *Custodian) InspectStaticPaymentTx(tx *wire.MsgTx) error {
+	req := &staticTxRequest{
+		tx:      tx,
+		errChan: make(chan error, 1),
+	}
+	if !fn.SendOrQuit(c.staticTxRequests, req, c.Quit) {
+		return fmt.Errorf("custodian shutting down")
+	}
+
+	select {
+	case err := <-req.errChan:
+		return err
+
+	case <-c.Quit:
+		return fmt.Errorf("custodian shutting down")
+	}
+}
+
+// inspectStaticPaymentTx looks for announcements of payments to our static
+// addresses in the given unconfirmed transaction and claims them.
+func (c *Custodian) inspectStaticPaymentTx(tx *wire.MsgTx) error {
+	ctxt, cancel := c.WithCtxQuit()
+	staticAddrs, err := c.cfg.AddrBook.ListStaticAddrs(ctxt)
+	cancel()
+	if err != nil {
+		return fmt.Errorf("unable to list static addresses: %w", err)
+	}
+
+	for _, txOut := range tx.TxOut {
+		ann, ok := address.ParseStaticAnnouncement(txOut.PkScript)
+		if !ok {
+			continue
+		}
+
+		for idx := range staticAddrs {
+			err := c.claimStaticPayment(
+				&staticAddrs[idx], ann, tx, nil,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// rollbackStaticAddrsScan checks whether any of the most recently scanned
+// blocks were re-organized out of the best chain. If so, the scan height of
+// the static addresses is reset to the fork point and the payments found in
+// the disconnected blocks are unclaimed, so they're found again once the new
+// chain is scanned. Payments that were already completed are handled by the
+// re-org watcher of the proofs instead.
+func (c *Custodian) rollbackStaticAddrsScan(height uint32) error {
+	ctxt, cancel := c.WithCtxQuit()
+	defer cancel()
+
+	scanBlocks, err := c.cfg.AddrBook.StaticAddrScanBlocks(ctxt)
+	if err != nil {
+		return fmt.Errorf("unable to fetch scanned blocks: %w", err)
+	}
+
+	if len(scanBlocks) == 0 {
+		return nil
+	}
+
+	heights := maps.Keys(scanBlocks)
+	sort.Slice(heights, func(i, j int) bool {
+		return heights[i] > heights[j]
+	})
+
+	// We walk back from the most recently scanned block until we find one
+	// that is still part of the best chain. If none of them are, we roll
+	// back to just below the oldest block we know of.
+	forkHeight := heights[len(heights)-1] - 1
+	for _, blockHeight := range heights {
+		// Blocks above the current height were disconnected.
+		if blockHeight > height {
+			continue
+		}
+
+		blockHash, err := c.cfg.ChainBridge.GetBlockHash(
+			ctxt, int64(blockHeight),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to fetch block hash for "+
+				"height %d: %w", blockHeight, err)
+		}
+
+		if blockHash == scanBlocks[blockHeight] {
+			forkHeight = blockHeight
+			break
+		}
+	}
+
+	// The most recently scanned block is still part of the best chain.
+	if forkHeight == heights[0] {
+		return nil
+	}
+
+	log.Infof("Re-org detected, rolling back static address scan from "+
+		"height %d to %d", heights[0], forkHeight)
+
+	outpoints, err := c.cfg.AddrBook.RollbackStaticAddrsScan(
+		ctxt, forkHeight,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to roll back static address scan: "+
+			"%w", err)
+	}
+
+	for _, op := range outpoints {
+		log.Infof("Unclaiming static address payment in %v after "+
+			"re-org", op)
+
+		delete(c.events, op)
+		delete(c.unconfirmedTxns, op)
+	}
+
+	return nil
+}
+
+// scanStaticAddrs scans all blocks up to and including the given height that
+// weren't scanned yet for announcements of payments to our static addresses.
+// Payments to static addresses don't show up in the lnd wallet, as their
+// output keys are only known after the announcement was found.
+func (c *Custodian) scanStaticAddrs(height uint32) error {
+	if err := c.rollbackStaticAddrsScan(height); err != nil {
+		return err
+	}
+
+	ctxt, cancel := c.WithCtxQuit()
+	staticAddrs, err := c.cfg.AddrBook.ListStaticAddrs(ctxt)
+	cancel()
+	if err != nil {
+		return fmt.Errorf("unable to list static addresses: %w", err)
+	}
+
+	if len(staticAddrs) == 0 {
+		return nil
+	}
+
+	startHeight := staticAddrs[0].ScanHeight + 1
+	for _, staticAddr := range staticAddrs {
+		startHeight = min(startHeight, staticAddr.ScanHeight+1)
+	}
+
+	if startHeight <= height {
+		log.Debugf("Scanning blocks %d to %d for static address "+
+			"payments", startHeight, height)
+	}
+
+	for blockHeight := startHeight; blockHeight <= height; blockHeight++ {
+		blockHash, err := c.scanStaticAddrsBlock(
+			staticAddrs, blockHeight, height,
+		)
+		if err != nil {
+			return err
+		}
+
+		ctxt, cancel := c.WithCtxQuit()
+		err = c.cfg.AddrBook.SetStaticAddrsScanHeight(
+			ctxt, blockHeight, blockHash,
+		)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("unable to update static address "+
+				"scan height: %w", err)
+		}
+
+		for idx := range staticAddrs {
+			staticAddrs[idx].ScanHeight = max(
+				staticAddrs[idx].ScanHeight, blockHeight,
+			)
+		}
+	}
+
+	return nil
+}
+
+// scanStaticAddrsBlock scans the block at the given height for announcements
+// of payments to the given static addresses and returns the hash of the
+// block. Static addresses that already scanned the block are skipped.
+func (c *Custodian) scanStaticAddrsBlock(
+	staticAddrs []address.StaticAddrWithKeyInfo, blockHeight,
+	bestHeight uint32) (chainhash.Hash, error) {
+
+	ctxt, cancel := c.WithCtxQuit()
+	defer cancel()
+
+	blockHash, err := c.cfg.ChainBridge.GetBlockHash(
+		ctxt, int64(blockHeight),
+	)
+	if err != nil {
+		return chainhash.Hash{}, fmt.Errorf("unable to fetch block "+
+			"hash for height %d: %w", blockHeight, err)
+	}
+
+	block, err := c.cfg.ChainBridge.GetBlock(ctxt, blockHash)
+	if err != nil {
+		return chainhash.Hash{}, fmt.Errorf("unable to fetch block "+
+			"%v: %w", blockHash, err)
+	}
+
+	conf := &staticTxConf{
+		blockHash:   blockHash,
+		blockHeight: blockHeight,
+		bestHeight:  bestHeight,
+	}
+	for _, tx := range block.Transactions {
+		for _, txOut := range tx.TxOut {
+			ann, ok := address.ParseStaticAnnouncement(
+				txOut.PkScript,
+			)
+			if !ok {
+				continue
+			}
+
+			for idx := range staticAddrs {
+				staticAddr := &staticAddrs[idx]
+				if staticAddr.ScanHeight >= blockHeight {
+					continue
+				}
+
+				err := c.claimStaticPayment(
+					staticAddr, ann, tx, conf,
+				)
+				if err != nil {
+					return chainhash.Hash{}, err
+				}
+			}
+		}
+	}
+
+	return blockHash, nil
+}
+
+// claimStaticPayment checks whether the given announcement belongs to a
+// payment to the given static address. If it does, the single-use address of
+// the payment is added to the address book and the transaction is inspected
+// like a wallet transaction that pays to a regular address. The confirmation
+// details are nil if the transaction is still unconfirmed.
+func (c *Custodian) claimStaticPayment(
+	staticAddr *address.StaticAddrWithKeyInfo,
+	ann *address.StaticAnnouncement, tx *wire.MsgTx,
+	conf *staticTxConf) error {
+
+	ctxt, cancel := c.WithCtxQuit()
+	paymentAddr, err := c.cfg.AddrBook.ClaimStaticPayment(
+		ctxt, staticAddr, ann,
+	)
+	cancel()
+	switch {
+	// The announcement is for someone else's static address.
+	case errors.Is(err, address.ErrStaticAnnouncementMismatch):
+		return nil
+
+	case err != nil:
+		return fmt.Errorf("unable to claim static payment: %w", err)
+	}
+
+	pkScript, err := txscript.PayToTaprootScript(
+		&paymentAddr.TaprootOutputKey,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to create payment pk script: %w",
+			err)
+	}
+
+	// The announcement was meant for us, so the transaction should also
+	// contain the output that carries the assets. We build the same
+	// transaction details the wallet would report for it, with only that
+	// output marked as ours.
+	const p2trType = lnrpc.OutputScriptType_SCRIPT_TYPE_WITNESS_V1_TAPROOT
+	outputIdx := -1
+	outputDetails := make([]*lnrpc.OutputDetail, len(tx.TxOut))
+	for idx, txOut := range tx.TxOut {
+		outputDetails[idx] = &lnrpc.OutputDetail{
+			OutputIndex: int64(idx),
+			Amount:      txOut.Value,
+		}
+
+		if outputIdx == -1 && bytes.Equal(txOut.PkScript, pkScript) {
+			outputIdx = idx
+			outputDetails[idx].IsOurAddress = true
+			outputDetails[idx].OutputType = p2trType
+		}
+	}
+
+	txHash := tx.TxHash()
+	if outputIdx == -1 {
+		log.Warnf("Static address payment in tx %v announced without "+
+			"a matching output", txHash)
+
+		return nil
+	}
+
+	op := wire.OutPoint{Hash: txHash, Index: uint32(outputIdx)}
+	if _, ok := c.events[op]; !ok {
+		log.Infof("Found static address payment of %d units "+
+			"(asset_id=%x) in %v", paymentAddr.Amount,
+			paymentAddr.AssetID[:], op)
+	}
+
+	walletTx := &lndclient.Transaction{
+		Tx:            tx,
+		TxHash:        txHash.String(),
+		Amount:        btcutil.Amount(tx.TxOut[outputIdx].Value),
+		OutputDetails: outputDetails,
+	}
+	if conf != nil {
+		walletTx.Confirmations = int32(
+			conf.bestHeight - conf.blockHeight + 1,
+		)
+		walletTx.BlockHash = conf.blockHash.String()
+		walletTx.BlockHeight = int32(conf.blockHeight)
+	}
+
+	// This creates the event for the payment if it's new, or moves it to
+	// the confirmed state if it was detected unconfirmed before. Once it's
+	// confirmed, the proof is fetched from the courier.
+	return c.inspectWalletTx(walletTx)
+}
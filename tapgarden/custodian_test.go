@@ -13,6 +13,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taproot-assets/address"
@@ -24,6 +25,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/tapdb"
 	"github.com/lightninglabs/taproot-assets/tapgarden"
 	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightninglabs/taproot-assets/tapsend"
 	"github.com/lightninglabs/taproot-assets/universe"
 	"github.com/lightningnetwork/lnd/clock"
 	"github.com/lightningnetwork/lnd/lnrpc"
@@ -166,6 +168,13 @@ func (h *custodianHarness) assertStartup() {
 	)
 	require.NoError(h.t, err)
 
+	// Make sure we subscribe to new blocks on startup, to scan them for
+	// static address payments.
+	_, err = fn.RecvOrTimeout(
+		h.chainBridge.BlockEpochSignal, testTimeout,
+	)
+	require.NoError(h.t, err)
+
 	// Make sure we don't have an error on startup.
 	select {
 	case err := <-h.errChan:
@@ -946,3 +955,106 @@ func TestAddrMatchesAsset(t *testing.T) {
 		})
 	}
 }
+
+// TestNewCpfpPlan tests that a CPFP plan for a stalled inbound transfer is
+// only created if it passes all safety checks.
+func TestNewCpfpPlan(t *testing.T) {
+	t.Parallel()
+
+	parentTx := wire.NewMsgTx(2)
+	parentTx.AddTxIn(&wire.TxIn{})
+	parentTx.AddTxOut(&wire.TxOut{PkScript: make([]byte, 34)})
+	parentTx.AddTxOut(&wire.TxOut{PkScript: make([]byte, 34)})
+
+	event := &address.Event{
+		Outpoint: wire.OutPoint{Hash: parentTx.TxHash(), Index: 1},
+		Amt:      5_000,
+	}
+
+	// A fee rate above the maximum should be rejected.
+	_, err := tapgarden.NewCpfpPlan(event, parentTx, 5_000, 1_000)
+	require.ErrorIs(t, err, tapgarden.ErrCpfpFeeRateTooHigh)
+
+	// An anchor output that can't pay for the package while staying above
+	// the dust limit should be rejected as well.
+	_, err = tapgarden.NewCpfpPlan(event, parentTx, 10_000, 0)
+	require.ErrorIs(t, err, tapgarden.ErrCpfpAnchorValueTooLow)
+
+	// With a reasonable fee rate, the child should pay for the whole
+	// package from the anchor output value.
+	plan, err := tapgarden.NewCpfpPlan(event, parentTx, 1_000, 2_000)
+	require.NoError(t, err)
+	require.Equal(t, event.Outpoint, plan.Outpoint)
+	require.Greater(t, plan.ChildFee, btcutil.Amount(0))
+	require.Equal(t, event.Amt, plan.ChildFee+plan.ChildOutputValue)
+	require.GreaterOrEqual(
+		t, plan.ChildOutputValue, tapsend.DummyAmtSats,
+	)
+}
+
+// TestZeroConfReceive tests that qualifying inbound transfers are credited at
+// zero confirmations and that the credit is settled once the anchor
+// transaction confirms.
+func TestZeroConfReceive(t *testing.T) {
+	h := newHarness(t, nil)
+
+	ctx := context.Background()
+
+	const numAddrs = 2
+	addrs := make([]*address.AddrWithKeyInfo, numAddrs)
+	genesis := make([]*asset.Genesis, numAddrs)
+	transactions := make([]*lndclient.Transaction, numAddrs)
+	for i := 0; i < numAddrs; i++ {
+		addrs[i], genesis[i] = randAddr(h)
+		err := h.tapdbBook.InsertAddrs(ctx, *addrs[i])
+		require.NoError(t, err)
+
+		outputIdx, tx := randWalletTx(addrs[i])
+		transactions[i] = tx
+		h.walletAnchor.Transactions = append(
+			h.walletAnchor.Transactions, *tx,
+		)
+
+		mockProof := randProof(
+			t, outputIdx, tx.Tx, genesis[i], addrs[i],
+		)
+		_ = h.courier.DeliverProof(nil, mockProof)
+	}
+
+	// Only the asset of the first address qualifies for zero-conf
+	// receives.
+	h.cfg.ZeroConf = tapgarden.ZeroConfConfig{
+		Enabled:       true,
+		AssetIDs:      []asset.ID{addrs[0].AssetID},
+		CheckInterval: time.Hour,
+	}
+
+	require.NoError(t, h.c.Start())
+	t.Cleanup(func() {
+		require.NoError(t, h.c.Stop())
+	})
+	h.assertStartup()
+	h.assertAddrsRegistered(addrs...)
+
+	// Both transfers are detected, but only the first one is credited at
+	// zero confirmations.
+	h.assertEventsPresent(numAddrs, address.StatusTransactionDetected)
+	h.eventually(func() bool {
+		return len(h.c.ZeroConfReceives()) == 1
+	})
+
+	receive := h.c.ZeroConfReceives()[0]
+	require.Equal(t, tapgarden.ZeroConfUnconfirmed, receive.Label)
+	require.Equal(t, addrs[0].AssetID, receive.Address.AssetID)
+
+	// Once the transactions confirm, the credit is settled and the proofs
+	// are imported as usual.
+	for idx := range transactions {
+		tx := transactions[idx]
+		tx.Confirmations = 1
+		h.walletAnchor.SubscribeTx <- *tx
+	}
+
+	h.assertEventsPresent(numAddrs, address.StatusCompleted)
+	require.Empty(t, h.c.ZeroConfReceives())
+}
@@ -0,0 +1,240 @@
+package tapgarden
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/tapsend"
+)
+
+var (
+	// ErrNotExternallyFunded is returned when signatures are submitted
+	// for a batch that is funded by the backing wallet.
+	ErrNotExternallyFunded = errors.New("batch is not externally funded")
+
+	// ErrGenesisTxModified is returned when an externally signed genesis
+	// transaction differs from the one the batch commits to.
+	ErrGenesisTxModified = errors.New("externally signed genesis " +
+		"transaction was modified")
+)
+
+// NewGenesisSkeleton returns the unsigned skeleton of a genesis transaction.
+// It has a single output that is replaced with the Taproot Asset commitment
+// when the batch is finalized. An external wallet can fund the skeleton by
+// adding inputs and an optional change output, without touching the existing
+// output.
+func NewGenesisSkeleton() (*psbt.Packet, error) {
+	txTemplate := wire.NewMsgTx(2)
+	txTemplate.AddTxOut(tapsend.CreateDummyOutput())
+
+	return psbt.NewFromUnsignedTx(txTemplate)
+}
+
+// isExternallyFunded returns true if the given genesis packet was funded by an
+// external wallet.
+func isExternallyFunded(pkt *psbt.Packet) bool {
+	if pkt == nil {
+		return false
+	}
+
+	return tappsbt.ExtractCustomField(
+		pkt.Unknowns, tappsbt.PsbtKeyTypeGlobalExternalFunding,
+	) != nil
+}
+
+// verifyExternalFunding checks that the given externally funded genesis
+// skeleton still contains the output for the Taproot Asset commitment, that
+// all inputs are described so the fee can be computed, and that no input is
+// signed yet. The signatures are only added once the commitment output is
+// known. The packet is returned as a funded genesis packet that is marked as
+// externally funded.
+func verifyExternalFunding(pkt *psbt.Packet) (*tapsend.FundedPsbt, error) {
+	if pkt == nil || pkt.UnsignedTx == nil {
+		return nil, fmt.Errorf("external funding PSBT is empty")
+	}
+
+	tx := pkt.UnsignedTx
+	if len(tx.TxIn) == 0 {
+		return nil, fmt.Errorf("external funding PSBT has no inputs")
+	}
+
+	// The caretaker expects the commitment output and at most one change
+	// output.
+	if len(tx.TxOut) == 0 || len(tx.TxOut) > 2 {
+		return nil, fmt.Errorf("external funding PSBT must have one "+
+			"or two outputs, got %d", len(tx.TxOut))
+	}
+
+	dummyOut := tapsend.CreateDummyOutput()
+	anchorIndex := -1
+	for idx, txOut := range tx.TxOut {
+		if txOut.Value != dummyOut.Value ||
+			!bytes.Equal(txOut.PkScript, dummyOut.PkScript) {
+
+			continue
+		}
+
+		if anchorIndex != -1 {
+			return nil, fmt.Errorf("external funding PSBT has " +
+				"more than one genesis output")
+		}
+		anchorIndex = idx
+	}
+	if anchorIndex == -1 {
+		return nil, fmt.Errorf("external funding PSBT is missing the " +
+			"genesis output of the skeleton")
+	}
+
+	changeIndex := int32(-1)
+	if len(tx.TxOut) == 2 {
+		changeIndex = int32(1 - anchorIndex)
+	}
+
+	for idx, pIn := range pkt.Inputs {
+		if pIn.WitnessUtxo == nil && pIn.NonWitnessUtxo == nil {
+			return nil, fmt.Errorf("external funding PSBT input "+
+				"%d is missing its UTXO", idx)
+		}
+
+		// Any signature would be invalidated by replacing the genesis
+		// output, so we don't accept any.
+		isSigned := len(pIn.FinalScriptWitness) != 0 ||
+			len(pIn.FinalScriptSig) != 0 ||
+			len(pIn.PartialSigs) != 0 ||
+			len(pIn.TaprootKeySpendSig) != 0 ||
+			len(pIn.TaprootScriptSpendSig) != 0
+		if isSigned {
+			return nil, fmt.Errorf("external funding PSBT input "+
+				"%d is already signed", idx)
+		}
+	}
+
+	chainFees, err := pkt.GetTxFee()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get on-chain fees for "+
+			"external funding PSBT: %w", err)
+	}
+
+	pkt.Unknowns = tappsbt.AddCustomField(
+		pkt.Unknowns, tappsbt.PsbtKeyTypeGlobalExternalFunding,
+		[]byte{1},
+	)
+
+	return &tapsend.FundedPsbt{
+		Pkt:               pkt,
+		ChangeOutputIndex: changeIndex,
+		ChainFees:         int64(chainFees),
+	}, nil
+}
+
+// applyExternalSigs adds the input signatures of the given externally signed
+// packet to the unsigned genesis packet of a batch. The signed packet must
+// spend the same inputs to the same outputs, so the Taproot Asset commitment
+// can't be tampered with. The signed genesis packet is returned.
+func applyExternalSigs(unsignedPkt, signedPkt *psbt.Packet) (*psbt.Packet,
+	error) {
+
+	if signedPkt == nil || signedPkt.UnsignedTx == nil {
+		return nil, fmt.Errorf("signed genesis PSBT is empty")
+	}
+
+	// Any difference in the inputs, outputs or transaction fields results
+	// in a different txid, so this covers the commitment output as well.
+	unsignedTxid := unsignedPkt.UnsignedTx.TxHash()
+	signedTxid := signedPkt.UnsignedTx.TxHash()
+	if unsignedTxid != signedTxid {
+		return nil, fmt.Errorf("%w: expected txid %v, got %v",
+			ErrGenesisTxModified, unsignedTxid, signedTxid)
+	}
+
+	if err := psbt.MaybeFinalizeAll(signedPkt); err != nil {
+		return nil, fmt.Errorf("unable to finalize signed genesis "+
+			"PSBT: %w", err)
+	}
+
+	// We copy our own packet, so we keep the information we added to it,
+	// and only take over the final witnesses of the inputs.
+	var psbtBuf bytes.Buffer
+	if err := unsignedPkt.Serialize(&psbtBuf); err != nil {
+		return nil, fmt.Errorf("unable to serialize genesis PSBT: %w",
+			err)
+	}
+	finalPkt, err := psbt.NewFromRawBytes(&psbtBuf, false)
+	if err != nil {
+		return nil, fmt.Errorf("unable to deserialize genesis PSBT: %w",
+			err)
+	}
+
+	for idx := range finalPkt.Inputs {
+		finalPkt.Inputs[idx].FinalScriptSig =
+			signedPkt.Inputs[idx].FinalScriptSig
+		finalPkt.Inputs[idx].FinalScriptWitness =
+			signedPkt.Inputs[idx].FinalScriptWitness
+	}
+
+	return finalPkt, nil
+}
+
+// externalSigReq delivers the externally signed genesis PSBT of a batch to its
+// caretaker. The result of applying the signatures is sent on the error
+// channel.
+type externalSigReq struct {
+	signedPkt *psbt.Packet
+	errChan   chan error
+}
+
+// awaitExternalSigs waits until valid signatures for the genesis TX of an
+// externally funded batch are submitted, and returns the signed genesis
+// packet. Invalid signatures are rejected, so they can be submitted again.
+func (b *BatchCaretaker) awaitExternalSigs() (*psbt.Packet, error) {
+	log.Infof("BatchCaretaker(%x): waiting for external signatures of "+
+		"GenesisPacket", b.batchKey[:])
+
+	// Let the caller that finalized the batch know that the genesis TX
+	// can now be signed. After a restart, nobody is waiting for this.
+	select {
+	case b.cfg.AwaitingSigsChan <- struct{}{}:
+	default:
+	}
+
+	for {
+		select {
+		case req := <-b.cfg.ExternalSigReqs:
+			signedPkt, err := applyExternalSigs(
+				b.cfg.Batch.GenesisPacket.Pkt, req.signedPkt,
+			)
+			if err == nil {
+				_, err = psbt.Extract(signedPkt)
+			}
+			req.errChan <- err
+
+			if err != nil {
+				log.Warnf("BatchCaretaker(%x): invalid "+
+					"external signatures: %v",
+					b.batchKey[:], err)
+
+				continue
+			}
+
+			return signedPkt, nil
+
+		case <-b.cfg.CancelReqChan:
+			cancelErr := b.Cancel()
+			if cancelErr == nil {
+				return nil, fmt.Errorf("BatchCaretaker(%x), "+
+					"attempted batch cancellation, "+
+					"shutting down", b.batchKey[:])
+			}
+
+			log.Info(cancelErr)
+
+		case <-b.Quit:
+			return nil, fmt.Errorf("BatchCaretaker(%x), shutting "+
+				"down", b.batchKey[:])
+		}
+	}
+}
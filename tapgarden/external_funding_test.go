@@ -0,0 +1,144 @@
+package tapgarden
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// TestExternalFunding tests that an externally funded genesis skeleton is
+// verified, and that external signatures are only accepted for the genesis TX
+// the batch commits to.
+func TestExternalFunding(t *testing.T) {
+	t.Parallel()
+
+	privKey := test.RandPrivKey(t)
+	pkScript, err := txscript.PayToTaprootScript(
+		txscript.ComputeTaprootKeyNoScript(privKey.PubKey()),
+	)
+	require.NoError(t, err)
+
+	changeScript, err := txscript.PayToTaprootScript(test.RandPubKey(t))
+	require.NoError(t, err)
+
+	// fundSkeleton adds an external input and a change output to a new
+	// genesis skeleton.
+	fundSkeleton := func() *psbt.Packet {
+		pkt, err := NewGenesisSkeleton()
+		require.NoError(t, err)
+
+		pkt.UnsignedTx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: test.RandOp(t),
+		})
+		pkt.Inputs = append(pkt.Inputs, psbt.PInput{
+			WitnessUtxo: &wire.TxOut{
+				Value:    100_000,
+				PkScript: pkScript,
+			},
+		})
+
+		// The change output goes first, so the genesis output is the
+		// second one.
+		pkt.UnsignedTx.TxOut = append([]*wire.TxOut{{
+			Value:    98_000,
+			PkScript: changeScript,
+		}}, pkt.UnsignedTx.TxOut...)
+		pkt.Outputs = append(pkt.Outputs, psbt.POutput{})
+
+		return pkt
+	}
+
+	// A skeleton without inputs, or with a modified genesis output, isn't
+	// accepted.
+	skeleton, err := NewGenesisSkeleton()
+	require.NoError(t, err)
+	_, err = verifyExternalFunding(skeleton)
+	require.ErrorContains(t, err, "no inputs")
+
+	pkt := fundSkeleton()
+	pkt.UnsignedTx.TxOut[1].Value++
+	_, err = verifyExternalFunding(pkt)
+	require.ErrorContains(t, err, "missing the genesis output")
+
+	pkt = fundSkeleton()
+	pkt.Inputs[0].TaprootKeySpendSig = make([]byte, 64)
+	_, err = verifyExternalFunding(pkt)
+	require.ErrorContains(t, err, "already signed")
+
+	funded, err := verifyExternalFunding(fundSkeleton())
+	require.NoError(t, err)
+	require.EqualValues(t, 0, funded.ChangeOutputIndex)
+	require.EqualValues(t, 1_000, funded.ChainFees)
+	require.True(t, isExternallyFunded(funded.Pkt))
+
+	// When finalizing the batch, the genesis output is replaced with the
+	// Taproot Asset commitment.
+	genesisScript, err := txscript.PayToTaprootScript(test.RandPubKey(t))
+	require.NoError(t, err)
+	unsignedPkt := funded.Pkt
+	unsignedPkt.UnsignedTx.TxOut[1].PkScript = genesisScript
+
+	// signPkt signs the input of the given genesis packet.
+	signPkt := func(pkt *psbt.Packet) {
+		prevOut := pkt.Inputs[0].WitnessUtxo
+		fetcher := txscript.NewCannedPrevOutputFetcher(
+			prevOut.PkScript, prevOut.Value,
+		)
+		sig, err := txscript.RawTxInTaprootSignature(
+			pkt.UnsignedTx, txscript.NewTxSigHashes(
+				pkt.UnsignedTx, fetcher,
+			), 0, prevOut.Value, prevOut.PkScript, nil,
+			txscript.SigHashDefault, privKey,
+		)
+		require.NoError(t, err)
+
+		pkt.Inputs[0].TaprootKeySpendSig = sig
+	}
+
+	// A signed TX that spends to a different genesis output is rejected.
+	tamperedPkt := copyPkt(t, unsignedPkt)
+	tamperedPkt.UnsignedTx.TxOut[1].PkScript = changeScript
+	signPkt(tamperedPkt)
+	_, err = applyExternalSigs(unsignedPkt, tamperedPkt)
+	require.ErrorIs(t, err, ErrGenesisTxModified)
+
+	// The signatures for the genesis TX are applied, and the resulting TX
+	// is valid.
+	signedPkt := copyPkt(t, unsignedPkt)
+	signPkt(signedPkt)
+	finalPkt, err := applyExternalSigs(unsignedPkt, signedPkt)
+	require.NoError(t, err)
+	require.True(t, isExternallyFunded(finalPkt))
+
+	finalTx, err := psbt.Extract(finalPkt)
+	require.NoError(t, err)
+	require.Equal(t, genesisScript, finalTx.TxOut[1].PkScript)
+
+	prevOut := finalPkt.Inputs[0].WitnessUtxo
+	fetcher := txscript.NewCannedPrevOutputFetcher(
+		prevOut.PkScript, prevOut.Value,
+	)
+	vm, err := txscript.NewEngine(
+		prevOut.PkScript, finalTx, 0, txscript.StandardVerifyFlags,
+		nil, txscript.NewTxSigHashes(finalTx, fetcher), prevOut.Value,
+		fetcher,
+	)
+	require.NoError(t, err)
+	require.NoError(t, vm.Execute())
+}
+
+// copyPkt returns a deep copy of the given packet.
+func copyPkt(t *testing.T, pkt *psbt.Packet) *psbt.Packet {
+	b64, err := pkt.B64Encode()
+	require.NoError(t, err)
+
+	pktCopy, err := psbt.NewFromRawBytes(strings.NewReader(b64), true)
+	require.NoError(t, err)
+
+	return pktCopy
+}
@@ -0,0 +1,483 @@
+package tapgarden
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapmusig"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc/signrpc"
+)
+
+var (
+	// ErrGroupSessionNotFound is returned if a group signing session can't
+	// be found.
+	ErrGroupSessionNotFound = errors.New("group signing session not found")
+
+	// ErrGroupKeyMismatch is returned if the combined key of the signers
+	// of a group signing session doesn't match the group key of the
+	// seedling.
+	ErrGroupKeyMismatch = errors.New("combined key of the signers " +
+		"doesn't match the group key")
+)
+
+// GroupSession is a MuSig2 signing session for the group witness of a seedling
+// whose group key is shared between multiple issuers. Once all issuers
+// contributed their nonces and partial signatures, the session produces the
+// group witness that is passed to SealBatch.
+type GroupSession struct {
+	// ID is the ID of the session, as assigned by lnd.
+	ID [32]byte
+
+	// GenID is the asset ID of the seedling the group witness is for.
+	GenID asset.ID
+
+	// GroupKey is the tweaked group key the group witness is valid for.
+	GroupKey *btcec.PublicKey
+
+	// SigHash is the signature hash of the group virtual transaction.
+	SigHash [32]byte
+
+	// Participants are all issuers of the session, including the local
+	// one.
+	Participants []*tapmusig.Participant
+
+	// Witness is the group witness, once the session is finalized.
+	Witness wire.TxWitness
+}
+
+// Copy returns a deep copy of the session.
+func (s *GroupSession) Copy() *GroupSession {
+	sessionCopy := *s
+	sessionCopy.Participants = make(
+		[]*tapmusig.Participant, len(s.Participants),
+	)
+	for idx, p := range s.Participants {
+		participantCopy := *p
+		participantCopy.PartialSig = fn.CopySlice(p.PartialSig)
+		sessionCopy.Participants[idx] = &participantCopy
+	}
+	sessionCopy.Witness = fn.CopySlice(s.Witness)
+
+	return &sessionCopy
+}
+
+// IsFinalized returns true if the session produced the group witness.
+func (s *GroupSession) IsFinalized() bool {
+	return len(s.Witness) != 0
+}
+
+// haveAllNonces returns true if the public nonces of all issuers are known.
+func (s *GroupSession) haveAllNonces() bool {
+	return fn.All(s.Participants, func(p *tapmusig.Participant) bool {
+		return p.PubNonce.IsSome()
+	})
+}
+
+// localParticipant returns the local issuer of the session.
+func (s *GroupSession) localParticipant() *tapmusig.Participant {
+	for _, participant := range s.Participants {
+		if participant.Local {
+			return participant
+		}
+	}
+
+	return nil
+}
+
+// remoteParticipant returns the remote issuer with the given key.
+func (s *GroupSession) remoteParticipant(
+	pubKey *btcec.PublicKey) (*tapmusig.Participant, error) {
+
+	for _, participant := range s.Participants {
+		if !participant.Local && participant.PubKey.IsEqual(pubKey) {
+			return participant, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %x", tapmusig.ErrUnknownSigner,
+		pubKey.SerializeCompressed())
+}
+
+// GroupSessions drives MuSig2 signing sessions for the group witnesses of
+// seedlings whose group key is the MuSig2 combination of multiple issuer
+// keys, as created by asset.MuSig2GroupRawKey. As the secret nonce of the
+// local issuer only lives in the memory of lnd, the sessions are only kept in
+// memory as well and need to be started over if tapd or lnd restart.
+type GroupSessions struct {
+	signer tapmusig.Signer
+
+	// sessions are the active sessions, keyed by their ID.
+	sessions map[[32]byte]*GroupSession
+
+	// mtx guards the sessions map and serializes all steps of all
+	// sessions.
+	mtx sync.Mutex
+}
+
+// NewGroupSessions creates a new group signing session manager that uses the
+// given lnd signer for the local issuer key.
+func NewGroupSessions(signer tapmusig.Signer) *GroupSessions {
+	return &GroupSessions{
+		signer:   signer,
+		sessions: make(map[[32]byte]*GroupSession),
+	}
+}
+
+// muSig2TweakOpt returns a session option that applies the given generic
+// tweaks to the combined key.
+func muSig2TweakOpt(tweaks []musig2.KeyTweakDesc) lndclient.MuSig2SessionOpts {
+	return func(req *signrpc.MuSig2SessionRequest) {
+		for _, tweak := range tweaks {
+			req.Tweaks = append(req.Tweaks, &signrpc.TweakDesc{
+				Tweak:   fn.CopySlice(tweak.Tweak[:]),
+				IsXOnly: tweak.IsXOnly,
+			})
+		}
+	}
+}
+
+// CreateSession starts a new signing session for the group witness of the
+// given pending asset group, as listed by a verbose ListBatches call. The raw
+// group key must be the MuSig2 combination of the given issuer keys, which
+// includes the local key. Only the key spend path of the group key can be
+// signed. The returned session contains the public nonce of the local issuer
+// that needs to be shared with the remote issuers.
+func (g *GroupSessions) CreateSession(ctx context.Context,
+	group *PendingAssetGroup, localKey keychain.KeyDescriptor,
+	signers []*btcec.PublicKey) (*GroupSession, error) {
+
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	if g.signer == nil {
+		return nil, fmt.Errorf("no musig2 signer available")
+	}
+	if localKey.PubKey == nil {
+		return nil, fmt.Errorf("missing local issuer key")
+	}
+
+	participants := []*tapmusig.Participant{{
+		PubKey: localKey.PubKey,
+		Local:  true,
+	}}
+	for _, signer := range signers {
+		isKnown := fn.Any(
+			participants, func(p *tapmusig.Participant) bool {
+				return p.PubKey.IsEqual(signer)
+			},
+		)
+		if isKnown {
+			continue
+		}
+
+		participants = append(participants, &tapmusig.Participant{
+			PubKey: signer,
+		})
+	}
+	if len(participants) < 2 {
+		return nil, fmt.Errorf("a group signing session needs at " +
+			"least one remote issuer")
+	}
+
+	if group.RawKey.PubKey == nil {
+		return nil, fmt.Errorf("missing raw group key")
+	}
+	tweaks, err := asset.MuSig2GroupKeyTweaks(
+		group.RawKey.PubKey, group.GenID, group.TapscriptRoot,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sigHash, err := group.KeySpendSigHash()
+	if err != nil {
+		return nil, fmt.Errorf("unable to compute signature hash: %w",
+			err)
+	}
+
+	rawKeys := fn.Map(participants, func(p *tapmusig.Participant) []byte {
+		return p.PubKey.SerializeCompressed()
+	})
+	info, err := g.signer.MuSig2CreateSession(
+		ctx, input.MuSig2Version100RC2, &localKey.KeyLocator, rawKeys,
+		muSig2TweakOpt(tweaks),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create musig2 session: %w",
+			err)
+	}
+
+	groupKey := schnorr.SerializePubKey(&group.TweakedKey)
+	if !bytes.Equal(schnorr.SerializePubKey(info.CombinedKey), groupKey) {
+		err := g.signer.MuSig2Cleanup(ctx, info.SessionID)
+		if err != nil {
+			log.Warnf("Unable to clean up musig2 session %x: %v",
+				info.SessionID[:], err)
+		}
+
+		return nil, fmt.Errorf("%w: combined key %x, group key %x",
+			ErrGroupKeyMismatch,
+			schnorr.SerializePubKey(info.CombinedKey), groupKey)
+	}
+
+	participants[0].PubNonce = fn.Some(info.PublicNonce)
+
+	session := &GroupSession{
+		ID:           info.SessionID,
+		GenID:        group.GenID,
+		GroupKey:     info.CombinedKey,
+		SigHash:      sigHash,
+		Participants: participants,
+	}
+	g.sessions[session.ID] = session
+
+	log.Infof("Created group signing session %x for asset %v with %d "+
+		"issuers", session.ID[:], session.GenID, len(participants))
+
+	return session.Copy(), nil
+}
+
+// fetchSession returns the session with the given ID.
+func (g *GroupSessions) fetchSession(id [32]byte) (*GroupSession, error) {
+	session, ok := g.sessions[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %x", ErrGroupSessionNotFound, id[:])
+	}
+
+	if session.IsFinalized() {
+		return nil, fmt.Errorf("group signing session %x is already "+
+			"finalized", id[:])
+	}
+
+	return session, nil
+}
+
+// RegisterNonce registers the public nonce of the remote issuer with the
+// given key.
+func (g *GroupSessions) RegisterNonce(ctx context.Context, id [32]byte,
+	signer *btcec.PublicKey,
+	nonce [musig2.PubNonceSize]byte) (*GroupSession, error) {
+
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	session, err := g.fetchSession(id)
+	if err != nil {
+		return nil, err
+	}
+
+	participant, err := session.remoteParticipant(signer)
+	if err != nil {
+		return nil, err
+	}
+
+	// Contributing the same nonce twice is fine, a different one is
+	// rejected, as lnd already uses the first one.
+	if participant.PubNonce.IsSome() {
+		knownNonce := participant.PubNonce.UnwrapOr(
+			[musig2.PubNonceSize]byte{},
+		)
+		if knownNonce != nonce {
+			return nil, fmt.Errorf("issuer %x already contributed "+
+				"a different nonce",
+				signer.SerializeCompressed())
+		}
+
+		return session.Copy(), nil
+	}
+
+	_, err = g.signer.MuSig2RegisterNonces(
+		ctx, id, [][musig2.PubNonceSize]byte{nonce},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to register nonce: %w", err)
+	}
+
+	participant.PubNonce = fn.Some(nonce)
+
+	return session.Copy(), nil
+}
+
+// Sign creates the partial signature of the local issuer, once the nonces of
+// all issuers are known. Calling Sign again returns the session with the
+// existing signature.
+func (g *GroupSessions) Sign(ctx context.Context,
+	id [32]byte) (*GroupSession, error) {
+
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	session, err := g.fetchSession(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !session.haveAllNonces() {
+		return nil, fmt.Errorf("group signing session %x is missing "+
+			"nonces", id[:])
+	}
+
+	local := session.localParticipant()
+	if len(local.PartialSig) != 0 {
+		return session.Copy(), nil
+	}
+
+	partialSig, err := g.signer.MuSig2Sign(ctx, id, session.SigHash, false)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign: %w", err)
+	}
+
+	local.PartialSig = partialSig
+
+	return session.Copy(), nil
+}
+
+// RegisterPartialSig registers the partial signature of the remote issuer
+// with the given key.
+func (g *GroupSessions) RegisterPartialSig(_ context.Context, id [32]byte,
+	signer *btcec.PublicKey, partialSig []byte) (*GroupSession, error) {
+
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	if len(partialSig) != 32 {
+		return nil, fmt.Errorf("invalid partial signature length %d",
+			len(partialSig))
+	}
+
+	session, err := g.fetchSession(id)
+	if err != nil {
+		return nil, err
+	}
+
+	participant, err := session.remoteParticipant(signer)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(participant.PartialSig) != 0 &&
+		!bytes.Equal(participant.PartialSig, partialSig) {
+
+		return nil, fmt.Errorf("issuer %x already contributed a "+
+			"different partial signature",
+			signer.SerializeCompressed())
+	}
+
+	participant.PartialSig = fn.CopySlice(partialSig)
+
+	return session.Copy(), nil
+}
+
+// Finalize combines the partial signatures of all issuers into the group
+// witness of the session. The witness can then be passed to SealBatch.
+func (g *GroupSessions) Finalize(ctx context.Context,
+	id [32]byte) (*asset.PendingGroupWitness, error) {
+
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	session, ok := g.sessions[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %x", ErrGroupSessionNotFound, id[:])
+	}
+
+	if !session.IsFinalized() {
+		var remoteSigs [][]byte
+		for _, participant := range session.Participants {
+			if participant.Local {
+				continue
+			}
+
+			if len(participant.PartialSig) == 0 {
+				return nil, fmt.Errorf("group signing session "+
+					"%x is missing partial signatures",
+					id[:])
+			}
+			remoteSigs = append(remoteSigs, participant.PartialSig)
+		}
+
+		if len(session.localParticipant().PartialSig) == 0 {
+			return nil, fmt.Errorf("group signing session %x "+
+				"wasn't signed locally", id[:])
+		}
+
+		haveAllSigs, rawSig, err := g.signer.MuSig2CombineSig(
+			ctx, id, remoteSigs,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to combine signatures: "+
+				"%w", err)
+		}
+		if !haveAllSigs {
+			return nil, fmt.Errorf("group signing session %x is "+
+				"missing partial signatures", id[:])
+		}
+
+		finalSig, err := schnorr.ParseSignature(rawSig)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse final "+
+				"signature: %w", err)
+		}
+
+		// A remote issuer could have contributed an invalid partial
+		// signature, which we only notice once they're combined.
+		if !finalSig.Verify(session.SigHash[:], session.GroupKey) {
+			return nil, fmt.Errorf("final signature of group "+
+				"signing session %x is invalid", id[:])
+		}
+
+		session.Witness = wire.TxWitness{finalSig.Serialize()}
+
+		log.Infof("Finalized group signing session %x", id[:])
+	}
+
+	return &asset.PendingGroupWitness{
+		GenID:   session.GenID,
+		Witness: fn.CopySlice(session.Witness),
+	}, nil
+}
+
+// AbortSession aborts the session with the given ID and removes it from lnd.
+func (g *GroupSessions) AbortSession(ctx context.Context, id [32]byte) error {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	if _, ok := g.sessions[id]; !ok {
+		return fmt.Errorf("%w: %x", ErrGroupSessionNotFound, id[:])
+	}
+	delete(g.sessions, id)
+
+	// The session might already be gone if lnd restarted, or if it was
+	// finalized.
+	if err := g.signer.MuSig2Cleanup(ctx, id); err != nil {
+		log.Debugf("Unable to clean up musig2 session %x: %v", id[:],
+			err)
+	}
+
+	return nil
+}
+
+// FetchSession returns the session with the given ID.
+func (g *GroupSessions) FetchSession(id [32]byte) (*GroupSession, error) {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	session, ok := g.sessions[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %x", ErrGroupSessionNotFound, id[:])
+	}
+
+	return session.Copy(), nil
+}
@@ -0,0 +1,256 @@
+package tapgarden_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/lightninglabs/lndclient"
+	tap "github.com/lightninglabs/taproot-assets"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/tapmusig"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc/signrpc"
+	"github.com/stretchr/testify/require"
+)
+
+// mockMuSig2Signer is a tapmusig.Signer that keeps its sessions in memory,
+// the same way lnd does.
+type mockMuSig2Signer struct {
+	manager *input.MusigSessionManager
+}
+
+// newMockMuSig2Signer creates a mock signer that signs with the given private
+// key.
+func newMockMuSig2Signer(privKey *btcec.PrivateKey) *mockMuSig2Signer {
+	return &mockMuSig2Signer{
+		manager: input.NewMusigSessionManager(
+			func(*keychain.KeyDescriptor) (*btcec.PrivateKey,
+				error) {
+
+				return privKey, nil
+			},
+		),
+	}
+}
+
+func (m *mockMuSig2Signer) MuSig2CreateSession(_ context.Context,
+	version input.MuSig2Version, signerLoc *keychain.KeyLocator,
+	signers [][]byte, opts ...lndclient.MuSig2SessionOpts) (
+	*input.MuSig2SessionInfo, error) {
+
+	var req signrpc.MuSig2SessionRequest
+	for _, opt := range opts {
+		opt(&req)
+	}
+
+	pubKeys, err := input.MuSig2ParsePubKeys(version, signers)
+	if err != nil {
+		return nil, err
+	}
+
+	var tweaks input.MuSig2Tweaks
+	for _, tweak := range req.Tweaks {
+		var tweakBytes [32]byte
+		copy(tweakBytes[:], tweak.Tweak)
+
+		tweaks.GenericTweaks = append(
+			tweaks.GenericTweaks, musig2.KeyTweakDesc{
+				Tweak:   tweakBytes,
+				IsXOnly: tweak.IsXOnly,
+			},
+		)
+	}
+
+	return m.manager.MuSig2CreateSession(
+		version, *signerLoc, pubKeys, &tweaks, nil, nil,
+	)
+}
+
+func (m *mockMuSig2Signer) MuSig2RegisterNonces(_ context.Context,
+	sessionID [32]byte, nonces [][musig2.PubNonceSize]byte) (bool,
+	error) {
+
+	return m.manager.MuSig2RegisterNonces(sessionID, nonces)
+}
+
+func (m *mockMuSig2Signer) MuSig2Sign(_ context.Context, sessionID [32]byte,
+	message [32]byte, cleanup bool) ([]byte, error) {
+
+	partialSig, err := m.manager.MuSig2Sign(sessionID, message, cleanup)
+	if err != nil {
+		return nil, err
+	}
+
+	sigBytes, err := input.SerializePartialSignature(partialSig)
+	if err != nil {
+		return nil, err
+	}
+
+	return sigBytes[:], nil
+}
+
+func (m *mockMuSig2Signer) MuSig2CombineSig(_ context.Context,
+	sessionID [32]byte, otherPartialSigs [][]byte) (bool, []byte, error) {
+
+	partialSigs := make([]*musig2.PartialSignature, len(otherPartialSigs))
+	for idx, sigBytes := range otherPartialSigs {
+		partialSig, err := input.DeserializePartialSignature(sigBytes)
+		if err != nil {
+			return false, nil, err
+		}
+		partialSigs[idx] = partialSig
+	}
+
+	finalSig, haveAllSigs, err := m.manager.MuSig2CombineSig(
+		sessionID, partialSigs,
+	)
+	if err != nil || !haveAllSigs {
+		return haveAllSigs, nil, err
+	}
+
+	return true, finalSig.Serialize(), nil
+}
+
+func (m *mockMuSig2Signer) MuSig2Cleanup(_ context.Context,
+	sessionID [32]byte) error {
+
+	return m.manager.MuSig2Cleanup(sessionID)
+}
+
+// TestGroupSessions tests that two issuers can create the group witness of a
+// seedling whose group key is their combined key.
+func TestGroupSessions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	localPriv, remotePriv := test.RandPrivKey(t), test.RandPrivKey(t)
+	localPub, remotePub := localPriv.PubKey(), remotePriv.PubKey()
+
+	// The keys are sorted in place by the MuSig2 implementation, so we
+	// refer to them by name below.
+	pubKeys := []*btcec.PublicKey{localPub, remotePub}
+
+	rawKey, err := asset.MuSig2GroupRawKey(pubKeys)
+	require.NoError(t, err)
+
+	gen := asset.RandGenesis(t, asset.Normal)
+	protoAsset := asset.RandAssetWithValues(
+		t, gen, nil, asset.RandScriptKey(t),
+	)
+	groupReq, err := asset.NewGroupKeyRequest(
+		test.PubToKeyDesc(rawKey), gen, protoAsset, nil,
+	)
+	require.NoError(t, err)
+
+	genTx, err := groupReq.BuildGroupVirtualTx(&tapscript.GroupTxBuilder{})
+	require.NoError(t, err)
+
+	group := &tapgarden.PendingAssetGroup{
+		GroupKeyRequest: *groupReq,
+		GroupVirtualTx:  *genTx,
+	}
+
+	sessions := tapgarden.NewGroupSessions(newMockMuSig2Signer(localPriv))
+	localKey := keychain.KeyDescriptor{PubKey: localPub}
+
+	// A session needs at least one remote issuer.
+	_, err = sessions.CreateSession(ctx, group, localKey, nil)
+	require.ErrorContains(t, err, "at least one remote issuer")
+
+	// The group key must be the combined key of the issuers.
+	otherKey := test.RandPubKey(t)
+	_, err = sessions.CreateSession(
+		ctx, group, localKey, []*btcec.PublicKey{otherKey},
+	)
+	require.ErrorIs(t, err, tapgarden.ErrGroupKeyMismatch)
+
+	session, err := sessions.CreateSession(
+		ctx, group, localKey, []*btcec.PublicKey{remotePub},
+	)
+	require.NoError(t, err)
+	require.Len(t, session.Participants, 2)
+
+	// The remote issuer signs with the btcec MuSig2 implementation
+	// directly.
+	tweaks, err := asset.MuSig2GroupKeyTweaks(rawKey, genTx.GenID, nil)
+	require.NoError(t, err)
+
+	muSigCtx, err := musig2.NewContext(
+		remotePriv, true, musig2.WithKnownSigners(pubKeys),
+		musig2.WithTweakedContext(tweaks...),
+	)
+	require.NoError(t, err)
+	remoteSession, err := muSigCtx.NewSession()
+	require.NoError(t, err)
+
+	localNonce := session.Participants[0].PubNonce.UnwrapOr(
+		[musig2.PubNonceSize]byte{},
+	)
+	_, err = remoteSession.RegisterPubNonce(localNonce)
+	require.NoError(t, err)
+
+	// The local issuer can't sign before all nonces are known.
+	_, err = sessions.Sign(ctx, session.ID)
+	require.ErrorContains(t, err, "missing nonces")
+
+	_, err = sessions.RegisterNonce(
+		ctx, session.ID, otherKey, remoteSession.PublicNonce(),
+	)
+	require.ErrorIs(t, err, tapmusig.ErrUnknownSigner)
+
+	_, err = sessions.RegisterNonce(
+		ctx, session.ID, remotePub, remoteSession.PublicNonce(),
+	)
+	require.NoError(t, err)
+
+	session, err = sessions.Sign(ctx, session.ID)
+	require.NoError(t, err)
+
+	remoteSig, err := remoteSession.Sign(session.SigHash)
+	require.NoError(t, err)
+
+	var sigBuf [32]byte
+	remoteSig.S.PutBytesUnchecked(sigBuf[:])
+	_, err = sessions.RegisterPartialSig(
+		ctx, session.ID, remotePub, sigBuf[:],
+	)
+	require.NoError(t, err)
+
+	witness, err := sessions.Finalize(ctx, session.ID)
+	require.NoError(t, err)
+	require.Equal(t, genTx.GenID, witness.GenID)
+	require.Len(t, witness.Witness, 1)
+
+	finalSig, err := schnorr.ParseSignature(witness.Witness[0])
+	require.NoError(t, err)
+	require.True(t, finalSig.Verify(session.SigHash[:], &genTx.TweakedKey))
+
+	// Finalizing again returns the same witness.
+	witnessAgain, err := sessions.Finalize(ctx, session.ID)
+	require.NoError(t, err)
+	require.Equal(t, witness, witnessAgain)
+
+	// The group key with the witness must be valid for the new asset.
+	groupKey, err := asset.AssembleGroupKeyFromWitness(
+		*genTx, *groupReq, nil, witness.Witness[0],
+	)
+	require.NoError(t, err)
+
+	groupedAsset, err := asset.New(
+		protoAsset.Genesis, protoAsset.Amount, protoAsset.LockTime,
+		protoAsset.RelativeLockTime, protoAsset.ScriptKey, groupKey,
+		asset.WithAssetVersion(protoAsset.Version),
+	)
+	require.NoError(t, err)
+
+	chainLookup := tapgarden.NewMockChainBridge().GenFileChainLookup(nil)
+	err = (&tap.ValidatorV0{}).Execute(groupedAsset, nil, nil, chainLookup)
+	require.NoError(t, err)
+}
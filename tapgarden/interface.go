@@ -49,10 +49,32 @@ type Planter interface {
 	// deriving all witnesses necessary to create the final genesis TX.
 	SealBatch(params SealParams) (*MintingBatch, error)
 
+	// GroupSessions returns the signing sessions for group witnesses of
+	// group keys that are shared between multiple issuers.
+	GroupSessions() *GroupSessions
+
 	// FinalizeBatch signals that the asset minter should finalize
 	// the current batch, if one exists.
 	FinalizeBatch(params FinalizeParams) (*MintingBatch, error)
 
+	// ScheduleBatch sets the schedule at which the current batch is
+	// finalized automatically. An empty schedule removes the current
+	// schedule.
+	ScheduleBatch(schedule BatchSchedule) error
+
+	// BatchSchedule returns the current schedule at which the current
+	// batch is finalized automatically, if there is one.
+	BatchSchedule() (fn.Option[BatchSchedule], error)
+
+	// PreviewBatch returns a dry-run of the finalization of the current
+	// batch, including the estimated chain fees of its genesis TX, without
+	// broadcasting anything.
+	PreviewBatch(params PreviewParams) (*BatchPreview, error)
+
+	// SubmitGenesisSigs submits the externally signed genesis TX of a
+	// finalized, externally funded batch, which is then broadcast.
+	SubmitGenesisSigs(params ExternalSigParams) (*MintingBatch, error)
+
 	// CancelBatch signals that the asset minter should cancel the
 	// current batch, if one exists.
 	CancelBatch() (*btcec.PublicKey, error)
@@ -278,6 +300,19 @@ type MintingStore interface {
 	// the genesis point for the batch.
 	CommitBatchTx(ctx context.Context, batchKey *btcec.PublicKey,
 		genesisTx *tapsend.FundedPsbt) error
+
+	// StoreBatchSchedule stores the schedule for finalizing the pending
+	// batch, replacing any existing schedule.
+	StoreBatchSchedule(ctx context.Context, schedule BatchSchedule) error
+
+	// FetchBatchSchedule fetches the stored schedule for finalizing the
+	// pending batch, if there is one.
+	FetchBatchSchedule(ctx context.Context) (fn.Option[BatchSchedule],
+		error)
+
+	// DeleteBatchSchedule removes the stored schedule for finalizing the
+	// pending batch.
+	DeleteBatchSchedule(ctx context.Context) error
 }
 
 // ChainBridge is our bridge to the target chain. It's used to get confirmation
@@ -309,8 +309,9 @@ type MockChainBridge struct {
 
 	NewBlocks chan int32
 
-	ReqCount int
-	ConfReqs map[int]*chainntnfs.ConfirmationEvent
+	ReqCount   int
+	ConfReqs   map[int]*chainntnfs.ConfirmationEvent
+	ReOrgChans map[int]chan struct{}
 
 	failFeeEstimates bool
 	emptyConf        bool
@@ -323,6 +324,7 @@ func NewMockChainBridge() *MockChainBridge {
 		FeeEstimateSignal: make(chan struct{}),
 		PublishReq:        make(chan *wire.MsgTx),
 		ConfReqs:          make(map[int]*chainntnfs.ConfirmationEvent),
+		ReOrgChans:        make(map[int]chan struct{}),
 		ConfReqSignal:     make(chan int),
 		BlockEpochSignal:  make(chan struct{}, 1),
 		NewBlocks:         make(chan int32),
@@ -361,7 +363,8 @@ func (m *MockChainBridge) SendConfNtfn(reqNo int, blockHash *chainhash.Hash,
 
 func (m *MockChainBridge) RegisterConfirmationsNtfn(ctx context.Context,
 	_ *chainhash.Hash, _ []byte, _, _ uint32, _ bool,
-	_ chan struct{}) (*chainntnfs.ConfirmationEvent, chan error, error) {
+	reOrgChan chan struct{}) (*chainntnfs.ConfirmationEvent, chan error,
+	error) {
 
 	select {
 	case <-ctx.Done():
@@ -380,6 +383,7 @@ func (m *MockChainBridge) RegisterConfirmationsNtfn(ctx context.Context,
 	m.confErr = make(chan error, 1)
 
 	m.ConfReqs[m.ReqCount] = req
+	m.ReOrgChans[m.ReqCount] = reOrgChan
 
 	select {
 	case m.ConfReqSignal <- m.ReqCount:
@@ -657,6 +661,20 @@ func (m *MockKeyRing) IsLocalKey(context.Context, keychain.KeyDescriptor) bool {
 	return true
 }
 
+func (m *MockKeyRing) DeriveSharedKey(_ context.Context,
+	ephemeralPubKey *btcec.PublicKey,
+	keyLocator *keychain.KeyLocator) ([32]byte, error) {
+
+	priv, ok := m.Keys[*keyLocator]
+	if !ok {
+		return [32]byte{}, fmt.Errorf("unknown key locator %v",
+			keyLocator)
+	}
+
+	ecdh := keychain.PrivKeyECDH{PrivKey: priv}
+	return ecdh.ECDH(ephemeralPubKey)
+}
+
 type MockGenSigner struct {
 	KeyRing *MockKeyRing
 }
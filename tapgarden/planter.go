@@ -14,8 +14,10 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/commitment"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapmusig"
 	"github.com/lightninglabs/taproot-assets/tapscript"
 	"github.com/lightninglabs/taproot-assets/tapsend"
 	"github.com/lightninglabs/taproot-assets/universe"
@@ -23,6 +25,12 @@ import (
 	"golang.org/x/exp/maps"
 )
 
+var (
+	// errPlanterShuttingDown is returned when the planter shuts down while
+	// waiting for a batch to be finalized.
+	errPlanterShuttingDown = errors.New("chain planter shutting down")
+)
+
 // GardenKit holds the set of shared fundamental interfaces all sub-systems of
 // the tapgarden need to function.
 type GardenKit struct {
@@ -55,6 +63,10 @@ type GardenKit struct {
 	// witness generation process.
 	GenTxBuilder asset.GenesisTxBuilder
 
+	// MuSig2Signer is used to sign group witnesses of group keys that are
+	// shared between multiple issuers.
+	MuSig2Signer tapmusig.Signer
+
 	// TxValidator is used to validate group witnesses when creating assets
 	// that support reissuance.
 	TxValidator tapscript.TxValidator
@@ -86,6 +98,11 @@ type PlanterConfig struct {
 	// critical errors to the main server.
 	ErrChan chan<- error
 
+	// BatchScheduleInterval is the interval at which the planter checks
+	// whether a scheduled batch is due to be finalized. If zero,
+	// DefaultBatchScheduleInterval is used.
+	BatchScheduleInterval time.Duration
+
 	// TODO(roasbeef): something notification related?
 }
 
@@ -159,6 +176,24 @@ type FinalizeParams struct {
 type FundParams struct {
 	FeeRate        fn.Option[chainfee.SatPerKWeight]
 	SiblingTapTree fn.Option[asset.TapscriptTreeNodes]
+
+	// ExternalPsbt is an optional genesis skeleton, as created by
+	// NewGenesisSkeleton, that was funded by an external wallet. If set,
+	// the backing wallet isn't used to fund or sign the genesis TX.
+	// Instead, the signatures are submitted with SubmitGenesisSigs once
+	// the batch is finalized.
+	ExternalPsbt fn.Option[*psbt.Packet]
+}
+
+// ExternalSigParams are the signatures of an externally funded batch.
+type ExternalSigParams struct {
+	// BatchKey is the key of the finalized batch the signatures are for.
+	BatchKey *btcec.PublicKey
+
+	// SignedPsbt is the genesis PSBT of the batch, as returned when
+	// finalizing the batch, with all inputs signed by the external
+	// wallet.
+	SignedPsbt *psbt.Packet
 }
 
 // SealParams change how asset groups in a minting batch are created.
@@ -218,6 +253,10 @@ const (
 	reqTypeCancelBatch
 	reqTypeFundBatch
 	reqTypeSealBatch
+	reqTypeSubmitGenesisSigs
+	reqTypeScheduleBatch
+	reqTypeBatchSchedule
+	reqTypePreviewBatch
 )
 
 // ChainPlanter is responsible for accepting new incoming requests to create
@@ -259,6 +298,15 @@ type ChainPlanter struct {
 	// subscriberMtx guards the subscribers map.
 	subscriberMtx sync.Mutex
 
+	// groupSessions are the signing sessions for group witnesses of group
+	// keys that are shared between multiple issuers.
+	groupSessions *GroupSessions
+
+	// batchSchedule is the optional schedule at which the pending batch is
+	// finalized automatically. The schedule is persisted, so it survives
+	// a restart, and is removed once the batch is finalized.
+	batchSchedule fn.Option[BatchSchedule]
+
 	// ContextGuard provides a wait group and main quit channel that can be
 	// used to create guarded contexts.
 	*fn.ContextGuard
@@ -273,6 +321,7 @@ func NewChainPlanter(cfg PlanterConfig) *ChainPlanter {
 		seedlingReqs:      make(chan *Seedling),
 		stateReqs:         make(chan stateRequest),
 		subscribers:       make(map[uint64]*fn.EventReceiver[fn.Event]),
+		groupSessions:     NewGroupSessions(cfg.MuSig2Signer),
 		ContextGuard: &fn.ContextGuard{
 			DefaultTimeout: DefaultTimeout,
 			Quit:           make(chan struct{}),
@@ -291,6 +340,8 @@ func (c *ChainPlanter) newCaretakerForBatch(batch *MintingBatch,
 		GardenKit:             c.cfg.GardenKit,
 		BroadcastCompleteChan: make(chan struct{}, 1),
 		BroadcastErrChan:      make(chan error, 1),
+		AwaitingSigsChan:      make(chan struct{}, 1),
+		ExternalSigReqs:       make(chan *externalSigReq),
 		SignalCompletion: func() {
 			c.completionSignals <- batchKey
 		},
@@ -406,6 +457,18 @@ func (c *ChainPlanter) Start() error {
 			}
 		}
 
+		// Any batch schedule that was set before our last restart
+		// applies to the next pending batch.
+		c.batchSchedule, err = c.cfg.Log.FetchBatchSchedule(ctx)
+		if err != nil {
+			startErr = fmt.Errorf("unable to fetch batch "+
+				"schedule: %w", err)
+			return
+		}
+		c.batchSchedule.WhenSome(func(schedule BatchSchedule) {
+			log.Infof("Restored batch schedule: %v", schedule)
+		})
+
 		// With all the caretakers for each minting batch launched,
 		// we'll start up the main gardener goroutine so we can accept
 		// new minting requests.
@@ -501,9 +564,7 @@ func (c *ChainPlanter) fundGenesisPsbt(ctx context.Context,
 
 	// Construct a 1-output TX as a template for our genesis TX, which the
 	// backing wallet will fund.
-	txTemplate := wire.NewMsgTx(2)
-	txTemplate.AddTxOut(tapsend.CreateDummyOutput())
-	genesisPkt, err := psbt.NewFromUnsignedTx(txTemplate)
+	genesisPkt, err := NewGenesisSkeleton()
 	if err != nil {
 		return nil, fmt.Errorf("unable to make psbt packet: %w", err)
 	}
@@ -946,6 +1007,67 @@ func (c *ChainPlanter) cancelMintingBatch(ctx context.Context,
 	return nil
 }
 
+// submitGenesisSigs hands the externally signed genesis TX of a batch to the
+// caretaker of the batch. The request is resolved once the caretaker broadcast
+// the genesis TX. An error is returned if the signatures can't be submitted.
+func (c *ChainPlanter) submitGenesisSigs(params ExternalSigParams,
+	req stateRequest) error {
+
+	if params.BatchKey == nil {
+		return fmt.Errorf("batch key must be set")
+	}
+
+	batchKey := asset.ToSerialized(params.BatchKey)
+	caretaker, ok := c.caretakers[batchKey]
+	if !ok {
+		return fmt.Errorf("no finalized batch with key %x",
+			batchKey[:])
+	}
+	if !caretaker.externallyFunded {
+		return ErrNotExternallyFunded
+	}
+
+	sigReq := &externalSigReq{
+		signedPkt: params.SignedPsbt,
+		errChan:   make(chan error, 1),
+	}
+	select {
+	case caretaker.cfg.ExternalSigReqs <- sigReq:
+	case <-caretaker.Quit:
+		return fmt.Errorf("caretaker for batch %x shutting down",
+			batchKey[:])
+	case <-c.Quit:
+		return fmt.Errorf("chain planter shutting down")
+	}
+
+	if err := <-sigReq.errChan; err != nil {
+		return fmt.Errorf("unable to apply external signatures: %w",
+			err)
+	}
+
+	// The caretaker now either broadcasts the genesis TX or fails to do
+	// so.
+	select {
+	case <-caretaker.cfg.BroadcastCompleteChan:
+		req.Resolve(caretaker.cfg.Batch)
+
+	case err := <-caretaker.cfg.BroadcastErrChan:
+		req.Error(err)
+
+		// Unrecoverable error, stop caretaker directly.
+		if stopErr := caretaker.Stop(); stopErr != nil {
+			log.Warnf("Unable to stop caretaker gracefully: %v",
+				stopErr)
+		}
+
+		delete(c.caretakers, batchKey)
+
+	case <-c.Quit:
+	}
+
+	return nil
+}
+
 // gardener is responsible for collecting new potential taproot asset
 // seeds/seedlings into a batch to ultimately be anchored in a genesis output
 // creating the assets from seedlings into sprouts, and eventually fully grown
@@ -959,6 +1081,13 @@ func (c *ChainPlanter) gardener() {
 
 	log.Infof("Gardener for ChainPlanter now active!")
 
+	scheduleInterval := c.cfg.BatchScheduleInterval
+	if scheduleInterval == 0 {
+		scheduleInterval = DefaultBatchScheduleInterval
+	}
+	scheduleTicker := time.NewTicker(scheduleInterval)
+	defer scheduleTicker.Stop()
+
 	for {
 		select {
 		// A request for new asset issuance just arrived, add this to
@@ -993,6 +1122,19 @@ func (c *ChainPlanter) gardener() {
 				NewState:     MintingStateSeed,
 			}
 
+			// The new seedling might be the one the batch schedule
+			// was waiting for.
+			if err := c.checkBatchSchedule(); err != nil {
+				return
+			}
+
+		// Check whether the scheduled finalization of the pending
+		// batch is due.
+		case <-scheduleTicker.C:
+			if err := c.checkBatchSchedule(); err != nil {
+				return
+			}
+
 		// A caretaker has finished processing their batch to full
 		// Taproot Asset maturity. We'll clean up our local state, and
 		// signal that it can exit.
@@ -1112,10 +1254,6 @@ func (c *ChainPlanter) gardener() {
 					break
 				}
 
-				batchKey := c.pendingBatch.BatchKey.PubKey
-				batchKeySerial := asset.ToSerialized(batchKey)
-				log.Infof("Finalizing batch %x", batchKeySerial)
-
 				finalizeReqParams, err :=
 					typedParam[FinalizeParams](req)
 				if err != nil {
@@ -1124,45 +1262,106 @@ func (c *ChainPlanter) gardener() {
 					break
 				}
 
-				caretaker, err := c.finalizeBatch(
+				batch, err := c.finalizePendingBatch(
 					*finalizeReqParams,
 				)
+				switch {
+				case errors.Is(err, errPlanterShuttingDown):
+					return
+
+				case err != nil:
+					req.Error(err)
+
+				default:
+					req.Resolve(batch)
+				}
+
+			case reqTypeScheduleBatch:
+				schedule, err := typedParam[BatchSchedule](req)
 				if err != nil {
-					freezeErr := fmt.Errorf("unable to "+
-						"freeze minting batch: %w", err)
-					c.cfg.ErrChan <- freezeErr
-					req.Error(freezeErr)
+					req.Error(fmt.Errorf("bad batch "+
+						"schedule: %w", err))
 					break
 				}
 
-				// We now wait for the caretaker to either
-				// broadcast the batch or fail to do so.
-				select {
-				case <-caretaker.cfg.BroadcastCompleteChan:
-					req.Resolve(caretaker.cfg.Batch)
+				// An empty schedule removes the current one.
+				if schedule.IsEmpty() {
+					log.Infof("Removing batch schedule")
+					err := c.removeBatchSchedule()
+					if err != nil {
+						req.Error(err)
+						break
+					}
 
-				case err := <-caretaker.cfg.BroadcastErrChan:
+					req.Resolve(true)
+					break
+				}
+
+				if err := schedule.Validate(); err != nil {
 					req.Error(err)
-					// Unrecoverable error, stop caretaker
-					// directly. The pending batch will not
-					// be saved.
-					stopErr := caretaker.Stop()
-					if stopErr != nil {
-						log.Warnf("Unable to stop "+
-							"caretaker "+
-							"gracefully: %v", err)
-					}
+					break
+				}
 
-					delete(c.caretakers, batchKeySerial)
+				log.Infof("Scheduling finalization of pending "+
+					"batch: %v", schedule)
 
-				case <-c.Quit:
+				ctx, cancel := c.WithCtxQuit()
+				err = c.cfg.Log.StoreBatchSchedule(
+					ctx, *schedule,
+				)
+				cancel()
+				if err != nil {
+					req.Error(fmt.Errorf("unable to store "+
+						"batch schedule: %w", err))
+					break
+				}
+
+				c.batchSchedule = fn.Some(*schedule)
+				req.Resolve(true)
+
+				// The schedule might already be due.
+				if err := c.checkBatchSchedule(); err != nil {
 					return
 				}
 
-				// Now that we have a caretaker launched for
-				// this batch and broadcast its minting
-				// transaction, we can remove the pending batch.
-				c.pendingBatch = nil
+			case reqTypeBatchSchedule:
+				req.Resolve(c.batchSchedule)
+
+			case reqTypePreviewBatch:
+				previewParams, err :=
+					typedParam[PreviewParams](req)
+				if err != nil {
+					req.Error(fmt.Errorf("bad preview "+
+						"params: %w", err))
+					break
+				}
+
+				ctx, cancel := c.WithCtxQuit()
+				preview, err := c.previewBatch(
+					ctx, *previewParams, c.pendingBatch,
+				)
+				cancel()
+				if err != nil {
+					req.Error(fmt.Errorf("unable to preview "+
+						"minting batch: %w", err))
+					break
+				}
+
+				req.Resolve(preview)
+
+			case reqTypeSubmitGenesisSigs:
+				sigParams, err :=
+					typedParam[ExternalSigParams](req)
+				if err != nil {
+					req.Error(fmt.Errorf("bad external "+
+						"sig params: %w", err))
+					break
+				}
+
+				err = c.submitGenesisSigs(*sigParams, req)
+				if err != nil {
+					req.Error(err)
+				}
 
 			case reqTypeCancelBatch:
 				batchKey, err := c.canCancelBatch()
@@ -1189,6 +1388,138 @@ func (c *ChainPlanter) gardener() {
 	}
 }
 
+// finalizePendingBatch finalizes the pending batch and waits for its caretaker
+// to either broadcast the genesis transaction or fail to do so. An externally
+// funded batch is returned as soon as its genesis TX is ready to be signed.
+// If the planter shuts down while waiting, errPlanterShuttingDown is returned.
+func (c *ChainPlanter) finalizePendingBatch(
+	params FinalizeParams) (*MintingBatch, error) {
+
+	batchKey := c.pendingBatch.BatchKey.PubKey
+	batchKeySerial := asset.ToSerialized(batchKey)
+	log.Infof("Finalizing batch %x", batchKeySerial)
+
+	caretaker, err := c.finalizeBatch(params)
+	if err != nil {
+		freezeErr := fmt.Errorf("unable to freeze minting batch: %w",
+			err)
+		c.cfg.ErrChan <- freezeErr
+		return nil, freezeErr
+	}
+
+	// We now wait for the caretaker to either broadcast the batch or fail
+	// to do so.
+	var finalizeErr error
+	select {
+	case <-caretaker.cfg.BroadcastCompleteChan:
+	case <-caretaker.cfg.AwaitingSigsChan:
+
+	case err := <-caretaker.cfg.BroadcastErrChan:
+		finalizeErr = err
+
+		// Unrecoverable error, stop caretaker directly. The pending
+		// batch will not be saved.
+		stopErr := caretaker.Stop()
+		if stopErr != nil {
+			log.Warnf("Unable to stop caretaker gracefully: %v",
+				err)
+		}
+
+		delete(c.caretakers, batchKeySerial)
+
+	case <-c.Quit:
+		return nil, errPlanterShuttingDown
+	}
+
+	// Now that we have a caretaker launched for this batch and broadcast
+	// its minting transaction, we can remove the pending batch. Any
+	// schedule was meant for this batch, so it is removed as well.
+	c.pendingBatch = nil
+	if err := c.removeBatchSchedule(); err != nil {
+		log.Errorf("Unable to remove batch schedule: %v", err)
+	}
+
+	if finalizeErr != nil {
+		return nil, finalizeErr
+	}
+
+	return caretaker.cfg.Batch, nil
+}
+
+// checkBatchSchedule finalizes the pending batch if its schedule is due. Only
+// errPlanterShuttingDown is returned, any other error while finalizing the
+// batch is logged and removes the schedule.
+func (c *ChainPlanter) checkBatchSchedule() error {
+	if c.batchSchedule.IsNone() {
+		return nil
+	}
+	schedule := c.batchSchedule.UnwrapOr(BatchSchedule{})
+
+	// An empty batch is never finalized, the schedule stays in place
+	// until the batch has at least one seedling.
+	if c.pendingBatch == nil || !c.pendingBatch.HasSeedlings() {
+		return nil
+	}
+
+	var (
+		height uint32
+		err    error
+	)
+	if schedule.Height.IsSome() {
+		ctx, cancel := c.WithCtxQuit()
+		height, err = c.cfg.ChainBridge.CurrentHeight(ctx)
+		cancel()
+		if err != nil {
+			log.Warnf("Unable to fetch current height for batch "+
+				"schedule: %v", err)
+			return nil
+		}
+	}
+
+	numSeedlings := len(c.pendingBatch.Seedlings)
+	if !schedule.isDue(height, time.Now(), numSeedlings) {
+		return nil
+	}
+
+	log.Infof("Batch schedule (%v) is due, finalizing pending batch with "+
+		"%d seedlings", schedule, numSeedlings)
+
+	_, err = c.finalizePendingBatch(FinalizeParams{
+		FeeRate:        schedule.FeeRate,
+		SiblingTapTree: fn.None[asset.TapscriptTreeNodes](),
+	})
+	switch {
+	case errors.Is(err, errPlanterShuttingDown):
+		return err
+
+	case err != nil:
+		log.Errorf("Unable to finalize scheduled batch: %v", err)
+		if err := c.removeBatchSchedule(); err != nil {
+			log.Errorf("Unable to remove batch schedule: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// removeBatchSchedule removes the schedule of the pending batch, both in
+// memory and on disk.
+func (c *ChainPlanter) removeBatchSchedule() error {
+	if c.batchSchedule.IsNone() {
+		return nil
+	}
+
+	ctx, cancel := c.WithCtxQuit()
+	defer cancel()
+	if err := c.cfg.Log.DeleteBatchSchedule(ctx); err != nil {
+		return fmt.Errorf("unable to delete batch schedule: %w", err)
+	}
+
+	c.batchSchedule = fn.None[BatchSchedule]()
+
+	return nil
+}
+
 // fundBatch attempts to fund a minting batch and create a funded genesis PSBT.
 // This PSBT is a template that the caretaker will modify when finalizing the
 // batch. If a feerate or tapscript sibling are provided, those will be used
@@ -1204,10 +1535,17 @@ func (c *ChainPlanter) fundBatch(ctx context.Context, params FundParams,
 		err      error
 	)
 
+	// An externally funded batch already pays its fees, so a fee rate
+	// can't be applied.
+	feeRate = params.FeeRate.UnwrapToPtr()
+	if feeRate != nil && params.ExternalPsbt.IsSome() {
+		return fmt.Errorf("cannot provide fee rate for externally " +
+			"funded batch")
+	}
+
 	// If a tapscript tree was specified for this batch, we'll store it on
 	// disk. The caretaker we start for this batch will use it when deriving
 	// the final Taproot output key.
-	feeRate = params.FeeRate.UnwrapToPtr()
 	params.SiblingTapTree.WhenSome(func(tn asset.TapscriptTreeNodes) {
 		rootHash, err = c.cfg.TreeStore.StoreTapscriptTree(ctx, tn)
 	})
@@ -1219,13 +1557,35 @@ func (c *ChainPlanter) fundBatch(ctx context.Context, params FundParams,
 
 	// Update the batch by adding the sibling root hash and genesis TX.
 	updateBatch := func(batch *MintingBatch) error {
-		// Add the batch sibling root hash if present.
+		// Add the batch sibling root hash if present. The seedlings
+		// of the batch may already have contributed to the sibling,
+		// in which case the trees are merged.
 		if rootHash != nil {
-			batch.tapSibling = rootHash
+			err := c.mergeBatchTapSibling(ctx, batch, *rootHash)
+			if err != nil {
+				return err
+			}
 		}
 
-		// Fund the batch with the specified fee rate.
+		// Use the externally funded genesis TX if there is one,
+		// otherwise fund the batch with the specified fee rate.
 		batchKey := asset.ToSerialized(batch.BatchKey.PubKey)
+		if params.ExternalPsbt.IsSome() {
+			batchTX, err := verifyExternalFunding(
+				params.ExternalPsbt.UnwrapOr(nil),
+			)
+			if err != nil {
+				return fmt.Errorf("invalid external funding "+
+					"for batch %x: %w", batchKey[:], err)
+			}
+
+			log.Infof("Externally funded batch: %x", batchKey[:])
+
+			batch.GenesisPacket = batchTX
+
+			return nil
+		}
+
 		batchTX, err := c.fundGenesisPsbt(ctx, batchKey, feeRate)
 		if err != nil {
 			return fmt.Errorf("unable to fund minting PSBT for "+
@@ -1269,9 +1629,10 @@ func (c *ChainPlanter) fundBatch(ctx context.Context, params FundParams,
 	}
 
 	// Write the associated sibling root hash and TX to disk.
-	if workingBatch.tapSibling != nil {
+	if rootHash != nil {
 		err = c.cfg.Log.CommitBatchTapSibling(
-			ctx, workingBatch.BatchKey.PubKey, rootHash,
+			ctx, workingBatch.BatchKey.PubKey,
+			workingBatch.tapSibling,
 		)
 		if err != nil {
 			return fmt.Errorf("unable to commit tapscript "+
@@ -1516,7 +1877,11 @@ func (c *ChainPlanter) finalizeBatch(params FinalizeParams) (*BatchCaretaker,
 		// clear the pending batch. The batch will exist on disk for
 		// the user to recreate it if necessary.
 		// TODO(jhb): Don't clear pending batch here
-		err = c.fundBatch(ctx, FundParams(params), c.pendingBatch)
+		fundParams := FundParams{
+			FeeRate:        params.FeeRate,
+			SiblingTapTree: params.SiblingTapTree,
+		}
+		err = c.fundBatch(ctx, fundParams, c.pendingBatch)
 		if err != nil {
 			c.pendingBatch = nil
 			return nil, err
@@ -1595,6 +1960,13 @@ func (c *ChainPlanter) FundBatch(params FundParams) (*MintingBatch, error) {
 	return <-req.resp, <-req.err
 }
 
+// GroupSessions returns the signing sessions for group witnesses of group keys
+// that are shared between multiple issuers. The witnesses produced by the
+// sessions are passed to SealBatch.
+func (c *ChainPlanter) GroupSessions() *GroupSessions {
+	return c.groupSessions
+}
+
 // SealBatch attempts to seal the current batch, by providing or deriving all
 // witnesses necessary to create the final genesis TX.
 func (c *ChainPlanter) SealBatch(params SealParams) (*MintingBatch, error) {
@@ -1620,6 +1992,65 @@ func (c *ChainPlanter) FinalizeBatch(params FinalizeParams) (*MintingBatch,
 	return <-req.resp, <-req.err
 }
 
+// ScheduleBatch sets the schedule at which the pending batch is finalized
+// automatically, replacing any previous schedule. An empty schedule removes
+// the current schedule. The schedule is persisted, so it is restored after a
+// restart.
+func (c *ChainPlanter) ScheduleBatch(schedule BatchSchedule) error {
+	req := newStateParamReq[bool](reqTypeScheduleBatch, schedule)
+
+	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return fmt.Errorf("chain planter shutting down")
+	}
+
+	<-req.resp
+	return <-req.err
+}
+
+// BatchSchedule returns the current schedule at which the pending batch is
+// finalized automatically, if there is one.
+func (c *ChainPlanter) BatchSchedule() (fn.Option[BatchSchedule], error) {
+	req := newStateReq[fn.Option[BatchSchedule]](reqTypeBatchSchedule)
+
+	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return fn.None[BatchSchedule](), fmt.Errorf("chain planter " +
+			"shutting down")
+	}
+
+	return <-req.resp, nil
+}
+
+// PreviewBatch returns the estimated size, chain fees and genesis outpoint of
+// the genesis TX of the current batch, without finalizing or broadcasting it.
+func (c *ChainPlanter) PreviewBatch(params PreviewParams) (*BatchPreview,
+	error) {
+
+	req := newStateParamReq[*BatchPreview](reqTypePreviewBatch, params)
+
+	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return nil, fmt.Errorf("chain planter shutting down")
+	}
+
+	return <-req.resp, <-req.err
+}
+
+// SubmitGenesisSigs sends the externally signed genesis TX of a finalized,
+// externally funded batch to the planter. The batch is returned once its
+// genesis TX was broadcast.
+func (c *ChainPlanter) SubmitGenesisSigs(params ExternalSigParams) (
+	*MintingBatch, error) {
+
+	req := newStateParamReq[*MintingBatch](
+		reqTypeSubmitGenesisSigs, params,
+	)
+
+	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return nil, fmt.Errorf("chain planter shutting down")
+	}
+
+	return <-req.resp, <-req.err
+}
+
 // CancelBatch sends a signal to the planter to cancel the current batch.
 func (c *ChainPlanter) CancelBatch() (*btcec.PublicKey, error) {
 	req := newStateReq[*btcec.PublicKey](reqTypeCancelBatch)
@@ -1739,6 +2170,17 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 		req.ScriptKey = asset.NewScriptKeyBip86(scriptKey)
 	}
 
+	// A tapscript sibling of the seedling must be a valid sibling for the
+	// genesis output, so it can't be a Taproot Asset commitment.
+	var siblingErr error
+	req.SiblingTapTree.WhenSome(func(tn asset.TapscriptTreeNodes) {
+		_, siblingErr = commitment.NewPreimageFromTapscriptTreeNodes(tn)
+	})
+	if siblingErr != nil {
+		return fmt.Errorf("invalid tapscript sibling for seedling "+
+			"%s: %w", req.AssetName, siblingErr)
+	}
+
 	// Now that we know the seedling is valid, we'll check to see if a batch
 	// already exists.
 	switch {
@@ -1756,6 +2198,11 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 
 		ctx, cancel := c.WithCtxQuit()
 		defer cancel()
+		err = c.mergeSeedlingTapSibling(ctx, newBatch, req)
+		if err != nil {
+			return err
+		}
+
 		err = c.cfg.Log.CommitMintingBatch(ctx, newBatch)
 		if err != nil {
 			return err
@@ -1779,6 +2226,24 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 		if err != nil {
 			return err
 		}
+
+		if req.SiblingTapTree.IsNone() {
+			break
+		}
+
+		err = c.mergeSeedlingTapSibling(ctx, c.pendingBatch, req)
+		if err != nil {
+			return err
+		}
+
+		err = c.cfg.Log.CommitBatchTapSibling(
+			ctx, c.pendingBatch.BatchKey.PubKey,
+			c.pendingBatch.tapSibling,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to commit tapscript "+
+				"sibling for minting batch %w", err)
+		}
 	}
 
 	// Now that we have the batch committed to disk, we'll return back to
@@ -1787,6 +2252,57 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 	return nil
 }
 
+// mergeSeedlingTapSibling stores the optional tapscript sibling of the given
+// seedling and merges it into the tapscript sibling of the batch.
+func (c *ChainPlanter) mergeSeedlingTapSibling(ctx context.Context,
+	batch *MintingBatch, seedling *Seedling) error {
+
+	var (
+		rootHash *chainhash.Hash
+		err      error
+	)
+	seedling.SiblingTapTree.WhenSome(func(tn asset.TapscriptTreeNodes) {
+		rootHash, err = c.cfg.TreeStore.StoreTapscriptTree(ctx, tn)
+	})
+	if err != nil {
+		return fmt.Errorf("unable to store tapscript tree for "+
+			"seedling %s: %w", seedling.AssetName, err)
+	}
+
+	if rootHash == nil {
+		return nil
+	}
+
+	return c.mergeBatchTapSibling(ctx, batch, *rootHash)
+}
+
+// mergeBatchTapSibling commits to the tapscript tree with the given root hash
+// in the tapscript sibling of the batch. If the batch already has a sibling,
+// both trees become the children of a new branch, so the spend conditions of
+// both remain available. The new branch is stored in the tree store, so the
+// sibling of the batch can always be loaded from it.
+func (c *ChainPlanter) mergeBatchTapSibling(ctx context.Context,
+	batch *MintingBatch, rootHash chainhash.Hash) error {
+
+	if batch.tapSibling == nil {
+		batch.tapSibling = &rootHash
+		return nil
+	}
+
+	mergedTree := asset.TapTreeNodesFromBranchHashes(
+		*batch.tapSibling, rootHash,
+	)
+	mergedHash, err := c.cfg.TreeStore.StoreTapscriptTree(ctx, mergedTree)
+	if err != nil {
+		return fmt.Errorf("unable to store merged tapscript tree for "+
+			"minting batch: %w", err)
+	}
+
+	batch.tapSibling = mergedHash
+
+	return nil
+}
+
 // updateMintingProofs is called by the re-org watcher when it detects a re-org
 // and has updated the minting proofs. This cannot be done by the caretaker
 // itself, because its job is already done at the point that a re-org can happen
@@ -1547,6 +1547,261 @@ func testFinalizeWithTapscriptTree(t *mintingTestHarness) {
 	t.assertMintOutputKey(batchWithSibling, siblingHash)
 }
 
+func testFinalizeWithSeedlingTapSiblings(t *mintingTestHarness) {
+	// First, create a new chain planter instance using the supplied test
+	// harness.
+	t.refreshChainPlanter()
+
+	var (
+		wg       sync.WaitGroup
+		respChan = make(chan *FinalizeBatchResp, 1)
+	)
+
+	// Two of the seedlings get their own tapscript sibling, the third one
+	// doesn't have any custom spend conditions.
+	sigLockKey := test.RandPubKey(t)
+	hashLockLeaf := test.ScriptHashLock(t.T, []byte("foobar"))
+	sigLeaf := test.ScriptSchnorrSig(t.T, sigLockKey)
+	firstTree, err := asset.TapTreeNodesFromLeaves(
+		[]txscript.TapLeaf{hashLockLeaf, sigLeaf},
+	)
+	require.NoError(t, err)
+	secondTree, err := asset.TapTreeNodesFromLeaves(
+		[]txscript.TapLeaf{test.ScriptSchnorrSig(t.T, sigLockKey)},
+	)
+	require.NoError(t, err)
+
+	seedlings := t.newRandSeedlings(3)
+	seedlings[0].SiblingTapTree = fn.Some(*firstTree)
+	seedlings[1].SiblingTapTree = fn.Some(*secondTree)
+	t.queueSeedlingsInBatch(false, seedlings...)
+	t.assertPendingBatchExists(3)
+
+	// A seedling with a sibling that is a Taproot Asset commitment is
+	// rejected, as the genesis output may only commit to one.
+	dummyLeaf := txscript.NewBaseTapLeaf(bytes.Join([][]byte{
+		{byte(asset.V0)}, commitment.TaprootAssetsMarker[:],
+		fn.ByteSlice(test.RandHash()), make([]byte, 8),
+	}, nil))
+	dummyTree, err := asset.TapTreeNodesFromLeaves(
+		[]txscript.TapLeaf{dummyLeaf},
+	)
+	require.NoError(t, err)
+
+	invalidSeedling := t.newRandSeedlings(1)[0]
+	invalidSeedling.EnableEmission = false
+	invalidSeedling.SiblingTapTree = fn.Some(*dummyTree)
+	updates, err := t.planter.QueueNewSeedling(invalidSeedling)
+	require.NoError(t, err)
+	t.assertKeyDerived()
+	update, err := fn.RecvOrTimeout(updates, defaultTimeout)
+	require.NoError(t, err)
+	require.ErrorContains(
+		t, update.Error, "preimage is a Taproot Asset commitment",
+	)
+	t.assertPendingBatchExists(3)
+
+	// The batch commits to both trees in its sibling, which is a branch
+	// with the trees of the two seedlings as its children.
+	treeHash := func(tn *asset.TapscriptTreeNodes) chainhash.Hash {
+		preimage, err := commitment.NewPreimageFromTapscriptTreeNodes(
+			*tn,
+		)
+		require.NoError(t, err)
+
+		hash, err := preimage.TapHash()
+		require.NoError(t, err)
+
+		return *hash
+	}
+	siblingPreimage, err := commitment.NewPreimageFromTapscriptTreeNodes(
+		asset.TapTreeNodesFromBranchHashes(
+			treeHash(firstTree), treeHash(secondTree),
+		),
+	)
+	require.NoError(t, err)
+
+	t.finalizeBatch(&wg, respChan, nil)
+	sendConfNtfn := t.progressCaretaker(false, siblingPreimage, nil)
+	sendConfNtfn()
+
+	batchWithSibling := t.assertFinalizeBatch(&wg, respChan, "")
+	require.NotNil(t, batchWithSibling)
+	t.assertNoError()
+	t.assertNoPendingBatch()
+
+	siblingHash, err := siblingPreimage.TapHash()
+	require.NoError(t, err)
+	t.assertMintOutputKey(batchWithSibling, siblingHash)
+}
+
+// testFinalizeBatchSchedule tests that a batch is finalized automatically once
+// its schedule is due.
+func testFinalizeBatchSchedule(t *mintingTestHarness) {
+	// First, create a new chain planter instance using the supplied test
+	// harness.
+	t.refreshChainPlanter()
+
+	// A schedule with an invalid number of seedlings is rejected.
+	require.ErrorContains(t, t.planter.ScheduleBatch(
+		tapgarden.BatchSchedule{
+			MinSeedlings: fn.Some(0),
+		},
+	), "invalid minimum number of seedlings")
+
+	// Schedule the batch to be finalized once it has three seedlings.
+	schedule := tapgarden.BatchSchedule{
+		MinSeedlings: fn.Some(3),
+	}
+	require.NoError(t, t.planter.ScheduleBatch(schedule))
+
+	// The schedule is persisted, so it survives a restart of the planter.
+	t.refreshChainPlanter()
+
+	currentSchedule, err := t.planter.BatchSchedule()
+	require.NoError(t, err)
+	require.Equal(t, fn.Some(schedule), currentSchedule)
+
+	// With only two seedlings, the batch stays pending.
+	_ = t.queueInitialBatch(2)
+	t.assertPendingBatchExists(2)
+
+	// The third seedling triggers the finalization of the batch.
+	t.queueSeedlingsInBatch(true, t.newRandSeedlings(1)...)
+	sendConfNtfn := t.progressCaretaker(false, nil, nil)
+	sendConfNtfn()
+
+	t.assertNoError()
+	t.assertNoPendingBatch()
+
+	currentSchedule, err = t.planter.BatchSchedule()
+	require.NoError(t, err)
+	require.True(t, currentSchedule.IsNone())
+
+	// The schedule was removed together with the batch, so a new batch
+	// isn't finalized automatically. An empty schedule removes a schedule
+	// before it is due.
+	require.NoError(t, t.planter.ScheduleBatch(tapgarden.BatchSchedule{
+		MinSeedlings: fn.Some(3),
+	}))
+	require.NoError(t, t.planter.ScheduleBatch(tapgarden.BatchSchedule{}))
+
+	_ = t.queueInitialBatch(3)
+	t.assertPendingBatchExists(3)
+
+	// A schedule that is already due finalizes the pending batch right
+	// away.
+	require.NoError(t, t.planter.ScheduleBatch(tapgarden.BatchSchedule{
+		Time: fn.Some(time.Now().Add(-time.Minute)),
+	}))
+	sendConfNtfn = t.progressCaretaker(false, nil, nil)
+	sendConfNtfn()
+
+	t.assertNoError()
+	t.assertNoPendingBatch()
+}
+
+func testPreviewBatch(t *mintingTestHarness) {
+	// First, create a new chain planter instance using the supplied test
+	// harness.
+	t.refreshChainPlanter()
+
+	// Without a pending batch, there is nothing to preview.
+	_, err := t.planter.PreviewBatch(tapgarden.PreviewParams{})
+	require.ErrorContains(t, err, "no pending batch")
+
+	seedlings := t.queueInitialBatch(3)
+	t.assertPendingBatchExists(3)
+
+	previewBatch := func(
+		params tapgarden.PreviewParams) chan *tapgarden.BatchPreview {
+
+		previewChan := make(chan *tapgarden.BatchPreview, 1)
+		go func() {
+			preview, err := t.planter.PreviewBatch(params)
+			if err != nil {
+				t.Errorf("unable to preview batch: %v", err)
+			}
+
+			previewChan <- preview
+		}()
+
+		return previewChan
+	}
+
+	// Previewing the unfunded batch funds a temporary genesis TX with the
+	// given fee rate.
+	manualFee := chainfee.SatPerKWeight(2000)
+	previewChan := previewBatch(tapgarden.PreviewParams{
+		FeeRate: fn.Some(manualFee),
+	})
+	t.assertGenesisTxFunded(&manualFee)
+
+	preview, err := fn.RecvOrTimeout(previewChan, defaultTimeout)
+	require.NoError(t, err)
+
+	// Our genesis TX in unit tests is always 1 P2TR in, 1 P2TR out &
+	// 1 P2WSH out. This has a fixed size of 155 vB.
+	const mintTxSize = 155
+	require.False(t, (*preview).Final)
+	require.Equal(t, manualFee, (*preview).FeeRate)
+	require.Equal(t, mintTxSize, (*preview).VSize)
+	require.Equal(
+		t, manualFee.FeePerKVByte().FeeForVSize(mintTxSize),
+		(*preview).ChainFees,
+	)
+	require.Len(t, (*preview).Assets, len(seedlings))
+
+	// The preview must not have funded the pending batch.
+	pendingBatch, err := t.planter.PendingBatch()
+	require.NoError(t, err)
+	require.False(t, pendingBatch.IsFunded())
+
+	// Once the batch is funded, the preview is based on its actual genesis
+	// TX and is final.
+	var (
+		wg       sync.WaitGroup
+		respChan = make(chan *FundBatchResp, 1)
+	)
+	t.fundBatch(&wg, respChan, &tapgarden.FundParams{
+		FeeRate: fn.Some(manualFee),
+	})
+	fundedPkt := t.assertGenesisTxFunded(&manualFee)
+	fundedBatch := t.assertFundBatch(&wg, respChan, "")
+
+	previewChan = previewBatch(tapgarden.PreviewParams{})
+	_, err = fn.RecvOrTimeout(t.chain.FeeEstimateSignal, defaultTimeout)
+	require.NoError(t, err)
+
+	preview, err = fn.RecvOrTimeout(previewChan, defaultTimeout)
+	require.NoError(t, err)
+
+	genesisPoint := fundedPkt.Pkt.UnsignedTx.TxIn[0].PreviousOutPoint
+	require.True(t, (*preview).Final)
+	require.Equal(t, chainfee.FeePerKwFloor, (*preview).FeeRate)
+	require.True(
+		t, fundedBatch.BatchKey.PubKey.IsEqual((*preview).BatchKey),
+	)
+	require.Equal(t, genesisPoint, (*preview).GenesisPoint)
+	require.Equal(t, mintTxSize, (*preview).VSize)
+
+	for _, assetPreview := range (*preview).Assets {
+		seedling := fundedBatch.Seedlings[assetPreview.AssetName]
+		require.NotNil(t, seedling)
+
+		assetGen := asset.Genesis{
+			FirstPrevOut: genesisPoint,
+			Tag:          seedling.AssetName,
+			OutputIndex:  (*preview).AnchorOutputIndex,
+			Type:         seedling.AssetType,
+		}
+		if seedling.Meta != nil {
+			assetGen.MetaHash = seedling.Meta.MetaHash()
+		}
+		require.Equal(t, assetGen.ID(), assetPreview.AssetID)
+	}
+}
+
 func testFundSealBeforeFinalize(t *mintingTestHarness) {
 	// First, create a new chain planter instance using the supplied test
 	// harness.
@@ -1808,6 +2063,18 @@ var testCases = []mintingStoreTestCase{
 		name:     "finalize_with_tapscript_tree",
 		testFunc: testFinalizeWithTapscriptTree,
 	},
+	{
+		name:     "finalize_with_seedling_tap_siblings",
+		testFunc: testFinalizeWithSeedlingTapSiblings,
+	},
+	{
+		name:     "finalize_batch_schedule",
+		testFunc: testFinalizeBatchSchedule,
+	},
+	{
+		name:     "preview_batch",
+		testFunc: testPreviewBatch,
+	},
 	{
 		name:     "fund_seal_before_finalize",
 		testFunc: testFundSealBeforeFinalize,
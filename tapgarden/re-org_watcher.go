@@ -35,6 +35,10 @@ type proofRegistration struct {
 type anchorTxNotification struct {
 	proofsRegistrations []*proofRegistration
 
+	// reOrged is true if the anchor transaction was re-organized out of
+	// the chain and wasn't included in a new block yet.
+	reOrged bool
+
 	cancel context.CancelFunc
 }
 
@@ -55,6 +59,19 @@ func (a *anchorTxNotification) firstRegistration() *proofRegistration {
 	return a.proofsRegistrations[0]
 }
 
+// AnchorReOrgNotifier is notified about anchor transactions of watched proofs
+// that were re-organized out of the chain and later included in a new block.
+type AnchorReOrgNotifier interface {
+	// AnchorReOrged is called when the given anchor transaction was
+	// re-organized out of the chain.
+	AnchorReOrged(txHash chainhash.Hash)
+
+	// AnchorReConfirmed is called when the given anchor transaction was
+	// included in a new block after a re-org and the affected proofs were
+	// updated.
+	AnchorReConfirmed(txHash chainhash.Hash)
+}
+
 // ReOrgWatcherConfig houses all the items that the re-org watcher needs to
 // carry out its duties.
 type ReOrgWatcherConfig struct {
@@ -79,6 +96,11 @@ type ReOrgWatcherConfig struct {
 	// consider a transaction to be safely buried in the chain.
 	SafeDepth int32
 
+	// ReOrgNotifier is the optional notifier that is informed about
+	// anchor transactions that were re-organized out of the chain and
+	// confirmed again.
+	ReOrgNotifier AnchorReOrgNotifier
+
 	// ErrChan is the main error channel the watcher will report back
 	// critical errors to the main server.
 	ErrChan chan<- error
@@ -97,6 +119,7 @@ type ReOrgWatcher struct {
 
 	incomingProofs chan *proofRegistration
 	incomingConfs  chan *chainntnfs.TxConfirmation
+	incomingReOrgs chan chainhash.Hash
 
 	// pendingProofs is a list of all proofs that are currently being
 	// watched for re-orgs, keyed by their anchor transaction hash.
@@ -113,6 +136,7 @@ func NewReOrgWatcher(cfg *ReOrgWatcherConfig) *ReOrgWatcher {
 		cfg:            cfg,
 		incomingProofs: make(chan *proofRegistration),
 		incomingConfs:  make(chan *chainntnfs.TxConfirmation),
+		incomingReOrgs: make(chan chainhash.Hash),
 		pendingProofs:  make(map[chainhash.Hash]*anchorTxNotification),
 		ContextGuard: &fn.ContextGuard{
 			DefaultTimeout: DefaultTimeout,
@@ -272,10 +296,15 @@ func (w *ReOrgWatcher) waitForConf(ctx context.Context, txHash chainhash.Hash,
 
 				// We continue to watch the transaction until
 				// it reaches a safe confirmation depth. We
-				// basically only use this signal to log the
-				// message above. We expect another confirmation
-				// to come in once the transaction is included
-				// in a new block in the re-organized chain.
+				// expect another confirmation to come in once
+				// the transaction is included in a new block in
+				// the re-organized chain. Until then, the main
+				// loop marks the proofs as unconfirmed and
+				// re-broadcasts the transaction.
+				select {
+				case w.incomingReOrgs <- txHash:
+				case <-w.Quit:
+				}
 
 			case err := <-errChan:
 				if !fn.IsRpcErr(
@@ -340,11 +369,66 @@ func (w *ReOrgWatcher) updateProofs(proofNtfn *anchorTxNotification,
 			log.Error(err.Error())
 			return err
 		}
+
+		// The proofs are now anchored in the new block, which is what
+		// we need to compare future confirmations and the safe depth
+		// against.
+		r.blockHash = *conf.BlockHash
+		r.blockHeight = int32(conf.BlockHeight)
 	}
 
 	return nil
 }
 
+// handleReOrg marks the proofs of the given anchor transaction as unconfirmed
+// and re-broadcasts the transaction, so it can be included in a block of the
+// new chain.
+func (w *ReOrgWatcher) handleReOrg(txHash chainhash.Hash) {
+	txNtfn, ok := w.pendingProofs[txHash]
+	if !ok {
+		log.Debugf("Received re-org for anchor TX we're (no longer?) "+
+			"watching: %v", txHash)
+		return
+	}
+
+	txNtfn.reOrged = true
+	if w.cfg.ReOrgNotifier != nil {
+		w.cfg.ReOrgNotifier.AnchorReOrged(txHash)
+	}
+
+	// The anchor TX is fully signed, so we can just publish it again. It
+	// is very likely still valid, unless one of its inputs was
+	// double-spent in the new chain, in which case there's nothing we can
+	// do.
+	ctx, cancel := w.WithCtxQuit()
+	defer cancel()
+
+	anchorTx := txNtfn.firstRegistration().anchorTx
+	err := w.cfg.ChainBridge.PublishTransaction(ctx, &anchorTx)
+	if err != nil {
+		log.Warnf("Unable to re-broadcast re-organized anchor TX %v: "+
+			"%v", txHash, err)
+		return
+	}
+
+	log.Infof("Re-broadcast anchor TX %v after re-org", txHash)
+}
+
+// markReConfirmed clears the re-org flag of the given anchor transaction
+// after it was included in a new block.
+func (w *ReOrgWatcher) markReConfirmed(txHash chainhash.Hash,
+	txNtfn *anchorTxNotification) {
+
+	if !txNtfn.reOrged {
+		return
+	}
+
+	txNtfn.reOrged = false
+	if w.cfg.ReOrgNotifier != nil {
+		w.cfg.ReOrgNotifier.AnchorReConfirmed(txHash)
+	}
+}
+
 // watchTransactions processes new proofs given to the watcher and watches their
 // anchor transactions until they reach a safe confirmation depth.
 func (w *ReOrgWatcher) watchTransactions() {
@@ -434,6 +518,10 @@ func (w *ReOrgWatcher) watchTransactions() {
 					"confirmed in block %v, ignoring "+
 					"confirmation for block %v", txHash,
 					conf.BlockHeight, conf.BlockHash)
+
+				// The re-org might have been reverted, in
+				// which case the proofs are valid again.
+				w.markReConfirmed(txHash, txNtfn)
 				continue
 			}
 
@@ -446,12 +534,25 @@ func (w *ReOrgWatcher) watchTransactions() {
 				return
 			}
 
+			w.markReConfirmed(txHash, txNtfn)
+
+		case txHash := <-w.incomingReOrgs:
+			w.handleReOrg(txHash)
+
 		case newBlock := <-newBlockChan:
 			log.Infof("New block at height %d", newBlock)
 			w.bestHeight.Store(newBlock)
 
 			for txid := range w.pendingProofs {
 				proofNtfn := w.pendingProofs[txid]
+
+				// A re-organized TX isn't confirmed at all, so
+				// we need to wait for it to be included in a
+				// new block first.
+				if proofNtfn.reOrged {
+					continue
+				}
+
 				firstReg := proofNtfn.firstRegistration()
 				confs := newBlock - firstReg.blockHeight
 
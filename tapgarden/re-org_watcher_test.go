@@ -199,3 +199,94 @@ func TestWatchProofs(t *testing.T) {
 		return len(h.w.pendingProofs) == 0
 	})
 }
+
+// testReOrgNotifier records the anchor TXs it was notified about.
+type testReOrgNotifier struct {
+	reOrged     chan chainhash.Hash
+	reConfirmed chan chainhash.Hash
+}
+
+func (n *testReOrgNotifier) AnchorReOrged(txHash chainhash.Hash) {
+	n.reOrged <- txHash
+}
+
+func (n *testReOrgNotifier) AnchorReConfirmed(txHash chainhash.Hash) {
+	n.reConfirmed <- txHash
+}
+
+// TestReOrgRebroadcast makes sure that an anchor TX that is re-organized out of
+// the chain is re-broadcast and reported as unconfirmed until it is included
+// in a new block.
+func TestReOrgRebroadcast(t *testing.T) {
+	t.Parallel()
+
+	h := newReOrgWatcherHarness(t)
+	notifier := &testReOrgNotifier{
+		reOrged:     make(chan chainhash.Hash, 1),
+		reConfirmed: make(chan chainhash.Hash, 1),
+	}
+	h.cfg.ReOrgNotifier = notifier
+
+	require.NoError(t, h.w.Start())
+	h.assertStartup()
+
+	anchorTx := makeTx()
+	anchorTxHash := anchorTx.TxHash()
+	proofs := []*proof.Proof{makeProof(anchorTx)}
+
+	var cbCalled atomic.Int32
+	require.NoError(t, h.w.WatchProofs(
+		proofs, func([]*proof.Proof) error {
+			cbCalled.Add(1)
+			return nil
+		},
+	))
+	confReq, err := fn.RecvOrTimeout(
+		h.chainBridge.ConfReqSignal, testTimeout,
+	)
+	require.NoError(t, err)
+
+	// The anchor TX is now re-organized out of the chain, which should
+	// cause it to be re-broadcast and marked as unconfirmed.
+	h.chainBridge.ReOrgChans[*confReq] <- struct{}{}
+
+	publishedTx, err := fn.RecvOrTimeout(
+		h.chainBridge.PublishReq, testTimeout,
+	)
+	require.NoError(t, err)
+	require.Equal(t, anchorTxHash, (*publishedTx).TxHash())
+
+	reOrgedHash, err := fn.RecvOrTimeout(notifier.reOrged, testTimeout)
+	require.NoError(t, err)
+	require.Equal(t, anchorTxHash, *reOrgedHash)
+
+	// Even though the safe depth is reached when counting from the
+	// original block, the TX is still watched because it isn't confirmed.
+	h.chainBridge.NewBlocks <- testInitialBlockHeight + testSafeDepth*2
+
+	// Once the TX is included in a new block, the proofs are updated and
+	// the TX is reported as confirmed again.
+	newBlock := makeBlock(anchorTx)
+	newBlockHash := newBlock.BlockHash()
+	confEvent := h.chainBridge.ConfReqs[*confReq]
+	confEvent.Confirmed <- &chainntnfs.TxConfirmation{
+		BlockHash:   &newBlockHash,
+		BlockHeight: testReOrgBlockHeight,
+		TxIndex:     1,
+		Tx:          anchorTx,
+		Block:       newBlock,
+	}
+
+	reConfirmedHash, err := fn.RecvOrTimeout(
+		notifier.reConfirmed, testTimeout,
+	)
+	require.NoError(t, err)
+	require.Equal(t, anchorTxHash, *reConfirmedHash)
+	require.EqualValues(t, 1, cbCalled.Load())
+
+	// The safe depth is now counted from the new block.
+	h.chainBridge.NewBlocks <- testReOrgBlockHeight + testSafeDepth
+
+	require.NoError(t, h.w.Stop())
+	require.Empty(t, h.w.pendingProofs)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightningnetwork/lnd/keychain"
 )
@@ -113,6 +114,13 @@ type Seedling struct {
 	// a Schnorr signature for reissuing assets. A group key with an empty
 	// Tapscript root can only authorize reissuance with a signature.
 	GroupTapscriptRoot []byte
+
+	// SiblingTapTree is an optional tapscript tree with custom spend
+	// conditions for the genesis output of this asset. All assets of a
+	// batch share a single genesis output, so the trees of all seedlings
+	// are merged into the tapscript sibling of the batch when the seedling
+	// is added. Seedlings loaded from disk therefore never carry a tree.
+	SiblingTapTree fn.Option[asset.TapscriptTreeNodes]
 }
 
 // validateFields attempts to validate the set of input fields for the passed
@@ -146,6 +154,15 @@ func (c Seedling) validateFields() error {
 		return err
 	}
 
+	// JSON metadata must also follow the schema of the well-known fields,
+	// so wallets and explorers can rely on them.
+	if c.Meta != nil && c.Meta.Type == proof.MetaJson {
+		_, err = c.Meta.JSONFields()
+		if err != nil {
+			return fmt.Errorf("invalid JSON metadata: %w", err)
+		}
+	}
+
 	// The group tapscript root must be 32 bytes.
 	tapscriptRootSize := len(c.GroupTapscriptRoot)
 	if tapscriptRootSize != 0 && tapscriptRootSize != sha256.Size {
@@ -0,0 +1,172 @@
+package tapgarden
+
+import (
+	"slices"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+)
+
+const (
+	// DefaultZeroConfCheckInterval is the default interval at which we
+	// check whether the anchor transactions of zero-conf receives are still
+	// valid.
+	DefaultZeroConfCheckInterval = 30 * time.Second
+)
+
+// ZeroConfLabel is the risk label of an inbound asset transfer that was
+// credited before its anchor transaction confirmed.
+type ZeroConfLabel uint8
+
+const (
+	// ZeroConfUnconfirmed is the label of an inbound transfer that was
+	// credited at zero confirmations. The anchor transaction can still be
+	// double spent, so the credit is risky.
+	ZeroConfUnconfirmed ZeroConfLabel = iota
+
+	// ZeroConfConfirmed is the label of a zero-conf receive whose anchor
+	// transaction has confirmed. The credit is no longer considered risky.
+	ZeroConfConfirmed
+
+	// ZeroConfConflicted is the label of a zero-conf receive whose anchor
+	// transaction was replaced or double spent. The credit must be
+	// considered removed.
+	ZeroConfConflicted
+)
+
+// String returns a human-readable representation of the label.
+func (l ZeroConfLabel) String() string {
+	switch l {
+	case ZeroConfUnconfirmed:
+		return "unconfirmed"
+
+	case ZeroConfConfirmed:
+		return "confirmed"
+
+	case ZeroConfConflicted:
+		return "conflicted"
+
+	default:
+		return "unknown"
+	}
+}
+
+// ZeroConfConfig houses the configuration for crediting inbound asset transfers
+// before their anchor transaction confirmed.
+type ZeroConfConfig struct {
+	// Enabled indicates whether inbound transfers should be credited at
+	// zero confirmations at all.
+	Enabled bool
+
+	// AssetIDs is the list of assets that qualify for zero-conf receives.
+	// If this is empty, all assets qualify.
+	AssetIDs []asset.ID
+
+	// MaxAmount is the maximum amount of a single inbound transfer that
+	// qualifies for zero-conf receives. A value of 0 means no limit.
+	MaxAmount uint64
+
+	// CheckInterval is the interval at which we check whether the anchor
+	// transactions of zero-conf receives were replaced or double spent.
+	CheckInterval time.Duration
+}
+
+// Qualifies returns true if a transfer to the given address may be credited at
+// zero confirmations.
+func (c *ZeroConfConfig) Qualifies(addr *address.Tap) bool {
+	if !c.Enabled {
+		return false
+	}
+
+	if c.MaxAmount != 0 && addr.Amount > c.MaxAmount {
+		return false
+	}
+
+	if len(c.AssetIDs) == 0 {
+		return true
+	}
+
+	return slices.Contains(c.AssetIDs, addr.AssetID)
+}
+
+// ZeroConfReceive is an inbound asset transfer that was credited before its
+// anchor transaction confirmed.
+type ZeroConfReceive struct {
+	// Address is the address the assets were sent to.
+	Address address.Tap
+
+	// OutPoint is the anchor output of the inbound transfer.
+	OutPoint wire.OutPoint
+
+	// DetectionTime is the time the unconfirmed anchor transaction was
+	// first detected.
+	DetectionTime time.Time
+
+	// Label is the current risk label of the receive.
+	Label ZeroConfLabel
+
+	// anchorTx is the unconfirmed anchor transaction of the receive.
+	anchorTx *wire.MsgTx
+}
+
+// zeroConfConflicted returns true if the anchor transaction of the given
+// zero-conf receive is no longer part of the given list of wallet transactions
+// or one of its inputs is spent by another wallet transaction.
+func zeroConfConflicted(receive *ZeroConfReceive,
+	walletTxns []lndclient.Transaction) bool {
+
+	anchorHash := receive.OutPoint.Hash
+	anchorInputs := make(map[wire.OutPoint]struct{})
+	for _, txIn := range receive.anchorTx.TxIn {
+		anchorInputs[txIn.PreviousOutPoint] = struct{}{}
+	}
+
+	var found bool
+	for _, walletTx := range walletTxns {
+		txHash := walletTx.Tx.TxHash()
+		if txHash == anchorHash {
+			found = true
+			continue
+		}
+
+		for _, txIn := range walletTx.Tx.TxIn {
+			if _, ok := anchorInputs[txIn.PreviousOutPoint]; ok {
+				log.Warnf("Anchor transaction %v of zero-conf "+
+					"receive conflicts with %v", anchorHash,
+					txHash)
+
+				return true
+			}
+		}
+	}
+
+	return !found
+}
+
+// AssetReceiveZeroConfEvent is an event that is sent to a subscriber when the
+// risk label of a zero-conf receive changes.
+type AssetReceiveZeroConfEvent struct {
+	// timestamp is the time the event was created.
+	timestamp time.Time
+
+	// Receive is the zero-conf receive with its new risk label.
+	Receive ZeroConfReceive
+}
+
+// Timestamp returns the timestamp of the event.
+func (e *AssetReceiveZeroConfEvent) Timestamp() time.Time {
+	return e.timestamp
+}
+
+// NewAssetReceiveZeroConfEvent creates a new AssetReceiveZeroConfEvent.
+func NewAssetReceiveZeroConfEvent(
+	receive ZeroConfReceive) *AssetReceiveZeroConfEvent {
+
+	return &AssetReceiveZeroConfEvent{
+		timestamp: time.Now().UTC(),
+		Receive:   receive,
+	}
+}
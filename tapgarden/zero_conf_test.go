@@ -0,0 +1,209 @@
+package tapgarden
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// randZeroConfAddr creates a random address for the zero-conf tests.
+func randZeroConfAddr(t *testing.T, amt uint64) *address.Tap {
+	addr, _, _ := address.RandAddr(
+		t, &address.RegressionNetTap, url.URL{Scheme: "mock"},
+	)
+	addr.Amount = amt
+
+	return addr.Tap
+}
+
+// randAnchorTx creates a random anchor transaction that spends a single random
+// input.
+func randAnchorTx() *wire.MsgTx {
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{
+			Hash:  test.RandHash(),
+			Index: test.RandInt[uint32](),
+		},
+	})
+	tx.AddTxOut(&wire.TxOut{
+		PkScript: test.RandBytes(34),
+		Value:    1_000,
+	})
+
+	return tx
+}
+
+// TestZeroConfQualifies tests that only transfers of the configured assets and
+// up to the configured amount qualify for zero-conf receives.
+func TestZeroConfQualifies(t *testing.T) {
+	t.Parallel()
+
+	addr := randZeroConfAddr(t, 100)
+
+	testCases := []struct {
+		name   string
+		cfg    ZeroConfConfig
+		result bool
+	}{{
+		name:   "disabled",
+		cfg:    ZeroConfConfig{},
+		result: false,
+	}, {
+		name: "all assets, no limit",
+		cfg: ZeroConfConfig{
+			Enabled: true,
+		},
+		result: true,
+	}, {
+		name: "amount within limit",
+		cfg: ZeroConfConfig{
+			Enabled:   true,
+			MaxAmount: 100,
+		},
+		result: true,
+	}, {
+		name: "amount above limit",
+		cfg: ZeroConfConfig{
+			Enabled:   true,
+			MaxAmount: 99,
+		},
+		result: false,
+	}, {
+		name: "asset allowed",
+		cfg: ZeroConfConfig{
+			Enabled:  true,
+			AssetIDs: []asset.ID{asset.RandID(t), addr.AssetID},
+		},
+		result: true,
+	}, {
+		name: "asset not allowed",
+		cfg: ZeroConfConfig{
+			Enabled:  true,
+			AssetIDs: []asset.ID{asset.RandID(t)},
+		},
+		result: false,
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			require.Equal(tt, tc.result, tc.cfg.Qualifies(addr))
+		})
+	}
+}
+
+// TestCheckZeroConfReceives tests that the credit of zero-conf receives is
+// removed once their anchor transaction is replaced or double spent, while it
+// is kept for valid receives.
+func TestCheckZeroConfReceives(t *testing.T) {
+	t.Parallel()
+
+	walletAnchor := NewMockWalletAnchor()
+	c := NewCustodian(&CustodianConfig{
+		WalletAnchor: walletAnchor,
+		ZeroConf: ZeroConfConfig{
+			Enabled:   true,
+			MaxAmount: 1_000,
+		},
+	})
+	t.Cleanup(func() {
+		close(c.Quit)
+	})
+
+	events := fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize)
+	require.NoError(t, c.RegisterSubscriber(events, false, time.Time{}))
+
+	// We credit three transfers: one stays valid, one is removed from the
+	// wallet and the inputs of the last one are double spent.
+	var (
+		addrs      = make([]*address.Tap, 3)
+		anchorTxns = make([]*wire.MsgTx, 3)
+		ops        = make([]wire.OutPoint, 3)
+	)
+	for idx := range addrs {
+		addrs[idx] = randZeroConfAddr(t, 500)
+		anchorTxns[idx] = randAnchorTx()
+		ops[idx] = wire.OutPoint{Hash: anchorTxns[idx].TxHash()}
+
+		c.creditZeroConf(addrs[idx], ops[idx], anchorTxns[idx])
+
+		event, err := fn.RecvOrTimeout(
+			events.NewItemCreated.ChanOut(), time.Second,
+		)
+		require.NoError(t, err)
+
+		zeroConfEvent, ok := (*event).(*AssetReceiveZeroConfEvent)
+		require.True(t, ok)
+		require.Equal(
+			t, ZeroConfUnconfirmed, zeroConfEvent.Receive.Label,
+		)
+		require.Equal(t, ops[idx], zeroConfEvent.Receive.OutPoint)
+	}
+
+	// A transfer above the maximum amount shouldn't be credited, and
+	// crediting the same transfer twice should be a no-op.
+	c.creditZeroConf(randZeroConfAddr(t, 1_001), wire.OutPoint{}, nil)
+	c.creditZeroConf(addrs[0], ops[0], anchorTxns[0])
+	require.Len(t, c.ZeroConfReceives(), 3)
+
+	doubleSpend := randAnchorTx()
+	doubleSpend.TxIn[0].PreviousOutPoint =
+		anchorTxns[2].TxIn[0].PreviousOutPoint
+	walletAnchor.Transactions = []lndclient.Transaction{
+		{Tx: anchorTxns[0]},
+		{Tx: anchorTxns[2]},
+		{Tx: doubleSpend},
+	}
+
+	go func() {
+		<-walletAnchor.ListTxnsSignal
+	}()
+	c.checkZeroConfReceives()
+
+	// Only the first receive should still be credited.
+	receives := c.ZeroConfReceives()
+	require.Len(t, receives, 1)
+	require.Equal(t, ops[0], receives[0].OutPoint)
+	require.Equal(t, *addrs[0], receives[0].Address)
+
+	conflicted := make(map[wire.OutPoint]struct{})
+	for i := 0; i < 2; i++ {
+		event, err := fn.RecvOrTimeout(
+			events.NewItemCreated.ChanOut(), time.Second,
+		)
+		require.NoError(t, err)
+
+		zeroConfEvent, ok := (*event).(*AssetReceiveZeroConfEvent)
+		require.True(t, ok)
+		require.Equal(
+			t, ZeroConfConflicted, zeroConfEvent.Receive.Label,
+		)
+
+		conflicted[zeroConfEvent.Receive.OutPoint] = struct{}{}
+	}
+	require.Contains(t, conflicted, ops[1])
+	require.Contains(t, conflicted, ops[2])
+
+	// Once the anchor transaction of the remaining receive confirms, the
+	// credit is no longer risky.
+	c.settleZeroConf(ops[0])
+	require.Empty(t, c.ZeroConfReceives())
+
+	event, err := fn.RecvOrTimeout(
+		events.NewItemCreated.ChanOut(), time.Second,
+	)
+	require.NoError(t, err)
+
+	zeroConfEvent, ok := (*event).(*AssetReceiveZeroConfEvent)
+	require.True(t, ok)
+	require.Equal(t, ZeroConfConfirmed, zeroConfEvent.Receive.Label)
+	require.Equal(t, ops[0], zeroConfEvent.Receive.OutPoint)
+}
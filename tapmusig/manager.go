@@ -0,0 +1,537 @@
+package tapmusig
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightninglabs/taproot-assets/tapsend"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+// ManagerConfig is the configuration of the MuSig2 session manager.
+type ManagerConfig struct {
+	// Store is used to persist the sessions.
+	Store SessionStore
+
+	// Signer is the lnd signer that holds the local key and secret nonce
+	// of each session.
+	Signer Signer
+
+	// Validator is used to verify a virtual packet once all of its inputs
+	// are signed.
+	Validator tapscript.WitnessValidator
+
+	// Clock is used to determine the creation time of sessions.
+	Clock clock.Clock
+}
+
+// Manager drives multi-round MuSig2 signing sessions for the key spend path
+// of asset script keys that are shared between multiple signers. Each step of
+// a session is persisted, so sessions survive restarts of tapd. As the secret
+// nonce of the local signer only lives in the memory of lnd, a session that
+// wasn't signed by the local signer yet can't be resumed after lnd restarts
+// and must be aborted and started over.
+type Manager struct {
+	cfg *ManagerConfig
+
+	// sessionMtx serializes all steps of all sessions, so concurrent
+	// contributions can't overwrite each other.
+	sessionMtx sync.Mutex
+}
+
+// NewManager creates a new MuSig2 session manager.
+func NewManager(cfg *ManagerConfig) *Manager {
+	return &Manager{
+		cfg: cfg,
+	}
+}
+
+// CreateSession starts a new MuSig2 session for the input with the given
+// index of the virtual packet. The script key of the input must be the
+// combined key of the given signers, with the BIP-0086 tweak or the tapscript
+// root of the input as the taproot tweak. The local key is added to the
+// signers if it isn't part of them already. The returned session contains the
+// public nonce of the local signer that needs to be shared with the remote
+// signers.
+func (m *Manager) CreateSession(ctx context.Context, vPkt *tappsbt.VPacket,
+	inputIndex uint32, localKey keychain.KeyDescriptor,
+	signers []*btcec.PublicKey) (*Session, error) {
+
+	m.sessionMtx.Lock()
+	defer m.sessionMtx.Unlock()
+
+	if int(inputIndex) >= len(vPkt.Inputs) {
+		return nil, fmt.Errorf("invalid input index %d", inputIndex)
+	}
+	if localKey.PubKey == nil {
+		return nil, fmt.Errorf("missing local signer key")
+	}
+
+	vIn := vPkt.Inputs[inputIndex]
+	if vIn.Asset() == nil || vIn.Asset().ScriptKey.PubKey == nil {
+		return nil, fmt.Errorf("input %d is missing its asset",
+			inputIndex)
+	}
+
+	// The session signs the key spend path, so we need to apply the same
+	// taproot tweak the script key was derived with.
+	var tweakOpt lndclient.MuSig2SessionOpts
+	switch len(vIn.TaprootMerkleRoot) {
+	case 0:
+		tweakOpt = lndclient.MuSig2TaprootTweakOpt(nil, true)
+
+	case sha256.Size:
+		tweakOpt = lndclient.MuSig2TaprootTweakOpt(
+			vIn.TaprootMerkleRoot, false,
+		)
+
+	default:
+		return nil, fmt.Errorf("invalid taproot merkle root of input "+
+			"%d", inputIndex)
+	}
+
+	participants := []*Participant{{
+		PubKey: localKey.PubKey,
+		Local:  true,
+	}}
+	for _, signer := range signers {
+		isKnown := fn.Any(participants, func(p *Participant) bool {
+			return p.PubKey.IsEqual(signer)
+		})
+		if isKnown {
+			continue
+		}
+
+		participants = append(participants, &Participant{
+			PubKey: signer,
+		})
+	}
+	if len(participants) < 2 {
+		return nil, fmt.Errorf("a musig2 session needs at least one " +
+			"remote signer")
+	}
+
+	sigHash, err := tapsend.InputKeySpendSigHash(vPkt, int(inputIndex))
+	if err != nil {
+		return nil, fmt.Errorf("unable to compute signature hash: %w",
+			err)
+	}
+
+	rawKeys := fn.Map(participants, func(p *Participant) []byte {
+		return p.PubKey.SerializeCompressed()
+	})
+	info, err := m.cfg.Signer.MuSig2CreateSession(
+		ctx, input.MuSig2Version100RC2, &localKey.KeyLocator, rawKeys,
+		tweakOpt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create musig2 session: %w",
+			err)
+	}
+
+	// If the session can't be stored, it also shouldn't stay around in
+	// lnd.
+	cleanup := func() {
+		err := m.cfg.Signer.MuSig2Cleanup(ctx, info.SessionID)
+		if err != nil {
+			log.Warnf("Unable to clean up musig2 session %x: %v",
+				info.SessionID[:], err)
+		}
+	}
+
+	scriptKey := vIn.Asset().ScriptKey.PubKey
+	if !bytes.Equal(
+		schnorr.SerializePubKey(info.CombinedKey),
+		schnorr.SerializePubKey(scriptKey),
+	) {
+
+		cleanup()
+		return nil, fmt.Errorf("%w: combined key %x, script key %x",
+			ErrScriptKeyMismatch,
+			schnorr.SerializePubKey(info.CombinedKey),
+			schnorr.SerializePubKey(scriptKey))
+	}
+
+	participants[0].PubNonce = fn.Some(info.PublicNonce)
+
+	session := &Session{
+		ID:           info.SessionID,
+		VPacket:      vPkt.Copy(),
+		InputIndex:   inputIndex,
+		LocalKey:     localKey.KeyLocator,
+		CombinedKey:  info.CombinedKey,
+		SigHash:      sigHash,
+		Participants: participants,
+		State:        StateCollectingNonces,
+		CreationTime: m.cfg.Clock.Now().UTC(),
+	}
+	if err := m.cfg.Store.InsertSession(ctx, session); err != nil {
+		cleanup()
+		return nil, fmt.Errorf("unable to store musig2 session: %w",
+			err)
+	}
+
+	log.Infof("Created musig2 session %x for input %d with %d signers",
+		session.ID[:], inputIndex, len(participants))
+
+	return session, nil
+}
+
+// fetchSession fetches the session with the given ID and makes sure it is in
+// the expected state.
+func (m *Manager) fetchSession(ctx context.Context, id [32]byte,
+	state SessionState) (*Session, error) {
+
+	session, err := m.cfg.Store.FetchSession(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if session.State != state {
+		return nil, fmt.Errorf("musig2 session %x is in state %v, "+
+			"expected %v", id[:], session.State, state)
+	}
+
+	return session, nil
+}
+
+// signerErr wraps an error of the lnd signer. As lnd only keeps sessions in
+// memory, the most likely cause of an error is that lnd restarted and lost
+// the session.
+func signerErr(id [32]byte, action string, err error) error {
+	return fmt.Errorf("unable to %s for musig2 session %x, the session "+
+		"might have been lost if lnd restarted: %w", action, id[:], err)
+}
+
+// RegisterNonce registers the public nonce of the remote signer with the given
+// key. Once the nonces of all signers are known, the session moves on to
+// collecting the partial signatures.
+func (m *Manager) RegisterNonce(ctx context.Context, id [32]byte,
+	signer *btcec.PublicKey,
+	nonce [musig2.PubNonceSize]byte) (*Session, error) {
+
+	m.sessionMtx.Lock()
+	defer m.sessionMtx.Unlock()
+
+	session, err := m.fetchSession(ctx, id, StateCollectingNonces)
+	if err != nil {
+		return nil, err
+	}
+
+	participant, err := session.remoteParticipant(signer)
+	if err != nil {
+		return nil, err
+	}
+
+	// Contributing the same nonce twice is fine, for example if the
+	// caller retries after a restart. A different nonce is rejected, as
+	// lnd already uses the first one.
+	if participant.PubNonce.IsSome() {
+		knownNonce := participant.PubNonce.UnwrapOr(
+			[musig2.PubNonceSize]byte{},
+		)
+		if knownNonce != nonce {
+			return nil, fmt.Errorf("signer %x already contributed "+
+				"a different nonce",
+				signer.SerializeCompressed())
+		}
+
+		return session, nil
+	}
+
+	_, err = m.cfg.Signer.MuSig2RegisterNonces(
+		ctx, id, [][musig2.PubNonceSize]byte{nonce},
+	)
+	if err != nil {
+		return nil, signerErr(id, "register nonce", err)
+	}
+
+	participant.PubNonce = fn.Some(nonce)
+	if session.HaveAllNonces() {
+		session.State = StateCollectingSigs
+	}
+
+	if err := m.cfg.Store.UpdateSession(ctx, session); err != nil {
+		return nil, fmt.Errorf("unable to update musig2 session: %w",
+			err)
+	}
+
+	return session, nil
+}
+
+// Sign creates the partial signature of the local signer, once the nonces of
+// all signers are known. The local signer only ever signs once per session,
+// so calling Sign again returns the session with the existing signature.
+func (m *Manager) Sign(ctx context.Context, id [32]byte) (*Session, error) {
+	m.sessionMtx.Lock()
+	defer m.sessionMtx.Unlock()
+
+	session, err := m.fetchSession(ctx, id, StateCollectingSigs)
+	if err != nil {
+		return nil, err
+	}
+
+	local, err := session.LocalParticipant()
+	if err != nil {
+		return nil, err
+	}
+	if len(local.PartialSig) != 0 {
+		return session, nil
+	}
+
+	partialSig, err := m.cfg.Signer.MuSig2Sign(
+		ctx, id, session.SigHash, false,
+	)
+	if err != nil {
+		return nil, signerErr(id, "sign", err)
+	}
+
+	local.PartialSig = partialSig
+	if err := m.cfg.Store.UpdateSession(ctx, session); err != nil {
+		return nil, fmt.Errorf("unable to update musig2 session: %w",
+			err)
+	}
+
+	return session, nil
+}
+
+// RegisterPartialSig registers the partial signature of the remote signer with
+// the given key. The partial signatures are only combined when the session is
+// finalized.
+func (m *Manager) RegisterPartialSig(ctx context.Context, id [32]byte,
+	signer *btcec.PublicKey, partialSig []byte) (*Session, error) {
+
+	m.sessionMtx.Lock()
+	defer m.sessionMtx.Unlock()
+
+	if len(partialSig) != 32 {
+		return nil, fmt.Errorf("invalid partial signature length %d",
+			len(partialSig))
+	}
+
+	session, err := m.fetchSession(ctx, id, StateCollectingSigs)
+	if err != nil {
+		return nil, err
+	}
+
+	participant, err := session.remoteParticipant(signer)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(participant.PartialSig) != 0 {
+		if !bytes.Equal(participant.PartialSig, partialSig) {
+			return nil, fmt.Errorf("signer %x already contributed "+
+				"a different partial signature",
+				signer.SerializeCompressed())
+		}
+
+		return session, nil
+	}
+
+	participant.PartialSig = partialSig
+	if err := m.cfg.Store.UpdateSession(ctx, session); err != nil {
+		return nil, fmt.Errorf("unable to update musig2 session: %w",
+			err)
+	}
+
+	return session, nil
+}
+
+// Finalize combines the partial signatures of all signers into the final
+// signature and adds it as the witness of the signed input. If a virtual
+// packet is given, for example one that already contains the witnesses of
+// other inputs, the witness is added to it instead of the packet the session
+// was created with. Once all inputs of the packet are signed, the transfer is
+// verified. The packet with the new witness is returned.
+func (m *Manager) Finalize(ctx context.Context, id [32]byte,
+	vPkt *tappsbt.VPacket) (*tappsbt.VPacket, error) {
+
+	m.sessionMtx.Lock()
+	defer m.sessionMtx.Unlock()
+
+	session, err := m.cfg.Store.FetchSession(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case session.State == StateFinalized && vPkt == nil:
+		return session.VPacket.Copy(), nil
+
+	case session.State == StateFinalized:
+
+	case session.State != StateCollectingSigs:
+		return nil, fmt.Errorf("musig2 session %x is in state %v",
+			id[:], session.State)
+
+	case !session.HaveAllSigs():
+		return nil, fmt.Errorf("musig2 session %x is missing partial "+
+			"signatures", id[:])
+	}
+
+	if vPkt == nil {
+		vPkt = session.VPacket
+	}
+	vPkt = vPkt.Copy()
+
+	// The witness is only valid for the exact transfer the session
+	// signed.
+	sigHash, err := tapsend.InputKeySpendSigHash(
+		vPkt, int(session.InputIndex),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compute signature hash: %w",
+			err)
+	}
+	if sigHash != session.SigHash {
+		return nil, fmt.Errorf("virtual packet doesn't match the " +
+			"transfer signed by the musig2 session")
+	}
+
+	// lnd forgets the session once the signatures are combined, so we
+	// persist the final signature right away. That way, the witness can
+	// still be added if the packet turns out to be invalid for other
+	// reasons.
+	if session.FinalSig == nil {
+		finalSig, err := m.combineSigs(ctx, session)
+		if err != nil {
+			return nil, err
+		}
+
+		session.FinalSig = finalSig
+		err = m.cfg.Store.UpdateSession(ctx, session)
+		if err != nil {
+			return nil, fmt.Errorf("unable to update musig2 "+
+				"session: %w", err)
+		}
+	}
+
+	vIn := vPkt.Inputs[session.InputIndex]
+	witness := wire.TxWitness{session.FinalSig.Serialize()}
+	if vIn.SighashType != txscript.SigHashDefault {
+		witness[0] = append(witness[0], byte(vIn.SighashType))
+	}
+
+	err = tapsend.AddInputWitness(
+		vPkt, int(session.InputIndex), witness, m.cfg.Validator,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to add witness: %w", err)
+	}
+
+	session.VPacket = vPkt
+	session.State = StateFinalized
+	if err := m.cfg.Store.UpdateSession(ctx, session); err != nil {
+		return nil, fmt.Errorf("unable to update musig2 session: %w",
+			err)
+	}
+
+	log.Infof("Finalized musig2 session %x", id[:])
+
+	return vPkt.Copy(), nil
+}
+
+// combineSigs combines the partial signatures of all signers of the given
+// session and verifies the final signature.
+func (m *Manager) combineSigs(ctx context.Context,
+	session *Session) (*schnorr.Signature, error) {
+
+	var remoteSigs [][]byte
+	for _, participant := range session.Participants {
+		if !participant.Local {
+			remoteSigs = append(remoteSigs, participant.PartialSig)
+		}
+	}
+
+	haveAllSigs, rawSig, err := m.cfg.Signer.MuSig2CombineSig(
+		ctx, session.ID, remoteSigs,
+	)
+	if err != nil {
+		return nil, signerErr(session.ID, "combine signatures", err)
+	}
+	if !haveAllSigs {
+		return nil, fmt.Errorf("musig2 session %x is missing partial "+
+			"signatures", session.ID[:])
+	}
+
+	finalSig, err := schnorr.ParseSignature(rawSig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse final signature: %w",
+			err)
+	}
+
+	// A remote signer could have contributed an invalid partial
+	// signature, which we only notice once they're combined.
+	if !finalSig.Verify(session.SigHash[:], session.CombinedKey) {
+		return nil, fmt.Errorf("final signature of musig2 session %x "+
+			"is invalid", session.ID[:])
+	}
+
+	return finalSig, nil
+}
+
+// AbortSession aborts the session with the given ID and removes it from lnd.
+// Finalized sessions can't be aborted.
+func (m *Manager) AbortSession(ctx context.Context, id [32]byte) error {
+	m.sessionMtx.Lock()
+	defer m.sessionMtx.Unlock()
+
+	session, err := m.cfg.Store.FetchSession(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	switch session.State {
+	case StateAborted:
+		return nil
+
+	case StateFinalized:
+		return fmt.Errorf("musig2 session %x is already finalized",
+			id[:])
+	}
+
+	// The session might already be gone if lnd restarted, which is one of
+	// the reasons to abort a session in the first place.
+	if err := m.cfg.Signer.MuSig2Cleanup(ctx, id); err != nil {
+		log.Debugf("Unable to clean up musig2 session %x: %v", id[:],
+			err)
+	}
+
+	session.State = StateAborted
+	if err := m.cfg.Store.UpdateSession(ctx, session); err != nil {
+		return fmt.Errorf("unable to update musig2 session: %w", err)
+	}
+
+	log.Infof("Aborted musig2 session %x", id[:])
+
+	return nil
+}
+
+// FetchSession returns the session with the given ID.
+func (m *Manager) FetchSession(ctx context.Context,
+	id [32]byte) (*Session, error) {
+
+	return m.cfg.Store.FetchSession(ctx, id)
+}
+
+// ListSessions returns all sessions, optionally filtered by state.
+func (m *Manager) ListSessions(ctx context.Context,
+	state fn.Option[SessionState]) ([]*Session, error) {
+
+	return m.cfg.Store.QuerySessions(ctx, state)
+}
@@ -0,0 +1,399 @@
+package tapmusig_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/lightninglabs/lndclient"
+	tap "github.com/lightninglabs/taproot-assets"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapdb"
+	"github.com/lightninglabs/taproot-assets/tapmusig"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/tapsend"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc/signrpc"
+	"github.com/stretchr/testify/require"
+)
+
+// mockSigner is a tapmusig.Signer that keeps its sessions in memory, the same
+// way lnd does.
+type mockSigner struct {
+	manager   *input.MusigSessionManager
+	signCalls int
+}
+
+// newMockSigner creates a mock signer that signs with the given private key.
+func newMockSigner(privKey *btcec.PrivateKey) *mockSigner {
+	return &mockSigner{
+		manager: input.NewMusigSessionManager(
+			func(*keychain.KeyDescriptor) (*btcec.PrivateKey,
+				error) {
+
+				return privKey, nil
+			},
+		),
+	}
+}
+
+// MuSig2CreateSession creates a new in-memory MuSig2 session.
+func (m *mockSigner) MuSig2CreateSession(_ context.Context,
+	version input.MuSig2Version, signerLoc *keychain.KeyLocator,
+	signers [][]byte, opts ...lndclient.MuSig2SessionOpts) (
+	*input.MuSig2SessionInfo, error) {
+
+	var req signrpc.MuSig2SessionRequest
+	for _, opt := range opts {
+		opt(&req)
+	}
+
+	pubKeys, err := input.MuSig2ParsePubKeys(version, signers)
+	if err != nil {
+		return nil, err
+	}
+
+	var tweaks input.MuSig2Tweaks
+	if req.TaprootTweak != nil {
+		tweaks.TaprootBIP0086Tweak = req.TaprootTweak.KeySpendOnly
+		tweaks.TaprootTweak = req.TaprootTweak.ScriptRoot
+	}
+
+	return m.manager.MuSig2CreateSession(
+		version, *signerLoc, pubKeys, &tweaks, nil, nil,
+	)
+}
+
+// MuSig2RegisterNonces registers the nonces of remote signers.
+func (m *mockSigner) MuSig2RegisterNonces(_ context.Context,
+	sessionID [32]byte, nonces [][musig2.PubNonceSize]byte) (bool,
+	error) {
+
+	return m.manager.MuSig2RegisterNonces(sessionID, nonces)
+}
+
+// MuSig2Sign creates the local partial signature.
+func (m *mockSigner) MuSig2Sign(_ context.Context, sessionID [32]byte,
+	message [32]byte, cleanup bool) ([]byte, error) {
+
+	m.signCalls++
+
+	partialSig, err := m.manager.MuSig2Sign(sessionID, message, cleanup)
+	if err != nil {
+		return nil, err
+	}
+
+	sigBytes, err := input.SerializePartialSignature(partialSig)
+	if err != nil {
+		return nil, err
+	}
+
+	return sigBytes[:], nil
+}
+
+// MuSig2CombineSig combines the remote partial signatures with the local one.
+func (m *mockSigner) MuSig2CombineSig(_ context.Context, sessionID [32]byte,
+	otherPartialSigs [][]byte) (bool, []byte, error) {
+
+	partialSigs := make([]*musig2.PartialSignature, len(otherPartialSigs))
+	for idx, sigBytes := range otherPartialSigs {
+		partialSig, err := input.DeserializePartialSignature(sigBytes)
+		if err != nil {
+			return false, nil, err
+		}
+		partialSigs[idx] = partialSig
+	}
+
+	finalSig, haveAllSigs, err := m.manager.MuSig2CombineSig(
+		sessionID, partialSigs,
+	)
+	if err != nil || !haveAllSigs {
+		return haveAllSigs, nil, err
+	}
+
+	return true, finalSig.Serialize(), nil
+}
+
+// MuSig2Cleanup removes the session from memory.
+func (m *mockSigner) MuSig2Cleanup(_ context.Context,
+	sessionID [32]byte) error {
+
+	return m.manager.MuSig2Cleanup(sessionID)
+}
+
+// remoteSigner is the counterparty of the local signer, signing with the
+// btcec MuSig2 implementation directly.
+type remoteSigner struct {
+	session *musig2.Session
+}
+
+// newRemoteSigner creates a remote signer for the BIP-0086 tweaked combined
+// key of the given signers.
+func newRemoteSigner(t *testing.T, privKey *btcec.PrivateKey,
+	signers []*btcec.PublicKey) *remoteSigner {
+
+	muSigCtx, err := musig2.NewContext(
+		privKey, true, musig2.WithKnownSigners(signers),
+		musig2.WithBip86TweakCtx(),
+	)
+	require.NoError(t, err)
+
+	session, err := muSigCtx.NewSession()
+	require.NoError(t, err)
+
+	return &remoteSigner{
+		session: session,
+	}
+}
+
+// sign registers the nonce of the local signer and creates the remote partial
+// signature.
+func (r *remoteSigner) sign(t *testing.T, localNonce [musig2.PubNonceSize]byte,
+	sigHash [32]byte) []byte {
+
+	_, err := r.session.RegisterPubNonce(localNonce)
+	require.NoError(t, err)
+
+	partialSig, err := r.session.Sign(sigHash, musig2.WithSortedKeys())
+	require.NoError(t, err)
+
+	sigBytes, err := input.SerializePartialSignature(partialSig)
+	require.NoError(t, err)
+
+	return sigBytes[:]
+}
+
+// createPacket creates a virtual packet that spends an asset whose script key
+// is the BIP-0086 tweaked MuSig2 combined key of the given signers to a
+// single interactive output.
+func createPacket(t *testing.T, signers []*btcec.PublicKey) *tappsbt.VPacket {
+	combinedKey, err := input.MuSig2CombineKeys(
+		input.MuSig2Version100RC2, signers, true, &input.MuSig2Tweaks{
+			TaprootBIP0086Tweak: true,
+		},
+	)
+	require.NoError(t, err)
+
+	inputAsset, err := asset.New(
+		asset.RandGenesis(t, asset.Normal), 1000, 0, 0,
+		asset.NewScriptKey(combinedKey.FinalKey), nil,
+	)
+	require.NoError(t, err)
+
+	vPkt := &tappsbt.VPacket{
+		Inputs: []*tappsbt.VInput{{
+			PrevID: asset.PrevID{
+				OutPoint: test.RandOp(t),
+				ID:       inputAsset.ID(),
+				ScriptKey: asset.ToSerialized(
+					inputAsset.ScriptKey.PubKey,
+				),
+			},
+		}},
+		Outputs: []*tappsbt.VOutput{{
+			Interactive:             true,
+			Amount:                  inputAsset.Amount,
+			AnchorOutputIndex:       0,
+			ScriptKey:               asset.RandScriptKey(t),
+			AnchorOutputInternalKey: test.RandPubKey(t),
+		}},
+		ChainParams: &address.RegressionNetTap,
+		Version:     tappsbt.V1,
+	}
+	vPkt.SetInputAsset(0, inputAsset)
+
+	err = tapsend.PrepareOutputAssets(context.Background(), vPkt)
+	require.NoError(t, err)
+
+	return vPkt
+}
+
+// newTestManager creates a session manager that persists its sessions in the
+// given database.
+func newTestManager(db *tapdb.BaseDB, signer tapmusig.Signer,
+	testClock clock.Clock) *tapmusig.Manager {
+
+	store := tapdb.NewMuSig2Sessions(tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.MuSig2SessionStore {
+			return db.WithTx(tx)
+		},
+	))
+
+	return tapmusig.NewManager(&tapmusig.ManagerConfig{
+		Store:     store,
+		Signer:    signer,
+		Validator: &tap.WitnessValidatorV0{},
+		Clock:     testClock,
+	})
+}
+
+// TestManagerSigningFlow tests the full signing flow of a two party MuSig2
+// session, including a restart of the manager between the rounds.
+func TestManagerSigningFlow(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testClock := clock.NewTestClock(time.Now())
+	db := tapdb.NewTestDB(t)
+
+	localPrivKey := test.RandPrivKey(t)
+	remotePrivKey := test.RandPrivKey(t)
+	signers := []*btcec.PublicKey{
+		localPrivKey.PubKey(), remotePrivKey.PubKey(),
+	}
+	localKey := keychain.KeyDescriptor{
+		KeyLocator: keychain.KeyLocator{
+			Family: 212,
+			Index:  1,
+		},
+		PubKey: localPrivKey.PubKey(),
+	}
+
+	vPkt := createPacket(t, signers)
+	signer := newMockSigner(localPrivKey)
+	manager := newTestManager(db.BaseDB, signer, testClock)
+
+	// A set of signers that doesn't result in the script key of the input
+	// is rejected.
+	_, err := manager.CreateSession(
+		ctx, vPkt, 0, localKey, []*btcec.PublicKey{
+			test.RandPubKey(t),
+		},
+	)
+	require.ErrorIs(t, err, tapmusig.ErrScriptKeyMismatch)
+
+	// The remote key is enough, the local key is added automatically.
+	session, err := manager.CreateSession(
+		ctx, vPkt, 0, localKey,
+		[]*btcec.PublicKey{remotePrivKey.PubKey()},
+	)
+	require.NoError(t, err)
+	require.Equal(t, tapmusig.StateCollectingNonces, session.State)
+	require.Len(t, session.Participants, 2)
+
+	local, err := session.LocalParticipant()
+	require.NoError(t, err)
+	localNonce := local.PubNonce.UnwrapOr([musig2.PubNonceSize]byte{})
+
+	// The local signer can't sign before all nonces are known.
+	_, err = manager.Sign(ctx, session.ID)
+	require.ErrorContains(t, err, "is in state collecting_nonces")
+
+	// Nonces of unknown signers are rejected.
+	remote := newRemoteSigner(t, remotePrivKey, signers)
+	remoteNonce := remote.session.PublicNonce()
+	_, err = manager.RegisterNonce(
+		ctx, session.ID, test.RandPubKey(t), remoteNonce,
+	)
+	require.ErrorIs(t, err, tapmusig.ErrUnknownSigner)
+
+	session, err = manager.RegisterNonce(
+		ctx, session.ID, remotePrivKey.PubKey(), remoteNonce,
+	)
+	require.NoError(t, err)
+	require.Equal(t, tapmusig.StateCollectingSigs, session.State)
+
+	// tapd restarts, the session is resumed from the database.
+	manager = newTestManager(db.BaseDB, signer, testClock)
+
+	session, err = manager.Sign(ctx, session.ID)
+	require.NoError(t, err)
+	require.Equal(t, 1, signer.signCalls)
+
+	// Signing again returns the existing partial signature instead of
+	// signing a second time.
+	session, err = manager.Sign(ctx, session.ID)
+	require.NoError(t, err)
+	require.Equal(t, 1, signer.signCalls)
+
+	remoteSig := remote.sign(t, localNonce, session.SigHash)
+	session, err = manager.RegisterPartialSig(
+		ctx, session.ID, remotePrivKey.PubKey(), remoteSig,
+	)
+	require.NoError(t, err)
+	require.True(t, session.HaveAllSigs())
+
+	// tapd restarts again before the signatures are combined.
+	manager = newTestManager(db.BaseDB, signer, testClock)
+
+	signedPkt, err := manager.Finalize(ctx, session.ID, nil)
+	require.NoError(t, err)
+
+	witness := signedPkt.Outputs[0].Asset.PrevWitnesses[0].TxWitness
+	require.Len(t, witness, 1)
+	sig, err := schnorr.ParseSignature(witness[0])
+	require.NoError(t, err)
+	require.True(t, sig.Verify(
+		session.SigHash[:], vPkt.Inputs[0].Asset().ScriptKey.PubKey,
+	))
+
+	// Finalizing again returns the same packet, the session can't be
+	// aborted anymore.
+	session, err = manager.FetchSession(ctx, session.ID)
+	require.NoError(t, err)
+	require.Equal(t, tapmusig.StateFinalized, session.State)
+
+	signedPkt2, err := manager.Finalize(ctx, session.ID, nil)
+	require.NoError(t, err)
+	require.Equal(
+		t, witness,
+		signedPkt2.Outputs[0].Asset.PrevWitnesses[0].TxWitness,
+	)
+
+	err = manager.AbortSession(ctx, session.ID)
+	require.ErrorContains(t, err, "already finalized")
+}
+
+// TestManagerAbortSession tests that aborted sessions can't be continued.
+func TestManagerAbortSession(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testClock := clock.NewTestClock(time.Now())
+	db := tapdb.NewTestDB(t)
+
+	localPrivKey := test.RandPrivKey(t)
+	remotePrivKey := test.RandPrivKey(t)
+	signers := []*btcec.PublicKey{
+		localPrivKey.PubKey(), remotePrivKey.PubKey(),
+	}
+	localKey := keychain.KeyDescriptor{
+		PubKey: localPrivKey.PubKey(),
+	}
+
+	vPkt := createPacket(t, signers)
+	manager := newTestManager(
+		db.BaseDB, newMockSigner(localPrivKey), testClock,
+	)
+
+	session, err := manager.CreateSession(ctx, vPkt, 0, localKey, signers)
+	require.NoError(t, err)
+
+	require.NoError(t, manager.AbortSession(ctx, session.ID))
+
+	remote := newRemoteSigner(t, remotePrivKey, signers)
+	_, err = manager.RegisterNonce(
+		ctx, session.ID, remotePrivKey.PubKey(),
+		remote.session.PublicNonce(),
+	)
+	require.ErrorContains(t, err, "is in state aborted")
+
+	sessions, err := manager.ListSessions(
+		ctx, fn.Some(tapmusig.StateAborted),
+	)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, session.ID, sessions[0].ID)
+
+	_, err = manager.FetchSession(ctx, [32]byte{1})
+	require.ErrorIs(t, err, tapmusig.ErrSessionNotFound)
+}
@@ -0,0 +1,229 @@
+package tapmusig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+var (
+	// ErrSessionNotFound is returned if a MuSig2 session can't be found.
+	ErrSessionNotFound = errors.New("musig2 session not found")
+
+	// ErrUnknownSigner is returned if a nonce or partial signature is
+	// contributed by a key that isn't a signer of the session.
+	ErrUnknownSigner = errors.New("key is not a remote signer of the " +
+		"musig2 session")
+
+	// ErrScriptKeyMismatch is returned if the combined key of the signers
+	// doesn't match the script key of the input that should be signed.
+	ErrScriptKeyMismatch = errors.New("combined key of the signers " +
+		"doesn't match the script key of the input")
+)
+
+// SessionState is the state of a MuSig2 signing session.
+type SessionState uint8
+
+const (
+	// StateCollectingNonces denotes a session that is waiting for the
+	// public nonces of the remote signers.
+	StateCollectingNonces SessionState = 0
+
+	// StateCollectingSigs denotes a session that has the public nonces of
+	// all signers and is waiting for the partial signatures.
+	StateCollectingSigs SessionState = 1
+
+	// StateFinalized denotes a session whose partial signatures were
+	// combined into the final witness of the signed input.
+	StateFinalized SessionState = 2
+
+	// StateAborted denotes a session that was cancelled or that failed.
+	// An aborted session can't be resumed.
+	StateAborted SessionState = 3
+)
+
+// String returns a human-readable representation of the state.
+func (s SessionState) String() string {
+	switch s {
+	case StateCollectingNonces:
+		return "collecting_nonces"
+
+	case StateCollectingSigs:
+		return "collecting_sigs"
+
+	case StateFinalized:
+		return "finalized"
+
+	case StateAborted:
+		return "aborted"
+
+	default:
+		return fmt.Sprintf("<unknown %d>", s)
+	}
+}
+
+// Participant is a single signer of a MuSig2 session.
+type Participant struct {
+	// PubKey is the public key the signer contributes to the combined
+	// key.
+	PubKey *btcec.PublicKey
+
+	// Local is true if this is the signer of the local lnd node.
+	Local bool
+
+	// PubNonce is the public nonce of the signer, once it is known.
+	PubNonce fn.Option[[musig2.PubNonceSize]byte]
+
+	// PartialSig is the partial signature of the signer, once it is known.
+	PartialSig []byte
+}
+
+// Session is a MuSig2 signing session for the key spend path of a virtual
+// packet input whose script key is shared between multiple signers. The
+// secret nonce of the local signer never leaves lnd, all other state of the
+// session is persisted, so a session can be resumed after a restart of tapd.
+type Session struct {
+	// ID is the ID of the session, as assigned by lnd.
+	ID [32]byte
+
+	// VPacket is the virtual packet that contains the signed input. Once
+	// the session is finalized, it contains the final witness.
+	VPacket *tappsbt.VPacket
+
+	// InputIndex is the index of the signed input of the virtual packet.
+	InputIndex uint32
+
+	// LocalKey is the locator of the local signer's key in lnd.
+	LocalKey keychain.KeyLocator
+
+	// CombinedKey is the combined key of all signers with the taproot
+	// tweak applied, which is the script key of the signed input.
+	CombinedKey *btcec.PublicKey
+
+	// SigHash is the signature hash of the signed input.
+	SigHash [32]byte
+
+	// Participants are all signers of the session, including the local
+	// one.
+	Participants []*Participant
+
+	// FinalSig is the final signature, once all partial signatures were
+	// combined.
+	FinalSig *schnorr.Signature
+
+	// State is the current state of the session.
+	State SessionState
+
+	// CreationTime is the time the session was created.
+	CreationTime time.Time
+}
+
+// LocalParticipant returns the local signer of the session.
+func (s *Session) LocalParticipant() (*Participant, error) {
+	for _, participant := range s.Participants {
+		if participant.Local {
+			return participant, nil
+		}
+	}
+
+	return nil, fmt.Errorf("musig2 session %x has no local signer", s.ID)
+}
+
+// remoteParticipant returns the remote signer with the given key.
+func (s *Session) remoteParticipant(
+	pubKey *btcec.PublicKey) (*Participant, error) {
+
+	for _, participant := range s.Participants {
+		if !participant.Local && participant.PubKey.IsEqual(pubKey) {
+			return participant, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %x", ErrUnknownSigner,
+		pubKey.SerializeCompressed())
+}
+
+// HaveAllNonces returns true if the public nonces of all signers are known.
+func (s *Session) HaveAllNonces() bool {
+	for _, participant := range s.Participants {
+		if participant.PubNonce.IsNone() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// HaveAllSigs returns true if the partial signatures of all signers are
+// known.
+func (s *Session) HaveAllSigs() bool {
+	for _, participant := range s.Participants {
+		if len(participant.PartialSig) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SessionStore is the interface that a component storing MuSig2 sessions
+// should implement.
+type SessionStore interface {
+	// InsertSession stores a new session along with its signers.
+	InsertSession(ctx context.Context, session *Session) error
+
+	// UpdateSession persists the state, the virtual packet, the final
+	// signature and the contributions of all signers of the given
+	// session.
+	UpdateSession(ctx context.Context, session *Session) error
+
+	// FetchSession returns the session with the given ID. If the session
+	// doesn't exist, ErrSessionNotFound is returned.
+	FetchSession(ctx context.Context, id [32]byte) (*Session, error)
+
+	// QuerySessions returns all sessions, optionally filtered by state.
+	QuerySessions(ctx context.Context,
+		state fn.Option[SessionState]) ([]*Session, error)
+}
+
+// Signer is the part of the lnd signer that creates MuSig2 signatures. The
+// sessions of the signer only live in memory, so they're lost when lnd
+// restarts.
+type Signer interface {
+	// MuSig2CreateSession creates a new MuSig2 session with the key and
+	// signers provided.
+	MuSig2CreateSession(ctx context.Context, version input.MuSig2Version,
+		signerLoc *keychain.KeyLocator, signers [][]byte,
+		opts ...lndclient.MuSig2SessionOpts) (*input.MuSig2SessionInfo,
+		error)
+
+	// MuSig2RegisterNonces registers additional public nonces for a
+	// MuSig2 session. It returns a boolean indicating whether we have all
+	// of our nonces present.
+	MuSig2RegisterNonces(ctx context.Context, sessionID [32]byte,
+		nonces [][musig2.PubNonceSize]byte) (bool, error)
+
+	// MuSig2Sign creates a partial signature for the given 32 byte
+	// message.
+	MuSig2Sign(ctx context.Context, sessionID [32]byte,
+		message [32]byte, cleanup bool) ([]byte, error)
+
+	// MuSig2CombineSig combines the given partial signature(s) with the
+	// local one. Once the partial signatures of all signers are
+	// registered, the final signature is returned.
+	MuSig2CombineSig(ctx context.Context, sessionID [32]byte,
+		otherPartialSigs [][]byte) (bool, []byte, error)
+
+	// MuSig2Cleanup removes a session from memory to free up resources.
+	MuSig2Cleanup(ctx context.Context, sessionID [32]byte) error
+}
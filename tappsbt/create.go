@@ -25,6 +25,14 @@ func FromAddresses(receiverAddrs []*address.Tap,
 		return nil, fmt.Errorf("at least one address must be specified")
 	}
 
+	// We can't send to an address that requires a feature we don't know
+	// about.
+	for _, addr := range receiverAddrs {
+		if err := addr.ValidateFeatures(); err != nil {
+			return nil, err
+		}
+	}
+
 	firstAddr := receiverAddrs[0]
 	pkt := &VPacket{
 		Inputs: []*VInput{{
@@ -39,7 +47,7 @@ func FromAddresses(receiverAddrs []*address.Tap,
 	switch firstAddr.Version {
 	case address.V0:
 		pkt.Version = V0
-	case address.V1:
+	case address.V1, address.V2:
 		pkt.Version = V1
 	default:
 		return nil, address.ErrUnknownVersion
@@ -50,8 +50,27 @@ func NewFromRawBytes(r io.Reader, b64 bool) (*VPacket, error) {
 }
 
 // NewFromPsbt returns a new instance of a VPacket struct created by reading the
-// custom fields on the given PSBT packet.
+// custom fields on the given PSBT packet. Both the legacy and the proprietary
+// key encoding are accepted.
 func NewFromPsbt(packet *psbt.Packet) (*VPacket, error) {
+	// If the packet uses the proprietary key encoding, we convert a copy of
+	// it to the legacy encoding first, so we don't modify the caller's
+	// packet.
+	if IsProprietaryPsbt(packet) {
+		packetCopy := *packet
+		packetCopy.Inputs = append(
+			[]psbt.PInput(nil), packet.Inputs...,
+		)
+		packetCopy.Outputs = append(
+			[]psbt.POutput(nil), packet.Outputs...,
+		)
+		if err := FromProprietaryPsbt(&packetCopy); err != nil {
+			return nil, err
+		}
+
+		packet = &packetCopy
+	}
+
 	// Make sure we have the correct markers for a virtual transaction.
 	if len(packet.Unknowns) != 3 {
 		return nil, fmt.Errorf("expected 3 global unknown fields, "+
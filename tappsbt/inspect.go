@@ -0,0 +1,219 @@
+package tappsbt
+
+import (
+	"sort"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+)
+
+// InputSummary is a summary of a single virtual transaction input.
+type InputSummary struct {
+	// Index is the index of the input within the virtual transaction.
+	Index int
+
+	// PrevID is the asset outpoint the input spends.
+	PrevID asset.PrevID
+
+	// Amount is the amount of the input asset. It is zero if the input
+	// asset isn't known.
+	Amount uint64
+
+	// HasAsset is true if the packet contains the input asset.
+	HasAsset bool
+
+	// HasProof is true if the packet contains the proof of the input
+	// asset.
+	HasProof bool
+
+	// AnchorValue is the value in satoshis of the on-chain output that
+	// anchors the input asset.
+	AnchorValue int64
+
+	// Signed is true if the witness for this input is present.
+	Signed bool
+}
+
+// OutputSummary is a summary of a single virtual transaction output.
+type OutputSummary struct {
+	// Index is the index of the output within the virtual transaction.
+	Index int
+
+	// Type is the type of the output.
+	Type VOutputType
+
+	// Amount is the amount of the output asset.
+	Amount uint64
+
+	// Interactive is true if the output is sent to the receiver
+	// interactively.
+	Interactive bool
+
+	// ScriptKey is the script key of the output asset.
+	ScriptKey *btcec.PublicKey
+
+	// AnchorOutputIndex is the index of the on-chain output that anchors
+	// the output asset.
+	AnchorOutputIndex uint32
+
+	// AnchorInternalKey is the internal key of the anchor output, if it is
+	// known.
+	AnchorInternalKey *btcec.PublicKey
+
+	// HasTapSibling is true if the anchor output commits to a tapscript
+	// sibling next to the Taproot Asset commitment.
+	HasTapSibling bool
+
+	// HasAsset is true if the output asset was already created.
+	HasAsset bool
+
+	// HasSplitCommitment is true if the output asset is a split asset
+	// that commits to the split root.
+	HasSplitCommitment bool
+
+	// HasProofSuffix is true if the proof suffix for the output was
+	// already created.
+	HasProofSuffix bool
+
+	// ProofDeliveryAddress is the address of the courier the proof of the
+	// output is delivered through, if any.
+	ProofDeliveryAddress string
+}
+
+// AnchorOutputSummary lists the virtual outputs that are anchored in the same
+// on-chain output.
+type AnchorOutputSummary struct {
+	// AnchorOutputIndex is the index of the on-chain output.
+	AnchorOutputIndex uint32
+
+	// OutputIndexes are the indexes of the virtual outputs that are
+	// anchored in the on-chain output.
+	OutputIndexes []int
+}
+
+// Inspection is a structured breakdown of a virtual packet, meant for
+// debugging transfers.
+type Inspection struct {
+	// Version is the version of the virtual packet.
+	Version VPacketVersion
+
+	// Network is the name of the network of the virtual packet.
+	Network string
+
+	// AssetID is the ID of the asset that is transferred. It is only set
+	// if all inputs spend the same asset.
+	AssetID *asset.ID
+
+	// Inputs are the summaries of all inputs.
+	Inputs []InputSummary
+
+	// Outputs are the summaries of all outputs.
+	Outputs []OutputSummary
+
+	// AnchorOutputs maps the on-chain outputs to the virtual outputs they
+	// anchor, ordered by the on-chain output index.
+	AnchorOutputs []AnchorOutputSummary
+
+	// MissingWitnesses are the indexes of the inputs that aren't signed
+	// yet.
+	MissingWitnesses []int
+}
+
+// Inspect returns a breakdown of the given virtual packet. Nothing in the
+// packet is verified, so this can also be used on incomplete or invalid
+// packets.
+func Inspect(vPkt *VPacket) *Inspection {
+	inspection := &Inspection{
+		Version: vPkt.Version,
+	}
+	if vPkt.ChainParams != nil {
+		inspection.Network = vPkt.ChainParams.Name
+	}
+	if assetID, err := vPkt.AssetID(); err == nil {
+		inspection.AssetID = &assetID
+	}
+
+	// The witnesses of all inputs are carried by the asset of the split
+	// root output. Without a split, there is only a single output that
+	// carries them.
+	var witnesses []asset.Witness
+	for _, vOut := range vPkt.Outputs {
+		if vOut.Asset == nil {
+			continue
+		}
+
+		if vOut.Type.IsSplitRoot() || len(vPkt.Outputs) == 1 {
+			witnesses = vOut.Asset.Witnesses()
+			break
+		}
+	}
+
+	for idx, vIn := range vPkt.Inputs {
+		summary := InputSummary{
+			Index:       idx,
+			PrevID:      vIn.PrevID,
+			HasAsset:    vIn.Asset() != nil,
+			HasProof:    vIn.Proof != nil,
+			AnchorValue: int64(vIn.Anchor.Value),
+		}
+		if vIn.Asset() != nil {
+			summary.Amount = vIn.Asset().Amount
+		}
+		if idx < len(witnesses) {
+			summary.Signed = len(witnesses[idx].TxWitness) > 0
+		}
+
+		if !summary.Signed {
+			inspection.MissingWitnesses = append(
+				inspection.MissingWitnesses, idx,
+			)
+		}
+
+		inspection.Inputs = append(inspection.Inputs, summary)
+	}
+
+	anchorOutputs := make(map[uint32][]int)
+	for idx, vOut := range vPkt.Outputs {
+		summary := OutputSummary{
+			Index:             idx,
+			Type:              vOut.Type,
+			Amount:            vOut.Amount,
+			Interactive:       vOut.Interactive,
+			ScriptKey:         vOut.ScriptKey.PubKey,
+			AnchorOutputIndex: vOut.AnchorOutputIndex,
+			AnchorInternalKey: vOut.AnchorOutputInternalKey,
+			HasAsset:          vOut.Asset != nil,
+			HasProofSuffix:    vOut.ProofSuffix != nil,
+		}
+		summary.HasTapSibling = vOut.AnchorOutputTapscriptSibling != nil
+		if vOut.Asset != nil {
+			summary.HasSplitCommitment =
+				vOut.Asset.HasSplitCommitmentWitness()
+		}
+		if vOut.ProofDeliveryAddress != nil {
+			summary.ProofDeliveryAddress =
+				vOut.ProofDeliveryAddress.String()
+		}
+
+		inspection.Outputs = append(inspection.Outputs, summary)
+
+		anchorOutputs[vOut.AnchorOutputIndex] = append(
+			anchorOutputs[vOut.AnchorOutputIndex], idx,
+		)
+	}
+
+	for anchorIdx, outputIndexes := range anchorOutputs {
+		inspection.AnchorOutputs = append(
+			inspection.AnchorOutputs, AnchorOutputSummary{
+				AnchorOutputIndex: anchorIdx,
+				OutputIndexes:     outputIndexes,
+			},
+		)
+	}
+	sort.Slice(inspection.AnchorOutputs, func(i, j int) bool {
+		return inspection.AnchorOutputs[i].AnchorOutputIndex <
+			inspection.AnchorOutputs[j].AnchorOutputIndex
+	})
+
+	return inspection
+}
@@ -0,0 +1,96 @@
+package tappsbt
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// TestInspect tests that the breakdown of a partially signed virtual packet
+// lists the missing witnesses and maps the outputs to their anchor outputs.
+func TestInspect(t *testing.T) {
+	t.Parallel()
+
+	inputAsset := asset.RandAsset(t, asset.Normal)
+	assetID := inputAsset.ID()
+
+	// The split root carries the witnesses of both inputs, but only the
+	// first one is signed.
+	rootAsset := inputAsset.Copy()
+	rootAsset.PrevWitnesses = []asset.Witness{{
+		TxWitness: wire.TxWitness{{1, 2, 3}},
+	}, {}}
+	splitAsset := inputAsset.Copy()
+	splitAsset.PrevWitnesses = []asset.Witness{{
+		PrevID: &asset.ZeroPrevID,
+		SplitCommitment: &asset.SplitCommitment{
+			RootAsset: *rootAsset,
+		},
+	}}
+
+	vPkt := &VPacket{
+		Inputs: []*VInput{{
+			PrevID: asset.PrevID{
+				OutPoint: test.RandOp(t),
+				ID:       assetID,
+			},
+			Anchor: Anchor{
+				Value: 1000,
+			},
+		}, {
+			PrevID: asset.PrevID{
+				OutPoint: test.RandOp(t),
+				ID:       assetID,
+			},
+		}},
+		Outputs: []*VOutput{{
+			Type:              TypeSimple,
+			Amount:            10,
+			AnchorOutputIndex: 2,
+			Asset:             splitAsset,
+			ScriptKey:         splitAsset.ScriptKey,
+		}, {
+			Type:              TypeSplitRoot,
+			Amount:            20,
+			AnchorOutputIndex: 0,
+			Asset:             rootAsset,
+			ScriptKey:         rootAsset.ScriptKey,
+		}, {
+			Type:              TypeSimple,
+			Amount:            30,
+			AnchorOutputIndex: 2,
+		}},
+		ChainParams: testParams,
+	}
+	vPkt.SetInputAsset(0, inputAsset)
+
+	inspection := Inspect(vPkt)
+	require.Equal(t, testParams.Name, inspection.Network)
+	require.NotNil(t, inspection.AssetID)
+	require.Equal(t, assetID, *inspection.AssetID)
+
+	require.Len(t, inspection.Inputs, 2)
+	require.True(t, inspection.Inputs[0].Signed)
+	require.True(t, inspection.Inputs[0].HasAsset)
+	require.Equal(t, inputAsset.Amount, inspection.Inputs[0].Amount)
+	require.EqualValues(t, 1000, inspection.Inputs[0].AnchorValue)
+	require.False(t, inspection.Inputs[1].Signed)
+	require.False(t, inspection.Inputs[1].HasAsset)
+	require.Equal(t, []int{1}, inspection.MissingWitnesses)
+
+	require.Len(t, inspection.Outputs, 3)
+	require.True(t, inspection.Outputs[0].HasSplitCommitment)
+	require.False(t, inspection.Outputs[1].HasSplitCommitment)
+	require.False(t, inspection.Outputs[2].HasAsset)
+
+	require.Equal(t, []AnchorOutputSummary{{
+		AnchorOutputIndex: 0,
+		OutputIndexes:     []int{1},
+	}, {
+		AnchorOutputIndex: 2,
+		OutputIndexes:     []int{0, 2},
+	}}, inspection.AnchorOutputs)
+}
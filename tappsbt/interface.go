@@ -22,10 +22,10 @@ import (
 // key types here that correspond to the custom types defined in the VPacket
 // below. We start at 0x70 because that is sufficiently high to not conflict
 // with any of the keys specified in BIP-0174. Also, 7 is leet speak for "t" as
-// in Taproot Assets. It would perhaps make sense to wrap these values in the
-// BIP-0174 defined proprietary types to make 100% sure that no parser removes
-// them. But the BIP also mentions to not remove unknown keys, so we should be
-// fine like this as well.
+// in Taproot Assets. The BIP mentions to not remove unknown keys, so this
+// legacy encoding should be kept intact by any compliant parser. For tooling
+// that isn't as lenient, the same keys can also be wrapped in BIP-0174
+// proprietary types, see EncodeAsProprietaryPsbt.
 var (
 	PsbtKeyTypeGlobalTapIsVirtualTx    = []byte{0x70}
 	PsbtKeyTypeGlobalTapChainParamsHRP = []byte{0x71}
@@ -75,6 +75,11 @@ var (
 	// commitment root hash in the BTC level anchor transaction PSBT.
 	PsbtKeyTypeOutputAssetRoot = []byte{0x71}
 
+	// PsbtKeyTypeGlobalExternalFunding is the key used to mark a minting
+	// anchor transaction PSBT as funded by an external wallet. The inputs
+	// of such a PSBT aren't signed by the backing lnd wallet.
+	PsbtKeyTypeGlobalExternalFunding = []byte{0x72}
+
 	// ErrInvalidVPacketVersion is an error returned when a VPacket version
 	// is invalid.
 	ErrInvalidVPacketVersion = fmt.Errorf("tappsbt: invalid version")
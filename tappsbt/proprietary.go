@@ -0,0 +1,374 @@
+package tappsbt
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// The proprietary encoding of a virtual packet wraps all the Taproot Asset
+// specific key types defined above in BIP-0174 proprietary keys, so generic
+// PSBT tooling is guaranteed to keep them around. A proprietary key has the
+// following layout:
+//
+//	0xfc | <compact size len(identifier)> | identifier |
+//	    <compact size subtype> | key data
+//
+// The identifier is always PsbtProprietaryIdentifier, the subtype is the
+// single byte key type of the legacy encoding (for example 0x70 for
+// PsbtKeyTypeGlobalTapIsVirtualTx) and the key data is empty for all currently
+// defined keys. The values are identical to the legacy encoding. The
+// proprietary encoding additionally carries the global key with subtype
+// PsbtProprietarySubtypeGlobalFormatVersion, which contains the version of the
+// proprietary encoding as a single byte.
+var (
+	// PsbtProprietaryIdentifier is the identifier used in all proprietary
+	// keys of a virtual packet.
+	PsbtProprietaryIdentifier = []byte("tap")
+
+	// PsbtProprietarySubtypeGlobalFormatVersion is the subtype of the
+	// global proprietary key that contains the version of the proprietary
+	// encoding.
+	PsbtProprietarySubtypeGlobalFormatVersion = byte(0x00)
+
+	// ErrInvalidProprietaryKey is returned when a proprietary key of a
+	// virtual packet can't be parsed or isn't known.
+	ErrInvalidProprietaryKey = errors.New("tappsbt: invalid proprietary " +
+		"key")
+
+	// ErrUnknownProprietaryVersion is returned when a virtual packet uses
+	// a version of the proprietary encoding that isn't known.
+	ErrUnknownProprietaryVersion = errors.New("tappsbt: unknown " +
+		"proprietary encoding version")
+)
+
+// ProprietaryFormatVersion is the version of the proprietary key encoding of a
+// virtual packet. This is independent of the VPacketVersion, which describes
+// the semantics of the packet itself.
+type ProprietaryFormatVersion uint8
+
+const (
+	// ProprietaryFormatV0 is the initial version of the proprietary key
+	// encoding.
+	ProprietaryFormatV0 ProprietaryFormatVersion = 0
+
+	// LatestProprietaryFormatVersion is the latest version of the
+	// proprietary key encoding.
+	LatestProprietaryFormatVersion = ProprietaryFormatV0
+)
+
+// proprietaryKeyTypes is the set of key types that are wrapped in proprietary
+// keys, per PSBT map.
+var (
+	proprietaryGlobalKeyTypes = [][]byte{
+		PsbtKeyTypeGlobalTapIsVirtualTx,
+		PsbtKeyTypeGlobalTapChainParamsHRP,
+		PsbtKeyTypeGlobalTapPsbtVersion,
+	}
+
+	proprietaryInputKeyTypes = [][]byte{
+		PsbtKeyTypeInputTapPrevID,
+		PsbtKeyTypeInputTapAnchorValue,
+		PsbtKeyTypeInputTapAnchorPkScript,
+		PsbtKeyTypeInputTapAnchorSigHashType,
+		PsbtKeyTypeInputTapAnchorInternalKey,
+		PsbtKeyTypeInputTapAnchorMerkleRoot,
+		PsbtKeyTypeInputTapAnchorOutputBip32Derivation,
+		PsbtKeyTypeInputTapAnchorOutputTaprootBip32Derivation,
+		PsbtKeyTypeInputTapAnchorTapscriptSibling,
+		PsbtKeyTypeInputTapAsset,
+		PsbtKeyTypeInputTapAssetProof,
+	}
+
+	proprietaryOutputKeyTypes = [][]byte{
+		PsbtKeyTypeOutputTapType,
+		PsbtKeyTypeOutputTapIsInteractive,
+		PsbtKeyTypeOutputTapAnchorOutputIndex,
+		PsbtKeyTypeOutputTapAnchorOutputInternalKey,
+		PsbtKeyTypeOutputTapAnchorOutputBip32Derivation,
+		PsbtKeyTypeOutputTapAnchorOutputTaprootBip32Derivation,
+		PsbtKeyTypeOutputTapAsset,
+		PsbtKeyTypeOutputTapSplitAsset,
+		PsbtKeyTypeOutputTapAnchorTapscriptSibling,
+		PsbtKeyTypeOutputTapAssetVersion,
+		PsbtKeyTypeOutputTapProofDeliveryAddress,
+		PsbtKeyTypeOutputTapAssetProofSuffix,
+		PsbtKeyTypeOutputTapAssetLockTime,
+		PsbtKeyTypeOutputTapAssetRelativeLockTime,
+	}
+)
+
+// EncodeAsProprietaryPsbt returns the PSBT encoding of the current virtual
+// packet with all Taproot Asset specific fields wrapped in BIP-0174
+// proprietary keys.
+func (p *VPacket) EncodeAsProprietaryPsbt() (*psbt.Packet, error) {
+	packet, err := p.EncodeAsPsbt()
+	if err != nil {
+		return nil, err
+	}
+
+	ToProprietaryPsbt(packet)
+
+	return packet, nil
+}
+
+// SerializeProprietary creates a binary serialization of the referenced
+// VPacket struct using the proprietary key encoding.
+func (p *VPacket) SerializeProprietary(w io.Writer) error {
+	packet, err := p.EncodeAsProprietaryPsbt()
+	if err != nil {
+		return fmt.Errorf("error encoding as PSBT: %w", err)
+	}
+
+	return packet.Serialize(w)
+}
+
+// B64EncodeProprietary returns the base64 encoding of the serialization of the
+// current virtual packet using the proprietary key encoding.
+func (p *VPacket) B64EncodeProprietary() (string, error) {
+	var b bytes.Buffer
+	if err := p.SerializeProprietary(&b); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(b.Bytes()), nil
+}
+
+// IsProprietaryPsbt returns true if the given PSBT packet contains a virtual
+// packet in the proprietary key encoding.
+func IsProprietaryPsbt(packet *psbt.Packet) bool {
+	for _, field := range packet.Unknowns {
+		subtype, _, err := parseProprietaryKey(field.Key)
+		if err != nil {
+			continue
+		}
+
+		if subtype == PsbtProprietarySubtypeGlobalFormatVersion {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ToProprietaryPsbt converts the Taproot Asset specific fields of the given
+// PSBT packet from the legacy encoding to the proprietary key encoding in
+// place.
+func ToProprietaryPsbt(packet *psbt.Packet) {
+	if IsProprietaryPsbt(packet) {
+		return
+	}
+
+	globals := wrapProprietaryFields(
+		packet.Unknowns, proprietaryGlobalKeyTypes,
+	)
+	packet.Unknowns = append([]*customPsbtField{{
+		Key: proprietaryKey(PsbtProprietarySubtypeGlobalFormatVersion),
+		Value: []byte{
+			uint8(LatestProprietaryFormatVersion),
+		},
+	}}, globals...)
+
+	for idx := range packet.Inputs {
+		pIn := &packet.Inputs[idx]
+		pIn.Unknowns = wrapProprietaryFields(
+			pIn.Unknowns, proprietaryInputKeyTypes,
+		)
+	}
+
+	for idx := range packet.Outputs {
+		pOut := &packet.Outputs[idx]
+		pOut.Unknowns = wrapProprietaryFields(
+			pOut.Unknowns, proprietaryOutputKeyTypes,
+		)
+	}
+}
+
+// FromProprietaryPsbt converts the Taproot Asset specific fields of the given
+// PSBT packet from the proprietary key encoding to the legacy encoding in
+// place. Proprietary keys of other identifiers are left untouched in the
+// inputs and outputs but are removed from the global map, as the legacy
+// encoding doesn't allow any additional global fields.
+func FromProprietaryPsbt(packet *psbt.Packet) error {
+	if !IsProprietaryPsbt(packet) {
+		return nil
+	}
+
+	var (
+		globals []*customPsbtField
+		version *ProprietaryFormatVersion
+	)
+	for _, field := range packet.Unknowns {
+		subtype, keyData, err := parseProprietaryKey(field.Key)
+		if err != nil {
+			// This isn't one of our keys, skip it.
+			continue
+		}
+
+		if subtype != PsbtProprietarySubtypeGlobalFormatVersion {
+			globals = append(globals, field)
+			continue
+		}
+
+		if len(keyData) != 0 || len(field.Value) != 1 {
+			return fmt.Errorf("%w: invalid format version field",
+				ErrInvalidProprietaryKey)
+		}
+
+		v := ProprietaryFormatVersion(field.Value[0])
+		version = &v
+	}
+
+	if version == nil || *version > LatestProprietaryFormatVersion {
+		return ErrUnknownProprietaryVersion
+	}
+
+	globals, err := unwrapProprietaryFields(
+		globals, proprietaryGlobalKeyTypes,
+	)
+	if err != nil {
+		return fmt.Errorf("error unwrapping global fields: %w", err)
+	}
+	packet.Unknowns = globals
+
+	for idx := range packet.Inputs {
+		pIn := &packet.Inputs[idx]
+		pIn.Unknowns, err = unwrapProprietaryFields(
+			pIn.Unknowns, proprietaryInputKeyTypes,
+		)
+		if err != nil {
+			return fmt.Errorf("error unwrapping fields of input "+
+				"%d: %w", idx, err)
+		}
+	}
+
+	for idx := range packet.Outputs {
+		pOut := &packet.Outputs[idx]
+		pOut.Unknowns, err = unwrapProprietaryFields(
+			pOut.Unknowns, proprietaryOutputKeyTypes,
+		)
+		if err != nil {
+			return fmt.Errorf("error unwrapping fields of output "+
+				"%d: %w", idx, err)
+		}
+	}
+
+	return nil
+}
+
+// wrapProprietaryFields wraps all fields with one of the given key types in a
+// proprietary key. All other fields are returned unchanged.
+func wrapProprietaryFields(fields []*customPsbtField,
+	keyTypes [][]byte) []*customPsbtField {
+
+	result := make([]*customPsbtField, 0, len(fields))
+	for _, field := range fields {
+		if len(field.Key) == 0 || !isKnownKeyType(field.Key, keyTypes) {
+			result = append(result, field)
+			continue
+		}
+
+		// All our key types are single bytes, anything after that is
+		// key data that we carry over.
+		key := proprietaryKey(field.Key[0])
+		key = append(key, field.Key[1:]...)
+
+		result = append(result, &customPsbtField{
+			Key:   key,
+			Value: field.Value,
+		})
+	}
+
+	return result
+}
+
+// unwrapProprietaryFields unwraps all proprietary fields with our identifier
+// into their legacy representation. Fields with other identifiers are returned
+// unchanged, an unknown subtype with our identifier results in an error.
+func unwrapProprietaryFields(fields []*customPsbtField,
+	keyTypes [][]byte) ([]*customPsbtField, error) {
+
+	result := make([]*customPsbtField, 0, len(fields))
+	for _, field := range fields {
+		subtype, keyData, err := parseProprietaryKey(field.Key)
+		if err != nil {
+			result = append(result, field)
+			continue
+		}
+
+		key := append([]byte{subtype}, keyData...)
+		if !isKnownKeyType(key, keyTypes) {
+			return nil, fmt.Errorf("%w: unknown subtype %x",
+				ErrInvalidProprietaryKey, subtype)
+		}
+
+		result = append(result, &customPsbtField{
+			Key:   key,
+			Value: field.Value,
+		})
+	}
+
+	return result, nil
+}
+
+// isKnownKeyType returns true if the first byte of the given key is one of the
+// given key types.
+func isKnownKeyType(key []byte, keyTypes [][]byte) bool {
+	for _, keyType := range keyTypes {
+		if key[0] == keyType[0] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// proprietaryKey returns the proprietary key with our identifier and the given
+// subtype, without any key data.
+func proprietaryKey(subtype byte) []byte {
+	var b bytes.Buffer
+	b.WriteByte(psbt.ProprietaryGlobalType)
+
+	// Writing to a bytes.Buffer never fails.
+	_ = wire.WriteVarBytes(&b, 0, PsbtProprietaryIdentifier)
+	_ = wire.WriteVarInt(&b, 0, uint64(subtype))
+
+	return b.Bytes()
+}
+
+// parseProprietaryKey parses a proprietary key with our identifier and returns
+// its subtype and key data. If the key isn't a proprietary key or uses a
+// different identifier, ErrInvalidProprietaryKey is returned.
+func parseProprietaryKey(key []byte) (byte, []byte, error) {
+	if len(key) == 0 || key[0] != psbt.ProprietaryGlobalType {
+		return 0, nil, ErrInvalidProprietaryKey
+	}
+
+	r := bytes.NewReader(key[1:])
+	identifier, err := wire.ReadVarBytes(
+		r, 0, psbt.MaxPsbtKeyLength, "identifier",
+	)
+	if err != nil {
+		return 0, nil, ErrInvalidProprietaryKey
+	}
+	if !bytes.Equal(identifier, PsbtProprietaryIdentifier) {
+		return 0, nil, ErrInvalidProprietaryKey
+	}
+
+	subtype, err := wire.ReadVarInt(r, 0)
+	if err != nil || subtype > 0xff {
+		return 0, nil, ErrInvalidProprietaryKey
+	}
+
+	keyData, err := io.ReadAll(r)
+	if err != nil {
+		return 0, nil, ErrInvalidProprietaryKey
+	}
+
+	return byte(subtype), keyData, nil
+}
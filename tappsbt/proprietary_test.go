@@ -0,0 +1,123 @@
+package tappsbt
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// TestProprietaryEncoding makes sure all packets of the BIP test vectors
+// round trip through the proprietary key encoding and that converting between
+// the legacy and proprietary encoding is lossless.
+func TestProprietaryEncoding(t *testing.T) {
+	t.Parallel()
+
+	testVectors := &TestVectors{}
+	test.ParseTestVectors(t, generatedTestVectorName, &testVectors)
+
+	for _, validCase := range testVectors.ValidTestCases {
+		validCase := validCase
+
+		t.Run(validCase.Comment, func(tt *testing.T) {
+			tt.Parallel()
+
+			p := validCase.Packet.ToVPacket(tt)
+
+			packet, err := p.EncodeAsProprietaryPsbt()
+			require.NoError(tt, err)
+			require.True(tt, IsProprietaryPsbt(packet))
+
+			// None of the legacy key types must be left in the
+			// packet.
+			assertProprietaryOnly(tt, packet.Unknowns)
+			for _, pIn := range packet.Inputs {
+				assertProprietaryOnly(tt, pIn.Unknowns)
+			}
+			for _, pOut := range packet.Outputs {
+				assertProprietaryOnly(tt, pOut.Unknowns)
+			}
+
+			// Decoding the serialized packet must result in the
+			// same virtual packet.
+			var b bytes.Buffer
+			require.NoError(tt, p.SerializeProprietary(&b))
+
+			decoded, err := NewFromRawBytes(&b, false)
+			require.NoError(tt, err)
+			require.Equal(tt, p, decoded)
+
+			// Converting back to the legacy encoding must result in
+			// exactly the bytes the legacy encoder produces.
+			require.NoError(tt, FromProprietaryPsbt(packet))
+			require.False(tt, IsProprietaryPsbt(packet))
+
+			var legacy bytes.Buffer
+			require.NoError(tt, packet.Serialize(&legacy))
+
+			var expected bytes.Buffer
+			require.NoError(tt, p.Serialize(&expected))
+			require.Equal(tt, expected.Bytes(), legacy.Bytes())
+		})
+	}
+}
+
+// TestProprietaryEncodingErrors tests that invalid proprietary keys are
+// rejected and that foreign proprietary keys are left untouched.
+func TestProprietaryEncodingErrors(t *testing.T) {
+	t.Parallel()
+
+	testVectors := &TestVectors{}
+	test.ParseTestVectors(t, generatedTestVectorName, &testVectors)
+	p := testVectors.ValidTestCases[0].Packet.ToVPacket(t)
+
+	// An unknown format version must be rejected.
+	packet, err := p.EncodeAsProprietaryPsbt()
+	require.NoError(t, err)
+	packet.Unknowns[0].Value = []byte{
+		uint8(LatestProprietaryFormatVersion) + 1,
+	}
+	_, err = NewFromPsbt(packet)
+	require.ErrorIs(t, err, ErrUnknownProprietaryVersion)
+
+	// An unknown subtype in our namespace must be rejected.
+	packet, err = p.EncodeAsProprietaryPsbt()
+	require.NoError(t, err)
+	packet.Inputs[0].Unknowns = append(
+		packet.Inputs[0].Unknowns, &psbt.Unknown{
+			Key:   proprietaryKey(0x6f),
+			Value: []byte{0x01},
+		},
+	)
+	_, err = NewFromPsbt(packet)
+	require.ErrorIs(t, err, ErrInvalidProprietaryKey)
+
+	// Proprietary keys of other applications must be kept in the inputs
+	// and outputs.
+	foreignKey := []byte{
+		psbt.ProprietaryGlobalType, 0x03, 'f', 'o', 'o', 0x70,
+	}
+	packet, err = p.EncodeAsProprietaryPsbt()
+	require.NoError(t, err)
+	packet.Outputs[0].Unknowns = append(
+		packet.Outputs[0].Unknowns, &psbt.Unknown{
+			Key:   foreignKey,
+			Value: []byte{0x01},
+		},
+	)
+	require.NoError(t, FromProprietaryPsbt(packet))
+	require.Contains(t, packet.Outputs[0].Unknowns, &psbt.Unknown{
+		Key:   foreignKey,
+		Value: []byte{0x01},
+	})
+}
+
+// assertProprietaryOnly asserts that all the given fields use a proprietary
+// key.
+func assertProprietaryOnly(t *testing.T, fields []*customPsbtField) {
+	for _, field := range fields {
+		require.Equal(t, byte(psbt.ProprietaryGlobalType), field.Key[0])
+	}
+}
@@ -77,6 +77,67 @@ type AssetWalletClient interface {
 	// recognized by the wallet automatically. Declaring a script key will make any
 	// assets sent to the script key be recognized as being local assets.
 	DeclareScriptKey(ctx context.Context, in *DeclareScriptKeyRequest, opts ...grpc.CallOption) (*DeclareScriptKeyResponse, error)
+	// tapcli: `assets validatevpsbt`
+	// ValidateVirtualPsbt statelessly validates a virtual PSBT built by an
+	// external party, without signing anything or touching the wallet. The input
+	// commitments are derived from the proofs of the virtual inputs, which must
+	// therefore be set. All problems found are returned as structured findings.
+	ValidateVirtualPsbt(ctx context.Context, in *ValidateVirtualPsbtRequest, opts ...grpc.CallOption) (*ValidateVirtualPsbtResponse, error)
+	// tapcli: `assets inspectvpsbt`
+	// InspectVirtualPsbt returns a readable breakdown of a virtual PSBT or of a
+	// pending transfer: the inputs with their previous asset outpoints and
+	// amounts, the outputs with their script keys and split commitments, the
+	// on-chain outputs the assets are anchored in and the inputs that are still
+	// missing a witness. Nothing is verified, so incomplete or invalid packets
+	// can be inspected too.
+	InspectVirtualPsbt(ctx context.Context, in *InspectVirtualPsbtRequest, opts ...grpc.CallOption) (*InspectVirtualPsbtResponse, error)
+	// CreateMuSig2Session starts a new MuSig2 signing session for the key spend
+	// path of a virtual PSBT input whose script key is the combined key of
+	// several signers. The returned session contains the public nonce of the
+	// local signer that needs to be shared with the remote signers. Sessions are
+	// persisted, so they survive a restart of tapd. The local secret nonce only
+	// lives in lnd though, so a session has to be started over if lnd restarts
+	// before the local signer signed.
+	CreateMuSig2Session(ctx context.Context, in *CreateMuSig2SessionRequest, opts ...grpc.CallOption) (*MuSig2SessionResponse, error)
+	// RegisterMuSig2Nonce registers the public nonce of a remote signer of a
+	// MuSig2 session. Once the nonces of all signers are known, the session can
+	// be signed.
+	RegisterMuSig2Nonce(ctx context.Context, in *RegisterMuSig2NonceRequest, opts ...grpc.CallOption) (*MuSig2SessionResponse, error)
+	// SignMuSig2Session creates the partial signature of the local signer of a
+	// MuSig2 session that has the nonces of all signers. The partial signature
+	// needs to be shared with the remote signers.
+	SignMuSig2Session(ctx context.Context, in *SignMuSig2SessionRequest, opts ...grpc.CallOption) (*MuSig2SessionResponse, error)
+	// RegisterMuSig2PartialSig registers the partial signature of a remote
+	// signer of a MuSig2 session.
+	RegisterMuSig2PartialSig(ctx context.Context, in *RegisterMuSig2PartialSigRequest, opts ...grpc.CallOption) (*MuSig2SessionResponse, error)
+	// FinalizeMuSig2Session combines the partial signatures of all signers of a
+	// MuSig2 session into the final signature and adds it as the witness of the
+	// signed input. Once all inputs of the virtual PSBT are signed, the transfer
+	// is verified.
+	FinalizeMuSig2Session(ctx context.Context, in *FinalizeMuSig2SessionRequest, opts ...grpc.CallOption) (*FinalizeMuSig2SessionResponse, error)
+	// AbortMuSig2Session cancels a MuSig2 session that isn't finalized yet. An
+	// aborted session can't be resumed.
+	AbortMuSig2Session(ctx context.Context, in *AbortMuSig2SessionRequest, opts ...grpc.CallOption) (*AbortMuSig2SessionResponse, error)
+	// ListMuSig2Sessions lists all MuSig2 sessions, optionally filtered by their
+	// state.
+	ListMuSig2Sessions(ctx context.Context, in *ListMuSig2SessionsRequest, opts ...grpc.CallOption) (*ListMuSig2SessionsResponse, error)
+	// tapcli: `assets exportanchor`
+	// ExportAnchorPsbt carries out a transfer up to the point where the BTC level
+	// anchor transaction needs to be signed. The funded anchor transaction is
+	// returned instead of being signed by the wallet, so it can be signed by an
+	// external signer, for example a hardware wallet. The transfer is continued
+	// with ImportSignedAnchorPsbt or aborted with CancelAnchorExport.
+	ExportAnchorPsbt(ctx context.Context, in *ExportAnchorPsbtRequest, opts ...grpc.CallOption) (*ExportAnchorPsbtResponse, error)
+	// tapcli: `assets importanchor`
+	// ImportSignedAnchorPsbt continues the transfer of a previously exported
+	// anchor transaction with the externally signed version of it. The transfer
+	// is then logged and broadcast like any other transfer.
+	ImportSignedAnchorPsbt(ctx context.Context, in *ImportSignedAnchorPsbtRequest, opts ...grpc.CallOption) (*taprpc.SendAssetResponse, error)
+	// tapcli: `assets cancelexport`
+	// CancelAnchorExport cancels the transfer of a previously exported anchor
+	// transaction. The BTC inputs the wallet added to pay for the fees are
+	// released immediately, the asset inputs once their lease expires.
+	CancelAnchorExport(ctx context.Context, in *CancelAnchorExportRequest, opts ...grpc.CallOption) (*CancelAnchorExportResponse, error)
 }
 
 type assetWalletClient struct {
@@ -204,6 +265,114 @@ func (c *assetWalletClient) DeclareScriptKey(ctx context.Context, in *DeclareScr
 	return out, nil
 }
 
+func (c *assetWalletClient) ValidateVirtualPsbt(ctx context.Context, in *ValidateVirtualPsbtRequest, opts ...grpc.CallOption) (*ValidateVirtualPsbtResponse, error) {
+	out := new(ValidateVirtualPsbtResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/ValidateVirtualPsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) InspectVirtualPsbt(ctx context.Context, in *InspectVirtualPsbtRequest, opts ...grpc.CallOption) (*InspectVirtualPsbtResponse, error) {
+	out := new(InspectVirtualPsbtResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/InspectVirtualPsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) CreateMuSig2Session(ctx context.Context, in *CreateMuSig2SessionRequest, opts ...grpc.CallOption) (*MuSig2SessionResponse, error) {
+	out := new(MuSig2SessionResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/CreateMuSig2Session", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) RegisterMuSig2Nonce(ctx context.Context, in *RegisterMuSig2NonceRequest, opts ...grpc.CallOption) (*MuSig2SessionResponse, error) {
+	out := new(MuSig2SessionResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/RegisterMuSig2Nonce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) SignMuSig2Session(ctx context.Context, in *SignMuSig2SessionRequest, opts ...grpc.CallOption) (*MuSig2SessionResponse, error) {
+	out := new(MuSig2SessionResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/SignMuSig2Session", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) RegisterMuSig2PartialSig(ctx context.Context, in *RegisterMuSig2PartialSigRequest, opts ...grpc.CallOption) (*MuSig2SessionResponse, error) {
+	out := new(MuSig2SessionResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/RegisterMuSig2PartialSig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) FinalizeMuSig2Session(ctx context.Context, in *FinalizeMuSig2SessionRequest, opts ...grpc.CallOption) (*FinalizeMuSig2SessionResponse, error) {
+	out := new(FinalizeMuSig2SessionResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/FinalizeMuSig2Session", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) AbortMuSig2Session(ctx context.Context, in *AbortMuSig2SessionRequest, opts ...grpc.CallOption) (*AbortMuSig2SessionResponse, error) {
+	out := new(AbortMuSig2SessionResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/AbortMuSig2Session", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) ListMuSig2Sessions(ctx context.Context, in *ListMuSig2SessionsRequest, opts ...grpc.CallOption) (*ListMuSig2SessionsResponse, error) {
+	out := new(ListMuSig2SessionsResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/ListMuSig2Sessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) ExportAnchorPsbt(ctx context.Context, in *ExportAnchorPsbtRequest, opts ...grpc.CallOption) (*ExportAnchorPsbtResponse, error) {
+	out := new(ExportAnchorPsbtResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/ExportAnchorPsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) ImportSignedAnchorPsbt(ctx context.Context, in *ImportSignedAnchorPsbtRequest, opts ...grpc.CallOption) (*taprpc.SendAssetResponse, error) {
+	out := new(taprpc.SendAssetResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/ImportSignedAnchorPsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) CancelAnchorExport(ctx context.Context, in *CancelAnchorExportRequest, opts ...grpc.CallOption) (*CancelAnchorExportResponse, error) {
+	out := new(CancelAnchorExportResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/CancelAnchorExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AssetWalletServer is the server API for AssetWallet service.
 // All implementations must embed UnimplementedAssetWalletServer
 // for forward compatibility
@@ -266,6 +435,67 @@ type AssetWalletServer interface {
 	// recognized by the wallet automatically. Declaring a script key will make any
 	// assets sent to the script key be recognized as being local assets.
 	DeclareScriptKey(context.Context, *DeclareScriptKeyRequest) (*DeclareScriptKeyResponse, error)
+	// tapcli: `assets validatevpsbt`
+	// ValidateVirtualPsbt statelessly validates a virtual PSBT built by an
+	// external party, without signing anything or touching the wallet. The input
+	// commitments are derived from the proofs of the virtual inputs, which must
+	// therefore be set. All problems found are returned as structured findings.
+	ValidateVirtualPsbt(context.Context, *ValidateVirtualPsbtRequest) (*ValidateVirtualPsbtResponse, error)
+	// tapcli: `assets inspectvpsbt`
+	// InspectVirtualPsbt returns a readable breakdown of a virtual PSBT or of a
+	// pending transfer: the inputs with their previous asset outpoints and
+	// amounts, the outputs with their script keys and split commitments, the
+	// on-chain outputs the assets are anchored in and the inputs that are still
+	// missing a witness. Nothing is verified, so incomplete or invalid packets
+	// can be inspected too.
+	InspectVirtualPsbt(context.Context, *InspectVirtualPsbtRequest) (*InspectVirtualPsbtResponse, error)
+	// CreateMuSig2Session starts a new MuSig2 signing session for the key spend
+	// path of a virtual PSBT input whose script key is the combined key of
+	// several signers. The returned session contains the public nonce of the
+	// local signer that needs to be shared with the remote signers. Sessions are
+	// persisted, so they survive a restart of tapd. The local secret nonce only
+	// lives in lnd though, so a session has to be started over if lnd restarts
+	// before the local signer signed.
+	CreateMuSig2Session(context.Context, *CreateMuSig2SessionRequest) (*MuSig2SessionResponse, error)
+	// RegisterMuSig2Nonce registers the public nonce of a remote signer of a
+	// MuSig2 session. Once the nonces of all signers are known, the session can
+	// be signed.
+	RegisterMuSig2Nonce(context.Context, *RegisterMuSig2NonceRequest) (*MuSig2SessionResponse, error)
+	// SignMuSig2Session creates the partial signature of the local signer of a
+	// MuSig2 session that has the nonces of all signers. The partial signature
+	// needs to be shared with the remote signers.
+	SignMuSig2Session(context.Context, *SignMuSig2SessionRequest) (*MuSig2SessionResponse, error)
+	// RegisterMuSig2PartialSig registers the partial signature of a remote
+	// signer of a MuSig2 session.
+	RegisterMuSig2PartialSig(context.Context, *RegisterMuSig2PartialSigRequest) (*MuSig2SessionResponse, error)
+	// FinalizeMuSig2Session combines the partial signatures of all signers of a
+	// MuSig2 session into the final signature and adds it as the witness of the
+	// signed input. Once all inputs of the virtual PSBT are signed, the transfer
+	// is verified.
+	FinalizeMuSig2Session(context.Context, *FinalizeMuSig2SessionRequest) (*FinalizeMuSig2SessionResponse, error)
+	// AbortMuSig2Session cancels a MuSig2 session that isn't finalized yet. An
+	// aborted session can't be resumed.
+	AbortMuSig2Session(context.Context, *AbortMuSig2SessionRequest) (*AbortMuSig2SessionResponse, error)
+	// ListMuSig2Sessions lists all MuSig2 sessions, optionally filtered by their
+	// state.
+	ListMuSig2Sessions(context.Context, *ListMuSig2SessionsRequest) (*ListMuSig2SessionsResponse, error)
+	// tapcli: `assets exportanchor`
+	// ExportAnchorPsbt carries out a transfer up to the point where the BTC level
+	// anchor transaction needs to be signed. The funded anchor transaction is
+	// returned instead of being signed by the wallet, so it can be signed by an
+	// external signer, for example a hardware wallet. The transfer is continued
+	// with ImportSignedAnchorPsbt or aborted with CancelAnchorExport.
+	ExportAnchorPsbt(context.Context, *ExportAnchorPsbtRequest) (*ExportAnchorPsbtResponse, error)
+	// tapcli: `assets importanchor`
+	// ImportSignedAnchorPsbt continues the transfer of a previously exported
+	// anchor transaction with the externally signed version of it. The transfer
+	// is then logged and broadcast like any other transfer.
+	ImportSignedAnchorPsbt(context.Context, *ImportSignedAnchorPsbtRequest) (*taprpc.SendAssetResponse, error)
+	// tapcli: `assets cancelexport`
+	// CancelAnchorExport cancels the transfer of a previously exported anchor
+	// transaction. The BTC inputs the wallet added to pay for the fees are
+	// released immediately, the asset inputs once their lease expires.
+	CancelAnchorExport(context.Context, *CancelAnchorExportRequest) (*CancelAnchorExportResponse, error)
 	mustEmbedUnimplementedAssetWalletServer()
 }
 
@@ -312,6 +542,42 @@ func (UnimplementedAssetWalletServer) RemoveUTXOLease(context.Context, *RemoveUT
 func (UnimplementedAssetWalletServer) DeclareScriptKey(context.Context, *DeclareScriptKeyRequest) (*DeclareScriptKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeclareScriptKey not implemented")
 }
+func (UnimplementedAssetWalletServer) ValidateVirtualPsbt(context.Context, *ValidateVirtualPsbtRequest) (*ValidateVirtualPsbtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateVirtualPsbt not implemented")
+}
+func (UnimplementedAssetWalletServer) InspectVirtualPsbt(context.Context, *InspectVirtualPsbtRequest) (*InspectVirtualPsbtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectVirtualPsbt not implemented")
+}
+func (UnimplementedAssetWalletServer) CreateMuSig2Session(context.Context, *CreateMuSig2SessionRequest) (*MuSig2SessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMuSig2Session not implemented")
+}
+func (UnimplementedAssetWalletServer) RegisterMuSig2Nonce(context.Context, *RegisterMuSig2NonceRequest) (*MuSig2SessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterMuSig2Nonce not implemented")
+}
+func (UnimplementedAssetWalletServer) SignMuSig2Session(context.Context, *SignMuSig2SessionRequest) (*MuSig2SessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignMuSig2Session not implemented")
+}
+func (UnimplementedAssetWalletServer) RegisterMuSig2PartialSig(context.Context, *RegisterMuSig2PartialSigRequest) (*MuSig2SessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterMuSig2PartialSig not implemented")
+}
+func (UnimplementedAssetWalletServer) FinalizeMuSig2Session(context.Context, *FinalizeMuSig2SessionRequest) (*FinalizeMuSig2SessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeMuSig2Session not implemented")
+}
+func (UnimplementedAssetWalletServer) AbortMuSig2Session(context.Context, *AbortMuSig2SessionRequest) (*AbortMuSig2SessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortMuSig2Session not implemented")
+}
+func (UnimplementedAssetWalletServer) ListMuSig2Sessions(context.Context, *ListMuSig2SessionsRequest) (*ListMuSig2SessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMuSig2Sessions not implemented")
+}
+func (UnimplementedAssetWalletServer) ExportAnchorPsbt(context.Context, *ExportAnchorPsbtRequest) (*ExportAnchorPsbtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAnchorPsbt not implemented")
+}
+func (UnimplementedAssetWalletServer) ImportSignedAnchorPsbt(context.Context, *ImportSignedAnchorPsbtRequest) (*taprpc.SendAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportSignedAnchorPsbt not implemented")
+}
+func (UnimplementedAssetWalletServer) CancelAnchorExport(context.Context, *CancelAnchorExportRequest) (*CancelAnchorExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAnchorExport not implemented")
+}
 func (UnimplementedAssetWalletServer) mustEmbedUnimplementedAssetWalletServer() {}
 
 // UnsafeAssetWalletServer may be embedded to opt out of forward compatibility for this service.
@@ -559,6 +825,222 @@ func _AssetWallet_DeclareScriptKey_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AssetWallet_ValidateVirtualPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateVirtualPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).ValidateVirtualPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/ValidateVirtualPsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).ValidateVirtualPsbt(ctx, req.(*ValidateVirtualPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_InspectVirtualPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectVirtualPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).InspectVirtualPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/InspectVirtualPsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).InspectVirtualPsbt(ctx, req.(*InspectVirtualPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_CreateMuSig2Session_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMuSig2SessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).CreateMuSig2Session(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/CreateMuSig2Session",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).CreateMuSig2Session(ctx, req.(*CreateMuSig2SessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_RegisterMuSig2Nonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterMuSig2NonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).RegisterMuSig2Nonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/RegisterMuSig2Nonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).RegisterMuSig2Nonce(ctx, req.(*RegisterMuSig2NonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_SignMuSig2Session_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignMuSig2SessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).SignMuSig2Session(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/SignMuSig2Session",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).SignMuSig2Session(ctx, req.(*SignMuSig2SessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_RegisterMuSig2PartialSig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterMuSig2PartialSigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).RegisterMuSig2PartialSig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/RegisterMuSig2PartialSig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).RegisterMuSig2PartialSig(ctx, req.(*RegisterMuSig2PartialSigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_FinalizeMuSig2Session_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizeMuSig2SessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).FinalizeMuSig2Session(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/FinalizeMuSig2Session",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).FinalizeMuSig2Session(ctx, req.(*FinalizeMuSig2SessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_AbortMuSig2Session_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortMuSig2SessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).AbortMuSig2Session(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/AbortMuSig2Session",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).AbortMuSig2Session(ctx, req.(*AbortMuSig2SessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_ListMuSig2Sessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMuSig2SessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).ListMuSig2Sessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/ListMuSig2Sessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).ListMuSig2Sessions(ctx, req.(*ListMuSig2SessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_ExportAnchorPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAnchorPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).ExportAnchorPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/ExportAnchorPsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).ExportAnchorPsbt(ctx, req.(*ExportAnchorPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_ImportSignedAnchorPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportSignedAnchorPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).ImportSignedAnchorPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/ImportSignedAnchorPsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).ImportSignedAnchorPsbt(ctx, req.(*ImportSignedAnchorPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_CancelAnchorExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAnchorExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).CancelAnchorExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/CancelAnchorExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).CancelAnchorExport(ctx, req.(*CancelAnchorExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AssetWallet_ServiceDesc is the grpc.ServiceDesc for AssetWallet service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -618,6 +1100,54 @@ var AssetWallet_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeclareScriptKey",
 			Handler:    _AssetWallet_DeclareScriptKey_Handler,
 		},
+		{
+			MethodName: "ValidateVirtualPsbt",
+			Handler:    _AssetWallet_ValidateVirtualPsbt_Handler,
+		},
+		{
+			MethodName: "InspectVirtualPsbt",
+			Handler:    _AssetWallet_InspectVirtualPsbt_Handler,
+		},
+		{
+			MethodName: "CreateMuSig2Session",
+			Handler:    _AssetWallet_CreateMuSig2Session_Handler,
+		},
+		{
+			MethodName: "RegisterMuSig2Nonce",
+			Handler:    _AssetWallet_RegisterMuSig2Nonce_Handler,
+		},
+		{
+			MethodName: "SignMuSig2Session",
+			Handler:    _AssetWallet_SignMuSig2Session_Handler,
+		},
+		{
+			MethodName: "RegisterMuSig2PartialSig",
+			Handler:    _AssetWallet_RegisterMuSig2PartialSig_Handler,
+		},
+		{
+			MethodName: "FinalizeMuSig2Session",
+			Handler:    _AssetWallet_FinalizeMuSig2Session_Handler,
+		},
+		{
+			MethodName: "AbortMuSig2Session",
+			Handler:    _AssetWallet_AbortMuSig2Session_Handler,
+		},
+		{
+			MethodName: "ListMuSig2Sessions",
+			Handler:    _AssetWallet_ListMuSig2Sessions_Handler,
+		},
+		{
+			MethodName: "ExportAnchorPsbt",
+			Handler:    _AssetWallet_ExportAnchorPsbt_Handler,
+		},
+		{
+			MethodName: "ImportSignedAnchorPsbt",
+			Handler:    _AssetWallet_ImportSignedAnchorPsbt_Handler,
+		},
+		{
+			MethodName: "CancelAnchorExport",
+			Handler:    _AssetWallet_CancelAnchorExport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "assetwalletrpc/assetwallet.proto",
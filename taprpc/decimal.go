@@ -0,0 +1,84 @@
+package taprpc
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// maxDecimalDisplay is the maximum decimal display an amount can be formatted
+// or parsed with. It mirrors proof.MaxDecDisplay, which can't be imported
+// here.
+const maxDecimalDisplay = 12
+
+var (
+	// ErrInvalidDecimalAmount is returned if a decimal amount can't be
+	// parsed.
+	ErrInvalidDecimalAmount = errors.New("invalid decimal amount")
+
+	// ErrDecimalAmountPrecision is returned if a decimal amount has more
+	// fractional digits than the decimal display of the asset allows.
+	ErrDecimalAmountPrecision = errors.New("decimal amount has too many " +
+		"fractional digits")
+)
+
+// FormatDecimalAmount formats the given amount of asset units as a decimal
+// string, according to the given decimal display. For example, 12345 units
+// with a decimal display of 2 are formatted as 123.45.
+func FormatDecimalAmount(units uint64, decDisplay uint32) string {
+	rawAmount := strconv.FormatUint(units, 10)
+	if decDisplay == 0 || decDisplay > maxDecimalDisplay {
+		return rawAmount
+	}
+
+	// We pad the raw amount with leading zeros, so there is at least one
+	// digit before the decimal point.
+	numDigits := int(decDisplay)
+	if len(rawAmount) <= numDigits {
+		rawAmount = strings.Repeat("0", numDigits-len(rawAmount)+1) +
+			rawAmount
+	}
+
+	pointIdx := len(rawAmount) - numDigits
+	return rawAmount[:pointIdx] + "." + rawAmount[pointIdx:]
+}
+
+// ParseDecimalAmount parses the given decimal amount into asset units,
+// according to the given decimal display. For example, 123.45 with a decimal
+// display of 2 is parsed as 12345 units. An error is returned if the amount
+// has more fractional digits than the decimal display allows.
+func ParseDecimalAmount(amount string, decDisplay uint32) (uint64, error) {
+	if decDisplay > maxDecimalDisplay {
+		return 0, fmt.Errorf("decimal display %d exceeds the maximum "+
+			"of %d", decDisplay, maxDecimalDisplay)
+	}
+
+	intPart, fracPart, hasPoint := strings.Cut(amount, ".")
+	if intPart == "" || (hasPoint && fracPart == "") {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidDecimalAmount, amount)
+	}
+
+	// Trailing zeros don't add any precision, so 1.50 is a valid amount
+	// with a decimal display of 1.
+	fracPart = strings.TrimRight(fracPart, "0")
+	if len(fracPart) > int(decDisplay) {
+		return 0, fmt.Errorf("%w: %q with decimal display %d",
+			ErrDecimalAmountPrecision, amount, decDisplay)
+	}
+	fracPart += strings.Repeat("0", int(decDisplay)-len(fracPart))
+
+	// Only digits are allowed, so signs and exponents are rejected.
+	digits := intPart + fracPart
+	if strings.Trim(digits, "0123456789") != "" {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidDecimalAmount, amount)
+	}
+
+	units, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q: %w", ErrInvalidDecimalAmount,
+			amount, err)
+	}
+
+	return units, nil
+}
@@ -158,7 +158,7 @@ func MarshalAssetVersion(version asset.Version) (AssetVersion, error) {
 
 // UnmarshalAddressVerion parses an address version from the RPC variant.
 func UnmarshalAddressVersion(version AddrVersion) (address.Version, error) {
-	// For now we'll only support two address versions. The ones in the
+	// For now we'll only support three address versions. The ones in the
 	// future should be reserved for future use, so we disallow unknown
 	// versions.
 	switch version {
@@ -171,6 +171,9 @@ func UnmarshalAddressVersion(version AddrVersion) (address.Version, error) {
 	case AddrVersion_ADDR_VERSION_V1:
 		return address.V1, nil
 
+	case AddrVersion_ADDR_VERSION_V2:
+		return address.V2, nil
+
 	default:
 		return 0, fmt.Errorf("unknown address version: %v", version)
 	}
@@ -179,7 +182,7 @@ func UnmarshalAddressVersion(version AddrVersion) (address.Version, error) {
 // MarshalAddressVerion marshals the native address version into the RPC
 // variant.
 func MarshalAddressVersion(version address.Version) (AddrVersion, error) {
-	// For now we'll only support two address versions. The ones in the
+	// For now we'll only support three address versions. The ones in the
 	// future should be reserved for future use, so we disallow unknown
 	// versions.
 	switch version {
@@ -189,11 +192,55 @@ func MarshalAddressVersion(version address.Version) (AddrVersion, error) {
 	case address.V1:
 		return AddrVersion_ADDR_VERSION_V1, nil
 
+	case address.V2:
+		return AddrVersion_ADDR_VERSION_V2, nil
+
 	default:
 		return 0, fmt.Errorf("unknown address version: %v", version)
 	}
 }
 
+// UnmarshalAddrState parses an address lifecycle state from the RPC variant.
+func UnmarshalAddrState(state AddrState) (address.AddrState, error) {
+	switch state {
+	case AddrState_ADDR_STATE_UNSPECIFIED:
+		return address.AddrStateAll, nil
+
+	case AddrState_ADDR_STATE_ACTIVE:
+		return address.AddrStateActive, nil
+
+	case AddrState_ADDR_STATE_EXPIRED:
+		return address.AddrStateExpired, nil
+
+	case AddrState_ADDR_STATE_ARCHIVED:
+		return address.AddrStateArchived, nil
+
+	default:
+		return 0, fmt.Errorf("unknown address state: %v", state)
+	}
+}
+
+// MarshalAddrState marshals the native address lifecycle state into the RPC
+// variant.
+func MarshalAddrState(state address.AddrState) (AddrState, error) {
+	switch state {
+	case address.AddrStateAll:
+		return AddrState_ADDR_STATE_UNSPECIFIED, nil
+
+	case address.AddrStateActive:
+		return AddrState_ADDR_STATE_ACTIVE, nil
+
+	case address.AddrStateExpired:
+		return AddrState_ADDR_STATE_EXPIRED, nil
+
+	case address.AddrStateArchived:
+		return AddrState_ADDR_STATE_ARCHIVED, nil
+
+	default:
+		return 0, fmt.Errorf("unknown address state: %v", state)
+	}
+}
+
 // MarshalGenesisInfo marshals the native asset genesis into the RPC
 // counterpart.
 func MarshalGenesisInfo(gen *asset.Genesis, assetType asset.Type) *GenesisInfo {
@@ -442,10 +489,12 @@ func UnmarshalGroupWitness(wit *GroupWitness) (*asset.PendingGroupWitness,
 	}, nil
 }
 
-// MarshalAsset converts an asset to its rpc representation.
+// MarshalAsset converts an asset to its rpc representation. The decoded meta
+// is optional and only set on the top level asset.
 func MarshalAsset(ctx context.Context, a *asset.Asset,
 	isSpent, withWitness bool, keyRing KeyLookup,
-	decDisplay fn.Option[uint32]) (*Asset, error) {
+	decDisplay fn.Option[uint32],
+	decodedMeta *DecodedAssetMeta) (*Asset, error) {
 
 	scriptKeyIsLocal := false
 	if a.ScriptKey.TweakedScriptKey != nil && keyRing != nil {
@@ -473,12 +522,14 @@ func MarshalAsset(ctx context.Context, a *asset.Asset,
 		ScriptKeyHasScriptPath: a.ScriptKey.HasScriptPath(),
 		IsSpent:                isSpent,
 		IsBurn:                 a.IsBurn(),
+		DecodedMeta:            decodedMeta,
 	}
 
 	decDisplay.WhenSome(func(u uint32) {
 		rpcAsset.DecimalDisplay = &DecimalDisplay{
 			DecimalDisplay: u,
 		}
+		rpcAsset.DecimalAmount = FormatDecimalAmount(a.Amount, u)
 	})
 
 	if a.GroupKey != nil {
@@ -527,7 +578,7 @@ func MarshalAsset(ctx context.Context, a *asset.Asset,
 			if witness.SplitCommitment != nil {
 				rootAsset, err := MarshalAsset(
 					ctx, &witness.SplitCommitment.RootAsset,
-					false, true, nil, decDisplay,
+					false, true, nil, decDisplay, nil,
 				)
 				if err != nil {
 					return nil, err
@@ -39,9 +39,56 @@ type MintClient interface {
 	// that require an external signer. Otherwise, FinalizeBatch can be called
 	// directly.
 	SealBatch(ctx context.Context, in *SealBatchRequest, opts ...grpc.CallOption) (*SealBatchResponse, error)
+	// CreateGroupSession starts a MuSig2 signing session for the group witness of
+	// an asset in the pending batch whose group key is shared between multiple
+	// issuers. The group internal key of the asset must be the MuSig2 combination
+	// of the issuer keys. The returned session contains the public nonce of the
+	// local issuer that needs to be shared with the remote issuers. Sessions are
+	// only kept in memory, as lnd keeps the local secret nonce in memory too.
+	CreateGroupSession(ctx context.Context, in *CreateGroupSessionRequest, opts ...grpc.CallOption) (*GroupSessionResponse, error)
+	// RegisterGroupSessionNonce registers the public nonce of a remote issuer of
+	// a group signing session. Once the nonces of all issuers are known, the
+	// session can be signed.
+	RegisterGroupSessionNonce(ctx context.Context, in *RegisterGroupSessionNonceRequest, opts ...grpc.CallOption) (*GroupSessionResponse, error)
+	// SignGroupSession creates the partial signature of the local issuer of a
+	// group signing session that has the nonces of all issuers. The partial
+	// signature needs to be shared with the remote issuers.
+	SignGroupSession(ctx context.Context, in *SignGroupSessionRequest, opts ...grpc.CallOption) (*GroupSessionResponse, error)
+	// RegisterGroupSessionPartialSig registers the partial signature of a remote
+	// issuer of a group signing session.
+	RegisterGroupSessionPartialSig(ctx context.Context, in *RegisterGroupSessionPartialSigRequest, opts ...grpc.CallOption) (*GroupSessionResponse, error)
+	// FinalizeGroupSession combines the partial signatures of all issuers of a
+	// group signing session into the group witness of the asset. The witness is
+	// then passed to SealBatch.
+	FinalizeGroupSession(ctx context.Context, in *FinalizeGroupSessionRequest, opts ...grpc.CallOption) (*FinalizeGroupSessionResponse, error)
+	// AbortGroupSession cancels a group signing session.
+	AbortGroupSession(ctx context.Context, in *AbortGroupSessionRequest, opts ...grpc.CallOption) (*AbortGroupSessionResponse, error)
 	// tapcli: `assets mint finalize`
 	// FinalizeBatch will attempt to finalize the current pending batch.
 	FinalizeBatch(ctx context.Context, in *FinalizeBatchRequest, opts ...grpc.CallOption) (*FinalizeBatchResponse, error)
+	// tapcli: `assets mint submitsigs`
+	// SubmitGenesisSigs submits the externally signed genesis transaction of a
+	// finalized batch that was funded with an external PSBT. The transaction is
+	// then broadcast like the genesis transaction of any other batch.
+	SubmitGenesisSigs(ctx context.Context, in *SubmitGenesisSigsRequest, opts ...grpc.CallOption) (*SubmitGenesisSigsResponse, error)
+	// tapcli: `assets mint preview`
+	// PreviewBatch returns a dry-run of the finalization of the current pending
+	// batch: the estimated virtual size and chain fee of its genesis transaction
+	// and the genesis outpoint and asset IDs that would result from it. Nothing
+	// is broadcast or persisted. If the batch isn't funded yet, a temporary
+	// genesis transaction is funded and its inputs are released again right away.
+	PreviewBatch(ctx context.Context, in *PreviewBatchRequest, opts ...grpc.CallOption) (*PreviewBatchResponse, error)
+	// tapcli: `assets mint schedule set`
+	// ScheduleBatch sets the schedule at which the pending batch is finalized
+	// automatically, replacing any existing schedule. The batch is finalized as
+	// soon as any of the conditions of the schedule is met. A request without a
+	// schedule removes the current schedule. The schedule is persisted, so it is
+	// restored after a restart, and is removed once the batch is finalized.
+	ScheduleBatch(ctx context.Context, in *ScheduleBatchRequest, opts ...grpc.CallOption) (*ScheduleBatchResponse, error)
+	// tapcli: `assets mint schedule get`
+	// GetBatchSchedule returns the current schedule at which the pending batch
+	// is finalized automatically, if there is one.
+	GetBatchSchedule(ctx context.Context, in *GetBatchScheduleRequest, opts ...grpc.CallOption) (*GetBatchScheduleResponse, error)
 	// tapcli: `assets mint cancel`
 	// CancelBatch will attempt to cancel the current pending batch.
 	CancelBatch(ctx context.Context, in *CancelBatchRequest, opts ...grpc.CallOption) (*CancelBatchResponse, error)
@@ -90,6 +137,60 @@ func (c *mintClient) SealBatch(ctx context.Context, in *SealBatchRequest, opts .
 	return out, nil
 }
 
+func (c *mintClient) CreateGroupSession(ctx context.Context, in *CreateGroupSessionRequest, opts ...grpc.CallOption) (*GroupSessionResponse, error) {
+	out := new(GroupSessionResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/CreateGroupSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) RegisterGroupSessionNonce(ctx context.Context, in *RegisterGroupSessionNonceRequest, opts ...grpc.CallOption) (*GroupSessionResponse, error) {
+	out := new(GroupSessionResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/RegisterGroupSessionNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) SignGroupSession(ctx context.Context, in *SignGroupSessionRequest, opts ...grpc.CallOption) (*GroupSessionResponse, error) {
+	out := new(GroupSessionResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/SignGroupSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) RegisterGroupSessionPartialSig(ctx context.Context, in *RegisterGroupSessionPartialSigRequest, opts ...grpc.CallOption) (*GroupSessionResponse, error) {
+	out := new(GroupSessionResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/RegisterGroupSessionPartialSig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) FinalizeGroupSession(ctx context.Context, in *FinalizeGroupSessionRequest, opts ...grpc.CallOption) (*FinalizeGroupSessionResponse, error) {
+	out := new(FinalizeGroupSessionResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/FinalizeGroupSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) AbortGroupSession(ctx context.Context, in *AbortGroupSessionRequest, opts ...grpc.CallOption) (*AbortGroupSessionResponse, error) {
+	out := new(AbortGroupSessionResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/AbortGroupSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mintClient) FinalizeBatch(ctx context.Context, in *FinalizeBatchRequest, opts ...grpc.CallOption) (*FinalizeBatchResponse, error) {
 	out := new(FinalizeBatchResponse)
 	err := c.cc.Invoke(ctx, "/mintrpc.Mint/FinalizeBatch", in, out, opts...)
@@ -99,6 +200,42 @@ func (c *mintClient) FinalizeBatch(ctx context.Context, in *FinalizeBatchRequest
 	return out, nil
 }
 
+func (c *mintClient) SubmitGenesisSigs(ctx context.Context, in *SubmitGenesisSigsRequest, opts ...grpc.CallOption) (*SubmitGenesisSigsResponse, error) {
+	out := new(SubmitGenesisSigsResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/SubmitGenesisSigs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) PreviewBatch(ctx context.Context, in *PreviewBatchRequest, opts ...grpc.CallOption) (*PreviewBatchResponse, error) {
+	out := new(PreviewBatchResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/PreviewBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) ScheduleBatch(ctx context.Context, in *ScheduleBatchRequest, opts ...grpc.CallOption) (*ScheduleBatchResponse, error) {
+	out := new(ScheduleBatchResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/ScheduleBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) GetBatchSchedule(ctx context.Context, in *GetBatchScheduleRequest, opts ...grpc.CallOption) (*GetBatchScheduleResponse, error) {
+	out := new(GetBatchScheduleResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/GetBatchSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mintClient) CancelBatch(ctx context.Context, in *CancelBatchRequest, opts ...grpc.CallOption) (*CancelBatchResponse, error) {
 	out := new(CancelBatchResponse)
 	err := c.cc.Invoke(ctx, "/mintrpc.Mint/CancelBatch", in, out, opts...)
@@ -174,9 +311,56 @@ type MintServer interface {
 	// that require an external signer. Otherwise, FinalizeBatch can be called
 	// directly.
 	SealBatch(context.Context, *SealBatchRequest) (*SealBatchResponse, error)
+	// CreateGroupSession starts a MuSig2 signing session for the group witness of
+	// an asset in the pending batch whose group key is shared between multiple
+	// issuers. The group internal key of the asset must be the MuSig2 combination
+	// of the issuer keys. The returned session contains the public nonce of the
+	// local issuer that needs to be shared with the remote issuers. Sessions are
+	// only kept in memory, as lnd keeps the local secret nonce in memory too.
+	CreateGroupSession(context.Context, *CreateGroupSessionRequest) (*GroupSessionResponse, error)
+	// RegisterGroupSessionNonce registers the public nonce of a remote issuer of
+	// a group signing session. Once the nonces of all issuers are known, the
+	// session can be signed.
+	RegisterGroupSessionNonce(context.Context, *RegisterGroupSessionNonceRequest) (*GroupSessionResponse, error)
+	// SignGroupSession creates the partial signature of the local issuer of a
+	// group signing session that has the nonces of all issuers. The partial
+	// signature needs to be shared with the remote issuers.
+	SignGroupSession(context.Context, *SignGroupSessionRequest) (*GroupSessionResponse, error)
+	// RegisterGroupSessionPartialSig registers the partial signature of a remote
+	// issuer of a group signing session.
+	RegisterGroupSessionPartialSig(context.Context, *RegisterGroupSessionPartialSigRequest) (*GroupSessionResponse, error)
+	// FinalizeGroupSession combines the partial signatures of all issuers of a
+	// group signing session into the group witness of the asset. The witness is
+	// then passed to SealBatch.
+	FinalizeGroupSession(context.Context, *FinalizeGroupSessionRequest) (*FinalizeGroupSessionResponse, error)
+	// AbortGroupSession cancels a group signing session.
+	AbortGroupSession(context.Context, *AbortGroupSessionRequest) (*AbortGroupSessionResponse, error)
 	// tapcli: `assets mint finalize`
 	// FinalizeBatch will attempt to finalize the current pending batch.
 	FinalizeBatch(context.Context, *FinalizeBatchRequest) (*FinalizeBatchResponse, error)
+	// tapcli: `assets mint submitsigs`
+	// SubmitGenesisSigs submits the externally signed genesis transaction of a
+	// finalized batch that was funded with an external PSBT. The transaction is
+	// then broadcast like the genesis transaction of any other batch.
+	SubmitGenesisSigs(context.Context, *SubmitGenesisSigsRequest) (*SubmitGenesisSigsResponse, error)
+	// tapcli: `assets mint preview`
+	// PreviewBatch returns a dry-run of the finalization of the current pending
+	// batch: the estimated virtual size and chain fee of its genesis transaction
+	// and the genesis outpoint and asset IDs that would result from it. Nothing
+	// is broadcast or persisted. If the batch isn't funded yet, a temporary
+	// genesis transaction is funded and its inputs are released again right away.
+	PreviewBatch(context.Context, *PreviewBatchRequest) (*PreviewBatchResponse, error)
+	// tapcli: `assets mint schedule set`
+	// ScheduleBatch sets the schedule at which the pending batch is finalized
+	// automatically, replacing any existing schedule. The batch is finalized as
+	// soon as any of the conditions of the schedule is met. A request without a
+	// schedule removes the current schedule. The schedule is persisted, so it is
+	// restored after a restart, and is removed once the batch is finalized.
+	ScheduleBatch(context.Context, *ScheduleBatchRequest) (*ScheduleBatchResponse, error)
+	// tapcli: `assets mint schedule get`
+	// GetBatchSchedule returns the current schedule at which the pending batch
+	// is finalized automatically, if there is one.
+	GetBatchSchedule(context.Context, *GetBatchScheduleRequest) (*GetBatchScheduleResponse, error)
 	// tapcli: `assets mint cancel`
 	// CancelBatch will attempt to cancel the current pending batch.
 	CancelBatch(context.Context, *CancelBatchRequest) (*CancelBatchResponse, error)
@@ -204,9 +388,39 @@ func (UnimplementedMintServer) FundBatch(context.Context, *FundBatchRequest) (*F
 func (UnimplementedMintServer) SealBatch(context.Context, *SealBatchRequest) (*SealBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SealBatch not implemented")
 }
+func (UnimplementedMintServer) CreateGroupSession(context.Context, *CreateGroupSessionRequest) (*GroupSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupSession not implemented")
+}
+func (UnimplementedMintServer) RegisterGroupSessionNonce(context.Context, *RegisterGroupSessionNonceRequest) (*GroupSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterGroupSessionNonce not implemented")
+}
+func (UnimplementedMintServer) SignGroupSession(context.Context, *SignGroupSessionRequest) (*GroupSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignGroupSession not implemented")
+}
+func (UnimplementedMintServer) RegisterGroupSessionPartialSig(context.Context, *RegisterGroupSessionPartialSigRequest) (*GroupSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterGroupSessionPartialSig not implemented")
+}
+func (UnimplementedMintServer) FinalizeGroupSession(context.Context, *FinalizeGroupSessionRequest) (*FinalizeGroupSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeGroupSession not implemented")
+}
+func (UnimplementedMintServer) AbortGroupSession(context.Context, *AbortGroupSessionRequest) (*AbortGroupSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortGroupSession not implemented")
+}
 func (UnimplementedMintServer) FinalizeBatch(context.Context, *FinalizeBatchRequest) (*FinalizeBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FinalizeBatch not implemented")
 }
+func (UnimplementedMintServer) SubmitGenesisSigs(context.Context, *SubmitGenesisSigsRequest) (*SubmitGenesisSigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitGenesisSigs not implemented")
+}
+func (UnimplementedMintServer) PreviewBatch(context.Context, *PreviewBatchRequest) (*PreviewBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewBatch not implemented")
+}
+func (UnimplementedMintServer) ScheduleBatch(context.Context, *ScheduleBatchRequest) (*ScheduleBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleBatch not implemented")
+}
+func (UnimplementedMintServer) GetBatchSchedule(context.Context, *GetBatchScheduleRequest) (*GetBatchScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBatchSchedule not implemented")
+}
 func (UnimplementedMintServer) CancelBatch(context.Context, *CancelBatchRequest) (*CancelBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelBatch not implemented")
 }
@@ -283,6 +497,114 @@ func _Mint_SealBatch_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Mint_CreateGroupSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).CreateGroupSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/CreateGroupSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).CreateGroupSession(ctx, req.(*CreateGroupSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_RegisterGroupSessionNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterGroupSessionNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).RegisterGroupSessionNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/RegisterGroupSessionNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).RegisterGroupSessionNonce(ctx, req.(*RegisterGroupSessionNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_SignGroupSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignGroupSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).SignGroupSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/SignGroupSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).SignGroupSession(ctx, req.(*SignGroupSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_RegisterGroupSessionPartialSig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterGroupSessionPartialSigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).RegisterGroupSessionPartialSig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/RegisterGroupSessionPartialSig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).RegisterGroupSessionPartialSig(ctx, req.(*RegisterGroupSessionPartialSigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_FinalizeGroupSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizeGroupSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).FinalizeGroupSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/FinalizeGroupSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).FinalizeGroupSession(ctx, req.(*FinalizeGroupSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_AbortGroupSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortGroupSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).AbortGroupSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/AbortGroupSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).AbortGroupSession(ctx, req.(*AbortGroupSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Mint_FinalizeBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FinalizeBatchRequest)
 	if err := dec(in); err != nil {
@@ -301,6 +623,78 @@ func _Mint_FinalizeBatch_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Mint_SubmitGenesisSigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitGenesisSigsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).SubmitGenesisSigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/SubmitGenesisSigs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).SubmitGenesisSigs(ctx, req.(*SubmitGenesisSigsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_PreviewBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).PreviewBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/PreviewBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).PreviewBatch(ctx, req.(*PreviewBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_ScheduleBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).ScheduleBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/ScheduleBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).ScheduleBatch(ctx, req.(*ScheduleBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_GetBatchSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBatchScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).GetBatchSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/GetBatchSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).GetBatchSchedule(ctx, req.(*GetBatchScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Mint_CancelBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CancelBatchRequest)
 	if err := dec(in); err != nil {
@@ -377,10 +771,50 @@ var Mint_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SealBatch",
 			Handler:    _Mint_SealBatch_Handler,
 		},
+		{
+			MethodName: "CreateGroupSession",
+			Handler:    _Mint_CreateGroupSession_Handler,
+		},
+		{
+			MethodName: "RegisterGroupSessionNonce",
+			Handler:    _Mint_RegisterGroupSessionNonce_Handler,
+		},
+		{
+			MethodName: "SignGroupSession",
+			Handler:    _Mint_SignGroupSession_Handler,
+		},
+		{
+			MethodName: "RegisterGroupSessionPartialSig",
+			Handler:    _Mint_RegisterGroupSessionPartialSig_Handler,
+		},
+		{
+			MethodName: "FinalizeGroupSession",
+			Handler:    _Mint_FinalizeGroupSession_Handler,
+		},
+		{
+			MethodName: "AbortGroupSession",
+			Handler:    _Mint_AbortGroupSession_Handler,
+		},
 		{
 			MethodName: "FinalizeBatch",
 			Handler:    _Mint_FinalizeBatch_Handler,
 		},
+		{
+			MethodName: "SubmitGenesisSigs",
+			Handler:    _Mint_SubmitGenesisSigs_Handler,
+		},
+		{
+			MethodName: "PreviewBatch",
+			Handler:    _Mint_PreviewBatch_Handler,
+		},
+		{
+			MethodName: "ScheduleBatch",
+			Handler:    _Mint_ScheduleBatch_Handler,
+		},
+		{
+			MethodName: "GetBatchSchedule",
+			Handler:    _Mint_GetBatchSchedule_Handler,
+		},
 		{
 			MethodName: "CancelBatch",
 			Handler:    _Mint_CancelBatch_Handler,
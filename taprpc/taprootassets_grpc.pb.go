@@ -25,16 +25,54 @@ type TaprootAssetsClient interface {
 	// ListUtxos lists the UTXOs managed by the target daemon, and the assets they
 	// hold.
 	ListUtxos(ctx context.Context, in *ListUtxosRequest, opts ...grpc.CallOption) (*ListUtxosResponse, error)
+	// tapcli: `assets setnote`
+	// SetUtxoNote sets the user-defined note of an asset anchor output. An empty
+	// note removes an existing note. Notes are keyed by outpoint, so a note can
+	// be set before the output is known to the daemon and is kept after the
+	// output was spent.
+	SetUtxoNote(ctx context.Context, in *SetUtxoNoteRequest, opts ...grpc.CallOption) (*SetUtxoNoteResponse, error)
+	// tapcli: `assets note`
+	// FetchUtxoNote returns the user-defined note of an asset anchor output.
+	FetchUtxoNote(ctx context.Context, in *FetchUtxoNoteRequest, opts ...grpc.CallOption) (*FetchUtxoNoteResponse, error)
 	// tapcli: `assets groups`
 	// ListGroups lists the asset groups known to the target daemon, and the assets
 	// held in each group.
 	ListGroups(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error)
+	// tapcli: `assets groupsummary`
+	// QueryGroupSummary aggregates the balance, the UTXOs and the outbound
+	// transfer history of all asset IDs within an asset group, with a per asset
+	// ID breakdown.
+	QueryGroupSummary(ctx context.Context, in *QueryGroupSummaryRequest, opts ...grpc.CallOption) (*QueryGroupSummaryResponse, error)
 	// tapcli: `assets balance`
 	// ListBalances lists asset balances
 	ListBalances(ctx context.Context, in *ListBalancesRequest, opts ...grpc.CallOption) (*ListBalancesResponse, error)
 	// tapcli: `assets transfers`
 	// ListTransfers lists outbound asset transfers tracked by the target daemon.
 	ListTransfers(ctx context.Context, in *ListTransfersRequest, opts ...grpc.CallOption) (*ListTransfersResponse, error)
+	// tapcli: `assets coinselections`
+	// ListCoinSelections lists the recorded outcomes of the asset coin selections
+	// of past transfers, most recent first. The records can be used to tune the
+	// coin selection strategy and to detect a growing fragmentation of the asset
+	// UTXOs before sends start failing.
+	ListCoinSelections(ctx context.Context, in *ListCoinSelectionsRequest, opts ...grpc.CallOption) (*ListCoinSelectionsResponse, error)
+	// tapcli: `assets statement`
+	// GenerateStatement lists all transfers between the local node and a
+	// counterparty over a period, together with the totals per asset. The
+	// statement is built from the transfer and receive logs and is meant for the
+	// reconciliation of the asset balances with the counterparty.
+	GenerateStatement(ctx context.Context, in *StatementRequest, opts ...grpc.CallOption) (*StatementResponse, error)
+	// tapcli: `assets feespend`
+	// QueryFeeSpend attributes the chain fees of the outbound transfers to the
+	// assets of their outputs and sums them up per asset and period. The fees of
+	// a transfer are split pro-rata by the output amounts, unless the caller
+	// specifies the weights of its outputs.
+	QueryFeeSpend(ctx context.Context, in *FeeSpendRequest, opts ...grpc.CallOption) (*FeeSpendResponse, error)
+	// tapcli: `assets snapshotdiff`
+	// DiffAssetSnapshots lists the local asset UTXOs that were created and spent
+	// between two block heights, together with the balance changes per asset.
+	// Minted assets and passive assets that were only re-anchored aren't part of
+	// the diff.
+	DiffAssetSnapshots(ctx context.Context, in *SnapshotDiffRequest, opts ...grpc.CallOption) (*SnapshotDiffResponse, error)
 	// tapcli: `stop`
 	// StopDaemon will send a shutdown request to the interrupt handler, triggering
 	// a graceful shutdown of the daemon.
@@ -60,24 +98,93 @@ type TaprootAssetsClient interface {
 	// List all receives for incoming asset transfers for addresses that were
 	// created previously.
 	AddrReceives(ctx context.Context, in *AddrReceivesRequest, opts ...grpc.CallOption) (*AddrReceivesResponse, error)
+	// tapcli: `addrs setnote`
+	// SetAddrNote sets the user-defined note of a Taproot Asset address. An empty
+	// note removes an existing note.
+	SetAddrNote(ctx context.Context, in *SetAddrNoteRequest, opts ...grpc.CallOption) (*SetAddrNoteResponse, error)
+	// tapcli: `addrs setexpiry`
+	// SetAddrExpiry sets the expiry time of a Taproot Asset address. The daemon
+	// stops watching for new inbound transfers to the address once the expiry
+	// time has passed. An expiry time of zero removes an existing expiry.
+	SetAddrExpiry(ctx context.Context, in *SetAddrExpiryRequest, opts ...grpc.CallOption) (*SetAddrExpiryResponse, error)
+	// tapcli: `addrs archive`
+	// ArchiveAddr archives a Taproot Asset address. The daemon stops watching for
+	// new inbound transfers to an archived address, while transfers that were
+	// already detected are still completed.
+	ArchiveAddr(ctx context.Context, in *ArchiveAddrRequest, opts ...grpc.CallOption) (*ArchiveAddrResponse, error)
+	// tapcli: `addrs unarchive`
+	// UnarchiveAddr unarchives a Taproot Asset address, so the daemon watches for
+	// new inbound transfers to it again.
+	UnarchiveAddr(ctx context.Context, in *UnarchiveAddrRequest, opts ...grpc.CallOption) (*UnarchiveAddrResponse, error)
+	// tapcli: `addrs newstatic`
+	// NewStaticAddr creates a new reusable static address for an asset. The chain
+	// is scanned for payments to the static address from the current block on.
+	NewStaticAddr(ctx context.Context, in *NewStaticAddrRequest, opts ...grpc.CallOption) (*StaticAddr, error)
+	// tapcli: `addrs liststatic`
+	// ListStaticAddrs lists all static addresses of the daemon.
+	ListStaticAddrs(ctx context.Context, in *ListStaticAddrsRequest, opts ...grpc.CallOption) (*ListStaticAddrsResponse, error)
+	// tapcli: `addrs importstaticpayment`
+	// ImportStaticPaymentTx inspects an unconfirmed transaction for payments to
+	// the static addresses of the daemon. Payments to static addresses are
+	// otherwise only found once they confirm, as the wallet doesn't know their
+	// outputs in advance.
+	ImportStaticPaymentTx(ctx context.Context, in *ImportStaticPaymentTxRequest, opts ...grpc.CallOption) (*ImportStaticPaymentTxResponse, error)
+	// tapcli: `contacts add`
+	// AddContact stores a Taproot Asset address under a label in the contact
+	// book, so it can be sent to by its label. If a contact with the same label
+	// already exists, its address, group and notes are replaced.
+	AddContact(ctx context.Context, in *AddContactRequest, opts ...grpc.CallOption) (*AddContactResponse, error)
+	// tapcli: `contacts list`
+	// ListContacts lists the contacts of the contact book, optionally limited to
+	// the ones of a group.
+	ListContacts(ctx context.Context, in *ListContactsRequest, opts ...grpc.CallOption) (*ListContactsResponse, error)
+	// tapcli: `contacts delete`
+	// DeleteContact removes a contact from the contact book.
+	DeleteContact(ctx context.Context, in *DeleteContactRequest, opts ...grpc.CallOption) (*DeleteContactResponse, error)
 	// tapcli: `proofs verify`
 	// VerifyProof attempts to verify a given proof file that claims to be anchored
 	// at the specified genesis point.
 	VerifyProof(ctx context.Context, in *ProofFile, opts ...grpc.CallOption) (*VerifyProofResponse, error)
 	// tapcli: `proofs decode`
 	// DecodeProof attempts to decode a given proof file into human readable
-	// format.
+	// format. Optionally, a breakdown of all state transitions of the proof can
+	// be returned, each partially verified without access to the chain. Nothing
+	// is imported or stored.
 	DecodeProof(ctx context.Context, in *DecodeProofRequest, opts ...grpc.CallOption) (*DecodeProofResponse, error)
 	// tapcli: `proofs export`
 	// ExportProof exports the latest raw proof file anchored at the specified
 	// script_key.
 	ExportProof(ctx context.Context, in *ExportProofRequest, opts ...grpc.CallOption) (*ProofFile, error)
+	// tapcli: `proofs bulkimport`
+	// BulkImportProofs submits a set of proof files to be imported in the
+	// background. Each proof file is verified and imported independently, so an
+	// invalid proof file doesn't prevent the others from being imported. The
+	// returned job ID can be used to query the progress of the import, which is
+	// resumed after a restart.
+	BulkImportProofs(ctx context.Context, in *BulkImportProofsRequest, opts ...grpc.CallOption) (*BulkImportProofsResponse, error)
+	// tapcli: `proofs importstatus`
+	// ProofImportStatus returns the progress of a bulk proof import job and the
+	// status of each of its proof files.
+	ProofImportStatus(ctx context.Context, in *ProofImportStatusRequest, opts ...grpc.CallOption) (*ProofImportStatusResponse, error)
 	// tapcli: `assets send`
 	// SendAsset uses one or multiple passed Taproot Asset address(es) to attempt
 	// to complete an asset send. The method returns information w.r.t the on chain
 	// send, as well as the proof file information the receiver needs to fully
 	// receive the asset.
 	SendAsset(ctx context.Context, in *SendAssetRequest, opts ...grpc.CallOption) (*SendAssetResponse, error)
+	// tapcli: `assets sendtokey`
+	// SendToScriptKey sends assets interactively to a script key and anchor
+	// output internal key that the receiver handed out out of band, instead of a
+	// Taproot Asset address. If the selected inputs exactly match the amount, the
+	// send is a full-value send without a change output. The proof of the
+	// receiver isn't delivered through a proof courier but can be exported with
+	// ExportProof once the anchor transaction confirmed.
+	SendToScriptKey(ctx context.Context, in *SendToScriptKeyRequest, opts ...grpc.CallOption) (*SendToScriptKeyResponse, error)
+	// tapcli: `assets sendstatic`
+	// SendStaticPayment sends assets to a reusable static address. A new
+	// single-use address is derived for the payment, and the anchor transaction
+	// announces the payment to the receiver, who finds it by scanning the chain.
+	SendStaticPayment(ctx context.Context, in *SendStaticPaymentRequest, opts ...grpc.CallOption) (*SendStaticPaymentResponse, error)
 	// tapcli: `assets burn`
 	// BurnAsset burns the given number of units of a given asset by sending them
 	// to a provably un-spendable script key. Burning means irrevocably destroying
@@ -85,9 +192,45 @@ type TaprootAssetsClient interface {
 	// burning is such a destructive and non-reversible operation, some specific
 	// values need to be set in the request to avoid accidental burns.
 	BurnAsset(ctx context.Context, in *BurnAssetRequest, opts ...grpc.CallOption) (*BurnAssetResponse, error)
+	// tapcli: `assets bumpfee`
+	// BumpTransferFee replaces the anchor transaction of a pending transfer that
+	// is still waiting to confirm with a version that pays a higher fee rate
+	// (BIP-125). The additional fee is deducted from the BTC change output of the
+	// anchor transaction.
+	BumpTransferFee(ctx context.Context, in *BumpTransferFeeRequest, opts ...grpc.CallOption) (*BumpTransferFeeResponse, error)
+	// tapcli: `assets cancel`
+	// CancelTransfer cancels a pending transfer whose anchor transaction was
+	// logged to disk but never made it to the network, for example because its
+	// broadcast failed. The transfer is removed and its asset and BTC inputs are
+	// released again.
+	CancelTransfer(ctx context.Context, in *CancelTransferRequest, opts ...grpc.CallOption) (*CancelTransferResponse, error)
+	// tapcli: `assets sendlimits set`
+	// SetSendLimit sets the maximum cumulative amount of an asset that can be
+	// sent to a destination script key, replacing any existing limit. Sends that
+	// would exceed the limit are rejected.
+	SetSendLimit(ctx context.Context, in *SetSendLimitRequest, opts ...grpc.CallOption) (*SetSendLimitResponse, error)
+	// tapcli: `assets sendlimits remove`
+	// RemoveSendLimit removes the limit of the cumulative amount of an asset that
+	// can be sent to a destination script key.
+	RemoveSendLimit(ctx context.Context, in *RemoveSendLimitRequest, opts ...grpc.CallOption) (*RemoveSendLimitResponse, error)
+	// tapcli: `assets sendlimits list`
+	// ListSendLimits lists all limits of the cumulative amounts of assets that
+	// can be sent to destination script keys.
+	ListSendLimits(ctx context.Context, in *ListSendLimitsRequest, opts ...grpc.CallOption) (*ListSendLimitsResponse, error)
+	// tapcli: `assets sendlimits totals`
+	// ListSendTotals lists the cumulative amounts of assets sent to destination
+	// script keys, together with their limits.
+	ListSendTotals(ctx context.Context, in *ListSendTotalsRequest, opts ...grpc.CallOption) (*ListSendTotalsResponse, error)
 	// tapcli: `getinfo`
 	// GetInfo returns the information for the node.
 	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
+	// tapcli: `dbhealth`
+	// GetDatabaseHealth reports the schema version of the database, the
+	// migrations that weren't applied yet, the row counts of the key tables and
+	// the results of quick referential integrity checks. As counting the rows and
+	// running the checks can take a while on large databases, the report is only
+	// created on request.
+	GetDatabaseHealth(ctx context.Context, in *DatabaseHealthRequest, opts ...grpc.CallOption) (*DatabaseHealthResponse, error)
 	// tapcli: `assets meta`
 	// FetchAssetMeta allows a caller to fetch the reveal meta data for an asset
 	// either by the asset ID for that asset, or a meta hash.
@@ -128,6 +271,24 @@ func (c *taprootAssetsClient) ListUtxos(ctx context.Context, in *ListUtxosReques
 	return out, nil
 }
 
+func (c *taprootAssetsClient) SetUtxoNote(ctx context.Context, in *SetUtxoNoteRequest, opts ...grpc.CallOption) (*SetUtxoNoteResponse, error) {
+	out := new(SetUtxoNoteResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/SetUtxoNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) FetchUtxoNote(ctx context.Context, in *FetchUtxoNoteRequest, opts ...grpc.CallOption) (*FetchUtxoNoteResponse, error) {
+	out := new(FetchUtxoNoteResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/FetchUtxoNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taprootAssetsClient) ListGroups(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error) {
 	out := new(ListGroupsResponse)
 	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ListGroups", in, out, opts...)
@@ -137,6 +298,15 @@ func (c *taprootAssetsClient) ListGroups(ctx context.Context, in *ListGroupsRequ
 	return out, nil
 }
 
+func (c *taprootAssetsClient) QueryGroupSummary(ctx context.Context, in *QueryGroupSummaryRequest, opts ...grpc.CallOption) (*QueryGroupSummaryResponse, error) {
+	out := new(QueryGroupSummaryResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/QueryGroupSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taprootAssetsClient) ListBalances(ctx context.Context, in *ListBalancesRequest, opts ...grpc.CallOption) (*ListBalancesResponse, error) {
 	out := new(ListBalancesResponse)
 	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ListBalances", in, out, opts...)
@@ -155,6 +325,42 @@ func (c *taprootAssetsClient) ListTransfers(ctx context.Context, in *ListTransfe
 	return out, nil
 }
 
+func (c *taprootAssetsClient) ListCoinSelections(ctx context.Context, in *ListCoinSelectionsRequest, opts ...grpc.CallOption) (*ListCoinSelectionsResponse, error) {
+	out := new(ListCoinSelectionsResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ListCoinSelections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) GenerateStatement(ctx context.Context, in *StatementRequest, opts ...grpc.CallOption) (*StatementResponse, error) {
+	out := new(StatementResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/GenerateStatement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) QueryFeeSpend(ctx context.Context, in *FeeSpendRequest, opts ...grpc.CallOption) (*FeeSpendResponse, error) {
+	out := new(FeeSpendResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/QueryFeeSpend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) DiffAssetSnapshots(ctx context.Context, in *SnapshotDiffRequest, opts ...grpc.CallOption) (*SnapshotDiffResponse, error) {
+	out := new(SnapshotDiffResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/DiffAssetSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taprootAssetsClient) StopDaemon(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error) {
 	out := new(StopResponse)
 	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/StopDaemon", in, out, opts...)
@@ -209,6 +415,96 @@ func (c *taprootAssetsClient) AddrReceives(ctx context.Context, in *AddrReceives
 	return out, nil
 }
 
+func (c *taprootAssetsClient) SetAddrNote(ctx context.Context, in *SetAddrNoteRequest, opts ...grpc.CallOption) (*SetAddrNoteResponse, error) {
+	out := new(SetAddrNoteResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/SetAddrNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) SetAddrExpiry(ctx context.Context, in *SetAddrExpiryRequest, opts ...grpc.CallOption) (*SetAddrExpiryResponse, error) {
+	out := new(SetAddrExpiryResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/SetAddrExpiry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ArchiveAddr(ctx context.Context, in *ArchiveAddrRequest, opts ...grpc.CallOption) (*ArchiveAddrResponse, error) {
+	out := new(ArchiveAddrResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ArchiveAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) UnarchiveAddr(ctx context.Context, in *UnarchiveAddrRequest, opts ...grpc.CallOption) (*UnarchiveAddrResponse, error) {
+	out := new(UnarchiveAddrResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/UnarchiveAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) NewStaticAddr(ctx context.Context, in *NewStaticAddrRequest, opts ...grpc.CallOption) (*StaticAddr, error) {
+	out := new(StaticAddr)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/NewStaticAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ListStaticAddrs(ctx context.Context, in *ListStaticAddrsRequest, opts ...grpc.CallOption) (*ListStaticAddrsResponse, error) {
+	out := new(ListStaticAddrsResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ListStaticAddrs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ImportStaticPaymentTx(ctx context.Context, in *ImportStaticPaymentTxRequest, opts ...grpc.CallOption) (*ImportStaticPaymentTxResponse, error) {
+	out := new(ImportStaticPaymentTxResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ImportStaticPaymentTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) AddContact(ctx context.Context, in *AddContactRequest, opts ...grpc.CallOption) (*AddContactResponse, error) {
+	out := new(AddContactResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/AddContact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ListContacts(ctx context.Context, in *ListContactsRequest, opts ...grpc.CallOption) (*ListContactsResponse, error) {
+	out := new(ListContactsResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ListContacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) DeleteContact(ctx context.Context, in *DeleteContactRequest, opts ...grpc.CallOption) (*DeleteContactResponse, error) {
+	out := new(DeleteContactResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/DeleteContact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taprootAssetsClient) VerifyProof(ctx context.Context, in *ProofFile, opts ...grpc.CallOption) (*VerifyProofResponse, error) {
 	out := new(VerifyProofResponse)
 	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/VerifyProof", in, out, opts...)
@@ -236,6 +532,24 @@ func (c *taprootAssetsClient) ExportProof(ctx context.Context, in *ExportProofRe
 	return out, nil
 }
 
+func (c *taprootAssetsClient) BulkImportProofs(ctx context.Context, in *BulkImportProofsRequest, opts ...grpc.CallOption) (*BulkImportProofsResponse, error) {
+	out := new(BulkImportProofsResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/BulkImportProofs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ProofImportStatus(ctx context.Context, in *ProofImportStatusRequest, opts ...grpc.CallOption) (*ProofImportStatusResponse, error) {
+	out := new(ProofImportStatusResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ProofImportStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taprootAssetsClient) SendAsset(ctx context.Context, in *SendAssetRequest, opts ...grpc.CallOption) (*SendAssetResponse, error) {
 	out := new(SendAssetResponse)
 	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/SendAsset", in, out, opts...)
@@ -245,6 +559,24 @@ func (c *taprootAssetsClient) SendAsset(ctx context.Context, in *SendAssetReques
 	return out, nil
 }
 
+func (c *taprootAssetsClient) SendToScriptKey(ctx context.Context, in *SendToScriptKeyRequest, opts ...grpc.CallOption) (*SendToScriptKeyResponse, error) {
+	out := new(SendToScriptKeyResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/SendToScriptKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) SendStaticPayment(ctx context.Context, in *SendStaticPaymentRequest, opts ...grpc.CallOption) (*SendStaticPaymentResponse, error) {
+	out := new(SendStaticPaymentResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/SendStaticPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taprootAssetsClient) BurnAsset(ctx context.Context, in *BurnAssetRequest, opts ...grpc.CallOption) (*BurnAssetResponse, error) {
 	out := new(BurnAssetResponse)
 	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/BurnAsset", in, out, opts...)
@@ -254,6 +586,60 @@ func (c *taprootAssetsClient) BurnAsset(ctx context.Context, in *BurnAssetReques
 	return out, nil
 }
 
+func (c *taprootAssetsClient) BumpTransferFee(ctx context.Context, in *BumpTransferFeeRequest, opts ...grpc.CallOption) (*BumpTransferFeeResponse, error) {
+	out := new(BumpTransferFeeResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/BumpTransferFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) CancelTransfer(ctx context.Context, in *CancelTransferRequest, opts ...grpc.CallOption) (*CancelTransferResponse, error) {
+	out := new(CancelTransferResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/CancelTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) SetSendLimit(ctx context.Context, in *SetSendLimitRequest, opts ...grpc.CallOption) (*SetSendLimitResponse, error) {
+	out := new(SetSendLimitResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/SetSendLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) RemoveSendLimit(ctx context.Context, in *RemoveSendLimitRequest, opts ...grpc.CallOption) (*RemoveSendLimitResponse, error) {
+	out := new(RemoveSendLimitResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/RemoveSendLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ListSendLimits(ctx context.Context, in *ListSendLimitsRequest, opts ...grpc.CallOption) (*ListSendLimitsResponse, error) {
+	out := new(ListSendLimitsResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ListSendLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ListSendTotals(ctx context.Context, in *ListSendTotalsRequest, opts ...grpc.CallOption) (*ListSendTotalsResponse, error) {
+	out := new(ListSendTotalsResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ListSendTotals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taprootAssetsClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
 	out := new(GetInfoResponse)
 	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/GetInfo", in, out, opts...)
@@ -263,6 +649,15 @@ func (c *taprootAssetsClient) GetInfo(ctx context.Context, in *GetInfoRequest, o
 	return out, nil
 }
 
+func (c *taprootAssetsClient) GetDatabaseHealth(ctx context.Context, in *DatabaseHealthRequest, opts ...grpc.CallOption) (*DatabaseHealthResponse, error) {
+	out := new(DatabaseHealthResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/GetDatabaseHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taprootAssetsClient) FetchAssetMeta(ctx context.Context, in *FetchAssetMetaRequest, opts ...grpc.CallOption) (*AssetMeta, error) {
 	out := new(AssetMeta)
 	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/FetchAssetMeta", in, out, opts...)
@@ -347,16 +742,54 @@ type TaprootAssetsServer interface {
 	// ListUtxos lists the UTXOs managed by the target daemon, and the assets they
 	// hold.
 	ListUtxos(context.Context, *ListUtxosRequest) (*ListUtxosResponse, error)
+	// tapcli: `assets setnote`
+	// SetUtxoNote sets the user-defined note of an asset anchor output. An empty
+	// note removes an existing note. Notes are keyed by outpoint, so a note can
+	// be set before the output is known to the daemon and is kept after the
+	// output was spent.
+	SetUtxoNote(context.Context, *SetUtxoNoteRequest) (*SetUtxoNoteResponse, error)
+	// tapcli: `assets note`
+	// FetchUtxoNote returns the user-defined note of an asset anchor output.
+	FetchUtxoNote(context.Context, *FetchUtxoNoteRequest) (*FetchUtxoNoteResponse, error)
 	// tapcli: `assets groups`
 	// ListGroups lists the asset groups known to the target daemon, and the assets
 	// held in each group.
 	ListGroups(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error)
+	// tapcli: `assets groupsummary`
+	// QueryGroupSummary aggregates the balance, the UTXOs and the outbound
+	// transfer history of all asset IDs within an asset group, with a per asset
+	// ID breakdown.
+	QueryGroupSummary(context.Context, *QueryGroupSummaryRequest) (*QueryGroupSummaryResponse, error)
 	// tapcli: `assets balance`
 	// ListBalances lists asset balances
 	ListBalances(context.Context, *ListBalancesRequest) (*ListBalancesResponse, error)
 	// tapcli: `assets transfers`
 	// ListTransfers lists outbound asset transfers tracked by the target daemon.
 	ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error)
+	// tapcli: `assets coinselections`
+	// ListCoinSelections lists the recorded outcomes of the asset coin selections
+	// of past transfers, most recent first. The records can be used to tune the
+	// coin selection strategy and to detect a growing fragmentation of the asset
+	// UTXOs before sends start failing.
+	ListCoinSelections(context.Context, *ListCoinSelectionsRequest) (*ListCoinSelectionsResponse, error)
+	// tapcli: `assets statement`
+	// GenerateStatement lists all transfers between the local node and a
+	// counterparty over a period, together with the totals per asset. The
+	// statement is built from the transfer and receive logs and is meant for the
+	// reconciliation of the asset balances with the counterparty.
+	GenerateStatement(context.Context, *StatementRequest) (*StatementResponse, error)
+	// tapcli: `assets feespend`
+	// QueryFeeSpend attributes the chain fees of the outbound transfers to the
+	// assets of their outputs and sums them up per asset and period. The fees of
+	// a transfer are split pro-rata by the output amounts, unless the caller
+	// specifies the weights of its outputs.
+	QueryFeeSpend(context.Context, *FeeSpendRequest) (*FeeSpendResponse, error)
+	// tapcli: `assets snapshotdiff`
+	// DiffAssetSnapshots lists the local asset UTXOs that were created and spent
+	// between two block heights, together with the balance changes per asset.
+	// Minted assets and passive assets that were only re-anchored aren't part of
+	// the diff.
+	DiffAssetSnapshots(context.Context, *SnapshotDiffRequest) (*SnapshotDiffResponse, error)
 	// tapcli: `stop`
 	// StopDaemon will send a shutdown request to the interrupt handler, triggering
 	// a graceful shutdown of the daemon.
@@ -382,24 +815,93 @@ type TaprootAssetsServer interface {
 	// List all receives for incoming asset transfers for addresses that were
 	// created previously.
 	AddrReceives(context.Context, *AddrReceivesRequest) (*AddrReceivesResponse, error)
+	// tapcli: `addrs setnote`
+	// SetAddrNote sets the user-defined note of a Taproot Asset address. An empty
+	// note removes an existing note.
+	SetAddrNote(context.Context, *SetAddrNoteRequest) (*SetAddrNoteResponse, error)
+	// tapcli: `addrs setexpiry`
+	// SetAddrExpiry sets the expiry time of a Taproot Asset address. The daemon
+	// stops watching for new inbound transfers to the address once the expiry
+	// time has passed. An expiry time of zero removes an existing expiry.
+	SetAddrExpiry(context.Context, *SetAddrExpiryRequest) (*SetAddrExpiryResponse, error)
+	// tapcli: `addrs archive`
+	// ArchiveAddr archives a Taproot Asset address. The daemon stops watching for
+	// new inbound transfers to an archived address, while transfers that were
+	// already detected are still completed.
+	ArchiveAddr(context.Context, *ArchiveAddrRequest) (*ArchiveAddrResponse, error)
+	// tapcli: `addrs unarchive`
+	// UnarchiveAddr unarchives a Taproot Asset address, so the daemon watches for
+	// new inbound transfers to it again.
+	UnarchiveAddr(context.Context, *UnarchiveAddrRequest) (*UnarchiveAddrResponse, error)
+	// tapcli: `addrs newstatic`
+	// NewStaticAddr creates a new reusable static address for an asset. The chain
+	// is scanned for payments to the static address from the current block on.
+	NewStaticAddr(context.Context, *NewStaticAddrRequest) (*StaticAddr, error)
+	// tapcli: `addrs liststatic`
+	// ListStaticAddrs lists all static addresses of the daemon.
+	ListStaticAddrs(context.Context, *ListStaticAddrsRequest) (*ListStaticAddrsResponse, error)
+	// tapcli: `addrs importstaticpayment`
+	// ImportStaticPaymentTx inspects an unconfirmed transaction for payments to
+	// the static addresses of the daemon. Payments to static addresses are
+	// otherwise only found once they confirm, as the wallet doesn't know their
+	// outputs in advance.
+	ImportStaticPaymentTx(context.Context, *ImportStaticPaymentTxRequest) (*ImportStaticPaymentTxResponse, error)
+	// tapcli: `contacts add`
+	// AddContact stores a Taproot Asset address under a label in the contact
+	// book, so it can be sent to by its label. If a contact with the same label
+	// already exists, its address, group and notes are replaced.
+	AddContact(context.Context, *AddContactRequest) (*AddContactResponse, error)
+	// tapcli: `contacts list`
+	// ListContacts lists the contacts of the contact book, optionally limited to
+	// the ones of a group.
+	ListContacts(context.Context, *ListContactsRequest) (*ListContactsResponse, error)
+	// tapcli: `contacts delete`
+	// DeleteContact removes a contact from the contact book.
+	DeleteContact(context.Context, *DeleteContactRequest) (*DeleteContactResponse, error)
 	// tapcli: `proofs verify`
 	// VerifyProof attempts to verify a given proof file that claims to be anchored
 	// at the specified genesis point.
 	VerifyProof(context.Context, *ProofFile) (*VerifyProofResponse, error)
 	// tapcli: `proofs decode`
 	// DecodeProof attempts to decode a given proof file into human readable
-	// format.
+	// format. Optionally, a breakdown of all state transitions of the proof can
+	// be returned, each partially verified without access to the chain. Nothing
+	// is imported or stored.
 	DecodeProof(context.Context, *DecodeProofRequest) (*DecodeProofResponse, error)
 	// tapcli: `proofs export`
 	// ExportProof exports the latest raw proof file anchored at the specified
 	// script_key.
 	ExportProof(context.Context, *ExportProofRequest) (*ProofFile, error)
+	// tapcli: `proofs bulkimport`
+	// BulkImportProofs submits a set of proof files to be imported in the
+	// background. Each proof file is verified and imported independently, so an
+	// invalid proof file doesn't prevent the others from being imported. The
+	// returned job ID can be used to query the progress of the import, which is
+	// resumed after a restart.
+	BulkImportProofs(context.Context, *BulkImportProofsRequest) (*BulkImportProofsResponse, error)
+	// tapcli: `proofs importstatus`
+	// ProofImportStatus returns the progress of a bulk proof import job and the
+	// status of each of its proof files.
+	ProofImportStatus(context.Context, *ProofImportStatusRequest) (*ProofImportStatusResponse, error)
 	// tapcli: `assets send`
 	// SendAsset uses one or multiple passed Taproot Asset address(es) to attempt
 	// to complete an asset send. The method returns information w.r.t the on chain
 	// send, as well as the proof file information the receiver needs to fully
 	// receive the asset.
 	SendAsset(context.Context, *SendAssetRequest) (*SendAssetResponse, error)
+	// tapcli: `assets sendtokey`
+	// SendToScriptKey sends assets interactively to a script key and anchor
+	// output internal key that the receiver handed out out of band, instead of a
+	// Taproot Asset address. If the selected inputs exactly match the amount, the
+	// send is a full-value send without a change output. The proof of the
+	// receiver isn't delivered through a proof courier but can be exported with
+	// ExportProof once the anchor transaction confirmed.
+	SendToScriptKey(context.Context, *SendToScriptKeyRequest) (*SendToScriptKeyResponse, error)
+	// tapcli: `assets sendstatic`
+	// SendStaticPayment sends assets to a reusable static address. A new
+	// single-use address is derived for the payment, and the anchor transaction
+	// announces the payment to the receiver, who finds it by scanning the chain.
+	SendStaticPayment(context.Context, *SendStaticPaymentRequest) (*SendStaticPaymentResponse, error)
 	// tapcli: `assets burn`
 	// BurnAsset burns the given number of units of a given asset by sending them
 	// to a provably un-spendable script key. Burning means irrevocably destroying
@@ -407,9 +909,45 @@ type TaprootAssetsServer interface {
 	// burning is such a destructive and non-reversible operation, some specific
 	// values need to be set in the request to avoid accidental burns.
 	BurnAsset(context.Context, *BurnAssetRequest) (*BurnAssetResponse, error)
+	// tapcli: `assets bumpfee`
+	// BumpTransferFee replaces the anchor transaction of a pending transfer that
+	// is still waiting to confirm with a version that pays a higher fee rate
+	// (BIP-125). The additional fee is deducted from the BTC change output of the
+	// anchor transaction.
+	BumpTransferFee(context.Context, *BumpTransferFeeRequest) (*BumpTransferFeeResponse, error)
+	// tapcli: `assets cancel`
+	// CancelTransfer cancels a pending transfer whose anchor transaction was
+	// logged to disk but never made it to the network, for example because its
+	// broadcast failed. The transfer is removed and its asset and BTC inputs are
+	// released again.
+	CancelTransfer(context.Context, *CancelTransferRequest) (*CancelTransferResponse, error)
+	// tapcli: `assets sendlimits set`
+	// SetSendLimit sets the maximum cumulative amount of an asset that can be
+	// sent to a destination script key, replacing any existing limit. Sends that
+	// would exceed the limit are rejected.
+	SetSendLimit(context.Context, *SetSendLimitRequest) (*SetSendLimitResponse, error)
+	// tapcli: `assets sendlimits remove`
+	// RemoveSendLimit removes the limit of the cumulative amount of an asset that
+	// can be sent to a destination script key.
+	RemoveSendLimit(context.Context, *RemoveSendLimitRequest) (*RemoveSendLimitResponse, error)
+	// tapcli: `assets sendlimits list`
+	// ListSendLimits lists all limits of the cumulative amounts of assets that
+	// can be sent to destination script keys.
+	ListSendLimits(context.Context, *ListSendLimitsRequest) (*ListSendLimitsResponse, error)
+	// tapcli: `assets sendlimits totals`
+	// ListSendTotals lists the cumulative amounts of assets sent to destination
+	// script keys, together with their limits.
+	ListSendTotals(context.Context, *ListSendTotalsRequest) (*ListSendTotalsResponse, error)
 	// tapcli: `getinfo`
 	// GetInfo returns the information for the node.
 	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
+	// tapcli: `dbhealth`
+	// GetDatabaseHealth reports the schema version of the database, the
+	// migrations that weren't applied yet, the row counts of the key tables and
+	// the results of quick referential integrity checks. As counting the rows and
+	// running the checks can take a while on large databases, the report is only
+	// created on request.
+	GetDatabaseHealth(context.Context, *DatabaseHealthRequest) (*DatabaseHealthResponse, error)
 	// tapcli: `assets meta`
 	// FetchAssetMeta allows a caller to fetch the reveal meta data for an asset
 	// either by the asset ID for that asset, or a meta hash.
@@ -435,15 +973,36 @@ func (UnimplementedTaprootAssetsServer) ListAssets(context.Context, *ListAssetRe
 func (UnimplementedTaprootAssetsServer) ListUtxos(context.Context, *ListUtxosRequest) (*ListUtxosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUtxos not implemented")
 }
+func (UnimplementedTaprootAssetsServer) SetUtxoNote(context.Context, *SetUtxoNoteRequest) (*SetUtxoNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUtxoNote not implemented")
+}
+func (UnimplementedTaprootAssetsServer) FetchUtxoNote(context.Context, *FetchUtxoNoteRequest) (*FetchUtxoNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchUtxoNote not implemented")
+}
 func (UnimplementedTaprootAssetsServer) ListGroups(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListGroups not implemented")
 }
+func (UnimplementedTaprootAssetsServer) QueryGroupSummary(context.Context, *QueryGroupSummaryRequest) (*QueryGroupSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryGroupSummary not implemented")
+}
 func (UnimplementedTaprootAssetsServer) ListBalances(context.Context, *ListBalancesRequest) (*ListBalancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBalances not implemented")
 }
 func (UnimplementedTaprootAssetsServer) ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTransfers not implemented")
 }
+func (UnimplementedTaprootAssetsServer) ListCoinSelections(context.Context, *ListCoinSelectionsRequest) (*ListCoinSelectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCoinSelections not implemented")
+}
+func (UnimplementedTaprootAssetsServer) GenerateStatement(context.Context, *StatementRequest) (*StatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateStatement not implemented")
+}
+func (UnimplementedTaprootAssetsServer) QueryFeeSpend(context.Context, *FeeSpendRequest) (*FeeSpendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryFeeSpend not implemented")
+}
+func (UnimplementedTaprootAssetsServer) DiffAssetSnapshots(context.Context, *SnapshotDiffRequest) (*SnapshotDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffAssetSnapshots not implemented")
+}
 func (UnimplementedTaprootAssetsServer) StopDaemon(context.Context, *StopRequest) (*StopResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopDaemon not implemented")
 }
@@ -462,6 +1021,36 @@ func (UnimplementedTaprootAssetsServer) DecodeAddr(context.Context, *DecodeAddrR
 func (UnimplementedTaprootAssetsServer) AddrReceives(context.Context, *AddrReceivesRequest) (*AddrReceivesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddrReceives not implemented")
 }
+func (UnimplementedTaprootAssetsServer) SetAddrNote(context.Context, *SetAddrNoteRequest) (*SetAddrNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAddrNote not implemented")
+}
+func (UnimplementedTaprootAssetsServer) SetAddrExpiry(context.Context, *SetAddrExpiryRequest) (*SetAddrExpiryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAddrExpiry not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ArchiveAddr(context.Context, *ArchiveAddrRequest) (*ArchiveAddrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveAddr not implemented")
+}
+func (UnimplementedTaprootAssetsServer) UnarchiveAddr(context.Context, *UnarchiveAddrRequest) (*UnarchiveAddrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnarchiveAddr not implemented")
+}
+func (UnimplementedTaprootAssetsServer) NewStaticAddr(context.Context, *NewStaticAddrRequest) (*StaticAddr, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewStaticAddr not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ListStaticAddrs(context.Context, *ListStaticAddrsRequest) (*ListStaticAddrsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStaticAddrs not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ImportStaticPaymentTx(context.Context, *ImportStaticPaymentTxRequest) (*ImportStaticPaymentTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportStaticPaymentTx not implemented")
+}
+func (UnimplementedTaprootAssetsServer) AddContact(context.Context, *AddContactRequest) (*AddContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddContact not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ListContacts(context.Context, *ListContactsRequest) (*ListContactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListContacts not implemented")
+}
+func (UnimplementedTaprootAssetsServer) DeleteContact(context.Context, *DeleteContactRequest) (*DeleteContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteContact not implemented")
+}
 func (UnimplementedTaprootAssetsServer) VerifyProof(context.Context, *ProofFile) (*VerifyProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyProof not implemented")
 }
@@ -471,15 +1060,48 @@ func (UnimplementedTaprootAssetsServer) DecodeProof(context.Context, *DecodeProo
 func (UnimplementedTaprootAssetsServer) ExportProof(context.Context, *ExportProofRequest) (*ProofFile, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExportProof not implemented")
 }
+func (UnimplementedTaprootAssetsServer) BulkImportProofs(context.Context, *BulkImportProofsRequest) (*BulkImportProofsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkImportProofs not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ProofImportStatus(context.Context, *ProofImportStatusRequest) (*ProofImportStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProofImportStatus not implemented")
+}
 func (UnimplementedTaprootAssetsServer) SendAsset(context.Context, *SendAssetRequest) (*SendAssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendAsset not implemented")
 }
+func (UnimplementedTaprootAssetsServer) SendToScriptKey(context.Context, *SendToScriptKeyRequest) (*SendToScriptKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendToScriptKey not implemented")
+}
+func (UnimplementedTaprootAssetsServer) SendStaticPayment(context.Context, *SendStaticPaymentRequest) (*SendStaticPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendStaticPayment not implemented")
+}
 func (UnimplementedTaprootAssetsServer) BurnAsset(context.Context, *BurnAssetRequest) (*BurnAssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BurnAsset not implemented")
 }
+func (UnimplementedTaprootAssetsServer) BumpTransferFee(context.Context, *BumpTransferFeeRequest) (*BumpTransferFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpTransferFee not implemented")
+}
+func (UnimplementedTaprootAssetsServer) CancelTransfer(context.Context, *CancelTransferRequest) (*CancelTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTransfer not implemented")
+}
+func (UnimplementedTaprootAssetsServer) SetSendLimit(context.Context, *SetSendLimitRequest) (*SetSendLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSendLimit not implemented")
+}
+func (UnimplementedTaprootAssetsServer) RemoveSendLimit(context.Context, *RemoveSendLimitRequest) (*RemoveSendLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSendLimit not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ListSendLimits(context.Context, *ListSendLimitsRequest) (*ListSendLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSendLimits not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ListSendTotals(context.Context, *ListSendTotalsRequest) (*ListSendTotalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSendTotals not implemented")
+}
 func (UnimplementedTaprootAssetsServer) GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
 }
+func (UnimplementedTaprootAssetsServer) GetDatabaseHealth(context.Context, *DatabaseHealthRequest) (*DatabaseHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDatabaseHealth not implemented")
+}
 func (UnimplementedTaprootAssetsServer) FetchAssetMeta(context.Context, *FetchAssetMetaRequest) (*AssetMeta, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FetchAssetMeta not implemented")
 }
@@ -538,164 +1160,470 @@ func _TaprootAssets_ListUtxos_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaprootAssets_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListGroupsRequest)
+func _TaprootAssets_SetUtxoNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUtxoNoteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaprootAssetsServer).ListGroups(ctx, in)
+		return srv.(TaprootAssetsServer).SetUtxoNote(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/taprpc.TaprootAssets/ListGroups",
+		FullMethod: "/taprpc.TaprootAssets/SetUtxoNote",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaprootAssetsServer).ListGroups(ctx, req.(*ListGroupsRequest))
+		return srv.(TaprootAssetsServer).SetUtxoNote(ctx, req.(*SetUtxoNoteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaprootAssets_ListBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListBalancesRequest)
+func _TaprootAssets_FetchUtxoNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchUtxoNoteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaprootAssetsServer).ListBalances(ctx, in)
+		return srv.(TaprootAssetsServer).FetchUtxoNote(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/taprpc.TaprootAssets/ListBalances",
+		FullMethod: "/taprpc.TaprootAssets/FetchUtxoNote",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaprootAssetsServer).ListBalances(ctx, req.(*ListBalancesRequest))
+		return srv.(TaprootAssetsServer).FetchUtxoNote(ctx, req.(*FetchUtxoNoteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaprootAssets_ListTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTransfersRequest)
+func _TaprootAssets_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaprootAssetsServer).ListTransfers(ctx, in)
+		return srv.(TaprootAssetsServer).ListGroups(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/taprpc.TaprootAssets/ListTransfers",
+		FullMethod: "/taprpc.TaprootAssets/ListGroups",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaprootAssetsServer).ListTransfers(ctx, req.(*ListTransfersRequest))
+		return srv.(TaprootAssetsServer).ListGroups(ctx, req.(*ListGroupsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaprootAssets_StopDaemon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StopRequest)
+func _TaprootAssets_QueryGroupSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupSummaryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaprootAssetsServer).StopDaemon(ctx, in)
+		return srv.(TaprootAssetsServer).QueryGroupSummary(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/taprpc.TaprootAssets/StopDaemon",
+		FullMethod: "/taprpc.TaprootAssets/QueryGroupSummary",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaprootAssetsServer).StopDaemon(ctx, req.(*StopRequest))
+		return srv.(TaprootAssetsServer).QueryGroupSummary(ctx, req.(*QueryGroupSummaryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaprootAssets_DebugLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DebugLevelRequest)
+func _TaprootAssets_ListBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBalancesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaprootAssetsServer).DebugLevel(ctx, in)
+		return srv.(TaprootAssetsServer).ListBalances(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/taprpc.TaprootAssets/DebugLevel",
+		FullMethod: "/taprpc.TaprootAssets/ListBalances",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaprootAssetsServer).DebugLevel(ctx, req.(*DebugLevelRequest))
+		return srv.(TaprootAssetsServer).ListBalances(ctx, req.(*ListBalancesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaprootAssets_QueryAddrs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAddrRequest)
+func _TaprootAssets_ListTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransfersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaprootAssetsServer).QueryAddrs(ctx, in)
+		return srv.(TaprootAssetsServer).ListTransfers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/taprpc.TaprootAssets/QueryAddrs",
+		FullMethod: "/taprpc.TaprootAssets/ListTransfers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaprootAssetsServer).QueryAddrs(ctx, req.(*QueryAddrRequest))
+		return srv.(TaprootAssetsServer).ListTransfers(ctx, req.(*ListTransfersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaprootAssets_NewAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NewAddrRequest)
+func _TaprootAssets_ListCoinSelections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCoinSelectionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaprootAssetsServer).NewAddr(ctx, in)
+		return srv.(TaprootAssetsServer).ListCoinSelections(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/taprpc.TaprootAssets/NewAddr",
+		FullMethod: "/taprpc.TaprootAssets/ListCoinSelections",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaprootAssetsServer).NewAddr(ctx, req.(*NewAddrRequest))
+		return srv.(TaprootAssetsServer).ListCoinSelections(ctx, req.(*ListCoinSelectionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaprootAssets_DecodeAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DecodeAddrRequest)
+func _TaprootAssets_GenerateStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatementRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaprootAssetsServer).DecodeAddr(ctx, in)
+		return srv.(TaprootAssetsServer).GenerateStatement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/taprpc.TaprootAssets/DecodeAddr",
+		FullMethod: "/taprpc.TaprootAssets/GenerateStatement",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaprootAssetsServer).DecodeAddr(ctx, req.(*DecodeAddrRequest))
+		return srv.(TaprootAssetsServer).GenerateStatement(ctx, req.(*StatementRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaprootAssets_AddrReceives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddrReceivesRequest)
+func _TaprootAssets_QueryFeeSpend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeeSpendRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaprootAssetsServer).AddrReceives(ctx, in)
+		return srv.(TaprootAssetsServer).QueryFeeSpend(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/taprpc.TaprootAssets/AddrReceives",
+		FullMethod: "/taprpc.TaprootAssets/QueryFeeSpend",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaprootAssetsServer).AddrReceives(ctx, req.(*AddrReceivesRequest))
+		return srv.(TaprootAssetsServer).QueryFeeSpend(ctx, req.(*FeeSpendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_DiffAssetSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).DiffAssetSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/DiffAssetSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).DiffAssetSnapshots(ctx, req.(*SnapshotDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_StopDaemon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).StopDaemon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/StopDaemon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).StopDaemon(ctx, req.(*StopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_DebugLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebugLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).DebugLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/DebugLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).DebugLevel(ctx, req.(*DebugLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_QueryAddrs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).QueryAddrs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/QueryAddrs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).QueryAddrs(ctx, req.(*QueryAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_NewAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewAddrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).NewAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/NewAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).NewAddr(ctx, req.(*NewAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_DecodeAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeAddrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).DecodeAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/DecodeAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).DecodeAddr(ctx, req.(*DecodeAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_AddrReceives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddrReceivesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).AddrReceives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/AddrReceives",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).AddrReceives(ctx, req.(*AddrReceivesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_SetAddrNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAddrNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).SetAddrNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/SetAddrNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).SetAddrNote(ctx, req.(*SetAddrNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_SetAddrExpiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAddrExpiryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).SetAddrExpiry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/SetAddrExpiry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).SetAddrExpiry(ctx, req.(*SetAddrExpiryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ArchiveAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveAddrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ArchiveAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ArchiveAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ArchiveAddr(ctx, req.(*ArchiveAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_UnarchiveAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnarchiveAddrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).UnarchiveAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/UnarchiveAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).UnarchiveAddr(ctx, req.(*UnarchiveAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_NewStaticAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewStaticAddrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).NewStaticAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/NewStaticAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).NewStaticAddr(ctx, req.(*NewStaticAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ListStaticAddrs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStaticAddrsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ListStaticAddrs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ListStaticAddrs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ListStaticAddrs(ctx, req.(*ListStaticAddrsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ImportStaticPaymentTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportStaticPaymentTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ImportStaticPaymentTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ImportStaticPaymentTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ImportStaticPaymentTx(ctx, req.(*ImportStaticPaymentTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_AddContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).AddContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/AddContact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).AddContact(ctx, req.(*AddContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ListContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ListContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ListContacts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ListContacts(ctx, req.(*ListContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_DeleteContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).DeleteContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/DeleteContact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).DeleteContact(ctx, req.(*DeleteContactRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -754,6 +1682,42 @@ func _TaprootAssets_ExportProof_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaprootAssets_BulkImportProofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkImportProofsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).BulkImportProofs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/BulkImportProofs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).BulkImportProofs(ctx, req.(*BulkImportProofsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ProofImportStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProofImportStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ProofImportStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ProofImportStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ProofImportStatus(ctx, req.(*ProofImportStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaprootAssets_SendAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SendAssetRequest)
 	if err := dec(in); err != nil {
@@ -772,6 +1736,42 @@ func _TaprootAssets_SendAsset_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaprootAssets_SendToScriptKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendToScriptKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).SendToScriptKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/SendToScriptKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).SendToScriptKey(ctx, req.(*SendToScriptKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_SendStaticPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendStaticPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).SendStaticPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/SendStaticPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).SendStaticPayment(ctx, req.(*SendStaticPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaprootAssets_BurnAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BurnAssetRequest)
 	if err := dec(in); err != nil {
@@ -790,6 +1790,114 @@ func _TaprootAssets_BurnAsset_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaprootAssets_BumpTransferFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpTransferFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).BumpTransferFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/BumpTransferFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).BumpTransferFee(ctx, req.(*BumpTransferFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_CancelTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).CancelTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/CancelTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).CancelTransfer(ctx, req.(*CancelTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_SetSendLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSendLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).SetSendLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/SetSendLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).SetSendLimit(ctx, req.(*SetSendLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_RemoveSendLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSendLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).RemoveSendLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/RemoveSendLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).RemoveSendLimit(ctx, req.(*RemoveSendLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ListSendLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSendLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ListSendLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ListSendLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ListSendLimits(ctx, req.(*ListSendLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ListSendTotals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSendTotalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ListSendTotals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ListSendTotals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ListSendTotals(ctx, req.(*ListSendTotalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaprootAssets_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetInfoRequest)
 	if err := dec(in); err != nil {
@@ -808,6 +1916,24 @@ func _TaprootAssets_GetInfo_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaprootAssets_GetDatabaseHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DatabaseHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).GetDatabaseHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/GetDatabaseHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).GetDatabaseHealth(ctx, req.(*DatabaseHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaprootAssets_FetchAssetMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FetchAssetMetaRequest)
 	if err := dec(in); err != nil {
@@ -883,10 +2009,22 @@ var TaprootAssets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListUtxos",
 			Handler:    _TaprootAssets_ListUtxos_Handler,
 		},
+		{
+			MethodName: "SetUtxoNote",
+			Handler:    _TaprootAssets_SetUtxoNote_Handler,
+		},
+		{
+			MethodName: "FetchUtxoNote",
+			Handler:    _TaprootAssets_FetchUtxoNote_Handler,
+		},
 		{
 			MethodName: "ListGroups",
 			Handler:    _TaprootAssets_ListGroups_Handler,
 		},
+		{
+			MethodName: "QueryGroupSummary",
+			Handler:    _TaprootAssets_QueryGroupSummary_Handler,
+		},
 		{
 			MethodName: "ListBalances",
 			Handler:    _TaprootAssets_ListBalances_Handler,
@@ -895,6 +2033,22 @@ var TaprootAssets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTransfers",
 			Handler:    _TaprootAssets_ListTransfers_Handler,
 		},
+		{
+			MethodName: "ListCoinSelections",
+			Handler:    _TaprootAssets_ListCoinSelections_Handler,
+		},
+		{
+			MethodName: "GenerateStatement",
+			Handler:    _TaprootAssets_GenerateStatement_Handler,
+		},
+		{
+			MethodName: "QueryFeeSpend",
+			Handler:    _TaprootAssets_QueryFeeSpend_Handler,
+		},
+		{
+			MethodName: "DiffAssetSnapshots",
+			Handler:    _TaprootAssets_DiffAssetSnapshots_Handler,
+		},
 		{
 			MethodName: "StopDaemon",
 			Handler:    _TaprootAssets_StopDaemon_Handler,
@@ -919,6 +2073,46 @@ var TaprootAssets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddrReceives",
 			Handler:    _TaprootAssets_AddrReceives_Handler,
 		},
+		{
+			MethodName: "SetAddrNote",
+			Handler:    _TaprootAssets_SetAddrNote_Handler,
+		},
+		{
+			MethodName: "SetAddrExpiry",
+			Handler:    _TaprootAssets_SetAddrExpiry_Handler,
+		},
+		{
+			MethodName: "ArchiveAddr",
+			Handler:    _TaprootAssets_ArchiveAddr_Handler,
+		},
+		{
+			MethodName: "UnarchiveAddr",
+			Handler:    _TaprootAssets_UnarchiveAddr_Handler,
+		},
+		{
+			MethodName: "NewStaticAddr",
+			Handler:    _TaprootAssets_NewStaticAddr_Handler,
+		},
+		{
+			MethodName: "ListStaticAddrs",
+			Handler:    _TaprootAssets_ListStaticAddrs_Handler,
+		},
+		{
+			MethodName: "ImportStaticPaymentTx",
+			Handler:    _TaprootAssets_ImportStaticPaymentTx_Handler,
+		},
+		{
+			MethodName: "AddContact",
+			Handler:    _TaprootAssets_AddContact_Handler,
+		},
+		{
+			MethodName: "ListContacts",
+			Handler:    _TaprootAssets_ListContacts_Handler,
+		},
+		{
+			MethodName: "DeleteContact",
+			Handler:    _TaprootAssets_DeleteContact_Handler,
+		},
 		{
 			MethodName: "VerifyProof",
 			Handler:    _TaprootAssets_VerifyProof_Handler,
@@ -931,18 +2125,62 @@ var TaprootAssets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExportProof",
 			Handler:    _TaprootAssets_ExportProof_Handler,
 		},
+		{
+			MethodName: "BulkImportProofs",
+			Handler:    _TaprootAssets_BulkImportProofs_Handler,
+		},
+		{
+			MethodName: "ProofImportStatus",
+			Handler:    _TaprootAssets_ProofImportStatus_Handler,
+		},
 		{
 			MethodName: "SendAsset",
 			Handler:    _TaprootAssets_SendAsset_Handler,
 		},
+		{
+			MethodName: "SendToScriptKey",
+			Handler:    _TaprootAssets_SendToScriptKey_Handler,
+		},
+		{
+			MethodName: "SendStaticPayment",
+			Handler:    _TaprootAssets_SendStaticPayment_Handler,
+		},
 		{
 			MethodName: "BurnAsset",
 			Handler:    _TaprootAssets_BurnAsset_Handler,
 		},
+		{
+			MethodName: "BumpTransferFee",
+			Handler:    _TaprootAssets_BumpTransferFee_Handler,
+		},
+		{
+			MethodName: "CancelTransfer",
+			Handler:    _TaprootAssets_CancelTransfer_Handler,
+		},
+		{
+			MethodName: "SetSendLimit",
+			Handler:    _TaprootAssets_SetSendLimit_Handler,
+		},
+		{
+			MethodName: "RemoveSendLimit",
+			Handler:    _TaprootAssets_RemoveSendLimit_Handler,
+		},
+		{
+			MethodName: "ListSendLimits",
+			Handler:    _TaprootAssets_ListSendLimits_Handler,
+		},
+		{
+			MethodName: "ListSendTotals",
+			Handler:    _TaprootAssets_ListSendTotals_Handler,
+		},
 		{
 			MethodName: "GetInfo",
 			Handler:    _TaprootAssets_GetInfo_Handler,
 		},
+		{
+			MethodName: "GetDatabaseHealth",
+			Handler:    _TaprootAssets_GetDatabaseHealth_Handler,
+		},
 		{
 			MethodName: "FetchAssetMeta",
 			Handler:    _TaprootAssets_FetchAssetMeta_Handler,
@@ -4,6 +4,7 @@ package universerpc
 
 import (
 	context "context"
+	taprpc "github.com/lightninglabs/taproot-assets/taprpc"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -58,6 +59,13 @@ type UniverseClient interface {
 	// to the known Universe root, as well as a Taproot Asset state transition or
 	// issuance proof for the said asset.
 	QueryProof(ctx context.Context, in *UniverseKey, opts ...grpc.CallOption) (*AssetProofResponse, error)
+	// tapcli: `universe meta`
+	// QueryAssetMeta returns the full metadata of an asset that has a leaf in
+	// one of the local universes, identified by its meta hash. This allows
+	// receivers to resolve the metadata of an asset without downloading its
+	// issuance proof. Metadata that is only known to the local wallet isn't
+	// returned.
+	QueryAssetMeta(ctx context.Context, in *AssetMetaRequest, opts ...grpc.CallOption) (*taprpc.AssetMeta, error)
 	// tapcli: `universe proofs insert`
 	// InsertProof attempts to insert a new issuance or transfer proof into the
 	// Universe tree specified by the UniverseKey. If valid, then the proof is
@@ -67,6 +75,18 @@ type UniverseClient interface {
 	// tapcli: `universe info`
 	// Info returns a set of information about the current state of the Universe.
 	Info(ctx context.Context, in *InfoRequest, opts ...grpc.CallOption) (*InfoResponse, error)
+	// NotifyRootUpdate notifies the Universe server that a universe root of one
+	// of its federation members changed, so the server can pull the new leaves
+	// right away instead of waiting for the next periodic sync. The notification
+	// is only accepted if it is signed with the identity key that was set for
+	// the federation member it originates from.
+	NotifyRootUpdate(ctx context.Context, in *NotifyRootUpdateRequest, opts ...grpc.CallOption) (*NotifyRootUpdateResponse, error)
+	// GossipServerList returns the list of federation servers the Universe
+	// server recently synced with, signed with its identity key. Federation
+	// members fetch the list to learn about new servers. The list is only
+	// accepted if it originates from the member it was fetched from and is
+	// signed with the identity key that was set for that member.
+	GossipServerList(ctx context.Context, in *GossipServerListRequest, opts ...grpc.CallOption) (*GossipServerListResponse, error)
 	// tapcli: `universe sync`
 	// SyncUniverse takes host information for a remote Universe server, then
 	// attempts to synchronize either only the set of specified asset_ids, or all
@@ -101,7 +121,8 @@ type UniverseClient interface {
 	QueryAssetStats(ctx context.Context, in *AssetStatsQuery, opts ...grpc.CallOption) (*UniverseAssetStats, error)
 	// tapcli `universe stats events`
 	// QueryEvents returns the number of sync and proof events for a given time
-	// period, grouped by day.
+	// period, grouped by day. If an asset ID filter is set, the supply changes of
+	// that asset are also returned for each day.
 	QueryEvents(ctx context.Context, in *QueryEventsRequest, opts ...grpc.CallOption) (*QueryEventsResponse, error)
 	// SetFederationSyncConfig sets the configuration of the universe federation
 	// sync.
@@ -110,6 +131,45 @@ type UniverseClient interface {
 	// QueryFederationSyncConfig queries the universe federation sync configuration
 	// settings.
 	QueryFederationSyncConfig(ctx context.Context, in *QueryFederationSyncConfigRequest, opts ...grpc.CallOption) (*QueryFederationSyncConfigResponse, error)
+	// tapcli: `universe federation profile list`
+	// ListFederationProfiles lists the stored federation profiles. A federation
+	// profile is a named set of universe servers and federation sync configs.
+	ListFederationProfiles(ctx context.Context, in *ListFederationProfilesRequest, opts ...grpc.CallOption) (*ListFederationProfilesResponse, error)
+	// tapcli: `universe federation profile save`
+	// SaveFederationProfile stores the current set of federation servers and sync
+	// configs as a profile with the given name, replacing any existing profile
+	// with the same name.
+	SaveFederationProfile(ctx context.Context, in *SaveFederationProfileRequest, opts ...grpc.CallOption) (*SaveFederationProfileResponse, error)
+	// tapcli: `universe federation profile apply`
+	// ApplyFederationProfile switches the federation to the profile with the
+	// given name. All federation servers that aren't part of the profile are
+	// removed, the servers of the profile are added and the sync configs of the
+	// profile are applied. Once applied, the profile is marked as the active one.
+	ApplyFederationProfile(ctx context.Context, in *ApplyFederationProfileRequest, opts ...grpc.CallOption) (*ApplyFederationProfileResponse, error)
+	// tapcli: `universe federation profile delete`
+	// DeleteFederationProfile deletes the federation profile with the given name.
+	// The current federation servers and sync configs are left untouched.
+	DeleteFederationProfile(ctx context.Context, in *DeleteFederationProfileRequest, opts ...grpc.CallOption) (*DeleteFederationProfileResponse, error)
+	// tapcli: `universe subscribe`
+	// SubscribeLeaves subscribes to the proof leaves that are inserted or
+	// updated in the given universes. If no universe is given, the leaves of all
+	// universes are streamed. Only leaves that are upserted after the
+	// subscription was created are delivered.
+	SubscribeLeaves(ctx context.Context, in *SubscribeLeavesRequest, opts ...grpc.CallOption) (Universe_SubscribeLeavesClient, error)
+	// tapcli: `universe subscriberoots`
+	// SubscribeUniverseRoots subscribes to changes of the roots of the local
+	// universes of the given assets and asset groups. If no asset or group is
+	// given, the root changes of all universes are streamed. A batch of leaves
+	// inserted into a universe at once only results in a single event.
+	SubscribeUniverseRoots(ctx context.Context, in *SubscribeUniverseRootsRequest, opts ...grpc.CallOption) (Universe_SubscribeUniverseRootsClient, error)
+	// tapcli: `universe proofs push`
+	// PushProof immediately pushes a single proof leaf of the local universe to
+	// the given federation servers, or to all of them if no servers are given.
+	// The federation sync configs are ignored, as the push is explicitly
+	// requested. The call returns once all pushes are done, with one result per
+	// server. A push that fails stays pending in the proof sync log and is
+	// retried with the next federation sync.
+	PushProof(ctx context.Context, in *PushProofRequest, opts ...grpc.CallOption) (*PushProofResponse, error)
 }
 
 type universeClient struct {
@@ -183,6 +243,15 @@ func (c *universeClient) QueryProof(ctx context.Context, in *UniverseKey, opts .
 	return out, nil
 }
 
+func (c *universeClient) QueryAssetMeta(ctx context.Context, in *AssetMetaRequest, opts ...grpc.CallOption) (*taprpc.AssetMeta, error) {
+	out := new(taprpc.AssetMeta)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/QueryAssetMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *universeClient) InsertProof(ctx context.Context, in *AssetProof, opts ...grpc.CallOption) (*AssetProofResponse, error) {
 	out := new(AssetProofResponse)
 	err := c.cc.Invoke(ctx, "/universerpc.Universe/InsertProof", in, out, opts...)
@@ -201,6 +270,24 @@ func (c *universeClient) Info(ctx context.Context, in *InfoRequest, opts ...grpc
 	return out, nil
 }
 
+func (c *universeClient) NotifyRootUpdate(ctx context.Context, in *NotifyRootUpdateRequest, opts ...grpc.CallOption) (*NotifyRootUpdateResponse, error) {
+	out := new(NotifyRootUpdateResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/NotifyRootUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) GossipServerList(ctx context.Context, in *GossipServerListRequest, opts ...grpc.CallOption) (*GossipServerListResponse, error) {
+	out := new(GossipServerListResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/GossipServerList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *universeClient) SyncUniverse(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*SyncResponse, error) {
 	out := new(SyncResponse)
 	err := c.cc.Invoke(ctx, "/universerpc.Universe/SyncUniverse", in, out, opts...)
@@ -282,6 +369,115 @@ func (c *universeClient) QueryFederationSyncConfig(ctx context.Context, in *Quer
 	return out, nil
 }
 
+func (c *universeClient) ListFederationProfiles(ctx context.Context, in *ListFederationProfilesRequest, opts ...grpc.CallOption) (*ListFederationProfilesResponse, error) {
+	out := new(ListFederationProfilesResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/ListFederationProfiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) SaveFederationProfile(ctx context.Context, in *SaveFederationProfileRequest, opts ...grpc.CallOption) (*SaveFederationProfileResponse, error) {
+	out := new(SaveFederationProfileResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/SaveFederationProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) ApplyFederationProfile(ctx context.Context, in *ApplyFederationProfileRequest, opts ...grpc.CallOption) (*ApplyFederationProfileResponse, error) {
+	out := new(ApplyFederationProfileResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/ApplyFederationProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) DeleteFederationProfile(ctx context.Context, in *DeleteFederationProfileRequest, opts ...grpc.CallOption) (*DeleteFederationProfileResponse, error) {
+	out := new(DeleteFederationProfileResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/DeleteFederationProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) SubscribeLeaves(ctx context.Context, in *SubscribeLeavesRequest, opts ...grpc.CallOption) (Universe_SubscribeLeavesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Universe_ServiceDesc.Streams[0], "/universerpc.Universe/SubscribeLeaves", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &universeSubscribeLeavesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Universe_SubscribeLeavesClient interface {
+	Recv() (*UniverseLeafEvent, error)
+	grpc.ClientStream
+}
+
+type universeSubscribeLeavesClient struct {
+	grpc.ClientStream
+}
+
+func (x *universeSubscribeLeavesClient) Recv() (*UniverseLeafEvent, error) {
+	m := new(UniverseLeafEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *universeClient) SubscribeUniverseRoots(ctx context.Context, in *SubscribeUniverseRootsRequest, opts ...grpc.CallOption) (Universe_SubscribeUniverseRootsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Universe_ServiceDesc.Streams[1], "/universerpc.Universe/SubscribeUniverseRoots", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &universeSubscribeUniverseRootsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Universe_SubscribeUniverseRootsClient interface {
+	Recv() (*UniverseRootEvent, error)
+	grpc.ClientStream
+}
+
+type universeSubscribeUniverseRootsClient struct {
+	grpc.ClientStream
+}
+
+func (x *universeSubscribeUniverseRootsClient) Recv() (*UniverseRootEvent, error) {
+	m := new(UniverseRootEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *universeClient) PushProof(ctx context.Context, in *PushProofRequest, opts ...grpc.CallOption) (*PushProofResponse, error) {
+	out := new(PushProofResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/PushProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UniverseServer is the server API for Universe service.
 // All implementations must embed UnimplementedUniverseServer
 // for forward compatibility
@@ -326,6 +522,13 @@ type UniverseServer interface {
 	// to the known Universe root, as well as a Taproot Asset state transition or
 	// issuance proof for the said asset.
 	QueryProof(context.Context, *UniverseKey) (*AssetProofResponse, error)
+	// tapcli: `universe meta`
+	// QueryAssetMeta returns the full metadata of an asset that has a leaf in
+	// one of the local universes, identified by its meta hash. This allows
+	// receivers to resolve the metadata of an asset without downloading its
+	// issuance proof. Metadata that is only known to the local wallet isn't
+	// returned.
+	QueryAssetMeta(context.Context, *AssetMetaRequest) (*taprpc.AssetMeta, error)
 	// tapcli: `universe proofs insert`
 	// InsertProof attempts to insert a new issuance or transfer proof into the
 	// Universe tree specified by the UniverseKey. If valid, then the proof is
@@ -335,6 +538,18 @@ type UniverseServer interface {
 	// tapcli: `universe info`
 	// Info returns a set of information about the current state of the Universe.
 	Info(context.Context, *InfoRequest) (*InfoResponse, error)
+	// NotifyRootUpdate notifies the Universe server that a universe root of one
+	// of its federation members changed, so the server can pull the new leaves
+	// right away instead of waiting for the next periodic sync. The notification
+	// is only accepted if it is signed with the identity key that was set for
+	// the federation member it originates from.
+	NotifyRootUpdate(context.Context, *NotifyRootUpdateRequest) (*NotifyRootUpdateResponse, error)
+	// GossipServerList returns the list of federation servers the Universe
+	// server recently synced with, signed with its identity key. Federation
+	// members fetch the list to learn about new servers. The list is only
+	// accepted if it originates from the member it was fetched from and is
+	// signed with the identity key that was set for that member.
+	GossipServerList(context.Context, *GossipServerListRequest) (*GossipServerListResponse, error)
 	// tapcli: `universe sync`
 	// SyncUniverse takes host information for a remote Universe server, then
 	// attempts to synchronize either only the set of specified asset_ids, or all
@@ -369,7 +584,8 @@ type UniverseServer interface {
 	QueryAssetStats(context.Context, *AssetStatsQuery) (*UniverseAssetStats, error)
 	// tapcli `universe stats events`
 	// QueryEvents returns the number of sync and proof events for a given time
-	// period, grouped by day.
+	// period, grouped by day. If an asset ID filter is set, the supply changes of
+	// that asset are also returned for each day.
 	QueryEvents(context.Context, *QueryEventsRequest) (*QueryEventsResponse, error)
 	// SetFederationSyncConfig sets the configuration of the universe federation
 	// sync.
@@ -378,6 +594,45 @@ type UniverseServer interface {
 	// QueryFederationSyncConfig queries the universe federation sync configuration
 	// settings.
 	QueryFederationSyncConfig(context.Context, *QueryFederationSyncConfigRequest) (*QueryFederationSyncConfigResponse, error)
+	// tapcli: `universe federation profile list`
+	// ListFederationProfiles lists the stored federation profiles. A federation
+	// profile is a named set of universe servers and federation sync configs.
+	ListFederationProfiles(context.Context, *ListFederationProfilesRequest) (*ListFederationProfilesResponse, error)
+	// tapcli: `universe federation profile save`
+	// SaveFederationProfile stores the current set of federation servers and sync
+	// configs as a profile with the given name, replacing any existing profile
+	// with the same name.
+	SaveFederationProfile(context.Context, *SaveFederationProfileRequest) (*SaveFederationProfileResponse, error)
+	// tapcli: `universe federation profile apply`
+	// ApplyFederationProfile switches the federation to the profile with the
+	// given name. All federation servers that aren't part of the profile are
+	// removed, the servers of the profile are added and the sync configs of the
+	// profile are applied. Once applied, the profile is marked as the active one.
+	ApplyFederationProfile(context.Context, *ApplyFederationProfileRequest) (*ApplyFederationProfileResponse, error)
+	// tapcli: `universe federation profile delete`
+	// DeleteFederationProfile deletes the federation profile with the given name.
+	// The current federation servers and sync configs are left untouched.
+	DeleteFederationProfile(context.Context, *DeleteFederationProfileRequest) (*DeleteFederationProfileResponse, error)
+	// tapcli: `universe subscribe`
+	// SubscribeLeaves subscribes to the proof leaves that are inserted or
+	// updated in the given universes. If no universe is given, the leaves of all
+	// universes are streamed. Only leaves that are upserted after the
+	// subscription was created are delivered.
+	SubscribeLeaves(*SubscribeLeavesRequest, Universe_SubscribeLeavesServer) error
+	// tapcli: `universe subscriberoots`
+	// SubscribeUniverseRoots subscribes to changes of the roots of the local
+	// universes of the given assets and asset groups. If no asset or group is
+	// given, the root changes of all universes are streamed. A batch of leaves
+	// inserted into a universe at once only results in a single event.
+	SubscribeUniverseRoots(*SubscribeUniverseRootsRequest, Universe_SubscribeUniverseRootsServer) error
+	// tapcli: `universe proofs push`
+	// PushProof immediately pushes a single proof leaf of the local universe to
+	// the given federation servers, or to all of them if no servers are given.
+	// The federation sync configs are ignored, as the push is explicitly
+	// requested. The call returns once all pushes are done, with one result per
+	// server. A push that fails stays pending in the proof sync log and is
+	// retried with the next federation sync.
+	PushProof(context.Context, *PushProofRequest) (*PushProofResponse, error)
 	mustEmbedUnimplementedUniverseServer()
 }
 
@@ -406,12 +661,21 @@ func (UnimplementedUniverseServer) AssetLeaves(context.Context, *ID) (*AssetLeaf
 func (UnimplementedUniverseServer) QueryProof(context.Context, *UniverseKey) (*AssetProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryProof not implemented")
 }
+func (UnimplementedUniverseServer) QueryAssetMeta(context.Context, *AssetMetaRequest) (*taprpc.AssetMeta, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAssetMeta not implemented")
+}
 func (UnimplementedUniverseServer) InsertProof(context.Context, *AssetProof) (*AssetProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InsertProof not implemented")
 }
 func (UnimplementedUniverseServer) Info(context.Context, *InfoRequest) (*InfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Info not implemented")
 }
+func (UnimplementedUniverseServer) NotifyRootUpdate(context.Context, *NotifyRootUpdateRequest) (*NotifyRootUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifyRootUpdate not implemented")
+}
+func (UnimplementedUniverseServer) GossipServerList(context.Context, *GossipServerListRequest) (*GossipServerListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GossipServerList not implemented")
+}
 func (UnimplementedUniverseServer) SyncUniverse(context.Context, *SyncRequest) (*SyncResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncUniverse not implemented")
 }
@@ -439,6 +703,27 @@ func (UnimplementedUniverseServer) SetFederationSyncConfig(context.Context, *Set
 func (UnimplementedUniverseServer) QueryFederationSyncConfig(context.Context, *QueryFederationSyncConfigRequest) (*QueryFederationSyncConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryFederationSyncConfig not implemented")
 }
+func (UnimplementedUniverseServer) ListFederationProfiles(context.Context, *ListFederationProfilesRequest) (*ListFederationProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFederationProfiles not implemented")
+}
+func (UnimplementedUniverseServer) SaveFederationProfile(context.Context, *SaveFederationProfileRequest) (*SaveFederationProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveFederationProfile not implemented")
+}
+func (UnimplementedUniverseServer) ApplyFederationProfile(context.Context, *ApplyFederationProfileRequest) (*ApplyFederationProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyFederationProfile not implemented")
+}
+func (UnimplementedUniverseServer) DeleteFederationProfile(context.Context, *DeleteFederationProfileRequest) (*DeleteFederationProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFederationProfile not implemented")
+}
+func (UnimplementedUniverseServer) SubscribeLeaves(*SubscribeLeavesRequest, Universe_SubscribeLeavesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeLeaves not implemented")
+}
+func (UnimplementedUniverseServer) SubscribeUniverseRoots(*SubscribeUniverseRootsRequest, Universe_SubscribeUniverseRootsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeUniverseRoots not implemented")
+}
+func (UnimplementedUniverseServer) PushProof(context.Context, *PushProofRequest) (*PushProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushProof not implemented")
+}
 func (UnimplementedUniverseServer) mustEmbedUnimplementedUniverseServer() {}
 
 // UnsafeUniverseServer may be embedded to opt out of forward compatibility for this service.
@@ -578,6 +863,24 @@ func _Universe_QueryProof_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Universe_QueryAssetMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssetMetaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).QueryAssetMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/QueryAssetMeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).QueryAssetMeta(ctx, req.(*AssetMetaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Universe_InsertProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AssetProof)
 	if err := dec(in); err != nil {
@@ -614,6 +917,42 @@ func _Universe_Info_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Universe_NotifyRootUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotifyRootUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).NotifyRootUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/NotifyRootUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).NotifyRootUpdate(ctx, req.(*NotifyRootUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_GossipServerList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GossipServerListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).GossipServerList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/GossipServerList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).GossipServerList(ctx, req.(*GossipServerListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Universe_SyncUniverse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SyncRequest)
 	if err := dec(in); err != nil {
@@ -776,6 +1115,138 @@ func _Universe_QueryFederationSyncConfig_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Universe_ListFederationProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFederationProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).ListFederationProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/ListFederationProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).ListFederationProfiles(ctx, req.(*ListFederationProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_SaveFederationProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveFederationProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).SaveFederationProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/SaveFederationProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).SaveFederationProfile(ctx, req.(*SaveFederationProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_ApplyFederationProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyFederationProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).ApplyFederationProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/ApplyFederationProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).ApplyFederationProfile(ctx, req.(*ApplyFederationProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_DeleteFederationProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFederationProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).DeleteFederationProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/DeleteFederationProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).DeleteFederationProfile(ctx, req.(*DeleteFederationProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_SubscribeLeaves_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeLeavesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UniverseServer).SubscribeLeaves(m, &universeSubscribeLeavesServer{stream})
+}
+
+type Universe_SubscribeLeavesServer interface {
+	Send(*UniverseLeafEvent) error
+	grpc.ServerStream
+}
+
+type universeSubscribeLeavesServer struct {
+	grpc.ServerStream
+}
+
+func (x *universeSubscribeLeavesServer) Send(m *UniverseLeafEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Universe_SubscribeUniverseRoots_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeUniverseRootsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UniverseServer).SubscribeUniverseRoots(m, &universeSubscribeUniverseRootsServer{stream})
+}
+
+type Universe_SubscribeUniverseRootsServer interface {
+	Send(*UniverseRootEvent) error
+	grpc.ServerStream
+}
+
+type universeSubscribeUniverseRootsServer struct {
+	grpc.ServerStream
+}
+
+func (x *universeSubscribeUniverseRootsServer) Send(m *UniverseRootEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Universe_PushProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).PushProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/PushProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).PushProof(ctx, req.(*PushProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Universe_ServiceDesc is the grpc.ServiceDesc for Universe service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -811,6 +1282,10 @@ var Universe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryProof",
 			Handler:    _Universe_QueryProof_Handler,
 		},
+		{
+			MethodName: "QueryAssetMeta",
+			Handler:    _Universe_QueryAssetMeta_Handler,
+		},
 		{
 			MethodName: "InsertProof",
 			Handler:    _Universe_InsertProof_Handler,
@@ -819,6 +1294,14 @@ var Universe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Info",
 			Handler:    _Universe_Info_Handler,
 		},
+		{
+			MethodName: "NotifyRootUpdate",
+			Handler:    _Universe_NotifyRootUpdate_Handler,
+		},
+		{
+			MethodName: "GossipServerList",
+			Handler:    _Universe_GossipServerList_Handler,
+		},
 		{
 			MethodName: "SyncUniverse",
 			Handler:    _Universe_SyncUniverse_Handler,
@@ -855,7 +1338,38 @@ var Universe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryFederationSyncConfig",
 			Handler:    _Universe_QueryFederationSyncConfig_Handler,
 		},
+		{
+			MethodName: "ListFederationProfiles",
+			Handler:    _Universe_ListFederationProfiles_Handler,
+		},
+		{
+			MethodName: "SaveFederationProfile",
+			Handler:    _Universe_SaveFederationProfile_Handler,
+		},
+		{
+			MethodName: "ApplyFederationProfile",
+			Handler:    _Universe_ApplyFederationProfile_Handler,
+		},
+		{
+			MethodName: "DeleteFederationProfile",
+			Handler:    _Universe_DeleteFederationProfile_Handler,
+		},
+		{
+			MethodName: "PushProof",
+			Handler:    _Universe_PushProof_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeLeaves",
+			Handler:       _Universe_SubscribeLeaves_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeUniverseRoots",
+			Handler:       _Universe_SubscribeUniverseRoots_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "universerpc/universe.proto",
 }
@@ -0,0 +1,366 @@
+package tapsend
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+)
+
+// OutputOrdering is the policy that governs the order of the inputs and
+// outputs of an anchor transaction.
+type OutputOrdering uint8
+
+const (
+	// OutputOrderingNone leaves the inputs and outputs in the order they
+	// were created in while funding the anchor transaction.
+	OutputOrderingNone OutputOrdering = 0
+
+	// OutputOrderingLexicographic sorts the inputs and outputs of the
+	// anchor transaction deterministically, similar to BIP-69. Inputs are
+	// sorted by their previous transaction ID and output index. Anchor
+	// outputs that carry assets come first, sorted by their internal key,
+	// as their pk script commits to their index. The remaining outputs
+	// follow, sorted by their amount and pk script.
+	OutputOrderingLexicographic OutputOrdering = 1
+
+	// OutputOrderingRandom shuffles the inputs and outputs of the anchor
+	// transaction randomly.
+	OutputOrderingRandom OutputOrdering = 2
+)
+
+// String returns a human-readable representation of the output ordering.
+func (o OutputOrdering) String() string {
+	switch o {
+	case OutputOrderingNone:
+		return "none"
+	case OutputOrderingLexicographic:
+		return "lexicographic"
+	case OutputOrderingRandom:
+		return "random"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(o))
+	}
+}
+
+// ParseOutputOrdering parses an output ordering policy from its string
+// representation.
+func ParseOutputOrdering(s string) (OutputOrdering, error) {
+	switch s {
+	case "", "none":
+		return OutputOrderingNone, nil
+	case "lexicographic", "bip69":
+		return OutputOrderingLexicographic, nil
+	case "random":
+		return OutputOrderingRandom, nil
+	default:
+		return 0, fmt.Errorf("unknown output ordering: %v", s)
+	}
+}
+
+// anchorOutputSortKeys returns the distinct anchor output indexes used by the
+// given virtual packets in ascending order, together with the key each anchor
+// output is sorted by with the lexicographic policy, which is its serialized
+// internal key. The final pk script of an anchor output commits to the split
+// commitment, which in turn commits to the anchor output index, so it can't be
+// used to decide on the index.
+func anchorOutputSortKeys(vPackets []*tappsbt.VPacket) ([]uint32,
+	map[uint32][]byte, error) {
+
+	var (
+		indexes  []uint32
+		sortKeys = make(map[uint32][]byte)
+	)
+	for _, vPkt := range vPackets {
+		for _, vOut := range vPkt.Outputs {
+			if vOut.AnchorOutputInternalKey == nil {
+				return nil, nil, fmt.Errorf("anchor output %d "+
+					"has no internal key",
+					vOut.AnchorOutputIndex)
+			}
+
+			idx := vOut.AnchorOutputIndex
+			internalKey := vOut.AnchorOutputInternalKey
+			key := internalKey.SerializeCompressed()
+
+			// Outputs of different packets that share an anchor
+			// output must agree on its internal key.
+			if sortKey, ok := sortKeys[idx]; ok {
+				if !bytes.Equal(sortKey, key) {
+					return nil, nil, fmt.Errorf("anchor "+
+						"output %d has conflicting "+
+						"internal keys", idx)
+				}
+
+				continue
+			}
+
+			indexes = append(indexes, idx)
+			sortKeys[idx] = key
+		}
+	}
+
+	sort.Slice(indexes, func(i, j int) bool {
+		return indexes[i] < indexes[j]
+	})
+
+	return indexes, sortKeys, nil
+}
+
+// OrderAnchorOutputs re-assigns the anchor output indexes of the outputs of
+// the given virtual packets according to the given policy. The packets must be
+// all packets anchored in the same anchor transaction, including the passive
+// ones, as the order is decided once over all their anchor outputs. The set of
+// anchor output indexes in use stays the same, only their assignment to the
+// outputs changes. Virtual outputs that share an anchor output keep sharing
+// it, even across packets. With the lexicographic policy, anchor outputs are
+// ordered by their internal key. This must be called before the output assets
+// are prepared, since the anchor output index is part of the split commitment.
+func OrderAnchorOutputs(ordering OutputOrdering,
+	vPackets []*tappsbt.VPacket) error {
+
+	if ordering == OutputOrderingNone {
+		return nil
+	}
+
+	indexes, sortKeys, err := anchorOutputSortKeys(vPackets)
+	if err != nil {
+		return err
+	}
+
+	ordered := make([]uint32, len(indexes))
+	copy(ordered, indexes)
+
+	switch ordering {
+	case OutputOrderingLexicographic:
+		sort.SliceStable(ordered, func(i, j int) bool {
+			return bytes.Compare(
+				sortKeys[ordered[i]], sortKeys[ordered[j]],
+			) < 0
+		})
+
+	case OutputOrderingRandom:
+		rand.Shuffle(len(ordered), func(i, j int) {
+			ordered[i], ordered[j] = ordered[j], ordered[i]
+		})
+
+	default:
+		return fmt.Errorf("unknown output ordering: %v", ordering)
+	}
+
+	// The anchor output that should come first in the new order gets the
+	// lowest index, and so on.
+	oldToNew := make(map[uint32]uint32, len(ordered))
+	for pos, oldIdx := range ordered {
+		oldToNew[oldIdx] = indexes[pos]
+	}
+
+	for _, vPkt := range vPackets {
+		for _, vOut := range vPkt.Outputs {
+			oldIdx := vOut.AnchorOutputIndex
+			vOut.AnchorOutputIndex = oldToNew[oldIdx]
+		}
+	}
+
+	return nil
+}
+
+// ValidateAnchorOutputOrder makes sure the anchor output indexes of the given
+// virtual packets follow the given policy. This is meant for packets that were
+// signed before they were handed to us, as their anchor output indexes can't
+// be changed anymore without invalidating their split commitments. Only the
+// lexicographic policy can be checked, any other policy is accepted.
+func ValidateAnchorOutputOrder(ordering OutputOrdering,
+	vPackets []*tappsbt.VPacket) error {
+
+	if ordering != OutputOrderingLexicographic {
+		return nil
+	}
+
+	indexes, sortKeys, err := anchorOutputSortKeys(vPackets)
+	if err != nil {
+		return err
+	}
+
+	for i := 1; i < len(indexes); i++ {
+		prev, cur := sortKeys[indexes[i-1]], sortKeys[indexes[i]]
+		if bytes.Compare(prev, cur) > 0 {
+			return fmt.Errorf("anchor output %d must come before "+
+				"anchor output %d with %v output ordering",
+				indexes[i], indexes[i-1], ordering)
+		}
+	}
+
+	return nil
+}
+
+// TrailingOutputOrder returns the order the outputs of the given funded anchor
+// transaction should be in according to the given policy, as the list of the
+// current indexes of the outputs in their new order. The first numFixed
+// outputs carry assets and were already ordered with OrderAnchorOutputs before
+// their virtual packets were signed, so they keep their index. Only the
+// outputs after them, which are the extra outputs and the change output added
+// by the wallet, are ordered. With the lexicographic policy, they're sorted as
+// described in BIP-69, by their amount and then their pk script. Nil is
+// returned if the outputs are already in order.
+func TrailingOutputOrder(ordering OutputOrdering, tx *wire.MsgTx,
+	numFixed int) ([]int, error) {
+
+	if ordering == OutputOrderingNone || numFixed >= len(tx.TxOut) {
+		return nil, nil
+	}
+
+	order := make([]int, len(tx.TxOut))
+	for i := range order {
+		order[i] = i
+	}
+	trailing := order[numFixed:]
+
+	switch ordering {
+	case OutputOrderingLexicographic:
+		sort.SliceStable(trailing, func(i, j int) bool {
+			a, b := tx.TxOut[trailing[i]], tx.TxOut[trailing[j]]
+			if a.Value != b.Value {
+				return a.Value < b.Value
+			}
+
+			return bytes.Compare(a.PkScript, b.PkScript) < 0
+		})
+
+	case OutputOrderingRandom:
+		rand.Shuffle(len(trailing), func(i, j int) {
+			trailing[i], trailing[j] = trailing[j], trailing[i]
+		})
+
+	default:
+		return nil, fmt.Errorf("unknown output ordering: %v", ordering)
+	}
+
+	for newIdx, oldIdx := range order {
+		if newIdx != oldIdx {
+			return order, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// ReorderAnchorOutputs moves the outputs of the given funded anchor
+// transaction into the given order, as returned by TrailingOutputOrder, and
+// updates the change output index accordingly. Outputs that carry assets must
+// not be moved, since their anchor output index is part of the split
+// commitment.
+func ReorderAnchorOutputs(fundedPkt *FundedPsbt, order []int) error {
+	pkt := fundedPkt.Pkt
+	tx := pkt.UnsignedTx
+	if len(tx.TxOut) != len(pkt.Outputs) {
+		return fmt.Errorf("invalid PSBT, number of outputs doesn't " +
+			"match unsigned TX")
+	}
+	if len(order) != len(tx.TxOut) {
+		return fmt.Errorf("invalid output order, expected %d "+
+			"outputs, got %d", len(tx.TxOut), len(order))
+	}
+
+	txOuts := make([]*wire.TxOut, len(order))
+	pOutputs := make([]psbt.POutput, len(order))
+	oldToNew := make(map[int]int, len(order))
+	for newIdx, oldIdx := range order {
+		if oldIdx < 0 || oldIdx >= len(order) {
+			return fmt.Errorf("invalid output index %d", oldIdx)
+		}
+		if _, ok := oldToNew[oldIdx]; ok {
+			return fmt.Errorf("duplicate output index %d", oldIdx)
+		}
+
+		txOuts[newIdx] = tx.TxOut[oldIdx]
+		pOutputs[newIdx] = pkt.Outputs[oldIdx]
+		oldToNew[oldIdx] = newIdx
+	}
+
+	tx.TxOut, pkt.Outputs = txOuts, pOutputs
+
+	if fundedPkt.ChangeOutputIndex >= 0 {
+		changeIdx := int(fundedPkt.ChangeOutputIndex)
+		fundedPkt.ChangeOutputIndex = int32(oldToNew[changeIdx])
+	}
+
+	return nil
+}
+
+// OrderAnchorInputs re-orders the inputs of the given funded, but not yet
+// signed anchor transaction according to the given policy. With the
+// lexicographic policy, the inputs are sorted as described in BIP-69, by the
+// previous transaction ID and then the output index. Since inputs are always
+// referenced by their outpoint, this can safely be done after funding.
+func OrderAnchorInputs(ordering OutputOrdering, pkt *psbt.Packet) error {
+	if ordering == OutputOrderingNone {
+		return nil
+	}
+
+	tx := pkt.UnsignedTx
+	if len(tx.TxIn) != len(pkt.Inputs) {
+		return fmt.Errorf("invalid PSBT, number of inputs doesn't " +
+			"match unsigned TX")
+	}
+
+	perm := make([]int, len(tx.TxIn))
+	for i := range perm {
+		perm[i] = i
+	}
+
+	switch ordering {
+	case OutputOrderingLexicographic:
+		sort.SliceStable(perm, func(i, j int) bool {
+			a := tx.TxIn[perm[i]].PreviousOutPoint
+			b := tx.TxIn[perm[j]].PreviousOutPoint
+
+			// BIP-69 compares the transaction IDs in their
+			// displayed (reversed) byte order.
+			cmp := bytes.Compare(
+				reversedHash(a.Hash), reversedHash(b.Hash),
+			)
+			if cmp != 0 {
+				return cmp < 0
+			}
+
+			return a.Index < b.Index
+		})
+
+	case OutputOrderingRandom:
+		rand.Shuffle(len(perm), func(i, j int) {
+			perm[i], perm[j] = perm[j], perm[i]
+		})
+
+	default:
+		return fmt.Errorf("unknown output ordering: %v", ordering)
+	}
+
+	// We apply the permutation to both the unsigned TX and the PSBT level
+	// inputs, so they stay in sync.
+	txIns := make([]*wire.TxIn, len(perm))
+	pInputs := make([]psbt.PInput, len(perm))
+	for newIdx, oldIdx := range perm {
+		txIns[newIdx] = tx.TxIn[oldIdx]
+		pInputs[newIdx] = pkt.Inputs[oldIdx]
+	}
+	tx.TxIn, pkt.Inputs = txIns, pInputs
+
+	return nil
+}
+
+// reversedHash returns the bytes of the given hash in reversed order, which is
+// the order they are displayed in.
+func reversedHash(h chainhash.Hash) []byte {
+	reversed := make([]byte, len(h))
+	for i := range h {
+		reversed[i] = h[len(h)-1-i]
+	}
+
+	return reversed
+}
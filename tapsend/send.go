@@ -638,6 +638,61 @@ func PrepareOutputAssets(ctx context.Context, vPkt *tappsbt.VPacket) error {
 func SignVirtualTransaction(vPkt *tappsbt.VPacket, signer tapscript.Signer,
 	validator tapscript.WitnessValidator) error {
 
+	inputs := vPkt.Inputs
+
+	newAsset, splitAssets, prevAssets, err := virtualTxAssets(vPkt)
+	if err != nil {
+		return err
+	}
+
+	// Create a Taproot Asset virtual transaction representing the asset
+	// transfer.
+	virtualTx, _, err := tapscript.VirtualTx(newAsset, prevAssets)
+	if err != nil {
+		return err
+	}
+
+	for idx := range inputs {
+		in := inputs[idx]
+
+		// For each input asset leaf, we need to produce a witness.
+		// Update the input of the virtual TX, generate a witness, and
+		// attach it to the copy of the new Asset.
+		virtualTxCopy := virtualTx.Copy()
+		inputSpecificVirtualTx := asset.VirtualTxWithInput(
+			virtualTxCopy, newAsset.LockTime,
+			newAsset.RelativeLockTime, uint32(idx), nil,
+		)
+
+		// Sign the virtual transaction based on the input script
+		// information (key spend or script spend).
+		newWitness, err := CreateTaprootSignature(
+			in, inputSpecificVirtualTx, 0, signer,
+		)
+		if err != nil {
+			return fmt.Errorf("error creating taproot "+
+				"signature: %w", err)
+		}
+
+		newAsset.PrevWitnesses[idx].TxWitness = newWitness
+	}
+
+	err = validator.ValidateWitnesses(newAsset, splitAssets, prevAssets)
+	if err != nil {
+		return err
+	}
+
+	updateSplitRoots(vPkt, newAsset)
+
+	return nil
+}
+
+// virtualTxAssets returns the new asset of the given virtual packet that
+// receives the witnesses of the transfer, the split assets the new asset
+// commits to in case of a split and the set of input assets.
+func virtualTxAssets(vPkt *tappsbt.VPacket) (*asset.Asset,
+	[]*commitment.SplitAsset, commitment.InputSet, error) {
+
 	inputs := vPkt.Inputs
 	outputs := vPkt.Outputs
 
@@ -645,7 +700,7 @@ func SignVirtualTransaction(vPkt *tappsbt.VPacket, signer tapscript.Signer,
 	// the root asset, which is located at the change output.
 	isSplit, err := vPkt.HasSplitCommitment()
 	if err != nil {
-		return err
+		return nil, nil, nil, err
 	}
 
 	// Identify new output asset. For splits, the new asset that receives
@@ -655,8 +710,8 @@ func SignVirtualTransaction(vPkt *tappsbt.VPacket, signer tapscript.Signer,
 	if isSplit {
 		splitOut, err := vPkt.SplitRootOutput()
 		if err != nil {
-			return fmt.Errorf("no split root output found for "+
-				"split transaction: %w", err)
+			return nil, nil, nil, fmt.Errorf("no split root "+
+				"output found for split transaction: %w", err)
 		}
 		newAsset = splitOut.Asset
 
@@ -689,66 +744,110 @@ func SignVirtualTransaction(vPkt *tappsbt.VPacket, signer tapscript.Signer,
 		prevAssets[input.PrevID] = input.Asset()
 	}
 
-	// Create a Taproot Asset virtual transaction representing the asset
-	// transfer.
+	return newAsset, splitAssets, prevAssets, nil
+}
+
+// updateSplitRoots updates each split asset of the given virtual packet to
+// store the given root asset with the witnesses attached, so the receiver can
+// verify inclusion of the root asset. This is a no-op if the packet doesn't
+// contain a split.
+func updateSplitRoots(vPkt *tappsbt.VPacket, newAsset *asset.Asset) {
+	outputs := vPkt.Outputs
+	for idx := range outputs {
+		splitAsset := outputs[idx].Asset
+
+		// The output that houses the root asset in case of a split has
+		// a special field for the split asset. That asset is no longer
+		// needed (and isn't committed to anywhere), but in order for it
+		// to be validated externally, we still want to include it and
+		// therefore also want to update it with the signed root asset.
+		if outputs[idx].Type.IsSplitRoot() {
+			splitAsset = outputs[idx].SplitAsset
+		}
+
+		if splitAsset == nil || len(splitAsset.PrevWitnesses) == 0 {
+			continue
+		}
+
+		splitCommitment := splitAsset.PrevWitnesses[0].SplitCommitment
+		if splitCommitment == nil {
+			continue
+		}
+
+		splitCommitment.RootAsset = *newAsset.Copy()
+	}
+}
+
+// InputKeySpendSigHash returns the signature hash of the key spend path of the
+// input with the given index of a virtual packet. This is the message an
+// externally created signature for the input, for example a MuSig2 signature
+// of the script key, must commit to.
+func InputKeySpendSigHash(vPkt *tappsbt.VPacket, idx int) ([32]byte, error) {
+	var sigHash [32]byte
+
+	if idx < 0 || idx >= len(vPkt.Inputs) {
+		return sigHash, fmt.Errorf("invalid input index %d", idx)
+	}
+
+	newAsset, _, prevAssets, err := virtualTxAssets(vPkt)
+	if err != nil {
+		return sigHash, err
+	}
+
 	virtualTx, _, err := tapscript.VirtualTx(newAsset, prevAssets)
 	if err != nil {
-		return err
+		return sigHash, err
 	}
 
-	for idx := range inputs {
-		in := inputs[idx]
+	vIn := vPkt.Inputs[idx]
+	hash, err := tapscript.InputKeySpendSigHash(
+		virtualTx, vIn.Asset(), newAsset, uint32(idx), vIn.SighashType,
+	)
+	if err != nil {
+		return sigHash, err
+	}
 
-		// For each input asset leaf, we need to produce a witness.
-		// Update the input of the virtual TX, generate a witness, and
-		// attach it to the copy of the new Asset.
-		virtualTxCopy := virtualTx.Copy()
-		inputSpecificVirtualTx := asset.VirtualTxWithInput(
-			virtualTxCopy, newAsset.LockTime,
-			newAsset.RelativeLockTime, uint32(idx), nil,
-		)
+	copy(sigHash[:], hash)
 
-		// Sign the virtual transaction based on the input script
-		// information (key spend or script spend).
-		newWitness, err := CreateTaprootSignature(
-			in, inputSpecificVirtualTx, 0, signer,
-		)
-		if err != nil {
-			return fmt.Errorf("error creating taproot "+
-				"signature: %w", err)
-		}
+	return sigHash, nil
+}
 
-		newAsset.PrevWitnesses[idx].TxWitness = newWitness
+// AddInputWitness attaches the given externally created witness to the input
+// with the given index of a virtual packet. Once all inputs have a witness,
+// the transfer is verified with the given validator.
+func AddInputWitness(vPkt *tappsbt.VPacket, idx int, witness wire.TxWitness,
+	validator tapscript.WitnessValidator) error {
+
+	if idx < 0 || idx >= len(vPkt.Inputs) {
+		return fmt.Errorf("invalid input index %d", idx)
 	}
 
-	err = validator.ValidateWitnesses(newAsset, splitAssets, prevAssets)
+	newAsset, splitAssets, prevAssets, err := virtualTxAssets(vPkt)
 	if err != nil {
 		return err
 	}
 
-	if isSplit {
-		// Update each split asset to store the root asset with the
-		// witness attached, so the receiver can verify inclusion of the
-		// root asset.
-		for idx := range outputs {
-			splitAsset := outputs[idx].Asset
-
-			// The output that houses the root asset in case of a
-			// split has a special field for the split asset. That
-			// asset is no longer needed (and isn't committed to
-			// anywhere), but in order for it to be validated
-			// externally, we still want to include it and therefore
-			// also want to update it with the signed root asset.
-			if outputs[idx].Type.IsSplitRoot() {
-				splitAsset = outputs[idx].SplitAsset
-			}
+	if idx >= len(newAsset.PrevWitnesses) {
+		return fmt.Errorf("new asset is missing witness for input %d",
+			idx)
+	}
+	newAsset.PrevWitnesses[idx].TxWitness = witness
 
-			splitCommitment :=
-				splitAsset.PrevWitnesses[0].SplitCommitment
-			splitCommitment.RootAsset = *newAsset.Copy()
+	// We can only verify the transfer once all inputs are signed.
+	for _, prevWitness := range newAsset.PrevWitnesses {
+		if len(prevWitness.TxWitness) == 0 {
+			updateSplitRoots(vPkt, newAsset)
+			return nil
 		}
 	}
 
+	err = validator.ValidateWitnesses(newAsset, splitAssets, prevAssets)
+	if err != nil {
+		return err
+	}
+
+	updateSplitRoots(vPkt, newAsset)
+
 	return nil
 }
 
@@ -5,6 +5,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"sort"
 	"testing"
 
 	"github.com/btcsuite/btcd/blockchain"
@@ -2935,3 +2936,385 @@ func TestValidateAnchorInputs(t *testing.T) {
 		})
 	}
 }
+
+// TestOrderAnchorOutputs tests that the anchor output indexes of a virtual
+// packet are re-assigned according to the output ordering policy.
+func TestOrderAnchorOutputs(t *testing.T) {
+	t.Parallel()
+
+	// We create three outputs with a random internal key each, where the
+	// last two outputs share the same anchor output.
+	keys := []*btcec.PublicKey{
+		test.RandPubKey(t), test.RandPubKey(t),
+	}
+	newPacket := func() *tappsbt.VPacket {
+		return &tappsbt.VPacket{
+			Outputs: []*tappsbt.VOutput{{
+				AnchorOutputIndex:       0,
+				AnchorOutputInternalKey: keys[0],
+			}, {
+				AnchorOutputIndex:       1,
+				AnchorOutputInternalKey: keys[1],
+			}, {
+				AnchorOutputIndex:       1,
+				AnchorOutputInternalKey: keys[1],
+			}},
+		}
+	}
+
+	// With no ordering policy, nothing should change.
+	vPkt := newPacket()
+	err := tapsend.OrderAnchorOutputs(
+		tapsend.OutputOrderingNone, []*tappsbt.VPacket{vPkt},
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, vPkt.Outputs[0].AnchorOutputIndex)
+	require.EqualValues(t, 1, vPkt.Outputs[1].AnchorOutputIndex)
+
+	// With lexicographic ordering, the output with the smaller internal
+	// key should end up at the first anchor output.
+	vPkt = newPacket()
+	err = tapsend.OrderAnchorOutputs(
+		tapsend.OutputOrderingLexicographic, []*tappsbt.VPacket{vPkt},
+	)
+	require.NoError(t, err)
+
+	firstIdx, secondIdx := uint32(0), uint32(1)
+	if bytes.Compare(
+		keys[0].SerializeCompressed(), keys[1].SerializeCompressed(),
+	) > 0 {
+
+		firstIdx, secondIdx = 1, 0
+	}
+	require.Equal(t, firstIdx, vPkt.Outputs[0].AnchorOutputIndex)
+	require.Equal(t, secondIdx, vPkt.Outputs[1].AnchorOutputIndex)
+	require.Equal(t, secondIdx, vPkt.Outputs[2].AnchorOutputIndex)
+
+	err = tapsend.ValidateAnchorOutputOrder(
+		tapsend.OutputOrderingLexicographic, []*tappsbt.VPacket{vPkt},
+	)
+	require.NoError(t, err)
+
+	// A packet that wasn't ordered is rejected, unless the keys happen to
+	// be in order already.
+	err = tapsend.ValidateAnchorOutputOrder(
+		tapsend.OutputOrderingLexicographic,
+		[]*tappsbt.VPacket{newPacket()},
+	)
+	if firstIdx == 0 {
+		require.NoError(t, err)
+	} else {
+		require.ErrorContains(t, err, "must come before")
+	}
+
+	// With random ordering, the outputs sharing an anchor output should
+	// still share one, and the set of indexes should be unchanged.
+	vPkt = newPacket()
+	err = tapsend.OrderAnchorOutputs(
+		tapsend.OutputOrderingRandom, []*tappsbt.VPacket{vPkt},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, vPkt.Outputs[1].AnchorOutputIndex,
+		vPkt.Outputs[2].AnchorOutputIndex,
+	)
+	require.NotEqual(
+		t, vPkt.Outputs[0].AnchorOutputIndex,
+		vPkt.Outputs[1].AnchorOutputIndex,
+	)
+	require.Less(t, vPkt.Outputs[0].AnchorOutputIndex, uint32(2))
+	require.Less(t, vPkt.Outputs[1].AnchorOutputIndex, uint32(2))
+}
+
+// TestOrderAnchorOutputsMultiPacket tests that the anchor outputs of all
+// virtual packets of an anchor transaction are ordered at once, with outputs
+// that share an anchor output across packets keeping to share it.
+func TestOrderAnchorOutputsMultiPacket(t *testing.T) {
+	t.Parallel()
+
+	// We use three anchor outputs. Both active packets anchor an output
+	// in the second anchor output, as does the passive packet.
+	keys := []*btcec.PublicKey{
+		test.RandPubKey(t), test.RandPubKey(t), test.RandPubKey(t),
+	}
+	newOutput := func(idx uint32) *tappsbt.VOutput {
+		return &tappsbt.VOutput{
+			AnchorOutputIndex:       idx,
+			AnchorOutputInternalKey: keys[idx],
+		}
+	}
+	active1 := &tappsbt.VPacket{
+		Outputs: []*tappsbt.VOutput{newOutput(0), newOutput(1)},
+	}
+	active2 := &tappsbt.VPacket{
+		Outputs: []*tappsbt.VOutput{newOutput(1), newOutput(2)},
+	}
+	passive := &tappsbt.VPacket{
+		Outputs: []*tappsbt.VOutput{newOutput(1)},
+	}
+	vPackets := []*tappsbt.VPacket{active1, active2, passive}
+
+	const ordering = tapsend.OutputOrderingLexicographic
+	require.NoError(t, tapsend.OrderAnchorOutputs(ordering, vPackets))
+	require.NoError(t, tapsend.ValidateAnchorOutputOrder(
+		ordering, vPackets,
+	))
+
+	// Each anchor output should now be at the position of its internal
+	// key among all internal keys, in all packets.
+	sortedKeys := make([]*btcec.PublicKey, len(keys))
+	copy(sortedKeys, keys)
+	sort.Slice(sortedKeys, func(i, j int) bool {
+		return bytes.Compare(
+			sortedKeys[i].SerializeCompressed(),
+			sortedKeys[j].SerializeCompressed(),
+		) < 0
+	})
+	for _, vPkt := range vPackets {
+		for _, vOut := range vPkt.Outputs {
+			require.Equal(
+				t, vOut.AnchorOutputInternalKey,
+				sortedKeys[vOut.AnchorOutputIndex],
+			)
+		}
+	}
+	require.Equal(
+		t, active1.Outputs[1].AnchorOutputIndex,
+		active2.Outputs[0].AnchorOutputIndex,
+	)
+	require.Equal(
+		t, active1.Outputs[1].AnchorOutputIndex,
+		passive.Outputs[0].AnchorOutputIndex,
+	)
+
+	// Packets that don't agree on the internal key of a shared anchor
+	// output are rejected.
+	conflicting := &tappsbt.VPacket{
+		Outputs: []*tappsbt.VOutput{{
+			AnchorOutputIndex:       0,
+			AnchorOutputInternalKey: test.RandPubKey(t),
+		}},
+	}
+	err := tapsend.OrderAnchorOutputs(
+		ordering, append(vPackets, conflicting),
+	)
+	require.ErrorContains(t, err, "conflicting internal keys")
+}
+
+// TestTrailingOutputOrder tests that only the outputs after the asset carrying
+// outputs of an anchor transaction are sorted as described in BIP-69 and that
+// the change output index follows the change output.
+func TestTrailingOutputOrder(t *testing.T) {
+	t.Parallel()
+
+	// The first output carries assets, the change output is at the end,
+	// as added by the wallet. The two extra outputs have the same amount,
+	// so their pk scripts decide.
+	txOuts := []*wire.TxOut{
+		{Value: 1_000, PkScript: []byte{0x04}},
+		{Value: 1_000, PkScript: []byte{0x02}},
+		{Value: 1_000, PkScript: []byte{0x01}},
+		{Value: 500, PkScript: []byte{0x03}},
+	}
+	newPacket := func() *tapsend.FundedPsbt {
+		tx := wire.NewMsgTx(2)
+		pkt := &psbt.Packet{UnsignedTx: tx}
+		for idx := range txOuts {
+			tx.AddTxOut(txOuts[idx])
+			pkt.Outputs = append(pkt.Outputs, psbt.POutput{
+				TaprootInternalKey: []byte{byte(idx)},
+			})
+		}
+
+		return &tapsend.FundedPsbt{
+			Pkt:               pkt,
+			ChangeOutputIndex: 3,
+		}
+	}
+
+	// With no ordering policy, nothing should change.
+	fundedPkt := newPacket()
+	order, err := tapsend.TrailingOutputOrder(
+		tapsend.OutputOrderingNone, fundedPkt.Pkt.UnsignedTx, 1,
+	)
+	require.NoError(t, err)
+	require.Nil(t, order)
+
+	// With lexicographic ordering, the trailing outputs are sorted by
+	// amount first, then by pk script. The asset carrying output stays
+	// where it is, even though it would come last otherwise.
+	order, err = tapsend.TrailingOutputOrder(
+		tapsend.OutputOrderingLexicographic, fundedPkt.Pkt.UnsignedTx,
+		1,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []int{0, 3, 2, 1}, order)
+
+	err = tapsend.ReorderAnchorOutputs(fundedPkt, order)
+	require.NoError(t, err)
+
+	for newIdx, oldIdx := range order {
+		require.Equal(
+			t, txOuts[oldIdx],
+			fundedPkt.Pkt.UnsignedTx.TxOut[newIdx],
+		)
+		require.Equal(
+			t, []byte{byte(oldIdx)},
+			fundedPkt.Pkt.Outputs[newIdx].TaprootInternalKey,
+		)
+	}
+	require.EqualValues(t, 1, fundedPkt.ChangeOutputIndex)
+
+	// Once sorted, the outputs don't need to be moved anymore.
+	order, err = tapsend.TrailingOutputOrder(
+		tapsend.OutputOrderingLexicographic, fundedPkt.Pkt.UnsignedTx,
+		1,
+	)
+	require.NoError(t, err)
+	require.Nil(t, order)
+
+	// An invalid order is rejected without modifying anything.
+	fundedPkt = newPacket()
+	err = tapsend.ReorderAnchorOutputs(fundedPkt, []int{0, 0, 1, 2})
+	require.ErrorContains(t, err, "duplicate output index")
+	require.Equal(t, txOuts[0], fundedPkt.Pkt.UnsignedTx.TxOut[0])
+	require.EqualValues(t, 3, fundedPkt.ChangeOutputIndex)
+}
+
+// TestOrderAnchorOutputsSplitSend tests that a split send funded with the
+// lexicographic ordering policy still has valid split commitments after the
+// outputs of its anchor transaction were ordered, without being signed again.
+func TestOrderAnchorOutputsSplitSend(t *testing.T) {
+	t.Parallel()
+
+	const ordering = tapsend.OutputOrderingLexicographic
+
+	state := initSpendScenario(t)
+	pkt := createPacket(
+		state.address1, state.asset2PrevID, state,
+		state.asset2InputAssets, false,
+	)
+	pkt.Outputs[1].AnchorOutputIndex = 1
+	vPackets := []*tappsbt.VPacket{pkt}
+
+	// The anchor output indexes are decided before the split commitment
+	// is created, which commits to them.
+	require.NoError(t, tapsend.OrderAnchorOutputs(ordering, vPackets))
+	err := tapsend.ValidateAnchorOutputOrder(ordering, vPackets)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, tapsend.PrepareOutputAssets(ctx, pkt))
+	err = tapsend.SignVirtualTransaction(
+		pkt, state.signer, state.witnessValidator,
+	)
+	require.NoError(t, err)
+
+	hasSplitCommitment, err := pkt.HasSplitCommitment()
+	require.NoError(t, err)
+	require.True(t, hasSplitCommitment)
+
+	outputCommitments, err := tapsend.CreateOutputCommitments(vPackets)
+	require.NoError(t, err)
+
+	btcPkt, err := tapsend.CreateAnchorTx(vPackets)
+	require.NoError(t, err)
+
+	err = tapsend.UpdateTaprootOutputKeys(btcPkt, pkt, outputCommitments)
+	require.NoError(t, err)
+
+	// We now add two extra outputs and a change output at the end, as the
+	// wallet does when funding the anchor transaction.
+	numAssetOutputs := len(btcPkt.UnsignedTx.TxOut)
+	assetTxOuts := append([]*wire.TxOut{}, btcPkt.UnsignedTx.TxOut...)
+	trailingOuts := []*wire.TxOut{
+		{Value: 0, PkScript: []byte{txscript.OP_RETURN, 0x02}},
+		{Value: 0, PkScript: []byte{txscript.OP_RETURN, 0x01}},
+		{Value: 50_000, PkScript: test.RandBytes(34)},
+	}
+	for _, txOut := range trailingOuts {
+		btcPkt.UnsignedTx.AddTxOut(txOut)
+		btcPkt.Outputs = append(btcPkt.Outputs, psbt.POutput{})
+	}
+	fundedPkt := &tapsend.FundedPsbt{
+		Pkt:               btcPkt,
+		ChangeOutputIndex: int32(numAssetOutputs + 2),
+	}
+
+	order, err := tapsend.TrailingOutputOrder(
+		ordering, btcPkt.UnsignedTx, numAssetOutputs,
+	)
+	require.NoError(t, err)
+	require.NotNil(t, order)
+	require.NoError(t, tapsend.ReorderAnchorOutputs(fundedPkt, order))
+
+	// The asset carrying outputs didn't move, so the signed virtual
+	// packet is still valid for the anchor transaction.
+	require.Equal(
+		t, assetTxOuts, btcPkt.UnsignedTx.TxOut[:numAssetOutputs],
+	)
+	require.Equal(
+		t, trailingOuts[1], btcPkt.UnsignedTx.TxOut[numAssetOutputs],
+	)
+	require.EqualValues(
+		t, numAssetOutputs+2, fundedPkt.ChangeOutputIndex,
+	)
+
+	// Committing to the signed packet again results in the same asset
+	// carrying outputs.
+	outputCommitments, err = tapsend.CreateOutputCommitments(vPackets)
+	require.NoError(t, err)
+	freshPkt, err := tapsend.CreateAnchorTx(vPackets)
+	require.NoError(t, err)
+	err = tapsend.UpdateTaprootOutputKeys(freshPkt, pkt, outputCommitments)
+	require.NoError(t, err)
+	require.Equal(t, freshPkt.UnsignedTx.TxOut, assetTxOuts)
+
+	// Swapping the anchor outputs of the signed packet violates the
+	// policy, so such a pre-signed packet is rejected.
+	pkt.Outputs[0].AnchorOutputIndex, pkt.Outputs[1].AnchorOutputIndex =
+		pkt.Outputs[1].AnchorOutputIndex,
+		pkt.Outputs[0].AnchorOutputIndex
+	err = tapsend.ValidateAnchorOutputOrder(ordering, vPackets)
+	require.ErrorContains(t, err, "must come before")
+}
+
+// TestOrderAnchorInputs tests that the inputs of an anchor transaction are
+// sorted as described in BIP-69 and that the PSBT inputs stay in sync.
+func TestOrderAnchorInputs(t *testing.T) {
+	t.Parallel()
+
+	hashA := chainhash.Hash{0x01}
+	hashB := chainhash.Hash{31: 0x01}
+	outPoints := []wire.OutPoint{
+		{Hash: hashA, Index: 2},
+		{Hash: hashA, Index: 1},
+		{Hash: hashB, Index: 0},
+	}
+
+	tx := wire.NewMsgTx(2)
+	pkt := &psbt.Packet{UnsignedTx: tx}
+	for idx := range outPoints {
+		tx.AddTxIn(&wire.TxIn{PreviousOutPoint: outPoints[idx]})
+		pkt.Inputs = append(pkt.Inputs, psbt.PInput{
+			SighashType: txscript.SigHashType(idx),
+		})
+	}
+
+	err := tapsend.OrderAnchorInputs(
+		tapsend.OutputOrderingLexicographic, pkt,
+	)
+	require.NoError(t, err)
+
+	// The hashes are compared in their reversed byte order, so hash A
+	// comes first, even though its first byte is larger.
+	expected := []int{1, 0, 2}
+	for newIdx, oldIdx := range expected {
+		require.Equal(
+			t, outPoints[oldIdx], tx.TxIn[newIdx].PreviousOutPoint,
+		)
+		require.EqualValues(
+			t, oldIdx, pkt.Inputs[newIdx].SighashType,
+		)
+	}
+}
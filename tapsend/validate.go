@@ -0,0 +1,607 @@
+package tapsend
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+)
+
+// VPacketCheck identifies the class of checks a virtual packet validation
+// finding belongs to.
+type VPacketCheck string
+
+const (
+	// VPacketCheckStructure is the check of the general structure of the
+	// virtual packet, for example that it has inputs and outputs.
+	VPacketCheckStructure VPacketCheck = "structure"
+
+	// VPacketCheckInputs is the check that the inputs of the virtual
+	// packet are committed to in the supplied input commitments.
+	VPacketCheckInputs VPacketCheck = "inputs"
+
+	// VPacketCheckAmounts is the check that the asset amounts of the
+	// inputs and outputs are conserved.
+	VPacketCheckAmounts VPacketCheck = "amounts"
+
+	// VPacketCheckSplit is the check that the split root and split
+	// commitments of the outputs are correct.
+	VPacketCheckSplit VPacketCheck = "split"
+
+	// VPacketCheckVersion is the check that the asset and script versions
+	// used are supported.
+	VPacketCheckVersion VPacketCheck = "version"
+
+	// VPacketCheckLockTime is the check that the lock times of the outputs
+	// are valid and can be expressed on the anchor transaction.
+	VPacketCheckLockTime VPacketCheck = "lock_time"
+)
+
+// VPacketFinding is a single problem that was found when validating a virtual
+// packet.
+type VPacketFinding struct {
+	// Check is the class of checks the finding belongs to.
+	Check VPacketCheck
+
+	// InputIndex is the index of the virtual input the finding relates
+	// to, if any.
+	InputIndex fn.Option[int]
+
+	// OutputIndex is the index of the virtual output the finding relates
+	// to, if any.
+	OutputIndex fn.Option[int]
+
+	// Message is a human-readable description of the problem.
+	Message string
+}
+
+// String returns a human-readable representation of the finding.
+func (f VPacketFinding) String() string {
+	location := ""
+	f.InputIndex.WhenSome(func(idx int) {
+		location = fmt.Sprintf(" (input %d)", idx)
+	})
+	f.OutputIndex.WhenSome(func(idx int) {
+		location = fmt.Sprintf(" (output %d)", idx)
+	})
+
+	return fmt.Sprintf("%v%s: %s", f.Check, location, f.Message)
+}
+
+// vPacketFindings is a helper to collect the findings of a virtual packet
+// validation.
+type vPacketFindings []VPacketFinding
+
+// add adds a finding that relates to the packet as a whole.
+func (f *vPacketFindings) add(check VPacketCheck, format string,
+	args ...any) {
+
+	*f = append(*f, VPacketFinding{
+		Check:   check,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// addInput adds a finding that relates to the input with the given index.
+func (f *vPacketFindings) addInput(check VPacketCheck, idx int, format string,
+	args ...any) {
+
+	*f = append(*f, VPacketFinding{
+		Check:      check,
+		InputIndex: fn.Some(idx),
+		Message:    fmt.Sprintf(format, args...),
+	})
+}
+
+// addOutput adds a finding that relates to the output with the given index.
+func (f *vPacketFindings) addOutput(check VPacketCheck, idx int,
+	format string, args ...any) {
+
+	*f = append(*f, VPacketFinding{
+		Check:       check,
+		OutputIndex: fn.Some(idx),
+		Message:     fmt.Sprintf(format, args...),
+	})
+}
+
+// ValidateVPacket statelessly validates a caller-constructed virtual packet
+// against the given input commitments, without signing anything or touching
+// the wallet. It checks that the inputs are committed to, that asset amounts
+// are conserved, that the split root and split commitments are correct, that
+// the asset and script versions are supported and that the lock times can be
+// expressed on the anchor transaction. The split commitments are only checked
+// if the output assets were already prepared.
+//
+// All problems found are returned as structured findings, an empty result
+// means the packet passed all checks.
+func ValidateVPacket(vPkt *tappsbt.VPacket,
+	inputCommitments tappsbt.InputCommitments) []VPacketFinding {
+
+	var findings vPacketFindings
+	if vPkt == nil {
+		findings.add(VPacketCheckStructure, "virtual packet is nil")
+		return findings
+	}
+
+	if vPkt.Version != tappsbt.V0 && vPkt.Version != tappsbt.V1 {
+		findings.add(
+			VPacketCheckVersion, "unknown virtual packet version "+
+				"%d", vPkt.Version,
+		)
+	}
+	if len(vPkt.Inputs) == 0 {
+		findings.add(VPacketCheckStructure, "packet has no inputs")
+	}
+	if len(vPkt.Outputs) == 0 {
+		findings.add(VPacketCheckStructure, "packet has no outputs")
+	}
+	if len(findings) > 0 {
+		return findings
+	}
+
+	totalInputAmount, inputType, ok := validateVInputs(
+		vPkt, inputCommitments, &findings,
+	)
+
+	validateVOutputs(vPkt, &findings)
+
+	// Without knowing the inputs, we can't say anything about the amounts
+	// or the split.
+	if !ok {
+		return findings
+	}
+
+	validateAmounts(vPkt, totalInputAmount, inputType, &findings)
+	validateSplit(vPkt, totalInputAmount, &findings)
+
+	return findings
+}
+
+// InputCommitmentsFromProofs derives the input commitments of a virtual packet
+// from the proofs attached to its inputs, for callers that don't have access to
+// the full anchor output commitments. Each proof's inclusion, exclusion and
+// split root proofs are verified against its anchor transaction, the returned
+// commitments then only contain the proven input asset. Inputs without a valid
+// proof are reported as findings and are left out of the returned commitments.
+func InputCommitmentsFromProofs(
+	vPkt *tappsbt.VPacket) (tappsbt.InputCommitments, []VPacketFinding) {
+
+	var findings vPacketFindings
+	if vPkt == nil {
+		return nil, findings
+	}
+
+	inputCommitments := make(tappsbt.InputCommitments, len(vPkt.Inputs))
+	for idx, vIn := range vPkt.Inputs {
+		if vIn.Proof == nil {
+			findings.addInput(
+				VPacketCheckInputs, idx, "input proof not set",
+			)
+
+			continue
+		}
+
+		if vIn.Proof.OutPoint() != vIn.PrevID.OutPoint {
+			findings.addInput(
+				VPacketCheckInputs, idx, "input proof "+
+					"outpoint %v doesn't match prev ID "+
+					"outpoint %v",
+				vIn.Proof.OutPoint(), vIn.PrevID.OutPoint,
+			)
+
+			continue
+		}
+
+		provenCommitment, err := vIn.Proof.VerifyProofs()
+		if err != nil {
+			findings.addInput(
+				VPacketCheckInputs, idx, "invalid input "+
+					"proof: %v", err,
+			)
+
+			continue
+		}
+
+		tapCommitment, err := commitment.FromAssets(
+			&provenCommitment.Version, vIn.Proof.Asset.Copy(),
+		)
+		if err != nil {
+			findings.addInput(
+				VPacketCheckInputs, idx, "unable to create "+
+					"input commitment: %v", err,
+			)
+
+			continue
+		}
+
+		inputCommitments[vIn.PrevID] = tapCommitment
+	}
+
+	return inputCommitments, findings
+}
+
+// validateVInputs checks the inputs of the virtual packet against the given
+// input commitments. It returns the total input amount and the input asset type
+// and whether all inputs could be resolved.
+func validateVInputs(vPkt *tappsbt.VPacket,
+	inputCommitments tappsbt.InputCommitments,
+	findings *vPacketFindings) (uint64, asset.Type, bool) {
+
+	var (
+		totalInputAmount uint64
+		allResolved      = true
+		firstAsset       = vPkt.Inputs[0].Asset()
+	)
+	for idx, vIn := range vPkt.Inputs {
+		inputAsset := vIn.Asset()
+		if inputAsset == nil {
+			findings.addInput(
+				VPacketCheckInputs, idx, "input asset not set",
+			)
+			allResolved = false
+
+			continue
+		}
+
+		if vIn.PrevID.ID != inputAsset.ID() {
+			findings.addInput(
+				VPacketCheckInputs, idx, "prev ID asset ID %v "+
+					"doesn't match input asset ID %v",
+				vIn.PrevID.ID, inputAsset.ID(),
+			)
+		}
+
+		// The virtual transaction can only spend a single asset ID of
+		// a single type.
+		if firstAsset != nil {
+			if inputAsset.ID() != firstAsset.ID() {
+				findings.addInput(
+					VPacketCheckInputs, idx, "input asset "+
+						"ID %v differs from first "+
+						"input asset ID %v",
+					inputAsset.ID(), firstAsset.ID(),
+				)
+			}
+			if inputAsset.Type != firstAsset.Type {
+				findings.addInput(
+					VPacketCheckInputs, idx, "input asset "+
+						"type %v differs from first "+
+						"input asset type %v",
+					inputAsset.Type, firstAsset.Type,
+				)
+			}
+		}
+
+		if inputAsset.ScriptVersion != asset.ScriptV0 {
+			findings.addInput(
+				VPacketCheckVersion, idx, "unsupported input "+
+					"script version %d",
+				inputAsset.ScriptVersion,
+			)
+		}
+
+		// This should never happen for a valid asset, but we want to
+		// make sure we never overflow when summing up the amounts.
+		if inputAsset.Amount > math.MaxInt64 {
+			findings.addInput(
+				VPacketCheckAmounts, idx, "input amount %d "+
+					"exceeds maximum", inputAsset.Amount,
+			)
+			allResolved = false
+
+			continue
+		}
+		totalInputAmount += inputAsset.Amount
+
+		tapCommitment, ok := inputCommitments[vIn.PrevID]
+		if !ok || tapCommitment == nil {
+			findings.addInput(
+				VPacketCheckInputs, idx, "no input commitment "+
+					"for prev ID %v", vIn.PrevID,
+			)
+
+			continue
+		}
+
+		scriptKey, err := vIn.PrevID.ScriptKey.ToPubKey()
+		if err != nil {
+			findings.addInput(
+				VPacketCheckInputs, idx, "invalid prev ID "+
+					"script key: %v", err,
+			)
+
+			continue
+		}
+
+		desc := &FundingDescriptor{
+			ID: inputAsset.ID(),
+		}
+		if inputAsset.GroupKey != nil {
+			desc.GroupKey = &inputAsset.GroupKey.GroupPubKey
+		}
+		committedAsset, err := AssetFromTapCommitment(
+			tapCommitment, desc, *scriptKey,
+		)
+		if err != nil {
+			findings.addInput(
+				VPacketCheckInputs, idx, "input asset not "+
+					"committed to: %v", err,
+			)
+
+			continue
+		}
+
+		if committedAsset.Amount != inputAsset.Amount {
+			findings.addInput(
+				VPacketCheckInputs, idx, "input amount %d "+
+					"doesn't match committed amount %d",
+				inputAsset.Amount, committedAsset.Amount,
+			)
+		}
+	}
+
+	if firstAsset == nil {
+		return totalInputAmount, 0, false
+	}
+
+	return totalInputAmount, firstAsset.Type, allResolved
+}
+
+// validateVOutputs checks the versions and lock times of the outputs of the
+// virtual packet, as well as the consistency of any already prepared output
+// assets.
+func validateVOutputs(vPkt *tappsbt.VPacket, findings *vPacketFindings) {
+	var heightLocks, timeLocks int
+	for idx, vOut := range vPkt.Outputs {
+		if vOut.AssetVersion != asset.V0 &&
+			vOut.AssetVersion != asset.V1 {
+
+			findings.addOutput(
+				VPacketCheckVersion, idx, "unsupported asset "+
+					"version %d", vOut.AssetVersion,
+			)
+		}
+
+		// The lock times are bubbled up to the anchor transaction, so
+		// they need to fit into the lock time and sequence fields.
+		if vOut.LockTime > math.MaxUint32 {
+			findings.addOutput(
+				VPacketCheckLockTime, idx, "lock time %d "+
+					"exceeds maximum", vOut.LockTime,
+			)
+		}
+		if vOut.RelativeLockTime > math.MaxUint32 {
+			findings.addOutput(
+				VPacketCheckLockTime, idx, "relative lock "+
+					"time %d exceeds maximum",
+				vOut.RelativeLockTime,
+			)
+		}
+
+		switch {
+		case vOut.LockTime == 0:
+		case vOut.LockTime < txscript.LockTimeThreshold:
+			heightLocks++
+		default:
+			timeLocks++
+		}
+
+		if vOut.Asset == nil {
+			continue
+		}
+
+		if vOut.Asset.Version != vOut.AssetVersion {
+			findings.addOutput(
+				VPacketCheckVersion, idx, "output asset "+
+					"version %d doesn't match declared "+
+					"version %d", vOut.Asset.Version,
+				vOut.AssetVersion,
+			)
+		}
+		if vOut.Asset.ScriptVersion != asset.ScriptV0 {
+			findings.addOutput(
+				VPacketCheckVersion, idx, "unsupported output "+
+					"script version %d",
+				vOut.Asset.ScriptVersion,
+			)
+		}
+		if vOut.Asset.LockTime != vOut.LockTime ||
+			vOut.Asset.RelativeLockTime != vOut.RelativeLockTime {
+
+			findings.addOutput(
+				VPacketCheckLockTime, idx, "output asset lock "+
+					"times don't match declared lock times",
+			)
+		}
+		if vOut.Asset.Amount != vOut.Amount {
+			findings.addOutput(
+				VPacketCheckAmounts, idx, "output asset "+
+					"amount %d doesn't match declared "+
+					"amount %d", vOut.Asset.Amount,
+				vOut.Amount,
+			)
+		}
+	}
+
+	// All outputs end up in the same anchor transaction, which can only
+	// have one type of lock time.
+	if heightLocks > 0 && timeLocks > 0 {
+		findings.add(
+			VPacketCheckLockTime, "outputs mix block height and "+
+				"timestamp based lock times",
+		)
+	}
+}
+
+// validateAmounts checks that the asset amounts of the inputs are conserved in
+// the outputs.
+func validateAmounts(vPkt *tappsbt.VPacket, totalInputAmount uint64,
+	inputType asset.Type, findings *vPacketFindings) {
+
+	var totalOutputAmount uint64
+	for idx, vOut := range vPkt.Outputs {
+		if vOut.Amount > math.MaxInt64 {
+			findings.addOutput(
+				VPacketCheckAmounts, idx, "output amount %d "+
+					"exceeds maximum", vOut.Amount,
+			)
+
+			return
+		}
+		totalOutputAmount += vOut.Amount
+
+		if inputType == asset.Collectible && vOut.Amount > 1 {
+			findings.addOutput(
+				VPacketCheckAmounts, idx, "collectible output "+
+					"amount %d is greater than one",
+				vOut.Amount,
+			)
+		}
+
+		// Only the split root can carry a zero amount, either as a
+		// tombstone or to anchor passive assets.
+		if vOut.Amount == 0 && !vOut.Type.IsSplitRoot() {
+			findings.addOutput(
+				VPacketCheckAmounts, idx, "zero amount output "+
+					"that isn't the split root",
+			)
+		}
+	}
+
+	if totalOutputAmount != totalInputAmount {
+		findings.add(
+			VPacketCheckAmounts, "total output amount %d doesn't "+
+				"match total input amount %d",
+			totalOutputAmount, totalInputAmount,
+		)
+	}
+}
+
+// validateSplit checks that the packet has a split root output if it needs
+// one and, if the output assets are already prepared, that the split
+// commitment proofs of all split outputs resolve to the split commitment root.
+func validateSplit(vPkt *tappsbt.VPacket, totalInputAmount uint64,
+	findings *vPacketFindings) {
+
+	numSplitRoots := fn.Count(vPkt.Outputs, tappsbt.VOutIsSplitRoot)
+	if numSplitRoots > 1 {
+		findings.add(
+			VPacketCheckSplit, "packet has %d split root outputs",
+			numSplitRoots,
+		)
+
+		return
+	}
+
+	// Only a full value interactive send can do without a split.
+	_, fullValueInteractive := interactiveFullValueSend(
+		totalInputAmount, vPkt.Outputs,
+	)
+	if numSplitRoots == 0 {
+		if !fullValueInteractive {
+			findings.add(
+				VPacketCheckSplit, "packet requires a split "+
+					"root output",
+			)
+		}
+
+		return
+	}
+
+	var (
+		rootIdx   int
+		splitRoot *tappsbt.VOutput
+	)
+	for idx, vOut := range vPkt.Outputs {
+		if vOut.Type.IsSplitRoot() {
+			rootIdx, splitRoot = idx, vOut
+		}
+	}
+
+	// A zero value split root is a tombstone that must not be spendable.
+	if splitRoot.Amount == 0 && splitRoot.ScriptKey.PubKey != nil {
+		unSpendable, err := splitRoot.ScriptKey.IsUnSpendable()
+		if err == nil && !unSpendable {
+			findings.addOutput(
+				VPacketCheckSplit, rootIdx, "zero amount "+
+					"split root must have an un-spendable "+
+					"script key",
+			)
+		}
+	}
+
+	// If the output assets aren't prepared yet, there is nothing more we
+	// can check. A full value interactive send with a split root only
+	// anchors passive assets and doesn't have a split commitment.
+	if splitRoot.Asset == nil || fullValueInteractive {
+		return
+	}
+
+	rootAsset := splitRoot.Asset
+	if rootAsset.SplitCommitmentRoot == nil {
+		findings.addOutput(
+			VPacketCheckSplit, rootIdx, "split root asset has no "+
+				"split commitment root",
+		)
+
+		return
+	}
+
+	for idx, vOut := range vPkt.Outputs {
+		if idx == rootIdx || vOut.Asset == nil {
+			continue
+		}
+
+		if !vOut.Asset.HasSplitCommitmentWitness() {
+			findings.addOutput(
+				VPacketCheckSplit, idx, "split output has no "+
+					"split commitment witness",
+			)
+
+			continue
+		}
+
+		if !verifySplitProof(vOut, rootAsset) {
+			findings.addOutput(
+				VPacketCheckSplit, idx, "split commitment "+
+					"proof doesn't resolve to split root",
+			)
+		}
+	}
+}
+
+// verifySplitProof verifies that the split commitment proof of the asset of the
+// given output resolves to the split commitment root of the given root asset.
+func verifySplitProof(vOut *tappsbt.VOutput, rootAsset *asset.Asset) bool {
+	splitAsset := vOut.Asset
+	splitWitness := splitAsset.PrevWitnesses[0]
+
+	locator := &commitment.SplitLocator{
+		OutputIndex: vOut.AnchorOutputIndex,
+		AssetID:     splitAsset.Genesis.ID(),
+		ScriptKey:   asset.ToSerialized(splitAsset.ScriptKey.PubKey),
+		Amount:      splitAsset.Amount,
+	}
+
+	// The split leaf is committed to without the split commitment witness
+	// and with the lock times of the root asset.
+	splitNoWitness := splitAsset.Copy()
+	splitNoWitness.PrevWitnesses[0].SplitCommitment = nil
+	splitNoWitness.LockTime = rootAsset.LockTime
+	splitNoWitness.RelativeLockTime = rootAsset.RelativeLockTime
+
+	splitLeaf, err := splitNoWitness.Leaf()
+	if err != nil {
+		return false
+	}
+
+	return mssmt.VerifyMerkleProof(
+		locator.Hash(), splitLeaf, &splitWitness.SplitCommitment.Proof,
+		rootAsset.SplitCommitmentRoot,
+	)
+}
@@ -0,0 +1,189 @@
+package tapsend_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/tapsend"
+	"github.com/stretchr/testify/require"
+)
+
+// TestValidateVPacket tests that the stateless virtual packet validation
+// accepts valid packets, both before and after preparing the output assets,
+// and reports the expected findings for invalid ones.
+func TestValidateVPacket(t *testing.T) {
+	t.Parallel()
+
+	state := initSpendScenario(t)
+	inputCommitments := tappsbt.InputCommitments{
+		state.asset2PrevID: &state.asset2TapTree,
+	}
+	newPacket := func() *tappsbt.VPacket {
+		return createPacket(
+			state.address1, state.asset2PrevID, state,
+			state.asset2InputAssets, false,
+		)
+	}
+	checks := func(
+		findings []tapsend.VPacketFinding) []tapsend.VPacketCheck {
+
+		return fn.Map(
+			findings,
+			func(f tapsend.VPacketFinding) tapsend.VPacketCheck {
+				return f.Check
+			},
+		)
+	}
+
+	// A valid packet passes both before and after its output assets are
+	// prepared.
+	pkt := newPacket()
+	require.Empty(t, tapsend.ValidateVPacket(pkt, inputCommitments))
+
+	err := tapsend.PrepareOutputAssets(context.Background(), pkt)
+	require.NoError(t, err)
+	require.Empty(t, tapsend.ValidateVPacket(pkt, inputCommitments))
+
+	// Moving a split output to a different anchor output invalidates its
+	// split commitment proof.
+	pkt.Outputs[1].AnchorOutputIndex++
+	findings := tapsend.ValidateVPacket(pkt, inputCommitments)
+	require.Equal(
+		t, []tapsend.VPacketCheck{tapsend.VPacketCheckSplit},
+		checks(findings),
+	)
+	require.Equal(t, fn.Some(1), findings[0].OutputIndex)
+
+	testCases := []struct {
+		name     string
+		modify   func(pkt *tappsbt.VPacket) tappsbt.InputCommitments
+		expected []tapsend.VPacketCheck
+	}{{
+		name: "missing input commitment",
+		modify: func(*tappsbt.VPacket) tappsbt.InputCommitments {
+			return nil
+		},
+		expected: []tapsend.VPacketCheck{
+			tapsend.VPacketCheckInputs,
+		},
+	}, {
+		name: "amount not conserved",
+		modify: func(pkt *tappsbt.VPacket) tappsbt.InputCommitments {
+			pkt.Outputs[0].Amount++
+			return inputCommitments
+		},
+		expected: []tapsend.VPacketCheck{
+			tapsend.VPacketCheckAmounts,
+		},
+	}, {
+		name: "missing split root",
+		modify: func(pkt *tappsbt.VPacket) tappsbt.InputCommitments {
+			pkt.Outputs[0].Type = tappsbt.TypeSimple
+			return inputCommitments
+		},
+		expected: []tapsend.VPacketCheck{
+			tapsend.VPacketCheckSplit,
+		},
+	}, {
+		name: "unsupported asset version",
+		modify: func(pkt *tappsbt.VPacket) tappsbt.InputCommitments {
+			pkt.Outputs[1].AssetVersion = 99
+			return inputCommitments
+		},
+		expected: []tapsend.VPacketCheck{
+			tapsend.VPacketCheckVersion,
+		},
+	}, {
+		name: "mixed lock times",
+		modify: func(pkt *tappsbt.VPacket) tappsbt.InputCommitments {
+			pkt.Outputs[0].LockTime = 100
+			pkt.Outputs[1].LockTime = txscript.LockTimeThreshold + 1
+			return inputCommitments
+		},
+		expected: []tapsend.VPacketCheck{
+			tapsend.VPacketCheckLockTime,
+		},
+	}, {
+		name: "no outputs",
+		modify: func(pkt *tappsbt.VPacket) tappsbt.InputCommitments {
+			pkt.Outputs = nil
+			return inputCommitments
+		},
+		expected: []tapsend.VPacketCheck{
+			tapsend.VPacketCheckStructure,
+		},
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pkt := newPacket()
+			commitments := tc.modify(pkt)
+
+			findings := tapsend.ValidateVPacket(pkt, commitments)
+			require.Equal(t, tc.expected, checks(findings))
+		})
+	}
+}
+
+// TestInputCommitmentsFromProofs tests that input commitments derived from the
+// input proofs of a virtual packet can be used to validate it, and that inputs
+// without a valid proof are reported.
+func TestInputCommitmentsFromProofs(t *testing.T) {
+	t.Parallel()
+
+	state := initSpendScenario(t)
+	createGenesisProof(t, &state)
+
+	genesisProof := state.asset2GenesisProof
+	newPacket := func() *tappsbt.VPacket {
+		prevID := state.asset2PrevID
+		prevID.OutPoint = genesisProof.OutPoint()
+		inputAssets := commitment.InputSet{
+			prevID: state.asset2InputAssets[state.asset2PrevID],
+		}
+
+		pkt := createPacket(
+			state.address1, prevID, state, inputAssets, false,
+		)
+		pkt.Inputs[0].Proof = &genesisProof
+
+		return pkt
+	}
+
+	// A packet with a valid input proof passes validation using the
+	// derived input commitments.
+	pkt := newPacket()
+	inputCommitments, findings := tapsend.InputCommitmentsFromProofs(pkt)
+	require.Empty(t, findings)
+	require.Len(t, inputCommitments, 1)
+	require.Empty(t, tapsend.ValidateVPacket(pkt, inputCommitments))
+
+	// An input without a proof is reported.
+	pkt = newPacket()
+	pkt.Inputs[0].Proof = nil
+	inputCommitments, findings = tapsend.InputCommitmentsFromProofs(pkt)
+	require.Empty(t, inputCommitments)
+	require.Len(t, findings, 1)
+	require.Equal(t, tapsend.VPacketCheckInputs, findings[0].Check)
+	require.Equal(t, fn.Some(0), findings[0].InputIndex)
+
+	// A proof for a different outpoint is reported.
+	pkt = newPacket()
+	pkt.Inputs[0].PrevID.OutPoint.Index++
+	_, findings = tapsend.InputCommitmentsFromProofs(pkt)
+	require.Len(t, findings, 1)
+	require.Contains(t, findings[0].Message, "doesn't match prev ID")
+
+	// A proof that doesn't commit to its anchor output is reported.
+	pkt = newPacket()
+	invalidProof := genesisProof
+	invalidProof.InclusionProof.InternalKey = &state.receiverPubKey
+	pkt.Inputs[0].Proof = &invalidProof
+	_, findings = tapsend.InputCommitmentsFromProofs(pkt)
+	require.Len(t, findings, 1)
+	require.Contains(t, findings[0].Message, "invalid input proof")
+}
@@ -0,0 +1,451 @@
+package universe
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+)
+
+var (
+	// ErrQuarantineUnsupported is returned if corrupted leaves should be
+	// quarantined, but the auditor has no quarantine configured.
+	ErrQuarantineUnsupported = errors.New("leaf quarantine not supported")
+)
+
+// LeafQuarantine is used to move corrupted leaves out of a universe tree.
+type LeafQuarantine interface {
+	// QuarantineLeaf removes the leaf with the given key from the target
+	// universe and stores it, together with the reason, for later
+	// inspection. The universe and multiverse roots are updated
+	// accordingly.
+	QuarantineLeaf(ctx context.Context, id Identifier, key LeafKey,
+		reason string) error
+}
+
+// CorruptedLeaf is a universe leaf that didn't pass the audit.
+type CorruptedLeaf struct {
+	// Key is the key of the corrupted leaf.
+	Key LeafKey
+
+	// Reason is the reason the leaf didn't pass the audit.
+	Reason error
+
+	// Quarantined is true if the leaf was moved out of the universe.
+	Quarantined bool
+}
+
+// AuditReport is the result of the audit of a single universe.
+type AuditReport struct {
+	// ID is the identifier of the audited universe.
+	ID Identifier
+
+	// StoredRoot is the root of the universe as it was stored before the
+	// audit.
+	StoredRoot mssmt.Node
+
+	// ComputedRoot is the root recomputed from all stored leaves.
+	ComputedRoot mssmt.Node
+
+	// NumLeaves is the number of leaves that were audited.
+	NumLeaves int
+
+	// CorruptedLeaves is the set of leaves that didn't pass the audit.
+	CorruptedLeaves []CorruptedLeaf
+}
+
+// RootMatches returns true if the recomputed root matches the stored root.
+func (r *AuditReport) RootMatches() bool {
+	if r.StoredRoot == nil || r.ComputedRoot == nil {
+		return r.StoredRoot == nil && r.ComputedRoot == nil
+	}
+
+	return mssmt.IsEqualNode(r.StoredRoot, r.ComputedRoot)
+}
+
+// Healthy returns true if the root matches and no corrupted leaves were found.
+func (r *AuditReport) Healthy() bool {
+	return r.RootMatches() && len(r.CorruptedLeaves) == 0
+}
+
+// String returns a human-readable summary of the report.
+func (r *AuditReport) String() string {
+	return fmt.Sprintf("universe %v: leaves=%d, corrupted=%d, "+
+		"root_matches=%v", r.ID.String(), r.NumLeaves,
+		len(r.CorruptedLeaves), r.RootMatches())
+}
+
+// AuditorConfig is the main config for the universe auditor.
+type AuditorConfig struct {
+	// Universe is the universe that is audited.
+	Universe DiffEngine
+
+	// Quarantine is used to move corrupted leaves out of the universe.
+	// This can be nil if quarantining isn't supported.
+	Quarantine LeafQuarantine
+
+	// AuditInterval is the interval at which all universes are audited in
+	// the background once the auditor is started. If this is zero, no
+	// background audits are performed.
+	AuditInterval time.Duration
+
+	// QuarantineCorrupted indicates that corrupted leaves found during a
+	// background audit are quarantined.
+	QuarantineCorrupted bool
+
+	// PageSize is the page size used to query roots and leaf keys. If
+	// this is zero, MaxPageSize is used.
+	PageSize int32
+}
+
+// Auditor walks universe trees and checks their consistency. Each stored proof
+// blob is decoded and checked against its leaf key and universe identifier,
+// and its inclusion proof is checked against the stored root. The root is then
+// recomputed from all leaves and compared with the stored root. Note that the
+// proofs aren't verified against the chain, so only corruption of the stored
+// data is detected.
+type Auditor struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg AuditorConfig
+
+	// ContextGuard provides a wait group and main quit channel that can be
+	// used to create guarded contexts.
+	*fn.ContextGuard
+}
+
+// NewAuditor creates a new universe auditor.
+func NewAuditor(cfg AuditorConfig) *Auditor {
+	if cfg.PageSize <= 0 {
+		cfg.PageSize = MaxPageSize
+	}
+
+	return &Auditor{
+		cfg: cfg,
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start starts the background audits, if enabled.
+func (a *Auditor) Start() error {
+	a.startOnce.Do(func() {
+		if a.cfg.AuditInterval <= 0 {
+			return
+		}
+
+		log.Infof("Starting universe auditor (interval=%v, "+
+			"quarantine=%v)", a.cfg.AuditInterval,
+			a.cfg.QuarantineCorrupted)
+
+		a.Wg.Add(1)
+		go a.auditLoop()
+	})
+
+	return nil
+}
+
+// Stop stops the background audits.
+func (a *Auditor) Stop() error {
+	a.stopOnce.Do(func() {
+		close(a.Quit)
+		a.Wg.Wait()
+	})
+
+	return nil
+}
+
+// auditLoop periodically audits all universes and logs the results.
+//
+// NOTE: This MUST be run as a goroutine.
+func (a *Auditor) auditLoop() {
+	defer a.Wg.Done()
+
+	ticker := time.NewTicker(a.cfg.AuditInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			ctx, cancel := a.WithCtxQuitNoTimeout()
+			reports, err := a.AuditAll(
+				ctx, a.cfg.QuarantineCorrupted,
+			)
+			cancel()
+			if err != nil {
+				log.Errorf("Unable to audit universes: %v", err)
+				continue
+			}
+
+			logAuditReports(reports)
+
+		case <-a.Quit:
+			return
+		}
+	}
+}
+
+// logAuditReports logs a warning for each universe that didn't pass the audit.
+func logAuditReports(reports []*AuditReport) {
+	var numUnhealthy int
+	for _, report := range reports {
+		if report.Healthy() {
+			continue
+		}
+
+		numUnhealthy++
+		log.Warnf("Universe audit failed: %v", report)
+		for _, leaf := range report.CorruptedLeaves {
+			log.Warnf("Corrupted leaf in universe %v (outpoint=%v, "+
+				"quarantined=%v): %v", report.ID.String(),
+				leaf.Key.OutPoint, leaf.Quarantined, leaf.Reason)
+		}
+	}
+
+	log.Infof("Audited %d universes, %d failed", len(reports), numUnhealthy)
+}
+
+// AuditAll audits all known universes. If quarantine is set, corrupted leaves
+// are moved out of their universe.
+func (a *Auditor) AuditAll(ctx context.Context,
+	quarantine bool) ([]*AuditReport, error) {
+
+	var (
+		offset int32
+		roots  []Root
+	)
+	for {
+		page, err := a.cfg.Universe.RootNodes(ctx, RootNodesQuery{
+			SortDirection: SortAscending,
+			Offset:        offset,
+			Limit:         a.cfg.PageSize,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch roots: %w", err)
+		}
+
+		if len(page) == 0 {
+			break
+		}
+
+		roots = append(roots, page...)
+		offset += a.cfg.PageSize
+	}
+
+	reports := make([]*AuditReport, 0, len(roots))
+	for _, root := range roots {
+		report, err := a.Audit(ctx, root.ID, quarantine)
+		if err != nil {
+			return nil, fmt.Errorf("unable to audit universe %v: "+
+				"%w", root.ID.String(), err)
+		}
+
+		reports = append(reports, report)
+	}
+
+	return reports, nil
+}
+
+// Audit audits the universe with the given identifier. If quarantine is set,
+// corrupted leaves are moved out of the universe.
+func (a *Auditor) Audit(ctx context.Context, id Identifier,
+	quarantine bool) (*AuditReport, error) {
+
+	if quarantine && a.cfg.Quarantine == nil {
+		return nil, ErrQuarantineUnsupported
+	}
+
+	storedRoot, err := a.cfg.Universe.RootNode(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch root: %w", err)
+	}
+
+	keys, err := a.fetchAllLeafKeys(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &AuditReport{
+		ID:         id,
+		StoredRoot: storedRoot.Node,
+		NumLeaves:  len(keys),
+	}
+
+	// We recompute the root in memory from the leaves as they are stored,
+	// including the corrupted ones, so we can tell whether the stored
+	// tree itself is consistent.
+	computedTree := mssmt.NewCompactedTree(mssmt.NewDefaultStore())
+	for _, key := range keys {
+		leaf, err := a.auditLeaf(ctx, id, key, storedRoot.Node)
+		if err != nil {
+			report.CorruptedLeaves = append(
+				report.CorruptedLeaves, CorruptedLeaf{
+					Key:    key,
+					Reason: err,
+				},
+			)
+		}
+
+		if leaf == nil {
+			continue
+		}
+
+		_, err = computedTree.Insert(
+			ctx, key.UniverseKey(), leaf.SmtLeafNode(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to insert leaf: %w", err)
+		}
+	}
+
+	report.ComputedRoot, err = computedTree.Root(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compute root: %w", err)
+	}
+
+	if !quarantine {
+		return report, nil
+	}
+
+	for idx := range report.CorruptedLeaves {
+		corrupted := &report.CorruptedLeaves[idx]
+		err := a.cfg.Quarantine.QuarantineLeaf(
+			ctx, id, corrupted.Key, corrupted.Reason.Error(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to quarantine leaf: %w",
+				err)
+		}
+
+		corrupted.Quarantined = true
+	}
+
+	return report, nil
+}
+
+// fetchAllLeafKeys fetches all leaf keys of the given universe.
+func (a *Auditor) fetchAllLeafKeys(ctx context.Context,
+	id Identifier) ([]LeafKey, error) {
+
+	var (
+		offset int32
+		keys   []LeafKey
+	)
+	for {
+		page, err := a.cfg.Universe.UniverseLeafKeys(
+			ctx, UniverseLeafKeysQuery{
+				Id:            id,
+				SortDirection: SortAscending,
+				Offset:        offset,
+				Limit:         a.cfg.PageSize,
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch leaf keys: %w",
+				err)
+		}
+
+		if len(page) == 0 {
+			break
+		}
+
+		keys = append(keys, page...)
+		offset += a.cfg.PageSize
+	}
+
+	return keys, nil
+}
+
+// auditLeaf fetches and checks the leaf with the given key. The leaf is
+// returned whenever it could be fetched, even if it didn't pass the audit.
+func (a *Auditor) auditLeaf(ctx context.Context, id Identifier, key LeafKey,
+	storedRoot mssmt.Node) (*Leaf, error) {
+
+	proofs, err := a.cfg.Universe.FetchProofLeaf(ctx, id, key)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch leaf: %w", err)
+	}
+	if len(proofs) != 1 || proofs[0].Leaf == nil {
+		return nil, fmt.Errorf("expected exactly one leaf, got %d",
+			len(proofs))
+	}
+
+	uniProof := proofs[0]
+	leaf := uniProof.Leaf
+	if err := VerifyLeaf(id, key, leaf); err != nil {
+		return leaf, err
+	}
+
+	// The inclusion proof must lead from the leaf to the stored root,
+	// otherwise the tree nodes on the path to the leaf are corrupted.
+	if uniProof.UniverseInclusionProof == nil ||
+		!uniProof.VerifyRoot(storedRoot) {
+
+		return leaf, fmt.Errorf("inclusion proof doesn't match " +
+			"stored root")
+	}
+
+	return leaf, nil
+}
+
+// VerifyLeaf checks that the proof blob of the given leaf decodes and commits
+// to the leaf key and universe identifier it is stored under.
+func VerifyLeaf(id Identifier, key LeafKey, leaf *Leaf) error {
+	var leafProof proof.Proof
+	err := leafProof.Decode(bytes.NewReader(leaf.RawProof))
+	if err != nil {
+		return fmt.Errorf("unable to decode proof: %w", err)
+	}
+
+	if leafProof.OutPoint() != key.OutPoint {
+		return fmt.Errorf("proof outpoint %v doesn't match leaf key "+
+			"outpoint %v", leafProof.OutPoint(), key.OutPoint)
+	}
+
+	proofAsset := &leafProof.Asset
+	if key.ScriptKey == nil || key.ScriptKey.PubKey == nil ||
+		!proofAsset.ScriptKey.PubKey.IsEqual(key.ScriptKey.PubKey) {
+
+		return fmt.Errorf("proof script key doesn't match leaf key")
+	}
+
+	if err := ValidateProofUniverseType(proofAsset, id); err != nil {
+		return err
+	}
+
+	if id.GroupKey != nil {
+		if proofAsset.GroupKey == nil {
+			return fmt.Errorf("proof asset has no group key")
+		}
+
+		proofGroupKey := schnorr.SerializePubKey(
+			&proofAsset.GroupKey.GroupPubKey,
+		)
+		if !bytes.Equal(
+			proofGroupKey, schnorr.SerializePubKey(id.GroupKey),
+		) {
+
+			return fmt.Errorf("proof group key doesn't match " +
+				"universe")
+		}
+
+		return nil
+	}
+
+	if proofAsset.ID() != id.AssetID {
+		return fmt.Errorf("proof asset ID %v doesn't match universe",
+			proofAsset.ID())
+	}
+
+	return nil
+}
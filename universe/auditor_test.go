@@ -0,0 +1,211 @@
+package universe
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/stretchr/testify/require"
+)
+
+// auditDiffEngine is a DiffEngine that serves the universes of an in-memory
+// multiverse. The stored root of a universe can be overridden to simulate a
+// corrupted universe tree.
+type auditDiffEngine struct {
+	*MemMultiverse
+
+	storedRoots map[string]mssmt.Node
+}
+
+// RootNode returns the root node of the given universe.
+func (a *auditDiffEngine) RootNode(ctx context.Context,
+	id Identifier) (Root, error) {
+
+	root, err := a.UniverseRootNode(ctx, id)
+	if err != nil {
+		return Root{}, err
+	}
+
+	if storedRoot, ok := a.storedRoots[id.String()]; ok {
+		root.Node = storedRoot
+	}
+
+	return root, nil
+}
+
+// Close is a no-op.
+func (a *auditDiffEngine) Close() error {
+	return nil
+}
+
+// recordingQuarantine is a LeafQuarantine that records the quarantined leaves.
+type recordingQuarantine struct {
+	keys []LeafKey
+}
+
+// QuarantineLeaf records the given leaf key.
+func (r *recordingQuarantine) QuarantineLeaf(_ context.Context, _ Identifier,
+	key LeafKey, _ string) error {
+
+	r.keys = append(r.keys, key)
+
+	return nil
+}
+
+// randAuditLeaf creates a leaf with a valid proof blob for the given genesis
+// asset, and the key it is stored under.
+func randAuditLeaf(t *testing.T, a asset.Asset) (LeafKey, *Leaf) {
+	leafProof := proof.Proof{
+		AnchorTx: wire.MsgTx{
+			Version: 2,
+			TxIn: []*wire.TxIn{{
+				PreviousOutPoint: test.RandOp(t),
+			}},
+		},
+		Asset: a,
+		InclusionProof: proof.TaprootProof{
+			InternalKey: test.RandPubKey(t),
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, leafProof.Encode(&buf))
+
+	key := LeafKey{
+		OutPoint:  leafProof.OutPoint(),
+		ScriptKey: fn.Ptr(a.ScriptKey),
+	}
+	leaf := &Leaf{
+		GenesisWithGroup: GenesisWithGroup{
+			Genesis: a.Genesis,
+		},
+		RawProof: buf.Bytes(),
+		Asset:    &a,
+		Amt:      a.Amount,
+	}
+
+	return key, leaf
+}
+
+// TestAuditor tests that the auditor detects corrupted leaves and roots.
+func TestAuditor(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	engine := &auditDiffEngine{
+		MemMultiverse: NewMemMultiverse(),
+		storedRoots:   make(map[string]mssmt.Node),
+	}
+
+	genesisAsset := randGenesisAsset(t)
+	id := Identifier{
+		AssetID:   genesisAsset.ID(),
+		ProofType: ProofTypeIssuance,
+	}
+
+	const numLeaves = 3
+	for i := 0; i < numLeaves; i++ {
+		key, leaf := randAuditLeaf(t, genesisAsset)
+		_, err := engine.UpsertProofLeaf(ctx, id, key, leaf, nil)
+		require.NoError(t, err)
+	}
+
+	// A universe with only valid leaves passes the audit.
+	auditor := NewAuditor(AuditorConfig{
+		Universe: engine,
+		PageSize: 2,
+	})
+	report, err := auditor.Audit(ctx, id, false)
+	require.NoError(t, err)
+	require.True(t, report.Healthy(), report.String())
+	require.Equal(t, numLeaves, report.NumLeaves)
+
+	// We now add a leaf whose proof was stored under the wrong key, and a
+	// leaf whose proof can't be decoded at all.
+	_, wrongKeyLeaf := randAuditLeaf(t, genesisAsset)
+	wrongKey := LeafKey{
+		OutPoint:  test.RandOp(t),
+		ScriptKey: fn.Ptr(genesisAsset.ScriptKey),
+	}
+	_, err = engine.UpsertProofLeaf(ctx, id, wrongKey, wrongKeyLeaf, nil)
+	require.NoError(t, err)
+
+	garbageKey, garbageLeaf := randAuditLeaf(t, genesisAsset)
+	garbageLeaf.RawProof = test.RandBytes(100)
+	_, err = engine.UpsertProofLeaf(ctx, id, garbageKey, garbageLeaf, nil)
+	require.NoError(t, err)
+
+	// The stored tree itself is still consistent, but both leaves should
+	// be reported.
+	report, err = auditor.Audit(ctx, id, false)
+	require.NoError(t, err)
+	require.True(t, report.RootMatches())
+	require.False(t, report.Healthy())
+	require.Equal(t, numLeaves+2, report.NumLeaves)
+	require.Len(t, report.CorruptedLeaves, 2)
+
+	corruptedKeys := fn.Map(
+		report.CorruptedLeaves, func(l CorruptedLeaf) [32]byte {
+			require.False(t, l.Quarantined)
+			return l.Key.UniverseKey()
+		},
+	)
+	require.ElementsMatch(
+		t, [][32]byte{wrongKey.UniverseKey(), garbageKey.UniverseKey()},
+		corruptedKeys,
+	)
+
+	// Quarantining requires a quarantine to be configured.
+	_, err = auditor.Audit(ctx, id, true)
+	require.ErrorIs(t, err, ErrQuarantineUnsupported)
+
+	quarantine := &recordingQuarantine{}
+	auditor = NewAuditor(AuditorConfig{
+		Universe:   engine,
+		Quarantine: quarantine,
+	})
+	report, err = auditor.Audit(ctx, id, true)
+	require.NoError(t, err)
+	require.Len(t, report.CorruptedLeaves, 2)
+	for _, leaf := range report.CorruptedLeaves {
+		require.True(t, leaf.Quarantined)
+	}
+	require.Len(t, quarantine.keys, 2)
+
+	// If the stored root doesn't match the leaves, the root mismatch and
+	// the failing inclusion proofs are reported.
+	engine.storedRoots[id.String()] = mssmt.NewComputedNode(
+		mssmt.NodeHash(test.RandHash()), 1,
+	)
+	report, err = auditor.Audit(ctx, id, false)
+	require.NoError(t, err)
+	require.False(t, report.RootMatches())
+	require.Len(t, report.CorruptedLeaves, numLeaves+2)
+
+	// Finally, all known universes are audited by AuditAll.
+	otherAsset := randGenesisAsset(t)
+	otherID := Identifier{
+		AssetID:   otherAsset.ID(),
+		ProofType: ProofTypeIssuance,
+	}
+	otherKey, otherLeaf := randAuditLeaf(t, otherAsset)
+	_, err = engine.UpsertProofLeaf(ctx, otherID, otherKey, otherLeaf, nil)
+	require.NoError(t, err)
+
+	reports, err := auditor.AuditAll(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, reports, 2)
+
+	for _, report := range reports {
+		require.Equal(
+			t, report.ID.String() == otherID.String(),
+			report.Healthy(),
+		)
+	}
+}
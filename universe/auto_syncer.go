@@ -27,8 +27,9 @@ type FederationConfig struct {
 	FederationDB FederationDB
 
 	// UniverseSyncer is used to synchronize with the federation
-	// periodically.
-	UniverseSyncer Syncer
+	// periodically. The streaming sync is used, so an interrupted sync
+	// resumes from its last checkpoint.
+	UniverseSyncer StreamingSyncer
 
 	// NewRemoteRegistrar is a function that returns a new register instance
 	// to the target remote Universe. This'll be used to optimistically push
@@ -41,7 +42,8 @@ type FederationConfig struct {
 	LocalRegistrar BatchRegistrar
 
 	// SyncInterval is the period that we'll use to synchronize with the
-	// set of Universe servers.
+	// set of Universe servers. It can be changed at runtime with
+	// SetSyncInterval.
 	SyncInterval time.Duration
 
 	// ErrChan is the main error channel the custodian will report back
@@ -56,6 +58,64 @@ type FederationConfig struct {
 	// ServerChecker is a function that can be used to check if a server is
 	// operational and not the local daemon.
 	ServerChecker func(ServerAddr) error
+
+	// FederationProfiles is used to persist named federation profiles.
+	FederationProfiles FederationProfileStore
+
+	// DefaultProfiles is a set of built-in federation profiles that'll be
+	// stored on start up if no profile with the same name exists yet.
+	DefaultProfiles []FederationProfile
+
+	// AssetFilter is an optional set of allow and deny lists by asset ID
+	// and group key that is applied on top of the stored sync configs.
+	AssetFilter *FedAssetFilter
+
+	// LocalAddr is the public address of our own universe server. Root
+	// change notifications are only sent out to the federation if this is
+	// set, as the receivers need to know where to pull the new leaves
+	// from.
+	LocalAddr fn.Option[ServerAddr]
+
+	// NewRootNotifier is a function that returns a new root notifier
+	// instance for the target remote Universe. This'll be used to notify
+	// federation members of changed universe roots, so they can pull the
+	// new leaves right away.
+	NewRootNotifier func(ServerAddr) (RootNotifier, error)
+
+	// RootNotifSigner is used to sign the root change notifications we
+	// send to the federation with our identity key. Notifications are only
+	// sent out if this is set.
+	RootNotifSigner ResponseSigner
+
+	// LocalDiffEngine is an optional diff engine of the local universe.
+	// If set, it is used to skip incoming root notifications for roots we
+	// already have.
+	LocalDiffEngine DiffEngine
+
+	// WatchRules is the issuance watch-list. An alert is published for
+	// each new issuance leaf synced from the federation that matches one
+	// of the rules.
+	WatchRules []*WatchRule
+
+	// GossipSigner is used to sign the list of live federation servers we
+	// hand out to the federation members. Gossip is only enabled if both
+	// this and NewListFetcher are set.
+	GossipSigner ResponseSigner
+
+	// NewListFetcher is a function that returns a new server list fetcher
+	// instance for the target remote Universe. This'll be used to fetch
+	// the lists of live federation servers after each sync.
+	NewListFetcher func(ServerAddr) (ServerListFetcher, error)
+
+	// GossipMaxServers is the federation size up to which servers learned
+	// through gossip are added automatically. If zero, gossiped servers
+	// are never added.
+	GossipMaxServers int
+
+	// GossipMaxAge is the maximum age of a gossiped server list, and the
+	// maximum time since the last successful sync with a server for it to
+	// be gossiped or added. If zero, DefaultGossipMaxAge is used.
+	GossipMaxAge time.Duration
 }
 
 // FederationPushReq is used to push out new updates to all or some members of
@@ -114,6 +174,31 @@ type FederationEnvoy struct {
 	// batchPushRequests is a channel that will be sent new requests to push
 	// out batch proof leaves to the federation.
 	batchPushRequests chan *FederationProofBatchPushReq
+
+	// incomingRootNotifs is a channel that will be sent root change
+	// notifications received from federation members.
+	incomingRootNotifs chan *RootNotification
+
+	// outgoingRootNotifs is a channel that will be sent root change
+	// notifications that should be sent out to the federation.
+	outgoingRootNotifs chan *outboundRootNotification
+
+	// alertDistributor is used to publish issuance watch-list alerts to
+	// all subscribers.
+	alertDistributor *fn.EventDistributor[fn.Event]
+
+	// syncIntervalUpdates is a channel that will be sent new default sync
+	// intervals.
+	syncIntervalUpdates chan time.Duration
+
+	// localServerList caches our own signed federation server list.
+	localServerList serverListCache
+
+	// scheduler keeps track of the default and scheduled syncs with each
+	// federation server.
+	//
+	// NOTE: This MUST only be accessed from the syncer goroutine.
+	scheduler *syncScheduler
 }
 
 // A compile-time check to ensure that FederationEnvoy meets the
@@ -126,6 +211,15 @@ func NewFederationEnvoy(cfg FederationConfig) *FederationEnvoy {
 		cfg:               cfg,
 		pushRequests:      make(chan *FederationPushReq),
 		batchPushRequests: make(chan *FederationProofBatchPushReq),
+		incomingRootNotifs: make(
+			chan *RootNotification, rootNotificationQueueSize,
+		),
+		outgoingRootNotifs: make(
+			chan *outboundRootNotification,
+			rootNotificationQueueSize,
+		),
+		alertDistributor:    fn.NewEventDistributor[fn.Event](),
+		syncIntervalUpdates: make(chan time.Duration),
 		ContextGuard: &fn.ContextGuard{
 			DefaultTimeout: DefaultTimeout,
 			Quit:           make(chan struct{}),
@@ -140,29 +234,11 @@ func (f *FederationEnvoy) Start() error {
 
 		// Before we start the main goroutine, we'll add the set of
 		// static Universe servers.
-		addrs := f.cfg.StaticFederationMembers
-		serverAddrs := fn.Map(addrs, NewServerAddrFromStr)
-
-		serverAddrs = fn.Filter(serverAddrs, func(a ServerAddr) bool {
-			// Before we add the server as a federation member, we
-			// check that we can actually connect to it and that it
-			// isn't ourselves.
-			if err := f.cfg.ServerChecker(a); err != nil {
-				log.Warnf("Not adding server to federation: %v",
-					err)
-
-				return false
-			}
+		f.AddStaticMembers(f.cfg.StaticFederationMembers)
 
-			return true
-		})
-
-		err := f.AddServer(serverAddrs...)
-		// On restart, we'll get an error for universe servers already
-		// inserted in our DB, since we can't store duplicates.
-		// We can safely ignore that error.
-		if err != nil && !errors.Is(err, ErrDuplicateUniverse) {
-			log.Warnf("Unable to add universe servers: %v", err)
+		if err := f.storeDefaultProfiles(); err != nil {
+			log.Warnf("Unable to store default federation "+
+				"profiles: %v", err)
 		}
 
 		f.Wg.Add(1)
@@ -173,6 +249,49 @@ func (f *FederationEnvoy) Start() error {
 	return nil
 }
 
+// AddStaticMembers adds the given static federation members to the
+// federation. Servers that we can't connect to are skipped, as well as
+// servers that are already part of the federation.
+func (f *FederationEnvoy) AddStaticMembers(addrs []string) {
+	serverAddrs := fn.Map(addrs, NewServerAddrFromStr)
+
+	serverAddrs = fn.Filter(serverAddrs, func(a ServerAddr) bool {
+		// Before we add the server as a federation member, we check
+		// that we can actually connect to it and that it isn't
+		// ourselves.
+		if err := f.cfg.ServerChecker(a); err != nil {
+			log.Warnf("Not adding server to federation: %v", err)
+
+			return false
+		}
+
+		return true
+	})
+
+	err := f.AddServer(serverAddrs...)
+	// On restart, we'll get an error for universe servers already inserted
+	// in our DB, since we can't store duplicates. We can safely ignore
+	// that error.
+	if err != nil && !errors.Is(err, ErrDuplicateUniverse) {
+		log.Warnf("Unable to add universe servers: %v", err)
+	}
+}
+
+// SetSyncInterval changes the default interval at which the envoy syncs with
+// the federation servers. The new interval takes effect right away, server
+// and universe specific sync schedules are unaffected.
+func (f *FederationEnvoy) SetSyncInterval(interval time.Duration) error {
+	if interval <= 0 {
+		return fmt.Errorf("sync interval must be positive")
+	}
+
+	if !fn.SendOrQuit(f.syncIntervalUpdates, interval, f.Quit) {
+		return fmt.Errorf("federation envoy shutting down")
+	}
+
+	return nil
+}
+
 // Close frees up any ephemeral resources allocated by the envoy.
 func (f *FederationEnvoy) Close() error {
 	return nil
@@ -193,18 +312,23 @@ func (f *FederationEnvoy) Stop() error {
 	return nil
 }
 
-// syncServerState attempts to sync Universe state with the target server.
-// If the sync is successful (even if no diff is generated), then a new sync
-// event will be logged.
+// syncServerState attempts to sync Universe state with the target server. If
+// no universe IDs are given, all universes are synced. If the sync is
+// successful (even if no diff is generated), then a new sync event will be
+// logged.
 func (f *FederationEnvoy) syncServerState(ctx context.Context,
-	addr ServerAddr, syncConfigs SyncConfigs) error {
+	addr ServerAddr, syncConfigs SyncConfigs,
+	idsToSync ...Identifier) error {
 
 	log.Infof("Syncing Universe state with server=%v", spew.Sdump(addr))
 
 	// Attempt to sync with the remote Universe server, if this errors then
-	// we'll bail out early as something wrong happened.
-	diff, err := f.cfg.UniverseSyncer.SyncUniverse(
-		ctx, addr, SyncFull, syncConfigs,
+	// we'll bail out early as something wrong happened. We don't need the
+	// progress events, but the streaming sync persists checkpoints, so a
+	// sync that is interrupted by a shutdown or a dropped connection
+	// doesn't need to start from scratch.
+	diff, err := f.cfg.UniverseSyncer.SyncUniverseStream(
+		ctx, addr, SyncFull, syncConfigs, nil, idsToSync...,
 	)
 	if err != nil {
 		return err
@@ -218,6 +342,21 @@ func (f *FederationEnvoy) syncServerState(ctx context.Context,
 	log.Infof("Synced new Universe leaves from server=%v, diff_size=%v",
 		spew.Sdump(addr), len(diff))
 
+	// Our roots changed, so we let the other federation members know they
+	// can pull the new leaves from us.
+	f.queueRootNotifications(diff, fn.Some(addr))
+
+	// Alert our subscribers of any new issuance leaves that are on our
+	// watch-list.
+	alerts := matchWatchRules(f.cfg.WatchRules, diff, addr)
+	for _, alert := range alerts {
+		log.Warnf("Watched issuance synced from server=%v: rule=%v, "+
+			"universe=%v", addr.HostStr(), alert.Rule,
+			alert.ID.StringForLog())
+
+		f.alertDistributor.NotifySubscribers(alert)
+	}
+
 	// Log a new sync event in the background now that we know we were able
 	// to contract the remote server.
 	f.Wg.Add(1)
@@ -405,7 +544,11 @@ func (f *FederationEnvoy) syncer() {
 
 	// TODO(roasbeef): trigger new sync on start up?
 
-	syncTicker := time.NewTicker(f.cfg.SyncInterval)
+	// The ticker fires at least once a minute, so universes and servers
+	// with a shorter schedule than the default sync interval are synced
+	// on time.
+	f.scheduler = newSyncScheduler(f.cfg.SyncInterval, time.Now())
+	syncTicker := time.NewTicker(f.scheduler.tickInterval())
 	defer syncTicker.Stop()
 
 	for {
@@ -446,6 +589,31 @@ func (f *FederationEnvoy) syncer() {
 					"request: %v", err)
 			}
 
+		// Handle a root change notification from a federation member.
+		case notif := <-f.incomingRootNotifs:
+			log.Debugf("Federation envoy handling root "+
+				"notification: %v", notif)
+			err := f.handleRootNotification(notif)
+			if err != nil {
+				// Warn, but don't exit the event handler
+				// routine.
+				log.Warnf("Unable to handle root "+
+					"notification: %v", err)
+			}
+
+		// Send out a root change notification to the federation.
+		case notif := <-f.outgoingRootNotifs:
+			f.notifyFederation(notif)
+
+		// Apply a new default sync interval. The ticker interval
+		// depends on it, so we need to reset the ticker as well.
+		case interval := <-f.syncIntervalUpdates:
+			log.Infof("Federation envoy changing sync interval "+
+				"to %v", interval)
+
+			f.scheduler.setDefaultInterval(interval)
+			syncTicker.Reset(f.scheduler.tickInterval())
+
 		case <-f.Quit:
 			return
 		}
@@ -453,7 +621,8 @@ func (f *FederationEnvoy) syncer() {
 }
 
 // handleTickEvent is called each time the sync ticker fires. It will attempt
-// to synchronize state with all the active universe servers in the federation.
+// to synchronize state with all the active universe servers in the federation
+// whose full sync or scheduled universe syncs are due.
 func (f *FederationEnvoy) handleTickEvent() error {
 	// Error propagation is handled in tryFetchServers, we only need to exit
 	// here.
@@ -463,18 +632,28 @@ func (f *FederationEnvoy) handleTickEvent() error {
 			"%w", err)
 	}
 
-	log.Infof("Synchronizing with %v federation members", len(fedServers))
-	err = f.SyncServers(fedServers)
+	ctx, cancel := f.WithCtxQuitNoTimeout()
+	defer cancel()
+
+	now := time.Now()
+	err = f.syncScheduled(ctx, now, fedServers)
 	if err != nil {
 		return fmt.Errorf("unable to sync with federation server: %w",
 			err)
 	}
 
+	// Gossip and the retry of pending proof pushes aren't scheduled, they
+	// happen once per default sync interval.
+	if !f.scheduler.defaultDue(now) {
+		return nil
+	}
+
+	// Now that we know which servers are alive, we fetch the server lists
+	// of the federation members, so it can grow organically.
+	f.gossipServerLists()
+
 	// After we've synced with the federation, we'll attempt to push out any
 	// pending proofs that we haven't yet completed.
-	ctx, cancel := f.WithCtxQuitNoTimeout()
-	defer cancel()
-
 	syncDirection := SyncDirectionPush
 	db := f.cfg.FederationDB
 
@@ -501,11 +680,12 @@ func (f *FederationEnvoy) handleTickEvent() error {
 	// We'll use a timeout that's slightly less than the sync interval to
 	// help avoid ticking into a new sync event before the previous event
 	// has finished.
-	syncContextTimeout := f.cfg.SyncInterval - 1*time.Second
+	syncInterval := f.scheduler.defaultInterval
+	syncContextTimeout := syncInterval - 1*time.Second
 	if syncContextTimeout < 0 {
 		// If the sync interval is less than a second, then we'll use
 		// the sync interval as the timeout.
-		syncContextTimeout = f.cfg.SyncInterval
+		syncContextTimeout = syncInterval
 	}
 
 	for idx := range logEntries {
@@ -733,6 +913,7 @@ func (f *FederationEnvoy) QuerySyncConfigs(
 	return &SyncConfigs{
 		GlobalSyncConfigs: globalConfigs,
 		UniSyncConfigs:    uniSyncConfigs,
+		AssetFilter:       f.cfg.AssetFilter,
 	}, nil
 }
 
@@ -764,6 +945,90 @@ func (f *FederationEnvoy) SyncServers(serverAddrs []ServerAddr) error {
 	return nil
 }
 
+// syncScheduled syncs with the given federation servers according to the
+// default sync interval and the universe and server specific sync schedules.
+// Scheduled universes are synced on their own and excluded from the full sync.
+// The servers are synced in parallel.
+func (f *FederationEnvoy) syncScheduled(ctx context.Context, now time.Time,
+	serverAddrs []ServerAddr) error {
+
+	syncConfigs, err := f.QuerySyncConfigs(ctx)
+	if err != nil {
+		return err
+	}
+
+	schedules, err := f.cfg.FederationDB.QuerySyncSchedules(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to query sync schedules: %w", err)
+	}
+
+	syncs := f.scheduler.dueSyncs(now, serverAddrs, schedules, syncConfigs)
+	if len(syncs) == 0 {
+		return nil
+	}
+
+	log.Infof("Synchronizing with %v federation members", len(syncs))
+
+	fullSyncConfigs := excludeScheduled(*syncConfigs, schedules)
+	syncServer := func(ctx context.Context, sync scheduledSync) error {
+		if sync.full {
+			err := f.syncServerState(
+				ctx, sync.server, fullSyncConfigs,
+			)
+			if err != nil {
+				log.Warnf("encountered an error whilst "+
+					"syncing with server=%v: %v",
+					sync.server.HostStr(), err)
+			}
+		}
+
+		if len(sync.ids) == 0 {
+			return nil
+		}
+
+		log.Debugf("Syncing %d scheduled universes with server=%v",
+			len(sync.ids), sync.server.HostStr())
+
+		err := f.syncServerState(
+			ctx, sync.server, *syncConfigs, sync.ids...,
+		)
+		if err != nil {
+			log.Warnf("encountered an error whilst syncing "+
+				"scheduled universes with server=%v: %v",
+				sync.server.HostStr(), err)
+		}
+
+		return nil
+	}
+
+	err = fn.ParSlice(ctx, syncs, syncServer)
+	if err != nil {
+		log.Warnf("unable to sync with server: %v", err)
+	}
+
+	return nil
+}
+
+// QuerySyncSchedules returns the universe and server specific sync schedules.
+func (f *FederationEnvoy) QuerySyncSchedules(
+	ctx context.Context) (*SyncSchedules, error) {
+
+	return f.cfg.FederationDB.QuerySyncSchedules(ctx)
+}
+
+// UpsertSyncSchedules validates and upserts the given universe and server
+// specific sync schedules. A schedule with a zero sync interval is removed.
+// The new schedules take effect on the next tick of the syncer.
+func (f *FederationEnvoy) UpsertSyncSchedules(ctx context.Context,
+	schedules *SyncSchedules) error {
+
+	if err := schedules.Validate(); err != nil {
+		return err
+	}
+
+	return f.cfg.FederationDB.UpsertSyncSchedules(ctx, schedules)
+}
+
 // SetConfigSyncAllAssets sets the global (default) sync config to sync all
 // assets.
 func (f *FederationEnvoy) SetConfigSyncAllAssets() error {
@@ -829,6 +1094,7 @@ func (f *FederationEnvoy) SyncAssetInfo(ctx context.Context,
 	}
 	fullConfig := SyncConfigs{
 		UniSyncConfigs: []*FedUniSyncConfig{&assetConfig},
+		AssetFilter:    f.cfg.AssetFilter,
 	}
 	// We'll sync with Universe servers in parallel and collect the diffs
 	// from any successful syncs. There can only be one diff per server, as
@@ -839,8 +1105,8 @@ func (f *FederationEnvoy) SyncAssetInfo(ctx context.Context,
 	// remote universe. Asset group import and verification is handled as
 	// part of the universe sync.
 	syncFromUni := func(ctxs context.Context, addr ServerAddr) error {
-		syncDiff, err := f.cfg.UniverseSyncer.SyncUniverse(
-			ctxs, addr, SyncIssuance, fullConfig,
+		syncDiff, err := f.cfg.UniverseSyncer.SyncUniverseStream(
+			ctxs, addr, SyncIssuance, fullConfig, nil,
 		)
 
 		// Sync failures are expected from Universe servers that do not
@@ -911,6 +1177,201 @@ func (f *FederationEnvoy) EnableAssetSync(ctx context.Context,
 	)
 }
 
+// storeDefaultProfiles stores the set of built-in federation profiles, unless
+// a profile with the same name already exists.
+func (f *FederationEnvoy) storeDefaultProfiles() error {
+	if f.cfg.FederationProfiles == nil {
+		return nil
+	}
+
+	ctx, cancel := f.WithCtxQuit()
+	defer cancel()
+
+	for _, profile := range f.cfg.DefaultProfiles {
+		_, err := f.cfg.FederationProfiles.FetchFederationProfile(
+			ctx, profile.Name,
+		)
+		switch {
+		// The profile already exists, we don't want to overwrite any
+		// changes the user might have made to it.
+		case err == nil:
+			continue
+
+		case !errors.Is(err, ErrNoFederationProfile):
+			return err
+		}
+
+		err = f.cfg.FederationProfiles.StoreFederationProfile(
+			ctx, profile,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SaveFederationProfile stores the current set of federation servers and sync
+// configs as a profile with the given name, replacing any existing profile
+// with the same name.
+func (f *FederationEnvoy) SaveFederationProfile(ctx context.Context,
+	name string) (*FederationProfile, error) {
+
+	if f.cfg.FederationProfiles == nil {
+		return nil, fmt.Errorf("federation profiles not supported")
+	}
+
+	servers, err := f.cfg.FederationDB.UniverseServers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch federation servers: "+
+			"%w", err)
+	}
+
+	syncConfigs, err := f.QuerySyncConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := FederationProfile{
+		Name:              name,
+		Servers:           servers,
+		GlobalSyncConfigs: syncConfigs.GlobalSyncConfigs,
+		UniSyncConfigs:    syncConfigs.UniSyncConfigs,
+	}
+	err = f.cfg.FederationProfiles.StoreFederationProfile(ctx, profile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to store federation profile: %w",
+			err)
+	}
+
+	return &profile, nil
+}
+
+// ApplyFederationProfile switches the federation to the profile with the
+// given name. All federation servers that aren't part of the profile are
+// removed, the servers of the profile are added and the sync configs of the
+// profile are upserted. Universe specific sync configs that aren't part of the
+// profile are left untouched. Once applied, the profile is marked as the
+// active one and a background sync with the new servers is started.
+func (f *FederationEnvoy) ApplyFederationProfile(ctx context.Context,
+	name string) error {
+
+	if f.cfg.FederationProfiles == nil {
+		return fmt.Errorf("federation profiles not supported")
+	}
+
+	profile, err := f.cfg.FederationProfiles.FetchFederationProfile(
+		ctx, name,
+	)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Applying federation profile %v, num_servers=%v", name,
+		len(profile.Servers))
+
+	currentServers, err := f.cfg.FederationDB.UniverseServers(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to fetch federation servers: %w", err)
+	}
+
+	hostSet := func(addrs []ServerAddr) fn.Set[string] {
+		return fn.NewSet(fn.Map(addrs, func(a ServerAddr) string {
+			return a.HostStr()
+		})...)
+	}
+	currentHosts := hostSet(currentServers)
+	profileHosts := hostSet(profile.Servers)
+
+	serversToDel := fn.Filter(currentServers, func(a ServerAddr) bool {
+		return !profileHosts.Contains(a.HostStr())
+	})
+	serversToAdd := fn.Filter(profile.Servers, func(a ServerAddr) bool {
+		return !currentHosts.Contains(a.HostStr())
+	})
+
+	// The proof sync log entries of a server need to be removed before the
+	// server itself can be removed, because of a foreign key constraint.
+	if len(serversToDel) > 0 {
+		err := f.cfg.FederationDB.DeleteProofsSyncLogEntries(
+			ctx, serversToDel...,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to delete proof sync log "+
+				"entries: %w", err)
+		}
+
+		err = f.cfg.FederationDB.RemoveServers(ctx, serversToDel...)
+		if err != nil {
+			return fmt.Errorf("unable to remove federation "+
+				"servers: %w", err)
+		}
+	}
+
+	if len(serversToAdd) > 0 {
+		err := f.cfg.FederationDB.AddServers(ctx, serversToAdd...)
+		if err != nil {
+			return fmt.Errorf("unable to add federation servers: "+
+				"%w", err)
+		}
+	}
+
+	err = f.cfg.FederationDB.UpsertFederationSyncConfig(
+		ctx, profile.GlobalSyncConfigs, profile.UniSyncConfigs,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to update federation sync configs: "+
+			"%w", err)
+	}
+
+	err = f.cfg.FederationProfiles.SetActiveFederationProfile(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	// We don't want to block the caller until the new servers are synced,
+	// so the sync is done in the background.
+	if len(serversToAdd) > 0 {
+		f.Wg.Add(1)
+		go func() {
+			defer f.Wg.Done()
+
+			if err := f.SyncServers(serversToAdd); err != nil {
+				log.Warnf("Unable to sync with federation "+
+					"profile servers: %v", err)
+			}
+		}()
+	}
+
+	return nil
+}
+
+// ListFederationProfiles returns all stored federation profiles, sorted by
+// name.
+func (f *FederationEnvoy) ListFederationProfiles(
+	ctx context.Context) ([]*FederationProfile, error) {
+
+	if f.cfg.FederationProfiles == nil {
+		return nil, fmt.Errorf("federation profiles not supported")
+	}
+
+	return f.cfg.FederationProfiles.ListFederationProfiles(ctx)
+}
+
+// DeleteFederationProfile deletes the federation profile with the given name.
+// The current federation servers and sync configs are left untouched, even if
+// the profile is the active one.
+func (f *FederationEnvoy) DeleteFederationProfile(ctx context.Context,
+	name string) error {
+
+	if f.cfg.FederationProfiles == nil {
+		return fmt.Errorf("federation profiles not supported")
+	}
+
+	return f.cfg.FederationProfiles.DeleteFederationProfile(ctx, name)
+}
+
 // SyncConfigs is a set of configs that are used to control which universes to
 // synchronize with the federation.
 type SyncConfigs struct {
@@ -919,15 +1380,26 @@ type SyncConfigs struct {
 
 	// UniSyncConfigs are the universe specific configs.
 	UniSyncConfigs []*FedUniSyncConfig
+
+	// AssetFilter is an optional set of allow and deny lists by asset ID
+	// and group key. A universe that doesn't pass the filter is neither
+	// inserted nor exported, regardless of the other configs.
+	AssetFilter *FedAssetFilter
 }
 
 // IsSyncInsertEnabled returns true if the given universe is configured to allow
 // insert (into this server) synchronization with the federation.
 func (s *SyncConfigs) IsSyncInsertEnabled(id Identifier) bool {
+	// Universes that are filtered out by asset ID or group key are never
+	// synced.
+	if !s.AssetFilter.IsAllowed(id) {
+		return false
+	}
+
 	// Check for universe specific config. This takes precedence over the
 	// global config.
 	for _, cfg := range s.UniSyncConfigs {
-		if cfg.UniverseID == id {
+		if cfg.UniverseID.String() == id.String() {
 			return cfg.AllowSyncInsert
 		}
 	}
@@ -945,10 +1417,16 @@ func (s *SyncConfigs) IsSyncInsertEnabled(id Identifier) bool {
 // IsSyncExportEnabled returns true if the given universe is configured to allow
 // export (from this server) synchronization with the federation.
 func (s *SyncConfigs) IsSyncExportEnabled(id Identifier) bool {
+	// Universes that are filtered out by asset ID or group key are never
+	// synced.
+	if !s.AssetFilter.IsAllowed(id) {
+		return false
+	}
+
 	// Check for universe specific config. This takes precedence over the
 	// global config.
 	for _, cfg := range s.UniSyncConfigs {
-		if cfg.UniverseID == id {
+		if cfg.UniverseID.String() == id.String() {
 			return cfg.AllowSyncExport
 		}
 	}
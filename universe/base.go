@@ -48,6 +48,11 @@ type ArchiveConfig struct {
 	// lookup interface that is required to validate proofs.
 	ChainLookupGenerator proof.ChainLookupGenerator
 
+	// UnconfirmedAnchors is the optional set of anchor transactions that
+	// were re-organized out of the chain. Proof leaves anchored in one of
+	// those transactions are returned as unconfirmed.
+	UnconfirmedAnchors *UnconfirmedAnchors
+
 	// TODO(roasbeef): query re genesis asset known?
 
 	// TODO(roasbeef): load all at once, or lazy load dynamic?
@@ -218,6 +223,30 @@ func (a *Archive) MultiverseRoot(ctx context.Context, proofType ProofType,
 	return fn.Some(multiverseRoot), nil
 }
 
+// CheckMultiverseInvariants verifies that the issuance and transfer
+// multiverses are consistent with their universes and returns all found
+// inconsistencies.
+func (a *Archive) CheckMultiverseInvariants(
+	ctx context.Context) ([]MultiverseInconsistency, error) {
+
+	var inconsistencies []MultiverseInconsistency
+	for _, proofType := range []ProofType{
+		ProofTypeIssuance, ProofTypeTransfer,
+	} {
+
+		found, err := CheckMultiverseInvariants(
+			ctx, a.cfg.Multiverse, proofType,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		inconsistencies = append(inconsistencies, found...)
+	}
+
+	return inconsistencies, nil
+}
+
 // UpsertProofLeaf attempts to upsert a proof for an asset issuance or transfer
 // event. This method will return an error if the passed proof is invalid. If
 // the leaf is already known, then no action is taken and the existing
@@ -245,6 +274,12 @@ func (a *Archive) UpsertProofLeaf(ctx context.Context, id Identifier,
 		return nil, err
 	}
 
+	// We can't store a leaf with extensions we're required to understand
+	// but don't.
+	if err := leaf.Extensions.Validate(); err != nil {
+		return nil, err
+	}
+
 	// We need to decode the new proof now.
 	var newProof proof.Proof
 	if err := newProof.Decode(bytes.NewReader(leaf.RawProof)); err != nil {
@@ -382,6 +417,32 @@ func (a *Archive) verifyIssuanceProof(ctx context.Context, id Identifier,
 	return assetSnapshot, nil
 }
 
+// VerifyProofLeaf fully verifies the proof of the given leaf, including its
+// chain anchor, without inserting it. This is used to verify leaves that were
+// imported from a trusted snapshot after the fact.
+func (a *Archive) VerifyProofLeaf(ctx context.Context, id Identifier,
+	key LeafKey, leaf *Leaf) error {
+
+	var leafProof proof.Proof
+	err := leafProof.Decode(bytes.NewReader(leaf.RawProof))
+	if err != nil {
+		return fmt.Errorf("unable to decode proof: %w", err)
+	}
+
+	prevAssetSnapshot, err := a.getPrevAssetSnapshot(
+		ctx, id, &leafProof.Asset, nil,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to fetch previous asset snapshot: %w",
+			err)
+	}
+
+	_, err = a.verifyIssuanceProof(
+		ctx, id, key, &leafProof, prevAssetSnapshot,
+	)
+	return err
+}
+
 // extractBatchDeps constructs map from leaf key to asset in a batch. This is
 // useful for when we're validating an asset state transition in a batch, and
 // the input asset it depends on is created in the batch.
@@ -432,6 +493,10 @@ func (a *Archive) UpsertProofLeafBatch(ctx context.Context,
 			return err
 		}
 
+		if err := item.Leaf.Extensions.Validate(); err != nil {
+			return err
+		}
+
 		// At this point, we'll need to decode the proof so we can
 		// partition it below.
 		var assetProof proof.Proof
@@ -453,6 +518,21 @@ func (a *Archive) UpsertProofLeafBatch(ctx context.Context,
 		}
 	}
 
+	// Verifying the group witnesses of grouped issuances is the most
+	// expensive part of the proof verification. We verify all of them in
+	// parallel upfront, so the full verification below can use the cached
+	// results.
+	genesisAssets := make([]*asset.Asset, 0, len(assetProofs))
+	for _, assetProof := range assetProofs {
+		genesisAssets = append(genesisAssets, &assetProof.Asset)
+	}
+	err := proof.DefaultGroupWitnessVerifier.VerifyBatch(
+		ctx, genesisAssets,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to verify group witnesses: %w", err)
+	}
+
 	batchDeps := extractBatchDeps(items)
 
 	verifyBatch := func(batchItems []*Item) error {
@@ -495,7 +575,7 @@ func (a *Archive) UpsertProofLeafBatch(ctx context.Context,
 		return nil
 	}
 
-	err := verifyBatch(anchorItems)
+	err = verifyBatch(anchorItems)
 	if err != nil {
 		return err
 	}
@@ -642,7 +722,49 @@ func (a *Archive) FetchProofLeaf(ctx context.Context, id Identifier,
 	log.Tracef("Retrieving Universe proof for: id=%v, base_key=%v",
 		id.StringForLog(), spew.Sdump(key))
 
-	return a.cfg.Multiverse.FetchProofLeaf(ctx, id, key)
+	proofs, err := a.cfg.Multiverse.FetchProofLeaf(ctx, id, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.cfg.UnconfirmedAnchors.markProofs(proofs), nil
+}
+
+// FetchProofLeaves returns the proof leaves for a batch of leaf keys of the
+// target universe, in the same order as the keys.
+//
+// NOTE: This is part of the BatchProofFetcher interface.
+func (a *Archive) FetchProofLeaves(ctx context.Context, id Identifier,
+	keys []LeafKey) ([]*Proof, error) {
+
+	log.Tracef("Retrieving %d Universe proofs for: id=%v", len(keys),
+		id.StringForLog())
+
+	proofs, err := a.cfg.Multiverse.FetchProofLeaves(ctx, id, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.cfg.UnconfirmedAnchors.markProofs(proofs), nil
+}
+
+// RegisterLeafSubscriber adds a new subscriber that is notified of every proof
+// leaf that is upserted into one of the given universes. If no universe is
+// given, the subscriber is notified of the leaves of all universes.
+func (a *Archive) RegisterLeafSubscriber(receiver *fn.EventReceiver[*LeafEvent],
+	ids ...Identifier) error {
+
+	log.Debugf("Registering leaf subscriber for %d universes", len(ids))
+
+	return a.cfg.Multiverse.RegisterLeafSubscriber(receiver, ids...)
+}
+
+// RemoveLeafSubscriber removes the given leaf subscriber and also stops it
+// from processing events.
+func (a *Archive) RemoveLeafSubscriber(
+	receiver *fn.EventReceiver[*LeafEvent]) error {
+
+	return a.cfg.Multiverse.RemoveLeafSubscriber(receiver)
 }
 
 type UniverseLeafKeysQuery struct {
@@ -650,6 +772,36 @@ type UniverseLeafKeysQuery struct {
 	SortDirection SortDirection
 	Offset        int32
 	Limit         int32
+
+	// MinBlockHeight, if set, restricts the query to leaves whose proof
+	// was anchored at or after this block height.
+	MinBlockHeight fn.Option[uint32]
+
+	// MaxBlockHeight, if set, restricts the query to leaves whose proof
+	// was anchored at or before this block height.
+	//
+	// NOTE: Leaves whose block height isn't known, for example because
+	// they were stored before block heights were tracked, match any block
+	// height range. A ranged query therefore never misses a leaf, but may
+	// return more leaves than requested.
+	MaxBlockHeight fn.Option[uint32]
+}
+
+// InBlockHeightRange returns true if the given block height is within the
+// block height range of the query.
+func (q UniverseLeafKeysQuery) InBlockHeightRange(height uint32) bool {
+	belowMin := fn.MapOptionZ(
+		q.MinBlockHeight, func(minHeight uint32) bool {
+			return height < minHeight
+		},
+	)
+	aboveMax := fn.MapOptionZ(
+		q.MaxBlockHeight, func(maxHeight uint32) bool {
+			return height > maxHeight
+		},
+	)
+
+	return !belowMin && !aboveMax
 }
 
 // UniverseLeafKeys returns the set of leaf keys known for the specified
@@ -662,6 +814,16 @@ func (a *Archive) UniverseLeafKeys(ctx context.Context,
 	return a.cfg.Multiverse.UniverseLeafKeys(ctx, q)
 }
 
+// FetchAssetMeta returns the meta reveal with the given hash of an asset that
+// has a leaf in one of the universes.
+func (a *Archive) FetchAssetMeta(ctx context.Context,
+	metaHash [asset.MetaHashLen]byte) (*proof.MetaReveal, error) {
+
+	log.Debugf("Retrieving asset meta: meta_hash=%x", metaHash[:])
+
+	return a.cfg.Multiverse.FetchAssetMeta(ctx, metaHash)
+}
+
 // MintingLeaves returns the set of minting leaves known for the specified base
 // universe.
 func (a *Archive) MintingLeaves(ctx context.Context,
@@ -691,3 +853,7 @@ func (a *Archive) DeleteRoot(ctx context.Context,
 
 	return uniStr, err
 }
+
+// A compile-time assertion to make sure Archive satisfies the
+// BatchProofFetcher interface.
+var _ BatchProofFetcher = (*Archive)(nil)
@@ -0,0 +1,490 @@
+package universe
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+const (
+	// serverListSigTag is the domain separation tag that is prefixed to
+	// every signed federation server list, so a server list signature
+	// can't be confused with a signature over any other message created
+	// with the same key.
+	serverListSigTag = "taproot-assets/federation-server-list"
+
+	// MaxGossipServers is the maximum number of servers a gossiped
+	// federation server list can contain.
+	MaxGossipServers = 100
+
+	// maxGossipHostLen is the maximum length of a server host string in a
+	// gossiped federation server list.
+	maxGossipHostLen = 255
+
+	// DefaultGossipMaxAge is the default maximum age of a gossiped server
+	// list, and the default maximum time since the last successful sync
+	// with a server for it to be considered alive.
+	DefaultGossipMaxAge = 24 * time.Hour
+
+	// serverListRefreshInterval is the interval after which our own signed
+	// server list is re-created. Until then, the cached list is handed out
+	// to avoid signing a new list for every gossip request.
+	serverListRefreshInterval = 10 * time.Minute
+)
+
+var (
+	// ErrServerListInvalid is returned if a gossiped federation server
+	// list is malformed or its signature doesn't match.
+	ErrServerListInvalid = errors.New("invalid federation server list")
+
+	// ErrServerListExpired is returned if a gossiped federation server
+	// list is older than the maximum accepted age or lies too far in the
+	// future.
+	ErrServerListExpired = errors.New("federation server list expired")
+
+	// ErrGossipDisabled is returned if federation gossip isn't enabled.
+	ErrGossipDisabled = errors.New("federation gossip disabled")
+)
+
+// ServerListFetcher is used to fetch the federation server list of a remote
+// universe server.
+type ServerListFetcher interface {
+	// FetchServerList returns the signed server list of the remote
+	// universe server.
+	FetchServerList(ctx context.Context) (*ServerList, error)
+
+	// Close is used to shutdown the active fetcher instance.
+	Close() error
+}
+
+// GossipServer is a single entry of a gossiped federation server list.
+type GossipServer struct {
+	// Addr is the address of the universe server.
+	Addr ServerAddr
+
+	// LastSeen is the last time the gossiping server successfully synced
+	// with the universe server.
+	LastSeen time.Time
+}
+
+// ServerList is the list of live federation servers a universe server
+// gossips to the members of its federation, signed with its identity key.
+type ServerList struct {
+	// Origin is the public address of the universe server that created
+	// the list. It is empty if the server doesn't know its public address.
+	Origin ServerAddr
+
+	// Servers is the list of federation servers the origin recently
+	// synced with.
+	Servers []GossipServer
+
+	// Timestamp is the time the list was signed.
+	Timestamp time.Time
+
+	// Sig is the signature of the origin's identity key over the list.
+	Sig *schnorr.Signature
+}
+
+// ServerListMsg returns the message that is signed for a federation server
+// list. The message commits to the origin, the signing time and each server
+// together with the time it was last seen.
+func ServerListMsg(origin string, timestamp time.Time,
+	servers []GossipServer) []byte {
+
+	var b bytes.Buffer
+	writeStr := func(s string) {
+		_ = binary.Write(&b, binary.BigEndian, uint16(len(s)))
+		b.WriteString(s)
+	}
+
+	b.WriteString(serverListSigTag)
+	writeStr(origin)
+	_ = binary.Write(&b, binary.BigEndian, timestamp.Unix())
+	_ = binary.Write(&b, binary.BigEndian, uint16(len(servers)))
+	for _, server := range servers {
+		writeStr(server.Addr.HostStr())
+		_ = binary.Write(&b, binary.BigEndian, server.LastSeen.Unix())
+	}
+
+	return b.Bytes()
+}
+
+// NewServerList creates a federation server list with the given origin and
+// servers and signs it with the passed signer.
+func NewServerList(ctx context.Context, signer ResponseSigner,
+	origin ServerAddr, servers []GossipServer,
+	timestamp time.Time) (*ServerList, error) {
+
+	if len(servers) > MaxGossipServers {
+		return nil, fmt.Errorf("%w: too many servers (%d > %d)",
+			ErrServerListInvalid, len(servers), MaxGossipServers)
+	}
+
+	// The signature only commits to full seconds, so we truncate all
+	// timestamps to make sure they round trip.
+	truncate := func(t time.Time) time.Time {
+		return time.Unix(t.Unix(), 0).UTC()
+	}
+	list := &ServerList{
+		Origin:    origin,
+		Servers:   make([]GossipServer, 0, len(servers)),
+		Timestamp: truncate(timestamp),
+	}
+	for _, server := range servers {
+		list.Servers = append(list.Servers, GossipServer{
+			Addr:     server.Addr,
+			LastSeen: truncate(server.LastSeen),
+		})
+	}
+
+	var err error
+	list.Sig, err = signer.SignResponse(ctx, ServerListMsg(
+		origin.HostStr(), list.Timestamp, list.Servers,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign server list: %w", err)
+	}
+
+	return list, nil
+}
+
+// Verify checks that the server list is well-formed and signed by the given
+// identity key of its origin, and that its timestamp isn't further away from
+// now than the given maximum age. A maximum age of zero disables the age check.
+func (l *ServerList) Verify(pubKey *btcec.PublicKey, now time.Time,
+	maxAge time.Duration) error {
+
+	if l.Sig == nil {
+		return fmt.Errorf("%w: missing signature", ErrServerListInvalid)
+	}
+
+	if len(l.Servers) > MaxGossipServers {
+		return fmt.Errorf("%w: too many servers (%d > %d)",
+			ErrServerListInvalid, len(l.Servers), MaxGossipServers)
+	}
+
+	hosts := append(
+		[]string{l.Origin.HostStr()},
+		fn.Map(l.Servers, func(s GossipServer) string {
+			return s.Addr.HostStr()
+		})...,
+	)
+	for _, host := range hosts {
+		if len(host) > maxGossipHostLen {
+			return fmt.Errorf("%w: host too long",
+				ErrServerListInvalid)
+		}
+	}
+
+	msgHash := sha256.Sum256(ServerListMsg(
+		l.Origin.HostStr(), l.Timestamp, l.Servers,
+	))
+	if !l.Sig.Verify(msgHash[:], pubKey) {
+		return fmt.Errorf("%w: signature mismatch",
+			ErrServerListInvalid)
+	}
+
+	if maxAge == 0 {
+		return nil
+	}
+
+	age := now.Sub(l.Timestamp)
+	if age > maxAge || age < -maxAge {
+		return fmt.Errorf("%w: signed at %v", ErrServerListExpired,
+			l.Timestamp)
+	}
+
+	return nil
+}
+
+// serverListCache caches our own signed federation server list.
+type serverListCache struct {
+	sync.Mutex
+
+	list *ServerList
+}
+
+// gossipEnabled returns true if we gossip server lists with the federation.
+func (f *FederationEnvoy) gossipEnabled() bool {
+	return f.cfg.GossipSigner != nil && f.cfg.NewListFetcher != nil
+}
+
+// gossipMaxAge returns the maximum age of gossiped server lists and of the
+// last sync with a server for it to be considered alive.
+func (f *FederationEnvoy) gossipMaxAge() time.Duration {
+	if f.cfg.GossipMaxAge == 0 {
+		return DefaultGossipMaxAge
+	}
+
+	return f.cfg.GossipMaxAge
+}
+
+// liveServers returns the federation servers we successfully synced with
+// within the maximum gossip age, most recently seen first.
+func (f *FederationEnvoy) liveServers(
+	ctx context.Context) ([]GossipServer, error) {
+
+	fedServers, err := f.cfg.FederationDB.UniverseServers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch federation servers: %w",
+			err)
+	}
+
+	syncTimes, err := f.cfg.FederationDB.ServerSyncTimes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch server sync times: %w",
+			err)
+	}
+
+	now := time.Now()
+	servers := make([]GossipServer, 0, len(fedServers))
+	for _, addr := range fedServers {
+		lastSeen, ok := syncTimes[addr.HostStr()]
+		if !ok || now.Sub(lastSeen) > f.gossipMaxAge() {
+			continue
+		}
+
+		servers = append(servers, GossipServer{
+			Addr:     NewServerAddrFromStr(addr.HostStr()),
+			LastSeen: lastSeen,
+		})
+	}
+
+	sort.SliceStable(servers, func(i, j int) bool {
+		return servers[i].LastSeen.After(servers[j].LastSeen)
+	})
+	if len(servers) > MaxGossipServers {
+		servers = servers[:MaxGossipServers]
+	}
+
+	return servers, nil
+}
+
+// LocalServerList returns our own signed list of live federation servers. The
+// list is cached and only re-created after the refresh interval, so it can be
+// handed out to every federation member that fetches it without signing a new
+// one each time.
+func (f *FederationEnvoy) LocalServerList(
+	ctx context.Context) (*ServerList, error) {
+
+	if !f.gossipEnabled() {
+		return nil, ErrGossipDisabled
+	}
+
+	f.localServerList.Lock()
+	defer f.localServerList.Unlock()
+
+	cached := f.localServerList.list
+	if cached != nil &&
+		time.Since(cached.Timestamp) < serverListRefreshInterval {
+
+		return cached, nil
+	}
+
+	servers, err := f.liveServers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := NewServerList(
+		ctx, f.cfg.GossipSigner, f.cfg.LocalAddr.UnwrapOr(ServerAddr{}),
+		servers, time.Now(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	f.localServerList.list = list
+
+	return list, nil
+}
+
+// gossipServerLists fetches the server lists of all federation members in
+// parallel and then handles the lists we received. Lists are only ever pulled
+// from the members we connect to ourselves, so we always know which member a
+// list was sent by.
+func (f *FederationEnvoy) gossipServerLists() {
+	if !f.gossipEnabled() {
+		return
+	}
+
+	ctx, cancel := f.WithCtxQuit()
+	defer cancel()
+
+	fedServers, err := f.tryFetchServers()
+	if err != nil {
+		log.Warnf("Unable to fetch federation servers: %v", err)
+		return
+	}
+
+	var (
+		remoteListsMtx sync.Mutex
+		remoteLists    = make(map[string]*ServerList)
+	)
+	fetchList := func(ctx context.Context, addr ServerAddr) error {
+		fetcher, err := f.cfg.NewListFetcher(addr)
+		if err != nil {
+			log.Warnf("Unable to connect to server(%v) for "+
+				"gossip: %v", addr.HostStr(), err)
+			return nil
+		}
+		defer fetcher.Close()
+
+		remoteList, err := fetcher.FetchServerList(ctx)
+		if err != nil {
+			log.Warnf("Unable to fetch server list of "+
+				"server(%v): %v", addr.HostStr(), err)
+			return nil
+		}
+
+		remoteListsMtx.Lock()
+		remoteLists[addr.HostStr()] = remoteList
+		remoteListsMtx.Unlock()
+
+		return nil
+	}
+
+	err = fn.ParSlice(ctx, fedServers, fetchList)
+	if err != nil {
+		log.Warnf("Unable to gossip with federation: %v", err)
+	}
+
+	for _, sender := range fedServers {
+		remoteList, ok := remoteLists[sender.HostStr()]
+		if !ok {
+			continue
+		}
+
+		err := f.handleServerList(remoteList, sender)
+		if err != nil {
+			log.Debugf("Unable to handle server list of "+
+				"server(%v): %v", sender.HostStr(), err)
+		}
+	}
+}
+
+// handleServerList is called for each server list fetched from the given
+// federation member. The list must originate from the member and be signed
+// with the identity key that was set for it. Servers of the list that were
+// recently seen alive are added to our federation, as long as it has fewer
+// members than the configured maximum and the servers are reachable.
+//
+// NOTE: This method MUST only be called from the syncer goroutine.
+func (f *FederationEnvoy) handleServerList(list *ServerList,
+	sender ServerAddr) error {
+
+	// We only take gossip from the members of our federation, so an
+	// arbitrary server can't make us add servers.
+	fedServers, err := f.tryFetchServers()
+	if err != nil {
+		return fmt.Errorf("unable to fetch federation servers: %w", err)
+	}
+	knownHosts := fn.NewSet(fn.Map(fedServers, func(a ServerAddr) string {
+		return a.HostStr()
+	})...)
+
+	senderHost := sender.HostStr()
+	member, err := fn.First(
+		fn.Map(fedServers, fn.Ptr[ServerAddr]),
+		func(a *ServerAddr) bool {
+			return a.HostStr() == senderHost
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("server list sender %v is not a federation "+
+			"member", senderHost)
+	}
+
+	// A member can only gossip its own list, and only with the identity
+	// key that was set for it.
+	if list.Origin.HostStr() != senderHost {
+		return fmt.Errorf("%w: origin %v doesn't match sender %v",
+			ErrServerListInvalid, list.Origin.HostStr(), senderHost)
+	}
+
+	identityKey, err := member.IdentityKey.UnwrapOrErr(fmt.Errorf(
+		"%w: no identity key set for %v", ErrServerListInvalid,
+		senderHost,
+	))
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	err = list.Verify(&identityKey, now, f.gossipMaxAge())
+	if err != nil {
+		return err
+	}
+
+	log.Debugf("Received federation server list with %d servers from %v",
+		len(list.Servers), senderHost)
+
+	maxServers := f.cfg.GossipMaxServers
+	if maxServers <= 0 || len(knownHosts) >= maxServers {
+		return nil
+	}
+
+	localHost := fn.MapOptionZ(f.cfg.LocalAddr, func(a ServerAddr) string {
+		return a.HostStr()
+	})
+
+	var newServers []ServerAddr
+	for _, server := range list.Servers {
+		if len(knownHosts) >= maxServers {
+			break
+		}
+
+		host := server.Addr.HostStr()
+		switch {
+		case host == "" || host == localHost:
+			continue
+
+		case knownHosts.Contains(host):
+			continue
+
+		// Servers the sender hasn't synced with recently are likely
+		// gone, so we don't bother adding them.
+		case now.Sub(server.LastSeen) > f.gossipMaxAge():
+			continue
+		}
+
+		// Before we add the server as a federation member, we check
+		// that we can actually connect to it and that it isn't
+		// ourselves.
+		addr := NewServerAddrFromStr(host)
+		if f.cfg.ServerChecker != nil {
+			if err := f.cfg.ServerChecker(addr); err != nil {
+				log.Debugf("Not adding gossiped server %v: %v",
+					host, err)
+				continue
+			}
+		}
+
+		knownHosts.Add(host)
+		newServers = append(newServers, addr)
+	}
+
+	if len(newServers) == 0 {
+		return nil
+	}
+
+	log.Infof("Adding %d federation servers learned from %v",
+		len(newServers), senderHost)
+
+	err = f.AddServer(newServers...)
+	if err != nil && !errors.Is(err, ErrDuplicateUniverse) {
+		return fmt.Errorf("unable to add gossiped servers: %w", err)
+	}
+
+	return nil
+}
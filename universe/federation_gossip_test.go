@@ -0,0 +1,339 @@
+package universe
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// mockFederationLog is a FederationDB that only keeps track of the federation
+// servers and their sync times.
+type mockFederationLog struct {
+	FederationDB
+
+	servers   []ServerAddr
+	syncTimes map[string]time.Time
+}
+
+// UniverseServers returns the set of servers in the federation.
+func (m *mockFederationLog) UniverseServers(
+	context.Context) ([]ServerAddr, error) {
+
+	return m.servers, nil
+}
+
+// AddServers adds a slice of servers to the federation.
+func (m *mockFederationLog) AddServers(_ context.Context,
+	addrs ...ServerAddr) error {
+
+	m.servers = append(m.servers, addrs...)
+	return nil
+}
+
+// ServerSyncTimes returns the last time we synced with each server.
+func (m *mockFederationLog) ServerSyncTimes(
+	context.Context) (map[string]time.Time, error) {
+
+	return m.syncTimes, nil
+}
+
+// QueryFederationSyncConfigs returns no sync configs.
+func (m *mockFederationLog) QueryFederationSyncConfigs(
+	context.Context) ([]*FedGlobalSyncConfig, []*FedUniSyncConfig, error) {
+
+	return nil, nil, nil
+}
+
+// mockSyncer is a Syncer that fails every sync.
+type mockSyncer struct{}
+
+// SyncUniverse always returns an error.
+func (m *mockSyncer) SyncUniverse(context.Context, ServerAddr, SyncType,
+	SyncConfigs, ...Identifier) ([]AssetSyncDiff, error) {
+
+	return nil, fmt.Errorf("unreachable")
+}
+
+// SyncUniverseStream always returns an error.
+func (m *mockSyncer) SyncUniverseStream(context.Context, ServerAddr, SyncType,
+	SyncConfigs, chan<- SyncEvent, ...Identifier) ([]AssetSyncDiff, error) {
+
+	return nil, fmt.Errorf("unreachable")
+}
+
+// TestServerList tests that federation server lists only verify for the
+// signed servers, liveness timestamps and within the maximum age.
+func TestServerList(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	signer := &mockResponseSigner{
+		privKey: test.RandPrivKey(t),
+	}
+	pubKey := signer.privKey.PubKey()
+	now := time.Now()
+	origin := NewServerAddrFromStr("origin.example.com:10029")
+	servers := []GossipServer{{
+		Addr:     NewServerAddrFromStr("a.example.com:10029"),
+		LastSeen: now.Add(-time.Minute),
+	}, {
+		Addr:     NewServerAddrFromStr("b.example.com:10029"),
+		LastSeen: now.Add(-time.Hour),
+	}}
+
+	list, err := NewServerList(ctx, signer, origin, servers, now)
+	require.NoError(t, err)
+	require.NoError(t, list.Verify(pubKey, now, time.Hour))
+
+	// Tampering with a server, its liveness timestamp or the origin
+	// invalidates the signature.
+	tampered := *list
+	tampered.Servers = fn.CopySlice(list.Servers)
+	tampered.Servers[1].LastSeen = now
+	require.ErrorIs(
+		t, tampered.Verify(pubKey, now, 0), ErrServerListInvalid,
+	)
+
+	tampered.Servers = append(fn.CopySlice(list.Servers), GossipServer{
+		Addr:     NewServerAddrFromStr("evil.example.com:10029"),
+		LastSeen: now,
+	})
+	require.ErrorIs(
+		t, tampered.Verify(pubKey, now, 0), ErrServerListInvalid,
+	)
+
+	tampered = *list
+	tampered.Origin = NewServerAddrFromStr("evil.example.com:10029")
+	require.ErrorIs(
+		t, tampered.Verify(pubKey, now, 0), ErrServerListInvalid,
+	)
+
+	// A list signed by another key doesn't verify.
+	require.ErrorIs(
+		t, list.Verify(test.RandPubKey(t), now, 0),
+		ErrServerListInvalid,
+	)
+
+	// Stale lists are rejected.
+	require.ErrorIs(
+		t, list.Verify(pubKey, now.Add(2*time.Hour), time.Hour),
+		ErrServerListExpired,
+	)
+	require.NoError(t, list.Verify(pubKey, now.Add(2*time.Hour), 0))
+}
+
+// mockListFetcher is a ServerListFetcher that returns a fixed server list.
+type mockListFetcher struct {
+	list *ServerList
+}
+
+// FetchServerList returns the fixed server list.
+func (m *mockListFetcher) FetchServerList(
+	context.Context) (*ServerList, error) {
+
+	if m.list == nil {
+		return nil, fmt.Errorf("gossip disabled")
+	}
+
+	return m.list, nil
+}
+
+// Close is a no-op.
+func (m *mockListFetcher) Close() error {
+	return nil
+}
+
+// TestHandleServerList tests that servers learned through gossip are only
+// added if the list was fetched from a federation member, originates from it
+// and is signed with its identity key, the servers were recently seen alive
+// and the federation is below its maximum size.
+func TestHandleServerList(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Now()
+	memberSigner := &mockResponseSigner{
+		privKey: test.RandPrivKey(t),
+	}
+	member := NewServerAddrFromStr("member.example.com:10029")
+	member.IdentityKey = fn.Some(*memberSigner.privKey.PubKey())
+	noKeyMember := NewServerAddrFromStr("nokey.example.com:10029")
+	localAddr := NewServerAddrFromStr("local.example.com:10029")
+	unreachable := NewServerAddrFromStr("down.example.com:10029")
+
+	fedLog := &mockFederationLog{
+		servers: []ServerAddr{member, noKeyMember},
+		syncTimes: map[string]time.Time{
+			member.HostStr(): now,
+		},
+	}
+	envoy := NewFederationEnvoy(FederationConfig{
+		FederationDB:     fedLog,
+		UniverseSyncer:   &mockSyncer{},
+		LocalAddr:        fn.Some(localAddr),
+		GossipMaxServers: 4,
+		ServerChecker: func(addr ServerAddr) error {
+			if addr.HostStr() == unreachable.HostStr() {
+				return fmt.Errorf("unreachable")
+			}
+
+			return nil
+		},
+	})
+
+	newList := func(signer ResponseSigner, origin ServerAddr,
+		servers ...GossipServer) *ServerList {
+
+		list, err := NewServerList(ctx, signer, origin, servers, now)
+		require.NoError(t, err)
+
+		return list
+	}
+	gossipServer := func(host string, age time.Duration) GossipServer {
+		return GossipServer{
+			Addr:     NewServerAddrFromStr(host),
+			LastSeen: now.Add(-age),
+		}
+	}
+	fedHosts := func() []string {
+		return fn.Map(fedLog.servers, func(a ServerAddr) string {
+			return a.HostStr()
+		})
+	}
+	newServer := gossipServer("a.example.com:10029", 0)
+
+	// Lists of servers that aren't federation members are ignored.
+	stranger := NewServerAddrFromStr("stranger.example.com:10029")
+	err := envoy.handleServerList(
+		newList(memberSigner, stranger, newServer), stranger,
+	)
+	require.ErrorContains(t, err, "not a federation member")
+
+	// A member can't gossip in the name of another member.
+	err = envoy.handleServerList(
+		newList(memberSigner, member, newServer), noKeyMember,
+	)
+	require.ErrorIs(t, err, ErrServerListInvalid)
+
+	// Lists of members without an identity key are rejected.
+	err = envoy.handleServerList(
+		newList(memberSigner, noKeyMember, newServer), noKeyMember,
+	)
+	require.ErrorIs(t, err, ErrServerListInvalid)
+
+	// Lists that aren't signed with the identity key of the member are
+	// rejected.
+	otherSigner := &mockResponseSigner{
+		privKey: test.RandPrivKey(t),
+	}
+	err = envoy.handleServerList(
+		newList(otherSigner, member, newServer), member,
+	)
+	require.ErrorIs(t, err, ErrServerListInvalid)
+	require.Len(t, fedLog.servers, 2)
+
+	// Ourselves, known, unreachable and stale servers are skipped. Only
+	// two new servers are added, as the federation would otherwise exceed
+	// its maximum size.
+	err = envoy.handleServerList(newList(
+		memberSigner, member,
+		gossipServer(localAddr.HostStr(), 0),
+		gossipServer(member.HostStr(), 0),
+		gossipServer(unreachable.HostStr(), 0),
+		gossipServer("stale.example.com:10029", 2*DefaultGossipMaxAge),
+		gossipServer("a.example.com:10029", time.Minute),
+		gossipServer("b.example.com:10029", time.Minute),
+		gossipServer("c.example.com:10029", time.Minute),
+	), member)
+	require.NoError(t, err)
+	require.Equal(
+		t, []string{
+			member.HostStr(), noKeyMember.HostStr(),
+			"a.example.com:10029", "b.example.com:10029",
+		}, fedHosts(),
+	)
+
+	// Our own list only contains the servers we recently synced with.
+	envoy.cfg.GossipSigner = memberSigner
+	envoy.cfg.NewListFetcher = func(ServerAddr) (ServerListFetcher,
+		error) {
+
+		return &mockListFetcher{}, nil
+	}
+	localList, err := envoy.LocalServerList(ctx)
+	require.NoError(t, err)
+	require.NoError(t, localList.Verify(
+		memberSigner.privKey.PubKey(), now, time.Hour,
+	))
+	require.Equal(t, localAddr.HostStr(), localList.Origin.HostStr())
+	require.Len(t, localList.Servers, 1)
+	require.Equal(t, member.HostStr(), localList.Servers[0].Addr.HostStr())
+}
+
+// TestGossipServerLists tests that the server lists are fetched from the
+// federation members, and that only the lists that originate from the member
+// they were fetched from are accepted.
+func TestGossipServerLists(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Now()
+	signer := &mockResponseSigner{
+		privKey: test.RandPrivKey(t),
+	}
+	member := NewServerAddrFromStr("member.example.com:10029")
+	member.IdentityKey = fn.Some(*signer.privKey.PubKey())
+	otherMember := NewServerAddrFromStr("other.example.com:10029")
+	otherMember.IdentityKey = fn.Some(*signer.privKey.PubKey())
+
+	fedLog := &mockFederationLog{
+		servers: []ServerAddr{member, otherMember},
+	}
+
+	// The member hands out its own list, while the other member replays
+	// the list of the member, which must be ignored even though it's
+	// signed with the same key.
+	memberList, err := NewServerList(ctx, signer, member, []GossipServer{{
+		Addr:     NewServerAddrFromStr("a.example.com:10029"),
+		LastSeen: now,
+	}}, now)
+	require.NoError(t, err)
+	replayedList, err := NewServerList(
+		ctx, signer, member, []GossipServer{{
+			Addr:     NewServerAddrFromStr("b.example.com:10029"),
+			LastSeen: now,
+		}}, now,
+	)
+	require.NoError(t, err)
+
+	envoy := NewFederationEnvoy(FederationConfig{
+		FederationDB:     fedLog,
+		UniverseSyncer:   &mockSyncer{},
+		GossipMaxServers: 10,
+		GossipSigner:     signer,
+		NewListFetcher: func(addr ServerAddr) (ServerListFetcher,
+			error) {
+
+			if addr.HostStr() == member.HostStr() {
+				return &mockListFetcher{list: memberList}, nil
+			}
+
+			return &mockListFetcher{list: replayedList}, nil
+		},
+	})
+
+	envoy.gossipServerLists()
+	require.Equal(
+		t, []string{
+			member.HostStr(), otherMember.HostStr(),
+			"a.example.com:10029",
+		}, fn.Map(fedLog.servers, func(a ServerAddr) string {
+			return a.HostStr()
+		}),
+	)
+}
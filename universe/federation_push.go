@@ -0,0 +1,116 @@
+package universe
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+// ProofPushResult is the result of pushing a proof leaf to a single federation
+// server.
+type ProofPushResult struct {
+	// Server is the federation server the proof leaf was pushed to.
+	Server ServerAddr
+
+	// Err is the error the push failed with, or nil if the server accepted
+	// the proof leaf.
+	Err error
+}
+
+// PushProofLeaf immediately pushes the proof leaf with the given key from the
+// local universe to the given federation servers, or to all of them if no
+// servers are given. Unlike the regular pushes, this ignores the federation
+// sync configs, as the push was explicitly requested. The pushes are tracked
+// in the proof sync log, so a push that fails stays pending and is retried
+// with the next sync. The result of each push is returned in the order of the
+// target servers.
+func (f *FederationEnvoy) PushProofLeaf(ctx context.Context, id Identifier,
+	key LeafKey, servers ...ServerAddr) ([]ProofPushResult, error) {
+
+	fedServers, err := f.cfg.FederationDB.UniverseServers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch federation servers: %w",
+			err)
+	}
+
+	// Only members of the federation can be selected, so we don't end up
+	// with sync log entries for servers we don't know about. A server can
+	// be selected by its host or its ID.
+	if len(servers) == 0 {
+		servers = fedServers
+	}
+	targets := make([]ServerAddr, 0, len(servers))
+	for _, server := range servers {
+		member, found := federationMember(fedServers, server)
+		if !found {
+			return nil, fmt.Errorf("server %v (id=%d) is not part "+
+				"of the federation", server.HostStr(),
+				server.ID)
+		}
+
+		targets = append(targets, member)
+	}
+	servers = targets
+	if len(servers) == 0 {
+		return nil, fmt.Errorf("no federation servers to push to")
+	}
+
+	if f.cfg.LocalDiffEngine == nil {
+		return nil, fmt.Errorf("local universe not available")
+	}
+	proofs, err := f.cfg.LocalDiffEngine.FetchProofLeaf(ctx, id, key)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch proof leaf: %w", err)
+	}
+	if len(proofs) == 0 || proofs[0].Leaf == nil {
+		return nil, fmt.Errorf("proof leaf not found in universe %v",
+			id.String())
+	}
+	leaf := proofs[0].Leaf
+
+	log.Infof("Force pushing proof leaf to %d federation servers, "+
+		"universe=%v", len(servers), id.String())
+
+	results := fn.Map(servers, func(a ServerAddr) *ProofPushResult {
+		return &ProofPushResult{
+			Server: a,
+		}
+	})
+
+	// A failed push is reported in the result of its server, so it doesn't
+	// abort the pushes to the other servers.
+	pushLeaf := func(ctx context.Context, result *ProofPushResult) error {
+		result.Err = f.pushProofToServerLogged(
+			ctx, id, key, leaf, result.Server,
+		)
+		return nil
+	}
+	if err := fn.ParSlice(ctx, results, pushLeaf); err != nil {
+		return nil, fmt.Errorf("unable to push proof leaf: %w", err)
+	}
+
+	return fn.Map(results, func(r *ProofPushResult) ProofPushResult {
+		return *r
+	}), nil
+}
+
+// federationMember returns the federation server that matches the given
+// server, either by its ID or by its host.
+func federationMember(fedServers []ServerAddr,
+	server ServerAddr) (ServerAddr, bool) {
+
+	for _, member := range fedServers {
+		if server.ID != 0 && member.ID == server.ID {
+			return member, true
+		}
+
+		if server.HostStr() != "" &&
+			member.HostStr() == server.HostStr() {
+
+			return member, true
+		}
+	}
+
+	return ServerAddr{}, false
+}
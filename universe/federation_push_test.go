@@ -0,0 +1,154 @@
+package universe
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// mockProofSyncLog is a FederationDB that records the proof sync log updates
+// on top of the federation servers.
+type mockProofSyncLog struct {
+	mockFederationLog
+
+	mu       sync.Mutex
+	statuses map[string]ProofSyncStatus
+}
+
+// UpsertFederationProofSyncLog records the sync status for the given server.
+func (m *mockProofSyncLog) UpsertFederationProofSyncLog(_ context.Context,
+	_ Identifier, _ LeafKey, addr ServerAddr, _ SyncDirection,
+	syncStatus ProofSyncStatus, _ bool) (int64, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.statuses[addr.HostStr()] = syncStatus
+	return 1, nil
+}
+
+// mockLeafDiffEngine is a DiffEngine that serves a single proof leaf.
+type mockLeafDiffEngine struct {
+	DiffEngine
+
+	leaf *Leaf
+}
+
+// FetchProofLeaf returns the proof leaf of the engine.
+func (m *mockLeafDiffEngine) FetchProofLeaf(context.Context, Identifier,
+	LeafKey) ([]*Proof, error) {
+
+	if m.leaf == nil {
+		return nil, ErrNoUniverseProofFound
+	}
+
+	return []*Proof{{
+		Leaf: m.leaf,
+	}}, nil
+}
+
+// mockRegistrar is a Registrar that optionally rejects all proof leaves.
+type mockRegistrar struct {
+	err error
+}
+
+// UpsertProofLeaf returns the error of the registrar.
+func (m *mockRegistrar) UpsertProofLeaf(context.Context, Identifier, LeafKey,
+	*Leaf) (*Proof, error) {
+
+	return nil, m.err
+}
+
+// Close is a no-op.
+func (m *mockRegistrar) Close() error {
+	return nil
+}
+
+// TestPushProofLeaf tests that a single proof leaf can be force pushed to
+// selected federation servers and that the result is reported per server.
+func TestPushProofLeaf(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	goodServer := NewServerAddr(1, "good.example.com:10029")
+	badServer := NewServerAddr(2, "bad.example.com:10029")
+	fedLog := &mockProofSyncLog{
+		mockFederationLog: mockFederationLog{
+			servers: []ServerAddr{goodServer, badServer},
+		},
+		statuses: make(map[string]ProofSyncStatus),
+	}
+	diffEngine := &mockLeafDiffEngine{}
+
+	envoy := NewFederationEnvoy(FederationConfig{
+		FederationDB: fedLog,
+		NewRemoteRegistrar: func(addr ServerAddr) (Registrar, error) {
+			if addr.HostStr() == badServer.HostStr() {
+				return &mockRegistrar{
+					err: fmt.Errorf("server unavailable"),
+				}, nil
+			}
+
+			return &mockRegistrar{}, nil
+		},
+		LocalDiffEngine: diffEngine,
+	})
+
+	id := Identifier{
+		AssetID:   asset.RandID(t),
+		ProofType: ProofTypeTransfer,
+	}
+	key := LeafKey{
+		OutPoint:  test.RandOp(t),
+		ScriptKey: fn.Ptr(asset.RandScriptKey(t)),
+	}
+
+	// A leaf that doesn't exist locally can't be pushed.
+	_, err := envoy.PushProofLeaf(ctx, id, key)
+	require.ErrorIs(t, err, ErrNoUniverseProofFound)
+
+	diffEngine.leaf = &Leaf{
+		Amt: 1,
+	}
+
+	// Servers outside the federation are rejected.
+	_, err = envoy.PushProofLeaf(
+		ctx, id, key, NewServerAddrFromStr("other.example.com:10029"),
+	)
+	require.ErrorContains(t, err, "not part of the federation")
+
+	// Without selected servers, the leaf is pushed to the whole
+	// federation. The failed push stays pending in the sync log.
+	results, err := envoy.PushProofLeaf(ctx, id, key)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Equal(t, goodServer.HostStr(), results[0].Server.HostStr())
+	require.NoError(t, results[0].Err)
+	require.Equal(t, badServer.HostStr(), results[1].Server.HostStr())
+	require.ErrorContains(t, results[1].Err, "server unavailable")
+
+	require.Equal(t, map[string]ProofSyncStatus{
+		goodServer.HostStr(): ProofSyncStatusComplete,
+		badServer.HostStr():  ProofSyncStatusPending,
+	}, fedLog.statuses)
+
+	// Only the selected server is pushed to.
+	results, err = envoy.PushProofLeaf(ctx, id, key, goodServer)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.NoError(t, results[0].Err)
+
+	// A server can also be selected by its ID only.
+	results, err = envoy.PushProofLeaf(ctx, id, key, NewServerAddr(2, ""))
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, badServer, results[0].Server)
+	require.ErrorContains(t, results[0].Err, "server unavailable")
+}
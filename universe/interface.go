@@ -1,6 +1,7 @@
 package universe
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
@@ -16,6 +17,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/mssmt"
 	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightningnetwork/lnd/tor"
 )
 
 var (
@@ -33,6 +35,27 @@ var (
 	// ErrNoUniverseProofFound is returned when a user attempts to look up
 	// a key in the universe that actually points to the empty leaf.
 	ErrNoUniverseProofFound = fmt.Errorf("no universe proof found")
+
+	// ErrNoUniverseMeta is returned when no asset with a leaf in one of the
+	// universes has the requested meta hash.
+	ErrNoUniverseMeta = fmt.Errorf("no universe asset meta found")
+
+	// ErrNoRemoteRoot is returned when no root for a universe on a remote
+	// server has been cached yet.
+	ErrNoRemoteRoot = fmt.Errorf("no remote root cached")
+
+	// ErrNoSyncCheckpoint is returned when no checkpoint of a streaming
+	// sync of a universe on a remote server has been stored.
+	ErrNoSyncCheckpoint = fmt.Errorf("no sync checkpoint stored")
+
+	// ErrNoFederationProfile is returned when a federation profile with the
+	// given name doesn't exist.
+	ErrNoFederationProfile = fmt.Errorf("no federation profile found")
+
+	// ErrInconsistentUniID is returned by the strict universe ID
+	// constructor and validation functions if the raw arguments of a
+	// universe ID contradict each other or the leaf they belong to.
+	ErrInconsistentUniID = fmt.Errorf("inconsistent universe ID")
 )
 
 const (
@@ -166,6 +189,77 @@ func NewUniIDFromRawArgs(assetIDBytes []byte, groupKeyBytes []byte,
 	}, nil
 }
 
+// NewUniIDFromRawArgsStrict creates a new universe ID from the raw arguments,
+// like NewUniIDFromRawArgs. But instead of silently preferring the group key,
+// it returns ErrInconsistentUniID if the raw arguments are malformed, which
+// surfaces data corruption instead of masking it. The asset ID bytes are still
+// allowed to be set together with the group key bytes, as the root of a
+// grouped universe stores the ID of one of the assets in the group.
+func NewUniIDFromRawArgsStrict(assetIDBytes []byte, groupKeyBytes []byte,
+	proofTypeStr string) (Identifier, error) {
+
+	proofType, err := ParseStrProofType(proofTypeStr)
+	if err != nil {
+		return Identifier{}, err
+	}
+
+	if proofType == ProofTypeUnspecified {
+		return Identifier{}, fmt.Errorf("%w: proof type unspecified",
+			ErrInconsistentUniID)
+	}
+
+	// Unlike the non-strict variant, we don't silently truncate or pad
+	// asset ID bytes of an unexpected length.
+	if len(assetIDBytes) != 0 && len(assetIDBytes) != sha256.Size {
+		return Identifier{}, fmt.Errorf("%w: invalid asset ID length "+
+			"%d", ErrInconsistentUniID, len(assetIDBytes))
+	}
+
+	if len(groupKeyBytes) == 0 && len(assetIDBytes) == 0 {
+		return Identifier{}, fmt.Errorf("%w: asset ID bytes and "+
+			"group key bytes are both nil", ErrInconsistentUniID)
+	}
+
+	return NewUniIDFromRawArgs(assetIDBytes, groupKeyBytes, proofTypeStr)
+}
+
+// ValidateUniIDLeaf makes sure the given universe ID is consistent with the
+// asset of one of its leaves. The proof type must match the asset, and the
+// asset must either be part of the universe's asset group or have the
+// universe's asset ID.
+func ValidateUniIDLeaf(uniID Identifier, a *asset.Asset) error {
+	if err := ValidateProofUniverseType(a, uniID); err != nil {
+		return fmt.Errorf("%w: %w", ErrInconsistentUniID, err)
+	}
+
+	switch {
+	case uniID.GroupKey != nil:
+		if a.GroupKey == nil {
+			return fmt.Errorf("%w: leaf asset %v of group "+
+				"universe %v isn't grouped",
+				ErrInconsistentUniID, a.ID(),
+				uniID.StringForLog())
+		}
+
+		// The group key of a universe ID might be parsed from its
+		// x-only representation, so we compare the x-only keys.
+		leafKey := schnorr.SerializePubKey(&a.GroupKey.GroupPubKey)
+		uniKey := schnorr.SerializePubKey(uniID.GroupKey)
+		if !bytes.Equal(leafKey, uniKey) {
+			return fmt.Errorf("%w: leaf asset %v isn't part of "+
+				"group universe %v", ErrInconsistentUniID,
+				a.ID(), uniID.StringForLog())
+		}
+
+	case a.ID() != uniID.AssetID:
+		return fmt.Errorf("%w: leaf asset %v doesn't match universe "+
+			"%v", ErrInconsistentUniID, a.ID(),
+			uniID.StringForLog())
+	}
+
+	return nil
+}
+
 // parseGroupKey parses a group key from bytes, which can be in either the
 // Schnorr or Compressed format.
 func parseGroupKey(scriptKey []byte) (*btcec.PublicKey, error) {
@@ -222,6 +316,12 @@ type Leaf struct {
 
 	// Amt is the amount of units associated with the coin.
 	Amt uint64
+
+	// Extensions is the TLV extension space of the leaf, which carries
+	// additional metadata about the leaf. The extensions aren't part of
+	// the leaf's MS-SMT node, so they don't change the universe root and
+	// federation members that don't know them still agree on the tree.
+	Extensions LeafExtensions
 }
 
 // SmtLeafNode returns the SMT leaf node for the given leaf.
@@ -294,6 +394,11 @@ type Proof struct {
 	// MultiverseInclusionProof is the inclusion proof for the asset within
 	// the multiverse tree.
 	MultiverseInclusionProof *mssmt.Proof
+
+	// Unconfirmed is true if the anchor transaction of the leaf was
+	// re-organized out of the chain and wasn't included in a new block
+	// yet.
+	Unconfirmed bool
 }
 
 // VerifyRoot verifies that the inclusion proof for the root node matches the
@@ -416,6 +521,12 @@ type MultiverseArchive interface {
 	FetchProofLeaf(ctx context.Context, id Identifier,
 		key LeafKey) ([]*Proof, error)
 
+	// FetchProofLeaves returns the proof leaves for a batch of leaf keys
+	// of the target universe, in the same order as the keys. This is
+	// considerably faster than fetching the proofs one key at a time.
+	FetchProofLeaves(ctx context.Context, id Identifier,
+		keys []LeafKey) ([]*Proof, error)
+
 	// DeleteUniverse deletes all leaves, and the root, for given universe.
 	DeleteUniverse(ctx context.Context, id Identifier) (string, error)
 
@@ -438,6 +549,23 @@ type MultiverseArchive interface {
 	// proof type.
 	MultiverseRootNode(ctx context.Context,
 		proofType ProofType) (fn.Option[MultiverseRoot], error)
+
+	// FetchAssetMeta returns the meta reveal with the given hash of an
+	// asset that has a leaf in one of the universes. ErrNoUniverseMeta is
+	// returned if there is no such asset.
+	FetchAssetMeta(ctx context.Context,
+		metaHash [asset.MetaHashLen]byte) (*proof.MetaReveal, error)
+
+	// RegisterLeafSubscriber adds a new subscriber that is notified of
+	// every proof leaf that is upserted into one of the given universes.
+	// If no universe is given, the subscriber is notified of the leaves of
+	// all universes.
+	RegisterLeafSubscriber(receiver *fn.EventReceiver[*LeafEvent],
+		ids ...Identifier) error
+
+	// RemoveLeafSubscriber removes the given leaf subscriber and also
+	// stops it from processing events.
+	RemoveLeafSubscriber(receiver *fn.EventReceiver[*LeafEvent]) error
 }
 
 // Registrar is an interface that allows a caller to upsert a proof leaf in a
@@ -490,16 +618,12 @@ const (
 	DefaultUniverseRPCPort = 10029
 )
 
-// resolveUniverseAddr maps an RPC universe host (of the form 'host' or
-// 'host:port') into a net.Addr.
-func resolverUniverseAddr(uniAddr string) (net.Addr, error) {
-	var (
-		host string
-		port int
-	)
-
+// universeHostPort splits an RPC universe host (of the form 'host' or
+// 'host:port') into its host and port components, using the default universe
+// RPC port if none is specified.
+func universeHostPort(uniAddr string) (string, int, error) {
 	if len(uniAddr) == 0 {
-		return nil, fmt.Errorf("universe host cannot be empty")
+		return "", 0, fmt.Errorf("universe host cannot be empty")
 	}
 
 	// Split the address into its host and port components.
@@ -507,19 +631,33 @@ func resolverUniverseAddr(uniAddr string) (net.Addr, error) {
 	if err != nil {
 		// If a port wasn't specified, we'll assume the address only
 		// contains the host so we'll use the default port.
-		host = uniAddr
-		port = DefaultUniverseRPCPort
-	} else {
-		// Otherwise, we'll note both the host and ports.
-		host = h
-		portNum, err := strconv.Atoi(p)
-		if err != nil {
-			return nil, err
-		}
-		port = portNum
+		return uniAddr, DefaultUniverseRPCPort, nil
+	}
+
+	// Otherwise, we'll note both the host and ports.
+	port, err := strconv.Atoi(p)
+	if err != nil {
+		return "", 0, err
 	}
 
-	// TODO(roasbeef): add tor support
+	return h, port, nil
+}
+
+// resolveUniverseAddr maps an RPC universe host (of the form 'host' or
+// 'host:port') into a net.Addr. Onion hosts are mapped to a tor.OnionAddr
+// without any name resolution, as they can only be reached through Tor.
+func resolverUniverseAddr(uniAddr string) (net.Addr, error) {
+	host, port, err := universeHostPort(uniAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if tor.IsOnionHost(host) {
+		return &tor.OnionAddr{
+			OnionService: host,
+			Port:         port,
+		}, nil
+	}
 
 	hostPort := net.JoinHostPort(host, strconv.Itoa(port))
 	return net.ResolveTCPAddr("tcp", hostPort)
@@ -539,6 +677,11 @@ type ServerAddr struct {
 	// cached the first time so resolution doesn't need to be hit
 	// repeatedly.
 	addr net.Addr
+
+	// IdentityKey is the identity key of the remote universe server, as
+	// set by the operator. Signed messages of the server are only accepted
+	// if they verify against this key.
+	IdentityKey fn.Option[btcec.PublicKey]
 }
 
 // NewServerAddrFromStr creates a new server address from a string that is the
@@ -578,6 +721,28 @@ func (s *ServerAddr) HostStr() string {
 	return s.addrStr
 }
 
+// HostPort returns the host and port of the remote universe server in the
+// form 'host:port', without resolving the host. If the server address doesn't
+// specify a port, the default universe RPC port is used.
+func (s *ServerAddr) HostPort() (string, error) {
+	host, port, err := universeHostPort(s.addrStr)
+	if err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(port)), nil
+}
+
+// IsOnion returns true if the remote universe server is a Tor onion service.
+func (s *ServerAddr) IsOnion() bool {
+	host, _, err := universeHostPort(s.addrStr)
+	if err != nil {
+		return false
+	}
+
+	return tor.IsOnionHost(host)
+}
+
 // SyncType is an enum that describes the type of sync that should be performed
 // between a local and remote universe.
 type SyncType uint8
@@ -615,8 +780,11 @@ type AssetSyncDiff struct {
 	// Universe.
 	NewLeafProofs []*Leaf
 
-	// TODO(roasbeef): ability to return if things failed?
-	//  * can used a sealed interface to return the error
+	// LeafOutcomes is the outcome of each remote leaf of the universe:
+	// whether it was inserted, skipped because it already existed locally
+	// or failed verification. Leaves that failed verification don't abort
+	// the sync, so a partially synced universe can be detected here.
+	LeafOutcomes []LeafSyncOutcome
 }
 
 // Syncer is used to synchronize the state of two Universe instances: a local
@@ -632,6 +800,44 @@ type Syncer interface {
 		idsToSync ...Identifier) ([]AssetSyncDiff, error)
 }
 
+// StreamingSyncer is a Syncer that can report the progress of a sync on a per
+// leaf basis while it is running.
+type StreamingSyncer interface {
+	Syncer
+
+	// SyncUniverseStream attempts to synchronize the local universe with
+	// the remote universe, just like SyncUniverse. In addition, progress
+	// events are sent to the given channel while the sync is running. If
+	// checkpoints are persisted, an interrupted sync resumes from the last
+	// checkpoint instead of starting from scratch.
+	SyncUniverseStream(ctx context.Context, host ServerAddr,
+		syncType SyncType, syncConfigs SyncConfigs,
+		events chan<- SyncEvent,
+		idsToSync ...Identifier) ([]AssetSyncDiff, error)
+}
+
+// DryRunSyncer is a Syncer that can compare the local universe with a remote
+// universe without inserting anything.
+type DryRunSyncer interface {
+	Syncer
+
+	// SyncUniverseDryRun compares the local universe with the remote
+	// universe, governed by the same parameters as SyncUniverse, and
+	// returns a report of the divergence of each universe. Nothing is
+	// inserted into the local universe.
+	SyncUniverseDryRun(ctx context.Context, host ServerAddr,
+		syncType SyncType, syncConfigs SyncConfigs,
+		idsToSync ...Identifier) ([]DivergenceReport, error)
+}
+
+// SyncMetrics exposes metrics about the universe syncs a Syncer performed.
+type SyncMetrics interface {
+	// NumSkippedSyncs returns the number of universe root syncs that were
+	// skipped because the remote root didn't change since the last
+	// successful sync.
+	NumSkippedSyncs() uint64
+}
+
 // DiffEngine is a Universe diff engine that can be used to compare the state
 // of two universes and find the set of assets that are different between them.
 type DiffEngine interface {
@@ -658,6 +864,52 @@ type DiffEngine interface {
 	Close() error
 }
 
+// BatchProofFetcher is an optional interface a DiffEngine can implement to
+// fetch the proof leaves of many leaf keys at once. Diff engines that don't
+// implement it are queried one key at a time.
+type BatchProofFetcher interface {
+	// FetchProofLeaves returns the proof leaves for a batch of leaf keys
+	// of the target universe, in the same order as the keys.
+	FetchProofLeaves(ctx context.Context, id Identifier,
+		keys []LeafKey) ([]*Proof, error)
+}
+
+// RemoteRootCache is used to persist the root of a universe on a remote
+// server as of the last successful sync. If the remote root didn't change
+// since then, there's no need to fetch its leaves again.
+type RemoteRootCache interface {
+	// FetchRemoteRoot returns the cached root of the given universe on the
+	// given remote server. If no root is cached, ErrNoRemoteRoot is
+	// returned.
+	FetchRemoteRoot(ctx context.Context, host ServerAddr,
+		id Identifier) (mssmt.Node, error)
+
+	// StoreRemoteRoot caches the root of a universe on the given remote
+	// server after it was successfully synced.
+	StoreRemoteRoot(ctx context.Context, host ServerAddr, root Root) error
+}
+
+// SyncCheckpointStore is used to persist the progress of streaming universe
+// syncs, so an interrupted sync can be resumed instead of being restarted from
+// scratch.
+type SyncCheckpointStore interface {
+	// FetchSyncCheckpoint returns the checkpoint of the sync of the given
+	// universe with the given remote server. If no checkpoint is stored,
+	// ErrNoSyncCheckpoint is returned.
+	FetchSyncCheckpoint(ctx context.Context, host ServerAddr,
+		id Identifier) (*SyncCheckpoint, error)
+
+	// StoreSyncCheckpoint stores the checkpoint of the sync of a universe
+	// with the given remote server, replacing any existing checkpoint.
+	StoreSyncCheckpoint(ctx context.Context, host ServerAddr,
+		checkpoint SyncCheckpoint) error
+
+	// DeleteSyncCheckpoint removes the checkpoint of the sync of the given
+	// universe with the given remote server.
+	DeleteSyncCheckpoint(ctx context.Context, host ServerAddr,
+		id Identifier) error
+}
+
 // Commitment is an on chain universe commitment. This includes the merkle
 // proof for a transaction which anchors the target universe root.
 type Commitment struct {
@@ -729,6 +981,10 @@ type FederationLog interface {
 	// LogNewSyncs logs a new sync event for each server. This can be used
 	// to keep track of the last time we synced with a remote server.
 	LogNewSyncs(ctx context.Context, addrs ...ServerAddr) error
+
+	// ServerSyncTimes returns the last time we synced with each server of
+	// the federation, keyed by the server's host string.
+	ServerSyncTimes(ctx context.Context) (map[string]time.Time, error)
 }
 
 // ProofType is an enum that describes the type of proof which can be stored in
@@ -819,6 +1075,147 @@ type FedUniSyncConfig struct {
 	AllowSyncExport bool
 }
 
+// FedAssetFilter is a set of allow and deny lists by asset ID and group key
+// that restricts which universes are synced with the federation, on top of the
+// proof type and universe specific sync configs. This allows a public universe
+// server to refuse to accept or serve proofs of specific assets while still
+// participating in the federation.
+type FedAssetFilter struct {
+	// AllowAssetIDs is the list of asset IDs whose universes are allowed
+	// to be synced. If this or AllowGroupKeys is non-empty, only universes
+	// that match one of the allow lists are synced.
+	AllowAssetIDs []asset.ID
+
+	// AllowGroupKeys is the list of group keys whose universes are allowed
+	// to be synced. If this or AllowAssetIDs is non-empty, only universes
+	// that match one of the allow lists are synced.
+	AllowGroupKeys []*btcec.PublicKey
+
+	// DenyAssetIDs is the list of asset IDs whose universes are never
+	// synced.
+	DenyAssetIDs []asset.ID
+
+	// DenyGroupKeys is the list of group keys whose universes are never
+	// synced.
+	DenyGroupKeys []*btcec.PublicKey
+}
+
+// NewFedAssetFilter creates a new asset filter from the given lists of hex
+// encoded asset IDs and group keys. Group keys can be given in either the
+// Schnorr or compressed format. If all lists are empty, nil is returned, which
+// allows all universes.
+func NewFedAssetFilter(allowAssetIDs, allowGroupKeys, denyAssetIDs,
+	denyGroupKeys []string) (*FedAssetFilter, error) {
+
+	if len(allowAssetIDs) == 0 && len(allowGroupKeys) == 0 &&
+		len(denyAssetIDs) == 0 && len(denyGroupKeys) == 0 {
+
+		return nil, nil
+	}
+
+	parseAssetIDs := func(idStrs []string) ([]asset.ID, error) {
+		assetIDs := make([]asset.ID, 0, len(idStrs))
+		for _, idStr := range idStrs {
+			idBytes, err := hex.DecodeString(idStr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid asset ID %v: "+
+					"%w", idStr, err)
+			}
+			if len(idBytes) != sha256.Size {
+				return nil, fmt.Errorf("invalid asset ID %v: "+
+					"must be %d bytes", idStr, sha256.Size)
+			}
+
+			var assetID asset.ID
+			copy(assetID[:], idBytes)
+			assetIDs = append(assetIDs, assetID)
+		}
+
+		return assetIDs, nil
+	}
+	parseGroupKeys := func(keyStrs []string) ([]*btcec.PublicKey, error) {
+		groupKeys := make([]*btcec.PublicKey, 0, len(keyStrs))
+		for _, keyStr := range keyStrs {
+			keyBytes, err := hex.DecodeString(keyStr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid group key %v: "+
+					"%w", keyStr, err)
+			}
+
+			groupKey, err := parseGroupKey(keyBytes)
+			if err != nil {
+				return nil, fmt.Errorf("invalid group key %v: "+
+					"%w", keyStr, err)
+			}
+
+			groupKeys = append(groupKeys, groupKey)
+		}
+
+		return groupKeys, nil
+	}
+
+	var (
+		filter FedAssetFilter
+		err    error
+	)
+	filter.AllowAssetIDs, err = parseAssetIDs(allowAssetIDs)
+	if err != nil {
+		return nil, err
+	}
+	filter.AllowGroupKeys, err = parseGroupKeys(allowGroupKeys)
+	if err != nil {
+		return nil, err
+	}
+	filter.DenyAssetIDs, err = parseAssetIDs(denyAssetIDs)
+	if err != nil {
+		return nil, err
+	}
+	filter.DenyGroupKeys, err = parseGroupKeys(denyGroupKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &filter, nil
+}
+
+// IsAllowed returns true if the given universe passes the filter. A universe
+// that matches one of the deny lists is never allowed, even if it also matches
+// one of the allow lists. A nil filter allows all universes.
+func (f *FedAssetFilter) IsAllowed(id Identifier) bool {
+	if f == nil {
+		return true
+	}
+
+	matchesAssetID := func(assetIDs []asset.ID) bool {
+		return fn.Any(assetIDs, func(assetID asset.ID) bool {
+			return assetID == id.AssetID
+		})
+	}
+	matchesGroupKey := func(groupKeys []*btcec.PublicKey) bool {
+		if id.GroupKey == nil {
+			return false
+		}
+
+		// Universe group keys are compared by their x-only
+		// representation, as the parity isn't always known.
+		idKey := schnorr.SerializePubKey(id.GroupKey)
+		return fn.Any(groupKeys, func(key *btcec.PublicKey) bool {
+			return bytes.Equal(schnorr.SerializePubKey(key), idKey)
+		})
+	}
+
+	if matchesAssetID(f.DenyAssetIDs) || matchesGroupKey(f.DenyGroupKeys) {
+		return false
+	}
+
+	if len(f.AllowAssetIDs) == 0 && len(f.AllowGroupKeys) == 0 {
+		return true
+	}
+
+	return matchesAssetID(f.AllowAssetIDs) ||
+		matchesGroupKey(f.AllowGroupKeys)
+}
+
 // FederationSyncConfigDB is used to manage the set of Universe servers as part
 // of a federation.
 type FederationSyncConfigDB interface {
@@ -832,6 +1229,14 @@ type FederationSyncConfigDB interface {
 	UpsertFederationSyncConfig(
 		ctx context.Context, globalSyncConfigs []*FedGlobalSyncConfig,
 		uniSyncConfigs []*FedUniSyncConfig) error
+
+	// QuerySyncSchedules returns the universe and server specific sync
+	// schedules.
+	QuerySyncSchedules(ctx context.Context) (*SyncSchedules, error)
+
+	// UpsertSyncSchedules upserts universe and server specific sync
+	// schedules. A schedule with a zero sync interval is removed.
+	UpsertSyncSchedules(ctx context.Context, schedules *SyncSchedules) error
 }
 
 // SyncDirection is the direction of a proof sync.
@@ -944,6 +1349,57 @@ type FederationDB interface {
 	FederationSyncConfigDB
 }
 
+// FederationProfile is a named set of universe servers and federation sync
+// configs. Applying a profile replaces the current federation servers and sync
+// configs with the ones of the profile, which allows switching between
+// different federation setups (for example the public mainnet federation and a
+// private consortium) without re-adding servers manually.
+type FederationProfile struct {
+	// Name is the unique name of the profile.
+	Name string
+
+	// Servers is the set of universe servers of the profile.
+	Servers []ServerAddr
+
+	// GlobalSyncConfigs are the global proof type specific sync configs of
+	// the profile.
+	GlobalSyncConfigs []*FedGlobalSyncConfig
+
+	// UniSyncConfigs are the universe specific sync configs of the
+	// profile.
+	UniSyncConfigs []*FedUniSyncConfig
+
+	// Active indicates whether the profile is the one that was last
+	// applied to the federation.
+	Active bool
+}
+
+// FederationProfileStore is used to persist named federation profiles.
+type FederationProfileStore interface {
+	// StoreFederationProfile stores the given profile, replacing any
+	// existing profile with the same name.
+	StoreFederationProfile(ctx context.Context,
+		profile FederationProfile) error
+
+	// FetchFederationProfile returns the profile with the given name. If
+	// no such profile exists, ErrNoFederationProfile is returned.
+	FetchFederationProfile(ctx context.Context,
+		name string) (*FederationProfile, error)
+
+	// ListFederationProfiles returns all stored profiles, sorted by name.
+	ListFederationProfiles(ctx context.Context) ([]*FederationProfile,
+		error)
+
+	// DeleteFederationProfile deletes the profile with the given name. If
+	// no such profile exists, ErrNoFederationProfile is returned.
+	DeleteFederationProfile(ctx context.Context, name string) error
+
+	// SetActiveFederationProfile marks the profile with the given name as
+	// the active one. If no such profile exists, ErrNoFederationProfile is
+	// returned.
+	SetActiveFederationProfile(ctx context.Context, name string) error
+}
+
 // SyncStatsSort is an enum used to specify the sort order of the returned sync
 // stats.
 type SyncStatsSort uint8
@@ -1098,12 +1554,34 @@ type GroupedStatsQuery struct {
 
 	// EndTime is the end time to use when querying for stats.
 	EndTime time.Time
+
+	// AssetIDFilter can be used to also query the supply changes of the
+	// given asset. If it isn't set, no supply changes are returned.
+	AssetIDFilter asset.ID
+}
+
+// AssetSupplyDeltas is the set of changes to the supply of an asset within a
+// given time period, as observed by the Universe.
+type AssetSupplyDeltas struct {
+	// MintedAmount is the number of units that were minted.
+	MintedAmount uint64
+
+	// BurnedAmount is the number of units that were burned.
+	BurnedAmount uint64
+
+	// NumTransfers is the number of transfers of the asset, not counting
+	// burns.
+	NumTransfers uint64
 }
 
 // GroupedStats is a type for aggregated stats grouped by day.
 type GroupedStats struct {
 	AggregateStats
 
+	// SupplyDeltas are the supply changes of the asset that was queried
+	// for. This is only populated if the query set an asset ID filter.
+	SupplyDeltas AssetSupplyDeltas
+
 	// Date is the string formatted date (YYYY-MM-DD) that the stats are
 	// for.
 	Date string
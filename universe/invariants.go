@@ -0,0 +1,169 @@
+package universe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/mssmt"
+)
+
+// MultiverseInconsistency describes a single violation of the invariant that
+// the multiverse tree of a proof type commits to the current root of each of
+// its universes.
+type MultiverseInconsistency struct {
+	// ProofType is the proof type of the inconsistent multiverse.
+	ProofType ProofType
+
+	// ID is the identifier of the affected universe. It is nil if the
+	// inconsistency concerns the multiverse root itself.
+	ID *Identifier
+
+	// Reason describes the inconsistency.
+	Reason string
+}
+
+// String returns a human-readable description of the inconsistency.
+func (i MultiverseInconsistency) String() string {
+	if i.ID == nil {
+		return fmt.Sprintf("multiverse %v: %s", i.ProofType, i.Reason)
+	}
+
+	return fmt.Sprintf("multiverse %v, universe %v: %s", i.ProofType,
+		i.ID.String(), i.Reason)
+}
+
+// CheckMultiverseInvariants verifies that the multiverse of the given proof
+// type is consistent with its universes. It checks that:
+//
+//  1. Every multiverse leaf commits to the current root of its universe.
+//  2. Every non-empty universe has a multiverse leaf.
+//  3. The multiverse root is the root of a tree that contains exactly the
+//     multiverse leaves.
+//
+// All found inconsistencies are returned. An error is only returned if the
+// archive couldn't be queried.
+func CheckMultiverseInvariants(ctx context.Context, archive MultiverseArchive,
+	proofType ProofType) ([]MultiverseInconsistency, error) {
+
+	var inconsistencies []MultiverseInconsistency
+	addInconsistency := func(id *Identifier, format string,
+		args ...interface{}) {
+
+		inconsistency := MultiverseInconsistency{
+			ProofType: proofType,
+			ID:        id,
+			Reason:    fmt.Sprintf(format, args...),
+		}
+		inconsistencies = append(inconsistencies, inconsistency)
+	}
+
+	leaves, err := archive.FetchLeaves(ctx, nil, proofType)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch multiverse leaves: %w",
+			err)
+	}
+
+	// We rebuild the multiverse tree from its leaves while checking each
+	// leaf against the root of its universe.
+	var (
+		expectedTree = mssmt.NewCompactedTree(mssmt.NewDefaultStore())
+		leafKeys     = make(map[[32]byte]struct{}, len(leaves))
+	)
+	for idx := range leaves {
+		leaf := leaves[idx]
+		leafKeys[leaf.ID.Bytes()] = struct{}{}
+
+		_, err := expectedTree.Insert(
+			ctx, leaf.ID.Bytes(), leaf.LeafNode,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		uniRoot, err := archive.UniverseRootNode(ctx, leaf.ID)
+		switch {
+		case errors.Is(err, ErrNoUniverseRoot):
+			addInconsistency(
+				&leaf.ID, "multiverse leaf without universe",
+			)
+			continue
+
+		case err != nil:
+			return nil, fmt.Errorf("unable to fetch universe root "+
+				"for %v: %w", leaf.ID.String(), err)
+		}
+
+		expectedLeaf := multiverseLeafNode(leaf.ID, uniRoot.Node)
+		if !mssmt.IsEqualNode(expectedLeaf, leaf.LeafNode) {
+			addInconsistency(
+				&leaf.ID, "multiverse leaf (hash=%v, "+
+					"sum=%d) doesn't match universe root "+
+					"(hash=%v, sum=%d)", leaf.NodeHash(),
+				leaf.NodeSum(), uniRoot.Node.NodeHash(),
+				uniRoot.Node.NodeSum(),
+			)
+		}
+	}
+
+	// Every universe that has leaves must be committed to by the
+	// multiverse.
+	for offset := int32(0); ; offset += MaxPageSize {
+		roots, err := archive.RootNodes(ctx, RootNodesQuery{
+			Offset: offset,
+			Limit:  MaxPageSize,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch universe "+
+				"roots: %w", err)
+		}
+
+		for idx := range roots {
+			root := roots[idx]
+			if root.ID.ProofType != proofType {
+				continue
+			}
+
+			if root.Node.NodeHash() == mssmt.EmptyTreeRootHash {
+				continue
+			}
+
+			if _, ok := leafKeys[root.ID.Bytes()]; !ok {
+				addInconsistency(
+					&root.ID, "universe without "+
+						"multiverse leaf",
+				)
+			}
+		}
+
+		if len(roots) < MaxPageSize {
+			break
+		}
+	}
+
+	expectedRoot, err := expectedTree.Root(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	multiverseRoot, err := archive.MultiverseRootNode(ctx, proofType)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch multiverse root: %w",
+			err)
+	}
+
+	var rootNode mssmt.Node = mssmt.EmptyTree[0]
+	multiverseRoot.WhenSome(func(root MultiverseRoot) {
+		rootNode = root.Node
+	})
+	if !mssmt.IsEqualNode(expectedRoot, rootNode) {
+		addInconsistency(
+			nil, "multiverse root (hash=%v, sum=%d) doesn't match "+
+				"multiverse leaves (hash=%v, sum=%d)",
+			rootNode.NodeHash(), rootNode.NodeSum(),
+			expectedRoot.NodeHash(), expectedRoot.NodeSum(),
+		)
+	}
+
+	return inconsistencies, nil
+}
@@ -0,0 +1,177 @@
+package universe
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/stretchr/testify/require"
+)
+
+// tamperedMultiverse is a multiverse that returns a modified set of multiverse
+// leaves.
+type tamperedMultiverse struct {
+	*MemMultiverse
+
+	tamper func([]MultiverseLeaf) []MultiverseLeaf
+}
+
+// FetchLeaves returns the tampered multiverse leaves.
+func (m *tamperedMultiverse) FetchLeaves(ctx context.Context,
+	universeTargets []MultiverseLeafDesc,
+	proofType ProofType) ([]MultiverseLeaf, error) {
+
+	leaves, err := m.MemMultiverse.FetchLeaves(
+		ctx, universeTargets, proofType,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.tamper(leaves), nil
+}
+
+// TestCheckMultiverseInvariants tests that inconsistencies between the
+// multiverse and its universes are detected, and that a failed batch upsert
+// doesn't cause any.
+func TestCheckMultiverseInvariants(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	multiverse := NewMemMultiverse()
+
+	var ids []Identifier
+	for i := 0; i < 3; i++ {
+		genesisAsset := randGenesisAsset(t)
+		id := Identifier{
+			AssetID:   genesisAsset.ID(),
+			ProofType: ProofTypeIssuance,
+		}
+		ids = append(ids, id)
+
+		for j := 0; j < 3; j++ {
+			key, leaf := randAuditLeaf(t, genesisAsset)
+			_, err := multiverse.UpsertProofLeaf(
+				ctx, id, key, leaf, nil,
+			)
+			require.NoError(t, err)
+		}
+	}
+
+	check := func(archive MultiverseArchive) []MultiverseInconsistency {
+		t.Helper()
+
+		found, err := CheckMultiverseInvariants(
+			ctx, archive, ProofTypeIssuance,
+		)
+		require.NoError(t, err)
+
+		return found
+	}
+	require.Empty(t, check(multiverse))
+
+	rootBefore, err := multiverse.MultiverseRootNode(ctx, ProofTypeIssuance)
+	require.NoError(t, err)
+
+	// A batch that fails at its last item doesn't change any of the trees.
+	newAsset := randGenesisAsset(t)
+	newKey, newLeaf := randAuditLeaf(t, newAsset)
+	_, replaceLeaf := randAuditLeaf(t, newAsset)
+	existingKeys, err := multiverse.UniverseLeafKeys(
+		ctx, UniverseLeafKeysQuery{Id: ids[0]},
+	)
+	require.NoError(t, err)
+	items := []*Item{
+		{
+			ID: Identifier{
+				AssetID:   newAsset.ID(),
+				ProofType: ProofTypeIssuance,
+			},
+			Key:  newKey,
+			Leaf: newLeaf,
+		},
+		{
+			ID:   ids[0],
+			Key:  existingKeys[1],
+			Leaf: replaceLeaf,
+		},
+		{
+			ID:   ids[1],
+			Key:  newKey,
+			Leaf: newLeaf,
+		},
+		{
+			ID: Identifier{
+				AssetID: newAsset.ID(),
+			},
+			Key:  newKey,
+			Leaf: newLeaf,
+		},
+	}
+	require.Error(t, multiverse.UpsertProofLeafBatch(ctx, items))
+
+	rootAfter, err := multiverse.MultiverseRootNode(ctx, ProofTypeIssuance)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(
+		rootBefore.UnwrapToPtr().Node, rootAfter.UnwrapToPtr().Node,
+	))
+
+	keysAfter, err := multiverse.UniverseLeafKeys(
+		ctx, UniverseLeafKeysQuery{Id: ids[0]},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, fn.Map(existingKeys, LeafKey.UniverseKey),
+		fn.Map(keysAfter, LeafKey.UniverseKey),
+	)
+	require.Empty(t, check(multiverse))
+
+	// A multiverse leaf that doesn't commit to the root of its universe is
+	// detected, as well as the resulting multiverse root mismatch.
+	found := check(&tamperedMultiverse{
+		MemMultiverse: multiverse,
+		tamper: func(leaves []MultiverseLeaf) []MultiverseLeaf {
+			leaves[0].LeafNode = mssmt.NewLeafNode(
+				test.RandBytes(32), 1,
+			)
+			return leaves
+		},
+	})
+	require.Len(t, found, 2)
+	require.Equal(t, ids[0].String(), found[0].ID.String())
+	require.Nil(t, found[1].ID)
+
+	// A missing multiverse leaf is detected.
+	found = check(&tamperedMultiverse{
+		MemMultiverse: multiverse,
+		tamper: func(leaves []MultiverseLeaf) []MultiverseLeaf {
+			return leaves[1:]
+		},
+	})
+	require.Len(t, found, 2)
+	require.Equal(t, ids[0].String(), found[0].ID.String())
+	require.Nil(t, found[1].ID)
+
+	// A multiverse leaf without a universe is detected.
+	unknownID := Identifier{
+		AssetID:   asset.RandID(t),
+		ProofType: ProofTypeIssuance,
+	}
+	found = check(&tamperedMultiverse{
+		MemMultiverse: multiverse,
+		tamper: func(leaves []MultiverseLeaf) []MultiverseLeaf {
+			return append(leaves, MultiverseLeaf{
+				ID: unknownID,
+				LeafNode: mssmt.NewLeafNode(
+					test.RandBytes(32), 1,
+				),
+			})
+		},
+	})
+	require.Len(t, found, 2)
+	require.Equal(t, unknownID.String(), found[0].ID.String())
+	require.Nil(t, found[1].ID)
+}
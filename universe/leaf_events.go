@@ -0,0 +1,129 @@
+package universe
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+// LeafEvent is the event that is emitted whenever a new proof leaf was upserted
+// into a universe.
+type LeafEvent struct {
+	// ID is the identifier of the universe the leaf was upserted into.
+	ID Identifier
+
+	// Key is the key of the upserted leaf.
+	Key LeafKey
+
+	// Leaf is the upserted leaf.
+	Leaf *Leaf
+
+	// timestamp is the time the leaf was upserted.
+	timestamp time.Time
+}
+
+// NewLeafEvent creates a new leaf event for a leaf that was just upserted.
+func NewLeafEvent(id Identifier, key LeafKey, leaf *Leaf) *LeafEvent {
+	return &LeafEvent{
+		ID:        id,
+		Key:       key,
+		Leaf:      leaf,
+		timestamp: time.Now(),
+	}
+}
+
+// Timestamp returns the time the leaf was upserted.
+//
+// NOTE: This is part of the fn.Event interface.
+func (e *LeafEvent) Timestamp() time.Time {
+	return e.timestamp
+}
+
+// A compile-time assertion to make sure LeafEvent satisfies the fn.Event
+// interface.
+var _ fn.Event = (*LeafEvent)(nil)
+
+// leafSubscription is a subscriber of leaf events along with the set of
+// universes it is interested in.
+type leafSubscription struct {
+	receiver *fn.EventReceiver[*LeafEvent]
+
+	// ids is the set of universe IDs (in their string form) the subscriber
+	// is interested in. If empty, the subscriber receives the events of
+	// all universes.
+	ids fn.Set[string]
+}
+
+// LeafEventDistributor distributes leaf events to subscribers, each of which
+// can be limited to a set of universes.
+type LeafEventDistributor struct {
+	// subscribers is the set of active subscriptions, keyed by the ID of
+	// their receiver.
+	subscribers map[uint64]*leafSubscription
+
+	// subscriberMtx guards the subscribers map.
+	subscriberMtx sync.Mutex
+}
+
+// NewLeafEventDistributor creates a new leaf event distributor.
+func NewLeafEventDistributor() *LeafEventDistributor {
+	return &LeafEventDistributor{
+		subscribers: make(map[uint64]*leafSubscription),
+	}
+}
+
+// RegisterSubscriber adds a new subscriber that is notified of the leaves
+// upserted into one of the given universes. If no universe is given, the
+// subscriber is notified of the leaves of all universes.
+func (d *LeafEventDistributor) RegisterSubscriber(
+	receiver *fn.EventReceiver[*LeafEvent], ids ...Identifier) {
+
+	d.subscriberMtx.Lock()
+	defer d.subscriberMtx.Unlock()
+
+	d.subscribers[receiver.ID()] = &leafSubscription{
+		receiver: receiver,
+		ids: fn.NewSet(fn.Map(ids, func(id Identifier) string {
+			return id.String()
+		})...),
+	}
+}
+
+// RemoveSubscriber removes the given subscriber and also stops it from
+// processing events.
+func (d *LeafEventDistributor) RemoveSubscriber(
+	receiver *fn.EventReceiver[*LeafEvent]) error {
+
+	d.subscriberMtx.Lock()
+	defer d.subscriberMtx.Unlock()
+
+	if _, ok := d.subscribers[receiver.ID()]; !ok {
+		return fmt.Errorf("subscriber with ID %d not found",
+			receiver.ID())
+	}
+
+	receiver.Stop()
+	delete(d.subscribers, receiver.ID())
+
+	return nil
+}
+
+// NotifySubscribers sends the given events to all subscribers that are
+// interested in the universe of the event.
+func (d *LeafEventDistributor) NotifySubscribers(events ...*LeafEvent) {
+	d.subscriberMtx.Lock()
+	defer d.subscriberMtx.Unlock()
+
+	for _, event := range events {
+		idStr := event.ID.String()
+		for _, sub := range d.subscribers {
+			if len(sub.ids) != 0 && !sub.ids.Contains(idStr) {
+				continue
+			}
+
+			sub.receiver.NewItemCreated.ChanIn() <- event
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package universe
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/lightningnetwork/lnd/tlv"
+	"golang.org/x/exp/maps"
+)
+
+var (
+	// ErrUnknownLeafExtension is returned when a leaf carries an extension
+	// record with an even type that we don't know. Even types are
+	// required to be understood, so such a leaf must be rejected.
+	ErrUnknownLeafExtension = errors.New("unknown required leaf " +
+		"extension")
+)
+
+// knownLeafExtensions is the set of leaf extension record types this node
+// understands. No extension records are defined yet, so any leaf with an even
+// extension type is rejected.
+var knownLeafExtensions = map[tlv.Type]struct{}{}
+
+// LeafExtensions is the generic TLV extension space of a universe leaf. It
+// allows attaching additional metadata to a leaf, such as the anchor height or
+// courier hints, without changing the leaf itself.
+//
+// The extension records follow the "it's OK to be odd" rule: records with an
+// odd type are optional, so nodes that don't know them keep them as opaque
+// bytes and pass them on unchanged. Records with an even type must be
+// understood, so leaves with an unknown even type are rejected.
+type LeafExtensions map[tlv.Type][]byte
+
+// Types returns the types of all extension records in ascending order.
+func (e LeafExtensions) Types() []tlv.Type {
+	types := maps.Keys(e)
+	sort.Slice(types, func(i, j int) bool {
+		return types[i] < types[j]
+	})
+
+	return types
+}
+
+// Validate makes sure that the extensions don't contain any records with an
+// even type that we don't know.
+func (e LeafExtensions) Validate() error {
+	for _, typ := range e.Types() {
+		if typ%2 == 1 {
+			continue
+		}
+
+		if _, ok := knownLeafExtensions[typ]; !ok {
+			return fmt.Errorf("%w: type %d", ErrUnknownLeafExtension,
+				typ)
+		}
+	}
+
+	return nil
+}
+
+// Encode encodes the extensions as a TLV stream, with the records sorted by
+// their type.
+func (e LeafExtensions) Encode(w io.Writer) error {
+	types := e.Types()
+	records := make([]tlv.Record, 0, len(types))
+	for _, typ := range types {
+		value := e[typ]
+		records = append(records, tlv.MakePrimitiveRecord(typ, &value))
+	}
+
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return err
+	}
+
+	return stream.Encode(w)
+}
+
+// Bytes returns the encoded extensions, or nil if there are none.
+func (e LeafExtensions) Bytes() ([]byte, error) {
+	if len(e) == 0 {
+		return nil, nil
+	}
+
+	var b bytes.Buffer
+	if err := e.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// DecodeLeafExtensions decodes the extensions from the given TLV stream. An
+// error is returned if the stream contains a record with an unknown even
+// type. An empty stream results in nil extensions.
+func DecodeLeafExtensions(r io.Reader) (LeafExtensions, error) {
+	stream, err := tlv.NewStream()
+	if err != nil {
+		return nil, err
+	}
+
+	// The stream doesn't know any records, so all records are returned as
+	// raw bytes in the parsed types.
+	parsedTypes, err := stream.DecodeWithParsedTypesP2P(r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode leaf extensions: %w",
+			err)
+	}
+	if len(parsedTypes) == 0 {
+		return nil, nil
+	}
+
+	extensions := make(LeafExtensions, len(parsedTypes))
+	for typ, value := range parsedTypes {
+		extensions[typ] = value
+	}
+
+	if err := extensions.Validate(); err != nil {
+		return nil, err
+	}
+
+	return extensions, nil
+}
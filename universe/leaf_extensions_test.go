@@ -0,0 +1,67 @@
+package universe
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/tlv"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLeafExtensions tests that leaf extensions are encoded canonically, that
+// unknown odd records are kept and that unknown even records are rejected.
+func TestLeafExtensions(t *testing.T) {
+	t.Parallel()
+
+	// Empty extensions are encoded as nil and decoded as nil.
+	var empty LeafExtensions
+	emptyBytes, err := empty.Bytes()
+	require.NoError(t, err)
+	require.Nil(t, emptyBytes)
+
+	decoded, err := DecodeLeafExtensions(bytes.NewReader(emptyBytes))
+	require.NoError(t, err)
+	require.Nil(t, decoded)
+
+	// Unknown odd records are kept as opaque bytes. They are encoded
+	// sorted by type, no matter the order they were added in.
+	extensions := LeafExtensions{
+		65537: []byte("courier hint"),
+		1:     []byte{0x00, 0x0c, 0x35, 0x00},
+		3:     {},
+	}
+	require.NoError(t, extensions.Validate())
+	require.Equal(t, []tlv.Type{1, 3, 65537}, extensions.Types())
+
+	extensionBytes, err := extensions.Bytes()
+	require.NoError(t, err)
+
+	decoded, err = DecodeLeafExtensions(bytes.NewReader(extensionBytes))
+	require.NoError(t, err)
+	require.Equal(t, extensions, decoded)
+
+	// Records with an even type we don't know are rejected, both when
+	// validating and when decoding.
+	extensions[2] = []byte("required")
+	require.ErrorIs(t, extensions.Validate(), ErrUnknownLeafExtension)
+
+	extensionBytes, err = extensions.Bytes()
+	require.NoError(t, err)
+
+	_, err = DecodeLeafExtensions(bytes.NewReader(extensionBytes))
+	require.ErrorIs(t, err, ErrUnknownLeafExtension)
+
+	// Streams that aren't sorted by type are rejected.
+	var unsorted bytes.Buffer
+	for _, typ := range []tlv.Type{3, 1} {
+		value := []byte{0x01}
+		stream, err := tlv.NewStream(
+			tlv.MakePrimitiveRecord(typ, &value),
+		)
+		require.NoError(t, err)
+		require.NoError(t, stream.Encode(&unsorted))
+	}
+
+	_, err = DecodeLeafExtensions(&unsorted)
+	require.ErrorIs(t, err, tlv.ErrStreamNotCanonical)
+}
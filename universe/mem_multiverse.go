@@ -0,0 +1,1110 @@
+package universe
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+)
+
+// memLeaf is a proof leaf stored in an in-memory universe.
+type memLeaf struct {
+	key  LeafKey
+	leaf *Leaf
+
+	// meta is the meta reveal of the asset of the leaf, if it was given
+	// when the leaf was upserted.
+	meta *proof.MetaReveal
+
+	// blockHeight is the block height of the leaf proof, or zero if it
+	// couldn't be decoded.
+	blockHeight uint32
+}
+
+// memUniverse is a single asset (group) specific universe that is kept in
+// memory.
+type memUniverse struct {
+	id Identifier
+
+	// assetName is the name of the asset of the first leaf that was
+	// inserted into the universe.
+	assetName string
+
+	// store is the tree store that holds the nodes of the universe tree.
+	store mssmt.TreeStore
+
+	tree mssmt.Tree
+
+	// leaves are the leaves of the universe in insertion order.
+	leaves []*memLeaf
+
+	// leafIndex maps the universe key of a leaf to its index in leaves.
+	leafIndex map[[32]byte]int
+}
+
+// rootNode returns the current root node of the universe.
+func (u *memUniverse) rootNode(ctx context.Context) (mssmt.Node, error) {
+	root, err := u.tree.Root(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mssmt.NewComputedNode(root.NodeHash(), root.NodeSum()), nil
+}
+
+// removeLeaf removes the leaf at the given index from the universe leaves and
+// updates the index of all following leaves.
+func (u *memUniverse) removeLeaf(idx int) {
+	delete(u.leafIndex, u.leaves[idx].key.UniverseKey())
+	u.leaves = append(u.leaves[:idx], u.leaves[idx+1:]...)
+
+	for i := idx; i < len(u.leaves); i++ {
+		u.leafIndex[u.leaves[i].key.UniverseKey()] = i
+	}
+}
+
+// TreeStoreFactory creates the tree store for the tree with the given
+// namespace.
+type TreeStoreFactory func(namespace string) mssmt.TreeStore
+
+// MemMultiverse is a multiverse that keeps all universe trees and proof leaves
+// in memory. It has the same behavior as the database backed multiverse but
+// all data is lost once the instance is discarded. This makes it suitable for
+// tests and short-lived, ephemeral universe servers. The nodes of the trees
+// can optionally be kept in a different tree store, such as a database backed
+// store, to bound the memory used for very large universes.
+//
+// NOTE: This implements the MultiverseArchive interface.
+type MemMultiverse struct {
+	// universes are the known universes, keyed by the string
+	// representation of their identifier.
+	universes map[string]*memUniverse
+
+	// universeOrder is the order in which the universes were created.
+	universeOrder []string
+
+	// multiverseTrees are the multiverse trees for each proof type.
+	multiverseTrees map[ProofType]mssmt.Tree
+
+	// newStore creates the tree store of each universe and multiverse
+	// tree.
+	newStore TreeStoreFactory
+
+	// transferProofDistributor is an event distributor that notifies
+	// subscribers about new transfer proofs.
+	transferProofDistributor *fn.EventDistributor[proof.Blob]
+
+	// leafEventDistributor is an event distributor that notifies
+	// subscribers about all new proof leaves.
+	leafEventDistributor *LeafEventDistributor
+
+	sync.RWMutex
+}
+
+// NewMemMultiverse creates a new, empty in-memory multiverse.
+func NewMemMultiverse() *MemMultiverse {
+	return NewMemMultiverseWithStores(func(string) mssmt.TreeStore {
+		return mssmt.NewDefaultStore()
+	})
+}
+
+// NewMemMultiverseWithStores creates a new, empty multiverse that keeps the
+// nodes of its trees in the tree stores created by the given factory. Each
+// tree uses its own namespace, which is the string representation of the
+// universe identifier or the multiverse name of the proof type.
+func NewMemMultiverseWithStores(newStore TreeStoreFactory) *MemMultiverse {
+	multiverseTree := func(proofType ProofType) mssmt.Tree {
+		return mssmt.NewCompactedTree(
+			newStore("multiverse-" + proofType.String()),
+		)
+	}
+
+	return &MemMultiverse{
+		universes: make(map[string]*memUniverse),
+		multiverseTrees: map[ProofType]mssmt.Tree{
+			ProofTypeIssuance: multiverseTree(ProofTypeIssuance),
+			ProofTypeTransfer: multiverseTree(ProofTypeTransfer),
+		},
+		newStore:                 newStore,
+		transferProofDistributor: fn.NewEventDistributor[proof.Blob](),
+		leafEventDistributor:     NewLeafEventDistributor(),
+	}
+}
+
+// multiverseTree returns the multiverse tree for the given proof type.
+func (m *MemMultiverse) multiverseTree(proofType ProofType) (mssmt.Tree,
+	error) {
+
+	tree, ok := m.multiverseTrees[proofType]
+	if !ok {
+		return nil, fmt.Errorf("unknown proof type: %d", int(proofType))
+	}
+
+	return tree, nil
+}
+
+// multiverseLeafNode returns the multiverse leaf node that commits to the given
+// root of the universe with the given ID. For issuance universes, the sum is
+// set to one, so the multiverse sum is the number of assets (groups).
+func multiverseLeafNode(id Identifier,
+	universeRoot mssmt.Node) *mssmt.LeafNode {
+
+	universeRootHash := universeRoot.NodeHash()
+	assetGroupSum := universeRoot.NodeSum()
+	if id.ProofType == ProofTypeIssuance {
+		assetGroupSum = 1
+	}
+
+	return mssmt.NewLeafNode(universeRootHash[:], assetGroupSum)
+}
+
+// upsertLeaf inserts or replaces the given leaf in the universe with the given
+// ID and updates the multiverse tree accordingly.
+//
+// NOTE: The caller must hold the write lock.
+func (m *MemMultiverse) upsertLeaf(ctx context.Context, id Identifier,
+	key LeafKey, leaf *Leaf, meta *proof.MetaReveal) (*Proof, error) {
+
+	multiverseTree, err := m.multiverseTree(id.ProofType)
+	if err != nil {
+		return nil, err
+	}
+
+	uni, ok := m.universes[id.String()]
+	if !ok {
+		store := m.newStore(id.String())
+		uni = &memUniverse{
+			id:        id,
+			assetName: leaf.Genesis.Tag,
+			store:     store,
+			tree:      mssmt.NewCompactedTree(store),
+			leafIndex: make(map[[32]byte]int),
+		}
+		m.universes[id.String()] = uni
+		m.universeOrder = append(m.universeOrder, id.String())
+	}
+
+	smtKey := key.UniverseKey()
+	_, err = uni.tree.Insert(ctx, smtKey, leaf.SmtLeafNode())
+	if err != nil {
+		return nil, err
+	}
+
+	// An existing leaf is replaced in place, so it keeps its position in
+	// the insertion order.
+	newLeaf := &memLeaf{
+		key: LeafKey{
+			OutPoint: key.OutPoint,
+			ScriptKey: fn.Ptr(
+				asset.NewScriptKey(key.ScriptKey.PubKey),
+			),
+		},
+		leaf:        leaf,
+		meta:        meta,
+		blockHeight: leafBlockHeight(leaf),
+	}
+	if idx, ok := uni.leafIndex[smtKey]; ok {
+		uni.leaves[idx] = newLeaf
+	} else {
+		uni.leafIndex[smtKey] = len(uni.leaves)
+		uni.leaves = append(uni.leaves, newLeaf)
+	}
+
+	leafInclusionProof, err := uni.tree.MerkleProof(ctx, smtKey)
+	if err != nil {
+		return nil, err
+	}
+
+	universeRoot, err := uni.tree.Root(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uniLeafNodeKey := id.Bytes()
+	_, err = multiverseTree.Insert(
+		ctx, uniLeafNodeKey, multiverseLeafNode(id, universeRoot),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	multiverseRoot, err := multiverseTree.Root(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	multiverseInclusionProof, err := multiverseTree.MerkleProof(
+		ctx, uniLeafNodeKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Proof{
+		LeafKey:                  key,
+		UniverseRoot:             universeRoot,
+		UniverseInclusionProof:   leafInclusionProof,
+		MultiverseRoot:           multiverseRoot,
+		MultiverseInclusionProof: multiverseInclusionProof,
+		Leaf:                     leaf,
+	}, nil
+}
+
+// fetchProofLeaf returns the proof leaves of the universe with the given ID
+// that match the given key.
+//
+// NOTE: The caller must hold at least the read lock.
+func (m *MemMultiverse) fetchProofLeaf(ctx context.Context, id Identifier,
+	key LeafKey) ([]*Proof, error) {
+
+	uni, ok := m.universes[id.String()]
+	if !ok {
+		return nil, ErrNoUniverseProofFound
+	}
+
+	matches := func(l *memLeaf) bool {
+		if key.ScriptKey == nil {
+			return l.key.OutPoint == key.OutPoint
+		}
+
+		return l.key.UniverseKey() == key.UniverseKey()
+	}
+
+	// If neither an outpoint nor a script key is specified, then all the
+	// leaves of the universe are returned.
+	leaves := uni.leaves
+	if key.ScriptKey != nil || key.OutPoint != (wire.OutPoint{}) {
+		leaves = fn.Filter(leaves, matches)
+	}
+	if len(leaves) == 0 {
+		return nil, ErrNoUniverseProofFound
+	}
+
+	universeRoot, err := uni.rootNode(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	multiverseTree, err := m.multiverseTree(id.ProofType)
+	if err != nil {
+		return nil, err
+	}
+
+	multiverseRoot, err := multiverseTree.Root(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	multiverseInclusionProof, err := multiverseTree.MerkleProof(
+		ctx, id.Bytes(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	proofs := make([]*Proof, 0, len(leaves))
+	for _, l := range leaves {
+		leafProof, err := uni.tree.MerkleProof(ctx, l.key.UniverseKey())
+		if err != nil {
+			return nil, err
+		}
+
+		proofs = append(proofs, &Proof{
+			LeafKey:                  l.key,
+			UniverseRoot:             universeRoot,
+			UniverseInclusionProof:   leafProof,
+			MultiverseRoot:           multiverseRoot,
+			MultiverseInclusionProof: multiverseInclusionProof,
+			Leaf:                     l.leaf,
+		})
+	}
+
+	return proofs, nil
+}
+
+// RootNodes returns the complete set of known root nodes for the set of assets
+// tracked in the multiverse.
+//
+// NOTE: This is part of the MultiverseArchive interface.
+func (m *MemMultiverse) RootNodes(ctx context.Context,
+	q RootNodesQuery) ([]Root, error) {
+
+	m.RLock()
+	defer m.RUnlock()
+
+	uniIDs := append([]string{}, m.universeOrder...)
+	if q.SortDirection == SortDescending {
+		for i, j := 0, len(uniIDs)-1; i < j; i, j = i+1, j-1 {
+			uniIDs[i], uniIDs[j] = uniIDs[j], uniIDs[i]
+		}
+	}
+
+	limit := int(q.Limit)
+	if limit == 0 {
+		limit = MaxPageSize
+	}
+
+	offset := int(q.Offset)
+	if offset >= len(uniIDs) {
+		return nil, nil
+	}
+	uniIDs = uniIDs[offset:min(offset+limit, len(uniIDs))]
+
+	roots := make([]Root, 0, len(uniIDs))
+	for _, uniID := range uniIDs {
+		uni := m.universes[uniID]
+
+		root, err := uni.tree.Root(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		var groupedAssets map[asset.ID]uint64
+		switch {
+		case uni.id.GroupKey != nil && q.WithAmountsById:
+			groupedAssets = make(map[asset.ID]uint64)
+			for _, l := range uni.leaves {
+				amt := l.leaf.SmtLeafNode().NodeSum()
+				groupedAssets[l.leaf.ID()] += amt
+			}
+
+		case q.WithAmountsById:
+			// For non-grouped assets, there's exactly one member,
+			// the asset itself.
+			groupedAssets = map[asset.ID]uint64{
+				uni.id.AssetID: root.NodeSum(),
+			}
+		}
+
+		roots = append(roots, Root{
+			ID: uni.id,
+			Node: mssmt.NewComputedBranch(
+				root.NodeHash(), root.NodeSum(),
+			),
+			AssetName:     uni.assetName,
+			GroupedAssets: groupedAssets,
+		})
+	}
+
+	return roots, nil
+}
+
+// UpsertProofLeaf upserts a proof leaf within the multiverse tree and the
+// universe tree that corresponds to the given key. The meta reveal isn't
+// stored, as it is already contained in the raw proof of the leaf.
+//
+// NOTE: This is part of the MultiverseArchive interface.
+func (m *MemMultiverse) UpsertProofLeaf(ctx context.Context, id Identifier,
+	key LeafKey, leaf *Leaf, meta *proof.MetaReveal) (*Proof, error) {
+
+	m.Lock()
+	leafProof, err := m.upsertLeaf(ctx, id, key, leaf, meta)
+	m.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the custodian is interested in transfer proofs, so we don't
+	// signal issuance proofs to it.
+	if id.ProofType == ProofTypeTransfer {
+		m.transferProofDistributor.NotifySubscribers(leaf.RawProof)
+	}
+	m.leafEventDistributor.NotifySubscribers(NewLeafEvent(id, key, leaf))
+
+	return leafProof, nil
+}
+
+// upsertJournalEntry records the state of a universe before a leaf was
+// upserted into it, so the upsert can be undone.
+type upsertJournalEntry struct {
+	id Identifier
+
+	key [32]byte
+
+	// newUniverse is true if the universe didn't exist before the upsert.
+	newUniverse bool
+
+	// prevLeaf is the leaf that was replaced by the upsert, or nil if the
+	// leaf didn't exist before.
+	prevLeaf *memLeaf
+}
+
+// journalEntry returns the journal entry that allows undoing the upsert of the
+// leaf with the given key into the universe with the given ID.
+//
+// NOTE: The caller must hold at least the read lock.
+func (m *MemMultiverse) journalEntry(id Identifier,
+	key LeafKey) upsertJournalEntry {
+
+	entry := upsertJournalEntry{
+		id:  id,
+		key: key.UniverseKey(),
+	}
+
+	uni, ok := m.universes[id.String()]
+	if !ok {
+		entry.newUniverse = true
+		return entry
+	}
+
+	if idx, ok := uni.leafIndex[entry.key]; ok {
+		entry.prevLeaf = uni.leaves[idx]
+	}
+
+	return entry
+}
+
+// rollback undoes the upserts recorded in the given journal, in reverse order.
+// Universes that were created by the upserts are removed again, all other
+// universes get their previous leaves back. The multiverse leaves are updated
+// to commit to the restored universe roots.
+//
+// NOTE: The caller must hold the write lock.
+func (m *MemMultiverse) rollback(ctx context.Context,
+	journal []upsertJournalEntry) error {
+
+	for i := len(journal) - 1; i >= 0; i-- {
+		entry := journal[i]
+
+		uni, ok := m.universes[entry.id.String()]
+		if !ok {
+			continue
+		}
+
+		if entry.newUniverse {
+			_, err := m.deleteUniverse(ctx, entry.id)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		var err error
+		switch {
+		case entry.prevLeaf != nil:
+			prevNode := entry.prevLeaf.leaf.SmtLeafNode()
+			_, err = uni.tree.Insert(ctx, entry.key, prevNode)
+			uni.leaves[uni.leafIndex[entry.key]] = entry.prevLeaf
+
+		default:
+			_, err = uni.tree.Delete(ctx, entry.key)
+			if idx, ok := uni.leafIndex[entry.key]; ok {
+				uni.removeLeaf(idx)
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		multiverseTree, err := m.multiverseTree(entry.id.ProofType)
+		if err != nil {
+			return err
+		}
+
+		universeRoot, err := uni.tree.Root(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = multiverseTree.Insert(
+			ctx, entry.id.Bytes(),
+			multiverseLeafNode(entry.id, universeRoot),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpsertProofLeafBatch upserts a proof leaf batch within the multiverse tree
+// and the universe tree that corresponds to the given key(s). The batch is
+// applied atomically: if any of the upserts fails, all upserts of the batch
+// are undone.
+//
+// NOTE: This is part of the MultiverseArchive interface.
+func (m *MemMultiverse) UpsertProofLeafBatch(ctx context.Context,
+	items []*Item) error {
+
+	m.Lock()
+	journal := make([]upsertJournalEntry, 0, len(items))
+	for _, item := range items {
+		journal = append(journal, m.journalEntry(item.ID, item.Key))
+
+		_, err := m.upsertLeaf(
+			ctx, item.ID, item.Key, item.Leaf, item.MetaReveal,
+		)
+		if err != nil {
+			// The batch is applied atomically, so we undo all the
+			// upserts of the batch, including the failed one, to
+			// not leave the multiverse tree inconsistent with the
+			// universe trees.
+			rollbackErr := m.rollback(ctx, journal)
+			if rollbackErr != nil {
+				err = fmt.Errorf("%w (rollback failed: %v)",
+					err, rollbackErr)
+			}
+			m.Unlock()
+
+			return err
+		}
+	}
+	m.Unlock()
+
+	for _, item := range items {
+		if item.ID.ProofType == ProofTypeTransfer {
+			m.transferProofDistributor.NotifySubscribers(
+				item.Leaf.RawProof,
+			)
+		}
+	}
+	m.leafEventDistributor.NotifySubscribers(
+		fn.Map(items, func(item *Item) *LeafEvent {
+			return NewLeafEvent(item.ID, item.Key, item.Leaf)
+		})...,
+	)
+
+	return nil
+}
+
+// FetchProofLeaf returns a proof leaf for the target key. If the key doesn't
+// have a script key specified, then all the proof leafs for the minting
+// outpoint will be returned. If neither are specified, then all inserted proof
+// leafs will be returned.
+//
+// NOTE: This is part of the MultiverseArchive interface.
+func (m *MemMultiverse) FetchProofLeaf(ctx context.Context, id Identifier,
+	key LeafKey) ([]*Proof, error) {
+
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.fetchProofLeaf(ctx, id, key)
+}
+
+// FetchProofLeaves returns the proof leaves for a batch of leaf keys of the
+// target universe, in the same order as the keys. If no proof is found for any
+// of the keys, ErrNoUniverseProofFound is returned.
+//
+// NOTE: This is part of the MultiverseArchive interface.
+func (m *MemMultiverse) FetchProofLeaves(ctx context.Context, id Identifier,
+	keys []LeafKey) ([]*Proof, error) {
+
+	m.RLock()
+	defer m.RUnlock()
+
+	var allProofs []*Proof
+	for _, key := range keys {
+		proofs, err := m.fetchProofLeaf(ctx, id, key)
+		if err != nil {
+			return nil, err
+		}
+
+		allProofs = append(allProofs, proofs...)
+	}
+
+	return allProofs, nil
+}
+
+// DeleteUniverse deletes all leaves, and the root, for given universe.
+//
+// NOTE: This is part of the MultiverseArchive interface.
+func (m *MemMultiverse) DeleteUniverse(ctx context.Context,
+	id Identifier) (string, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	return m.deleteUniverse(ctx, id)
+}
+
+// deleteUniverse removes the universe with the given ID and its multiverse
+// leaf.
+//
+// NOTE: The caller must hold the write lock.
+func (m *MemMultiverse) deleteUniverse(ctx context.Context,
+	id Identifier) (string, error) {
+
+	multiverseTree, err := m.multiverseTree(id.ProofType)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = multiverseTree.Delete(ctx, id.Bytes())
+	if err != nil {
+		return "", err
+	}
+
+	// The tree store may outlive the universe, so we make sure no stale
+	// nodes are left behind.
+	uniID := id.String()
+	if uni, ok := m.universes[uniID]; ok {
+		err := uni.store.Update(
+			ctx, func(tx mssmt.TreeStoreUpdateTx) error {
+				if err := tx.DeleteAllNodes(); err != nil {
+					return err
+				}
+
+				return tx.DeleteRoot()
+			},
+		)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	delete(m.universes, uniID)
+	m.universeOrder = fn.Filter(m.universeOrder, func(s string) bool {
+		return s != uniID
+	})
+
+	return uniID, nil
+}
+
+// UniverseRootNode returns the Universe root node for the given asset ID.
+//
+// NOTE: This is part of the MultiverseArchive interface.
+func (m *MemMultiverse) UniverseRootNode(ctx context.Context,
+	id Identifier) (Root, error) {
+
+	m.RLock()
+	defer m.RUnlock()
+
+	uni, ok := m.universes[id.String()]
+	if !ok {
+		return Root{}, ErrNoUniverseRoot
+	}
+
+	rootNode, err := uni.rootNode(ctx)
+	if err != nil {
+		return Root{}, err
+	}
+
+	return Root{
+		ID:        id,
+		Node:      rootNode,
+		AssetName: uni.assetName,
+	}, nil
+}
+
+// UniverseLeafKeys returns the set of leaf keys for the given universe.
+//
+// NOTE: This is part of the MultiverseArchive interface.
+func (m *MemMultiverse) UniverseLeafKeys(_ context.Context,
+	q UniverseLeafKeysQuery) ([]LeafKey, error) {
+
+	m.RLock()
+	defer m.RUnlock()
+
+	uni, ok := m.universes[q.Id.String()]
+	if !ok {
+		return nil, nil
+	}
+
+	// Leaves with an unknown block height match any block height range,
+	// same as in the database backed multiverse.
+	leaves := fn.Filter(uni.leaves, func(l *memLeaf) bool {
+		return l.blockHeight == 0 ||
+			q.InBlockHeightRange(l.blockHeight)
+	})
+	keys := fn.Map(leaves, func(l *memLeaf) LeafKey {
+		return l.key
+	})
+	if q.SortDirection == SortDescending {
+		for i, j := 0, len(keys)-1; i < j; i, j = i+1, j-1 {
+			keys[i], keys[j] = keys[j], keys[i]
+		}
+	}
+
+	limit := int(q.Limit)
+	if limit == 0 {
+		limit = MaxPageSize
+	}
+
+	offset := int(q.Offset)
+	if offset >= len(keys) {
+		return nil, nil
+	}
+
+	return keys[offset:min(offset+limit, len(keys))], nil
+}
+
+// FetchLeaves returns the set of multiverse leaves that satisfy the set of
+// universe targets. If the set of targets is empty, all leaves for the given
+// proof type will be returned.
+//
+// NOTE: This is part of the MultiverseArchive interface.
+func (m *MemMultiverse) FetchLeaves(ctx context.Context,
+	universeTargets []MultiverseLeafDesc,
+	proofType ProofType) ([]MultiverseLeaf, error) {
+
+	m.RLock()
+	defer m.RUnlock()
+
+	isTarget := func(id Identifier) bool {
+		if len(universeTargets) == 0 {
+			return true
+		}
+
+		return fn.Any(universeTargets, func(t MultiverseLeafDesc) bool {
+			var match bool
+			t.WhenLeft(func(assetID asset.ID) {
+				match = id.GroupKey == nil &&
+					id.AssetID == assetID
+			})
+			t.WhenRight(func(groupKey btcec.PublicKey) {
+				match = id.GroupKey != nil && bytes.Equal(
+					schnorr.SerializePubKey(id.GroupKey),
+					schnorr.SerializePubKey(&groupKey),
+				)
+			})
+
+			return match
+		})
+	}
+
+	var leaves []MultiverseLeaf
+	for _, uniID := range m.universeOrder {
+		uni := m.universes[uniID]
+		if uni.id.ProofType != proofType || !isTarget(uni.id) {
+			continue
+		}
+
+		root, err := uni.tree.Root(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// A multiverse leaf is identified by either the asset ID or
+		// the group key, never both.
+		leafID := Identifier{
+			GroupKey:  uni.id.GroupKey,
+			ProofType: proofType,
+		}
+		if uni.id.GroupKey == nil {
+			leafID.AssetID = uni.id.AssetID
+		}
+
+		leaves = append(leaves, MultiverseLeaf{
+			ID:       leafID,
+			LeafNode: multiverseLeafNode(uni.id, root),
+		})
+	}
+
+	return leaves, nil
+}
+
+// MultiverseRootNode returns the Multiverse root node for the given proof
+// type. If no universe of the proof type exists yet, then None is returned.
+//
+// NOTE: This is part of the MultiverseArchive interface.
+func (m *MemMultiverse) MultiverseRootNode(ctx context.Context,
+	proofType ProofType) (fn.Option[MultiverseRoot], error) {
+
+	none := fn.None[MultiverseRoot]()
+
+	m.RLock()
+	defer m.RUnlock()
+
+	multiverseTree, err := m.multiverseTree(proofType)
+	if err != nil {
+		return none, err
+	}
+
+	root, err := multiverseTree.Root(ctx)
+	if err != nil {
+		return none, err
+	}
+
+	if root.NodeHash() == mssmt.EmptyTreeRootHash {
+		return none, nil
+	}
+
+	return fn.Some(MultiverseRoot{
+		ProofType: proofType,
+		Node: mssmt.NewComputedBranch(
+			root.NodeHash(), root.NodeSum(),
+		),
+	}), nil
+}
+
+// FetchAssetMeta returns the meta reveal with the given hash of an asset that
+// has a leaf in one of the universes.
+//
+// NOTE: This is part of the MultiverseArchive interface.
+func (m *MemMultiverse) FetchAssetMeta(_ context.Context,
+	metaHash [asset.MetaHashLen]byte) (*proof.MetaReveal, error) {
+
+	m.RLock()
+	defer m.RUnlock()
+
+	for _, uniID := range m.universeOrder {
+		for _, leaf := range m.universes[uniID].leaves {
+			if leaf.meta == nil ||
+				leaf.leaf.Genesis.MetaHash != metaHash ||
+				leaf.meta.MetaHash() != metaHash {
+
+				continue
+			}
+
+			return &proof.MetaReveal{
+				Type: leaf.meta.Type,
+				Data: fn.CopySlice(leaf.meta.Data),
+			}, nil
+		}
+	}
+
+	return nil, ErrNoUniverseMeta
+}
+
+// RegisterLeafSubscriber adds a new subscriber that is notified of every proof
+// leaf that is upserted into one of the given universes. If no universe is
+// given, the subscriber is notified of the leaves of all universes.
+//
+// NOTE: This is part of the MultiverseArchive interface.
+func (m *MemMultiverse) RegisterLeafSubscriber(
+	receiver *fn.EventReceiver[*LeafEvent], ids ...Identifier) error {
+
+	m.leafEventDistributor.RegisterSubscriber(receiver, ids...)
+
+	return nil
+}
+
+// RemoveLeafSubscriber removes the given leaf subscriber and also stops it
+// from processing events.
+//
+// NOTE: This is part of the MultiverseArchive interface.
+func (m *MemMultiverse) RemoveLeafSubscriber(
+	receiver *fn.EventReceiver[*LeafEvent]) error {
+
+	return m.leafEventDistributor.RemoveSubscriber(receiver)
+}
+
+// leafKeyFromLocator returns the universe ID and leaf key of the transfer
+// proof identified by the given locator.
+func leafKeyFromLocator(loc proof.Locator) (Identifier, LeafKey) {
+	id := Identifier{
+		AssetID:   *loc.AssetID,
+		GroupKey:  loc.GroupKey,
+		ProofType: ProofTypeTransfer,
+	}
+	scriptKey := asset.NewScriptKey(&loc.ScriptKey)
+	key := LeafKey{
+		ScriptKey: &scriptKey,
+	}
+	if loc.OutPoint != nil {
+		key.OutPoint = *loc.OutPoint
+	}
+
+	return id, key
+}
+
+// FetchProof fetches a proof for an asset uniquely identified by the passed
+// Locator. The returned blob contains the encoded full proof file, representing
+// the complete provenance of the asset.
+//
+// If a proof cannot be found, then ErrProofNotFound is returned.
+//
+// NOTE: This is part of the proof.NotifyArchiver interface.
+func (m *MemMultiverse) FetchProof(ctx context.Context,
+	originLocator proof.Locator) (proof.Blob, error) {
+
+	// The universe only delivers a single proof at a time, so we need a
+	// callback that we can feed into proof.FetchProofProvenance to assemble
+	// the full proof file.
+	fetchProof := func(ctx context.Context, loc proof.Locator) (proof.Blob,
+		error) {
+
+		uniID, leafKey := leafKeyFromLocator(loc)
+		proofs, err := m.FetchProofLeaf(ctx, uniID, leafKey)
+		if errors.Is(err, ErrNoUniverseProofFound) {
+			// If we didn't find a proof, maybe we arrived at the
+			// issuance proof, in which case we need to adjust the
+			// proof type.
+			uniID.ProofType = ProofTypeIssuance
+			proofs, err = m.FetchProofLeaf(ctx, uniID, leafKey)
+			if errors.Is(err, ErrNoUniverseProofFound) {
+				return nil, proof.ErrProofNotFound
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error fetching proof from "+
+				"archive: %w", err)
+		}
+
+		if len(proofs) > 1 {
+			return nil, fmt.Errorf("expected only one proof, "+
+				"got %d", len(proofs))
+		}
+
+		return proofs[0].Leaf.RawProof, nil
+	}
+
+	file, err := proof.FetchProofProvenance(
+		ctx, nil, originLocator, fetchProof,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching proof from archive: %w",
+			err)
+	}
+
+	var buf bytes.Buffer
+	if err := file.Encode(&buf); err != nil {
+		return nil, fmt.Errorf("error encoding proof file: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// RegisterSubscriber adds a new subscriber for receiving events. The
+// deliverExisting boolean indicates whether already existing items should be
+// sent to the NewItemCreated channel when the subscription is started. An
+// optional deliverFrom can be specified to indicate from which timestamp/index/
+// marker onward existing items should be delivered on startup. If deliverFrom
+// is nil/zero/empty then all existing items will be delivered.
+//
+// NOTE: This is part of the proof.NotifyArchiver interface.
+func (m *MemMultiverse) RegisterSubscriber(
+	receiver *fn.EventReceiver[proof.Blob], deliverExisting bool,
+	deliverFrom []*proof.Locator) error {
+
+	m.transferProofDistributor.RegisterSubscriber(receiver)
+
+	// No delivery of existing items requested, we're done here.
+	if !deliverExisting {
+		return nil
+	}
+
+	ctx := context.Background()
+	for _, loc := range deliverFrom {
+		if loc.AssetID == nil {
+			return fmt.Errorf("missing asset ID")
+		}
+
+		id, key := leafKeyFromLocator(*loc)
+		leaves, err := m.FetchProofLeaf(ctx, id, key)
+		if err != nil {
+			return err
+		}
+
+		for idx := range leaves {
+			rawProof := leaves[idx].Leaf.RawProof
+			receiver.NewItemCreated.ChanIn() <- rawProof
+		}
+	}
+
+	return nil
+}
+
+// RemoveSubscriber removes the given subscriber and also stops it from
+// processing events.
+//
+// NOTE: This is part of the proof.NotifyArchiver interface.
+func (m *MemMultiverse) RemoveSubscriber(
+	subscriber *fn.EventReceiver[proof.Blob]) error {
+
+	return m.transferProofDistributor.RemoveSubscriber(subscriber)
+}
+
+// NewBaseTree returns a base universe backend for the universe with the given
+// ID that is backed by the in-memory multiverse.
+func (m *MemMultiverse) NewBaseTree(id Identifier) BaseBackend {
+	return &memBaseUniverse{
+		multiverse: m,
+		id:         id,
+	}
+}
+
+// memBaseUniverse is a view on a single universe of an in-memory multiverse.
+type memBaseUniverse struct {
+	multiverse *MemMultiverse
+
+	id Identifier
+}
+
+// RootNode returns the root node for a given base universe.
+//
+// NOTE: This is part of the BaseBackend interface.
+func (b *memBaseUniverse) RootNode(ctx context.Context) (mssmt.Node, string,
+	error) {
+
+	root, err := b.multiverse.UniverseRootNode(ctx, b.id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return root.Node, root.AssetName, nil
+}
+
+// RegisterIssuance inserts a new minting leaf within the universe tree, stored
+// at the base key.
+//
+// NOTE: This is part of the BaseBackend interface.
+func (b *memBaseUniverse) RegisterIssuance(ctx context.Context, key LeafKey,
+	leaf *Leaf, metaReveal *proof.MetaReveal) (*Proof, error) {
+
+	return b.multiverse.UpsertProofLeaf(ctx, b.id, key, leaf, metaReveal)
+}
+
+// FetchIssuanceProof returns an issuance proof for the target key.
+//
+// NOTE: This is part of the BaseBackend interface.
+func (b *memBaseUniverse) FetchIssuanceProof(ctx context.Context,
+	key LeafKey) ([]*Proof, error) {
+
+	return b.multiverse.FetchProofLeaf(ctx, b.id, key)
+}
+
+// MintingKeys returns all the keys inserted in the universe.
+//
+// NOTE: This is part of the BaseBackend interface.
+func (b *memBaseUniverse) MintingKeys(ctx context.Context,
+	q UniverseLeafKeysQuery) ([]LeafKey, error) {
+
+	q.Id = b.id
+	return b.multiverse.UniverseLeafKeys(ctx, q)
+}
+
+// MintingLeaves returns all the minting leaves inserted into the universe.
+//
+// NOTE: This is part of the BaseBackend interface.
+func (b *memBaseUniverse) MintingLeaves(_ context.Context) ([]Leaf, error) {
+	b.multiverse.RLock()
+	defer b.multiverse.RUnlock()
+
+	uni, ok := b.multiverse.universes[b.id.String()]
+	if !ok {
+		return nil, nil
+	}
+
+	return fn.Map(uni.leaves, func(l *memLeaf) Leaf {
+		return *l.leaf
+	}), nil
+}
+
+// DeleteUniverse deletes all leaves, and the root, for the base universe.
+//
+// NOTE: This is part of the BaseBackend interface.
+func (b *memBaseUniverse) DeleteUniverse(ctx context.Context) (string, error) {
+	return b.multiverse.DeleteUniverse(ctx, b.id)
+}
+
+// A compile-time assertion to make sure MemMultiverse satisfies the
+// MultiverseArchive and proof.NotifyArchiver interfaces.
+var _ MultiverseArchive = (*MemMultiverse)(nil)
+var _ proof.NotifyArchiver = (*MemMultiverse)(nil)
+
+// A compile-time assertion to make sure memBaseUniverse satisfies the
+// BaseBackend interface.
+var _ BaseBackend = (*memBaseUniverse)(nil)
@@ -0,0 +1,98 @@
+package universe
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMemMultiverseStores tests that a multiverse that keeps its trees in
+// external tree stores has the same roots as one that keeps them in memory,
+// and that deleting a universe removes its nodes from the external store.
+func TestMemMultiverseStores(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// The external stores outlive the universes of the multiverse, just
+	// like a database backed store would.
+	stores := make(map[string]*mssmt.DefaultStore)
+	newStore := func(namespace string) mssmt.TreeStore {
+		store, ok := stores[namespace]
+		if !ok {
+			store = mssmt.NewDefaultStore()
+			stores[namespace] = store
+		}
+
+		return store
+	}
+
+	memMultiverse := NewMemMultiverse()
+	storeMultiverse := NewMemMultiverseWithStores(newStore)
+
+	genesisAsset := randGenesisAsset(t)
+	id := Identifier{
+		AssetID:   genesisAsset.ID(),
+		ProofType: ProofTypeIssuance,
+	}
+	for i := 0; i < 10; i++ {
+		key, leaf := randAuditLeaf(t, genesisAsset)
+		_, err := memMultiverse.UpsertProofLeaf(ctx, id, key, leaf, nil)
+		require.NoError(t, err)
+		_, err = storeMultiverse.UpsertProofLeaf(
+			ctx, id, key, leaf, nil,
+		)
+		require.NoError(t, err)
+	}
+
+	assertEqualRoots := func() {
+		t.Helper()
+
+		memRoot, err := memMultiverse.UniverseRootNode(ctx, id)
+		require.NoError(t, err)
+		storeRoot, err := storeMultiverse.UniverseRootNode(ctx, id)
+		require.NoError(t, err)
+		require.True(t, mssmt.IsEqualNode(memRoot.Node, storeRoot.Node))
+
+		memMultiRoot, err := memMultiverse.MultiverseRootNode(
+			ctx, ProofTypeIssuance,
+		)
+		require.NoError(t, err)
+		storeMultiRoot, err := storeMultiverse.MultiverseRootNode(
+			ctx, ProofTypeIssuance,
+		)
+		require.NoError(t, err)
+		require.Equal(
+			t, memMultiRoot.IsSome(), storeMultiRoot.IsSome(),
+		)
+		memMultiRoot.WhenSome(func(memRoot MultiverseRoot) {
+			storeRoot := storeMultiRoot.UnwrapToPtr()
+			require.True(t, mssmt.IsEqualNode(
+				memRoot.Node, storeRoot.Node,
+			))
+		})
+	}
+	assertEqualRoots()
+
+	// Deleting the universe also removes all its nodes from the external
+	// store, so its tree is empty again.
+	_, err := storeMultiverse.DeleteUniverse(ctx, id)
+	require.NoError(t, err)
+
+	uniTree := mssmt.NewCompactedTree(newStore(id.String()))
+	root, err := uniTree.Root(ctx)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(mssmt.EmptyTree[0], root))
+
+	// The universe can be recreated from scratch afterwards.
+	key, leaf := randAuditLeaf(t, genesisAsset)
+	_, err = memMultiverse.DeleteUniverse(ctx, id)
+	require.NoError(t, err)
+	_, err = memMultiverse.UpsertProofLeaf(ctx, id, key, leaf, nil)
+	require.NoError(t, err)
+	_, err = storeMultiverse.UpsertProofLeaf(ctx, id, key, leaf, nil)
+	require.NoError(t, err)
+	assertEqualRoots()
+}
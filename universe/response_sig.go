@@ -0,0 +1,124 @@
+package universe
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+)
+
+const (
+	// responseSigTag is the domain separation tag that is prefixed to
+	// every signed universe query response, so a response signature can't
+	// be confused with a signature over any other message created with the
+	// same key.
+	responseSigTag = "taproot-assets/universe-response"
+
+	// DefaultResponseSigMaxAge is the default maximum age of a signed
+	// universe query response before it is considered stale.
+	DefaultResponseSigMaxAge = 24 * time.Hour
+)
+
+var (
+	// ErrResponseSigInvalid is returned if the signature of a universe
+	// query response doesn't match the response or the server's identity
+	// key.
+	ErrResponseSigInvalid = errors.New("invalid universe response " +
+		"signature")
+
+	// ErrResponseSigExpired is returned if the signature of a universe
+	// query response is older than the maximum accepted age or lies too
+	// far in the future.
+	ErrResponseSigExpired = errors.New("universe response signature " +
+		"expired")
+)
+
+// ResponseSigner signs universe query responses with the identity key of the
+// universe server.
+type ResponseSigner interface {
+	// SignResponse creates a Schnorr signature over the SHA256 hash of the
+	// given message with the server's identity key.
+	SignResponse(ctx context.Context, msg []byte) (*schnorr.Signature,
+		error)
+}
+
+// ResponseSig is a signature of a universe server over a query response at a
+// given point in time. It allows clients that pinned the server's identity key
+// to detect responses that were tampered with by untrusted proxies or caches.
+type ResponseSig struct {
+	// Timestamp is the time the response was signed.
+	Timestamp time.Time
+
+	// Sig is the signature of the server's identity key over the response
+	// message.
+	Sig *schnorr.Signature
+}
+
+// ResponseSigMsg returns the message that is signed for a response of the
+// given query method. The message commits to the method, the signing time and
+// the hash of the serialized response.
+func ResponseSigMsg(method string, timestamp time.Time, resp []byte) []byte {
+	respHash := sha256.Sum256(resp)
+
+	var b bytes.Buffer
+	b.WriteString(responseSigTag)
+	b.WriteString(method)
+	_ = binary.Write(&b, binary.BigEndian, timestamp.Unix())
+	b.Write(respHash[:])
+
+	return b.Bytes()
+}
+
+// NewResponseSig signs the given serialized response of the given query method
+// with the passed signer.
+func NewResponseSig(ctx context.Context, signer ResponseSigner, method string,
+	timestamp time.Time, resp []byte) (*ResponseSig, error) {
+
+	// The signature only commits to full seconds, so we truncate the
+	// timestamp to make sure it round trips.
+	timestamp = time.Unix(timestamp.Unix(), 0).UTC()
+
+	sig, err := signer.SignResponse(
+		ctx, ResponseSigMsg(method, timestamp, resp),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign universe response: %w",
+			err)
+	}
+
+	return &ResponseSig{
+		Timestamp: timestamp,
+		Sig:       sig,
+	}, nil
+}
+
+// Verify checks that the signature was created by the given server identity
+// key over the given serialized response of the given query method, and that
+// its timestamp isn't further away from now than the given maximum age. A
+// maximum age of zero disables the age check.
+func (s *ResponseSig) Verify(pubKey *btcec.PublicKey, method string,
+	resp []byte, now time.Time, maxAge time.Duration) error {
+
+	msgHash := sha256.Sum256(ResponseSigMsg(method, s.Timestamp, resp))
+	if !s.Sig.Verify(msgHash[:], pubKey) {
+		return ErrResponseSigInvalid
+	}
+
+	if maxAge == 0 {
+		return nil
+	}
+
+	age := now.Sub(s.Timestamp)
+	if age > maxAge || age < -maxAge {
+		return fmt.Errorf("%w: signed at %v", ErrResponseSigExpired,
+			s.Timestamp)
+	}
+
+	return nil
+}
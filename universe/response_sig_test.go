@@ -0,0 +1,90 @@
+package universe
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// mockResponseSigner is a ResponseSigner that signs with a private key.
+type mockResponseSigner struct {
+	privKey *btcec.PrivateKey
+}
+
+// SignResponse creates a Schnorr signature over the SHA256 hash of the given
+// message.
+func (m *mockResponseSigner) SignResponse(_ context.Context,
+	msg []byte) (*schnorr.Signature, error) {
+
+	msgHash := sha256.Sum256(msg)
+	return schnorr.Sign(m.privKey, msgHash[:])
+}
+
+// TestResponseSig tests that universe query response signatures only verify
+// for the signed response, method, server key and within the maximum age.
+func TestResponseSig(t *testing.T) {
+	t.Parallel()
+
+	const (
+		method = "/universerpc.Universe/QueryProof"
+		maxAge = time.Hour
+	)
+
+	ctx := context.Background()
+	privKey := test.RandPrivKey(t)
+	signer := &mockResponseSigner{privKey: privKey}
+	resp := test.RandBytes(100)
+	now := time.Now()
+
+	sig, err := NewResponseSig(ctx, signer, method, now, resp)
+	require.NoError(t, err)
+	require.Equal(t, now.Unix(), sig.Timestamp.Unix())
+
+	// The signature should verify for the signed response.
+	pubKey := privKey.PubKey()
+	require.NoError(t, sig.Verify(pubKey, method, resp, now, maxAge))
+
+	// A tampered response, a different method or a different server key
+	// should be detected.
+	tampered := append([]byte{}, resp...)
+	tampered[0] ^= 0x01
+	require.ErrorIs(
+		t, sig.Verify(pubKey, method, tampered, now, maxAge),
+		ErrResponseSigInvalid,
+	)
+	require.ErrorIs(
+		t, sig.Verify(
+			pubKey, "/universerpc.Universe/AssetLeafKeys", resp,
+			now, maxAge,
+		), ErrResponseSigInvalid,
+	)
+	require.ErrorIs(
+		t, sig.Verify(
+			test.RandPubKey(t), method, resp, now, maxAge,
+		), ErrResponseSigInvalid,
+	)
+
+	// Changing the timestamp invalidates the signature as well, so a stale
+	// response can't be passed off as a fresh one.
+	replayed := *sig
+	replayed.Timestamp = sig.Timestamp.Add(time.Minute)
+	require.ErrorIs(
+		t, replayed.Verify(pubKey, method, resp, now, maxAge),
+		ErrResponseSigInvalid,
+	)
+
+	// Signatures older than the maximum age are rejected, unless the age
+	// check is disabled.
+	later := now.Add(2 * maxAge)
+	require.ErrorIs(
+		t, sig.Verify(pubKey, method, resp, later, maxAge),
+		ErrResponseSigExpired,
+	)
+	require.NoError(t, sig.Verify(pubKey, method, resp, later, 0))
+}
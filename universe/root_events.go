@@ -0,0 +1,290 @@
+package universe
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+)
+
+// RootEvent is the event that is emitted whenever the root of a local universe
+// changed, either because a proof was inserted locally or because new leaves
+// were synced from the federation.
+type RootEvent struct {
+	// Root is the new root of the universe, including its identifier.
+	Root Root
+
+	// timestamp is the time the root change was detected.
+	timestamp time.Time
+}
+
+// Timestamp returns the time the root change was detected.
+//
+// NOTE: This is part of the fn.Event interface.
+func (e *RootEvent) Timestamp() time.Time {
+	return e.timestamp
+}
+
+// A compile-time assertion to make sure RootEvent satisfies the fn.Event
+// interface.
+var _ fn.Event = (*RootEvent)(nil)
+
+// RootFilter limits the root events a subscriber receives to the universes of
+// a set of assets and asset groups. An empty filter matches all universes.
+type RootFilter struct {
+	// AssetIDs is the set of asset IDs to receive root events for. The
+	// universe of an asset group matches if a leaf of one of the assets
+	// changed its root.
+	AssetIDs []asset.ID
+
+	// GroupKeys is the set of group keys to receive root events for.
+	GroupKeys []*btcec.PublicKey
+}
+
+// rootSubscription is a subscriber of root events along with the set of
+// assets and asset groups it is interested in.
+type rootSubscription struct {
+	receiver *fn.EventReceiver[*RootEvent]
+
+	// assetIDs is the set of asset IDs the subscriber is interested in.
+	assetIDs fn.Set[asset.ID]
+
+	// groupKeys is the set of x-only group keys the subscriber is
+	// interested in.
+	groupKeys fn.Set[[schnorr.PubKeyBytesLen]byte]
+}
+
+// matches returns true if the subscriber is interested in the root change
+// caused by the given leaf event.
+func (s *rootSubscription) matches(event *LeafEvent) bool {
+	if len(s.assetIDs) == 0 && len(s.groupKeys) == 0 {
+		return true
+	}
+
+	if event.ID.GroupKey != nil {
+		var groupKey [schnorr.PubKeyBytesLen]byte
+		copy(groupKey[:], schnorr.SerializePubKey(event.ID.GroupKey))
+
+		if s.groupKeys.Contains(groupKey) {
+			return true
+		}
+	}
+
+	if s.assetIDs.Contains(event.ID.AssetID) {
+		return true
+	}
+
+	return event.Leaf != nil && event.Leaf.Asset != nil &&
+		s.assetIDs.Contains(event.Leaf.Asset.ID())
+}
+
+// RootEventNotifierConfig is the main config for the root event notifier.
+type RootEventNotifierConfig struct {
+	// Multiverse is the multiverse whose universe roots are watched.
+	Multiverse MultiverseArchive
+}
+
+// RootEventNotifier notifies subscribers whenever the root of one of the local
+// universes they are interested in changes. It watches the leaves upserted
+// into the multiverse and looks up the new root of each changed universe.
+type RootEventNotifier struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg RootEventNotifierConfig
+
+	// leafEvents is the subscription to the leaves upserted into the
+	// multiverse.
+	leafEvents *fn.EventReceiver[*LeafEvent]
+
+	// subscribers is the set of active subscriptions, keyed by the ID of
+	// their receiver.
+	subscribers map[uint64]*rootSubscription
+
+	// lastRoots is the hash of the last root that was sent out for each
+	// universe, keyed by the string form of the universe ID. This makes
+	// sure a batch of leaves for the same universe only results in a
+	// single event.
+	lastRoots map[string]mssmt.NodeHash
+
+	// subscriberMtx guards the subscribers and lastRoots maps.
+	subscriberMtx sync.Mutex
+
+	// ContextGuard provides a wait group and main quit channel that can be
+	// used to create guarded contexts.
+	*fn.ContextGuard
+}
+
+// NewRootEventNotifier creates a new universe root event notifier.
+func NewRootEventNotifier(cfg RootEventNotifierConfig) *RootEventNotifier {
+	return &RootEventNotifier{
+		cfg: cfg,
+		leafEvents: fn.NewEventReceiver[*LeafEvent](
+			fn.DefaultQueueSize,
+		),
+		subscribers: make(map[uint64]*rootSubscription),
+		lastRoots:   make(map[string]mssmt.NodeHash),
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start starts watching the leaves upserted into the multiverse.
+func (n *RootEventNotifier) Start() error {
+	var startErr error
+	n.startOnce.Do(func() {
+		log.Infof("Starting universe root event notifier")
+
+		startErr = n.cfg.Multiverse.RegisterLeafSubscriber(
+			n.leafEvents,
+		)
+		if startErr != nil {
+			return
+		}
+
+		n.Wg.Add(1)
+		go n.watchLeaves()
+	})
+
+	return startErr
+}
+
+// Stop stops the notifier and all its subscribers.
+func (n *RootEventNotifier) Stop() error {
+	var stopErr error
+	n.stopOnce.Do(func() {
+		close(n.Quit)
+		n.Wg.Wait()
+
+		stopErr = n.cfg.Multiverse.RemoveLeafSubscriber(n.leafEvents)
+
+		n.subscriberMtx.Lock()
+		defer n.subscriberMtx.Unlock()
+
+		for id, sub := range n.subscribers {
+			sub.receiver.Stop()
+			delete(n.subscribers, id)
+		}
+	})
+
+	return stopErr
+}
+
+// RegisterSubscriber adds a new subscriber that is notified whenever the root
+// of a universe that matches the given filter changes.
+func (n *RootEventNotifier) RegisterSubscriber(
+	receiver *fn.EventReceiver[*RootEvent], filter RootFilter) {
+
+	n.subscriberMtx.Lock()
+	defer n.subscriberMtx.Unlock()
+
+	groupKeys := fn.Map(
+		filter.GroupKeys,
+		func(key *btcec.PublicKey) [schnorr.PubKeyBytesLen]byte {
+			var xOnly [schnorr.PubKeyBytesLen]byte
+			copy(xOnly[:], schnorr.SerializePubKey(key))
+
+			return xOnly
+		},
+	)
+
+	n.subscribers[receiver.ID()] = &rootSubscription{
+		receiver:  receiver,
+		assetIDs:  fn.NewSet(filter.AssetIDs...),
+		groupKeys: fn.NewSet(groupKeys...),
+	}
+}
+
+// RemoveSubscriber removes the given subscriber and also stops it from
+// processing events.
+func (n *RootEventNotifier) RemoveSubscriber(
+	receiver *fn.EventReceiver[*RootEvent]) error {
+
+	n.subscriberMtx.Lock()
+	defer n.subscriberMtx.Unlock()
+
+	if _, ok := n.subscribers[receiver.ID()]; !ok {
+		return fmt.Errorf("subscriber with ID %d not found",
+			receiver.ID())
+	}
+
+	receiver.Stop()
+	delete(n.subscribers, receiver.ID())
+
+	return nil
+}
+
+// watchLeaves looks up the new root of each universe a leaf was upserted into
+// and notifies the interested subscribers.
+//
+// NOTE: This MUST be run as a goroutine.
+func (n *RootEventNotifier) watchLeaves() {
+	defer n.Wg.Done()
+
+	for {
+		select {
+		case event := <-n.leafEvents.NewItemCreated.ChanOut():
+			n.handleLeafEvent(event)
+
+		case <-n.Quit:
+			return
+		}
+	}
+}
+
+// handleLeafEvent notifies the subscribers interested in the universe of the
+// given leaf event about its new root, if it changed.
+func (n *RootEventNotifier) handleLeafEvent(event *LeafEvent) {
+	n.subscriberMtx.Lock()
+	defer n.subscriberMtx.Unlock()
+
+	var interested []*rootSubscription
+	for _, sub := range n.subscribers {
+		if sub.matches(event) {
+			interested = append(interested, sub)
+		}
+	}
+
+	// We only look up the root if anyone is interested in it.
+	if len(interested) == 0 {
+		return
+	}
+
+	ctx, cancel := n.WithCtxQuit()
+	defer cancel()
+
+	root, err := n.cfg.Multiverse.UniverseRootNode(ctx, event.ID)
+	if err != nil {
+		log.Errorf("Unable to fetch root of universe %v: %v",
+			event.ID.StringForLog(), err)
+		return
+	}
+
+	// All leaves of a batch are only announced once the batch is stored,
+	// so the root of the first event already includes all of them.
+	idStr := event.ID.String()
+	rootHash := root.NodeHash()
+	if lastRoot, ok := n.lastRoots[idStr]; ok && lastRoot == rootHash {
+		return
+	}
+	n.lastRoots[idStr] = rootHash
+
+	log.Debugf("Root of universe %v changed to %v, notifying %d "+
+		"subscribers", event.ID.StringForLog(), rootHash,
+		len(interested))
+
+	rootEvent := &RootEvent{
+		Root:      root,
+		timestamp: time.Now(),
+	}
+	for _, sub := range interested {
+		sub.receiver.NewItemCreated.ChanIn() <- rootEvent
+	}
+}
@@ -0,0 +1,113 @@
+package universe
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRootEventNotifier tests that subscribers are notified about root changes
+// of the universes that match their filter only.
+func TestRootEventNotifier(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	multiverse := NewMemMultiverse()
+
+	notifier := NewRootEventNotifier(RootEventNotifierConfig{
+		Multiverse: multiverse,
+	})
+	require.NoError(t, notifier.Start())
+	t.Cleanup(func() {
+		require.NoError(t, notifier.Stop())
+	})
+
+	firstAsset := randGenesisAsset(t)
+	secondAsset := randGenesisAsset(t)
+	firstID := Identifier{
+		AssetID:   firstAsset.ID(),
+		ProofType: ProofTypeIssuance,
+	}
+	secondID := Identifier{
+		AssetID:   secondAsset.ID(),
+		ProofType: ProofTypeIssuance,
+	}
+
+	newReceiver := func() *fn.EventReceiver[*RootEvent] {
+		return fn.NewEventReceiver[*RootEvent](fn.DefaultQueueSize)
+	}
+	allRoots := newReceiver()
+	firstRoots := newReceiver()
+	groupRoots := newReceiver()
+
+	notifier.RegisterSubscriber(allRoots, RootFilter{})
+	notifier.RegisterSubscriber(firstRoots, RootFilter{
+		AssetIDs: []asset.ID{firstAsset.ID()},
+	})
+	notifier.RegisterSubscriber(groupRoots, RootFilter{
+		GroupKeys: []*btcec.PublicKey{test.RandPubKey(t)},
+	})
+
+	assertRootEvent := func(receiver *fn.EventReceiver[*RootEvent],
+		id Identifier) {
+
+		t.Helper()
+
+		select {
+		case event := <-receiver.NewItemCreated.ChanOut():
+			require.Equal(t, id.String(), event.Root.ID.String())
+
+			root, err := multiverse.UniverseRootNode(ctx, id)
+			require.NoError(t, err)
+			require.True(t, mssmt.IsEqualNode(
+				root.Node, event.Root.Node,
+			))
+
+		case <-time.After(DefaultTimeout):
+			t.Fatalf("no root event received for %v", id)
+		}
+	}
+	assertNoRootEvent := func(receiver *fn.EventReceiver[*RootEvent]) {
+		t.Helper()
+
+		select {
+		case event := <-receiver.NewItemCreated.ChanOut():
+			t.Fatalf("unexpected root event for %v",
+				event.Root.ID.String())
+
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+
+	key, leaf := randAuditLeaf(t, firstAsset)
+	_, err := multiverse.UpsertProofLeaf(ctx, firstID, key, leaf, nil)
+	require.NoError(t, err)
+
+	assertRootEvent(allRoots, firstID)
+	assertRootEvent(firstRoots, firstID)
+
+	key, leaf = randAuditLeaf(t, secondAsset)
+	_, err = multiverse.UpsertProofLeaf(ctx, secondID, key, leaf, nil)
+	require.NoError(t, err)
+
+	assertRootEvent(allRoots, secondID)
+	assertNoRootEvent(firstRoots)
+	assertNoRootEvent(groupRoots)
+
+	// Once removed, a subscriber no longer receives events.
+	require.NoError(t, notifier.RemoveSubscriber(firstRoots))
+	require.Error(t, notifier.RemoveSubscriber(firstRoots))
+
+	key, leaf = randAuditLeaf(t, firstAsset)
+	_, err = multiverse.UpsertProofLeaf(ctx, firstID, key, leaf, nil)
+	require.NoError(t, err)
+
+	assertRootEvent(allRoots, firstID)
+}
@@ -0,0 +1,443 @@
+package universe
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+)
+
+const (
+	// rootNotificationQueueSize is the number of incoming and outgoing
+	// root notifications the federation envoy buffers. If the queue is
+	// full, new notifications are dropped, as the periodic sync will pick
+	// up the changes eventually.
+	rootNotificationQueueSize = 100
+
+	// rootNotificationSigTag is the domain separation tag that is prefixed
+	// to every signed root notification, so a notification signature can't
+	// be confused with a signature over any other message created with the
+	// same key.
+	rootNotificationSigTag = "taproot-assets/root-notification"
+
+	// DefaultRootNotificationMaxAge is the default maximum age of a signed
+	// root notification before it is considered stale.
+	DefaultRootNotificationMaxAge = 10 * time.Minute
+)
+
+var (
+	// ErrRootNotificationInvalid is returned if a root notification is
+	// malformed or its signature doesn't match the identity key of the
+	// federation member it claims to originate from.
+	ErrRootNotificationInvalid = errors.New("invalid root notification")
+
+	// ErrRootNotificationExpired is returned if a root notification is
+	// older than the maximum accepted age or lies too far in the future.
+	ErrRootNotificationExpired = errors.New("root notification expired")
+)
+
+// RootNotification is a lightweight notification a federation member sends to
+// its peers once one of its universe roots changed. It allows the peers to pull
+// the new leaves right away instead of waiting for the next periodic sync.
+type RootNotification struct {
+	// ID is the identifier of the universe that changed.
+	ID Identifier
+
+	// Root is the new root of the universe.
+	Root mssmt.Node
+
+	// Height is the highest block height of the leaves that resulted in
+	// the new root.
+	Height uint32
+
+	// Origin is the public address of the universe server that sent the
+	// notification. This is the server the new leaves are pulled from.
+	Origin ServerAddr
+
+	// Timestamp is the time the notification was signed.
+	Timestamp time.Time
+
+	// Sig is the signature of the origin's identity key over the
+	// notification.
+	Sig *schnorr.Signature
+}
+
+// rootNotificationMsg returns the message that is signed for the notification.
+// The message commits to the universe, the new root, the block height, the
+// origin and the signing time.
+func (r *RootNotification) rootNotificationMsg() []byte {
+	rootHash := r.Root.NodeHash()
+
+	var b bytes.Buffer
+	b.WriteString(rootNotificationSigTag)
+	b.Write(r.ID.AssetID[:])
+	if r.ID.GroupKey != nil {
+		b.Write(schnorr.SerializePubKey(r.ID.GroupKey))
+	}
+	b.WriteByte(byte(r.ID.ProofType))
+	b.Write(rootHash[:])
+	_ = binary.Write(&b, binary.BigEndian, r.Root.NodeSum())
+	_ = binary.Write(&b, binary.BigEndian, r.Height)
+
+	origin := r.Origin.HostStr()
+	_ = binary.Write(&b, binary.BigEndian, uint16(len(origin)))
+	b.WriteString(origin)
+	_ = binary.Write(&b, binary.BigEndian, r.Timestamp.Unix())
+
+	return b.Bytes()
+}
+
+// Sign signs the notification at the given time with the passed signer, which
+// must sign with the identity key the receivers know for the origin.
+func (r *RootNotification) Sign(ctx context.Context, signer ResponseSigner,
+	timestamp time.Time) error {
+
+	// The signature only commits to full seconds, so we truncate the
+	// timestamp to make sure it round trips.
+	r.Timestamp = time.Unix(timestamp.Unix(), 0).UTC()
+
+	sig, err := signer.SignResponse(ctx, r.rootNotificationMsg())
+	if err != nil {
+		return fmt.Errorf("unable to sign root notification: %w", err)
+	}
+	r.Sig = sig
+
+	return nil
+}
+
+// Verify checks that the notification was signed by the given identity key of
+// its origin, and that its timestamp isn't further away from now than the
+// given maximum age. A maximum age of zero disables the age check.
+func (r *RootNotification) Verify(pubKey *btcec.PublicKey, now time.Time,
+	maxAge time.Duration) error {
+
+	if r.Root == nil || r.Sig == nil {
+		return fmt.Errorf("%w: missing root or signature",
+			ErrRootNotificationInvalid)
+	}
+
+	msgHash := sha256.Sum256(r.rootNotificationMsg())
+	if !r.Sig.Verify(msgHash[:], pubKey) {
+		return fmt.Errorf("%w: signature mismatch",
+			ErrRootNotificationInvalid)
+	}
+
+	if maxAge == 0 {
+		return nil
+	}
+
+	age := now.Sub(r.Timestamp)
+	if age > maxAge || age < -maxAge {
+		return fmt.Errorf("%w: signed at %v",
+			ErrRootNotificationExpired, r.Timestamp)
+	}
+
+	return nil
+}
+
+// String returns a human-readable description of the notification.
+func (r *RootNotification) String() string {
+	rootHash := r.Root.NodeHash()
+	return fmt.Sprintf("universe %v root changed to %v (sum=%d) at "+
+		"height %d, origin=%v", r.ID.String(), rootHash,
+		r.Root.NodeSum(), r.Height, r.Origin.HostStr())
+}
+
+// RootNotifier is used to deliver root change notifications to a remote
+// universe server.
+type RootNotifier interface {
+	// NotifyRootChange notifies the remote universe server that one of
+	// our universe roots changed.
+	NotifyRootChange(ctx context.Context, notif *RootNotification) error
+
+	// Close is used to shutdown the active notifier instance.
+	Close() error
+}
+
+// outboundRootNotification is a root notification that is queued to be sent
+// out to the federation.
+type outboundRootNotification struct {
+	*RootNotification
+
+	// skipServer is the federation member that doesn't need to be
+	// notified, as the new leaves were pulled from it.
+	skipServer fn.Option[ServerAddr]
+}
+
+// leafBlockHeight returns the block height of the proof of the given leaf, or
+// zero if it can't be decoded.
+func leafBlockHeight(leaf *Leaf) uint32 {
+	if leaf == nil {
+		return 0
+	}
+
+	var blockHeight uint32
+	_ = proof.SparseDecode(
+		bytes.NewReader(leaf.RawProof),
+		proof.BlockHeightRecord(&blockHeight),
+	)
+
+	return blockHeight
+}
+
+// maxLeafBlockHeight returns the highest block height of the given leaves.
+func maxLeafBlockHeight(leaves []*Leaf) uint32 {
+	var maxHeight uint32
+	for _, leaf := range leaves {
+		if height := leafBlockHeight(leaf); height > maxHeight {
+			maxHeight = height
+		}
+	}
+
+	return maxHeight
+}
+
+// NotifyRootChange queues a notification for the federation members that the
+// root of the given universe changed after the given leaves were inserted
+// locally. The notification is only sent out if our own public address is
+// known.
+func (f *FederationEnvoy) NotifyRootChange(id Identifier, root mssmt.Node,
+	leaves ...*Leaf) {
+
+	f.queueRootNotification(
+		id, root, maxLeafBlockHeight(leaves), fn.None[ServerAddr](),
+	)
+}
+
+// queueRootNotifications queues a root notification for each universe that
+// changed during a sync with the given server.
+func (f *FederationEnvoy) queueRootNotifications(diffs []AssetSyncDiff,
+	source fn.Option[ServerAddr]) {
+
+	for _, diff := range diffs {
+		newRoot := diff.NewUniverseRoot
+		if newRoot.Node == nil {
+			continue
+		}
+
+		f.queueRootNotification(
+			newRoot.ID, newRoot.Node,
+			maxLeafBlockHeight(diff.NewLeafProofs), source,
+		)
+	}
+}
+
+// queueRootNotification queues a root notification to be sent out to all
+// federation members except the given one. If the queue is full, the
+// notification is dropped.
+func (f *FederationEnvoy) queueRootNotification(id Identifier,
+	root mssmt.Node, height uint32, skipServer fn.Option[ServerAddr]) {
+
+	if f.cfg.NewRootNotifier == nil || f.cfg.RootNotifSigner == nil ||
+		f.cfg.LocalAddr.IsNone() {
+
+		return
+	}
+
+	notif := &outboundRootNotification{
+		RootNotification: &RootNotification{
+			ID:     id,
+			Root:   root,
+			Height: height,
+			Origin: f.cfg.LocalAddr.UnwrapOr(ServerAddr{}),
+		},
+		skipServer: skipServer,
+	}
+
+	select {
+	case f.outgoingRootNotifs <- notif:
+	default:
+		log.Debugf("Root notification queue full, dropping "+
+			"notification: %v", notif.RootNotification)
+	}
+}
+
+// notifyFederation sends the given root notification out to all federation
+// members in parallel.
+func (f *FederationEnvoy) notifyFederation(notif *outboundRootNotification) {
+	ctx, cancel := f.WithCtxQuit()
+	defer cancel()
+
+	// We only invite the federation to pull the new leaves if we'd also
+	// push them out.
+	syncConfigs, err := f.QuerySyncConfigs(ctx)
+	if err != nil {
+		log.Warnf("Unable to query sync configs: %v", err)
+		return
+	}
+	if !syncConfigs.IsSyncExportEnabled(notif.ID) {
+		return
+	}
+
+	fedServers, err := f.tryFetchServers()
+	if err != nil {
+		log.Warnf("Unable to fetch federation servers: %v", err)
+		return
+	}
+
+	// There's no need to notify the server we pulled the leaves from.
+	skipHost := fn.MapOptionZ(notif.skipServer, func(s ServerAddr) string {
+		return s.HostStr()
+	})
+	fedServers = fn.Filter(fedServers, func(a ServerAddr) bool {
+		return a.HostStr() != skipHost
+	})
+	if len(fedServers) == 0 {
+		return
+	}
+
+	// The receivers only accept notifications that are signed with the
+	// identity key they know for us.
+	err = notif.Sign(ctx, f.cfg.RootNotifSigner, time.Now())
+	if err != nil {
+		log.Warnf("Unable to sign root notification: %v", err)
+		return
+	}
+
+	log.Debugf("Notifying %v federation members: %v", len(fedServers),
+		notif.RootNotification)
+
+	sendNotification := func(ctx context.Context, addr ServerAddr) error {
+		notifier, err := f.cfg.NewRootNotifier(addr)
+		if err != nil {
+			log.Warnf("Unable to connect to server(%v) for root "+
+				"notification: %v", addr.HostStr(), err)
+			return nil
+		}
+		defer notifier.Close()
+
+		err = notifier.NotifyRootChange(ctx, notif.RootNotification)
+		if err != nil {
+			log.Warnf("Unable to send root notification to "+
+				"server(%v): %v", addr.HostStr(), err)
+		}
+
+		return nil
+	}
+
+	err = fn.ParSlice(ctx, fedServers, sendNotification)
+	if err != nil {
+		log.Warnf("Unable to notify federation: %v", err)
+	}
+}
+
+// HandleRootNotification verifies a root change notification received from a
+// federation member and queues it, so the new leaves can be pulled from it
+// right away. The notification must be signed with the identity key that was
+// set for the federation member it originates from. If the queue is full, an
+// error is returned and the change will be picked up by the next periodic sync
+// instead.
+func (f *FederationEnvoy) HandleRootNotification(ctx context.Context,
+	notif *RootNotification) error {
+
+	if notif == nil || notif.Root == nil {
+		return fmt.Errorf("%w: missing root",
+			ErrRootNotificationInvalid)
+	}
+
+	fedServers, err := f.cfg.FederationDB.UniverseServers(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to fetch federation servers: %w", err)
+	}
+
+	// We only accept notifications from federation members we know the
+	// identity key of, so nobody else can make us pull in their name.
+	originHost := notif.Origin.HostStr()
+	origin, err := fn.First(
+		fn.Map(fedServers, fn.Ptr[ServerAddr]),
+		func(a *ServerAddr) bool {
+			return a.HostStr() == originHost
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("%w: origin %v is not a federation member",
+			ErrRootNotificationInvalid, originHost)
+	}
+
+	identityKey, err := origin.IdentityKey.UnwrapOrErr(fmt.Errorf(
+		"%w: no identity key set for origin %v",
+		ErrRootNotificationInvalid, originHost,
+	))
+	if err != nil {
+		return err
+	}
+
+	err = notif.Verify(
+		&identityKey, time.Now(), DefaultRootNotificationMaxAge,
+	)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case f.incomingRootNotifs <- notif:
+		return nil
+
+	case <-f.Quit:
+		return fmt.Errorf("federation envoy shutting down")
+
+	default:
+		return fmt.Errorf("root notification queue full")
+	}
+}
+
+// handleRootNotification is called each time a root change notification is
+// received from a federation member. If we don't have the new root yet, we
+// pull the changed universe from the notifying server.
+func (f *FederationEnvoy) handleRootNotification(
+	notif *RootNotification) error {
+
+	ctx, cancel := f.WithCtxQuit()
+	defer cancel()
+
+	// We only pull from the members of our federation, so a notification
+	// can't make us connect to arbitrary servers.
+	fedServers, err := f.tryFetchServers()
+	if err != nil {
+		return fmt.Errorf("unable to fetch federation servers: %w", err)
+	}
+
+	originHost := notif.Origin.HostStr()
+	origin, err := fn.First(
+		fn.Map(fedServers, fn.Ptr[ServerAddr]),
+		func(a *ServerAddr) bool {
+			return a.HostStr() == originHost
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("notification origin %v is not a federation "+
+			"member", originHost)
+	}
+
+	syncConfigs, err := f.QuerySyncConfigs(ctx)
+	if err != nil {
+		return err
+	}
+	if !syncConfigs.IsSyncInsertEnabled(notif.ID) {
+		log.Debugf("Ignoring root notification for universe %v, sync "+
+			"insert disabled", notif.ID.String())
+		return nil
+	}
+
+	// If we already have the new root, for example because the leaves
+	// were pushed to us directly, there's nothing to pull.
+	if f.cfg.LocalDiffEngine != nil {
+		localRoot, err := f.cfg.LocalDiffEngine.RootNode(ctx, notif.ID)
+		if err == nil && localRoot.Node != nil &&
+			mssmt.IsEqualNode(localRoot.Node, notif.Root) {
+
+			return nil
+		}
+	}
+
+	return f.syncServerState(ctx, *origin, *syncConfigs, notif.ID)
+}
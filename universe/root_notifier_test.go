@@ -0,0 +1,192 @@
+package universe
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRootNotificationQueues tests that root notifications are only queued
+// for the federation if our own address and signer are known, and that both
+// the incoming and outgoing queues drop notifications once they are full.
+func TestRootNotificationQueues(t *testing.T) {
+	t.Parallel()
+
+	id := Identifier{
+		AssetID:   asset.RandID(t),
+		ProofType: ProofTypeIssuance,
+	}
+	root := mssmt.NewComputedBranch(mssmt.NodeHash{1}, 42)
+	newNotifier := func(ServerAddr) (RootNotifier, error) {
+		return nil, nil
+	}
+
+	signer := &mockResponseSigner{
+		privKey: test.RandPrivKey(t),
+	}
+
+	// Without a local address, there's nothing we could tell the
+	// federation members to pull from.
+	envoy := NewFederationEnvoy(FederationConfig{
+		NewRootNotifier: newNotifier,
+		RootNotifSigner: signer,
+	})
+	envoy.NotifyRootChange(id, root)
+	require.Empty(t, envoy.outgoingRootNotifs)
+
+	// Without a signer, the federation members wouldn't accept our
+	// notifications.
+	localAddr := NewServerAddrFromStr("universe.example.com:10029")
+	envoy = NewFederationEnvoy(FederationConfig{
+		NewRootNotifier: newNotifier,
+		LocalAddr:       fn.Some(localAddr),
+	})
+	envoy.NotifyRootChange(id, root)
+	require.Empty(t, envoy.outgoingRootNotifs)
+
+	syncAddr := NewServerAddrFromStr("other.example.com:10029")
+	syncAddr.IdentityKey = fn.Some(*signer.privKey.PubKey())
+	envoy = NewFederationEnvoy(FederationConfig{
+		FederationDB: &mockFederationLog{
+			servers: []ServerAddr{syncAddr},
+		},
+		NewRootNotifier: newNotifier,
+		RootNotifSigner: signer,
+		LocalAddr:       fn.Some(localAddr),
+	})
+
+	// A diff from a sync results in a notification that skips the server
+	// we synced from.
+	envoy.queueRootNotifications([]AssetSyncDiff{{
+		NewUniverseRoot: Root{
+			ID:   id,
+			Node: root,
+		},
+	}}, fn.Some(syncAddr))
+	require.Len(t, envoy.outgoingRootNotifs, 1)
+
+	notif := <-envoy.outgoingRootNotifs
+	require.Equal(t, id, notif.ID)
+	require.True(t, mssmt.IsEqualNode(root, notif.Root))
+	require.Equal(t, localAddr.HostStr(), notif.Origin.HostStr())
+	require.Equal(t, fn.Some(syncAddr), notif.skipServer)
+
+	// Once the outgoing queue is full, new notifications are dropped.
+	for i := 0; i < rootNotificationQueueSize+1; i++ {
+		envoy.NotifyRootChange(id, root)
+	}
+	require.Len(t, envoy.outgoingRootNotifs, rootNotificationQueueSize)
+
+	// Incoming notifications without a root are rejected, and an error is
+	// returned once the incoming queue is full.
+	ctx := context.Background()
+	require.ErrorIs(t, envoy.HandleRootNotification(ctx, &RootNotification{
+		ID: id,
+	}), ErrRootNotificationInvalid)
+
+	incoming := &RootNotification{
+		ID:     id,
+		Root:   root,
+		Origin: syncAddr,
+	}
+	require.NoError(t, incoming.Sign(ctx, signer, time.Now()))
+	for i := 0; i < rootNotificationQueueSize; i++ {
+		require.NoError(t, envoy.HandleRootNotification(ctx, incoming))
+	}
+	require.ErrorContains(
+		t, envoy.HandleRootNotification(ctx, incoming), "queue full",
+	)
+}
+
+// TestHandleRootNotificationAuth tests that incoming root notifications are
+// only accepted if they are signed with the identity key that was set for the
+// federation member they originate from.
+func TestHandleRootNotificationAuth(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	signer := &mockResponseSigner{
+		privKey: test.RandPrivKey(t),
+	}
+
+	member := NewServerAddrFromStr("member.example.com:10029")
+	member.IdentityKey = fn.Some(*signer.privKey.PubKey())
+	noKeyMember := NewServerAddrFromStr("nokey.example.com:10029")
+
+	envoy := NewFederationEnvoy(FederationConfig{
+		FederationDB: &mockFederationLog{
+			servers: []ServerAddr{member, noKeyMember},
+		},
+	})
+
+	newNotif := func(origin ServerAddr) *RootNotification {
+		return &RootNotification{
+			ID: Identifier{
+				AssetID:   asset.RandID(t),
+				ProofType: ProofTypeIssuance,
+			},
+			Root:   mssmt.NewComputedBranch(mssmt.NodeHash{1}, 42),
+			Height: 100,
+			Origin: origin,
+		}
+	}
+
+	// A notification of the member signed with its key is accepted.
+	notif := newNotif(member)
+	require.NoError(t, notif.Sign(ctx, signer, time.Now()))
+	require.NoError(t, envoy.HandleRootNotification(ctx, notif))
+
+	// Unsigned notifications are rejected.
+	require.ErrorIs(
+		t, envoy.HandleRootNotification(ctx, newNotif(member)),
+		ErrRootNotificationInvalid,
+	)
+
+	// Tampering with the signed notification invalidates the signature.
+	tampered := *notif
+	tampered.Height++
+	require.ErrorIs(
+		t, envoy.HandleRootNotification(ctx, &tampered),
+		ErrRootNotificationInvalid,
+	)
+
+	// A notification signed by another key is rejected.
+	otherSigner := &mockResponseSigner{
+		privKey: test.RandPrivKey(t),
+	}
+	notif = newNotif(member)
+	require.NoError(t, notif.Sign(ctx, otherSigner, time.Now()))
+	require.ErrorIs(
+		t, envoy.HandleRootNotification(ctx, notif),
+		ErrRootNotificationInvalid,
+	)
+
+	// Members without an identity key and unknown servers can't notify
+	// us, even if the notification is signed.
+	for _, origin := range []ServerAddr{
+		noKeyMember,
+		NewServerAddrFromStr("evil.example.com:10029"),
+	} {
+		notif = newNotif(origin)
+		require.NoError(t, notif.Sign(ctx, signer, time.Now()))
+		require.ErrorIs(
+			t, envoy.HandleRootNotification(ctx, notif),
+			ErrRootNotificationInvalid,
+		)
+	}
+
+	// Stale notifications are rejected.
+	notif = newNotif(member)
+	staleTime := time.Now().Add(-2 * DefaultRootNotificationMaxAge)
+	require.NoError(t, notif.Sign(ctx, signer, staleTime))
+	require.ErrorIs(
+		t, envoy.HandleRootNotification(ctx, notif),
+		ErrRootNotificationExpired,
+	)
+}
@@ -0,0 +1,585 @@
+package universe
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// snapshotSigTag is the domain separation tag that is prefixed to the
+	// signed message of every universe snapshot, so a snapshot signature
+	// can't be confused with a signature over any other message created
+	// with the same key.
+	snapshotSigTag = "taproot-assets/universe-snapshot"
+
+	// SnapshotMaxNumLeaves is the maximum number of leaves we accept in a
+	// single universe snapshot, to avoid OOM attacks.
+	SnapshotMaxNumLeaves = 1_000_000
+
+	// SnapshotMaxNumSigs is the maximum number of signatures we accept on
+	// a single universe snapshot.
+	SnapshotMaxNumSigs = 100
+)
+
+var (
+	// SnapshotMagicBytes are the magic bytes every encoded universe
+	// snapshot starts with.
+	SnapshotMagicBytes = [4]byte{'T', 'A', 'P', 'S'}
+
+	// ErrUnknownSnapshotVersion is returned when a universe snapshot with
+	// an unknown version is decoded.
+	ErrUnknownSnapshotVersion = errors.New("unknown universe snapshot " +
+		"version")
+
+	// ErrSnapshotNotTrusted is returned if a universe snapshot isn't
+	// signed by enough trusted signers.
+	ErrSnapshotNotTrusted = errors.New("universe snapshot not signed by " +
+		"enough trusted signers")
+
+	// ErrSnapshotRootMismatch is returned if the leaves of a universe
+	// snapshot don't add up to the signed root.
+	ErrSnapshotRootMismatch = errors.New("universe snapshot leaves don't " +
+		"match signed root")
+)
+
+// SnapshotVersion denotes the encoding version of a universe snapshot.
+type SnapshotVersion uint32
+
+const (
+	// SnapshotV0 is the first version of the universe snapshot encoding.
+	SnapshotV0 SnapshotVersion = 0
+)
+
+// SnapshotLeaf is a single leaf of a universe snapshot.
+type SnapshotLeaf struct {
+	// Key is the key the leaf is stored under in the universe tree.
+	Key LeafKey
+
+	// RawProof is the raw proof blob of the leaf.
+	RawProof proof.Blob
+}
+
+// SnapshotSig is the signature of a snapshot signer over the snapshot
+// header.
+type SnapshotSig struct {
+	// SignerKey is the public key of the signer.
+	SignerKey *btcec.PublicKey
+
+	// Sig is the signature over the snapshot signature message.
+	Sig *schnorr.Signature
+}
+
+// Snapshot is a signed snapshot of a single universe at a given block height.
+// Only the header, which commits to the universe root, is signed. The leaves
+// are bound to the signatures by recomputing the root from them on import.
+// Snapshots allow a new node to import the state of well-known universes
+// without verifying every single proof first. The proofs are then verified
+// in the background.
+type Snapshot struct {
+	// Version is the encoding version of the snapshot.
+	Version SnapshotVersion
+
+	// ID is the identifier of the universe.
+	ID Identifier
+
+	// Height is the block height at which the snapshot was taken.
+	Height uint32
+
+	// Root is the root of the universe as of the snapshot.
+	Root mssmt.Node
+
+	// Leaves is the set of all leaves of the universe.
+	Leaves []SnapshotLeaf
+
+	// Sigs is the set of signatures over the snapshot header.
+	Sigs []SnapshotSig
+}
+
+// NewSnapshot creates a new unsigned snapshot of the given universe at the
+// given height by fetching its root and all its leaves from the given diff
+// engine.
+func NewSnapshot(ctx context.Context, engine DiffEngine, id Identifier,
+	height uint32) (*Snapshot, error) {
+
+	root, err := engine.RootNode(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch root: %w", err)
+	}
+
+	snapshot := &Snapshot{
+		Version: SnapshotV0,
+		ID:      id,
+		Height:  height,
+		Root:    root.Node,
+	}
+
+	var offset int32
+	for {
+		keys, err := engine.UniverseLeafKeys(ctx, UniverseLeafKeysQuery{
+			Id:            id,
+			SortDirection: SortAscending,
+			Offset:        offset,
+			Limit:         MaxPageSize,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch leaf keys: %w",
+				err)
+		}
+
+		if len(keys) == 0 {
+			break
+		}
+
+		for _, key := range keys {
+			proofs, err := engine.FetchProofLeaf(ctx, id, key)
+			if err != nil {
+				return nil, fmt.Errorf("unable to fetch "+
+					"leaf: %w", err)
+			}
+			if len(proofs) == 0 || proofs[0].Leaf == nil {
+				return nil, ErrNoUniverseProofFound
+			}
+
+			snapshot.Leaves = append(snapshot.Leaves, SnapshotLeaf{
+				Key:      key,
+				RawProof: proofs[0].Leaf.RawProof,
+			})
+		}
+
+		offset += MaxPageSize
+	}
+
+	// The root might have changed while we fetched the leaves, so we make
+	// sure the snapshot is consistent before it's signed.
+	if _, err := snapshot.DecodeLeaves(ctx); err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// SigMsg returns the message that is signed by the snapshot signers. The
+// message commits to the encoding version, the universe identifier, the block
+// height and the universe root.
+func (s *Snapshot) SigMsg() []byte {
+	var b bytes.Buffer
+	b.WriteString(snapshotSigTag)
+	_ = binary.Write(&b, binary.BigEndian, uint32(s.Version))
+	_ = s.encodeHeader(&b)
+
+	return b.Bytes()
+}
+
+// SigDigest returns the SHA256 hash of the signature message, which is the
+// digest a snapshot signer signs.
+func (s *Snapshot) SigDigest() [32]byte {
+	return sha256.Sum256(s.SigMsg())
+}
+
+// AddSig verifies the given signature over the snapshot and adds it to the
+// snapshot. Any existing signature of the same signer is replaced.
+func (s *Snapshot) AddSig(signerKey *btcec.PublicKey,
+	sig *schnorr.Signature) error {
+
+	digest := s.SigDigest()
+	if !sig.Verify(digest[:], signerKey) {
+		return fmt.Errorf("invalid snapshot signature for signer %x",
+			schnorr.SerializePubKey(signerKey))
+	}
+
+	s.Sigs = fn.Filter(s.Sigs, func(existing SnapshotSig) bool {
+		return !bytes.Equal(
+			schnorr.SerializePubKey(existing.SignerKey),
+			schnorr.SerializePubKey(signerKey),
+		)
+	})
+	s.Sigs = append(s.Sigs, SnapshotSig{
+		SignerKey: signerKey,
+		Sig:       sig,
+	})
+
+	return nil
+}
+
+// VerifySigs checks that the snapshot carries valid signatures of at least
+// threshold distinct signers of the given set of trusted signers.
+func (s *Snapshot) VerifySigs(trustedSigners []*btcec.PublicKey,
+	threshold int) error {
+
+	if threshold <= 0 {
+		threshold = 1
+	}
+
+	trusted := make(map[[32]byte]struct{}, len(trustedSigners))
+	for _, signer := range trustedSigners {
+		var key [32]byte
+		copy(key[:], schnorr.SerializePubKey(signer))
+		trusted[key] = struct{}{}
+	}
+
+	digest := s.SigDigest()
+	validSigners := make(map[[32]byte]struct{}, len(s.Sigs))
+	for _, sig := range s.Sigs {
+		var key [32]byte
+		copy(key[:], schnorr.SerializePubKey(sig.SignerKey))
+
+		if _, ok := trusted[key]; !ok {
+			continue
+		}
+
+		if !sig.Sig.Verify(digest[:], sig.SignerKey) {
+			return fmt.Errorf("invalid snapshot signature for "+
+				"signer %x", key[:])
+		}
+
+		validSigners[key] = struct{}{}
+	}
+
+	if len(validSigners) < threshold {
+		return fmt.Errorf("%w: got %d valid signatures, need %d",
+			ErrSnapshotNotTrusted, len(validSigners), threshold)
+	}
+
+	return nil
+}
+
+// DecodeLeaves decodes the proofs of all leaves of the snapshot into universe
+// leaves and makes sure they add up to the snapshot root. The proofs are only
+// decoded, not verified.
+func (s *Snapshot) DecodeLeaves(ctx context.Context) ([]*Item, error) {
+	tree := mssmt.NewCompactedTree(mssmt.NewDefaultStore())
+	items := make([]*Item, 0, len(s.Leaves))
+	for _, snapshotLeaf := range s.Leaves {
+		var leafProof proof.Proof
+		err := leafProof.Decode(bytes.NewReader(snapshotLeaf.RawProof))
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode proof: %w",
+				err)
+		}
+
+		leaf := &Leaf{
+			GenesisWithGroup: GenesisWithGroup{
+				Genesis:  leafProof.Asset.Genesis,
+				GroupKey: leafProof.Asset.GroupKey,
+			},
+			RawProof: snapshotLeaf.RawProof,
+			Asset:    &leafProof.Asset,
+			Amt:      leafProof.Asset.Amount,
+		}
+
+		err = VerifyLeaf(s.ID, snapshotLeaf.Key, leaf)
+		if err != nil {
+			return nil, fmt.Errorf("invalid snapshot leaf %v: %w",
+				snapshotLeaf.Key.OutPoint, err)
+		}
+
+		_, err = tree.Insert(
+			ctx, snapshotLeaf.Key.UniverseKey(), leaf.SmtLeafNode(),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, &Item{
+			ID:         s.ID,
+			Key:        snapshotLeaf.Key,
+			Leaf:       leaf,
+			MetaReveal: leafProof.MetaReveal,
+		})
+	}
+
+	root, err := tree.Root(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Root == nil || !mssmt.IsEqualNode(root, s.Root) {
+		return nil, ErrSnapshotRootMismatch
+	}
+
+	return items, nil
+}
+
+// encodeHeader writes the signed header of the snapshot to the given writer.
+func (s *Snapshot) encodeHeader(w io.Writer) error {
+	var tlvBuf [8]byte
+
+	if err := binary.Write(w, binary.BigEndian, s.Height); err != nil {
+		return err
+	}
+
+	_, err := w.Write([]byte{byte(s.ID.ProofType)})
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write(s.ID.AssetID[:]); err != nil {
+		return err
+	}
+
+	var groupKey []byte
+	if s.ID.GroupKey != nil {
+		groupKey = schnorr.SerializePubKey(s.ID.GroupKey)
+	}
+	err = tlv.WriteVarInt(w, uint64(len(groupKey)), &tlvBuf)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(groupKey); err != nil {
+		return err
+	}
+
+	var (
+		rootHash mssmt.NodeHash
+		rootSum  uint64
+	)
+	if s.Root != nil {
+		rootHash = s.Root.NodeHash()
+		rootSum = s.Root.NodeSum()
+	}
+	if _, err := w.Write(rootHash[:]); err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, rootSum)
+}
+
+// decodeHeader reads the signed header of the snapshot from the given reader.
+func (s *Snapshot) decodeHeader(r io.Reader) error {
+	var tlvBuf [8]byte
+
+	if err := binary.Read(r, binary.BigEndian, &s.Height); err != nil {
+		return err
+	}
+
+	var proofType [1]byte
+	if _, err := io.ReadFull(r, proofType[:]); err != nil {
+		return err
+	}
+	s.ID.ProofType = ProofType(proofType[0])
+
+	if _, err := io.ReadFull(r, s.ID.AssetID[:]); err != nil {
+		return err
+	}
+
+	groupKeyLen, err := tlv.ReadVarInt(r, &tlvBuf)
+	if err != nil {
+		return err
+	}
+	switch groupKeyLen {
+	case 0:
+		s.ID.GroupKey = nil
+
+	case schnorr.PubKeyBytesLen:
+		var groupKey [schnorr.PubKeyBytesLen]byte
+		if _, err := io.ReadFull(r, groupKey[:]); err != nil {
+			return err
+		}
+
+		s.ID.GroupKey, err = schnorr.ParsePubKey(groupKey[:])
+		if err != nil {
+			return fmt.Errorf("invalid group key: %w", err)
+		}
+
+	default:
+		return fmt.Errorf("invalid group key length %d", groupKeyLen)
+	}
+
+	var rootHash mssmt.NodeHash
+	if _, err := io.ReadFull(r, rootHash[:]); err != nil {
+		return err
+	}
+
+	var rootSum uint64
+	if err := binary.Read(r, binary.BigEndian, &rootSum); err != nil {
+		return err
+	}
+	s.Root = mssmt.NewComputedNode(rootHash, rootSum)
+
+	return nil
+}
+
+// Encode encodes the snapshot into the given writer.
+func (s *Snapshot) Encode(w io.Writer) error {
+	if _, err := w.Write(SnapshotMagicBytes[:]); err != nil {
+		return err
+	}
+
+	err := binary.Write(w, binary.BigEndian, uint32(s.Version))
+	if err != nil {
+		return err
+	}
+
+	if err := s.encodeHeader(w); err != nil {
+		return err
+	}
+
+	var tlvBuf [8]byte
+	err = tlv.WriteVarInt(w, uint64(len(s.Leaves)), &tlvBuf)
+	if err != nil {
+		return err
+	}
+	for _, leaf := range s.Leaves {
+		if leaf.Key.ScriptKey == nil {
+			return fmt.Errorf("snapshot leaf %v has no script key",
+				leaf.Key.OutPoint)
+		}
+
+		err := asset.OutPointEncoder(w, &leaf.Key.OutPoint, &tlvBuf)
+		if err != nil {
+			return err
+		}
+
+		_, err = w.Write(
+			leaf.Key.ScriptKey.PubKey.SerializeCompressed(),
+		)
+		if err != nil {
+			return err
+		}
+
+		err = tlv.WriteVarInt(w, uint64(len(leaf.RawProof)), &tlvBuf)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(leaf.RawProof); err != nil {
+			return err
+		}
+	}
+
+	err = tlv.WriteVarInt(w, uint64(len(s.Sigs)), &tlvBuf)
+	if err != nil {
+		return err
+	}
+	for _, sig := range s.Sigs {
+		_, err := w.Write(schnorr.SerializePubKey(sig.SignerKey))
+		if err != nil {
+			return err
+		}
+
+		if _, err := w.Write(sig.Sig.Serialize()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Decode decodes a snapshot from the given reader.
+func (s *Snapshot) Decode(r io.Reader) error {
+	var magicBytes [len(SnapshotMagicBytes)]byte
+	if _, err := io.ReadFull(r, magicBytes[:]); err != nil {
+		return err
+	}
+	if magicBytes != SnapshotMagicBytes {
+		return fmt.Errorf("invalid snapshot magic bytes, expected %s, "+
+			"got %s", string(SnapshotMagicBytes[:]),
+			string(magicBytes[:]))
+	}
+
+	var version uint32
+	if err := binary.Read(r, binary.BigEndian, &version); err != nil {
+		return err
+	}
+	s.Version = SnapshotVersion(version)
+	if s.Version != SnapshotV0 {
+		return fmt.Errorf("%w: %d", ErrUnknownSnapshotVersion, version)
+	}
+
+	if err := s.decodeHeader(r); err != nil {
+		return fmt.Errorf("unable to decode snapshot header: %w", err)
+	}
+
+	var tlvBuf [8]byte
+	numLeaves, err := tlv.ReadVarInt(r, &tlvBuf)
+	if err != nil {
+		return err
+	}
+	if numLeaves > SnapshotMaxNumLeaves {
+		return fmt.Errorf("too many leaves in snapshot: %d", numLeaves)
+	}
+
+	s.Leaves = make([]SnapshotLeaf, 0, numLeaves)
+	for i := uint64(0); i < numLeaves; i++ {
+		var leaf SnapshotLeaf
+		err := asset.OutPointDecoder(r, &leaf.Key.OutPoint, &tlvBuf, 0)
+		if err != nil {
+			return err
+		}
+
+		var scriptKey [btcec.PubKeyBytesLenCompressed]byte
+		if _, err := io.ReadFull(r, scriptKey[:]); err != nil {
+			return err
+		}
+		scriptPubKey, err := btcec.ParsePubKey(scriptKey[:])
+		if err != nil {
+			return fmt.Errorf("invalid script key: %w", err)
+		}
+		leaf.Key.ScriptKey = fn.Ptr(asset.NewScriptKey(scriptPubKey))
+
+		proofLen, err := tlv.ReadVarInt(r, &tlvBuf)
+		if err != nil {
+			return err
+		}
+		if proofLen > proof.FileMaxProofSizeBytes {
+			return fmt.Errorf("snapshot proof too large: %d",
+				proofLen)
+		}
+
+		leaf.RawProof = make([]byte, proofLen)
+		if _, err := io.ReadFull(r, leaf.RawProof); err != nil {
+			return err
+		}
+
+		s.Leaves = append(s.Leaves, leaf)
+	}
+
+	numSigs, err := tlv.ReadVarInt(r, &tlvBuf)
+	if err != nil {
+		return err
+	}
+	if numSigs > SnapshotMaxNumSigs {
+		return fmt.Errorf("too many signatures in snapshot: %d",
+			numSigs)
+	}
+
+	s.Sigs = make([]SnapshotSig, 0, numSigs)
+	for i := uint64(0); i < numSigs; i++ {
+		var signerKey [schnorr.PubKeyBytesLen]byte
+		if _, err := io.ReadFull(r, signerKey[:]); err != nil {
+			return err
+		}
+
+		var sig [schnorr.SignatureSize]byte
+		if _, err := io.ReadFull(r, sig[:]); err != nil {
+			return err
+		}
+
+		var snapshotSig SnapshotSig
+		snapshotSig.SignerKey, err = schnorr.ParsePubKey(signerKey[:])
+		if err != nil {
+			return fmt.Errorf("invalid signer key: %w", err)
+		}
+
+		snapshotSig.Sig, err = schnorr.ParseSignature(sig[:])
+		if err != nil {
+			return fmt.Errorf("invalid signature: %w", err)
+		}
+
+		s.Sigs = append(s.Sigs, snapshotSig)
+	}
+
+	return nil
+}
@@ -0,0 +1,381 @@
+package universe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+)
+
+const (
+	// snapshotImportBatchSize is the number of leaves that are inserted
+	// into the multiverse in a single batch when importing a snapshot.
+	snapshotImportBatchSize = 500
+)
+
+// SnapshotRecord is the record of a universe snapshot that was imported
+// without verifying its proofs.
+type SnapshotRecord struct {
+	// ID is the identifier of the universe the snapshot was imported
+	// into.
+	ID Identifier
+
+	// Height is the block height at which the snapshot was taken.
+	Height uint32
+
+	// Root is the signed root of the universe as of the snapshot.
+	Root mssmt.Node
+
+	// ImportedAt is the time the snapshot was imported.
+	ImportedAt time.Time
+}
+
+// SnapshotStore keeps track of the imported universe snapshots that still
+// need to be verified.
+type SnapshotStore interface {
+	// AddSnapshot records a newly imported snapshot as pending
+	// verification. Importing the same universe at the same height again
+	// resets the verification state.
+	AddSnapshot(ctx context.Context, snapshot SnapshotRecord) error
+
+	// PendingSnapshots returns all imported snapshots that weren't
+	// verified yet.
+	PendingSnapshots(ctx context.Context) ([]SnapshotRecord, error)
+
+	// MarkSnapshotVerified marks the snapshot of the given universe at the
+	// given height as verified, recording the number of leaves that
+	// failed the verification.
+	MarkSnapshotVerified(ctx context.Context, id Identifier, height uint32,
+		numInvalid int) error
+}
+
+// LeafVerifier fully verifies the proof of a universe leaf.
+type LeafVerifier interface {
+	// VerifyProofLeaf fully verifies the proof of the given leaf, without
+	// inserting it.
+	VerifyProofLeaf(ctx context.Context, id Identifier, key LeafKey,
+		leaf *Leaf) error
+}
+
+// SnapshotImporterConfig is the main config for the universe snapshot
+// importer.
+type SnapshotImporterConfig struct {
+	// Multiverse is the multiverse the snapshots are imported into. The
+	// leaves are inserted directly, without verification.
+	Multiverse MultiverseArchive
+
+	// Verifier is used to verify the imported leaves in the background.
+	Verifier LeafVerifier
+
+	// Store keeps track of the snapshots that still need to be verified.
+	Store SnapshotStore
+
+	// Quarantine is used to move leaves that fail the background
+	// verification out of the universe. If this is nil, invalid leaves
+	// are only logged.
+	Quarantine LeafQuarantine
+
+	// TrustedSigners is the set of keys whose snapshot signatures are
+	// trusted.
+	TrustedSigners []*btcec.PublicKey
+
+	// SigThreshold is the number of distinct trusted signers that need to
+	// sign a snapshot before it is imported.
+	SigThreshold int
+
+	// SnapshotFiles is the list of snapshot files that are imported on
+	// start up.
+	SnapshotFiles []string
+}
+
+// SnapshotImporter imports signed universe snapshots and verifies the
+// imported proofs in the background. This allows a new node to trust the
+// state of well-known universes right away, assuming enough trusted signers
+// vouch for it, instead of first verifying every single proof.
+type SnapshotImporter struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg SnapshotImporterConfig
+
+	// verifySignal is used to wake up the verification loop once a new
+	// snapshot was imported.
+	verifySignal chan struct{}
+
+	// ContextGuard provides a wait group and main quit channel that can be
+	// used to create guarded contexts.
+	*fn.ContextGuard
+}
+
+// NewSnapshotImporter creates a new universe snapshot importer.
+func NewSnapshotImporter(cfg SnapshotImporterConfig) *SnapshotImporter {
+	return &SnapshotImporter{
+		cfg:          cfg,
+		verifySignal: make(chan struct{}, 1),
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start imports the configured snapshot files and starts the background
+// verification of all pending snapshots.
+func (s *SnapshotImporter) Start() error {
+	var startErr error
+	s.startOnce.Do(func() {
+		log.Infof("Starting universe snapshot importer (signers=%d, "+
+			"threshold=%d)", len(s.cfg.TrustedSigners),
+			s.cfg.SigThreshold)
+
+		ctx, cancel := s.WithCtxQuitNoTimeout()
+		defer cancel()
+
+		for _, snapshotFile := range s.cfg.SnapshotFiles {
+			err := s.ImportFile(ctx, snapshotFile)
+			if err != nil {
+				startErr = fmt.Errorf("unable to import "+
+					"snapshot %v: %w", snapshotFile, err)
+				return
+			}
+		}
+
+		s.Wg.Add(1)
+		go s.verifyLoop()
+	})
+
+	return startErr
+}
+
+// Stop stops the background verification.
+func (s *SnapshotImporter) Stop() error {
+	s.stopOnce.Do(func() {
+		close(s.Quit)
+		s.Wg.Wait()
+	})
+
+	return nil
+}
+
+// ImportFile reads the snapshot from the given file and imports it. If the
+// local universe already matches the snapshot root, the snapshot is skipped.
+func (s *SnapshotImporter) ImportFile(ctx context.Context,
+	snapshotFile string) error {
+
+	f, err := os.Open(snapshotFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var snapshot Snapshot
+	if err := snapshot.Decode(f); err != nil {
+		return fmt.Errorf("unable to decode snapshot: %w", err)
+	}
+
+	localRoot, err := s.cfg.Multiverse.UniverseRootNode(ctx, snapshot.ID)
+	switch {
+	case err == nil && snapshot.Root != nil &&
+		mssmt.IsEqualNode(localRoot.Node, snapshot.Root):
+
+		log.Debugf("Universe %v already matches snapshot at height "+
+			"%d, skipping import", snapshot.ID.StringForLog(),
+			snapshot.Height)
+
+		return nil
+
+	case err != nil && !errors.Is(err, ErrNoUniverseRoot):
+		return fmt.Errorf("unable to fetch local root: %w", err)
+	}
+
+	return s.Import(ctx, &snapshot)
+}
+
+// Import checks the signatures of the given snapshot and inserts all its
+// leaves into the multiverse without verifying their proofs. The snapshot is
+// then verified in the background.
+func (s *SnapshotImporter) Import(ctx context.Context,
+	snapshot *Snapshot) error {
+
+	err := snapshot.VerifySigs(s.cfg.TrustedSigners, s.cfg.SigThreshold)
+	if err != nil {
+		return err
+	}
+
+	items, err := snapshot.DecodeLeaves(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Importing %d leaves of universe %v from snapshot at "+
+		"height %d", len(items), snapshot.ID.StringForLog(),
+		snapshot.Height)
+
+	for start := 0; start < len(items); start += snapshotImportBatchSize {
+		end := min(start+snapshotImportBatchSize, len(items))
+		err := s.cfg.Multiverse.UpsertProofLeafBatch(
+			ctx, items[start:end],
+		)
+		if err != nil {
+			return fmt.Errorf("unable to insert snapshot leaves: "+
+				"%w", err)
+		}
+	}
+
+	err = s.cfg.Store.AddSnapshot(ctx, SnapshotRecord{
+		ID:         snapshot.ID,
+		Height:     snapshot.Height,
+		Root:       snapshot.Root,
+		ImportedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to store snapshot: %w", err)
+	}
+
+	// Wake up the verification loop, unless it was already signalled.
+	select {
+	case s.verifySignal <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// verifyLoop verifies all pending snapshots on start up and whenever a new
+// snapshot was imported.
+//
+// NOTE: This MUST be run as a goroutine.
+func (s *SnapshotImporter) verifyLoop() {
+	defer s.Wg.Done()
+
+	for {
+		ctx, cancel := s.WithCtxQuitNoTimeout()
+		err := s.VerifyPending(ctx)
+		cancel()
+		if err != nil {
+			log.Errorf("Unable to verify universe snapshots: %v",
+				err)
+		}
+
+		select {
+		case <-s.verifySignal:
+		case <-s.Quit:
+			return
+		}
+	}
+}
+
+// VerifyPending fully verifies the leaves of all pending snapshots. Leaves
+// that fail the verification are quarantined, if a quarantine is configured.
+func (s *SnapshotImporter) VerifyPending(ctx context.Context) error {
+	pending, err := s.cfg.Store.PendingSnapshots(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to fetch pending snapshots: %w", err)
+	}
+
+	for _, snapshot := range pending {
+		numInvalid, err := s.verifySnapshot(ctx, snapshot)
+		if err != nil {
+			return fmt.Errorf("unable to verify snapshot of "+
+				"universe %v: %w", snapshot.ID.StringForLog(),
+				err)
+		}
+
+		err = s.cfg.Store.MarkSnapshotVerified(
+			ctx, snapshot.ID, snapshot.Height, numInvalid,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to mark snapshot verified: "+
+				"%w", err)
+		}
+
+		log.Infof("Verified snapshot of universe %v at height %d, "+
+			"%d invalid leaves", snapshot.ID.StringForLog(),
+			snapshot.Height, numInvalid)
+	}
+
+	return nil
+}
+
+// verifySnapshot verifies all current leaves of the universe of the given
+// snapshot and returns the number of invalid leaves.
+func (s *SnapshotImporter) verifySnapshot(ctx context.Context,
+	snapshot SnapshotRecord) (int, error) {
+
+	// We fetch all keys up front, as quarantining leaves would otherwise
+	// shift the pages.
+	var (
+		offset int32
+		keys   []LeafKey
+	)
+	for {
+		page, err := s.cfg.Multiverse.UniverseLeafKeys(
+			ctx, UniverseLeafKeysQuery{
+				Id:            snapshot.ID,
+				SortDirection: SortAscending,
+				Offset:        offset,
+				Limit:         MaxPageSize,
+			},
+		)
+		if err != nil {
+			return 0, fmt.Errorf("unable to fetch leaf keys: %w",
+				err)
+		}
+
+		if len(page) == 0 {
+			break
+		}
+
+		keys = append(keys, page...)
+		offset += MaxPageSize
+	}
+
+	var numInvalid int
+	for start := 0; start < len(keys); start += snapshotImportBatchSize {
+		end := min(start+snapshotImportBatchSize, len(keys))
+		batch := keys[start:end]
+		proofs, err := s.cfg.Multiverse.FetchProofLeaves(
+			ctx, snapshot.ID, batch,
+		)
+		if err != nil {
+			return 0, fmt.Errorf("unable to fetch leaves: %w", err)
+		}
+
+		for idx, uniProof := range proofs {
+			key := batch[idx]
+			err := s.cfg.Verifier.VerifyProofLeaf(
+				ctx, snapshot.ID, key, uniProof.Leaf,
+			)
+			if err == nil {
+				continue
+			}
+
+			numInvalid++
+			log.Warnf("Invalid leaf in snapshot of universe %v "+
+				"(outpoint=%v): %v",
+				snapshot.ID.StringForLog(), key.OutPoint, err)
+
+			if s.cfg.Quarantine == nil {
+				continue
+			}
+
+			reason := fmt.Sprintf("snapshot verification "+
+				"failed: %v", err)
+			err = s.cfg.Quarantine.QuarantineLeaf(
+				ctx, snapshot.ID, key, reason,
+			)
+			if err != nil {
+				return 0, fmt.Errorf("unable to quarantine "+
+					"leaf: %w", err)
+			}
+		}
+	}
+
+	return numInvalid, nil
+}
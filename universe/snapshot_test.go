@@ -0,0 +1,255 @@
+package universe
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/stretchr/testify/require"
+)
+
+// memSnapshotStore is an in-memory SnapshotStore.
+type memSnapshotStore struct {
+	pending    []SnapshotRecord
+	numInvalid map[uint32]int
+}
+
+// AddSnapshot records the given snapshot as pending.
+func (m *memSnapshotStore) AddSnapshot(_ context.Context,
+	snapshot SnapshotRecord) error {
+
+	m.pending = append(m.pending, snapshot)
+
+	return nil
+}
+
+// PendingSnapshots returns all pending snapshots.
+func (m *memSnapshotStore) PendingSnapshots(
+	_ context.Context) ([]SnapshotRecord, error) {
+
+	return m.pending, nil
+}
+
+// MarkSnapshotVerified removes the snapshot from the pending set.
+func (m *memSnapshotStore) MarkSnapshotVerified(_ context.Context,
+	id Identifier, height uint32, numInvalid int) error {
+
+	var pending []SnapshotRecord
+	for _, snapshot := range m.pending {
+		if snapshot.ID.String() == id.String() &&
+			snapshot.Height == height {
+
+			continue
+		}
+
+		pending = append(pending, snapshot)
+	}
+	m.pending = pending
+	m.numInvalid[height] = numInvalid
+
+	return nil
+}
+
+// rejectingVerifier is a LeafVerifier that rejects the leaves with the given
+// keys.
+type rejectingVerifier struct {
+	invalid map[UniverseKey]struct{}
+}
+
+// VerifyProofLeaf rejects the leaf if its key is marked as invalid.
+func (r *rejectingVerifier) VerifyProofLeaf(_ context.Context, _ Identifier,
+	key LeafKey, _ *Leaf) error {
+
+	if _, ok := r.invalid[key.UniverseKey()]; ok {
+		return fmt.Errorf("invalid leaf")
+	}
+
+	return nil
+}
+
+// newTestSnapshot creates a universe with the given number of leaves and
+// returns an unsigned snapshot of it.
+func newTestSnapshot(t *testing.T, numLeaves int) *Snapshot {
+	ctx := context.Background()
+	engine := &auditDiffEngine{
+		MemMultiverse: NewMemMultiverse(),
+	}
+
+	genesisAsset := randGenesisAsset(t)
+	id := Identifier{
+		AssetID:   genesisAsset.ID(),
+		ProofType: ProofTypeIssuance,
+	}
+	for i := 0; i < numLeaves; i++ {
+		key, leaf := randAuditLeaf(t, genesisAsset)
+		_, err := engine.UpsertProofLeaf(ctx, id, key, leaf, nil)
+		require.NoError(t, err)
+	}
+
+	snapshot, err := NewSnapshot(ctx, engine, id, 100)
+	require.NoError(t, err)
+	require.Len(t, snapshot.Leaves, numLeaves)
+
+	return snapshot
+}
+
+// signSnapshot signs the given snapshot with a new random key and returns
+// the key.
+func signSnapshot(t *testing.T, snapshot *Snapshot) *btcec.PrivateKey {
+	signer := test.RandPrivKey(t)
+
+	digest := snapshot.SigDigest()
+	sig, err := schnorr.Sign(signer, digest[:])
+	require.NoError(t, err)
+	require.NoError(t, snapshot.AddSig(signer.PubKey(), sig))
+
+	return signer
+}
+
+// TestSnapshotEncoding tests that a signed snapshot survives an encoding
+// round trip and that its signatures are checked against the trusted signers.
+func TestSnapshotEncoding(t *testing.T) {
+	t.Parallel()
+
+	snapshot := newTestSnapshot(t, 3)
+	signer1 := signSnapshot(t, snapshot)
+	signer2 := signSnapshot(t, snapshot)
+
+	// A signature over a different message is rejected.
+	otherSigner := test.RandPrivKey(t)
+	sig, err := schnorr.Sign(otherSigner, test.RandBytes(32))
+	require.NoError(t, err)
+	require.Error(t, snapshot.AddSig(otherSigner.PubKey(), sig))
+
+	var buf bytes.Buffer
+	require.NoError(t, snapshot.Encode(&buf))
+
+	var decoded Snapshot
+	require.NoError(t, decoded.Decode(&buf))
+
+	require.Equal(t, snapshot.Version, decoded.Version)
+	require.Equal(t, snapshot.ID.String(), decoded.ID.String())
+	require.Equal(t, snapshot.Height, decoded.Height)
+	require.True(t, mssmt.IsEqualNode(snapshot.Root, decoded.Root))
+	require.Equal(t, snapshot.SigDigest(), decoded.SigDigest())
+	require.Len(t, decoded.Leaves, len(snapshot.Leaves))
+	for idx, leaf := range snapshot.Leaves {
+		require.Equal(
+			t, leaf.Key.UniverseKey(),
+			decoded.Leaves[idx].Key.UniverseKey(),
+		)
+		require.Equal(t, leaf.RawProof, decoded.Leaves[idx].RawProof)
+	}
+
+	// Both signatures are valid, so a threshold of two is met, but only
+	// if both signers are trusted.
+	trusted := []*btcec.PublicKey{signer1.PubKey(), signer2.PubKey()}
+	require.NoError(t, decoded.VerifySigs(trusted, 2))
+	require.ErrorIs(
+		t, decoded.VerifySigs(trusted[:1], 2), ErrSnapshotNotTrusted,
+	)
+	require.NoError(t, decoded.VerifySigs(trusted[:1], 1))
+	require.ErrorIs(
+		t, decoded.VerifySigs(trusted, 3), ErrSnapshotNotTrusted,
+	)
+	require.ErrorIs(
+		t, decoded.VerifySigs(
+			[]*btcec.PublicKey{otherSigner.PubKey()}, 1,
+		), ErrSnapshotNotTrusted,
+	)
+
+	// Changing the signed header invalidates all signatures.
+	decoded.Height++
+	require.Error(t, decoded.VerifySigs(trusted, 1))
+
+	// The leaves must add up to the signed root.
+	items, err := snapshot.DecodeLeaves(context.Background())
+	require.NoError(t, err)
+	require.Len(t, items, len(snapshot.Leaves))
+
+	snapshot.Leaves = snapshot.Leaves[1:]
+	_, err = snapshot.DecodeLeaves(context.Background())
+	require.ErrorIs(t, err, ErrSnapshotRootMismatch)
+}
+
+// TestSnapshotImporter tests that a trusted snapshot is imported without
+// verification, and that its leaves are verified and quarantined in the
+// background.
+func TestSnapshotImporter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	snapshot := newTestSnapshot(t, 4)
+	signer := signSnapshot(t, snapshot)
+
+	multiverse := NewMemMultiverse()
+	store := &memSnapshotStore{
+		numInvalid: make(map[uint32]int),
+	}
+	invalidKey := snapshot.Leaves[0].Key
+	verifier := &rejectingVerifier{
+		invalid: map[UniverseKey]struct{}{
+			invalidKey.UniverseKey(): {},
+		},
+	}
+	quarantine := &recordingQuarantine{}
+
+	// A snapshot that isn't signed by a trusted signer is rejected.
+	importer := NewSnapshotImporter(SnapshotImporterConfig{
+		Multiverse:     multiverse,
+		Verifier:       verifier,
+		Store:          store,
+		TrustedSigners: []*btcec.PublicKey{test.RandPubKey(t)},
+	})
+	err := importer.Import(ctx, snapshot)
+	require.ErrorIs(t, err, ErrSnapshotNotTrusted)
+
+	importer = NewSnapshotImporter(SnapshotImporterConfig{
+		Multiverse:     multiverse,
+		Verifier:       verifier,
+		Store:          store,
+		Quarantine:     quarantine,
+		TrustedSigners: []*btcec.PublicKey{signer.PubKey()},
+		SigThreshold:   1,
+	})
+
+	// We write the snapshot to a file, so we can also test the import
+	// from disk.
+	var buf bytes.Buffer
+	require.NoError(t, snapshot.Encode(&buf))
+	snapshotFile := filepath.Join(t.TempDir(), "snapshot.taps")
+	require.NoError(t, os.WriteFile(snapshotFile, buf.Bytes(), 0600))
+
+	err = importer.ImportFile(ctx, snapshotFile)
+	require.NoError(t, err)
+
+	// The universe now matches the snapshot root and the snapshot is
+	// pending verification.
+	root, err := multiverse.UniverseRootNode(ctx, snapshot.ID)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(snapshot.Root, root.Node))
+	require.Len(t, store.pending, 1)
+
+	// Importing the same file again is a no-op, since the local universe
+	// already matches the snapshot.
+	require.NoError(t, importer.ImportFile(ctx, snapshotFile))
+	require.Len(t, store.pending, 1)
+
+	// The background verification rejects one leaf, which is then
+	// quarantined.
+	require.NoError(t, importer.VerifyPending(ctx))
+	require.Empty(t, store.pending)
+	require.Equal(t, 1, store.numInvalid[snapshot.Height])
+	require.Len(t, quarantine.keys, 1)
+	require.Equal(
+		t, invalidKey.UniverseKey(), quarantine.keys[0].UniverseKey(),
+	)
+}
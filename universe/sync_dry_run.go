@@ -0,0 +1,251 @@
+package universe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+)
+
+// DivergenceReport describes how a local universe diverges from the same
+// universe on a remote server.
+type DivergenceReport struct {
+	// ID is the identifier of the universe that was compared.
+	ID Identifier
+
+	// LocalRoot is the root of the local universe. This is nil if the
+	// universe doesn't exist locally.
+	LocalRoot mssmt.Node
+
+	// RemoteRoot is the root of the remote universe.
+	RemoteRoot Root
+
+	// RemoteOnly are the keys of the leaves that only exist in the remote
+	// universe. These are the leaves a sync would insert.
+	RemoteOnly []LeafKey
+
+	// LocalOnly are the keys of the leaves that only exist in the local
+	// universe.
+	LocalOnly []LeafKey
+
+	// Conflicting are the keys of the leaves that exist in both universes
+	// but with different content.
+	Conflicting []LeafKey
+}
+
+// InSync returns true if the local and remote universe have the same root.
+func (r *DivergenceReport) InSync() bool {
+	return mssmt.IsEqualNode(r.LocalRoot, r.RemoteRoot.Node)
+}
+
+// SyncUniverseDryRun compares the local universe with the remote universe,
+// governed by the sync type and the set of universe IDs to sync, just like
+// SyncUniverse. But instead of inserting the missing leaves, a report of the
+// divergence of each universe is returned. Nothing is written to the local
+// universe, which makes this useful to inspect a new federation member before
+// trusting it.
+//
+// NOTE: This is part of the DryRunSyncer interface.
+func (s *SimpleSyncer) SyncUniverseDryRun(ctx context.Context,
+	host ServerAddr, syncType SyncType, syncConfigs SyncConfigs,
+	idsToSync ...Identifier) ([]DivergenceReport, error) {
+
+	log.Infof("Attempting dry run sync of universe: host=%v, "+
+		"sync_type=%v, ids=%v", host.HostStr(), syncType,
+		spew.Sdump(idsToSync))
+
+	diffEngine, err := s.cfg.NewRemoteDiffEngine(host)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create remote diff "+
+			"engine: %w", err)
+	}
+	defer diffEngine.Close()
+
+	session, err := negotiateSyncSession(ctx, diffEngine, s.cfg.Network)
+	if err != nil {
+		return nil, err
+	}
+	diffEngine = session.limitDiffEngine(diffEngine)
+
+	targetRoots, err := s.fetchTargetRoots(
+		ctx, diffEngine, session, syncType, syncConfigs, idsToSync,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make(chan DivergenceReport, len(targetRoots))
+	err = fn.ParSlice(
+		ctx, targetRoots, func(ctx context.Context, r Root) error {
+			report, err := s.compareRoot(
+				ctx, r, diffEngine, session,
+			)
+			if err != nil {
+				return err
+			}
+
+			reports <- *report
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return fn.Collect(reports), nil
+}
+
+// compareRoot compares the leaves of the given remote universe root with the
+// leaves of the same local universe.
+func (s *SimpleSyncer) compareRoot(ctx context.Context, remoteRoot Root,
+	diffEngine DiffEngine, session SyncSession) (*DivergenceReport,
+	error) {
+
+	uniID := remoteRoot.ID
+	report := &DivergenceReport{
+		ID:         uniID,
+		RemoteRoot: remoteRoot,
+	}
+
+	// In contrast to a real sync, we don't consult the remote root cache
+	// here, as leaves that only exist locally are part of the report too.
+	localRoot, err := s.cfg.LocalDiffEngine.RootNode(ctx, uniID)
+	switch {
+	case errors.Is(err, ErrNoUniverseRoot):
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to fetch local root: %w", err)
+
+	default:
+		report.LocalRoot = localRoot.Node
+	}
+
+	if report.InSync() {
+		return report, nil
+	}
+
+	log.Infof("UniverseRoot(%v) diverges, comparing leaves...",
+		uniID.String())
+
+	remoteKeys, err := s.fetchAllLeafKeys(
+		ctx, diffEngine, uniID, session.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var localKeys []LeafKey
+	if report.LocalRoot != nil {
+		localKeys, err = s.fetchAllLeafKeys(
+			ctx, s.cfg.LocalDiffEngine, uniID, defaultPageSize,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var commonKeys []LeafKey
+	report.LocalOnly, report.RemoteOnly, commonKeys = diffLeafKeys(
+		localKeys, remoteKeys,
+	)
+
+	// Leaves with the same key can only differ in their content if the
+	// key exists in both universes, so we only need to fetch those.
+	if len(commonKeys) != 0 {
+		localHashes, err := fetchLeafHashes(
+			ctx, s.cfg.LocalDiffEngine, localRoot, commonKeys,
+			defaultPageSize,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		remoteHashes, err := fetchLeafHashes(
+			ctx, diffEngine, remoteRoot, commonKeys,
+			session.PageSize,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		isConflicting := func(k LeafKey) bool {
+			key := k.UniverseKey()
+			return localHashes[key] != remoteHashes[key]
+		}
+		report.Conflicting = fn.Filter(commonKeys, isConflicting)
+	}
+
+	log.Infof("UniverseRoot(%v): remote_only=%v, local_only=%v, "+
+		"conflicting=%v", uniID.String(), len(report.RemoteOnly),
+		len(report.LocalOnly), len(report.Conflicting))
+
+	return report, nil
+}
+
+// diffLeafKeys splits the given local and remote leaf keys into the keys that
+// only exist locally, the keys that only exist remotely and the keys that
+// exist in both sets. Keys are compared by their universe key.
+func diffLeafKeys(localKeys, remoteKeys []LeafKey) ([]LeafKey, []LeafKey,
+	[]LeafKey) {
+
+	toSet := func(keys []LeafKey) fn.Set[[32]byte] {
+		return fn.NewSet(fn.Map(keys, func(k LeafKey) [32]byte {
+			return k.UniverseKey()
+		})...)
+	}
+	localSet, remoteSet := toSet(localKeys), toSet(remoteKeys)
+
+	localOnly := fn.Filter(localKeys, func(k LeafKey) bool {
+		return !remoteSet.Contains(k.UniverseKey())
+	})
+	remoteOnly := fn.Filter(remoteKeys, func(k LeafKey) bool {
+		return !localSet.Contains(k.UniverseKey())
+	})
+	common := fn.Filter(remoteKeys, func(k LeafKey) bool {
+		return localSet.Contains(k.UniverseKey())
+	})
+
+	return localOnly, remoteOnly, common
+}
+
+// fetchLeafHashes fetches the leaves of the given keys from the diff engine,
+// verifies them against the given root and returns the hashes of their leaf
+// nodes, keyed by their universe key.
+func fetchLeafHashes(ctx context.Context, diffEngine DiffEngine, root Root,
+	keys []LeafKey, pageSize int32) (map[[32]byte]mssmt.NodeHash, error) {
+
+	var (
+		hashes   = make(map[[32]byte]mssmt.NodeHash, len(keys))
+		hashesMu sync.Mutex
+	)
+	err := fetchProofLeaves(
+		ctx, diffEngine, root.ID, keys, pageSize,
+		func(key LeafKey, leafProof *Proof) error {
+			if !leafProof.VerifyRoot(root) {
+				return fmt.Errorf("proof for key=%v is "+
+					"invalid", spew.Sdump(key))
+			}
+
+			leafHash := leafProof.Leaf.SmtLeafNode().NodeHash()
+
+			hashesMu.Lock()
+			hashes[key.UniverseKey()] = leafHash
+			hashesMu.Unlock()
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return hashes, nil
+}
+
+// A compile-time assertion to make sure SimpleSyncer satisfies the
+// DryRunSyncer interface.
+var _ DryRunSyncer = (*SimpleSyncer)(nil)
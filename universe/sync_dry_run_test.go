@@ -0,0 +1,56 @@
+package universe
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDiffLeafKeys tests that leaf keys are split into local only, remote only
+// and common keys by their universe key.
+func TestDiffLeafKeys(t *testing.T) {
+	t.Parallel()
+
+	randKey := func() LeafKey {
+		return LeafKey{
+			OutPoint: test.RandOp(t),
+			ScriptKey: fn.Ptr(
+				asset.NewScriptKey(test.RandPubKey(t)),
+			),
+		}
+	}
+
+	// copyKey returns a key with the same content but a different script
+	// key pointer, to make sure keys aren't compared by pointer.
+	copyKey := func(k LeafKey) LeafKey {
+		scriptKey := *k.ScriptKey
+		return LeafKey{
+			OutPoint:  k.OutPoint,
+			ScriptKey: &scriptKey,
+		}
+	}
+
+	localOnly := []LeafKey{randKey(), randKey()}
+	remoteOnly := []LeafKey{randKey()}
+	common := []LeafKey{randKey(), randKey(), randKey()}
+
+	localKeys := append(append([]LeafKey{}, localOnly...), common...)
+	remoteKeys := append([]LeafKey{}, remoteOnly...)
+	remoteKeys = append(remoteKeys, fn.Map(common, copyKey)...)
+
+	gotLocalOnly, gotRemoteOnly, gotCommon := diffLeafKeys(
+		localKeys, remoteKeys,
+	)
+	require.Equal(t, localOnly, gotLocalOnly)
+	require.Equal(t, remoteOnly, gotRemoteOnly)
+	require.Equal(t, common, gotCommon)
+
+	// Without any local keys, all remote keys are remote only.
+	gotLocalOnly, gotRemoteOnly, gotCommon = diffLeafKeys(nil, remoteKeys)
+	require.Empty(t, gotLocalOnly)
+	require.Equal(t, remoteKeys, gotRemoteOnly)
+	require.Empty(t, gotCommon)
+}
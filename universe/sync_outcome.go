@@ -0,0 +1,207 @@
+package universe
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+var (
+	// ErrLeafNotInRoot is returned as the outcome of a remote leaf whose
+	// inclusion proof doesn't verify against the remote universe root.
+	ErrLeafNotInRoot = errors.New("leaf proof doesn't verify against " +
+		"the remote universe root")
+
+	// ErrLeafRejected is returned as the outcome of a remote leaf that was
+	// rejected by the local universe, for example because its asset proof
+	// is invalid. The error of the local universe is wrapped as well.
+	ErrLeafRejected = errors.New("leaf rejected by the local universe")
+)
+
+// LeafSyncStatus is the outcome of syncing a single remote leaf.
+type LeafSyncStatus uint8
+
+const (
+	// LeafSyncInserted denotes a leaf that was inserted into the local
+	// universe.
+	LeafSyncInserted LeafSyncStatus = iota
+
+	// LeafSyncSkippedDuplicate denotes a leaf that wasn't fetched, as the
+	// local universe already has a leaf with the same key.
+	LeafSyncSkippedDuplicate
+
+	// LeafSyncFailedVerification denotes a leaf that failed verification
+	// and wasn't inserted into the local universe.
+	LeafSyncFailedVerification
+)
+
+// String returns a human-readable representation of the status.
+func (s LeafSyncStatus) String() string {
+	switch s {
+	case LeafSyncInserted:
+		return "inserted"
+
+	case LeafSyncSkippedDuplicate:
+		return "skipped_duplicate"
+
+	case LeafSyncFailedVerification:
+		return "failed_verification"
+
+	default:
+		return fmt.Sprintf("unknown(%d)", s)
+	}
+}
+
+// LeafSyncOutcome is the outcome of syncing a single remote leaf.
+type LeafSyncOutcome struct {
+	// Key is the key of the leaf.
+	Key LeafKey
+
+	// Status is the outcome of the sync of the leaf.
+	Status LeafSyncStatus
+
+	// Err is the reason the leaf failed verification. It wraps either
+	// ErrLeafNotInRoot or ErrLeafRejected. This is only set for
+	// LeafSyncFailedVerification outcomes.
+	Err error
+}
+
+// SyncTotals are the number of leaves per outcome of one or more universe
+// syncs.
+type SyncTotals struct {
+	// Inserted is the number of leaves that were inserted.
+	Inserted uint64
+
+	// SkippedDuplicate is the number of leaves that were skipped because
+	// they already existed locally.
+	SkippedDuplicate uint64
+
+	// FailedVerification is the number of leaves that failed
+	// verification.
+	FailedVerification uint64
+}
+
+// add adds the outcome of a single leaf to the totals.
+func (t *SyncTotals) add(status LeafSyncStatus) {
+	switch status {
+	case LeafSyncInserted:
+		t.Inserted++
+
+	case LeafSyncSkippedDuplicate:
+		t.SkippedDuplicate++
+
+	case LeafSyncFailedVerification:
+		t.FailedVerification++
+	}
+}
+
+// Totals returns the number of leaves per outcome of the sync.
+func (d *AssetSyncDiff) Totals() SyncTotals {
+	var totals SyncTotals
+	for _, outcome := range d.LeafOutcomes {
+		totals.add(outcome.Status)
+	}
+
+	return totals
+}
+
+// Failures returns the outcomes of all leaves that failed verification.
+func (d *AssetSyncDiff) Failures() []LeafSyncOutcome {
+	var failures []LeafSyncOutcome
+	for _, outcome := range d.LeafOutcomes {
+		if outcome.Status == LeafSyncFailedVerification {
+			failures = append(failures, outcome)
+		}
+	}
+
+	return failures
+}
+
+// SumSyncTotals returns the number of leaves per outcome of all given syncs.
+func SumSyncTotals(diffs []AssetSyncDiff) SyncTotals {
+	var totals SyncTotals
+	for idx := range diffs {
+		for _, outcome := range diffs[idx].LeafOutcomes {
+			totals.add(outcome.Status)
+		}
+	}
+
+	return totals
+}
+
+// leafOutcomes collects the outcomes of the leaves of a single universe sync.
+// Leaves can be fetched in parallel, so it is safe for concurrent use.
+type leafOutcomes struct {
+	mu       sync.Mutex
+	outcomes []LeafSyncOutcome
+	failed   fn.Set[[32]byte]
+}
+
+// add records the outcome of the leaf with the given key.
+func (l *leafOutcomes) add(key LeafKey, status LeafSyncStatus, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.outcomes = append(l.outcomes, LeafSyncOutcome{
+		Key:    key,
+		Status: status,
+		Err:    err,
+	})
+
+	if status == LeafSyncFailedVerification {
+		if l.failed == nil {
+			l.failed = fn.NewSet[[32]byte]()
+		}
+		l.failed.Add(key.UniverseKey())
+	}
+}
+
+// addAll records the same outcome for all leaves with the given keys.
+func (l *leafOutcomes) addAll(keys []LeafKey, status LeafSyncStatus) {
+	for _, key := range keys {
+		l.add(key, status, nil)
+	}
+}
+
+// reset removes the recorded outcomes of the leaves with the given keys, so
+// they can be recorded again when the leaves are retried.
+func (l *leafOutcomes) reset(keys []LeafKey) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	resetKeys := fn.NewSet(fn.Map(keys, func(k LeafKey) [32]byte {
+		return k.UniverseKey()
+	})...)
+	l.outcomes = fn.Filter(l.outcomes, func(o LeafSyncOutcome) bool {
+		return !resetKeys.Contains(o.Key.UniverseKey())
+	})
+	for key := range resetKeys {
+		delete(l.failed, key)
+	}
+}
+
+// hasFailures returns true if any leaf failed verification.
+func (l *leafOutcomes) hasFailures() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return len(l.failed) > 0
+}
+
+// isFailed returns true if the leaf with the given key failed verification.
+func (l *leafOutcomes) isFailed(key LeafKey) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.failed.Contains(key.UniverseKey())
+}
+
+// list returns all recorded outcomes.
+func (l *leafOutcomes) list() []LeafSyncOutcome {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.outcomes
+}
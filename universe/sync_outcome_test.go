@@ -0,0 +1,147 @@
+package universe
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// mockBatchRegistrar is a BatchRegistrar that rejects the leaves with the
+// given keys and records all inserted leaves.
+type mockBatchRegistrar struct {
+	rejected fn.Set[[32]byte]
+	inserted []LeafKey
+}
+
+// UpsertProofLeaf inserts the leaf unless its key is rejected.
+func (m *mockBatchRegistrar) UpsertProofLeaf(_ context.Context, _ Identifier,
+	key LeafKey, _ *Leaf) (*Proof, error) {
+
+	if m.rejected.Contains(key.UniverseKey()) {
+		return nil, fmt.Errorf("invalid proof")
+	}
+
+	m.inserted = append(m.inserted, key)
+	return &Proof{}, nil
+}
+
+// UpsertProofLeafBatch inserts the batch unless it contains a rejected key.
+func (m *mockBatchRegistrar) UpsertProofLeafBatch(_ context.Context,
+	items []*Item) error {
+
+	for _, item := range items {
+		if m.rejected.Contains(item.Key.UniverseKey()) {
+			return fmt.Errorf("invalid proof in batch")
+		}
+	}
+
+	for _, item := range items {
+		m.inserted = append(m.inserted, item.Key)
+	}
+
+	return nil
+}
+
+// Close is a no-op.
+func (m *mockBatchRegistrar) Close() error {
+	return nil
+}
+
+// TestInsertLeafItemsOutcomes tests that a rejected leaf only fails its
+// own insertion, that the outcome of every leaf is reported and that the
+// outcome of a retried leaf replaces its failed outcome.
+func TestInsertLeafItemsOutcomes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	id := Identifier{
+		AssetID:   asset.RandID(t),
+		ProofType: ProofTypeIssuance,
+	}
+
+	items := make([]*Item, 5)
+	for i := range items {
+		items[i] = &Item{
+			ID: id,
+			Key: LeafKey{
+				OutPoint:  test.RandOp(t),
+				ScriptKey: fn.Ptr(asset.RandScriptKey(t)),
+			},
+			Leaf: &Leaf{
+				Amt: uint64(i + 1),
+			},
+		}
+	}
+
+	// The second leaf is rejected, so the first batch of three leaves is
+	// inserted one by one.
+	rejectedKey := items[1].Key
+	registrar := &mockBatchRegistrar{
+		rejected: fn.NewSet(rejectedKey.UniverseKey()),
+	}
+	syncer := NewSimpleSyncer(SimpleSyncCfg{
+		LocalRegistrar: registrar,
+		SyncBatchSize:  3,
+	})
+
+	var outcomes leafOutcomes
+	duplicateKey := LeafKey{
+		OutPoint:  test.RandOp(t),
+		ScriptKey: fn.Ptr(asset.RandScriptKey(t)),
+	}
+	outcomes.addAll([]LeafKey{duplicateKey}, LeafSyncSkippedDuplicate)
+
+	newLeaves, err := syncer.insertLeafItems(ctx, id, items, &outcomes)
+	require.NoError(t, err)
+	require.Len(t, newLeaves, 4)
+	require.Len(t, registrar.inserted, 4)
+	require.True(t, outcomes.hasFailures())
+	require.True(t, outcomes.isFailed(rejectedKey))
+	require.False(t, outcomes.isFailed(items[0].Key))
+
+	diff := AssetSyncDiff{
+		NewLeafProofs: newLeaves,
+		LeafOutcomes:  outcomes.list(),
+	}
+	require.Equal(t, SyncTotals{
+		Inserted:           4,
+		SkippedDuplicate:   1,
+		FailedVerification: 1,
+	}, diff.Totals())
+
+	failures := diff.Failures()
+	require.Len(t, failures, 1)
+	require.Equal(t, rejectedKey, failures[0].Key)
+	require.ErrorIs(t, failures[0].Err, ErrLeafRejected)
+	require.ErrorContains(t, failures[0].Err, "invalid proof")
+
+	require.Equal(t, SyncTotals{
+		Inserted:           8,
+		SkippedDuplicate:   2,
+		FailedVerification: 2,
+	}, SumSyncTotals([]AssetSyncDiff{diff, diff}))
+
+	// Once the leaf the rejected leaf depends on is known, a retry of the
+	// rejected leaf replaces its failed outcome.
+	registrar.rejected = fn.NewSet[[32]byte]()
+	outcomes.reset([]LeafKey{rejectedKey})
+	require.False(t, outcomes.hasFailures())
+
+	retriedLeaves, err := syncer.insertLeafItems(
+		ctx, id, items[1:2], &outcomes,
+	)
+	require.NoError(t, err)
+	require.Len(t, retriedLeaves, 1)
+	require.False(t, outcomes.isFailed(rejectedKey))
+
+	diff.LeafOutcomes = outcomes.list()
+	require.Equal(t, SyncTotals{
+		Inserted:         5,
+		SkippedDuplicate: 1,
+	}, diff.Totals())
+}
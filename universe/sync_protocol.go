@@ -0,0 +1,384 @@
+package universe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"golang.org/x/time/rate"
+)
+
+var (
+	// ErrNetworkMismatch is returned if a remote universe operates on a
+	// different network than the local one.
+	ErrNetworkMismatch = errors.New("universe network mismatch")
+)
+
+// SyncProtocolVersion is the version of the universe sync protocol that two
+// peers use to communicate during a sync session.
+type SyncProtocolVersion uint32
+
+const (
+	// SyncProtocolV0 is the legacy sync protocol, used by peers that don't
+	// take part in the version handshake. It uses a fixed page size of
+	// LegacyPageSize and supports no optional features.
+	SyncProtocolV0 SyncProtocolVersion = 0
+
+	// SyncProtocolV1 is the first version of the sync protocol that is
+	// negotiated explicitly at the start of a sync session. It allows the
+	// page size and the set of optional features to be negotiated.
+	SyncProtocolV1 SyncProtocolVersion = 1
+
+	// LatestSyncProtocolVersion is the latest sync protocol version this
+	// node understands.
+	LatestSyncProtocolVersion = SyncProtocolV1
+
+	// LegacyPageSize is the page size used with peers that don't take part
+	// in the version handshake.
+	LegacyPageSize = MaxPageSize
+)
+
+// SyncFeature is a bit vector of optional features of the universe sync
+// protocol.
+type SyncFeature uint64
+
+const (
+	// SyncFeatureCompressedProofs signals that proofs can be transferred
+	// in a compressed encoding.
+	SyncFeatureCompressedProofs SyncFeature = 1 << 0
+
+	// SyncFeatureBisectionDiff signals that the set difference of two
+	// universes can be computed by bisecting their trees.
+	SyncFeatureBisectionDiff SyncFeature = 1 << 1
+
+	// SyncFeatureCursorPagination signals that pages can be requested by
+	// an opaque cursor instead of an offset.
+	SyncFeatureCursorPagination SyncFeature = 1 << 2
+)
+
+// Has returns true if all the given features are set.
+func (f SyncFeature) Has(features SyncFeature) bool {
+	return f&features == features
+}
+
+// String returns a human-readable list of the features that are set.
+func (f SyncFeature) String() string {
+	var names []string
+	if f.Has(SyncFeatureCompressedProofs) {
+		names = append(names, "compressed_proofs")
+	}
+	if f.Has(SyncFeatureBisectionDiff) {
+		names = append(names, "bisection_diff")
+	}
+	if f.Has(SyncFeatureCursorPagination) {
+		names = append(names, "cursor_pagination")
+	}
+
+	return fmt.Sprintf("[%s]", strings.Join(names, ","))
+}
+
+// SyncProtocolInfo describes the sync protocol capabilities of a peer.
+type SyncProtocolInfo struct {
+	// Version is the latest protocol version the peer understands.
+	Version SyncProtocolVersion
+
+	// MaxPageSize is the maximum number of items the peer is willing to
+	// return in a single page.
+	MaxPageSize int32
+
+	// Features is the set of optional features the peer supports.
+	Features SyncFeature
+
+	// Network is the ID of the network the peer operates on, as returned
+	// by address.ChainParams.NetworkID. This is empty if the peer didn't
+	// advertise its network.
+	Network string
+
+	// ServerVersion is the software version of the peer. This is empty if
+	// the peer didn't advertise its version.
+	ServerVersion string
+
+	// ProofTypes is the set of proof types the peer serves. If empty, the
+	// peer didn't advertise its proof types and is assumed to serve all of
+	// them.
+	ProofTypes []ProofType
+
+	// QueriesPerSecond is the maximum number of queries per second the
+	// peer serves before it starts to rate limit. Zero means the peer
+	// didn't advertise a rate limit.
+	QueriesPerSecond float64
+
+	// QueriesBurst is the burst budget of the peer's rate limit.
+	QueriesBurst int
+}
+
+// LocalSyncProtocolInfo returns the sync protocol capabilities of this node
+// operating on the network with the given ID.
+func LocalSyncProtocolInfo(network string) SyncProtocolInfo {
+	return SyncProtocolInfo{
+		Version:     LatestSyncProtocolVersion,
+		MaxPageSize: MaxPageSize,
+		Network:     network,
+		ProofTypes: []ProofType{
+			ProofTypeIssuance, ProofTypeTransfer,
+		},
+	}
+}
+
+// CheckNetwork returns an error wrapping ErrNetworkMismatch if both the local
+// and the remote peer advertised their network and the networks differ.
+func CheckNetwork(local, remote SyncProtocolInfo) error {
+	if local.Network == "" || remote.Network == "" {
+		return nil
+	}
+
+	if local.Network != remote.Network {
+		return fmt.Errorf("%w: local universe is on %v, remote "+
+			"universe is on %v", ErrNetworkMismatch, local.Network,
+			remote.Network)
+	}
+
+	return nil
+}
+
+// LegacySyncProtocolInfo returns the sync protocol capabilities we assume for
+// peers that don't take part in the version handshake.
+func LegacySyncProtocolInfo() SyncProtocolInfo {
+	return SyncProtocolInfo{
+		Version:     SyncProtocolV0,
+		MaxPageSize: LegacyPageSize,
+	}
+}
+
+// SyncSession holds the parameters negotiated between two peers at the start
+// of a sync session.
+type SyncSession struct {
+	// Version is the protocol version used for the session.
+	Version SyncProtocolVersion
+
+	// PageSize is the number of items to request per page.
+	PageSize int32
+
+	// Features is the set of optional features both peers support.
+	Features SyncFeature
+
+	// ProofTypes is the set of proof types both peers support. If empty,
+	// all proof types can be synced.
+	ProofTypes []ProofType
+
+	// QueriesPerSecond is the rate at which queries are sent to the remote
+	// peer, to stay below its rate limit. Zero means queries aren't rate
+	// limited.
+	QueriesPerSecond float64
+
+	// QueriesBurst is the burst budget used for rate limiting the queries
+	// sent to the remote peer.
+	QueriesBurst int
+}
+
+// SupportsProofType returns true if the given proof type can be synced within
+// the session.
+func (s SyncSession) SupportsProofType(proofType ProofType) bool {
+	return len(s.ProofTypes) == 0 ||
+		slices.Contains(s.ProofTypes, proofType)
+}
+
+// NegotiateSyncSession negotiates the parameters of a sync session given the
+// capabilities of the local and the remote peer. The lowest common version and
+// page size are used, and only features supported by both peers are enabled.
+// Peers on different networks can't sync with each other.
+func NegotiateSyncSession(local, remote SyncProtocolInfo) (SyncSession,
+	error) {
+
+	if err := CheckNetwork(local, remote); err != nil {
+		return SyncSession{}, err
+	}
+
+	if remote.MaxPageSize <= 0 {
+		return SyncSession{}, fmt.Errorf("invalid remote max page "+
+			"size: %d", remote.MaxPageSize)
+	}
+
+	session := SyncSession{
+		Version:  min(local.Version, remote.Version),
+		PageSize: min(local.MaxPageSize, remote.MaxPageSize),
+		Features: local.Features & remote.Features,
+	}
+
+	// Only proof types supported by both peers can be synced. A peer that
+	// doesn't advertise its proof types is assumed to support all of them.
+	switch {
+	case len(remote.ProofTypes) == 0:
+
+	case len(local.ProofTypes) == 0:
+		session.ProofTypes = remote.ProofTypes
+
+	default:
+		for _, proofType := range local.ProofTypes {
+			if slices.Contains(remote.ProofTypes, proofType) {
+				session.ProofTypes = append(
+					session.ProofTypes, proofType,
+				)
+			}
+		}
+
+		if len(session.ProofTypes) == 0 {
+			return SyncSession{}, fmt.Errorf("no common proof "+
+				"types, local supports %v, remote supports %v",
+				local.ProofTypes, remote.ProofTypes)
+		}
+	}
+
+	// We pace our queries according to the rate limit the remote peer
+	// advertised, so we aren't throttled in the middle of a sync.
+	if remote.QueriesPerSecond > 0 &&
+		rate.Limit(remote.QueriesPerSecond) != rate.Inf {
+
+		session.QueriesPerSecond = remote.QueriesPerSecond
+		session.QueriesBurst = max(remote.QueriesBurst, 1)
+	}
+
+	// Legacy peers don't support any of the optional features.
+	if session.Version == SyncProtocolV0 {
+		session.Features = 0
+	}
+
+	return session, nil
+}
+
+// SyncProtocolNegotiator is an optional interface a DiffEngine can implement
+// to take part in the sync protocol version handshake. Diff engines that don't
+// implement it are treated as legacy peers.
+type SyncProtocolNegotiator interface {
+	// SyncProtocolInfo returns the sync protocol capabilities of the peer
+	// the diff engine is connected to.
+	SyncProtocolInfo(ctx context.Context) (SyncProtocolInfo, error)
+}
+
+// negotiateSyncSession performs the version handshake with the peer behind the
+// given diff engine. The local network is the ID of the network the local
+// universe operates on.
+func negotiateSyncSession(ctx context.Context, diffEngine DiffEngine,
+	localNetwork string) (SyncSession, error) {
+
+	remoteInfo := LegacySyncProtocolInfo()
+	if negotiator, ok := diffEngine.(SyncProtocolNegotiator); ok {
+		var err error
+		remoteInfo, err = negotiator.SyncProtocolInfo(ctx)
+		if err != nil {
+			return SyncSession{}, fmt.Errorf("unable to fetch "+
+				"remote sync protocol info: %w", err)
+		}
+	}
+
+	return NegotiateSyncSession(
+		LocalSyncProtocolInfo(localNetwork), remoteInfo,
+	)
+}
+
+// rateLimitedDiffEngine is a DiffEngine that waits for the rate limiter of a
+// sync session before each query to the remote peer.
+type rateLimitedDiffEngine struct {
+	DiffEngine
+
+	limiter *rate.Limiter
+}
+
+// RootNode returns the root node for a given base universe.
+func (r *rateLimitedDiffEngine) RootNode(ctx context.Context,
+	id Identifier) (Root, error) {
+
+	if err := r.limiter.Wait(ctx); err != nil {
+		return Root{}, err
+	}
+
+	return r.DiffEngine.RootNode(ctx, id)
+}
+
+// RootNodes returns the set of root nodes for all known universes.
+func (r *rateLimitedDiffEngine) RootNodes(ctx context.Context,
+	q RootNodesQuery) ([]Root, error) {
+
+	if err := r.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	return r.DiffEngine.RootNodes(ctx, q)
+}
+
+// UniverseLeafKeys returns all the keys inserted in the universe.
+func (r *rateLimitedDiffEngine) UniverseLeafKeys(ctx context.Context,
+	q UniverseLeafKeysQuery) ([]LeafKey, error) {
+
+	if err := r.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	return r.DiffEngine.UniverseLeafKeys(ctx, q)
+}
+
+// FetchProofLeaf attempts to fetch a proof leaf for the target leaf key and
+// given a universe identifier (assetID/groupKey).
+func (r *rateLimitedDiffEngine) FetchProofLeaf(ctx context.Context,
+	id Identifier, key LeafKey) ([]*Proof, error) {
+
+	if err := r.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	return r.DiffEngine.FetchProofLeaf(ctx, id, key)
+}
+
+// rateLimitedBatchDiffEngine is a rateLimitedDiffEngine for diff engines that
+// also implement the BatchProofFetcher interface.
+type rateLimitedBatchDiffEngine struct {
+	*rateLimitedDiffEngine
+
+	batchFetcher BatchProofFetcher
+}
+
+// FetchProofLeaves returns the proof leaves for a batch of leaf keys of the
+// target universe, in the same order as the keys.
+func (r *rateLimitedBatchDiffEngine) FetchProofLeaves(ctx context.Context,
+	id Identifier, keys []LeafKey) ([]*Proof, error) {
+
+	if err := r.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	return r.batchFetcher.FetchProofLeaves(ctx, id, keys)
+}
+
+// limitDiffEngine wraps the given diff engine so queries to the remote peer
+// are paced according to the rate limit negotiated for the session. If the
+// remote peer didn't advertise a rate limit, the diff engine is returned as
+// is.
+func (s SyncSession) limitDiffEngine(diffEngine DiffEngine) DiffEngine {
+	if s.QueriesPerSecond <= 0 {
+		return diffEngine
+	}
+
+	limited := &rateLimitedDiffEngine{
+		DiffEngine: diffEngine,
+		limiter: rate.NewLimiter(
+			rate.Limit(s.QueriesPerSecond), s.QueriesBurst,
+		),
+	}
+
+	// The syncer fetches proofs in batches if the diff engine supports
+	// it, so we need to retain that capability.
+	if batchFetcher, ok := diffEngine.(BatchProofFetcher); ok {
+		return &rateLimitedBatchDiffEngine{
+			rateLimitedDiffEngine: limited,
+			batchFetcher:          batchFetcher,
+		}
+	}
+
+	return limited
+}
+
+// A compile-time assertion to make sure rateLimitedBatchDiffEngine satisfies
+// the BatchProofFetcher interface.
+var _ BatchProofFetcher = (*rateLimitedBatchDiffEngine)(nil)
@@ -0,0 +1,186 @@
+package universe
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestNegotiateSyncSession tests that the sync session parameters are
+// negotiated correctly between peers with different capabilities.
+func TestNegotiateSyncSession(t *testing.T) {
+	t.Parallel()
+
+	allFeatures := SyncFeatureCompressedProofs | SyncFeatureBisectionDiff |
+		SyncFeatureCursorPagination
+
+	const localNetwork = "testnet4:283f161c"
+
+	testCases := []struct {
+		name        string
+		local       SyncProtocolInfo
+		remote      SyncProtocolInfo
+		expected    SyncSession
+		expectedErr string
+	}{{
+		name:   "legacy remote",
+		local:  LocalSyncProtocolInfo(localNetwork),
+		remote: LegacySyncProtocolInfo(),
+		expected: SyncSession{
+			Version:  SyncProtocolV0,
+			PageSize: LegacyPageSize,
+		},
+	}, {
+		name: "legacy remote ignores features",
+		local: SyncProtocolInfo{
+			Version:     SyncProtocolV1,
+			MaxPageSize: MaxPageSize,
+			Features:    allFeatures,
+		},
+		remote: SyncProtocolInfo{
+			Version:     SyncProtocolV0,
+			MaxPageSize: MaxPageSize,
+			Features:    allFeatures,
+		},
+		expected: SyncSession{
+			Version:  SyncProtocolV0,
+			PageSize: MaxPageSize,
+		},
+	}, {
+		name: "smaller remote page size and common features",
+		local: SyncProtocolInfo{
+			Version:     SyncProtocolV1,
+			MaxPageSize: MaxPageSize,
+			Features:    allFeatures,
+		},
+		remote: SyncProtocolInfo{
+			Version:     SyncProtocolV1 + 1,
+			MaxPageSize: 100,
+			Features:    SyncFeatureBisectionDiff,
+		},
+		expected: SyncSession{
+			Version:  SyncProtocolV1,
+			PageSize: 100,
+			Features: SyncFeatureBisectionDiff,
+		},
+	}, {
+		name:  "invalid remote page size",
+		local: LocalSyncProtocolInfo(localNetwork),
+		remote: SyncProtocolInfo{
+			Version: SyncProtocolV1,
+		},
+		expectedErr: "invalid remote max page size",
+	}, {
+		name:  "remote on same network",
+		local: LocalSyncProtocolInfo(localNetwork),
+		remote: SyncProtocolInfo{
+			Version:     SyncProtocolV1,
+			MaxPageSize: MaxPageSize,
+			Network:     localNetwork,
+		},
+		expected: SyncSession{
+			Version:  SyncProtocolV1,
+			PageSize: MaxPageSize,
+		},
+	}, {
+		name:  "remote on different network",
+		local: LocalSyncProtocolInfo(localNetwork),
+		remote: SyncProtocolInfo{
+			Version:     SyncProtocolV1,
+			MaxPageSize: MaxPageSize,
+			Network:     "signet:a553b1ef",
+		},
+		expectedErr: ErrNetworkMismatch.Error(),
+	}, {
+		name:  "remote serves subset of proof types",
+		local: LocalSyncProtocolInfo(localNetwork),
+		remote: SyncProtocolInfo{
+			Version:     SyncProtocolV1,
+			MaxPageSize: MaxPageSize,
+			ProofTypes:  []ProofType{ProofTypeIssuance},
+		},
+		expected: SyncSession{
+			Version:    SyncProtocolV1,
+			PageSize:   MaxPageSize,
+			ProofTypes: []ProofType{ProofTypeIssuance},
+		},
+	}, {
+		name: "no common proof types",
+		local: SyncProtocolInfo{
+			Version:     SyncProtocolV1,
+			MaxPageSize: MaxPageSize,
+			ProofTypes:  []ProofType{ProofTypeTransfer},
+		},
+		remote: SyncProtocolInfo{
+			Version:     SyncProtocolV1,
+			MaxPageSize: MaxPageSize,
+			ProofTypes:  []ProofType{ProofTypeIssuance},
+		},
+		expectedErr: "no common proof types",
+	}, {
+		name:  "remote rate limit",
+		local: LocalSyncProtocolInfo(localNetwork),
+		remote: SyncProtocolInfo{
+			Version:          SyncProtocolV1,
+			MaxPageSize:      MaxPageSize,
+			ServerVersion:    "0.4.0-alpha",
+			QueriesPerSecond: 2.5,
+		},
+		expected: SyncSession{
+			Version:          SyncProtocolV1,
+			PageSize:         MaxPageSize,
+			QueriesPerSecond: 2.5,
+			QueriesBurst:     1,
+		},
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			session, err := NegotiateSyncSession(
+				tc.local, tc.remote,
+			)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, session)
+		})
+	}
+}
+
+// TestSyncSessionLimitDiffEngine tests that the diff engine is only rate
+// limited if the remote peer advertised a rate limit, and that the batch proof
+// fetching capability is retained.
+func TestSyncSessionLimitDiffEngine(t *testing.T) {
+	t.Parallel()
+
+	diffEngine := &batchDiffEngine{}
+
+	session := SyncSession{}
+	require.Same(t, diffEngine, session.limitDiffEngine(diffEngine))
+
+	session.QueriesPerSecond = 10
+	session.QueriesBurst = 1
+	limited := session.limitDiffEngine(diffEngine)
+	require.IsType(t, &rateLimitedBatchDiffEngine{}, limited)
+	require.True(t, session.SupportsProofType(ProofTypeTransfer))
+
+	session.ProofTypes = []ProofType{ProofTypeIssuance}
+	require.True(t, session.SupportsProofType(ProofTypeIssuance))
+	require.False(t, session.SupportsProofType(ProofTypeTransfer))
+}
+
+// batchDiffEngine is a DiffEngine that also implements the BatchProofFetcher
+// interface.
+type batchDiffEngine struct {
+	DiffEngine
+}
+
+func (b *batchDiffEngine) FetchProofLeaves(context.Context, Identifier,
+	[]LeafKey) ([]*Proof, error) {
+
+	return nil, nil
+}
@@ -0,0 +1,270 @@
+package universe
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// MinSyncInterval is the smallest sync interval that can be scheduled
+	// for a single universe or federation server. This is also the
+	// resolution at which the scheduled syncs are checked.
+	MinSyncInterval = time.Minute
+)
+
+// FedUniSyncSchedule overrides the interval at which a single universe is
+// synced with the federation. Universes with a schedule are synced on their
+// own, and are no longer part of the periodic full sync with the federation
+// servers.
+type FedUniSyncSchedule struct {
+	// UniverseID is the ID of the Universe that the schedule applies to.
+	UniverseID Identifier
+
+	// SyncInterval is the interval at which the universe is synced. If
+	// zero, the schedule is removed when upserted.
+	SyncInterval time.Duration
+}
+
+// FedServerSyncSchedule overrides the interval of the periodic full sync with
+// a single federation server.
+type FedServerSyncSchedule struct {
+	// ServerHost is the host of the universe server the schedule applies
+	// to.
+	ServerHost string
+
+	// SyncInterval is the interval at which the server is synced. If zero,
+	// the schedule is removed when upserted.
+	SyncInterval time.Duration
+}
+
+// SyncSchedules is the set of universe and server specific sync intervals
+// that override the default sync interval of the federation envoy.
+type SyncSchedules struct {
+	// Universes are the universe specific sync schedules.
+	Universes []*FedUniSyncSchedule
+
+	// Servers are the server specific sync schedules.
+	Servers []*FedServerSyncSchedule
+}
+
+// validateSyncInterval makes sure the given interval can be scheduled. A zero
+// interval is valid, as it removes a schedule.
+func validateSyncInterval(interval time.Duration) error {
+	if interval != 0 && interval < MinSyncInterval {
+		return fmt.Errorf("sync interval %v is below the minimum of %v",
+			interval, MinSyncInterval)
+	}
+
+	return nil
+}
+
+// Validate makes sure all schedules have a valid sync interval and target.
+func (s *SyncSchedules) Validate() error {
+	for _, schedule := range s.Universes {
+		err := validateSyncInterval(schedule.SyncInterval)
+		if err != nil {
+			return fmt.Errorf("universe %v: %w",
+				schedule.UniverseID.String(), err)
+		}
+	}
+
+	for _, schedule := range s.Servers {
+		if schedule.ServerHost == "" {
+			return fmt.Errorf("missing server host")
+		}
+
+		err := validateSyncInterval(schedule.SyncInterval)
+		if err != nil {
+			return fmt.Errorf("server %v: %w", schedule.ServerHost,
+				err)
+		}
+	}
+
+	return nil
+}
+
+// scheduledSync is a sync with a single federation server that is due.
+type scheduledSync struct {
+	// server is the federation server to sync with.
+	server ServerAddr
+
+	// full indicates that the periodic full sync with the server is due.
+	full bool
+
+	// ids are the scheduled universes that are due to be synced with the
+	// server.
+	ids []Identifier
+}
+
+// syncScheduler keeps track of when the default and scheduled syncs with each
+// federation server were last attempted, to decide which of them are due.
+//
+// NOTE: The scheduler is not safe for concurrent use, it MUST only be used
+// from the syncer goroutine of the envoy.
+type syncScheduler struct {
+	// defaultInterval is the interval of the full sync with the servers
+	// that don't have their own schedule.
+	defaultInterval time.Duration
+
+	// started is the time the scheduler was created. Syncs that were
+	// never attempted are scheduled relative to it.
+	started time.Time
+
+	// lastTick is the last time the default interval elapsed.
+	lastTick time.Time
+
+	// lastFullSyncs maps the host of each server to the time of the last
+	// full sync with it.
+	lastFullSyncs map[string]time.Time
+
+	// lastUniSyncs maps the host of each server and the ID of each
+	// scheduled universe to the time the universe was last synced with
+	// the server.
+	lastUniSyncs map[string]time.Time
+}
+
+// newSyncScheduler creates a new sync scheduler with the given default
+// interval.
+func newSyncScheduler(defaultInterval time.Duration,
+	now time.Time) *syncScheduler {
+
+	return &syncScheduler{
+		defaultInterval: defaultInterval,
+		started:         now,
+		lastTick:        now,
+		lastFullSyncs:   make(map[string]time.Time),
+		lastUniSyncs:    make(map[string]time.Time),
+	}
+}
+
+// tickInterval returns the interval at which the scheduler should be checked
+// for due syncs.
+func (s *syncScheduler) tickInterval() time.Duration {
+	return min(s.defaultInterval, MinSyncInterval)
+}
+
+// setDefaultInterval changes the interval of the full sync with the servers
+// that don't have their own schedule. Syncs that were already attempted are
+// due once the new interval elapsed since the last attempt.
+func (s *syncScheduler) setDefaultInterval(interval time.Duration) {
+	s.defaultInterval = interval
+}
+
+// isDue returns true if the given interval has elapsed since the last time.
+// As the scheduler is only checked once per tick, half a tick of slack is
+// allowed so that syncs aren't delayed by a full tick due to timer jitter.
+func (s *syncScheduler) isDue(last, now time.Time,
+	interval time.Duration) bool {
+
+	return now.Sub(last)+s.tickInterval()/2 >= interval
+}
+
+// defaultDue returns true and resets the default interval if it has elapsed.
+func (s *syncScheduler) defaultDue(now time.Time) bool {
+	if !s.isDue(s.lastTick, now, s.defaultInterval) {
+		return false
+	}
+
+	s.lastTick = now
+
+	return true
+}
+
+// dueSyncs returns the syncs with the given federation servers that are due
+// according to the given schedules, and marks them as attempted. Scheduled
+// universes are only synced if their sync insert is enabled.
+func (s *syncScheduler) dueSyncs(now time.Time, servers []ServerAddr,
+	schedules *SyncSchedules, syncConfigs *SyncConfigs) []scheduledSync {
+
+	serverIntervals := make(map[string]time.Duration)
+	for _, schedule := range schedules.Servers {
+		serverIntervals[schedule.ServerHost] = schedule.SyncInterval
+	}
+
+	// Start from fresh maps, so we don't keep track of servers and
+	// universes that were removed in the meantime.
+	lastFullSyncs := make(map[string]time.Time, len(servers))
+	lastUniSyncs := make(map[string]time.Time)
+	lastSync := func(times map[string]time.Time, key string) time.Time {
+		if last, ok := times[key]; ok {
+			return last
+		}
+
+		return s.started
+	}
+
+	var syncs []scheduledSync
+	for _, server := range servers {
+		host := server.HostStr()
+		sync := scheduledSync{
+			server: server,
+		}
+
+		interval, ok := serverIntervals[host]
+		if !ok {
+			interval = s.defaultInterval
+		}
+
+		lastFullSyncs[host] = lastSync(s.lastFullSyncs, host)
+		if s.isDue(lastFullSyncs[host], now, interval) {
+			sync.full = true
+			lastFullSyncs[host] = now
+		}
+
+		for _, schedule := range schedules.Universes {
+			id := schedule.UniverseID
+			if !syncConfigs.IsSyncInsertEnabled(id) {
+				continue
+			}
+
+			key := host + "/" + id.String()
+			last := lastSync(s.lastUniSyncs, key)
+			lastUniSyncs[key] = last
+			if s.isDue(last, now, schedule.SyncInterval) {
+				sync.ids = append(sync.ids, id)
+				lastUniSyncs[key] = now
+			}
+		}
+
+		if sync.full || len(sync.ids) > 0 {
+			syncs = append(syncs, sync)
+		}
+	}
+
+	s.lastFullSyncs = lastFullSyncs
+	s.lastUniSyncs = lastUniSyncs
+
+	return syncs
+}
+
+// excludeScheduled returns a copy of the given sync configs that disables
+// sync insert for the universes with their own schedule, so they aren't part
+// of the full sync.
+func excludeScheduled(syncConfigs SyncConfigs,
+	schedules *SyncSchedules) SyncConfigs {
+
+	if len(schedules.Universes) == 0 {
+		return syncConfigs
+	}
+
+	// Universe specific configs are matched in order, so the ones we
+	// prepend take precedence over the stored ones.
+	uniConfigs := make(
+		[]*FedUniSyncConfig, 0,
+		len(schedules.Universes)+len(syncConfigs.UniSyncConfigs),
+	)
+	for _, schedule := range schedules.Universes {
+		uniConfigs = append(uniConfigs, &FedUniSyncConfig{
+			UniverseID:      schedule.UniverseID,
+			AllowSyncInsert: false,
+			AllowSyncExport: syncConfigs.IsSyncExportEnabled(
+				schedule.UniverseID,
+			),
+		})
+	}
+	syncConfigs.UniSyncConfigs = append(
+		uniConfigs, syncConfigs.UniSyncConfigs...,
+	)
+
+	return syncConfigs
+}
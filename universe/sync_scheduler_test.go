@@ -0,0 +1,235 @@
+package universe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSyncScheduler tests that the sync scheduler only returns the full and
+// universe specific syncs with each server that are due.
+func TestSyncScheduler(t *testing.T) {
+	t.Parallel()
+
+	start := time.Now()
+	fast := NewServerAddrFromStr("fast.example.com:10029")
+	slow := NewServerAddrFromStr("slow.example.com:10029")
+	servers := []ServerAddr{fast, slow}
+
+	hotID := Identifier{
+		AssetID:   asset.RandID(t),
+		ProofType: ProofTypeIssuance,
+	}
+	coldID := Identifier{
+		AssetID:   asset.RandID(t),
+		ProofType: ProofTypeIssuance,
+	}
+	deniedID := Identifier{
+		AssetID:   asset.RandID(t),
+		ProofType: ProofTypeTransfer,
+	}
+
+	schedules := &SyncSchedules{
+		Universes: []*FedUniSyncSchedule{{
+			UniverseID:   hotID,
+			SyncInterval: time.Minute,
+		}, {
+			UniverseID:   coldID,
+			SyncInterval: 24 * time.Hour,
+		}, {
+			UniverseID:   deniedID,
+			SyncInterval: time.Minute,
+		}},
+		Servers: []*FedServerSyncSchedule{{
+			ServerHost:   slow.HostStr(),
+			SyncInterval: time.Hour,
+		}},
+	}
+	syncConfigs := &SyncConfigs{
+		GlobalSyncConfigs: []*FedGlobalSyncConfig{{
+			ProofType:       ProofTypeIssuance,
+			AllowSyncInsert: true,
+		}},
+	}
+
+	scheduler := newSyncScheduler(10*time.Minute, start)
+	require.Equal(t, time.Minute, scheduler.tickInterval())
+
+	// hostsOf returns the hosts of the given syncs and the IDs they sync.
+	hostsOf := func(syncs []scheduledSync) map[string][]Identifier {
+		hosts := make(map[string][]Identifier)
+		for _, sync := range syncs {
+			ids := sync.ids
+			if sync.full {
+				ids = append(ids, Identifier{})
+			}
+			hosts[sync.server.HostStr()] = ids
+		}
+
+		return hosts
+	}
+
+	// Right after the start, nothing is due yet.
+	syncs := scheduler.dueSyncs(start, servers, schedules, syncConfigs)
+	require.Empty(t, syncs)
+	require.False(t, scheduler.defaultDue(start))
+
+	// After a minute, the hot universe is due with both servers, even
+	// with a bit of timer jitter. The universe without sync insert is
+	// never synced.
+	now := start.Add(time.Minute - time.Second)
+	syncs = scheduler.dueSyncs(now, servers, schedules, syncConfigs)
+	require.Equal(t, map[string][]Identifier{
+		fast.HostStr(): {hotID},
+		slow.HostStr(): {hotID},
+	}, hostsOf(syncs))
+
+	// After the default interval, the full sync with the fast server is
+	// due as well, but not the one with the slow server.
+	now = start.Add(10 * time.Minute)
+	syncs = scheduler.dueSyncs(now, servers, schedules, syncConfigs)
+	require.Equal(t, map[string][]Identifier{
+		fast.HostStr(): {hotID, {}},
+		slow.HostStr(): {hotID},
+	}, hostsOf(syncs))
+	require.True(t, scheduler.defaultDue(now))
+	require.False(t, scheduler.defaultDue(now.Add(time.Minute)))
+
+	// After an hour, the slow server is due for a full sync too.
+	now = start.Add(time.Hour)
+	syncs = scheduler.dueSyncs(now, servers, schedules, syncConfigs)
+	require.Equal(t, map[string][]Identifier{
+		fast.HostStr(): {hotID, {}},
+		slow.HostStr(): {hotID, {}},
+	}, hostsOf(syncs))
+
+	// Removed servers are forgotten, so a server that is added again is
+	// scheduled relative to the start of the scheduler.
+	now = start.Add(90 * time.Minute)
+	scheduler.dueSyncs(now, []ServerAddr{fast}, schedules, syncConfigs)
+	require.NotContains(t, scheduler.lastFullSyncs, slow.HostStr())
+
+	// Once a day, the cold universe is synced as well.
+	now = start.Add(24 * time.Hour)
+	syncs = scheduler.dueSyncs(
+		now, []ServerAddr{fast}, schedules, syncConfigs,
+	)
+	require.Equal(t, map[string][]Identifier{
+		fast.HostStr(): {hotID, coldID, {}},
+	}, hostsOf(syncs))
+}
+
+// TestExcludeScheduled tests that scheduled universes are excluded from the
+// full sync, without changing their export config or the original configs.
+func TestExcludeScheduled(t *testing.T) {
+	t.Parallel()
+
+	scheduledID := Identifier{
+		AssetID:   asset.RandID(t),
+		ProofType: ProofTypeIssuance,
+	}
+	otherID := Identifier{
+		AssetID:   asset.RandID(t),
+		ProofType: ProofTypeIssuance,
+	}
+	syncConfigs := SyncConfigs{
+		GlobalSyncConfigs: []*FedGlobalSyncConfig{{
+			ProofType:       ProofTypeIssuance,
+			AllowSyncInsert: true,
+			AllowSyncExport: true,
+		}},
+	}
+
+	fullSyncConfigs := excludeScheduled(syncConfigs, &SyncSchedules{
+		Universes: []*FedUniSyncSchedule{{
+			UniverseID:   scheduledID,
+			SyncInterval: time.Minute,
+		}},
+	})
+	require.False(t, fullSyncConfigs.IsSyncInsertEnabled(scheduledID))
+	require.True(t, fullSyncConfigs.IsSyncExportEnabled(scheduledID))
+	require.True(t, fullSyncConfigs.IsSyncInsertEnabled(otherID))
+
+	require.True(t, syncConfigs.IsSyncInsertEnabled(scheduledID))
+	require.Empty(t, syncConfigs.UniSyncConfigs)
+}
+
+// TestSyncSchedulesValidate tests that intervals below the minimum and server
+// schedules without a host are rejected.
+func TestSyncSchedulesValidate(t *testing.T) {
+	t.Parallel()
+
+	id := Identifier{
+		AssetID:   asset.RandID(t),
+		ProofType: ProofTypeIssuance,
+	}
+
+	valid := &SyncSchedules{
+		Universes: []*FedUniSyncSchedule{{
+			UniverseID:   id,
+			SyncInterval: MinSyncInterval,
+		}, {
+			UniverseID: id,
+		}},
+		Servers: []*FedServerSyncSchedule{{
+			ServerHost:   "universe.example.com:10029",
+			SyncInterval: time.Hour,
+		}},
+	}
+	require.NoError(t, valid.Validate())
+
+	tooShort := &SyncSchedules{
+		Universes: []*FedUniSyncSchedule{{
+			UniverseID:   id,
+			SyncInterval: time.Second,
+		}},
+	}
+	require.ErrorContains(t, tooShort.Validate(), "below the minimum")
+
+	noHost := &SyncSchedules{
+		Servers: []*FedServerSyncSchedule{{
+			SyncInterval: time.Hour,
+		}},
+	}
+	require.ErrorContains(t, noHost.Validate(), "missing server host")
+}
+
+// TestSyncSchedulerSetDefaultInterval tests that a changed default interval is
+// applied relative to the last full sync with each server.
+func TestSyncSchedulerSetDefaultInterval(t *testing.T) {
+	t.Parallel()
+
+	start := time.Now()
+	server := NewServerAddrFromStr("server.example.com:10029")
+	servers := []ServerAddr{server}
+	schedules := &SyncSchedules{}
+	syncConfigs := &SyncConfigs{}
+
+	scheduler := newSyncScheduler(time.Hour, start)
+
+	now := start.Add(10 * time.Minute)
+	syncs := scheduler.dueSyncs(now, servers, schedules, syncConfigs)
+	require.Empty(t, syncs)
+	require.False(t, scheduler.defaultDue(now))
+
+	// Once the interval is shortened, the full sync that is now overdue
+	// happens on the next tick.
+	scheduler.setDefaultInterval(5 * time.Minute)
+	require.Equal(t, time.Minute, scheduler.tickInterval())
+
+	syncs = scheduler.dueSyncs(now, servers, schedules, syncConfigs)
+	require.Len(t, syncs, 1)
+	require.True(t, syncs[0].full)
+	require.True(t, scheduler.defaultDue(now))
+
+	// The next full sync is due after the new interval.
+	now = now.Add(4 * time.Minute)
+	syncs = scheduler.dueSyncs(now, servers, schedules, syncConfigs)
+	require.Empty(t, syncs)
+
+	now = now.Add(time.Minute)
+	syncs = scheduler.dueSyncs(now, servers, schedules, syncConfigs)
+	require.Len(t, syncs, 1)
+}
@@ -0,0 +1,509 @@
+package universe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+)
+
+// SyncEventType is the type of a progress event of a streaming universe sync.
+type SyncEventType uint8
+
+const (
+	// SyncEventRootStarted is sent when the leaves of a diverging universe
+	// start being synced. If the sync is resumed from a checkpoint, the
+	// event carries the progress of the checkpoint.
+	SyncEventRootStarted SyncEventType = iota
+
+	// SyncEventLeafSynced is sent for each new leaf that was inserted into
+	// the local universe.
+	SyncEventLeafSynced
+
+	// SyncEventCheckpoint is sent each time the progress of a universe
+	// sync was persisted as a checkpoint.
+	SyncEventCheckpoint
+
+	// SyncEventRootSynced is sent once all leaves of a universe were
+	// synced.
+	SyncEventRootSynced
+)
+
+// String returns a human-readable representation of the event type.
+func (t SyncEventType) String() string {
+	switch t {
+	case SyncEventRootStarted:
+		return "root_started"
+
+	case SyncEventLeafSynced:
+		return "leaf_synced"
+
+	case SyncEventCheckpoint:
+		return "checkpoint"
+
+	case SyncEventRootSynced:
+		return "root_synced"
+
+	default:
+		return fmt.Sprintf("unknown(%d)", t)
+	}
+}
+
+// SyncEvent is a progress event of a streaming universe sync.
+type SyncEvent struct {
+	// Type is the type of the event.
+	Type SyncEventType
+
+	// ID is the identifier of the universe the event relates to.
+	ID Identifier
+
+	// Key is the key of the leaf that was synced. This is only set for
+	// SyncEventLeafSynced events.
+	Key LeafKey
+
+	// Leaf is the leaf that was synced. This is only set for
+	// SyncEventLeafSynced events.
+	Leaf *Leaf
+
+	// Offset is the number of remote leaf keys that were processed so far.
+	Offset int32
+
+	// NumSynced is the number of new leaves that were inserted into the
+	// local universe so far.
+	NumSynced uint64
+
+	// Diff is the final diff of the universe. This is only set for
+	// SyncEventRootSynced events.
+	Diff *AssetSyncDiff
+}
+
+// SyncCheckpoint is the persisted progress of a streaming sync of a single
+// universe with a remote server.
+type SyncCheckpoint struct {
+	// ID is the identifier of the universe that is being synced.
+	ID Identifier
+
+	// RemoteRoot is the root of the remote universe the sync was started
+	// for. The checkpoint is only valid as long as the remote root doesn't
+	// change.
+	RemoteRoot mssmt.Node
+
+	// LocalRoot is the root of the local universe after the leaves up to
+	// the checkpoint were inserted. This is nil if the local universe was
+	// still empty. The checkpoint is only valid as long as the local root
+	// doesn't change.
+	LocalRoot mssmt.Node
+
+	// Offset is the number of remote leaf keys that were processed.
+	Offset int32
+
+	// NumSynced is the number of new leaves that were inserted into the
+	// local universe.
+	NumSynced uint64
+}
+
+// resumable returns true if a sync can be resumed from the checkpoint, given
+// the current remote and local root of the universe.
+func (c *SyncCheckpoint) resumable(remoteRoot, localRoot mssmt.Node) bool {
+	if !mssmt.IsEqualNode(c.RemoteRoot, remoteRoot) {
+		return false
+	}
+
+	if c.LocalRoot == nil || localRoot == nil {
+		return c.LocalRoot == nil && localRoot == nil
+	}
+
+	return mssmt.IsEqualNode(c.LocalRoot, localRoot)
+}
+
+// sendSyncEvent sends the given event to the events channel, if one is set.
+func sendSyncEvent(ctx context.Context, events chan<- SyncEvent,
+	event SyncEvent) {
+
+	if events == nil {
+		return
+	}
+
+	select {
+	case events <- event:
+	case <-ctx.Done():
+	}
+}
+
+// SyncUniverseStream attempts to synchronize the local universe with the
+// remote universe, governed by the sync type and the set of universe IDs to
+// sync. Progress events are sent to the given channel, which must be read by
+// the caller until the call returns.
+//
+// In contrast to SyncUniverse, the leaves of a diverging universe are synced
+// one page of remote leaf keys at a time. The progress is persisted as a
+// checkpoint after each page, so an interrupted sync resumes from the last
+// checkpoint, as long as neither the remote nor the local universe changed in
+// the meantime.
+//
+// NOTE: This is part of the StreamingSyncer interface.
+func (s *SimpleSyncer) SyncUniverseStream(ctx context.Context,
+	host ServerAddr, syncType SyncType, syncConfigs SyncConfigs,
+	events chan<- SyncEvent, idsToSync ...Identifier) ([]AssetSyncDiff,
+	error) {
+
+	log.Infof("Attempting to stream sync universe: host=%v, "+
+		"sync_type=%v, ids=%v", host.HostStr(), syncType,
+		spew.Sdump(idsToSync))
+
+	diffEngine, err := s.cfg.NewRemoteDiffEngine(host)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create remote diff "+
+			"engine: %w", err)
+	}
+	defer diffEngine.Close()
+
+	syncRoot := func(ctx context.Context, host ServerAddr,
+		remoteRoot Root, diffEngine DiffEngine, session SyncSession,
+		result chan<- AssetSyncDiff) error {
+
+		return s.syncRootStream(
+			ctx, host, remoteRoot, diffEngine, session, events,
+			result,
+		)
+	}
+
+	return s.executeSync(
+		ctx, host, diffEngine, syncType, syncConfigs, idsToSync,
+		syncRoot,
+	)
+}
+
+// syncRootStream syncs the leaves of a single universe root from the remote
+// diff engine one page of remote leaf keys at a time, persisting a checkpoint
+// after each page.
+func (s *SimpleSyncer) syncRootStream(ctx context.Context, host ServerAddr,
+	remoteRoot Root, diffEngine DiffEngine, session SyncSession,
+	events chan<- SyncEvent, result chan<- AssetSyncDiff) error {
+
+	uniID := remoteRoot.ID
+	localRoot, needsSync, err := s.rootNeedsSync(ctx, host, remoteRoot)
+	if err != nil || !needsSync {
+		return err
+	}
+
+	var (
+		offset    int32
+		numSynced uint64
+	)
+	checkpoint, err := s.fetchSyncCheckpoint(ctx, host, uniID)
+	if err != nil {
+		return err
+	}
+	if checkpoint != nil &&
+		checkpoint.resumable(remoteRoot, localRoot.Node) {
+
+		offset = checkpoint.Offset
+		numSynced = checkpoint.NumSynced
+
+		log.Infof("UniverseRoot(%v): resuming sync from checkpoint, "+
+			"offset=%v, num_synced=%v", uniID.String(), offset,
+			numSynced)
+	}
+
+	sendSyncEvent(ctx, events, SyncEvent{
+		Type:      SyncEventRootStarted,
+		ID:        uniID,
+		Offset:    offset,
+		NumSynced: numSynced,
+	})
+
+	// We only fetch the keys of the local universe once. Every leaf we
+	// insert is added to the set, so we never fetch a leaf twice.
+	localKeys, err := s.fetchAllLeafKeys(
+		ctx, s.cfg.LocalDiffEngine, uniID, defaultPageSize,
+	)
+	if err != nil {
+		return err
+	}
+	knownKeys := fn.NewSet(fn.Map(localKeys, func(k LeafKey) [32]byte {
+		return k.UniverseKey()
+	})...)
+
+	var (
+		outcomes      leafOutcomes
+		newLeafProofs []*Leaf
+		rejectedItems []*Item
+	)
+	leafSynced := func(item *Item) {
+		knownKeys.Add(item.Key.UniverseKey())
+		numSynced++
+
+		sendSyncEvent(ctx, events, SyncEvent{
+			Type:      SyncEventLeafSynced,
+			ID:        uniID,
+			Key:       item.Key,
+			Leaf:      item.Leaf,
+			Offset:    offset,
+			NumSynced: numSynced,
+		})
+	}
+	for {
+		remoteKeys, err := diffEngine.UniverseLeafKeys(
+			ctx, UniverseLeafKeysQuery{
+				Id:            uniID,
+				Offset:        offset,
+				Limit:         session.PageSize,
+				SortDirection: SortAscending,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		if len(remoteKeys) == 0 {
+			break
+		}
+
+		keysToFetch := fn.Filter(remoteKeys, func(k LeafKey) bool {
+			return !knownKeys.Contains(k.UniverseKey())
+		})
+		outcomes.addAll(
+			fn.Filter(remoteKeys, func(k LeafKey) bool {
+				return knownKeys.Contains(k.UniverseKey())
+			}), LeafSyncSkippedDuplicate,
+		)
+
+		log.Debugf("UniverseRoot(%v): offset=%v, page_size=%v, "+
+			"diff_size=%v", uniID.String(), offset,
+			len(remoteKeys), len(keysToFetch))
+
+		items, err := fetchLeafItems(
+			ctx, diffEngine, remoteRoot, keysToFetch, &outcomes,
+		)
+		if err != nil {
+			return err
+		}
+
+		newLeaves, err := s.insertLeafItems(
+			ctx, uniID, items, &outcomes,
+		)
+		if err != nil {
+			return err
+		}
+		newLeafProofs = append(newLeafProofs, newLeaves...)
+
+		// Only the leaves that were inserted are known now, the ones
+		// that failed verification are tried again with the next
+		// sync.
+		offset += int32(len(remoteKeys))
+		for _, item := range items {
+			if outcomes.isFailed(item.Key) {
+				rejectedItems = append(rejectedItems, item)
+				continue
+			}
+
+			leafSynced(item)
+		}
+
+		err = s.storeSyncCheckpoint(
+			ctx, host, remoteRoot, offset, numSynced, events,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Transfer leaves are only sorted by block height within a page, so a
+	// leaf can be rejected because it depends on a leaf of a later page.
+	// Now that all pages were inserted, we retry the rejected leaves once,
+	// sorted across all pages.
+	if uniID.ProofType != ProofTypeIssuance && len(rejectedItems) != 0 {
+		log.Infof("UniverseRoot(%v): retrying %d rejected leaves",
+			uniID.String(), len(rejectedItems))
+
+		sortTransferItems(rejectedItems)
+		outcomes.reset(fn.Map(rejectedItems, func(i *Item) LeafKey {
+			return i.Key
+		}))
+
+		newLeaves, err := s.insertLeafItems(
+			ctx, uniID, rejectedItems, &outcomes,
+		)
+		if err != nil {
+			return err
+		}
+		newLeafProofs = append(newLeafProofs, newLeaves...)
+
+		for _, item := range rejectedItems {
+			if !outcomes.isFailed(item.Key) {
+				leafSynced(item)
+			}
+		}
+	}
+
+	// The universe is fully synced, so the checkpoint isn't needed
+	// anymore.
+	if s.cfg.SyncCheckpoints != nil {
+		err := s.cfg.SyncCheckpoints.DeleteSyncCheckpoint(
+			ctx, host, uniID,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to delete sync "+
+				"checkpoint: %w", err)
+		}
+	}
+
+	log.Infof("Stream sync for UniverseRoot(%v) complete, %d new leaves "+
+		"inserted", uniID.String(), numSynced)
+
+	diff := AssetSyncDiff{
+		OldUniverseRoot: localRoot,
+		NewUniverseRoot: remoteRoot,
+		NewLeafProofs:   newLeafProofs,
+		LeafOutcomes:    outcomes.list(),
+	}
+	result <- diff
+
+	sendSyncEvent(ctx, events, SyncEvent{
+		Type:      SyncEventRootSynced,
+		ID:        uniID,
+		Offset:    offset,
+		NumSynced: numSynced,
+		Diff:      &diff,
+	})
+
+	return s.finishRootSync(ctx, host, remoteRoot, &outcomes)
+}
+
+// fetchSyncCheckpoint returns the stored checkpoint of the sync of the given
+// universe with the given remote server, or nil if there is none.
+func (s *SimpleSyncer) fetchSyncCheckpoint(ctx context.Context,
+	host ServerAddr, id Identifier) (*SyncCheckpoint, error) {
+
+	if s.cfg.SyncCheckpoints == nil {
+		return nil, nil
+	}
+
+	checkpoint, err := s.cfg.SyncCheckpoints.FetchSyncCheckpoint(
+		ctx, host, id,
+	)
+	switch {
+	case errors.Is(err, ErrNoSyncCheckpoint):
+		return nil, nil
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to fetch sync checkpoint: %w",
+			err)
+	}
+
+	return checkpoint, nil
+}
+
+// storeSyncCheckpoint persists the progress of the sync of the given remote
+// root, together with the current local root of the universe.
+func (s *SimpleSyncer) storeSyncCheckpoint(ctx context.Context,
+	host ServerAddr, remoteRoot Root, offset int32, numSynced uint64,
+	events chan<- SyncEvent) error {
+
+	if s.cfg.SyncCheckpoints == nil {
+		return nil
+	}
+
+	var localRoot mssmt.Node
+	root, err := s.cfg.LocalDiffEngine.RootNode(ctx, remoteRoot.ID)
+	switch {
+	// If no leaves were inserted yet, the local universe might still be
+	// empty.
+	case errors.Is(err, ErrNoUniverseRoot):
+
+	case err != nil:
+		return fmt.Errorf("unable to fetch local root: %w", err)
+
+	default:
+		localRoot = root.Node
+	}
+
+	checkpoint := SyncCheckpoint{
+		ID:         remoteRoot.ID,
+		RemoteRoot: remoteRoot.Node,
+		LocalRoot:  localRoot,
+		Offset:     offset,
+		NumSynced:  numSynced,
+	}
+	err = s.cfg.SyncCheckpoints.StoreSyncCheckpoint(ctx, host, checkpoint)
+	if err != nil {
+		return fmt.Errorf("unable to store sync checkpoint: %w", err)
+	}
+
+	sendSyncEvent(ctx, events, SyncEvent{
+		Type:      SyncEventCheckpoint,
+		ID:        remoteRoot.ID,
+		Offset:    offset,
+		NumSynced: numSynced,
+	})
+
+	return nil
+}
+
+// fetchLeafItems fetches the leaves of the given keys from the remote diff
+// engine and verifies them against the remote root. Leaves that aren't part of
+// the remote root are recorded as failed and left out. Transfer leaves are
+// sorted by block height, so they can be validated in dependency order.
+func fetchLeafItems(ctx context.Context, diffEngine DiffEngine,
+	remoteRoot Root, keys []LeafKey,
+	outcomes *leafOutcomes) ([]*Item, error) {
+
+	uniID := remoteRoot.ID
+	fetchedItems := make(chan *Item, len(keys))
+	err := fetchProofLeaves(
+		ctx, diffEngine, uniID, keys, int32(len(keys)),
+		func(key LeafKey, leafProof *Proof) error {
+			if !leafProof.VerifyRoot(remoteRoot) {
+				log.Warnf("UniverseRoot(%v): proof for "+
+					"key=%v is invalid", uniID.String(),
+					spew.Sdump(key))
+
+				outcomes.add(
+					key, LeafSyncFailedVerification,
+					ErrLeafNotInRoot,
+				)
+				return nil
+			}
+
+			fetchedItems <- &Item{
+				ID:   uniID,
+				Key:  key,
+				Leaf: leafProof.Leaf,
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	items := fn.Collect(fetchedItems)
+	if uniID.ProofType != ProofTypeIssuance {
+		sortTransferItems(items)
+	}
+
+	return items, nil
+}
+
+// insertLeafItems inserts the given items into the local universe in batches
+// and returns the new leaves. The outcome of each item is recorded.
+func (s *SimpleSyncer) insertLeafItems(ctx context.Context, uniID Identifier,
+	items []*Item, outcomes *leafOutcomes) ([]*Leaf, error) {
+
+	fetchedLeaves := make(chan *Item, len(items))
+	fn.SendAll(fetchedLeaves, items...)
+	close(fetchedLeaves)
+
+	return s.batchStreamNewItems(
+		ctx, uniID, fetchedLeaves, len(items), outcomes,
+	)
+}
+
+// A compile-time assertion to make sure SimpleSyncer satisfies the
+// StreamingSyncer interface.
+var _ StreamingSyncer = (*SimpleSyncer)(nil)
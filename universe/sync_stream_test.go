@@ -0,0 +1,92 @@
+package universe
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSyncCheckpointResumable tests that a sync is only resumed from a
+// checkpoint if neither the remote nor the local root changed since the
+// checkpoint was stored.
+func TestSyncCheckpointResumable(t *testing.T) {
+	t.Parallel()
+
+	randNode := func() mssmt.Node {
+		var nodeHash mssmt.NodeHash
+		copy(nodeHash[:], test.RandBytes(32))
+
+		return mssmt.NewComputedBranch(nodeHash, 100)
+	}
+
+	remoteRoot, localRoot := randNode(), randNode()
+
+	testCases := []struct {
+		name       string
+		checkpoint SyncCheckpoint
+		remoteRoot mssmt.Node
+		localRoot  mssmt.Node
+		resumable  bool
+	}{{
+		name: "same roots",
+		checkpoint: SyncCheckpoint{
+			RemoteRoot: remoteRoot,
+			LocalRoot:  localRoot,
+		},
+		remoteRoot: remoteRoot,
+		localRoot:  localRoot,
+		resumable:  true,
+	}, {
+		name: "empty local universe",
+		checkpoint: SyncCheckpoint{
+			RemoteRoot: remoteRoot,
+		},
+		remoteRoot: remoteRoot,
+		resumable:  true,
+	}, {
+		name: "remote root changed",
+		checkpoint: SyncCheckpoint{
+			RemoteRoot: remoteRoot,
+			LocalRoot:  localRoot,
+		},
+		remoteRoot: randNode(),
+		localRoot:  localRoot,
+	}, {
+		name: "local root changed",
+		checkpoint: SyncCheckpoint{
+			RemoteRoot: remoteRoot,
+			LocalRoot:  localRoot,
+		},
+		remoteRoot: remoteRoot,
+		localRoot:  randNode(),
+	}, {
+		name: "local universe no longer empty",
+		checkpoint: SyncCheckpoint{
+			RemoteRoot: remoteRoot,
+		},
+		remoteRoot: remoteRoot,
+		localRoot:  localRoot,
+	}, {
+		name: "local universe emptied",
+		checkpoint: SyncCheckpoint{
+			RemoteRoot: remoteRoot,
+			LocalRoot:  localRoot,
+		},
+		remoteRoot: remoteRoot,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(
+				t, tc.resumable, tc.checkpoint.resumable(
+					tc.remoteRoot, tc.localRoot,
+				),
+			)
+		})
+	}
+}
@@ -43,8 +43,30 @@ type SimpleSyncCfg struct {
 
 	// SyncBatchSize is the number of items to sync in a single batch.
 	SyncBatchSize int
+
+	// RemoteRootCache is an optional cache of the roots of remote
+	// universes as of the last successful sync. If set, universes whose
+	// remote root didn't change since then are skipped.
+	RemoteRootCache RemoteRootCache
+
+	// SyncCheckpoints is an optional store for the progress of streaming
+	// syncs. If set, an interrupted streaming sync resumes from the last
+	// checkpoint instead of starting from scratch.
+	SyncCheckpoints SyncCheckpointStore
+
+	// Network is the ID of the network the local universe operates on, as
+	// returned by address.ChainParams.NetworkID. If set, syncing with a
+	// remote universe that advertises a different network is refused.
+	Network string
 }
 
+// rootSyncFunc is a function that syncs the leaves of a single universe root
+// from the remote diff engine and sends the resulting diff to the given
+// channel.
+type rootSyncFunc func(ctx context.Context, host ServerAddr, remoteRoot Root,
+	diffEngine DiffEngine, session SyncSession,
+	result chan<- AssetSyncDiff) error
+
 // SimpleSyncer is a simple implementation of the Syncer interface. It's based
 // on a set difference operation between the local and remote Universe.
 type SimpleSyncer struct {
@@ -54,6 +76,10 @@ type SimpleSyncer struct {
 	// Universe with a remote Universe. This is used to prevent concurrent
 	// syncs.
 	isSyncing atomic.Bool
+
+	// numSkippedSyncs is the number of universe root syncs that were
+	// skipped because the remote root didn't change since the last sync.
+	numSkippedSyncs atomic.Uint64
 }
 
 // NewSimpleSyncer creates a new SimpleSyncer instance.
@@ -66,9 +92,10 @@ func NewSimpleSyncer(cfg SimpleSyncCfg) *SimpleSyncer {
 // executeSync attempts to sync the local Universe with the remote diff engine.
 // A simple approach where a set difference is used to find the set of assets
 // that need to be synced is used.
-func (s *SimpleSyncer) executeSync(ctx context.Context, diffEngine DiffEngine,
-	syncType SyncType, syncConfigs SyncConfigs,
-	idsToSync []Identifier) ([]AssetSyncDiff, error) {
+func (s *SimpleSyncer) executeSync(ctx context.Context, host ServerAddr,
+	diffEngine DiffEngine, syncType SyncType, syncConfigs SyncConfigs,
+	idsToSync []Identifier, syncRoot rootSyncFunc) ([]AssetSyncDiff,
+	error) {
 
 	// Prevent the syncer from running twice.
 	if !s.isSyncing.CompareAndSwap(false, true) {
@@ -80,6 +107,54 @@ func (s *SimpleSyncer) executeSync(ctx context.Context, diffEngine DiffEngine,
 		s.isSyncing.Store(false)
 	}()
 
+	// Before we start, we'll perform the version handshake with the remote
+	// Universe to find out which page size and features we can use.
+	session, err := negotiateSyncSession(ctx, diffEngine, s.cfg.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Negotiated sync session: version=%v, page_size=%v, "+
+		"features=%v, proof_types=%v, queries_per_second=%v",
+		session.Version, session.PageSize, session.Features,
+		session.ProofTypes, session.QueriesPerSecond)
+
+	// If the remote Universe advertised a rate limit, we'll pace our
+	// queries, so we don't get throttled in the middle of the sync.
+	diffEngine = session.limitDiffEngine(diffEngine)
+
+	targetRoots, err := s.fetchTargetRoots(
+		ctx, diffEngine, session, syncType, syncConfigs, idsToSync,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Now that we know the set of Universes we need to sync, we'll execute
+	// the diff operation for each of them.
+	syncDiffs := make(chan AssetSyncDiff, len(targetRoots))
+	err = fn.ParSlice(
+		ctx, targetRoots, func(ctx context.Context, r Root) error {
+			return syncRoot(
+				ctx, host, r, diffEngine, session, syncDiffs,
+			)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Finally, we'll collect all the diffs and return them to the caller.
+	return fn.Collect(syncDiffs), nil
+}
+
+// fetchTargetRoots fetches the roots of all remote universes that should be
+// synced, governed by the sync type and sync configs or the given set of
+// universe IDs.
+func (s *SimpleSyncer) fetchTargetRoots(ctx context.Context,
+	diffEngine DiffEngine, session SyncSession, syncType SyncType,
+	syncConfigs SyncConfigs, idsToSync []Identifier) ([]Root, error) {
+
 	// Examine config to ascertain whether global insertion for either proof
 	// type is allowed.
 	globalInsertEnabled := fn.Any(
@@ -101,6 +176,12 @@ func (s *SimpleSyncer) executeSync(ctx context.Context, diffEngine DiffEngine,
 			return false
 		}
 
+		// There's no point in syncing proofs the remote Universe
+		// doesn't serve.
+		if !session.SupportsProofType(id.ProofType) {
+			return false
+		}
+
 		return syncConfigs.IsSyncInsertEnabled(id)
 	}
 
@@ -110,9 +191,15 @@ func (s *SimpleSyncer) executeSync(ctx context.Context, diffEngine DiffEngine,
 	)
 	switch {
 	// If we have been given a specific set of Universes to sync, then we'll
-	// only fetch roots for those universes. We wont filter out any
-	// Universes here as we assume that the caller has already done so.
+	// only fetch roots for those universes. Apart from the proof types the
+	// remote doesn't serve and the ones excluded by the asset filter, we
+	// wont filter out any Universes here as we assume that the caller has
+	// already done so.
 	case len(idsToSync) != 0:
+		idsToSync = fn.Filter(idsToSync, func(id Identifier) bool {
+			return session.SupportsProofType(id.ProofType) &&
+				syncConfigs.AssetFilter.IsAllowed(id)
+		})
 		targetRoots, err = fetchRootsForIDs(ctx, idsToSync, diffEngine)
 		if err != nil {
 			return nil, err
@@ -123,7 +210,9 @@ func (s *SimpleSyncer) executeSync(ctx context.Context, diffEngine DiffEngine,
 	case globalInsertEnabled:
 		log.Infof("Fetching all roots for remote Universe server...")
 
-		targetRoots, err = s.fetchAllRoots(ctx, diffEngine)
+		targetRoots, err = s.fetchAllRoots(
+			ctx, diffEngine, session.PageSize,
+		)
 		if err != nil {
 			return nil, err
 		}
@@ -164,20 +253,7 @@ func (s *SimpleSyncer) executeSync(ctx context.Context, diffEngine DiffEngine,
 	log.Infof("Obtained %v roots from remote Universe server",
 		len(targetRoots))
 
-	// Now that we know the set of Universes we need to sync, we'll execute
-	// the diff operation for each of them.
-	syncDiffs := make(chan AssetSyncDiff, len(targetRoots))
-	err = fn.ParSlice(
-		ctx, targetRoots, func(ctx context.Context, r Root) error {
-			return s.syncRoot(ctx, r, diffEngine, syncDiffs)
-		},
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	// Finally, we'll collect all the diffs and return them to the caller.
-	return fn.Collect(syncDiffs), nil
+	return targetRoots, nil
 }
 
 // fetchRootsForIDs fetches the roots for a specific set of universe IDs.
@@ -213,28 +289,15 @@ func fetchRootsForIDs(ctx context.Context, idsToSync []Identifier,
 
 // syncRoot attempts to sync the local Universe with the remote diff engine for
 // a specific base root.
-func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot Root,
-	diffEngine DiffEngine, result chan<- AssetSyncDiff) error {
+func (s *SimpleSyncer) syncRoot(ctx context.Context, host ServerAddr,
+	remoteRoot Root, diffEngine DiffEngine, session SyncSession,
+	result chan<- AssetSyncDiff) error {
 
 	// First, we'll compare the remote root against the local root.
 	uniID := remoteRoot.ID
-	localRoot, err := s.cfg.LocalDiffEngine.RootNode(ctx, uniID)
-	switch {
-	// If we don't have this root, then we don't have anything to compare
-	// to, so we'll proceed as normal.
-	case errors.Is(err, ErrNoUniverseRoot):
-		// TODO(roasbeef): abstraction leak, error should be in
-		// universe package
-
-	// If the local root matches the remote root, then we're done here.
-	case err == nil && mssmt.IsEqualNode(localRoot, remoteRoot):
-		log.Debugf("Root for %v matches, no sync needed",
-			uniID.String())
-
-		return nil
-
-	case err != nil:
-		return fmt.Errorf("unable to fetch local root: %w", err)
+	localRoot, needsSync, err := s.rootNeedsSync(ctx, host, remoteRoot)
+	if err != nil || !needsSync {
+		return err
 	}
 
 	log.Infof("UniverseRoot(%v) diverges, performing leaf diff...",
@@ -248,19 +311,25 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot Root,
 		localUniKeys  []LeafKey
 	)
 
-	remoteUniKeys, err = s.fetchAllLeafKeys(ctx, diffEngine, uniID)
+	remoteUniKeys, err = s.fetchAllLeafKeys(
+		ctx, diffEngine, uniID, session.PageSize,
+	)
 	if err != nil {
 		return err
 	}
 
-	localUniKeys, err = s.fetchAllLeafKeys(ctx, s.cfg.LocalDiffEngine, uniID)
+	localUniKeys, err = s.fetchAllLeafKeys(
+		ctx, s.cfg.LocalDiffEngine, uniID, defaultPageSize,
+	)
 	if err != nil {
 		return err
 	}
 
 	// With the set of keys fetched, we can now find the set of keys that
-	// need to be synced.
-	keysToFetch := fn.SetDiff(remoteUniKeys, localUniKeys)
+	// need to be synced. The keys we already have are skipped.
+	var outcomes leafOutcomes
+	_, keysToFetch, knownKeys := diffLeafKeys(localUniKeys, remoteUniKeys)
+	outcomes.addAll(knownKeys, LeafSyncSkippedDuplicate)
 
 	log.Infof("UniverseRoot(%v): diff_size=%v", uniID.String(),
 		len(keysToFetch))
@@ -282,7 +351,7 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot Root,
 	// into the input fetchedLeaves channel.
 	batchSyncEG.Go(func() error {
 		newLeafProofs, err = s.batchStreamNewItems(
-			ctx, uniID, fetchedLeaves, len(keysToFetch),
+			ctx, uniID, fetchedLeaves, len(keysToFetch), &outcomes,
 		)
 		return err
 	})
@@ -294,24 +363,24 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot Root,
 
 	// Now that we know where the divergence is, we can fetch the issuance
 	// proofs from the remote party.
-	err = fn.ParSlice(
-		ctx, keysToFetch, func(ctx context.Context, key LeafKey) error {
-			newProof, err := diffEngine.FetchProofLeaf(
-				ctx, uniID, key,
-			)
-			if err != nil {
-				return err
-			}
-
-			leafProof := newProof[0]
-
+	err = fetchProofLeaves(
+		ctx, diffEngine, uniID, keysToFetch, session.PageSize,
+		func(key LeafKey, leafProof *Proof) error {
 			// Now that we have this leaf proof, we want to ensure
 			// that it's actually part of the remote root we were
-			// given.
+			// given. A leaf that isn't is skipped, so it doesn't
+			// prevent the other leaves from being synced.
 			validRoot := leafProof.VerifyRoot(remoteRoot)
 			if !validRoot {
-				return fmt.Errorf("proof for key=%v is "+
-					"invalid", spew.Sdump(key))
+				log.Warnf("UniverseRoot(%v): proof for "+
+					"key=%v is invalid", uniID.String(),
+					spew.Sdump(key))
+
+				outcomes.add(
+					key, LeafSyncFailedVerification,
+					ErrLeafNotInRoot,
+				)
+				return nil
 			}
 
 			// If this is an issuance proof, then we can send
@@ -333,7 +402,8 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot Root,
 			}
 
 			return nil
-		})
+		},
+	)
 	if err != nil {
 		return err
 	}
@@ -342,28 +412,7 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot Root,
 	// need to sort them to ensure we can validate them in dep order.
 	if !isIssuanceTree {
 		transferLeaves := fn.Collect(transferLeafProofs)
-		sort.Slice(transferLeaves, func(i, j int) bool {
-			// We'll need to decode the block heights from the
-			// proof, so we'll make a record to do so.
-			var iBlockHeight, jBlockHeight uint32
-
-			iRecord := proof.BlockHeightRecord(&iBlockHeight)
-			jRecord := proof.BlockHeightRecord(&jBlockHeight)
-
-			_ = proof.SparseDecode(
-				//nolint:lll
-				bytes.NewReader(transferLeaves[i].Leaf.RawProof),
-				iRecord,
-			)
-
-			_ = proof.SparseDecode(
-				//nolint:lll
-				bytes.NewReader(transferLeaves[j].Leaf.RawProof),
-				jRecord,
-			)
-
-			return iBlockHeight < jBlockHeight
-		})
+		sortTransferItems(transferLeaves)
 
 		fn.SendAll(fetchedLeaves, transferLeaves...)
 	}
@@ -376,7 +425,7 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot Root,
 	}
 
 	log.Infof("Universe sync for UniverseRoot(%v) complete, %d "+
-		"new leaves inserted", uniID.String(), len(keysToFetch))
+		"new leaves inserted", uniID.String(), len(newLeafProofs))
 
 	// TODO(roabseef): sanity check local and remote roots match now?
 
@@ -386,20 +435,209 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot Root,
 		OldUniverseRoot: localRoot,
 		NewUniverseRoot: remoteRoot,
 		NewLeafProofs:   newLeafProofs,
+		LeafOutcomes:    outcomes.list(),
 	}
 
 	log.Infof("Sync for UniverseRoot(%v) complete!", uniID.String())
 	log.Tracef("Sync for UniverseRoot(%v) complete! New "+
 		"universe_root=%v", uniID.String(), spew.Sdump(remoteRoot))
 
+	return s.finishRootSync(ctx, host, remoteRoot, &outcomes)
+}
+
+// finishRootSync caches the given remote root after its leaves were synced.
+// If any leaf failed verification, the local universe doesn't match the remote
+// root, so the root isn't cached and the next sync tries the failed leaves
+// again.
+func (s *SimpleSyncer) finishRootSync(ctx context.Context, host ServerAddr,
+	remoteRoot Root, outcomes *leafOutcomes) error {
+
+	if outcomes.hasFailures() {
+		log.Warnf("UniverseRoot(%v) only partially synced, some "+
+			"leaves failed verification", remoteRoot.ID.String())
+
+		return nil
+	}
+
+	return s.storeRemoteRoot(ctx, host, remoteRoot)
+}
+
+// rootNeedsSync compares the given remote root against the local root of the
+// same universe and returns the local root and whether the leaves of the
+// universe need to be synced.
+func (s *SimpleSyncer) rootNeedsSync(ctx context.Context, host ServerAddr,
+	remoteRoot Root) (Root, bool, error) {
+
+	uniID := remoteRoot.ID
+	localRoot, err := s.cfg.LocalDiffEngine.RootNode(ctx, uniID)
+	switch {
+	// If we don't have this root, then we don't have anything to compare
+	// to, so we'll proceed as normal.
+	case errors.Is(err, ErrNoUniverseRoot):
+		// TODO(roasbeef): abstraction leak, error should be in
+		// universe package
+
+	// If the local root matches the remote root, then we're done here.
+	case err == nil && mssmt.IsEqualNode(localRoot, remoteRoot):
+		log.Debugf("Root for %v matches, no sync needed",
+			uniID.String())
+
+		return localRoot, false, s.storeRemoteRoot(
+			ctx, host, remoteRoot,
+		)
+
+	case err != nil:
+		return localRoot, false, fmt.Errorf("unable to fetch local "+
+			"root: %w", err)
+
+	// The roots differ, but if the remote root didn't change since we
+	// last synced it, the difference is on our side only and there's
+	// nothing new for us to fetch.
+	case s.remoteRootUnchanged(ctx, host, remoteRoot):
+		log.Debugf("Remote root for %v unchanged since last sync, "+
+			"skipping", uniID.String())
+
+		s.numSkippedSyncs.Add(1)
+
+		return localRoot, false, nil
+	}
+
+	return localRoot, true, nil
+}
+
+// fetchProofLeaves fetches the proof leaves of the given keys from the diff
+// engine and calls handleProof for each of them. If the diff engine supports
+// batch fetching, the proofs are fetched one page of keys at a time.
+// Otherwise, they're fetched one key at a time in parallel, in which case
+// handleProof is called concurrently.
+func fetchProofLeaves(ctx context.Context, diffEngine DiffEngine,
+	id Identifier, keys []LeafKey, pageSize int32,
+	handleProof func(LeafKey, *Proof) error) error {
+
+	batchFetcher, ok := diffEngine.(BatchProofFetcher)
+	if !ok {
+		fetchProof := func(ctx context.Context, key LeafKey) error {
+			newProof, err := diffEngine.FetchProofLeaf(ctx, id, key)
+			if err != nil {
+				return err
+			}
+
+			return handleProof(key, newProof[0])
+		}
+
+		return fn.ParSlice(ctx, keys, fetchProof)
+	}
+
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	for start := 0; start < len(keys); start += int(pageSize) {
+		page := keys[start:min(start+int(pageSize), len(keys))]
+
+		proofs, err := batchFetcher.FetchProofLeaves(ctx, id, page)
+		if err != nil {
+			return err
+		}
+
+		if len(proofs) != len(page) {
+			return fmt.Errorf("expected %d proofs, got %d",
+				len(page), len(proofs))
+		}
+
+		for i, key := range page {
+			if err := handleProof(key, proofs[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// sortTransferItems sorts the given transfer leaves by the block height of
+// their proofs, so they can be validated in dependency order.
+func sortTransferItems(items []*Item) {
+	sort.Slice(items, func(i, j int) bool {
+		// We'll need to decode the block heights from the proof, so
+		// we'll make a record to do so.
+		var iBlockHeight, jBlockHeight uint32
+
+		iRecord := proof.BlockHeightRecord(&iBlockHeight)
+		jRecord := proof.BlockHeightRecord(&jBlockHeight)
+
+		_ = proof.SparseDecode(
+			bytes.NewReader(items[i].Leaf.RawProof), iRecord,
+		)
+
+		_ = proof.SparseDecode(
+			bytes.NewReader(items[j].Leaf.RawProof), jRecord,
+		)
+
+		return iBlockHeight < jBlockHeight
+	})
+}
+
+// remoteRootUnchanged returns true if the given remote root matches the root
+// we cached for the remote server after the last successful sync.
+func (s *SimpleSyncer) remoteRootUnchanged(ctx context.Context,
+	host ServerAddr, remoteRoot Root) bool {
+
+	if s.cfg.RemoteRootCache == nil {
+		return false
+	}
+
+	cachedRoot, err := s.cfg.RemoteRootCache.FetchRemoteRoot(
+		ctx, host, remoteRoot.ID,
+	)
+	switch {
+	case errors.Is(err, ErrNoRemoteRoot):
+		return false
+
+	// A failure to read the cache shouldn't prevent us from syncing, so
+	// we'll just log it and do a full sync of this root.
+	case err != nil:
+		log.Warnf("Unable to fetch cached remote root for %v: %v",
+			remoteRoot.ID.String(), err)
+
+		return false
+	}
+
+	return mssmt.IsEqualNode(cachedRoot, remoteRoot)
+}
+
+// storeRemoteRoot caches the given remote root after it was successfully
+// synced.
+func (s *SimpleSyncer) storeRemoteRoot(ctx context.Context, host ServerAddr,
+	remoteRoot Root) error {
+
+	if s.cfg.RemoteRootCache == nil {
+		return nil
+	}
+
+	err := s.cfg.RemoteRootCache.StoreRemoteRoot(ctx, host, remoteRoot)
+	if err != nil {
+		return fmt.Errorf("unable to cache remote root: %w", err)
+	}
+
 	return nil
 }
 
+// NumSkippedSyncs returns the number of universe root syncs that were skipped
+// because the remote root didn't change since the last successful sync.
+//
+// NOTE: This is part of the SyncMetrics interface.
+func (s *SimpleSyncer) NumSkippedSyncs() uint64 {
+	return s.numSkippedSyncs.Load()
+}
+
 // batchStreamNewItems streams the set of new items to the local registrar in
-// batches and returns the new leaf proofs.
+// batches and returns the new leaf proofs. If a batch is rejected, its items
+// are inserted one by one, so a single invalid leaf doesn't prevent the others
+// from being synced. The outcome of each item is recorded.
 func (s *SimpleSyncer) batchStreamNewItems(ctx context.Context,
-	uniID Identifier, fetchedLeaves chan *Item,
-	numTotal int) ([]*Leaf, error) {
+	uniID Identifier, fetchedLeaves chan *Item, numTotal int,
+	outcomes *leafOutcomes) ([]*Leaf, error) {
 
 	var (
 		numItems      int
@@ -418,8 +656,23 @@ func (s *SimpleSyncer) batchStreamNewItems(ctx context.Context,
 				ctx, batch,
 			)
 			if err != nil {
-				return fmt.Errorf("unable to register "+
-					"proofs: %w", err)
+				log.Warnf("UniverseRoot(%v): Unable to insert "+
+					"batch of %d leaves, inserting them "+
+					"one by one: %v", uniID.String(),
+					len(batch), err)
+
+				batch, err = s.insertItemsSingly(
+					ctx, batch, outcomes,
+				)
+				if err != nil {
+					return err
+				}
+			} else {
+				for _, item := range batch {
+					outcomes.add(
+						item.Key, LeafSyncInserted, nil,
+					)
+				}
 			}
 
 			if len(batch) > 0 {
@@ -445,6 +698,41 @@ func (s *SimpleSyncer) batchStreamNewItems(ctx context.Context,
 	return newLeafProofs, nil
 }
 
+// insertItemsSingly inserts the given items into the local universe one by
+// one, in order, and returns the items that were inserted. Items that are
+// rejected are recorded as failed verification.
+func (s *SimpleSyncer) insertItemsSingly(ctx context.Context, items []*Item,
+	outcomes *leafOutcomes) ([]*Item, error) {
+
+	inserted := make([]*Item, 0, len(items))
+	for _, item := range items {
+		_, err := s.cfg.LocalRegistrar.UpsertProofLeaf(
+			ctx, item.ID, item.Key, item.Leaf,
+		)
+
+		switch {
+		// If the sync was canceled, the leaf wasn't rejected.
+		case ctx.Err() != nil:
+			return nil, ctx.Err()
+
+		case err != nil:
+			log.Warnf("UniverseRoot(%v): Leaf %v rejected: %v",
+				item.ID.String(), item.Key.OutPoint, err)
+
+			outcomes.add(
+				item.Key, LeafSyncFailedVerification,
+				fmt.Errorf("%w: %w", ErrLeafRejected, err),
+			)
+
+		default:
+			outcomes.add(item.Key, LeafSyncInserted, nil)
+			inserted = append(inserted, item)
+		}
+	}
+
+	return inserted, nil
+}
+
 // SyncUniverse attempts to synchronize the local universe with the remote
 // universe, governed by the sync type and the set of universe IDs to sync.
 func (s *SimpleSyncer) SyncUniverse(ctx context.Context, host ServerAddr,
@@ -465,15 +753,19 @@ func (s *SimpleSyncer) SyncUniverse(ctx context.Context, host ServerAddr,
 
 	// With the engine created, we can now sync the local Universe with the
 	// remote instance.
-	return s.executeSync(ctx, diffEngine, syncType, syncConfigs, idsToSync)
+	return s.executeSync(
+		ctx, host, diffEngine, syncType, syncConfigs, idsToSync,
+		s.syncRoot,
+	)
 }
 
-// fetchAllRoots fetches all the roots from the remote Universe. This function
-// is used in order to isolate any logic related to the specifics of how we
-// fetch the data from the universe server.
-func (s *SimpleSyncer) fetchAllRoots(ctx context.Context, diffEngine DiffEngine) ([]Root, error) {
+// fetchAllRoots fetches all the roots from the remote Universe using the given
+// page size. This function is used in order to isolate any logic related to
+// the specifics of how we fetch the data from the universe server.
+func (s *SimpleSyncer) fetchAllRoots(ctx context.Context, diffEngine DiffEngine,
+	pageSize int32) ([]Root, error) {
+
 	offset := int32(0)
-	pageSize := defaultPageSize
 	roots := make([]Root, 0)
 
 	for {
@@ -503,15 +795,15 @@ func (s *SimpleSyncer) fetchAllRoots(ctx context.Context, diffEngine DiffEngine)
 	return roots, nil
 }
 
-// fetchAllLeafKeys fetches all the leaf keys from the remote Universe. This
-// function is used in order to isolate any logic related to the specifics of
-// how we fetch the data from the universe server.
+// fetchAllLeafKeys fetches all the leaf keys from the remote Universe using
+// the given page size. This function is used in order to isolate any logic
+// related to the specifics of how we fetch the data from the universe server.
 func (s *SimpleSyncer) fetchAllLeafKeys(ctx context.Context,
-	diffEngine DiffEngine, uniID Identifier) ([]LeafKey, error) {
+	diffEngine DiffEngine, uniID Identifier,
+	pageSize int32) ([]LeafKey, error) {
 
 	// Initialize the offset to be used for the pages.
 	offset := int32(0)
-	pageSize := defaultPageSize
 	leafKeys := make([]LeafKey, 0)
 
 	for {
@@ -537,3 +829,7 @@ func (s *SimpleSyncer) fetchAllLeafKeys(ctx context.Context,
 
 	return leafKeys, nil
 }
+
+// A compile-time assertion to make sure SimpleSyncer satisfies the SyncMetrics
+// interface.
+var _ SyncMetrics = (*SimpleSyncer)(nil)
@@ -0,0 +1,98 @@
+package universe
+
+import (
+	"sync"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+// UnconfirmedAnchors keeps track of anchor transactions that were
+// re-organized out of the chain and weren't included in a new block yet.
+// Universe leaves that are anchored in one of those transactions are reported
+// as unconfirmed until their anchor transaction is confirmed again.
+type UnconfirmedAnchors struct {
+	sync.RWMutex
+
+	anchors fn.Set[chainhash.Hash]
+}
+
+// NewUnconfirmedAnchors creates a new, empty set of unconfirmed anchor
+// transactions.
+func NewUnconfirmedAnchors() *UnconfirmedAnchors {
+	return &UnconfirmedAnchors{
+		anchors: fn.NewSet[chainhash.Hash](),
+	}
+}
+
+// AnchorReOrged marks the given anchor transaction as re-organized out of the
+// chain.
+func (u *UnconfirmedAnchors) AnchorReOrged(txHash chainhash.Hash) {
+	u.Lock()
+	defer u.Unlock()
+
+	log.Infof("Marking universe leaves anchored in TX %v as unconfirmed",
+		txHash)
+
+	u.anchors.Add(txHash)
+}
+
+// AnchorReConfirmed marks the given anchor transaction as confirmed again.
+func (u *UnconfirmedAnchors) AnchorReConfirmed(txHash chainhash.Hash) {
+	u.Lock()
+	defer u.Unlock()
+
+	if !u.anchors.Contains(txHash) {
+		return
+	}
+
+	log.Infof("Universe leaves anchored in TX %v are confirmed again",
+		txHash)
+
+	u.anchors.Remove(txHash)
+}
+
+// IsUnconfirmed returns true if the given anchor transaction was re-organized
+// out of the chain and wasn't confirmed again yet.
+func (u *UnconfirmedAnchors) IsUnconfirmed(txHash chainhash.Hash) bool {
+	u.RLock()
+	defer u.RUnlock()
+
+	return u.anchors.Contains(txHash)
+}
+
+// markProofs returns the given proofs with the Unconfirmed flag set for all
+// proofs whose anchor transaction is currently unconfirmed. The proofs are
+// copied before they're modified, as they might be shared with a cache.
+func (u *UnconfirmedAnchors) markProofs(proofs []*Proof) []*Proof {
+	if u == nil {
+		return proofs
+	}
+
+	u.RLock()
+	defer u.RUnlock()
+
+	if len(u.anchors) == 0 {
+		return proofs
+	}
+
+	markedProofs := make([]*Proof, len(proofs))
+	for idx := range proofs {
+		markedProofs[idx] = proofs[idx]
+
+		if proofs[idx] == nil {
+			continue
+		}
+
+		anchorHash := proofs[idx].LeafKey.OutPoint.Hash
+		if !u.anchors.Contains(anchorHash) {
+			continue
+		}
+
+		proofCopy := *proofs[idx]
+		proofCopy.Unconfirmed = true
+		markedProofs[idx] = &proofCopy
+	}
+
+	return markedProofs
+}
@@ -0,0 +1,56 @@
+package universe
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// TestUnconfirmedAnchors tests that proof leaves are only marked as
+// unconfirmed while their anchor transaction is re-organized out of the chain,
+// without modifying the original proofs.
+func TestUnconfirmedAnchors(t *testing.T) {
+	t.Parallel()
+
+	newProof := func(txHash chainhash.Hash) *Proof {
+		return &Proof{
+			LeafKey: LeafKey{
+				OutPoint: wire.OutPoint{
+					Hash: txHash,
+				},
+			},
+		}
+	}
+
+	reOrgedHash := chainhash.Hash(test.RandBytes(32))
+	otherHash := chainhash.Hash(test.RandBytes(32))
+	proofs := []*Proof{newProof(reOrgedHash), newProof(otherHash), nil}
+
+	// Without a tracker, or without any unconfirmed anchors, the proofs
+	// are returned as they are.
+	var noTracker *UnconfirmedAnchors
+	require.Equal(t, proofs, noTracker.markProofs(proofs))
+
+	anchors := NewUnconfirmedAnchors()
+	require.Equal(t, proofs, anchors.markProofs(proofs))
+
+	anchors.AnchorReOrged(reOrgedHash)
+	require.True(t, anchors.IsUnconfirmed(reOrgedHash))
+	require.False(t, anchors.IsUnconfirmed(otherHash))
+
+	markedProofs := anchors.markProofs(proofs)
+	require.Len(t, markedProofs, len(proofs))
+	require.True(t, markedProofs[0].Unconfirmed)
+	require.False(t, markedProofs[1].Unconfirmed)
+	require.Nil(t, markedProofs[2])
+
+	// The original proof must not be modified, as it might be cached.
+	require.False(t, proofs[0].Unconfirmed)
+
+	anchors.AnchorReConfirmed(reOrgedHash)
+	require.False(t, anchors.IsUnconfirmed(reOrgedHash))
+	require.False(t, anchors.markProofs(proofs)[0].Unconfirmed)
+}
@@ -1,10 +1,14 @@
 package universe
 
 import (
+	"encoding/hex"
 	"testing"
 
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
 	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightningnetwork/lnd/tor"
 	"github.com/stretchr/testify/require"
 )
 
@@ -107,3 +111,413 @@ func TestValidateProofUniverseType(t *testing.T) {
 		})
 	}
 }
+
+// TestNewUniIDFromRawArgsStrict tests that the strict universe ID constructor
+// rejects malformed raw arguments instead of masking them.
+func TestNewUniIDFromRawArgsStrict(t *testing.T) {
+	t.Parallel()
+
+	assetID := asset.RandID(t)
+	groupKey := test.RandPubKey(t)
+	groupKeyBytes := schnorr.SerializePubKey(groupKey)
+
+	testCases := []struct {
+		name          string
+		assetIDBytes  []byte
+		groupKeyBytes []byte
+		proofType     string
+		expectErr     string
+	}{
+		{
+			name:         "asset ID",
+			assetIDBytes: assetID[:],
+			proofType:    "issuance",
+		},
+		{
+			name:          "group key with asset ID",
+			assetIDBytes:  assetID[:],
+			groupKeyBytes: groupKeyBytes,
+			proofType:     "transfer",
+		},
+		{
+			name:          "group key only",
+			groupKeyBytes: groupKeyBytes,
+			proofType:     "transfer",
+		},
+		{
+			name:         "unspecified proof type",
+			assetIDBytes: assetID[:],
+			proofType:    "unspecified",
+			expectErr:    "proof type unspecified",
+		},
+		{
+			name:         "unknown proof type",
+			assetIDBytes: assetID[:],
+			proofType:    "foo",
+			expectErr:    "unknown proof type",
+		},
+		{
+			name:          "truncated asset ID with group key",
+			assetIDBytes:  assetID[:16],
+			groupKeyBytes: groupKeyBytes,
+			proofType:     "issuance",
+			expectErr:     "invalid asset ID length",
+		},
+		{
+			name:      "nothing set",
+			proofType: "issuance",
+			expectErr: "both nil",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(tt *testing.T) {
+			id, err := NewUniIDFromRawArgsStrict(
+				tc.assetIDBytes, tc.groupKeyBytes, tc.proofType,
+			)
+			if tc.expectErr != "" {
+				require.ErrorContains(tt, err, tc.expectErr)
+				return
+			}
+			require.NoError(tt, err)
+
+			// The strict constructor must create the same ID as the
+			// non-strict one for consistent arguments.
+			expectedID, err := NewUniIDFromRawArgs(
+				tc.assetIDBytes, tc.groupKeyBytes, tc.proofType,
+			)
+			require.NoError(tt, err)
+			require.Equal(tt, expectedID.String(), id.String())
+		})
+	}
+}
+
+// TestValidateUniIDLeaf tests that a universe ID that contradicts the asset
+// of its leaf is detected.
+func TestValidateUniIDLeaf(t *testing.T) {
+	t.Parallel()
+
+	ungrouped := randTransferredAsset(t)
+	ungrouped.GroupKey = nil
+
+	grouped := randTransferredAsset(t)
+	grouped.GroupKey = &asset.GroupKey{
+		GroupPubKey: *test.RandPubKey(t),
+	}
+
+	groupKey := &grouped.GroupKey.GroupPubKey
+	otherKey := test.RandPubKey(t)
+
+	testCases := []struct {
+		name      string
+		asset     asset.Asset
+		uniID     Identifier
+		expectErr string
+	}{
+		{
+			name:  "matching asset ID",
+			asset: ungrouped,
+			uniID: Identifier{
+				AssetID:   ungrouped.ID(),
+				ProofType: ProofTypeTransfer,
+			},
+		},
+		{
+			name:  "matching group key",
+			asset: grouped,
+			uniID: Identifier{
+				GroupKey:  groupKey,
+				ProofType: ProofTypeTransfer,
+			},
+		},
+		{
+			name:  "proof type mismatch",
+			asset: ungrouped,
+			uniID: Identifier{
+				AssetID:   ungrouped.ID(),
+				ProofType: ProofTypeIssuance,
+			},
+			expectErr: "proof type mismatch",
+		},
+		{
+			name:  "asset ID mismatch",
+			asset: ungrouped,
+			uniID: Identifier{
+				AssetID:   grouped.ID(),
+				ProofType: ProofTypeTransfer,
+			},
+			expectErr: "doesn't match universe",
+		},
+		{
+			name:  "ungrouped asset in group universe",
+			asset: ungrouped,
+			uniID: Identifier{
+				GroupKey:  groupKey,
+				ProofType: ProofTypeTransfer,
+			},
+			expectErr: "isn't grouped",
+		},
+		{
+			name:  "group key mismatch",
+			asset: grouped,
+			uniID: Identifier{
+				GroupKey:  otherKey,
+				ProofType: ProofTypeTransfer,
+			},
+			expectErr: "isn't part of group universe",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(tt *testing.T) {
+			err := ValidateUniIDLeaf(tc.uniID, &tc.asset)
+			if tc.expectErr == "" {
+				require.NoError(tt, err)
+				return
+			}
+
+			require.ErrorIs(tt, err, ErrInconsistentUniID)
+			require.ErrorContains(tt, err, tc.expectErr)
+		})
+	}
+}
+
+// TestServerAddrOnion tests that onion universe server addresses are parsed
+// without name resolution and that the default port is applied.
+func TestServerAddrOnion(t *testing.T) {
+	t.Parallel()
+
+	const onionHost = "vww6ybal4bd7szmgncyruucpgfkqahzddi37ktceo3ah7ngmc" +
+		"opnpyyd.onion"
+
+	testCases := []struct {
+		name         string
+		addr         string
+		isOnion      bool
+		expectedHost string
+	}{{
+		name:         "onion without port",
+		addr:         onionHost,
+		isOnion:      true,
+		expectedHost: onionHost + ":10029",
+	}, {
+		name:         "onion with port",
+		addr:         onionHost + ":8443",
+		isOnion:      true,
+		expectedHost: onionHost + ":8443",
+	}, {
+		name:         "clearnet with port",
+		addr:         "127.0.0.1:8443",
+		expectedHost: "127.0.0.1:8443",
+	}, {
+		name:         "invalid onion",
+		addr:         "not-an-onion.onion:10029",
+		expectedHost: "not-an-onion.onion:10029",
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			serverAddr := NewServerAddrFromStr(tc.addr)
+			require.Equal(t, tc.isOnion, serverAddr.IsOnion())
+
+			hostPort, err := serverAddr.HostPort()
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedHost, hostPort)
+
+			if !tc.isOnion {
+				return
+			}
+
+			addr, err := serverAddr.Addr()
+			require.NoError(t, err)
+			require.IsType(t, &tor.OnionAddr{}, addr)
+			require.Equal(t, tc.expectedHost, addr.String())
+		})
+	}
+}
+
+// TestFedAssetFilter tests that the federation asset filter correctly allows
+// and denies universes by asset ID and group key, and that it takes precedence
+// over the other sync configs.
+func TestFedAssetFilter(t *testing.T) {
+	t.Parallel()
+
+	var (
+		allowedID  = asset.RandID(t)
+		deniedID   = asset.RandID(t)
+		otherID    = asset.RandID(t)
+		allowedKey = test.RandPubKey(t)
+		deniedKey  = test.RandPubKey(t)
+	)
+
+	// Group keys can be given in the compressed or Schnorr format.
+	filter, err := NewFedAssetFilter(
+		[]string{hex.EncodeToString(allowedID[:])},
+		[]string{hex.EncodeToString(
+			allowedKey.SerializeCompressed(),
+		)},
+		[]string{hex.EncodeToString(deniedID[:])},
+		[]string{hex.EncodeToString(
+			schnorr.SerializePubKey(deniedKey),
+		)},
+	)
+	require.NoError(t, err)
+
+	// Group universes are identified by the x-only group key.
+	xOnly := func(key []byte) Identifier {
+		groupKey, err := schnorr.ParsePubKey(key[1:])
+		require.NoError(t, err)
+
+		return Identifier{GroupKey: groupKey}
+	}
+
+	require.True(t, filter.IsAllowed(Identifier{AssetID: allowedID}))
+	require.False(t, filter.IsAllowed(Identifier{AssetID: deniedID}))
+	require.False(t, filter.IsAllowed(Identifier{AssetID: otherID}))
+	require.True(t, filter.IsAllowed(
+		xOnly(allowedKey.SerializeCompressed()),
+	))
+	require.False(t, filter.IsAllowed(
+		xOnly(deniedKey.SerializeCompressed()),
+	))
+
+	// Deny lists take precedence over allow lists.
+	filter.AllowAssetIDs = append(filter.AllowAssetIDs, deniedID)
+	require.False(t, filter.IsAllowed(Identifier{AssetID: deniedID}))
+
+	// Without allow lists, everything that isn't denied is allowed.
+	denyOnly := &FedAssetFilter{DenyAssetIDs: []asset.ID{deniedID}}
+	require.True(t, denyOnly.IsAllowed(Identifier{AssetID: otherID}))
+	require.False(t, denyOnly.IsAllowed(Identifier{AssetID: deniedID}))
+
+	// A nil filter allows everything.
+	var noFilter *FedAssetFilter
+	require.True(t, noFilter.IsAllowed(Identifier{AssetID: deniedID}))
+
+	noFilter, err = NewFedAssetFilter(nil, nil, nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, noFilter)
+
+	_, err = NewFedAssetFilter([]string{"abcd"}, nil, nil, nil)
+	require.ErrorContains(t, err, "invalid asset ID")
+	_, err = NewFedAssetFilter(nil, nil, nil, []string{"xyz"})
+	require.ErrorContains(t, err, "invalid group key")
+
+	// The filter overrides both the global and universe specific sync
+	// configs.
+	deniedUni := Identifier{
+		AssetID:   deniedID,
+		ProofType: ProofTypeIssuance,
+	}
+	syncConfigs := SyncConfigs{
+		GlobalSyncConfigs: []*FedGlobalSyncConfig{{
+			ProofType:       ProofTypeIssuance,
+			AllowSyncInsert: true,
+			AllowSyncExport: true,
+		}},
+		UniSyncConfigs: []*FedUniSyncConfig{{
+			UniverseID:      deniedUni,
+			AllowSyncInsert: true,
+			AllowSyncExport: true,
+		}},
+		AssetFilter: denyOnly,
+	}
+	require.False(t, syncConfigs.IsSyncInsertEnabled(deniedUni))
+	require.False(t, syncConfigs.IsSyncExportEnabled(deniedUni))
+	require.True(t, syncConfigs.IsSyncInsertEnabled(Identifier{
+		AssetID:   otherID,
+		ProofType: ProofTypeIssuance,
+	}))
+}
+
+// TestWatchRules tests that issuance watch rules are parsed correctly and that
+// only new issuance leaves that match a rule result in an alert.
+func TestWatchRules(t *testing.T) {
+	t.Parallel()
+
+	newLeaf := func(modify func(leaf *Leaf)) *Leaf {
+		leaf := &Leaf{
+			GenesisWithGroup: GenesisWithGroup{
+				Genesis: asset.RandGenesis(t, asset.Normal),
+			},
+		}
+		leaf.Genesis.Tag = "other"
+		modify(leaf)
+
+		return leaf
+	}
+
+	var (
+		watchedKey = test.RandPubKey(t)
+		source     = NewServerAddrFromStr("universe.example.com:10029")
+		unwatched  = newLeaf(func(*Leaf) {})
+		byAssetID  = newLeaf(func(*Leaf) {})
+		byTag      = newLeaf(func(leaf *Leaf) {
+			leaf.Genesis.Tag = "stable-coin"
+		})
+		byGroup = newLeaf(func(leaf *Leaf) {
+			leaf.GroupKey = &asset.GroupKey{
+				GroupPubKey: *watchedKey,
+			}
+		})
+		watchedID = byAssetID.Genesis.ID()
+	)
+
+	// Group keys can be given in the compressed or Schnorr format.
+	rules := make([]*WatchRule, 0, 3)
+	for _, ruleStr := range []string{
+		"asset=" + hex.EncodeToString(watchedID[:]),
+		"group=" + hex.EncodeToString(watchedKey.SerializeCompressed()),
+		"tag=stable-*",
+	} {
+		rule, err := ParseWatchRule(ruleStr)
+		require.NoError(t, err)
+		rules = append(rules, rule)
+	}
+	require.Equal(
+		t, "group="+hex.EncodeToString(
+			schnorr.SerializePubKey(watchedKey),
+		), rules[1].String(),
+	)
+
+	for _, invalid := range []string{
+		"asset=abcd", "group=xyz", "tag=[", "tag=", "key=abcd",
+	} {
+		_, err := ParseWatchRule(invalid)
+		require.Error(t, err, invalid)
+	}
+
+	for _, rule := range rules {
+		require.False(t, rule.Matches(unwatched))
+	}
+	require.True(t, rules[0].Matches(byAssetID))
+	require.True(t, rules[1].Matches(byGroup))
+	require.True(t, rules[2].Matches(byTag))
+
+	// Only issuance leaves result in alerts.
+	diffs := []AssetSyncDiff{{
+		NewUniverseRoot: Root{
+			ID: Identifier{ProofType: ProofTypeIssuance},
+		},
+		NewLeafProofs: []*Leaf{unwatched, byTag, byGroup},
+	}, {
+		NewUniverseRoot: Root{
+			ID: Identifier{ProofType: ProofTypeTransfer},
+		},
+		NewLeafProofs: []*Leaf{byAssetID},
+	}}
+	alerts := matchWatchRules(rules, diffs, source)
+	require.Len(t, alerts, 2)
+	require.Equal(t, byTag, alerts[0].Leaf)
+	require.Equal(t, rules[2], alerts[0].Rule)
+	require.Equal(t, byGroup, alerts[1].Leaf)
+	require.Equal(t, rules[1], alerts[1].Rule)
+	require.Equal(t, source.HostStr(), alerts[1].Source.HostStr())
+}
@@ -0,0 +1,247 @@
+package universe
+
+import (
+	"encoding/hex"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+// WatchRule is a rule of the issuance watch-list. New issuance leaves that
+// match a rule and appear through federation sync result in an alert. This can
+// for example be used to detect unauthorized issuance into an asset group in
+// case the group key was compromised. Exactly one of the fields is set.
+type WatchRule struct {
+	// AssetID matches issuance leaves of the asset with this ID.
+	AssetID fn.Option[asset.ID]
+
+	// GroupKey matches issuance leaves of assets in the group with this
+	// key. Group keys are compared in their x-only form.
+	GroupKey fn.Option[[schnorr.PubKeyBytesLen]byte]
+
+	// TagPattern matches issuance leaves of assets whose tag matches this
+	// shell pattern, as supported by path.Match.
+	TagPattern fn.Option[string]
+}
+
+// ParseWatchRule parses a watch rule from its config representation, which is
+// one of asset=<asset ID>, group=<group key> or tag=<pattern>.
+func ParseWatchRule(s string) (*WatchRule, error) {
+	kind, value, ok := strings.Cut(strings.TrimSpace(s), "=")
+	if !ok || value == "" {
+		return nil, fmt.Errorf("invalid watch rule %q, expected "+
+			"asset=<id>, group=<key> or tag=<pattern>", s)
+	}
+
+	switch kind {
+	case "asset":
+		var assetID asset.ID
+		idBytes, err := hex.DecodeString(value)
+		if err != nil || len(idBytes) != len(assetID) {
+			return nil, fmt.Errorf("invalid watch rule asset ID: "+
+				"%v", value)
+		}
+		copy(assetID[:], idBytes)
+
+		return &WatchRule{
+			AssetID: fn.Some(assetID),
+		}, nil
+
+	case "group":
+		keyBytes, err := hex.DecodeString(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid watch rule group key: "+
+				"%w", err)
+		}
+
+		groupKey, err := parseGroupKey(keyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid watch rule group key: "+
+				"%w", err)
+		}
+
+		var xOnlyKey [schnorr.PubKeyBytesLen]byte
+		copy(xOnlyKey[:], schnorr.SerializePubKey(groupKey))
+
+		return &WatchRule{
+			GroupKey: fn.Some(xOnlyKey),
+		}, nil
+
+	case "tag":
+		// We make sure the pattern is well-formed, so we don't fail
+		// silently when matching.
+		if _, err := path.Match(value, ""); err != nil {
+			return nil, fmt.Errorf("invalid watch rule tag "+
+				"pattern %q: %w", value, err)
+		}
+
+		return &WatchRule{
+			TagPattern: fn.Some(value),
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown watch rule type %q", kind)
+	}
+}
+
+// String returns the config representation of the watch rule.
+func (w *WatchRule) String() string {
+	switch {
+	case w.AssetID.IsSome():
+		assetID := w.AssetID.UnwrapOr(asset.ID{})
+		return fmt.Sprintf("asset=%v", assetID.String())
+
+	case w.GroupKey.IsSome():
+		groupKey := w.GroupKey.UnwrapOr(
+			[schnorr.PubKeyBytesLen]byte{},
+		)
+		return fmt.Sprintf("group=%x", groupKey[:])
+
+	default:
+		return fmt.Sprintf("tag=%v", w.TagPattern.UnwrapOr(""))
+	}
+}
+
+// Matches returns true if the given issuance leaf matches the watch rule.
+func (w *WatchRule) Matches(leaf *Leaf) bool {
+	if leaf == nil {
+		return false
+	}
+
+	switch {
+	case w.AssetID.IsSome():
+		return w.AssetID.UnwrapOr(asset.ID{}) == leaf.Genesis.ID()
+
+	case w.GroupKey.IsSome():
+		if leaf.GroupKey == nil {
+			return false
+		}
+
+		leafKey := schnorr.SerializePubKey(&leaf.GroupKey.GroupPubKey)
+		groupKey := w.GroupKey.UnwrapOr(
+			[schnorr.PubKeyBytesLen]byte{},
+		)
+
+		return string(leafKey) == string(groupKey[:])
+
+	case w.TagPattern.IsSome():
+		match, _ := path.Match(
+			w.TagPattern.UnwrapOr(""), leaf.Genesis.Tag,
+		)
+		return match
+
+	default:
+		return false
+	}
+}
+
+// IssuanceAlert is the event that is published if a new issuance leaf that
+// matches a watch rule appeared through federation sync.
+type IssuanceAlert struct {
+	// Rule is the watch rule the leaf matched.
+	Rule *WatchRule
+
+	// ID is the identifier of the universe the leaf was added to.
+	ID Identifier
+
+	// Leaf is the new issuance leaf.
+	Leaf *Leaf
+
+	// Source is the federation member the leaf was synced from.
+	Source ServerAddr
+
+	// timestamp is the time the alert was created.
+	timestamp time.Time
+}
+
+// NewIssuanceAlert creates a new issuance alert for the given leaf.
+func NewIssuanceAlert(rule *WatchRule, id Identifier, leaf *Leaf,
+	source ServerAddr) *IssuanceAlert {
+
+	return &IssuanceAlert{
+		Rule:      rule,
+		ID:        id,
+		Leaf:      leaf,
+		Source:    source,
+		timestamp: time.Now().UTC(),
+	}
+}
+
+// Timestamp returns the time the alert was created.
+//
+// NOTE: This is part of the fn.Event interface.
+func (a *IssuanceAlert) Timestamp() time.Time {
+	return a.timestamp
+}
+
+// A compile-time assertion to ensure IssuanceAlert satisfies the fn.Event
+// interface.
+var _ fn.Event = (*IssuanceAlert)(nil)
+
+// matchWatchRules returns an alert for each new issuance leaf of the given sync
+// diffs that matches one of the watch rules. Each leaf results in at most one
+// alert, for the first rule it matches.
+func matchWatchRules(rules []*WatchRule, diffs []AssetSyncDiff,
+	source ServerAddr) []*IssuanceAlert {
+
+	var alerts []*IssuanceAlert
+	for _, diff := range diffs {
+		id := diff.NewUniverseRoot.ID
+		if id.ProofType != ProofTypeIssuance {
+			continue
+		}
+
+		for _, leaf := range diff.NewLeafProofs {
+			for _, rule := range rules {
+				if !rule.Matches(leaf) {
+					continue
+				}
+
+				alerts = append(alerts, NewIssuanceAlert(
+					rule, id, leaf, source,
+				))
+				break
+			}
+		}
+	}
+
+	return alerts
+}
+
+// RegisterSubscriber adds a new subscriber that is notified of issuance
+// watch-list alerts. As alerts aren't persisted, delivering existing alerts
+// isn't supported.
+//
+// NOTE: This is part of the fn.EventPublisher interface.
+func (f *FederationEnvoy) RegisterSubscriber(
+	receiver *fn.EventReceiver[fn.Event], deliverExisting bool,
+	_ time.Time) error {
+
+	if deliverExisting {
+		return fmt.Errorf("delivering existing issuance alerts is " +
+			"not supported")
+	}
+
+	f.alertDistributor.RegisterSubscriber(receiver)
+
+	return nil
+}
+
+// RemoveSubscriber removes the given subscriber of issuance watch-list alerts
+// and stops it from processing events.
+//
+// NOTE: This is part of the fn.EventPublisher interface.
+func (f *FederationEnvoy) RemoveSubscriber(
+	subscriber *fn.EventReceiver[fn.Event]) error {
+
+	return f.alertDistributor.RemoveSubscriber(subscriber)
+}
+
+// A compile-time assertion to ensure FederationEnvoy satisfies the
+// fn.EventPublisher interface.
+var _ fn.EventPublisher[fn.Event, time.Time] = (*FederationEnvoy)(nil)
@@ -0,0 +1,356 @@
+package taprootassets
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/perms"
+	"github.com/lightninglabs/taproot-assets/rpcperms"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"gopkg.in/macaroon.v2"
+)
+
+const (
+	// maxTrackedRateLimitClients is the number of clients the universe
+	// rate limiter tracks before it starts to evict idle clients.
+	maxTrackedRateLimitClients = 10_000
+
+	// rateLimitEvictInterval is the minimum time between two evictions of
+	// idle clients from the universe rate limiter.
+	rateLimitEvictInterval = time.Second
+
+	// universeMethodPrefix is the prefix of the full gRPC method names of
+	// the universe RPC methods.
+	universeMethodPrefix = "/universerpc.Universe/"
+
+	// assetRootsMethod is the name of the AssetRoots universe RPC method.
+	assetRootsMethod = "AssetRoots"
+
+	// queryAssetRootsMethod is the name of the QueryAssetRoots universe
+	// RPC method.
+	queryAssetRootsMethod = "QueryAssetRoots"
+
+	// assetLeafKeysMethod is the name of the AssetLeafKeys universe RPC
+	// method.
+	assetLeafKeysMethod = "AssetLeafKeys"
+
+	// assetLeavesMethod is the name of the AssetLeaves universe RPC
+	// method.
+	assetLeavesMethod = "AssetLeaves"
+
+	// queryProofMethod is the name of the QueryProof universe RPC method.
+	queryProofMethod = "QueryProof"
+
+	// insertProofMethod is the name of the InsertProof universe RPC
+	// method.
+	insertProofMethod = "InsertProof"
+
+	// queryAssetMetaMethod is the name of the QueryAssetMeta universe RPC
+	// method.
+	queryAssetMetaMethod = "QueryAssetMeta"
+)
+
+// DefaultUniverseMethodCosts is the default number of tokens a call to each
+// rate limited universe RPC method costs a client. Methods that aren't listed
+// cost a single token.
+var DefaultUniverseMethodCosts = map[string]int{
+	assetRootsMethod:      1,
+	queryAssetRootsMethod: 1,
+	assetLeafKeysMethod:   1,
+	queryProofMethod:      2,
+	queryAssetMetaMethod:  1,
+	assetLeavesMethod:     5,
+	insertProofMethod:     10,
+}
+
+// ParseUniverseMethodCosts parses a list of method costs in the format
+// <method>=<cost> and applies them on top of the default method costs.
+func ParseUniverseMethodCosts(costs []string) (map[string]int, error) {
+	methodCosts := make(map[string]int, len(DefaultUniverseMethodCosts))
+	for method, cost := range DefaultUniverseMethodCosts {
+		methodCosts[method] = cost
+	}
+
+	for _, methodCost := range costs {
+		method, costStr, ok := strings.Cut(methodCost, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid method cost %q, "+
+				"expected format <method>=<cost>", methodCost)
+		}
+
+		if _, ok := DefaultUniverseMethodCosts[method]; !ok {
+			return nil, fmt.Errorf("unknown rate limited universe "+
+				"method %q", method)
+		}
+
+		cost, err := strconv.Atoi(costStr)
+		if err != nil || cost < 1 {
+			return nil, fmt.Errorf("invalid cost %q for method "+
+				"%v, must be a positive integer", costStr,
+				method)
+		}
+
+		methodCosts[method] = cost
+	}
+
+	return methodCosts, nil
+}
+
+// universeRateLimiter limits the rate of universe RPC calls. All calls are
+// subject to a global limit, and each client is additionally subject to its
+// own token bucket from which each call consumes a method specific number of
+// tokens. Clients are identified by their macaroon, or by their IP address if
+// they don't supply one.
+type universeRateLimiter struct {
+	// global is the limiter shared by all clients.
+	global *rate.Limiter
+
+	// clientLimit is the rate at which the token bucket of each client is
+	// refilled. If this is zero, clients aren't limited individually.
+	//
+	// NOTE: The client limits and method costs can be changed at runtime,
+	// so they must only be accessed while holding the mutex.
+	clientLimit rate.Limit
+
+	// clientBurst is the size of the token bucket of each client.
+	clientBurst int
+
+	// methodCosts is the number of tokens a call to each method costs.
+	methodCosts map[string]int
+
+	// clients is the token bucket of each client, keyed by the client
+	// identity.
+	clients map[string]*rate.Limiter
+
+	// lastEviction is the time idle clients were last evicted.
+	lastEviction time.Time
+
+	// interceptorChain is used to validate the macaroons clients are
+	// identified by.
+	interceptorChain *rpcperms.InterceptorChain
+
+	mtx sync.Mutex
+}
+
+// newUniverseRateLimiter creates a new universe rate limiter from the given
+// config.
+func newUniverseRateLimiter(cfg *Config,
+	interceptorChain *rpcperms.InterceptorChain) *universeRateLimiter {
+	methodCosts := cfg.UniverseMethodCosts
+	if methodCosts == nil {
+		methodCosts = DefaultUniverseMethodCosts
+	}
+
+	return &universeRateLimiter{
+		global: rate.NewLimiter(
+			cfg.UniverseQueriesPerSecond, cfg.UniverseQueriesBurst,
+		),
+		clientLimit: cfg.UniverseClientQPS,
+		clientBurst: cfg.UniverseClientBurst,
+		methodCosts: methodCosts,
+		clients:     make(map[string]*rate.Limiter),
+
+		interceptorChain: interceptorChain,
+	}
+}
+
+// updateLimits applies new limits to the rate limiter. The token buckets of
+// all clients are reset, as their size might have changed.
+func (u *universeRateLimiter) updateLimits(globalLimit rate.Limit,
+	globalBurst int, clientLimit rate.Limit, clientBurst int,
+	methodCosts map[string]int) {
+
+	if methodCosts == nil {
+		methodCosts = DefaultUniverseMethodCosts
+	}
+
+	u.global.SetLimit(globalLimit)
+	u.global.SetBurst(globalBurst)
+
+	u.mtx.Lock()
+	defer u.mtx.Unlock()
+
+	u.clientLimit = clientLimit
+	u.clientBurst = clientBurst
+	u.methodCosts = methodCosts
+	u.clients = make(map[string]*rate.Limiter)
+}
+
+// clientsLimited returns true if each client is subject to its own limit.
+func (u *universeRateLimiter) clientsLimited() bool {
+	u.mtx.Lock()
+	defer u.mtx.Unlock()
+
+	return u.clientLimit > 0 && u.clientBurst > 0
+}
+
+// methodCost returns the number of tokens a call to the given method costs.
+// The cost is capped at the client burst, as the call could otherwise never
+// be made.
+//
+// NOTE: The mutex must be held when calling this method.
+func (u *universeRateLimiter) methodCost(method string) int {
+	cost, ok := u.methodCosts[method]
+	if !ok || cost < 1 {
+		cost = 1
+	}
+
+	return min(cost, u.clientBurst)
+}
+
+// limits returns the global rate limit and burst.
+func (u *universeRateLimiter) limits() (rate.Limit, int) {
+	return u.global.Limit(), u.global.Burst()
+}
+
+// Wait checks the quota of the calling client for the given method and then
+// waits until the global limit permits the call. If the client exceeded its
+// quota, a ResourceExhausted error is returned right away.
+func (u *universeRateLimiter) Wait(ctx context.Context, method string) error {
+	if u.clientsLimited() {
+		client := u.clientID(ctx, method)
+		if !u.allowClient(client, method, time.Now()) {
+			rpcsLog.Debugf("Rate limiting universe client %v "+
+				"(method=%v)", client, method)
+
+			return status.Errorf(codes.ResourceExhausted, "rate "+
+				"limit exceeded for %v, try again later",
+				method)
+		}
+	}
+
+	return u.global.Wait(ctx)
+}
+
+// allowClient consumes the tokens a call to the given method costs from the
+// bucket of the given client, returning false if there aren't enough tokens
+// left.
+func (u *universeRateLimiter) allowClient(client, method string,
+	now time.Time) bool {
+
+	u.mtx.Lock()
+	defer u.mtx.Unlock()
+
+	cost := u.methodCost(method)
+
+	limiter, ok := u.clients[client]
+	if !ok {
+		u.maybeEvictClients(now)
+
+		limiter = rate.NewLimiter(u.clientLimit, u.clientBurst)
+		u.clients[client] = limiter
+	}
+
+	return limiter.AllowN(now, cost)
+}
+
+// maybeEvictClients removes the buckets of all clients that are full again
+// once too many clients are tracked. Removing a full bucket is lossless, as
+// a new bucket starts out full as well.
+//
+// NOTE: The mutex must be held when calling this method.
+func (u *universeRateLimiter) maybeEvictClients(now time.Time) {
+	if len(u.clients) < maxTrackedRateLimitClients ||
+		now.Sub(u.lastEviction) < rateLimitEvictInterval {
+
+		return
+	}
+
+	u.lastEviction = now
+	for client, limiter := range u.clients {
+		if limiter.TokensAt(now) >= float64(u.clientBurst) {
+			delete(u.clients, client)
+		}
+	}
+}
+
+// clientID returns the identity the calling client is tracked under. Clients
+// that supply a macaroon which is valid for the called method are identified by
+// its ID, all others by their IP address. As most universe methods don't
+// require a macaroon, we need to validate it ourselves to prevent clients from
+// evading their quota with made up macaroons.
+func (u *universeRateLimiter) clientID(ctx context.Context,
+	method string) string {
+
+	macID, ok := u.macaroonID(ctx, universeMethodPrefix+method)
+	if ok {
+		return macaroonClientID(macID)
+	}
+
+	return peerClientID(ctx)
+}
+
+// macaroonClientID returns the identity of a client that is identified by the
+// macaroon with the given ID.
+func macaroonClientID(macID []byte) string {
+	hash := sha256.Sum256(macID)
+	return fmt.Sprintf("macaroon:%x", hash[:8])
+}
+
+// peerClientID returns the identity of a client that is identified by its IP
+// address.
+func peerClientID(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "unknown"
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return "ip:" + p.Addr.String()
+	}
+
+	return "ip:" + host
+}
+
+// macaroonID returns the ID of the macaroon the call was made with, if the
+// macaroon is valid for the method with the given full name.
+func (u *universeRateLimiter) macaroonID(ctx context.Context,
+	fullMethod string) ([]byte, bool) {
+
+	if u.interceptorChain == nil {
+		return nil, false
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get("macaroon")) == 0 {
+		return nil, false
+	}
+
+	svc := u.interceptorChain.MacaroonService()
+	if svc == nil {
+		return nil, false
+	}
+
+	macBytes, err := hex.DecodeString(md.Get("macaroon")[0])
+	if err != nil {
+		return nil, false
+	}
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(macBytes); err != nil {
+		return nil, false
+	}
+
+	requiredPerms, ok := perms.RequiredPermissions[fullMethod]
+	if !ok {
+		return nil, false
+	}
+
+	err = svc.ValidateMacaroon(ctx, requiredPerms, fullMethod)
+	if err != nil {
+		return nil, false
+	}
+
+	return mac.Id(), true
+}
@@ -0,0 +1,197 @@
+package taprootassets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/lndclient"
+	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/keychain"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// responseSigField is the name of the proto field that carries the
+	// signature of the universe server over a query response.
+	responseSigField = "signature"
+)
+
+var (
+	// ErrResponseSigMissing is returned if a universe query response that
+	// is expected to be signed doesn't carry a signature.
+	ErrResponseSigMissing = errors.New("universe response signature " +
+		"missing")
+)
+
+// LndRpcResponseSigner is an implementation of the universe.ResponseSigner
+// interface that signs with the identity key of an active lnd node.
+type LndRpcResponseSigner struct {
+	lnd *lndclient.LndServices
+}
+
+// NewLndRpcResponseSigner returns a new response signer instance backed by the
+// passed connection to a remote lnd node.
+func NewLndRpcResponseSigner(
+	lnd *lndclient.LndServices) *LndRpcResponseSigner {
+
+	return &LndRpcResponseSigner{
+		lnd: lnd,
+	}
+}
+
+// SignResponse creates a Schnorr signature over the SHA256 hash of the given
+// message with the node's identity key.
+//
+// NOTE: This is part of the universe.ResponseSigner interface.
+func (l *LndRpcResponseSigner) SignResponse(ctx context.Context,
+	msg []byte) (*schnorr.Signature, error) {
+
+	sig, err := l.lnd.Signer.SignMessage(
+		ctx, msg, keychain.KeyLocator{
+			Family: keychain.KeyFamilyNodeKey,
+		}, lndclient.SignSchnorr(nil),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return schnorr.ParseSignature(sig)
+}
+
+// marshalResponseSig encodes the given response signature as its RPC
+// counterpart.
+func marshalResponseSig(sig *universe.ResponseSig) *unirpc.ResponseSignature {
+	return &unirpc.ResponseSignature{
+		Sig:       sig.Sig.Serialize(),
+		Timestamp: sig.Timestamp.Unix(),
+	}
+}
+
+// unmarshalResponseSig decodes a response signature from its RPC counterpart.
+func unmarshalResponseSig(
+	rpcSig *unirpc.ResponseSignature) (*universe.ResponseSig, error) {
+
+	if rpcSig == nil || len(rpcSig.Sig) == 0 {
+		return nil, ErrResponseSigMissing
+	}
+
+	sig, err := schnorr.ParseSignature(rpcSig.Sig)
+	if err != nil {
+		return nil, fmt.Errorf("invalid response signature: %w", err)
+	}
+
+	return &universe.ResponseSig{
+		Timestamp: time.Unix(rpcSig.Timestamp, 0).UTC(),
+		Sig:       sig,
+	}, nil
+}
+
+// responseSigFieldDesc returns the descriptor of the signature field of the
+// given universe query response.
+func responseSigFieldDesc(
+	resp proto.Message) (protoreflect.FieldDescriptor, error) {
+
+	sigMsgName := (&unirpc.ResponseSignature{}).ProtoReflect().
+		Descriptor().FullName()
+
+	fields := resp.ProtoReflect().Descriptor().Fields()
+	fd := fields.ByName(responseSigField)
+	if fd == nil || fd.Message() == nil ||
+		fd.Message().FullName() != sigMsgName {
+
+		return nil, fmt.Errorf("response %T has no signature field",
+			resp)
+	}
+
+	return fd, nil
+}
+
+// marshalSignedResponse serializes a query response in the deterministic form
+// that is covered by the response signature, which is the response with its
+// signature field unset.
+func marshalSignedResponse(resp proto.Message) ([]byte, error) {
+	fd, err := responseSigFieldDesc(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	unsigned := proto.Clone(resp)
+	unsigned.ProtoReflect().Clear(fd)
+
+	return proto.MarshalOptions{Deterministic: true}.Marshal(unsigned)
+}
+
+// VerifyUniverseResponse verifies that the given response of the given
+// universe query method (for example "/universerpc.Universe/QueryProof") was
+// signed by the universe server with the given identity key. The signature is
+// taken from the signature field of the response. Signatures older than the
+// given maximum age are rejected.
+func VerifyUniverseResponse(pubKey *btcec.PublicKey, method string,
+	resp proto.Message, maxAge time.Duration) error {
+
+	fd, err := responseSigFieldDesc(resp)
+	if err != nil {
+		return err
+	}
+
+	var rpcSig *unirpc.ResponseSignature
+	if resp.ProtoReflect().Has(fd) {
+		msg := resp.ProtoReflect().Get(fd).Message().Interface()
+		rpcSig, _ = msg.(*unirpc.ResponseSignature)
+	}
+
+	sig, err := unmarshalResponseSig(rpcSig)
+	if err != nil {
+		return err
+	}
+
+	respBytes, err := marshalSignedResponse(resp)
+	if err != nil {
+		return fmt.Errorf("unable to serialize response: %w", err)
+	}
+
+	return sig.Verify(pubKey, method, respBytes, time.Now(), maxAge)
+}
+
+// signUniverseResponse signs the given universe query response and sets the
+// signature field of the response, if response signing is enabled. Clients
+// that don't verify response signatures will just ignore the field.
+func (r *rpcServer) signUniverseResponse(ctx context.Context, method string,
+	resp proto.Message) {
+
+	signer := r.cfg.UniverseResponseSigner
+	if signer == nil {
+		return
+	}
+
+	fd, err := responseSigFieldDesc(resp)
+	if err != nil {
+		rpcsLog.Warnf("Unable to sign universe response: %v", err)
+		return
+	}
+
+	respBytes, err := marshalSignedResponse(resp)
+	if err != nil {
+		rpcsLog.Warnf("Unable to serialize universe response: %v", err)
+		return
+	}
+
+	sig, err := universe.NewResponseSig(
+		ctx, signer, method, time.Now(), respBytes,
+	)
+	if err != nil {
+		rpcsLog.Warnf("Unable to sign universe response: %v", err)
+		return
+	}
+
+	rpcSig := marshalResponseSig(sig)
+	resp.ProtoReflect().Set(
+		fd, protoreflect.ValueOfMessage(rpcSig.ProtoReflect()),
+	)
+}
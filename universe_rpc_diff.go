@@ -5,13 +5,32 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/mssmt"
 	"github.com/lightninglabs/taproot-assets/taprpc/universerpc"
 	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
 	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/tor"
 	"golang.org/x/exp/maps"
+	"google.golang.org/grpc"
 )
 
+// marshalHeightBound converts an optional block height bound of a leaf keys
+// query into its RPC form, where zero means no bound.
+func marshalHeightBound(height fn.Option[uint32]) uint32 {
+	return height.UnwrapOr(0)
+}
+
+// unmarshalHeightBound converts the RPC form of a block height bound of a
+// leaf keys query, where zero means no bound, into an optional height.
+func unmarshalHeightBound(height uint32) fn.Option[uint32] {
+	if height == 0 {
+		return fn.None[uint32]()
+	}
+
+	return fn.Some(height)
+}
+
 // RpcUniverseDiff is an implementation of the universe.DiffEngine interface
 // that uses an RPC connection to target Universe.
 type RpcUniverseDiff struct {
@@ -19,11 +38,13 @@ type RpcUniverseDiff struct {
 }
 
 // NewRpcUniverseDiff creates a new RpcUniverseDiff instance that dials out to
-// the target remote universe server address.
-func NewRpcUniverseDiff(
-	serverAddr universe.ServerAddr) (universe.DiffEngine, error) {
+// the target remote universe server address. If dialNet is set, the connection
+// is established through it, for example through a Tor proxy. Any additional
+// dial options are applied to the connection.
+func NewRpcUniverseDiff(serverAddr universe.ServerAddr, dialNet tor.Net,
+	opts ...grpc.DialOption) (universe.DiffEngine, error) {
 
-	conn, err := ConnectUniverse(serverAddr)
+	conn, err := ConnectUniverse(serverAddr, dialNet, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("unable to connect to universe RPC "+
 			"server: %w", err)
@@ -34,6 +55,93 @@ func NewRpcUniverseDiff(
 	}, nil
 }
 
+// NewRpcUniverseDiffFromClient creates a new RpcUniverseDiff instance that
+// uses an existing universe RPC client, for example to inspect the universe of
+// a local tapd instance. The connection of the client is owned by the caller,
+// so it isn't closed when the diff engine is closed.
+func NewRpcUniverseDiffFromClient(
+	client unirpc.UniverseClient) universe.DiffEngine {
+
+	return &RpcUniverseDiff{
+		conn: &universeClientConn{
+			UniverseClient: client,
+		},
+	}
+}
+
+// marshalSyncProtocolInfo adds the given sync protocol capabilities to the
+// given Info response.
+func marshalSyncProtocolInfo(info universe.SyncProtocolInfo,
+	resp *unirpc.InfoResponse) error {
+
+	resp.SyncProtocolVersion = uint32(info.Version)
+	resp.MaxPageSize = info.MaxPageSize
+	resp.SyncFeatures = uint64(info.Features)
+	resp.Network = info.Network
+	resp.ServerVersion = info.ServerVersion
+	resp.QueriesPerSecond = info.QueriesPerSecond
+	resp.QueriesBurst = int32(info.QueriesBurst)
+
+	resp.ProofTypes = make([]unirpc.ProofType, 0, len(info.ProofTypes))
+	for _, proofType := range info.ProofTypes {
+		rpcProofType, err := MarshalUniProofType(proofType)
+		if err != nil {
+			return err
+		}
+
+		resp.ProofTypes = append(resp.ProofTypes, rpcProofType)
+	}
+
+	return nil
+}
+
+// unmarshalSyncProtocolInfo decodes the sync protocol capabilities from the
+// given Info response. If the response doesn't contain a version, the remote
+// server is a legacy server that doesn't take part in the version handshake.
+func unmarshalSyncProtocolInfo(
+	resp *unirpc.InfoResponse) (universe.SyncProtocolInfo, error) {
+
+	info := universe.LegacySyncProtocolInfo()
+
+	// The network is advertised independently of the protocol version, so
+	// we can detect a network mismatch with any peer that sends it.
+	info.Network = resp.Network
+	info.ServerVersion = resp.ServerVersion
+
+	if resp.SyncProtocolVersion == 0 {
+		return info, nil
+	}
+	info.Version = universe.SyncProtocolVersion(resp.SyncProtocolVersion)
+	info.Features = universe.SyncFeature(resp.SyncFeatures)
+
+	switch {
+	case resp.MaxPageSize < 0:
+		return info, fmt.Errorf("invalid max page size: %d",
+			resp.MaxPageSize)
+
+	case resp.MaxPageSize > 0:
+		info.MaxPageSize = resp.MaxPageSize
+	}
+
+	for _, rpcProofType := range resp.ProofTypes {
+		proofType, err := UnmarshalUniProofType(rpcProofType)
+		if err != nil {
+			return info, err
+		}
+
+		info.ProofTypes = append(info.ProofTypes, proofType)
+	}
+
+	if resp.QueriesPerSecond < 0 || resp.QueriesBurst < 0 {
+		return info, fmt.Errorf("invalid rate limit: qps=%v, burst=%d",
+			resp.QueriesPerSecond, resp.QueriesBurst)
+	}
+	info.QueriesPerSecond = resp.QueriesPerSecond
+	info.QueriesBurst = int(resp.QueriesBurst)
+
+	return info, nil
+}
+
 func unmarshalMerkleSumNode(root *unirpc.MerkleSumNode) mssmt.Node {
 	var nodeHash mssmt.NodeHash
 	copy(nodeHash[:], root.RootHash)
@@ -74,6 +182,21 @@ func unmarshalUniverseRoots(
 	return uniRoots, nil
 }
 
+// SyncProtocolInfo returns the sync protocol capabilities of the remote
+// universe server, as advertised in its Info response.
+//
+// NOTE: This is part of the universe.SyncProtocolNegotiator interface.
+func (r *RpcUniverseDiff) SyncProtocolInfo(
+	ctx context.Context) (universe.SyncProtocolInfo, error) {
+
+	info, err := r.conn.Info(ctx, &unirpc.InfoRequest{})
+	if err != nil {
+		return universe.SyncProtocolInfo{}, err
+	}
+
+	return unmarshalSyncProtocolInfo(info)
+}
+
 // RootNodes returns the complete set of known root nodes for the set
 // of assets tracked in the universe.
 func (r *RpcUniverseDiff) RootNodes(ctx context.Context,
@@ -135,6 +258,12 @@ func (r *RpcUniverseDiff) UniverseLeafKeys(ctx context.Context,
 			Direction: unirpc.SortDirection(q.SortDirection),
 			Offset:    q.Offset,
 			Limit:     q.Limit,
+			MinBlockHeight: marshalHeightBound(
+				q.MinBlockHeight,
+			),
+			MaxBlockHeight: marshalHeightBound(
+				q.MaxBlockHeight,
+			),
 		},
 	)
 	if err != nil {
@@ -212,6 +341,12 @@ func (r *RpcUniverseDiff) FetchProofLeaf(ctx context.Context,
 
 // Close closes the underlying RPC connection to the remote universe server.
 func (r *RpcUniverseDiff) Close() error {
+	// If the diff engine was created from an existing client, there's no
+	// connection for us to close.
+	if r.conn.ClientConn == nil {
+		return nil
+	}
+
 	if err := r.conn.Close(); err != nil {
 		tapdLog.Warnf("unable to close universe RPC "+
 			"connection: %v", err)
@@ -224,3 +359,7 @@ func (r *RpcUniverseDiff) Close() error {
 // A compile time interface to ensure that RpcUniverseDiff implements the
 // universe.DiffEngine interface.
 var _ universe.DiffEngine = (*RpcUniverseDiff)(nil)
+
+// A compile time interface to ensure that RpcUniverseDiff implements the
+// universe.SyncProtocolNegotiator interface.
+var _ universe.SyncProtocolNegotiator = (*RpcUniverseDiff)(nil)
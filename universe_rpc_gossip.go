@@ -0,0 +1,145 @@
+package taprootassets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/tor"
+	"google.golang.org/grpc"
+)
+
+// marshalServerList encodes the given federation server list as its RPC
+// counterpart.
+func marshalServerList(
+	list *universe.ServerList) *unirpc.GossipServerListResponse {
+
+	servers := make([]*unirpc.GossipServer, 0, len(list.Servers))
+	for _, server := range list.Servers {
+		servers = append(servers, &unirpc.GossipServer{
+			Host:     server.Addr.HostStr(),
+			LastSeen: server.LastSeen.Unix(),
+		})
+	}
+
+	return &unirpc.GossipServerListResponse{
+		Origin:    list.Origin.HostStr(),
+		Servers:   servers,
+		Timestamp: list.Timestamp.Unix(),
+		Sig:       list.Sig.Serialize(),
+	}
+}
+
+// unmarshalServerList decodes a federation server list from its RPC
+// counterpart. The signature of the list is not verified.
+func unmarshalServerList(
+	resp *unirpc.GossipServerListResponse) (*universe.ServerList, error) {
+
+	if len(resp.Servers) > universe.MaxGossipServers {
+		return nil, fmt.Errorf("too many servers in list: %d",
+			len(resp.Servers))
+	}
+
+	sig, err := schnorr.ParseSignature(resp.Sig)
+	if err != nil {
+		return nil, fmt.Errorf("invalid server list signature: %w",
+			err)
+	}
+
+	list := &universe.ServerList{
+		Origin:    universe.NewServerAddrFromStr(resp.Origin),
+		Servers:   make([]universe.GossipServer, 0, len(resp.Servers)),
+		Timestamp: time.Unix(resp.Timestamp, 0).UTC(),
+		Sig:       sig,
+	}
+	for _, server := range resp.Servers {
+		list.Servers = append(list.Servers, universe.GossipServer{
+			Addr:     universe.NewServerAddrFromStr(server.Host),
+			LastSeen: time.Unix(server.LastSeen, 0).UTC(),
+		})
+	}
+
+	return list, nil
+}
+
+// RpcServerListFetcher is an implementation of the universe.ServerListFetcher
+// interface that uses an RPC connection to the target Universe.
+type RpcServerListFetcher struct {
+	conn *universeClientConn
+}
+
+// NewRpcServerListFetcher creates a new RpcServerListFetcher instance that
+// dials out to the target remote universe server address. If dialNet is set,
+// the connection is established through it, for example through a Tor proxy.
+// Any additional dial options are applied to the connection.
+func NewRpcServerListFetcher(serverAddr universe.ServerAddr,
+	dialNet tor.Net,
+	opts ...grpc.DialOption) (universe.ServerListFetcher, error) {
+
+	conn, err := ConnectUniverse(serverAddr, dialNet, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to universe RPC "+
+			"server: %w", err)
+	}
+
+	return &RpcServerListFetcher{
+		conn: conn,
+	}, nil
+}
+
+// FetchServerList returns the signed server list of the remote universe
+// server.
+//
+// NOTE: This is part of the universe.ServerListFetcher interface.
+func (r *RpcServerListFetcher) FetchServerList(
+	ctx context.Context) (*universe.ServerList, error) {
+
+	resp, err := r.conn.GossipServerList(
+		ctx, &unirpc.GossipServerListRequest{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalServerList(resp)
+}
+
+// Close closes the underlying RPC connection to the remote universe server.
+//
+// NOTE: This is part of the universe.ServerListFetcher interface.
+func (r *RpcServerListFetcher) Close() error {
+	if err := r.conn.Close(); err != nil {
+		tapdLog.Warnf("unable to close universe RPC "+
+			"connection: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// A compile time interface to ensure that RpcServerListFetcher implements the
+// universe.ServerListFetcher interface.
+var _ universe.ServerListFetcher = (*RpcServerListFetcher)(nil)
+
+// GossipServerList returns the list of federation servers the Universe server
+// recently synced with, signed with its identity key.
+func (r *rpcServer) GossipServerList(ctx context.Context,
+	_ *unirpc.GossipServerListRequest) (*unirpc.GossipServerListResponse,
+	error) {
+
+	list, err := r.cfg.UniverseFederation.LocalServerList(ctx)
+	switch {
+	case errors.Is(err, universe.ErrGossipDisabled):
+		return nil, err
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to create federation server "+
+			"list: %w", err)
+	}
+
+	return marshalServerList(list), nil
+}
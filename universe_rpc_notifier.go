@@ -0,0 +1,146 @@
+package taprootassets
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/tor"
+	"google.golang.org/grpc"
+)
+
+// RpcRootNotifier is an implementation of the universe.RootNotifier interface
+// that uses an RPC connection to the target Universe.
+type RpcRootNotifier struct {
+	conn *universeClientConn
+}
+
+// NewRpcRootNotifier creates a new RpcRootNotifier instance that dials out to
+// the target remote universe server address. If dialNet is set, the connection
+// is established through it, for example through a Tor proxy. Any additional
+// dial options are applied to the connection.
+func NewRpcRootNotifier(serverAddr universe.ServerAddr, dialNet tor.Net,
+	opts ...grpc.DialOption) (universe.RootNotifier, error) {
+
+	conn, err := ConnectUniverse(serverAddr, dialNet, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to universe RPC "+
+			"server: %w", err)
+	}
+
+	return &RpcRootNotifier{
+		conn: conn,
+	}, nil
+}
+
+// marshalRootNotification encodes the given signed root notification as its
+// RPC counterpart.
+func marshalRootNotification(
+	notif *universe.RootNotification) (*unirpc.NotifyRootUpdateRequest,
+	error) {
+
+	if notif.Sig == nil {
+		return nil, fmt.Errorf("root notification not signed")
+	}
+
+	uniID, err := MarshalUniID(notif.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	rootHash := notif.Root.NodeHash()
+	return &unirpc.NotifyRootUpdateRequest{
+		Id: uniID,
+		Root: &unirpc.MerkleSumNode{
+			RootHash: rootHash[:],
+			RootSum:  int64(notif.Root.NodeSum()),
+		},
+		Height:    notif.Height,
+		Origin:    notif.Origin.HostStr(),
+		Timestamp: notif.Timestamp.Unix(),
+		Sig:       notif.Sig.Serialize(),
+	}, nil
+}
+
+// unmarshalRootNotification decodes a root notification from its RPC
+// counterpart. The signature of the notification is not verified.
+func unmarshalRootNotification(
+	req *unirpc.NotifyRootUpdateRequest) (*universe.RootNotification,
+	error) {
+
+	uniID, err := UnmarshalUniID(req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid universe ID: %w", err)
+	}
+
+	if req.Root == nil {
+		return nil, fmt.Errorf("missing root")
+	}
+	var rootHash mssmt.NodeHash
+	if len(req.Root.RootHash) != len(rootHash) {
+		return nil, fmt.Errorf("invalid root hash length")
+	}
+	copy(rootHash[:], req.Root.RootHash)
+
+	if req.Root.RootSum < 0 {
+		return nil, fmt.Errorf("invalid root sum: %d", req.Root.RootSum)
+	}
+
+	if req.Origin == "" {
+		return nil, fmt.Errorf("missing origin")
+	}
+
+	sig, err := schnorr.ParseSignature(req.Sig)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature: %w", err)
+	}
+
+	return &universe.RootNotification{
+		ID: uniID,
+		Root: mssmt.NewComputedBranch(
+			rootHash, uint64(req.Root.RootSum),
+		),
+		Height:    req.Height,
+		Origin:    universe.NewServerAddrFromStr(req.Origin),
+		Timestamp: time.Unix(req.Timestamp, 0).UTC(),
+		Sig:       sig,
+	}, nil
+}
+
+// NotifyRootChange notifies the remote universe server that one of our
+// universe roots changed.
+//
+// NOTE: This is part of the universe.RootNotifier interface.
+func (r *RpcRootNotifier) NotifyRootChange(ctx context.Context,
+	notif *universe.RootNotification) error {
+
+	req, err := marshalRootNotification(notif)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.conn.NotifyRootUpdate(ctx, req)
+
+	return err
+}
+
+// Close closes the underlying RPC connection to the remote universe server.
+//
+// NOTE: This is part of the universe.RootNotifier interface.
+func (r *RpcRootNotifier) Close() error {
+	if err := r.conn.Close(); err != nil {
+		tapdLog.Warnf("unable to close universe RPC "+
+			"connection: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// A compile time interface to ensure that RpcRootNotifier implements the
+// universe.RootNotifier interface.
+var _ universe.RootNotifier = (*RpcRootNotifier)(nil)
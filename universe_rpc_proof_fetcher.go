@@ -0,0 +1,183 @@
+package taprootassets
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/proof"
+	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/tor"
+	"google.golang.org/grpc"
+)
+
+// UniverseProofFetcherCfg is the config for the UniverseProofFetcher.
+type UniverseProofFetcherCfg struct {
+	// Servers returns the universe servers of the federation that proofs
+	// are fetched from, in the order they are tried.
+	Servers func(ctx context.Context) ([]universe.ServerAddr, error)
+
+	// LocalArchive is the local proof archive that is checked for the
+	// earlier proofs of the provenance before they are fetched from a
+	// universe server. This MUST NOT be an archive that falls back to
+	// this fetcher itself.
+	LocalArchive proof.Archiver
+
+	// QueryAssetGroup returns the group of the asset with the given ID.
+	// Proofs of grouped assets are stored in the universe of their group,
+	// so the group key is needed to look them up.
+	QueryAssetGroup func(ctx context.Context,
+		id asset.ID) (*asset.AssetGroup, error)
+
+	// DialNet is the optional network the universe servers are dialed
+	// through, for example a Tor proxy.
+	DialNet tor.Net
+
+	// DialOpts returns the additional dial options for the connection to
+	// the given universe server.
+	DialOpts func(addr universe.ServerAddr) []grpc.DialOption
+}
+
+// UniverseProofFetcher is an implementation of the proof.RemoteProofFetcher
+// interface that fetches the full provenance of a proof from the universe
+// servers of the federation, trying one server after the other.
+type UniverseProofFetcher struct {
+	cfg UniverseProofFetcherCfg
+}
+
+// NewUniverseProofFetcher creates a new UniverseProofFetcher from the given
+// config.
+func NewUniverseProofFetcher(
+	cfg UniverseProofFetcherCfg) *UniverseProofFetcher {
+
+	return &UniverseProofFetcher{
+		cfg: cfg,
+	}
+}
+
+// FetchRemoteProof fetches the full proof file of the asset identified by the
+// given locator from the first universe server that has it. The host of that
+// server is returned along with the proof file.
+//
+// NOTE: This is part of the proof.RemoteProofFetcher interface.
+func (u *UniverseProofFetcher) FetchRemoteProof(ctx context.Context,
+	loc proof.Locator) (proof.Blob, string, error) {
+
+	if loc.AssetID == nil || loc.OutPoint == nil {
+		return nil, "", fmt.Errorf("proof locator is missing asset " +
+			"ID or outpoint")
+	}
+
+	if loc.GroupKey == nil {
+		assetGroup, err := u.cfg.QueryAssetGroup(ctx, *loc.AssetID)
+		switch {
+		// Assets that aren't grouped are stored in the universe of
+		// their asset ID.
+		case errors.Is(err, address.ErrAssetGroupUnknown):
+
+		case err != nil:
+			return nil, "", fmt.Errorf("unable to query asset "+
+				"group: %w", err)
+
+		case assetGroup.GroupKey != nil:
+			loc.GroupKey = &assetGroup.GroupKey.GroupPubKey
+		}
+	}
+
+	servers, err := u.cfg.Servers(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to fetch universe "+
+			"servers: %w", err)
+	}
+	if len(servers) == 0 {
+		return nil, "", fmt.Errorf("no universe servers configured")
+	}
+
+	var errs []error
+	for _, server := range servers {
+		proofBlob, err := u.fetchFromServer(ctx, server, loc)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%v: %w",
+				server.HostStr(), err))
+			continue
+		}
+
+		return proofBlob, server.HostStr(), nil
+	}
+
+	return nil, "", errors.Join(errs...)
+}
+
+// fetchFromServer fetches the full proof file of the asset identified by the
+// given locator from a single universe server.
+func (u *UniverseProofFetcher) fetchFromServer(ctx context.Context,
+	server universe.ServerAddr, loc proof.Locator) (proof.Blob, error) {
+
+	var dialOpts []grpc.DialOption
+	if u.cfg.DialOpts != nil {
+		dialOpts = u.cfg.DialOpts(server)
+	}
+
+	conn, err := ConnectUniverse(server, u.cfg.DialNet, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to universe RPC "+
+			"server: %w", err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			tapdLog.Warnf("unable to close universe RPC "+
+				"connection: %v", err)
+		}
+	}()
+
+	fetchProof := func(ctx context.Context,
+		loc proof.Locator) (proof.Blob, error) {
+
+		var groupKeyBytes []byte
+		if loc.GroupKey != nil {
+			groupKeyBytes = loc.GroupKey.SerializeCompressed()
+		}
+
+		if loc.OutPoint == nil {
+			return nil, fmt.Errorf("proof locator for asset %x "+
+				"is missing outpoint", loc.AssetID[:])
+		}
+
+		resp, err := conn.QueryProof(ctx, &unirpc.UniverseKey{
+			Id: unirpc.MarshalUniverseID(
+				loc.AssetID[:], groupKeyBytes,
+			),
+			LeafKey: unirpc.MarshalAssetKey(
+				*loc.OutPoint, &loc.ScriptKey,
+			),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return resp.AssetLeaf.Proof, nil
+	}
+
+	proofFile, err := proof.FetchProofProvenance(
+		ctx, u.cfg.LocalArchive, loc, fetchProof,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching proof provenance: %w",
+			err)
+	}
+
+	var buf bytes.Buffer
+	if err := proofFile.Encode(&buf); err != nil {
+		return nil, fmt.Errorf("error encoding proof file: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// A compile time interface to ensure that UniverseProofFetcher implements the
+// proof.RemoteProofFetcher interface.
+var _ proof.RemoteProofFetcher = (*UniverseProofFetcher)(nil)
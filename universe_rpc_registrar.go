@@ -5,12 +5,14 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
+	"net"
 	"time"
 
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/mssmt"
 	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
 	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/tor"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 )
@@ -22,11 +24,13 @@ type RpcUniverseRegistrar struct {
 }
 
 // NewRpcUniverseRegistrar creates a new RpcUniverseRegistrar instance that
-// dials out to the target remote universe server address.
-func NewRpcUniverseRegistrar(
-	serverAddr universe.ServerAddr) (universe.Registrar, error) {
+// dials out to the target remote universe server address. If dialNet is set,
+// the connection is established through it, for example through a Tor proxy.
+// Any additional dial options are applied to the connection.
+func NewRpcUniverseRegistrar(serverAddr universe.ServerAddr, dialNet tor.Net,
+	opts ...grpc.DialOption) (universe.Registrar, error) {
 
-	conn, err := ConnectUniverse(serverAddr)
+	conn, err := ConnectUniverse(serverAddr, dialNet, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("unable to connect to universe RPC "+
 			"server: %w", err)
@@ -131,14 +135,17 @@ func (r *RpcUniverseRegistrar) Close() error {
 var _ universe.Registrar = (*RpcUniverseRegistrar)(nil)
 
 // CheckFederationServer attempts to connect to the target server and ensure
-// that it is a valid federation server that isn't the local daemon.
-func CheckFederationServer(localRuntimeID int64, connectTimeout time.Duration,
-	server universe.ServerAddr) error {
+// that it is a valid federation server that isn't the local daemon and that
+// operates on the same network as the local daemon, identified by the given
+// network ID.
+func CheckFederationServer(localRuntimeID int64, localNetwork string,
+	connectTimeout time.Duration, server universe.ServerAddr,
+	dialNet tor.Net) error {
 
 	srvrLog.Debugf("Attempting to connect to federation server %v",
 		server.HostStr())
 
-	conn, err := ConnectUniverse(server)
+	conn, err := ConnectUniverse(server, dialNet)
 	if err != nil {
 		return fmt.Errorf("error connecting to server %v: %w",
 			server.HostStr(), err)
@@ -159,7 +166,17 @@ func CheckFederationServer(localRuntimeID int64, connectTimeout time.Duration,
 		return fmt.Errorf("cannot add ourselves as a federation member")
 	}
 
-	return nil
+	// Servers that advertise their network must be on the same network as
+	// we are.
+	remoteInfo, err := unmarshalSyncProtocolInfo(info)
+	if err != nil {
+		return fmt.Errorf("invalid info from server %v: %w",
+			server.HostStr(), err)
+	}
+
+	return universe.CheckNetwork(
+		universe.LocalSyncProtocolInfo(localNetwork), remoteInfo,
+	)
 }
 
 // universeClientConn is a wrapper around a gRPC client connection that also
@@ -171,9 +188,12 @@ type universeClientConn struct {
 }
 
 // ConnectUniverse connects to a remote Universe server using the provided
-// server address.
-func ConnectUniverse(
-	serverAddr universe.ServerAddr) (*universeClientConn, error) {
+// server address. If dialNet is set, the connection is established through
+// it, which allows universe servers to be reached over Tor. Onion services can
+// only be reached if dialNet is set. Any additional dial options, for example
+// to negotiate compression, are applied to the connection.
+func ConnectUniverse(serverAddr universe.ServerAddr, dialNet tor.Net,
+	extraOpts ...grpc.DialOption) (*universeClientConn, error) {
 
 	// TODO(roasbeef): all info is authenticated, but also want to allow
 	// brontide connect as well, can avoid TLS certs
@@ -186,13 +206,46 @@ func ConnectUniverse(
 		grpc.WithTransportCredentials(creds),
 		grpc.WithDefaultCallOptions(MaxMsgReceiveSize),
 	}
-
-	uniAddr, err := serverAddr.Addr()
-	if err != nil {
-		return nil, err
+	opts = append(opts, extraOpts...)
+
+	var target string
+	switch {
+	// If we have a custom dialer, we don't resolve the host ourselves, as
+	// that would leak the address of the server through DNS if the dialer
+	// is a Tor proxy. The dialer takes care of the resolution instead.
+	case dialNet != nil:
+		hostPort, err := serverAddr.HostPort()
+		if err != nil {
+			return nil, err
+		}
+		target = hostPort
+
+		opts = append(opts, grpc.WithContextDialer(
+			func(ctx context.Context, addr string) (net.Conn,
+				error) {
+
+				timeout := universe.DefaultTimeout
+				if deadline, ok := ctx.Deadline(); ok {
+					timeout = time.Until(deadline)
+				}
+
+				return dialNet.Dial("tcp", addr, timeout)
+			},
+		))
+
+	case serverAddr.IsOnion():
+		return nil, fmt.Errorf("unable to connect to onion universe "+
+			"server %v, Tor is not enabled", serverAddr.HostStr())
+
+	default:
+		uniAddr, err := serverAddr.Addr()
+		if err != nil {
+			return nil, err
+		}
+		target = uniAddr.String()
 	}
 
-	rawConn, err := grpc.Dial(uniAddr.String(), opts...)
+	rawConn, err := grpc.Dial(target, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("unable to connect to RPC server: "+
 			"%w", err)
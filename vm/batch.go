@@ -0,0 +1,263 @@
+package vm
+
+import (
+	"crypto/rand"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+)
+
+// SchnorrSig is a BIP-340 Schnorr signature together with the message digest
+// it signs and the public key it must be valid for. The raw signature and key
+// are kept, as they are also what a signature cache is keyed by.
+type SchnorrSig struct {
+	// PubKey is the serialized x-only public key.
+	PubKey []byte
+
+	// Sig is the raw signature, which may have a sighash flag appended.
+	Sig []byte
+
+	// SigHash is the message digest that was signed.
+	SigHash chainhash.Hash
+}
+
+// verify verifies the signature on its own.
+func (s *SchnorrSig) verify() bool {
+	if len(s.Sig) < schnorr.SignatureSize {
+		return false
+	}
+
+	pubKey, err := schnorr.ParsePubKey(s.PubKey)
+	if err != nil {
+		return false
+	}
+
+	sig, err := schnorr.ParseSignature(s.Sig[:schnorr.SignatureSize])
+	if err != nil {
+		return false
+	}
+
+	return sig.Verify(s.SigHash[:], pubKey)
+}
+
+// BatchSigError is returned if a batch of signatures is invalid. It identifies
+// the first invalid signature of the batch.
+type BatchSigError struct {
+	// Index is the index of the first invalid signature within the batch.
+	Index int
+}
+
+// Error returns a human-readable version of the error.
+func (e *BatchSigError) Error() string {
+	return fmt.Sprintf("signature %d of batch is invalid", e.Index)
+}
+
+// batchWindowSize is the number of scalar bits that are processed at once in
+// the multi-scalar multiplication of a batch verification.
+const batchWindowSize = 4
+
+// VerifySchnorrBatch verifies the given signatures all at once. The
+// verification equations of the signatures are combined with random weights,
+// which turns the verification into a single multi-scalar multiplication that
+// shares its point doublings across all signatures. If the batch is invalid,
+// the signatures are verified one by one to identify the first invalid one,
+// which is returned as a BatchSigError. If a signature cache is given, the
+// valid signatures are added to it, so a script engine using the same cache
+// doesn't verify them again.
+func VerifySchnorrBatch(sigs []SchnorrSig, sigCache *txscript.SigCache) error {
+	if len(sigs) == 0 {
+		return nil
+	}
+
+	valid, err := verifyBatch(sigs)
+	if err != nil {
+		return err
+	}
+
+	// The batch equation doesn't hold, so at least one of the signatures
+	// is invalid. We fall back to verifying them individually, to find out
+	// which one it is.
+	if !valid {
+		for idx := range sigs {
+			if !sigs[idx].verify() {
+				return newErrInner(
+					ErrInvalidTransferWitness,
+					&BatchSigError{Index: idx},
+				)
+			}
+		}
+
+		// This should never happen, as a batch with only valid
+		// signatures always verifies.
+		return fmt.Errorf("batch is invalid, but all signatures are " +
+			"valid")
+	}
+
+	if sigCache != nil {
+		for _, sig := range sigs {
+			sigCache.Add(sig.SigHash, sig.Sig, sig.PubKey)
+		}
+	}
+
+	return nil
+}
+
+// verifyBatch checks the BIP-340 batch verification equation
+//
+//	(a_1*s_1 + ... + a_u*s_u)*G =
+//		a_1*R_1 + ... + a_u*R_u + a_1*e_1*P_1 + ... + a_u*e_u*P_u
+//
+// for the given signatures, where a_1 is 1 and a_2..a_u are random weights. It
+// returns false if any of the signatures can't be parsed or the equation
+// doesn't hold.
+func verifyBatch(sigs []SchnorrSig) (bool, error) {
+	var (
+		sumS    btcec.ModNScalar
+		scalars = make([]btcec.ModNScalar, 0, 2*len(sigs))
+		points  = make([]btcec.JacobianPoint, 0, 2*len(sigs))
+	)
+	for idx, sig := range sigs {
+		if len(sig.Sig) < schnorr.SignatureSize {
+			return false, nil
+		}
+
+		pubKey, err := schnorr.ParsePubKey(sig.PubKey)
+		if err != nil {
+			return false, nil
+		}
+
+		var pubKeyPoint btcec.JacobianPoint
+		pubKey.AsJacobian(&pubKeyPoint)
+
+		// R is the point with the even y coordinate for the x
+		// coordinate r.
+		var r, ry btcec.FieldVal
+		if overflow := r.SetByteSlice(sig.Sig[:32]); overflow {
+			return false, nil
+		}
+		if !btcec.DecompressY(&r, false, &ry) {
+			return false, nil
+		}
+		ry.Normalize()
+
+		var one btcec.FieldVal
+		one.SetInt(1)
+		rPoint := btcec.MakeJacobianPoint(&r, &ry, &one)
+
+		var s btcec.ModNScalar
+		overflow := s.SetByteSlice(sig.Sig[32:schnorr.SignatureSize])
+		if overflow {
+			return false, nil
+		}
+
+		// The challenge is e = int(hash(r || P || m)) mod n.
+		challengeHash := chainhash.TaggedHash(
+			chainhash.TagBIP0340Challenge, sig.Sig[:32],
+			sig.PubKey, sig.SigHash[:],
+		)
+		var e btcec.ModNScalar
+		e.SetByteSlice(challengeHash[:])
+
+		// The first weight is always one, the others are random.
+		var weight btcec.ModNScalar
+		weight.SetInt(1)
+		if idx > 0 {
+			var err error
+			weight, err = randWeight()
+			if err != nil {
+				return false, err
+			}
+		}
+
+		var weightedS btcec.ModNScalar
+		weightedS.Mul2(&weight, &s)
+		sumS.Add(&weightedS)
+
+		var weightedE btcec.ModNScalar
+		weightedE.Mul2(&weight, &e)
+
+		scalars = append(scalars, weight, weightedE)
+		points = append(points, rPoint, pubKeyPoint)
+	}
+
+	var lhs, rhs btcec.JacobianPoint
+	btcec.ScalarBaseMultNonConst(&sumS, &lhs)
+	multiScalarMult(scalars, points, &rhs)
+
+	lhs.ToAffine()
+	rhs.ToAffine()
+
+	return lhs.X.Equals(&rhs.X) && lhs.Y.Equals(&rhs.Y), nil
+}
+
+// randWeight returns a random non-zero scalar.
+func randWeight() (btcec.ModNScalar, error) {
+	var (
+		weight btcec.ModNScalar
+		b      [32]byte
+	)
+	for weight.IsZero() {
+		if _, err := rand.Read(b[:]); err != nil {
+			return weight, fmt.Errorf("unable to read random "+
+				"bytes: %w", err)
+		}
+
+		weight.SetBytes(&b)
+	}
+
+	return weight, nil
+}
+
+// multiScalarMult computes the sum of the products of the given scalars and
+// points using Straus' method with a fixed window. All products share the same
+// point doublings, which makes this considerably cheaper than multiplying each
+// point on its own.
+func multiScalarMult(scalars []btcec.ModNScalar,
+	points []btcec.JacobianPoint, result *btcec.JacobianPoint) {
+
+	const tableSize = 1 << batchWindowSize
+
+	// We precompute the multiples 1*P to 15*P of each point. The entry
+	// for a window value of zero is never used.
+	tables := make([][tableSize]btcec.JacobianPoint, len(points))
+	for idx := range points {
+		table := &tables[idx]
+		table[1].Set(&points[idx])
+		for j := 2; j < tableSize; j++ {
+			btcec.AddNonConst(&table[j-1], &points[idx], &table[j])
+		}
+	}
+
+	scalarBytes := make([][32]byte, len(scalars))
+	for idx := range scalars {
+		scalarBytes[idx] = scalars[idx].Bytes()
+	}
+
+	// We process the big-endian scalars a window at a time, starting with
+	// the most significant one.
+	var acc btcec.JacobianPoint
+	for byteIdx := 0; byteIdx < 32; byteIdx++ {
+		for _, shift := range []uint{batchWindowSize, 0} {
+			for i := 0; i < batchWindowSize; i++ {
+				btcec.DoubleNonConst(&acc, &acc)
+			}
+
+			for idx := range points {
+				window := (scalarBytes[idx][byteIdx] >> shift) &
+					(tableSize - 1)
+				if window == 0 {
+					continue
+				}
+
+				btcec.AddNonConst(
+					&acc, &tables[idx][window], &acc,
+				)
+			}
+		}
+	}
+
+	result.Set(&acc)
+}
@@ -0,0 +1,164 @@
+package vm
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// randSchnorrSig returns a random valid signature.
+func randSchnorrSig(t *testing.T, withSigHashFlag bool) SchnorrSig {
+	privKey := test.RandPrivKey(t)
+
+	var sigHash chainhash.Hash
+	test.RandRead(t, sigHash[:])
+
+	sig, err := schnorr.Sign(privKey, sigHash[:])
+	require.NoError(t, err)
+
+	rawSig := sig.Serialize()
+	if withSigHashFlag {
+		rawSig = append(rawSig, byte(txscript.SigHashAll))
+	}
+
+	return SchnorrSig{
+		PubKey:  schnorr.SerializePubKey(privKey.PubKey()),
+		Sig:     rawSig,
+		SigHash: sigHash,
+	}
+}
+
+// TestVerifySchnorrBatch tests that a batch of valid signatures verifies and
+// is added to the signature cache, while an invalid signature is identified.
+func TestVerifySchnorrBatch(t *testing.T) {
+	t.Parallel()
+
+	const numSigs = 20
+	sigs := make([]SchnorrSig, numSigs)
+	for idx := range sigs {
+		sigs[idx] = randSchnorrSig(t, idx%2 == 0)
+	}
+
+	// An empty batch is valid.
+	require.NoError(t, VerifySchnorrBatch(nil, nil))
+
+	sigCache := txscript.NewSigCache(numSigs)
+	require.NoError(t, VerifySchnorrBatch(sigs, sigCache))
+	for _, sig := range sigs {
+		require.True(
+			t, sigCache.Exists(sig.SigHash, sig.Sig, sig.PubKey),
+		)
+	}
+
+	// A signature over a different digest is found, and the other
+	// signatures aren't added to the cache.
+	const invalidIdx = 7
+	invalidSig := randSchnorrSig(t, false)
+	invalidSig.SigHash[0] ^= 1
+	sigs[invalidIdx] = invalidSig
+
+	sigCache = txscript.NewSigCache(numSigs)
+	err := VerifySchnorrBatch(sigs, sigCache)
+
+	var batchErr *BatchSigError
+	require.True(t, errors.As(err, &batchErr))
+	require.Equal(t, invalidIdx, batchErr.Index)
+
+	var vmErr Error
+	require.True(t, errors.As(err, &vmErr))
+	require.Equal(t, ErrInvalidTransferWitness, vmErr.Kind)
+	require.False(t, sigCache.Exists(
+		sigs[0].SigHash, sigs[0].Sig, sigs[0].PubKey,
+	))
+
+	// A signature that can't be parsed is found as well.
+	sigs[invalidIdx] = randSchnorrSig(t, false)
+	sigs[numSigs-1].Sig = sigs[numSigs-1].Sig[:schnorr.SignatureSize-1]
+
+	err = VerifySchnorrBatch(sigs, nil)
+	require.True(t, errors.As(err, &batchErr))
+	require.Equal(t, numSigs-1, batchErr.Index)
+}
+
+// TestMultiScalarMult tests that the multi-scalar multiplication matches the
+// sum of the individual products.
+func TestMultiScalarMult(t *testing.T) {
+	t.Parallel()
+
+	const numPoints = 10
+	var (
+		scalars  = make([]btcec.ModNScalar, numPoints)
+		points   = make([]btcec.JacobianPoint, numPoints)
+		expected btcec.JacobianPoint
+	)
+	for idx := range points {
+		test.RandPrivKey(t).PubKey().AsJacobian(&points[idx])
+
+		var err error
+		scalars[idx], err = randWeight()
+		require.NoError(t, err)
+
+		var product btcec.JacobianPoint
+		btcec.ScalarMultNonConst(&scalars[idx], &points[idx], &product)
+		btcec.AddNonConst(&expected, &product, &expected)
+	}
+
+	var result btcec.JacobianPoint
+	multiScalarMult(scalars, points, &result)
+
+	expected.ToAffine()
+	result.ToAffine()
+	require.True(t, expected.X.Equals(&result.X))
+	require.True(t, expected.Y.Equals(&result.Y))
+}
+
+// TestKeySpendSigs tests that the key spend signatures of a state transition
+// can be verified in a batch, and that the engine accepts the transition with
+// the resulting signature cache.
+func TestKeySpendSigs(t *testing.T) {
+	t.Parallel()
+
+	newAsset, _, inputs, _ := collectibleStateTransition(t)
+
+	engine, err := New(newAsset, nil, inputs, WithSkipTimeLockValidation())
+	require.NoError(t, err)
+
+	sigs := engine.KeySpendSigs()
+	require.Len(t, sigs, 1)
+
+	sigCache := txscript.NewSigCache(1)
+	require.NoError(t, VerifySchnorrBatch(sigs, sigCache))
+
+	engine, err = New(
+		newAsset, nil, inputs, WithSkipTimeLockValidation(),
+		WithSigCache(sigCache),
+	)
+	require.NoError(t, err)
+	require.NoError(t, engine.Execute())
+
+	// The group witness of a group anchor with a BIP-86 group key is a
+	// key spend as well.
+	groupAnchor, _, _, _ := groupAnchorStateTransition(
+		false, true, false, true, newAsset.Type,
+	)(t)
+	engine, err = New(groupAnchor, nil, nil)
+	require.NoError(t, err)
+
+	sigs = engine.KeySpendSigs()
+	require.Len(t, sigs, 1)
+	require.NoError(t, VerifySchnorrBatch(sigs, nil))
+
+	// A genesis asset without a group doesn't have any signatures.
+	genesisAsset, _, _, _ := genesisStateTransition(
+		newAsset.Type, true, false,
+	)(t)
+	engine, err = New(genesisAsset, nil, nil)
+	require.NoError(t, err)
+	require.Empty(t, engine.KeySpendSigs())
+}
@@ -7,6 +7,8 @@ import (
 	"math"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/davecgh/go-spew/spew"
@@ -44,6 +46,10 @@ type Engine struct {
 	// chainLookup is an interface that can be used to look up certain
 	// information on chain.
 	chainLookup asset.ChainLookup
+
+	// sigCache is an optional cache of signatures that are known to be
+	// valid, which the script engine doesn't verify again.
+	sigCache *txscript.SigCache
 }
 
 // newEngineOptions is a struct that is used to customize how a new engine is to
@@ -52,6 +58,7 @@ type newEngineOptions struct {
 	skipTimeLockValidation bool
 	blockHeight            fn.Option[uint32]
 	chainLookup            asset.ChainLookup
+	sigCache               *txscript.SigCache
 }
 
 // NewEngineOpt is used to modify how a new engine is to be created.
@@ -88,6 +95,15 @@ func WithSkipTimeLockValidation() NewEngineOpt {
 	}
 }
 
+// WithSigCache can be used to create an engine that doesn't verify signatures
+// again that are found in the given cache, for example because they were
+// already verified in a batch.
+func WithSigCache(sigCache *txscript.SigCache) NewEngineOpt {
+	return func(o *newEngineOptions) {
+		o.sigCache = sigCache
+	}
+}
+
 // New returns a new virtual machine capable of executing and verifying Taproot
 // Asset state transitions.
 func New(newAsset *asset.Asset, splitAssets []*commitment.SplitAsset,
@@ -105,6 +121,7 @@ func New(newAsset *asset.Asset, splitAssets []*commitment.SplitAsset,
 		skipTimeLockValidation: options.skipTimeLockValidation,
 		blockHeight:            options.blockHeight,
 		chainLookup:            options.chainLookup,
+		sigCache:               options.sigCache,
 	}, nil
 }
 
@@ -392,7 +409,7 @@ func (vm *Engine) validateWitnessV0(virtualTx *wire.MsgTx, inputIdx uint32,
 	// heavy lifting here.
 	engine, err := txscript.NewEngine(
 		prevOut.PkScript, virtualTxCopy, 0, txscript.StandardVerifyFlags,
-		nil, sigHashes, prevOut.Value, prevOutFetcher,
+		vm.sigCache, sigHashes, prevOut.Value, prevOutFetcher,
 	)
 	if err != nil {
 		return newErrInner(ErrInvalidTransferWitness, err)
@@ -404,6 +421,101 @@ func (vm *Engine) validateWitnessV0(virtualTx *wire.MsgTx, inputIdx uint32,
 	return nil
 }
 
+// KeySpendSigs returns the signatures of all witnesses of the state transition
+// that spend their input through the taproot key spend path, together with the
+// digest they sign. These can be verified in a batch before executing the
+// engine with a signature cache. Witnesses that can't be mapped to a key spend
+// signature are skipped, as the engine will verify them on execution anyway.
+func (vm *Engine) KeySpendSigs() []SchnorrSig {
+	// Genesis assets without a group witness don't have any signatures.
+	if vm.newAsset.HasGenesisWitness() {
+		return nil
+	}
+
+	prevAssets := vm.prevAssets
+	if vm.newAsset.HasGenesisWitnessForGroup() {
+		prevAssets = commitment.InputSet{
+			asset.ZeroPrevID: vm.newAsset,
+		}
+	}
+
+	virtualTx, _, err := tapscript.VirtualTx(vm.newAsset, prevAssets)
+	if err != nil {
+		return nil
+	}
+
+	var sigs []SchnorrSig
+	for idx := range vm.newAsset.PrevWitnesses {
+		witness := vm.newAsset.PrevWitnesses[idx]
+
+		// A key spend witness consists of a single signature, with or
+		// without a sighash flag.
+		if witness.PrevID == nil || len(witness.TxWitness) != 1 {
+			continue
+		}
+		rawSig := witness.TxWitness[0]
+		if len(rawSig) != schnorr.SignatureSize &&
+			len(rawSig) != schnorr.SignatureSize+1 {
+
+			continue
+		}
+
+		prevAsset, ok := prevAssets[*witness.PrevID]
+		if !ok || prevAsset.ScriptVersion != asset.ScriptV0 {
+			continue
+		}
+
+		var prevOutFetcher *txscript.CannedPrevOutputFetcher
+		if vm.newAsset.HasGenesisWitnessForGroup() {
+			prevOutFetcher, err = asset.GenesisPrevOutFetcher(
+				*prevAsset,
+			)
+		} else {
+			prevOutFetcher, err = tapscript.InputPrevOutFetcher(
+				*prevAsset,
+			)
+		}
+		if err != nil {
+			continue
+		}
+
+		prevOut := prevOutFetcher.FetchPrevOutput(wire.OutPoint{})
+		if !txscript.IsPayToTaproot(prevOut.PkScript) {
+			continue
+		}
+
+		hashType := txscript.SigHashDefault
+		if len(rawSig) == schnorr.SignatureSize+1 {
+			hashType = txscript.SigHashType(
+				rawSig[schnorr.SignatureSize],
+			)
+		}
+
+		virtualTxCopy := asset.VirtualTxWithInput(
+			virtualTx, vm.newAsset.LockTime,
+			vm.newAsset.RelativeLockTime, uint32(idx),
+			witness.TxWitness,
+		)
+		sigHashes := txscript.NewTxSigHashes(
+			virtualTxCopy, prevOutFetcher,
+		)
+		sigHash, err := txscript.CalcTaprootSignatureHash(
+			sigHashes, hashType, virtualTxCopy, 0, prevOutFetcher,
+		)
+		if err != nil {
+			continue
+		}
+
+		sigs = append(sigs, SchnorrSig{
+			PubKey:  prevOut.PkScript[2:],
+			Sig:     rawSig,
+			SigHash: chainhash.Hash(sigHash),
+		})
+	}
+
+	return sigs
+}
+
 // validateStateTransition attempts to validate a normal state transition where
 // an asset (normal or collectible) is fully consumed without splits. This is
 // done by verifying each input has a valid witness generated over the virtual
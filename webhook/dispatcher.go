@@ -0,0 +1,423 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/universe"
+)
+
+const (
+	// SignatureHeader is the header that contains the hex encoded
+	// HMAC-SHA256 signature of the payload, if the endpoint has a secret.
+	// The signature is computed over the timestamp header value, a dot and
+	// the raw request body.
+	SignatureHeader = "X-Tapd-Signature"
+
+	// TimestampHeader is the header that contains the unix timestamp of
+	// the delivery attempt, which is part of the signed message to prevent
+	// replays.
+	TimestampHeader = "X-Tapd-Timestamp"
+
+	// EventIDHeader is the header that contains the ID of the event.
+	EventIDHeader = "X-Tapd-Event-Id"
+
+	// DefaultMaxAttempts is the default number of attempts to deliver an
+	// event to an endpoint.
+	DefaultMaxAttempts = 5
+
+	// DefaultInitialBackoff is the default time to wait before retrying a
+	// failed delivery. The backoff is doubled after each failed attempt.
+	DefaultInitialBackoff = 5 * time.Second
+
+	// DefaultRequestTimeout is the default timeout for a single delivery
+	// attempt.
+	DefaultRequestTimeout = 10 * time.Second
+)
+
+// Config is the configuration for the webhook dispatcher.
+type Config struct {
+	// Endpoints is the list of endpoints events are sent to.
+	Endpoints []*Endpoint
+
+	// ReceiveEvents is the source of inbound transfer events.
+	ReceiveEvents fn.EventPublisher[fn.Event, time.Time]
+
+	// SendEvents is the source of outbound transfer events.
+	SendEvents fn.EventPublisher[fn.Event, bool]
+
+	// IssuanceAlerts is the optional source of universe issuance
+	// watch-list alerts.
+	IssuanceAlerts fn.EventPublisher[fn.Event, time.Time]
+
+	// ProofRecoveries is the optional source of events for proofs that
+	// were missing from the local proof archive and were recovered from a
+	// universe server.
+	ProofRecoveries fn.EventPublisher[fn.Event, time.Time]
+
+	// SpendAlerts is the optional source of alerts for anchor outputs that
+	// were spent by a third party.
+	SpendAlerts fn.EventPublisher[fn.Event, time.Time]
+
+	// HTTPClient is the client used to deliver the events.
+	HTTPClient *http.Client
+
+	// MaxAttempts is the number of attempts to deliver an event to an
+	// endpoint before giving up.
+	MaxAttempts int
+
+	// InitialBackoff is the time to wait before retrying a failed
+	// delivery. The backoff is doubled after each failed attempt.
+	InitialBackoff time.Duration
+}
+
+// Dispatcher delivers transfer events and issuance alerts to a set of webhook
+// endpoints.
+type Dispatcher struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg *Config
+
+	receiveSub *fn.EventReceiver[fn.Event]
+	sendSub    *fn.EventReceiver[fn.Event]
+	alertSub   *fn.EventReceiver[fn.Event]
+	proofSub   *fn.EventReceiver[fn.Event]
+	spendSub   *fn.EventReceiver[fn.Event]
+
+	// subscribed is true if we registered our subscribers with the event
+	// sources and need to remove them on shutdown.
+	subscribed bool
+
+	// ContextGuard provides a wait group and main quit channel that can be
+	// used to create guarded contexts.
+	*fn.ContextGuard
+}
+
+// NewDispatcher creates a new webhook dispatcher.
+func NewDispatcher(cfg *Config) *Dispatcher {
+	return &Dispatcher{
+		cfg:        cfg,
+		receiveSub: fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize),
+		sendSub:    fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize),
+		alertSub:   fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize),
+		proofSub:   fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize),
+		spendSub:   fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize),
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: DefaultRequestTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start subscribes to the event sources and starts delivering events.
+func (d *Dispatcher) Start() error {
+	var startErr error
+	d.startOnce.Do(func() {
+		if len(d.cfg.Endpoints) == 0 {
+			return
+		}
+
+		log.Infof("Starting webhook dispatcher with %d endpoints",
+			len(d.cfg.Endpoints))
+
+		err := d.cfg.ReceiveEvents.RegisterSubscriber(
+			d.receiveSub, false, time.Time{},
+		)
+		if err != nil {
+			startErr = fmt.Errorf("unable to subscribe to receive "+
+				"events: %w", err)
+			return
+		}
+
+		err = d.cfg.SendEvents.RegisterSubscriber(
+			d.sendSub, false, false,
+		)
+		if err != nil {
+			startErr = fmt.Errorf("unable to subscribe to send "+
+				"events: %w", err)
+			return
+		}
+
+		if d.cfg.IssuanceAlerts != nil {
+			err = d.cfg.IssuanceAlerts.RegisterSubscriber(
+				d.alertSub, false, time.Time{},
+			)
+			if err != nil {
+				startErr = fmt.Errorf("unable to subscribe "+
+					"to issuance alerts: %w", err)
+				return
+			}
+		}
+
+		if d.cfg.ProofRecoveries != nil {
+			err = d.cfg.ProofRecoveries.RegisterSubscriber(
+				d.proofSub, false, time.Time{},
+			)
+			if err != nil {
+				startErr = fmt.Errorf("unable to subscribe "+
+					"to proof recoveries: %w", err)
+				return
+			}
+		}
+
+		if d.cfg.SpendAlerts != nil {
+			err = d.cfg.SpendAlerts.RegisterSubscriber(
+				d.spendSub, false, time.Time{},
+			)
+			if err != nil {
+				startErr = fmt.Errorf("unable to subscribe "+
+					"to anchor spend alerts: %w", err)
+				return
+			}
+		}
+		d.subscribed = true
+
+		d.Wg.Add(1)
+		go d.dispatchEvents()
+	})
+
+	return startErr
+}
+
+// Stop stops delivering events.
+func (d *Dispatcher) Stop() error {
+	var stopErr error
+	d.stopOnce.Do(func() {
+		close(d.Quit)
+		d.Wg.Wait()
+
+		if !d.subscribed {
+			return
+		}
+
+		err := d.cfg.ReceiveEvents.RemoveSubscriber(d.receiveSub)
+		if err != nil {
+			stopErr = err
+		}
+
+		err = d.cfg.SendEvents.RemoveSubscriber(d.sendSub)
+		if err != nil {
+			stopErr = err
+		}
+
+		if d.cfg.IssuanceAlerts != nil {
+			err = d.cfg.IssuanceAlerts.RemoveSubscriber(d.alertSub)
+			if err != nil {
+				stopErr = err
+			}
+		}
+
+		if d.cfg.ProofRecoveries != nil {
+			err = d.cfg.ProofRecoveries.RemoveSubscriber(d.proofSub)
+			if err != nil {
+				stopErr = err
+			}
+		}
+
+		if d.cfg.SpendAlerts != nil {
+			err = d.cfg.SpendAlerts.RemoveSubscriber(d.spendSub)
+			if err != nil {
+				stopErr = err
+			}
+		}
+	})
+
+	return stopErr
+}
+
+// dispatchEvents is the main event loop that converts incoming events and
+// hands them off for delivery.
+func (d *Dispatcher) dispatchEvents() {
+	defer d.Wg.Done()
+
+	for {
+		var (
+			event *Event
+			err   error
+		)
+		select {
+		case e := <-d.receiveSub.NewItemCreated.ChanOut():
+			receiveEvent, ok := e.(*tapgarden.AssetReceiveEvent)
+			if !ok {
+				continue
+			}
+
+			event, err = newReceiveEvent(receiveEvent)
+
+		case e := <-d.sendSub.NewItemCreated.ChanOut():
+			sendEvent, ok := e.(*tapfreighter.AssetSendEvent)
+			if !ok {
+				continue
+			}
+
+			event, err = newSendEvent(sendEvent)
+
+		case e := <-d.alertSub.NewItemCreated.ChanOut():
+			alert, ok := e.(*universe.IssuanceAlert)
+			if !ok {
+				continue
+			}
+
+			event, err = newIssuanceAlertEvent(alert)
+
+		case e := <-d.proofSub.NewItemCreated.ChanOut():
+			recovered, ok := e.(*proof.ProofRecoveredEvent)
+			if !ok {
+				continue
+			}
+
+			event, err = newProofRecoveredEvent(recovered)
+
+		case e := <-d.spendSub.NewItemCreated.ChanOut():
+			alert, ok := e.(*tapfreighter.AnchorSpendAlert)
+			if !ok {
+				continue
+			}
+
+			event = newAnchorSpendAlertEvent(alert)
+
+		case <-d.Quit:
+			return
+		}
+
+		if err != nil {
+			log.Errorf("Unable to create webhook event: %v", err)
+			continue
+		}
+		if event == nil {
+			continue
+		}
+
+		d.Dispatch(event)
+	}
+}
+
+// Dispatch delivers the given event to all endpoints whose filters match it.
+// Each delivery happens in its own goroutine and is retried independently.
+func (d *Dispatcher) Dispatch(event *Event) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		log.Errorf("Unable to encode webhook event: %v", err)
+		return
+	}
+
+	for _, endpoint := range d.cfg.Endpoints {
+		if !endpoint.Matches(event) {
+			continue
+		}
+
+		d.Wg.Add(1)
+		go func(endpoint *Endpoint) {
+			defer d.Wg.Done()
+
+			d.deliver(endpoint, event.ID, payload)
+		}(endpoint)
+	}
+}
+
+// deliver attempts to POST the given payload to the endpoint, retrying with
+// an exponential backoff until it succeeds or the maximum number of attempts
+// is reached.
+func (d *Dispatcher) deliver(endpoint *Endpoint, eventID string,
+	payload []byte) {
+
+	backoff := d.cfg.InitialBackoff
+	for attempt := 1; attempt <= d.cfg.MaxAttempts; attempt++ {
+		err := d.post(endpoint, eventID, payload)
+		if err == nil {
+			log.Debugf("Delivered webhook event %s to %s", eventID,
+				endpoint.URL)
+			return
+		}
+
+		log.Warnf("Attempt %d/%d to deliver webhook event %s to %s "+
+			"failed: %v", attempt, d.cfg.MaxAttempts, eventID,
+			endpoint.URL, err)
+
+		if attempt == d.cfg.MaxAttempts {
+			break
+		}
+
+		select {
+		case <-time.After(backoff):
+			backoff *= 2
+
+		case <-d.Quit:
+			return
+		}
+	}
+
+	log.Errorf("Giving up delivering webhook event %s to %s", eventID,
+		endpoint.URL)
+}
+
+// post makes a single attempt to POST the given payload to the endpoint.
+func (d *Dispatcher) post(endpoint *Endpoint, eventID string,
+	payload []byte) error {
+
+	ctxt, cancel := d.WithCtxQuit()
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(
+		ctxt, http.MethodPost, endpoint.URL, bytes.NewReader(payload),
+	)
+	if err != nil {
+		return err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventIDHeader, eventID)
+	req.Header.Set(TimestampHeader, timestamp)
+
+	if endpoint.AuthHeaderName != "" {
+		req.Header.Set(
+			endpoint.AuthHeaderName, endpoint.AuthHeaderValue,
+		)
+	}
+
+	if len(endpoint.Secret) > 0 {
+		req.Header.Set(
+			SignatureHeader,
+			SignPayload(endpoint.Secret, timestamp, payload),
+		)
+	}
+
+	resp, err := d.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status code: %d",
+			resp.StatusCode)
+	}
+
+	return nil
+}
+
+// SignPayload returns the hex encoded HMAC-SHA256 signature of the given
+// timestamp and payload with the given secret. Receivers can use this to
+// verify the authenticity of an event.
+func SignPayload(secret []byte, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write([]byte(timestamp))
+	_, _ = mac.Write([]byte("."))
+	_, _ = mac.Write(payload)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
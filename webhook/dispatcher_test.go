@@ -0,0 +1,339 @@
+package webhook
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/stretchr/testify/require"
+)
+
+var testAssetID = strings.Repeat("0f1e2d3c", 8)
+
+// TestParseEndpoint tests that webhook endpoints are parsed correctly from
+// their config representation.
+func TestParseEndpoint(t *testing.T) {
+	t.Parallel()
+
+	endpoint, err := ParseEndpoint(
+		"url=https://example.com/hook;events=send_confirmed," +
+			"receive_completed;assets=" + testAssetID + ";" +
+			"auth=Authorization: Bearer foo;secret=bar",
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, "https://example.com/hook", endpoint.URL)
+	require.Equal(t, []EventType{
+		EventSendConfirmed, EventReceiveCompleted,
+	}, endpoint.EventTypes)
+	require.Equal(t, []string{testAssetID}, endpoint.AssetIDs)
+	require.Equal(t, "Authorization", endpoint.AuthHeaderName)
+	require.Equal(t, "Bearer foo", endpoint.AuthHeaderValue)
+	require.Equal(t, []byte("bar"), endpoint.Secret)
+
+	_, err = ParseEndpoint("events=send_confirmed")
+	require.ErrorContains(t, err, "url is required")
+
+	_, err = ParseEndpoint("url=https://example.com;events=foo")
+	require.ErrorContains(t, err, "unknown webhook event type")
+
+	_, err = ParseEndpoint("url=https://example.com;assets=abcd")
+	require.ErrorContains(t, err, "invalid webhook asset ID")
+}
+
+// TestEndpointMatches tests the event filters of an endpoint.
+func TestEndpointMatches(t *testing.T) {
+	t.Parallel()
+
+	event := &Event{
+		Type: EventSendConfirmed,
+		Assets: []AssetAmount{{
+			AssetID: testAssetID,
+			Amount:  10,
+		}},
+	}
+
+	require.True(t, (&Endpoint{}).Matches(event))
+	require.True(t, (&Endpoint{
+		EventTypes: []EventType{EventSendConfirmed},
+		AssetIDs:   []string{testAssetID},
+	}).Matches(event))
+	require.False(t, (&Endpoint{
+		EventTypes: []EventType{EventReceiveCompleted},
+	}).Matches(event))
+	require.False(t, (&Endpoint{
+		AssetIDs: []string{strings.Repeat("00", 32)},
+	}).Matches(event))
+}
+
+// TestDispatchRetryAndSign tests that events are signed, carry the configured
+// auth header and are retried if the endpoint returns an error.
+func TestDispatchRetryAndSign(t *testing.T) {
+	t.Parallel()
+
+	var (
+		numCalls atomic.Int32
+		received = make(chan *http.Request, 1)
+		bodies   = make(chan []byte, 1)
+	)
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			// Fail the first attempt to trigger a retry.
+			if numCalls.Add(1) == 1 {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+
+			received <- r
+			bodies <- body
+		},
+	))
+	defer server.Close()
+
+	secret := []byte("top secret")
+	d := NewDispatcher(&Config{
+		Endpoints: []*Endpoint{{
+			URL:             server.URL,
+			AuthHeaderName:  "Authorization",
+			AuthHeaderValue: "Bearer foo",
+			Secret:          secret,
+		}, {
+			// This endpoint is filtered out and must not be called.
+			URL:        server.URL + "/filtered",
+			EventTypes: []EventType{EventReceiveCompleted},
+		}},
+		HTTPClient:     server.Client(),
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+	})
+	defer func() {
+		require.NoError(t, d.Stop())
+	}()
+
+	event := &Event{
+		ID:   "event-id",
+		Type: EventSendConfirmed,
+		Assets: []AssetAmount{{
+			AssetID: testAssetID,
+			Amount:  10,
+		}},
+	}
+	d.Dispatch(event)
+
+	var (
+		req  *http.Request
+		body []byte
+	)
+	select {
+	case req = <-received:
+		body = <-bodies
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("webhook not delivered")
+	}
+
+	require.EqualValues(t, 2, numCalls.Load())
+	require.Equal(t, "/", req.URL.Path)
+	require.Equal(t, "Bearer foo", req.Header.Get("Authorization"))
+	require.Equal(t, "event-id", req.Header.Get(EventIDHeader))
+
+	timestamp := req.Header.Get(TimestampHeader)
+	require.Equal(
+		t, SignPayload(secret, timestamp, body),
+		req.Header.Get(SignatureHeader),
+	)
+
+	var decoded Event
+	require.NoError(t, json.Unmarshal(body, &decoded))
+	require.Equal(t, *event, decoded)
+}
+
+// TestReceiveEvent tests that the custodian's receive events for a detected,
+// confirmed and completed inbound transfer are converted into webhook events,
+// while other receive events are skipped.
+func TestReceiveEvent(t *testing.T) {
+	t.Parallel()
+
+	addr, _, _ := address.RandAddr(
+		t, &address.RegressionNetTap, address.RandProofCourierAddr(t),
+	)
+	encodedAddr, err := addr.EncodeAddress()
+	require.NoError(t, err)
+
+	outPoint := test.RandOp(t)
+	testCases := []struct {
+		status    address.Status
+		eventType EventType
+	}{{
+		status:    address.StatusTransactionDetected,
+		eventType: EventReceiveDetected,
+	}, {
+		status:    address.StatusTransactionConfirmed,
+		eventType: EventReceiveConfirmed,
+	}, {
+		status:    address.StatusCompleted,
+		eventType: EventReceiveCompleted,
+	}}
+	for _, tc := range testCases {
+		receiveEvent := tapgarden.NewAssetReceiveEvent(
+			*addr.Tap, outPoint, 0, tc.status,
+		)
+
+		event, err := newReceiveEvent(receiveEvent)
+		require.NoError(t, err)
+		require.Equal(t, tc.eventType, event.Type)
+		require.Equal(t, outPoint.String(), event.Outpoint)
+		require.Equal(t, encodedAddr, event.Address)
+		require.Equal(t, []AssetAmount{{
+			AssetID: addr.AssetID.String(),
+			Amount:  addr.Amount,
+			ScriptKey: hex.EncodeToString(
+				schnorr.SerializePubKey(&addr.ScriptKey),
+			),
+		}}, event.Assets)
+	}
+
+	// The intermediate proof received status and failed receives don't
+	// result in a webhook event.
+	event, err := newReceiveEvent(tapgarden.NewAssetReceiveEvent(
+		*addr.Tap, outPoint, 0, address.StatusProofReceived,
+	))
+	require.NoError(t, err)
+	require.Nil(t, event)
+
+	event, err = newReceiveEvent(tapgarden.NewAssetReceiveErrorEvent(
+		errors.New("courier unavailable"), *addr.Tap, outPoint, 0,
+		address.StatusTransactionDetected,
+	))
+	require.NoError(t, err)
+	require.Nil(t, event)
+}
+
+// TestIssuanceAlertEvent tests that universe issuance alerts are converted into
+// webhook events that can be filtered by asset ID.
+func TestIssuanceAlertEvent(t *testing.T) {
+	t.Parallel()
+
+	rule, err := universe.ParseWatchRule("tag=stable-*")
+	require.NoError(t, err)
+
+	leaf := &universe.Leaf{
+		GenesisWithGroup: universe.GenesisWithGroup{
+			Genesis: asset.RandGenesis(t, asset.Normal),
+		},
+		Amt: 1000,
+	}
+	source := universe.NewServerAddrFromStr("universe.example.com:10029")
+	alert := universe.NewIssuanceAlert(
+		rule, universe.Identifier{}, leaf, source,
+	)
+
+	event, err := newIssuanceAlertEvent(alert)
+	require.NoError(t, err)
+
+	assetID := leaf.Genesis.ID().String()
+	require.Equal(t, EventIssuanceAlert, event.Type)
+	require.Equal(t, "tag=stable-*", event.WatchRule)
+	require.Equal(t, source.HostStr(), event.Source)
+	require.Empty(t, event.GroupKey)
+	require.Equal(t, []AssetAmount{{
+		AssetID: assetID,
+		Amount:  1000,
+	}}, event.Assets)
+
+	require.True(t, (&Endpoint{
+		EventTypes: []EventType{EventIssuanceAlert},
+		AssetIDs:   []string{assetID},
+	}).Matches(event))
+}
+
+// TestProofRecoveredEvent tests that proof recovery events of the proof
+// archive are converted into webhook events that can be filtered by asset ID.
+func TestProofRecoveredEvent(t *testing.T) {
+	t.Parallel()
+
+	var (
+		assetID   = asset.RandID(t)
+		scriptKey = test.RandPubKey(t)
+		outPoint  = test.RandOp(t)
+	)
+	recovered := proof.NewProofRecoveredEvent(proof.Locator{
+		AssetID:   &assetID,
+		ScriptKey: *scriptKey,
+		OutPoint:  &outPoint,
+	}, "universe.example.com:10029")
+
+	event, err := newProofRecoveredEvent(recovered)
+	require.NoError(t, err)
+
+	require.Equal(t, EventProofRecovered, event.Type)
+	require.Equal(t, outPoint.String(), event.Outpoint)
+	require.Equal(t, outPoint.Hash.String(), event.AnchorTxid)
+	require.Equal(t, "universe.example.com:10029", event.Source)
+	require.Equal(t, []AssetAmount{{
+		AssetID: assetID.String(),
+		ScriptKey: hex.EncodeToString(
+			schnorr.SerializePubKey(scriptKey),
+		),
+	}}, event.Assets)
+
+	require.True(t, (&Endpoint{
+		EventTypes: []EventType{EventProofRecovered},
+		AssetIDs:   []string{assetID.String()},
+	}).Matches(event))
+
+	// Recovered proofs without an outpoint can't be announced.
+	_, err = newProofRecoveredEvent(proof.NewProofRecoveredEvent(
+		proof.Locator{AssetID: &assetID}, "",
+	))
+	require.Error(t, err)
+}
+
+// TestAnchorSpendAlertEvent tests that alerts of the anchor spend watcher are
+// converted into webhook events that can be filtered by asset ID.
+func TestAnchorSpendAlertEvent(t *testing.T) {
+	t.Parallel()
+
+	var (
+		assetID     = asset.RandID(t)
+		outPoint    = test.RandOp(t)
+		spendTxHash = test.RandHash()
+	)
+	alert := tapfreighter.NewAnchorSpendAlert(tapfreighter.WatchedAnchor{
+		OutPoint: outPoint,
+		AssetIDs: []asset.ID{assetID},
+	}, spendTxHash, true)
+
+	event := newAnchorSpendAlertEvent(alert)
+	require.Equal(t, EventAnchorSpendAlert, event.Type)
+	require.Equal(t, outPoint.String(), event.Outpoint)
+	require.Equal(t, outPoint.Hash.String(), event.AnchorTxid)
+	require.Equal(t, spendTxHash.String(), event.SpendingTxid)
+	require.True(t, event.Confirmed)
+	require.Equal(t, []AssetAmount{{
+		AssetID: assetID.String(),
+	}}, event.Assets)
+
+	require.True(t, (&Endpoint{
+		EventTypes: []EventType{EventAnchorSpendAlert},
+		AssetIDs:   []string{assetID.String()},
+	}).Matches(event))
+}
@@ -0,0 +1,532 @@
+package webhook
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/universe"
+)
+
+// EventType is the type of event that is delivered to a webhook.
+type EventType string
+
+const (
+	// EventReceiveDetected is sent once the anchor transaction of an
+	// inbound transfer was detected, usually while it's still in the
+	// mempool.
+	EventReceiveDetected EventType = "receive_detected"
+
+	// EventReceiveConfirmed is sent once the anchor transaction of an
+	// inbound transfer confirmed on chain.
+	EventReceiveConfirmed EventType = "receive_confirmed"
+
+	// EventReceiveCompleted is sent once the proof of an inbound transfer
+	// was received and imported and the assets are spendable.
+	EventReceiveCompleted EventType = "receive_completed"
+
+	// EventSendBroadcast is sent once the anchor transaction of an outbound
+	// transfer was broadcast.
+	EventSendBroadcast EventType = "send_broadcast"
+
+	// EventSendConfirmed is sent once the anchor transaction of an
+	// outbound transfer confirmed on chain.
+	EventSendConfirmed EventType = "send_confirmed"
+
+	// EventSendCompleted is sent once an outbound transfer is complete and
+	// the proofs were delivered to the receivers.
+	EventSendCompleted EventType = "send_completed"
+
+	// EventBurnBroadcast is sent once the anchor transaction of a burn was
+	// broadcast.
+	EventBurnBroadcast EventType = "burn_broadcast"
+
+	// EventBurnConfirmed is sent once the anchor transaction of a burn
+	// confirmed on chain.
+	EventBurnConfirmed EventType = "burn_confirmed"
+
+	// EventIssuanceAlert is sent once a new issuance leaf that matches a
+	// rule of the universe issuance watch-list was synced from the
+	// federation.
+	EventIssuanceAlert EventType = "issuance_alert"
+
+	// EventProofRecovered is sent once a proof that was missing from the
+	// local proof archive was fetched from a universe server and
+	// re-populated in the archive.
+	EventProofRecovered EventType = "proof_recovered"
+
+	// EventAnchorSpendAlert is sent once an anchor output holding our
+	// assets was spent, in the mempool or on chain, by a transaction that
+	// didn't originate from this node.
+	EventAnchorSpendAlert EventType = "anchor_spend_alert"
+)
+
+// AllEventTypes is the list of all event types that can be delivered to a
+// webhook.
+var AllEventTypes = []EventType{
+	EventReceiveDetected, EventReceiveConfirmed, EventReceiveCompleted,
+	EventSendBroadcast, EventSendConfirmed, EventSendCompleted,
+	EventBurnBroadcast, EventBurnConfirmed, EventIssuanceAlert,
+	EventProofRecovered, EventAnchorSpendAlert,
+}
+
+// ParseEventType parses an event type from its string representation.
+func ParseEventType(s string) (EventType, error) {
+	for _, eventType := range AllEventTypes {
+		if string(eventType) == s {
+			return eventType, nil
+		}
+	}
+
+	return "", fmt.Errorf("unknown webhook event type: %v", s)
+}
+
+// AssetAmount is the amount of an asset that is part of an event.
+type AssetAmount struct {
+	// AssetID is the hex encoded ID of the asset.
+	AssetID string `json:"asset_id"`
+
+	// Amount is the amount of units of the asset.
+	Amount uint64 `json:"amount"`
+
+	// ScriptKey is the hex encoded script key the asset is sent to.
+	ScriptKey string `json:"script_key,omitempty"`
+
+	// Burn is true if the amount is burned.
+	Burn bool `json:"burn,omitempty"`
+}
+
+// Event is the JSON payload that is POSTed to a webhook.
+type Event struct {
+	// ID uniquely identifies the event. It stays the same for all delivery
+	// attempts, so it can be used to de-duplicate events.
+	ID string `json:"id"`
+
+	// Type is the type of the event.
+	Type EventType `json:"type"`
+
+	// Timestamp is the unix timestamp of the event in seconds.
+	Timestamp int64 `json:"timestamp"`
+
+	// AnchorTxid is the hex encoded ID of the anchor transaction.
+	AnchorTxid string `json:"anchor_txid,omitempty"`
+
+	// Outpoint is the anchor outpoint of an inbound transfer.
+	Outpoint string `json:"outpoint,omitempty"`
+
+	// ConfirmationHeight is the block height the anchor transaction
+	// confirmed at, if known.
+	ConfirmationHeight uint32 `json:"confirmation_height,omitempty"`
+
+	// Address is the Taproot Asset address of an inbound transfer.
+	Address string `json:"address,omitempty"`
+
+	// Assets is the list of assets and amounts that are part of the event.
+	Assets []AssetAmount `json:"assets"`
+
+	// GroupKey is the hex encoded group key of the asset of an issuance
+	// alert, if the asset is grouped.
+	GroupKey string `json:"group_key,omitempty"`
+
+	// WatchRule is the issuance watch-list rule that triggered an
+	// issuance alert.
+	WatchRule string `json:"watch_rule,omitempty"`
+
+	// Source is the universe server the leaf of an issuance alert was
+	// synced from, or a recovered proof was fetched from.
+	Source string `json:"source,omitempty"`
+
+	// SpendingTxid is the hex encoded ID of the transaction that spent an
+	// anchor output of an anchor spend alert.
+	SpendingTxid string `json:"spending_txid,omitempty"`
+
+	// Confirmed is true if the spending transaction of an anchor spend
+	// alert was detected in a block rather than in the mempool.
+	Confirmed bool `json:"confirmed,omitempty"`
+}
+
+// hasAsset returns true if the event involves the given asset.
+func (e *Event) hasAsset(assetID string) bool {
+	for _, a := range e.Assets {
+		if a.AssetID == assetID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// newEventID derives a deterministic ID for an event from its type, a
+// reference (outpoint or anchor TXID) and its timestamp.
+func newEventID(eventType EventType, ref string, ts time.Time) string {
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "%s:%s:%d", eventType, ref, ts.UnixNano())
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// newReceiveEvent converts a custodian receive event into a webhook event. If
+// the receive event isn't relevant for webhooks, nil is returned.
+func newReceiveEvent(e *tapgarden.AssetReceiveEvent) (*Event, error) {
+	if e.Error != nil {
+		return nil, nil
+	}
+
+	var eventType EventType
+	switch e.Status {
+	case address.StatusTransactionDetected:
+		eventType = EventReceiveDetected
+
+	case address.StatusTransactionConfirmed:
+		eventType = EventReceiveConfirmed
+
+	case address.StatusCompleted:
+		eventType = EventReceiveCompleted
+
+	default:
+		return nil, nil
+	}
+
+	addr, err := e.Address.EncodeAddress()
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode address: %w", err)
+	}
+
+	var (
+		outpoint = e.OutPoint.String()
+		ts       = e.Timestamp()
+	)
+
+	return &Event{
+		ID:                 newEventID(eventType, outpoint, ts),
+		Type:               eventType,
+		Timestamp:          ts.Unix(),
+		AnchorTxid:         e.OutPoint.Hash.String(),
+		Outpoint:           outpoint,
+		ConfirmationHeight: e.ConfirmationHeight,
+		Address:            addr,
+		Assets: []AssetAmount{{
+			AssetID: e.Address.AssetID.String(),
+			Amount:  e.Address.Amount,
+			ScriptKey: hex.EncodeToString(
+				schnorr.SerializePubKey(&e.Address.ScriptKey),
+			),
+		}},
+	}, nil
+}
+
+// newSendEvent converts a chain porter send event into a webhook event. If
+// the send event isn't relevant for webhooks, nil is returned.
+func newSendEvent(e *tapfreighter.AssetSendEvent) (*Event, error) {
+	if e.Error != nil {
+		return nil, nil
+	}
+
+	var (
+		assets []AssetAmount
+		isBurn bool
+	)
+	for _, vPkt := range e.VirtualPackets {
+		for _, vOut := range vPkt.Outputs {
+			if vOut.Asset == nil {
+				continue
+			}
+
+			burn := vOut.Asset.IsBurn()
+			isBurn = isBurn || burn
+
+			assets = append(assets, assetAmount(vOut.Asset, burn))
+		}
+	}
+
+	var eventType EventType
+	switch {
+	case e.SendState == tapfreighter.SendStateBroadcast && isBurn:
+		eventType = EventBurnBroadcast
+
+	case e.SendState == tapfreighter.SendStateBroadcast:
+		eventType = EventSendBroadcast
+
+	case e.SendState == tapfreighter.SendStateWaitTxConf && isBurn:
+		eventType = EventBurnConfirmed
+
+	case e.SendState == tapfreighter.SendStateWaitTxConf:
+		eventType = EventSendConfirmed
+
+	case e.SendState == tapfreighter.SendStateComplete && !isBurn:
+		eventType = EventSendCompleted
+
+	default:
+		return nil, nil
+	}
+
+	var txid string
+	if e.AnchorTx != nil && e.AnchorTx.FinalTx != nil {
+		txid = e.AnchorTx.FinalTx.TxHash().String()
+	}
+
+	return &Event{
+		ID:         newEventID(eventType, txid, e.Timestamp()),
+		Type:       eventType,
+		Timestamp:  e.Timestamp().Unix(),
+		AnchorTxid: txid,
+		Assets:     assets,
+	}, nil
+}
+
+// newIssuanceAlertEvent converts a universe issuance watch-list alert into a
+// webhook event.
+func newIssuanceAlertEvent(a *universe.IssuanceAlert) (*Event, error) {
+	if a.Leaf == nil {
+		return nil, fmt.Errorf("issuance alert without leaf")
+	}
+
+	var (
+		genesisOutpoint = a.Leaf.Genesis.FirstPrevOut.String()
+		ts              = a.Timestamp()
+	)
+
+	var groupKey string
+	if a.Leaf.GroupKey != nil {
+		groupKey = hex.EncodeToString(schnorr.SerializePubKey(
+			&a.Leaf.GroupKey.GroupPubKey,
+		))
+	}
+
+	return &Event{
+		ID:        newEventID(EventIssuanceAlert, genesisOutpoint, ts),
+		Type:      EventIssuanceAlert,
+		Timestamp: ts.Unix(),
+		Outpoint:  genesisOutpoint,
+		Assets: []AssetAmount{{
+			AssetID: a.Leaf.Genesis.ID().String(),
+			Amount:  a.Leaf.Amt,
+		}},
+		GroupKey:  groupKey,
+		WatchRule: a.Rule.String(),
+		Source:    a.Source.HostStr(),
+	}, nil
+}
+
+// newProofRecoveredEvent converts a proof recovery event of the proof archive
+// into a webhook event.
+func newProofRecoveredEvent(e *proof.ProofRecoveredEvent) (*Event, error) {
+	loc := e.Locator
+	if loc.AssetID == nil || loc.OutPoint == nil {
+		return nil, fmt.Errorf("recovered proof without asset ID or " +
+			"outpoint")
+	}
+
+	var (
+		outpoint = loc.OutPoint.String()
+		ts       = e.Timestamp()
+	)
+
+	var groupKey string
+	if loc.GroupKey != nil {
+		groupKey = hex.EncodeToString(
+			schnorr.SerializePubKey(loc.GroupKey),
+		)
+	}
+
+	return &Event{
+		ID:         newEventID(EventProofRecovered, outpoint, ts),
+		Type:       EventProofRecovered,
+		Timestamp:  ts.Unix(),
+		AnchorTxid: loc.OutPoint.Hash.String(),
+		Outpoint:   outpoint,
+		Assets: []AssetAmount{{
+			AssetID: loc.AssetID.String(),
+			ScriptKey: hex.EncodeToString(
+				schnorr.SerializePubKey(&loc.ScriptKey),
+			),
+		}},
+		GroupKey: groupKey,
+		Source:   e.Source,
+	}, nil
+}
+
+// newAnchorSpendAlertEvent converts an alert of the anchor spend watcher into
+// a webhook event.
+func newAnchorSpendAlertEvent(a *tapfreighter.AnchorSpendAlert) *Event {
+	var (
+		outpoint = a.Anchor.OutPoint.String()
+		ts       = a.Timestamp()
+	)
+
+	assets := make([]AssetAmount, 0, len(a.Anchor.AssetIDs))
+	for _, assetID := range a.Anchor.AssetIDs {
+		assets = append(assets, AssetAmount{
+			AssetID: assetID.String(),
+		})
+	}
+
+	return &Event{
+		ID:           newEventID(EventAnchorSpendAlert, outpoint, ts),
+		Type:         EventAnchorSpendAlert,
+		Timestamp:    ts.Unix(),
+		AnchorTxid:   a.Anchor.OutPoint.Hash.String(),
+		Outpoint:     outpoint,
+		Assets:       assets,
+		SpendingTxid: a.SpendingTxHash.String(),
+		Confirmed:    a.Confirmed,
+	}
+}
+
+// assetAmount creates the webhook representation of an asset output.
+func assetAmount(a *asset.Asset, burn bool) AssetAmount {
+	var scriptKey string
+	if a.ScriptKey.PubKey != nil {
+		scriptKey = hex.EncodeToString(
+			schnorr.SerializePubKey(a.ScriptKey.PubKey),
+		)
+	}
+
+	return AssetAmount{
+		AssetID:   a.ID().String(),
+		Amount:    a.Amount,
+		ScriptKey: scriptKey,
+		Burn:      burn,
+	}
+}
+
+// Endpoint is a webhook endpoint events are POSTed to.
+type Endpoint struct {
+	// URL is the URL events are POSTed to.
+	URL string
+
+	// AuthHeaderName is the name of an optional header that is added to
+	// each request for authentication (for example "Authorization").
+	AuthHeaderName string
+
+	// AuthHeaderValue is the value of the optional authentication header.
+	AuthHeaderValue string
+
+	// Secret is an optional secret used to sign the payloads. If set, the
+	// hex encoded HMAC-SHA256 of the payload is added to each request in
+	// the SignatureHeader.
+	Secret []byte
+
+	// EventTypes is the optional list of event types that should be sent
+	// to this endpoint. If empty, all events are sent.
+	EventTypes []EventType
+
+	// AssetIDs is the optional list of hex encoded asset IDs to filter
+	// events by. If empty, events for all assets are sent.
+	AssetIDs []string
+}
+
+// Matches returns true if the given event passes the filters of the endpoint.
+func (e *Endpoint) Matches(event *Event) bool {
+	if len(e.EventTypes) > 0 {
+		var match bool
+		for _, eventType := range e.EventTypes {
+			if eventType == event.Type {
+				match = true
+				break
+			}
+		}
+
+		if !match {
+			return false
+		}
+	}
+
+	if len(e.AssetIDs) > 0 {
+		for _, assetID := range e.AssetIDs {
+			if event.hasAsset(assetID) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return true
+}
+
+// ParseEndpoint parses a webhook endpoint from its config representation. The
+// format is a semicolon separated list of key=value pairs, where only the url
+// key is mandatory:
+//
+//	url=<url>;events=<type>,<type>;assets=<asset_id>,<asset_id>;
+//	auth=<header name>:<header value>;secret=<secret>
+func ParseEndpoint(s string) (*Endpoint, error) {
+	var endpoint Endpoint
+	for _, part := range strings.Split(s, ";") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid webhook option %q, "+
+				"expected key=value", part)
+		}
+
+		switch key {
+		case "url":
+			endpoint.URL = value
+
+		case "events":
+			for _, eventStr := range strings.Split(value, ",") {
+				eventType, err := ParseEventType(eventStr)
+				if err != nil {
+					return nil, err
+				}
+
+				endpoint.EventTypes = append(
+					endpoint.EventTypes, eventType,
+				)
+			}
+
+		case "assets":
+			for _, assetIDStr := range strings.Split(value, ",") {
+				assetID, err := hex.DecodeString(assetIDStr)
+				if err != nil || len(assetID) != sha256.Size {
+					return nil, fmt.Errorf("invalid "+
+						"webhook asset ID: %v",
+						assetIDStr)
+				}
+
+				endpoint.AssetIDs = append(
+					endpoint.AssetIDs, assetIDStr,
+				)
+			}
+
+		case "auth":
+			name, headerValue, ok := strings.Cut(value, ":")
+			if !ok {
+				return nil, fmt.Errorf("invalid webhook auth " +
+					"header, expected name:value")
+			}
+
+			endpoint.AuthHeaderName = strings.TrimSpace(name)
+			endpoint.AuthHeaderValue = strings.TrimSpace(
+				headerValue,
+			)
+
+		case "secret":
+			endpoint.Secret = []byte(value)
+
+		default:
+			return nil, fmt.Errorf("unknown webhook option: %v",
+				key)
+		}
+	}
+
+	if endpoint.URL == "" {
+		return nil, fmt.Errorf("webhook url is required")
+	}
+
+	return &endpoint, nil
+}